@@ -68,12 +68,16 @@ type Gloo struct {
 type GlooDeployment struct {
 	Image   *Image `json:"image,omitempty"`
 	XdsPort string `json:"xdsPort,omitempty"`
+	// the port gloo serves the wasm filter modules pulled from image registries on, which enables the wasm options of
+	// the settings
+	WasmImageCachePort string `json:"wasmImageCachePort,omitempty"`
 	*DeploymentSpec
 }
 
 type Discovery struct {
-	Deployment *DiscoveryDeployment `json:"deployment,omitempty"`
-	DisableFDS bool `json:"disable_fds,omitempty"`
+	Deployment            *DiscoveryDeployment `json:"deployment,omitempty"`
+	DisableFDS            bool                 `json:"disable_fds,omitempty"`
+	DisableLeaderElection bool                 `json:"disable_leader_election,omitempty"`
 }
 
 type DiscoveryDeployment struct {
@@ -106,12 +110,12 @@ type GatewayProxyDeployment struct {
 	*DeploymentSpec
 }
 type GatewayProxyService struct {
-	Type             string            `json:"type,omitempty"`
-	HttpPort         string            `json:"httpPort,omitempty"`
-	HttpsPort        string            `json:"httpsPort,omitempty"`
-	ClusterIP        string            `json:"clusterIP,omitempty"`
-	ExtraAnnotations map[string]string `json:"extraAnnotations,omitempty"`
-	ExternalTrafficPolicy string       `json:"externalTrafficPolicy,omitempty"`
+	Type                  string            `json:"type,omitempty"`
+	HttpPort              string            `json:"httpPort,omitempty"`
+	HttpsPort             string            `json:"httpsPort,omitempty"`
+	ClusterIP             string            `json:"clusterIP,omitempty"`
+	ExtraAnnotations      map[string]string `json:"extraAnnotations,omitempty"`
+	ExternalTrafficPolicy string            `json:"externalTrafficPolicy,omitempty"`
 }
 
 type GatewayProxyConfigMap struct {
@@ -0,0 +1,82 @@
+package client_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/solo-io/gloo/pkg/client"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/external"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+var _ = Describe("Builders", func() {
+
+	It("builds an upstream of google cloud functions", func() {
+		us := NewUpstream("gloo-system", "gcf").
+			GoogleCloudFunction("us-central1", "my-project", "hello").
+			GoogleCloudFunction("us-central1", "my-project", "goodbye").
+			Build()
+		Expect(us.Metadata).To(Equal(core.Metadata{Namespace: "gloo-system", Name: "gcf"}))
+		Expect(us.UpstreamSpec.GetExternal().Functions).To(Equal([]*external.FunctionSpec{
+			{LogicalName: "hello", Url: "https://us-central1-my-project.cloudfunctions.net/hello"},
+			{LogicalName: "goodbye", Url: "https://us-central1-my-project.cloudfunctions.net/goodbye"},
+		}))
+	})
+
+	It("replaces the type of the upstream", func() {
+		us := NewUpstream("gloo-system", "petstore").
+			StaticHost("petstore.example.com", 80).
+			Kube("petstore", "default", 8080).
+			Build()
+		Expect(us.UpstreamSpec.GetStatic()).To(BeNil())
+		Expect(us.UpstreamSpec.GetKube().ServiceName).To(Equal("petstore"))
+		Expect(us.UpstreamSpec.GetKube().ServicePort).To(BeEquivalentTo(8080))
+	})
+
+	It("builds new resources each time", func() {
+		builder := NewUpstream("gloo-system", "static").StaticHost("a.example.com", 80)
+		first := builder.Build()
+		builder.StaticHost("b.example.com", 80)
+		Expect(first.UpstreamSpec.GetStatic().Hosts).To(HaveLen(1))
+		Expect(builder.Build().UpstreamSpec.GetStatic().Hosts).To(HaveLen(2))
+	})
+
+	It("builds a virtual service routing to functions", func() {
+		gcf := core.ResourceRef{Namespace: "gloo-system", Name: "gcf"}
+		petstore := core.ResourceRef{Namespace: "gloo-system", Name: "petstore"}
+		vs := NewVirtualService("gloo-system", "default").
+			Domains("example.com").
+			Route(
+				PrefixRoute("/hello").Methods("POST").To(GoogleCloudFunction(gcf, "hello")),
+				ExactRoute("/pets").Header("x-canary", "").ToWeighted(
+					Weighted(RestFunction(petstore, "listPets"), 9),
+					Weighted(UpstreamDestination(petstore), 1),
+				),
+				PrefixRoute("/").DirectResponse(404, "not found"),
+			).
+			Build()
+
+		Expect(vs.Metadata.Ref()).To(Equal(core.ResourceRef{Namespace: "gloo-system", Name: "default"}))
+		Expect(vs.VirtualHost.Domains).To(Equal([]string{"example.com"}))
+		routes := vs.VirtualHost.Routes
+		Expect(routes).To(HaveLen(3))
+
+		Expect(routes[0].Matcher).To(Equal(&v1.Matcher{
+			PathSpecifier: &v1.Matcher_Prefix{Prefix: "/hello"},
+			Methods:       []string{"POST"},
+		}))
+		single := routes[0].GetRouteAction().GetSingle()
+		Expect(*single.GetUpstream()).To(Equal(gcf))
+		Expect(single.DestinationSpec.GetExternal().LogicalName).To(Equal("hello"))
+
+		Expect(routes[1].Matcher.Headers).To(Equal([]*v1.HeaderMatcher{{Name: "x-canary"}}))
+		multi := routes[1].GetRouteAction().GetMulti().Destinations
+		Expect(multi).To(HaveLen(2))
+		Expect(multi[0].Weight).To(BeEquivalentTo(9))
+		Expect(multi[0].Destination.DestinationSpec.GetRest().FunctionName).To(Equal("listPets"))
+		Expect(multi[1].Destination.DestinationSpec).To(BeNil())
+
+		Expect(routes[2].GetDirectResponseAction().Status).To(BeEquivalentTo(404))
+	})
+})
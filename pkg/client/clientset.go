@@ -0,0 +1,102 @@
+// Package client is the supported Go client of Gloo, for the controllers and tools that configure Gloo
+// programmatically: typed clients for the resources, builders for upstreams, virtual services and their routes,
+// and helpers to watch the resources.
+package client
+
+import (
+	"context"
+
+	gatewayv1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/factory"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/kube"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/kube/cache"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/kube/crd"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/memory"
+	"github.com/solo-io/solo-kit/pkg/errors"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+// Clientset holds a registered client for each resource of Gloo and of the gateway
+type Clientset struct {
+	Upstreams       v1.UpstreamClient
+	UpstreamGroups  v1.UpstreamGroupClient
+	Proxies         v1.ProxyClient
+	Settings        v1.SettingsClient
+	Secrets         v1.SecretClient
+	VirtualServices gatewayv1.VirtualServiceClient
+	Gateways        gatewayv1.GatewayClient
+}
+
+// NewKubeClientset stores the resources in the custom resources of the cluster, and the secrets in kubernetes secrets.
+// The caches of the clients are stopped with the context.
+func NewKubeClientset(ctx context.Context, cfg *rest.Config) (*Clientset, error) {
+	kubeClient, err := kubernetes.NewForConfig(cfg)
+	if err != nil {
+		return nil, errors.Wrapf(err, "creating kube client")
+	}
+	coreCache, err := cache.NewKubeCoreCache(ctx, kubeClient)
+	if err != nil {
+		return nil, errors.Wrapf(err, "creating kube core cache")
+	}
+	sharedCache := kube.NewKubeCache(ctx)
+	crdFactory := func(resourceCrd crd.Crd) factory.ResourceClientFactory {
+		return &factory.KubeResourceClientFactory{
+			Crd:         resourceCrd,
+			Cfg:         cfg,
+			SharedCache: sharedCache,
+		}
+	}
+	return newClientset(crdFactory, &factory.KubeSecretClientFactory{
+		Clientset: kubeClient,
+		Cache:     coreCache,
+	})
+}
+
+// NewMemoryClientset stores the resources in memory, e.g. to test controllers
+func NewMemoryClientset() (*Clientset, error) {
+	memoryFactory := &factory.MemoryResourceClientFactory{
+		Cache: memory.NewInMemoryResourceCache(),
+	}
+	return newClientset(func(crd.Crd) factory.ResourceClientFactory {
+		return memoryFactory
+	}, memoryFactory)
+}
+
+func newClientset(crdFactory func(crd.Crd) factory.ResourceClientFactory, secretFactory factory.ResourceClientFactory) (*Clientset, error) {
+	var (
+		cs  Clientset
+		err error
+	)
+	if cs.Upstreams, err = v1.NewUpstreamClient(crdFactory(v1.UpstreamCrd)); err != nil {
+		return nil, errors.Wrapf(err, "creating upstreams client")
+	}
+	if cs.UpstreamGroups, err = v1.NewUpstreamGroupClient(crdFactory(v1.UpstreamGroupCrd)); err != nil {
+		return nil, errors.Wrapf(err, "creating upstream groups client")
+	}
+	if cs.Proxies, err = v1.NewProxyClient(crdFactory(v1.ProxyCrd)); err != nil {
+		return nil, errors.Wrapf(err, "creating proxies client")
+	}
+	if cs.Settings, err = v1.NewSettingsClient(crdFactory(v1.SettingsCrd)); err != nil {
+		return nil, errors.Wrapf(err, "creating settings client")
+	}
+	if cs.Secrets, err = v1.NewSecretClient(secretFactory); err != nil {
+		return nil, errors.Wrapf(err, "creating secrets client")
+	}
+	if cs.VirtualServices, err = gatewayv1.NewVirtualServiceClient(crdFactory(gatewayv1.VirtualServiceCrd)); err != nil {
+		return nil, errors.Wrapf(err, "creating virtual services client")
+	}
+	if cs.Gateways, err = gatewayv1.NewGatewayClient(crdFactory(gatewayv1.GatewayCrd)); err != nil {
+		return nil, errors.Wrapf(err, "creating gateways client")
+	}
+
+	for _, client := range []interface{ Register() error }{
+		cs.Upstreams, cs.UpstreamGroups, cs.Proxies, cs.Settings, cs.Secrets, cs.VirtualServices, cs.Gateways,
+	} {
+		if err := client.Register(); err != nil {
+			return nil, err
+		}
+	}
+	return &cs, nil
+}
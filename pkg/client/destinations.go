@@ -0,0 +1,88 @@
+package client
+
+import (
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/aws"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/azure"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/external"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/grpc"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/rest"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+// UpstreamDestination routes to an upstream
+func UpstreamDestination(upstream core.ResourceRef) *v1.Destination {
+	return &v1.Destination{
+		DestinationType: &v1.Destination_Upstream{Upstream: &upstream},
+	}
+}
+
+// ServiceDestination routes to a port of a kubernetes service, without an upstream
+func ServiceDestination(service core.ResourceRef, port uint32) *v1.Destination {
+	return &v1.Destination{
+		DestinationType: &v1.Destination_Service{
+			Service: &v1.ServiceDestination{Ref: service, Port: port},
+		},
+	}
+}
+
+// AwsFunction invokes a lambda function of an aws upstream, by its logical name
+func AwsFunction(upstream core.ResourceRef, logicalName string) *v1.Destination {
+	return functionDestination(upstream, &v1.DestinationSpec{
+		DestinationType: &v1.DestinationSpec_Aws{
+			Aws: &aws.DestinationSpec{LogicalName: logicalName},
+		},
+	})
+}
+
+// AzureFunction invokes a function of an azure upstream
+func AzureFunction(upstream core.ResourceRef, functionName string) *v1.Destination {
+	return functionDestination(upstream, &v1.DestinationSpec{
+		DestinationType: &v1.DestinationSpec_Azure{
+			Azure: &azure.DestinationSpec{FunctionName: functionName},
+		},
+	})
+}
+
+// RestFunction calls a rest function of an upstream, e.g. one discovered from its swagger
+func RestFunction(upstream core.ResourceRef, functionName string) *v1.Destination {
+	return functionDestination(upstream, &v1.DestinationSpec{
+		DestinationType: &v1.DestinationSpec_Rest{
+			Rest: &rest.DestinationSpec{FunctionName: functionName},
+		},
+	})
+}
+
+// GrpcFunction calls a method of a grpc service of an upstream
+func GrpcFunction(upstream core.ResourceRef, pkg, service, function string) *v1.Destination {
+	return functionDestination(upstream, &v1.DestinationSpec{
+		DestinationType: &v1.DestinationSpec_Grpc{
+			Grpc: &grpc.DestinationSpec{Package: pkg, Service: service, Function: function},
+		},
+	})
+}
+
+// ExternalFunction calls a function of an external upstream, by its logical name
+func ExternalFunction(upstream core.ResourceRef, logicalName string) *v1.Destination {
+	return functionDestination(upstream, &v1.DestinationSpec{
+		DestinationType: &v1.DestinationSpec_External{
+			External: &external.DestinationSpec{LogicalName: logicalName},
+		},
+	})
+}
+
+// GoogleCloudFunction calls a google cloud function added to an external upstream with UpstreamBuilder.GoogleCloudFunction
+func GoogleCloudFunction(upstream core.ResourceRef, function string) *v1.Destination {
+	return ExternalFunction(upstream, function)
+}
+
+func functionDestination(upstream core.ResourceRef, spec *v1.DestinationSpec) *v1.Destination {
+	dest := UpstreamDestination(upstream)
+	dest.DestinationSpec = spec
+	return dest
+}
+
+// Weighted gives a weight to a destination, to route to several destinations
+func Weighted(dest *v1.Destination, weight uint32) *v1.WeightedDestination {
+	return &v1.WeightedDestination{Destination: dest, Weight: weight}
+}
@@ -0,0 +1,119 @@
+package client
+
+import (
+	"fmt"
+
+	"github.com/gogo/protobuf/proto"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/aws"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/external"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/kubernetes"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/static"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+// UpstreamBuilder builds an upstream, e.g. NewUpstream("gloo-system", "petstore").Kube("petstore", "default", 8080).Build().
+// Setting the type of the upstream replaces the type set before.
+type UpstreamBuilder struct {
+	upstream v1.Upstream
+}
+
+func NewUpstream(namespace, name string) *UpstreamBuilder {
+	return &UpstreamBuilder{upstream: v1.Upstream{
+		Metadata:     core.Metadata{Namespace: namespace, Name: name},
+		UpstreamSpec: &v1.UpstreamSpec{},
+	}}
+}
+
+func (b *UpstreamBuilder) Labels(labels map[string]string) *UpstreamBuilder {
+	b.upstream.Metadata.Labels = labels
+	return b
+}
+
+func (b *UpstreamBuilder) Annotation(key, value string) *UpstreamBuilder {
+	if b.upstream.Metadata.Annotations == nil {
+		b.upstream.Metadata.Annotations = make(map[string]string)
+	}
+	b.upstream.Metadata.Annotations[key] = value
+	return b
+}
+
+// Kube routes to the pods of a kubernetes service
+func (b *UpstreamBuilder) Kube(serviceName, serviceNamespace string, servicePort uint32) *UpstreamBuilder {
+	b.upstream.UpstreamSpec.UpstreamType = &v1.UpstreamSpec_Kube{
+		Kube: &kubernetes.UpstreamSpec{
+			ServiceName:      serviceName,
+			ServiceNamespace: serviceNamespace,
+			ServicePort:      servicePort,
+		},
+	}
+	return b
+}
+
+// StaticHost adds a host to a static upstream
+func (b *UpstreamBuilder) StaticHost(addr string, port uint32) *UpstreamBuilder {
+	spec := b.upstream.UpstreamSpec.GetStatic()
+	if spec == nil {
+		spec = &static.UpstreamSpec{}
+		b.upstream.UpstreamSpec.UpstreamType = &v1.UpstreamSpec_Static{Static: spec}
+	}
+	spec.Hosts = append(spec.Hosts, &static.Host{Addr: addr, Port: port})
+	return b
+}
+
+// Aws invokes the lambda functions of a region, with the credentials of the secret
+func (b *UpstreamBuilder) Aws(region string, secretRef core.ResourceRef) *UpstreamBuilder {
+	b.upstream.UpstreamSpec.UpstreamType = &v1.UpstreamSpec_Aws{
+		Aws: &aws.UpstreamSpec{
+			Region:    region,
+			SecretRef: secretRef,
+		},
+	}
+	return b
+}
+
+// LambdaFunction adds a function to an aws upstream, the qualifier is optional
+func (b *UpstreamBuilder) LambdaFunction(logicalName, functionName, qualifier string) *UpstreamBuilder {
+	spec := b.upstream.UpstreamSpec.GetAws()
+	if spec == nil {
+		spec = &aws.UpstreamSpec{}
+		b.upstream.UpstreamSpec.UpstreamType = &v1.UpstreamSpec_Aws{Aws: spec}
+	}
+	spec.LambdaFunctions = append(spec.LambdaFunctions, &aws.LambdaFunctionSpec{
+		LogicalName:        logicalName,
+		LambdaFunctionName: functionName,
+		Qualifier:          qualifier,
+	})
+	return b
+}
+
+// ExternalFunction adds a function served at an https url to an external upstream.
+// All the functions of an upstream must be served by the same host.
+func (b *UpstreamBuilder) ExternalFunction(logicalName, url string) *UpstreamBuilder {
+	spec := b.upstream.UpstreamSpec.GetExternal()
+	if spec == nil {
+		spec = &external.UpstreamSpec{}
+		b.upstream.UpstreamSpec.UpstreamType = &v1.UpstreamSpec_External{External: spec}
+	}
+	spec.Functions = append(spec.Functions, &external.FunctionSpec{
+		LogicalName: logicalName,
+		Url:         url,
+	})
+	return b
+}
+
+// GoogleCloudFunction adds an http triggered google cloud function to an external upstream, named after the function.
+// The functions of a project and region share a host, and can be added to the same upstream.
+func (b *UpstreamBuilder) GoogleCloudFunction(region, project, function string) *UpstreamBuilder {
+	return b.ExternalFunction(function, GoogleCloudFunctionUrl(region, project, function))
+}
+
+// GoogleCloudFunctionUrl is the url that triggers a google cloud function
+func GoogleCloudFunctionUrl(region, project, function string) string {
+	return fmt.Sprintf("https://%v-%v.cloudfunctions.net/%v", region, project, function)
+}
+
+// Build returns a new upstream each time it is called
+func (b *UpstreamBuilder) Build() *v1.Upstream {
+	return proto.Clone(&b.upstream).(*v1.Upstream)
+}
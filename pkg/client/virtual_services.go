@@ -0,0 +1,155 @@
+package client
+
+import (
+	"github.com/gogo/protobuf/proto"
+	gatewayv1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+// VirtualServiceBuilder builds a virtual service, e.g.
+// NewVirtualService("gloo-system", "default").Domains("*").Route(PrefixRoute("/").To(UpstreamDestination(ref))).Build()
+type VirtualServiceBuilder struct {
+	virtualService gatewayv1.VirtualService
+}
+
+func NewVirtualService(namespace, name string) *VirtualServiceBuilder {
+	return &VirtualServiceBuilder{virtualService: gatewayv1.VirtualService{
+		Metadata:    core.Metadata{Namespace: namespace, Name: name},
+		VirtualHost: &v1.VirtualHost{},
+	}}
+}
+
+// Domains sets the domains of the virtual service, it serves every domain without any
+func (b *VirtualServiceBuilder) Domains(domains ...string) *VirtualServiceBuilder {
+	b.virtualService.VirtualHost.Domains = domains
+	return b
+}
+
+// Route appends routes, the first route that matches a request is selected
+func (b *VirtualServiceBuilder) Route(routes ...*RouteBuilder) *VirtualServiceBuilder {
+	for _, route := range routes {
+		b.virtualService.VirtualHost.Routes = append(b.virtualService.VirtualHost.Routes, route.Build())
+	}
+	return b
+}
+
+func (b *VirtualServiceBuilder) Plugins(plugins *v1.VirtualHostPlugins) *VirtualServiceBuilder {
+	b.virtualService.VirtualHost.VirtualHostPlugins = plugins
+	return b
+}
+
+// SslConfig serves the virtual service over tls
+func (b *VirtualServiceBuilder) SslConfig(sslConfig *v1.SslConfig) *VirtualServiceBuilder {
+	b.virtualService.SslConfig = sslConfig
+	return b
+}
+
+// Build returns a new virtual service each time it is called
+func (b *VirtualServiceBuilder) Build() *gatewayv1.VirtualService {
+	return proto.Clone(&b.virtualService).(*gatewayv1.VirtualService)
+}
+
+// RouteBuilder builds a route from its matcher and action
+type RouteBuilder struct {
+	route v1.Route
+}
+
+// PrefixRoute matches the requests whose path starts with the prefix
+func PrefixRoute(prefix string) *RouteBuilder {
+	return &RouteBuilder{route: v1.Route{Matcher: &v1.Matcher{
+		PathSpecifier: &v1.Matcher_Prefix{Prefix: prefix},
+	}}}
+}
+
+// ExactRoute matches the requests with the path
+func ExactRoute(path string) *RouteBuilder {
+	return &RouteBuilder{route: v1.Route{Matcher: &v1.Matcher{
+		PathSpecifier: &v1.Matcher_Exact{Exact: path},
+	}}}
+}
+
+// RegexRoute matches the requests whose whole path matches the regex
+func RegexRoute(regex string) *RouteBuilder {
+	return &RouteBuilder{route: v1.Route{Matcher: &v1.Matcher{
+		PathSpecifier: &v1.Matcher_Regex{Regex: regex},
+	}}}
+}
+
+// Methods restricts the route to the http methods
+func (b *RouteBuilder) Methods(methods ...string) *RouteBuilder {
+	b.route.Matcher.Methods = append(b.route.Matcher.Methods, methods...)
+	return b
+}
+
+// Header restricts the route to the requests with the header, with the value unless it is empty
+func (b *RouteBuilder) Header(name, value string) *RouteBuilder {
+	b.route.Matcher.Headers = append(b.route.Matcher.Headers, &v1.HeaderMatcher{Name: name, Value: value})
+	return b
+}
+
+// HeaderRegex restricts the route to the requests with a header value that matches the regex
+func (b *RouteBuilder) HeaderRegex(name, regex string) *RouteBuilder {
+	b.route.Matcher.Headers = append(b.route.Matcher.Headers, &v1.HeaderMatcher{Name: name, Value: regex, Regex: true})
+	return b
+}
+
+// QueryParameter restricts the route to the requests with the query parameter, with the value unless it is empty
+func (b *RouteBuilder) QueryParameter(name, value string) *RouteBuilder {
+	b.route.Matcher.QueryParameters = append(b.route.Matcher.QueryParameters, &v1.QueryParameterMatcher{Name: name, Value: value})
+	return b
+}
+
+// To routes to a single destination
+func (b *RouteBuilder) To(dest *v1.Destination) *RouteBuilder {
+	b.route.Action = &v1.Route_RouteAction{
+		RouteAction: &v1.RouteAction{
+			Destination: &v1.RouteAction_Single{Single: dest},
+		},
+	}
+	return b
+}
+
+// ToWeighted splits the requests between the destinations, in proportion to their weights
+func (b *RouteBuilder) ToWeighted(dests ...*v1.WeightedDestination) *RouteBuilder {
+	b.route.Action = &v1.Route_RouteAction{
+		RouteAction: &v1.RouteAction{
+			Destination: &v1.RouteAction_Multi{Multi: &v1.MultiDestination{Destinations: dests}},
+		},
+	}
+	return b
+}
+
+// ToUpstreamGroup routes to the destinations of an upstream group
+func (b *RouteBuilder) ToUpstreamGroup(upstreamGroup core.ResourceRef) *RouteBuilder {
+	b.route.Action = &v1.Route_RouteAction{
+		RouteAction: &v1.RouteAction{
+			Destination: &v1.RouteAction_UpstreamGroup{UpstreamGroup: &upstreamGroup},
+		},
+	}
+	return b
+}
+
+// Redirect redirects the requests to another host
+func (b *RouteBuilder) Redirect(redirect *v1.RedirectAction) *RouteBuilder {
+	b.route.Action = &v1.Route_RedirectAction{RedirectAction: redirect}
+	return b
+}
+
+// DirectResponse responds to the requests without routing them
+func (b *RouteBuilder) DirectResponse(status uint32, body string) *RouteBuilder {
+	b.route.Action = &v1.Route_DirectResponseAction{
+		DirectResponseAction: &v1.DirectResponseAction{Status: status, Body: body},
+	}
+	return b
+}
+
+func (b *RouteBuilder) Plugins(plugins *v1.RoutePlugins) *RouteBuilder {
+	b.route.RoutePlugins = plugins
+	return b
+}
+
+// Build returns a new route each time it is called
+func (b *RouteBuilder) Build() *v1.Route {
+	return proto.Clone(&b.route).(*v1.Route)
+}
@@ -0,0 +1,115 @@
+package client
+
+import (
+	"context"
+	"time"
+
+	gatewayv1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/solo-io/solo-kit/pkg/errors"
+)
+
+// the interval at which WaitForAccepted reads the resource
+const statusPollInterval = 500 * time.Millisecond
+
+// WatchUpstreams calls handle with the upstreams of the namespace each time they change, until the context is done.
+// Returns the error of the watch, or nil once the context is done.
+func (cs *Clientset) WatchUpstreams(ctx context.Context, namespace string, handle func(v1.UpstreamList)) error {
+	lists, errs, err := cs.Upstreams.Watch(namespace, clients.WatchOpts{Ctx: ctx})
+	if err != nil {
+		return errors.Wrapf(err, "watching upstreams")
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case err := <-errs:
+			return errors.Wrapf(err, "watching upstreams")
+		case list := <-lists:
+			handle(list)
+		}
+	}
+}
+
+// WatchUpstreamGroups calls handle with the upstream groups of the namespace each time they change, until the context is done
+func (cs *Clientset) WatchUpstreamGroups(ctx context.Context, namespace string, handle func(v1.UpstreamGroupList)) error {
+	lists, errs, err := cs.UpstreamGroups.Watch(namespace, clients.WatchOpts{Ctx: ctx})
+	if err != nil {
+		return errors.Wrapf(err, "watching upstream groups")
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case err := <-errs:
+			return errors.Wrapf(err, "watching upstream groups")
+		case list := <-lists:
+			handle(list)
+		}
+	}
+}
+
+// WatchVirtualServices calls handle with the virtual services of the namespace each time they change, until the context is done
+func (cs *Clientset) WatchVirtualServices(ctx context.Context, namespace string, handle func(gatewayv1.VirtualServiceList)) error {
+	lists, errs, err := cs.VirtualServices.Watch(namespace, clients.WatchOpts{Ctx: ctx})
+	if err != nil {
+		return errors.Wrapf(err, "watching virtual services")
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case err := <-errs:
+			return errors.Wrapf(err, "watching virtual services")
+		case list := <-lists:
+			handle(list)
+		}
+	}
+}
+
+// WatchProxies calls handle with the proxies of the namespace each time they change, until the context is done
+func (cs *Clientset) WatchProxies(ctx context.Context, namespace string, handle func(v1.ProxyList)) error {
+	lists, errs, err := cs.Proxies.Watch(namespace, clients.WatchOpts{Ctx: ctx})
+	if err != nil {
+		return errors.Wrapf(err, "watching proxies")
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case err := <-errs:
+			return errors.Wrapf(err, "watching proxies")
+		case list := <-lists:
+			handle(list)
+		}
+	}
+}
+
+// WaitForAccepted reads a resource until gloo accepts it, e.g. after writing a virtual service:
+// WaitForAccepted(ctx, func() (resources.InputResource, error) { return cs.VirtualServices.Read(ns, name, clients.ReadOpts{}) })
+// Returns an error with the reason when the resource is rejected, or when the context is done first.
+func WaitForAccepted(ctx context.Context, read func() (resources.InputResource, error)) error {
+	ticker := time.NewTicker(statusPollInterval)
+	defer ticker.Stop()
+	for {
+		resource, err := read()
+		if err != nil {
+			return err
+		}
+		status := resource.GetStatus()
+		switch status.State {
+		case core.Status_Accepted:
+			return nil
+		case core.Status_Rejected:
+			return errors.Errorf("%v was rejected: %v", resource.GetMetadata().Ref().Key(), status.Reason)
+		}
+		select {
+		case <-ctx.Done():
+			return errors.Wrapf(ctx.Err(), "waiting for %v to be accepted", resource.GetMetadata().Ref().Key())
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,95 @@
+package client_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/solo-io/gloo/pkg/client"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+var _ = Describe("Watch", func() {
+
+	var (
+		cs     *Clientset
+		ctx    context.Context
+		cancel context.CancelFunc
+	)
+
+	BeforeEach(func() {
+		var err error
+		cs, err = NewMemoryClientset()
+		Expect(err).NotTo(HaveOccurred())
+		ctx, cancel = context.WithCancel(context.Background())
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	It("calls the handler with the upstreams until the context is done", func() {
+		lists := make(chan v1.UpstreamList, 10)
+		result := make(chan error, 1)
+		go func() {
+			defer GinkgoRecover()
+			result <- cs.WatchUpstreams(ctx, "gloo-system", func(list v1.UpstreamList) {
+				lists <- list
+			})
+		}()
+
+		_, err := cs.Upstreams.Write(NewUpstream("gloo-system", "petstore").Kube("petstore", "default", 8080).Build(), clients.WriteOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Eventually(func() []string {
+			var names []string
+			select {
+			case list := <-lists:
+				for _, us := range list {
+					names = append(names, us.Metadata.Name)
+				}
+			default:
+			}
+			return names
+		}, 5*time.Second).Should(Equal([]string{"petstore"}))
+
+		cancel()
+		Eventually(result, time.Second).Should(Receive(BeNil()))
+	})
+
+	It("waits until the resource is accepted", func() {
+		vs := NewVirtualService("gloo-system", "default").Build()
+		vs, err := cs.VirtualServices.Write(vs, clients.WriteOpts{})
+		Expect(err).NotTo(HaveOccurred())
+
+		read := func() (resources.InputResource, error) {
+			return cs.VirtualServices.Read("gloo-system", "default", clients.ReadOpts{})
+		}
+		result := make(chan error, 1)
+		go func() {
+			result <- WaitForAccepted(ctx, read)
+		}()
+		Consistently(result, time.Second).ShouldNot(Receive())
+
+		vs.Status = core.Status{State: core.Status_Accepted}
+		_, err = cs.VirtualServices.Write(vs, clients.WriteOpts{OverwriteExisting: true})
+		Expect(err).NotTo(HaveOccurred())
+		Eventually(result, 2*time.Second).Should(Receive(BeNil()))
+	})
+
+	It("fails when the resource is rejected", func() {
+		vs := NewVirtualService("gloo-system", "default").Build()
+		vs.Status = core.Status{State: core.Status_Rejected, Reason: "no routes"}
+		_, err := cs.VirtualServices.Write(vs, clients.WriteOpts{})
+		Expect(err).NotTo(HaveOccurred())
+
+		err = WaitForAccepted(ctx, func() (resources.InputResource, error) {
+			return cs.VirtualServices.Read("gloo-system", "default", clients.ReadOpts{})
+		})
+		Expect(err).To(MatchError("gloo-system.default was rejected: no routes"))
+	})
+})
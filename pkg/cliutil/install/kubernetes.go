@@ -22,8 +22,16 @@ func (k *CmdKubectl) Kubectl(stdin io.Reader, args ...string) error {
 	return Kubectl(stdin, args...)
 }
 
+// KubectlCommand returns the kubectl command with the args, in the kube context of cliutil.UseKubeContext if any
+func KubectlCommand(args ...string) *exec.Cmd {
+	if kubeContext := cliutil.KubeContext(); kubeContext != "" {
+		args = append([]string{"--context", kubeContext}, args...)
+	}
+	return exec.Command("kubectl", args...)
+}
+
 func Kubectl(stdin io.Reader, args ...string) error {
-	kubectl := exec.Command("kubectl", args...)
+	kubectl := KubectlCommand(args...)
 	if stdin != nil {
 		kubectl.Stdin = stdin
 	}
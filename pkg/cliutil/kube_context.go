@@ -0,0 +1,41 @@
+package cliutil
+
+import (
+	"sync"
+
+	"github.com/solo-io/go-utils/kubeutils"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+var (
+	kubeContextLock sync.Mutex
+	kubeContext     string
+)
+
+// UseKubeContext makes the kube clients and the kubectl commands act in the cluster of the kube context, rather than
+// of the current kube context
+func UseKubeContext(name string) {
+	kubeContextLock.Lock()
+	defer kubeContextLock.Unlock()
+	kubeContext = name
+}
+
+// KubeContext returns the kube context set by UseKubeContext, empty for the current kube context
+func KubeContext() string {
+	kubeContextLock.Lock()
+	defer kubeContextLock.Unlock()
+	return kubeContext
+}
+
+// GetKubeConfig returns the config of the kube clients, for the kube context set by UseKubeContext if any
+func GetKubeConfig() (*rest.Config, error) {
+	name := KubeContext()
+	if name == "" {
+		return kubeutils.GetConfig("", "")
+	}
+	return clientcmd.NewNonInteractiveDeferredLoadingClientConfig(
+		clientcmd.NewDefaultClientConfigLoadingRules(),
+		&clientcmd.ConfigOverrides{CurrentContext: name},
+	).ClientConfig()
+}
@@ -11,7 +11,6 @@ import (
 	"strings"
 
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/options"
-	"github.com/solo-io/go-utils/kubeutils"
 	"github.com/solo-io/solo-kit/pkg/errors"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -52,7 +51,7 @@ func GetResource(uri string) (io.ReadCloser, error) {
 }
 
 func GetIngressHost(opts *options.Proxy, namespace string) (string, error) {
-	restCfg, err := kubeutils.GetConfig("", "")
+	restCfg, err := GetKubeConfig()
 	if err != nil {
 		return "", errors.Wrapf(err, "getting kube rest config")
 	}
@@ -1,12 +1,19 @@
 package main
 
 import (
+	"context"
 	"os"
 
+	"github.com/solo-io/gloo/pkg/utils/setuputils"
+	"github.com/solo-io/gloo/projects/discovery/pkg/election"
 	fdssetup "github.com/solo-io/gloo/projects/discovery/pkg/fds/setup"
 	uds "github.com/solo-io/gloo/projects/discovery/pkg/uds/setup"
+	"github.com/solo-io/gloo/projects/gloo/pkg/defaults"
+	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/go-utils/kubeutils"
 	"github.com/solo-io/go-utils/log"
 	"github.com/solo-io/go-utils/stats"
+	"k8s.io/client-go/kubernetes"
 )
 
 const (
@@ -22,7 +29,36 @@ func main() {
 	}
 }
 
+// with several replicas, only the one holding the lease discovers, the others stand by
 func run() error {
+	if os.Getenv(election.DISABLE_LEADER_ELECTION) == "true" {
+		return runDiscovery(context.Background())
+	}
+	cfg, err := kubeutils.GetConfig("", "")
+	if err != nil {
+		// outside of kubernetes, there is nothing to elect with
+		return runDiscovery(context.Background())
+	}
+	kubeClient, err := kubernetes.NewForConfig(cfg)
+	if err != nil {
+		return err
+	}
+	namespace := os.Getenv(setuputils.POD_NAMESPACE)
+	if namespace == "" {
+		namespace = defaults.GlooSystem
+	}
+	identity := os.Getenv(election.POD_NAME)
+	if identity == "" {
+		if identity, err = os.Hostname(); err != nil {
+			return err
+		}
+	}
+	ctx := contextutils.WithLogger(context.Background(), "discovery")
+	return election.RunAsLeader(ctx, kubeClient, namespace, identity, runDiscovery)
+}
+
+// the discoveries run until the process exits
+func runDiscovery(_ context.Context) error {
 	errs := make(chan error)
 	go func() {
 		errs <- uds.Main()
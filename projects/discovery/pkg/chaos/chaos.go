@@ -0,0 +1,137 @@
+// Package chaos injects failures and latency into the API calls made by discovery, to test that
+// discovery backs off and recovers from unavailable cloud and Kubernetes APIs.
+// It is disabled unless one of the environment variables below is set, and must never be enabled in production.
+package chaos
+
+import (
+	"context"
+	"math/rand"
+	"net/http"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/go-utils/kubeutils"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	// the fraction of API calls that fail, between 0 and 1
+	FailureRateEnv = "DISCOVERY_CHAOS_FAILURE_RATE"
+	// the latency added to every API call, e.g. 500ms
+	LatencyEnv = "DISCOVERY_CHAOS_LATENCY"
+)
+
+// ErrInjected is returned for the API calls failed by chaos
+var ErrInjected = errors.New("failure injected by discovery chaos mode")
+
+type Config struct {
+	FailureRate float64
+	Latency     time.Duration
+}
+
+func (c Config) Enabled() bool {
+	return c.FailureRate > 0 || c.Latency > 0
+}
+
+// ConfigFromEnv reads the chaos configuration from the environment
+func ConfigFromEnv() (Config, error) {
+	var c Config
+	if rate := os.Getenv(FailureRateEnv); rate != "" {
+		failureRate, err := strconv.ParseFloat(rate, 64)
+		if err != nil || failureRate < 0 || failureRate > 1 {
+			return Config{}, errors.Errorf("%v must be a number between 0 and 1, got %q", FailureRateEnv, rate)
+		}
+		c.FailureRate = failureRate
+	}
+	if latency := os.Getenv(LatencyEnv); latency != "" {
+		d, err := time.ParseDuration(latency)
+		if err != nil {
+			return Config{}, errors.Wrapf(err, "invalid %v", LatencyEnv)
+		}
+		c.Latency = d
+	}
+	return c, nil
+}
+
+type transport struct {
+	config Config
+	next   http.RoundTripper
+
+	lock sync.Mutex
+	rand *rand.Rand
+}
+
+// NewTransport returns a transport delaying the requests sent with the next transport, and failing some of them
+func NewTransport(config Config, next http.RoundTripper) http.RoundTripper {
+	return &transport{
+		config: config,
+		next:   next,
+		rand:   rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+}
+
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.config.Latency > 0 {
+		select {
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		case <-time.After(t.config.Latency):
+		}
+	}
+	if t.shouldFail() {
+		return nil, ErrInjected
+	}
+	return t.next.RoundTrip(req)
+}
+
+func (t *transport) shouldFail() bool {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	return t.rand.Float64() < t.config.FailureRate
+}
+
+// NewHTTPClient returns a client injecting chaos into the requests to the cloud APIs
+func NewHTTPClient(config Config) *http.Client {
+	return &http.Client{Transport: NewTransport(config, http.DefaultTransport)}
+}
+
+// NewKubeClient returns a Kubernetes client injecting chaos into its requests and watches
+func NewKubeClient(config Config) (kubernetes.Interface, error) {
+	cfg, err := kubeutils.GetConfig("", "")
+	if err != nil {
+		return nil, err
+	}
+	cfg.WrapTransport = func(rt http.RoundTripper) http.RoundTripper {
+		return NewTransport(config, rt)
+	}
+	return kubernetes.NewForConfig(cfg)
+}
+
+// Setup reads the chaos configuration from the environment, and returns the Kubernetes client and the client of the
+// cloud APIs discovery should use. The given Kubernetes client and a nil http client, for the default one of each
+// discovery, are returned if chaos is disabled.
+func Setup(ctx context.Context, kubeClient kubernetes.Interface) (kubernetes.Interface, *http.Client, error) {
+	config, err := ConfigFromEnv()
+	if err != nil {
+		return nil, nil, err
+	}
+	if !config.Enabled() {
+		return kubeClient, nil, nil
+	}
+	contextutils.LoggerFrom(ctx).Warnw("discovery chaos mode enabled, API calls will be delayed and fail",
+		"failureRate", config.FailureRate, "latency", config.Latency)
+
+	httpClient := NewHTTPClient(config)
+	if kubeClient == nil {
+		return nil, httpClient, nil
+	}
+	kubeClient, err = NewKubeClient(config)
+	if err != nil {
+		return nil, nil, err
+	}
+	return kubeClient, httpClient, nil
+}
@@ -0,0 +1,135 @@
+package chaos_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/solo-io/gloo/projects/discovery/pkg/chaos"
+)
+
+var _ = Describe("Chaos", func() {
+
+	Context("config", func() {
+		AfterEach(func() {
+			os.Unsetenv(FailureRateEnv)
+			os.Unsetenv(LatencyEnv)
+		})
+
+		It("should be disabled by default", func() {
+			config, err := ConfigFromEnv()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(config.Enabled()).To(BeFalse())
+		})
+
+		It("should read the failure rate and latency", func() {
+			os.Setenv(FailureRateEnv, "0.25")
+			os.Setenv(LatencyEnv, "300ms")
+			config, err := ConfigFromEnv()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(config).To(Equal(Config{FailureRate: 0.25, Latency: 300 * time.Millisecond}))
+			Expect(config.Enabled()).To(BeTrue())
+		})
+
+		It("should reject a failure rate above 1", func() {
+			os.Setenv(FailureRateEnv, "2")
+			_, err := ConfigFromEnv()
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should reject an invalid latency", func() {
+			os.Setenv(LatencyEnv, "soon")
+			_, err := ConfigFromEnv()
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("transport", func() {
+		var (
+			server *httptest.Server
+		)
+
+		BeforeEach(func() {
+			server = httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+				rw.WriteHeader(http.StatusOK)
+			}))
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		send := func(config Config, ctx context.Context) (*http.Response, error) {
+			req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+			Expect(err).NotTo(HaveOccurred())
+			return NewTransport(config, http.DefaultTransport).RoundTrip(req.WithContext(ctx))
+		}
+
+		It("should pass requests through without failure rate", func() {
+			resp, err := send(Config{}, context.Background())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+		})
+
+		It("should fail all requests with a failure rate of 1", func() {
+			for i := 0; i < 10; i++ {
+				_, err := send(Config{FailureRate: 1}, context.Background())
+				Expect(err).To(Equal(ErrInjected))
+			}
+		})
+
+		It("should delay requests", func() {
+			start := time.Now()
+			_, err := send(Config{Latency: 100 * time.Millisecond}, context.Background())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(time.Since(start)).To(BeNumerically(">=", 100*time.Millisecond))
+		})
+
+		It("should stop delaying requests when they are cancelled", func() {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+			defer cancel()
+			_, err := send(Config{Latency: time.Hour}, ctx)
+			Expect(err).To(Equal(context.DeadlineExceeded))
+		})
+
+		It("should leave the default transport alone", func() {
+			defaultTransport := http.DefaultTransport
+			client := NewHTTPClient(Config{FailureRate: 1})
+			Expect(http.DefaultTransport).To(BeIdenticalTo(defaultTransport))
+
+			_, err := client.Get(server.URL)
+			Expect(err).To(MatchError(ContainSubstring(ErrInjected.Error())))
+			resp, err := http.Get(server.URL)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+		})
+	})
+
+	Context("setup", func() {
+		AfterEach(func() {
+			os.Unsetenv(FailureRateEnv)
+		})
+
+		It("should return the clients as is when disabled", func() {
+			kubeClient, httpClient, err := Setup(context.Background(), nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(kubeClient).To(BeNil())
+			Expect(httpClient).To(BeNil())
+		})
+
+		It("should return a client of the cloud APIs with the configuration", func() {
+			os.Setenv(FailureRateEnv, "1")
+			_, httpClient, err := Setup(context.Background(), nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(httpClient).NotTo(BeNil())
+			req, err := http.NewRequest(http.MethodGet, "http://example.com", nil)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = httpClient.Transport.RoundTrip(req)
+			Expect(err).To(Equal(ErrInjected))
+		})
+	})
+})
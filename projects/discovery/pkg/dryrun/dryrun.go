@@ -0,0 +1,191 @@
+package dryrun
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/solo-io/solo-kit/pkg/errors"
+
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+)
+
+type Action string
+
+const (
+	Create Action = "create"
+	Update Action = "update"
+	Delete Action = "delete"
+)
+
+// Change is a write discovery would have made
+type Change struct {
+	Action Action `json:"action"`
+	// the key of the resource
+	Resource string `json:"resource"`
+	// the discovery that would have made the change, uds or fds
+	Source string `json:"source"`
+	// the resource as it would have been written, none for a delete
+	Desired resources.Resource `json:"desired,omitempty"`
+	Time    time.Time          `json:"time"`
+}
+
+// Recorder keeps the latest change to each resource, by discovery
+type Recorder struct {
+	lock    sync.Mutex
+	changes map[string]Change
+}
+
+// DefaultRecorder is shared by the discoveries of the process, so that a single endpoint serves all their changes
+var DefaultRecorder = NewRecorder()
+
+func NewRecorder() *Recorder {
+	return &Recorder{changes: make(map[string]Change)}
+}
+
+// Changes returns the latest changes, by resource
+func (r *Recorder) Changes() []Change {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	changes := make([]Change, 0, len(r.changes))
+	for _, change := range r.changes {
+		changes = append(changes, change)
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		if changes[i].Resource != changes[j].Resource {
+			return changes[i].Resource < changes[j].Resource
+		}
+		return changes[i].Source < changes[j].Source
+	})
+	return changes
+}
+
+// records the change, logging it unless the same change was already recorded
+func (r *Recorder) record(ctx context.Context, change Change) {
+	key := change.Source + "/" + change.Resource
+	r.lock.Lock()
+	previous, ok := r.changes[key]
+	r.changes[key] = change
+	r.lock.Unlock()
+	if ok && previous.Action == change.Action && equal(previous.Desired, change.Desired) {
+		return
+	}
+	contextutils.LoggerFrom(ctx).Infow("dry run: discovery would "+string(change.Action)+" "+change.Resource,
+		"source", change.Source, "desired", change.Desired)
+}
+
+// forgets the change to a resource that is already as desired
+func (r *Recorder) forget(source, resource string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	delete(r.changes, source+"/"+resource)
+}
+
+func (r *Recorder) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(r.Changes()); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+// Serve serves the changes as json on the address, until the context is done
+func Serve(ctx context.Context, recorder *Recorder, bindAddr string) error {
+	server := &http.Server{
+		Addr:    bindAddr,
+		Handler: recorder,
+	}
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		server.Shutdown(shutdownCtx)
+	}()
+	contextutils.LoggerFrom(ctx).Infof("serving discovery dry run changes on %v", bindAddr)
+	if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		return errors.Wrapf(err, "serving discovery dry run changes on %v", bindAddr)
+	}
+	return nil
+}
+
+// resourceClient reads through to the client, and records the writes and deletes instead of making them
+type resourceClient struct {
+	clients.ResourceClient
+	recorder *Recorder
+	source   string
+}
+
+// NewUpstreamClient returns an upstream client that does not write, for the discovery named by source
+func NewUpstreamClient(client v1.UpstreamClient, recorder *Recorder, source string) v1.UpstreamClient {
+	return v1.NewUpstreamClientWithBase(&resourceClient{
+		ResourceClient: client.BaseClient(),
+		recorder:       recorder,
+		source:         source,
+	})
+}
+
+func (c *resourceClient) Write(resource resources.Resource, opts clients.WriteOpts) (resources.Resource, error) {
+	opts = opts.WithDefaults()
+	meta := resource.GetMetadata()
+	key := meta.Ref().Key()
+	existing, err := c.ResourceClient.Read(meta.Namespace, meta.Name, clients.ReadOpts{Ctx: opts.Ctx})
+	switch {
+	case err != nil && !errors.IsNotExist(err):
+		return nil, err
+	case err != nil:
+		c.recorder.record(opts.Ctx, Change{Action: Create, Resource: key, Source: c.source, Desired: resource, Time: time.Now()})
+	case equal(existing, resource):
+		c.recorder.forget(c.source, key)
+		return existing, nil
+	default:
+		c.recorder.record(opts.Ctx, Change{Action: Update, Resource: key, Source: c.source, Desired: resource, Time: time.Now()})
+	}
+	// the resource as if it had been written, the next reads still return the existing one
+	written := resources.Clone(resource)
+	if existing != nil {
+		meta.ResourceVersion = existing.GetMetadata().ResourceVersion
+		written.SetMetadata(meta)
+	}
+	return written, nil
+}
+
+func (c *resourceClient) Delete(namespace, name string, opts clients.DeleteOpts) error {
+	opts = opts.WithDefaults()
+	key := (&core.ResourceRef{Namespace: namespace, Name: name}).Key()
+	if _, err := c.ResourceClient.Read(namespace, name, clients.ReadOpts{Ctx: opts.Ctx}); err != nil {
+		if errors.IsNotExist(err) {
+			c.recorder.forget(c.source, key)
+			if opts.IgnoreNotExist {
+				return nil
+			}
+		}
+		return err
+	}
+	c.recorder.record(opts.Ctx, Change{Action: Delete, Resource: key, Source: c.source, Time: time.Now()})
+	return nil
+}
+
+// the resources are equal but for their resource version and status
+func equal(a, b resources.Resource) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return comparable(a).Equal(comparable(b))
+}
+
+func comparable(resource resources.Resource) resources.Resource {
+	resource = resources.Clone(resource)
+	meta := resource.GetMetadata()
+	meta.ResourceVersion = ""
+	resource.SetMetadata(meta)
+	if input, ok := resource.(resources.InputResource); ok {
+		input.SetStatus(core.Status{})
+	}
+	return resource
+}
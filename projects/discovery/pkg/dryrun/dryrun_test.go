@@ -0,0 +1,122 @@
+package dryrun_test
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/factory"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/memory"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+
+	. "github.com/solo-io/gloo/projects/discovery/pkg/dryrun"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/kubernetes"
+)
+
+var _ = Describe("Dry run", func() {
+
+	var (
+		client   v1.UpstreamClient
+		dryRun   v1.UpstreamClient
+		recorder *Recorder
+	)
+
+	upstream := func(name string, port uint32) *v1.Upstream {
+		return &v1.Upstream{
+			Metadata: core.Metadata{Namespace: "gloo-system", Name: name},
+			UpstreamSpec: &v1.UpstreamSpec{
+				UpstreamType: &v1.UpstreamSpec_Kube{
+					Kube: &kubernetes.UpstreamSpec{ServiceName: name, ServiceNamespace: "default", ServicePort: port},
+				},
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		var err error
+		client, err = v1.NewUpstreamClient(&factory.MemoryResourceClientFactory{
+			Cache: memory.NewInMemoryResourceCache(),
+		})
+		Expect(err).NotTo(HaveOccurred())
+		recorder = NewRecorder()
+		dryRun = NewUpstreamClient(client, recorder, "uds")
+	})
+
+	It("records the upstreams it would create without writing them", func() {
+		written, err := dryRun.Write(upstream("petstore", 8080), clients.WriteOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(written.Metadata.Name).To(Equal("petstore"))
+
+		_, err = client.Read("gloo-system", "petstore", clients.ReadOpts{})
+		Expect(err).To(HaveOccurred())
+
+		changes := recorder.Changes()
+		Expect(changes).To(HaveLen(1))
+		Expect(changes[0].Action).To(Equal(Create))
+		Expect(changes[0].Resource).To(Equal("gloo-system.petstore"))
+		Expect(changes[0].Source).To(Equal("uds"))
+		Expect(changes[0].Desired).To(Equal(upstream("petstore", 8080)))
+	})
+
+	It("records updates, and forgets them once the upstream is as desired", func() {
+		existing, err := client.Write(upstream("petstore", 8080), clients.WriteOpts{})
+		Expect(err).NotTo(HaveOccurred())
+
+		desired := upstream("petstore", 9090)
+		desired.Metadata.ResourceVersion = existing.Metadata.ResourceVersion
+		_, err = dryRun.Write(desired, clients.WriteOpts{OverwriteExisting: true})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(recorder.Changes()).To(HaveLen(1))
+		Expect(recorder.Changes()[0].Action).To(Equal(Update))
+
+		read, err := client.Read("gloo-system", "petstore", clients.ReadOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(read.UpstreamSpec.GetKube().ServicePort).To(BeEquivalentTo(8080))
+
+		_, err = dryRun.Write(upstream("petstore", 8080), clients.WriteOpts{OverwriteExisting: true})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(recorder.Changes()).To(BeEmpty())
+	})
+
+	It("records the upstreams it would delete without deleting them", func() {
+		_, err := client.Write(upstream("petstore", 8080), clients.WriteOpts{})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(dryRun.Delete("gloo-system", "petstore", clients.DeleteOpts{})).NotTo(HaveOccurred())
+		Expect(recorder.Changes()).To(Equal([]Change{{
+			Action:   Delete,
+			Resource: "gloo-system.petstore",
+			Source:   "uds",
+			Time:     recorder.Changes()[0].Time,
+		}}))
+		_, err = client.Read("gloo-system", "petstore", clients.ReadOpts{})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(dryRun.Delete("gloo-system", "missing", clients.DeleteOpts{IgnoreNotExist: true})).NotTo(HaveOccurred())
+	})
+
+	It("reads through to the client", func() {
+		_, err := client.Write(upstream("petstore", 8080), clients.WriteOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		list, err := dryRun.List("gloo-system", clients.ListOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(list).To(HaveLen(1))
+	})
+
+	It("serves the changes as json", func() {
+		_, err := dryRun.Write(upstream("petstore", 8080), clients.WriteOpts{})
+		Expect(err).NotTo(HaveOccurred())
+
+		rec := httptest.NewRecorder()
+		recorder.ServeHTTP(rec, httptest.NewRequest("GET", "/", nil))
+		var changes []map[string]interface{}
+		Expect(json.Unmarshal(rec.Body.Bytes(), &changes)).NotTo(HaveOccurred())
+		Expect(changes).To(HaveLen(1))
+		Expect(changes[0]["action"]).To(Equal("create"))
+		Expect(changes[0]["resource"]).To(Equal("gloo-system.petstore"))
+		Expect(changes[0]["desired"]).NotTo(BeNil())
+	})
+})
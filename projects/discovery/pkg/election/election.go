@@ -0,0 +1,72 @@
+package election
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/solo-io/go-utils/contextutils"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/leaderelection"
+)
+
+const (
+	// the lease held by the leading discovery, in the namespace of the pods
+	LeaseName = "gloo-discovery"
+
+	// the identity of the replica in the election, defaults to the hostname
+	POD_NAME = "POD_NAME"
+	// every replica runs discovery when set to "true"
+	DISABLE_LEADER_ELECTION = "DISABLE_LEADER_ELECTION"
+)
+
+// the defaults of the kubernetes controllers: a replica that stops renewing is replaced within 15s
+var (
+	LeaseDuration = 15 * time.Second
+	RenewDeadline = 10 * time.Second
+	RetryPeriod   = 2 * time.Second
+)
+
+var ErrLostLeadership = errors.New("lost the leadership of discovery")
+
+// RunAsLeader runs discovery once this replica holds the lease, and stands by while another replica does.
+// It returns the error of run, or ErrLostLeadership when the lease could not be renewed. In that case run may still be
+// going on, and the caller should exit so that it stops before another replica takes over.
+func RunAsLeader(ctx context.Context, kubeClient kubernetes.Interface, namespace, identity string, run func(ctx context.Context) error) error {
+	logger := contextutils.LoggerFrom(ctx)
+	errs := make(chan error, 2)
+	elector, err := leaderelection.NewLeaderElector(leaderelection.LeaderElectionConfig{
+		Lock:          NewLeaseLock(kubeClient.CoordinationV1beta1(), namespace, LeaseName, identity),
+		LeaseDuration: LeaseDuration,
+		RenewDeadline: RenewDeadline,
+		RetryPeriod:   RetryPeriod,
+		Callbacks: leaderelection.LeaderCallbacks{
+			OnStartedLeading: func(ctx context.Context) {
+				logger.Infof("%v is leading discovery", identity)
+				errs <- run(ctx)
+			},
+			OnStoppedLeading: func() {
+				logger.Infof("%v stopped leading discovery", identity)
+			},
+			OnNewLeader: func(leader string) {
+				if leader != identity {
+					logger.Infof("%v is leading discovery, %v stands by", leader, identity)
+				}
+			},
+		},
+		Name: LeaseName,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "invalid leader election")
+	}
+	go func() {
+		// returns once the lease is lost or the context is done
+		elector.Run(ctx)
+		if ctx.Err() != nil {
+			errs <- ctx.Err()
+			return
+		}
+		errs <- ErrLostLeadership
+	}()
+	return <-errs
+}
@@ -0,0 +1,124 @@
+package election_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+
+	. "github.com/solo-io/gloo/projects/discovery/pkg/election"
+)
+
+var _ = Describe("Election", func() {
+
+	var (
+		kubeClient *fake.Clientset
+	)
+
+	BeforeEach(func() {
+		kubeClient = fake.NewSimpleClientset()
+	})
+
+	Context("lease lock", func() {
+		It("stores the leader election record in the lease", func() {
+			lock := NewLeaseLock(kubeClient.CoordinationV1beta1(), "gloo-system", LeaseName, "discovery-1")
+			_, err := lock.Get()
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+
+			now := metav1.NewTime(time.Now().Truncate(time.Second))
+			Expect(lock.Create(resourcelock.LeaderElectionRecord{
+				HolderIdentity:       "discovery-1",
+				LeaseDurationSeconds: 15,
+				AcquireTime:          now,
+				RenewTime:            now,
+			})).NotTo(HaveOccurred())
+
+			lease, err := kubeClient.CoordinationV1beta1().Leases("gloo-system").Get(LeaseName, metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(*lease.Spec.HolderIdentity).To(Equal("discovery-1"))
+			Expect(*lease.Spec.LeaseDurationSeconds).To(BeEquivalentTo(15))
+
+			other := NewLeaseLock(kubeClient.CoordinationV1beta1(), "gloo-system", LeaseName, "discovery-2")
+			record, err := other.Get()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(record.HolderIdentity).To(Equal("discovery-1"))
+			Expect(record.RenewTime.Equal(&now)).To(BeTrue())
+
+			record.HolderIdentity = "discovery-2"
+			record.LeaderTransitions = 1
+			Expect(other.Update(*record)).NotTo(HaveOccurred())
+			record, err = lock.Get()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(record.HolderIdentity).To(Equal("discovery-2"))
+			Expect(record.LeaderTransitions).To(Equal(1))
+		})
+
+		It("requires the lease to be read before it is updated", func() {
+			lock := NewLeaseLock(kubeClient.CoordinationV1beta1(), "gloo-system", LeaseName, "discovery-1")
+			Expect(lock.Update(resourcelock.LeaderElectionRecord{})).To(HaveOccurred())
+		})
+	})
+
+	Context("run as leader", func() {
+		var leaseDuration, renewDeadline, retryPeriod time.Duration
+
+		BeforeEach(func() {
+			leaseDuration, renewDeadline, retryPeriod = LeaseDuration, RenewDeadline, RetryPeriod
+			LeaseDuration, RenewDeadline, RetryPeriod = time.Second, 500*time.Millisecond, 100*time.Millisecond
+		})
+
+		AfterEach(func() {
+			LeaseDuration, RenewDeadline, RetryPeriod = leaseDuration, renewDeadline, retryPeriod
+		})
+
+		// runs until the context of the candidate is cancelled, and signals when it starts
+		candidate := func(ctx context.Context, identity string, started chan<- string) <-chan error {
+			result := make(chan error, 1)
+			go func() {
+				defer GinkgoRecover()
+				result <- RunAsLeader(ctx, kubeClient, "gloo-system", identity, func(runCtx context.Context) error {
+					started <- identity
+					<-ctx.Done()
+					return nil
+				})
+			}()
+			return result
+		}
+
+		It("runs discovery on a single replica, and fails over when it stops", func() {
+			started := make(chan string, 2)
+			ctx1, cancel1 := context.WithCancel(context.Background())
+			defer cancel1()
+			result1 := candidate(ctx1, "discovery-1", started)
+			Eventually(started, time.Second).Should(Receive(Equal("discovery-1")))
+
+			ctx2, cancel2 := context.WithCancel(context.Background())
+			defer cancel2()
+			result2 := candidate(ctx2, "discovery-2", started)
+			Consistently(started, 2*time.Second).ShouldNot(Receive())
+
+			cancel1()
+			Eventually(result1, time.Second).Should(Receive())
+			Eventually(started, 3*time.Second).Should(Receive(Equal("discovery-2")))
+
+			cancel2()
+			Eventually(result2, time.Second).Should(Receive())
+		})
+
+		It("returns the error of discovery", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			discoveryErr := fmt.Errorf("discovery failed")
+			err := RunAsLeader(ctx, kubeClient, "gloo-system", "discovery-1", func(context.Context) error {
+				return discoveryErr
+			})
+			Expect(err).To(Equal(discoveryErr))
+		})
+	})
+})
@@ -0,0 +1,118 @@
+package election
+
+import (
+	"fmt"
+	"time"
+
+	coordinationv1beta1 "k8s.io/api/coordination/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	coordinationclient "k8s.io/client-go/kubernetes/typed/coordination/v1beta1"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+)
+
+// LeaseLock is a resource lock on a coordination.k8s.io lease, which client-go only provides from kubernetes 1.14
+type LeaseLock struct {
+	LeaseMeta metav1.ObjectMeta
+	Client    coordinationclient.LeasesGetter
+	Holder    string
+
+	// the lease as last read or written, updates fail if it changed since
+	lease *coordinationv1beta1.Lease
+}
+
+var _ resourcelock.Interface = &LeaseLock{}
+
+func NewLeaseLock(client coordinationclient.LeasesGetter, namespace, name, identity string) *LeaseLock {
+	return &LeaseLock{
+		LeaseMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+		Client:    client,
+		Holder:    identity,
+	}
+}
+
+func (l *LeaseLock) Get() (*resourcelock.LeaderElectionRecord, error) {
+	lease, err := l.Client.Leases(l.LeaseMeta.Namespace).Get(l.LeaseMeta.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	l.lease = lease
+	return leaseToRecord(&lease.Spec), nil
+}
+
+func (l *LeaseLock) Create(ler resourcelock.LeaderElectionRecord) error {
+	lease, err := l.Client.Leases(l.LeaseMeta.Namespace).Create(&coordinationv1beta1.Lease{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      l.LeaseMeta.Name,
+			Namespace: l.LeaseMeta.Namespace,
+		},
+		Spec: recordToLease(ler),
+	})
+	if err != nil {
+		return err
+	}
+	l.lease = lease
+	return nil
+}
+
+func (l *LeaseLock) Update(ler resourcelock.LeaderElectionRecord) error {
+	if l.lease == nil {
+		return fmt.Errorf("lease %v must be read before it is updated", l.Describe())
+	}
+	lease := l.lease.DeepCopy()
+	lease.Spec = recordToLease(ler)
+	lease, err := l.Client.Leases(l.LeaseMeta.Namespace).Update(lease)
+	if err != nil {
+		return err
+	}
+	l.lease = lease
+	return nil
+}
+
+// the transitions are logged by the elector callbacks, there is no event recorder
+func (l *LeaseLock) RecordEvent(string) {}
+
+func (l *LeaseLock) Identity() string {
+	return l.Holder
+}
+
+func (l *LeaseLock) Describe() string {
+	return fmt.Sprintf("%v/%v", l.LeaseMeta.Namespace, l.LeaseMeta.Name)
+}
+
+func leaseToRecord(spec *coordinationv1beta1.LeaseSpec) *resourcelock.LeaderElectionRecord {
+	record := &resourcelock.LeaderElectionRecord{}
+	if spec.HolderIdentity != nil {
+		record.HolderIdentity = *spec.HolderIdentity
+	}
+	if spec.LeaseDurationSeconds != nil {
+		record.LeaseDurationSeconds = int(*spec.LeaseDurationSeconds)
+	}
+	if spec.LeaseTransitions != nil {
+		record.LeaderTransitions = int(*spec.LeaseTransitions)
+	}
+	if spec.AcquireTime != nil {
+		record.AcquireTime = metav1.Time{Time: spec.AcquireTime.Time}
+	}
+	if spec.RenewTime != nil {
+		record.RenewTime = metav1.Time{Time: spec.RenewTime.Time}
+	}
+	return record
+}
+
+func recordToLease(ler resourcelock.LeaderElectionRecord) coordinationv1beta1.LeaseSpec {
+	holder := ler.HolderIdentity
+	duration := int32(ler.LeaseDurationSeconds)
+	transitions := int32(ler.LeaderTransitions)
+	return coordinationv1beta1.LeaseSpec{
+		HolderIdentity:       &holder,
+		LeaseDurationSeconds: &duration,
+		LeaseTransitions:     &transitions,
+		AcquireTime:          microTime(ler.AcquireTime.Time),
+		RenewTime:            microTime(ler.RenewTime.Time),
+	}
+}
+
+func microTime(t time.Time) *metav1.MicroTime {
+	mt := metav1.NewMicroTime(t)
+	return &mt
+}
@@ -0,0 +1,149 @@
+package fds
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	kubev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+)
+
+// the kinds of workloads with cold starts
+const (
+	WorkloadKindJob          = "Job"
+	WorkloadKindCronJob      = "CronJob"
+	WorkloadKindScaledToZero = "ScaledToZero"
+)
+
+// the hints of the upstreams with cold starts, long enough for a pod to be scheduled and started
+var (
+	DefaultColdStartTimeout    = time.Minute
+	DefaultColdStartNumRetries = uint32(3)
+)
+
+// the horizontal pod autoscalers created by KEDA for its scaled objects
+const kedaHpaPrefix = "keda-hpa-"
+
+// ColdStartDetector tells whether the workloads serving an upstream are short-lived or scaled to zero
+type ColdStartDetector interface {
+	// DetectColdStart returns the hints of the upstream, nil if it is served by long-running workloads
+	DetectColdStart(ctx context.Context, upstream *v1.Upstream) (*v1.ColdStartHints, error)
+}
+
+type kubeColdStartDetector struct {
+	kubeClient kubernetes.Interface
+}
+
+// NewKubeColdStartDetector detects the kube upstreams served by the pods of Jobs and CronJobs, and by the deployments
+// scaled to zero, or scaled by KEDA
+func NewKubeColdStartDetector(kubeClient kubernetes.Interface) ColdStartDetector {
+	return &kubeColdStartDetector{kubeClient: kubeClient}
+}
+
+func (d *kubeColdStartDetector) DetectColdStart(ctx context.Context, upstream *v1.Upstream) (*v1.ColdStartHints, error) {
+	kubeSpec, ok := upstream.GetUpstreamSpec().GetUpstreamType().(*v1.UpstreamSpec_Kube)
+	if !ok {
+		return nil, nil
+	}
+	namespace := kubeSpec.Kube.ServiceNamespace
+	svc, err := d.kubeClient.CoreV1().Services(namespace).Get(kubeSpec.Kube.ServiceName, metav1.GetOptions{})
+	if err != nil {
+		return nil, errors.Wrapf(err, "reading service %v.%v", namespace, kubeSpec.Kube.ServiceName)
+	}
+	if len(svc.Spec.Selector) == 0 {
+		// the endpoints of the service are managed by hand
+		return nil, nil
+	}
+	selector := labels.Merge(svc.Spec.Selector, kubeSpec.Kube.Selector)
+
+	kind, err := d.workloadKind(namespace, labels.SelectorFromSet(selector))
+	if err != nil || kind == "" {
+		return nil, err
+	}
+	timeout := DefaultColdStartTimeout
+	return &v1.ColdStartHints{
+		WorkloadKind: kind,
+		Timeout:      &timeout,
+		NumRetries:   DefaultColdStartNumRetries,
+	}, nil
+}
+
+// the kind of the workloads with cold starts whose pods the selector selects, empty if none
+func (d *kubeColdStartDetector) workloadKind(namespace string, selector labels.Selector) (string, error) {
+	pods, err := d.kubeClient.CoreV1().Pods(namespace).List(metav1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return "", errors.Wrapf(err, "listing the pods of %v", namespace)
+	}
+	for _, pod := range pods.Items {
+		kind, err := d.jobKind(pod)
+		if err != nil || kind != "" {
+			return kind, err
+		}
+	}
+
+	deployments, err := d.kubeClient.AppsV1().Deployments(namespace).List(metav1.ListOptions{})
+	if err != nil {
+		return "", errors.Wrapf(err, "listing the deployments of %v", namespace)
+	}
+	scaledByKeda, err := d.scaledByKeda(namespace)
+	if err != nil {
+		return "", err
+	}
+	for _, deployment := range deployments.Items {
+		if !selector.Matches(labels.Set(deployment.Spec.Template.Labels)) {
+			continue
+		}
+		if scaledByKeda[deployment.Name] || (deployment.Spec.Replicas != nil && *deployment.Spec.Replicas == 0) {
+			return WorkloadKindScaledToZero, nil
+		}
+	}
+	return "", nil
+}
+
+// the kind of the job running the pod, empty if the pod is not run by a job
+func (d *kubeColdStartDetector) jobKind(pod kubev1.Pod) (string, error) {
+	owner := metav1.GetControllerOf(&pod)
+	if owner == nil || owner.Kind != WorkloadKindJob {
+		return "", nil
+	}
+	job, err := d.kubeClient.BatchV1().Jobs(pod.Namespace).Get(owner.Name, metav1.GetOptions{})
+	if err != nil {
+		return "", errors.Wrapf(err, "reading job %v.%v", pod.Namespace, owner.Name)
+	}
+	if jobOwner := metav1.GetControllerOf(job); jobOwner != nil && jobOwner.Kind == WorkloadKindCronJob {
+		return WorkloadKindCronJob, nil
+	}
+	return WorkloadKindJob, nil
+}
+
+// the names of the deployments KEDA scales, which it scales to zero when idle
+func (d *kubeColdStartDetector) scaledByKeda(namespace string) (map[string]bool, error) {
+	hpas, err := d.kubeClient.AutoscalingV1().HorizontalPodAutoscalers(namespace).List(metav1.ListOptions{})
+	if err != nil {
+		return nil, errors.Wrapf(err, "listing the horizontal pod autoscalers of %v", namespace)
+	}
+	scaled := make(map[string]bool)
+	for _, hpa := range hpas.Items {
+		if strings.HasPrefix(hpa.Name, kedaHpaPrefix) && hpa.Spec.ScaleTargetRef.Kind == "Deployment" {
+			scaled[hpa.Spec.ScaleTargetRef.Name] = true
+		}
+	}
+	return scaled, nil
+}
+
+// UpdateColdStartHints sets the cold start hints of the upstream, removing them if nil
+func UpdateColdStartHints(upstream *v1.Upstream, hints *v1.ColdStartHints) {
+	if upstream.DiscoveryMetadata == nil {
+		if hints == nil {
+			return
+		}
+		upstream.DiscoveryMetadata = &v1.DiscoveryMetadata{}
+	}
+	upstream.DiscoveryMetadata.ColdStartHints = hints
+}
@@ -0,0 +1,116 @@
+package fds_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	kubev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+
+	. "github.com/solo-io/gloo/projects/discovery/pkg/fds"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	kubernetes_plugins_gloo_solo_io "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/kubernetes"
+	core_solo_io "github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+var _ = Describe("ColdStartDetector", func() {
+
+	var (
+		up  *v1.Upstream
+		svc *kubev1.Service
+	)
+
+	controlledBy := func(kind, name string) []metav1.OwnerReference {
+		controller := true
+		return []metav1.OwnerReference{{Kind: kind, Name: name, Controller: &controller}}
+	}
+
+	detect := func(objects ...runtime.Object) *v1.ColdStartHints {
+		detector := NewKubeColdStartDetector(fake.NewSimpleClientset(append(objects, svc)...))
+		hints, err := detector.DetectColdStart(context.TODO(), up)
+		Expect(err).NotTo(HaveOccurred())
+		return hints
+	}
+
+	BeforeEach(func() {
+		up = &v1.Upstream{
+			Metadata: core_solo_io.Metadata{Namespace: "gloo-system", Name: "default-report-8080"},
+			UpstreamSpec: &v1.UpstreamSpec{
+				UpstreamType: &v1.UpstreamSpec_Kube{
+					Kube: &kubernetes_plugins_gloo_solo_io.UpstreamSpec{ServiceName: "report", ServiceNamespace: "default", ServicePort: 8080},
+				},
+			},
+		}
+		svc = &kubev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "report"},
+			Spec:       kubev1.ServiceSpec{Selector: map[string]string{"app": "report"}},
+		}
+	})
+
+	It("detects the upstreams served by the pods of a cron job", func() {
+		pod := &kubev1.Pod{ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default", Name: "report-1-abcde", Labels: map[string]string{"app": "report"},
+			OwnerReferences: controlledBy("Job", "report-1"),
+		}}
+		job := &batchv1.Job{ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default", Name: "report-1",
+			OwnerReferences: controlledBy("CronJob", "report"),
+		}}
+		hints := detect(pod, job)
+		Expect(hints).NotTo(BeNil())
+		Expect(hints.WorkloadKind).To(Equal(WorkloadKindCronJob))
+		Expect(*hints.Timeout).To(Equal(DefaultColdStartTimeout))
+		Expect(hints.NumRetries).To(Equal(DefaultColdStartNumRetries))
+	})
+
+	It("detects the upstreams served by the deployments scaled by KEDA", func() {
+		deployment := &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "report"},
+			Spec: appsv1.DeploymentSpec{Template: kubev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "report"}},
+			}},
+		}
+		hpa := &autoscalingv1.HorizontalPodAutoscaler{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "keda-hpa-report"},
+			Spec: autoscalingv1.HorizontalPodAutoscalerSpec{
+				ScaleTargetRef: autoscalingv1.CrossVersionObjectReference{Kind: "Deployment", Name: "report"},
+			},
+		}
+		hints := detect(deployment, hpa)
+		Expect(hints).NotTo(BeNil())
+		Expect(hints.WorkloadKind).To(Equal(WorkloadKindScaledToZero))
+	})
+
+	It("doesn't hint the upstreams served by long-running pods", func() {
+		replicas := int32(2)
+		deployment := &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "report"},
+			Spec: appsv1.DeploymentSpec{
+				Replicas: &replicas,
+				Template: kubev1.PodTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "report"}},
+				},
+			},
+		}
+		pod := &kubev1.Pod{ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default", Name: "report-6d4cf56db6-abcde", Labels: map[string]string{"app": "report"},
+			OwnerReferences: controlledBy("ReplicaSet", "report-6d4cf56db6"),
+		}}
+		Expect(detect(deployment, pod)).To(BeNil())
+	})
+
+	It("removes the hints of the upstreams that no longer have cold starts", func() {
+		timeout := time.Minute
+		UpdateColdStartHints(up, &v1.ColdStartHints{WorkloadKind: WorkloadKindJob, Timeout: &timeout})
+		Expect(up.DiscoveryMetadata.ColdStartHints).NotTo(BeNil())
+		UpdateColdStartHints(up, nil)
+		Expect(up.DiscoveryMetadata.ColdStartHints).To(BeNil())
+	})
+})
@@ -0,0 +1,148 @@
+package fds
+
+import (
+	"strconv"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/solo-io/go-utils/hashutils"
+	kubev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+)
+
+// the config map of the detection cache, in the write namespace
+const DetectionCacheConfigMap = "gloo-fds-cache"
+
+// DetectionCache remembers the upstreams no discovery could detect, so that they are not probed again
+// until their spec changes. The service specs and functions that were detected are kept on the upstreams.
+type DetectionCache interface {
+	// Undetectable returns true if no discovery could detect the upstream, and its spec didn't change since
+	Undetectable(upstream *v1.Upstream) bool
+	// SetUndetectable records whether the upstream could be detected
+	SetUndetectable(upstream *v1.Upstream, undetectable bool) error
+	// Retain forgets the upstreams that are not in the list
+	Retain(upstreams v1.UpstreamList) error
+}
+
+type configMapDetectionCache struct {
+	kubeClient kubernetes.Interface
+	namespace  string
+
+	lock sync.Mutex
+	// the hash of the spec of the undetectable upstreams, by upstream key, loaded on first use
+	entries map[string]string
+}
+
+// NewConfigMapDetectionCache persists the cache in a config map, so that it survives the restarts of discovery
+func NewConfigMapDetectionCache(kubeClient kubernetes.Interface, namespace string) DetectionCache {
+	return &configMapDetectionCache{
+		kubeClient: kubeClient,
+		namespace:  namespace,
+	}
+}
+
+func (c *configMapDetectionCache) Undetectable(upstream *v1.Upstream) bool {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if err := c.load(); err != nil {
+		return false
+	}
+	hash, ok := c.entries[cacheKey(upstream)]
+	return ok && hash == specHash(upstream)
+}
+
+func (c *configMapDetectionCache) SetUndetectable(upstream *v1.Upstream, undetectable bool) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if err := c.load(); err != nil {
+		return err
+	}
+	key := cacheKey(upstream)
+	hash, ok := c.entries[key]
+	switch {
+	case undetectable && hash == specHash(upstream):
+		return nil
+	case undetectable:
+		c.entries[key] = specHash(upstream)
+	case ok:
+		delete(c.entries, key)
+	default:
+		return nil
+	}
+	return c.save()
+}
+
+func (c *configMapDetectionCache) Retain(upstreams v1.UpstreamList) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if err := c.load(); err != nil {
+		return err
+	}
+	keep := make(map[string]bool, len(upstreams))
+	for _, us := range upstreams {
+		keep[cacheKey(us)] = true
+	}
+	var pruned bool
+	for key := range c.entries {
+		if !keep[key] {
+			delete(c.entries, key)
+			pruned = true
+		}
+	}
+	if !pruned {
+		return nil
+	}
+	return c.save()
+}
+
+func (c *configMapDetectionCache) load() error {
+	if c.entries != nil {
+		return nil
+	}
+	cm, err := c.kubeClient.CoreV1().ConfigMaps(c.namespace).Get(DetectionCacheConfigMap, metav1.GetOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return errors.Wrapf(err, "reading detection cache %v.%v", c.namespace, DetectionCacheConfigMap)
+	}
+	c.entries = make(map[string]string)
+	if err == nil {
+		for key, hash := range cm.Data {
+			c.entries[key] = hash
+		}
+	}
+	return nil
+}
+
+func (c *configMapDetectionCache) save() error {
+	configMaps := c.kubeClient.CoreV1().ConfigMaps(c.namespace)
+	cm := &kubev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      DetectionCacheConfigMap,
+			Namespace: c.namespace,
+		},
+		Data: make(map[string]string, len(c.entries)),
+	}
+	for key, hash := range c.entries {
+		cm.Data[key] = hash
+	}
+	_, err := configMaps.Update(cm)
+	if apierrors.IsNotFound(err) {
+		_, err = configMaps.Create(cm)
+	}
+	if err != nil {
+		return errors.Wrapf(err, "writing detection cache %v.%v", c.namespace, DetectionCacheConfigMap)
+	}
+	return nil
+}
+
+// config map keys may contain dots, but not slashes
+func cacheKey(upstream *v1.Upstream) string {
+	return upstream.Metadata.Namespace + "." + upstream.Metadata.Name
+}
+
+func specHash(upstream *v1.Upstream) string {
+	return strconv.FormatUint(hashutils.HashAll(upstream.UpstreamSpec), 16)
+}
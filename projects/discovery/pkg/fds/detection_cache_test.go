@@ -0,0 +1,67 @@
+package fds_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+
+	. "github.com/solo-io/gloo/projects/discovery/pkg/fds"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	kubernetes_plugins_gloo_solo_io "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/kubernetes"
+	core_solo_io "github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+var _ = Describe("DetectionCache", func() {
+
+	var (
+		kubeClient kubernetes.Interface
+		cache      DetectionCache
+		up         *v1.Upstream
+	)
+
+	BeforeEach(func() {
+		kubeClient = fake.NewSimpleClientset()
+		cache = NewConfigMapDetectionCache(kubeClient, "gloo-system")
+		up = &v1.Upstream{
+			Metadata: core_solo_io.Metadata{Namespace: "default", Name: "legacy-8080"},
+			UpstreamSpec: &v1.UpstreamSpec{
+				UpstreamType: &v1.UpstreamSpec_Kube{
+					Kube: &kubernetes_plugins_gloo_solo_io.UpstreamSpec{ServiceName: "legacy", ServicePort: 8080},
+				},
+			},
+		}
+	})
+
+	It("remembers the undetectable upstreams across restarts", func() {
+		Expect(cache.Undetectable(up)).To(BeFalse())
+		Expect(cache.SetUndetectable(up, true)).NotTo(HaveOccurred())
+		Expect(cache.Undetectable(up)).To(BeTrue())
+
+		restarted := NewConfigMapDetectionCache(kubeClient, "gloo-system")
+		Expect(restarted.Undetectable(up)).To(BeTrue())
+	})
+
+	It("forgets the upstreams whose spec changed", func() {
+		Expect(cache.SetUndetectable(up, true)).NotTo(HaveOccurred())
+		up.UpstreamSpec.GetKube().ServicePort = 9090
+		Expect(cache.Undetectable(up)).To(BeFalse())
+	})
+
+	It("forgets the upstreams that were detected", func() {
+		Expect(cache.SetUndetectable(up, true)).NotTo(HaveOccurred())
+		Expect(cache.SetUndetectable(up, false)).NotTo(HaveOccurred())
+		Expect(cache.Undetectable(up)).To(BeFalse())
+	})
+
+	It("prunes the upstreams that were removed", func() {
+		Expect(cache.SetUndetectable(up, true)).NotTo(HaveOccurred())
+		Expect(cache.Retain(v1.UpstreamList{})).NotTo(HaveOccurred())
+		Expect(cache.Undetectable(up)).To(BeFalse())
+
+		cm, err := kubeClient.CoreV1().ConfigMaps("gloo-system").Get(DetectionCacheConfigMap, metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cm.Data).To(BeEmpty())
+	})
+})
@@ -0,0 +1,129 @@
+package alibaba
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/solo-io/gloo/projects/discovery/pkg/fds"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins"
+	glooalibaba "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/alibaba"
+	"github.com/solo-io/go-utils/contextutils"
+)
+
+type FunctionComputeDiscoveryFactory struct {
+	PollingTime time.Duration
+
+	// HTTPClient overrides the client used to call the Function Compute API
+	HTTPClient *http.Client
+}
+
+func (f *FunctionComputeDiscoveryFactory) NewFunctionDiscovery(u *v1.Upstream) fds.UpstreamFunctionDiscovery {
+	return &FunctionComputeDiscovery{
+		timetowait: fds.PollInterval(u, f.PollingTime),
+		upstream:   u,
+		httpClient: f.HTTPClient,
+	}
+}
+
+type FunctionComputeDiscovery struct {
+	timetowait time.Duration
+	upstream   *v1.Upstream
+	httpClient *http.Client
+}
+
+func (f *FunctionComputeDiscovery) IsFunctional() bool {
+	_, ok := f.upstream.UpstreamSpec.UpstreamType.(*v1.UpstreamSpec_Alibaba)
+	return ok
+}
+
+func (f *FunctionComputeDiscovery) DetectType(ctx context.Context, url *url.URL, _ func() fds.Dependencies) (*plugins.ServiceSpec, error) {
+	return nil, nil
+}
+
+func (f *FunctionComputeDiscovery) DetectFunctions(ctx context.Context, url *url.URL, dependencies func() fds.Dependencies, updatecb func(fds.UpstreamMutator) error) error {
+	for {
+		// TODO: get backoff values from config?
+		err := contextutils.NewExponentioalBackoff(contextutils.ExponentioalBackoff{}).Backoff(ctx, fds.RecordAttempts("alibaba", func(ctx context.Context) error {
+			newfunctions, err := f.DetectFunctionsOnce(ctx, dependencies().Secrets)
+			if err != nil {
+				return err
+			}
+
+			// sort for idempotency
+			sort.Slice(newfunctions, func(i, j int) bool {
+				return newfunctions[i].LogicalName < newfunctions[j].LogicalName
+			})
+
+			err = updatecb(func(out *v1.Upstream) error {
+				if out == nil {
+					return errors.New("nil upstream")
+				}
+				if out.UpstreamSpec == nil {
+					return errors.New("nil upstream spec")
+				}
+				alibabaspec, ok := out.UpstreamSpec.UpstreamType.(*v1.UpstreamSpec_Alibaba)
+				if !ok {
+					return errors.New("not alibaba upstream")
+				}
+				alibabaspec.Alibaba.Functions = newfunctions
+				return nil
+			})
+			if err != nil {
+				return errors.Wrap(err, "unable to update upstream")
+			}
+			return nil
+		}))
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			// ignore other errors as we would like to continue forever.
+			contextutils.LoggerFrom(ctx).Warnw("unable to discover function compute functions", "upstream", f.upstream.Metadata.Name, "error", err)
+		}
+
+		// sleep so we are not hogging
+		if err := fds.WaitForPoll(ctx, f.timetowait); err != nil {
+			return err
+		}
+	}
+}
+
+func (f *FunctionComputeDiscovery) DetectFunctionsOnce(ctx context.Context, secrets v1.SecretList) ([]*glooalibaba.FunctionSpec, error) {
+	alibabaspec, ok := f.upstream.UpstreamSpec.UpstreamType.(*v1.UpstreamSpec_Alibaba)
+	if !ok {
+		return nil, errors.New("not an alibaba upstream spec")
+	}
+	spec := alibabaspec.Alibaba
+
+	client, err := newFcClient(secrets, spec, f.httpClient)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to create function compute client")
+	}
+
+	services, err := client.listServices(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list function compute services")
+	}
+
+	var functions []*glooalibaba.FunctionSpec
+	for _, service := range services {
+		functionNames, err := client.listFunctions(ctx, service)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to list functions of service %v", service)
+		}
+		for _, functionName := range functionNames {
+			functions = append(functions, &glooalibaba.FunctionSpec{
+				LogicalName:  service + "-" + functionName,
+				ServiceName:  service,
+				FunctionName: functionName,
+				InvokeUrl:    client.invokeUrl(service, functionName),
+			})
+		}
+	}
+	return functions, nil
+}
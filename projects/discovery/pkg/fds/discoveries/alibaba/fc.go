@@ -0,0 +1,171 @@
+package alibaba
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	glooalibaba "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/alibaba"
+	alibabaplugin "github.com/solo-io/gloo/projects/gloo/pkg/plugins/alibaba"
+)
+
+const (
+	// version of the function compute api
+	apiVersion = "2016-08-15"
+
+	// maximum page size of the list apis
+	listLimit = "100"
+
+	fcHeaderPrefix = "x-fc-"
+)
+
+type serviceList struct {
+	Services []struct {
+		ServiceName string `json:"serviceName"`
+	} `json:"services"`
+	NextToken string `json:"nextToken"`
+}
+
+type functionList struct {
+	Functions []struct {
+		FunctionName string `json:"functionName"`
+	} `json:"functions"`
+	NextToken string `json:"nextToken"`
+}
+
+type fcClient struct {
+	accessKeyId     string
+	accessKeySecret string
+	host            string
+	httpClient      *http.Client
+}
+
+func newFcClient(secrets v1.SecretList, spec *glooalibaba.UpstreamSpec, httpClient *http.Client) (*fcClient, error) {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	secret, err := secrets.Find(spec.SecretRef.Strings())
+	if err != nil {
+		return nil, errors.Wrapf(err, "alibaba secrets for ref %v not found", spec.SecretRef)
+	}
+	alibabaSecret, ok := secret.Kind.(*v1.Secret_Alibaba)
+	if !ok {
+		return nil, errors.Errorf("secret %v is not an Alibaba secret", secret.GetMetadata().Ref())
+	}
+	return &fcClient{
+		accessKeyId:     alibabaSecret.Alibaba.AccessKeyId,
+		accessKeySecret: alibabaSecret.Alibaba.AccessKeySecret,
+		host:            alibabaplugin.GetFunctionComputeHostname(spec),
+		httpClient:      httpClient,
+	}, nil
+}
+
+func (c *fcClient) listServices(ctx context.Context) ([]string, error) {
+	var services []string
+	nextToken := ""
+	for {
+		var page serviceList
+		if err := c.get(ctx, "/"+apiVersion+"/services", nextToken, &page); err != nil {
+			return nil, err
+		}
+		for _, service := range page.Services {
+			services = append(services, service.ServiceName)
+		}
+		if page.NextToken == "" {
+			return services, nil
+		}
+		nextToken = page.NextToken
+	}
+}
+
+func (c *fcClient) listFunctions(ctx context.Context, serviceName string) ([]string, error) {
+	var functions []string
+	nextToken := ""
+	for {
+		var page functionList
+		if err := c.get(ctx, fmt.Sprintf("/%s/services/%s/functions", apiVersion, serviceName), nextToken, &page); err != nil {
+			return nil, err
+		}
+		for _, function := range page.Functions {
+			functions = append(functions, function.FunctionName)
+		}
+		if page.NextToken == "" {
+			return functions, nil
+		}
+		nextToken = page.NextToken
+	}
+}
+
+// invokeUrl returns the url of the http trigger of the latest version of the function
+func (c *fcClient) invokeUrl(serviceName, functionName string) string {
+	return fmt.Sprintf("https://%s/%s/proxy/%s.LATEST/%s/", c.host, apiVersion, serviceName, functionName)
+}
+
+func (c *fcClient) get(ctx context.Context, path, nextToken string, out interface{}) error {
+	query := url.Values{"limit": {listLimit}}
+	if nextToken != "" {
+		query.Set("nextToken", nextToken)
+	}
+	u := url.URL{Scheme: "https", Host: c.host, Path: path, RawQuery: query.Encode()}
+	req, err := http.NewRequest(http.MethodGet, u.String(), nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Date", time.Now().UTC().Format(http.TimeFormat))
+	req.Header.Set("Authorization", fmt.Sprintf("FC %s:%s", c.accessKeyId, sign(req, c.accessKeySecret)))
+
+	resp, err := c.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := ioutil.ReadAll(resp.Body)
+		return errors.Errorf("function compute returned %v: %s", resp.Status, body)
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+// sign computes the signature of a function compute api request:
+// base64(hmac-sha1(secret, method\ncontent-md5\ncontent-type\ndate\ncanonical fc headers + path))
+// see https://www.alibabacloud.com/help/doc-detail/52877.htm
+func sign(req *http.Request, accessKeySecret string) string {
+	var fcHeaders []string
+	for name, values := range req.Header {
+		name = strings.ToLower(name)
+		if strings.HasPrefix(name, fcHeaderPrefix) {
+			fcHeaders = append(fcHeaders, name+":"+strings.Join(values, ","))
+		}
+	}
+	sort.Strings(fcHeaders)
+
+	var canonicalHeaders string
+	for _, header := range fcHeaders {
+		canonicalHeaders += header + "\n"
+	}
+
+	stringToSign := strings.Join([]string{
+		req.Method,
+		req.Header.Get("Content-MD5"),
+		req.Header.Get("Content-Type"),
+		req.Header.Get("Date"),
+		canonicalHeaders + req.URL.Path,
+	}, "\n")
+
+	mac := hmac.New(sha1.New, []byte(accessKeySecret))
+	mac.Write([]byte(stringToSign))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
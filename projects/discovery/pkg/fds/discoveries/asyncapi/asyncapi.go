@@ -0,0 +1,200 @@
+package asyncapi
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"time"
+
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/pkg/errors"
+	"github.com/solo-io/gloo/projects/discovery/pkg/fds"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins"
+	rest_plugins "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/rest"
+	"github.com/solo-io/go-utils/contextutils"
+)
+
+// the documents are not read past this size
+const maxDocumentBytes = 10 << 20
+
+var commonAsyncApiUrls = []string{
+	"/asyncapi.json",
+	"/asyncapi.yaml",
+	"/asyncapi.yml",
+	"/asyncapi",
+	"/docs/asyncapi.json",
+}
+
+type AsyncApiFunctionDiscoveryFactory struct {
+	DetectionTimeout  time.Duration
+	FunctionPollTime  time.Duration
+	AsyncApiUrlsToTry []string
+}
+
+func (f *AsyncApiFunctionDiscoveryFactory) NewFunctionDiscovery(u *v1.Upstream) fds.UpstreamFunctionDiscovery {
+	return &AsyncApiFunctionDiscovery{
+		detectionTimeout: f.DetectionTimeout,
+		functionPollTime: fds.PollInterval(u, f.FunctionPollTime),
+		urlsToTry:        append(f.AsyncApiUrlsToTry, commonAsyncApiUrls...),
+		upstream:         u,
+	}
+}
+
+type AsyncApiFunctionDiscovery struct {
+	detectionTimeout time.Duration
+	functionPollTime time.Duration
+	urlsToTry        []string
+	upstream         *v1.Upstream
+}
+
+func getasyncapispec(u *v1.Upstream) *rest_plugins.ServiceSpec_AsyncApiInfo {
+	spec, ok := u.UpstreamSpec.UpstreamType.(v1.ServiceSpecGetter)
+	if !ok {
+		return nil
+	}
+	serviceSpec := spec.GetServiceSpec()
+	if serviceSpec == nil {
+		return nil
+	}
+	restwrapper, ok := serviceSpec.PluginType.(*plugins.ServiceSpec_Rest)
+	if !ok {
+		return nil
+	}
+	return restwrapper.Rest.AsyncApiInfo
+}
+
+func (d *AsyncApiFunctionDiscovery) IsFunctional() bool {
+	return getasyncapispec(d.upstream) != nil
+}
+
+func (d *AsyncApiFunctionDiscovery) DetectType(ctx context.Context, baseurl *url.URL, _ func() fds.Dependencies) (*plugins.ServiceSpec, error) {
+	var spec *plugins.ServiceSpec
+
+	err := contextutils.NewExponentioalBackoff(contextutils.ExponentioalBackoff{MaxDuration: &d.detectionTimeout}).Backoff(ctx, func(ctx context.Context) error {
+		var err error
+		spec, err = d.detectUpstreamTypeOnce(ctx, baseurl)
+		return err
+	})
+
+	return spec, err
+}
+
+func (d *AsyncApiFunctionDiscovery) detectUpstreamTypeOnce(ctx context.Context, baseurl *url.URL) (*plugins.ServiceSpec, error) {
+	var errs error
+	for _, docUrl := range d.urlsToTry {
+		if _, err := fetchDocument(ctx, baseurl, docUrl); err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			errs = multierror.Append(errs, err)
+			continue
+		}
+		contextutils.LoggerFrom(ctx).Infof("asyncapi upstream detected: %v%v", baseurl, docUrl)
+		return &plugins.ServiceSpec{
+			PluginType: &plugins.ServiceSpec_Rest{
+				Rest: &rest_plugins.ServiceSpec{
+					AsyncApiInfo: &rest_plugins.ServiceSpec_AsyncApiInfo{
+						Url: docUrl,
+					},
+				},
+			},
+		}, nil
+	}
+	return nil, errors.Wrapf(errs, "service at %s does not serve an asyncapi document at a known url, "+
+		"or was unreachable", baseurl.String())
+}
+
+func (d *AsyncApiFunctionDiscovery) DetectFunctions(ctx context.Context, baseurl *url.URL, _ func() fds.Dependencies, updatecb func(fds.UpstreamMutator) error) error {
+	spec := getasyncapispec(d.upstream)
+	if spec == nil || spec.Url == "" {
+		return errors.New("upstream doesn't have an asyncapi document")
+	}
+	for {
+		err := contextutils.NewExponentioalBackoff(contextutils.ExponentioalBackoff{}).Backoff(ctx, fds.RecordAttempts("asyncapi", func(ctx context.Context) error {
+			return d.DetectFunctionsOnce(ctx, baseurl, spec, updatecb)
+		}))
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			// ignore other errors as we would like to continue forever.
+			contextutils.LoggerFrom(ctx).Warnw("unable to discover asyncapi operations", "upstream", d.upstream.Metadata.Name, "error", err)
+		}
+
+		if err := fds.WaitForPoll(ctx, d.functionPollTime); err != nil {
+			return err
+		}
+	}
+}
+
+func (d *AsyncApiFunctionDiscovery) DetectFunctionsOnce(ctx context.Context, baseurl *url.URL, spec *rest_plugins.ServiceSpec_AsyncApiInfo, updatecb func(fds.UpstreamMutator) error) error {
+	doc, err := fetchDocument(ctx, baseurl, spec.Url)
+	if err != nil {
+		return err
+	}
+	funcs := Functions(doc, spec.Bridge)
+
+	return updatecb(func(u *v1.Upstream) error {
+		upstreamSpec, ok := u.UpstreamSpec.UpstreamType.(v1.ServiceSpecMutator)
+		if !ok {
+			return errors.New("not a valid upstream")
+		}
+		spec := upstreamSpec.GetServiceSpec()
+		if spec == nil {
+			spec = &plugins.ServiceSpec{}
+		}
+		restspec, ok := spec.PluginType.(*plugins.ServiceSpec_Rest)
+		if !ok || restspec.Rest == nil {
+			restspec = &plugins.ServiceSpec_Rest{
+				Rest: &rest_plugins.ServiceSpec{},
+			}
+		}
+
+		restspec.Rest.Transformations = funcs
+		spec.PluginType = restspec
+
+		upstreamSpec.SetServiceSpec(spec)
+		return nil
+	})
+}
+
+// gets and parses the document, the url is resolved against the address of the upstream
+func fetchDocument(ctx context.Context, baseurl *url.URL, docUrl string) (*Document, error) {
+	endpoint, err := fds.HttpBaseUrl(baseurl, "asyncapi")
+	if err != nil {
+		return nil, err
+	}
+	ref, err := url.Parse(docUrl)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid url %v", docUrl)
+	}
+	endpointurl := endpoint.ResolveReference(ref).String()
+
+	req, err := http.NewRequest("GET", endpointurl, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid url for request")
+	}
+	req.Header.Set("X-Gloo-Discovery", "AsyncApi-Discovery")
+
+	res, err := fds.ProbeClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not perform HTTP GET on resolved addr: %v", endpointurl)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("%v returned %v", endpointurl, res.Status)
+	}
+	body, err := ioutil.ReadAll(&io.LimitedReader{R: res.Body, N: maxDocumentBytes})
+	if err != nil {
+		return nil, errors.Wrapf(err, "reading %v", endpointurl)
+	}
+	doc, err := ParseDocument(body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "%v is not an asyncapi document", endpointurl)
+	}
+	return doc, nil
+}
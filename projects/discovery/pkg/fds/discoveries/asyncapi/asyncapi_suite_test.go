@@ -0,0 +1,13 @@
+package asyncapi
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestAsyncapi(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Asyncapi Suite")
+}
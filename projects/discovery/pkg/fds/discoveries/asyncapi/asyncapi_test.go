@@ -0,0 +1,128 @@
+package asyncapi
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	rest_plugins "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/rest"
+)
+
+const asyncApi2Yaml = `
+asyncapi: 2.6.0
+info:
+  title: Accounts
+  version: 1.0.0
+defaultContentType: application/json
+channels:
+  user/{userId}/signedup:
+    publish:
+      operationId: userSignedUp
+      message:
+        contentType: application/cloudevents+json
+  user/deleted:
+    publish:
+      message:
+        name: UserDeleted
+  user/notified:
+    subscribe:
+      operationId: userNotified
+`
+
+const asyncApi3Json = `{
+  "asyncapi": "3.0.0",
+  "channels": {
+    "signup": {
+      "address": "/users/{userId}/signup",
+      "messages": {"signedUp": {"contentType": "text/plain"}}
+    },
+    "notifications": {
+      "address": "notifications"
+    },
+    "dynamic": {
+      "address": null
+    }
+  },
+  "operations": {
+    "onUserSignUp": {"action": "receive", "channel": {"$ref": "#/channels/signup"}},
+    "notify": {"action": "send", "channel": {"$ref": "#/channels/notifications"}},
+    "onDynamic": {"action": "receive", "channel": {"$ref": "#/channels/dynamic"}}
+  }
+}`
+
+var _ = Describe("Asyncapi", func() {
+
+	It("creates a function for each publish operation of a 2.x document", func() {
+		doc, err := ParseDocument([]byte(asyncApi2Yaml))
+		Expect(err).NotTo(HaveOccurred())
+
+		funcs := Functions(doc, nil)
+		Expect(funcs).To(HaveLen(2))
+		Expect(funcs).To(HaveKey("userSignedUp"))
+		Expect(funcs).To(HaveKey("user/deleted"))
+
+		signedUp := funcs["userSignedUp"]
+		Expect(signedUp.Headers[":method"].Text).To(Equal("POST"))
+		Expect(signedUp.Headers[":path"].Text).To(Equal("/user/{{ userId }}/signedup"))
+		Expect(signedUp.Headers["content-type"].Text).To(Equal("application/cloudevents+json"))
+		Expect(signedUp.GetPassthrough()).NotTo(BeNil())
+
+		Expect(funcs["user/deleted"].Headers["content-type"].Text).To(Equal("application/json"))
+	})
+
+	It("creates a function for each receive operation of a 3.x document", func() {
+		doc, err := ParseDocument([]byte(asyncApi3Json))
+		Expect(err).NotTo(HaveOccurred())
+
+		funcs := Functions(doc, nil)
+		Expect(funcs).To(HaveLen(1))
+		signUp := funcs["onUserSignUp"]
+		Expect(signUp.Headers[":path"].Text).To(Equal("/users/{{ userId }}/signup"))
+		Expect(signUp.Headers["content-type"].Text).To(Equal("text/plain"))
+	})
+
+	It("routes the operations through the bridge", func() {
+		doc, err := ParseDocument([]byte(asyncApi2Yaml))
+		Expect(err).NotTo(HaveOccurred())
+
+		funcs := Functions(doc, &rest_plugins.ServiceSpec_AsyncApiInfo_HttpBridge{
+			PathTemplate: "/publish?topic={channel}",
+			Method:       "PUT",
+			Headers:      map[string]string{"Content-Type": "application/octet-stream", "x-bridge": "gloo"},
+		})
+		deleted := funcs["user/deleted"]
+		Expect(deleted.Headers[":method"].Text).To(Equal("PUT"))
+		Expect(deleted.Headers[":path"].Text).To(Equal("/publish?topic=user/deleted"))
+		Expect(deleted.Headers["content-type"].Text).To(Equal("application/octet-stream"))
+		Expect(deleted.Headers["x-bridge"].Text).To(Equal("gloo"))
+	})
+
+	It("rejects documents of unknown versions", func() {
+		_, err := ParseDocument([]byte(`{"openapi": "3.0.0"}`))
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("fetches the document", func() {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/asyncapi.yaml" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.Write([]byte(asyncApi2Yaml))
+		}))
+		defer server.Close()
+
+		u, err := url.Parse(server.URL)
+		Expect(err).NotTo(HaveOccurred())
+
+		doc, err := fetchDocument(context.Background(), u, "/asyncapi.yaml")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(doc.AsyncApi).To(Equal("2.6.0"))
+
+		_, err = fetchDocument(context.Background(), u, "/asyncapi.json")
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,165 @@
+package asyncapi
+
+import (
+	"encoding/json"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/pkg/errors"
+	rest_plugins "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/rest"
+	transformation_plugins "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/transformation"
+)
+
+const (
+	defaultPathTemplate = "/{channel}"
+	defaultMethod       = "POST"
+	defaultContentType  = "application/json"
+)
+
+// the parameters of the channel names, e.g. {userId}
+var channelParameter = regexp.MustCompile(`\{([\.\-_[:word:]]+)\}`)
+
+// Document is the part of an AsyncAPI document function discovery needs, for the 2.x and 3.x versions
+type Document struct {
+	AsyncApi           string              `json:"asyncapi"`
+	DefaultContentType string              `json:"defaultContentType"`
+	Channels           map[string]*Channel `json:"channels"`
+	// 3.x only, the operations are under their channels in 2.x
+	Operations map[string]*Operation `json:"operations"`
+}
+
+type Channel struct {
+	// 3.x only, the name of the channel is its address in 2.x
+	Address *string `json:"address"`
+	// 2.x only. publish is the operation of the clients sending messages to the application.
+	Publish   *Operation `json:"publish"`
+	Subscribe *Operation `json:"subscribe"`
+	// 3.x only
+	Messages map[string]*Message `json:"messages"`
+}
+
+type Operation struct {
+	OperationId string `json:"operationId"`
+	// 3.x only. the application receives the messages of the receive operations.
+	Action  string     `json:"action"`
+	Channel *Reference `json:"channel"`
+	// 2.x only
+	Message *Message `json:"message"`
+}
+
+type Message struct {
+	ContentType string `json:"contentType"`
+}
+
+type Reference struct {
+	Ref string `json:"$ref"`
+}
+
+// ParseDocument parses an AsyncAPI document in JSON or YAML
+func ParseDocument(docBytes []byte) (*Document, error) {
+	jsn := docBytes
+	if !json.Valid(jsn) {
+		var err error
+		if jsn, err = yaml.YAMLToJSON(docBytes); err != nil {
+			return nil, errors.Wrap(err, "the document is neither json nor yaml")
+		}
+	}
+	var doc Document
+	if err := json.Unmarshal(jsn, &doc); err != nil {
+		return nil, errors.Wrap(err, "invalid asyncapi document")
+	}
+	if !strings.HasPrefix(doc.AsyncApi, "2.") && !strings.HasPrefix(doc.AsyncApi, "3.") {
+		return nil, errors.Errorf("unsupported asyncapi version %q", doc.AsyncApi)
+	}
+	return &doc, nil
+}
+
+// the operations the application receives messages of, by function name
+type operation struct {
+	channel     string
+	contentType string
+}
+
+func (d *Document) operations() map[string]operation {
+	ops := make(map[string]operation)
+	if strings.HasPrefix(d.AsyncApi, "2.") {
+		for name, channel := range d.Channels {
+			if channel == nil || channel.Publish == nil {
+				continue
+			}
+			funcName := channel.Publish.OperationId
+			if funcName == "" {
+				funcName = name
+			}
+			ops[funcName] = operation{channel: name, contentType: d.contentType(channel.Publish.Message)}
+		}
+		return ops
+	}
+	for name, op := range d.Operations {
+		if op == nil || op.Action != "receive" || op.Channel == nil {
+			continue
+		}
+		channel := d.Channels[strings.TrimPrefix(op.Channel.Ref, "#/channels/")]
+		if channel == nil || channel.Address == nil {
+			// channels without address are only known at runtime
+			continue
+		}
+		var message *Message
+		if len(channel.Messages) == 1 {
+			for _, m := range channel.Messages {
+				message = m
+			}
+		}
+		ops[name] = operation{channel: *channel.Address, contentType: d.contentType(message)}
+	}
+	return ops
+}
+
+func (d *Document) contentType(message *Message) string {
+	if message != nil && message.ContentType != "" {
+		return message.ContentType
+	}
+	if d.DefaultContentType != "" {
+		return d.DefaultContentType
+	}
+	return defaultContentType
+}
+
+// Functions returns the transformations posting the messages of the operations to the bridge
+func Functions(doc *Document, bridge *rest_plugins.ServiceSpec_AsyncApiInfo_HttpBridge) map[string]*transformation_plugins.TransformationTemplate {
+	pathTemplate := bridge.GetPathTemplate()
+	if pathTemplate == "" {
+		pathTemplate = defaultPathTemplate
+	}
+	method := bridge.GetMethod()
+	if method == "" {
+		method = defaultMethod
+	}
+
+	funcs := make(map[string]*transformation_plugins.TransformationTemplate)
+	for name, op := range doc.operations() {
+		channel := channelParameter.ReplaceAllString(strings.TrimPrefix(op.channel, "/"), "{{ $1 }}")
+		headers := map[string]*transformation_plugins.InjaTemplate{
+			":method":      {Text: method},
+			":path":        {Text: strings.Replace(pathTemplate, "{channel}", channel, -1)},
+			"content-type": {Text: op.contentType},
+		}
+		var names []string
+		for header := range bridge.GetHeaders() {
+			names = append(names, header)
+		}
+		sort.Strings(names)
+		for _, header := range names {
+			headers[strings.ToLower(header)] = &transformation_plugins.InjaTemplate{Text: bridge.Headers[header]}
+		}
+		funcs[name] = &transformation_plugins.TransformationTemplate{
+			Headers: headers,
+			BodyTransformation: &transformation_plugins.TransformationTemplate_Passthrough{
+				Passthrough: &transformation_plugins.Passthrough{},
+			},
+		}
+	}
+	return funcs
+}
@@ -0,0 +1,75 @@
+package aws
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// aliases can be moved without publishing a version, so the cached aliases of a function are listed again after
+	// this long even if its versions did not change
+	aliasesRefreshInterval = 10 * time.Minute
+)
+
+type aliasesKey struct {
+	upstream string
+	function string
+}
+
+type cachedAliases struct {
+	versions string
+	listed   time.Time
+	aliases  []string
+}
+
+// aliasCache keeps the aliases of the functions discovered on each upstream, so that the aliases of every function
+// are not listed on every poll. The aliases of a function are listed again when its versions change, as a new version
+// is usually published to move an alias to it, or when they are older than the refresh interval.
+type aliasCache struct {
+	lock    sync.Mutex
+	entries map[aliasesKey]*cachedAliases
+}
+
+func newAliasCache() *aliasCache {
+	return &aliasCache{entries: make(map[aliasesKey]*cachedAliases)}
+}
+
+func (c *aliasCache) get(upstream, function string, versions []string, now time.Time, list func() ([]string, error)) ([]string, error) {
+	key := aliasesKey{upstream: upstream, function: function}
+	sortedVersions := append([]string{}, versions...)
+	sort.Strings(sortedVersions)
+	joinedVersions := strings.Join(sortedVersions, ",")
+
+	c.lock.Lock()
+	cached, ok := c.entries[key]
+	c.lock.Unlock()
+	if ok && cached.versions == joinedVersions && now.Sub(cached.listed) < aliasesRefreshInterval {
+		return cached.aliases, nil
+	}
+
+	aliases, err := list()
+	if err != nil {
+		return nil, err
+	}
+	c.lock.Lock()
+	c.entries[key] = &cachedAliases{versions: joinedVersions, listed: now, aliases: aliases}
+	c.lock.Unlock()
+	return aliases, nil
+}
+
+// retain drops the aliases of the functions of the upstream that were not discovered anymore
+func (c *aliasCache) retain(upstream string, functions []string) {
+	discovered := make(map[string]bool)
+	for _, function := range functions {
+		discovered[function] = true
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	for key := range c.entries {
+		if key.upstream == upstream && !discovered[key.function] {
+			delete(c.entries, key)
+		}
+	}
+}
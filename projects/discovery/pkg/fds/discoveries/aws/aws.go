@@ -3,13 +3,16 @@ package aws
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"net/url"
 	"sort"
+	"strconv"
+	"strings"
+	"sync"
 	"time"
-	"unicode/utf8"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/lambda"
 	"github.com/pkg/errors"
@@ -17,29 +20,50 @@ import (
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins"
 	glooaws "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/aws"
+	awsplugin "github.com/solo-io/gloo/projects/gloo/pkg/plugins/aws"
 	"github.com/solo-io/go-utils/contextutils"
 )
 
 const (
-	// expected map identifiers for secrets
-	awsAccessKey = "access_key"
-	awsSecretKey = "secret_key"
+	listFunctionsPermission = "lambda:ListFunctions"
+	listAliasesPermission   = "lambda:ListAliases"
+
+	accessDeniedErrorCode = "AccessDeniedException"
 )
 
 type AWSLambdaFunctionDiscoveryFactory struct {
 	PollingTime time.Duration
+
+	// Endpoint overrides the endpoint of the Lambda API, e.g. to discover the functions of a fake API in tests.
+	// Defaults to the endpoint of the region of the upstream.
+	Endpoint string
+	// HTTPClient overrides the client used to call the Lambda API
+	HTTPClient *http.Client
+
+	// the discoveries of an upstream are restarted when it is written, so they share the aliases they listed
+	aliasesOnce sync.Once
+	aliases     *aliasCache
 }
 
 func (f *AWSLambdaFunctionDiscoveryFactory) NewFunctionDiscovery(u *v1.Upstream) fds.UpstreamFunctionDiscovery {
+	f.aliasesOnce.Do(func() {
+		f.aliases = newAliasCache()
+	})
 	return &AWSLambdaFunctionDiscovery{
-		timetowait: f.PollingTime,
+		timetowait: fds.PollInterval(u, f.PollingTime),
 		upstream:   u,
+		endpoint:   f.Endpoint,
+		httpClient: f.HTTPClient,
+		aliases:    f.aliases,
 	}
 }
 
 type AWSLambdaFunctionDiscovery struct {
 	timetowait time.Duration
 	upstream   *v1.Upstream
+	endpoint   string
+	httpClient *http.Client
+	aliases    *aliasCache
 }
 
 func (f *AWSLambdaFunctionDiscovery) IsFunctional() bool {
@@ -47,16 +71,27 @@ func (f *AWSLambdaFunctionDiscovery) IsFunctional() bool {
 	return ok
 }
 
-func (f *AWSLambdaFunctionDiscovery) DetectType(ctx context.Context, url *url.URL) (*plugins.ServiceSpec, error) {
+func (f *AWSLambdaFunctionDiscovery) DetectType(ctx context.Context, url *url.URL, _ func() fds.Dependencies) (*plugins.ServiceSpec, error) {
 	return nil, nil
 }
 
 // TODO: how to handle changes in secret or upstream (like the upstream ref)?
 // perhaps the in param for the upstream should be a function? in func() *v1.Upstream
 func (f *AWSLambdaFunctionDiscovery) DetectFunctions(ctx context.Context, url *url.URL, dependencies func() fds.Dependencies, updatecb func(fds.UpstreamMutator) error) error {
+	awsspec, ok := f.upstream.UpstreamSpec.UpstreamType.(*v1.UpstreamSpec_Aws)
+	checkPermissions := ok && awsspec.Aws.CheckDiscoveryPermissions
 	for {
 		// TODO: get backoff values from config?
-		err := contextutils.NewExponentioalBackoff(contextutils.ExponentioalBackoff{}).Backoff(ctx, func(ctx context.Context) error {
+		err := contextutils.NewExponentioalBackoff(contextutils.ExponentioalBackoff{}).Backoff(ctx, fds.RecordAttempts("aws", func(ctx context.Context) error {
+			if checkPermissions {
+				// the missing permissions are reported as the error of the attempt on the function discovery
+				// status of the upstream, which gloo does not overwrite
+				if err := f.requirePermissions(ctx, dependencies().Secrets); err != nil {
+					return err
+				}
+				checkPermissions = false
+			}
+
 			newfunctions, err := f.DetectFunctionsOnce(ctx, dependencies().Secrets)
 
 			if err != nil {
@@ -97,7 +132,7 @@ func (f *AWSLambdaFunctionDiscovery) DetectFunctions(ctx context.Context, url *u
 			}
 			return nil
 
-		})
+		}))
 		if err != nil {
 			if ctx.Err() != nil {
 				return ctx.Err()
@@ -106,46 +141,108 @@ func (f *AWSLambdaFunctionDiscovery) DetectFunctions(ctx context.Context, url *u
 		}
 
 		// sleep so we are not hogging
-		if err := contextutils.Sleep(ctx, f.timetowait); err != nil {
+		if err := fds.WaitForPoll(ctx, f.timetowait); err != nil {
 			return err
 		}
 	}
 }
 
-func (f *AWSLambdaFunctionDiscovery) DetectFunctionsOnce(ctx context.Context, secrets v1.SecretList) ([]*glooaws.LambdaFunctionSpec, error) {
-	in := f.upstream
-	awsspec, ok := in.UpstreamSpec.UpstreamType.(*v1.UpstreamSpec_Aws)
+// CheckPermissions returns the permissions required by function discovery that the credentials of the upstream are missing.
+// The permissions are checked by making the smallest possible request for each of them.
+func (f *AWSLambdaFunctionDiscovery) CheckPermissions(ctx context.Context, secrets v1.SecretList) ([]string, error) {
+	svc, _, err := f.lambdaClient(secrets)
+	if err != nil {
+		return nil, err
+	}
 
+	var missing []string
+	functions, err := svc.ListFunctionsWithContext(ctx, &lambda.ListFunctionsInput{MaxItems: aws.Int64(1)})
+	if err != nil {
+		if !isAccessDenied(err) {
+			return nil, errors.Wrap(err, "unable to check permissions")
+		}
+		missing = append(missing, listFunctionsPermission)
+	}
+
+	// aliases can only be listed for an existing function
+	if functions != nil && len(functions.Functions) > 0 {
+		_, err := svc.ListAliasesWithContext(ctx, &lambda.ListAliasesInput{
+			FunctionName: functions.Functions[0].FunctionName,
+			MaxItems:     aws.Int64(1),
+		})
+		if err != nil {
+			if !isAccessDenied(err) {
+				return nil, errors.Wrap(err, "unable to check permissions")
+			}
+			missing = append(missing, listAliasesPermission)
+		}
+	}
+
+	return missing, nil
+}
+
+func isAccessDenied(err error) bool {
+	awsErr, ok := err.(awserr.Error)
+	return ok && awsErr.Code() == accessDeniedErrorCode
+}
+
+// requirePermissions returns an error naming the permissions required by function discovery that the credentials of
+// the upstream are missing
+func (f *AWSLambdaFunctionDiscovery) requirePermissions(ctx context.Context, secrets v1.SecretList) error {
+	missing, err := f.CheckPermissions(ctx, secrets)
+	if err != nil {
+		return err
+	}
+	if len(missing) > 0 {
+		return errors.Errorf("credentials are missing permissions required for function discovery: %v", strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+func (f *AWSLambdaFunctionDiscovery) lambdaClient(secrets v1.SecretList) (*lambda.Lambda, *glooaws.UpstreamSpec, error) {
+	awsspec, ok := f.upstream.UpstreamSpec.UpstreamType.(*v1.UpstreamSpec_Aws)
 	if !ok {
-		return nil, errors.New("not a lambda upstream spec")
+		return nil, nil, errors.New("not a lambda upstream spec")
 	}
 	lambdaSpec := awsspec.Aws
-	awsSecrets, err := secrets.Find(lambdaSpec.SecretRef.Namespace, lambdaSpec.SecretRef.Name)
+	creds, err := awsplugin.UpstreamCredentials(secrets, lambdaSpec)
 	if err != nil {
-		return nil, errors.Wrapf(err, "secrets not found for secret ref %v", lambdaSpec.SecretRef)
+		return nil, nil, errors.Wrapf(err, "unable to get credentials for aws upstream")
 	}
 
-	awsSecret, ok := awsSecrets.Kind.(*v1.Secret_Aws)
-	if !ok {
-		return nil, errors.Errorf("provided secret is not an aws secret")
+	sess, err := session.NewSession(aws.NewConfig().
+		WithCredentials(creds))
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "unable to create AWS session")
 	}
-	accessKey := awsSecret.Aws.AccessKey
-	if accessKey != "" && !utf8.Valid([]byte(accessKey)) {
-		return nil, errors.Errorf("%s not a valid string", awsAccessKey)
+	config := aws.NewConfig().WithRegion(lambdaSpec.Region)
+	if f.endpoint != "" {
+		config = config.WithEndpoint(f.endpoint)
 	}
-	secretKey := awsSecret.Aws.SecretKey
-	if secretKey != "" && !utf8.Valid([]byte(secretKey)) {
-		return nil, errors.Errorf("%s not a valid string", awsSecretKey)
+	if f.httpClient != nil {
+		config = config.WithHTTPClient(f.httpClient)
 	}
+	return lambda.New(sess, config), lambdaSpec, nil
+}
 
-	sess, err := session.NewSession(aws.NewConfig().
-		WithCredentials(credentials.NewStaticCredentials(accessKey, secretKey, "")))
+func (f *AWSLambdaFunctionDiscovery) DetectFunctionsOnce(ctx context.Context, secrets v1.SecretList) ([]*glooaws.LambdaFunctionSpec, error) {
+	svc, lambdaSpec, err := f.lambdaClient(secrets)
 	if err != nil {
-		return nil, errors.Wrap(err, "unable to create AWS session")
+		return nil, err
+	}
+
+	qualifiers := make(map[string]bool)
+	for _, qualifier := range lambdaSpec.Qualifiers {
+		qualifiers[qualifier] = true
+	}
+	// an empty restriction imports all qualifiers
+	shouldImport := func(qualifier string) bool {
+		return len(qualifiers) == 0 || qualifiers[qualifier]
 	}
-	svc := lambda.New(sess, &aws.Config{Region: aws.String(lambdaSpec.Region)})
 
 	var newfunctions []*glooaws.LambdaFunctionSpec
+	var functionNames []string
+	versions := make(map[string][]string)
 
 	options := &lambda.ListFunctionsInput{FunctionVersion: aws.String("ALL")}
 	err = svc.ListFunctionsPagesWithContext(ctx, options, func(results *lambda.ListFunctionsOutput, _ bool) bool {
@@ -154,16 +251,15 @@ func (f *AWSLambdaFunctionDiscovery) DetectFunctionsOnce(ctx context.Context, se
 			version := aws.StringValue(f.Version)
 			name := aws.StringValue(f.FunctionName)
 
-			logicalname := fmt.Sprintf("%s:%s", name, version)
+			// every function has a $LATEST version
 			if version == "$LATEST" {
-				logicalname = name
+				functionNames = append(functionNames, name)
 			}
-
-			newfunctions = append(newfunctions, &glooaws.LambdaFunctionSpec{
-				LambdaFunctionName: name,
-				Qualifier:          version,
-				LogicalName:        logicalname,
-			})
+			versions[name] = append(versions[name], version)
+			if !shouldImport(version) {
+				continue
+			}
+			newfunctions = append(newfunctions, newLambdaFunctionSpec(name, version))
 		}
 
 		return true
@@ -172,5 +268,75 @@ func (f *AWSLambdaFunctionDiscovery) DetectFunctionsOnce(ctx context.Context, se
 		return nil, errors.Wrap(err, "unable to get list of functions from AWS")
 	}
 
+	if !importsAliases(lambdaSpec.Qualifiers) {
+		return newfunctions, nil
+	}
+	upstream := f.upstream.Metadata.Ref().Key()
+	aliasCache := f.aliases
+	if aliasCache == nil {
+		aliasCache = newAliasCache()
+	}
+	aliasCache.retain(upstream, functionNames)
+	now := time.Now()
+	for _, name := range functionNames {
+		name := name
+		aliases, err := aliasCache.get(upstream, name, versions[name], now, func() ([]string, error) {
+			return listAliases(ctx, svc, name)
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, alias := range aliases {
+			if !shouldImport(alias) {
+				continue
+			}
+			newfunctions = append(newfunctions, newLambdaFunctionSpec(name, alias))
+		}
+	}
+
 	return newfunctions, nil
 }
+
+// whether the qualifiers may name aliases, rather than only versions
+func importsAliases(qualifiers []string) bool {
+	if len(qualifiers) == 0 {
+		return true
+	}
+	for _, qualifier := range qualifiers {
+		if _, err := strconv.ParseUint(qualifier, 10, 64); err != nil && qualifier != "$LATEST" {
+			return true
+		}
+	}
+	return false
+}
+
+func newLambdaFunctionSpec(name, qualifier string) *glooaws.LambdaFunctionSpec {
+	logicalname := fmt.Sprintf("%s:%s", name, qualifier)
+	if qualifier == "$LATEST" {
+		logicalname = name
+	}
+
+	return &glooaws.LambdaFunctionSpec{
+		LambdaFunctionName: name,
+		Qualifier:          qualifier,
+		LogicalName:        logicalname,
+	}
+}
+
+func listAliases(ctx context.Context, svc *lambda.Lambda, functionName string) ([]string, error) {
+	var aliases []string
+	options := &lambda.ListAliasesInput{FunctionName: aws.String(functionName)}
+	for {
+		results, err := svc.ListAliasesWithContext(ctx, options)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to get list of aliases for function %v from AWS", functionName)
+		}
+		for _, alias := range results.Aliases {
+			aliases = append(aliases, aws.StringValue(alias.Name))
+		}
+		if aws.StringValue(results.NextMarker) == "" {
+			return aliases, nil
+		}
+		options.Marker = results.NextMarker
+	}
+}
@@ -0,0 +1,174 @@
+package aws
+
+import (
+	"context"
+	"net/url"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/solo-io/gloo/projects/discovery/pkg/fds"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/test/v1helpers"
+)
+
+var _ = Describe("AWSLambdaFunctionDiscovery", func() {
+	const region = "us-east-1"
+
+	var (
+		ctx        context.Context
+		cancel     context.CancelFunc
+		fakeLambda *v1helpers.FakeLambda
+		secrets    v1.SecretList
+		upstream   *v1.Upstream
+		discovery  *AWSLambdaFunctionDiscovery
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		fakeLambda = v1helpers.NewFakeLambda(ctx, &v1helpers.FakeLambdaFunction{
+			Name:    "uppercase",
+			Aliases: []string{"prod"},
+		})
+		secret := fakeLambda.Secret("default", region)
+		secrets = v1.SecretList{secret}
+		upstream = fakeLambda.Upstream(secret, region)
+		upstream.UpstreamSpec.UpstreamType.(*v1.UpstreamSpec_Aws).Aws.CheckDiscoveryPermissions = true
+
+		factory := &AWSLambdaFunctionDiscoveryFactory{
+			PollingTime: time.Second,
+			Endpoint:    fakeLambda.Endpoint(),
+			HTTPClient:  fakeLambda.Client(),
+		}
+		discovery = factory.NewFunctionDiscovery(upstream).(*AWSLambdaFunctionDiscovery)
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	Context("permissions", func() {
+
+		It("finds no missing permission when the credentials have them all", func() {
+			missing, err := discovery.CheckPermissions(ctx, secrets)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(missing).To(BeEmpty())
+			Expect(discovery.requirePermissions(ctx, secrets)).To(Succeed())
+		})
+
+		It("finds the missing permissions", func() {
+			fakeLambda.DenyPermissions(listAliasesPermission)
+
+			missing, err := discovery.CheckPermissions(ctx, secrets)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(missing).To(Equal([]string{listAliasesPermission}))
+
+			err = discovery.requirePermissions(ctx, secrets)
+			Expect(err).To(MatchError(ContainSubstring(listAliasesPermission)))
+		})
+
+		It("cannot check the aliases without a function", func() {
+			fakeLambda = v1helpers.NewFakeLambda(ctx)
+			fakeLambda.DenyPermissions(listFunctionsPermission, listAliasesPermission)
+			discovery.endpoint = fakeLambda.Endpoint()
+			discovery.httpClient = fakeLambda.Client()
+
+			missing, err := discovery.CheckPermissions(ctx, secrets)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(missing).To(Equal([]string{listFunctionsPermission}))
+		})
+	})
+
+	Context("aliases", func() {
+
+		It("lists the aliases of a function again only when its versions change", func() {
+			functions, err := discovery.DetectFunctionsOnce(ctx, secrets)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(functions).To(HaveLen(2))
+			Expect(fakeLambda.Calls(listAliasesPermission)).To(Equal(1))
+
+			_, err = discovery.DetectFunctionsOnce(ctx, secrets)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fakeLambda.Calls(listAliasesPermission)).To(Equal(1))
+
+			fakeLambda.AddFunction(&v1helpers.FakeLambdaFunction{
+				Name:     "uppercase",
+				Versions: []string{"1"},
+				Aliases:  []string{"prod", "canary"},
+			})
+			functions, err = discovery.DetectFunctionsOnce(ctx, secrets)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(functions).To(HaveLen(4))
+			Expect(fakeLambda.Calls(listAliasesPermission)).To(Equal(2))
+		})
+
+		It("lists the aliases again once they are older than the refresh interval", func() {
+			cache := newAliasCache()
+			calls := 0
+			list := func() ([]string, error) {
+				calls++
+				return []string{"prod"}, nil
+			}
+			now := time.Now()
+			_, err := cache.get("upstream", "uppercase", []string{"$LATEST"}, now, list)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = cache.get("upstream", "uppercase", []string{"$LATEST"}, now.Add(aliasesRefreshInterval/2), list)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(calls).To(Equal(1))
+			_, err = cache.get("upstream", "uppercase", []string{"$LATEST"}, now.Add(aliasesRefreshInterval), list)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(calls).To(Equal(2))
+
+			cache.retain("upstream", nil)
+			Expect(cache.entries).To(BeEmpty())
+		})
+
+		It("does not list the aliases when only versions are imported", func() {
+			upstream.UpstreamSpec.UpstreamType.(*v1.UpstreamSpec_Aws).Aws.Qualifiers = []string{"$LATEST", "1"}
+
+			_, err := discovery.DetectFunctionsOnce(ctx, secrets)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fakeLambda.Calls(listAliasesPermission)).To(Equal(0))
+		})
+	})
+
+	Context("detecting functions", func() {
+
+		detectFunctions := func() <-chan *v1.Upstream {
+			updated := make(chan *v1.Upstream, 10)
+			go discovery.DetectFunctions(ctx, &url.URL{}, func() fds.Dependencies {
+				return fds.Dependencies{Secrets: secrets}
+			}, func(mutator fds.UpstreamMutator) error {
+				out := *upstream
+				out.UpstreamSpec = &v1.UpstreamSpec{}
+				*out.UpstreamSpec = *upstream.UpstreamSpec
+				aws := *upstream.UpstreamSpec.UpstreamType.(*v1.UpstreamSpec_Aws).Aws
+				out.UpstreamSpec.UpstreamType = &v1.UpstreamSpec_Aws{Aws: &aws}
+				if err := mutator(&out); err != nil {
+					return err
+				}
+				updated <- &out
+				return nil
+			})
+			return updated
+		}
+
+		It("discovers the functions once the credentials have the permissions", func() {
+			updated := detectFunctions()
+
+			var us *v1.Upstream
+			Eventually(updated).Should(Receive(&us))
+			functions := us.UpstreamSpec.UpstreamType.(*v1.UpstreamSpec_Aws).Aws.LambdaFunctions
+			Expect(functions).To(HaveLen(2))
+			Expect(us.Status).To(Equal(upstream.Status))
+		})
+
+		It("neither writes the functions nor the status of the upstream while permissions are missing", func() {
+			fakeLambda.DenyPermissions(listAliasesPermission)
+
+			updated := detectFunctions()
+			Consistently(updated, 500*time.Millisecond).ShouldNot(Receive())
+		})
+	})
+})
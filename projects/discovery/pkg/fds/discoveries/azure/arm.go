@@ -0,0 +1,224 @@
+package azure
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"path"
+	"strings"
+	"sync"
+
+	"github.com/Azure/go-autorest/autorest/adal"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/pkg/errors"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	glooazure "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/azure"
+)
+
+const (
+	// api version of the Microsoft.Web resource provider
+	webApiVersion = "2018-02-01"
+
+	functionAppKind    = "functionapp"
+	httpTriggerBinding = "httptrigger"
+)
+
+// resources returned by the azure resource manager api
+type armResource struct {
+	Id         string          `json:"id"`
+	Name       string          `json:"name"`
+	Kind       string          `json:"kind"`
+	Properties json.RawMessage `json:"properties"`
+}
+
+type armResourceList struct {
+	Value    []armResource `json:"value"`
+	NextLink string        `json:"nextLink"`
+}
+
+type functionProperties struct {
+	Config struct {
+		Bindings []struct {
+			Type      string `json:"type"`
+			AuthLevel string `json:"authLevel"`
+		} `json:"bindings"`
+	} `json:"config"`
+}
+
+var (
+	// the azure cloud of the service principals and of the resource manager, overridden in tests
+	azureEnvironment = azure.PublicCloud
+
+	tokensLock sync.Mutex
+	// the tokens of the service principals of the secrets, by secret. they are only fetched again when they expire,
+	// rather than on every poll.
+	tokens = make(map[string]*servicePrincipalToken)
+)
+
+type servicePrincipal struct {
+	tenantId     string
+	clientId     string
+	clientSecret string
+}
+
+type servicePrincipalToken struct {
+	principal servicePrincipal
+	token     *adal.ServicePrincipalToken
+}
+
+// returns the token of the service principal of the secret, replacing the cached one when the secret changes
+func tokenForSecret(secretKey string, principal servicePrincipal, httpClient *http.Client) (*adal.ServicePrincipalToken, error) {
+	tokensLock.Lock()
+	defer tokensLock.Unlock()
+
+	if cached, ok := tokens[secretKey]; ok && cached.principal == principal {
+		return cached.token, nil
+	}
+	oauthConfig, err := adal.NewOAuthConfig(azureEnvironment.ActiveDirectoryEndpoint, principal.tenantId)
+	if err != nil {
+		return nil, err
+	}
+	token, err := adal.NewServicePrincipalToken(*oauthConfig, principal.clientId, principal.clientSecret, azureEnvironment.ResourceManagerEndpoint)
+	if err != nil {
+		return nil, err
+	}
+	token.SetSender(httpClient)
+	tokens[secretKey] = &servicePrincipalToken{principal: principal, token: token}
+	return token, nil
+}
+
+type armClient struct {
+	token      *adal.ServicePrincipalToken
+	httpClient *http.Client
+	endpoint   string
+}
+
+func newArmClient(secrets v1.SecretList, spec *glooazure.UpstreamSpec, httpClient *http.Client) (*armClient, error) {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	secret, err := secrets.Find(spec.SecretRef.Strings())
+	if err != nil {
+		return nil, errors.Wrapf(err, "azure secrets for ref %v not found", spec.SecretRef)
+	}
+	azureSecret, ok := secret.Kind.(*v1.Secret_Azure)
+	if !ok {
+		return nil, errors.Errorf("secret %v is not an Azure secret", secret.GetMetadata().Ref())
+	}
+	if azureSecret.Azure.TenantId == "" || azureSecret.Azure.ClientId == "" || azureSecret.Azure.ClientSecret == "" {
+		return nil, errors.Errorf("secret %v does not contain service principal credentials", secret.GetMetadata().Ref())
+	}
+
+	token, err := tokenForSecret(secret.GetMetadata().Ref().Key(), servicePrincipal{
+		tenantId:     azureSecret.Azure.TenantId,
+		clientId:     azureSecret.Azure.ClientId,
+		clientSecret: azureSecret.Azure.ClientSecret,
+	}, httpClient)
+	if err != nil {
+		return nil, err
+	}
+	return &armClient{
+		token:      token,
+		httpClient: httpClient,
+		endpoint:   strings.TrimSuffix(azureEnvironment.ResourceManagerEndpoint, "/"),
+	}, nil
+}
+
+// findFunctionApp returns the resource id of the function app of the upstream
+func (c *armClient) findFunctionApp(ctx context.Context, spec *glooazure.UpstreamSpec) (string, error) {
+	if spec.ResourceGroup != "" {
+		return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Web/sites/%s",
+			spec.SubscriptionId, spec.ResourceGroup, spec.FunctionAppName), nil
+	}
+
+	sites, err := c.list(ctx, fmt.Sprintf("/subscriptions/%s/providers/Microsoft.Web/sites", spec.SubscriptionId))
+	if err != nil {
+		return "", errors.Wrapf(err, "unable to list function apps of subscription %v", spec.SubscriptionId)
+	}
+	for _, site := range sites {
+		// the kind of linux function apps is "functionapp,linux"
+		if site.Name == spec.FunctionAppName && strings.Contains(site.Kind, functionAppKind) {
+			return site.Id, nil
+		}
+	}
+	return "", errors.Errorf("function app %v not found in subscription %v", spec.FunctionAppName, spec.SubscriptionId)
+}
+
+func (c *armClient) listFunctions(ctx context.Context, functionAppId string) ([]*glooazure.UpstreamSpec_FunctionSpec, error) {
+	resources, err := c.list(ctx, functionAppId+"/functions")
+	if err != nil {
+		return nil, err
+	}
+
+	var functions []*glooazure.UpstreamSpec_FunctionSpec
+	for _, resource := range resources {
+		var properties functionProperties
+		if err := json.Unmarshal(resource.Properties, &properties); err != nil {
+			return nil, errors.Wrapf(err, "unable to parse function %v", resource.Name)
+		}
+		for _, binding := range properties.Config.Bindings {
+			// only http triggered functions can be routed to
+			if strings.ToLower(binding.Type) != httpTriggerBinding {
+				continue
+			}
+			functions = append(functions, &glooazure.UpstreamSpec_FunctionSpec{
+				// function resources are named <function app>/<function>
+				FunctionName: path.Base(resource.Name),
+				AuthLevel:    convertAuthLevel(binding.AuthLevel),
+			})
+			break
+		}
+	}
+	return functions, nil
+}
+
+func convertAuthLevel(authLevel string) glooazure.UpstreamSpec_FunctionSpec_AuthLevel {
+	switch strings.ToLower(authLevel) {
+	case "anonymous":
+		return glooazure.UpstreamSpec_FunctionSpec_Anonymous
+	case "admin":
+		return glooazure.UpstreamSpec_FunctionSpec_Admin
+	}
+	// function is the default auth level of http triggers
+	return glooazure.UpstreamSpec_FunctionSpec_Function
+}
+
+// list returns all the resources of a collection, following the pages of the response
+func (c *armClient) list(ctx context.Context, resourcePath string) ([]armResource, error) {
+	var resources []armResource
+	nextLink := fmt.Sprintf("%s%s?api-version=%s", c.endpoint, resourcePath, webApiVersion)
+	for nextLink != "" {
+		var page armResourceList
+		if err := c.get(ctx, nextLink, &page); err != nil {
+			return nil, err
+		}
+		resources = append(resources, page.Value...)
+		nextLink = page.NextLink
+	}
+	return resources, nil
+}
+
+func (c *armClient) get(ctx context.Context, url string, out interface{}) error {
+	if err := c.token.EnsureFreshWithContext(ctx); err != nil {
+		return errors.Wrap(err, "unable to get azure access token")
+	}
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+c.token.OAuthToken())
+
+	resp, err := c.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := ioutil.ReadAll(resp.Body)
+		return errors.Errorf("azure resource manager returned %v: %s", resp.Status, body)
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
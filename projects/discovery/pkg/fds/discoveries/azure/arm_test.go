@@ -0,0 +1,125 @@
+package azure
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest/azure"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	glooazure "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/azure"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+var _ = Describe("Azure resource manager client", func() {
+
+	var (
+		ctx               context.Context
+		cancel            context.CancelFunc
+		server            *httptest.Server
+		tokenRequests     int32
+		origEnvironment   azure.Environment
+		secrets           v1.SecretList
+		upstream          *v1.Upstream
+		discovery         *AzureFunctionDiscovery
+		functionsResponse = `{"value": [
+			{"name": "app/hello", "properties": {"config": {"bindings": [{"type": "httpTrigger", "authLevel": "anonymous"}]}}},
+			{"name": "app/queue", "properties": {"config": {"bindings": [{"type": "queueTrigger"}]}}}
+		]}`
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		tokenRequests = 0
+		server = httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+			if strings.HasSuffix(r.URL.Path, "/oauth2/token") {
+				n := atomic.AddInt32(&tokenRequests, 1)
+				json.NewEncoder(rw).Encode(map[string]string{
+					"access_token": fmt.Sprintf("token-%d", n),
+					"expires_in":   "3600",
+					"expires_on":   fmt.Sprint(time.Now().Add(time.Hour).Unix()),
+					"not_before":   fmt.Sprint(time.Now().Unix()),
+					"resource":     "resource-manager",
+					"token_type":   "Bearer",
+				})
+				return
+			}
+			if r.Header.Get("Authorization") != "Bearer token-1" {
+				rw.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			if r.URL.Path != "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Web/sites/app/functions" {
+				rw.WriteHeader(http.StatusNotFound)
+				return
+			}
+			rw.Write([]byte(functionsResponse))
+		}))
+		origEnvironment = azureEnvironment
+		azureEnvironment = azure.Environment{
+			ActiveDirectoryEndpoint: server.URL + "/",
+			ResourceManagerEndpoint: server.URL + "/",
+		}
+
+		secret := &v1.Secret{
+			Metadata: core.Metadata{Namespace: "default", Name: fmt.Sprintf("azure-%d", time.Now().UnixNano())},
+			Kind: &v1.Secret_Azure{Azure: &v1.AzureSecret{
+				TenantId:     "tenant",
+				ClientId:     "client",
+				ClientSecret: "secret",
+			}},
+		}
+		secrets = v1.SecretList{secret}
+		upstream = &v1.Upstream{
+			Metadata: core.Metadata{Namespace: "default", Name: "azure"},
+			UpstreamSpec: &v1.UpstreamSpec{UpstreamType: &v1.UpstreamSpec_Azure{Azure: &glooazure.UpstreamSpec{
+				FunctionAppName: "app",
+				SubscriptionId:  "sub",
+				ResourceGroup:   "rg",
+				SecretRef:       secret.Metadata.Ref(),
+			}}},
+		}
+		discovery = (&AzureFunctionDiscoveryFactory{}).NewFunctionDiscovery(upstream).(*AzureFunctionDiscovery)
+	})
+
+	AfterEach(func() {
+		azureEnvironment = origEnvironment
+		server.Close()
+		cancel()
+	})
+
+	It("lists the http triggered functions of the function app", func() {
+		functions, err := discovery.DetectFunctionsOnce(ctx, secrets)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(functions).To(Equal([]*glooazure.UpstreamSpec_FunctionSpec{{
+			FunctionName: "hello",
+			AuthLevel:    glooazure.UpstreamSpec_FunctionSpec_Anonymous,
+		}}))
+	})
+
+	It("reuses the token of the secret until it expires", func() {
+		for i := 0; i < 3; i++ {
+			_, err := discovery.DetectFunctionsOnce(ctx, secrets)
+			Expect(err).NotTo(HaveOccurred())
+		}
+		Expect(atomic.LoadInt32(&tokenRequests)).To(Equal(int32(1)))
+	})
+
+	It("fetches a new token when the credentials of the secret change", func() {
+		_, err := discovery.DetectFunctionsOnce(ctx, secrets)
+		Expect(err).NotTo(HaveOccurred())
+
+		secrets[0].Kind.(*v1.Secret_Azure).Azure.ClientSecret = "rotated"
+		_, err = discovery.DetectFunctionsOnce(ctx, secrets)
+		// the fake only accepts the first token
+		Expect(err).To(HaveOccurred())
+		Expect(atomic.LoadInt32(&tokenRequests)).To(Equal(int32(2)))
+	})
+})
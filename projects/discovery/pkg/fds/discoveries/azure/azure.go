@@ -0,0 +1,123 @@
+package azure
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/solo-io/gloo/projects/discovery/pkg/fds"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins"
+	glooazure "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/azure"
+	"github.com/solo-io/go-utils/contextutils"
+)
+
+type AzureFunctionDiscoveryFactory struct {
+	PollingTime time.Duration
+
+	// HTTPClient overrides the client used to call the Azure Active Directory and resource manager APIs
+	HTTPClient *http.Client
+}
+
+func (f *AzureFunctionDiscoveryFactory) NewFunctionDiscovery(u *v1.Upstream) fds.UpstreamFunctionDiscovery {
+	return &AzureFunctionDiscovery{
+		timetowait: fds.PollInterval(u, f.PollingTime),
+		upstream:   u,
+		httpClient: f.HTTPClient,
+	}
+}
+
+type AzureFunctionDiscovery struct {
+	timetowait time.Duration
+	upstream   *v1.Upstream
+	httpClient *http.Client
+}
+
+func (f *AzureFunctionDiscovery) IsFunctional() bool {
+	_, ok := f.upstream.UpstreamSpec.UpstreamType.(*v1.UpstreamSpec_Azure)
+	return ok
+}
+
+func (f *AzureFunctionDiscovery) DetectType(ctx context.Context, url *url.URL, _ func() fds.Dependencies) (*plugins.ServiceSpec, error) {
+	return nil, nil
+}
+
+func (f *AzureFunctionDiscovery) DetectFunctions(ctx context.Context, url *url.URL, dependencies func() fds.Dependencies, updatecb func(fds.UpstreamMutator) error) error {
+	azurespec, ok := f.upstream.UpstreamSpec.UpstreamType.(*v1.UpstreamSpec_Azure)
+	if !ok || azurespec.Azure.SubscriptionId == "" {
+		// discovery is not configured for this upstream
+		return nil
+	}
+	for {
+		// TODO: get backoff values from config?
+		err := contextutils.NewExponentioalBackoff(contextutils.ExponentioalBackoff{}).Backoff(ctx, fds.RecordAttempts("azure", func(ctx context.Context) error {
+			newfunctions, err := f.DetectFunctionsOnce(ctx, dependencies().Secrets)
+			if err != nil {
+				return err
+			}
+
+			// sort for idempotency
+			sort.Slice(newfunctions, func(i, j int) bool {
+				return newfunctions[i].FunctionName < newfunctions[j].FunctionName
+			})
+
+			err = updatecb(func(out *v1.Upstream) error {
+				if out == nil {
+					return errors.New("nil upstream")
+				}
+				if out.UpstreamSpec == nil {
+					return errors.New("nil upstream spec")
+				}
+				azurespec, ok := out.UpstreamSpec.UpstreamType.(*v1.UpstreamSpec_Azure)
+				if !ok {
+					return errors.New("not azure upstream")
+				}
+				azurespec.Azure.Functions = newfunctions
+				return nil
+			})
+			if err != nil {
+				return errors.Wrap(err, "unable to update upstream")
+			}
+			return nil
+		}))
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			// ignore other errors as we would like to continue forever.
+			contextutils.LoggerFrom(ctx).Warnw("unable to discover azure functions", "upstream", f.upstream.Metadata.Name, "error", err)
+		}
+
+		// sleep so we are not hogging
+		if err := fds.WaitForPoll(ctx, f.timetowait); err != nil {
+			return err
+		}
+	}
+}
+
+func (f *AzureFunctionDiscovery) DetectFunctionsOnce(ctx context.Context, secrets v1.SecretList) ([]*glooazure.UpstreamSpec_FunctionSpec, error) {
+	azurespec, ok := f.upstream.UpstreamSpec.UpstreamType.(*v1.UpstreamSpec_Azure)
+	if !ok {
+		return nil, errors.New("not an azure upstream spec")
+	}
+	spec := azurespec.Azure
+
+	client, err := newArmClient(secrets, spec, f.httpClient)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to create azure resource manager client")
+	}
+
+	functionAppId, err := client.findFunctionApp(ctx, spec)
+	if err != nil {
+		return nil, err
+	}
+
+	functions, err := client.listFunctions(ctx, functionAppId)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to list functions of function app %v", spec.FunctionAppName)
+	}
+	return functions, nil
+}
@@ -0,0 +1,208 @@
+package graphql
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"time"
+
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/pkg/errors"
+	"github.com/solo-io/gloo/projects/discovery/pkg/fds"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins"
+	rest_plugins "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/rest"
+	"github.com/solo-io/go-utils/contextutils"
+)
+
+var commonGraphQLPaths = []string{
+	"/graphql",
+	"/query",
+	"/api/graphql",
+}
+
+type GraphQLFunctionDiscoveryFactory struct {
+	DetectionTimeout  time.Duration
+	FunctionPollTime  time.Duration
+	GraphQLPathsToTry []string
+}
+
+func (f *GraphQLFunctionDiscoveryFactory) NewFunctionDiscovery(u *v1.Upstream) fds.UpstreamFunctionDiscovery {
+	return &GraphQLFunctionDiscovery{
+		detectionTimeout: f.DetectionTimeout,
+		functionPollTime: fds.PollInterval(u, f.FunctionPollTime),
+		pathsToTry:       append(f.GraphQLPathsToTry, commonGraphQLPaths...),
+		upstream:         u,
+	}
+}
+
+type GraphQLFunctionDiscovery struct {
+	detectionTimeout time.Duration
+	functionPollTime time.Duration
+	pathsToTry       []string
+	upstream         *v1.Upstream
+}
+
+func getgraphqlspec(u *v1.Upstream) *rest_plugins.ServiceSpec_GraphQLInfo {
+	spec, ok := u.UpstreamSpec.UpstreamType.(v1.ServiceSpecGetter)
+	if !ok {
+		return nil
+	}
+	serviceSpec := spec.GetServiceSpec()
+	if serviceSpec == nil {
+		return nil
+	}
+	restwrapper, ok := serviceSpec.PluginType.(*plugins.ServiceSpec_Rest)
+	if !ok {
+		return nil
+	}
+	return restwrapper.Rest.GraphqlInfo
+}
+
+func (d *GraphQLFunctionDiscovery) IsFunctional() bool {
+	return getgraphqlspec(d.upstream) != nil
+}
+
+func (d *GraphQLFunctionDiscovery) DetectType(ctx context.Context, baseurl *url.URL, _ func() fds.Dependencies) (*plugins.ServiceSpec, error) {
+	var spec *plugins.ServiceSpec
+
+	err := contextutils.NewExponentioalBackoff(contextutils.ExponentioalBackoff{MaxDuration: &d.detectionTimeout}).Backoff(ctx, func(ctx context.Context) error {
+		var err error
+		spec, err = d.detectUpstreamTypeOnce(ctx, baseurl)
+		return err
+	})
+
+	return spec, err
+}
+
+func (d *GraphQLFunctionDiscovery) detectUpstreamTypeOnce(ctx context.Context, baseurl *url.URL) (*plugins.ServiceSpec, error) {
+	var errs error
+	for _, path := range d.pathsToTry {
+		if _, err := introspect(ctx, baseurl, path); err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			errs = multierror.Append(errs, err)
+			continue
+		}
+		contextutils.LoggerFrom(ctx).Infof("graphql upstream detected: %v%v", baseurl, path)
+		return &plugins.ServiceSpec{
+			PluginType: &plugins.ServiceSpec_Rest{
+				Rest: &rest_plugins.ServiceSpec{
+					GraphqlInfo: &rest_plugins.ServiceSpec_GraphQLInfo{
+						Path: path,
+					},
+				},
+			},
+		}, nil
+	}
+	return nil, errors.Wrapf(errs, "service at %s does not implement graphql at a known endpoint, "+
+		"or was unreachable", baseurl.String())
+}
+
+func (d *GraphQLFunctionDiscovery) DetectFunctions(ctx context.Context, baseurl *url.URL, _ func() fds.Dependencies, updatecb func(fds.UpstreamMutator) error) error {
+	spec := getgraphqlspec(d.upstream)
+	if spec == nil || spec.Path == "" {
+		return errors.New("upstream doesn't have a graphql endpoint")
+	}
+	for {
+		err := contextutils.NewExponentioalBackoff(contextutils.ExponentioalBackoff{}).Backoff(ctx, fds.RecordAttempts("graphql", func(ctx context.Context) error {
+			return d.DetectFunctionsOnce(ctx, baseurl, spec, updatecb)
+		}))
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			// ignore other errors as we would like to continue forever.
+			contextutils.LoggerFrom(ctx).Warnw("unable to discover graphql operations", "upstream", d.upstream.Metadata.Name, "error", err)
+		}
+
+		if err := fds.WaitForPoll(ctx, d.functionPollTime); err != nil {
+			return err
+		}
+	}
+}
+
+func (d *GraphQLFunctionDiscovery) DetectFunctionsOnce(ctx context.Context, baseurl *url.URL, spec *rest_plugins.ServiceSpec_GraphQLInfo, updatecb func(fds.UpstreamMutator) error) error {
+	schema, err := introspect(ctx, baseurl, spec.Path)
+	if err != nil {
+		return err
+	}
+	funcs := Functions(schema, spec.Path, int(spec.MaxSelectionDepth))
+
+	return updatecb(func(u *v1.Upstream) error {
+		upstreamSpec, ok := u.UpstreamSpec.UpstreamType.(v1.ServiceSpecMutator)
+		if !ok {
+			return errors.New("not a valid upstream")
+		}
+		spec := upstreamSpec.GetServiceSpec()
+		if spec == nil {
+			spec = &plugins.ServiceSpec{}
+		}
+		restspec, ok := spec.PluginType.(*plugins.ServiceSpec_Rest)
+		if !ok || restspec.Rest == nil {
+			restspec = &plugins.ServiceSpec_Rest{
+				Rest: &rest_plugins.ServiceSpec{},
+			}
+		}
+
+		restspec.Rest.Transformations = funcs
+		spec.PluginType = restspec
+
+		upstreamSpec.SetServiceSpec(spec)
+		return nil
+	})
+}
+
+// posts the introspection query to the endpoint and returns the schema
+func introspect(ctx context.Context, baseurl *url.URL, path string) (*Schema, error) {
+	endpoint, err := fds.HttpBaseUrl(baseurl, "graphql")
+	if err != nil {
+		return nil, err
+	}
+	endpointurl := endpoint.ResolveReference(&url.URL{Path: path}).String()
+
+	reqBody, err := json.Marshal(map[string]string{"query": introspectionQuery})
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest("POST", endpointurl, bytes.NewReader(reqBody))
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid url for request")
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Gloo-Discovery", "GraphQL-Discovery")
+
+	res, err := fds.ProbeClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not perform HTTP POST on resolved addr: %v", endpointurl)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		body, _ := ioutil.ReadAll(res.Body)
+		return nil, errors.Errorf("graphql endpoint %v returned %v: %s", endpointurl, res.Status, body)
+	}
+
+	var result struct {
+		Data struct {
+			Schema *Schema `json:"__schema"`
+		} `json:"data"`
+		Errors []struct {
+			Message string `json:"message"`
+		} `json:"errors"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&result); err != nil {
+		return nil, errors.Wrapf(err, "%v is not a graphql endpoint", endpointurl)
+	}
+	if len(result.Errors) > 0 {
+		return nil, errors.Errorf("introspection of %v failed: %v", endpointurl, result.Errors[0].Message)
+	}
+	if result.Data.Schema == nil {
+		return nil, errors.Errorf("%v returned no schema", endpointurl)
+	}
+	return result.Data.Schema, nil
+}
@@ -0,0 +1,97 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+const schemaJson = `{
+  "queryType": {"name": "Query"},
+  "mutationType": {"name": "Mutation"},
+  "types": [
+    {"kind": "OBJECT", "name": "Query", "fields": [
+      {"name": "user", "args": [{"name": "id", "type": {"kind": "NON_NULL", "ofType": {"kind": "SCALAR", "name": "ID"}}}],
+       "type": {"kind": "OBJECT", "name": "User"}},
+      {"name": "version", "args": [], "type": {"kind": "SCALAR", "name": "String"}}
+    ]},
+    {"kind": "OBJECT", "name": "Mutation", "fields": [
+      {"name": "tag", "args": [
+        {"name": "ids", "type": {"kind": "LIST", "ofType": {"kind": "SCALAR", "name": "ID"}}},
+        {"name": "limit", "type": {"kind": "SCALAR", "name": "Int"}}
+      ], "type": {"kind": "SCALAR", "name": "Boolean"}}
+    ]},
+    {"kind": "OBJECT", "name": "User", "fields": [
+      {"name": "id", "args": [], "type": {"kind": "NON_NULL", "ofType": {"kind": "SCALAR", "name": "ID"}}},
+      {"name": "friends", "args": [{"name": "first", "type": {"kind": "NON_NULL", "ofType": {"kind": "SCALAR", "name": "Int"}}}],
+       "type": {"kind": "LIST", "ofType": {"kind": "OBJECT", "name": "User"}}},
+      {"name": "manager", "args": [], "type": {"kind": "OBJECT", "name": "User"}}
+    ]}
+  ]
+}`
+
+var _ = Describe("Graphql", func() {
+
+	var schema *Schema
+
+	BeforeEach(func() {
+		schema = &Schema{}
+		err := json.Unmarshal([]byte(schemaJson), schema)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("creates a function for each query and mutation", func() {
+		funcs := Functions(schema, "/graphql", 0)
+		Expect(funcs).To(HaveLen(3))
+		Expect(funcs).To(HaveKey("query.user"))
+		Expect(funcs).To(HaveKey("query.version"))
+		Expect(funcs).To(HaveKey("mutation.tag"))
+
+		user := funcs["query.user"]
+		Expect(user.Headers[":method"].Text).To(Equal("POST"))
+		Expect(user.Headers[":path"].Text).To(Equal("/graphql"))
+		Expect(user.Headers["content-type"].Text).To(Equal("application/json"))
+	})
+
+	It("generates the operation and the variables of the arguments", func() {
+		funcs := Functions(schema, "/graphql", 0)
+		body := funcs["query.user"].GetBody().Text
+		Expect(body).To(Equal(`{"query": "query user($id: ID!) { user(id: $id) { id manager { id } } }", ` +
+			`"variables": {"id": "{{ default(id, "") }}"}}`))
+
+		body = funcs["mutation.tag"].GetBody().Text
+		Expect(body).To(Equal(`{"query": "mutation tag($ids: [ID], $limit: Int) { tag(ids: $ids, limit: $limit) }", ` +
+			`"variables": {"ids": {{ default(ids, "null") }}, "limit": {{ default(limit, "null") }}}}`))
+	})
+
+	It("limits the depth of the selection set", func() {
+		funcs := Functions(schema, "/graphql", 1)
+		Expect(funcs["query.user"].GetBody().Text).To(ContainSubstring("{ user(id: $id) { id } }"))
+	})
+
+	It("introspects the endpoint", func() {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/graphql" || r.Method != "POST" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.Write([]byte(`{"data": {"__schema": ` + schemaJson + `}}`))
+		}))
+		defer server.Close()
+
+		u, err := url.Parse(server.URL)
+		Expect(err).NotTo(HaveOccurred())
+
+		discovered, err := introspect(context.Background(), u, "/graphql")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(discovered).To(Equal(schema))
+
+		_, err = introspect(context.Background(), u, "/query")
+		Expect(err).To(HaveOccurred())
+	})
+})
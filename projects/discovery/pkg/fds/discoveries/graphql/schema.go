@@ -0,0 +1,215 @@
+package graphql
+
+import (
+	"fmt"
+	"strings"
+
+	transformation_plugins "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/transformation"
+)
+
+const defaultMaxSelectionDepth = 2
+
+// the subset of the introspection query used to generate the functions
+const introspectionQuery = `query IntrospectionQuery {
+  __schema {
+    queryType { name }
+    mutationType { name }
+    types {
+      kind
+      name
+      fields(includeDeprecated: false) {
+        name
+        args { name type { ...TypeRef } }
+        type { ...TypeRef }
+      }
+    }
+  }
+}
+fragment TypeRef on __Type {
+  kind
+  name
+  ofType { kind name ofType { kind name ofType { kind name ofType { kind name } } } }
+}`
+
+const (
+	kindScalar    = "SCALAR"
+	kindObject    = "OBJECT"
+	kindInterface = "INTERFACE"
+	kindEnum      = "ENUM"
+	kindList      = "LIST"
+	kindNonNull   = "NON_NULL"
+)
+
+type typeRef struct {
+	Kind   string   `json:"kind"`
+	Name   string   `json:"name"`
+	OfType *typeRef `json:"ofType"`
+}
+
+// returns the named type wrapped by the list and non null modifiers
+func (t typeRef) named() typeRef {
+	for t.OfType != nil && (t.Kind == kindList || t.Kind == kindNonNull) {
+		t = *t.OfType
+	}
+	return t
+}
+
+// returns the type as written in a variable definition, e.g. [ID!]!
+func (t typeRef) String() string {
+	switch {
+	case t.Kind == kindNonNull && t.OfType != nil:
+		return t.OfType.String() + "!"
+	case t.Kind == kindList && t.OfType != nil:
+		return "[" + t.OfType.String() + "]"
+	}
+	return t.Name
+}
+
+type inputValue struct {
+	Name string  `json:"name"`
+	Type typeRef `json:"type"`
+}
+
+type field struct {
+	Name string       `json:"name"`
+	Args []inputValue `json:"args"`
+	Type typeRef      `json:"type"`
+}
+
+type fullType struct {
+	Kind   string  `json:"kind"`
+	Name   string  `json:"name"`
+	Fields []field `json:"fields"`
+}
+
+type namedType struct {
+	Name string `json:"name"`
+}
+
+type Schema struct {
+	QueryType    *namedType `json:"queryType"`
+	MutationType *namedType `json:"mutationType"`
+	Types        []fullType `json:"types"`
+}
+
+func (s *Schema) typeByName(name string) *fullType {
+	for i := range s.Types {
+		if s.Types[i].Name == name {
+			return &s.Types[i]
+		}
+	}
+	return nil
+}
+
+// Functions returns a transformation for each query and mutation of the schema, named `query.<field>`
+// and `mutation.<field>`. The transformations post the operation to the path, with the arguments
+// of the field taken from the parameters of the same name.
+func Functions(schema *Schema, path string, maxSelectionDepth int) map[string]*transformation_plugins.TransformationTemplate {
+	if maxSelectionDepth <= 0 {
+		maxSelectionDepth = defaultMaxSelectionDepth
+	}
+	funcs := make(map[string]*transformation_plugins.TransformationTemplate)
+	for operation, root := range map[string]*namedType{"query": schema.QueryType, "mutation": schema.MutationType} {
+		if root == nil {
+			continue
+		}
+		rootType := schema.typeByName(root.Name)
+		if rootType == nil {
+			continue
+		}
+		for _, f := range rootType.Fields {
+			funcs[operation+"."+f.Name] = functionForField(schema, path, operation, f, maxSelectionDepth)
+		}
+	}
+	return funcs
+}
+
+func functionForField(schema *Schema, path, operation string, f field, maxSelectionDepth int) *transformation_plugins.TransformationTemplate {
+	var definitions, arguments, variables []string
+	for _, arg := range f.Args {
+		definitions = append(definitions, fmt.Sprintf("$%v: %v", arg.Name, arg.Type))
+		arguments = append(arguments, fmt.Sprintf("%v: $%v", arg.Name, arg.Name))
+		variables = append(variables, fmt.Sprintf(`"%v": %v`, arg.Name, variableTemplate(arg)))
+	}
+
+	query := operation + " " + f.Name
+	if len(definitions) > 0 {
+		query += "(" + strings.Join(definitions, ", ") + ")"
+	}
+	query += " { " + f.Name
+	if len(arguments) > 0 {
+		query += "(" + strings.Join(arguments, ", ") + ")"
+	}
+	if selection := selectionSet(schema, f.Type, maxSelectionDepth); selection != "" {
+		query += " " + selection
+	}
+	query += " }"
+
+	body := fmt.Sprintf(`{"query": "%v", "variables": {%v}}`, query, strings.Join(variables, ", "))
+	return &transformation_plugins.TransformationTemplate{
+		Headers: map[string]*transformation_plugins.InjaTemplate{
+			":method":      {Text: "POST"},
+			":path":        {Text: path},
+			"content-type": {Text: "application/json"},
+		},
+		BodyTransformation: &transformation_plugins.TransformationTemplate_Body{
+			Body: &transformation_plugins.InjaTemplate{Text: body},
+		},
+	}
+}
+
+// same as swagger discovery, strings default to empty and are quoted, other values are inlined as json
+func variableTemplate(arg inputValue) string {
+	named := arg.Type.named()
+	isList := arg.Type.Kind == kindList || (arg.Type.Kind == kindNonNull && arg.Type.OfType != nil && arg.Type.OfType.Kind == kindList)
+	if !isList && (named.Kind == kindEnum || (named.Kind == kindScalar && (named.Name == "String" || named.Name == "ID"))) {
+		return fmt.Sprintf(`"{{ default(%v, "") }}"`, arg.Name)
+	}
+	return fmt.Sprintf(`{{ default(%v, "null") }}`, arg.Name)
+}
+
+// returns the selection set of the fields of an object type, or an empty string for leaf types.
+// fields requiring arguments are skipped, and object fields are only selected up to the max depth.
+func selectionSet(schema *Schema, t typeRef, depth int) string {
+	named := t.named()
+	if named.Kind != kindObject && named.Kind != kindInterface {
+		if named.Kind == kindScalar || named.Kind == kindEnum {
+			return ""
+		}
+		// unions need fragments, only their type is selected
+		return "{ __typename }"
+	}
+	objectType := schema.typeByName(named.Name)
+	var fields []string
+	if objectType != nil {
+		for _, f := range objectType.Fields {
+			if requiresArguments(f) {
+				continue
+			}
+			fieldNamed := f.Type.named()
+			switch fieldNamed.Kind {
+			case kindScalar, kindEnum:
+				fields = append(fields, f.Name)
+			default:
+				if depth <= 1 {
+					continue
+				}
+				fields = append(fields, f.Name+" "+selectionSet(schema, f.Type, depth-1))
+			}
+		}
+	}
+	if len(fields) == 0 {
+		fields = []string{"__typename"}
+	}
+	// spaced so the braces are never mistaken for template expressions
+	return "{ " + strings.Join(fields, " ") + " }"
+}
+
+func requiresArguments(f field) bool {
+	for _, arg := range f.Args {
+		if arg.Type.Kind == kindNonNull {
+			return true
+		}
+	}
+	return false
+}
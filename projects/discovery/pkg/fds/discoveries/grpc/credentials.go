@@ -0,0 +1,184 @@
+package grpc
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"io/ioutil"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	grpc_plugins "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/grpc"
+)
+
+const (
+	defaultCredentialsHeader = "authorization"
+	tokenField               = "token"
+)
+
+// returns the dial options securing the reflection client of the upstream
+func dialOptions(upstream *v1.Upstream, secure bool, secrets v1.SecretList) ([]grpc.DialOption, error) {
+	sslConfig := upstream.UpstreamSpec.GetSslConfig()
+	if sslConfig == nil && !secure {
+		if creds := reflectionCredentials(upstream); creds != nil {
+			return nil, errors.Errorf("reflection credentials require an ssl config on upstream %v", upstream.Metadata.Ref())
+		}
+		return []grpc.DialOption{grpc.WithInsecure()}, nil
+	}
+
+	tlsConfig := &tls.Config{}
+	if sslConfig != nil {
+		var err error
+		tlsConfig, err = clientTlsConfig(sslConfig, secrets)
+		if err != nil {
+			return nil, errors.Wrapf(err, "ssl config of upstream %v", upstream.Metadata.Ref())
+		}
+	}
+	dialopts := []grpc.DialOption{grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig))}
+
+	if creds := reflectionCredentials(upstream); creds != nil {
+		perRpc, err := newTokenCredentials(creds, secrets)
+		if err != nil {
+			return nil, errors.Wrapf(err, "reflection credentials of upstream %v", upstream.Metadata.Ref())
+		}
+		dialopts = append(dialopts, grpc.WithPerRPCCredentials(perRpc))
+	}
+	return dialopts, nil
+}
+
+func reflectionCredentials(upstream *v1.Upstream) *grpc_plugins.ServiceSpec_ReflectionCredentials {
+	spec := getgrpcspec(upstream)
+	if spec == nil {
+		return nil
+	}
+	return spec.ReflectionCredentials
+}
+
+// same as envoy, the root ca and the subject alt names verify the server, and the cert chain is the client certificate
+func clientTlsConfig(sslConfig *v1.UpstreamSslConfig, secrets v1.SecretList) (*tls.Config, error) {
+	var certChain, privateKey, rootCa []byte
+	switch sslSecrets := sslConfig.SslSecrets.(type) {
+	case *v1.UpstreamSslConfig_SecretRef:
+		secret, err := secrets.Find(sslSecrets.SecretRef.Strings())
+		if err != nil {
+			return nil, errors.Wrapf(err, "SSL secret not found")
+		}
+		tlsSecret, ok := secret.Kind.(*v1.Secret_Tls)
+		if !ok {
+			return nil, errors.Errorf("%v is not a TLS secret", secret.Metadata.Ref())
+		}
+		certChain, privateKey, rootCa = []byte(tlsSecret.Tls.CertChain), []byte(tlsSecret.Tls.PrivateKey), []byte(tlsSecret.Tls.RootCa)
+	case *v1.UpstreamSslConfig_SslFiles:
+		var err error
+		if certChain, err = readFile(sslSecrets.SslFiles.TlsCert); err != nil {
+			return nil, err
+		}
+		if privateKey, err = readFile(sslSecrets.SslFiles.TlsKey); err != nil {
+			return nil, err
+		}
+		if rootCa, err = readFile(sslSecrets.SslFiles.RootCa); err != nil {
+			return nil, err
+		}
+	case *v1.UpstreamSslConfig_Sds:
+		return nil, errors.New("certificates from SDS cannot be used by function discovery")
+	}
+
+	tlsConfig := &tls.Config{
+		ServerName: sslConfig.Sni,
+	}
+	if len(certChain) > 0 || len(privateKey) > 0 {
+		cert, err := tls.X509KeyPair(certChain, privateKey)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid client certificate")
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	if len(rootCa) > 0 {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(rootCa) {
+			return nil, errors.New("invalid root ca")
+		}
+		tlsConfig.RootCAs = pool
+	} else if len(sslConfig.VerifySubjectAltName) != 0 {
+		return nil, errors.New("a root_ca must be provided if verify_subject_alt_name is not empty")
+	}
+	if sans := sslConfig.VerifySubjectAltName; len(sans) != 0 {
+		tlsConfig.VerifyPeerCertificate = func(_ [][]byte, chains [][]*x509.Certificate) error {
+			return verifySubjectAltName(chains, sans)
+		}
+	}
+	return tlsConfig, nil
+}
+
+func readFile(name string) ([]byte, error) {
+	if name == "" {
+		return nil, nil
+	}
+	data, err := ioutil.ReadFile(name)
+	if err != nil {
+		return nil, errors.Wrapf(err, "reading %v", name)
+	}
+	return data, nil
+}
+
+// the chains were already verified against the root ca, only the leaf certificate is checked
+func verifySubjectAltName(chains [][]*x509.Certificate, sans []string) error {
+	for _, chain := range chains {
+		if len(chain) == 0 {
+			continue
+		}
+		leaf := chain[0]
+		for _, san := range sans {
+			if leaf.VerifyHostname(san) == nil {
+				return nil
+			}
+			for _, uri := range leaf.URIs {
+				if uri.String() == san {
+					return nil
+				}
+			}
+		}
+	}
+	return errors.Errorf("the server certificate matches none of the subject alt names %v", sans)
+}
+
+type tokenCredentials struct {
+	header string
+	value  string
+}
+
+func newTokenCredentials(creds *grpc_plugins.ServiceSpec_ReflectionCredentials, secrets v1.SecretList) (credentials.PerRPCCredentials, error) {
+	if creds.SecretRef == nil {
+		return nil, errors.New("a secret ref is required")
+	}
+	secret, err := secrets.Find(creds.SecretRef.Strings())
+	if err != nil {
+		return nil, errors.Wrapf(err, "token secret not found")
+	}
+	extension, ok := secret.Kind.(*v1.Secret_Extension)
+	if !ok || extension.Extension.GetConfig() == nil {
+		return nil, errors.Errorf("%v is not an extension secret", secret.Metadata.Ref())
+	}
+	token := extension.Extension.Config.Fields[tokenField].GetStringValue()
+	if token == "" {
+		return nil, errors.Errorf("secret %v has no %v", secret.Metadata.Ref(), tokenField)
+	}
+	// grpc metadata keys are lower case
+	header := strings.ToLower(creds.Header)
+	if header == "" {
+		header = defaultCredentialsHeader
+	}
+	return &tokenCredentials{header: header, value: creds.Prefix + token}, nil
+}
+
+func (t *tokenCredentials) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return map[string]string{t.header: t.value}, nil
+}
+
+func (t *tokenCredentials) RequireTransportSecurity() bool {
+	return true
+}
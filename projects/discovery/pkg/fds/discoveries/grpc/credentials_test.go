@@ -0,0 +1,125 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/gogo/protobuf/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins"
+	grpc_plugins "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/grpc"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/static"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+var _ = Describe("Credentials", func() {
+	var (
+		upstream *v1.Upstream
+		secrets  v1.SecretList
+	)
+
+	BeforeEach(func() {
+		upstream = &v1.Upstream{
+			Metadata: core.Metadata{Name: "grpc", Namespace: "gloo-system"},
+			UpstreamSpec: &v1.UpstreamSpec{
+				UpstreamType: &v1.UpstreamSpec_Static{
+					Static: &static.UpstreamSpec{
+						ServiceSpec: &plugins.ServiceSpec{
+							PluginType: &plugins.ServiceSpec_Grpc{
+								Grpc: &grpc_plugins.ServiceSpec{},
+							},
+						},
+					},
+				},
+			},
+		}
+		secrets = v1.SecretList{{
+			Metadata: core.Metadata{Name: "token", Namespace: "gloo-system"},
+			Kind: &v1.Secret_Extension{
+				Extension: &v1.Extension{
+					Config: &types.Struct{
+						Fields: map[string]*types.Value{
+							"token": {Kind: &types.Value_StringValue{StringValue: "abc"}},
+						},
+					},
+				},
+			},
+		}}
+	})
+
+	grpcSpec := func() *grpc_plugins.ServiceSpec {
+		return getgrpcspec(upstream)
+	}
+
+	It("dials insecurely without ssl config", func() {
+		opts, err := dialOptions(upstream, false, secrets)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(opts).To(HaveLen(1))
+	})
+
+	It("requires an ssl config to send reflection credentials", func() {
+		grpcSpec().ReflectionCredentials = &grpc_plugins.ServiceSpec_ReflectionCredentials{
+			SecretRef: &core.ResourceRef{Name: "token", Namespace: "gloo-system"},
+		}
+		_, err := dialOptions(upstream, false, secrets)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("uses tls and the reflection credentials with an ssl config", func() {
+		upstream.UpstreamSpec.SslConfig = &v1.UpstreamSslConfig{Sni: "grpc.example.com"}
+		grpcSpec().ReflectionCredentials = &grpc_plugins.ServiceSpec_ReflectionCredentials{
+			SecretRef: &core.ResourceRef{Name: "token", Namespace: "gloo-system"},
+		}
+		opts, err := dialOptions(upstream, false, secrets)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(opts).To(HaveLen(2))
+	})
+
+	It("errors when the tls secret is missing", func() {
+		upstream.UpstreamSpec.SslConfig = &v1.UpstreamSslConfig{
+			SslSecrets: &v1.UpstreamSslConfig_SecretRef{
+				SecretRef: &core.ResourceRef{Name: "missing", Namespace: "gloo-system"},
+			},
+		}
+		_, err := dialOptions(upstream, false, secrets)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("rejects certificates from sds", func() {
+		_, err := clientTlsConfig(&v1.UpstreamSslConfig{
+			SslSecrets: &v1.UpstreamSslConfig_Sds{Sds: &v1.SDSConfig{}},
+		}, secrets)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("sets the server name from the sni", func() {
+		cfg, err := clientTlsConfig(&v1.UpstreamSslConfig{Sni: "grpc.example.com"}, secrets)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cfg.ServerName).To(Equal("grpc.example.com"))
+	})
+
+	It("sends the token in the configured header", func() {
+		creds, err := newTokenCredentials(&grpc_plugins.ServiceSpec_ReflectionCredentials{
+			SecretRef: &core.ResourceRef{Name: "token", Namespace: "gloo-system"},
+			Header:    "X-Api-Key",
+			Prefix:    "Bearer ",
+		}, secrets)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(creds.RequireTransportSecurity()).To(BeTrue())
+		md, err := creds.GetRequestMetadata(context.Background())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(md).To(Equal(map[string]string{"x-api-key": "Bearer abc"}))
+	})
+
+	It("defaults to the authorization header", func() {
+		creds, err := newTokenCredentials(&grpc_plugins.ServiceSpec_ReflectionCredentials{
+			SecretRef: &core.ResourceRef{Name: "token", Namespace: "gloo-system"},
+		}, secrets)
+		Expect(err).NotTo(HaveOccurred())
+		md, err := creds.GetRequestMetadata(context.Background())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(md).To(HaveKeyWithValue("authorization", "abc"))
+	})
+})
@@ -53,23 +53,25 @@ type FunctionDiscoveryFactory struct {
 
 func (f *FunctionDiscoveryFactory) NewFunctionDiscovery(u *v1.Upstream) fds.UpstreamFunctionDiscovery {
 	return &UpstreamFunctionDiscovery{
-		upstream: u,
+		upstream:         u,
+		functionPollTime: fds.PollInterval(u, time.Minute),
 	}
 }
 
 type UpstreamFunctionDiscovery struct {
-	upstream *v1.Upstream
+	upstream         *v1.Upstream
+	functionPollTime time.Duration
 }
 
 func (f *UpstreamFunctionDiscovery) IsFunctional() bool {
 	return getgrpcspec(f.upstream) != nil
 }
 
-func (f *UpstreamFunctionDiscovery) DetectType(ctx context.Context, url *url.URL) (*plugins.ServiceSpec, error) {
+func (f *UpstreamFunctionDiscovery) DetectType(ctx context.Context, url *url.URL, dependencies func() fds.Dependencies) (*plugins.ServiceSpec, error) {
 	log := contextutils.LoggerFrom(ctx)
 	log.Debugf("attempting to detect GRPC for %s", f.upstream.Metadata.Name)
 
-	refClient, closeConn, err := getclient(ctx, url)
+	refClient, closeConn, err := f.getclient(ctx, url, dependencies().Secrets)
 	if err != nil {
 		return nil, err
 	}
@@ -89,12 +91,12 @@ func (f *UpstreamFunctionDiscovery) DetectType(ctx context.Context, url *url.URL
 	return svcInfo, nil
 }
 
-func (f *UpstreamFunctionDiscovery) DetectFunctions(ctx context.Context, url *url.URL, _ func() fds.Dependencies, updatecb func(fds.UpstreamMutator) error) error {
+func (f *UpstreamFunctionDiscovery) DetectFunctions(ctx context.Context, url *url.URL, dependencies func() fds.Dependencies, updatecb func(fds.UpstreamMutator) error) error {
 	for {
 		// TODO: get backoff values from config?
-		err := contextutils.NewExponentioalBackoff(contextutils.ExponentioalBackoff{}).Backoff(ctx, func(ctx context.Context) error {
-			return f.DetectFunctionsOnce(ctx, url, updatecb)
-		})
+		err := contextutils.NewExponentioalBackoff(contextutils.ExponentioalBackoff{}).Backoff(ctx, fds.RecordAttempts("grpc", func(ctx context.Context) error {
+			return f.DetectFunctionsOnce(ctx, url, dependencies().Secrets, updatecb)
+		}))
 
 		if err != nil {
 			if ctx.Err() != nil {
@@ -104,19 +106,18 @@ func (f *UpstreamFunctionDiscovery) DetectFunctions(ctx context.Context, url *ur
 		}
 
 		// sleep so we are not hogging
-		// TODO(yuval-k): customize time to sleep in config
-		if err := contextutils.Sleep(ctx, time.Minute); err != nil {
+		if err := fds.WaitForPoll(ctx, f.functionPollTime); err != nil {
 			return err
 		}
 	}
 }
 
-func (f *UpstreamFunctionDiscovery) DetectFunctionsOnce(ctx context.Context, url *url.URL, updatecb func(fds.UpstreamMutator) error) error {
+func (f *UpstreamFunctionDiscovery) DetectFunctionsOnce(ctx context.Context, url *url.URL, secrets v1.SecretList, updatecb func(fds.UpstreamMutator) error) error {
 	log := contextutils.LoggerFrom(ctx)
 
 	log.Infof("%v discovered as a gRPC service", url)
 
-	refClient, closeConn, err := getclient(ctx, url)
+	refClient, closeConn, err := f.getclient(ctx, url, secrets)
 	if err != nil {
 		return err
 	}
@@ -183,12 +184,16 @@ func (f *UpstreamFunctionDiscovery) DetectFunctionsOnce(ctx context.Context, url
 	})
 }
 
-func getclient(ctx context.Context, url *url.URL) (*grpcreflect.Client, func() error, error) {
-	var dialopts []grpc.DialOption
-	if url.Scheme != "https" {
-		dialopts = append(dialopts, grpc.WithInsecure())
+// the connection uses TLS if the upstream has an ssl config or if its url is secure
+func (f *UpstreamFunctionDiscovery) getclient(ctx context.Context, url *url.URL, secrets v1.SecretList) (*grpcreflect.Client, func() error, error) {
+	dialopts, err := dialOptions(f.upstream, url.Scheme == "https", secrets)
+	if err != nil {
+		return nil, nil, err
 	}
 
+	// the reflection streams are probes of the upstream
+	dialopts = append(dialopts, grpc.WithStreamInterceptor(waitForProbe))
+
 	cc, err := grpc.Dial(url.Host, dialopts...)
 	if err != nil {
 		return nil, nil, errors.Wrapf(err, "dialing grpc on %v", url.Host)
@@ -197,6 +202,13 @@ func getclient(ctx context.Context, url *url.URL) (*grpcreflect.Client, func() e
 	return refClient, cc.Close, nil
 }
 
+func waitForProbe(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	if err := fds.WaitForProbe(ctx); err != nil {
+		return nil, err
+	}
+	return streamer(ctx, desc, cc, method, opts...)
+}
+
 func getDepTree(root *desc.FileDescriptor) []*descriptor.FileDescriptorProto {
 	var deps []*descriptor.FileDescriptorProto
 	for _, dep := range root.GetDependencies() {
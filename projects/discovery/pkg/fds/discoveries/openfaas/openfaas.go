@@ -0,0 +1,177 @@
+package openfaas
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/solo-io/gloo/projects/discovery/pkg/fds"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins"
+	openfaas_plugins "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/openfaas"
+	"github.com/solo-io/go-utils/contextutils"
+)
+
+// the endpoint of the gateway that lists the deployed functions
+const functionsPath = "/system/functions"
+
+type OpenFaaSFunctionDiscoveryFactory struct {
+	DetectionTimeout time.Duration
+	FunctionPollTime time.Duration
+}
+
+func (f *OpenFaaSFunctionDiscoveryFactory) NewFunctionDiscovery(u *v1.Upstream) fds.UpstreamFunctionDiscovery {
+	return &OpenFaaSFunctionDiscovery{
+		detectionTimeout: f.DetectionTimeout,
+		functionPollTime: fds.PollInterval(u, f.FunctionPollTime),
+		upstream:         u,
+	}
+}
+
+type OpenFaaSFunctionDiscovery struct {
+	detectionTimeout time.Duration
+	functionPollTime time.Duration
+	upstream         *v1.Upstream
+}
+
+// a function as listed by the gateway
+type gatewayFunction struct {
+	Name string `json:"name"`
+}
+
+func getopenfaasspec(u *v1.Upstream) *openfaas_plugins.ServiceSpec {
+	spec, ok := u.UpstreamSpec.UpstreamType.(v1.ServiceSpecGetter)
+	if !ok {
+		return nil
+	}
+	serviceSpec := spec.GetServiceSpec()
+	if serviceSpec == nil {
+		return nil
+	}
+	openfaaswrapper, ok := serviceSpec.PluginType.(*plugins.ServiceSpec_Openfaas)
+	if !ok {
+		return nil
+	}
+	return openfaaswrapper.Openfaas
+}
+
+func (d *OpenFaaSFunctionDiscovery) IsFunctional() bool {
+	return getopenfaasspec(d.upstream) != nil
+}
+
+func (d *OpenFaaSFunctionDiscovery) DetectType(ctx context.Context, baseurl *url.URL, _ func() fds.Dependencies) (*plugins.ServiceSpec, error) {
+	var spec *plugins.ServiceSpec
+
+	err := contextutils.NewExponentioalBackoff(contextutils.ExponentioalBackoff{MaxDuration: &d.detectionTimeout}).Backoff(ctx, func(ctx context.Context) error {
+		if _, err := listFunctions(ctx, baseurl); err != nil {
+			return err
+		}
+		contextutils.LoggerFrom(ctx).Infof("openfaas gateway detected: %v", baseurl)
+		spec = &plugins.ServiceSpec{
+			PluginType: &plugins.ServiceSpec_Openfaas{
+				Openfaas: &openfaas_plugins.ServiceSpec{},
+			},
+		}
+		return nil
+	})
+
+	return spec, err
+}
+
+func (d *OpenFaaSFunctionDiscovery) DetectFunctions(ctx context.Context, baseurl *url.URL, _ func() fds.Dependencies, updatecb func(fds.UpstreamMutator) error) error {
+	for {
+		// TODO: get backoff values from config?
+		err := contextutils.NewExponentioalBackoff(contextutils.ExponentioalBackoff{}).Backoff(ctx, fds.RecordAttempts("openfaas", func(ctx context.Context) error {
+			return d.DetectFunctionsOnce(ctx, baseurl, updatecb)
+		}))
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			// ignore other errors as we would like to continue forever.
+			contextutils.LoggerFrom(ctx).Warnw("unable to discover openfaas functions", "upstream", d.upstream.Metadata.Name, "error", err)
+		}
+
+		// sleep so we are not hogging
+		if err := fds.WaitForPoll(ctx, d.functionPollTime); err != nil {
+			return err
+		}
+	}
+}
+
+func (d *OpenFaaSFunctionDiscovery) DetectFunctionsOnce(ctx context.Context, baseurl *url.URL, updatecb func(fds.UpstreamMutator) error) error {
+	gatewayFunctions, err := listFunctions(ctx, baseurl)
+	if err != nil {
+		return err
+	}
+
+	var functions []*openfaas_plugins.ServiceSpec_FunctionSpec
+	for _, fn := range gatewayFunctions {
+		functions = append(functions, &openfaas_plugins.ServiceSpec_FunctionSpec{
+			FunctionName: fn.Name,
+		})
+	}
+	// sort for idempotency
+	sort.Slice(functions, func(i, j int) bool {
+		return functions[i].FunctionName < functions[j].FunctionName
+	})
+
+	return updatecb(func(u *v1.Upstream) error {
+		upstreamSpec, ok := u.UpstreamSpec.UpstreamType.(v1.ServiceSpecMutator)
+		if !ok {
+			return errors.New("not a valid upstream")
+		}
+		spec := upstreamSpec.GetServiceSpec()
+		if spec == nil {
+			spec = &plugins.ServiceSpec{}
+		}
+		openfaasspec, ok := spec.PluginType.(*plugins.ServiceSpec_Openfaas)
+		if !ok || openfaasspec.Openfaas == nil {
+			openfaasspec = &plugins.ServiceSpec_Openfaas{
+				Openfaas: &openfaas_plugins.ServiceSpec{},
+			}
+		}
+
+		openfaasspec.Openfaas.Functions = functions
+		spec.PluginType = openfaasspec
+
+		upstreamSpec.SetServiceSpec(spec)
+		return nil
+	})
+}
+
+func listFunctions(ctx context.Context, baseurl *url.URL) ([]gatewayFunction, error) {
+	gatewayurl, err := fds.HttpBaseUrl(baseurl, "openfaas")
+	if err != nil {
+		return nil, err
+	}
+
+	functionsurl := gatewayurl.ResolveReference(&url.URL{Path: functionsPath}).String()
+	req, err := http.NewRequest("GET", functionsurl, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid url for request")
+	}
+	req.Header.Set("X-Gloo-Discovery", "OpenFaaS-Discovery")
+
+	res, err := fds.ProbeClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not perform HTTP GET on resolved addr: %v", functionsurl)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		body, _ := ioutil.ReadAll(res.Body)
+		return nil, errors.Errorf("openfaas gateway returned %v: %s", res.Status, body)
+	}
+
+	var functions []gatewayFunction
+	if err := json.NewDecoder(res.Body).Decode(&functions); err != nil {
+		return nil, errors.Wrapf(err, "%v is not an openfaas function list", functionsurl)
+	}
+	return functions, nil
+}
@@ -0,0 +1,192 @@
+package openwhisk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/solo-io/gloo/projects/discovery/pkg/fds"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins"
+	glooopenwhisk "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/openwhisk"
+	openwhiskplugin "github.com/solo-io/gloo/projects/gloo/pkg/plugins/openwhisk"
+	"github.com/solo-io/go-utils/contextutils"
+)
+
+const (
+	// maximum page size of the list actions api
+	listLimit = 200
+)
+
+type action struct {
+	Name string `json:"name"`
+	// the namespace of an action in a package is `<namespace>/<package>`
+	Namespace string `json:"namespace"`
+}
+
+type OpenWhiskActionDiscoveryFactory struct {
+	PollingTime time.Duration
+
+	// HTTPClient overrides the client used to call the OpenWhisk API
+	HTTPClient *http.Client
+}
+
+func (f *OpenWhiskActionDiscoveryFactory) NewFunctionDiscovery(u *v1.Upstream) fds.UpstreamFunctionDiscovery {
+	httpClient := f.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	return &OpenWhiskActionDiscovery{
+		timetowait: fds.PollInterval(u, f.PollingTime),
+		upstream:   u,
+		httpClient: httpClient,
+	}
+}
+
+type OpenWhiskActionDiscovery struct {
+	timetowait time.Duration
+	upstream   *v1.Upstream
+	httpClient *http.Client
+}
+
+func (f *OpenWhiskActionDiscovery) IsFunctional() bool {
+	_, ok := f.upstream.UpstreamSpec.UpstreamType.(*v1.UpstreamSpec_Openwhisk)
+	return ok
+}
+
+func (f *OpenWhiskActionDiscovery) DetectType(ctx context.Context, url *url.URL, _ func() fds.Dependencies) (*plugins.ServiceSpec, error) {
+	return nil, nil
+}
+
+func (f *OpenWhiskActionDiscovery) DetectFunctions(ctx context.Context, url *url.URL, dependencies func() fds.Dependencies, updatecb func(fds.UpstreamMutator) error) error {
+	for {
+		// TODO: get backoff values from config?
+		err := contextutils.NewExponentioalBackoff(contextutils.ExponentioalBackoff{}).Backoff(ctx, fds.RecordAttempts("openwhisk", func(ctx context.Context) error {
+			newactions, err := f.DetectFunctionsOnce(ctx, dependencies().Secrets)
+			if err != nil {
+				return err
+			}
+
+			// sort for idempotency
+			sort.Slice(newactions, func(i, j int) bool {
+				return newactions[i].LogicalName < newactions[j].LogicalName
+			})
+
+			err = updatecb(func(out *v1.Upstream) error {
+				if out == nil {
+					return errors.New("nil upstream")
+				}
+				if out.UpstreamSpec == nil {
+					return errors.New("nil upstream spec")
+				}
+				openwhiskspec, ok := out.UpstreamSpec.UpstreamType.(*v1.UpstreamSpec_Openwhisk)
+				if !ok {
+					return errors.New("not openwhisk upstream")
+				}
+				openwhiskspec.Openwhisk.Actions = newactions
+				return nil
+			})
+			if err != nil {
+				return errors.Wrap(err, "unable to update upstream")
+			}
+			return nil
+		}))
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			// ignore other errors as we would like to continue forever.
+			contextutils.LoggerFrom(ctx).Warnw("unable to discover openwhisk actions", "upstream", f.upstream.Metadata.Name, "error", err)
+		}
+
+		// sleep so we are not hogging
+		if err := fds.WaitForPoll(ctx, f.timetowait); err != nil {
+			return err
+		}
+	}
+}
+
+func (f *OpenWhiskActionDiscovery) DetectFunctionsOnce(ctx context.Context, secrets v1.SecretList) ([]*glooopenwhisk.ActionSpec, error) {
+	openwhiskspec, ok := f.upstream.UpstreamSpec.UpstreamType.(*v1.UpstreamSpec_Openwhisk)
+	if !ok {
+		return nil, errors.New("not an openwhisk upstream spec")
+	}
+	spec := openwhiskspec.Openwhisk
+
+	apiKey, err := openwhiskplugin.GetApiKey(secrets, spec.SecretRef)
+	if err != nil {
+		return nil, err
+	}
+
+	var actionSpecs []*glooopenwhisk.ActionSpec
+	for skip := 0; ; skip += listLimit {
+		page, err := f.listActions(ctx, spec, apiKey, skip)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to list openwhisk actions")
+		}
+		for _, a := range page {
+			actionSpecs = append(actionSpecs, actionSpec(a))
+		}
+		if len(page) < listLimit {
+			return actionSpecs, nil
+		}
+	}
+}
+
+// returns the spec of an action listed by the OpenWhisk api, including its package if any
+func actionSpec(a action) *glooopenwhisk.ActionSpec {
+	parts := strings.SplitN(a.Namespace, "/", 2)
+	if len(parts) < 2 {
+		return &glooopenwhisk.ActionSpec{
+			LogicalName: a.Name,
+			ActionName:  a.Name,
+		}
+	}
+	pkg := parts[1]
+	return &glooopenwhisk.ActionSpec{
+		LogicalName: pkg + "-" + a.Name,
+		ActionName:  pkg + "/" + a.Name,
+	}
+}
+
+func (f *OpenWhiskActionDiscovery) listActions(ctx context.Context, spec *glooopenwhisk.UpstreamSpec, apiKey string, skip int) ([]action, error) {
+	u := url.URL{
+		Scheme:   "https",
+		Host:     spec.ApiHost,
+		Path:     fmt.Sprintf("/api/v1/namespaces/%s/actions", openwhiskplugin.GetNamespace(spec)),
+		RawQuery: url.Values{"limit": {fmt.Sprint(listLimit)}, "skip": {fmt.Sprint(skip)}}.Encode(),
+	}
+	req, err := http.NewRequest(http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	user := strings.SplitN(apiKey, ":", 2)
+	req.SetBasicAuth(user[0], user[1])
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := f.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("openwhisk api returned %v: %s", resp.Status, body)
+	}
+
+	var actions []action
+	if err := json.Unmarshal(body, &actions); err != nil {
+		return nil, errors.Wrap(err, "invalid response from openwhisk api")
+	}
+	return actions, nil
+}
@@ -0,0 +1,13 @@
+package openwhisk
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestOpenwhisk(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Openwhisk Suite")
+}
@@ -0,0 +1,160 @@
+package openwhisk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/solo-io/gloo/projects/discovery/pkg/fds"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	glooopenwhisk "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/openwhisk"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+const (
+	user = "23bc46b1-71f6-4ed5-8c54-816aa4f8c502"
+	key  = "123zO3xZCLrMN6v2BKK1dXYFpXlPkccOFqm12CdAsMgRU4VrNZ9lyGVCGuMDGIwP"
+)
+
+var _ = Describe("OpenWhisk action discovery", func() {
+
+	var (
+		server    *httptest.Server
+		actions   []action
+		paths     []string
+		upstream  *v1.Upstream
+		secrets   v1.SecretList
+		discovery *OpenWhiskActionDiscovery
+	)
+
+	BeforeEach(func() {
+		actions = []action{
+			{Name: "hello", Namespace: "user@example.com_dev"},
+			{Name: "echo", Namespace: "user@example.com_dev/utils"},
+		}
+		paths = nil
+		server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if u, p, ok := r.BasicAuth(); !ok || u != user || p != key {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			paths = append(paths, r.URL.Path)
+			skip, _ := strconv.Atoi(r.URL.Query().Get("skip"))
+			limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+			page := []action{}
+			for i := skip; i < len(actions) && i < skip+limit; i++ {
+				page = append(page, actions[i])
+			}
+			json.NewEncoder(w).Encode(page)
+		}))
+
+		upstream = &v1.Upstream{
+			Metadata: core.Metadata{Name: "openwhisk", Namespace: "gloo-system"},
+			UpstreamSpec: &v1.UpstreamSpec{
+				UpstreamType: &v1.UpstreamSpec_Openwhisk{
+					Openwhisk: &glooopenwhisk.UpstreamSpec{
+						ApiHost:   strings.TrimPrefix(server.URL, "https://"),
+						Namespace: "user@example.com_dev",
+						SecretRef: core.ResourceRef{Name: "openwhisk", Namespace: "gloo-system"},
+					},
+				},
+			},
+		}
+		secrets = v1.SecretList{{
+			Metadata: core.Metadata{Name: "openwhisk", Namespace: "gloo-system"},
+			Kind: &v1.Secret_Openwhisk{
+				Openwhisk: &v1.OpenWhiskSecret{ApiKey: user + ":" + key},
+			},
+		}}
+
+		discovery = (&OpenWhiskActionDiscoveryFactory{
+			PollingTime: time.Hour,
+			HTTPClient:  server.Client(),
+		}).NewFunctionDiscovery(upstream).(*OpenWhiskActionDiscovery)
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("should only handle the openwhisk upstreams", func() {
+		Expect(discovery.IsFunctional()).To(BeTrue())
+		other := (&OpenWhiskActionDiscoveryFactory{}).NewFunctionDiscovery(&v1.Upstream{
+			UpstreamSpec: &v1.UpstreamSpec{UpstreamType: &v1.UpstreamSpec_Static{}},
+		})
+		Expect(other.IsFunctional()).To(BeFalse())
+	})
+
+	It("should list the actions of the namespace, including those in packages", func() {
+		specs, err := discovery.DetectFunctionsOnce(context.Background(), secrets)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(specs).To(Equal([]*glooopenwhisk.ActionSpec{
+			{LogicalName: "hello", ActionName: "hello"},
+			{LogicalName: "utils-echo", ActionName: "utils/echo"},
+		}))
+		Expect(paths).To(ConsistOf("/api/v1/namespaces/user@example.com_dev/actions"))
+	})
+
+	It("should page through the actions", func() {
+		actions = nil
+		for i := 0; i < listLimit+1; i++ {
+			actions = append(actions, action{Name: fmt.Sprintf("action-%d", i), Namespace: "_"})
+		}
+		specs, err := discovery.DetectFunctionsOnce(context.Background(), secrets)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(specs).To(HaveLen(listLimit + 1))
+		Expect(paths).To(HaveLen(2))
+	})
+
+	It("should error when the api rejects the key", func() {
+		secrets[0].Kind = &v1.Secret_Openwhisk{
+			Openwhisk: &v1.OpenWhiskSecret{ApiKey: user + ":wrong"},
+		}
+		_, err := discovery.DetectFunctionsOnce(context.Background(), secrets)
+		Expect(err).To(MatchError(ContainSubstring("401")))
+	})
+
+	It("should error without the secret", func() {
+		_, err := discovery.DetectFunctionsOnce(context.Background(), nil)
+		Expect(err).To(HaveOccurred())
+		Expect(paths).To(BeEmpty())
+	})
+
+	It("should set the sorted actions on the upstream", func() {
+		actions = append(actions, action{Name: "bye", Namespace: "user@example.com_dev"})
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		updated := make(chan *v1.Upstream, 1)
+		go discovery.DetectFunctions(ctx, nil, func() fds.Dependencies {
+			return fds.Dependencies{Secrets: secrets}
+		}, func(mutator fds.UpstreamMutator) error {
+			out := &v1.Upstream{UpstreamSpec: &v1.UpstreamSpec{
+				UpstreamType: &v1.UpstreamSpec_Openwhisk{Openwhisk: &glooopenwhisk.UpstreamSpec{}},
+			}}
+			if err := mutator(out); err != nil {
+				return err
+			}
+			select {
+			case updated <- out:
+			default:
+			}
+			return nil
+		})
+
+		var out *v1.Upstream
+		Eventually(updated).Should(Receive(&out))
+		var names []string
+		for _, spec := range out.UpstreamSpec.GetOpenwhisk().Actions {
+			names = append(names, spec.LogicalName)
+		}
+		Expect(names).To(Equal([]string{"bye", "hello", "utils-echo"}))
+	})
+})
@@ -0,0 +1,199 @@
+package soap
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"time"
+
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/pkg/errors"
+	"github.com/solo-io/gloo/projects/discovery/pkg/fds"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins"
+	rest_plugins "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/rest"
+	"github.com/solo-io/go-utils/contextutils"
+)
+
+// the wsdl is not read past this size
+const maxWsdlBytes = 10 << 20
+
+var commonWsdlUrls = []string{
+	"/?wsdl",
+	"/service?wsdl",
+	"/services?wsdl",
+	"/ws?wsdl",
+}
+
+type SoapFunctionDiscoveryFactory struct {
+	DetectionTimeout time.Duration
+	FunctionPollTime time.Duration
+	WsdlUrlsToTry    []string
+}
+
+func (f *SoapFunctionDiscoveryFactory) NewFunctionDiscovery(u *v1.Upstream) fds.UpstreamFunctionDiscovery {
+	return &SoapFunctionDiscovery{
+		detectionTimeout: f.DetectionTimeout,
+		functionPollTime: fds.PollInterval(u, f.FunctionPollTime),
+		urlsToTry:        append(f.WsdlUrlsToTry, commonWsdlUrls...),
+		upstream:         u,
+	}
+}
+
+type SoapFunctionDiscovery struct {
+	detectionTimeout time.Duration
+	functionPollTime time.Duration
+	urlsToTry        []string
+	upstream         *v1.Upstream
+}
+
+func getsoapspec(u *v1.Upstream) *rest_plugins.ServiceSpec_SoapInfo {
+	spec, ok := u.UpstreamSpec.UpstreamType.(v1.ServiceSpecGetter)
+	if !ok {
+		return nil
+	}
+	serviceSpec := spec.GetServiceSpec()
+	if serviceSpec == nil {
+		return nil
+	}
+	restwrapper, ok := serviceSpec.PluginType.(*plugins.ServiceSpec_Rest)
+	if !ok {
+		return nil
+	}
+	return restwrapper.Rest.SoapInfo
+}
+
+func (d *SoapFunctionDiscovery) IsFunctional() bool {
+	return getsoapspec(d.upstream) != nil
+}
+
+func (d *SoapFunctionDiscovery) DetectType(ctx context.Context, baseurl *url.URL, _ func() fds.Dependencies) (*plugins.ServiceSpec, error) {
+	var spec *plugins.ServiceSpec
+
+	err := contextutils.NewExponentioalBackoff(contextutils.ExponentioalBackoff{MaxDuration: &d.detectionTimeout}).Backoff(ctx, func(ctx context.Context) error {
+		var err error
+		spec, err = d.detectUpstreamTypeOnce(ctx, baseurl)
+		return err
+	})
+
+	return spec, err
+}
+
+func (d *SoapFunctionDiscovery) detectUpstreamTypeOnce(ctx context.Context, baseurl *url.URL) (*plugins.ServiceSpec, error) {
+	var errs error
+	for _, wsdlUrl := range d.urlsToTry {
+		if _, err := fetchWsdl(ctx, baseurl, wsdlUrl); err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			errs = multierror.Append(errs, err)
+			continue
+		}
+		contextutils.LoggerFrom(ctx).Infof("soap upstream detected: %v%v", baseurl, wsdlUrl)
+		return &plugins.ServiceSpec{
+			PluginType: &plugins.ServiceSpec_Rest{
+				Rest: &rest_plugins.ServiceSpec{
+					SoapInfo: &rest_plugins.ServiceSpec_SoapInfo{
+						WsdlUrl: wsdlUrl,
+					},
+				},
+			},
+		}, nil
+	}
+	return nil, errors.Wrapf(errs, "service at %s does not serve a wsdl at a known url, "+
+		"or was unreachable", baseurl.String())
+}
+
+func (d *SoapFunctionDiscovery) DetectFunctions(ctx context.Context, baseurl *url.URL, _ func() fds.Dependencies, updatecb func(fds.UpstreamMutator) error) error {
+	spec := getsoapspec(d.upstream)
+	if spec == nil || spec.WsdlUrl == "" {
+		return errors.New("upstream doesn't have a wsdl url")
+	}
+	for {
+		err := contextutils.NewExponentioalBackoff(contextutils.ExponentioalBackoff{}).Backoff(ctx, fds.RecordAttempts("soap", func(ctx context.Context) error {
+			return d.DetectFunctionsOnce(ctx, baseurl, spec, updatecb)
+		}))
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			// ignore other errors as we would like to continue forever.
+			contextutils.LoggerFrom(ctx).Warnw("unable to discover soap operations", "upstream", d.upstream.Metadata.Name, "error", err)
+		}
+
+		if err := fds.WaitForPoll(ctx, d.functionPollTime); err != nil {
+			return err
+		}
+	}
+}
+
+func (d *SoapFunctionDiscovery) DetectFunctionsOnce(ctx context.Context, baseurl *url.URL, spec *rest_plugins.ServiceSpec_SoapInfo, updatecb func(fds.UpstreamMutator) error) error {
+	defs, err := fetchWsdl(ctx, baseurl, spec.WsdlUrl)
+	if err != nil {
+		return err
+	}
+	funcs := Functions(defs)
+
+	return updatecb(func(u *v1.Upstream) error {
+		upstreamSpec, ok := u.UpstreamSpec.UpstreamType.(v1.ServiceSpecMutator)
+		if !ok {
+			return errors.New("not a valid upstream")
+		}
+		spec := upstreamSpec.GetServiceSpec()
+		if spec == nil {
+			spec = &plugins.ServiceSpec{}
+		}
+		restspec, ok := spec.PluginType.(*plugins.ServiceSpec_Rest)
+		if !ok || restspec.Rest == nil {
+			restspec = &plugins.ServiceSpec_Rest{
+				Rest: &rest_plugins.ServiceSpec{},
+			}
+		}
+
+		restspec.Rest.Transformations = funcs
+		spec.PluginType = restspec
+
+		upstreamSpec.SetServiceSpec(spec)
+		return nil
+	})
+}
+
+// gets the wsdl, the url is resolved against the address of the upstream
+func fetchWsdl(ctx context.Context, baseurl *url.URL, wsdlUrl string) (*Definitions, error) {
+	endpoint, err := fds.HttpBaseUrl(baseurl, "soap")
+	if err != nil {
+		return nil, err
+	}
+	ref, err := url.Parse(wsdlUrl)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid wsdl url %v", wsdlUrl)
+	}
+	endpointurl := endpoint.ResolveReference(ref).String()
+
+	req, err := http.NewRequest("GET", endpointurl, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid url for request")
+	}
+	req.Header.Set("X-Gloo-Discovery", "SOAP-Discovery")
+
+	res, err := fds.ProbeClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not perform HTTP GET on resolved addr: %v", endpointurl)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("%v returned %v", endpointurl, res.Status)
+	}
+	body, err := ioutil.ReadAll(&io.LimitedReader{R: res.Body, N: maxWsdlBytes})
+	if err != nil {
+		return nil, errors.Wrapf(err, "reading %v", endpointurl)
+	}
+	defs, err := ParseWsdl(body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "%v", endpointurl)
+	}
+	return defs, nil
+}
@@ -0,0 +1,13 @@
+package soap
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestSoap(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Soap Suite")
+}
@@ -0,0 +1,329 @@
+package soap
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+	transformation_plugins "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/transformation"
+)
+
+const (
+	wsdlNamespace   = "http://schemas.xmlsoap.org/wsdl/"
+	soap11Namespace = "http://schemas.xmlsoap.org/wsdl/soap/"
+	soap12Namespace = "http://schemas.xmlsoap.org/wsdl/soap12/"
+
+	soap11Envelope = "http://schemas.xmlsoap.org/soap/envelope/"
+	soap12Envelope = "http://www.w3.org/2003/05/soap-envelope"
+
+	styleRpc = "rpc"
+)
+
+// the elements are matched by local name, the wsdl and soap namespaces are checked where they matter
+type Definitions struct {
+	XMLName         xml.Name   `xml:"definitions"`
+	TargetNamespace string     `xml:"targetNamespace,attr"`
+	Schemas         []schema   `xml:"types>schema"`
+	Messages        []message  `xml:"message"`
+	PortTypes       []portType `xml:"portType"`
+	Bindings        []binding  `xml:"binding"`
+	Services        []service  `xml:"service"`
+}
+
+type schema struct {
+	TargetNamespace    string        `xml:"targetNamespace,attr"`
+	ElementFormDefault string        `xml:"elementFormDefault,attr"`
+	Elements           []element     `xml:"element"`
+	ComplexTypes       []complexType `xml:"complexType"`
+}
+
+type element struct {
+	Name        string       `xml:"name,attr"`
+	Type        string       `xml:"type,attr"`
+	ComplexType *complexType `xml:"complexType"`
+}
+
+type complexType struct {
+	Name     string    `xml:"name,attr"`
+	Sequence []element `xml:"sequence>element"`
+	All      []element `xml:"all>element"`
+}
+
+type message struct {
+	Name  string `xml:"name,attr"`
+	Parts []part `xml:"part"`
+}
+
+type part struct {
+	Name    string `xml:"name,attr"`
+	Element string `xml:"element,attr"`
+	Type    string `xml:"type,attr"`
+}
+
+type portType struct {
+	Name       string `xml:"name,attr"`
+	Operations []struct {
+		Name  string `xml:"name,attr"`
+		Input struct {
+			Message string `xml:"message,attr"`
+		} `xml:"input"`
+	} `xml:"operation"`
+}
+
+type binding struct {
+	Name string `xml:"name,attr"`
+	Type string `xml:"type,attr"`
+	Soap struct {
+		XMLName xml.Name
+		Style   string `xml:"style,attr"`
+	} `xml:"binding"`
+	Operations []bindingOperation `xml:"operation"`
+}
+
+type bindingOperation struct {
+	Name string `xml:"name,attr"`
+	Soap struct {
+		SoapAction string `xml:"soapAction,attr"`
+		Style      string `xml:"style,attr"`
+	} `xml:"operation"`
+	Input struct {
+		Body struct {
+			Namespace string `xml:"namespace,attr"`
+		} `xml:"body"`
+	} `xml:"input"`
+}
+
+type service struct {
+	Name  string `xml:"name,attr"`
+	Ports []struct {
+		Name    string `xml:"name,attr"`
+		Binding string `xml:"binding,attr"`
+		Address struct {
+			XMLName  xml.Name
+			Location string `xml:"location,attr"`
+		} `xml:"address"`
+	} `xml:"port"`
+}
+
+// ParseWsdl returns the definitions of a WSDL 1.1 document
+func ParseWsdl(data []byte) (*Definitions, error) {
+	var defs Definitions
+	if err := xml.Unmarshal(data, &defs); err != nil {
+		return nil, errors.Wrap(err, "not a wsdl document")
+	}
+	if defs.XMLName.Space != wsdlNamespace {
+		return nil, errors.Errorf("not a wsdl 1.1 document, its namespace is %v", defs.XMLName.Space)
+	}
+	return &defs, nil
+}
+
+// Functions returns a transformation for each operation of the SOAP ports of the services, named after the operation.
+// When an operation is available on several ports, the first one is used. The transformations post the SOAP envelope
+// to the path of the address of the port, with the parameters of the operation taken from the parameters of the same name.
+func Functions(defs *Definitions) map[string]*transformation_plugins.TransformationTemplate {
+	funcs := make(map[string]*transformation_plugins.TransformationTemplate)
+	for _, svc := range defs.Services {
+		for _, port := range svc.Ports {
+			soap12 := port.Address.XMLName.Space == soap12Namespace
+			if !soap12 && port.Address.XMLName.Space != soap11Namespace {
+				// http bindings are not soap
+				continue
+			}
+			bind := defs.binding(localName(port.Binding))
+			if bind == nil {
+				continue
+			}
+			pt := defs.portType(localName(bind.Type))
+			if pt == nil {
+				continue
+			}
+			path := addressPath(port.Address.Location)
+			for _, op := range bind.Operations {
+				if _, ok := funcs[op.Name]; ok {
+					continue
+				}
+				for _, ptOp := range pt.Operations {
+					if ptOp.Name != op.Name {
+						continue
+					}
+					style := op.Soap.Style
+					if style == "" {
+						style = bind.Soap.Style
+					}
+					var body string
+					if style == styleRpc {
+						body = defs.rpcBody(op, defs.message(localName(ptOp.Input.Message)))
+					} else {
+						body = defs.documentBody(defs.message(localName(ptOp.Input.Message)))
+					}
+					funcs[op.Name] = functionForOperation(path, op.Soap.SoapAction, soap12, body)
+				}
+			}
+		}
+	}
+	return funcs
+}
+
+func functionForOperation(path, soapAction string, soap12 bool, body string) *transformation_plugins.TransformationTemplate {
+	headers := map[string]*transformation_plugins.InjaTemplate{
+		":method": {Text: "POST"},
+		":path":   {Text: path},
+	}
+	envelope := soap11Envelope
+	if soap12 {
+		envelope = soap12Envelope
+		contentType := "application/soap+xml; charset=utf-8"
+		if soapAction != "" {
+			contentType += fmt.Sprintf(`; action="%v"`, soapAction)
+		}
+		headers["content-type"] = &transformation_plugins.InjaTemplate{Text: contentType}
+	} else {
+		headers["content-type"] = &transformation_plugins.InjaTemplate{Text: "text/xml; charset=utf-8"}
+		headers["soapaction"] = &transformation_plugins.InjaTemplate{Text: `"` + soapAction + `"`}
+	}
+	return &transformation_plugins.TransformationTemplate{
+		Headers: headers,
+		BodyTransformation: &transformation_plugins.TransformationTemplate_Body{
+			Body: &transformation_plugins.InjaTemplate{
+				Text: `<?xml version="1.0" encoding="utf-8"?>` +
+					`<soap:Envelope xmlns:soap="` + envelope + `"><soap:Body>` + body + `</soap:Body></soap:Envelope>`,
+			},
+		},
+	}
+}
+
+// document style: each part is an element of the schemas, usually wrapping the parameters of the operation
+func (d *Definitions) documentBody(msg *message) string {
+	if msg == nil {
+		return ""
+	}
+	var body bytes.Buffer
+	for _, p := range msg.Parts {
+		if p.Element == "" {
+			continue
+		}
+		s, el := d.element(localName(p.Element))
+		if el == nil {
+			continue
+		}
+		fmt.Fprintf(&body, `<tns:%v xmlns:tns="%v">`, el.Name, s.TargetNamespace)
+		children := d.children(el)
+		if len(children) == 0 {
+			body.WriteString(parameterTemplate(p.Name))
+		}
+		prefix := ""
+		if s.ElementFormDefault == "qualified" {
+			prefix = "tns:"
+		}
+		for _, child := range children {
+			fmt.Fprintf(&body, "<%v%v>%v</%v%v>", prefix, child.Name, parameterTemplate(child.Name), prefix, child.Name)
+		}
+		fmt.Fprintf(&body, "</tns:%v>", el.Name)
+	}
+	return body.String()
+}
+
+// rpc style: the parts are the parameters, wrapped in an element named after the operation
+func (d *Definitions) rpcBody(op bindingOperation, msg *message) string {
+	namespace := op.Input.Body.Namespace
+	if namespace == "" {
+		namespace = d.TargetNamespace
+	}
+	var body bytes.Buffer
+	fmt.Fprintf(&body, `<tns:%v xmlns:tns="%v">`, op.Name, namespace)
+	if msg != nil {
+		for _, p := range msg.Parts {
+			fmt.Fprintf(&body, "<%v>%v</%v>", p.Name, parameterTemplate(p.Name), p.Name)
+		}
+	}
+	fmt.Fprintf(&body, "</tns:%v>", op.Name)
+	return body.String()
+}
+
+// the elements of the inline or named complex type of the element
+func (d *Definitions) children(el *element) []element {
+	ct := el.ComplexType
+	if ct == nil && el.Type != "" {
+		ct = d.complexType(localName(el.Type))
+	}
+	if ct == nil {
+		return nil
+	}
+	return append(append([]element(nil), ct.Sequence...), ct.All...)
+}
+
+var identifier = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// parameters that are not valid template identifiers are left empty
+func parameterTemplate(name string) string {
+	if !identifier.MatchString(name) {
+		return ""
+	}
+	return fmt.Sprintf(`{{ default(%v, "") }}`, name)
+}
+
+// the path and query of the address, the upstream is the host
+func addressPath(location string) string {
+	u, err := url.Parse(location)
+	if err != nil || u.Path == "" {
+		return "/"
+	}
+	return u.RequestURI()
+}
+
+func localName(qname string) string {
+	return qname[strings.LastIndex(qname, ":")+1:]
+}
+
+func (d *Definitions) binding(name string) *binding {
+	for i := range d.Bindings {
+		if d.Bindings[i].Name == name {
+			return &d.Bindings[i]
+		}
+	}
+	return nil
+}
+
+func (d *Definitions) portType(name string) *portType {
+	for i := range d.PortTypes {
+		if d.PortTypes[i].Name == name {
+			return &d.PortTypes[i]
+		}
+	}
+	return nil
+}
+
+func (d *Definitions) message(name string) *message {
+	for i := range d.Messages {
+		if d.Messages[i].Name == name {
+			return &d.Messages[i]
+		}
+	}
+	return nil
+}
+
+func (d *Definitions) element(name string) (*schema, *element) {
+	for i := range d.Schemas {
+		for j := range d.Schemas[i].Elements {
+			if d.Schemas[i].Elements[j].Name == name {
+				return &d.Schemas[i], &d.Schemas[i].Elements[j]
+			}
+		}
+	}
+	return nil, nil
+}
+
+func (d *Definitions) complexType(name string) *complexType {
+	for i := range d.Schemas {
+		for j := range d.Schemas[i].ComplexTypes {
+			if d.Schemas[i].ComplexTypes[j].Name == name {
+				return &d.Schemas[i].ComplexTypes[j]
+			}
+		}
+	}
+	return nil
+}
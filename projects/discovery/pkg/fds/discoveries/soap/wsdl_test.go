@@ -0,0 +1,145 @@
+package soap
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+const stockQuoteWsdl = `<?xml version="1.0"?>
+<definitions name="StockQuote"
+    targetNamespace="http://example.com/stockquote.wsdl"
+    xmlns:tns="http://example.com/stockquote.wsdl"
+    xmlns:xsd1="http://example.com/stockquote.xsd"
+    xmlns:xs="http://www.w3.org/2001/XMLSchema"
+    xmlns:soap="http://schemas.xmlsoap.org/wsdl/soap/"
+    xmlns:soap12="http://schemas.xmlsoap.org/wsdl/soap12/"
+    xmlns="http://schemas.xmlsoap.org/wsdl/">
+  <types>
+    <xs:schema targetNamespace="http://example.com/stockquote.xsd" elementFormDefault="qualified">
+      <xs:element name="GetLastTradePrice">
+        <xs:complexType>
+          <xs:sequence>
+            <xs:element name="tickerSymbol" type="xs:string"/>
+            <xs:element name="currency" type="xs:string"/>
+          </xs:sequence>
+        </xs:complexType>
+      </xs:element>
+    </xs:schema>
+  </types>
+  <message name="GetLastTradePriceInput">
+    <part name="body" element="xsd1:GetLastTradePrice"/>
+  </message>
+  <message name="GetHistoryInput">
+    <part name="tickerSymbol" type="xs:string"/>
+    <part name="days" type="xs:int"/>
+  </message>
+  <portType name="StockQuotePortType">
+    <operation name="GetLastTradePrice">
+      <input message="tns:GetLastTradePriceInput"/>
+    </operation>
+    <operation name="GetHistory">
+      <input message="tns:GetHistoryInput"/>
+    </operation>
+  </portType>
+  <binding name="StockQuoteSoapBinding" type="tns:StockQuotePortType">
+    <soap:binding style="document" transport="http://schemas.xmlsoap.org/soap/http"/>
+    <operation name="GetLastTradePrice">
+      <soap:operation soapAction="http://example.com/GetLastTradePrice"/>
+      <input><soap:body use="literal"/></input>
+    </operation>
+    <operation name="GetHistory">
+      <soap:operation soapAction="http://example.com/GetHistory" style="rpc"/>
+      <input><soap:body use="literal" namespace="http://example.com/history"/></input>
+    </operation>
+  </binding>
+  <binding name="StockQuoteSoap12Binding" type="tns:StockQuotePortType">
+    <soap12:binding style="document" transport="http://schemas.xmlsoap.org/soap/http"/>
+    <operation name="GetLastTradePrice">
+      <soap12:operation soapAction="http://example.com/GetLastTradePrice"/>
+      <input><soap12:body use="literal"/></input>
+    </operation>
+  </binding>
+  <service name="StockQuoteService">
+    <port name="StockQuoteSoap12Port" binding="tns:StockQuoteSoap12Binding">
+      <soap12:address location="http://legacy.example.com/stockquote12"/>
+    </port>
+    <port name="StockQuotePort" binding="tns:StockQuoteSoapBinding">
+      <soap:address location="http://legacy.example.com/stockquote"/>
+    </port>
+  </service>
+</definitions>`
+
+var _ = Describe("Wsdl", func() {
+
+	var defs *Definitions
+
+	BeforeEach(func() {
+		var err error
+		defs, err = ParseWsdl([]byte(stockQuoteWsdl))
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("rejects documents that are not wsdl", func() {
+		_, err := ParseWsdl([]byte(`<html><body>not found</body></html>`))
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("creates a function for each operation, from the first port", func() {
+		funcs := Functions(defs)
+		Expect(funcs).To(HaveLen(2))
+
+		price := funcs["GetLastTradePrice"]
+		Expect(price.Headers[":method"].Text).To(Equal("POST"))
+		Expect(price.Headers[":path"].Text).To(Equal("/stockquote12"))
+		Expect(price.Headers["content-type"].Text).To(Equal(`application/soap+xml; charset=utf-8; action="http://example.com/GetLastTradePrice"`))
+
+		history := funcs["GetHistory"]
+		Expect(history.Headers[":path"].Text).To(Equal("/stockquote"))
+		Expect(history.Headers["content-type"].Text).To(Equal("text/xml; charset=utf-8"))
+		Expect(history.Headers["soapaction"].Text).To(Equal(`"http://example.com/GetHistory"`))
+	})
+
+	It("wraps the parameters of document operations in their element", func() {
+		body := Functions(defs)["GetLastTradePrice"].GetBody().Text
+		Expect(body).To(Equal(`<?xml version="1.0" encoding="utf-8"?>` +
+			`<soap:Envelope xmlns:soap="http://www.w3.org/2003/05/soap-envelope"><soap:Body>` +
+			`<tns:GetLastTradePrice xmlns:tns="http://example.com/stockquote.xsd">` +
+			`<tns:tickerSymbol>{{ default(tickerSymbol, "") }}</tns:tickerSymbol>` +
+			`<tns:currency>{{ default(currency, "") }}</tns:currency>` +
+			`</tns:GetLastTradePrice></soap:Body></soap:Envelope>`))
+	})
+
+	It("wraps the parts of rpc operations in an element named after the operation", func() {
+		body := Functions(defs)["GetHistory"].GetBody().Text
+		Expect(body).To(ContainSubstring(`<soap:Envelope xmlns:soap="http://schemas.xmlsoap.org/soap/envelope/">`))
+		Expect(body).To(ContainSubstring(`<tns:GetHistory xmlns:tns="http://example.com/history">` +
+			`<tickerSymbol>{{ default(tickerSymbol, "") }}</tickerSymbol>` +
+			`<days>{{ default(days, "") }}</days>` +
+			`</tns:GetHistory>`))
+	})
+
+	It("fetches the wsdl of the upstream", func() {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/service" || r.URL.RawQuery != "wsdl" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.Write([]byte(stockQuoteWsdl))
+		}))
+		defer server.Close()
+		baseurl, err := url.Parse(server.URL)
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = fetchWsdl(context.Background(), baseurl, "/?wsdl")
+		Expect(err).To(HaveOccurred())
+
+		fetched, err := fetchWsdl(context.Background(), baseurl, "/service?wsdl")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(Functions(fetched)).To(HaveLen(2))
+	})
+})
@@ -0,0 +1,198 @@
+package swagger
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/url"
+	"strings"
+
+	"github.com/go-openapi/spec"
+	"github.com/pkg/errors"
+)
+
+// the subset of an OpenAPI 3.x document used to discover functions
+type openApi3Doc struct {
+	OpenAPI    string                      `json:"openapi"`
+	Servers    []openApi3Server            `json:"servers"`
+	Paths      map[string]openApi3PathItem `json:"paths"`
+	Components struct {
+		Schemas       map[string]spec.Schema         `json:"schemas"`
+		Parameters    map[string]openApi3Parameter   `json:"parameters"`
+		RequestBodies map[string]openApi3RequestBody `json:"requestBodies"`
+	} `json:"components"`
+}
+
+type openApi3Server struct {
+	URL       string `json:"url"`
+	Variables map[string]struct {
+		Default string `json:"default"`
+	} `json:"variables"`
+}
+
+type openApi3PathItem struct {
+	Parameters []openApi3Parameter `json:"parameters"`
+	Get        *openApi3Operation  `json:"get"`
+	Put        *openApi3Operation  `json:"put"`
+	Post       *openApi3Operation  `json:"post"`
+	Delete     *openApi3Operation  `json:"delete"`
+	Options    *openApi3Operation  `json:"options"`
+	Head       *openApi3Operation  `json:"head"`
+	Patch      *openApi3Operation  `json:"patch"`
+}
+
+type openApi3Operation struct {
+	OperationID string               `json:"operationId"`
+	Parameters  []openApi3Parameter  `json:"parameters"`
+	RequestBody *openApi3RequestBody `json:"requestBody"`
+}
+
+type openApi3Parameter struct {
+	Ref      string `json:"$ref"`
+	Name     string `json:"name"`
+	In       string `json:"in"`
+	Required bool   `json:"required"`
+}
+
+type openApi3RequestBody struct {
+	Ref     string `json:"$ref"`
+	Content map[string]struct {
+		Schema *spec.Schema `json:"schema"`
+	} `json:"content"`
+}
+
+const (
+	openApi3SchemasPrefix       = "#/components/schemas/"
+	openApi3ParametersPrefix    = "#/components/parameters/"
+	openApi3RequestBodiesPrefix = "#/components/requestBodies/"
+	swaggerDefinitionsPrefix    = "#/definitions/"
+)
+
+// convertOpenApi3Doc converts an OpenAPI 3.x document to the swagger 2.0 document describing the same functions:
+// the path of the first server is the base path, the component schemas are the definitions, and the json request
+// bodies are body parameters.
+func convertOpenApi3Doc(jsn []byte) (*spec.Swagger, error) {
+	// the schemas are definitions in swagger 2.0
+	jsn = bytes.Replace(jsn, []byte(`"`+openApi3SchemasPrefix), []byte(`"`+swaggerDefinitionsPrefix), -1)
+	var doc openApi3Doc
+	if err := json.Unmarshal(jsn, &doc); err != nil {
+		return nil, errors.Wrap(err, "invalid openapi doc")
+	}
+
+	swaggerSpec := &spec.Swagger{}
+	swaggerSpec.Swagger = "2.0"
+	swaggerSpec.BasePath = openApi3BasePath(doc.Servers)
+	swaggerSpec.Definitions = doc.Components.Schemas
+	swaggerSpec.Paths = &spec.Paths{Paths: make(map[string]spec.PathItem)}
+
+	for path, item := range doc.Paths {
+		var swaggerItem spec.PathItem
+		convertOperation := func(operation *openApi3Operation) (*spec.Operation, error) {
+			if operation == nil {
+				return nil, nil
+			}
+			return doc.convertOperation(path, item.Parameters, operation)
+		}
+		var err error
+		for _, op := range []struct {
+			in  *openApi3Operation
+			out **spec.Operation
+		}{
+			{item.Get, &swaggerItem.Get},
+			{item.Put, &swaggerItem.Put},
+			{item.Post, &swaggerItem.Post},
+			{item.Delete, &swaggerItem.Delete},
+			{item.Options, &swaggerItem.Options},
+			{item.Head, &swaggerItem.Head},
+			{item.Patch, &swaggerItem.Patch},
+		} {
+			if *op.out, err = convertOperation(op.in); err != nil {
+				return nil, err
+			}
+		}
+		swaggerSpec.Paths.Paths[path] = swaggerItem
+	}
+	return swaggerSpec, nil
+}
+
+func (doc *openApi3Doc) convertOperation(path string, pathParameters []openApi3Parameter, operation *openApi3Operation) (*spec.Operation, error) {
+	swaggerOperation := &spec.Operation{}
+	swaggerOperation.ID = operation.OperationID
+
+	// the parameters of the operation override the ones of its path
+	parameters := make(map[string]openApi3Parameter)
+	var keys []string
+	for _, param := range append(append([]openApi3Parameter{}, pathParameters...), operation.Parameters...) {
+		resolved, err := doc.resolveParameter(param)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid parameter of path %v", path)
+		}
+		key := resolved.In + "/" + resolved.Name
+		if _, ok := parameters[key]; !ok {
+			keys = append(keys, key)
+		}
+		parameters[key] = resolved
+	}
+	for _, key := range keys {
+		param := parameters[key]
+		if param.In == "cookie" {
+			// cookies cannot be templated
+			continue
+		}
+		swaggerOperation.Parameters = append(swaggerOperation.Parameters, spec.Parameter{
+			ParamProps: spec.ParamProps{Name: param.Name, In: param.In, Required: param.Required},
+		})
+	}
+
+	if operation.RequestBody != nil {
+		body, err := doc.resolveRequestBody(*operation.RequestBody)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid request body of path %v", path)
+		}
+		// only json bodies are templated
+		if content, ok := body.Content["application/json"]; ok && content.Schema != nil {
+			swaggerOperation.Parameters = append(swaggerOperation.Parameters, spec.Parameter{
+				ParamProps: spec.ParamProps{Name: "body", In: "body", Schema: content.Schema},
+			})
+		}
+	}
+	return swaggerOperation, nil
+}
+
+func (doc *openApi3Doc) resolveParameter(param openApi3Parameter) (openApi3Parameter, error) {
+	if param.Ref == "" {
+		return param, nil
+	}
+	resolved, ok := doc.Components.Parameters[strings.TrimPrefix(param.Ref, openApi3ParametersPrefix)]
+	if !ok {
+		return param, errors.Errorf("parameter %v not found", param.Ref)
+	}
+	return resolved, nil
+}
+
+func (doc *openApi3Doc) resolveRequestBody(body openApi3RequestBody) (openApi3RequestBody, error) {
+	if body.Ref == "" {
+		return body, nil
+	}
+	resolved, ok := doc.Components.RequestBodies[strings.TrimPrefix(body.Ref, openApi3RequestBodiesPrefix)]
+	if !ok {
+		return body, errors.Errorf("request body %v not found", body.Ref)
+	}
+	return resolved, nil
+}
+
+// returns the path of the url of the first server, with the default values of its variables
+func openApi3BasePath(servers []openApi3Server) string {
+	if len(servers) == 0 {
+		return ""
+	}
+	server := servers[0]
+	serverUrl := server.URL
+	for name, variable := range server.Variables {
+		serverUrl = strings.Replace(serverUrl, "{"+name+"}", variable.Default, -1)
+	}
+	parsed, err := url.Parse(serverUrl)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSuffix(parsed.Path, "/")
+}
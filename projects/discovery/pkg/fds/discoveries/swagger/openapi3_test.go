@@ -0,0 +1,92 @@
+package swagger
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	transformation_plugins "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/transformation"
+)
+
+const petstoreOpenApi3 = `
+openapi: 3.0.0
+servers:
+- url: https://{host}/{basePath}/
+  variables:
+    host:
+      default: petstore.example.com
+    basePath:
+      default: v1
+paths:
+  /pets/{petId}:
+    parameters:
+    - name: petId
+      in: path
+      required: true
+    get:
+      operationId: getPet
+      parameters:
+      - $ref: '#/components/parameters/verbose'
+      - name: session
+        in: cookie
+    put:
+      operationId: updatePet
+      requestBody:
+        $ref: '#/components/requestBodies/Pet'
+components:
+  parameters:
+    verbose:
+      name: verbose
+      in: query
+  requestBodies:
+    Pet:
+      content:
+        application/json:
+          schema:
+            $ref: '#/components/schemas/Pet'
+  schemas:
+    Pet:
+      type: object
+      properties:
+        name:
+          type: string
+        age:
+          type: integer
+`
+
+var _ = Describe("OpenAPI 3", func() {
+	It("converts the document to swagger 2.0", func() {
+		swaggerSpec, err := parseSwaggerDoc([]byte(petstoreOpenApi3))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(swaggerSpec.BasePath).To(Equal("/v1"))
+		Expect(swaggerSpec.Definitions).To(HaveKey("Pet"))
+
+		item := swaggerSpec.Paths.Paths["/pets/{petId}"]
+		Expect(item.Get).NotTo(BeNil())
+		Expect(item.Get.ID).To(Equal("getPet"))
+		var params []string
+		for _, param := range item.Get.Parameters {
+			params = append(params, param.In+"/"+param.Name)
+		}
+		Expect(params).To(Equal([]string{"path/petId", "query/verbose"}))
+
+		Expect(item.Put).NotTo(BeNil())
+		Expect(item.Put.Parameters).To(HaveLen(2))
+		body := item.Put.Parameters[1]
+		Expect(body.In).To(Equal("body"))
+		Expect(body.Schema.Ref.String()).To(Equal("#/definitions/Pet"))
+	})
+
+	It("creates the functions of the operations", func() {
+		swaggerSpec, err := parseSwaggerDoc([]byte(petstoreOpenApi3))
+		Expect(err).NotTo(HaveOccurred())
+
+		funcs := make(map[string]*transformation_plugins.TransformationTemplate)
+		for path, item := range swaggerSpec.Paths.Paths {
+			createFunctionsForPath(funcs, swaggerSpec.BasePath, path, item.PathItemProps, swaggerSpec.Definitions)
+		}
+		Expect(funcs).To(HaveKey("getPet"))
+		Expect(funcs["getPet"].Headers[":path"].Text).To(Equal(`/v1/pets/{{ default(petId, "") }}?verbose={{default(verbose, "")}}`))
+		Expect(funcs).To(HaveKey("updatePet"))
+		Expect(funcs["updatePet"].Headers[":method"].Text).To(Equal("PUT"))
+		Expect(funcs["updatePet"].GetBody().Text).To(Equal(`{"age": {{ default(age, "") }},"name": "{{ default(name, "")}}"}`))
+	})
+})
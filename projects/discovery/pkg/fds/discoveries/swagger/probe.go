@@ -0,0 +1,101 @@
+package swagger
+
+import (
+	"context"
+	"encoding/base64"
+	"net/http"
+
+	"github.com/pkg/errors"
+
+	"github.com/solo-io/gloo/projects/discovery/pkg/fds"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+)
+
+// the fields of the extension secret holding the credentials
+const (
+	tokenField    = "token"
+	usernameField = "username"
+	passwordField = "password"
+
+	discoveryHeader = "X-Gloo-Discovery"
+
+	maxRedirects = 10
+)
+
+// probeHeaders returns the headers sent with the requests for the swagger document of the upstream,
+// including the credentials of its swagger discovery options. The credentials secret must be in the namespace
+// of the upstream, so that an upstream cannot send the secrets of other namespaces to its service.
+func probeHeaders(u *v1.Upstream, secrets func() v1.SecretList) (http.Header, error) {
+	options := u.GetDiscoveryMetadata().GetSwaggerDiscovery()
+	headers := make(http.Header)
+	headers.Set(discoveryHeader, "Swagger-Discovery")
+	for name, value := range options.GetHeaders() {
+		headers.Set(name, value)
+	}
+	ref := options.GetCredentials().GetSecretRef()
+	if ref == nil {
+		return headers, nil
+	}
+	if ref.Namespace != u.Metadata.Namespace {
+		return nil, errors.Errorf("the credentials secret %v is not in the namespace of the upstream %v", ref.Key(), u.Metadata.Ref().Key())
+	}
+	secret, err := secrets().Find(ref.Strings())
+	if err != nil {
+		return nil, errors.Wrapf(err, "credentials secret not found")
+	}
+	extension, ok := secret.Kind.(*v1.Secret_Extension)
+	if !ok || extension.Extension.GetConfig() == nil {
+		return nil, errors.Errorf("%v is not an extension secret", secret.Metadata.Ref())
+	}
+	fields := extension.Extension.Config.Fields
+	if token := fields[tokenField].GetStringValue(); token != "" {
+		headers.Set("Authorization", "Bearer "+token)
+		return headers, nil
+	}
+	username := fields[usernameField].GetStringValue()
+	if username == "" {
+		return nil, errors.Errorf("secret %v has neither a %v nor a %v", secret.Metadata.Ref(), tokenField, usernameField)
+	}
+	credentials := username + ":" + fields[passwordField].GetStringValue()
+	headers.Set("Authorization", "Basic "+base64.StdEncoding.EncodeToString([]byte(credentials)))
+	return headers, nil
+}
+
+// probeRequest returns a GET request for the document. The headers of the upstream, credentials included, are only
+// sent to the host of the upstream: a document on another host only gets the discovery header.
+func probeRequest(ctx context.Context, target, host string, headers http.Header) (*http.Request, error) {
+	req, err := http.NewRequest("GET", target, nil)
+	if err != nil {
+		return nil, err
+	}
+	if discovery := headers.Get(discoveryHeader); discovery != "" {
+		req.Header.Set(discoveryHeader, discovery)
+	}
+	if req.URL.Host == host {
+		for name, values := range headers {
+			req.Header[name] = values
+		}
+	}
+	return req.WithContext(ctx), nil
+}
+
+// probeClient is the probe client, but a redirect to another host than the one of the upstream drops the headers
+// of the upstream
+func probeClient(host string) *http.Client {
+	return &http.Client{
+		Transport: fds.ProbeClient.Transport,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) >= maxRedirects {
+				return errors.Errorf("stopped after %v redirects", maxRedirects)
+			}
+			if req.URL.Host != host {
+				discovery := req.Header.Get(discoveryHeader)
+				req.Header = make(http.Header)
+				if discovery != "" {
+					req.Header.Set(discoveryHeader, discovery)
+				}
+			}
+			return nil
+		},
+	}
+}
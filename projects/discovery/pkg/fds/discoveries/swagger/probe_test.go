@@ -0,0 +1,185 @@
+package swagger
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+
+	"github.com/solo-io/gloo/projects/discovery/pkg/fds"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+)
+
+const privateSwagger = `{
+  "swagger": "2.0",
+  "info": {"title": "private", "version": "1.0"},
+  "paths": {
+    "/pets": {"get": {"operationId": "listPets", "responses": {"200": {"description": "ok"}}}}
+  }
+}`
+
+var _ = Describe("Probe options", func() {
+
+	var (
+		server   *httptest.Server
+		upstream *v1.Upstream
+		secrets  v1.SecretList
+	)
+
+	extensionSecret := func(fields map[string]string) *v1.Secret {
+		values := make(map[string]*types.Value)
+		for name, value := range fields {
+			values[name] = &types.Value{Kind: &types.Value_StringValue{StringValue: value}}
+		}
+		return &v1.Secret{
+			Metadata: core.Metadata{Namespace: "gloo-system", Name: "swagger-creds"},
+			Kind: &v1.Secret_Extension{
+				Extension: &v1.Extension{Config: &types.Struct{Fields: values}},
+			},
+		}
+	}
+
+	dependencies := func() fds.Dependencies {
+		return fds.Dependencies{Secrets: secrets}
+	}
+
+	BeforeEach(func() {
+		// only serves the document at a non-standard path, with the api key and bearer token
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/private/api-docs" || r.URL.Query().Get("format") != "json" ||
+				r.Header.Get("X-Api-Key") != "key" || r.Header.Get("Authorization") != "Bearer abc" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.Write([]byte(privateSwagger))
+		}))
+		upstream = &v1.Upstream{
+			Metadata: core.Metadata{Namespace: "gloo-system", Name: "private"},
+			DiscoveryMetadata: &v1.DiscoveryMetadata{
+				SwaggerDiscovery: &v1.SwaggerDiscovery{
+					Paths:   []string{"/private/api-docs?format=json"},
+					Headers: map[string]string{"x-api-key": "key"},
+					Credentials: &v1.SwaggerDiscovery_Credentials{
+						SecretRef: &core.ResourceRef{Namespace: "gloo-system", Name: "swagger-creds"},
+					},
+				},
+			},
+		}
+		secrets = v1.SecretList{extensionSecret(map[string]string{"token": "abc"})}
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	detect := func() (string, error) {
+		factory := &SwaggerFunctionDiscoveryFactory{DetectionTimeout: 100 * time.Millisecond}
+		baseurl, err := url.Parse(server.URL)
+		Expect(err).NotTo(HaveOccurred())
+		spec, err := factory.NewFunctionDiscovery(upstream).DetectType(context.Background(), baseurl, dependencies)
+		if err != nil {
+			return "", err
+		}
+		return spec.GetRest().GetSwaggerInfo().GetUrl(), nil
+	}
+
+	It("tries the paths of the upstream with its headers and credentials", func() {
+		detected, err := detect()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(detected).To(Equal(server.URL + "/private/api-docs?format=json"))
+	})
+
+	It("does not detect the document without the credentials", func() {
+		upstream.DiscoveryMetadata.SwaggerDiscovery.Credentials = nil
+		detected, err := detect()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(detected).To(BeEmpty())
+	})
+
+	It("fails when the credentials secret is missing", func() {
+		secrets = nil
+		_, err := probeHeaders(upstream, secretsOf(dependencies))
+		Expect(err).To(MatchError(ContainSubstring("credentials secret not found")))
+		// the detection is retried until it times out
+		detected, err := detect()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(detected).To(BeEmpty())
+	})
+
+	It("rejects the credentials secrets of other namespaces", func() {
+		upstream.DiscoveryMetadata.SwaggerDiscovery.Credentials.SecretRef.Namespace = "other"
+		_, err := probeHeaders(upstream, secretsOf(dependencies))
+		Expect(err).To(MatchError(ContainSubstring("is not in the namespace of the upstream")))
+	})
+
+	Context("other hosts", func() {
+		var (
+			other    *httptest.Server
+			received chan http.Header
+		)
+
+		BeforeEach(func() {
+			received = make(chan http.Header, 10)
+			other = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				received <- r.Header
+				w.Write([]byte(privateSwagger))
+			}))
+		})
+
+		AfterEach(func() {
+			other.Close()
+		})
+
+		It("does not send the headers of the upstream to the documents of other hosts", func() {
+			upstream.DiscoveryMetadata.SwaggerDiscovery.Paths = []string{other.URL + "/api-docs"}
+			detected, err := detect()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(detected).To(Equal(other.URL + "/api-docs"))
+
+			var headers http.Header
+			Eventually(received).Should(Receive(&headers))
+			Expect(headers.Get("Authorization")).To(BeEmpty())
+			Expect(headers.Get("X-Api-Key")).To(BeEmpty())
+			Expect(headers.Get("X-Gloo-Discovery")).To(Equal("Swagger-Discovery"))
+		})
+
+		It("does not send the headers of the upstream when redirected to other hosts", func() {
+			server.Close()
+			server = httptest.NewServer(http.RedirectHandler(other.URL+"/api-docs", http.StatusFound))
+			upstream.DiscoveryMetadata.SwaggerDiscovery.Paths = []string{"/api-docs"}
+			_, err := detect()
+			Expect(err).NotTo(HaveOccurred())
+
+			var headers http.Header
+			Eventually(received).Should(Receive(&headers))
+			Expect(headers.Get("Authorization")).To(BeEmpty())
+			Expect(headers.Get("X-Api-Key")).To(BeEmpty())
+		})
+	})
+
+	It("sends basic credentials", func() {
+		secrets = v1.SecretList{extensionSecret(map[string]string{"username": "user", "password": "pass"})}
+		headers, err := probeHeaders(upstream, secretsOf(dependencies))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(headers.Get("Authorization")).To(Equal("Basic dXNlcjpwYXNz"))
+		Expect(headers.Get("X-Api-Key")).To(Equal("key"))
+	})
+
+	It("rejects secrets without credentials", func() {
+		secrets = v1.SecretList{extensionSecret(map[string]string{"password": "pass"})}
+		_, err := probeHeaders(upstream, secretsOf(dependencies))
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("does not need secrets without credentials", func() {
+		headers, err := probeHeaders(&v1.Upstream{}, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(headers.Get("X-Gloo-Discovery")).To(Equal("Swagger-Discovery"))
+	})
+})
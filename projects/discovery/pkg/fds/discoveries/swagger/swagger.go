@@ -2,12 +2,15 @@ package swagger
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/url"
+	"strings"
 	"time"
 
+	"github.com/ghodss/yaml"
 	"github.com/go-openapi/loads"
 	"github.com/go-openapi/spec"
 	"github.com/go-openapi/swag"
@@ -30,6 +33,8 @@ var commonSwaggerURIs = []string{
 	"/swagger/docs/v2",
 	"/v1/swagger",
 	"/v2/swagger",
+	"/openapi.json",
+	"/v3/api-docs",
 }
 
 // TODO(yuval-k): run this in a back off for a limited amount of time, with high initial retry.
@@ -42,10 +47,14 @@ type SwaggerFunctionDiscoveryFactory struct {
 }
 
 func (f *SwaggerFunctionDiscoveryFactory) NewFunctionDiscovery(u *v1.Upstream) fds.UpstreamFunctionDiscovery {
+	// the paths of the upstream are tried first
+	var urisToTry []string
+	urisToTry = append(urisToTry, u.GetDiscoveryMetadata().GetSwaggerDiscovery().GetPaths()...)
+	urisToTry = append(urisToTry, f.SwaggerUrisToTry...)
 	return &SwaggerFunctionDiscovery{
 		detectionTimeout: f.DetectionTimeout,
-		functionPollTime: f.FunctionPollTime,
-		swaggerUrisToTry: append(f.SwaggerUrisToTry, commonSwaggerURIs...),
+		functionPollTime: fds.PollInterval(u, f.FunctionPollTime),
+		swaggerUrisToTry: append(urisToTry, commonSwaggerURIs...),
 		upstream:         u,
 	}
 }
@@ -78,47 +87,46 @@ func (d *SwaggerFunctionDiscovery) IsFunctional() bool {
 	return getswagspec(d.upstream) != nil
 }
 
-func (d *SwaggerFunctionDiscovery) DetectType(ctx context.Context, baseurl *url.URL) (*plugins.ServiceSpec, error) {
+func (d *SwaggerFunctionDiscovery) DetectType(ctx context.Context, baseurl *url.URL, dependencies func() fds.Dependencies) (*plugins.ServiceSpec, error) {
 	var spec *plugins.ServiceSpec
 
 	err := contextutils.NewExponentioalBackoff(contextutils.ExponentioalBackoff{MaxDuration: &d.detectionTimeout}).Backoff(ctx, func(ctx context.Context) error {
-		var err error
-		spec, err = d.detectUpstreamTypeOnce(ctx, baseurl)
+		headers, err := probeHeaders(d.upstream, secretsOf(dependencies))
+		if err != nil {
+			return err
+		}
+		spec, err = d.detectUpstreamTypeOnce(ctx, baseurl, headers)
 		return err
 	})
 
 	return spec, err
 }
 
-func (d *SwaggerFunctionDiscovery) detectUpstreamTypeOnce(ctx context.Context, baseurl *url.URL) (*plugins.ServiceSpec, error) {
+func (d *SwaggerFunctionDiscovery) detectUpstreamTypeOnce(ctx context.Context, baseurl *url.URL, headers http.Header) (*plugins.ServiceSpec, error) {
 	// run detection and get functions
 	var errs error
 	log := contextutils.LoggerFrom(ctx)
 
 	log.Debugf("attempting to detect swagger base url %v", baseurl)
 
-	switch baseurl.Scheme {
-	case "http":
-		fallthrough
-	case "https":
-		// nothing to do as this baseurl already has an http address.
-	case "tcp":
-		// if it is a tcp address, assume it is plain http
-		baseurl.Scheme = "http"
-	default:
-		return nil, fmt.Errorf("unsupported baseurl for swagger discovery %v", baseurl)
+	baseurl, err := fds.HttpBaseUrl(baseurl, "swagger")
+	if err != nil {
+		return nil, err
 	}
 
 	for _, uri := range d.swaggerUrisToTry {
-		url := baseurl.ResolveReference(&url.URL{Path: uri}).String()
-		req, err := http.NewRequest("GET", url, nil)
+		ref, err := url.Parse(uri)
+		if err != nil {
+			errs = multierror.Append(errs, errors.Wrapf(err, "invalid swagger path %v", uri))
+			continue
+		}
+		url := baseurl.ResolveReference(ref).String()
+		req, err := probeRequest(ctx, url, baseurl.Host, headers)
 		if err != nil {
 			return nil, errors.Wrap(err, "invalid url for request")
 		}
-		req.Header.Set("X-Gloo-Discovery", "Swagger-Discovery")
 
-		req = req.WithContext(ctx)
-		res, err := http.DefaultClient.Do(req)
+		res, err := probeClient(baseurl.Host).Do(req)
 		if err != nil {
 			if ctx.Err() != nil {
 				return nil, ctx.Err()
@@ -128,7 +136,7 @@ func (d *SwaggerFunctionDiscovery) detectUpstreamTypeOnce(ctx context.Context, b
 		}
 		// might have found a swagger service
 		if res.StatusCode == http.StatusOK {
-			if _, err := RetrieveSwaggerDocFromUrl(ctx, url); err != nil {
+			if _, err := retrieveSwaggerDoc(ctx, url, baseurl.Host, headers); err != nil {
 				// first check if this is a context error
 				if ctx.Err() != nil {
 					return nil, ctx.Err()
@@ -161,7 +169,7 @@ func (d *SwaggerFunctionDiscovery) detectUpstreamTypeOnce(ctx context.Context, b
 
 }
 
-func (f *SwaggerFunctionDiscovery) DetectFunctions(ctx context.Context, url *url.URL, _ func() fds.Dependencies, updatecb func(fds.UpstreamMutator) error) error {
+func (f *SwaggerFunctionDiscovery) DetectFunctions(ctx context.Context, url *url.URL, dependencies func() fds.Dependencies, updatecb func(fds.UpstreamMutator) error) error {
 	in := f.upstream
 	spec := getswagspec(in)
 	if spec == nil || spec.SwaggerSpec == nil {
@@ -170,7 +178,7 @@ func (f *SwaggerFunctionDiscovery) DetectFunctions(ctx context.Context, url *url
 	}
 	switch document := spec.SwaggerSpec.(type) {
 	case *rest_plugins.ServiceSpec_SwaggerInfo_Url:
-		return f.detectFunctionsFromUrl(ctx, document.Url, in, updatecb)
+		return f.detectFunctionsFromUrl(ctx, document.Url, url.Host, in, secretsOf(dependencies), updatecb)
 	case *rest_plugins.ServiceSpec_SwaggerInfo_Inline:
 		return f.detectFunctionsFromInline(ctx, document.Inline, in, updatecb)
 	}
@@ -178,11 +186,14 @@ func (f *SwaggerFunctionDiscovery) DetectFunctions(ctx context.Context, url *url
 	return errors.New("upstream doesn't have a swagger source")
 }
 
-func (f *SwaggerFunctionDiscovery) detectFunctionsFromUrl(ctx context.Context, url string, in *v1.Upstream, updatecb func(fds.UpstreamMutator) error) error {
+func (f *SwaggerFunctionDiscovery) detectFunctionsFromUrl(ctx context.Context, url, host string, in *v1.Upstream, secrets func() v1.SecretList, updatecb func(fds.UpstreamMutator) error) error {
 	for {
-		err := contextutils.NewExponentioalBackoff(contextutils.ExponentioalBackoff{}).Backoff(ctx, func(ctx context.Context) error {
-
-			spec, err := RetrieveSwaggerDocFromUrl(ctx, url)
+		err := contextutils.NewExponentioalBackoff(contextutils.ExponentioalBackoff{}).Backoff(ctx, fds.RecordAttempts("swagger", func(ctx context.Context) error {
+			headers, err := probeHeaders(in, secrets)
+			if err != nil {
+				return err
+			}
+			spec, err := retrieveSwaggerDoc(ctx, url, host, headers)
 			if err != nil {
 				return err
 			}
@@ -191,7 +202,7 @@ func (f *SwaggerFunctionDiscovery) detectFunctionsFromUrl(ctx context.Context, u
 				return err
 			}
 			return nil
-		})
+		}))
 		if err != nil {
 			if ctx.Err() != nil {
 				return ctx.Err()
@@ -199,7 +210,7 @@ func (f *SwaggerFunctionDiscovery) detectFunctionsFromUrl(ctx context.Context, u
 			// ignore other erros as we would like to continue forever.
 		}
 
-		if err := contextutils.Sleep(ctx, f.functionPollTime); err != nil {
+		if err := fds.WaitForPoll(ctx, f.functionPollTime); err != nil {
 			return err
 		}
 	}
@@ -266,7 +277,12 @@ func (f *SwaggerFunctionDiscovery) detectFunctionsFromSpec(ctx context.Context,
 }
 
 func RetrieveSwaggerDocFromUrl(ctx context.Context, url string) (*spec.Swagger, error) {
-	docBytes, err := LoadFromFileOrHTTP(ctx, url)
+	return retrieveSwaggerDoc(ctx, url, "", nil)
+}
+
+// the headers are only sent to the host of the upstream
+func retrieveSwaggerDoc(ctx context.Context, url, host string, headers http.Header) (*spec.Swagger, error) {
+	docBytes, err := swag.LoadStrategy(url, ioutil.ReadFile, loadHTTPBytes(ctx, host, headers))(url)
 	if err != nil {
 		return nil, errors.Wrap(err, "loading swagger doc from url")
 	}
@@ -274,17 +290,16 @@ func RetrieveSwaggerDocFromUrl(ctx context.Context, url string) (*spec.Swagger,
 }
 
 func LoadFromFileOrHTTP(ctx context.Context, url string) ([]byte, error) {
-	return swag.LoadStrategy(url, ioutil.ReadFile, loadHTTPBytes(ctx))(url)
+	return swag.LoadStrategy(url, ioutil.ReadFile, loadHTTPBytes(ctx, "", nil))(url)
 }
 
-func loadHTTPBytes(ctx context.Context) func(path string) ([]byte, error) {
+func loadHTTPBytes(ctx context.Context, host string, headers http.Header) func(path string) ([]byte, error) {
 	return func(path string) ([]byte, error) {
-		req, err := http.NewRequest("GET", path, nil)
+		req, err := probeRequest(ctx, path, host, headers)
 		if err != nil {
 			return nil, err
 		}
-		req = req.WithContext(ctx)
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := probeClient(host).Do(req)
 		defer func() {
 			if resp != nil {
 				if e := resp.Body.Close(); e != nil {
@@ -305,17 +320,33 @@ func loadHTTPBytes(ctx context.Context) func(path string) ([]byte, error) {
 }
 
 func parseSwaggerDoc(docBytes []byte) (*spec.Swagger, error) {
-	doc, err := loads.Analyzed(docBytes, "")
-	if err != nil {
+	jsn := docBytes
+	var version struct {
+		OpenAPI string `json:"openapi"`
+	}
+	if err := json.Unmarshal(jsn, &version); err != nil {
 		log.Warnf("parsing doc as json failed, falling back to yaml")
-		jsn, err := swag.YAMLToJSON(docBytes)
+		jsn, err = yaml.YAMLToJSON(docBytes)
 		if err != nil {
 			return nil, errors.Wrap(err, "failed to convert yaml to json (after falling back to yaml parsing)")
 		}
-		doc, err = loads.Analyzed(jsn, "")
-		if err != nil {
+		if err := json.Unmarshal(jsn, &version); err != nil {
 			return nil, errors.Wrap(err, "invalid swagger doc")
 		}
 	}
+	if strings.HasPrefix(version.OpenAPI, "3.") {
+		return convertOpenApi3Doc(jsn)
+	}
+	doc, err := loads.Analyzed(jsn, "")
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid swagger doc")
+	}
 	return doc.Spec(), nil
 }
+
+// the secrets are only listed when the upstream has credentials
+func secretsOf(dependencies func() fds.Dependencies) func() v1.SecretList {
+	return func() v1.SecretList {
+		return dependencies().Secrets
+	}
+}
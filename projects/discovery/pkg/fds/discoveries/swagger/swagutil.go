@@ -54,7 +54,7 @@ func createFunctionForOpertaion(method string, basePath, functionPath string, op
 		case "formData":
 			log.Warnf("form data params not currently supported; ignoring")
 		case "body":
-			tmp := getBodyTemplate("", definitions[param.Name].SchemaProps, definitions)
+			tmp := getBodyTemplate("", bodySchema(param, definitions).SchemaProps, definitions)
 			body = &tmp
 			//bodyParams[param.Name] = param.Schema.SchemaProps
 		}
@@ -164,6 +164,17 @@ func getBodyTemplate(parent string, schema spec.SchemaProps, definitions spec.De
 	return bodyTemplate
 }
 
+// returns the schema of a body parameter, either the definition named after the parameter or its schema
+func bodySchema(param spec.Parameter, definitions spec.Definitions) spec.Schema {
+	if def, ok := definitions[param.Name]; ok || param.Schema == nil {
+		return def
+	}
+	if def := getDefinitionFor(param.Schema.Ref, definitions); def != nil {
+		return *def
+	}
+	return *param.Schema
+}
+
 func getDefinitionFor(ref spec.Ref, definitions spec.Definitions) *spec.Schema {
 	refName := strings.TrimPrefix(ref.String(), "#/definitions/")
 	schema, ok := definitions[refName]
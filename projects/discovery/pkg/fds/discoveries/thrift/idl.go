@@ -0,0 +1,461 @@
+package thrift
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	thrift_plugins "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/thrift"
+)
+
+// Document is the part of a thrift IDL function discovery needs: the services, and the types of their arguments
+type Document struct {
+	Services []*Service
+
+	typedefs map[string]string
+	enums    map[string]bool
+}
+
+type Service struct {
+	Name    string
+	Extends string
+	Methods []*Method
+}
+
+type Method struct {
+	Name      string
+	Oneway    bool
+	Arguments []*Field
+}
+
+type Field struct {
+	Id   int32
+	Name string
+	// the type as written in the IDL, containers being only their kind, e.g. `list`
+	Type string
+}
+
+var baseTypes = map[string]bool{
+	"bool":   true,
+	"byte":   true,
+	"i8":     true,
+	"i16":    true,
+	"i32":    true,
+	"i64":    true,
+	"double": true,
+	"string": true,
+	"binary": true,
+	"slist":  true,
+}
+
+// ParseIdl parses the definitions of a thrift IDL. The included files are not read: their types are seen as structs.
+func ParseIdl(idl []byte) (*Document, error) {
+	tokens, err := tokenize(string(idl))
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{tokens: tokens}
+	doc := &Document{
+		typedefs: make(map[string]string),
+		enums:    make(map[string]bool),
+	}
+	if err := p.document(doc); err != nil {
+		return nil, errors.Wrapf(err, "parsing thrift idl")
+	}
+	return doc, nil
+}
+
+// ServiceSpecs returns the services of the document, with the methods of the services they extend
+// and the types of the arguments resolved
+func (d *Document) ServiceSpecs() []*thrift_plugins.ServiceSpec_ThriftService {
+	var specs []*thrift_plugins.ServiceSpec_ThriftService
+	for _, svc := range d.Services {
+		spec := &thrift_plugins.ServiceSpec_ThriftService{Name: svc.Name}
+		for _, method := range d.methods(svc, map[string]bool{}) {
+			methodSpec := &thrift_plugins.ServiceSpec_Method{
+				Name:   method.Name,
+				Oneway: method.Oneway,
+			}
+			for _, arg := range method.Arguments {
+				methodSpec.Arguments = append(methodSpec.Arguments, &thrift_plugins.ServiceSpec_Field{
+					Id:   arg.Id,
+					Name: arg.Name,
+					Type: d.resolveType(arg.Type, map[string]bool{}),
+				})
+			}
+			spec.Methods = append(spec.Methods, methodSpec)
+		}
+		specs = append(specs, spec)
+	}
+	return specs
+}
+
+// the methods of the parent services come first. parents defined in included files are not known.
+func (d *Document) methods(svc *Service, visited map[string]bool) []*Method {
+	if visited[svc.Name] {
+		return nil
+	}
+	visited[svc.Name] = true
+	var methods []*Method
+	for _, parent := range d.Services {
+		if svc.Extends != "" && parent.Name == svc.Extends {
+			methods = append(methods, d.methods(parent, visited)...)
+		}
+	}
+	return append(methods, svc.Methods...)
+}
+
+func (d *Document) resolveType(typ string, visited map[string]bool) string {
+	switch {
+	case typ == "slist":
+		return "string"
+	case baseTypes[typ], typ == "list", typ == "set", typ == "map":
+		return typ
+	case d.enums[typ]:
+		return "i32"
+	}
+	if aliased, ok := d.typedefs[typ]; ok && !visited[typ] {
+		visited[typ] = true
+		return d.resolveType(aliased, visited)
+	}
+	return "struct"
+}
+
+type parser struct {
+	tokens []string
+	pos    int
+}
+
+func (p *parser) done() bool {
+	return p.pos >= len(p.tokens)
+}
+
+func (p *parser) peek() string {
+	if p.done() {
+		return ""
+	}
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() (string, error) {
+	if p.done() {
+		return "", errors.New("unexpected end of the idl")
+	}
+	tok := p.tokens[p.pos]
+	p.pos++
+	return tok, nil
+}
+
+func (p *parser) expect(expected string) error {
+	tok, err := p.next()
+	if err != nil {
+		return err
+	}
+	if tok != expected {
+		return errors.Errorf("expected %q, found %q", expected, tok)
+	}
+	return nil
+}
+
+func (p *parser) accept(tok string) bool {
+	if p.peek() == tok {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *parser) separator() {
+	if !p.accept(",") {
+		p.accept(";")
+	}
+}
+
+func (p *parser) document(doc *Document) error {
+	for !p.done() {
+		keyword, err := p.next()
+		if err != nil {
+			return err
+		}
+		switch keyword {
+		case "include", "cpp_include":
+			if _, err := p.next(); err != nil {
+				return err
+			}
+		case "namespace":
+			// the scope, then the namespace
+			if _, err := p.next(); err != nil {
+				return err
+			}
+			if _, err := p.next(); err != nil {
+				return err
+			}
+			if err := p.skipAnnotations(); err != nil {
+				return err
+			}
+		case "typedef":
+			typ, err := p.fieldType()
+			if err != nil {
+				return err
+			}
+			name, err := p.next()
+			if err != nil {
+				return err
+			}
+			if err := p.skipAnnotations(); err != nil {
+				return err
+			}
+			doc.typedefs[name] = typ
+		case "const":
+			if _, err := p.fieldType(); err != nil {
+				return err
+			}
+			if _, err := p.next(); err != nil {
+				return err
+			}
+			if err := p.expect("="); err != nil {
+				return err
+			}
+			if err := p.skipValue(); err != nil {
+				return err
+			}
+		case "enum", "senum":
+			name, err := p.next()
+			if err != nil {
+				return err
+			}
+			if err := p.skipBlock("{", "}"); err != nil {
+				return err
+			}
+			if err := p.skipAnnotations(); err != nil {
+				return err
+			}
+			if keyword == "enum" {
+				doc.enums[name] = true
+			} else {
+				doc.typedefs[name] = "string"
+			}
+		case "struct", "union", "exception":
+			if _, err := p.next(); err != nil {
+				return err
+			}
+			p.accept("xsd_all")
+			if err := p.expect("{"); err != nil {
+				return err
+			}
+			if _, err := p.fields("}"); err != nil {
+				return err
+			}
+			if err := p.skipAnnotations(); err != nil {
+				return err
+			}
+		case "service":
+			svc, err := p.service()
+			if err != nil {
+				return err
+			}
+			doc.Services = append(doc.Services, svc)
+		default:
+			return errors.Errorf("unexpected %q", keyword)
+		}
+		p.separator()
+	}
+	return nil
+}
+
+func (p *parser) service() (*Service, error) {
+	name, err := p.next()
+	if err != nil {
+		return nil, err
+	}
+	svc := &Service{Name: name}
+	if p.accept("extends") {
+		if svc.Extends, err = p.next(); err != nil {
+			return nil, err
+		}
+	}
+	if err := p.expect("{"); err != nil {
+		return nil, err
+	}
+	for !p.accept("}") {
+		method := &Method{}
+		method.Oneway = p.accept("oneway")
+		if !p.accept("void") {
+			if _, err := p.fieldType(); err != nil {
+				return nil, err
+			}
+		}
+		if method.Name, err = p.next(); err != nil {
+			return nil, err
+		}
+		if err := p.expect("("); err != nil {
+			return nil, err
+		}
+		if method.Arguments, err = p.fields(")"); err != nil {
+			return nil, err
+		}
+		if p.accept("throws") {
+			if err := p.expect("("); err != nil {
+				return nil, err
+			}
+			if _, err := p.fields(")"); err != nil {
+				return nil, err
+			}
+		}
+		if err := p.skipAnnotations(); err != nil {
+			return nil, err
+		}
+		p.separator()
+		svc.Methods = append(svc.Methods, method)
+	}
+	return svc, p.skipAnnotations()
+}
+
+// parses the fields up to the closing token, which is consumed. fields without an id get negative ids, as in thrift.
+func (p *parser) fields(closing string) ([]*Field, error) {
+	var fields []*Field
+	implicitId := int32(0)
+	for !p.accept(closing) {
+		field := &Field{}
+		tok, err := p.next()
+		if err != nil {
+			return nil, err
+		}
+		if p.accept(":") {
+			id, err := strconv.ParseInt(tok, 0, 32)
+			if err != nil {
+				return nil, errors.Errorf("invalid field id %q", tok)
+			}
+			field.Id = int32(id)
+		} else {
+			implicitId--
+			field.Id = implicitId
+			p.pos--
+		}
+		if !p.accept("required") {
+			p.accept("optional")
+		}
+		if field.Type, err = p.fieldType(); err != nil {
+			return nil, err
+		}
+		if field.Name, err = p.next(); err != nil {
+			return nil, err
+		}
+		if p.accept("=") {
+			if err := p.skipValue(); err != nil {
+				return nil, err
+			}
+		}
+		if err := p.skipAnnotations(); err != nil {
+			return nil, err
+		}
+		p.separator()
+		fields = append(fields, field)
+	}
+	return fields, nil
+}
+
+// returns the name of the type, or the kind of the container
+func (p *parser) fieldType() (string, error) {
+	typ, err := p.next()
+	if err != nil {
+		return "", err
+	}
+	switch typ {
+	case "map", "set", "list":
+		p.accept("cpp_type")
+		if strings.HasPrefix(p.peek(), `"`) {
+			p.pos++
+		}
+		if err := p.skipBlock("<", ">"); err != nil {
+			return "", err
+		}
+		if typ == "list" && p.accept("cpp_type") {
+			p.pos++
+		}
+	}
+	return typ, p.skipAnnotations()
+}
+
+func (p *parser) skipAnnotations() error {
+	if p.peek() != "(" {
+		return nil
+	}
+	return p.skipBlock("(", ")")
+}
+
+// skips a constant value, which may be a list or a map
+func (p *parser) skipValue() error {
+	switch p.peek() {
+	case "[":
+		return p.skipBlock("[", "]")
+	case "{":
+		return p.skipBlock("{", "}")
+	}
+	_, err := p.next()
+	return err
+}
+
+// skips the tokens from the opening token to the matching closing one
+func (p *parser) skipBlock(opening, closing string) error {
+	if err := p.expect(opening); err != nil {
+		return err
+	}
+	for depth := 1; depth > 0; {
+		tok, err := p.next()
+		if err != nil {
+			return err
+		}
+		switch tok {
+		case opening:
+			depth++
+		case closing:
+			depth--
+		}
+	}
+	return nil
+}
+
+func tokenize(idl string) ([]string, error) {
+	var tokens []string
+	for i := 0; i < len(idl); {
+		c := idl[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '#' || strings.HasPrefix(idl[i:], "//"):
+			end := strings.IndexByte(idl[i:], '\n')
+			if end < 0 {
+				return tokens, nil
+			}
+			i += end + 1
+		case strings.HasPrefix(idl[i:], "/*"):
+			end := strings.Index(idl[i+2:], "*/")
+			if end < 0 {
+				return nil, errors.New("unterminated comment")
+			}
+			i += end + 4
+		case c == '"' || c == '\'':
+			end := strings.IndexByte(idl[i+1:], c)
+			if end < 0 {
+				return nil, errors.New("unterminated string")
+			}
+			tokens = append(tokens, idl[i:i+end+2])
+			i += end + 2
+		case strings.IndexByte("{}()<>[],;:=", c) >= 0:
+			tokens = append(tokens, string(c))
+			i++
+		default:
+			start := i
+			for i < len(idl) && strings.IndexByte(" \t\n\r{}()<>[],;:=\"'#/", idl[i]) < 0 {
+				i++
+			}
+			if i == start {
+				// a lone slash
+				return nil, errors.Errorf("unexpected %q", c)
+			}
+			tokens = append(tokens, idl[start:i])
+		}
+	}
+	return tokens, nil
+}
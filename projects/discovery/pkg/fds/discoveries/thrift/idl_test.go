@@ -0,0 +1,117 @@
+package thrift
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	thrift_plugins "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/thrift"
+)
+
+const calculatorIdl = `
+/**
+ * The calculator of the thrift tutorial
+ */
+include "shared.thrift"
+
+namespace go tutorial
+namespace * tutorial
+
+typedef i32 MyInteger
+typedef MyInteger Counter
+
+const i32 INT32CONSTANT = 9853
+const map<string,string> MAPCONSTANT = {'hello':'world', 'goodnight':'moon'}
+
+enum Operation {
+  ADD = 1,
+  SUBTRACT = 2 (deprecated = "true"),
+  MULTIPLY = 3,
+  DIVIDE = 4
+}
+
+struct Work {
+  1: i32 num1 = 0,
+  2: i32 num2,
+  3: Operation op,
+  4: optional string comment,
+}
+
+exception InvalidOperation {
+  1: i32 whatOp,
+  2: string why
+}
+
+service Base {
+  # the base service
+  void ping()
+}
+
+service Calculator extends Base {
+   i32 add(1:i32 num1, 2:Counter num2),
+   i32 calculate(1:i32 logid, 2:Work w) throws (1:InvalidOperation ouch),
+   map<string, list<i64>> history(1: required Operation op, 2: list<string> tags, 3: shared.Filter filter);
+   oneway void zip(1: bool hard, 2: double level, 3: binary payload) (async = "true")
+}
+`
+
+var _ = Describe("Idl", func() {
+	var doc *Document
+
+	BeforeEach(func() {
+		var err error
+		doc, err = ParseIdl([]byte(calculatorIdl))
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("parses the services", func() {
+		Expect(doc.Services).To(HaveLen(2))
+		Expect(doc.Services[1].Name).To(Equal("Calculator"))
+		Expect(doc.Services[1].Extends).To(Equal("Base"))
+		Expect(doc.Services[1].Methods).To(HaveLen(4))
+	})
+
+	It("includes the methods of the extended services", func() {
+		specs := doc.ServiceSpecs()
+		Expect(specs).To(HaveLen(2))
+		var names []string
+		for _, method := range specs[1].Methods {
+			names = append(names, method.Name)
+		}
+		Expect(names).To(Equal([]string{"ping", "add", "calculate", "history", "zip"}))
+	})
+
+	It("resolves the types of the arguments", func() {
+		methods := doc.ServiceSpecs()[1].Methods
+		Expect(methods[1].Arguments).To(Equal([]*thrift_plugins.ServiceSpec_Field{
+			{Id: 1, Name: "num1", Type: "i32"},
+			{Id: 2, Name: "num2", Type: "i32"},
+		}))
+		Expect(methods[2].Arguments[1]).To(Equal(&thrift_plugins.ServiceSpec_Field{Id: 2, Name: "w", Type: "struct"}))
+		Expect(methods[3].Arguments).To(Equal([]*thrift_plugins.ServiceSpec_Field{
+			{Id: 1, Name: "op", Type: "i32"},
+			{Id: 2, Name: "tags", Type: "list"},
+			{Id: 3, Name: "filter", Type: "struct"},
+		}))
+		Expect(methods[4].Oneway).To(BeTrue())
+		Expect(methods[4].Arguments).To(Equal([]*thrift_plugins.ServiceSpec_Field{
+			{Id: 1, Name: "hard", Type: "bool"},
+			{Id: 2, Name: "level", Type: "double"},
+			{Id: 3, Name: "payload", Type: "binary"},
+		}))
+	})
+
+	It("gives the fields without an id negative ids", func() {
+		doc, err := ParseIdl([]byte(`service Legacy { void log(string message, i32 level) }`))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(doc.Services[0].Methods[0].Arguments).To(Equal([]*Field{
+			{Id: -1, Name: "message", Type: "string"},
+			{Id: -2, Name: "level", Type: "i32"},
+		}))
+	})
+
+	It("fails on invalid idls", func() {
+		_, err := ParseIdl([]byte(`service Calculator { i32 add(1:i32 num1`))
+		Expect(err).To(HaveOccurred())
+		_, err = ParseIdl([]byte(`/* unterminated`))
+		Expect(err).To(HaveOccurred())
+	})
+})
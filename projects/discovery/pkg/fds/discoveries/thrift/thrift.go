@@ -0,0 +1,277 @@
+package thrift
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"time"
+
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/pkg/errors"
+	"github.com/solo-io/gloo/projects/discovery/pkg/fds"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins"
+	thrift_plugins "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/thrift"
+	thriftplugin "github.com/solo-io/gloo/projects/gloo/pkg/plugins/thrift"
+	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+)
+
+const (
+	// neither the idl nor the replies are read past this size
+	maxIdlBytes   = 10 << 20
+	maxReplyBytes = 64 << 10
+
+	// a call of a method no service has. thrift servers reply with an exception, which tells them apart.
+	probeCall = `[1,"__gloo_discovery",1,0,{}]`
+
+	messageTypeException = 3
+)
+
+var commonThriftPaths = []string{
+	"/",
+	"/thrift",
+}
+
+type ThriftFunctionDiscoveryFactory struct {
+	DetectionTimeout time.Duration
+	FunctionPollTime time.Duration
+	PathsToTry       []string
+	// reads the idl artifacts
+	Artifacts v1.ArtifactClient
+}
+
+func (f *ThriftFunctionDiscoveryFactory) NewFunctionDiscovery(u *v1.Upstream) fds.UpstreamFunctionDiscovery {
+	return &ThriftFunctionDiscovery{
+		detectionTimeout: f.DetectionTimeout,
+		functionPollTime: fds.PollInterval(u, f.FunctionPollTime),
+		pathsToTry:       append(f.PathsToTry, commonThriftPaths...),
+		artifacts:        f.Artifacts,
+		upstream:         u,
+	}
+}
+
+type ThriftFunctionDiscovery struct {
+	detectionTimeout time.Duration
+	functionPollTime time.Duration
+	pathsToTry       []string
+	artifacts        v1.ArtifactClient
+	upstream         *v1.Upstream
+}
+
+func getthriftspec(u *v1.Upstream) *thrift_plugins.ServiceSpec {
+	spec, ok := u.UpstreamSpec.UpstreamType.(v1.ServiceSpecGetter)
+	if !ok {
+		return nil
+	}
+	serviceSpec := spec.GetServiceSpec()
+	if serviceSpec == nil {
+		return nil
+	}
+	thriftwrapper, ok := serviceSpec.PluginType.(*plugins.ServiceSpec_Thrift)
+	if !ok {
+		return nil
+	}
+	return thriftwrapper.Thrift
+}
+
+func (d *ThriftFunctionDiscovery) IsFunctional() bool {
+	return getthriftspec(d.upstream) != nil
+}
+
+// the upstreams are probed with a thrift call only if they opted in to function discovery, as the services that
+// don't serve thrift may not expect a post
+func (d *ThriftFunctionDiscovery) DetectType(ctx context.Context, baseurl *url.URL, _ func() fds.Dependencies) (*plugins.ServiceSpec, error) {
+	if !fds.FunctionDiscoveryRequestedFor(d.upstream) {
+		return nil, nil
+	}
+	var spec *plugins.ServiceSpec
+
+	err := contextutils.NewExponentioalBackoff(contextutils.ExponentioalBackoff{MaxDuration: &d.detectionTimeout}).Backoff(ctx, func(ctx context.Context) error {
+		var err error
+		spec, err = d.detectUpstreamTypeOnce(ctx, baseurl)
+		return err
+	})
+
+	return spec, err
+}
+
+func (d *ThriftFunctionDiscovery) detectUpstreamTypeOnce(ctx context.Context, baseurl *url.URL) (*plugins.ServiceSpec, error) {
+	var errs error
+	for _, path := range d.pathsToTry {
+		if err := probe(ctx, baseurl, path); err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			errs = multierror.Append(errs, err)
+			continue
+		}
+		contextutils.LoggerFrom(ctx).Infof("thrift upstream detected: %v%v", baseurl, path)
+		return &plugins.ServiceSpec{
+			PluginType: &plugins.ServiceSpec_Thrift{
+				Thrift: &thrift_plugins.ServiceSpec{
+					Path: path,
+				},
+			},
+		}, nil
+	}
+	return nil, errors.Wrapf(errs, "service at %s does not serve thrift at a known path, "+
+		"or was unreachable", baseurl.String())
+}
+
+func (d *ThriftFunctionDiscovery) DetectFunctions(ctx context.Context, baseurl *url.URL, _ func() fds.Dependencies, updatecb func(fds.UpstreamMutator) error) error {
+	spec := getthriftspec(d.upstream)
+	if spec == nil {
+		return errors.New("upstream doesn't have a thrift service spec")
+	}
+	if spec.IdlSource == nil {
+		// the methods are only known from the idl, wait for the upstream to be updated with one
+		<-ctx.Done()
+		return ctx.Err()
+	}
+	for {
+		err := contextutils.NewExponentioalBackoff(contextutils.ExponentioalBackoff{}).Backoff(ctx, fds.RecordAttempts("thrift", func(ctx context.Context) error {
+			return d.DetectFunctionsOnce(ctx, baseurl, spec, updatecb)
+		}))
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			// ignore other errors as we would like to continue forever.
+			contextutils.LoggerFrom(ctx).Warnw("unable to discover thrift methods", "upstream", d.upstream.Metadata.Name, "error", err)
+		}
+
+		if err := fds.WaitForPoll(ctx, d.functionPollTime); err != nil {
+			return err
+		}
+	}
+}
+
+func (d *ThriftFunctionDiscovery) DetectFunctionsOnce(ctx context.Context, baseurl *url.URL, spec *thrift_plugins.ServiceSpec, updatecb func(fds.UpstreamMutator) error) error {
+	idl, err := d.readIdl(ctx, baseurl, spec)
+	if err != nil {
+		return err
+	}
+	doc, err := ParseIdl(idl)
+	if err != nil {
+		return err
+	}
+	services := doc.ServiceSpecs()
+
+	return updatecb(func(u *v1.Upstream) error {
+		upstreamSpec, ok := u.UpstreamSpec.UpstreamType.(v1.ServiceSpecMutator)
+		if !ok {
+			return errors.New("not a valid upstream")
+		}
+		spec := upstreamSpec.GetServiceSpec()
+		if spec == nil {
+			spec = &plugins.ServiceSpec{}
+		}
+		thriftspec, ok := spec.PluginType.(*plugins.ServiceSpec_Thrift)
+		if !ok || thriftspec.Thrift == nil {
+			thriftspec = &plugins.ServiceSpec_Thrift{
+				Thrift: &thrift_plugins.ServiceSpec{},
+			}
+		}
+
+		thriftspec.Thrift.Services = services
+		spec.PluginType = thriftspec
+
+		upstreamSpec.SetServiceSpec(spec)
+		return nil
+	})
+}
+
+func (d *ThriftFunctionDiscovery) readIdl(ctx context.Context, baseurl *url.URL, spec *thrift_plugins.ServiceSpec) ([]byte, error) {
+	switch source := spec.IdlSource.(type) {
+	case *thrift_plugins.ServiceSpec_IdlArtifact:
+		if d.artifacts == nil {
+			return nil, errors.New("artifacts are not available to function discovery")
+		}
+		ref := source.IdlArtifact
+		artifact, err := d.artifacts.Read(ref.Namespace, ref.Name, clients.ReadOpts{Ctx: ctx})
+		if err != nil {
+			return nil, errors.Wrapf(err, "reading idl artifact %v", ref)
+		}
+		return []byte(artifact.Data), nil
+	case *thrift_plugins.ServiceSpec_IdlUrl:
+		return fetchIdl(ctx, baseurl, source.IdlUrl)
+	}
+	return nil, errors.New("upstream doesn't have an idl")
+}
+
+// gets the idl, the url is resolved against the address of the upstream
+func fetchIdl(ctx context.Context, baseurl *url.URL, idlUrl string) ([]byte, error) {
+	endpointurl, err := resolve(baseurl, idlUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", endpointurl, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid url for request")
+	}
+	req.Header.Set("X-Gloo-Discovery", "Thrift-Discovery")
+
+	res, err := fds.ProbeClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not perform HTTP GET on resolved addr: %v", endpointurl)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("%v returned %v", endpointurl, res.Status)
+	}
+	return ioutil.ReadAll(&io.LimitedReader{R: res.Body, N: maxIdlBytes})
+}
+
+// posts a call of an unknown method, and checks the reply is a thrift exception
+func probe(ctx context.Context, baseurl *url.URL, path string) error {
+	endpointurl, err := resolve(baseurl, path)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest("POST", endpointurl, bytes.NewReader([]byte(probeCall)))
+	if err != nil {
+		return errors.Wrap(err, "invalid url for request")
+	}
+	req.Header.Set("Content-Type", thriftplugin.ContentType)
+	req.Header.Set("X-Gloo-Discovery", "Thrift-Discovery")
+
+	res, err := fds.ProbeClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return errors.Wrapf(err, "could not perform HTTP POST on resolved addr: %v", endpointurl)
+	}
+	defer res.Body.Close()
+
+	body, err := ioutil.ReadAll(&io.LimitedReader{R: res.Body, N: maxReplyBytes})
+	if err != nil {
+		return errors.Wrapf(err, "reading %v", endpointurl)
+	}
+	// a message is [version, name, type, seqid, struct]
+	var message []interface{}
+	if err := json.Unmarshal(body, &message); err != nil || len(message) != 5 {
+		return errors.Errorf("%v did not reply with a thrift message", endpointurl)
+	}
+	if messageType, ok := message[2].(float64); !ok || messageType != messageTypeException {
+		return errors.Errorf("%v did not reply with a thrift exception", endpointurl)
+	}
+	return nil
+}
+
+func resolve(baseurl *url.URL, path string) (string, error) {
+	endpoint, err := fds.HttpBaseUrl(baseurl, "thrift")
+	if err != nil {
+		return "", err
+	}
+	ref, err := url.Parse(path)
+	if err != nil {
+		return "", errors.Wrapf(err, "invalid url %v", path)
+	}
+	return endpoint.ResolveReference(ref).String(), nil
+}
@@ -0,0 +1,13 @@
+package thrift
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestThrift(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Thrift Suite")
+}
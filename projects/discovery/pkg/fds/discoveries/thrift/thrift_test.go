@@ -0,0 +1,111 @@
+package thrift
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo/projects/discovery/pkg/fds"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/static"
+	thrift_plugins "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/thrift"
+)
+
+var _ = Describe("Thrift", func() {
+	var (
+		server  *httptest.Server
+		baseurl *url.URL
+	)
+
+	BeforeEach(func() {
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch {
+			case r.Method == "GET" && r.URL.Path == "/calculator.thrift":
+				w.Write([]byte(calculatorIdl))
+			case r.Method == "POST" && r.URL.Path == "/thrift":
+				body, _ := ioutil.ReadAll(r.Body)
+				Expect(string(body)).To(Equal(probeCall))
+				Expect(r.Header.Get("Content-Type")).To(Equal("application/x-thrift"))
+				w.Write([]byte(`[1,"__gloo_discovery",3,0,{"1":{"str":"Invalid method name: '__gloo_discovery'"},"2":{"i32":1}}]`))
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}))
+		var err error
+		baseurl, err = url.Parse(server.URL)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("detects the path serving thrift", func() {
+		factory := &ThriftFunctionDiscoveryFactory{}
+		discovery := factory.NewFunctionDiscovery(&v1.Upstream{}).(*ThriftFunctionDiscovery)
+		spec, err := discovery.detectUpstreamTypeOnce(context.Background(), baseurl)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(spec.PluginType).To(Equal(&plugins.ServiceSpec_Thrift{
+			Thrift: &thrift_plugins.ServiceSpec{Path: "/thrift"},
+		}))
+	})
+
+	It("does not probe the upstreams that did not opt in", func() {
+		factory := &ThriftFunctionDiscoveryFactory{}
+		discovery := factory.NewFunctionDiscovery(&v1.Upstream{})
+		spec, err := discovery.DetectType(context.Background(), baseurl, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(spec).To(BeNil())
+	})
+
+	It("probes the upstreams that opted in", func() {
+		upstream := &v1.Upstream{}
+		upstream.Metadata.Annotations = map[string]string{fds.FunctionDiscoveryAnnotation: fds.FunctionDiscoveryEnabled}
+		factory := &ThriftFunctionDiscoveryFactory{DetectionTimeout: time.Second}
+		discovery := factory.NewFunctionDiscovery(upstream)
+		spec, err := discovery.DetectType(context.Background(), baseurl, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(spec.GetThrift().GetPath()).To(Equal("/thrift"))
+	})
+
+	It("does not detect other services", func() {
+		discovery := &ThriftFunctionDiscovery{pathsToTry: []string{"/", "/calculator.thrift"}}
+		_, err := discovery.detectUpstreamTypeOnce(context.Background(), baseurl)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("discovers the methods from the idl url", func() {
+		spec := &thrift_plugins.ServiceSpec{
+			IdlSource: &thrift_plugins.ServiceSpec_IdlUrl{IdlUrl: "/calculator.thrift"},
+			Path:      "/thrift",
+		}
+		upstream := &v1.Upstream{
+			UpstreamSpec: &v1.UpstreamSpec{
+				UpstreamType: &v1.UpstreamSpec_Static{
+					Static: &static.UpstreamSpec{
+						ServiceSpec: &plugins.ServiceSpec{
+							PluginType: &plugins.ServiceSpec_Thrift{Thrift: spec},
+						},
+					},
+				},
+			},
+		}
+		discovery := &ThriftFunctionDiscovery{upstream: upstream}
+		err := discovery.DetectFunctionsOnce(context.Background(), baseurl, spec, func(mutator fds.UpstreamMutator) error {
+			return mutator(upstream)
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		updated := getthriftspec(upstream)
+		Expect(updated.Path).To(Equal("/thrift"))
+		Expect(updated.GetIdlUrl()).To(Equal("/calculator.thrift"))
+		Expect(updated.Services).To(HaveLen(2))
+		Expect(updated.Services[1].Name).To(Equal("Calculator"))
+	})
+})
@@ -0,0 +1,251 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/pkg/errors"
+	"github.com/solo-io/gloo/projects/discovery/pkg/fds"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins"
+	rest_plugins "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/rest"
+	transformation_plugins "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/transformation"
+	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/solo-kit/pkg/utils/protoutils"
+)
+
+const (
+	defaultTimeout = 10 * time.Second
+
+	// the response of the webhook is not read past this size
+	maxResponseBytes = 10 << 20
+)
+
+// the functions of an upstream, as returned by the webhook
+type response struct {
+	Functions []Function `json:"functions"`
+}
+
+type Function struct {
+	Name    string            `json:"name"`
+	Method  string            `json:"method"`
+	Path    string            `json:"path"`
+	Headers map[string]string `json:"headers"`
+	Body    string            `json:"body"`
+}
+
+type WebhookFunctionDiscoveryFactory struct {
+	Webhook          *v1.FunctionDiscoveryWebhook
+	DetectionTimeout time.Duration
+	FunctionPollTime time.Duration
+}
+
+func (f *WebhookFunctionDiscoveryFactory) NewFunctionDiscovery(u *v1.Upstream) fds.UpstreamFunctionDiscovery {
+	timeout := defaultTimeout
+	if f.Webhook.GetTimeout() != nil {
+		if d, err := types.DurationFromProto(f.Webhook.Timeout); err == nil && d > 0 {
+			timeout = d
+		}
+	}
+	return &WebhookFunctionDiscovery{
+		webhookUrl:       f.Webhook.GetUrl(),
+		client:           &http.Client{Timeout: timeout, Transport: fds.ProbeTransport()},
+		detectionTimeout: f.DetectionTimeout,
+		functionPollTime: fds.PollInterval(u, f.FunctionPollTime),
+		upstream:         u,
+	}
+}
+
+type WebhookFunctionDiscovery struct {
+	webhookUrl       string
+	client           *http.Client
+	detectionTimeout time.Duration
+	functionPollTime time.Duration
+	upstream         *v1.Upstream
+}
+
+func getwebhookspec(u *v1.Upstream) *rest_plugins.ServiceSpec_WebhookInfo {
+	spec, ok := u.UpstreamSpec.UpstreamType.(v1.ServiceSpecGetter)
+	if !ok {
+		return nil
+	}
+	serviceSpec := spec.GetServiceSpec()
+	if serviceSpec == nil {
+		return nil
+	}
+	restwrapper, ok := serviceSpec.PluginType.(*plugins.ServiceSpec_Rest)
+	if !ok {
+		return nil
+	}
+	return restwrapper.Rest.WebhookInfo
+}
+
+// the upstreams are discovered by the webhook that detected them, each webhook has its own discovery
+func (d *WebhookFunctionDiscovery) IsFunctional() bool {
+	return d.webhookUrl != "" && getwebhookspec(d.upstream).GetUrl() == d.webhookUrl
+}
+
+func (d *WebhookFunctionDiscovery) DetectType(ctx context.Context, _ *url.URL, _ func() fds.Dependencies) (*plugins.ServiceSpec, error) {
+	var functions []Function
+	err := contextutils.NewExponentioalBackoff(contextutils.ExponentioalBackoff{MaxDuration: &d.detectionTimeout}).Backoff(ctx, func(ctx context.Context) error {
+		var err error
+		functions, err = d.fetchFunctions(ctx)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(functions) == 0 {
+		// the webhook doesn't know this upstream
+		return nil, nil
+	}
+	contextutils.LoggerFrom(ctx).Infof("upstream %v detected by discovery webhook %v", d.upstream.Metadata.Name, d.webhookUrl)
+	return &plugins.ServiceSpec{
+		PluginType: &plugins.ServiceSpec_Rest{
+			Rest: &rest_plugins.ServiceSpec{
+				WebhookInfo: &rest_plugins.ServiceSpec_WebhookInfo{
+					Url: d.webhookUrl,
+				},
+			},
+		},
+	}, nil
+}
+
+func (d *WebhookFunctionDiscovery) DetectFunctions(ctx context.Context, _ *url.URL, _ func() fds.Dependencies, updatecb func(fds.UpstreamMutator) error) error {
+	for {
+		err := contextutils.NewExponentioalBackoff(contextutils.ExponentioalBackoff{}).Backoff(ctx, fds.RecordAttempts("webhook", func(ctx context.Context) error {
+			return d.DetectFunctionsOnce(ctx, updatecb)
+		}))
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			// ignore other errors as we would like to continue forever.
+			contextutils.LoggerFrom(ctx).Warnw("unable to discover functions with webhook", "upstream", d.upstream.Metadata.Name, "webhook", d.webhookUrl, "error", err)
+		}
+
+		if err := fds.WaitForPoll(ctx, d.functionPollTime); err != nil {
+			return err
+		}
+	}
+}
+
+func (d *WebhookFunctionDiscovery) DetectFunctionsOnce(ctx context.Context, updatecb func(fds.UpstreamMutator) error) error {
+	functions, err := d.fetchFunctions(ctx)
+	if err != nil {
+		return err
+	}
+	transformations := Transformations(functions)
+
+	return updatecb(func(u *v1.Upstream) error {
+		upstreamSpec, ok := u.UpstreamSpec.UpstreamType.(v1.ServiceSpecMutator)
+		if !ok {
+			return errors.New("not a valid upstream")
+		}
+		spec := upstreamSpec.GetServiceSpec()
+		if spec == nil {
+			spec = &plugins.ServiceSpec{}
+		}
+		restspec, ok := spec.PluginType.(*plugins.ServiceSpec_Rest)
+		if !ok || restspec.Rest == nil {
+			restspec = &plugins.ServiceSpec_Rest{
+				Rest: &rest_plugins.ServiceSpec{},
+			}
+		}
+
+		restspec.Rest.Transformations = transformations
+		spec.PluginType = restspec
+
+		upstreamSpec.SetServiceSpec(spec)
+		return nil
+	})
+}
+
+// posts the upstream to the webhook, a 404 means no functions
+func (d *WebhookFunctionDiscovery) fetchFunctions(ctx context.Context) ([]Function, error) {
+	upstream, err := protoutils.MarshalBytes(d.upstream)
+	if err != nil {
+		return nil, errors.Wrapf(err, "marshalling upstream %v", d.upstream.Metadata.Name)
+	}
+	payload, err := json.Marshal(map[string]json.RawMessage{"upstream": upstream})
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest("POST", d.webhookUrl, bytes.NewReader(payload))
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid webhook url %v", d.webhookUrl)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Gloo-Discovery", "Webhook-Discovery")
+
+	res, err := d.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, errors.Wrapf(err, "calling discovery webhook %v", d.webhookUrl)
+	}
+	defer res.Body.Close()
+
+	switch res.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return nil, nil
+	default:
+		return nil, errors.Errorf("discovery webhook %v returned %v", d.webhookUrl, res.Status)
+	}
+	body, err := ioutil.ReadAll(&io.LimitedReader{R: res.Body, N: maxResponseBytes})
+	if err != nil {
+		return nil, errors.Wrapf(err, "reading the response of discovery webhook %v", d.webhookUrl)
+	}
+	var functions response
+	if err := json.Unmarshal(body, &functions); err != nil {
+		return nil, errors.Wrapf(err, "invalid response from discovery webhook %v", d.webhookUrl)
+	}
+	return functions.Functions, nil
+}
+
+// Transformations returns the rest transformations of the functions, by name. The functions without a name are ignored.
+func Transformations(functions []Function) map[string]*transformation_plugins.TransformationTemplate {
+	transformations := make(map[string]*transformation_plugins.TransformationTemplate)
+	for _, function := range functions {
+		if function.Name == "" {
+			continue
+		}
+		method := strings.ToUpper(function.Method)
+		if method == "" {
+			method = "GET"
+		}
+		path := function.Path
+		if path == "" {
+			path = "/"
+		}
+		headers := map[string]*transformation_plugins.InjaTemplate{
+			":method": {Text: method},
+			":path":   {Text: path},
+		}
+		for name, value := range function.Headers {
+			headers[strings.ToLower(name)] = &transformation_plugins.InjaTemplate{Text: value}
+		}
+		template := &transformation_plugins.TransformationTemplate{
+			Headers: headers,
+		}
+		switch {
+		case function.Body != "":
+			template.BodyTransformation = &transformation_plugins.TransformationTemplate_Body{
+				Body: &transformation_plugins.InjaTemplate{Text: function.Body},
+			}
+		case method == "POST" || method == "PATCH" || method == "PUT":
+			template.BodyTransformation = &transformation_plugins.TransformationTemplate_Passthrough{
+				Passthrough: &transformation_plugins.Passthrough{},
+			}
+		}
+		transformations[function.Name] = template
+	}
+	return transformations
+}
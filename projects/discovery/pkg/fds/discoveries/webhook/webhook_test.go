@@ -0,0 +1,159 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+
+	"github.com/solo-io/gloo/projects/discovery/pkg/fds"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/kubernetes"
+	rest_plugins "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/rest"
+	transformation_plugins "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/transformation"
+)
+
+var _ = Describe("Webhook", func() {
+
+	var (
+		server   *httptest.Server
+		factory  *WebhookFunctionDiscoveryFactory
+		upstream *v1.Upstream
+		ctx      context.Context
+		cancel   context.CancelFunc
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		// knows the functions of the users service
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			defer GinkgoRecover()
+			Expect(r.Method).To(Equal("POST"))
+			body, err := ioutil.ReadAll(r.Body)
+			Expect(err).NotTo(HaveOccurred())
+			var payload struct {
+				Upstream struct {
+					Metadata struct {
+						Name string `json:"name"`
+					} `json:"metadata"`
+				} `json:"upstream"`
+			}
+			Expect(json.Unmarshal(body, &payload)).NotTo(HaveOccurred())
+			if payload.Upstream.Metadata.Name != "users" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.Write([]byte(`{"functions": [
+				{"name": "getUser", "path": "/users/{{ id }}"},
+				{"name": "createUser", "method": "post", "path": "/users", "headers": {"X-Api": "v2"}}
+			]}`))
+		}))
+		factory = &WebhookFunctionDiscoveryFactory{
+			Webhook:          &v1.FunctionDiscoveryWebhook{Url: server.URL},
+			DetectionTimeout: time.Second,
+			FunctionPollTime: time.Second,
+		}
+		upstream = &v1.Upstream{
+			Metadata: core.Metadata{Namespace: "gloo-system", Name: "users"},
+			UpstreamSpec: &v1.UpstreamSpec{
+				UpstreamType: &v1.UpstreamSpec_Kube{
+					Kube: &kubernetes.UpstreamSpec{ServiceName: "users", ServiceNamespace: "default", ServicePort: 8080},
+				},
+			},
+		}
+	})
+
+	AfterEach(func() {
+		cancel()
+		server.Close()
+	})
+
+	detect := func() (*plugins.ServiceSpec, error) {
+		return factory.NewFunctionDiscovery(upstream).DetectType(ctx, &url.URL{Scheme: "tcp", Host: "users:8080"}, nil)
+	}
+
+	It("detects the upstreams the webhook knows", func() {
+		spec, err := detect()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(spec.GetRest().GetWebhookInfo().GetUrl()).To(Equal(server.URL))
+
+		upstream.Metadata.Name = "orders"
+		spec, err = detect()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(spec).To(BeNil())
+	})
+
+	It("only discovers the functions of the upstreams detected by the same webhook", func() {
+		Expect(factory.NewFunctionDiscovery(upstream).IsFunctional()).To(BeFalse())
+
+		upstream.UpstreamSpec.GetKube().ServiceSpec = &plugins.ServiceSpec{
+			PluginType: &plugins.ServiceSpec_Rest{
+				Rest: &rest_plugins.ServiceSpec{
+					WebhookInfo: &rest_plugins.ServiceSpec_WebhookInfo{Url: server.URL},
+				},
+			},
+		}
+		Expect(factory.NewFunctionDiscovery(upstream).IsFunctional()).To(BeTrue())
+
+		other := &WebhookFunctionDiscoveryFactory{Webhook: &v1.FunctionDiscoveryWebhook{Url: "http://catalog.example.com"}}
+		Expect(other.NewFunctionDiscovery(upstream).IsFunctional()).To(BeFalse())
+	})
+
+	It("sets the functions of the webhook as rest transformations", func() {
+		discovery := factory.NewFunctionDiscovery(upstream).(*WebhookFunctionDiscovery)
+		var mutator fds.UpstreamMutator
+		err := discovery.DetectFunctionsOnce(ctx, func(m fds.UpstreamMutator) error {
+			mutator = m
+			return nil
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(mutator(upstream)).NotTo(HaveOccurred())
+
+		transformations := upstream.UpstreamSpec.GetKube().ServiceSpec.GetRest().Transformations
+		Expect(transformations).To(HaveLen(2))
+		Expect(transformations["getUser"]).To(Equal(&transformation_plugins.TransformationTemplate{
+			Headers: map[string]*transformation_plugins.InjaTemplate{
+				":method": {Text: "GET"},
+				":path":   {Text: "/users/{{ id }}"},
+			},
+		}))
+		Expect(transformations["createUser"]).To(Equal(&transformation_plugins.TransformationTemplate{
+			Headers: map[string]*transformation_plugins.InjaTemplate{
+				":method": {Text: "POST"},
+				":path":   {Text: "/users"},
+				"x-api":   {Text: "v2"},
+			},
+			BodyTransformation: &transformation_plugins.TransformationTemplate_Passthrough{
+				Passthrough: &transformation_plugins.Passthrough{},
+			},
+		}))
+	})
+
+	It("templates the body of the functions", func() {
+		transformations := Transformations([]Function{
+			{Name: "search", Method: "POST", Path: "/search", Body: `{"query": "{{ q }}"}`},
+			{Path: "/unnamed"},
+		})
+		Expect(transformations).To(HaveLen(1))
+		Expect(transformations["search"].GetBody().GetText()).To(Equal(`{"query": "{{ q }}"}`))
+	})
+
+	It("does not detect the upstreams while the webhook fails", func() {
+		server.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		})
+		factory.DetectionTimeout = 100 * time.Millisecond
+		// the detection is retried until it times out
+		spec, err := detect()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(spec).To(BeNil())
+	})
+})
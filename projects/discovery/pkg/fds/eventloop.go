@@ -38,6 +38,9 @@ func (d *FunctionDiscovery) Update(upstreams v1.UpstreamList, secrets v1.SecretL
 		d.updater.UpstreamUpdated(u)
 	}
 
+	if cache := d.updater.detectionCache; cache != nil {
+		return cache.Retain(upstreams)
+	}
 	return nil
 }
 
@@ -0,0 +1,228 @@
+package fds
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/gogo/protobuf/proto"
+
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/alibaba"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/aws"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/azure"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/external"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/openwhisk"
+	"github.com/solo-io/solo-kit/pkg/utils/protoutils"
+)
+
+const (
+	// etcd rejects requests larger than 1.5MiB, leaving room for the custom resource around the upstream and its status
+	DefaultMaxUpstreamBytes = 1 << 20
+
+	// comma separated names of the functions kept first when not all of them fit in the upstream
+	FunctionPriorityAnnotation = "discovery.solo.io/function-priority"
+)
+
+// the discovered functions of an upstream, by name
+type functionList struct {
+	names []string
+	// keeps only the functions with the given names
+	keep func(kept map[string]bool)
+}
+
+// UpstreamSize returns the size of the upstream as stored, encoded as json in its custom resource
+func UpstreamSize(us *v1.Upstream) int {
+	data, err := protoutils.MarshalBytes(us)
+	if err != nil {
+		return proto.Size(us)
+	}
+	return len(data)
+}
+
+// LimitFunctions leaves functions out of the upstream until its size is no larger than the max bytes. The functions named
+// in the priority annotation are kept first, then the others in name order. Returns the number of functions
+// kept and discovered, which are equal if none was left out.
+func LimitFunctions(us *v1.Upstream, maxBytes int) (int, int) {
+	functions := functionsOf(us)
+	if functions == nil {
+		return 0, 0
+	}
+	total := len(functions.names)
+	if UpstreamSize(us) <= maxBytes {
+		return total, total
+	}
+
+	names := prioritized(functions.names, us.Metadata.Annotations[FunctionPriorityAnnotation])
+	keepFirst := func(upstream *v1.Upstream, n int) {
+		kept := make(map[string]bool, n)
+		for _, name := range names[:n] {
+			kept[name] = true
+		}
+		functionsOf(upstream).keep(kept)
+	}
+	// the largest number of functions that fit
+	n := sort.Search(total, func(n int) bool {
+		candidate := proto.Clone(us).(*v1.Upstream)
+		keepFirst(candidate, n+1)
+		return UpstreamSize(candidate) > maxBytes
+	})
+	keepFirst(us, n)
+	return n, total
+}
+
+// WithFunctionsLimit limits the functions set by the mutator, and warns on the discovery status of the upstream when some were left out.
+// The mutations leaving the spec of the upstream untouched, e.g. of its status, are not limited.
+func WithFunctionsLimit(mutator UpstreamMutator, maxBytes int) UpstreamMutator {
+	return func(upstream *v1.Upstream) error {
+		spec := proto.Clone(upstream.GetUpstreamSpec())
+		if err := mutator(upstream); err != nil {
+			return err
+		}
+		if upstream.GetUpstreamSpec().Equal(spec) {
+			return nil
+		}
+		kept, total := LimitFunctions(upstream, maxBytes)
+		var warning string
+		if kept < total {
+			warning = fmt.Sprintf("only %v of the %v discovered functions were kept, the upstream would exceed %v bytes "+
+				"with all of them; list the functions to keep first in the %v annotation", kept, total, maxBytes, FunctionPriorityAnnotation)
+		}
+		status := upstream.GetDiscoveryMetadata().GetFunctionDiscoveryStatus()
+		if status.GetWarning() == warning {
+			return nil
+		}
+		if status == nil {
+			if upstream.DiscoveryMetadata == nil {
+				upstream.DiscoveryMetadata = &v1.DiscoveryMetadata{}
+			}
+			status = &v1.FunctionDiscoveryStatus{}
+			upstream.DiscoveryMetadata.FunctionDiscoveryStatus = status
+		}
+		status.Warning = warning
+		return nil
+	}
+}
+
+func prioritized(names []string, priority string) []string {
+	rank := make(map[string]int)
+	for i, name := range strings.Split(priority, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			if _, ok := rank[name]; !ok {
+				rank[name] = i + 1
+			}
+		}
+	}
+	sorted := append([]string(nil), names...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		ri, rj := rank[sorted[i]], rank[sorted[j]]
+		switch {
+		case ri != 0 && rj != 0:
+			return ri < rj
+		case ri != 0 || rj != 0:
+			return ri != 0
+		}
+		return sorted[i] < sorted[j]
+	})
+	return sorted
+}
+
+func functionsOf(us *v1.Upstream) *functionList {
+	switch upstreamType := us.GetUpstreamSpec().GetUpstreamType().(type) {
+	case *v1.UpstreamSpec_Aws:
+		spec := upstreamType.Aws
+		list := &functionList{keep: func(kept map[string]bool) {
+			var functions []*aws.LambdaFunctionSpec
+			for _, f := range spec.LambdaFunctions {
+				if kept[f.LogicalName] {
+					functions = append(functions, f)
+				}
+			}
+			spec.LambdaFunctions = functions
+		}}
+		for _, f := range spec.LambdaFunctions {
+			list.names = append(list.names, f.LogicalName)
+		}
+		return list
+	case *v1.UpstreamSpec_Azure:
+		spec := upstreamType.Azure
+		list := &functionList{keep: func(kept map[string]bool) {
+			var functions []*azure.UpstreamSpec_FunctionSpec
+			for _, f := range spec.Functions {
+				if kept[f.FunctionName] {
+					functions = append(functions, f)
+				}
+			}
+			spec.Functions = functions
+		}}
+		for _, f := range spec.Functions {
+			list.names = append(list.names, f.FunctionName)
+		}
+		return list
+	case *v1.UpstreamSpec_Alibaba:
+		spec := upstreamType.Alibaba
+		list := &functionList{keep: func(kept map[string]bool) {
+			var functions []*alibaba.FunctionSpec
+			for _, f := range spec.Functions {
+				if kept[f.LogicalName] {
+					functions = append(functions, f)
+				}
+			}
+			spec.Functions = functions
+		}}
+		for _, f := range spec.Functions {
+			list.names = append(list.names, f.LogicalName)
+		}
+		return list
+	case *v1.UpstreamSpec_Openwhisk:
+		spec := upstreamType.Openwhisk
+		list := &functionList{keep: func(kept map[string]bool) {
+			var actions []*openwhisk.ActionSpec
+			for _, a := range spec.Actions {
+				if kept[a.LogicalName] {
+					actions = append(actions, a)
+				}
+			}
+			spec.Actions = actions
+		}}
+		for _, a := range spec.Actions {
+			list.names = append(list.names, a.LogicalName)
+		}
+		return list
+	case *v1.UpstreamSpec_External:
+		spec := upstreamType.External
+		list := &functionList{keep: func(kept map[string]bool) {
+			var functions []*external.FunctionSpec
+			for _, f := range spec.Functions {
+				if kept[f.LogicalName] {
+					functions = append(functions, f)
+				}
+			}
+			spec.Functions = functions
+		}}
+		for _, f := range spec.Functions {
+			list.names = append(list.names, f.LogicalName)
+		}
+		return list
+	case v1.ServiceSpecGetter:
+		// the grpc descriptors cannot be split, only the rest functions are limited
+		rest, ok := upstreamType.GetServiceSpec().GetPluginType().(*plugins.ServiceSpec_Rest)
+		if !ok || rest.Rest == nil {
+			return nil
+		}
+		spec := rest.Rest
+		list := &functionList{keep: func(kept map[string]bool) {
+			for name := range spec.Transformations {
+				if !kept[name] {
+					delete(spec.Transformations, name)
+				}
+			}
+		}}
+		for name := range spec.Transformations {
+			list.names = append(list.names, name)
+		}
+		return list
+	}
+	return nil
+}
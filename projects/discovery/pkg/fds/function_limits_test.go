@@ -0,0 +1,124 @@
+package fds_test
+
+import (
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/solo-io/gloo/projects/discovery/pkg/fds"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/aws"
+	rest_plugins "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/rest"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/static"
+	transformation_plugins "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/transformation"
+)
+
+var _ = Describe("Function limits", func() {
+
+	lambdaUpstream := func(count int) *v1.Upstream {
+		spec := &aws.UpstreamSpec{Region: "us-east-1"}
+		for i := 0; i < count; i++ {
+			name := fmt.Sprintf("function-%03d", i)
+			spec.LambdaFunctions = append(spec.LambdaFunctions, &aws.LambdaFunctionSpec{
+				LogicalName:        name,
+				LambdaFunctionName: name,
+			})
+		}
+		return &v1.Upstream{
+			UpstreamSpec: &v1.UpstreamSpec{
+				UpstreamType: &v1.UpstreamSpec_Aws{Aws: spec},
+			},
+		}
+	}
+
+	lambdaNames := func(us *v1.Upstream) []string {
+		var names []string
+		for _, f := range us.UpstreamSpec.GetAws().LambdaFunctions {
+			names = append(names, f.LogicalName)
+		}
+		return names
+	}
+
+	It("keeps all the functions of upstreams under the limit", func() {
+		up := lambdaUpstream(10)
+		kept, total := LimitFunctions(up, DefaultMaxUpstreamBytes)
+		Expect(kept).To(Equal(10))
+		Expect(total).To(Equal(10))
+		Expect(up.UpstreamSpec.GetAws().LambdaFunctions).To(HaveLen(10))
+	})
+
+	It("keeps the first functions by name that fit", func() {
+		up := lambdaUpstream(100)
+		maxBytes := UpstreamSize(up) / 2
+		kept, total := LimitFunctions(up, maxBytes)
+		Expect(total).To(Equal(100))
+		Expect(kept).To(BeNumerically(">", 0))
+		Expect(kept).To(BeNumerically("<", 100))
+		Expect(UpstreamSize(up)).To(BeNumerically("<=", maxBytes))
+		Expect(lambdaNames(up)).To(HaveLen(kept))
+		Expect(lambdaNames(up)[0]).To(Equal("function-000"))
+
+		// one more function would not fit
+		bigger := lambdaUpstream(kept + 1)
+		Expect(UpstreamSize(bigger)).To(BeNumerically(">", maxBytes))
+	})
+
+	It("keeps the prioritized functions first", func() {
+		up := lambdaUpstream(100)
+		up.Metadata.Annotations = map[string]string{FunctionPriorityAnnotation: "function-099, function-050"}
+		LimitFunctions(up, UpstreamSize(up)/2)
+		Expect(lambdaNames(up)).To(ContainElement("function-099"))
+		Expect(lambdaNames(up)).To(ContainElement("function-050"))
+		Expect(lambdaNames(up)).To(ContainElement("function-000"))
+		Expect(lambdaNames(up)).NotTo(ContainElement("function-098"))
+	})
+
+	It("limits the rest functions", func() {
+		transformations := make(map[string]*transformation_plugins.TransformationTemplate)
+		for i := 0; i < 100; i++ {
+			transformations[fmt.Sprintf("function-%03d", i)] = &transformation_plugins.TransformationTemplate{}
+		}
+		up := &v1.Upstream{
+			UpstreamSpec: &v1.UpstreamSpec{
+				UpstreamType: &v1.UpstreamSpec_Static{
+					Static: &static.UpstreamSpec{
+						ServiceSpec: &plugins.ServiceSpec{
+							PluginType: &plugins.ServiceSpec_Rest{
+								Rest: &rest_plugins.ServiceSpec{Transformations: transformations},
+							},
+						},
+					},
+				},
+			},
+		}
+		maxBytes := UpstreamSize(up) / 2
+		kept, total := LimitFunctions(up, maxBytes)
+		Expect(total).To(Equal(100))
+		Expect(transformations).To(HaveLen(kept))
+		Expect(transformations).To(HaveKey("function-000"))
+		Expect(UpstreamSize(up)).To(BeNumerically("<=", maxBytes))
+	})
+
+	It("only limits the mutations of the functions", func() {
+		up := lambdaUpstream(100)
+		maxBytes := UpstreamSize(up) / 2
+		err := WithFunctionsLimit(func(us *v1.Upstream) error {
+			UpdateFunctionDiscoveryStatus(us, "aws", nil, time.Now())
+			return nil
+		}, maxBytes)(up)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(lambdaNames(up)).To(HaveLen(100))
+		Expect(up.DiscoveryMetadata.FunctionDiscoveryStatus.Warning).To(BeEmpty())
+
+		err = WithFunctionsLimit(func(us *v1.Upstream) error {
+			us.UpstreamSpec.GetAws().LambdaFunctions = lambdaUpstream(101).UpstreamSpec.GetAws().LambdaFunctions
+			return nil
+		}, maxBytes)(up)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(len(lambdaNames(up))).To(BeNumerically("<", 101))
+		Expect(up.DiscoveryMetadata.FunctionDiscoveryStatus.Warning).To(ContainSubstring("of the 101 discovered functions"))
+	})
+})
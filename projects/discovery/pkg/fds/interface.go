@@ -41,7 +41,7 @@ type UpstreamFunctionDiscovery interface {
 	// err != nil temporary error. try again
 	// err == nil spec == nil. no type detected, don't try again
 	// url is never nil
-	DetectType(ctx context.Context, url *url.URL) (*plugins.ServiceSpec, error)
+	DetectType(ctx context.Context, url *url.URL, dependencies func() Dependencies) (*plugins.ServiceSpec, error)
 
 	// url maybe nil if it couldnt be resolved
 	DetectFunctions(ctx context.Context, url *url.URL, dependencies func() Dependencies, out func(UpstreamMutator) error) error
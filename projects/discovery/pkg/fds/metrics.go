@@ -0,0 +1,63 @@
+package fds
+
+import (
+	"context"
+
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+)
+
+const (
+	detectionSuccess = "success"
+	detectionFailure = "failure"
+)
+
+var (
+	mDetections         = stats.Int64("discovery.gloo.solo.io/fds/detections", "The number of attempts to detect the functions of an upstream", "1")
+	discoveryTypeKey, _ = tag.NewKey("discovery_type")
+	resultKey, _        = tag.NewKey("result")
+
+	detectionsView = &view.View{
+		Name:        "discovery.gloo.solo.io/fds/detections",
+		Measure:     mDetections,
+		Description: "The number of attempts to detect the functions of an upstream, by discovery type and result",
+		Aggregation: view.Count(),
+		TagKeys:     []tag.Key{discoveryTypeKey, resultKey},
+	}
+)
+
+func init() {
+	view.Register(detectionsView)
+}
+
+// RecordAttempts wraps an attempt to detect the functions of an upstream, recording its result.
+// The attempt waits for the turn of the upstream, if the upstreams discovered at once are bounded.
+// Successes following failures show that the discovery recovered, rather than retrying forever.
+// The result is also reported on the status of the upstream.
+func RecordAttempts(discoveryType string, attempt func(ctx context.Context) error) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		release, err := acquireTurn(ctx)
+		if err != nil {
+			return err
+		}
+		err = attempt(ctx)
+		release()
+		recordTurnResult(ctx, err)
+		recordDetection(ctx, discoveryType, err)
+		if ctx.Err() == nil {
+			reportStatus(ctx, discoveryType, err)
+		}
+		return err
+	}
+}
+
+func recordDetection(ctx context.Context, discoveryType string, err error) {
+	result := detectionSuccess
+	if err != nil {
+		result = detectionFailure
+	}
+	if ctxWithTags, err := tag.New(ctx, tag.Insert(discoveryTypeKey, discoveryType), tag.Insert(resultKey, result)); err == nil {
+		stats.Record(ctxWithTags, mDetections.M(1))
+	}
+}
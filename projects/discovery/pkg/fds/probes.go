@@ -0,0 +1,80 @@
+package fds
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"net/url"
+
+	"github.com/solo-io/solo-kit/pkg/errors"
+	"golang.org/x/time/rate"
+
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+)
+
+type probeLimiterKey struct{}
+
+// ProbeClient sends the requests of the discoveries to the upstreams. The requests wait for the probe rate limit
+// of their context, if any.
+var ProbeClient = &http.Client{Transport: &probeTransport{base: http.DefaultTransport}}
+
+type probeTransport struct {
+	base http.RoundTripper
+}
+
+func (t *probeTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := WaitForProbe(req.Context()); err != nil {
+		return nil, err
+	}
+	return t.base.RoundTrip(req)
+}
+
+// ProbeTransport returns a transport waiting for the probe rate limit, for clients that need their own settings
+func ProbeTransport() http.RoundTripper {
+	return ProbeClient.Transport
+}
+
+// HttpBaseUrl returns the http address of the upstream at the base url, to resolve the urls of the probes against.
+// The tcp addresses are assumed to serve plain http, the other schemes are not supported by the discovery.
+func HttpBaseUrl(baseurl *url.URL, discoveryType string) (*url.URL, error) {
+	endpoint := *baseurl
+	switch endpoint.Scheme {
+	case "http", "https":
+		// nothing to do as this baseurl already has an http address.
+	case "tcp":
+		endpoint.Scheme = "http"
+	default:
+		return nil, errors.Errorf("unsupported baseurl for %v discovery %v", discoveryType, baseurl)
+	}
+	return &endpoint, nil
+}
+
+// NewProbeLimiter returns the rate limit of the probes, nil if they are not limited
+func NewProbeLimiter(config *v1.DiscoveryProbes) *rate.Limiter {
+	qps := float64(config.GetQps())
+	if qps <= 0 {
+		return nil
+	}
+	burst := int(config.GetBurst())
+	if burst <= 0 {
+		burst = int(math.Ceil(qps))
+	}
+	return rate.NewLimiter(rate.Limit(qps), burst)
+}
+
+// WithProbeLimiter rate limits the probes sent with the context
+func WithProbeLimiter(ctx context.Context, limiter *rate.Limiter) context.Context {
+	if limiter == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, probeLimiterKey{}, limiter)
+}
+
+// WaitForProbe blocks until a probe can be sent with the context, for the discoveries that don't use the ProbeClient
+func WaitForProbe(ctx context.Context) error {
+	limiter, ok := ctx.Value(probeLimiterKey{}).(*rate.Limiter)
+	if !ok {
+		return nil
+	}
+	return limiter.Wait(ctx)
+}
@@ -0,0 +1,144 @@
+package fds_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/solo-io/gloo/projects/discovery/pkg/fds"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins"
+	kubernetes_plugins_gloo_solo_io "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/kubernetes"
+	core_solo_io "github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+// counts the upstreams detected at once
+type concurrentDiscovery struct {
+	current int32
+	max     int32
+	done    int32
+}
+
+func (c *concurrentDiscovery) NewFunctionDiscovery(u *v1.Upstream) UpstreamFunctionDiscovery {
+	return c
+}
+
+func (c *concurrentDiscovery) IsFunctional() bool {
+	return false
+}
+
+func (c *concurrentDiscovery) DetectType(ctx context.Context, url *url.URL, _ func() Dependencies) (*plugins.ServiceSpec, error) {
+	current := atomic.AddInt32(&c.current, 1)
+	for {
+		max := atomic.LoadInt32(&c.max)
+		if current <= max || atomic.CompareAndSwapInt32(&c.max, max, current) {
+			break
+		}
+	}
+	time.Sleep(50 * time.Millisecond)
+	atomic.AddInt32(&c.current, -1)
+	atomic.AddInt32(&c.done, 1)
+	return nil, nil
+}
+
+func (c *concurrentDiscovery) DetectFunctions(ctx context.Context, url *url.URL, _ func() Dependencies, _ func(UpstreamMutator) error) error {
+	return nil
+}
+
+var _ = Describe("Probes", func() {
+
+	var (
+		ctx    context.Context
+		cancel context.CancelFunc
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	It("does not limit the probes if not configured", func() {
+		Expect(NewProbeLimiter(nil)).To(BeNil())
+		Expect(WithProbeLimiter(ctx, nil)).To(Equal(ctx))
+		Expect(WaitForProbe(ctx)).NotTo(HaveOccurred())
+	})
+
+	It("rate limits the requests of the probe client", func() {
+		var requests int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&requests, 1)
+		}))
+		defer server.Close()
+
+		ctx := WithProbeLimiter(ctx, NewProbeLimiter(&v1.DiscoveryProbes{Qps: 20, Burst: 1}))
+		start := time.Now()
+		for i := 0; i < 3; i++ {
+			req, err := http.NewRequest("GET", server.URL, nil)
+			Expect(err).NotTo(HaveOccurred())
+			res, err := ProbeClient.Do(req.WithContext(ctx))
+			Expect(err).NotTo(HaveOccurred())
+			res.Body.Close()
+		}
+		Expect(requests).To(Equal(int32(3)))
+		Expect(time.Since(start)).To(BeNumerically(">=", 90*time.Millisecond))
+	})
+
+	It("stops waiting for a probe when the context is done", func() {
+		limiter := NewProbeLimiter(&v1.DiscoveryProbes{Qps: 0.1, Burst: 1})
+		limited := WithProbeLimiter(ctx, limiter)
+		Expect(WaitForProbe(limited)).NotTo(HaveOccurred())
+		cancel()
+		Expect(WaitForProbe(limited)).To(HaveOccurred())
+	})
+
+	It("bounds the upstreams detected at once", func() {
+		u, err := url.Parse("http://solo.io")
+		Expect(err).NotTo(HaveOccurred())
+		disc := &concurrentDiscovery{}
+		updater := NewUpdater(ctx, &fakeResolver{resolveUrl: u}, &testUpstreamWriterClient{}, 0, []FunctionDiscoveryFactory{disc})
+		updater.SetProbeLimits(&v1.DiscoveryProbes{MaxConcurrentUpstreams: 2})
+		for _, name := range []string{"a", "b", "c", "d", "e"} {
+			updater.UpstreamAdded(&v1.Upstream{
+				Metadata: core_solo_io.Metadata{Namespace: "ns", Name: name},
+				UpstreamSpec: &v1.UpstreamSpec{
+					UpstreamType: &v1.UpstreamSpec_Kube{
+						Kube: &kubernetes_plugins_gloo_solo_io.UpstreamSpec{},
+					},
+				},
+			})
+		}
+		Eventually(func() int32 { return atomic.LoadInt32(&disc.done) }, time.Second).Should(Equal(int32(5)))
+		Expect(atomic.LoadInt32(&disc.max)).To(BeNumerically("<=", 2))
+	})
+
+	Context("base url", func() {
+		It("keeps the http addresses", func() {
+			baseurl := &url.URL{Scheme: "https", Host: "example.com:8443"}
+			endpoint, err := HttpBaseUrl(baseurl, "swagger")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(endpoint.String()).To(Equal("https://example.com:8443"))
+		})
+
+		It("probes the tcp addresses with plain http, without changing the base url", func() {
+			baseurl := &url.URL{Scheme: "tcp", Host: "10.0.0.1:8080"}
+			endpoint, err := HttpBaseUrl(baseurl, "swagger")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(endpoint.String()).To(Equal("http://10.0.0.1:8080"))
+			Expect(baseurl.Scheme).To(Equal("tcp"))
+		})
+
+		It("rejects the other schemes", func() {
+			_, err := HttpBaseUrl(&url.URL{Scheme: "grpc", Host: "10.0.0.1:8080"}, "soap")
+			Expect(err).To(MatchError(ContainSubstring("unsupported baseurl for soap discovery")))
+		})
+	})
+})
@@ -0,0 +1,239 @@
+package fds
+
+import (
+	"container/heap"
+	"context"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/solo-io/go-utils/contextutils"
+)
+
+// Priority of a turn of discovery. The turns are handed out by deadline, the deadline of a turn being the time it was
+// requested plus the delay of its priority, so that urgent turns go first without starving the others.
+type Priority int
+
+const (
+	// the first detection of an upstream, or of its functions
+	PriorityNew Priority = iota
+	// the attempts following a failure
+	PriorityFailing
+	// the polls of the functions of the upstreams that were discovered
+	PrioritySteady
+)
+
+var priorityDelays = map[Priority]time.Duration{
+	PriorityNew:     0,
+	PriorityFailing: 15 * time.Second,
+	PrioritySteady:  time.Minute,
+}
+
+// the polls are spread by this fraction of their interval
+const pollJitter = 0.1
+
+// Scheduler bounds the upstreams discovered at once, handing out the turns by deadline
+type Scheduler struct {
+	lock    sync.Mutex
+	free    uint
+	bounded bool
+	waiting turnQueue
+	seq     uint64
+	now     func() time.Time
+}
+
+// NewScheduler returns a scheduler running at most maxConcurrent turns at once, not bounded if 0
+func NewScheduler(maxConcurrent uint) *Scheduler {
+	return &Scheduler{
+		free:    maxConcurrent,
+		bounded: maxConcurrent > 0,
+		now:     time.Now,
+	}
+}
+
+type turn struct {
+	deadline time.Time
+	seq      uint64
+	ready    chan struct{}
+	index    int
+}
+
+type turnQueue []*turn
+
+func (q turnQueue) Len() int { return len(q) }
+
+func (q turnQueue) Less(i, j int) bool {
+	if q[i].deadline.Equal(q[j].deadline) {
+		return q[i].seq < q[j].seq
+	}
+	return q[i].deadline.Before(q[j].deadline)
+}
+
+func (q turnQueue) Swap(i, j int) {
+	q[i], q[j] = q[j], q[i]
+	q[i].index = i
+	q[j].index = j
+}
+
+func (q *turnQueue) Push(x interface{}) {
+	t := x.(*turn)
+	t.index = len(*q)
+	*q = append(*q, t)
+}
+
+func (q *turnQueue) Pop() interface{} {
+	old := *q
+	t := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return t
+}
+
+// Acquire waits for a turn of the priority. The returned func gives the turn back.
+func (s *Scheduler) Acquire(ctx context.Context, priority Priority) (func(), error) {
+	if s == nil || !s.bounded {
+		return func() {}, nil
+	}
+	s.lock.Lock()
+	if s.free > 0 {
+		s.free--
+		s.lock.Unlock()
+		return s.releaseOnce(), nil
+	}
+	t := &turn{
+		deadline: s.now().Add(priorityDelays[priority]),
+		seq:      s.seq,
+		ready:    make(chan struct{}),
+	}
+	s.seq++
+	heap.Push(&s.waiting, t)
+	s.lock.Unlock()
+
+	select {
+	case <-t.ready:
+		return s.releaseOnce(), nil
+	case <-ctx.Done():
+		s.lock.Lock()
+		defer s.lock.Unlock()
+		select {
+		case <-t.ready:
+			// the turn was handed out in the meantime, pass it on
+			s.release()
+		default:
+			heap.Remove(&s.waiting, t.index)
+		}
+		return nil, ctx.Err()
+	}
+}
+
+func (s *Scheduler) releaseOnce() func() {
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			s.lock.Lock()
+			defer s.lock.Unlock()
+			s.release()
+		})
+	}
+}
+
+// hands the turn to the waiting turn with the earliest deadline. must be called with the lock held.
+func (s *Scheduler) release() {
+	if len(s.waiting) == 0 {
+		s.free++
+		return
+	}
+	close(heap.Pop(&s.waiting).(*turn).ready)
+}
+
+// the history of the discovery of an upstream, kept across the restarts of its discovery
+type upstreamSchedule struct {
+	scheduler *Scheduler
+
+	lock      sync.Mutex
+	attempted bool
+	failed    bool
+	// the polls since the discovery of the upstream was (re)started
+	polls int
+}
+
+func (u *upstreamSchedule) priority() Priority {
+	u.lock.Lock()
+	defer u.lock.Unlock()
+	switch {
+	case !u.attempted:
+		return PriorityNew
+	case u.failed:
+		return PriorityFailing
+	}
+	return PrioritySteady
+}
+
+// the discovery of the upstream is (re)started
+func (u *upstreamSchedule) restart() {
+	u.lock.Lock()
+	defer u.lock.Unlock()
+	u.polls = 0
+}
+
+// the upstream is discovered again as a new one
+func (u *upstreamSchedule) reset() {
+	u.lock.Lock()
+	defer u.lock.Unlock()
+	u.attempted = false
+	u.failed = false
+}
+
+func (u *upstreamSchedule) recordResult(err error) {
+	u.lock.Lock()
+	defer u.lock.Unlock()
+	u.attempted = true
+	u.failed = err != nil
+}
+
+// the first poll after a (re)start is spread across the interval, so that upstreams discovered together are not
+// polled together. the following polls are jittered.
+func (u *upstreamSchedule) nextPoll(interval time.Duration) time.Duration {
+	u.lock.Lock()
+	defer u.lock.Unlock()
+	u.polls++
+	if u.polls == 1 {
+		return time.Duration(rand.Int63n(int64(interval))) + 1
+	}
+	return interval + time.Duration((rand.Float64()*2-1)*pollJitter*float64(interval))
+}
+
+type scheduleKey struct{}
+
+func withSchedule(ctx context.Context, schedule *upstreamSchedule) context.Context {
+	return context.WithValue(ctx, scheduleKey{}, schedule)
+}
+
+func scheduleFrom(ctx context.Context) (*upstreamSchedule, bool) {
+	schedule, ok := ctx.Value(scheduleKey{}).(*upstreamSchedule)
+	return schedule, ok
+}
+
+// waits for the turn of an attempt of the upstream of the context, by the outcome of its last attempts
+func acquireTurn(ctx context.Context) (func(), error) {
+	schedule, ok := scheduleFrom(ctx)
+	if !ok {
+		return func() {}, nil
+	}
+	return schedule.scheduler.Acquire(ctx, schedule.priority())
+}
+
+func recordTurnResult(ctx context.Context, err error) {
+	if schedule, ok := scheduleFrom(ctx); ok {
+		schedule.recordResult(err)
+	}
+}
+
+// WaitForPoll waits until the functions of the upstream of the context are polled again. The polls of the upstreams
+// are spread with jitter across the interval, rather than all happening at once.
+func WaitForPoll(ctx context.Context, interval time.Duration) error {
+	schedule, ok := scheduleFrom(ctx)
+	if !ok || interval <= 0 {
+		return contextutils.Sleep(ctx, interval)
+	}
+	return contextutils.Sleep(ctx, schedule.nextPoll(interval))
+}
@@ -0,0 +1,107 @@
+package fds_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/solo-io/gloo/projects/discovery/pkg/fds"
+)
+
+var _ = Describe("Scheduler", func() {
+
+	var (
+		ctx    context.Context
+		cancel context.CancelFunc
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	// requests a turn in the background, sending the priority once the turn is acquired
+	acquire := func(scheduler *Scheduler, priority Priority, acquired chan Priority) {
+		go func() {
+			defer GinkgoRecover()
+			release, err := scheduler.Acquire(ctx, priority)
+			if err != nil {
+				return
+			}
+			acquired <- priority
+			release()
+		}()
+		// let the turn be queued
+		time.Sleep(20 * time.Millisecond)
+	}
+
+	It("does not bound the turns if not configured", func() {
+		scheduler := NewScheduler(0)
+		for i := 0; i < 10; i++ {
+			_, err := scheduler.Acquire(ctx, PrioritySteady)
+			Expect(err).NotTo(HaveOccurred())
+		}
+	})
+
+	It("hands out the turns of new upstreams first", func() {
+		scheduler := NewScheduler(1)
+		release, err := scheduler.Acquire(ctx, PrioritySteady)
+		Expect(err).NotTo(HaveOccurred())
+
+		acquired := make(chan Priority, 3)
+		acquire(scheduler, PrioritySteady, acquired)
+		acquire(scheduler, PriorityFailing, acquired)
+		acquire(scheduler, PriorityNew, acquired)
+		Consistently(acquired).ShouldNot(Receive())
+
+		release()
+		Eventually(acquired).Should(Receive(Equal(PriorityNew)))
+		Eventually(acquired).Should(Receive(Equal(PriorityFailing)))
+		Eventually(acquired).Should(Receive(Equal(PrioritySteady)))
+	})
+
+	It("gives the turn back once", func() {
+		scheduler := NewScheduler(1)
+		release, err := scheduler.Acquire(ctx, PriorityNew)
+		Expect(err).NotTo(HaveOccurred())
+		release()
+		release()
+
+		_, err = scheduler.Acquire(ctx, PriorityNew)
+		Expect(err).NotTo(HaveOccurred())
+		acquired := make(chan Priority, 1)
+		acquire(scheduler, PriorityNew, acquired)
+		Consistently(acquired).ShouldNot(Receive())
+	})
+
+	It("stops waiting for a turn when the context is done", func() {
+		scheduler := NewScheduler(1)
+		release, err := scheduler.Acquire(ctx, PriorityNew)
+		Expect(err).NotTo(HaveOccurred())
+
+		waitCtx, waitCancel := context.WithCancel(ctx)
+		errs := make(chan error, 1)
+		go func() {
+			_, err := scheduler.Acquire(waitCtx, PriorityNew)
+			errs <- err
+		}()
+		waitCancel()
+		Eventually(errs).Should(Receive(HaveOccurred()))
+
+		// the cancelled turn is not handed out
+		release()
+		_, err = scheduler.Acquire(ctx, PrioritySteady)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("waits for the poll interval of the upstreams without a schedule", func() {
+		start := time.Now()
+		Expect(WaitForPoll(ctx, 50*time.Millisecond)).NotTo(HaveOccurred())
+		Expect(time.Since(start)).To(BeNumerically(">=", 50*time.Millisecond))
+	})
+})
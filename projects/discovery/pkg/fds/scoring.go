@@ -0,0 +1,127 @@
+package fds
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins"
+)
+
+// ServiceTypeAnnotation forces the type of service an upstream is detected as, if its discovery metadata doesn't
+const ServiceTypeAnnotation = "discovery.solo.io/service-type"
+
+// DefaultDetectionWindow is how long the other discoveries have to detect the upstream after the first detection
+const DefaultDetectionWindow = 5 * time.Second
+
+// the types of service of the specs detected by the discoveries
+const (
+	ServiceTypeSwagger  = "swagger"
+	ServiceTypeGraphQL  = "graphql"
+	ServiceTypeSoap     = "soap"
+	ServiceTypeWebhook  = "webhook"
+	ServiceTypeAsyncApi = "asyncapi"
+	ServiceTypeRest     = "rest"
+	ServiceTypeGrpc     = "grpc"
+	ServiceTypeOpenFaaS = "openfaas"
+	ServiceTypeThrift   = "thrift"
+)
+
+// ServiceType returns the type of service a detected spec describes
+func ServiceType(spec *plugins.ServiceSpec) string {
+	switch {
+	case spec.GetGrpc() != nil:
+		return ServiceTypeGrpc
+	case spec.GetThrift() != nil:
+		return ServiceTypeThrift
+	case spec.GetOpenfaas() != nil:
+		return ServiceTypeOpenFaaS
+	}
+	rest := spec.GetRest()
+	switch {
+	case rest.GetGraphqlInfo() != nil:
+		return ServiceTypeGraphQL
+	case rest.GetSoapInfo() != nil:
+		return ServiceTypeSoap
+	case rest.GetAsyncApiInfo() != nil:
+		return ServiceTypeAsyncApi
+	case rest.GetWebhookInfo() != nil:
+		return ServiceTypeWebhook
+	case rest.GetSwaggerInfo() != nil:
+		return ServiceTypeSwagger
+	}
+	return ServiceTypeRest
+}
+
+// ForcedServiceType returns the type of service the upstream must be detected as, empty if it is not forced
+func ForcedServiceType(us *v1.Upstream) string {
+	if serviceType := us.GetDiscoveryMetadata().GetServiceType(); serviceType != "" {
+		return serviceType
+	}
+	return us.Metadata.Annotations[ServiceTypeAnnotation]
+}
+
+// DetectionScorer scores the detections of an upstream, when more than one discovery detected it.
+// The detection with the highest score wins, the first detection on a tie.
+type DetectionScorer interface {
+	// Score returns the confidence in the detection, and the reason for it
+	Score(upstream *v1.Upstream, spec *plugins.ServiceSpec) (float64, string)
+}
+
+type serviceTypeScore struct {
+	score  float64
+	reason string
+}
+
+// DefaultDetectionScorer scores the detections by the type of service: the protocols the services describe themselves
+// with are trusted over the documents served next to them, which may describe another service, e.g. a gateway.
+type DefaultDetectionScorer struct{}
+
+var serviceTypeScores = map[string]serviceTypeScore{
+	ServiceTypeGrpc:     {0.9, "the service answered gRPC reflection"},
+	ServiceTypeThrift:   {0.8, "the service serves its thrift IDL"},
+	ServiceTypeGraphQL:  {0.7, "the service answered a GraphQL introspection query"},
+	ServiceTypeSoap:     {0.6, "the service serves a WSDL"},
+	ServiceTypeAsyncApi: {0.6, "the service serves an AsyncAPI document"},
+	ServiceTypeOpenFaaS: {0.6, "the upstream is an OpenFaaS gateway"},
+	ServiceTypeSwagger:  {0.5, "the service serves a swagger document, which may describe a gateway in front of it"},
+	ServiceTypeWebhook:  {0.3, "the function discovery webhook accepted the upstream"},
+}
+
+func (DefaultDetectionScorer) Score(_ *v1.Upstream, spec *plugins.ServiceSpec) (float64, string) {
+	if score, ok := serviceTypeScores[ServiceType(spec)]; ok {
+		return score.score, score.reason
+	}
+	return 0.1, "the type of service is not known to the scorer"
+}
+
+type scoredDetection struct {
+	detectResult
+	score float64
+}
+
+// chooseDetection returns the detection with the highest score, with the reason it won
+func chooseDetection(scorer DetectionScorer, upstream *v1.Upstream, results []detectResult) detectResult {
+	var scored []scoredDetection
+	for _, res := range results {
+		score, reason := scorer.Score(upstream, res.spec)
+		res.serviceType = ServiceType(res.spec)
+		res.reason = reason
+		scored = append(scored, scoredDetection{detectResult: res, score: score})
+	}
+	sort.SliceStable(scored, func(i, j int) bool {
+		return scored[i].score > scored[j].score
+	})
+	winner := scored[0]
+	if len(scored) == 1 {
+		return winner.detectResult
+	}
+	var others []string
+	for _, other := range scored[1:] {
+		others = append(others, fmt.Sprintf("%v (%.2f)", other.serviceType, other.score))
+	}
+	winner.reason = fmt.Sprintf("%v (%.2f) won over %v: %v", winner.serviceType, winner.score, strings.Join(others, ", "), winner.reason)
+	return winner.detectResult
+}
@@ -0,0 +1,122 @@
+package fds
+
+import (
+	"context"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/gogo/protobuf/types"
+	"github.com/pkg/errors"
+	"github.com/solo-io/go-utils/contextutils"
+
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+)
+
+type statusReporterKey struct{}
+
+// the attempts recorded with the returned context are reported on the status of the upstream
+func withStatusReporter(ctx context.Context, u *updaterUpdater) context.Context {
+	return context.WithValue(ctx, statusReporterKey{}, u)
+}
+
+func reportStatus(ctx context.Context, discoveryType string, err error) {
+	u, ok := ctx.Value(statusReporterKey{}).(*updaterUpdater)
+	if !ok {
+		return
+	}
+	if !functionDiscoveryStatusChanged(u.upstream, discoveryType, err) {
+		// every write of the upstream restarts its discovery, so an unchanged outcome is not written
+		return
+	}
+	now := time.Now()
+	if saveErr := u.saveUpstream(func(upstream *v1.Upstream) error {
+		UpdateFunctionDiscoveryStatus(upstream, discoveryType, err, now)
+		return nil
+	}); saveErr != nil {
+		contextutils.LoggerFrom(ctx).Warnw("unable to report function discovery status", "upstream", u.upstream.Metadata.Name, "error", saveErr)
+	}
+}
+
+// UpdateFunctionDiscoveryStatus records the outcome of an attempt on the status of the upstream.
+// The status is left untouched if the outcome is the same as the last one recorded.
+func UpdateFunctionDiscoveryStatus(upstream *v1.Upstream, discoveryType string, err error, now time.Time) {
+	if !functionDiscoveryStatusChanged(upstream, discoveryType, err) {
+		return
+	}
+	var lastError string
+	if err != nil {
+		lastError = statusError(err)
+	}
+	status := upstream.GetDiscoveryMetadata().GetFunctionDiscoveryStatus()
+	// the times of the attempts are within the range of the timestamps
+	attempt, _ := types.TimestampProto(now)
+	if upstream.DiscoveryMetadata == nil {
+		upstream.DiscoveryMetadata = &v1.DiscoveryMetadata{}
+	}
+	updated := &v1.FunctionDiscoveryStatus{
+		DiscoveryType: discoveryType,
+		LastAttempt:   attempt,
+		LastError:     lastError,
+		// set by the limits of the functions
+		Warning: status.GetWarning(),
+		// set by the detection of the upstream
+		DetectedServiceType: status.GetDetectedServiceType(),
+		DetectionReason:     status.GetDetectionReason(),
+	}
+	if err == nil {
+		updated.LastSuccess = attempt
+	} else if status != nil {
+		updated.LastSuccess = status.LastSuccess
+	}
+	upstream.DiscoveryMetadata.FunctionDiscoveryStatus = updated
+}
+
+// whether the outcome of an attempt differs from the last one recorded on the status of the upstream
+func functionDiscoveryStatusChanged(upstream *v1.Upstream, discoveryType string, err error) bool {
+	var lastError string
+	if err != nil {
+		lastError = statusError(err)
+	}
+	status := upstream.GetDiscoveryMetadata().GetFunctionDiscoveryStatus()
+	return status.GetLastAttempt() == nil || status.DiscoveryType != discoveryType || status.LastError != lastError
+}
+
+// the error recorded on the status, without the details that change on every attempt, such as the request ids of the
+// aws errors or the local ports of the connections, so that the same failure is not written again on every retry
+func statusError(err error) string {
+	cause := errors.Cause(err)
+	return strings.Replace(err.Error(), cause.Error(), stableError(cause), 1)
+}
+
+func stableError(err error) string {
+	switch err := err.(type) {
+	case awserr.Error:
+		msg := err.Code() + ": " + err.Message()
+		if orig := err.OrigErr(); orig != nil {
+			msg += "\ncaused by: " + stableError(orig)
+		}
+		return msg
+	case *url.Error:
+		return err.Op + " " + err.URL + ": " + stableError(err.Err)
+	case *net.OpError:
+		withoutSource := *err
+		withoutSource.Source = nil
+		return withoutSource.Error()
+	}
+	return err.Error()
+}
+
+// RecordDetection records on the status of the upstream the type of service it was detected as, and why
+func RecordDetection(upstream *v1.Upstream, serviceType, reason string) {
+	if upstream.DiscoveryMetadata == nil {
+		upstream.DiscoveryMetadata = &v1.DiscoveryMetadata{}
+	}
+	if upstream.DiscoveryMetadata.FunctionDiscoveryStatus == nil {
+		upstream.DiscoveryMetadata.FunctionDiscoveryStatus = &v1.FunctionDiscoveryStatus{}
+	}
+	upstream.DiscoveryMetadata.FunctionDiscoveryStatus.DetectedServiceType = serviceType
+	upstream.DiscoveryMetadata.FunctionDiscoveryStatus.DetectionReason = reason
+}
@@ -0,0 +1,97 @@
+package fds_test
+
+import (
+	"errors"
+	"net"
+	"net/url"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	pkgerrors "github.com/pkg/errors"
+
+	"github.com/gogo/protobuf/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/solo-io/gloo/projects/discovery/pkg/fds"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+)
+
+var _ = Describe("Function discovery status", func() {
+
+	var (
+		up  *v1.Upstream
+		now time.Time
+	)
+
+	status := func() *v1.FunctionDiscoveryStatus {
+		return up.GetDiscoveryMetadata().GetFunctionDiscoveryStatus()
+	}
+
+	BeforeEach(func() {
+		up = &v1.Upstream{}
+		now = time.Now().UTC()
+	})
+
+	It("records a success", func() {
+		UpdateFunctionDiscoveryStatus(up, "swagger", nil, now)
+		Expect(status().DiscoveryType).To(Equal("swagger"))
+		Expect(types.TimestampFromProto(status().LastAttempt)).To(Equal(now))
+		Expect(types.TimestampFromProto(status().LastSuccess)).To(Equal(now))
+		Expect(status().LastError).To(BeEmpty())
+	})
+
+	It("records a failure, keeping the last success", func() {
+		UpdateFunctionDiscoveryStatus(up, "swagger", nil, now)
+		later := now.Add(time.Second)
+		UpdateFunctionDiscoveryStatus(up, "swagger", errors.New("unreachable"), later)
+		Expect(types.TimestampFromProto(status().LastAttempt)).To(Equal(later))
+		Expect(types.TimestampFromProto(status().LastSuccess)).To(Equal(now))
+		Expect(status().LastError).To(Equal("unreachable"))
+	})
+
+	It("does not update the status when the outcome is unchanged", func() {
+		UpdateFunctionDiscoveryStatus(up, "grpc", errors.New("unreachable"), now)
+		UpdateFunctionDiscoveryStatus(up, "grpc", errors.New("unreachable"), now.Add(time.Second))
+		Expect(types.TimestampFromProto(status().LastAttempt)).To(Equal(now))
+		Expect(status().LastError).To(Equal("unreachable"))
+
+		UpdateFunctionDiscoveryStatus(up, "grpc", nil, now)
+		UpdateFunctionDiscoveryStatus(up, "grpc", nil, now.Add(time.Hour))
+		Expect(types.TimestampFromProto(status().LastSuccess)).To(Equal(now))
+	})
+
+	It("updates the status when the error changes", func() {
+		UpdateFunctionDiscoveryStatus(up, "grpc", errors.New("unreachable"), now)
+		later := now.Add(time.Second)
+		UpdateFunctionDiscoveryStatus(up, "grpc", errors.New("timeout"), later)
+		Expect(types.TimestampFromProto(status().LastAttempt)).To(Equal(later))
+		Expect(status().LastError).To(Equal("timeout"))
+	})
+
+	It("ignores the request ids of the aws errors", func() {
+		failure := func(requestID string) error {
+			return pkgerrors.Wrap(awserr.NewRequestFailure(awserr.New("AccessDeniedException", "not authorized", nil), 403, requestID), "unable to list functions")
+		}
+		UpdateFunctionDiscoveryStatus(up, "aws", failure("1b2c"), now)
+		UpdateFunctionDiscoveryStatus(up, "aws", failure("3d4e"), now.Add(time.Second))
+		Expect(types.TimestampFromProto(status().LastAttempt)).To(Equal(now))
+		Expect(status().LastError).To(Equal("unable to list functions: AccessDeniedException: not authorized"))
+	})
+
+	It("ignores the local ports of the failed connections", func() {
+		failure := func(port int) error {
+			return &url.Error{Op: "Get", URL: "http://petstore:8080/swagger.json", Err: &net.OpError{
+				Op:     "read",
+				Net:    "tcp",
+				Source: &net.TCPAddr{IP: net.IPv4(10, 0, 0, 1), Port: port},
+				Addr:   &net.TCPAddr{IP: net.IPv4(10, 0, 0, 2), Port: 8080},
+				Err:    errors.New("connection reset by peer"),
+			}}
+		}
+		UpdateFunctionDiscoveryStatus(up, "swagger", failure(40001), now)
+		UpdateFunctionDiscoveryStatus(up, "swagger", failure(40002), now.Add(time.Second))
+		Expect(types.TimestampFromProto(status().LastAttempt)).To(Equal(now))
+		Expect(status().LastError).To(Equal("Get http://petstore:8080/swagger.json: read tcp 10.0.0.2:8080: connection reset by peer"))
+	})
+})
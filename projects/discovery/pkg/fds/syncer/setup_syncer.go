@@ -3,10 +3,21 @@ package syncer
 import (
 	"time"
 
+	"github.com/solo-io/gloo/projects/discovery/pkg/chaos"
+	"github.com/solo-io/gloo/projects/discovery/pkg/dryrun"
 	"github.com/solo-io/gloo/projects/discovery/pkg/fds"
+	"github.com/solo-io/gloo/projects/discovery/pkg/fds/discoveries/alibaba"
+	"github.com/solo-io/gloo/projects/discovery/pkg/fds/discoveries/asyncapi"
 	"github.com/solo-io/gloo/projects/discovery/pkg/fds/discoveries/aws"
+	"github.com/solo-io/gloo/projects/discovery/pkg/fds/discoveries/azure"
+	"github.com/solo-io/gloo/projects/discovery/pkg/fds/discoveries/graphql"
 	"github.com/solo-io/gloo/projects/discovery/pkg/fds/discoveries/grpc"
+	"github.com/solo-io/gloo/projects/discovery/pkg/fds/discoveries/openfaas"
+	"github.com/solo-io/gloo/projects/discovery/pkg/fds/discoveries/openwhisk"
+	"github.com/solo-io/gloo/projects/discovery/pkg/fds/discoveries/soap"
 	"github.com/solo-io/gloo/projects/discovery/pkg/fds/discoveries/swagger"
+	"github.com/solo-io/gloo/projects/discovery/pkg/fds/discoveries/thrift"
+	"github.com/solo-io/gloo/projects/discovery/pkg/fds/discoveries/webhook"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/gloo/pkg/bootstrap"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/registry"
@@ -18,6 +29,18 @@ func RunFDS(opts bootstrap.Opts) error {
 	watchOpts := opts.WatchOpts.WithDefaults()
 	watchOpts.Ctx = contextutils.WithLogger(watchOpts.Ctx, "fds")
 
+	// the discovery of the previous settings was stopped when the settings changed, it is resumed by running again
+	if opts.Settings.GetDiscovery().GetFdsMode() == v1.DiscoveryOptions_PAUSED {
+		contextutils.LoggerFrom(watchOpts.Ctx).Infof("function discovery is paused by the settings")
+		return nil
+	}
+
+	kubeClient, cloudClient, err := chaos.Setup(watchOpts.Ctx, opts.KubeClient)
+	if err != nil {
+		return err
+	}
+	opts.KubeClient = kubeClient
+
 	upstreamClient, err := v1.NewUpstreamClient(opts.Upstreams)
 	if err != nil {
 		return err
@@ -25,6 +48,10 @@ func RunFDS(opts bootstrap.Opts) error {
 	if err := upstreamClient.Register(); err != nil {
 		return err
 	}
+	dryRun := opts.Settings.GetDiscoveryDryRun() != nil
+	if dryRun {
+		upstreamClient = dryrun.NewUpstreamClient(upstreamClient, dryrun.DefaultRecorder, "fds")
+	}
 	secretClient, err := v1.NewSecretClient(opts.Secrets)
 	if err != nil {
 		return err
@@ -33,6 +60,14 @@ func RunFDS(opts bootstrap.Opts) error {
 		return err
 	}
 
+	artifactClient, err := v1.NewArtifactClient(opts.Artifacts)
+	if err != nil {
+		return err
+	}
+	if err := artifactClient.Register(); err != nil {
+		return err
+	}
+
 	cache := v1.NewDiscoveryEmitter(upstreamClient, secretClient)
 
 	var resolvers fds.Resolvers
@@ -47,19 +82,78 @@ func RunFDS(opts bootstrap.Opts) error {
 	functionalPlugins := []fds.FunctionDiscoveryFactory{
 		&aws.AWSLambdaFunctionDiscoveryFactory{
 			PollingTime: time.Second,
+			HTTPClient:  cloudClient,
+		},
+		&azure.AzureFunctionDiscoveryFactory{
+			PollingTime: time.Second * 15,
+			HTTPClient:  cloudClient,
+		},
+		&alibaba.FunctionComputeDiscoveryFactory{
+			PollingTime: time.Second * 15,
+			HTTPClient:  cloudClient,
+		},
+		&openwhisk.OpenWhiskActionDiscoveryFactory{
+			PollingTime: time.Second * 15,
+			HTTPClient:  cloudClient,
 		},
 		&swagger.SwaggerFunctionDiscoveryFactory{
 			DetectionTimeout: time.Minute,
 			FunctionPollTime: time.Second * 15,
 		},
+		&graphql.GraphQLFunctionDiscoveryFactory{
+			DetectionTimeout: time.Minute,
+			FunctionPollTime: time.Second * 15,
+		},
+		&soap.SoapFunctionDiscoveryFactory{
+			DetectionTimeout: time.Minute,
+			FunctionPollTime: time.Second * 15,
+		},
 		&grpc.FunctionDiscoveryFactory{
 			DetectionTimeout: time.Minute,
 			FunctionPollTime: time.Second * 15,
 		},
+		&openfaas.OpenFaaSFunctionDiscoveryFactory{
+			DetectionTimeout: time.Minute,
+			FunctionPollTime: time.Second * 15,
+		},
+		&thrift.ThriftFunctionDiscoveryFactory{
+			DetectionTimeout: time.Minute,
+			FunctionPollTime: time.Second * 15,
+			Artifacts:        artifactClient,
+		},
+		&asyncapi.AsyncApiFunctionDiscoveryFactory{
+			DetectionTimeout: time.Minute,
+			FunctionPollTime: time.Second * 15,
+		},
 	}
 
-	// TODO(yuval-k): max Concurrency here
-	updater := fds.NewUpdater(watchOpts.Ctx, resolvers, upstreamClient, 0, functionalPlugins)
+	for _, hook := range opts.Settings.GetFunctionDiscoveryWebhooks() {
+		if hook.GetUrl() == "" {
+			contextutils.LoggerFrom(watchOpts.Ctx).Warnf("ignoring function discovery webhook without url")
+			continue
+		}
+		functionalPlugins = append(functionalPlugins, &webhook.WebhookFunctionDiscoveryFactory{
+			Webhook:          hook,
+			DetectionTimeout: time.Minute,
+			FunctionPollTime: time.Second * 15,
+		})
+	}
+
+	// batch the writes so that discovering many upstreams does not flood the api server
+	writer := fds.NewWriteCoalescer(upstreamClient, opts.Settings.GetDiscoveryWrites())
+	go writer.Run(watchOpts.Ctx)
+
+	updater := fds.NewUpdater(watchOpts.Ctx, resolvers, writer, 0, functionalPlugins)
+	// bound the probes so that discovering many upstreams at once does not flood them
+	updater.SetProbeLimits(opts.Settings.GetDiscoveryProbes())
+	if opts.KubeClient != nil && !dryRun {
+		// remember the undetectable upstreams across restarts
+		updater.SetDetectionCache(fds.NewConfigMapDetectionCache(opts.KubeClient, opts.WriteNamespace))
+	}
+	if opts.KubeClient != nil {
+		// hint the routes to the upstreams of short-lived and scale-to-zero workloads to wait for them to start
+		updater.SetColdStartDetector(fds.NewKubeColdStartDetector(opts.KubeClient))
+	}
 	disc := fds.NewFunctionDiscovery(updater)
 
 	sync := NewDiscoverySyncer(disc)
@@ -73,6 +167,14 @@ func RunFDS(opts bootstrap.Opts) error {
 	}
 	go errutils.AggregateErrs(watchOpts.Ctx, errs, eventLoopErrs, "event_loop.fds")
 
+	if triggers := opts.Settings.GetDiscoveryTriggers(); triggers != nil {
+		go func() {
+			if err := fds.ServeTriggers(watchOpts.Ctx, updater, triggers); err != nil {
+				errs <- err
+			}
+		}()
+	}
+
 	logger := contextutils.LoggerFrom(watchOpts.Ctx)
 
 	go func() {
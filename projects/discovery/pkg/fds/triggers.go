@@ -0,0 +1,115 @@
+package fds
+
+import (
+	"context"
+	"crypto/subtle"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/solo-io/go-utils/contextutils"
+
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+)
+
+const (
+	DefaultTriggersBindAddr = ":9979"
+
+	triggersPathPrefix = "/upstreams/"
+	triggersPathSuffix = "/discover"
+	triggersTokenField = "token"
+)
+
+type triggersHandler struct {
+	updater *Updater
+	config  *v1.DiscoveryTriggers
+}
+
+// NewTriggersHandler serves the `POST /upstreams/<namespace>/<name>/discover` requests restarting the discovery of an upstream
+func NewTriggersHandler(updater *Updater, config *v1.DiscoveryTriggers) http.Handler {
+	return &triggersHandler{
+		updater: updater,
+		config:  config,
+	}
+}
+
+func (h *triggersHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !strings.HasPrefix(r.URL.Path, triggersPathPrefix) || !strings.HasSuffix(r.URL.Path, triggersPathSuffix) {
+		http.NotFound(w, r)
+		return
+	}
+	parts := strings.Split(strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, triggersPathPrefix), triggersPathSuffix), "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		http.NotFound(w, r)
+		return
+	}
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	token, err := h.token()
+	if err != nil {
+		contextutils.LoggerFrom(r.Context()).Warnw("unable to authenticate discovery trigger", "error", err)
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+	bearer := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if subtle.ConstantTimeCompare([]byte(bearer), []byte(token)) != 1 {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	namespace, name := parts[0], parts[1]
+	if !h.updater.Trigger(namespace, name) {
+		http.Error(w, "upstream not discovered", http.StatusNotFound)
+		return
+	}
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// the token is read from the latest secrets, so it can be rotated without restarting discovery
+func (h *triggersHandler) token() (string, error) {
+	ref := h.config.TokenSecretRef
+	if ref == nil {
+		return "", errors.New("no token secret configured for discovery triggers")
+	}
+	secret, err := h.updater.GetSecrets().Find(ref.Strings())
+	if err != nil {
+		return "", errors.Wrapf(err, "token secret not found")
+	}
+	extension, ok := secret.Kind.(*v1.Secret_Extension)
+	if !ok || extension.Extension.GetConfig() == nil {
+		return "", errors.Errorf("%v is not an extension secret", secret.Metadata.Ref())
+	}
+	token := extension.Extension.Config.Fields[triggersTokenField].GetStringValue()
+	if token == "" {
+		return "", errors.Errorf("secret %v has no %v", secret.Metadata.Ref(), triggersTokenField)
+	}
+	return token, nil
+}
+
+// ServeTriggers serves the discovery triggers until the context is done
+func ServeTriggers(ctx context.Context, updater *Updater, config *v1.DiscoveryTriggers) error {
+	bindAddr := config.BindAddr
+	if bindAddr == "" {
+		bindAddr = DefaultTriggersBindAddr
+	}
+	server := &http.Server{
+		Addr:    bindAddr,
+		Handler: NewTriggersHandler(updater, config),
+	}
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		server.Shutdown(shutdownCtx)
+	}()
+	contextutils.LoggerFrom(ctx).Infof("serving discovery triggers on %v", bindAddr)
+	if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		return errors.Wrapf(err, "serving discovery triggers on %v", bindAddr)
+	}
+	return nil
+}
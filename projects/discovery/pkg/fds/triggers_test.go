@@ -0,0 +1,90 @@
+package fds_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+
+	"github.com/gogo/protobuf/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/solo-io/gloo/projects/discovery/pkg/fds"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	kubernetes_plugins_gloo_solo_io "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/kubernetes"
+	core_solo_io "github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+var _ = Describe("Triggers", func() {
+
+	var (
+		ctx     context.Context
+		cancel  context.CancelFunc
+		handler http.Handler
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		u, err := url.Parse("http://solo.io")
+		Expect(err).NotTo(HaveOccurred())
+		testDisc := &testDiscovery{isUpstreamFunctionalResult: true}
+		testDisc.functionsCalled.Store(functionsCalled{})
+		updater := NewUpdater(ctx, &fakeResolver{resolveUrl: u}, &testUpstreamWriterClient{}, 0, []FunctionDiscoveryFactory{testDisc})
+		updater.SetSecrets(v1.SecretList{{
+			Metadata: core_solo_io.Metadata{Namespace: "gloo-system", Name: "triggers"},
+			Kind: &v1.Secret_Extension{
+				Extension: &v1.Extension{
+					Config: &types.Struct{
+						Fields: map[string]*types.Value{
+							"token": {Kind: &types.Value_StringValue{StringValue: "s3cr3t"}},
+						},
+					},
+				},
+			},
+		}})
+		updater.UpstreamAdded(&v1.Upstream{
+			Metadata: core_solo_io.Metadata{Namespace: "ns", Name: "up"},
+			UpstreamSpec: &v1.UpstreamSpec{
+				UpstreamType: &v1.UpstreamSpec_Kube{
+					Kube: &kubernetes_plugins_gloo_solo_io.UpstreamSpec{},
+				},
+			},
+		})
+		handler = NewTriggersHandler(updater, &v1.DiscoveryTriggers{
+			TokenSecretRef: &core_solo_io.ResourceRef{Namespace: "gloo-system", Name: "triggers"},
+		})
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	trigger := func(method, path, token string) int {
+		req := httptest.NewRequest(method, path, nil)
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	It("triggers the discovery of an upstream", func() {
+		Expect(trigger("POST", "/upstreams/ns/up/discover", "s3cr3t")).To(Equal(http.StatusAccepted))
+	})
+
+	It("rejects requests without the token", func() {
+		Expect(trigger("POST", "/upstreams/ns/up/discover", "")).To(Equal(http.StatusUnauthorized))
+		Expect(trigger("POST", "/upstreams/ns/up/discover", "guess")).To(Equal(http.StatusUnauthorized))
+	})
+
+	It("returns not found for unknown upstreams", func() {
+		Expect(trigger("POST", "/upstreams/ns/other/discover", "s3cr3t")).To(Equal(http.StatusNotFound))
+		Expect(trigger("POST", "/upstreams/ns/discover", "s3cr3t")).To(Equal(http.StatusNotFound))
+	})
+
+	It("only accepts posts", func() {
+		Expect(trigger("GET", "/upstreams/ns/up/discover", "s3cr3t")).To(Equal(http.StatusMethodNotAllowed))
+	})
+})
@@ -3,9 +3,11 @@ package fds
 import (
 	"context"
 	"errors"
+	"fmt"
 	"net/url"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/gogo/protobuf/proto"
 
@@ -16,6 +18,7 @@ import (
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 )
 
 var errorUndetectableUpstream = errors.New("upstream type cannot be detected")
@@ -31,6 +34,9 @@ type updaterUpdater struct {
 	upstream          *v1.Upstream
 	functionalPlugins []UpstreamFunctionDiscovery
 
+	// the upstream as it was added, the upstream above is updated by the discovery
+	original *v1.Upstream
+
 	parent *Updater
 }
 
@@ -41,11 +47,29 @@ type Updater struct {
 	resolver          Resolver
 	logger            *zap.SugaredLogger
 
+	// guards the active upstreams, as discovery can be triggered concurrently with the syncs
+	lock sync.Mutex
+
 	upstreamWriter UpstreamWriterClient
 
 	maxInParallelSemaphore chan struct{}
 
+	// hands out the turns of the upstreams to be discovered, nil if not bounded
+	scheduler *Scheduler
+	// the history of the discovery of the upstreams, kept until they are removed
+	schedules map[string]*upstreamSchedule
+
 	secrets atomic.Value
+
+	// nil if the undetectable upstreams are not cached
+	detectionCache DetectionCache
+
+	// chooses between the discoveries detecting the same upstream
+	scorer          DetectionScorer
+	detectionWindow time.Duration
+
+	// nil if the cold starts of the upstreams are not detected
+	coldStartDetector ColdStartDetector
 }
 
 func getConcurrencyChan(maxoncurrency uint) chan struct{} {
@@ -70,14 +94,21 @@ func NewUpdater(ctx context.Context, resolver Resolver, upstreamclient UpstreamW
 		resolver:               resolver,
 		functionalPlugins:      functionalPlugins,
 		activeupstreams:        make(map[string]*updaterUpdater),
+		schedules:              make(map[string]*upstreamSchedule),
 		maxInParallelSemaphore: getConcurrencyChan(maxconncurrency),
 		upstreamWriter:         upstreamclient,
+		scorer:                 DefaultDetectionScorer{},
+		detectionWindow:        DefaultDetectionWindow,
 	}
 }
 
 type detectResult struct {
 	spec *plugins.ServiceSpec
 	fp   UpstreamFunctionDiscovery
+
+	// set when the detection is chosen
+	serviceType string
+	reason      string
 }
 
 func (u *Updater) SetSecrets(secretlist v1.SecretList) {
@@ -86,6 +117,31 @@ func (u *Updater) SetSecrets(secretlist v1.SecretList) {
 	u.secrets.Store(secretlist)
 }
 
+// SetDetectionCache sets the cache of the upstreams no discovery could detect. Must be called before the upstreams are added.
+func (u *Updater) SetDetectionCache(cache DetectionCache) {
+	u.detectionCache = cache
+}
+
+// SetDetectionScorer sets how the detections of an upstream are chosen between, when more than one discovery detects
+// it. The discoveries have the window after the first detection to detect the upstream. Must be called before the
+// upstreams are added.
+func (u *Updater) SetDetectionScorer(scorer DetectionScorer, window time.Duration) {
+	u.scorer = scorer
+	u.detectionWindow = window
+}
+
+// SetColdStartDetector sets how the upstreams served by short-lived or scale-to-zero workloads are detected. Must be
+// called before the upstreams are added.
+func (u *Updater) SetColdStartDetector(detector ColdStartDetector) {
+	u.coldStartDetector = detector
+}
+
+// SetProbeLimits bounds the upstreams discovered at once and the rate of the probes. Must be called before the upstreams are added.
+func (u *Updater) SetProbeLimits(config *v1.DiscoveryProbes) {
+	u.scheduler = NewScheduler(uint(config.GetMaxConcurrentUpstreams()))
+	u.ctx = WithProbeLimiter(u.ctx, NewProbeLimiter(config))
+}
+
 func (u *Updater) GetSecrets() v1.SecretList {
 	sl := u.secrets.Load()
 	if sl == nil {
@@ -103,22 +159,59 @@ func (u *Updater) createDiscoveries(upstream *v1.Upstream) []UpstreamFunctionDis
 }
 
 func (u *Updater) UpstreamUpdated(upstream *v1.Upstream) {
+	u.lock.Lock()
+	defer u.lock.Unlock()
 	// remove and re-add for now. think if we want to be sophisticated later.
-	u.UpstreamRemoved(upstream)
-	u.UpstreamAdded(upstream)
+	u.upstreamRemoved(upstream)
+	u.upstreamAdded(upstream)
+}
+
+// Trigger restarts the discovery of the upstream, so that its functions are detected right away
+// instead of at the next poll. Returns false if the upstream is not discovered.
+func (u *Updater) Trigger(namespace, name string) bool {
+	u.lock.Lock()
+	defer u.lock.Unlock()
+	active, ok := u.activeupstreams[resources.Key(&v1.Upstream{Metadata: core.Metadata{Namespace: namespace, Name: name}})]
+	if !ok {
+		return false
+	}
+	if schedule, ok := u.schedules[resources.Key(active.original)]; ok {
+		// discovered first, as a new upstream
+		schedule.reset()
+	}
+	u.upstreamRemoved(active.original)
+	u.upstreamAdded(active.original)
+	return true
 }
 
 func (u *Updater) UpstreamAdded(upstream *v1.Upstream) {
+	u.lock.Lock()
+	defer u.lock.Unlock()
+	u.upstreamAdded(upstream)
+}
+
+func (u *Updater) upstreamAdded(upstream *v1.Upstream) {
 	// upstream already tracked. ignore.
 	key := resources.Key(upstream)
 	if _, ok := u.activeupstreams[key]; ok {
 		return
 	}
-	ctx, cancel := context.WithCancel(u.ctx)
+	if !FunctionDiscoveryEnabledFor(upstream) {
+		u.logger.Debugw("function discovery disabled for upstream", "upstream", upstream.Metadata.Name)
+		return
+	}
+	schedule, ok := u.schedules[key]
+	if !ok {
+		schedule = &upstreamSchedule{scheduler: u.scheduler}
+		u.schedules[key] = schedule
+	}
+	schedule.restart()
+	ctx, cancel := context.WithCancel(withSchedule(u.ctx, schedule))
 	updater := &updaterUpdater{
 		cancel:            cancel,
 		ctx:               ctx,
 		upstream:          upstream,
+		original:          upstream,
 		functionalPlugins: u.createDiscoveries(upstream),
 		parent:            u,
 	}
@@ -132,6 +225,13 @@ func (u *Updater) UpstreamAdded(upstream *v1.Upstream) {
 }
 
 func (u *Updater) UpstreamRemoved(upstream *v1.Upstream) {
+	u.lock.Lock()
+	defer u.lock.Unlock()
+	u.upstreamRemoved(upstream)
+	delete(u.schedules, resources.Key(upstream))
+}
+
+func (u *Updater) upstreamRemoved(upstream *v1.Upstream) {
 	key := resources.Key(upstream)
 	if upstreamState, ok := u.activeupstreams[key]; ok {
 		upstreamState.cancel()
@@ -142,6 +242,7 @@ func (u *Updater) UpstreamRemoved(upstream *v1.Upstream) {
 func (u *updaterUpdater) saveUpstream(mutator UpstreamMutator) error {
 	logger := contextutils.LoggerFrom(u.ctx)
 	logger.Debugw("Updating upstream with functions", "upstream", u.upstream.Metadata.Name)
+	mutator = WithFunctionsLimit(mutator, DefaultMaxUpstreamBytes)
 	newupstream := proto.Clone(u.upstream).(*v1.Upstream)
 	err := mutator(newupstream)
 	if err != nil {
@@ -158,7 +259,7 @@ func (u *updaterUpdater) saveUpstream(mutator UpstreamMutator) error {
 	wo.OverwriteExisting = true
 
 	/* upstream, err = */
-	newupstream, err = u.parent.upstreamWriter.Write(newupstream, wo)
+	newupstream, err = u.writeUpstream(u.upstream, newupstream, mutator, wo)
 	if err != nil {
 		logger.Warnw("error updating upstream on first try", "upstream", u.upstream.Metadata.Name, "error", err)
 		newupstream, err = u.parent.upstreamWriter.Read(u.upstream.Metadata.Namespace, u.upstream.Metadata.Name, clients.ReadOpts{Ctx: u.ctx})
@@ -171,6 +272,8 @@ func (u *updaterUpdater) saveUpstream(mutator UpstreamMutator) error {
 		return nil
 	}
 	// try again with the new one
+	base := newupstream
+	newupstream = proto.Clone(base).(*v1.Upstream)
 	err = mutator(newupstream)
 	if err != nil {
 		return err
@@ -180,7 +283,7 @@ func (u *updaterUpdater) saveUpstream(mutator UpstreamMutator) error {
 		return nil
 	}
 
-	newupstream, err = u.parent.upstreamWriter.Write(newupstream, wo)
+	newupstream, err = u.writeUpstream(base, newupstream, mutator, wo)
 	if err != nil {
 		logger.Warnw("error updating upstream on second try", "upstream", u.upstream.Metadata.Name, "error", err)
 	} else {
@@ -191,6 +294,15 @@ func (u *updaterUpdater) saveUpstream(mutator UpstreamMutator) error {
 	return nil
 }
 
+// writeUpstream writes the mutation of the base upstream; writers coalescing the writes of several discoveries
+// apply the mutator to the latest version of the upstream
+func (u *updaterUpdater) writeUpstream(base, mutated *v1.Upstream, mutator UpstreamMutator, opts clients.WriteOpts) (*v1.Upstream, error) {
+	if writer, ok := u.parent.upstreamWriter.(UpstreamMutationWriter); ok {
+		return writer.WriteMutation(base, mutator, opts)
+	}
+	return u.parent.upstreamWriter.Write(mutated, opts)
+}
+
 func (u *updaterUpdater) detectSingle(fp UpstreamFunctionDiscovery, url url.URL, result chan detectResult) {
 	if u.parent.maxInParallelSemaphore != nil {
 		select {
@@ -204,7 +316,7 @@ func (u *updaterUpdater) detectSingle(fp UpstreamFunctionDiscovery, url url.URL,
 	}
 
 	contextutils.NewExponentioalBackoff(contextutils.ExponentioalBackoff{}).Backoff(u.ctx, func(ctx context.Context) error {
-		spec, err := fp.DetectType(ctx, &url)
+		spec, err := fp.DetectType(ctx, &url, u.dependencies)
 		if err != nil {
 			return err
 		}
@@ -220,11 +332,19 @@ func (u *updaterUpdater) detectSingle(fp UpstreamFunctionDiscovery, url url.URL,
 }
 
 func (u *updaterUpdater) detectType(url_ url.URL) (*detectResult, error) {
+	// wait for the turn of the upstream
+	release, err := acquireTurn(u.ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
 	// TODO add global timeout?
 	ctx, cancel := context.WithCancel(u.ctx)
 	defer cancel()
 
-	result := make(chan detectResult, 1)
+	// buffered so that the detections coming after the choice don't block
+	result := make(chan detectResult, len(u.functionalPlugins))
 
 	// run all detections in parallel
 	var waitgroup sync.WaitGroup
@@ -240,16 +360,41 @@ func (u *updaterUpdater) detectType(url_ url.URL) (*detectResult, error) {
 		close(result)
 	}()
 
-	select {
-	case res, ok := <-result:
-		if ok {
-			return &res, nil
+	forced := ForcedServiceType(u.upstream)
+	var results []detectResult
+	// the discoveries have the window after the first detection to detect the upstream too
+	var window <-chan time.Time
+	for {
+		select {
+		case res, ok := <-result:
+			if !ok {
+				if len(results) == 0 {
+					return nil, errorUndetectableUpstream
+				}
+				chosen := chooseDetection(u.parent.scorer, u.upstream, results)
+				return &chosen, nil
+			}
+			if forced != "" {
+				if ServiceType(res.spec) != forced {
+					continue
+				}
+				res.serviceType = forced
+				res.reason = fmt.Sprintf("the service type %v is forced by the upstream", forced)
+				return &res, nil
+			}
+			results = append(results, res)
+			if window == nil {
+				timer := time.NewTimer(u.parent.detectionWindow)
+				defer timer.Stop()
+				window = timer.C
+			}
+		case <-window:
+			chosen := chooseDetection(u.parent.scorer, u.upstream, results)
+			return &chosen, nil
+		case <-ctx.Done():
+			return nil, ctx.Err()
 		}
-		return nil, errorUndetectableUpstream
-	case <-ctx.Done():
-		return nil, ctx.Err()
 	}
-
 }
 
 func (u *updaterUpdater) dependencies() Dependencies {
@@ -272,6 +417,8 @@ func (u *updaterUpdater) Run() error {
 		return u.saveUpstream(m)
 	}
 
+	coldStart := u.detectColdStart(upstreamSave)
+
 	resolvedUrl, resolvedErr := u.parent.resolver.Resolve(u.upstream)
 
 	if discoveryForUpstream == nil {
@@ -286,25 +433,59 @@ func (u *updaterUpdater) Run() error {
 		if resolvedErr != nil {
 			return resolvedErr
 		}
+		cache := u.parent.detectionCache
+		if coldStart {
+			// the probes fail until the workloads start, which doesn't make the upstream undetectable
+			cache = nil
+		}
+		if cache != nil && cache.Undetectable(u.upstream) {
+			// all discoveries gave up on this spec already
+			return errorUndetectableUpstream
+		}
 		// try to detect the type
 		res, err := u.detectType(*resolvedUrl)
-		if err != nil {
-			if err == errorUndetectableUpstream {
-				// TODO(yuval-k): at this point all discoveries gave up.
-				// do we want to mark an upstream as undetected persistently so we do not detect it anymore?
+		recordTurnResult(u.ctx, err)
+		if cache != nil && (err == nil || err == errorUndetectableUpstream) {
+			if cacheErr := cache.SetUndetectable(u.upstream, err != nil); cacheErr != nil {
+				contextutils.LoggerFrom(u.ctx).Warnw("unable to update the detection cache", "upstream", u.upstream.Metadata.Name, "error", cacheErr)
 			}
+		}
+		if err != nil {
 			return err
 		}
 		discoveryForUpstream = res.fp
+		contextutils.LoggerFrom(u.ctx).Infow("detected upstream", "upstream", u.upstream.Metadata.Name, "service_type", res.serviceType, "reason", res.reason)
 		upstreamSave(func(upstream *v1.Upstream) error {
 			servicespecupstream, ok := upstream.UpstreamSpec.UpstreamType.(v1.ServiceSpecSetter)
 			if !ok {
 				return errors.New("can't set spec")
 			}
 			servicespecupstream.SetServiceSpec(res.spec)
+			RecordDetection(upstream, res.serviceType, res.reason)
 			return nil
 		})
 	}
 
-	return discoveryForUpstream.DetectFunctions(u.ctx, resolvedUrl, u.dependencies, upstreamSave)
+	return discoveryForUpstream.DetectFunctions(withStatusReporter(u.ctx, u), resolvedUrl, u.dependencies, upstreamSave)
+}
+
+// records the cold start hints of the upstream, returns true if its workloads have cold starts
+func (u *updaterUpdater) detectColdStart(upstreamSave func(UpstreamMutator) error) bool {
+	detector := u.parent.coldStartDetector
+	if detector == nil {
+		return false
+	}
+	hints, err := detector.DetectColdStart(u.ctx, u.upstream)
+	if err != nil {
+		// keep the hints detected so far
+		contextutils.LoggerFrom(u.ctx).Warnw("unable to detect the cold starts of the upstream", "upstream", u.upstream.Metadata.Name, "error", err)
+		return u.upstream.GetDiscoveryMetadata().GetColdStartHints() != nil
+	}
+	if err := upstreamSave(func(upstream *v1.Upstream) error {
+		UpdateColdStartHints(upstream, hints)
+		return nil
+	}); err != nil {
+		contextutils.LoggerFrom(u.ctx).Warnw("unable to record the cold start hints of the upstream", "upstream", u.upstream.Metadata.Name, "error", err)
+	}
+	return hints != nil
 }
@@ -13,18 +13,28 @@ import (
 	. "github.com/solo-io/gloo/projects/discovery/pkg/fds"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins"
+	grpc_plugins "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/grpc"
+	rest_plugins "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/rest"
 
 	kubernetes_plugins_gloo_solo_io "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/kubernetes"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
 	core_solo_io "github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 )
 
-type testUpstreamWriterClient struct{}
+type testUpstreamWriterClient struct {
+	written atomic.Value
+}
 
 func (t *testUpstreamWriterClient) Write(resource *v1.Upstream, opts clients.WriteOpts) (*v1.Upstream, error) {
+	t.written.Store(resource)
 	return resource, nil
 }
 
+func (t *testUpstreamWriterClient) lastWritten() *v1.Upstream {
+	written, _ := t.written.Load().(*v1.Upstream)
+	return written
+}
+
 func (t *testUpstreamWriterClient) Read(namespace, name string, opts clients.ReadOpts) (*v1.Upstream, error) {
 	return nil, fmt.Errorf("test - no upstream")
 }
@@ -63,7 +73,7 @@ func (t *testDiscovery) IsFunctional() bool {
 	t.setFunctionsCalled(fc)
 	return t.isUpstreamFunctionalResult
 }
-func (t *testDiscovery) DetectType(ctx context.Context, url *url.URL) (*plugins.ServiceSpec, error) {
+func (t *testDiscovery) DetectType(ctx context.Context, url *url.URL, _ func() Dependencies) (*plugins.ServiceSpec, error) {
 	fc := t.getFunctionsCalled()
 	fc.detectUpstreamType = true
 	t.setFunctionsCalled(fc)
@@ -151,4 +161,53 @@ var _ = Describe("Updater", func() {
 		Expect(fc.detectFunctions).To(BeTrue())
 	})
 
+	It("should not discover upstreams that opted out", func() {
+		testDisc.isUpstreamFunctionalResult = true
+		up.Metadata.Annotations = map[string]string{FunctionDiscoveryAnnotation: FunctionDiscoveryDisabled}
+		updater.UpstreamAdded(up)
+		time.Sleep(time.Second / 10)
+		fc := testDisc.getFunctionsCalled()
+		Expect(fc.isUpstreamFunctional).To(BeFalse())
+		Expect(fc.detectFunctions).To(BeFalse())
+	})
+
+	Context("several discoveries detect the upstream", func() {
+		var (
+			swaggerDisc *testDiscovery
+			grpcDisc    *testDiscovery
+		)
+
+		BeforeEach(func() {
+			swaggerDisc = &testDiscovery{serviceSpec: &plugins.ServiceSpec{
+				PluginType: &plugins.ServiceSpec_Rest{Rest: &rest_plugins.ServiceSpec{SwaggerInfo: &rest_plugins.ServiceSpec_SwaggerInfo{}}},
+			}}
+			swaggerDisc.functionsCalled.Store(functionsCalled{})
+			grpcDisc = &testDiscovery{serviceSpec: &plugins.ServiceSpec{
+				PluginType: &plugins.ServiceSpec_Grpc{Grpc: &grpc_plugins.ServiceSpec{}},
+			}}
+			grpcDisc.functionsCalled.Store(functionsCalled{})
+			updater = NewUpdater(ctx, resolver, upstreamWriterClient, 0, []FunctionDiscoveryFactory{swaggerDisc, grpcDisc})
+		})
+
+		It("chooses the detection with the highest score", func() {
+			updater.UpstreamAdded(up)
+			Eventually(func() bool { return grpcDisc.getFunctionsCalled().detectFunctions }).Should(BeTrue())
+			Expect(swaggerDisc.getFunctionsCalled().detectFunctions).To(BeFalse())
+
+			status := upstreamWriterClient.lastWritten().GetDiscoveryMetadata().GetFunctionDiscoveryStatus()
+			Expect(status.GetDetectedServiceType()).To(Equal(ServiceTypeGrpc))
+			Expect(status.GetDetectionReason()).To(ContainSubstring("won over swagger"))
+		})
+
+		It("chooses the service type forced by the upstream", func() {
+			up.DiscoveryMetadata = &v1.DiscoveryMetadata{ServiceType: ServiceTypeSwagger}
+			updater.UpstreamAdded(up)
+			Eventually(func() bool { return swaggerDisc.getFunctionsCalled().detectFunctions }).Should(BeTrue())
+			Expect(grpcDisc.getFunctionsCalled().detectFunctions).To(BeFalse())
+
+			status := upstreamWriterClient.lastWritten().GetDiscoveryMetadata().GetFunctionDiscoveryStatus()
+			Expect(status.GetDetectedServiceType()).To(Equal(ServiceTypeSwagger))
+			Expect(status.GetDetectionReason()).To(ContainSubstring("forced by the upstream"))
+		})
+	})
 })
@@ -0,0 +1,48 @@
+package fds
+
+import (
+	"time"
+
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+)
+
+// annotations of the upstreams, usually copied from the kubernetes services by upstream discovery.
+// the fields of the discovery metadata of the upstream take precedence.
+const (
+	FunctionDiscoveryAnnotation    = "discovery.solo.io/function-discovery"
+	FunctionPollIntervalAnnotation = "discovery.solo.io/function-poll-interval"
+
+	FunctionDiscoveryEnabled  = "enabled"
+	FunctionDiscoveryDisabled = "disabled"
+)
+
+// FunctionDiscoveryEnabledFor returns false if the upstream opted out of function discovery
+func FunctionDiscoveryEnabledFor(us *v1.Upstream) bool {
+	if enabled := us.GetDiscoveryMetadata().GetFunctionDiscovery(); enabled != nil {
+		return enabled.Value
+	}
+	return us.Metadata.Annotations[FunctionDiscoveryAnnotation] != FunctionDiscoveryDisabled
+}
+
+// FunctionDiscoveryRequestedFor returns true if the upstream explicitly opted in to function discovery. The
+// discoveries that probe unknown services with requests of their protocol only probe these upstreams.
+func FunctionDiscoveryRequestedFor(us *v1.Upstream) bool {
+	if enabled := us.GetDiscoveryMetadata().GetFunctionDiscovery(); enabled != nil {
+		return enabled.Value
+	}
+	return us.Metadata.Annotations[FunctionDiscoveryAnnotation] == FunctionDiscoveryEnabled
+}
+
+// PollInterval returns how often the functions of the upstream are polled, or the default interval
+// of the discovery if the upstream doesn't override it
+func PollInterval(us *v1.Upstream, defaultInterval time.Duration) time.Duration {
+	if interval := us.GetDiscoveryMetadata().GetFunctionPollInterval(); interval != nil && *interval > 0 {
+		return *interval
+	}
+	if annotation, ok := us.Metadata.Annotations[FunctionPollIntervalAnnotation]; ok {
+		if interval, err := time.ParseDuration(annotation); err == nil && interval > 0 {
+			return interval
+		}
+	}
+	return defaultInterval
+}
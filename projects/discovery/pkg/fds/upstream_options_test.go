@@ -0,0 +1,75 @@
+package fds_test
+
+import (
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/solo-io/gloo/projects/discovery/pkg/fds"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+)
+
+var _ = Describe("Upstream options", func() {
+
+	var up *v1.Upstream
+
+	BeforeEach(func() {
+		up = &v1.Upstream{}
+	})
+
+	Context("function discovery", func() {
+		It("is enabled by default", func() {
+			Expect(FunctionDiscoveryEnabledFor(up)).To(BeTrue())
+		})
+
+		It("is disabled by the annotation", func() {
+			up.Metadata.Annotations = map[string]string{FunctionDiscoveryAnnotation: FunctionDiscoveryDisabled}
+			Expect(FunctionDiscoveryEnabledFor(up)).To(BeFalse())
+		})
+
+		It("prefers the discovery metadata to the annotation", func() {
+			up.Metadata.Annotations = map[string]string{FunctionDiscoveryAnnotation: FunctionDiscoveryDisabled}
+			up.DiscoveryMetadata = &v1.DiscoveryMetadata{FunctionDiscovery: &types.BoolValue{Value: true}}
+			Expect(FunctionDiscoveryEnabledFor(up)).To(BeTrue())
+
+			up.Metadata.Annotations = nil
+			up.DiscoveryMetadata.FunctionDiscovery.Value = false
+			Expect(FunctionDiscoveryEnabledFor(up)).To(BeFalse())
+		})
+
+		It("is requested only explicitly", func() {
+			Expect(FunctionDiscoveryRequestedFor(up)).To(BeFalse())
+
+			up.Metadata.Annotations = map[string]string{FunctionDiscoveryAnnotation: FunctionDiscoveryEnabled}
+			Expect(FunctionDiscoveryRequestedFor(up)).To(BeTrue())
+
+			up.DiscoveryMetadata = &v1.DiscoveryMetadata{FunctionDiscovery: &types.BoolValue{Value: false}}
+			Expect(FunctionDiscoveryRequestedFor(up)).To(BeFalse())
+		})
+	})
+
+	Context("poll interval", func() {
+		It("defaults to the interval of the discovery", func() {
+			Expect(PollInterval(up, time.Minute)).To(Equal(time.Minute))
+		})
+
+		It("uses the annotation", func() {
+			up.Metadata.Annotations = map[string]string{FunctionPollIntervalAnnotation: "30s"}
+			Expect(PollInterval(up, time.Minute)).To(Equal(30 * time.Second))
+		})
+
+		It("ignores invalid annotations", func() {
+			up.Metadata.Annotations = map[string]string{FunctionPollIntervalAnnotation: "soon"}
+			Expect(PollInterval(up, time.Minute)).To(Equal(time.Minute))
+		})
+
+		It("prefers the discovery metadata to the annotation", func() {
+			interval := 5 * time.Minute
+			up.Metadata.Annotations = map[string]string{FunctionPollIntervalAnnotation: "30s"}
+			up.DiscoveryMetadata = &v1.DiscoveryMetadata{FunctionPollInterval: &interval}
+			Expect(PollInterval(up, time.Minute)).To(Equal(interval))
+		})
+	})
+})
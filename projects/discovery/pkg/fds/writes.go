@@ -0,0 +1,204 @@
+package fds
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/types"
+	"golang.org/x/time/rate"
+
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
+	"github.com/solo-io/solo-kit/pkg/errors"
+)
+
+const (
+	defaultBatchWindow = time.Second
+	defaultWritesQps   = 10
+)
+
+// a write to an upstream, waiting for the end of the window
+type pendingWrite struct {
+	// the version of the upstream the mutator applies to
+	upstream *v1.Upstream
+	// nil for the writes of a whole upstream
+	mutator UpstreamMutator
+	opts    clients.WriteOpts
+
+	// closed once the write is done
+	done   chan struct{}
+	result *v1.Upstream
+	err    error
+}
+
+// UpstreamMutationWriter is implemented by the writers coalescing the writes of several discoveries to an upstream:
+// the mutations of all the writes are applied to the latest version of the upstream, so that none is lost.
+type UpstreamMutationWriter interface {
+	WriteMutation(upstream *v1.Upstream, mutator UpstreamMutator, opts clients.WriteOpts) (*v1.Upstream, error)
+}
+
+// WriteCoalescer batches the upstream writes of the discoveries: the writes to the same upstream within a window
+// are coalesced into a single write, and the requests to the client are rate limited. The mutations of the
+// coalesced writes are all applied to the latest version of the upstream written; the writes of a whole upstream
+// superseded by a later write fail, so that their writer retries on the upstream as written.
+// Writes block until their batch is written.
+type WriteCoalescer struct {
+	client  UpstreamWriterClient
+	window  time.Duration
+	limiter *rate.Limiter
+
+	lock    sync.Mutex
+	pending map[string][]*pendingWrite
+	// the keys of the pending writes, in the order they were first written
+	order []string
+}
+
+var _ UpstreamWriterClient = &WriteCoalescer{}
+var _ UpstreamMutationWriter = &WriteCoalescer{}
+
+func NewWriteCoalescer(client UpstreamWriterClient, config *v1.DiscoveryWrites) *WriteCoalescer {
+	window := defaultBatchWindow
+	if config.GetBatchWindow() != nil {
+		if d, err := types.DurationFromProto(config.BatchWindow); err == nil && d > 0 {
+			window = d
+		}
+	}
+	qps := float64(config.GetQps())
+	if qps <= 0 {
+		qps = defaultWritesQps
+	}
+	burst := int(config.GetBurst())
+	if burst <= 0 {
+		burst = int(math.Ceil(qps))
+	}
+	return &WriteCoalescer{
+		client:  client,
+		window:  window,
+		limiter: rate.NewLimiter(rate.Limit(qps), burst),
+		pending: make(map[string][]*pendingWrite),
+	}
+}
+
+// Run writes the pending upstreams at the end of each window, until the context is done
+func (c *WriteCoalescer) Run(ctx context.Context) {
+	ticker := time.NewTicker(c.window)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			c.flush(ctx)
+			return
+		case <-ticker.C:
+			c.flush(ctx)
+		}
+	}
+}
+
+func (c *WriteCoalescer) Write(upstream *v1.Upstream, opts clients.WriteOpts) (*v1.Upstream, error) {
+	return c.WriteMutation(upstream, nil, opts)
+}
+
+func (c *WriteCoalescer) WriteMutation(upstream *v1.Upstream, mutator UpstreamMutator, opts clients.WriteOpts) (*v1.Upstream, error) {
+	opts = opts.WithDefaults()
+	key := resources.Key(upstream)
+	write := &pendingWrite{
+		upstream: upstream,
+		mutator:  mutator,
+		opts:     opts,
+		done:     make(chan struct{}),
+	}
+
+	c.lock.Lock()
+	if _, ok := c.pending[key]; !ok {
+		c.order = append(c.order, key)
+	}
+	c.pending[key] = append(c.pending[key], write)
+	c.lock.Unlock()
+
+	select {
+	case <-write.done:
+		return write.result, write.err
+	case <-opts.Ctx.Done():
+		return nil, opts.Ctx.Err()
+	}
+}
+
+func (c *WriteCoalescer) Read(namespace, name string, opts clients.ReadOpts) (*v1.Upstream, error) {
+	opts = opts.WithDefaults()
+	if err := c.limiter.Wait(opts.Ctx); err != nil {
+		return nil, err
+	}
+	return c.client.Read(namespace, name, opts)
+}
+
+func (c *WriteCoalescer) flush(ctx context.Context) {
+	c.lock.Lock()
+	pending, order := c.pending, c.order
+	c.pending, c.order = make(map[string][]*pendingWrite), nil
+	c.lock.Unlock()
+
+	for _, key := range order {
+		c.flushUpstream(ctx, pending[key])
+	}
+}
+
+// writes the latest version of an upstream with the mutations of all its pending writes
+func (c *WriteCoalescer) flushUpstream(ctx context.Context, writes []*pendingWrite) {
+	defer func() {
+		for _, write := range writes {
+			close(write.done)
+		}
+	}()
+
+	var live []*pendingWrite
+	for _, write := range writes {
+		switch {
+		case write.opts.Ctx.Err() != nil:
+			// the discovery of the upstream stopped, its write is stale
+			write.err = write.opts.Ctx.Err()
+		case ctx.Err() != nil:
+			write.err = ctx.Err()
+		default:
+			live = append(live, write)
+		}
+	}
+	if len(live) == 0 {
+		return
+	}
+
+	latest := live[len(live)-1]
+	upstream := proto.Clone(latest.upstream).(*v1.Upstream)
+	var applied []*pendingWrite
+	for _, write := range live {
+		if write.mutator == nil {
+			if write != latest {
+				write.err = errors.Errorf("the write of upstream %v was superseded by a later write", upstream.Metadata.Ref().Key())
+				continue
+			}
+			applied = append(applied, write)
+			continue
+		}
+		mutated := proto.Clone(upstream).(*v1.Upstream)
+		if write.err = write.mutator(mutated); write.err != nil {
+			continue
+		}
+		upstream = mutated
+		applied = append(applied, write)
+	}
+	if len(applied) == 0 {
+		return
+	}
+
+	var result *v1.Upstream
+	err := c.limiter.Wait(ctx)
+	if err == nil {
+		result, err = c.client.Write(upstream, latest.opts)
+	}
+	for _, write := range applied {
+		write.result, write.err = result, err
+	}
+}
@@ -0,0 +1,132 @@
+package fds_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/solo-io/gloo/projects/discovery/pkg/fds"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	core_solo_io "github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+type countingWriterClient struct {
+	testUpstreamWriterClient
+
+	lock   sync.Mutex
+	writes []*v1.Upstream
+}
+
+func (c *countingWriterClient) Write(resource *v1.Upstream, opts clients.WriteOpts) (*v1.Upstream, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.writes = append(c.writes, resource)
+	return resource, nil
+}
+
+func (c *countingWriterClient) Writes() []*v1.Upstream {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return append([]*v1.Upstream(nil), c.writes...)
+}
+
+var _ = Describe("WriteCoalescer", func() {
+
+	var (
+		ctx    context.Context
+		cancel context.CancelFunc
+		client *countingWriterClient
+		writer *WriteCoalescer
+	)
+
+	upstream := func(name, version string) *v1.Upstream {
+		return &v1.Upstream{
+			Metadata: core_solo_io.Metadata{Namespace: "ns", Name: name, Annotations: map[string]string{"version": version}},
+		}
+	}
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		client = &countingWriterClient{}
+		writer = NewWriteCoalescer(client, &v1.DiscoveryWrites{
+			BatchWindow: types.DurationProto(200 * time.Millisecond),
+		})
+		go writer.Run(ctx)
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	write := func(up *v1.Upstream, mutator UpstreamMutator, results chan<- *v1.Upstream) {
+		defer GinkgoRecover()
+		result, err := writer.WriteMutation(up, mutator, clients.WriteOpts{Ctx: ctx})
+		Expect(err).NotTo(HaveOccurred())
+		results <- result
+	}
+
+	annotate := func(key string) UpstreamMutator {
+		return func(up *v1.Upstream) error {
+			up.Metadata.Annotations[key] = "true"
+			return nil
+		}
+	}
+
+	It("coalesces the writes to the same upstream within a window", func() {
+		results := make(chan *v1.Upstream, 3)
+		go write(upstream("a", "1"), annotate("swagger"), results)
+		time.Sleep(10 * time.Millisecond)
+		go write(upstream("a", "2"), annotate("grpc"), results)
+		go write(upstream("b", "1"), nil, results)
+
+		for i := 0; i < 3; i++ {
+			Eventually(results, time.Second).Should(Receive())
+		}
+		writes := client.Writes()
+		Expect(writes).To(HaveLen(2))
+		Expect(writes[0].Metadata.Name).To(Equal("a"))
+		// the mutations of all the writes apply to the latest version
+		Expect(writes[0].Metadata.Annotations).To(Equal(map[string]string{"version": "2", "swagger": "true", "grpc": "true"}))
+		Expect(writes[1].Metadata.Name).To(Equal("b"))
+	})
+
+	It("fails the superseded writes of a whole upstream", func() {
+		errs := make(chan error, 1)
+		go func() {
+			_, err := writer.Write(upstream("a", "1"), clients.WriteOpts{Ctx: ctx})
+			errs <- err
+		}()
+		time.Sleep(10 * time.Millisecond)
+		result, err := writer.Write(upstream("a", "2"), clients.WriteOpts{Ctx: ctx})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Metadata.Annotations["version"]).To(Equal("2"))
+		Eventually(errs).Should(Receive(MatchError(ContainSubstring("superseded"))))
+		Expect(client.Writes()).To(HaveLen(1))
+	})
+
+	It("fails the writes whose mutation fails and writes the others", func() {
+		results := make(chan *v1.Upstream, 1)
+		go write(upstream("a", "1"), annotate("swagger"), results)
+		_, err := writer.WriteMutation(upstream("a", "1"), func(up *v1.Upstream) error {
+			return errors.New("too large")
+		}, clients.WriteOpts{Ctx: ctx})
+		Expect(err).To(MatchError("too large"))
+		Eventually(results, time.Second).Should(Receive())
+		Expect(client.Writes()).To(HaveLen(1))
+		Expect(client.Writes()[0].Metadata.Annotations).To(HaveKey("swagger"))
+	})
+
+	It("does not write the upstreams of stopped discoveries", func() {
+		writeCtx, writeCancel := context.WithCancel(ctx)
+		writeCancel()
+		_, err := writer.Write(upstream("a", "1"), clients.WriteOpts{Ctx: writeCtx})
+		Expect(err).To(MatchError(context.Canceled))
+		Consistently(func() int { return len(client.Writes()) }, 300*time.Millisecond).Should(Equal(0))
+	})
+})
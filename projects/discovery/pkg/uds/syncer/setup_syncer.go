@@ -2,6 +2,8 @@ package syncer
 
 import (
 	"github.com/solo-io/gloo/pkg/utils"
+	"github.com/solo-io/gloo/projects/discovery/pkg/chaos"
+	"github.com/solo-io/gloo/projects/discovery/pkg/dryrun"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/gloo/pkg/bootstrap"
 	"github.com/solo-io/gloo/projects/gloo/pkg/discovery"
@@ -14,6 +16,19 @@ func RunUDS(opts bootstrap.Opts) error {
 	watchOpts := opts.WatchOpts.WithDefaults()
 	watchOpts.Ctx = contextutils.WithLogger(watchOpts.Ctx, "uds")
 
+	// the discovery of the previous settings was stopped when the settings changed, it is resumed by running again
+	if opts.Settings.GetDiscovery().GetUdsMode() == v1.DiscoveryOptions_PAUSED {
+		contextutils.LoggerFrom(watchOpts.Ctx).Infof("upstream discovery is paused by the settings")
+		return nil
+	}
+
+	// the cloud APIs are called by the discovery plugins of gloo, only the calls to Kubernetes are injected with chaos
+	kubeClient, _, err := chaos.Setup(watchOpts.Ctx, opts.KubeClient)
+	if err != nil {
+		return err
+	}
+	opts.KubeClient = kubeClient
+
 	upstreamClient, err := v1.NewUpstreamClient(opts.Upstreams)
 	if err != nil {
 		return err
@@ -21,6 +36,10 @@ func RunUDS(opts bootstrap.Opts) error {
 	if err := upstreamClient.Register(); err != nil {
 		return err
 	}
+	dryRun := opts.Settings.GetDiscoveryDryRun()
+	if dryRun != nil {
+		upstreamClient = dryrun.NewUpstreamClient(upstreamClient, dryrun.DefaultRecorder, "uds")
+	}
 
 	secretClient, err := v1.NewSecretClient(opts.Secrets)
 	if err != nil {
@@ -51,9 +70,37 @@ func RunUDS(opts bootstrap.Opts) error {
 
 	errs := make(chan error)
 
+	if dryRun.GetBindAddr() != "" {
+		go func() {
+			if err := dryrun.Serve(watchOpts.Ctx, dryrun.DefaultRecorder, dryRun.BindAddr); err != nil {
+				errs <- err
+			}
+		}()
+	}
+
 	uds := discovery.NewUpstreamDiscovery(watchNamespaces, opts.WriteNamespace, upstreamClient, discoveryPlugins)
+	if stalePolicy := opts.Settings.GetDiscovery().GetStaleUpstreamPolicy(); stalePolicy != nil {
+		var referenced discovery.ReferencedUpstreams
+		if stalePolicy.ProtectReferenced {
+			proxyClient, upstreamGroupClient, err := routeClients(opts)
+			if err != nil {
+				return err
+			}
+			referenced = discovery.ReferencedByRoutes(proxyClient, upstreamGroupClient, watchNamespaces)
+			// the upstreams no route refers to anymore are deleted without waiting for a discovery event
+			referenceChanges, referenceErrs, err := discovery.WatchReferences(watchOpts, proxyClient, upstreamGroupClient, watchNamespaces)
+			if err != nil {
+				return err
+			}
+			go errutils.AggregateErrs(watchOpts.Ctx, errs, referenceErrs, "references.uds")
+			go uds.ResyncOn(watchOpts.Ctx, referenceChanges)
+		}
+		uds.SetStaleUpstreamPolicy(stalePolicy, referenced)
+	}
 	// TODO(ilackarms) expose discovery options
-	udsErrs, err := uds.StartUds(watchOpts, discovery.Opts{})
+	var discOpts discovery.Opts
+	discOpts.KubeOpts.UpstreamNameTemplate = opts.Settings.GetDiscovery().GetUpstreamNameTemplate()
+	udsErrs, err := uds.StartUds(watchOpts, discOpts)
 	if err != nil {
 		return err
 	}
@@ -82,3 +129,21 @@ func RunUDS(opts bootstrap.Opts) error {
 	}()
 	return nil
 }
+
+func routeClients(opts bootstrap.Opts) (v1.ProxyClient, v1.UpstreamGroupClient, error) {
+	proxyClient, err := v1.NewProxyClient(opts.Proxies)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := proxyClient.Register(); err != nil {
+		return nil, nil, err
+	}
+	upstreamGroupClient, err := v1.NewUpstreamGroupClient(opts.UpstreamGroups)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := upstreamGroupClient.Register(); err != nil {
+		return nil, nil, err
+	}
+	return proxyClient, upstreamGroupClient, nil
+}
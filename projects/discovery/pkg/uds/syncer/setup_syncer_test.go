@@ -0,0 +1,63 @@
+package syncer_test
+
+import (
+	"context"
+	"os"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/solo-io/gloo/projects/discovery/pkg/chaos"
+	. "github.com/solo-io/gloo/projects/discovery/pkg/uds/syncer"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/bootstrap"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/factory"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/memory"
+)
+
+var _ = Describe("RunUDS", func() {
+
+	var (
+		ctx    context.Context
+		cancel context.CancelFunc
+		opts   bootstrap.Opts
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		memoryFactory := &factory.MemoryResourceClientFactory{Cache: memory.NewInMemoryResourceCache()}
+		opts = bootstrap.Opts{
+			WriteNamespace:  "gloo-system",
+			WatchNamespaces: []string{"gloo-system"},
+			Upstreams:       memoryFactory,
+			UpstreamGroups:  memoryFactory,
+			Proxies:         memoryFactory,
+			Secrets:         memoryFactory,
+			Artifacts:       memoryFactory,
+			WatchOpts:       clients.WatchOpts{Ctx: ctx},
+			Settings:        &v1.Settings{},
+		}
+		// an invalid chaos configuration fails the discovery once it starts setting up
+		os.Setenv(chaos.FailureRateEnv, "invalid")
+	})
+
+	AfterEach(func() {
+		os.Unsetenv(chaos.FailureRateEnv)
+		cancel()
+	})
+
+	It("sets up the discovery when it is enabled", func() {
+		Expect(RunUDS(opts)).To(HaveOccurred())
+	})
+
+	It("returns before setting up the discovery when it is paused", func() {
+		opts.Settings.Discovery = &v1.DiscoveryOptions{UdsMode: v1.DiscoveryOptions_PAUSED}
+		Expect(RunUDS(opts)).NotTo(HaveOccurred())
+	})
+
+	It("only obeys its own mode", func() {
+		opts.Settings.Discovery = &v1.DiscoveryOptions{FdsMode: v1.DiscoveryOptions_PAUSED}
+		Expect(RunUDS(opts)).To(HaveOccurred())
+	})
+})
@@ -26,6 +26,36 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 
+type ServiceOptions_ExternalTrafficPolicy int32
+
+const (
+	// Leave the policy of the service untouched.
+	ServiceOptions_Default ServiceOptions_ExternalTrafficPolicy = 0
+	ServiceOptions_Cluster ServiceOptions_ExternalTrafficPolicy = 1
+	// Preserve the client source IP, only routing to the proxies of the node receiving the traffic.
+	ServiceOptions_Local ServiceOptions_ExternalTrafficPolicy = 2
+)
+
+var ServiceOptions_ExternalTrafficPolicy_name = map[int32]string{
+	0: "Default",
+	1: "Cluster",
+	2: "Local",
+}
+
+var ServiceOptions_ExternalTrafficPolicy_value = map[string]int32{
+	"Default": 0,
+	"Cluster": 1,
+	"Local":   2,
+}
+
+func (x ServiceOptions_ExternalTrafficPolicy) String() string {
+	return proto.EnumName(ServiceOptions_ExternalTrafficPolicy_name, int32(x))
+}
+
+func (ServiceOptions_ExternalTrafficPolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_30f7529f6633771c, []int{1, 0}
+}
+
 //
 //@solo-kit:resource.short_name=gw
 //@solo-kit:resource.plural_name=gateways
@@ -51,10 +81,16 @@ type Gateway struct {
 	// Metadata contains the object metadata for this resource
 	Metadata core.Metadata `protobuf:"bytes,7,opt,name=metadata,proto3" json:"metadata"`
 	// Enable ProxyProtocol support for this listener
-	UseProxyProto        *types.BoolValue `protobuf:"bytes,8,opt,name=use_proxy_proto,json=useProxyProto,proto3" json:"use_proxy_proto,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+	UseProxyProto *types.BoolValue `protobuf:"bytes,8,opt,name=use_proxy_proto,json=useProxyProto,proto3" json:"use_proxy_proto,omitempty"`
+	// Configures the Kubernetes service exposing the gateway proxy, in the namespace of the gateway.
+	// The service itself is not created by Gloo, only the fields set here are updated.
+	Service *ServiceOptions `protobuf:"bytes,9,opt,name=service,proto3" json:"service,omitempty"`
+	// Configures the Kubernetes deployment of the gateway proxy, in the namespace of the gateway.
+	// The deployment itself is not created by Gloo, only the fields set here are updated.
+	Deployment           *DeploymentOptions `protobuf:"bytes,10,opt,name=deployment,proto3" json:"deployment,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
 }
 
 func (m *Gateway) Reset()         { *m = Gateway{} }
@@ -137,8 +173,301 @@ func (m *Gateway) GetUseProxyProto() *types.BoolValue {
 	return nil
 }
 
+func (m *Gateway) GetService() *ServiceOptions {
+	if m != nil {
+		return m.Service
+	}
+	return nil
+}
+
+func (m *Gateway) GetDeployment() *DeploymentOptions {
+	if m != nil {
+		return m.Deployment
+	}
+	return nil
+}
+
+type ServiceOptions struct {
+	// The name of the service. Defaults to `gateway-proxy`.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The static IP requested from the cloud provider for the load balancer of the service.
+	LoadBalancerIp string `protobuf:"bytes,2,opt,name=load_balancer_ip,json=loadBalancerIp,proto3" json:"load_balancer_ip,omitempty"`
+	// Annotations added to the service, typically to configure the load balancer of the cloud provider.
+	// Values are Go templates, rendered with the `Name`, `Namespace`, `BindPort` and `Ssl` of the gateway,
+	// e.g. `{{ .Namespace }}-{{ .Name }}`. Annotations removed from the gateway are removed from the service.
+	Annotations           map[string]string                    `protobuf:"bytes,3,rep,name=annotations,proto3" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	ExternalTrafficPolicy ServiceOptions_ExternalTrafficPolicy `protobuf:"varint,4,opt,name=external_traffic_policy,json=externalTrafficPolicy,proto3,enum=gateway.solo.io.ServiceOptions_ExternalTrafficPolicy" json:"external_traffic_policy,omitempty"`
+	// The node port of the service port targeting the bind port of the gateway.
+	// The service must be of type `NodePort` or `LoadBalancer`.
+	//
+	// Types that are valid to be assigned to NodePortSpecifier:
+	//	*ServiceOptions_NodePort
+	//	*ServiceOptions_NodePortRange_
+	NodePortSpecifier    isServiceOptions_NodePortSpecifier `protobuf_oneof:"node_port_specifier"`
+	XXX_NoUnkeyedLiteral struct{}                           `json:"-"`
+	XXX_unrecognized     []byte                             `json:"-"`
+	XXX_sizecache        int32                              `json:"-"`
+}
+
+func (m *ServiceOptions) Reset()         { *m = ServiceOptions{} }
+func (m *ServiceOptions) String() string { return proto.CompactTextString(m) }
+func (*ServiceOptions) ProtoMessage()    {}
+func (*ServiceOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_30f7529f6633771c, []int{1}
+}
+func (m *ServiceOptions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ServiceOptions.Unmarshal(m, b)
+}
+func (m *ServiceOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ServiceOptions.Marshal(b, m, deterministic)
+}
+func (m *ServiceOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ServiceOptions.Merge(m, src)
+}
+func (m *ServiceOptions) XXX_Size() int {
+	return xxx_messageInfo_ServiceOptions.Size(m)
+}
+func (m *ServiceOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_ServiceOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ServiceOptions proto.InternalMessageInfo
+
+type isServiceOptions_NodePortSpecifier interface {
+	isServiceOptions_NodePortSpecifier()
+	Equal(interface{}) bool
+}
+
+type ServiceOptions_NodePort struct {
+	NodePort uint32 `protobuf:"varint,5,opt,name=node_port,json=nodePort,proto3,oneof"`
+}
+type ServiceOptions_NodePortRange_ struct {
+	NodePortRange *ServiceOptions_NodePortRange `protobuf:"bytes,6,opt,name=node_port_range,json=nodePortRange,proto3,oneof"`
+}
+
+func (*ServiceOptions_NodePort) isServiceOptions_NodePortSpecifier()       {}
+func (*ServiceOptions_NodePortRange_) isServiceOptions_NodePortSpecifier() {}
+
+func (m *ServiceOptions) GetNodePortSpecifier() isServiceOptions_NodePortSpecifier {
+	if m != nil {
+		return m.NodePortSpecifier
+	}
+	return nil
+}
+
+func (m *ServiceOptions) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ServiceOptions) GetLoadBalancerIp() string {
+	if m != nil {
+		return m.LoadBalancerIp
+	}
+	return ""
+}
+
+func (m *ServiceOptions) GetAnnotations() map[string]string {
+	if m != nil {
+		return m.Annotations
+	}
+	return nil
+}
+
+func (m *ServiceOptions) GetExternalTrafficPolicy() ServiceOptions_ExternalTrafficPolicy {
+	if m != nil {
+		return m.ExternalTrafficPolicy
+	}
+	return ServiceOptions_Default
+}
+
+func (m *ServiceOptions) GetNodePort() uint32 {
+	if x, ok := m.GetNodePortSpecifier().(*ServiceOptions_NodePort); ok {
+		return x.NodePort
+	}
+	return 0
+}
+
+func (m *ServiceOptions) GetNodePortRange() *ServiceOptions_NodePortRange {
+	if x, ok := m.GetNodePortSpecifier().(*ServiceOptions_NodePortRange_); ok {
+		return x.NodePortRange
+	}
+	return nil
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*ServiceOptions) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _ServiceOptions_OneofMarshaler, _ServiceOptions_OneofUnmarshaler, _ServiceOptions_OneofSizer, []interface{}{
+		(*ServiceOptions_NodePort)(nil),
+		(*ServiceOptions_NodePortRange_)(nil),
+	}
+}
+
+func _ServiceOptions_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*ServiceOptions)
+	// node_port_specifier
+	switch x := m.NodePortSpecifier.(type) {
+	case *ServiceOptions_NodePort:
+		_ = b.EncodeVarint(5<<3 | proto.WireVarint)
+		_ = b.EncodeVarint(uint64(x.NodePort))
+	case *ServiceOptions_NodePortRange_:
+		_ = b.EncodeVarint(6<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.NodePortRange); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("ServiceOptions.NodePortSpecifier has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _ServiceOptions_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*ServiceOptions)
+	switch tag {
+	case 5: // node_port_specifier.node_port
+		if wire != proto.WireVarint {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeVarint()
+		m.NodePortSpecifier = &ServiceOptions_NodePort{uint32(x)}
+		return true, err
+	case 6: // node_port_specifier.node_port_range
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ServiceOptions_NodePortRange)
+		err := b.DecodeMessage(msg)
+		m.NodePortSpecifier = &ServiceOptions_NodePortRange_{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _ServiceOptions_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*ServiceOptions)
+	// node_port_specifier
+	switch x := m.NodePortSpecifier.(type) {
+	case *ServiceOptions_NodePort:
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(x.NodePort))
+	case *ServiceOptions_NodePortRange_:
+		s := proto.Size(x.NodePortRange)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+type ServiceOptions_NodePortRange struct {
+	Start                uint32   `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	End                  uint32   `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ServiceOptions_NodePortRange) Reset()         { *m = ServiceOptions_NodePortRange{} }
+func (m *ServiceOptions_NodePortRange) String() string { return proto.CompactTextString(m) }
+func (*ServiceOptions_NodePortRange) ProtoMessage()    {}
+func (*ServiceOptions_NodePortRange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_30f7529f6633771c, []int{1, 1}
+}
+func (m *ServiceOptions_NodePortRange) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ServiceOptions_NodePortRange.Unmarshal(m, b)
+}
+func (m *ServiceOptions_NodePortRange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ServiceOptions_NodePortRange.Marshal(b, m, deterministic)
+}
+func (m *ServiceOptions_NodePortRange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ServiceOptions_NodePortRange.Merge(m, src)
+}
+func (m *ServiceOptions_NodePortRange) XXX_Size() int {
+	return xxx_messageInfo_ServiceOptions_NodePortRange.Size(m)
+}
+func (m *ServiceOptions_NodePortRange) XXX_DiscardUnknown() {
+	xxx_messageInfo_ServiceOptions_NodePortRange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ServiceOptions_NodePortRange proto.InternalMessageInfo
+
+func (m *ServiceOptions_NodePortRange) GetStart() uint32 {
+	if m != nil {
+		return m.Start
+	}
+	return 0
+}
+
+func (m *ServiceOptions_NodePortRange) GetEnd() uint32 {
+	if m != nil {
+		return m.End
+	}
+	return 0
+}
+
+type DeploymentOptions struct {
+	// The name of the deployment. Defaults to `gateway-proxy`.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The number of worker threads of Envoy, set with its `--concurrency` flag.
+	// Envoy defaults to one worker thread per hardware thread of the node.
+	Concurrency          uint32   `protobuf:"varint,2,opt,name=concurrency,proto3" json:"concurrency,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeploymentOptions) Reset()         { *m = DeploymentOptions{} }
+func (m *DeploymentOptions) String() string { return proto.CompactTextString(m) }
+func (*DeploymentOptions) ProtoMessage()    {}
+func (*DeploymentOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_30f7529f6633771c, []int{2}
+}
+func (m *DeploymentOptions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeploymentOptions.Unmarshal(m, b)
+}
+func (m *DeploymentOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeploymentOptions.Marshal(b, m, deterministic)
+}
+func (m *DeploymentOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeploymentOptions.Merge(m, src)
+}
+func (m *DeploymentOptions) XXX_Size() int {
+	return xxx_messageInfo_DeploymentOptions.Size(m)
+}
+func (m *DeploymentOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeploymentOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeploymentOptions proto.InternalMessageInfo
+
+func (m *DeploymentOptions) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *DeploymentOptions) GetConcurrency() uint32 {
+	if m != nil {
+		return m.Concurrency
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("gateway.solo.io.ServiceOptions_ExternalTrafficPolicy", ServiceOptions_ExternalTrafficPolicy_name, ServiceOptions_ExternalTrafficPolicy_value)
 	proto.RegisterType((*Gateway)(nil), "gateway.solo.io.Gateway")
+	proto.RegisterType((*ServiceOptions)(nil), "gateway.solo.io.ServiceOptions")
+	proto.RegisterMapType((map[string]string)(nil), "gateway.solo.io.ServiceOptions.AnnotationsEntry")
+	proto.RegisterType((*ServiceOptions_NodePortRange)(nil), "gateway.solo.io.ServiceOptions.NodePortRange")
+	proto.RegisterType((*DeploymentOptions)(nil), "gateway.solo.io.DeploymentOptions")
 }
 
 func init() {
@@ -146,37 +475,57 @@ func init() {
 }
 
 var fileDescriptor_30f7529f6633771c = []byte{
-	// 465 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0xc7, 0x31, 0x29, 0x49, 0xba, 0xa1, 0x4a, 0xb1, 0x2a, 0xe4, 0x06, 0xd1, 0x86, 0x9c, 0x7c,
-	0x00, 0xaf, 0xda, 0x1e, 0x28, 0x95, 0x38, 0xe0, 0x4b, 0x25, 0x04, 0x52, 0xb4, 0x95, 0x38, 0x70,
-	0x89, 0x36, 0xf6, 0x78, 0x59, 0xea, 0x66, 0xac, 0xfd, 0x48, 0xe1, 0x89, 0xe0, 0x51, 0x78, 0x8a,
-	0x1e, 0x78, 0x04, 0x9e, 0x00, 0xed, 0x7a, 0x5d, 0xa9, 0x12, 0x12, 0xe9, 0x29, 0xf3, 0xf5, 0x9b,
-	0xfd, 0x67, 0x66, 0x4c, 0xde, 0x0a, 0x69, 0xbe, 0xd8, 0x65, 0x56, 0xe0, 0x15, 0xd5, 0x58, 0xe3,
-	0x2b, 0x89, 0x54, 0xd4, 0x88, 0xb4, 0x51, 0xf8, 0x15, 0x0a, 0xa3, 0xa9, 0xe0, 0x06, 0xae, 0xf9,
-	0x77, 0xca, 0x1b, 0x49, 0xd7, 0x47, 0x9d, 0x9b, 0x35, 0x0a, 0x0d, 0xc6, 0xe3, 0xce, 0x75, 0x6c,
-	0x26, 0x71, 0x72, 0x20, 0x10, 0x45, 0x0d, 0xd4, 0xa7, 0x97, 0xb6, 0xa2, 0xd7, 0x8a, 0x37, 0x0d,
-	0x28, 0xdd, 0x02, 0x93, 0x3d, 0x81, 0x02, 0xbd, 0x49, 0x9d, 0x15, 0xa2, 0x47, 0xff, 0x50, 0xe1,
-	0x7f, 0x2f, 0xa5, 0xe9, 0x1e, 0xbe, 0x02, 0xc3, 0x4b, 0x6e, 0x78, 0x40, 0xe8, 0x06, 0x88, 0x36,
-	0xdc, 0xd8, 0xee, 0xe5, 0x97, 0x1b, 0x00, 0x0a, 0xaa, 0x50, 0x7d, 0xfa, 0xff, 0xb9, 0x38, 0x2f,
-	0x70, 0x8d, 0xc2, 0x6f, 0x61, 0x24, 0x93, 0xb3, 0xfb, 0x91, 0xb5, 0x15, 0x72, 0x15, 0x34, 0xce,
-	0x7e, 0xf4, 0xc8, 0xe0, 0xbc, 0x9d, 0x68, 0xbc, 0x4b, 0x7a, 0x5a, 0xd7, 0x49, 0x34, 0x8d, 0xd2,
-	0x21, 0x73, 0x66, 0xfc, 0x9e, 0xec, 0xae, 0xa5, 0x32, 0x96, 0xd7, 0x0b, 0x0d, 0x6a, 0x2d, 0x0b,
-	0xd0, 0xc9, 0xc3, 0x69, 0x2f, 0x1d, 0x1d, 0xef, 0x67, 0x05, 0x2a, 0xe8, 0x96, 0x90, 0x31, 0xd0,
-	0x68, 0x55, 0x01, 0x0c, 0xaa, 0x7c, 0xeb, 0xd7, 0xcd, 0xe1, 0x03, 0x36, 0x0e, 0xe0, 0x45, 0xe0,
-	0xe2, 0x17, 0xe4, 0xf1, 0x52, 0xae, 0xca, 0x05, 0x2f, 0x4b, 0x05, 0x5a, 0x27, 0xbd, 0x69, 0x94,
-	0x6e, 0xb3, 0x91, 0x8b, 0xbd, 0x6b, 0x43, 0xf1, 0x33, 0xb2, 0xed, 0x4b, 0x1a, 0x54, 0x26, 0xd9,
-	0x9a, 0x46, 0xe9, 0x0e, 0x1b, 0xba, 0xc0, 0x1c, 0x95, 0x89, 0x5f, 0x93, 0x41, 0x90, 0x9e, 0x3c,
-	0x9a, 0x46, 0xe9, 0xe8, 0xf8, 0x79, 0xe6, 0xfe, 0xd6, 0xad, 0x84, 0x0f, 0x52, 0x1b, 0x58, 0x81,
-	0x9a, 0xb7, 0x45, 0xac, 0xab, 0x8e, 0xcf, 0x49, 0xbf, 0x5d, 0x4b, 0xd2, 0xf7, 0xdc, 0xde, 0x5d,
-	0xe9, 0x17, 0x3e, 0x97, 0xef, 0x3b, 0xd5, 0x7f, 0x6e, 0x0e, 0x9f, 0x18, 0xd0, 0xa6, 0x94, 0x55,
-	0x75, 0x36, 0x93, 0x62, 0x85, 0x0a, 0x66, 0x2c, 0xe0, 0xf1, 0x29, 0x19, 0x76, 0x27, 0x91, 0x0c,
-	0x7c, 0xab, 0xa7, 0x77, 0x5b, 0x7d, 0x0c, 0xd9, 0x30, 0x82, 0xdb, 0xea, 0x38, 0x27, 0x63, 0xab,
-	0x61, 0xe1, 0x97, 0xb6, 0xf0, 0x83, 0x4f, 0x86, 0xbe, 0xc1, 0x24, 0x6b, 0xaf, 0x37, 0xeb, 0xae,
-	0x37, 0xcb, 0x11, 0xeb, 0x4f, 0xbc, 0xb6, 0xc0, 0x76, 0xac, 0x86, 0xb9, 0x23, 0xe6, 0x2e, 0x97,
-	0xbf, 0xf9, 0xf9, 0xfb, 0x20, 0xfa, 0x7c, 0xb2, 0xf1, 0xd7, 0xd3, 0x5c, 0x8a, 0xb0, 0xf2, 0x65,
-	0xdf, 0x77, 0x3f, 0xf9, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x7f, 0x36, 0xee, 0x27, 0x7b, 0x03, 0x00,
-	0x00,
+	// 792 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x95, 0xd1, 0x6e, 0xdb, 0x36,
+	0x14, 0x86, 0xa3, 0xc4, 0x89, 0x6d, 0x7a, 0x4e, 0x5c, 0x2e, 0xd9, 0x54, 0x0f, 0x6d, 0x3c, 0x5f,
+	0xf9, 0x62, 0x95, 0xd6, 0x14, 0x43, 0xd3, 0x60, 0x1b, 0x50, 0xad, 0x45, 0xdb, 0xa1, 0xdb, 0x0c,
+	0x76, 0xd8, 0x80, 0xdd, 0x08, 0xb4, 0x74, 0xa4, 0x71, 0xa1, 0x49, 0x81, 0xa4, 0xd2, 0xfa, 0x85,
+	0x86, 0x3d, 0xca, 0xde, 0x60, 0x77, 0xbd, 0xd8, 0x23, 0xec, 0x09, 0x06, 0x52, 0x54, 0x9a, 0x34,
+	0x01, 0x9c, 0x5e, 0xe5, 0x9c, 0xc3, 0xf3, 0x1d, 0x1d, 0xfd, 0xfc, 0x15, 0xa3, 0x6f, 0x4a, 0x66,
+	0x7e, 0xaf, 0x17, 0x51, 0x26, 0x97, 0xb1, 0x96, 0x5c, 0xde, 0x63, 0x32, 0x2e, 0xb9, 0x94, 0x71,
+	0xa5, 0xe4, 0x1f, 0x90, 0x19, 0x1d, 0x97, 0xd4, 0xc0, 0x6b, 0xba, 0x8a, 0x69, 0xc5, 0xe2, 0xb3,
+	0xfb, 0x6d, 0x1a, 0x55, 0x4a, 0x1a, 0x89, 0xf7, 0xda, 0xd4, 0xb2, 0x11, 0x93, 0xe3, 0xbb, 0xa5,
+	0x94, 0x25, 0x87, 0xd8, 0x1d, 0x2f, 0xea, 0x22, 0x7e, 0xad, 0x68, 0x55, 0x81, 0xd2, 0x0d, 0x30,
+	0xde, 0x2f, 0x65, 0x29, 0x5d, 0x18, 0xdb, 0xc8, 0x57, 0xef, 0x5f, 0xb3, 0x85, 0xfb, 0x7b, 0xca,
+	0x4c, 0xfb, 0xe0, 0x25, 0x18, 0x9a, 0x53, 0x43, 0x3d, 0x12, 0xdf, 0x00, 0xd1, 0x86, 0x9a, 0xba,
+	0x7d, 0xf2, 0x17, 0x37, 0x00, 0x14, 0x14, 0xbe, 0xfb, 0x78, 0xbd, 0x2e, 0x36, 0xf3, 0x5c, 0xa5,
+	0xe4, 0x1b, 0x2f, 0xc9, 0xf8, 0xe4, 0xc3, 0x48, 0x5e, 0x97, 0x4c, 0xf8, 0x1d, 0xa7, 0x7f, 0x76,
+	0x50, 0xf7, 0x59, 0xa3, 0x28, 0x1e, 0xa1, 0x2d, 0xad, 0x79, 0x18, 0x4c, 0x82, 0x59, 0x8f, 0xd8,
+	0x10, 0x7f, 0x8f, 0x46, 0x67, 0x4c, 0x99, 0x9a, 0xf2, 0x54, 0x83, 0x3a, 0x63, 0x19, 0xe8, 0x70,
+	0x73, 0xb2, 0x35, 0x1b, 0x1c, 0xdd, 0x8e, 0x32, 0xa9, 0xa0, 0xbd, 0x84, 0x88, 0x80, 0x96, 0xb5,
+	0xca, 0x80, 0x40, 0x91, 0x74, 0xfe, 0x7e, 0x7b, 0xb8, 0x41, 0xf6, 0x3c, 0xf8, 0xca, 0x73, 0xf8,
+	0x73, 0xf4, 0xd1, 0x82, 0x89, 0x3c, 0xa5, 0x79, 0xae, 0x40, 0xeb, 0x70, 0x6b, 0x12, 0xcc, 0xfa,
+	0x64, 0x60, 0x6b, 0x8f, 0x9b, 0x12, 0xfe, 0x0c, 0xf5, 0x5d, 0x4b, 0x25, 0x95, 0x09, 0x3b, 0x93,
+	0x60, 0x36, 0x24, 0x3d, 0x5b, 0x98, 0x4b, 0x65, 0xf0, 0x43, 0xd4, 0xf5, 0xab, 0x87, 0xdb, 0x93,
+	0x60, 0x36, 0x38, 0xba, 0x13, 0xd9, 0xd7, 0x3a, 0x5f, 0xe1, 0x25, 0xd3, 0x06, 0x04, 0xa8, 0x79,
+	0xd3, 0x44, 0xda, 0x6e, 0xfc, 0x0c, 0xed, 0x34, 0xd7, 0x12, 0xee, 0x38, 0x6e, 0xff, 0xf2, 0xea,
+	0xaf, 0xdc, 0x59, 0x72, 0xdb, 0x6e, 0xfd, 0xdf, 0xdb, 0xc3, 0x5b, 0x06, 0xb4, 0xc9, 0x59, 0x51,
+	0x9c, 0x4c, 0x59, 0x29, 0xa4, 0x82, 0x29, 0xf1, 0x38, 0x3e, 0x46, 0xbd, 0xd6, 0x12, 0x61, 0xd7,
+	0x8d, 0xfa, 0xe4, 0xf2, 0xa8, 0x1f, 0xfc, 0xa9, 0x97, 0xe0, 0xbc, 0x1b, 0x27, 0x68, 0xaf, 0xd6,
+	0x90, 0xba, 0x4b, 0x4b, 0x9d, 0xf0, 0x61, 0xcf, 0x0d, 0x18, 0x47, 0x8d, 0x7b, 0xa3, 0xd6, 0xbd,
+	0x51, 0x22, 0x25, 0xff, 0x85, 0xf2, 0x1a, 0xc8, 0xb0, 0xd6, 0x30, 0xb7, 0xc4, 0xdc, 0x19, 0xff,
+	0x11, 0xea, 0xfa, 0x3b, 0x08, 0xfb, 0x8e, 0x3d, 0x8c, 0xde, 0xfb, 0x14, 0x22, 0xaf, 0xf5, 0x4f,
+	0x95, 0x61, 0xd2, 0x2a, 0xe0, 0xfb, 0x71, 0x82, 0x50, 0x0e, 0x15, 0x97, 0xab, 0x25, 0x08, 0x13,
+	0x22, 0x47, 0x4f, 0xaf, 0xd0, 0x4f, 0xce, 0x5b, 0xda, 0x01, 0x17, 0xa8, 0xe9, 0x3f, 0x1d, 0xb4,
+	0x7b, 0x79, 0x3e, 0xc6, 0xa8, 0x23, 0xe8, 0x12, 0x9c, 0x61, 0xfa, 0xc4, 0xc5, 0x78, 0x86, 0x46,
+	0x5c, 0xd2, 0x3c, 0x5d, 0x50, 0x4e, 0x45, 0x06, 0x2a, 0x65, 0x55, 0xb8, 0xe9, 0xce, 0x77, 0x6d,
+	0x3d, 0xf1, 0xe5, 0x17, 0x15, 0x26, 0x68, 0x40, 0x85, 0x90, 0x86, 0xba, 0x61, 0xe1, 0x96, 0xb3,
+	0xd5, 0x97, 0x6b, 0xde, 0x29, 0x7a, 0xfc, 0x0e, 0x79, 0x2a, 0x8c, 0x5a, 0x91, 0x8b, 0x43, 0xf0,
+	0x12, 0x7d, 0x0a, 0x6f, 0x0c, 0x28, 0x41, 0x79, 0x6a, 0x14, 0x2d, 0x0a, 0x96, 0xa5, 0x95, 0xe4,
+	0x2c, 0x5b, 0x39, 0x3b, 0xed, 0x1e, 0x7d, 0xb5, 0x6e, 0xfe, 0x53, 0x8f, 0xff, 0xdc, 0xd0, 0x73,
+	0x07, 0x93, 0x03, 0xb8, 0xae, 0x8c, 0xef, 0xa0, 0xbe, 0x90, 0x39, 0x34, 0x7e, 0xb5, 0xa6, 0x1c,
+	0x3e, 0xdf, 0x20, 0x3d, 0x5b, 0x72, 0x8e, 0xfd, 0x15, 0xed, 0x9d, 0x1f, 0xa7, 0x8a, 0x8a, 0x12,
+	0xbc, 0x03, 0xef, 0xad, 0xdb, 0xe2, 0x47, 0x3f, 0x82, 0x58, 0xe8, 0xf9, 0x06, 0x19, 0x8a, 0x8b,
+	0x85, 0xf1, 0xb7, 0x68, 0xf4, 0xbe, 0x0e, 0xf6, 0xe3, 0x3d, 0x85, 0x95, 0xbf, 0x0b, 0x1b, 0xe2,
+	0x7d, 0xb4, 0x7d, 0x66, 0x8d, 0xe4, 0xf5, 0x6f, 0x92, 0x93, 0xcd, 0xe3, 0x60, 0xfc, 0x10, 0x0d,
+	0x2f, 0x3d, 0xc1, 0xb6, 0x6a, 0x43, 0x95, 0x71, 0xf8, 0x90, 0x34, 0x89, 0x1d, 0x09, 0x22, 0x77,
+	0xf8, 0x90, 0xd8, 0x70, 0xfa, 0x35, 0x3a, 0xb8, 0x56, 0x20, 0x3c, 0x40, 0xdd, 0x27, 0x50, 0xd0,
+	0x9a, 0x9b, 0xd1, 0x86, 0x4d, 0xbe, 0xe3, 0xb5, 0x36, 0xa0, 0x46, 0x01, 0xee, 0xa3, 0xed, 0x97,
+	0x32, 0xa3, 0x7c, 0xb4, 0x99, 0x1c, 0xa0, 0x8f, 0xdf, 0xe9, 0xa1, 0x2b, 0xc8, 0x58, 0xc1, 0x40,
+	0x4d, 0x5f, 0xa0, 0x5b, 0x57, 0xac, 0x77, 0xad, 0xb7, 0x26, 0x68, 0x90, 0x49, 0x91, 0xd5, 0x4a,
+	0x81, 0xc8, 0x56, 0x7e, 0xaf, 0x8b, 0xa5, 0xe4, 0xd1, 0x5f, 0xff, 0xde, 0x0d, 0x7e, 0x7b, 0x70,
+	0xe3, 0x5f, 0x98, 0xea, 0xb4, 0xf4, 0xff, 0x16, 0x17, 0x3b, 0xee, 0x0b, 0x7c, 0xf0, 0xff, 0x00,
+	0xab, 0xee, 0x8f, 0x3c, 0x9f, 0x06, 0x00, 0x00,
 }
 
 func (this *Gateway) Equal(that interface{}) bool {
@@ -227,6 +576,170 @@ func (this *Gateway) Equal(that interface{}) bool {
 	if !this.UseProxyProto.Equal(that1.UseProxyProto) {
 		return false
 	}
+	if !this.Service.Equal(that1.Service) {
+		return false
+	}
+	if !this.Deployment.Equal(that1.Deployment) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *ServiceOptions) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ServiceOptions)
+	if !ok {
+		that2, ok := that.(ServiceOptions)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.LoadBalancerIp != that1.LoadBalancerIp {
+		return false
+	}
+	if len(this.Annotations) != len(that1.Annotations) {
+		return false
+	}
+	for i := range this.Annotations {
+		if this.Annotations[i] != that1.Annotations[i] {
+			return false
+		}
+	}
+	if this.ExternalTrafficPolicy != that1.ExternalTrafficPolicy {
+		return false
+	}
+	if that1.NodePortSpecifier == nil {
+		if this.NodePortSpecifier != nil {
+			return false
+		}
+	} else if this.NodePortSpecifier == nil {
+		return false
+	} else if !this.NodePortSpecifier.Equal(that1.NodePortSpecifier) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *ServiceOptions_NodePort) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ServiceOptions_NodePort)
+	if !ok {
+		that2, ok := that.(ServiceOptions_NodePort)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.NodePort != that1.NodePort {
+		return false
+	}
+	return true
+}
+func (this *ServiceOptions_NodePortRange_) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ServiceOptions_NodePortRange_)
+	if !ok {
+		that2, ok := that.(ServiceOptions_NodePortRange_)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.NodePortRange.Equal(that1.NodePortRange) {
+		return false
+	}
+	return true
+}
+func (this *ServiceOptions_NodePortRange) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ServiceOptions_NodePortRange)
+	if !ok {
+		that2, ok := that.(ServiceOptions_NodePortRange)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Start != that1.Start {
+		return false
+	}
+	if this.End != that1.End {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *DeploymentOptions) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DeploymentOptions)
+	if !ok {
+		that2, ok := that.(DeploymentOptions)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.Concurrency != that1.Concurrency {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -0,0 +1,13 @@
+package deployments_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestDeployments(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Deployments Suite")
+}
@@ -0,0 +1,82 @@
+package deployments
+
+import (
+	"strconv"
+	"strings"
+
+	v1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	"github.com/solo-io/solo-kit/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	kubev1 "k8s.io/api/core/v1"
+)
+
+const (
+	DefaultDeploymentName = "gateway-proxy"
+
+	// the name of the envoy container in the deployments of the helm chart
+	proxyContainerName = "gateway-proxy"
+	concurrencyFlag    = "--concurrency"
+)
+
+// DeploymentName returns the name of the deployment configured by the gateway
+func DeploymentName(gateway *v1.Gateway) string {
+	if gateway.Deployment == nil || gateway.Deployment.Name == "" {
+		return DefaultDeploymentName
+	}
+	return gateway.Deployment.Name
+}
+
+// ApplyOptions applies the deployment options of the gateways to their deployment
+func ApplyOptions(deployment *appsv1.Deployment, gateways []*v1.Gateway) error {
+	var concurrency uint32
+	for _, gateway := range gateways {
+		opts := gateway.Deployment
+		if opts == nil || opts.Concurrency == 0 {
+			continue
+		}
+		if concurrency != 0 && concurrency != opts.Concurrency {
+			return errors.Errorf("gateway %v sets concurrency %v, conflicting with another gateway of deployment %v",
+				gateway.Metadata.Ref(), opts.Concurrency, deployment.Name)
+		}
+		concurrency = opts.Concurrency
+	}
+	if concurrency == 0 {
+		return nil
+	}
+
+	container := proxyContainer(deployment)
+	if container == nil {
+		return errors.Errorf("deployment %v has no %v container", deployment.Name, proxyContainerName)
+	}
+	container.Args = append(withoutConcurrency(container.Args), concurrencyFlag, strconv.Itoa(int(concurrency)))
+	return nil
+}
+
+// returns the envoy container, or the only container of the deployment
+func proxyContainer(deployment *appsv1.Deployment) *kubev1.Container {
+	containers := deployment.Spec.Template.Spec.Containers
+	for i := range containers {
+		if containers[i].Name == proxyContainerName {
+			return &containers[i]
+		}
+	}
+	if len(containers) == 1 {
+		return &containers[0]
+	}
+	return nil
+}
+
+// removes the concurrency flag, given as `--concurrency N` or `--concurrency=N`
+func withoutConcurrency(args []string) []string {
+	var out []string
+	for i := 0; i < len(args); i++ {
+		switch {
+		case args[i] == concurrencyFlag:
+			i++
+		case strings.HasPrefix(args[i], concurrencyFlag+"="):
+		default:
+			out = append(out, args[i])
+		}
+	}
+	return out
+}
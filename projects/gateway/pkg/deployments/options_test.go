@@ -0,0 +1,69 @@
+package deployments_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	. "github.com/solo-io/gloo/projects/gateway/pkg/deployments"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	appsv1 "k8s.io/api/apps/v1"
+	kubev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("ApplyOptions", func() {
+	var (
+		deployment *appsv1.Deployment
+		gateway    *v1.Gateway
+	)
+
+	BeforeEach(func() {
+		deployment = &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "gateway-proxy", Namespace: "gloo-system"},
+			Spec: appsv1.DeploymentSpec{
+				Template: kubev1.PodTemplateSpec{
+					Spec: kubev1.PodSpec{
+						Containers: []kubev1.Container{
+							{Name: "sidecar"},
+							{Name: "gateway-proxy", Args: []string{"--disable-hot-restart"}},
+						},
+					},
+				},
+			},
+		}
+		gateway = &v1.Gateway{
+			Metadata:   core.Metadata{Name: "gateway", Namespace: "gloo-system"},
+			Deployment: &v1.DeploymentOptions{Concurrency: 4},
+		}
+	})
+
+	args := func() []string {
+		return deployment.Spec.Template.Spec.Containers[1].Args
+	}
+
+	It("sets the concurrency of the proxy container", func() {
+		Expect(ApplyOptions(deployment, []*v1.Gateway{gateway})).NotTo(HaveOccurred())
+		Expect(args()).To(Equal([]string{"--disable-hot-restart", "--concurrency", "4"}))
+		Expect(deployment.Spec.Template.Spec.Containers[0].Args).To(BeEmpty())
+	})
+
+	It("replaces the existing concurrency", func() {
+		deployment.Spec.Template.Spec.Containers[1].Args = []string{"--concurrency=2", "--disable-hot-restart", "--concurrency", "8"}
+		Expect(ApplyOptions(deployment, []*v1.Gateway{gateway})).NotTo(HaveOccurred())
+		Expect(args()).To(Equal([]string{"--disable-hot-restart", "--concurrency", "4"}))
+	})
+
+	It("leaves the deployment untouched without concurrency", func() {
+		gateway.Deployment.Concurrency = 0
+		Expect(ApplyOptions(deployment, []*v1.Gateway{gateway})).NotTo(HaveOccurred())
+		Expect(args()).To(Equal([]string{"--disable-hot-restart"}))
+	})
+
+	It("errors when gateways of the same deployment conflict", func() {
+		other := &v1.Gateway{
+			Metadata:   core.Metadata{Name: "gateway-ssl", Namespace: "gloo-system"},
+			Deployment: &v1.DeploymentOptions{Concurrency: 2},
+		}
+		Expect(ApplyOptions(deployment, []*v1.Gateway{gateway, other})).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,75 @@
+package deployments
+
+import (
+	"context"
+	"reflect"
+	"sort"
+
+	"github.com/hashicorp/go-multierror"
+	v1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/solo-io/solo-kit/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// DeploymentSyncer applies the deployment options of the gateways to the kubernetes deployments of the gateway proxies
+type DeploymentSyncer struct {
+	kubeClient kubernetes.Interface
+}
+
+func NewDeploymentSyncer(kubeClient kubernetes.Interface) *DeploymentSyncer {
+	return &DeploymentSyncer{kubeClient: kubeClient}
+}
+
+var _ v1.ApiSyncer = &DeploymentSyncer{}
+
+func (s *DeploymentSyncer) Sync(ctx context.Context, snap *v1.ApiSnapshot) error {
+	ctx = contextutils.WithLogger(ctx, "deployment_syncer")
+
+	gatewaysByDeployment := make(map[core.ResourceRef][]*v1.Gateway)
+	var deploymentRefs []core.ResourceRef
+	for _, gateway := range snap.Gateways {
+		if gateway.Deployment == nil {
+			continue
+		}
+		ref := core.ResourceRef{Namespace: gateway.Metadata.Namespace, Name: DeploymentName(gateway)}
+		if _, ok := gatewaysByDeployment[ref]; !ok {
+			deploymentRefs = append(deploymentRefs, ref)
+		}
+		gatewaysByDeployment[ref] = append(gatewaysByDeployment[ref], gateway)
+	}
+	sort.SliceStable(deploymentRefs, func(i, j int) bool {
+		return deploymentRefs[i].Namespace < deploymentRefs[j].Namespace ||
+			(deploymentRefs[i].Namespace == deploymentRefs[j].Namespace && deploymentRefs[i].Name < deploymentRefs[j].Name)
+	})
+
+	var errs error
+	for _, ref := range deploymentRefs {
+		if err := s.syncDeployment(ctx, ref, gatewaysByDeployment[ref]); err != nil {
+			errs = multierror.Append(errs, err)
+		}
+	}
+	return errs
+}
+
+func (s *DeploymentSyncer) syncDeployment(ctx context.Context, ref core.ResourceRef, gateways []*v1.Gateway) error {
+	deployment, err := s.kubeClient.AppsV1().Deployments(ref.Namespace).Get(ref.Name, metav1.GetOptions{})
+	if err != nil {
+		return errors.Wrapf(err, "unable to get gateway deployment %v", ref)
+	}
+	original := deployment.DeepCopy()
+	if err := ApplyOptions(deployment, gateways); err != nil {
+		return errors.Wrapf(err, "unable to configure gateway deployment %v", ref)
+	}
+	if reflect.DeepEqual(original, deployment) {
+		return nil
+	}
+	// changing the pod template rolls out new proxies
+	contextutils.LoggerFrom(ctx).Infof("updating gateway deployment %v", ref)
+	if _, err := s.kubeClient.AppsV1().Deployments(ref.Namespace).Update(deployment); err != nil {
+		return errors.Wrapf(err, "unable to update gateway deployment %v", ref)
+	}
+	return nil
+}
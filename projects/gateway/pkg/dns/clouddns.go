@@ -0,0 +1,165 @@
+package dns
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/solo-kit/pkg/errors"
+	"golang.org/x/oauth2/google"
+)
+
+const (
+	cloudDnsApi   = "https://dns.googleapis.com/dns/v1"
+	cloudDnsScope = "https://www.googleapis.com/auth/ndev.clouddns.readwrite"
+)
+
+type resourceRecordSet struct {
+	Name    string   `json:"name"`
+	Type    string   `json:"type"`
+	Ttl     int64    `json:"ttl"`
+	Rrdatas []string `json:"rrdatas"`
+}
+
+type cloudDnsProvider struct {
+	httpClient *http.Client
+	// the url of the managed zone
+	zoneUrl string
+}
+
+func NewCloudDnsProvider(ctx context.Context, config *gloov1.DnsPublishing_CloudDns) (Provider, error) {
+	if config.Project == "" || config.ManagedZone == "" {
+		return nil, errors.Errorf("the project and the managed zone are required to publish to cloud dns")
+	}
+	// the client refreshes its token with the context, which must outlive it
+	httpClient, err := google.DefaultClient(ctx, cloudDnsScope)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to get the google application default credentials")
+	}
+	return &cloudDnsProvider{
+		httpClient: httpClient,
+		zoneUrl: fmt.Sprintf("%s/projects/%s/managedZones/%s", cloudDnsApi,
+			url.PathEscape(config.Project), url.PathEscape(config.ManagedZone)),
+	}, nil
+}
+
+func (p *cloudDnsProvider) Records(ctx context.Context) ([]Record, error) {
+	var records []Record
+	pageToken := ""
+	for {
+		var page struct {
+			Rrsets        []resourceRecordSet `json:"rrsets"`
+			NextPageToken string              `json:"nextPageToken"`
+		}
+		query := url.Values{}
+		if pageToken != "" {
+			query.Set("pageToken", pageToken)
+		}
+		if err := p.do(ctx, http.MethodGet, "/rrsets?"+query.Encode(), nil, &page); err != nil {
+			return nil, errors.Wrapf(err, "unable to list the records of managed zone %v", p.zoneUrl)
+		}
+		for _, set := range page.Rrsets {
+			if set.Type != RecordTypeA && set.Type != RecordTypeCNAME && set.Type != RecordTypeTXT {
+				continue
+			}
+			record := Record{
+				Name: strings.TrimSuffix(set.Name, "."),
+				Type: set.Type,
+				TTL:  set.Ttl,
+			}
+			for _, target := range set.Rrdatas {
+				switch set.Type {
+				case RecordTypeTXT:
+					target = strings.Trim(target, `"`)
+				case RecordTypeCNAME:
+					target = strings.TrimSuffix(target, ".")
+				}
+				record.Targets = append(record.Targets, target)
+			}
+			records = append(records, record)
+		}
+		if page.NextPageToken == "" {
+			return records, nil
+		}
+		pageToken = page.NextPageToken
+	}
+}
+
+func (p *cloudDnsProvider) Apply(ctx context.Context, changes Changes) error {
+	var change struct {
+		Additions []resourceRecordSet `json:"additions,omitempty"`
+		Deletions []resourceRecordSet `json:"deletions,omitempty"`
+	}
+	for _, record := range changes.Delete {
+		change.Deletions = append(change.Deletions, cloudDnsRecordSet(record))
+	}
+	// record sets are updated by replacing them
+	for _, update := range changes.Update {
+		change.Deletions = append(change.Deletions, cloudDnsRecordSet(update.Old))
+		change.Additions = append(change.Additions, cloudDnsRecordSet(update.New))
+	}
+	for _, record := range changes.Create {
+		change.Additions = append(change.Additions, cloudDnsRecordSet(record))
+	}
+	if err := p.do(ctx, http.MethodPost, "/changes", change, nil); err != nil {
+		return errors.Wrapf(err, "unable to change the records of managed zone %v", p.zoneUrl)
+	}
+	return nil
+}
+
+func cloudDnsRecordSet(record Record) resourceRecordSet {
+	set := resourceRecordSet{
+		Name: record.Name + ".",
+		Type: record.Type,
+		Ttl:  record.TTL,
+	}
+	for _, target := range record.Targets {
+		switch record.Type {
+		case RecordTypeTXT:
+			target = `"` + target + `"`
+		case RecordTypeCNAME:
+			target = target + "."
+		}
+		set.Rrdatas = append(set.Rrdatas, target)
+	}
+	return set
+}
+
+func (p *cloudDnsProvider) do(ctx context.Context, method, path string, in, out interface{}) error {
+	var body []byte
+	if in != nil {
+		var err error
+		body, err = json.Marshal(in)
+		if err != nil {
+			return err
+		}
+	}
+	req, err := http.NewRequest(method, p.zoneUrl+path, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := p.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return errors.Errorf("cloud dns api returned %v: %s", resp.Status, respBody)
+	}
+	if out == nil {
+		return nil
+	}
+	return json.Unmarshal(respBody, out)
+}
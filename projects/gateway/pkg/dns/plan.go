@@ -0,0 +1,172 @@
+package dns
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+const (
+	RecordTypeA     = "A"
+	RecordTypeCNAME = "CNAME"
+	RecordTypeTXT   = "TXT"
+
+	ownershipPrefix         = "_gloo-owner."
+	wildcardOwnershipPrefix = "_gloo-owner-wildcard."
+)
+
+// Record is a DNS record set. Names have no trailing dot, and the targets of TXT records are not quoted.
+type Record struct {
+	Name    string
+	Type    string
+	Targets []string
+	TTL     int64
+}
+
+func (r Record) Equal(other Record) bool {
+	if r.Name != other.Name || r.Type != other.Type || r.TTL != other.TTL || len(r.Targets) != len(other.Targets) {
+		return false
+	}
+	targets := sortedTargets(r)
+	otherTargets := sortedTargets(other)
+	for i := range targets {
+		if targets[i] != otherTargets[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func sortedTargets(r Record) []string {
+	targets := append([]string{}, r.Targets...)
+	sort.Strings(targets)
+	return targets
+}
+
+type Update struct {
+	Old Record
+	New Record
+}
+
+// Changes to apply to the records of a zone
+type Changes struct {
+	Create []Record
+	Update []Update
+	Delete []Record
+}
+
+func (c Changes) Empty() bool {
+	return len(c.Create) == 0 && len(c.Update) == 0 && len(c.Delete) == 0
+}
+
+// OwnershipName returns the name of the TXT record claiming the records of the domain
+func OwnershipName(domain string) string {
+	if strings.HasPrefix(domain, "*.") {
+		return wildcardOwnershipPrefix + strings.TrimPrefix(domain, "*.")
+	}
+	return ownershipPrefix + domain
+}
+
+// returns the domain claimed by an ownership record, or false if the name is not the one of an ownership record
+func ownedDomain(ownershipName string) (string, bool) {
+	if strings.HasPrefix(ownershipName, wildcardOwnershipPrefix) {
+		return "*." + strings.TrimPrefix(ownershipName, wildcardOwnershipPrefix), true
+	}
+	if strings.HasPrefix(ownershipName, ownershipPrefix) {
+		return strings.TrimPrefix(ownershipName, ownershipPrefix), true
+	}
+	return "", false
+}
+
+// OwnershipValue returns the value of the TXT records claiming the records of the owner
+func OwnershipValue(ownerId string) string {
+	return fmt.Sprintf("heritage=gloo,gloo/owner=%s", ownerId)
+}
+
+// Plan returns the changes publishing the desired records, given the current records of the zone.
+// Records claimed by another owner, or not claimed at all, are never changed: their domains are
+// returned as conflicts instead.
+func Plan(ownerId string, desired, current []Record) (Changes, []string) {
+	currentByName := make(map[string][]Record)
+	for _, record := range current {
+		currentByName[record.Name] = append(currentByName[record.Name], record)
+	}
+	ownership := Record{Type: RecordTypeTXT, Targets: []string{OwnershipValue(ownerId)}}
+
+	var (
+		changes   Changes
+		conflicts []string
+	)
+	desiredDomains := make(map[string]bool)
+	for _, record := range desired {
+		desiredDomains[record.Name] = true
+
+		ownershipRecords := currentByName[OwnershipName(record.Name)]
+		existing := addressRecords(currentByName[record.Name])
+		owned := isOwned(ownershipRecords, ownerId)
+		if !owned && (len(ownershipRecords) > 0 || len(existing) > 0) {
+			conflicts = append(conflicts, record.Name)
+			continue
+		}
+
+		if !owned {
+			txt := ownership
+			txt.Name = OwnershipName(record.Name)
+			txt.TTL = record.TTL
+			changes.Create = append(changes.Create, txt, record)
+			continue
+		}
+
+		switch {
+		case len(existing) == 0:
+			changes.Create = append(changes.Create, record)
+		case len(existing) == 1 && existing[0].Type == record.Type:
+			if !existing[0].Equal(record) {
+				changes.Update = append(changes.Update, Update{Old: existing[0], New: record})
+			}
+		default:
+			// the type of the record changed, e.g. the gateway got an ip instead of a hostname
+			changes.Delete = append(changes.Delete, existing...)
+			changes.Create = append(changes.Create, record)
+		}
+	}
+
+	// clean up the records of the domains that are not used anymore
+	for _, record := range current {
+		if record.Type != RecordTypeTXT {
+			continue
+		}
+		domain, ok := ownedDomain(record.Name)
+		if !ok || desiredDomains[domain] || !isOwned([]Record{record}, ownerId) {
+			continue
+		}
+		changes.Delete = append(changes.Delete, addressRecords(currentByName[domain])...)
+		changes.Delete = append(changes.Delete, record)
+	}
+	return changes, conflicts
+}
+
+// only the A and CNAME records are published, other records of the domains are left untouched
+func addressRecords(records []Record) []Record {
+	var out []Record
+	for _, record := range records {
+		if record.Type == RecordTypeA || record.Type == RecordTypeCNAME {
+			out = append(out, record)
+		}
+	}
+	return out
+}
+
+func isOwned(ownershipRecords []Record, ownerId string) bool {
+	for _, record := range ownershipRecords {
+		if record.Type != RecordTypeTXT {
+			continue
+		}
+		for _, target := range record.Targets {
+			if target == OwnershipValue(ownerId) {
+				return true
+			}
+		}
+	}
+	return false
+}
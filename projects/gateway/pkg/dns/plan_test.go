@@ -0,0 +1,85 @@
+package dns_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/solo-io/gloo/projects/gateway/pkg/dns"
+)
+
+var _ = Describe("Plan", func() {
+	const owner = "gloo-1"
+
+	a := func(name string, ips ...string) Record {
+		return Record{Name: name, Type: RecordTypeA, Targets: ips, TTL: 300}
+	}
+	txt := func(name, owner string) Record {
+		return Record{Name: OwnershipName(name), Type: RecordTypeTXT, Targets: []string{OwnershipValue(owner)}, TTL: 300}
+	}
+
+	It("should create the records and their ownership records", func() {
+		changes, conflicts := Plan(owner, []Record{a("foo.example.com", "1.2.3.4")}, nil)
+		Expect(conflicts).To(BeEmpty())
+		Expect(changes).To(Equal(Changes{
+			Create: []Record{txt("foo.example.com", owner), a("foo.example.com", "1.2.3.4")},
+		}))
+	})
+
+	It("should name the ownership records of wildcard domains", func() {
+		Expect(OwnershipName("*.example.com")).To(Equal("_gloo-owner-wildcard.example.com"))
+		Expect(OwnershipName("foo.example.com")).To(Equal("_gloo-owner.foo.example.com"))
+	})
+
+	It("should do nothing when the records are published", func() {
+		current := []Record{txt("foo.example.com", owner), a("foo.example.com", "1.2.3.4")}
+		changes, conflicts := Plan(owner, []Record{a("foo.example.com", "1.2.3.4")}, current)
+		Expect(conflicts).To(BeEmpty())
+		Expect(changes.Empty()).To(BeTrue())
+	})
+
+	It("should update the owned records", func() {
+		current := []Record{txt("foo.example.com", owner), a("foo.example.com", "1.2.3.4")}
+		changes, _ := Plan(owner, []Record{a("foo.example.com", "5.6.7.8")}, current)
+		Expect(changes).To(Equal(Changes{
+			Update: []Update{{Old: a("foo.example.com", "1.2.3.4"), New: a("foo.example.com", "5.6.7.8")}},
+		}))
+	})
+
+	It("should replace the owned records whose type changed", func() {
+		cname := Record{Name: "foo.example.com", Type: RecordTypeCNAME, Targets: []string{"lb.example.com"}, TTL: 300}
+		current := []Record{txt("foo.example.com", owner), a("foo.example.com", "1.2.3.4")}
+		changes, _ := Plan(owner, []Record{cname}, current)
+		Expect(changes).To(Equal(Changes{
+			Create: []Record{cname},
+			Delete: []Record{a("foo.example.com", "1.2.3.4")},
+		}))
+	})
+
+	It("should not change the records of other owners", func() {
+		current := []Record{txt("foo.example.com", "someone-else"), a("foo.example.com", "1.2.3.4")}
+		changes, conflicts := Plan(owner, []Record{a("foo.example.com", "5.6.7.8")}, current)
+		Expect(conflicts).To(ConsistOf("foo.example.com"))
+		Expect(changes.Empty()).To(BeTrue())
+	})
+
+	It("should not change the records that were not created by gloo", func() {
+		current := []Record{a("foo.example.com", "1.2.3.4")}
+		changes, conflicts := Plan(owner, []Record{a("foo.example.com", "5.6.7.8")}, current)
+		Expect(conflicts).To(ConsistOf("foo.example.com"))
+		Expect(changes.Empty()).To(BeTrue())
+	})
+
+	It("should delete the owned records of the domains that are not used anymore", func() {
+		spf := Record{Name: "bar.example.com", Type: RecordTypeTXT, Targets: []string{"v=spf1 -all"}, TTL: 300}
+		current := []Record{
+			txt("foo.example.com", owner), a("foo.example.com", "1.2.3.4"),
+			txt("bar.example.com", owner), a("bar.example.com", "1.2.3.4"), spf,
+			txt("baz.example.com", "someone-else"), a("baz.example.com", "1.2.3.4"),
+		}
+		changes, conflicts := Plan(owner, []Record{a("foo.example.com", "1.2.3.4")}, current)
+		Expect(conflicts).To(BeEmpty())
+		Expect(changes).To(Equal(Changes{
+			Delete: []Record{a("bar.example.com", "1.2.3.4"), txt("bar.example.com", owner)},
+		}))
+	})
+})
@@ -0,0 +1,27 @@
+package dns
+
+import (
+	"context"
+
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/solo-kit/pkg/errors"
+)
+
+// Provider manages the records of a DNS zone
+type Provider interface {
+	// Records returns the A, CNAME and TXT records of the zone
+	Records(ctx context.Context) ([]Record, error)
+	// Apply applies all the changes at once
+	Apply(ctx context.Context, changes Changes) error
+}
+
+// NewProvider returns the provider configured in the settings
+func NewProvider(ctx context.Context, config *gloov1.DnsPublishing) (Provider, error) {
+	switch provider := config.Provider.(type) {
+	case *gloov1.DnsPublishing_Route53_:
+		return NewRoute53Provider(provider.Route53)
+	case *gloov1.DnsPublishing_CloudDns_:
+		return NewCloudDnsProvider(ctx, provider.CloudDns)
+	}
+	return nil, errors.Errorf("no dns provider configured for dns publishing")
+}
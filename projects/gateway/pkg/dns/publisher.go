@@ -0,0 +1,209 @@
+package dns
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	v1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/solo-kit/pkg/errors"
+	kubev1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	defaultGatewayService = "gateway-proxy"
+	defaultTTL            = 300
+	defaultInterval       = time.Minute
+)
+
+// Publisher publishes the domains of the virtual services bound to the gateways of the namespace,
+// with records pointing at the address of the gateway proxy service.
+// It is synced with the gateways and virtual services, and reconciles the records periodically.
+type Publisher struct {
+	namespace  string
+	config     *gloov1.DnsPublishing
+	provider   Provider
+	kubeClient kubernetes.Interface
+
+	lock    sync.Mutex
+	domains []string
+	// no records are published until the domains are known
+	synced bool
+	resync chan struct{}
+}
+
+func NewPublisher(namespace string, config *gloov1.DnsPublishing, provider Provider, kubeClient kubernetes.Interface) *Publisher {
+	return &Publisher{
+		namespace:  namespace,
+		config:     config,
+		provider:   provider,
+		kubeClient: kubeClient,
+		resync:     make(chan struct{}, 1),
+	}
+}
+
+func (p *Publisher) Sync(ctx context.Context, snap *v1.ApiSnapshot) error {
+	domains := Domains(p.namespace, snap)
+
+	p.lock.Lock()
+	p.domains = domains
+	p.synced = true
+	p.lock.Unlock()
+
+	// publish without waiting for the next interval
+	select {
+	case p.resync <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+// Run publishes the records until the context is done
+func (p *Publisher) Run(ctx context.Context) {
+	ctx = contextutils.WithLogger(ctx, "dns_publisher")
+	interval := defaultInterval
+	if p.config.Interval != nil {
+		if d, err := types.DurationFromProto(p.config.Interval); err == nil && d > 0 {
+			interval = d
+		}
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-p.resync:
+		}
+		if err := p.Publish(ctx); err != nil {
+			contextutils.LoggerFrom(ctx).Warnw("unable to publish dns records", "error", err)
+		}
+	}
+}
+
+// Publish reconciles the records of the provider with the domains of the last sync
+func (p *Publisher) Publish(ctx context.Context) error {
+	p.lock.Lock()
+	domains, synced := p.domains, p.synced
+	p.lock.Unlock()
+	if !synced {
+		return nil
+	}
+
+	record, err := p.gatewayRecord(ctx)
+	if err != nil {
+		return err
+	}
+	if record == nil {
+		contextutils.LoggerFrom(ctx).Infof("the gateway service has no address yet, not publishing dns records")
+		return nil
+	}
+	var desired []Record
+	for _, domain := range domains {
+		r := *record
+		r.Name = domain
+		desired = append(desired, r)
+	}
+
+	current, err := p.provider.Records(ctx)
+	if err != nil {
+		return err
+	}
+	changes, conflicts := Plan(p.config.OwnerId, desired, current)
+	for _, domain := range conflicts {
+		contextutils.LoggerFrom(ctx).Warnw("not publishing domain, its records are owned by another owner or were not created by gloo",
+			"domain", domain)
+	}
+	if changes.Empty() {
+		return nil
+	}
+	contextutils.LoggerFrom(ctx).Infow("publishing dns records", "create", len(changes.Create),
+		"update", len(changes.Update), "delete", len(changes.Delete))
+	return p.provider.Apply(ctx, changes)
+}
+
+// returns the record of the address of the gateway service, without name, or nil if it has no address yet
+func (p *Publisher) gatewayRecord(ctx context.Context) (*Record, error) {
+	name := p.config.GatewayService
+	if name == "" {
+		name = defaultGatewayService
+	}
+	svc, err := p.kubeClient.CoreV1().Services(p.namespace).Get(name, kubev1.GetOptions{})
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to get gateway service %v.%v", p.namespace, name)
+	}
+	ttl := int64(p.config.Ttl)
+	if ttl == 0 {
+		ttl = defaultTTL
+	}
+
+	var ips []string
+	for _, ingress := range svc.Status.LoadBalancer.Ingress {
+		if ingress.Hostname != "" {
+			// a record can only have one canonical name
+			return &Record{Type: RecordTypeCNAME, Targets: []string{ingress.Hostname}, TTL: ttl}, nil
+		}
+		if ingress.IP != "" {
+			ips = append(ips, ingress.IP)
+		}
+	}
+	if len(ips) == 0 {
+		return nil, nil
+	}
+	return &Record{Type: RecordTypeA, Targets: ips, TTL: ttl}, nil
+}
+
+// Domains returns the domains of the virtual services bound to the gateways of the namespace.
+// Ports are removed, and the catch-all domain is ignored as it cannot be published.
+func Domains(namespace string, snap *v1.ApiSnapshot) []string {
+	unique := make(map[string]bool)
+	for _, gateway := range snap.Gateways {
+		if gateway.Metadata.Namespace != namespace {
+			continue
+		}
+		for _, vs := range snap.VirtualServices {
+			if !boundToGateway(gateway, vs) || vs.VirtualHost == nil {
+				continue
+			}
+			for _, domain := range vs.VirtualHost.Domains {
+				if i := strings.LastIndex(domain, ":"); i >= 0 {
+					domain = domain[:i]
+				}
+				domain = strings.TrimSuffix(strings.ToLower(domain), ".")
+				if domain == "" || domain == "*" || strings.Contains(strings.TrimPrefix(domain, "*."), "*") {
+					continue
+				}
+				unique[domain] = true
+			}
+		}
+	}
+	var domains []string
+	for domain := range unique {
+		domains = append(domains, domain)
+	}
+	sort.Strings(domains)
+	return domains
+}
+
+// same as the gateway translator, gateways with no virtual services are bound to all of them
+func boundToGateway(gateway *v1.Gateway, vs *v1.VirtualService) bool {
+	if gateway.Ssl != (vs.SslConfig != nil) {
+		return false
+	}
+	if len(gateway.VirtualServices) == 0 {
+		return true
+	}
+	for _, ref := range gateway.VirtualServices {
+		if ref == vs.Metadata.Ref() {
+			return true
+		}
+	}
+	return false
+}
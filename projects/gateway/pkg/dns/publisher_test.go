@@ -0,0 +1,136 @@
+package dns_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	v1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	. "github.com/solo-io/gloo/projects/gateway/pkg/dns"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	corev1 "k8s.io/api/core/v1"
+	kubev1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+type mockProvider struct {
+	records []Record
+	applied []Changes
+}
+
+func (p *mockProvider) Records(ctx context.Context) ([]Record, error) {
+	return p.records, nil
+}
+
+func (p *mockProvider) Apply(ctx context.Context, changes Changes) error {
+	p.applied = append(p.applied, changes)
+	return nil
+}
+
+var _ = Describe("Publisher", func() {
+	const ns = "gloo-system"
+
+	var (
+		snap *v1.ApiSnapshot
+	)
+
+	virtualService := func(name string, domains ...string) *v1.VirtualService {
+		return &v1.VirtualService{
+			Metadata:    core.Metadata{Namespace: ns, Name: name},
+			VirtualHost: &gloov1.VirtualHost{Domains: domains},
+		}
+	}
+
+	BeforeEach(func() {
+		snap = &v1.ApiSnapshot{
+			Gateways: v1.GatewayList{
+				{Metadata: core.Metadata{Namespace: ns, Name: "gateway"}},
+				{Metadata: core.Metadata{Namespace: "other", Name: "gateway"}},
+			},
+			VirtualServices: v1.VirtualServiceList{
+				virtualService("vs1", "foo.example.com", "Bar.example.com:8080"),
+				virtualService("vs2", "*", "*.example.com", "foo.example.com"),
+			},
+		}
+	})
+
+	Context("domains", func() {
+		It("should return the domains of the virtual services of the gateways", func() {
+			Expect(Domains(ns, snap)).To(Equal([]string{"*.example.com", "bar.example.com", "foo.example.com"}))
+		})
+
+		It("should only return the domains of the virtual services bound to the gateways", func() {
+			snap.Gateways[0].VirtualServices = []core.ResourceRef{{Namespace: ns, Name: "vs1"}}
+			Expect(Domains(ns, snap)).To(Equal([]string{"bar.example.com", "foo.example.com"}))
+		})
+
+		It("should not return the domains of ssl virtual services without an ssl gateway", func() {
+			snap.VirtualServices[1].SslConfig = &gloov1.SslConfig{}
+			Expect(Domains(ns, snap)).To(Equal([]string{"bar.example.com", "foo.example.com"}))
+		})
+
+		It("should not return domains without gateways in the namespace", func() {
+			Expect(Domains("default", snap)).To(BeEmpty())
+		})
+	})
+
+	Context("publish", func() {
+		var (
+			provider  *mockProvider
+			publisher *Publisher
+			svc       *corev1.Service
+		)
+
+		BeforeEach(func() {
+			provider = &mockProvider{}
+			svc = &corev1.Service{
+				ObjectMeta: kubev1.ObjectMeta{Namespace: ns, Name: "gateway-proxy"},
+			}
+			snap.VirtualServices = v1.VirtualServiceList{virtualService("vs1", "foo.example.com")}
+		})
+
+		// the fake client copies the gateway service, its status must be set before
+		newPublisher := func() {
+			config := &gloov1.DnsPublishing{OwnerId: "gloo-1"}
+			publisher = NewPublisher(ns, config, provider, fake.NewSimpleClientset(svc))
+		}
+
+		It("should not publish before the first sync", func() {
+			svc.Status.LoadBalancer.Ingress = []corev1.LoadBalancerIngress{{IP: "1.2.3.4"}}
+			newPublisher()
+			Expect(publisher.Publish(context.Background())).NotTo(HaveOccurred())
+			Expect(provider.applied).To(BeEmpty())
+		})
+
+		It("should not publish before the gateway service has an address", func() {
+			newPublisher()
+			Expect(publisher.Sync(context.Background(), snap)).NotTo(HaveOccurred())
+			Expect(publisher.Publish(context.Background())).NotTo(HaveOccurred())
+			Expect(provider.applied).To(BeEmpty())
+		})
+
+		It("should publish A records for the ips of the gateway service", func() {
+			svc.Status.LoadBalancer.Ingress = []corev1.LoadBalancerIngress{{IP: "1.2.3.4"}}
+			newPublisher()
+			Expect(publisher.Sync(context.Background(), snap)).NotTo(HaveOccurred())
+			Expect(publisher.Publish(context.Background())).NotTo(HaveOccurred())
+			Expect(provider.applied).To(HaveLen(1))
+			Expect(provider.applied[0].Create).To(ContainElement(Record{
+				Name: "foo.example.com", Type: RecordTypeA, Targets: []string{"1.2.3.4"}, TTL: 300,
+			}))
+		})
+
+		It("should publish CNAME records for the hostname of the gateway service", func() {
+			svc.Status.LoadBalancer.Ingress = []corev1.LoadBalancerIngress{{Hostname: "lb.example.com"}}
+			newPublisher()
+			Expect(publisher.Sync(context.Background(), snap)).NotTo(HaveOccurred())
+			Expect(publisher.Publish(context.Background())).NotTo(HaveOccurred())
+			Expect(provider.applied).To(HaveLen(1))
+			Expect(provider.applied[0].Create).To(ContainElement(Record{
+				Name: "foo.example.com", Type: RecordTypeCNAME, Targets: []string{"lb.example.com"}, TTL: 300,
+			}))
+		})
+	})
+})
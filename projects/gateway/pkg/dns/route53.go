@@ -0,0 +1,122 @@
+package dns
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/aws/aws-sdk-go/service/route53/route53iface"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	awsplugin "github.com/solo-io/gloo/projects/gloo/pkg/plugins/aws"
+	"github.com/solo-io/solo-kit/pkg/errors"
+)
+
+// route53 is a global service, the region is only used to resolve its endpoint
+const route53Region = "us-east-1"
+
+type route53Provider struct {
+	client       route53iface.Route53API
+	hostedZoneId string
+}
+
+func NewRoute53Provider(config *gloov1.DnsPublishing_Route53) (Provider, error) {
+	if config.HostedZoneId == "" {
+		return nil, errors.Errorf("the hosted zone id is required to publish to route53")
+	}
+	creds := awsplugin.DefaultCredentials()
+	if config.RoleArn != "" {
+		var err error
+		creds, err = awsplugin.RoleCredentials("", "", config.RoleArn)
+		if err != nil {
+			return nil, err
+		}
+	}
+	sess, err := session.NewSession(aws.NewConfig().
+		WithCredentials(creds).
+		WithRegion(route53Region))
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to create AWS session")
+	}
+	return &route53Provider{
+		client:       route53.New(sess),
+		hostedZoneId: config.HostedZoneId,
+	}, nil
+}
+
+func (p *route53Provider) Records(ctx context.Context) ([]Record, error) {
+	var records []Record
+	err := p.client.ListResourceRecordSetsPagesWithContext(ctx, &route53.ListResourceRecordSetsInput{
+		HostedZoneId: aws.String(p.hostedZoneId),
+	}, func(out *route53.ListResourceRecordSetsOutput, lastPage bool) bool {
+		for _, set := range out.ResourceRecordSets {
+			recordType := aws.StringValue(set.Type)
+			// alias records have no values, and are not managed by gloo
+			if set.AliasTarget != nil || (recordType != RecordTypeA && recordType != RecordTypeCNAME && recordType != RecordTypeTXT) {
+				continue
+			}
+			record := Record{
+				// route53 escapes the wildcard character
+				Name: strings.TrimSuffix(strings.Replace(aws.StringValue(set.Name), `\052`, "*", 1), "."),
+				Type: recordType,
+				TTL:  aws.Int64Value(set.TTL),
+			}
+			for _, value := range set.ResourceRecords {
+				target := aws.StringValue(value.Value)
+				if recordType == RecordTypeTXT {
+					target = strings.Trim(target, `"`)
+				}
+				record.Targets = append(record.Targets, target)
+			}
+			records = append(records, record)
+		}
+		return true
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to list the records of hosted zone %v", p.hostedZoneId)
+	}
+	return records, nil
+}
+
+func (p *route53Provider) Apply(ctx context.Context, changes Changes) error {
+	var route53Changes []*route53.Change
+	for _, record := range changes.Delete {
+		route53Changes = append(route53Changes, route53Change(route53.ChangeActionDelete, record))
+	}
+	for _, update := range changes.Update {
+		route53Changes = append(route53Changes, route53Change(route53.ChangeActionUpsert, update.New))
+	}
+	for _, record := range changes.Create {
+		route53Changes = append(route53Changes, route53Change(route53.ChangeActionCreate, record))
+	}
+	_, err := p.client.ChangeResourceRecordSetsWithContext(ctx, &route53.ChangeResourceRecordSetsInput{
+		HostedZoneId: aws.String(p.hostedZoneId),
+		ChangeBatch: &route53.ChangeBatch{
+			Comment: aws.String("published by gloo"),
+			Changes: route53Changes,
+		},
+	})
+	if err != nil {
+		return errors.Wrapf(err, "unable to change the records of hosted zone %v", p.hostedZoneId)
+	}
+	return nil
+}
+
+func route53Change(action string, record Record) *route53.Change {
+	set := &route53.ResourceRecordSet{
+		Name: aws.String(record.Name + "."),
+		Type: aws.String(record.Type),
+		TTL:  aws.Int64(record.TTL),
+	}
+	for _, target := range record.Targets {
+		if record.Type == RecordTypeTXT {
+			target = `"` + target + `"`
+		}
+		set.ResourceRecords = append(set.ResourceRecords, &route53.ResourceRecord{Value: aws.String(target)})
+	}
+	return &route53.Change{
+		Action:            aws.String(action),
+		ResourceRecordSet: set,
+	}
+}
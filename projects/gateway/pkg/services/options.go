@@ -0,0 +1,195 @@
+package services
+
+import (
+	"bytes"
+	"sort"
+	"strings"
+	"text/template"
+
+	v1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	"github.com/solo-io/solo-kit/pkg/errors"
+	kubev1 "k8s.io/api/core/v1"
+)
+
+const (
+	DefaultServiceName = "gateway-proxy"
+
+	// lists the annotations set from the gateways, to remove them from the service once they are removed from the gateways
+	ManagedAnnotationsKey = "gateway.solo.io/managed-annotations"
+)
+
+// ServiceName returns the name of the service configured by the gateway
+func ServiceName(gateway *v1.Gateway) string {
+	if gateway.Service == nil || gateway.Service.Name == "" {
+		return DefaultServiceName
+	}
+	return gateway.Service.Name
+}
+
+// the fields of the gateway available to the annotation templates
+type templateData struct {
+	Name      string
+	Namespace string
+	BindPort  uint32
+	Ssl       bool
+}
+
+// ApplyOptions applies the service options of the gateways to their service.
+// usedNodePorts are the node ports of the other services of the cluster, only required for node port ranges.
+func ApplyOptions(svc *kubev1.Service, gateways []*v1.Gateway, usedNodePorts map[int32]bool) error {
+	annotations := make(map[string]string)
+	var (
+		loadBalancerIP string
+		policy         kubev1.ServiceExternalTrafficPolicyType
+	)
+	for _, gateway := range gateways {
+		opts := gateway.Service
+		if opts == nil {
+			continue
+		}
+		ref := gateway.Metadata.Ref()
+		for key, value := range opts.Annotations {
+			rendered, err := renderAnnotation(gateway, key, value)
+			if err != nil {
+				return err
+			}
+			if existing, ok := annotations[key]; ok && existing != rendered {
+				return errors.Errorf("gateway %v sets annotation %v to %q, conflicting with another gateway of service %v",
+					ref, key, rendered, svc.Name)
+			}
+			annotations[key] = rendered
+		}
+		if opts.LoadBalancerIp != "" {
+			if loadBalancerIP != "" && loadBalancerIP != opts.LoadBalancerIp {
+				return errors.Errorf("gateway %v sets load balancer ip %v, conflicting with another gateway of service %v",
+					ref, opts.LoadBalancerIp, svc.Name)
+			}
+			loadBalancerIP = opts.LoadBalancerIp
+		}
+		if gatewayPolicy := externalTrafficPolicy(opts.ExternalTrafficPolicy); gatewayPolicy != "" {
+			if policy != "" && policy != gatewayPolicy {
+				return errors.Errorf("gateway %v sets external traffic policy %v, conflicting with another gateway of service %v",
+					ref, gatewayPolicy, svc.Name)
+			}
+			policy = gatewayPolicy
+		}
+		if err := applyNodePort(svc, gateway, usedNodePorts); err != nil {
+			return err
+		}
+	}
+
+	applyAnnotations(svc, annotations)
+	if loadBalancerIP != "" {
+		svc.Spec.LoadBalancerIP = loadBalancerIP
+	}
+	if policy != "" {
+		svc.Spec.ExternalTrafficPolicy = policy
+	}
+	return nil
+}
+
+func renderAnnotation(gateway *v1.Gateway, key, value string) (string, error) {
+	tmpl, err := template.New(key).Option("missingkey=error").Parse(value)
+	if err != nil {
+		return "", errors.Wrapf(err, "invalid template for annotation %v of gateway %v", key, gateway.Metadata.Ref())
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, templateData{
+		Name:      gateway.Metadata.Name,
+		Namespace: gateway.Metadata.Namespace,
+		BindPort:  gateway.BindPort,
+		Ssl:       gateway.Ssl,
+	}); err != nil {
+		return "", errors.Wrapf(err, "rendering annotation %v of gateway %v", key, gateway.Metadata.Ref())
+	}
+	return buf.String(), nil
+}
+
+// sets the annotations, and removes the ones previously set from the gateways that are not set anymore
+func applyAnnotations(svc *kubev1.Service, annotations map[string]string) {
+	if svc.Annotations == nil {
+		svc.Annotations = make(map[string]string)
+	}
+	for _, key := range strings.Split(svc.Annotations[ManagedAnnotationsKey], ",") {
+		if _, ok := annotations[key]; !ok {
+			delete(svc.Annotations, key)
+		}
+	}
+	delete(svc.Annotations, ManagedAnnotationsKey)
+	if len(annotations) == 0 {
+		return
+	}
+
+	var keys []string
+	for key, value := range annotations {
+		svc.Annotations[key] = value
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	svc.Annotations[ManagedAnnotationsKey] = strings.Join(keys, ",")
+}
+
+func externalTrafficPolicy(policy v1.ServiceOptions_ExternalTrafficPolicy) kubev1.ServiceExternalTrafficPolicyType {
+	switch policy {
+	case v1.ServiceOptions_Cluster:
+		return kubev1.ServiceExternalTrafficPolicyTypeCluster
+	case v1.ServiceOptions_Local:
+		return kubev1.ServiceExternalTrafficPolicyTypeLocal
+	}
+	return ""
+}
+
+func applyNodePort(svc *kubev1.Service, gateway *v1.Gateway, usedNodePorts map[int32]bool) error {
+	if gateway.Service.NodePortSpecifier == nil {
+		return nil
+	}
+	if svc.Spec.Type != kubev1.ServiceTypeNodePort && svc.Spec.Type != kubev1.ServiceTypeLoadBalancer {
+		return errors.Errorf("gateway %v sets a node port, but service %v is of type %v", gateway.Metadata.Ref(), svc.Name, svc.Spec.Type)
+	}
+	port := gatewayPort(svc, gateway)
+	if port == nil {
+		return errors.Errorf("service %v has no port targeting the bind port %v of gateway %v", svc.Name, gateway.BindPort, gateway.Metadata.Ref())
+	}
+
+	switch specifier := gateway.Service.NodePortSpecifier.(type) {
+	case *v1.ServiceOptions_NodePort:
+		port.NodePort = int32(specifier.NodePort)
+	case *v1.ServiceOptions_NodePortRange_:
+		start, end := int32(specifier.NodePortRange.Start), int32(specifier.NodePortRange.End)
+		if port.NodePort >= start && port.NodePort <= end {
+			return nil
+		}
+		for nodePort := start; nodePort <= end; nodePort++ {
+			if !usedNodePorts[nodePort] && !usedByService(svc, nodePort) {
+				port.NodePort = nodePort
+				return nil
+			}
+		}
+		return errors.Errorf("no free node port in range %d-%d for gateway %v", start, end, gateway.Metadata.Ref())
+	}
+	return nil
+}
+
+// returns the port of the service targeting the bind port of the gateway
+func gatewayPort(svc *kubev1.Service, gateway *v1.Gateway) *kubev1.ServicePort {
+	for i, port := range svc.Spec.Ports {
+		targetPort := port.TargetPort.IntValue()
+		if targetPort == 0 {
+			// the target port defaults to the port
+			targetPort = int(port.Port)
+		}
+		if targetPort == int(gateway.BindPort) {
+			return &svc.Spec.Ports[i]
+		}
+	}
+	return nil
+}
+
+func usedByService(svc *kubev1.Service, nodePort int32) bool {
+	for _, port := range svc.Spec.Ports {
+		if port.NodePort == nodePort {
+			return true
+		}
+	}
+	return false
+}
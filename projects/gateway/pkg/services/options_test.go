@@ -0,0 +1,116 @@
+package services_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	. "github.com/solo-io/gloo/projects/gateway/pkg/services"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	kubev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+var _ = Describe("ApplyOptions", func() {
+	var (
+		svc      *kubev1.Service
+		gateway  *v1.Gateway
+		gateways []*v1.Gateway
+	)
+
+	BeforeEach(func() {
+		svc = &kubev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: "gateway-proxy", Namespace: "gloo-system"},
+			Spec: kubev1.ServiceSpec{
+				Type: kubev1.ServiceTypeLoadBalancer,
+				Ports: []kubev1.ServicePort{
+					{Name: "http", Port: 80, TargetPort: intstr.FromInt(8080), NodePort: 31000},
+					{Name: "https", Port: 443, TargetPort: intstr.FromInt(8443), NodePort: 31001},
+				},
+			},
+		}
+		gateway = &v1.Gateway{
+			Metadata: core.Metadata{Name: "gateway", Namespace: "gloo-system"},
+			BindPort: 8080,
+			Service:  &v1.ServiceOptions{},
+		}
+		gateways = []*v1.Gateway{gateway}
+	})
+
+	It("sets the load balancer ip and the external traffic policy", func() {
+		gateway.Service.LoadBalancerIp = "1.2.3.4"
+		gateway.Service.ExternalTrafficPolicy = v1.ServiceOptions_Local
+		Expect(ApplyOptions(svc, gateways, nil)).NotTo(HaveOccurred())
+		Expect(svc.Spec.LoadBalancerIP).To(Equal("1.2.3.4"))
+		Expect(svc.Spec.ExternalTrafficPolicy).To(Equal(kubev1.ServiceExternalTrafficPolicyTypeLocal))
+	})
+
+	It("renders the annotations and removes the ones not set anymore", func() {
+		gateway.Service.Annotations = map[string]string{
+			"cloud.google.com/load-balancer-type":               "Internal",
+			"service.beta.kubernetes.io/aws-load-balancer-name": "{{ .Namespace }}-{{ .Name }}-{{ .BindPort }}",
+		}
+		Expect(ApplyOptions(svc, gateways, nil)).NotTo(HaveOccurred())
+		Expect(svc.Annotations).To(Equal(map[string]string{
+			"cloud.google.com/load-balancer-type":               "Internal",
+			"service.beta.kubernetes.io/aws-load-balancer-name": "gloo-system-gateway-8080",
+			ManagedAnnotationsKey:                               "cloud.google.com/load-balancer-type,service.beta.kubernetes.io/aws-load-balancer-name",
+		}))
+
+		svc.Annotations["unmanaged"] = "value"
+		delete(gateway.Service.Annotations, "cloud.google.com/load-balancer-type")
+		Expect(ApplyOptions(svc, gateways, nil)).NotTo(HaveOccurred())
+		Expect(svc.Annotations).To(Equal(map[string]string{
+			"service.beta.kubernetes.io/aws-load-balancer-name": "gloo-system-gateway-8080",
+			"unmanaged":           "value",
+			ManagedAnnotationsKey: "service.beta.kubernetes.io/aws-load-balancer-name",
+		}))
+	})
+
+	It("errors on invalid annotation templates", func() {
+		gateway.Service.Annotations = map[string]string{"key": "{{ .Missing }}"}
+		Expect(ApplyOptions(svc, gateways, nil)).To(HaveOccurred())
+	})
+
+	It("errors when gateways of the same service conflict", func() {
+		gateway.Service.LoadBalancerIp = "1.2.3.4"
+		other := &v1.Gateway{
+			Metadata: core.Metadata{Name: "gateway-ssl", Namespace: "gloo-system"},
+			BindPort: 8443,
+			Service:  &v1.ServiceOptions{LoadBalancerIp: "5.6.7.8"},
+		}
+		Expect(ApplyOptions(svc, append(gateways, other), nil)).To(HaveOccurred())
+	})
+
+	It("sets the node port of the port targeting the bind port", func() {
+		gateway.Service.NodePortSpecifier = &v1.ServiceOptions_NodePort{NodePort: 30080}
+		Expect(ApplyOptions(svc, gateways, nil)).NotTo(HaveOccurred())
+		Expect(svc.Spec.Ports[0].NodePort).To(Equal(int32(30080)))
+		Expect(svc.Spec.Ports[1].NodePort).To(Equal(int32(31001)))
+	})
+
+	It("picks a free node port of the range", func() {
+		gateway.Service.NodePortSpecifier = &v1.ServiceOptions_NodePortRange_{
+			NodePortRange: &v1.ServiceOptions_NodePortRange{Start: 30000, End: 30010},
+		}
+		Expect(ApplyOptions(svc, gateways, map[int32]bool{30000: true})).NotTo(HaveOccurred())
+		Expect(svc.Spec.Ports[0].NodePort).To(Equal(int32(30001)))
+
+		// the node port is kept while it is in the range
+		Expect(ApplyOptions(svc, gateways, nil)).NotTo(HaveOccurred())
+		Expect(svc.Spec.Ports[0].NodePort).To(Equal(int32(30001)))
+	})
+
+	It("errors when the range has no free node port", func() {
+		gateway.Service.NodePortSpecifier = &v1.ServiceOptions_NodePortRange_{
+			NodePortRange: &v1.ServiceOptions_NodePortRange{Start: 30000, End: 30000},
+		}
+		Expect(ApplyOptions(svc, gateways, map[int32]bool{30000: true})).To(HaveOccurred())
+	})
+
+	It("errors when setting a node port on a cluster ip service", func() {
+		svc.Spec.Type = kubev1.ServiceTypeClusterIP
+		gateway.Service.NodePortSpecifier = &v1.ServiceOptions_NodePort{NodePort: 30080}
+		Expect(ApplyOptions(svc, gateways, nil)).To(HaveOccurred())
+	})
+})
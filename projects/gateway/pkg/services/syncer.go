@@ -0,0 +1,122 @@
+package services
+
+import (
+	"context"
+	"reflect"
+	"sort"
+
+	"github.com/hashicorp/go-multierror"
+	v1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/solo-io/solo-kit/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// ServiceSyncer applies the service options of the gateways to the kubernetes services exposing the gateway proxies
+type ServiceSyncer struct {
+	kubeClient kubernetes.Interface
+}
+
+func NewServiceSyncer(kubeClient kubernetes.Interface) *ServiceSyncer {
+	return &ServiceSyncer{kubeClient: kubeClient}
+}
+
+func (s *ServiceSyncer) Sync(ctx context.Context, snap *v1.ApiSnapshot) error {
+	ctx = contextutils.WithLogger(ctx, "service_syncer")
+
+	gatewaysByService := make(map[core.ResourceRef][]*v1.Gateway)
+	var serviceRefs []core.ResourceRef
+	needsNodePorts := false
+	for _, gateway := range snap.Gateways {
+		if gateway.Service == nil {
+			continue
+		}
+		ref := core.ResourceRef{Namespace: gateway.Metadata.Namespace, Name: ServiceName(gateway)}
+		if _, ok := gatewaysByService[ref]; !ok {
+			serviceRefs = append(serviceRefs, ref)
+		}
+		gatewaysByService[ref] = append(gatewaysByService[ref], gateway)
+		if _, ok := gateway.Service.NodePortSpecifier.(*v1.ServiceOptions_NodePortRange_); ok {
+			needsNodePorts = true
+		}
+	}
+	if len(serviceRefs) == 0 {
+		return nil
+	}
+	sort.SliceStable(serviceRefs, func(i, j int) bool {
+		return serviceRefs[i].Namespace < serviceRefs[j].Namespace ||
+			(serviceRefs[i].Namespace == serviceRefs[j].Namespace && serviceRefs[i].Name < serviceRefs[j].Name)
+	})
+
+	var nodePorts map[core.ResourceRef][]int32
+	if needsNodePorts {
+		var err error
+		nodePorts, err = s.nodePorts()
+		if err != nil {
+			return err
+		}
+	}
+
+	var errs error
+	for _, ref := range serviceRefs {
+		if err := s.syncService(ctx, ref, gatewaysByService[ref], usedNodePorts(nodePorts, ref)); err != nil {
+			errs = multierror.Append(errs, err)
+		}
+	}
+	return errs
+}
+
+func (s *ServiceSyncer) syncService(ctx context.Context, ref core.ResourceRef, gateways []*v1.Gateway, usedNodePorts map[int32]bool) error {
+	svc, err := s.kubeClient.CoreV1().Services(ref.Namespace).Get(ref.Name, metav1.GetOptions{})
+	if err != nil {
+		return errors.Wrapf(err, "unable to get gateway service %v", ref)
+	}
+	original := svc.DeepCopy()
+	if err := ApplyOptions(svc, gateways, usedNodePorts); err != nil {
+		return errors.Wrapf(err, "unable to configure gateway service %v", ref)
+	}
+	if reflect.DeepEqual(original, svc) {
+		return nil
+	}
+	contextutils.LoggerFrom(ctx).Infof("updating gateway service %v", ref)
+	if _, err := s.kubeClient.CoreV1().Services(ref.Namespace).Update(svc); err != nil {
+		return errors.Wrapf(err, "unable to update gateway service %v", ref)
+	}
+	return nil
+}
+
+// returns the node ports of all the services of the cluster
+func (s *ServiceSyncer) nodePorts() (map[core.ResourceRef][]int32, error) {
+	list, err := s.kubeClient.CoreV1().Services(metav1.NamespaceAll).List(metav1.ListOptions{})
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to list the services of the cluster")
+	}
+	nodePorts := make(map[core.ResourceRef][]int32)
+	for _, svc := range list.Items {
+		ref := core.ResourceRef{Namespace: svc.Namespace, Name: svc.Name}
+		for _, port := range svc.Spec.Ports {
+			if port.NodePort != 0 {
+				nodePorts[ref] = append(nodePorts[ref], port.NodePort)
+			}
+		}
+	}
+	return nodePorts, nil
+}
+
+// returns the node ports used by the services other than the one of the ref
+func usedNodePorts(nodePorts map[core.ResourceRef][]int32, ref core.ResourceRef) map[int32]bool {
+	used := make(map[int32]bool)
+	for svcRef, ports := range nodePorts {
+		if svcRef == ref {
+			continue
+		}
+		for _, port := range ports {
+			used[port] = true
+		}
+	}
+	return used
+}
+
+var _ v1.ApiSyncer = &ServiceSyncer{}
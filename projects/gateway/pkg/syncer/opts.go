@@ -1,8 +1,10 @@
 package syncer
 
 import (
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients/factory"
+	"k8s.io/client-go/kubernetes"
 )
 
 type Opts struct {
@@ -13,4 +15,8 @@ type Opts struct {
 	Proxies         factory.ResourceClientFactory
 	WatchOpts       clients.WatchOpts
 	DevMode         bool
+	// publishes the domains of the virtual services if set
+	DnsPublishing *gloov1.DnsPublishing
+	// configures the services and deployments of the gateways if set
+	KubeClient kubernetes.Interface
 }
@@ -7,17 +7,22 @@ import (
 	"github.com/solo-io/gloo/pkg/utils"
 	v1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/gateway/pkg/defaults"
+	"github.com/solo-io/gloo/projects/gateway/pkg/deployments"
+	"github.com/solo-io/gloo/projects/gateway/pkg/dns"
 	"github.com/solo-io/gloo/projects/gateway/pkg/propagator"
+	"github.com/solo-io/gloo/projects/gateway/pkg/services"
 	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/gloo/pkg/bootstrap"
 	gloodefaults "github.com/solo-io/gloo/projects/gloo/pkg/defaults"
 	"github.com/solo-io/go-utils/contextutils"
 	"github.com/solo-io/go-utils/errutils"
+	"github.com/solo-io/go-utils/kubeutils"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients/kube"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients/memory"
 	"github.com/solo-io/solo-kit/pkg/api/v1/reporter"
 	"github.com/solo-io/solo-kit/pkg/errors"
+	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 )
 
@@ -79,7 +84,22 @@ func Setup(ctx context.Context, kubeCache kube.SharedCache, inMemoryCache memory
 			Ctx:         ctx,
 			RefreshRate: refreshRate,
 		},
-		DevMode: true,
+		DevMode:       true,
+		DnsPublishing: settings.DnsPublishing,
+	}
+
+	if settings.DnsPublishing != nil && cfg == nil {
+		cfg, err = kubeutils.GetConfig("", "")
+		if err != nil {
+			return err
+		}
+	}
+	// the services and deployments of the gateways can only be configured when running in kubernetes
+	if cfg != nil {
+		opts.KubeClient, err = kubernetes.NewForConfig(cfg)
+		if err != nil {
+			return err
+		}
 	}
 
 	return RunGateway(opts)
@@ -130,7 +150,20 @@ func RunGateway(opts Opts) error {
 
 	sync := NewTranslatorSyncer(opts.WriteNamespace, proxyClient, gatewayClient, virtualServiceClient, rpt, prop)
 
-	eventLoop := v1.NewApiEventLoop(emitter, sync)
+	syncers := v1.ApiSyncers{sync}
+	if opts.KubeClient != nil {
+		syncers = append(syncers, services.NewServiceSyncer(opts.KubeClient), deployments.NewDeploymentSyncer(opts.KubeClient))
+	}
+	if opts.DnsPublishing != nil {
+		publisher, err := newDnsPublisher(opts)
+		if err != nil {
+			return err
+		}
+		go publisher.Run(opts.WatchOpts.Ctx)
+		syncers = append(syncers, publisher)
+	}
+
+	eventLoop := v1.NewApiEventLoop(emitter, syncers)
 	eventLoopErrs, err := eventLoop.Run(opts.WatchNamespaces, opts.WatchOpts)
 	if err != nil {
 		return err
@@ -152,3 +185,17 @@ func RunGateway(opts Opts) error {
 	}()
 	return nil
 }
+
+func newDnsPublisher(opts Opts) (*dns.Publisher, error) {
+	if opts.DnsPublishing.OwnerId == "" {
+		return nil, errors.Errorf("an owner id is required to publish dns records")
+	}
+	if opts.KubeClient == nil {
+		return nil, errors.Errorf("a kubernetes client is required to publish dns records")
+	}
+	provider, err := dns.NewProvider(opts.WatchOpts.Ctx, opts.DnsPublishing)
+	if err != nil {
+		return nil, err
+	}
+	return dns.NewPublisher(opts.WriteNamespace, opts.DnsPublishing, provider, opts.KubeClient), nil
+}
@@ -147,7 +147,7 @@ func validateAndMergeVirtualServices(ns string, gateway *v1.Gateway, virtualServ
 		var sslConfig *gloov1.SslConfig
 		var vhostPlugins *gloov1.VirtualHostPlugins
 		for _, vs := range vslist {
-			routes = append(routes, vs.VirtualHost.Routes...)
+			routes = append(routes, routesWithAnnotations(vs)...)
 			if sslConfig == nil {
 				sslConfig = vs.SslConfig
 			} else if !vs.SslConfig.Equal(sslConfig) {
@@ -247,7 +247,9 @@ func desiredListener(gateway *v1.Gateway, virtualServicesForGateway v1.VirtualSe
 			virtualService.VirtualHost = &gloov1.VirtualHost{}
 		}
 		virtualService.VirtualHost.Name = fmt.Sprintf("%v.%v", ref.Namespace, ref.Name)
-		virtualHosts = append(virtualHosts, virtualService.VirtualHost)
+		virtualHost := *virtualService.VirtualHost
+		virtualHost.Routes = routesWithAnnotations(virtualService)
+		virtualHosts = append(virtualHosts, &virtualHost)
 		if virtualService.SslConfig != nil {
 			sslConfigs = append(sslConfigs, virtualService.SslConfig)
 		}
@@ -266,3 +268,24 @@ func desiredListener(gateway *v1.Gateway, virtualServicesForGateway v1.VirtualSe
 		UseProxyProto:    gateway.UseProxyProto,
 	}
 }
+
+// returns the routes of the virtual service with its annotations added, without overriding the annotations
+// of the routes. The routes of the virtual service are copied rather than modified.
+func routesWithAnnotations(vs *v1.VirtualService) []*gloov1.Route {
+	if len(vs.Metadata.Annotations) == 0 {
+		return vs.VirtualHost.Routes
+	}
+	var routes []*gloov1.Route
+	for _, route := range vs.VirtualHost.Routes {
+		annotated := *route
+		annotated.Annotations = make(map[string]string, len(vs.Metadata.Annotations)+len(route.Annotations))
+		for k, v := range vs.Metadata.Annotations {
+			annotated.Annotations[k] = v
+		}
+		for k, v := range route.Annotations {
+			annotated.Annotations[k] = v
+		}
+		routes = append(routes, &annotated)
+	}
+	return routes
+}
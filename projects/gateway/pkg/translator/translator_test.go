@@ -158,6 +158,48 @@ var _ = Describe("Translator", func() {
 		Expect(err.Error()).To(ContainSubstring("bind-address :2 is not unique in a proxy. gateways: gloo-system.name,gloo-system.name2"))
 	})
 
+	Context("annotations", func() {
+		BeforeEach(func() {
+			snap.VirtualServices[0].Metadata.Annotations = map[string]string{"team": "payments", "tier": "gold"}
+			snap.VirtualServices[0].VirtualHost.Routes[0].Annotations = map[string]string{"tier": "silver"}
+		})
+
+		It("should add the annotations of the virtual service to its routes", func() {
+			proxy, errs := Translate(context.Background(), ns, snap)
+
+			Expect(errs.Validate()).NotTo(HaveOccurred())
+			listener := proxy.Listeners[0].ListenerType.(*gloov1.Listener_HttpListener).HttpListener
+			Expect(listener.VirtualHosts).To(HaveLen(2))
+			for _, vh := range listener.VirtualHosts {
+				if vh.Name == ns+".name1" {
+					Expect(vh.Routes[0].Annotations).To(Equal(map[string]string{"team": "payments", "tier": "silver"}))
+				} else {
+					Expect(vh.Routes[0].Annotations).To(BeEmpty())
+				}
+			}
+			// the virtual service is not modified
+			Expect(snap.VirtualServices[0].VirtualHost.Routes[0].Annotations).To(Equal(map[string]string{"tier": "silver"}))
+		})
+
+		It("should add the annotations of merged virtual services to their routes", func() {
+			snap.VirtualServices[1].VirtualHost.Domains = snap.VirtualServices[0].VirtualHost.Domains
+
+			proxy, errs := Translate(context.Background(), ns, snap)
+
+			Expect(errs.Validate()).NotTo(HaveOccurred())
+			listener := proxy.Listeners[0].ListenerType.(*gloov1.Listener_HttpListener).HttpListener
+			Expect(listener.VirtualHosts).To(HaveLen(1))
+			Expect(listener.VirtualHosts[0].Routes).To(HaveLen(2))
+			for _, route := range listener.VirtualHosts[0].Routes {
+				if route.Matcher.GetPrefix() == "/1" {
+					Expect(route.Annotations).To(Equal(map[string]string{"team": "payments", "tier": "silver"}))
+				} else {
+					Expect(route.Annotations).To(BeEmpty())
+				}
+			}
+		})
+	})
+
 	Context("merge", func() {
 		BeforeEach(func() {
 			snap.VirtualServices[1].VirtualHost.Domains = snap.VirtualServices[0].VirtualHost.Domains
@@ -238,10 +238,15 @@ func pluginsFromInput(input options.RoutePlugins) (*v1.RoutePlugins, error) {
 func destSpecFromInput(input options.DestinationSpec) (*v1.DestinationSpec, error) {
 	switch {
 	case input.Aws.LogicalName != "":
+		invocationStyle := aws.DestinationSpec_SYNC
+		if input.Aws.Async {
+			invocationStyle = aws.DestinationSpec_ASYNC
+		}
 		return &v1.DestinationSpec{
 			DestinationType: &v1.DestinationSpec_Aws{
 				Aws: &aws.DestinationSpec{
 					LogicalName:            input.Aws.LogicalName,
+					InvocationStyle:        invocationStyle,
 					ResponseTransformation: input.Aws.ResponseTransformation,
 				},
 			},
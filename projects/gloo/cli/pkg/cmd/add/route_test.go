@@ -6,6 +6,7 @@ import (
 
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/helpers"
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/testutils"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/aws"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
 )
 
@@ -38,4 +39,14 @@ var _ = Describe("Routes", func() {
 		Expect(ug.GetName()).To(Equal("petstore"))
 		Expect(ug.GetNamespace()).To(Equal("default"))
 	})
+	It("should create a route invoking a lambda asynchronously", func() {
+		err := testutils.Glooctl("add route --path-exact /webhook --dest-name default-petstore-8080 --aws-function-name hook --aws-async")
+		Expect(err).NotTo(HaveOccurred())
+
+		vs, err := helpers.MustVirtualServiceClient().Read("gloo-system", "default", clients.ReadOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		awsSpec := vs.VirtualHost.Routes[0].GetRouteAction().GetSingle().GetDestinationSpec().GetAws()
+		Expect(awsSpec.LogicalName).To(Equal("hook"))
+		Expect(awsSpec.InvocationStyle).To(Equal(aws.DestinationSpec_ASYNC))
+	})
 })
@@ -0,0 +1,97 @@
+package contexts
+
+import (
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/options"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/constants"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/glooctlrc"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/helpers"
+	"github.com/spf13/cobra"
+)
+
+// the verbs of the top level commands acting on resources
+var verbs = map[string]string{
+	constants.GET_COMMAND.Use:    glooctlrc.VerbGet,
+	constants.CREATE_COMMAND.Use: glooctlrc.VerbCreate,
+	constants.DELETE_COMMAND.Use: glooctlrc.VerbDelete,
+	constants.EDIT_COMMAND.Use:   glooctlrc.VerbUpdate,
+	constants.ADD_COMMAND.Use:    glooctlrc.VerbUpdate,
+	"remove":                     glooctlrc.VerbUpdate,
+	constants.ROUTE_COMMAND.Use:  glooctlrc.VerbUpdate,
+}
+
+// the top level commands that act neither on resources nor on the cluster. The other commands not acting on
+// resources, e.g. install, are writes.
+var localCommands = map[string]bool{
+	constants.CONTEXT_COMMAND.Use: true,
+	"completion":                  true,
+	"help":                        true,
+}
+
+// the top level commands changing the routes of virtual services
+var routeCommands = map[string]bool{
+	constants.ADD_COMMAND.Use:   true,
+	"remove":                    true,
+	constants.ROUTE_COMMAND.Use: true,
+}
+
+// Apply applies the glooctl context to the command about to run: the command acts in the cluster and the default
+// namespace of the context, and fails if it is out of the scope of the context, or if the cluster doesn't allow it
+// when the context asks for server side checks. The commands acting neither on resources nor on the cluster are left
+// as is.
+func Apply(cmd *cobra.Command, opts *options.Options) error {
+	op, ok := operationOf(cmd)
+	if !ok {
+		return nil
+	}
+	config, err := glooctlrc.Load(glooctlrc.Path())
+	if err != nil {
+		return err
+	}
+	context, err := config.Get(opts.Top.ContextName)
+	if err != nil || context == nil {
+		return err
+	}
+
+	if context.Cluster != "" {
+		helpers.UseKubeContext(context.Cluster)
+	}
+	if flag := cmd.Flags().Lookup("namespace"); flag != nil && !flag.Changed && context.Namespace != "" {
+		if err := flag.Value.Set(context.Namespace); err != nil {
+			return err
+		}
+	}
+	op.Namespace = opts.Metadata.Namespace
+
+	if err := context.Authorize(op); err != nil {
+		return err
+	}
+	if context.ServerSideChecks && op.Resource != "" {
+		// the commands that don't act on resources are checked by the cluster as they run
+		return helpers.KubeAccessReviewer{}.Review(op)
+	}
+	return nil
+}
+
+// the operation of the command, not ok if the command acts neither on resources nor on the cluster. The commands not
+// acting on resources are writes without resource.
+func operationOf(cmd *cobra.Command) (glooctlrc.Operation, bool) {
+	// the commands from the top level command down to the command
+	var path []*cobra.Command
+	for c := cmd; c.HasParent(); c = c.Parent() {
+		path = append([]*cobra.Command{c}, path...)
+	}
+	if len(path) == 0 || localCommands[path[0].Name()] {
+		return glooctlrc.Operation{}, false
+	}
+	verb, ok := verbs[path[0].Name()]
+	if !ok {
+		return glooctlrc.Operation{Verb: path[0].Name()}, true
+	}
+	if routeCommands[path[0].Name()] {
+		return glooctlrc.Operation{Verb: verb, Resource: constants.VIRTUAL_SERVICE_COMMAND.Use}, true
+	}
+	if len(path) < 2 {
+		return glooctlrc.Operation{}, false
+	}
+	return glooctlrc.Operation{Verb: verb, Resource: path[1].Name()}, true
+}
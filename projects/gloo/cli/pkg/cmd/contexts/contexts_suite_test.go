@@ -0,0 +1,13 @@
+package contexts_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestContexts(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Contexts Suite")
+}
@@ -0,0 +1,168 @@
+package contexts
+
+import (
+	"fmt"
+
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/options"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/constants"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/glooctlrc"
+	"github.com/solo-io/go-utils/cliutils"
+	"github.com/solo-io/solo-kit/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+const EmptyContextError = "please provide a subcommand"
+
+func RootCmd(opts *options.Options, optionsFunc ...cliutils.OptionsFunc) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     constants.CONTEXT_COMMAND.Use,
+		Aliases: constants.CONTEXT_COMMAND.Aliases,
+		Short:   constants.CONTEXT_COMMAND.Short,
+		Long:    constants.CONTEXT_COMMAND.Long,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return errors.Errorf(EmptyContextError)
+		},
+	}
+	cmd.AddCommand(listCmd(), currentCmd(), useCmd(), setCmd(opts), deleteCmd())
+	cliutils.ApplyOptions(cmd, optionsFunc)
+	return cmd
+}
+
+func listCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "list the contexts, the current one marked with *",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			config, err := glooctlrc.Load(glooctlrc.Path())
+			if err != nil {
+				return err
+			}
+			for _, name := range config.Names() {
+				marker := " "
+				if name == config.CurrentContext {
+					marker = "*"
+				}
+				fmt.Printf("%v %v\n", marker, name)
+			}
+			return nil
+		},
+	}
+}
+
+func currentCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "current",
+		Short: "print the current context",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			config, err := glooctlrc.Load(glooctlrc.Path())
+			if err != nil {
+				return err
+			}
+			if config.CurrentContext == "" {
+				return errors.Errorf("no current context")
+			}
+			fmt.Println(config.CurrentContext)
+			return nil
+		},
+	}
+}
+
+func useCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "use NAME",
+		Short: "switch to the context",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return update(func(config *glooctlrc.Config) error {
+				if _, ok := config.Contexts[args[0]]; !ok {
+					return errors.Errorf("context %v not found", args[0])
+				}
+				config.CurrentContext = args[0]
+				return nil
+			})
+		},
+	}
+}
+
+func setCmd(opts *options.Options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set NAME",
+		Short: "create a context, or update the given fields of a context",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return update(func(config *glooctlrc.Config) error {
+				if config.Contexts == nil {
+					config.Contexts = make(map[string]*glooctlrc.Context)
+				}
+				context, ok := config.Contexts[args[0]]
+				if !ok {
+					context = &glooctlrc.Context{}
+					config.Contexts[args[0]] = context
+				}
+				flags := cmd.Flags()
+				if flags.Changed("cluster") {
+					context.Cluster = opts.Context.Cluster
+				}
+				if flags.Changed("namespace") {
+					context.Namespace = opts.Context.Namespace
+				}
+				if flags.Changed("allowed-namespaces") {
+					context.AllowedNamespaces = opts.Context.AllowedNamespaces
+				}
+				if flags.Changed("allowed-resources") {
+					context.AllowedResources = opts.Context.AllowedResources
+				}
+				if flags.Changed("read-only") {
+					context.ReadOnly = opts.Context.ReadOnly
+				}
+				if flags.Changed("server-side-checks") {
+					context.ServerSideChecks = opts.Context.ServerSideChecks
+				}
+				if config.CurrentContext == "" {
+					config.CurrentContext = args[0]
+				}
+				return nil
+			})
+		},
+	}
+	flags := cmd.Flags()
+	flags.StringVar(&opts.Context.Cluster, "cluster", "", "the kube context of the cluster, the current kube context if empty")
+	flags.StringVarP(&opts.Context.Namespace, "namespace", "n", "", "the namespace of the commands not given one")
+	flags.StringSliceVar(&opts.Context.AllowedNamespaces, "allowed-namespaces", nil, "the namespaces the commands may act in, any if empty")
+	flags.StringSliceVar(&opts.Context.AllowedResources, "allowed-resources", nil, "the kinds of resources the commands may act on, e.g. upstream or virtualservice, any if empty")
+	flags.BoolVar(&opts.Context.ReadOnly, "read-only", false, "the commands may only read resources, the commands acting on the cluster such as install are not allowed")
+	flags.BoolVar(&opts.Context.ServerSideChecks, "server-side-checks", false, "ask the cluster whether the user may perform each operation before performing it")
+	return cmd
+}
+
+func deleteCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "delete NAME",
+		Short: "delete the context",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return update(func(config *glooctlrc.Config) error {
+				if _, ok := config.Contexts[args[0]]; !ok {
+					return errors.Errorf("context %v not found", args[0])
+				}
+				delete(config.Contexts, args[0])
+				if config.CurrentContext == args[0] {
+					config.CurrentContext = ""
+				}
+				return nil
+			})
+		},
+	}
+}
+
+func update(mutate func(config *glooctlrc.Config) error) error {
+	path := glooctlrc.Path()
+	config, err := glooctlrc.Load(path)
+	if err != nil {
+		return err
+	}
+	if err := mutate(config); err != nil {
+		return err
+	}
+	return config.Save(path)
+}
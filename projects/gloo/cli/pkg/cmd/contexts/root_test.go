@@ -0,0 +1,80 @@
+package contexts_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/glooctlrc"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/helpers"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/testutils"
+)
+
+var _ = Describe("Context", func() {
+	var dir string
+
+	BeforeEach(func() {
+		helpers.UseMemoryClients()
+		var err error
+		dir, err = ioutil.TempDir("", "glooctlrc")
+		Expect(err).NotTo(HaveOccurred())
+		os.Setenv(glooctlrc.EnvConfigPath, filepath.Join(dir, "config.yaml"))
+	})
+
+	AfterEach(func() {
+		os.Unsetenv(glooctlrc.EnvConfigPath)
+		os.RemoveAll(dir)
+	})
+
+	It("creates, lists and switches contexts", func() {
+		err := testutils.Glooctl("context set team-a --namespace team-a --allowed-namespaces team-a")
+		Expect(err).NotTo(HaveOccurred())
+		err = testutils.Glooctl("context set team-b --read-only")
+		Expect(err).NotTo(HaveOccurred())
+
+		out, err := testutils.GlooctlOut("context list")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out).To(Equal("* team-a\n  team-b"))
+
+		err = testutils.Glooctl("context use team-b")
+		Expect(err).NotTo(HaveOccurred())
+		out, err = testutils.GlooctlOut("context current")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out).To(Equal("team-b"))
+
+		err = testutils.Glooctl("context use team-c")
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("keeps the commands in the scope of the context", func() {
+		err := testutils.Glooctl("context set team-a --namespace team-a --allowed-namespaces team-a")
+		Expect(err).NotTo(HaveOccurred())
+
+		err = testutils.Glooctl("get upstream")
+		Expect(err).NotTo(HaveOccurred())
+		err = testutils.Glooctl("get upstream -n team-b")
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("the context only allows the namespaces"))
+	})
+
+	It("rejects the changes in read-only contexts", func() {
+		err := testutils.Glooctl("context set viewer --read-only")
+		Expect(err).NotTo(HaveOccurred())
+
+		err = testutils.Glooctl("delete upstream test")
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("the context is read-only"))
+
+		err = testutils.Glooctl("uninstall")
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("the context is read-only, uninstall is not allowed"))
+
+		_, err = testutils.GlooctlOut("context current")
+		Expect(err).NotTo(HaveOccurred())
+
+		err = testutils.Glooctl("--context missing get upstream")
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -44,6 +44,9 @@ func azureCmd(opts *options.Options) *cobra.Command {
 
 	flags := cmd.Flags()
 	flags.StringSliceVar(&input.ApiKeys.Entries, "api-keys", []string{}, "comma-separated list of azure api key=value entries")
+	flags.StringVar(&input.TenantId, "tenant-id", "", "tenant id of the service principal used by function discovery")
+	flags.StringVar(&input.ClientId, "client-id", "", "client id of the service principal used by function discovery")
+	flags.StringVar(&input.ClientSecret, "client-secret", "", "client secret of the service principal used by function discovery")
 
 	return cmd
 }
@@ -60,14 +63,21 @@ func AzureSecretArgsInteractive(meta *core.Metadata, input *options.AzureSecret)
 }
 
 func createAzureSecret(ctx context.Context, meta core.Metadata, input options.AzureSecret, dryRun bool) error {
-	if input.ApiKeys.Entries == nil {
+	servicePrincipal := input.TenantId != "" || input.ClientId != "" || input.ClientSecret != ""
+	if input.ApiKeys.Entries == nil && !servicePrincipal {
 		return errors.Errorf("must provide azure api keys")
 	}
+	if servicePrincipal && (input.TenantId == "" || input.ClientId == "" || input.ClientSecret == "") {
+		return errors.Errorf("must provide tenant id, client id and client secret of the service principal")
+	}
 	secret := &gloov1.Secret{
 		Metadata: meta,
 		Kind: &gloov1.Secret_Azure{
 			Azure: &gloov1.AzureSecret{
-				ApiKeys: input.ApiKeys.MustMap(),
+				ApiKeys:      input.ApiKeys.MustMap(),
+				TenantId:     input.TenantId,
+				ClientId:     input.ClientId,
+				ClientSecret: input.ClientSecret,
 			},
 		},
 	}
@@ -0,0 +1,172 @@
+package secret
+
+import (
+	"crypto/tls"
+	"encoding/json"
+	"encoding/pem"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/gogo/protobuf/types"
+	"github.com/pkg/errors"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/options"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/client-go/util/homedir"
+)
+
+func convertAwsCredentials(input options.ImportSecret) (*gloov1.Secret, error) {
+	// empty filename and profile fall back to the aws defaults
+	creds, err := credentials.NewSharedCredentials(input.Filename, input.Profile).Get()
+	if err != nil {
+		return nil, err
+	}
+	if creds.SessionToken != "" {
+		return nil, errors.Errorf("temporary credentials with a session token are not supported by AWS secrets")
+	}
+	return &gloov1.Secret{
+		Kind: &gloov1.Secret_Aws{
+			Aws: &gloov1.AwsSecret{
+				AccessKey: creds.AccessKeyID,
+				SecretKey: creds.SecretAccessKey,
+			},
+		},
+	}, nil
+}
+
+const (
+	gcloudCredentialsEnv = "GOOGLE_APPLICATION_CREDENTIALS"
+	gcloudServiceAccount = "service_account"
+)
+
+// fields required by each type of application default credentials
+var gcloudRequiredFields = map[string][]string{
+	"authorized_user":    {"client_id", "client_secret", "refresh_token"},
+	gcloudServiceAccount: {"project_id", "private_key_id", "private_key", "client_email", "client_id"},
+}
+
+func gcloudCredentialsFile() string {
+	if filename := os.Getenv(gcloudCredentialsEnv); filename != "" {
+		return filename
+	}
+	return filepath.Join(homedir.HomeDir(), ".config", "gcloud", "application_default_credentials.json")
+}
+
+func convertGcloudCredentials(input options.ImportSecret) (*gloov1.Secret, error) {
+	filename := input.Filename
+	if filename == "" {
+		filename = gcloudCredentialsFile()
+	}
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, errors.Wrapf(err, "reading application default credentials file: %v", filename)
+	}
+	var fields map[string]string
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, errors.Wrapf(err, "parsing application default credentials file: %v", filename)
+	}
+
+	credentialsType := fields["type"]
+	requiredFields, ok := gcloudRequiredFields[credentialsType]
+	if !ok {
+		return nil, errors.Errorf("unsupported application default credentials type %q", credentialsType)
+	}
+	for _, field := range requiredFields {
+		if fields[field] == "" {
+			return nil, errors.Errorf("%v credentials are missing %v", credentialsType, field)
+		}
+	}
+	if credentialsType == gcloudServiceAccount {
+		if block, _ := pem.Decode([]byte(fields["private_key"])); block == nil {
+			return nil, errors.Errorf("private_key of service account %v is not PEM encoded", fields["client_email"])
+		}
+	}
+
+	config := &types.Struct{Fields: make(map[string]*types.Value)}
+	for key, value := range fields {
+		config.Fields[key] = &types.Value{Kind: &types.Value_StringValue{StringValue: value}}
+	}
+	return &gloov1.Secret{
+		Kind: &gloov1.Secret_Extension{
+			Extension: &gloov1.Extension{
+				Config: config,
+			},
+		},
+	}, nil
+}
+
+func convertKubeconfig(input options.ImportSecret) (*gloov1.Secret, error) {
+	filename := input.Filename
+	if filename == "" {
+		filename = clientcmd.RecommendedHomeFile
+	}
+	config, err := clientcmd.LoadFromFile(filename)
+	if err != nil {
+		return nil, errors.Wrapf(err, "reading kubeconfig file: %v", filename)
+	}
+
+	contextName := input.Context
+	if contextName == "" {
+		contextName = config.CurrentContext
+	}
+	kubeContext, ok := config.Contexts[contextName]
+	if !ok {
+		return nil, errors.Errorf("context %q not found in kubeconfig file: %v", contextName, filename)
+	}
+	authInfo, ok := config.AuthInfos[kubeContext.AuthInfo]
+	if !ok {
+		return nil, errors.Errorf("user %q of context %q not found in kubeconfig file: %v", kubeContext.AuthInfo, contextName, filename)
+	}
+
+	// paths in a kubeconfig file are relative to the file
+	baseDir := filepath.Dir(filename)
+	certChain, err := kubeconfigData(baseDir, authInfo.ClientCertificateData, authInfo.ClientCertificate)
+	if err != nil {
+		return nil, err
+	}
+	privateKey, err := kubeconfigData(baseDir, authInfo.ClientKeyData, authInfo.ClientKey)
+	if err != nil {
+		return nil, err
+	}
+	if len(certChain) == 0 || len(privateKey) == 0 {
+		return nil, errors.Errorf("user %q of context %q does not authenticate with a client certificate", kubeContext.AuthInfo, contextName)
+	}
+	if _, err := tls.X509KeyPair(certChain, privateKey); err != nil {
+		return nil, errors.Wrapf(err, "invalid client certificate for user %q", kubeContext.AuthInfo)
+	}
+
+	var rootCa []byte
+	if cluster, ok := config.Clusters[kubeContext.Cluster]; ok {
+		rootCa, err = kubeconfigData(baseDir, cluster.CertificateAuthorityData, cluster.CertificateAuthority)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &gloov1.Secret{
+		Kind: &gloov1.Secret_Tls{
+			Tls: &gloov1.TlsSecret{
+				CertChain:  string(certChain),
+				PrivateKey: string(privateKey),
+				RootCa:     string(rootCa),
+			},
+		},
+	}, nil
+}
+
+// kubeconfig files either embed data or reference a file containing it
+func kubeconfigData(baseDir string, data []byte, filename string) ([]byte, error) {
+	if len(data) > 0 || filename == "" {
+		return data, nil
+	}
+	if !filepath.IsAbs(filename) {
+		filename = filepath.Join(baseDir, filename)
+	}
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, errors.Wrapf(err, "reading file referenced by kubeconfig: %v", filename)
+	}
+	return data, nil
+}
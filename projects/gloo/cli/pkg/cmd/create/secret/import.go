@@ -0,0 +1,120 @@
+package secret
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/solo-io/gloo/pkg/cliutil"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/argsutils"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/options"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/common"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/helpers"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/spf13/cobra"
+)
+
+// SecretConverter converts an existing credentials file into a Gloo secret.
+// Converters only need to set the kind of the secret, its metadata is provided by the user.
+type SecretConverter func(input options.ImportSecret) (*gloov1.Secret, error)
+
+var secretConverters = map[string]SecretConverter{
+	"aws":        convertAwsCredentials,
+	"gcloud":     convertGcloudCredentials,
+	"kubeconfig": convertKubeconfig,
+}
+
+// RegisterSecretConverter makes a credentials format available to `glooctl create secret import`
+func RegisterSecretConverter(format string, converter SecretConverter) {
+	secretConverters[format] = converter
+}
+
+func secretConverterFormats() []string {
+	var formats []string
+	for format := range secretConverters {
+		formats = append(formats, format)
+	}
+	sort.Strings(formats)
+	return formats
+}
+
+func importCmd(opts *options.Options) *cobra.Command {
+	input := &opts.Create.InputSecret.ImportSecret
+	cmd := &cobra.Command{
+		Use:   "import",
+		Short: `Create a secret with the given name from existing credentials`,
+		Long: "Create a secret with the given name by converting an existing credentials file into the corresponding kind of Gloo secret:\n" +
+			"  aws: a profile of an AWS shared credentials file (default ~/.aws/credentials), imported as an AWS secret\n" +
+			"  gcloud: a gcloud application default credentials file (default $GOOGLE_APPLICATION_CREDENTIALS or ~/.config/gcloud/application_default_credentials.json), imported as an extension secret\n" +
+			"  kubeconfig: the client certificate of a kubeconfig context (default ~/.kube/config), imported as a TLS secret",
+		RunE: func(c *cobra.Command, args []string) error {
+			if err := argsutils.MetadataArgsParse(opts, args); err != nil {
+				return err
+			}
+			if opts.Top.Interactive {
+				// and gather any missing args that are available through interactive mode
+				if err := ImportSecretArgsInteractive(&opts.Metadata, input); err != nil {
+					return err
+				}
+			}
+			// create the secret
+			if err := createImportedSecret(opts.Top.Ctx, opts.Metadata, *input, opts.Create.DryRun); err != nil {
+				return err
+			}
+			return nil
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&input.Format, "format", "", "format of the imported credentials. Available: "+strings.Join(secretConverterFormats(), " | "))
+	flags.StringVar(&input.Filename, "file", "", "filename of the imported credentials (defaults to the standard location for the format)")
+	flags.StringVar(&input.Profile, "profile", "", "profile to import from the aws credentials file (defaults to $AWS_PROFILE or default)")
+	flags.StringVar(&input.Context, "context", "", "context to import from the kubeconfig file (defaults to the current context)")
+
+	return cmd
+}
+
+const (
+	importPromptFormat   = "format of the imported credentials"
+	importPromptFilename = "filename of the imported credentials (leave empty for the default location)"
+)
+
+func ImportSecretArgsInteractive(meta *core.Metadata, input *options.ImportSecret) error {
+	if err := cliutil.ChooseFromList(importPromptFormat, &input.Format, secretConverterFormats()); err != nil {
+		return err
+	}
+	if err := cliutil.GetStringInput(importPromptFilename, &input.Filename); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func createImportedSecret(ctx context.Context, meta core.Metadata, input options.ImportSecret, dryRun bool) error {
+	converter, ok := secretConverters[input.Format]
+	if !ok {
+		return errors.Errorf("unknown credentials format %q, available formats: %v", input.Format, strings.Join(secretConverterFormats(), ", "))
+	}
+	secret, err := converter(input)
+	if err != nil {
+		return errors.Wrapf(err, "importing %v credentials", input.Format)
+	}
+	secret.Metadata = meta
+
+	if dryRun {
+		return common.PrintKubeSecret(ctx, secret)
+	}
+
+	secretClient := helpers.MustSecretClient()
+	if _, err := secretClient.Write(secret, clients.WriteOpts{Ctx: ctx}); err != nil {
+		return err
+	}
+
+	fmt.Printf("Imported %v credentials into secret [%v] in namespace [%v]\n", input.Format, meta.Name, meta.Namespace)
+
+	return nil
+}
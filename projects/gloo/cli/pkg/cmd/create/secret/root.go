@@ -23,6 +23,7 @@ func CreateCmd(opts *options.Options, optionsFunc ...cliutils.OptionsFunc) *cobr
 	cmd.AddCommand(awsCmd(opts))
 	cmd.AddCommand(azureCmd(opts))
 	cmd.AddCommand(tlsCmd(opts))
+	cmd.AddCommand(importCmd(opts))
 	cliutils.ApplyOptions(cmd, optionsFunc)
 	return cmd
 }
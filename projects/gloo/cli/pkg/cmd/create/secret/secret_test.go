@@ -129,6 +129,25 @@ metadata:
 		It("should work with custom namespace", func() {
 			shouldWork("create secret azure test --namespace custom --api-keys foo=bar,gloo=baz", "custom")
 		})
+
+		It("should store service principal credentials", func() {
+			err := testutils.Glooctl("create secret azure test --api-keys foo=bar --tenant-id tenant --client-id client --client-secret secret")
+			Expect(err).NotTo(HaveOccurred())
+
+			secret, err := helpers.MustSecretClient().Read("gloo-system", "test", clients.ReadOpts{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(*secret.GetAzure()).To(Equal(v1.AzureSecret{
+				ApiKeys:      map[string]string{"foo": "bar"},
+				TenantId:     "tenant",
+				ClientId:     "client",
+				ClientSecret: "secret",
+			}))
+		})
+
+		It("should error with incomplete service principal credentials", func() {
+			err := testutils.Glooctl("create secret azure test --api-keys foo=bar --tenant-id tenant")
+			Expect(err).To(HaveOccurred())
+		})
 	})
 
 	Context("TLS", func() {
@@ -218,6 +237,138 @@ metadata:
 `))
 		})
 	})
+
+	Context("Import", func() {
+		It("should error if no name provided", func() {
+			err := testutils.Glooctl("create secret import --format aws")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(Equal(argsutils.NameError))
+		})
+
+		It("should error with an unknown format", func() {
+			err := testutils.Glooctl("create secret import test --format foo")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(`unknown credentials format "foo"`))
+		})
+
+		It("should import an aws credentials profile", func() {
+			credentials := mustWriteTestFile(`
+[default]
+aws_access_key_id = foo
+aws_secret_access_key = bar
+
+[other]
+aws_access_key_id = baz
+aws_secret_access_key = qux
+`)
+			defer os.Remove(credentials)
+
+			err := testutils.Glooctl(fmt.Sprintf("create secret import test --format aws --file %s --profile other", credentials))
+			Expect(err).NotTo(HaveOccurred())
+
+			secret, err := helpers.MustSecretClient().Read("gloo-system", "test", clients.ReadOpts{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(*secret.GetAws()).To(Equal(v1.AwsSecret{
+				AccessKey: "baz",
+				SecretKey: "qux",
+			}))
+		})
+
+		It("should error for an aws profile with a session token", func() {
+			credentials := mustWriteTestFile(`
+[default]
+aws_access_key_id = foo
+aws_secret_access_key = bar
+aws_session_token = baz
+`)
+			defer os.Remove(credentials)
+
+			err := testutils.Glooctl(fmt.Sprintf("create secret import test --format aws --file %s", credentials))
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should import gcloud application default credentials", func() {
+			adc := mustWriteTestFile(`{
+  "client_id": "foo",
+  "client_secret": "bar",
+  "refresh_token": "baz",
+  "type": "authorized_user"
+}`)
+			defer os.Remove(adc)
+
+			err := testutils.Glooctl(fmt.Sprintf("create secret import test --format gcloud --file %s", adc))
+			Expect(err).NotTo(HaveOccurred())
+
+			secret, err := helpers.MustSecretClient().Read("gloo-system", "test", clients.ReadOpts{})
+			Expect(err).NotTo(HaveOccurred())
+			fields := secret.GetExtension().GetConfig().GetFields()
+			Expect(fields).To(HaveLen(4))
+			Expect(fields["type"].GetStringValue()).To(Equal("authorized_user"))
+			Expect(fields["refresh_token"].GetStringValue()).To(Equal("baz"))
+		})
+
+		It("should error for incomplete gcloud application default credentials", func() {
+			adc := mustWriteTestFile(`{
+  "client_id": "foo",
+  "type": "authorized_user"
+}`)
+			defer os.Remove(adc)
+
+			err := testutils.Glooctl(fmt.Sprintf("create secret import test --format gcloud --file %s", adc))
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("authorized_user credentials are missing client_secret"))
+		})
+
+		It("should import the client certificate of a kubeconfig context", func() {
+			privatekey := mustWriteTestFile(privateKey1)
+			defer os.Remove(privatekey)
+			certchain := mustWriteTestFile(privateKey1Cert)
+			defer os.Remove(certchain)
+			kubeconfig := mustWriteTestFile(fmt.Sprintf(`
+apiVersion: v1
+kind: Config
+current-context: other
+clusters:
+- name: cluster
+  cluster:
+    server: https://127.0.0.1:6443
+    certificate-authority-data: Zm9v
+users:
+- name: user
+  user:
+    client-certificate: %s
+    client-key: %s
+- name: token-user
+  user:
+    token: foo
+contexts:
+- name: test
+  context:
+    cluster: cluster
+    user: user
+- name: other
+  context:
+    cluster: cluster
+    user: token-user
+`, certchain, privatekey))
+			defer os.Remove(kubeconfig)
+
+			err := testutils.Glooctl(fmt.Sprintf("create secret import test --format kubeconfig --file %s", kubeconfig))
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("does not authenticate with a client certificate"))
+
+			err = testutils.Glooctl(fmt.Sprintf("create secret import test --format kubeconfig --file %s --context test", kubeconfig))
+			Expect(err).NotTo(HaveOccurred())
+
+			secret, err := helpers.MustSecretClient().Read("gloo-system", "test", clients.ReadOpts{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(*secret.GetTls()).To(Equal(v1.TlsSecret{
+				RootCa:     "foo",
+				PrivateKey: privateKey1,
+				CertChain:  privateKey1Cert,
+			}))
+		})
+	})
 })
 
 func mustWriteTestFile(contents string) string {
@@ -178,8 +178,10 @@ func upstreamSpecFromOpts(input options.InputUpstream) (*v1.UpstreamSpec, error)
 		}
 		spec.UpstreamType = &v1.UpstreamSpec_Aws{
 			Aws: &aws.UpstreamSpec{
-				Region:    input.Aws.Region,
-				SecretRef: input.Aws.Secret,
+				Region:     input.Aws.Region,
+				SecretRef:  input.Aws.Secret,
+				RoleArn:    input.Aws.RoleArn,
+				Qualifiers: input.Aws.Qualifiers,
 			},
 		}
 	case options.UpstreamType_Azure:
@@ -196,6 +198,8 @@ func upstreamSpecFromOpts(input options.InputUpstream) (*v1.UpstreamSpec, error)
 			Azure: &azure.UpstreamSpec{
 				FunctionAppName: input.Azure.FunctionAppName,
 				SecretRef:       input.Azure.Secret,
+				SubscriptionId:  input.Azure.SubscriptionId,
+				ResourceGroup:   input.Azure.ResourceGroup,
 			},
 		}
 	case options.UpstreamType_Consul:
@@ -220,6 +224,7 @@ func upstreamSpecFromOpts(input options.InputUpstream) (*v1.UpstreamSpec, error)
 				ServiceNamespace: input.Kube.ServiceNamespace,
 				ServicePort:      input.Kube.ServicePort,
 				Selector:         input.Kube.Selector.MustMap(),
+				PodName:          input.Kube.PodName,
 				ServiceSpec:      svcSpec,
 			},
 		}
@@ -95,6 +95,14 @@ var _ = Describe("Upstream", func() {
 			Expect(err).NotTo(HaveOccurred())
 			expectAwsUpstream("aws-us-west-1", "us-west-1", "aws-lambda-access", "custom-namespace")
 		})
+
+		It("should restrict discovery to the provided qualifiers", func() {
+			err := testutils.Glooctl("create upstream aws --aws-secret-name aws-lambda-access --aws-qualifiers live,$LATEST --name aws-us-east-1")
+			Expect(err).NotTo(HaveOccurred())
+			up := getUpstream("aws-us-east-1")
+			awsSpec := up.UpstreamSpec.UpstreamType.(*v1.UpstreamSpec_Aws).Aws
+			Expect(awsSpec.Qualifiers).To(Equal([]string{"live", "$LATEST"}))
+		})
 	})
 
 	Context("Azure", func() {
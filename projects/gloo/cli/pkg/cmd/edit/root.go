@@ -4,6 +4,7 @@ import (
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/constants"
 
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/argsutils"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/contexts"
 	editOptions "github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/edit/options"
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/edit/upstream"
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/edit/virtualservice"
@@ -27,6 +28,10 @@ func RootCmdWithEditOpts(opts *editOptions.EditOptions, optionsFunc ...cliutils.
 		Long:    constants.EDIT_COMMAND.Long,
 
 		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			// replaces the hook of the root command
+			if err := contexts.Apply(cmd, opts.Options); err != nil {
+				return err
+			}
 			err := argsutils.MetadataArgsParse(opts.Options, args)
 			if err != nil {
 				return err
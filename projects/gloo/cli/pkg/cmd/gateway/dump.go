@@ -6,18 +6,24 @@ import (
 	"log"
 	"net/http"
 	"os"
-	"os/exec"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/solo-io/go-utils/cliutils"
 
+	"github.com/solo-io/gloo/pkg/cliutil/install"
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/options"
 	"github.com/solo-io/gloo/projects/gloo/pkg/defaults"
+	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 	"github.com/solo-io/solo-kit/pkg/errors"
 	"github.com/spf13/cobra"
 )
 
+// the prefix of the stats of the envoy clusters
+const clusterStatsPrefix = "cluster."
+
 func dumpCmd(opts *options.Options, optionsFunc ...cliutils.OptionsFunc) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "dump",
@@ -37,7 +43,7 @@ func dumpCmd(opts *options.Options, optionsFunc ...cliutils.OptionsFunc) *cobra.
 
 func getEnvoyCfgDump(opts *options.Options) (string, error) {
 	adminPort := strconv.Itoa(int(defaults.EnvoyAdminPort))
-	portFwd := exec.Command("kubectl", "port-forward", "-n", opts.Metadata.Namespace,
+	portFwd := install.KubectlCommand("port-forward", "-n", opts.Metadata.Namespace,
 		"deployment/"+opts.Proxy.Name, adminPort)
 	portFwd.Stdout = os.Stderr
 	portFwd.Stderr = os.Stderr
@@ -105,17 +111,58 @@ func statsCmd(opts *options.Options, optionsFunc ...cliutils.OptionsFunc) *cobra
 			if err != nil {
 				return err
 			}
+			if len(opts.Proxy.Upstreams) > 0 {
+				cfgDump = FilterUpstreamStats(cfgDump, upstreamRefs(opts.Proxy.Upstreams, opts.Metadata.Namespace))
+			}
 			fmt.Printf("%v", cfgDump)
 			return nil
 		},
 	}
+	cmd.Flags().StringSliceVar(&opts.Proxy.Upstreams, "upstream", nil, "only print the stats of the clusters of "+
+		"these upstreams, as namespace.name, or name for the upstreams in the namespace of the proxy")
 	cliutils.ApplyOptions(cmd, optionsFunc)
 	return cmd
 }
 
+func upstreamRefs(upstreams []string, namespace string) []core.ResourceRef {
+	var refs []core.ResourceRef
+	for _, us := range upstreams {
+		ref := core.ResourceRef{Namespace: namespace, Name: us}
+		if i := strings.Index(us, "."); i >= 0 {
+			ref = core.ResourceRef{Namespace: us[:i], Name: us[i+1:]}
+		}
+		refs = append(refs, ref)
+	}
+	return refs
+}
+
+// FilterUpstreamStats keeps the stats of the clusters of the upstreams, e.g. `cluster.petstore_default.upstream_rq_total: 3`
+func FilterUpstreamStats(stats string, upstreams []core.ResourceRef) string {
+	keep := make(map[core.ResourceRef]bool, len(upstreams))
+	for _, ref := range upstreams {
+		keep[ref] = true
+	}
+	var out strings.Builder
+	for _, line := range strings.SplitAfter(stats, "\n") {
+		if !strings.HasPrefix(line, clusterStatsPrefix) {
+			continue
+		}
+		// cluster names have no dots
+		clusterName := strings.TrimPrefix(line, clusterStatsPrefix)
+		if i := strings.Index(clusterName, "."); i >= 0 {
+			clusterName = clusterName[:i]
+		}
+		ref, ok := translator.ClusterNameToUpstreamRef(clusterName)
+		if ok && keep[ref] {
+			out.WriteString(line)
+		}
+	}
+	return out.String()
+}
+
 func getEnvoyStatsDump(opts *options.Options) (string, error) {
 	adminPort := strconv.Itoa(int(defaults.EnvoyAdminPort))
-	portFwd := exec.Command("kubectl", "port-forward", "-n", opts.Metadata.Namespace,
+	portFwd := install.KubectlCommand("port-forward", "-n", opts.Metadata.Namespace,
 		"deployment/"+opts.Proxy.Name, adminPort)
 	portFwd.Stdout = os.Stderr
 	portFwd.Stderr = os.Stderr
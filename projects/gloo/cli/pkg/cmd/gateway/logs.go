@@ -4,12 +4,12 @@ import (
 	"log"
 	"net/http"
 	"os"
-	"os/exec"
 	"strconv"
 	"time"
 
 	"github.com/solo-io/go-utils/cliutils"
 
+	"github.com/solo-io/gloo/pkg/cliutil/install"
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/options"
 	"github.com/solo-io/gloo/projects/gloo/pkg/defaults"
 	"github.com/solo-io/solo-kit/pkg/errors"
@@ -41,7 +41,7 @@ func getEnvoyLogs(opts *options.Options) error {
 	if opts.Proxy.DebugLogs {
 
 		adminPort := strconv.Itoa(int(defaults.EnvoyAdminPort))
-		portFwd := exec.Command("kubectl", "port-forward", "-n", opts.Metadata.Namespace,
+		portFwd := install.KubectlCommand("port-forward", "-n", opts.Metadata.Namespace,
 			"deployment/"+opts.Proxy.Name, adminPort)
 		portFwd.Stdout = os.Stderr
 		portFwd.Stderr = os.Stderr
@@ -92,7 +92,7 @@ func getEnvoyLogs(opts *options.Options) error {
 		}
 	}
 
-	logsCmd := exec.Command("kubectl", "logs", "-n", opts.Metadata.Namespace,
+	logsCmd := install.KubectlCommand("logs", "-n", opts.Metadata.Namespace,
 		"deployment/"+opts.Proxy.Name, "-c", opts.Proxy.Name)
 	if opts.Proxy.FollowLogs {
 		logsCmd.Args = append(logsCmd.Args, "-f")
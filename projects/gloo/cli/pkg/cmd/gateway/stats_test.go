@@ -0,0 +1,29 @@
+package gateway_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/gateway"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+var _ = Describe("Stats", func() {
+	It("keeps the stats of the clusters of the upstreams", func() {
+		stats := `cluster.default-petstore-8080_gloo-system.upstream_rq_total: 3
+cluster.default-petstore-8080_gloo-system.upstream_rq_200: 2
+cluster.my_upstream_default.upstream_rq_total: 1
+cluster.xds_cluster.upstream_rq_total: 12
+cluster_manager.active_clusters: 3
+http.http.downstream_rq_total: 4
+`
+		filtered := FilterUpstreamStats(stats, []core.ResourceRef{
+			{Namespace: "gloo-system", Name: "default-petstore-8080"},
+			{Namespace: "default", Name: "my_upstream"},
+		})
+		Expect(filtered).To(Equal(`cluster.default-petstore-8080_gloo-system.upstream_rq_total: 3
+cluster.default-petstore-8080_gloo-system.upstream_rq_200: 2
+cluster.my_upstream_default.upstream_rq_total: 1
+`))
+	})
+})
@@ -20,6 +20,7 @@ func RootCmd(opts *options.Options, optionsFunc ...cliutils.OptionsFunc) *cobra.
 	cmd.AddCommand(VirtualService(opts))
 	cmd.AddCommand(Proxy(opts))
 	cmd.AddCommand(Upstream(opts))
+	cmd.AddCommand(UpstreamGroup(opts))
 	cliutils.ApplyOptions(cmd, optionsFunc)
 	return cmd
 }
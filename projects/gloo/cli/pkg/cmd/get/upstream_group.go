@@ -0,0 +1,27 @@
+package get
+
+import (
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/options"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/common"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/constants"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/helpers"
+	"github.com/spf13/cobra"
+)
+
+func UpstreamGroup(opts *options.Options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     constants.UPSTREAM_GROUP_COMMAND.Use,
+		Aliases: constants.UPSTREAM_GROUP_COMMAND.Aliases,
+		Short:   "read an upstream group or list upstream groups in a namespace",
+		Long:    "usage: glooctl get upstreamgroup",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			upstreamGroupList, err := common.GetUpstreamGroups(common.GetName(args, opts), opts)
+			if err != nil {
+				return err
+			}
+			helpers.PrintUpstreamGroups(upstreamGroupList, opts.Top.Output)
+			return nil
+		},
+	}
+	return cmd
+}
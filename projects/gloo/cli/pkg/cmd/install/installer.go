@@ -7,10 +7,10 @@ import (
 	"time"
 
 	"github.com/pkg/errors"
+	"github.com/solo-io/gloo/pkg/cliutil"
 	"github.com/solo-io/gloo/pkg/cliutil/install"
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/options"
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/constants"
-	"github.com/solo-io/go-utils/kubeutils"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/helm/pkg/chartutil"
@@ -57,7 +57,7 @@ func (i *DefaultGlooKubeInstallClient) WaitForCrdsToBeRegistered(crds []string,
 }
 
 func (i *DefaultGlooKubeInstallClient) CheckKnativeInstallation() (bool, bool, error) {
-	restCfg, err := kubeutils.GetConfig("", "")
+	restCfg, err := cliutil.GetKubeConfig()
 	if err != nil {
 		return false, false, err
 	}
@@ -18,6 +18,7 @@ type Options struct {
 	Get       Get
 	Add       Add
 	Remove    Remove
+	Context   Context
 }
 
 type Top struct {
@@ -25,6 +26,8 @@ type Top struct {
 	File        string
 	Output      string
 	Ctx         context.Context
+	// the glooctl context the commands act in, the current context of the rc file if empty
+	ContextName string
 }
 
 type Install struct {
@@ -46,6 +49,16 @@ type Proxy struct {
 	Port         string
 	FollowLogs   bool
 	DebugLogs    bool
+	Upstreams    []string
+}
+
+type Context struct {
+	Cluster           string
+	Namespace         string
+	AllowedNamespaces []string
+	AllowedResources  []string
+	ReadOnly          bool
+	ServerSideChecks  bool
 }
 
 type Upgrade struct {
@@ -132,6 +145,7 @@ type DestinationSpec struct {
 type AwsDestinationSpec struct {
 	LogicalName            string
 	ResponseTransformation bool
+	Async                  bool
 }
 
 type RestDestinationSpec struct {
@@ -181,13 +195,17 @@ type InputUpstream struct {
 }
 
 type InputAwsSpec struct {
-	Region string
-	Secret core.ResourceRef
+	Region     string
+	Secret     core.ResourceRef
+	RoleArn    string
+	Qualifiers []string
 }
 
 type InputAzureSpec struct {
 	FunctionAppName string
 	Secret          core.ResourceRef
+	SubscriptionId  string
+	ResourceGroup   string
 }
 
 type InputConsulSpec struct {
@@ -210,6 +228,8 @@ type InputKubeSpec struct {
 	// any are provided here.
 	// (see [Kubernetes labels and selectors](https://kubernetes.io/docs/concepts/overview/working-with-objects/labels/)
 	Selector InputMapStringString
+	// The name of the pod the Upstream is restricted to
+	PodName string
 }
 
 type Selector struct {
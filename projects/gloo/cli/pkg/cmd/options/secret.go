@@ -8,9 +8,10 @@ import (
 )
 
 type Secret struct {
-	TlsSecret   TlsSecret
-	AwsSecret   AwsSecret
-	AzureSecret AzureSecret
+	TlsSecret    TlsSecret
+	AwsSecret    AwsSecret
+	AzureSecret  AzureSecret
+	ImportSecret ImportSecret
 }
 
 type AwsSecret struct {
@@ -20,6 +21,20 @@ type AwsSecret struct {
 
 type AzureSecret struct {
 	ApiKeys InputMapStringString
+	// service principal credentials used by function discovery
+	TenantId     string
+	ClientId     string
+	ClientSecret string
+}
+
+type ImportSecret struct {
+	// the format of the imported file, e.g. aws, gcloud or kubeconfig
+	Format   string
+	Filename string
+	// the aws profile to import
+	Profile string
+	// the kubeconfig context to import
+	Context string
 }
 
 type TlsSecret struct {
@@ -7,6 +7,7 @@ import (
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/route"
 
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/add"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/contexts"
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/create"
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/del"
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/edit"
@@ -51,6 +52,10 @@ func GlooCli(version string) *cobra.Command {
 	optionsFunc := func(app *cobra.Command) {
 		pflags := app.PersistentFlags()
 		pflags.BoolVarP(&opts.Top.Interactive, "interactive", "i", false, "use interactive mode")
+		pflags.StringVar(&opts.Top.ContextName, "context", "", "the glooctl context to act in, the current context if empty")
+		app.PersistentPreRunE = func(cmd *cobra.Command, args []string) error {
+			return contexts.Apply(cmd, opts)
+		}
 
 		app.SuggestionsMinimumDistance = 1
 		app.AddCommand(
@@ -65,6 +70,7 @@ func GlooCli(version string) *cobra.Command {
 			edit.RootCmd(opts),
 			upgrade.RootCmd(opts),
 			gateway.RootCmd(opts),
+			contexts.RootCmd(opts),
 			completionCmd(),
 		)
 	}
@@ -77,6 +77,29 @@ func GetProxies(name string, opts *options.Options) (gloov1.ProxyList, error) {
 	return list, nil
 }
 
+func GetUpstreamGroups(name string, opts *options.Options) (gloov1.UpstreamGroupList, error) {
+	var list gloov1.UpstreamGroupList
+
+	ugClient := helpers.MustUpstreamGroupClient()
+	if name == "" {
+		ugs, err := ugClient.List(opts.Metadata.Namespace,
+			clients.ListOpts{Ctx: opts.Top.Ctx, Selector: opts.Get.Selector.MustMap()})
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, ugs...)
+	} else {
+		ug, err := ugClient.Read(opts.Metadata.Namespace, name, clients.ReadOpts{Ctx: opts.Top.Ctx})
+		if err != nil {
+			return nil, err
+		}
+		opts.Metadata.Name = name
+		list = append(list, ug)
+	}
+
+	return list, nil
+}
+
 func GetName(args []string, opts *options.Options) string {
 	if len(args) > 0 {
 		return args[0]
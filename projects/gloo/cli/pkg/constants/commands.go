@@ -13,6 +13,11 @@ var (
 		Aliases: []string{"u", "us", "upstreams"},
 	}
 
+	UPSTREAM_GROUP_COMMAND = cobra.Command{
+		Use:     "upstreamgroup",
+		Aliases: []string{"ug", "ugs", "upstreamgroups"},
+	}
+
 	PROXY_COMMAND = cobra.Command{
 		Use:     "proxy",
 		Aliases: []string{"p", "proxies"},
@@ -75,4 +80,11 @@ var (
 		Aliases: []string{"ed"},
 		Short:   "Edit a Gloo resource",
 	}
+
+	CONTEXT_COMMAND = cobra.Command{
+		Use:     "context",
+		Aliases: []string{"ctx", "contexts"},
+		Short:   "Manage the contexts of glooctl",
+		Long:    "A context names a cluster, the default namespace of the commands and the resources they may act on. The contexts are kept in ~/.glooctl/config.yaml, or in the file of the GLOOCTLRC environment variable.",
+	}
 )
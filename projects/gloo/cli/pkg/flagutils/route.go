@@ -34,6 +34,9 @@ func AddRouteFlags(set *pflag.FlagSet, route *options.InputRoute) {
 	set.BoolVarP(&route.Destination.DestinationSpec.Aws.ResponseTransformation, "aws-unescape", "", false,
 		"unescape JSON returned by this lambda function (useful if the response is not intended to be JSON formatted, "+
 			"e.g. in the case of static content (images, HTML, etc.) being served by Lambda")
+	set.BoolVarP(&route.Destination.DestinationSpec.Aws.Async, "aws-async", "", false,
+		"invoke this lambda function asynchronously, responding immediately with 202 Accepted "+
+			"(useful for fire-and-forget webhooks)")
 
 	set.StringVarP(&route.Destination.DestinationSpec.Rest.FunctionName, "rest-function-name", "f", "",
 		"name of the REST function to invoke with this route. use if destination has a REST service spec")
@@ -22,6 +22,11 @@ func AddUpstreamFlags(set *pflag.FlagSet, upstreamType string, upstream *options
 		set.StringVar(&upstream.Aws.Secret.Namespace, "aws-secret-namespace", defaults.GlooSystem,
 			"namespace where the AWS secret lives. See `glooctl create secret aws --help` "+
 				"for help creating secrets")
+		set.StringVar(&upstream.Aws.RoleArn, "aws-role-arn", "",
+			"(optional) ARN of an IAM role to assume via STS with the credentials in the AWS secret")
+		set.StringSliceVar(&upstream.Aws.Qualifiers, "aws-qualifiers", []string{},
+			"(optional) comma-separated list of versions and aliases of the Lambda functions to import with discovery. "+
+				"imports all qualifiers if empty")
 	case options.UpstreamType_Azure:
 		set.StringVar(&upstream.Azure.FunctionAppName, "azure-app-name", "",
 			"name of the Azure Functions app to associate with this upstream")
@@ -31,6 +36,10 @@ func AddUpstreamFlags(set *pflag.FlagSet, upstreamType string, upstream *options
 		set.StringVar(&upstream.Azure.Secret.Namespace, "azure-secret-namespace", defaults.GlooSystem,
 			"namespace where the Azure secret lives. See `glooctl create secret azure --help` "+
 				"for help creating secrets")
+		set.StringVar(&upstream.Azure.SubscriptionId, "azure-subscription-id", "",
+			"(optional) ID of the Azure subscription of the Functions app. required for function discovery")
+		set.StringVar(&upstream.Azure.ResourceGroup, "azure-resource-group", "",
+			"(optional) resource group of the Functions app. if empty, function discovery searches all resource groups of the subscription")
 	case options.UpstreamType_Consul:
 		addServiceSpecFlags = true
 		set.StringVar(&upstream.Consul.ServiceName, "consul-service", "",
@@ -49,6 +58,9 @@ func AddUpstreamFlags(set *pflag.FlagSet, upstreamType string, upstream *options
 		set.StringSliceVar(&upstream.Kube.Selector.Entries, "kube-service-labels", []string{},
 			"comma-separated list of labels (key=value) to use for customized selection of pods for this upstream. can be used to select subsets of "+
 				"pods for a service e.g. for blue-green deployment")
+		set.StringVar(&upstream.Kube.PodName, "kube-pod-name", "",
+			"(optional) the name of the pod the upstream is restricted to. can be used to address a single member "+
+				"of a statefulset behind a headless service")
 	case options.UpstreamType_Static:
 		addServiceSpecFlags = true
 		set.StringSliceVar(&upstream.Static.Hosts, "static-hosts", []string{},
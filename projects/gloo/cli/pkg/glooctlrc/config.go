@@ -0,0 +1,100 @@
+package glooctlrc
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/ghodss/yaml"
+	"github.com/solo-io/solo-kit/pkg/errors"
+	"k8s.io/client-go/util/homedir"
+)
+
+// EnvConfigPath overrides the path of the rc file of glooctl
+const EnvConfigPath = "GLOOCTLRC"
+
+// Config is the rc file of glooctl, holding its named contexts
+type Config struct {
+	CurrentContext string              `json:"currentContext,omitempty"`
+	Contexts       map[string]*Context `json:"contexts,omitempty"`
+}
+
+// Context is an environment glooctl acts in: a cluster, a default namespace, and the resources the commands may act on
+type Context struct {
+	// The kube context of the cluster, the current kube context if empty
+	Cluster string `json:"cluster,omitempty"`
+	// The namespace of the commands not given one
+	Namespace string `json:"namespace,omitempty"`
+	// The namespaces the commands may act in, any if empty
+	AllowedNamespaces []string `json:"allowedNamespaces,omitempty"`
+	// The kinds of resources the commands may act on, e.g. `upstream` or `virtualservice`, any if empty
+	AllowedResources []string `json:"allowedResources,omitempty"`
+	// The commands may only read resources
+	ReadOnly bool `json:"readOnly,omitempty"`
+	// Ask the cluster whether the user may perform each operation before performing it, so that the RBAC of the
+	// cluster is enforced with a clear error rather than halfway through a command
+	ServerSideChecks bool `json:"serverSideChecks,omitempty"`
+}
+
+// Path returns the path of the rc file, `~/.glooctl/config.yaml` unless overridden
+func Path() string {
+	if path := os.Getenv(EnvConfigPath); path != "" {
+		return path
+	}
+	return filepath.Join(homedir.HomeDir(), ".glooctl", "config.yaml")
+}
+
+// Load reads the rc file at the path, returning an empty config if there is none
+func Load(path string) (*Config, error) {
+	raw, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return &Config{}, nil
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "reading %v", path)
+	}
+	var config Config
+	if err := yaml.Unmarshal(raw, &config); err != nil {
+		return nil, errors.Wrapf(err, "parsing %v", path)
+	}
+	return &config, nil
+}
+
+// Save writes the rc file at the path, creating its directory if needed
+func (c *Config) Save(path string) error {
+	raw, err := yaml.Marshal(c)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return errors.Wrapf(err, "creating the directory of %v", path)
+	}
+	return ioutil.WriteFile(path, raw, 0600)
+}
+
+// Get returns the named context, or the current context if the name is empty. Returns nil if no context is named
+// and there is no current context.
+func (c *Config) Get(name string) (*Context, error) {
+	if name == "" {
+		name = c.CurrentContext
+	}
+	if name == "" {
+		return nil, nil
+	}
+	context, ok := c.Contexts[name]
+	if !ok {
+		return nil, errors.Errorf("context %v not found", name)
+	}
+	return context, nil
+}
+
+// Names returns the names of the contexts, sorted
+func (c *Config) Names() []string {
+	var names []string
+	for name := range c.Contexts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
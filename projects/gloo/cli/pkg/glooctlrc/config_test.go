@@ -0,0 +1,85 @@
+package glooctlrc_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/glooctlrc"
+)
+
+var _ = Describe("Config", func() {
+	var dir string
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "glooctlrc")
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	It("loads an empty config when there is no rc file", func() {
+		config, err := glooctlrc.Load(filepath.Join(dir, "config.yaml"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(config.Contexts).To(BeEmpty())
+
+		context, err := config.Get("")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(context).To(BeNil())
+	})
+
+	It("saves and loads the contexts", func() {
+		path := filepath.Join(dir, ".glooctl", "config.yaml")
+		config := &glooctlrc.Config{
+			CurrentContext: "team-a",
+			Contexts: map[string]*glooctlrc.Context{
+				"team-a": {Cluster: "prod", Namespace: "team-a", AllowedNamespaces: []string{"team-a"}, ReadOnly: true},
+			},
+		}
+		Expect(config.Save(path)).NotTo(HaveOccurred())
+
+		loaded, err := glooctlrc.Load(path)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(loaded).To(Equal(config))
+
+		context, err := loaded.Get("")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(context.Cluster).To(Equal("prod"))
+
+		_, err = loaded.Get("team-b")
+		Expect(err).To(HaveOccurred())
+	})
+
+	Context("authorize", func() {
+		context := &glooctlrc.Context{
+			AllowedNamespaces: []string{"team-a"},
+			AllowedResources:  []string{"virtualservice"},
+		}
+
+		It("allows the operations in the scope of the context", func() {
+			Expect(context.Authorize(glooctlrc.Operation{Verb: glooctlrc.VerbCreate, Resource: "virtualservice", Namespace: "team-a"})).NotTo(HaveOccurred())
+		})
+
+		It("rejects the operations out of the scope of the context", func() {
+			Expect(context.Authorize(glooctlrc.Operation{Verb: glooctlrc.VerbCreate, Resource: "upstream", Namespace: "team-a"})).To(HaveOccurred())
+			Expect(context.Authorize(glooctlrc.Operation{Verb: glooctlrc.VerbCreate, Resource: "virtualservice", Namespace: "team-b"})).To(HaveOccurred())
+		})
+
+		It("only allows reading resources in read-only contexts", func() {
+			readOnly := &glooctlrc.Context{ReadOnly: true}
+			Expect(readOnly.Authorize(glooctlrc.Operation{Verb: glooctlrc.VerbGet, Resource: "upstream"})).NotTo(HaveOccurred())
+			Expect(readOnly.Authorize(glooctlrc.Operation{Verb: glooctlrc.VerbDelete, Resource: "upstream"})).To(HaveOccurred())
+			Expect(readOnly.Authorize(glooctlrc.Operation{Verb: "install"})).To(MatchError(ContainSubstring("install is not allowed")))
+		})
+
+		It("allows any operation without context", func() {
+			var none *glooctlrc.Context
+			Expect(none.Authorize(glooctlrc.Operation{Verb: glooctlrc.VerbDelete, Resource: "upstream"})).NotTo(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,13 @@
+package glooctlrc_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestGlooctlrc(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Glooctlrc Suite")
+}
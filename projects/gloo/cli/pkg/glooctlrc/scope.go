@@ -0,0 +1,59 @@
+package glooctlrc
+
+import (
+	"github.com/solo-io/solo-kit/pkg/errors"
+)
+
+const (
+	VerbGet    = "get"
+	VerbCreate = "create"
+	VerbUpdate = "update"
+	VerbDelete = "delete"
+)
+
+// Operation is what a command does to the resources
+type Operation struct {
+	// the verb, or the command for the commands that don't act on resources, e.g. `install`
+	Verb string
+	// the kind of resource, e.g. `upstream`, empty for the commands that don't act on resources
+	Resource  string
+	Namespace string
+}
+
+func (op Operation) String() string {
+	if op.Resource == "" {
+		return op.Verb
+	}
+	return op.Verb + " " + op.Resource
+}
+
+// AccessReviewer asks the server whether the user may perform an operation
+type AccessReviewer interface {
+	Review(op Operation) error
+}
+
+// Authorize returns an error if the operation is out of the scope of the context
+func (c *Context) Authorize(op Operation) error {
+	if c == nil {
+		return nil
+	}
+	if c.ReadOnly && op.Verb != VerbGet {
+		return errors.Errorf("the context is read-only, %v is not allowed", op)
+	}
+	if len(c.AllowedResources) > 0 && !contains(c.AllowedResources, op.Resource) {
+		return errors.Errorf("the context only allows the resources %v, %v is not allowed", c.AllowedResources, op)
+	}
+	if len(c.AllowedNamespaces) > 0 && !contains(c.AllowedNamespaces, op.Namespace) {
+		return errors.Errorf("the context only allows the namespaces %v, not %v", c.AllowedNamespaces, op.Namespace)
+	}
+	return nil
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -11,7 +11,6 @@ import (
 	gatewayv1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/gloo/pkg/defaults"
-	"github.com/solo-io/go-utils/kubeutils"
 	"github.com/solo-io/go-utils/log"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients/factory"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients/kube"
@@ -54,7 +53,7 @@ func GetNamespaces() ([]string, error) {
 		return []string{"default", defaults.GlooSystem}, nil
 	}
 
-	cfg, err := kubeutils.GetConfig("", "")
+	cfg, err := getConfig()
 	if err != nil {
 		return nil, errors.Wrapf(err, "getting kube config")
 	}
@@ -87,7 +86,7 @@ func UpstreamClient() (v1.UpstreamClient, error) {
 		return v1.NewUpstreamClient(memoryResourceClient)
 	}
 
-	cfg, err := kubeutils.GetConfig("", "")
+	cfg, err := getConfig()
 	if err != nil {
 		return nil, errors.Wrapf(err, "getting kube config")
 	}
@@ -120,7 +119,7 @@ func UpstreamGroupClient() (v1.UpstreamGroupClient, error) {
 		return v1.NewUpstreamGroupClient(memoryResourceClient)
 	}
 
-	cfg, err := kubeutils.GetConfig("", "")
+	cfg, err := getConfig()
 	if err != nil {
 		return nil, errors.Wrapf(err, "getting kube config")
 	}
@@ -153,7 +152,7 @@ func ProxyClient() (v1.ProxyClient, error) {
 		return v1.NewProxyClient(memoryResourceClient)
 	}
 
-	cfg, err := kubeutils.GetConfig("", "")
+	cfg, err := getConfig()
 	if err != nil {
 		return nil, errors.Wrapf(err, "getting kube config")
 	}
@@ -186,7 +185,7 @@ func VirtualServiceClient() (gatewayv1.VirtualServiceClient, error) {
 		return gatewayv1.NewVirtualServiceClient(memoryResourceClient)
 	}
 
-	cfg, err := kubeutils.GetConfig("", "")
+	cfg, err := getConfig()
 	if err != nil {
 		return nil, errors.Wrapf(err, "getting kube config")
 	}
@@ -219,7 +218,7 @@ func SettingsClient() (v1.SettingsClient, error) {
 		return v1.NewSettingsClient(memoryResourceClient)
 	}
 
-	cfg, err := kubeutils.GetConfig("", "")
+	cfg, err := getConfig()
 	if err != nil {
 		return nil, errors.Wrapf(err, "getting kube config")
 	}
@@ -286,7 +285,7 @@ func GetKubernetesClient() (*kubernetes.Clientset, error) {
 }
 
 func getKubernetesConfig(timeout time.Duration) (*rest.Config, error) {
-	config, err := kubeutils.GetConfig("", "")
+	config, err := getConfig()
 	if err != nil {
 		return nil, fmt.Errorf("Error retrieving Kubernetes configuration: %v \n", err)
 	}
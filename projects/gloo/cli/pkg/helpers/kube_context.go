@@ -0,0 +1,59 @@
+package helpers
+
+import (
+	"github.com/solo-io/gloo/pkg/cliutil"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/glooctlrc"
+	"github.com/solo-io/solo-kit/pkg/errors"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	"k8s.io/client-go/rest"
+)
+
+// UseKubeContext makes the clients connect to the cluster of the kube context, rather than of the current kube context
+func UseKubeContext(name string) {
+	cliutil.UseKubeContext(name)
+}
+
+func getConfig() (*rest.Config, error) {
+	return cliutil.GetKubeConfig()
+}
+
+// the api group and plural of the kinds of resources of glooctl
+var resourceAttributes = map[string]authorizationv1.ResourceAttributes{
+	"upstream":       {Group: "gloo.solo.io", Resource: "upstreams"},
+	"upstreamgroup":  {Group: "gloo.solo.io", Resource: "upstreamgroups"},
+	"proxy":          {Group: "gloo.solo.io", Resource: "proxies"},
+	"virtualservice": {Group: "gateway.solo.io", Resource: "virtualservices"},
+	"secret":         {Resource: "secrets"},
+}
+
+// KubeAccessReviewer asks the cluster whether the user may perform the operations, with self subject access reviews
+type KubeAccessReviewer struct{}
+
+var _ glooctlrc.AccessReviewer = KubeAccessReviewer{}
+
+func (KubeAccessReviewer) Review(op glooctlrc.Operation) error {
+	if getMemoryClients() != nil {
+		return nil
+	}
+	attributes, ok := resourceAttributes[op.Resource]
+	if !ok {
+		return errors.Errorf("unknown resource %v", op.Resource)
+	}
+	attributes.Verb = op.Verb
+	attributes.Namespace = op.Namespace
+
+	kubeClient, err := GetKubernetesClient()
+	if err != nil {
+		return errors.Wrapf(err, "getting kube client")
+	}
+	review, err := kubeClient.AuthorizationV1().SelfSubjectAccessReviews().Create(&authorizationv1.SelfSubjectAccessReview{
+		Spec: authorizationv1.SelfSubjectAccessReviewSpec{ResourceAttributes: &attributes},
+	})
+	if err != nil {
+		return errors.Wrapf(err, "reviewing access to %v %v", op.Verb, op.Resource)
+	}
+	if !review.Status.Allowed {
+		return errors.Errorf("the cluster does not allow %v %v in namespace %v: %v", op.Verb, op.Resource, op.Namespace, review.Status.Reason)
+	}
+	return nil
+}
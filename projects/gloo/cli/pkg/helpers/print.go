@@ -26,6 +26,14 @@ func PrintProxies(proxies v1.ProxyList, outputType string) {
 		}, os.Stdout)
 }
 
+func PrintUpstreamGroups(upstreamGroups v1.UpstreamGroupList, outputType string) {
+	cliutils.PrintList(outputType, "", upstreamGroups,
+		func(data interface{}, w io.Writer) error {
+			printers.UpstreamGroupTable(data.(v1.UpstreamGroupList), w)
+			return nil
+		}, os.Stdout)
+}
+
 func PrintVirtualServices(virtualServices gatewayv1.VirtualServiceList, outputType string) {
 	cliutils.PrintList(outputType, "", virtualServices,
 		func(data interface{}, w io.Writer) error {
@@ -114,12 +114,22 @@ func Destinations(d *gloov1.Destination) string {
 	switch d.DestinationSpec.DestinationType.(type) {
 	case *gloov1.DestinationSpec_Aws:
 		return "aws"
+	case *gloov1.DestinationSpec_Alibaba:
+		return "alibaba"
 	case *gloov1.DestinationSpec_Azure:
 		return "azure"
 	case *gloov1.DestinationSpec_Grpc:
 		return "grpc"
+	case *gloov1.DestinationSpec_Openfaas:
+		return "openfaas"
+	case *gloov1.DestinationSpec_Openwhisk:
+		return "openwhisk"
+	case *gloov1.DestinationSpec_External:
+		return "external"
 	case *gloov1.DestinationSpec_Rest:
 		return "rest"
+	case *gloov1.DestinationSpec_Thrift:
+		return "thrift"
 	default:
 		return "unknown"
 	}
@@ -43,10 +43,26 @@ func upstreamType(up *v1.Upstream) string {
 	switch up.UpstreamSpec.UpstreamType.(type) {
 	case *v1.UpstreamSpec_Aws:
 		return "AWS"
+	case *v1.UpstreamSpec_Alibaba:
+		return "Alibaba"
+	case *v1.UpstreamSpec_Ec2:
+		return "EC2"
+	case *v1.UpstreamSpec_Cloudmap:
+		return "Cloud Map"
 	case *v1.UpstreamSpec_Azure:
 		return "Azure"
+	case *v1.UpstreamSpec_Openwhisk:
+		return "OpenWhisk"
+	case *v1.UpstreamSpec_External:
+		return "External"
+	case *v1.UpstreamSpec_Failover:
+		return "Failover"
+	case *v1.UpstreamSpec_OriginalDst:
+		return "Original Destination"
 	case *v1.UpstreamSpec_Consul:
 		return "Consul"
+	case *v1.UpstreamSpec_Nomad:
+		return "Nomad"
 	case *v1.UpstreamSpec_Kube:
 		return "Kubernetes"
 	case *v1.UpstreamSpec_Static:
@@ -93,6 +109,67 @@ func upstreamDetails(up *v1.Upstream) []string {
 			}
 			add(fmt.Sprintf("- %v", functions[i]))
 		}
+	case *v1.UpstreamSpec_Alibaba:
+		add(
+			fmt.Sprintf("region: %v", usType.Alibaba.Region),
+			fmt.Sprintf("account id: %v", usType.Alibaba.AccountId),
+			fmt.Sprintf("secret: %v", usType.Alibaba.SecretRef.Key()),
+		)
+		for i, fn := range usType.Alibaba.Functions {
+			if i == 0 {
+				add("functions:")
+			}
+			add(fmt.Sprintf("- %v", fn.LogicalName))
+		}
+	case *v1.UpstreamSpec_Openwhisk:
+		add(
+			fmt.Sprintf("api host: %v", usType.Openwhisk.ApiHost),
+			fmt.Sprintf("namespace: %v", usType.Openwhisk.Namespace),
+			fmt.Sprintf("secret: %v", usType.Openwhisk.SecretRef.Key()),
+		)
+		for i, action := range usType.Openwhisk.Actions {
+			if i == 0 {
+				add("actions:")
+			}
+			add(fmt.Sprintf("- %v", action.LogicalName))
+		}
+	case *v1.UpstreamSpec_External:
+		for i, fn := range usType.External.Functions {
+			if i == 0 {
+				add("functions:")
+			}
+			add(fmt.Sprintf("- %v: %v", fn.LogicalName, fn.Url))
+		}
+	case *v1.UpstreamSpec_Failover:
+		for i, ref := range usType.Failover.Upstreams {
+			if i == 0 {
+				add("upstreams:")
+			}
+			add(fmt.Sprintf("- %v", ref.Key()))
+		}
+	case *v1.UpstreamSpec_OriginalDst:
+		if usType.OriginalDst.UseHttpHeader {
+			add("destination: x-envoy-original-dst-host header")
+		}
+	case *v1.UpstreamSpec_Ec2:
+		add(
+			fmt.Sprintf("region: %v", usType.Ec2.Region),
+			fmt.Sprintf("secret: %v", usType.Ec2.SecretRef.Key()),
+			fmt.Sprintf("port: %v", usType.Ec2.Port),
+		)
+		for i, filter := range usType.Ec2.Filters {
+			if i == 0 {
+				add("tag filters:")
+			}
+			add(fmt.Sprintf("- %v=%v", filter.Key, filter.Value))
+		}
+	case *v1.UpstreamSpec_Cloudmap:
+		add(
+			fmt.Sprintf("region: %v", usType.Cloudmap.Region),
+			fmt.Sprintf("secret: %v", usType.Cloudmap.SecretRef.Key()),
+			fmt.Sprintf("namespace: %v", usType.Cloudmap.NamespaceName),
+			fmt.Sprintf("service: %v", usType.Cloudmap.ServiceName),
+		)
 	case *v1.UpstreamSpec_Consul:
 		add(
 			fmt.Sprintf("svc name: %v", usType.Consul.ServiceName),
@@ -101,12 +178,24 @@ func upstreamDetails(up *v1.Upstream) []string {
 		if usType.Consul.ServiceSpec != nil {
 			add(linesForServiceSpec(usType.Consul.ServiceSpec)...)
 		}
+	case *v1.UpstreamSpec_Nomad:
+		add(
+			fmt.Sprintf("svc name: %v", usType.Nomad.ServiceName),
+			fmt.Sprintf("namespace: %v", usType.Nomad.Namespace),
+			fmt.Sprintf("svc tags: %v", usType.Nomad.ServiceTags),
+		)
+		if usType.Nomad.ServiceSpec != nil {
+			add(linesForServiceSpec(usType.Nomad.ServiceSpec)...)
+		}
 	case *v1.UpstreamSpec_Kube:
 		add(
 			fmt.Sprintf("svc name:      %v", usType.Kube.ServiceName),
 			fmt.Sprintf("svc namespace: %v", usType.Kube.ServiceNamespace),
 			fmt.Sprintf("port:          %v", usType.Kube.ServicePort),
 		)
+		if usType.Kube.PodName != "" {
+			add(fmt.Sprintf("pod name:      %v", usType.Kube.PodName))
+		}
 		if usType.Kube.ServiceSpec != nil {
 			add(linesForServiceSpec(usType.Kube.ServiceSpec)...)
 		}
@@ -117,6 +206,9 @@ func upstreamDetails(up *v1.Upstream) []string {
 			}
 			add(fmt.Sprintf("- %v:%v", usType.Static.Hosts[i].Addr, usType.Static.Hosts[i].Port))
 		}
+		if usType.Static.SrvDiscovery != nil {
+			add(fmt.Sprintf("srv records: %v", usType.Static.SrvDiscovery.Name))
+		}
 		if usType.Static.ServiceSpec != nil {
 			add(linesForServiceSpec(usType.Static.ServiceSpec)...)
 		}
@@ -167,6 +259,22 @@ func linesForServiceSpec(serviceSpec *plugins.ServiceSpec) []string {
 				add(fmt.Sprintf("  - %v", fn))
 			}
 		}
+	case *plugins.ServiceSpec_Openfaas:
+		add("OpenFaaS service:")
+		for i, fn := range plug.Openfaas.Functions {
+			if i == 0 {
+				add("functions:")
+			}
+			add(fmt.Sprintf("- %v", fn.FunctionName))
+		}
+	case *plugins.ServiceSpec_Thrift:
+		add("Thrift service:")
+		for _, thriftService := range plug.Thrift.Services {
+			add(fmt.Sprintf("  %v", thriftService.Name))
+			for _, method := range thriftService.Methods {
+				add(fmt.Sprintf("  - %v", method.Name))
+			}
+		}
 	}
 
 	return spec
@@ -0,0 +1,59 @@
+package printers
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/olekukonko/tablewriter"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+)
+
+// PrintTable prints upstream groups using tables to io.Writer
+func UpstreamGroupTable(list v1.UpstreamGroupList, w io.Writer) {
+	table := tablewriter.NewWriter(w)
+	table.SetHeader([]string{"Upstream Group", "Status", "Total Weight", "Details"})
+
+	for _, ug := range list {
+		var totalWeight uint32
+		for _, dest := range ug.Destinations {
+			totalWeight += dest.Weight
+		}
+		details := upstreamGroupDetails(ug)
+		if len(details) == 0 {
+			details = []string{""}
+		}
+		for i, line := range details {
+			if i == 0 {
+				table.Append([]string{ug.Metadata.Name, ug.Status.State.String(), strconv.Itoa(int(totalWeight)), line})
+			} else {
+				table.Append([]string{"", "", "", line})
+			}
+		}
+	}
+
+	table.SetAlignment(tablewriter.ALIGN_LEFT)
+	table.Render()
+}
+
+func upstreamGroupDetails(ug *v1.UpstreamGroup) []string {
+	var details []string
+	for _, dest := range ug.Destinations {
+		if dest.Destination == nil {
+			continue
+		}
+		var target string
+		switch destType := dest.Destination.DestinationType.(type) {
+		case *v1.Destination_Upstream:
+			target = fmt.Sprintf("upstream: %v.%v", destType.Upstream.Namespace, destType.Upstream.Name)
+		case *v1.Destination_Service:
+			target = fmt.Sprintf("service: %v.%v:%v", destType.Service.Ref.Namespace, destType.Service.Ref.Name, destType.Service.Port)
+		}
+		line := fmt.Sprintf("%v weight: %v", target, dest.Weight)
+		if dest.Destination.DestinationSpec != nil {
+			line += " function: " + Destinations(dest.Destination)
+		}
+		details = append(details, line)
+	}
+	return details
+}
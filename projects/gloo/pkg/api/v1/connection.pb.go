@@ -11,7 +11,7 @@ import (
 
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
-	_ "github.com/gogo/protobuf/types"
+	types "github.com/gogo/protobuf/types"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -33,10 +33,16 @@ type ConnectionConfig struct {
 	// The timeout for new network connections to hosts in the cluster
 	ConnectTimeout *time.Duration `protobuf:"bytes,2,opt,name=connect_timeout,json=connectTimeout,proto3,stdduration" json:"connect_timeout,omitempty"`
 	// Configure OS-level tcp keepalive checks
-	TcpKeepalive         *ConnectionConfig_TcpKeepAlive `protobuf:"bytes,3,opt,name=tcp_keepalive,json=tcpKeepalive,proto3" json:"tcp_keepalive,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
-	XXX_unrecognized     []byte                         `json:"-"`
-	XXX_sizecache        int32                          `json:"-"`
+	TcpKeepalive *ConnectionConfig_TcpKeepAlive `protobuf:"bytes,3,opt,name=tcp_keepalive,json=tcpKeepalive,proto3" json:"tcp_keepalive,omitempty"`
+	// Setting HTTP/2 settings enables HTTP/2 to the upstream
+	Http2Settings *ConnectionConfig_Http2Settings `protobuf:"bytes,4,opt,name=http2_settings,json=http2Settings,proto3" json:"http2_settings,omitempty"`
+	// The idle timeout of the connections to the upstream, after which the connections without active requests are
+	// closed. Set it below the idle timeout of the NAT gateways and load balancers in front of the upstream, so that
+	// envoy closes the connections before they are silently dropped. Unset means no idle timeout.
+	IdleTimeout          *time.Duration `protobuf:"bytes,5,opt,name=idle_timeout,json=idleTimeout,proto3,stdduration" json:"idle_timeout,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
 func (m *ConnectionConfig) Reset()         { *m = ConnectionConfig{} }
@@ -84,6 +90,20 @@ func (m *ConnectionConfig) GetTcpKeepalive() *ConnectionConfig_TcpKeepAlive {
 	return nil
 }
 
+func (m *ConnectionConfig) GetHttp2Settings() *ConnectionConfig_Http2Settings {
+	if m != nil {
+		return m.Http2Settings
+	}
+	return nil
+}
+
+func (m *ConnectionConfig) GetIdleTimeout() *time.Duration {
+	if m != nil {
+		return m.IdleTimeout
+	}
+	return nil
+}
+
 // If set then set SO_KEEPALIVE on the socket to enable TCP Keepalives.
 // see more info here: https://www.envoyproxy.io/docs/envoy/latest/api-v2/api/v2/core/address.proto#envoy-api-msg-core-tcpkeepalive
 type ConnectionConfig_TcpKeepAlive struct {
@@ -143,9 +163,69 @@ func (m *ConnectionConfig_TcpKeepAlive) GetKeepaliveInterval() *time.Duration {
 	return nil
 }
 
+// HTTP/2 settings of the connections to the upstream, e.g. to tune large gRPC payloads.
+// see more info here: https://www.envoyproxy.io/docs/envoy/latest/api-v2/api/v2/core/protocol.proto#envoy-api-msg-core-http2protocoloptions
+type ConnectionConfig_Http2Settings struct {
+	// Maximum concurrent streams on a single connection, from 1 to 2147483647. Defaults to 2147483647.
+	MaxConcurrentStreams *types.UInt32Value `protobuf:"bytes,1,opt,name=max_concurrent_streams,json=maxConcurrentStreams,proto3" json:"max_concurrent_streams,omitempty"`
+	// Initial flow-control window of a stream in bytes, from 65535 to 2147483647. Defaults to 268435456 (256 * 1024 * 1024).
+	InitialStreamWindowSize *types.UInt32Value `protobuf:"bytes,2,opt,name=initial_stream_window_size,json=initialStreamWindowSize,proto3" json:"initial_stream_window_size,omitempty"`
+	// Initial flow-control window of a connection in bytes, with the same range and default as the stream window.
+	InitialConnectionWindowSize *types.UInt32Value `protobuf:"bytes,3,opt,name=initial_connection_window_size,json=initialConnectionWindowSize,proto3" json:"initial_connection_window_size,omitempty"`
+	XXX_NoUnkeyedLiteral        struct{}           `json:"-"`
+	XXX_unrecognized            []byte             `json:"-"`
+	XXX_sizecache               int32              `json:"-"`
+}
+
+func (m *ConnectionConfig_Http2Settings) Reset()         { *m = ConnectionConfig_Http2Settings{} }
+func (m *ConnectionConfig_Http2Settings) String() string { return proto.CompactTextString(m) }
+func (*ConnectionConfig_Http2Settings) ProtoMessage()    {}
+func (*ConnectionConfig_Http2Settings) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56610fe13cf10c84, []int{0, 1}
+}
+func (m *ConnectionConfig_Http2Settings) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConnectionConfig_Http2Settings.Unmarshal(m, b)
+}
+func (m *ConnectionConfig_Http2Settings) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConnectionConfig_Http2Settings.Marshal(b, m, deterministic)
+}
+func (m *ConnectionConfig_Http2Settings) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConnectionConfig_Http2Settings.Merge(m, src)
+}
+func (m *ConnectionConfig_Http2Settings) XXX_Size() int {
+	return xxx_messageInfo_ConnectionConfig_Http2Settings.Size(m)
+}
+func (m *ConnectionConfig_Http2Settings) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConnectionConfig_Http2Settings.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConnectionConfig_Http2Settings proto.InternalMessageInfo
+
+func (m *ConnectionConfig_Http2Settings) GetMaxConcurrentStreams() *types.UInt32Value {
+	if m != nil {
+		return m.MaxConcurrentStreams
+	}
+	return nil
+}
+
+func (m *ConnectionConfig_Http2Settings) GetInitialStreamWindowSize() *types.UInt32Value {
+	if m != nil {
+		return m.InitialStreamWindowSize
+	}
+	return nil
+}
+
+func (m *ConnectionConfig_Http2Settings) GetInitialConnectionWindowSize() *types.UInt32Value {
+	if m != nil {
+		return m.InitialConnectionWindowSize
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ConnectionConfig)(nil), "gloo.solo.io.ConnectionConfig")
 	proto.RegisterType((*ConnectionConfig_TcpKeepAlive)(nil), "gloo.solo.io.ConnectionConfig.TcpKeepAlive")
+	proto.RegisterType((*ConnectionConfig_Http2Settings)(nil), "gloo.solo.io.ConnectionConfig.Http2Settings")
 }
 
 func init() {
@@ -153,30 +233,40 @@ func init() {
 }
 
 var fileDescriptor_56610fe13cf10c84 = []byte{
-	// 367 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0x4d, 0x4e, 0xdb, 0x40,
-	0x14, 0x96, 0x9b, 0xaa, 0x8b, 0x69, 0xfe, 0x3a, 0xea, 0xc2, 0x4d, 0xa5, 0x34, 0xea, 0x2a, 0x55,
-	0xd5, 0x99, 0xb6, 0x48, 0xec, 0xb2, 0x20, 0x41, 0x08, 0x84, 0x84, 0x22, 0x2b, 0x2b, 0x36, 0x96,
-	0x6d, 0x5e, 0x86, 0x21, 0xb6, 0xdf, 0x30, 0x1e, 0x87, 0x1c, 0x85, 0x23, 0x70, 0x0d, 0x8e, 0xc0,
-	0x09, 0x90, 0x38, 0x09, 0xb2, 0x3d, 0xd8, 0x11, 0x2c, 0xc8, 0xce, 0x6f, 0xbe, 0x9f, 0xf7, 0x7d,
-	0xd6, 0x23, 0x13, 0x21, 0xcd, 0x65, 0x1e, 0xb2, 0x08, 0x13, 0x9e, 0x61, 0x8c, 0x7f, 0x24, 0x72,
-	0x11, 0x23, 0x72, 0xa5, 0xf1, 0x0a, 0x22, 0x93, 0x55, 0x53, 0xa0, 0x24, 0x5f, 0xff, 0xe3, 0x11,
-	0xa6, 0x29, 0x44, 0x46, 0x62, 0xca, 0x94, 0x46, 0x83, 0xb4, 0x5d, 0xa0, 0xac, 0x10, 0x32, 0x89,
-	0x83, 0xaf, 0x02, 0x05, 0x96, 0x00, 0x2f, 0xbe, 0x2a, 0xce, 0x60, 0x28, 0x10, 0x45, 0x0c, 0xbc,
-	0x9c, 0xc2, 0x7c, 0xc9, 0x2f, 0x72, 0x1d, 0x34, 0x1e, 0x6f, 0xf1, 0x1b, 0x1d, 0x28, 0x05, 0x3a,
-	0xab, 0xf0, 0x9f, 0xf7, 0x2d, 0xd2, 0x9f, 0xd5, 0x8b, 0x67, 0x98, 0x2e, 0xa5, 0xa0, 0x13, 0xf2,
-	0x3d, 0x09, 0x36, 0xbe, 0x86, 0xeb, 0x1c, 0x32, 0x93, 0xf9, 0x0a, 0xb4, 0xdf, 0xa4, 0x73, 0x9d,
-	0x91, 0x33, 0xee, 0x78, 0x6e, 0x12, 0x6c, 0x3c, 0xcb, 0x98, 0x83, 0x6e, 0x4c, 0xe8, 0x31, 0xe9,
-	0x59, 0xb6, 0x6f, 0x64, 0x02, 0x98, 0x1b, 0xf7, 0xc3, 0xc8, 0x19, 0x7f, 0xfe, 0xff, 0x8d, 0x55,
-	0x69, 0xd8, 0x4b, 0x1a, 0x76, 0x68, 0xd3, 0x4e, 0x3f, 0xde, 0x3e, 0xfe, 0x70, 0xbc, 0xae, 0xd5,
-	0x2d, 0x2a, 0x19, 0x9d, 0x93, 0x8e, 0x89, 0x94, 0xbf, 0x02, 0x50, 0x41, 0x2c, 0xd7, 0xe0, 0xb6,
-	0x4a, 0x9f, 0xdf, 0x6c, 0xfb, 0xcf, 0xb0, 0xd7, 0xf9, 0xd9, 0x22, 0x52, 0xa7, 0x00, 0xea, 0xa0,
-	0x90, 0x78, 0x6d, 0x53, 0x4d, 0xa5, 0xc1, 0xe0, 0xc1, 0x21, 0xed, 0x6d, 0x98, 0xfe, 0x22, 0xfd,
-	0xda, 0xde, 0x57, 0x1a, 0x43, 0xc8, 0x6c, 0xc1, 0x5e, 0xfd, 0x3e, 0x2f, 0x9f, 0xe9, 0x11, 0xe9,
-	0x36, 0xd4, 0xa2, 0xd9, 0xae, 0xb5, 0x3a, 0xb5, 0xac, 0x28, 0x46, 0xcf, 0x08, 0x6d, 0x7c, 0x64,
-	0x6a, 0x40, 0xaf, 0x83, 0xd8, 0x56, 0x7b, 0xd7, 0xeb, 0x4b, 0x2d, 0x3d, 0xb1, 0xca, 0xe9, 0xfe,
-	0xdd, 0xd3, 0xd0, 0x39, 0xff, 0xbb, 0xdb, 0xb1, 0xa9, 0x95, 0xb0, 0x07, 0x17, 0x7e, 0x2a, 0x77,
-	0xec, 0x3d, 0x07, 0x00, 0x00, 0xff, 0xff, 0x84, 0x69, 0x71, 0xdb, 0xa7, 0x02, 0x00, 0x00,
+	// 516 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xcf, 0x6e, 0xd3, 0x40,
+	0x10, 0xc6, 0xe5, 0xa6, 0xf4, 0xb0, 0xf9, 0xd3, 0xb2, 0xaa, 0xc0, 0xa4, 0x28, 0x54, 0x9c, 0x8a,
+	0x00, 0x1b, 0x52, 0x89, 0x5b, 0x0f, 0x24, 0x08, 0xb5, 0x42, 0x42, 0x91, 0x53, 0x40, 0x70, 0xb1,
+	0x36, 0xce, 0xd4, 0x59, 0x6a, 0xef, 0x2c, 0xbb, 0xeb, 0x24, 0xea, 0x93, 0x70, 0xe5, 0xc6, 0xeb,
+	0xf0, 0x04, 0x48, 0x3c, 0x08, 0x42, 0xb6, 0x37, 0x76, 0x4a, 0x25, 0xc8, 0xcd, 0xe3, 0x99, 0xef,
+	0x37, 0xdf, 0x8c, 0x46, 0x4b, 0x4e, 0x62, 0x6e, 0x66, 0xd9, 0xc4, 0x8b, 0x30, 0xf5, 0x35, 0x26,
+	0xf8, 0x94, 0xa3, 0x1f, 0x27, 0x88, 0xbe, 0x54, 0xf8, 0x19, 0x22, 0xa3, 0xcb, 0x88, 0x49, 0xee,
+	0xcf, 0x9f, 0xfb, 0x11, 0x0a, 0x01, 0x91, 0xe1, 0x28, 0x3c, 0xa9, 0xd0, 0x20, 0x6d, 0xe5, 0x59,
+	0x2f, 0x17, 0x7a, 0x1c, 0xbb, 0xfb, 0x31, 0xc6, 0x58, 0x24, 0xfc, 0xfc, 0xab, 0xac, 0xe9, 0xf6,
+	0x62, 0xc4, 0x38, 0x01, 0xbf, 0x88, 0x26, 0xd9, 0x85, 0x3f, 0xcd, 0x14, 0xab, 0x19, 0x37, 0xf3,
+	0x0b, 0xc5, 0xa4, 0x04, 0xa5, 0xcb, 0xfc, 0xc3, 0xdf, 0x3b, 0x64, 0x6f, 0x58, 0x35, 0x1e, 0xa2,
+	0xb8, 0xe0, 0x31, 0x3d, 0x21, 0x07, 0x29, 0x5b, 0x86, 0x0a, 0xbe, 0x64, 0xa0, 0x8d, 0x0e, 0x25,
+	0xa8, 0xb0, 0x76, 0xe7, 0x3a, 0x87, 0xce, 0x51, 0x3b, 0x70, 0x53, 0xb6, 0x0c, 0x6c, 0xc5, 0x08,
+	0x54, 0x0d, 0xa1, 0xa7, 0x64, 0xd7, 0x56, 0x87, 0x86, 0xa7, 0x80, 0x99, 0x71, 0xb7, 0x0e, 0x9d,
+	0xa3, 0x66, 0xff, 0x9e, 0x57, 0xba, 0xf1, 0x56, 0x6e, 0xbc, 0x57, 0xd6, 0xed, 0x60, 0xfb, 0xeb,
+	0xcf, 0x07, 0x4e, 0xd0, 0xb1, 0xba, 0xf3, 0x52, 0x46, 0x47, 0xa4, 0x6d, 0x22, 0x19, 0x5e, 0x02,
+	0x48, 0x96, 0xf0, 0x39, 0xb8, 0x8d, 0x82, 0xf3, 0xd8, 0x5b, 0xdf, 0x8c, 0xf7, 0xb7, 0x7f, 0xef,
+	0x3c, 0x92, 0x6f, 0x00, 0xe4, 0xcb, 0x5c, 0x12, 0xb4, 0x4c, 0x19, 0x15, 0x00, 0x3a, 0x26, 0x9d,
+	0x99, 0x31, 0xb2, 0x1f, 0x6a, 0x30, 0x86, 0x8b, 0x58, 0xbb, 0xdb, 0x05, 0xf2, 0xc9, 0x7f, 0x90,
+	0xa7, 0xb9, 0x68, 0x6c, 0x35, 0x41, 0x7b, 0xb6, 0x1e, 0xd2, 0x01, 0x69, 0xf1, 0x69, 0x02, 0xd5,
+	0xb4, 0xb7, 0x36, 0x9b, 0xb6, 0x99, 0x8b, 0xec, 0xa8, 0xdd, 0x1f, 0x0e, 0x69, 0xad, 0xfb, 0xa6,
+	0x8f, 0xc8, 0x5e, 0x35, 0x77, 0x28, 0x15, 0x4e, 0x40, 0xdb, 0xcd, 0xef, 0x56, 0xff, 0x47, 0xc5,
+	0x6f, 0xfa, 0x9a, 0x74, 0xea, 0xd2, 0xdc, 0xc4, 0xa6, 0xfb, 0x6e, 0x57, 0xb2, 0xdc, 0x06, 0x7d,
+	0x4b, 0x68, 0xcd, 0xe1, 0xc2, 0x80, 0x9a, 0xb3, 0xc4, 0x6d, 0x6c, 0xc6, 0xba, 0x5d, 0x49, 0xcf,
+	0xac, 0xb2, 0xfb, 0x6d, 0x8b, 0xb4, 0xaf, 0x2d, 0x8e, 0x06, 0xe4, 0x4e, 0x7e, 0x59, 0x11, 0x8a,
+	0x28, 0x53, 0x0a, 0x84, 0x09, 0xb5, 0x51, 0xc0, 0xd2, 0x72, 0xb4, 0x66, 0xff, 0xfe, 0x8d, 0x2e,
+	0xef, 0xce, 0x84, 0x39, 0xee, 0xbf, 0x67, 0x49, 0x06, 0xc1, 0x7e, 0xca, 0x96, 0xc3, 0x4a, 0x3a,
+	0x2e, 0x95, 0xf4, 0x23, 0xe9, 0x72, 0xc1, 0x0d, 0x67, 0x89, 0x85, 0x85, 0x0b, 0x2e, 0xa6, 0xb8,
+	0x08, 0x35, 0xbf, 0x5a, 0x6d, 0xe2, 0xdf, 0xdc, 0xbb, 0x56, 0x5f, 0x12, 0x3f, 0x14, 0xea, 0x31,
+	0xbf, 0x02, 0xca, 0x48, 0x6f, 0x85, 0xae, 0xef, 0xff, 0x1a, 0xbe, 0xb1, 0x01, 0xfe, 0xc0, 0x32,
+	0xea, 0xa3, 0xaa, 0x5b, 0x0c, 0x5e, 0x7c, 0xff, 0xd5, 0x73, 0x3e, 0x3d, 0xdb, 0xec, 0xa5, 0x90,
+	0x97, 0xb1, 0x7d, 0x2d, 0x26, 0x3b, 0x45, 0xab, 0xe3, 0x3f, 0x03, 0x00, 0xd5, 0x2d, 0xcb, 0x56,
+	0x64, 0x04, 0x00, 0x00,
 }
 
 func (this *ConnectionConfig) Equal(that interface{}) bool {
@@ -213,6 +303,18 @@ func (this *ConnectionConfig) Equal(that interface{}) bool {
 	if !this.TcpKeepalive.Equal(that1.TcpKeepalive) {
 		return false
 	}
+	if !this.Http2Settings.Equal(that1.Http2Settings) {
+		return false
+	}
+	if this.IdleTimeout != nil && that1.IdleTimeout != nil {
+		if *this.IdleTimeout != *that1.IdleTimeout {
+			return false
+		}
+	} else if this.IdleTimeout != nil {
+		return false
+	} else if that1.IdleTimeout != nil {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -263,3 +365,36 @@ func (this *ConnectionConfig_TcpKeepAlive) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *ConnectionConfig_Http2Settings) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ConnectionConfig_Http2Settings)
+	if !ok {
+		that2, ok := that.(ConnectionConfig_Http2Settings)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.MaxConcurrentStreams.Equal(that1.MaxConcurrentStreams) {
+		return false
+	}
+	if !this.InitialStreamWindowSize.Equal(that1.InitialStreamWindowSize) {
+		return false
+	}
+	if !this.InitialConnectionWindowSize.Equal(that1.InitialConnectionWindowSize) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
@@ -36,6 +36,9 @@ type Endpoint struct {
 	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
 	// listening port for the endpoint
 	Port uint32 `protobuf:"varint,3,opt,name=port,proto3" json:"port,omitempty"`
+	// The locality of the endpoint, e.g. the zone of the kubernetes node of the pod. The endpoints are grouped by
+	// locality to prioritize and weight the localities with the `loadBalancerConfig` of the upstreams.
+	Locality *Locality `protobuf:"bytes,4,opt,name=locality,proto3" json:"locality,omitempty"`
 	// Metadata contains the object metadata for this resource
 	Metadata             core.Metadata `protobuf:"bytes,7,opt,name=metadata,proto3" json:"metadata"`
 	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
@@ -88,6 +91,13 @@ func (m *Endpoint) GetPort() uint32 {
 	return 0
 }
 
+func (m *Endpoint) GetLocality() *Locality {
+	if m != nil {
+		return m.Locality
+	}
+	return nil
+}
+
 func (m *Endpoint) GetMetadata() core.Metadata {
 	if m != nil {
 		return m.Metadata
@@ -95,8 +105,67 @@ func (m *Endpoint) GetMetadata() core.Metadata {
 	return core.Metadata{}
 }
 
+// Locality identifies where an endpoint runs
+type Locality struct {
+	// The region of the endpoint, e.g. `us-east1`.
+	Region string `protobuf:"bytes,1,opt,name=region,proto3" json:"region,omitempty"`
+	// The zone of the endpoint within its region, e.g. `us-east1-b`.
+	Zone string `protobuf:"bytes,2,opt,name=zone,proto3" json:"zone,omitempty"`
+	// The sub zone of the endpoint within its zone, e.g. a rack.
+	SubZone              string   `protobuf:"bytes,3,opt,name=sub_zone,json=subZone,proto3" json:"sub_zone,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Locality) Reset()         { *m = Locality{} }
+func (m *Locality) String() string { return proto.CompactTextString(m) }
+func (*Locality) ProtoMessage()    {}
+func (*Locality) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7969f9617648787, []int{1}
+}
+func (m *Locality) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Locality.Unmarshal(m, b)
+}
+func (m *Locality) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Locality.Marshal(b, m, deterministic)
+}
+func (m *Locality) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Locality.Merge(m, src)
+}
+func (m *Locality) XXX_Size() int {
+	return xxx_messageInfo_Locality.Size(m)
+}
+func (m *Locality) XXX_DiscardUnknown() {
+	xxx_messageInfo_Locality.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Locality proto.InternalMessageInfo
+
+func (m *Locality) GetRegion() string {
+	if m != nil {
+		return m.Region
+	}
+	return ""
+}
+
+func (m *Locality) GetZone() string {
+	if m != nil {
+		return m.Zone
+	}
+	return ""
+}
+
+func (m *Locality) GetSubZone() string {
+	if m != nil {
+		return m.SubZone
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Endpoint)(nil), "gloo.solo.io.Endpoint")
+	proto.RegisterType((*Locality)(nil), "gloo.solo.io.Locality")
 }
 
 func init() {
@@ -104,24 +173,28 @@ func init() {
 }
 
 var fileDescriptor_f7969f9617648787 = []byte{
-	// 269 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x90, 0x3d, 0x4e, 0x03, 0x31,
-	0x10, 0x85, 0x31, 0x89, 0x48, 0xe2, 0x40, 0x63, 0x21, 0x64, 0x52, 0xc0, 0x8a, 0x6a, 0x0b, 0xb0,
-	0x49, 0x90, 0x00, 0x89, 0x2e, 0x12, 0x25, 0x8d, 0x4b, 0x3a, 0x67, 0x77, 0x62, 0x4c, 0xb2, 0x19,
-	0xcb, 0xf6, 0x72, 0x26, 0x24, 0x2e, 0xc2, 0x29, 0x28, 0x38, 0x09, 0xda, 0x3f, 0x10, 0x52, 0x8a,
-	0x54, 0x9e, 0xd1, 0xfb, 0x9e, 0xe7, 0xcd, 0xd0, 0x07, 0x63, 0xe3, 0x4b, 0xb9, 0x10, 0x19, 0x16,
-	0x32, 0xe0, 0x1a, 0xaf, 0x2c, 0x4a, 0xb3, 0x46, 0x94, 0xce, 0xe3, 0x2b, 0x64, 0x31, 0x34, 0x9d,
-	0x76, 0x56, 0xbe, 0x4d, 0x25, 0x6c, 0x72, 0x87, 0x76, 0x13, 0x85, 0xf3, 0x18, 0x91, 0x1d, 0x56,
-	0x9a, 0xa8, 0x6c, 0xc2, 0xe2, 0xe4, 0xd8, 0xa0, 0xc1, 0x5a, 0x90, 0x55, 0xd5, 0x30, 0x93, 0xe9,
-	0x96, 0x01, 0xf5, 0xbb, 0xb2, 0xb1, 0xfb, 0xb6, 0x80, 0xa8, 0x73, 0x1d, 0x75, 0x6b, 0xb9, 0xdc,
-	0xc1, 0xe2, 0x61, 0xd9, 0xd0, 0x17, 0x1f, 0x84, 0x0e, 0x1f, 0xdb, 0x5c, 0xec, 0x8e, 0x8e, 0x4a,
-	0x17, 0xa2, 0x07, 0x5d, 0x04, 0x4e, 0x92, 0x5e, 0x3a, 0x9e, 0x9d, 0x8a, 0x0c, 0x3d, 0x74, 0x29,
-	0x85, 0x82, 0x80, 0xa5, 0xcf, 0x40, 0xc1, 0x52, 0xfd, 0xb1, 0x8c, 0xd3, 0x81, 0xce, 0x73, 0x0f,
-	0x21, 0xf0, 0xfd, 0x84, 0xa4, 0x23, 0xd5, 0xb5, 0x8c, 0xd1, 0xbe, 0x43, 0x1f, 0x79, 0x2f, 0x21,
-	0xe9, 0x91, 0xaa, 0x6b, 0x76, 0x4f, 0x87, 0x5d, 0x66, 0x3e, 0x48, 0x48, 0x3a, 0x9e, 0x9d, 0xfc,
-	0x9f, 0xf2, 0xd4, 0xaa, 0xf3, 0xfe, 0xe7, 0xd7, 0xf9, 0x9e, 0xfa, 0xa5, 0xe7, 0xb7, 0xef, 0xdf,
-	0x67, 0xe4, 0xf9, 0x7a, 0xb7, 0xab, 0xbb, 0x95, 0x69, 0xf7, 0x5d, 0x1c, 0xd4, 0xcb, 0xde, 0xfc,
-	0x04, 0x00, 0x00, 0xff, 0xff, 0xd5, 0xbd, 0x46, 0xf1, 0xb0, 0x01, 0x00, 0x00,
+	// 332 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x51, 0xb1, 0x4e, 0xeb, 0x30,
+	0x14, 0x7d, 0x79, 0xad, 0xda, 0xd4, 0x85, 0xc5, 0x42, 0x55, 0xda, 0x01, 0xa2, 0x4e, 0x19, 0xc0,
+	0xa1, 0x45, 0x02, 0x24, 0xb6, 0x4a, 0x6c, 0x30, 0xe0, 0xb1, 0x0b, 0x72, 0x12, 0x37, 0x98, 0xa6,
+	0xb9, 0x96, 0xed, 0x20, 0xc1, 0x17, 0xf1, 0x29, 0x7c, 0x05, 0x48, 0x7c, 0x09, 0x8a, 0xe3, 0x14,
+	0x90, 0x18, 0x3a, 0xf9, 0x5e, 0x9f, 0x73, 0xae, 0xcf, 0x3d, 0x46, 0x57, 0xb9, 0x30, 0x0f, 0x55,
+	0x42, 0x52, 0xd8, 0xc4, 0x1a, 0x0a, 0x38, 0x11, 0x10, 0xe7, 0x05, 0x40, 0x2c, 0x15, 0x3c, 0xf2,
+	0xd4, 0xe8, 0xa6, 0x63, 0x52, 0xc4, 0x4f, 0xb3, 0x98, 0x97, 0x99, 0x04, 0x51, 0x1a, 0x22, 0x15,
+	0x18, 0xc0, 0x7b, 0x35, 0x46, 0x6a, 0x19, 0x11, 0x30, 0x39, 0xc8, 0x21, 0x07, 0x0b, 0xc4, 0x75,
+	0xd5, 0x70, 0x26, 0xb3, 0x3f, 0x1e, 0xb0, 0xe7, 0x5a, 0x98, 0x76, 0xec, 0x86, 0x1b, 0x96, 0x31,
+	0xc3, 0x9c, 0xe4, 0x78, 0x07, 0x89, 0xe2, 0xab, 0x86, 0x3d, 0xfd, 0xf0, 0x90, 0x7f, 0xed, 0x7c,
+	0xe1, 0x0b, 0x34, 0xa8, 0xa4, 0x36, 0x8a, 0xb3, 0x8d, 0x0e, 0xbc, 0xb0, 0x13, 0x0d, 0xe7, 0x63,
+	0x92, 0x82, 0xe2, 0xad, 0x4b, 0x42, 0xb9, 0x86, 0x4a, 0xa5, 0x9c, 0xf2, 0x15, 0xfd, 0xe6, 0xe2,
+	0x00, 0xf5, 0x59, 0x96, 0x29, 0xae, 0x75, 0xf0, 0x3f, 0xf4, 0xa2, 0x01, 0x6d, 0x5b, 0x8c, 0x51,
+	0x57, 0x82, 0x32, 0x41, 0x27, 0xf4, 0xa2, 0x7d, 0x6a, 0x6b, 0x3c, 0x47, 0x7e, 0x01, 0x29, 0x2b,
+	0x84, 0x79, 0x0e, 0xba, 0xa1, 0x17, 0x0d, 0xe7, 0x23, 0xf2, 0x33, 0x0b, 0x72, 0xe3, 0x50, 0xba,
+	0xe5, 0xe1, 0x4b, 0xe4, 0xb7, 0x7b, 0x06, 0x7d, 0xa7, 0xf9, 0xe5, 0xec, 0xd6, 0xa1, 0x8b, 0xee,
+	0xdb, 0xfb, 0xd1, 0x3f, 0xba, 0x65, 0x4f, 0xef, 0x90, 0xdf, 0xce, 0xc3, 0x23, 0xd4, 0x53, 0x3c,
+	0x17, 0x50, 0x06, 0x9e, 0xb5, 0xe9, 0xba, 0xda, 0xe5, 0x0b, 0x94, 0xdc, 0x99, 0xb7, 0x35, 0x1e,
+	0x23, 0x5f, 0x57, 0xc9, 0xbd, 0xbd, 0xef, 0x34, 0x4b, 0xe9, 0x2a, 0x59, 0x42, 0xc9, 0x17, 0xe7,
+	0xaf, 0x9f, 0x87, 0xde, 0xf2, 0x74, 0xb7, 0xcf, 0x97, 0xeb, 0xdc, 0xc5, 0x9e, 0xf4, 0x6c, 0xe6,
+	0x67, 0x5f, 0x03, 0x00, 0x0c, 0xb9, 0xd8, 0x89, 0x37, 0x02, 0x00, 0x00,
 }
 
 func (this *Endpoint) Equal(that interface{}) bool {
@@ -157,6 +230,9 @@ func (this *Endpoint) Equal(that interface{}) bool {
 	if this.Port != that1.Port {
 		return false
 	}
+	if !this.Locality.Equal(that1.Locality) {
+		return false
+	}
 	if !this.Metadata.Equal(&that1.Metadata) {
 		return false
 	}
@@ -165,3 +241,36 @@ func (this *Endpoint) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *Locality) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Locality)
+	if !ok {
+		that2, ok := that.(Locality)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Region != that1.Region {
+		return false
+	}
+	if this.Zone != that1.Zone {
+		return false
+	}
+	if this.SubZone != that1.SubZone {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
@@ -35,6 +35,7 @@ func (r *Endpoint) Hash() uint64 {
 		r.Upstreams,
 		r.Address,
 		r.Port,
+		r.Locality,
 	)
 }
 
@@ -53,6 +53,14 @@ func (us *UpstreamSpec_Consul) SetServiceSpec(spec *plugins.ServiceSpec) {
 	us.Consul.ServiceSpec = spec
 }
 
+func (us *UpstreamSpec_Nomad) GetServiceSpec() *plugins.ServiceSpec {
+	return us.Nomad.ServiceSpec
+}
+
+func (us *UpstreamSpec_Nomad) SetServiceSpec(spec *plugins.ServiceSpec) {
+	us.Nomad.ServiceSpec = spec
+}
+
 func (us *UpstreamSpec_Kube) GetSubsetSpec() *plugins.SubsetSpec {
 	return us.Kube.SubsetSpec
 }
@@ -0,0 +1,693 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: github.com/solo-io/gloo/projects/gloo/api/v1/health_check.proto
+
+package v1
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	math "math"
+	time "time"
+
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	types "github.com/gogo/protobuf/types"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+// HealthCheck configures envoy to actively check the health of the endpoints of an upstream, and to stop sending
+// requests to the endpoints that fail the checks.
+// see more info [here](https://www.envoyproxy.io/docs/envoy/latest/api-v2/api/v2/core/health_check.proto).
+// An upstream with a single endpoint, e.g. an AWS region, is only ejected when the `healthyPanicThreshold` of its
+// `loadBalancerConfig` is 0, as envoy otherwise disregards the health of the hosts when all of them are unhealthy.
+type HealthCheck struct {
+	// The time to wait for a health check response. Required.
+	Timeout *time.Duration `protobuf:"bytes,1,opt,name=timeout,proto3,stdduration" json:"timeout,omitempty"`
+	// The interval between health checks. Required.
+	Interval *time.Duration `protobuf:"bytes,2,opt,name=interval,proto3,stdduration" json:"interval,omitempty"`
+	// A random time up to this jitter is added to each interval.
+	IntervalJitter *time.Duration `protobuf:"bytes,3,opt,name=interval_jitter,json=intervalJitter,proto3,stdduration" json:"interval_jitter,omitempty"`
+	// The number of failed health checks before a host is marked unhealthy. Defaults to 1.
+	UnhealthyThreshold *types.UInt32Value `protobuf:"bytes,4,opt,name=unhealthy_threshold,json=unhealthyThreshold,proto3" json:"unhealthy_threshold,omitempty"`
+	// The number of successful health checks before a host is marked healthy. Defaults to 1.
+	HealthyThreshold *types.UInt32Value `protobuf:"bytes,5,opt,name=healthy_threshold,json=healthyThreshold,proto3" json:"healthy_threshold,omitempty"`
+	// The kind of health check. Required.
+	//
+	// Types that are valid to be assigned to HealthChecker:
+	//	*HealthCheck_HttpHealthCheck_
+	//	*HealthCheck_TcpHealthCheck_
+	//	*HealthCheck_GrpcHealthCheck_
+	HealthChecker        isHealthCheck_HealthChecker `protobuf_oneof:"health_checker"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
+}
+
+func (m *HealthCheck) Reset()         { *m = HealthCheck{} }
+func (m *HealthCheck) String() string { return proto.CompactTextString(m) }
+func (*HealthCheck) ProtoMessage()    {}
+func (*HealthCheck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17ca67440953dae5, []int{0}
+}
+func (m *HealthCheck) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HealthCheck.Unmarshal(m, b)
+}
+func (m *HealthCheck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HealthCheck.Marshal(b, m, deterministic)
+}
+func (m *HealthCheck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HealthCheck.Merge(m, src)
+}
+func (m *HealthCheck) XXX_Size() int {
+	return xxx_messageInfo_HealthCheck.Size(m)
+}
+func (m *HealthCheck) XXX_DiscardUnknown() {
+	xxx_messageInfo_HealthCheck.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HealthCheck proto.InternalMessageInfo
+
+type isHealthCheck_HealthChecker interface {
+	isHealthCheck_HealthChecker()
+	Equal(interface{}) bool
+}
+
+type HealthCheck_HttpHealthCheck_ struct {
+	HttpHealthCheck *HealthCheck_HttpHealthCheck `protobuf:"bytes,6,opt,name=http_health_check,json=httpHealthCheck,proto3,oneof"`
+}
+type HealthCheck_TcpHealthCheck_ struct {
+	TcpHealthCheck *HealthCheck_TcpHealthCheck `protobuf:"bytes,7,opt,name=tcp_health_check,json=tcpHealthCheck,proto3,oneof"`
+}
+type HealthCheck_GrpcHealthCheck_ struct {
+	GrpcHealthCheck *HealthCheck_GrpcHealthCheck `protobuf:"bytes,8,opt,name=grpc_health_check,json=grpcHealthCheck,proto3,oneof"`
+}
+
+func (*HealthCheck_HttpHealthCheck_) isHealthCheck_HealthChecker() {}
+func (*HealthCheck_TcpHealthCheck_) isHealthCheck_HealthChecker()  {}
+func (*HealthCheck_GrpcHealthCheck_) isHealthCheck_HealthChecker() {}
+
+func (m *HealthCheck) GetHealthChecker() isHealthCheck_HealthChecker {
+	if m != nil {
+		return m.HealthChecker
+	}
+	return nil
+}
+
+func (m *HealthCheck) GetTimeout() *time.Duration {
+	if m != nil {
+		return m.Timeout
+	}
+	return nil
+}
+
+func (m *HealthCheck) GetInterval() *time.Duration {
+	if m != nil {
+		return m.Interval
+	}
+	return nil
+}
+
+func (m *HealthCheck) GetIntervalJitter() *time.Duration {
+	if m != nil {
+		return m.IntervalJitter
+	}
+	return nil
+}
+
+func (m *HealthCheck) GetUnhealthyThreshold() *types.UInt32Value {
+	if m != nil {
+		return m.UnhealthyThreshold
+	}
+	return nil
+}
+
+func (m *HealthCheck) GetHealthyThreshold() *types.UInt32Value {
+	if m != nil {
+		return m.HealthyThreshold
+	}
+	return nil
+}
+
+func (m *HealthCheck) GetHttpHealthCheck() *HealthCheck_HttpHealthCheck {
+	if x, ok := m.GetHealthChecker().(*HealthCheck_HttpHealthCheck_); ok {
+		return x.HttpHealthCheck
+	}
+	return nil
+}
+
+func (m *HealthCheck) GetTcpHealthCheck() *HealthCheck_TcpHealthCheck {
+	if x, ok := m.GetHealthChecker().(*HealthCheck_TcpHealthCheck_); ok {
+		return x.TcpHealthCheck
+	}
+	return nil
+}
+
+func (m *HealthCheck) GetGrpcHealthCheck() *HealthCheck_GrpcHealthCheck {
+	if x, ok := m.GetHealthChecker().(*HealthCheck_GrpcHealthCheck_); ok {
+		return x.GrpcHealthCheck
+	}
+	return nil
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*HealthCheck) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _HealthCheck_OneofMarshaler, _HealthCheck_OneofUnmarshaler, _HealthCheck_OneofSizer, []interface{}{
+		(*HealthCheck_HttpHealthCheck_)(nil),
+		(*HealthCheck_TcpHealthCheck_)(nil),
+		(*HealthCheck_GrpcHealthCheck_)(nil),
+	}
+}
+
+func _HealthCheck_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*HealthCheck)
+	// health_checker
+	switch x := m.HealthChecker.(type) {
+	case *HealthCheck_HttpHealthCheck_:
+		_ = b.EncodeVarint(6<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.HttpHealthCheck); err != nil {
+			return err
+		}
+	case *HealthCheck_TcpHealthCheck_:
+		_ = b.EncodeVarint(7<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.TcpHealthCheck); err != nil {
+			return err
+		}
+	case *HealthCheck_GrpcHealthCheck_:
+		_ = b.EncodeVarint(8<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.GrpcHealthCheck); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("HealthCheck.HealthChecker has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _HealthCheck_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*HealthCheck)
+	switch tag {
+	case 6: // health_checker.http_health_check
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(HealthCheck_HttpHealthCheck)
+		err := b.DecodeMessage(msg)
+		m.HealthChecker = &HealthCheck_HttpHealthCheck_{msg}
+		return true, err
+	case 7: // health_checker.tcp_health_check
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(HealthCheck_TcpHealthCheck)
+		err := b.DecodeMessage(msg)
+		m.HealthChecker = &HealthCheck_TcpHealthCheck_{msg}
+		return true, err
+	case 8: // health_checker.grpc_health_check
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(HealthCheck_GrpcHealthCheck)
+		err := b.DecodeMessage(msg)
+		m.HealthChecker = &HealthCheck_GrpcHealthCheck_{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _HealthCheck_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*HealthCheck)
+	// health_checker
+	switch x := m.HealthChecker.(type) {
+	case *HealthCheck_HttpHealthCheck_:
+		s := proto.Size(x.HttpHealthCheck)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *HealthCheck_TcpHealthCheck_:
+		s := proto.Size(x.TcpHealthCheck)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *HealthCheck_GrpcHealthCheck_:
+		s := proto.Size(x.GrpcHealthCheck)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+type HealthCheck_HttpHealthCheck struct {
+	// The host header of the health check requests. Defaults to the hostname of the upstream when it has a
+	// single hostname, e.g. the function upstreams, and to the name of the envoy cluster otherwise.
+	Host string `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	// The path of the health check requests. Required.
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	// Send the health check requests with HTTP/2.
+	UseHttp2             bool     `protobuf:"varint,3,opt,name=use_http2,json=useHttp2,proto3" json:"use_http2,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HealthCheck_HttpHealthCheck) Reset()         { *m = HealthCheck_HttpHealthCheck{} }
+func (m *HealthCheck_HttpHealthCheck) String() string { return proto.CompactTextString(m) }
+func (*HealthCheck_HttpHealthCheck) ProtoMessage()    {}
+func (*HealthCheck_HttpHealthCheck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17ca67440953dae5, []int{0, 0}
+}
+func (m *HealthCheck_HttpHealthCheck) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HealthCheck_HttpHealthCheck.Unmarshal(m, b)
+}
+func (m *HealthCheck_HttpHealthCheck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HealthCheck_HttpHealthCheck.Marshal(b, m, deterministic)
+}
+func (m *HealthCheck_HttpHealthCheck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HealthCheck_HttpHealthCheck.Merge(m, src)
+}
+func (m *HealthCheck_HttpHealthCheck) XXX_Size() int {
+	return xxx_messageInfo_HealthCheck_HttpHealthCheck.Size(m)
+}
+func (m *HealthCheck_HttpHealthCheck) XXX_DiscardUnknown() {
+	xxx_messageInfo_HealthCheck_HttpHealthCheck.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HealthCheck_HttpHealthCheck proto.InternalMessageInfo
+
+func (m *HealthCheck_HttpHealthCheck) GetHost() string {
+	if m != nil {
+		return m.Host
+	}
+	return ""
+}
+
+func (m *HealthCheck_HttpHealthCheck) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *HealthCheck_HttpHealthCheck) GetUseHttp2() bool {
+	if m != nil {
+		return m.UseHttp2
+	}
+	return false
+}
+
+type HealthCheck_TcpHealthCheck struct {
+	// The hex encoded payload to send. Only connects when empty.
+	Send string `protobuf:"bytes,1,opt,name=send,proto3" json:"send,omitempty"`
+	// The hex encoded payloads that must be found in the response, in order.
+	Receive              []string `protobuf:"bytes,2,rep,name=receive,proto3" json:"receive,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HealthCheck_TcpHealthCheck) Reset()         { *m = HealthCheck_TcpHealthCheck{} }
+func (m *HealthCheck_TcpHealthCheck) String() string { return proto.CompactTextString(m) }
+func (*HealthCheck_TcpHealthCheck) ProtoMessage()    {}
+func (*HealthCheck_TcpHealthCheck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17ca67440953dae5, []int{0, 1}
+}
+func (m *HealthCheck_TcpHealthCheck) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HealthCheck_TcpHealthCheck.Unmarshal(m, b)
+}
+func (m *HealthCheck_TcpHealthCheck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HealthCheck_TcpHealthCheck.Marshal(b, m, deterministic)
+}
+func (m *HealthCheck_TcpHealthCheck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HealthCheck_TcpHealthCheck.Merge(m, src)
+}
+func (m *HealthCheck_TcpHealthCheck) XXX_Size() int {
+	return xxx_messageInfo_HealthCheck_TcpHealthCheck.Size(m)
+}
+func (m *HealthCheck_TcpHealthCheck) XXX_DiscardUnknown() {
+	xxx_messageInfo_HealthCheck_TcpHealthCheck.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HealthCheck_TcpHealthCheck proto.InternalMessageInfo
+
+func (m *HealthCheck_TcpHealthCheck) GetSend() string {
+	if m != nil {
+		return m.Send
+	}
+	return ""
+}
+
+func (m *HealthCheck_TcpHealthCheck) GetReceive() []string {
+	if m != nil {
+		return m.Receive
+	}
+	return nil
+}
+
+type HealthCheck_GrpcHealthCheck struct {
+	// The service name of the health check requests, checks the server when empty.
+	// see more info [here](https://github.com/grpc/grpc/blob/master/doc/health-checking.md).
+	ServiceName string `protobuf:"bytes,1,opt,name=service_name,json=serviceName,proto3" json:"service_name,omitempty"`
+	// The authority header of the health check requests. Defaults to the name of the envoy cluster.
+	Authority            string   `protobuf:"bytes,2,opt,name=authority,proto3" json:"authority,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HealthCheck_GrpcHealthCheck) Reset()         { *m = HealthCheck_GrpcHealthCheck{} }
+func (m *HealthCheck_GrpcHealthCheck) String() string { return proto.CompactTextString(m) }
+func (*HealthCheck_GrpcHealthCheck) ProtoMessage()    {}
+func (*HealthCheck_GrpcHealthCheck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17ca67440953dae5, []int{0, 2}
+}
+func (m *HealthCheck_GrpcHealthCheck) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HealthCheck_GrpcHealthCheck.Unmarshal(m, b)
+}
+func (m *HealthCheck_GrpcHealthCheck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HealthCheck_GrpcHealthCheck.Marshal(b, m, deterministic)
+}
+func (m *HealthCheck_GrpcHealthCheck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HealthCheck_GrpcHealthCheck.Merge(m, src)
+}
+func (m *HealthCheck_GrpcHealthCheck) XXX_Size() int {
+	return xxx_messageInfo_HealthCheck_GrpcHealthCheck.Size(m)
+}
+func (m *HealthCheck_GrpcHealthCheck) XXX_DiscardUnknown() {
+	xxx_messageInfo_HealthCheck_GrpcHealthCheck.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HealthCheck_GrpcHealthCheck proto.InternalMessageInfo
+
+func (m *HealthCheck_GrpcHealthCheck) GetServiceName() string {
+	if m != nil {
+		return m.ServiceName
+	}
+	return ""
+}
+
+func (m *HealthCheck_GrpcHealthCheck) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*HealthCheck)(nil), "gloo.solo.io.HealthCheck")
+	proto.RegisterType((*HealthCheck_HttpHealthCheck)(nil), "gloo.solo.io.HealthCheck.HttpHealthCheck")
+	proto.RegisterType((*HealthCheck_TcpHealthCheck)(nil), "gloo.solo.io.HealthCheck.TcpHealthCheck")
+	proto.RegisterType((*HealthCheck_GrpcHealthCheck)(nil), "gloo.solo.io.HealthCheck.GrpcHealthCheck")
+}
+
+func init() {
+	proto.RegisterFile("github.com/solo-io/gloo/projects/gloo/api/v1/health_check.proto", fileDescriptor_17ca67440953dae5)
+}
+
+var fileDescriptor_17ca67440953dae5 = []byte{
+	// 503 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xdf, 0x6e, 0xd3, 0x30,
+	0x14, 0xc6, 0xe9, 0x56, 0xfa, 0xc7, 0x9d, 0xda, 0xce, 0x70, 0x11, 0xca, 0x34, 0x06, 0x57, 0xe5,
+	0x82, 0x04, 0x3a, 0x09, 0x09, 0x21, 0x81, 0x54, 0x90, 0xe8, 0x90, 0xe0, 0x22, 0x2a, 0x43, 0xe2,
+	0x26, 0x72, 0xdd, 0x43, 0xec, 0x2d, 0x8d, 0x2d, 0xe7, 0xa4, 0x68, 0x6f, 0xc2, 0x23, 0xf0, 0x56,
+	0x48, 0xbc, 0x00, 0xaf, 0x80, 0xec, 0x34, 0xa3, 0x4d, 0x35, 0xad, 0x77, 0xe7, 0x7c, 0xc7, 0xdf,
+	0xcf, 0xc7, 0xf6, 0x91, 0xc9, 0xdb, 0x58, 0xa2, 0xc8, 0x67, 0x3e, 0x57, 0x8b, 0x20, 0x53, 0x89,
+	0x7a, 0x26, 0x55, 0x10, 0x27, 0x4a, 0x05, 0xda, 0xa8, 0x0b, 0xe0, 0x98, 0x15, 0x19, 0xd3, 0x32,
+	0x58, 0xbe, 0x08, 0x04, 0xb0, 0x04, 0x45, 0xc4, 0x05, 0xf0, 0x4b, 0x5f, 0x1b, 0x85, 0x8a, 0x1e,
+	0xd8, 0xba, 0x6f, 0xad, 0xbe, 0x54, 0x83, 0xfb, 0xb1, 0x8a, 0x95, 0x2b, 0x04, 0x36, 0x2a, 0xd6,
+	0x0c, 0x8e, 0x63, 0xa5, 0xe2, 0x04, 0x02, 0x97, 0xcd, 0xf2, 0xef, 0xc1, 0x3c, 0x37, 0x0c, 0xa5,
+	0x4a, 0x6f, 0xaa, 0xff, 0x30, 0x4c, 0x6b, 0x30, 0x59, 0x51, 0x7f, 0xf2, 0xb7, 0x41, 0x3a, 0x13,
+	0xb7, 0xf5, 0x3b, 0xbb, 0x33, 0x7d, 0x45, 0x9a, 0x28, 0x17, 0xa0, 0x72, 0xf4, 0x6a, 0x27, 0xb5,
+	0x61, 0x67, 0xf4, 0xc0, 0x2f, 0x08, 0x7e, 0x49, 0xf0, 0xdf, 0xaf, 0x76, 0x18, 0xd7, 0x7f, 0xfe,
+	0x7e, 0x54, 0x0b, 0xcb, 0xf5, 0xf4, 0x35, 0x69, 0xc9, 0x14, 0xc1, 0x2c, 0x59, 0xe2, 0xed, 0xed,
+	0xe6, 0xbd, 0x36, 0xd0, 0x09, 0xe9, 0x95, 0x71, 0x74, 0x21, 0x11, 0xc1, 0x78, 0xfb, 0xbb, 0x31,
+	0xba, 0xa5, 0xef, 0xa3, 0xb3, 0xd1, 0x4f, 0xe4, 0x5e, 0x9e, 0x16, 0xb7, 0x79, 0x15, 0xa1, 0x30,
+	0x90, 0x09, 0x95, 0xcc, 0xbd, 0xba, 0xa3, 0x1d, 0x6d, 0xd1, 0xbe, 0x9c, 0xa5, 0x78, 0x3a, 0x3a,
+	0x67, 0x49, 0x0e, 0x21, 0xbd, 0x36, 0x4e, 0x4b, 0x1f, 0x3d, 0x23, 0x87, 0xdb, 0xb0, 0xbb, 0x3b,
+	0xc0, 0xfa, 0x5b, 0xa8, 0xaf, 0xe4, 0x50, 0x20, 0xea, 0x68, 0xfd, 0xa9, 0xbd, 0x86, 0x43, 0x3d,
+	0xf5, 0xd7, 0xdf, 0xda, 0x5f, 0x7b, 0x11, 0x7f, 0x82, 0xa8, 0xd7, 0xf2, 0xc9, 0x9d, 0xb0, 0x27,
+	0x36, 0x25, 0x3a, 0x25, 0x7d, 0xe4, 0x15, 0x6e, 0xd3, 0x71, 0x87, 0x37, 0x73, 0xa7, 0xbc, 0x82,
+	0xed, 0xe2, 0x86, 0x62, 0xdb, 0x8d, 0x8d, 0xe6, 0x9b, 0xd8, 0xd6, 0x6d, 0xed, 0x7e, 0x30, 0x9a,
+	0x57, 0xda, 0x8d, 0x37, 0xa5, 0xc1, 0x39, 0xe9, 0x55, 0x0e, 0x45, 0x29, 0xa9, 0x0b, 0x95, 0x15,
+	0x33, 0xd7, 0x0e, 0x5d, 0x6c, 0x35, 0xcd, 0x50, 0xb8, 0x59, 0x6a, 0x87, 0x2e, 0xa6, 0x0f, 0x49,
+	0x3b, 0xcf, 0x20, 0xb2, 0x17, 0x30, 0x72, 0x03, 0xd2, 0x0a, 0x5b, 0x79, 0x06, 0x16, 0x37, 0x1a,
+	0xbc, 0x21, 0xdd, 0xcd, 0x43, 0x59, 0x44, 0x06, 0xe9, 0xbc, 0xc4, 0xda, 0x98, 0x7a, 0xa4, 0x69,
+	0x80, 0x83, 0x5c, 0x82, 0xb7, 0x77, 0xb2, 0x3f, 0x6c, 0x87, 0x65, 0x3a, 0x08, 0x49, 0xaf, 0xd2,
+	0x3d, 0x7d, 0x4c, 0x0e, 0x32, 0x30, 0x4b, 0xc9, 0x21, 0x4a, 0xd9, 0x02, 0x56, 0xa0, 0xce, 0x4a,
+	0xfb, 0xcc, 0x16, 0x40, 0x8f, 0x48, 0x9b, 0xe5, 0x28, 0x94, 0x91, 0x78, 0xb5, 0xea, 0xf5, 0xbf,
+	0x30, 0xee, 0x93, 0xee, 0xfa, 0xfd, 0x81, 0x19, 0xbf, 0xfc, 0xf5, 0xe7, 0xb8, 0xf6, 0xed, 0xf9,
+	0x6e, 0x9f, 0x83, 0xbe, 0x8c, 0x57, 0x1f, 0xc4, 0xac, 0xe1, 0xa6, 0xec, 0xf4, 0xdf, 0x00, 0x70,
+	0x62, 0x5d, 0xd4, 0x57, 0x04, 0x00, 0x00,
+}
+
+func (this *HealthCheck) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*HealthCheck)
+	if !ok {
+		that2, ok := that.(HealthCheck)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Timeout != nil && that1.Timeout != nil {
+		if *this.Timeout != *that1.Timeout {
+			return false
+		}
+	} else if this.Timeout != nil {
+		return false
+	} else if that1.Timeout != nil {
+		return false
+	}
+	if this.Interval != nil && that1.Interval != nil {
+		if *this.Interval != *that1.Interval {
+			return false
+		}
+	} else if this.Interval != nil {
+		return false
+	} else if that1.Interval != nil {
+		return false
+	}
+	if this.IntervalJitter != nil && that1.IntervalJitter != nil {
+		if *this.IntervalJitter != *that1.IntervalJitter {
+			return false
+		}
+	} else if this.IntervalJitter != nil {
+		return false
+	} else if that1.IntervalJitter != nil {
+		return false
+	}
+	if !this.UnhealthyThreshold.Equal(that1.UnhealthyThreshold) {
+		return false
+	}
+	if !this.HealthyThreshold.Equal(that1.HealthyThreshold) {
+		return false
+	}
+	if that1.HealthChecker == nil {
+		if this.HealthChecker != nil {
+			return false
+		}
+	} else if this.HealthChecker == nil {
+		return false
+	} else if !this.HealthChecker.Equal(that1.HealthChecker) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *HealthCheck_HttpHealthCheck_) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*HealthCheck_HttpHealthCheck_)
+	if !ok {
+		that2, ok := that.(HealthCheck_HttpHealthCheck_)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.HttpHealthCheck.Equal(that1.HttpHealthCheck) {
+		return false
+	}
+	return true
+}
+func (this *HealthCheck_TcpHealthCheck_) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*HealthCheck_TcpHealthCheck_)
+	if !ok {
+		that2, ok := that.(HealthCheck_TcpHealthCheck_)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.TcpHealthCheck.Equal(that1.TcpHealthCheck) {
+		return false
+	}
+	return true
+}
+func (this *HealthCheck_GrpcHealthCheck_) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*HealthCheck_GrpcHealthCheck_)
+	if !ok {
+		that2, ok := that.(HealthCheck_GrpcHealthCheck_)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.GrpcHealthCheck.Equal(that1.GrpcHealthCheck) {
+		return false
+	}
+	return true
+}
+func (this *HealthCheck_HttpHealthCheck) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*HealthCheck_HttpHealthCheck)
+	if !ok {
+		that2, ok := that.(HealthCheck_HttpHealthCheck)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Host != that1.Host {
+		return false
+	}
+	if this.Path != that1.Path {
+		return false
+	}
+	if this.UseHttp2 != that1.UseHttp2 {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *HealthCheck_TcpHealthCheck) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*HealthCheck_TcpHealthCheck)
+	if !ok {
+		that2, ok := that.(HealthCheck_TcpHealthCheck)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Send != that1.Send {
+		return false
+	}
+	if len(this.Receive) != len(that1.Receive) {
+		return false
+	}
+	for i := range this.Receive {
+		if this.Receive[i] != that1.Receive[i] {
+			return false
+		}
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *HealthCheck_GrpcHealthCheck) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*HealthCheck_GrpcHealthCheck)
+	if !ok {
+		that2, ok := that.(HealthCheck_GrpcHealthCheck)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ServiceName != that1.ServiceName {
+		return false
+	}
+	if this.Authority != that1.Authority {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
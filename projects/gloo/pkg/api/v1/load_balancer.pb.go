@@ -41,10 +41,20 @@ type LoadBalancerConfig struct {
 	//	*LoadBalancerConfig_RoundRobin_
 	//	*LoadBalancerConfig_LeastRequest_
 	//	*LoadBalancerConfig_Random_
-	Type                 isLoadBalancerConfig_Type `protobuf_oneof:"type"`
-	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
-	XXX_unrecognized     []byte                    `json:"-"`
-	XXX_sizecache        int32                     `json:"-"`
+	//	*LoadBalancerConfig_RingHash_
+	//	*LoadBalancerConfig_Maglev_
+	Type isLoadBalancerConfig_Type `protobuf_oneof:"type"`
+	// The priority and weight of the endpoints in the localities matching a locality of the list. The endpoints of
+	// the first priority receive the requests while enough of them are healthy, the requests fail over to the endpoints
+	// of the next priorities otherwise. The endpoints whose locality matches none of the list have the lowest priority,
+	// so that listing the zone of the proxies first makes it prefer the endpoints of the same zone.
+	LocalityPriorities []*LoadBalancerConfig_LocalityPriority `protobuf:"bytes,8,rep,name=locality_priorities,json=localityPriorities,proto3" json:"locality_priorities,omitempty"`
+	// Balance the requests between the localities of the same priority by the weights of `localityPriorities`,
+	// rather than between all their endpoints.
+	LocalityWeighted     bool     `protobuf:"varint,9,opt,name=locality_weighted,json=localityWeighted,proto3" json:"locality_weighted,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *LoadBalancerConfig) Reset()         { *m = LoadBalancerConfig{} }
@@ -85,10 +95,18 @@ type LoadBalancerConfig_LeastRequest_ struct {
 type LoadBalancerConfig_Random_ struct {
 	Random *LoadBalancerConfig_Random `protobuf:"bytes,5,opt,name=random,proto3,oneof"`
 }
+type LoadBalancerConfig_RingHash_ struct {
+	RingHash *LoadBalancerConfig_RingHash `protobuf:"bytes,6,opt,name=ring_hash,json=ringHash,proto3,oneof"`
+}
+type LoadBalancerConfig_Maglev_ struct {
+	Maglev *LoadBalancerConfig_Maglev `protobuf:"bytes,7,opt,name=maglev,proto3,oneof"`
+}
 
 func (*LoadBalancerConfig_RoundRobin_) isLoadBalancerConfig_Type()   {}
 func (*LoadBalancerConfig_LeastRequest_) isLoadBalancerConfig_Type() {}
 func (*LoadBalancerConfig_Random_) isLoadBalancerConfig_Type()       {}
+func (*LoadBalancerConfig_RingHash_) isLoadBalancerConfig_Type()     {}
+func (*LoadBalancerConfig_Maglev_) isLoadBalancerConfig_Type()       {}
 
 func (m *LoadBalancerConfig) GetType() isLoadBalancerConfig_Type {
 	if m != nil {
@@ -132,12 +150,42 @@ func (m *LoadBalancerConfig) GetRandom() *LoadBalancerConfig_Random {
 	return nil
 }
 
+func (m *LoadBalancerConfig) GetRingHash() *LoadBalancerConfig_RingHash {
+	if x, ok := m.GetType().(*LoadBalancerConfig_RingHash_); ok {
+		return x.RingHash
+	}
+	return nil
+}
+
+func (m *LoadBalancerConfig) GetMaglev() *LoadBalancerConfig_Maglev {
+	if x, ok := m.GetType().(*LoadBalancerConfig_Maglev_); ok {
+		return x.Maglev
+	}
+	return nil
+}
+
+func (m *LoadBalancerConfig) GetLocalityPriorities() []*LoadBalancerConfig_LocalityPriority {
+	if m != nil {
+		return m.LocalityPriorities
+	}
+	return nil
+}
+
+func (m *LoadBalancerConfig) GetLocalityWeighted() bool {
+	if m != nil {
+		return m.LocalityWeighted
+	}
+	return false
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*LoadBalancerConfig) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _LoadBalancerConfig_OneofMarshaler, _LoadBalancerConfig_OneofUnmarshaler, _LoadBalancerConfig_OneofSizer, []interface{}{
 		(*LoadBalancerConfig_RoundRobin_)(nil),
 		(*LoadBalancerConfig_LeastRequest_)(nil),
 		(*LoadBalancerConfig_Random_)(nil),
+		(*LoadBalancerConfig_RingHash_)(nil),
+		(*LoadBalancerConfig_Maglev_)(nil),
 	}
 }
 
@@ -160,6 +208,16 @@ func _LoadBalancerConfig_OneofMarshaler(msg proto.Message, b *proto.Buffer) erro
 		if err := b.EncodeMessage(x.Random); err != nil {
 			return err
 		}
+	case *LoadBalancerConfig_RingHash_:
+		_ = b.EncodeVarint(6<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.RingHash); err != nil {
+			return err
+		}
+	case *LoadBalancerConfig_Maglev_:
+		_ = b.EncodeVarint(7<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Maglev); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("LoadBalancerConfig.Type has unexpected type %T", x)
@@ -194,6 +252,22 @@ func _LoadBalancerConfig_OneofUnmarshaler(msg proto.Message, tag, wire int, b *p
 		err := b.DecodeMessage(msg)
 		m.Type = &LoadBalancerConfig_Random_{msg}
 		return true, err
+	case 6: // type.ring_hash
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(LoadBalancerConfig_RingHash)
+		err := b.DecodeMessage(msg)
+		m.Type = &LoadBalancerConfig_RingHash_{msg}
+		return true, err
+	case 7: // type.maglev
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(LoadBalancerConfig_Maglev)
+		err := b.DecodeMessage(msg)
+		m.Type = &LoadBalancerConfig_Maglev_{msg}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -218,6 +292,16 @@ func _LoadBalancerConfig_OneofSizer(msg proto.Message) (n int) {
 		n += 1 // tag and wire
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *LoadBalancerConfig_RingHash_:
+		s := proto.Size(x.RingHash)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *LoadBalancerConfig_Maglev_:
+		s := proto.Size(x.Maglev)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -324,11 +408,154 @@ func (m *LoadBalancerConfig_Random) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_LoadBalancerConfig_Random proto.InternalMessageInfo
 
+// Hashes the requests onto a ring of the endpoints, so that the requests with the same hash, set by the `lbHash`
+// of the routes, are sent to the same endpoint while the endpoints do not change.
+type LoadBalancerConfig_RingHash struct {
+	// Minimum size of the ring. Defaults to 1024.
+	MinimumRingSize uint64 `protobuf:"varint,1,opt,name=minimum_ring_size,json=minimumRingSize,proto3" json:"minimum_ring_size,omitempty"`
+	// Maximum size of the ring. Defaults to 8M.
+	MaximumRingSize      uint64   `protobuf:"varint,2,opt,name=maximum_ring_size,json=maximumRingSize,proto3" json:"maximum_ring_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LoadBalancerConfig_RingHash) Reset()         { *m = LoadBalancerConfig_RingHash{} }
+func (m *LoadBalancerConfig_RingHash) String() string { return proto.CompactTextString(m) }
+func (*LoadBalancerConfig_RingHash) ProtoMessage()    {}
+func (*LoadBalancerConfig_RingHash) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aaa1c019b03e4b0f, []int{0, 3}
+}
+func (m *LoadBalancerConfig_RingHash) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LoadBalancerConfig_RingHash.Unmarshal(m, b)
+}
+func (m *LoadBalancerConfig_RingHash) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LoadBalancerConfig_RingHash.Marshal(b, m, deterministic)
+}
+func (m *LoadBalancerConfig_RingHash) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LoadBalancerConfig_RingHash.Merge(m, src)
+}
+func (m *LoadBalancerConfig_RingHash) XXX_Size() int {
+	return xxx_messageInfo_LoadBalancerConfig_RingHash.Size(m)
+}
+func (m *LoadBalancerConfig_RingHash) XXX_DiscardUnknown() {
+	xxx_messageInfo_LoadBalancerConfig_RingHash.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LoadBalancerConfig_RingHash proto.InternalMessageInfo
+
+func (m *LoadBalancerConfig_RingHash) GetMinimumRingSize() uint64 {
+	if m != nil {
+		return m.MinimumRingSize
+	}
+	return 0
+}
+
+func (m *LoadBalancerConfig_RingHash) GetMaximumRingSize() uint64 {
+	if m != nil {
+		return m.MaximumRingSize
+	}
+	return 0
+}
+
+// Hashes the requests onto a fixed size lookup table of the endpoints, which builds faster than a ring and moves
+// fewer requests to other endpoints when the endpoints change. The requests are hashed by the `lbHash` of the routes.
+type LoadBalancerConfig_Maglev struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LoadBalancerConfig_Maglev) Reset()         { *m = LoadBalancerConfig_Maglev{} }
+func (m *LoadBalancerConfig_Maglev) String() string { return proto.CompactTextString(m) }
+func (*LoadBalancerConfig_Maglev) ProtoMessage()    {}
+func (*LoadBalancerConfig_Maglev) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aaa1c019b03e4b0f, []int{0, 4}
+}
+func (m *LoadBalancerConfig_Maglev) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LoadBalancerConfig_Maglev.Unmarshal(m, b)
+}
+func (m *LoadBalancerConfig_Maglev) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LoadBalancerConfig_Maglev.Marshal(b, m, deterministic)
+}
+func (m *LoadBalancerConfig_Maglev) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LoadBalancerConfig_Maglev.Merge(m, src)
+}
+func (m *LoadBalancerConfig_Maglev) XXX_Size() int {
+	return xxx_messageInfo_LoadBalancerConfig_Maglev.Size(m)
+}
+func (m *LoadBalancerConfig_Maglev) XXX_DiscardUnknown() {
+	xxx_messageInfo_LoadBalancerConfig_Maglev.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LoadBalancerConfig_Maglev proto.InternalMessageInfo
+
+type LoadBalancerConfig_LocalityPriority struct {
+	// The localities this entry matches. An empty field matches any value.
+	Locality *Locality `protobuf:"bytes,1,opt,name=locality,proto3" json:"locality,omitempty"`
+	// The priority of the endpoints in the localities. 0 is the highest priority.
+	Priority uint32 `protobuf:"varint,2,opt,name=priority,proto3" json:"priority,omitempty"`
+	// The weight of the localities among the localities of the same priority, when `localityWeighted` is set.
+	// Defaults to 1.
+	Weight               uint32   `protobuf:"varint,3,opt,name=weight,proto3" json:"weight,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LoadBalancerConfig_LocalityPriority) Reset()         { *m = LoadBalancerConfig_LocalityPriority{} }
+func (m *LoadBalancerConfig_LocalityPriority) String() string { return proto.CompactTextString(m) }
+func (*LoadBalancerConfig_LocalityPriority) ProtoMessage()    {}
+func (*LoadBalancerConfig_LocalityPriority) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aaa1c019b03e4b0f, []int{0, 5}
+}
+func (m *LoadBalancerConfig_LocalityPriority) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LoadBalancerConfig_LocalityPriority.Unmarshal(m, b)
+}
+func (m *LoadBalancerConfig_LocalityPriority) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LoadBalancerConfig_LocalityPriority.Marshal(b, m, deterministic)
+}
+func (m *LoadBalancerConfig_LocalityPriority) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LoadBalancerConfig_LocalityPriority.Merge(m, src)
+}
+func (m *LoadBalancerConfig_LocalityPriority) XXX_Size() int {
+	return xxx_messageInfo_LoadBalancerConfig_LocalityPriority.Size(m)
+}
+func (m *LoadBalancerConfig_LocalityPriority) XXX_DiscardUnknown() {
+	xxx_messageInfo_LoadBalancerConfig_LocalityPriority.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LoadBalancerConfig_LocalityPriority proto.InternalMessageInfo
+
+func (m *LoadBalancerConfig_LocalityPriority) GetLocality() *Locality {
+	if m != nil {
+		return m.Locality
+	}
+	return nil
+}
+
+func (m *LoadBalancerConfig_LocalityPriority) GetPriority() uint32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+func (m *LoadBalancerConfig_LocalityPriority) GetWeight() uint32 {
+	if m != nil {
+		return m.Weight
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*LoadBalancerConfig)(nil), "gloo.solo.io.LoadBalancerConfig")
 	proto.RegisterType((*LoadBalancerConfig_RoundRobin)(nil), "gloo.solo.io.LoadBalancerConfig.RoundRobin")
 	proto.RegisterType((*LoadBalancerConfig_LeastRequest)(nil), "gloo.solo.io.LoadBalancerConfig.LeastRequest")
 	proto.RegisterType((*LoadBalancerConfig_Random)(nil), "gloo.solo.io.LoadBalancerConfig.Random")
+	proto.RegisterType((*LoadBalancerConfig_RingHash)(nil), "gloo.solo.io.LoadBalancerConfig.RingHash")
+	proto.RegisterType((*LoadBalancerConfig_Maglev)(nil), "gloo.solo.io.LoadBalancerConfig.Maglev")
+	proto.RegisterType((*LoadBalancerConfig_LocalityPriority)(nil), "gloo.solo.io.LoadBalancerConfig.LocalityPriority")
 }
 
 func init() {
@@ -336,34 +563,47 @@ func init() {
 }
 
 var fileDescriptor_aaa1c019b03e4b0f = []byte{
-	// 419 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xcd, 0x6e, 0xd4, 0x30,
-	0x10, 0xc7, 0x77, 0xe9, 0xb2, 0x42, 0xde, 0xf4, 0x40, 0x00, 0x11, 0x22, 0x54, 0x3e, 0x2e, 0x20,
-	0xa1, 0x3a, 0x14, 0x24, 0xce, 0x90, 0x72, 0xd8, 0x43, 0xf9, 0x90, 0xb5, 0x02, 0x89, 0x4b, 0xe4,
-	0x24, 0x53, 0xc7, 0xe0, 0xf5, 0x18, 0xc7, 0x66, 0xd5, 0x37, 0xe1, 0x11, 0x78, 0x2b, 0x24, 0x5e,
-	0x82, 0x2b, 0xb2, 0x93, 0xc2, 0x4a, 0x15, 0xda, 0xde, 0xf2, 0xf7, 0xcc, 0xef, 0x67, 0x3b, 0x1e,
-	0xf2, 0x52, 0x48, 0xd7, 0xf9, 0x9a, 0x36, 0xb8, 0x2e, 0x7a, 0x54, 0x78, 0x28, 0xb1, 0x10, 0x0a,
-	0xb1, 0x30, 0x16, 0x3f, 0x43, 0xe3, 0xfa, 0x21, 0x71, 0x23, 0x8b, 0x6f, 0x47, 0x85, 0x42, 0xde,
-	0x56, 0x35, 0x57, 0x5c, 0x37, 0x60, 0xa9, 0xb1, 0xe8, 0x30, 0x4d, 0x42, 0x03, 0x0d, 0x2c, 0x95,
-	0x98, 0xdf, 0x14, 0x28, 0x30, 0x16, 0x8a, 0xf0, 0x35, 0xf4, 0xe4, 0x07, 0x02, 0x51, 0x28, 0x28,
-	0x62, 0xaa, 0xfd, 0x69, 0xd1, 0x7a, 0xcb, 0x9d, 0x44, 0xfd, 0xbf, 0xfa, 0xc6, 0x72, 0x63, 0xc0,
-	0xf6, 0x43, 0xfd, 0xe1, 0xef, 0x3d, 0x92, 0x9e, 0x20, 0x6f, 0xcb, 0x71, 0xeb, 0x63, 0xd4, 0xa7,
-	0x52, 0xa4, 0x2b, 0x72, 0xbb, 0x03, 0xae, 0x5c, 0x77, 0x56, 0x19, 0xae, 0x65, 0x53, 0xb9, 0xce,
-	0x42, 0xdf, 0xa1, 0x6a, 0xb3, 0xe9, 0xfd, 0xe9, 0xe3, 0xc5, 0xb3, 0xbb, 0x74, 0x10, 0xd3, 0x73,
-	0x31, 0x7d, 0x8d, 0xbe, 0x56, 0xf0, 0x81, 0x2b, 0x0f, 0xec, 0xd6, 0x08, 0xbf, 0x0f, 0xec, 0xea,
-	0x1c, 0x4d, 0xdf, 0x91, 0x1b, 0xde, 0xb4, 0xdc, 0x41, 0xb5, 0x06, 0x2b, 0xa0, 0xda, 0x48, 0xdd,
-	0xe2, 0x26, 0xbb, 0x12, 0x8d, 0x77, 0x2e, 0x1a, 0xc7, 0xab, 0x94, 0xb3, 0xef, 0x3f, 0xef, 0x4d,
-	0xd9, 0xf5, 0x81, 0x7d, 0x13, 0xd0, 0x8f, 0x91, 0x4c, 0xdf, 0x92, 0x85, 0x45, 0xaf, 0xdb, 0xca,
-	0x62, 0x2d, 0x75, 0xb6, 0x17, 0x45, 0x4f, 0xe8, 0xf6, 0x7f, 0xa3, 0x17, 0x6f, 0x47, 0x59, 0x60,
-	0x58, 0x40, 0x96, 0x13, 0x46, 0xec, 0xdf, 0x94, 0xae, 0xc8, 0xbe, 0x02, 0xde, 0xbb, 0xca, 0xc2,
-	0x57, 0x0f, 0xbd, 0xcb, 0x66, 0xd1, 0x78, 0xb8, 0xd3, 0x78, 0x12, 0x28, 0x36, 0x40, 0xcb, 0x09,
-	0x4b, 0xd4, 0x56, 0x4e, 0x5f, 0x91, 0xb9, 0xe5, 0xba, 0xc5, 0x75, 0x76, 0x35, 0xea, 0x1e, 0xed,
-	0x3e, 0x60, 0x6c, 0x5f, 0x4e, 0xd8, 0x08, 0xe6, 0x09, 0x21, 0xff, 0x0e, 0x9d, 0x1f, 0x91, 0x64,
-	0x7b, 0xc3, 0xf4, 0x01, 0x49, 0x9a, 0x0e, 0x65, 0x03, 0x55, 0x83, 0x5e, 0xbb, 0xf8, 0x44, 0xfb,
-	0x6c, 0x31, 0xac, 0x1d, 0x87, 0xa5, 0xfc, 0x1a, 0x99, 0x0f, 0xd2, 0x72, 0x4e, 0x66, 0xee, 0xcc,
-	0x40, 0xf9, 0xe2, 0xc7, 0xaf, 0x83, 0xe9, 0xa7, 0xa7, 0x97, 0x9b, 0x52, 0xf3, 0x45, 0x8c, 0x93,
-	0x5a, 0xcf, 0xe3, 0xfb, 0x3c, 0xff, 0x13, 0x00, 0x00, 0xff, 0xff, 0x54, 0x1e, 0xcd, 0x56, 0xe0,
-	0x02, 0x00, 0x00,
+	// 627 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xd1, 0x6e, 0xd3, 0x3a,
+	0x18, 0xc7, 0xdb, 0xad, 0xa7, 0xcb, 0xdc, 0x56, 0x67, 0xf3, 0xce, 0x19, 0x21, 0x42, 0x63, 0x70,
+	0xc3, 0x60, 0x5a, 0xc2, 0x86, 0xc4, 0x0d, 0x37, 0xd0, 0x71, 0xd1, 0x8b, 0x0d, 0x26, 0x33, 0x31,
+	0x89, 0x9b, 0xc8, 0x49, 0xbc, 0xc4, 0xe0, 0xf8, 0x0b, 0x8e, 0xb3, 0xae, 0x7b, 0x12, 0x1e, 0x81,
+	0xb7, 0x42, 0xe2, 0x45, 0x40, 0x71, 0xdc, 0x52, 0x36, 0xa1, 0x8e, 0xbb, 0x7c, 0xfe, 0xfe, 0xbf,
+	0xcf, 0xff, 0xfc, 0x1d, 0x07, 0xbd, 0x4c, 0xb9, 0xce, 0xaa, 0xc8, 0x8f, 0x21, 0x0f, 0x4a, 0x10,
+	0xb0, 0xc7, 0x21, 0x48, 0x05, 0x40, 0x50, 0x28, 0xf8, 0xc8, 0x62, 0x5d, 0x36, 0x15, 0x2d, 0x78,
+	0x70, 0xb1, 0x1f, 0x08, 0xa0, 0x49, 0x18, 0x51, 0x41, 0x65, 0xcc, 0x94, 0x5f, 0x28, 0xd0, 0x80,
+	0xfb, 0xb5, 0xc0, 0xaf, 0x59, 0x9f, 0x83, 0xf7, 0x5f, 0x0a, 0x29, 0x98, 0x46, 0x50, 0x3f, 0x35,
+	0x1a, 0x6f, 0x2b, 0x05, 0x48, 0x05, 0x0b, 0x4c, 0x15, 0x55, 0xe7, 0x41, 0x52, 0x29, 0xaa, 0x39,
+	0xc8, 0x3f, 0xf5, 0xc7, 0x8a, 0x16, 0x05, 0x53, 0xa5, 0xed, 0xbf, 0xf8, 0x2b, 0x97, 0x4c, 0x26,
+	0x05, 0x70, 0xa9, 0x1b, 0xf8, 0xe1, 0x8f, 0x15, 0x84, 0x8f, 0x80, 0x26, 0x43, 0xeb, 0xfb, 0x10,
+	0xe4, 0x39, 0x4f, 0xf1, 0x29, 0xba, 0x93, 0x31, 0x2a, 0x74, 0x36, 0x09, 0x0b, 0x2a, 0x79, 0x1c,
+	0xea, 0x4c, 0xb1, 0x32, 0x03, 0x91, 0xb8, 0xed, 0xed, 0xf6, 0x4e, 0xef, 0xe0, 0x9e, 0xdf, 0xb8,
+	0xf2, 0xa7, 0xae, 0xfc, 0xd7, 0x50, 0x45, 0x82, 0xbd, 0xa7, 0xa2, 0x62, 0xe4, 0x7f, 0x0b, 0x9f,
+	0xd4, 0xec, 0xe9, 0x14, 0xc5, 0x6f, 0xd1, 0x46, 0x55, 0x24, 0x54, 0xb3, 0x30, 0x67, 0x2a, 0x65,
+	0xe1, 0x98, 0xcb, 0x04, 0xc6, 0xee, 0x92, 0x99, 0x78, 0xf7, 0xe6, 0x44, 0x9b, 0xc3, 0xb0, 0xf3,
+	0xe5, 0xdb, 0xfd, 0x36, 0x59, 0x6f, 0xd8, 0xe3, 0x1a, 0x3d, 0x33, 0x24, 0x7e, 0x83, 0x7a, 0x0a,
+	0x2a, 0x99, 0x84, 0x0a, 0x22, 0x2e, 0xdd, 0x65, 0x33, 0x68, 0xd7, 0x9f, 0x0f, 0xdd, 0xbf, 0xf9,
+	0x76, 0x3e, 0xa9, 0x19, 0x52, 0x23, 0xa3, 0x16, 0x41, 0x6a, 0x56, 0xe1, 0x53, 0x34, 0x10, 0x8c,
+	0x96, 0x3a, 0x54, 0xec, 0x73, 0xc5, 0x4a, 0xed, 0x76, 0xcc, 0xc4, 0xbd, 0x85, 0x13, 0x8f, 0x6a,
+	0x8a, 0x34, 0xd0, 0xa8, 0x45, 0xfa, 0x62, 0xae, 0xc6, 0xaf, 0x50, 0x57, 0x51, 0x99, 0x40, 0xee,
+	0xfe, 0x63, 0xc6, 0x3d, 0x5a, 0x6c, 0xd0, 0xc8, 0x47, 0x2d, 0x62, 0x41, 0x3c, 0x42, 0xab, 0x8a,
+	0xcb, 0x34, 0xcc, 0x68, 0x99, 0xb9, 0x5d, 0x33, 0xe5, 0xf1, 0xe2, 0x29, 0x5c, 0xa6, 0x23, 0x5a,
+	0x66, 0xa3, 0x16, 0x71, 0x94, 0x7d, 0xae, 0xcd, 0xe4, 0x34, 0x15, 0xec, 0xc2, 0x5d, 0xb9, 0xa5,
+	0x99, 0x63, 0x23, 0xaf, 0xcd, 0x34, 0x20, 0x8e, 0xd0, 0x86, 0x80, 0x98, 0x0a, 0xae, 0x27, 0x61,
+	0xa1, 0x38, 0x28, 0xae, 0x39, 0x2b, 0x5d, 0x67, 0x7b, 0x79, 0xa7, 0x77, 0xb0, 0xbf, 0x38, 0x2b,
+	0xcb, 0x9e, 0x34, 0xe8, 0x84, 0x60, 0xf1, 0xfb, 0x0a, 0x67, 0x25, 0xde, 0x45, 0xeb, 0xb3, 0x3d,
+	0xc6, 0x8c, 0xa7, 0x99, 0x66, 0x89, 0xbb, 0xba, 0xdd, 0xde, 0x71, 0xc8, 0xda, 0xb4, 0x71, 0x66,
+	0xd7, 0xbd, 0x3e, 0x42, 0xbf, 0x8e, 0xd4, 0xdb, 0x47, 0xfd, 0xf9, 0xe3, 0xc0, 0x0f, 0x50, 0x3f,
+	0xce, 0x80, 0xc7, 0x2c, 0x8c, 0xa1, 0x92, 0xda, 0x7c, 0xc0, 0x03, 0xd2, 0x6b, 0xd6, 0x0e, 0xeb,
+	0x25, 0xcf, 0x41, 0xdd, 0x26, 0x72, 0x2f, 0x42, 0xce, 0x34, 0x36, 0xfc, 0x04, 0xad, 0xe7, 0x5c,
+	0xf2, 0xbc, 0xca, 0x43, 0x13, 0x7e, 0xc9, 0xaf, 0x98, 0xa1, 0x3b, 0xe4, 0x5f, 0xdb, 0xa8, 0xb5,
+	0xef, 0xf8, 0x15, 0x33, 0x5a, 0x7a, 0x79, 0x4d, 0xbb, 0x64, 0xb5, 0xf4, 0x72, 0x5e, 0x5b, 0xef,
+	0xd6, 0x64, 0xea, 0x5d, 0xa1, 0xb5, 0xeb, 0x69, 0xe0, 0x03, 0xe4, 0x4c, 0x5f, 0xd0, 0xde, 0xb5,
+	0xcd, 0xeb, 0x91, 0x36, 0x5d, 0x32, 0xd3, 0x61, 0x0f, 0x39, 0xf6, 0x20, 0x26, 0x66, 0xd3, 0x01,
+	0x99, 0xd5, 0x78, 0x13, 0x75, 0x9b, 0x00, 0xcd, 0xf5, 0x18, 0x10, 0x5b, 0x0d, 0xbb, 0xa8, 0xa3,
+	0x27, 0x05, 0x1b, 0x3e, 0xff, 0xfa, 0x7d, 0xab, 0xfd, 0xe1, 0xe9, 0xed, 0x7e, 0x22, 0xc5, 0xa7,
+	0xd4, 0xfe, 0x48, 0xa2, 0xae, 0xb9, 0xa7, 0xcf, 0x7e, 0x0e, 0x00, 0xf6, 0x25, 0x35, 0x08, 0x25,
+	0x05, 0x00, 0x00,
 }
 
 func (this *LoadBalancerConfig) Equal(that interface{}) bool {
@@ -406,6 +646,17 @@ func (this *LoadBalancerConfig) Equal(that interface{}) bool {
 	} else if !this.Type.Equal(that1.Type) {
 		return false
 	}
+	if len(this.LocalityPriorities) != len(that1.LocalityPriorities) {
+		return false
+	}
+	for i := range this.LocalityPriorities {
+		if !this.LocalityPriorities[i].Equal(that1.LocalityPriorities[i]) {
+			return false
+		}
+	}
+	if this.LocalityWeighted != that1.LocalityWeighted {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -483,6 +734,54 @@ func (this *LoadBalancerConfig_Random_) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *LoadBalancerConfig_RingHash_) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*LoadBalancerConfig_RingHash_)
+	if !ok {
+		that2, ok := that.(LoadBalancerConfig_RingHash_)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.RingHash.Equal(that1.RingHash) {
+		return false
+	}
+	return true
+}
+func (this *LoadBalancerConfig_Maglev_) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*LoadBalancerConfig_Maglev_)
+	if !ok {
+		that2, ok := that.(LoadBalancerConfig_Maglev_)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Maglev.Equal(that1.Maglev) {
+		return false
+	}
+	return true
+}
 func (this *LoadBalancerConfig_RoundRobin) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -558,3 +857,90 @@ func (this *LoadBalancerConfig_Random) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *LoadBalancerConfig_RingHash) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*LoadBalancerConfig_RingHash)
+	if !ok {
+		that2, ok := that.(LoadBalancerConfig_RingHash)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MinimumRingSize != that1.MinimumRingSize {
+		return false
+	}
+	if this.MaximumRingSize != that1.MaximumRingSize {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *LoadBalancerConfig_Maglev) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*LoadBalancerConfig_Maglev)
+	if !ok {
+		that2, ok := that.(LoadBalancerConfig_Maglev)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *LoadBalancerConfig_LocalityPriority) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*LoadBalancerConfig_LocalityPriority)
+	if !ok {
+		that2, ok := that.(LoadBalancerConfig_LocalityPriority)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Locality.Equal(that1.Locality) {
+		return false
+	}
+	if this.Priority != that1.Priority {
+		return false
+	}
+	if this.Weight != that1.Weight {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
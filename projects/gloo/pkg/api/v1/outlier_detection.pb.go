@@ -0,0 +1,249 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: github.com/solo-io/gloo/projects/gloo/api/v1/outlier_detection.proto
+
+package v1
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	math "math"
+	time "time"
+
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	types "github.com/gogo/protobuf/types"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+// OutlierDetection configures envoy to eject the endpoints of an upstream that fail the requests sent to them, without
+// health checking them actively.
+// see more info [here](https://www.envoyproxy.io/docs/envoy/latest/api-v2/api/v2/cluster/outlier_detection.proto).
+type OutlierDetection struct {
+	// The number of consecutive 5xx responses before an endpoint is ejected. Defaults to 5.
+	Consecutive_5Xx *types.UInt32Value `protobuf:"bytes,1,opt,name=consecutive_5xx,json=consecutive5xx,proto3" json:"consecutive_5xx,omitempty"`
+	// The interval between the ejection analyses. Defaults to 10 seconds.
+	Interval *time.Duration `protobuf:"bytes,2,opt,name=interval,proto3,stdduration" json:"interval,omitempty"`
+	// An ejected endpoint is ejected for this time multiplied by the number of times it was ejected.
+	// Defaults to 30 seconds.
+	BaseEjectionTime *time.Duration `protobuf:"bytes,3,opt,name=base_ejection_time,json=baseEjectionTime,proto3,stdduration" json:"base_ejection_time,omitempty"`
+	// The maximum percentage of the endpoints that can be ejected, from 0 to 100. Defaults to 10.
+	MaxEjectionPercent *types.UInt32Value `protobuf:"bytes,4,opt,name=max_ejection_percent,json=maxEjectionPercent,proto3" json:"max_ejection_percent,omitempty"`
+	// The chance in percent that an endpoint is ejected after consecutive 5xx responses, from 0 to 100.
+	// Defaults to 100.
+	EnforcingConsecutive_5Xx *types.UInt32Value `protobuf:"bytes,5,opt,name=enforcing_consecutive_5xx,json=enforcingConsecutive5xx,proto3" json:"enforcing_consecutive_5xx,omitempty"`
+	// The chance in percent that an endpoint is ejected for its success rate, from 0 to 100. Defaults to 100.
+	EnforcingSuccessRate *types.UInt32Value `protobuf:"bytes,6,opt,name=enforcing_success_rate,json=enforcingSuccessRate,proto3" json:"enforcing_success_rate,omitempty"`
+	// The minimum number of endpoints with enough requests to eject endpoints for their success rate. Defaults to 5.
+	SuccessRateMinimumHosts *types.UInt32Value `protobuf:"bytes,7,opt,name=success_rate_minimum_hosts,json=successRateMinimumHosts,proto3" json:"success_rate_minimum_hosts,omitempty"`
+	// The minimum number of requests of an endpoint during an interval to eject it for its success rate.
+	// Defaults to 100.
+	SuccessRateRequestVolume *types.UInt32Value `protobuf:"bytes,8,opt,name=success_rate_request_volume,json=successRateRequestVolume,proto3" json:"success_rate_request_volume,omitempty"`
+	// An endpoint is ejected when its success rate is below the mean success rate minus this factor divided by 1000
+	// times the standard deviation of the success rates. Defaults to 1900.
+	SuccessRateStdevFactor *types.UInt32Value `protobuf:"bytes,9,opt,name=success_rate_stdev_factor,json=successRateStdevFactor,proto3" json:"success_rate_stdev_factor,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{}           `json:"-"`
+	XXX_unrecognized       []byte             `json:"-"`
+	XXX_sizecache          int32              `json:"-"`
+}
+
+func (m *OutlierDetection) Reset()         { *m = OutlierDetection{} }
+func (m *OutlierDetection) String() string { return proto.CompactTextString(m) }
+func (*OutlierDetection) ProtoMessage()    {}
+func (*OutlierDetection) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1c37a7572b9464c, []int{0}
+}
+func (m *OutlierDetection) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OutlierDetection.Unmarshal(m, b)
+}
+func (m *OutlierDetection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OutlierDetection.Marshal(b, m, deterministic)
+}
+func (m *OutlierDetection) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OutlierDetection.Merge(m, src)
+}
+func (m *OutlierDetection) XXX_Size() int {
+	return xxx_messageInfo_OutlierDetection.Size(m)
+}
+func (m *OutlierDetection) XXX_DiscardUnknown() {
+	xxx_messageInfo_OutlierDetection.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OutlierDetection proto.InternalMessageInfo
+
+func (m *OutlierDetection) GetConsecutive_5Xx() *types.UInt32Value {
+	if m != nil {
+		return m.Consecutive_5Xx
+	}
+	return nil
+}
+
+func (m *OutlierDetection) GetInterval() *time.Duration {
+	if m != nil {
+		return m.Interval
+	}
+	return nil
+}
+
+func (m *OutlierDetection) GetBaseEjectionTime() *time.Duration {
+	if m != nil {
+		return m.BaseEjectionTime
+	}
+	return nil
+}
+
+func (m *OutlierDetection) GetMaxEjectionPercent() *types.UInt32Value {
+	if m != nil {
+		return m.MaxEjectionPercent
+	}
+	return nil
+}
+
+func (m *OutlierDetection) GetEnforcingConsecutive_5Xx() *types.UInt32Value {
+	if m != nil {
+		return m.EnforcingConsecutive_5Xx
+	}
+	return nil
+}
+
+func (m *OutlierDetection) GetEnforcingSuccessRate() *types.UInt32Value {
+	if m != nil {
+		return m.EnforcingSuccessRate
+	}
+	return nil
+}
+
+func (m *OutlierDetection) GetSuccessRateMinimumHosts() *types.UInt32Value {
+	if m != nil {
+		return m.SuccessRateMinimumHosts
+	}
+	return nil
+}
+
+func (m *OutlierDetection) GetSuccessRateRequestVolume() *types.UInt32Value {
+	if m != nil {
+		return m.SuccessRateRequestVolume
+	}
+	return nil
+}
+
+func (m *OutlierDetection) GetSuccessRateStdevFactor() *types.UInt32Value {
+	if m != nil {
+		return m.SuccessRateStdevFactor
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*OutlierDetection)(nil), "gloo.solo.io.OutlierDetection")
+}
+
+func init() {
+	proto.RegisterFile("github.com/solo-io/gloo/projects/gloo/api/v1/outlier_detection.proto", fileDescriptor_e1c37a7572b9464c)
+}
+
+var fileDescriptor_e1c37a7572b9464c = []byte{
+	// 441 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xcd, 0x6e, 0xd4, 0x30,
+	0x10, 0x80, 0xb5, 0xd0, 0x96, 0x62, 0x10, 0x54, 0xd6, 0xaa, 0x64, 0x17, 0x54, 0x10, 0x27, 0x2e,
+	0x24, 0xd0, 0xaa, 0x5c, 0xb8, 0x95, 0x2d, 0x82, 0x43, 0x01, 0xa5, 0x50, 0xfe, 0x0e, 0x96, 0xd7,
+	0x3b, 0x9b, 0xba, 0xc4, 0x99, 0x60, 0x8f, 0x43, 0x1e, 0x85, 0x47, 0xe0, 0xad, 0x90, 0x78, 0x06,
+	0x1e, 0x00, 0x25, 0xde, 0x5f, 0x7a, 0xc9, 0xcd, 0xa3, 0x99, 0xef, 0x9b, 0xf1, 0x48, 0xc3, 0x46,
+	0x99, 0xa6, 0x73, 0x3f, 0x8e, 0x15, 0x9a, 0xc4, 0x61, 0x8e, 0x8f, 0x35, 0x26, 0x59, 0x8e, 0x98,
+	0x94, 0x16, 0x2f, 0x40, 0x91, 0x0b, 0x91, 0x2c, 0x75, 0x52, 0x3d, 0x4d, 0xd0, 0x53, 0xae, 0xc1,
+	0x8a, 0x09, 0x10, 0x28, 0xd2, 0x58, 0xc4, 0xa5, 0x45, 0x42, 0x7e, 0xb3, 0x29, 0x8a, 0x1b, 0x3e,
+	0xd6, 0x38, 0xec, 0x67, 0x98, 0x61, 0x9b, 0x48, 0x9a, 0x57, 0xa8, 0x19, 0xee, 0x65, 0x88, 0x59,
+	0x0e, 0x49, 0x1b, 0x8d, 0xfd, 0x34, 0x99, 0x78, 0x2b, 0x97, 0x8e, 0xcb, 0xf9, 0x1f, 0x56, 0x96,
+	0x25, 0x58, 0x17, 0xf2, 0x0f, 0xff, 0x6e, 0xb2, 0x9d, 0xb7, 0xa1, 0xff, 0x68, 0xde, 0x9e, 0x1f,
+	0xb3, 0xdb, 0x0a, 0x0b, 0x07, 0xca, 0x93, 0xae, 0x40, 0x1c, 0xd6, 0x75, 0xd4, 0x7b, 0xd0, 0x7b,
+	0x74, 0x63, 0xff, 0x5e, 0x1c, 0x74, 0xf1, 0x5c, 0x17, 0x7f, 0x78, 0x5d, 0xd0, 0xc1, 0xfe, 0x99,
+	0xcc, 0x3d, 0xa4, 0xb7, 0x56, 0xa0, 0xc3, 0xba, 0xe6, 0xcf, 0xd9, 0xb6, 0x2e, 0x08, 0x6c, 0x25,
+	0xf3, 0xe8, 0x4a, 0xcb, 0x0f, 0x2e, 0xf1, 0xa3, 0xd9, 0xb8, 0x47, 0x1b, 0x3f, 0x7f, 0xdf, 0xef,
+	0xa5, 0x0b, 0x80, 0x9f, 0x30, 0x3e, 0x96, 0x0e, 0x04, 0x5c, 0x84, 0xa1, 0x04, 0x69, 0x03, 0xd1,
+	0xd5, 0x6e, 0x9a, 0x9d, 0x06, 0x3d, 0x9e, 0x91, 0xef, 0xb5, 0x01, 0xfe, 0x86, 0xf5, 0x8d, 0xac,
+	0x97, 0xb6, 0x12, 0xac, 0x82, 0x82, 0xa2, 0x8d, 0x0e, 0xff, 0xe2, 0x46, 0xd6, 0x73, 0xd9, 0xbb,
+	0xc0, 0xf1, 0x4f, 0x6c, 0x00, 0xc5, 0x14, 0xad, 0xd2, 0x45, 0x26, 0xfe, 0x5f, 0xd6, 0x66, 0x07,
+	0xe9, 0x9d, 0x05, 0xfe, 0x62, 0x7d, 0x6b, 0x29, 0xdb, 0x5d, 0x9a, 0x9d, 0x57, 0x0a, 0x9c, 0x13,
+	0x56, 0x12, 0x44, 0x5b, 0x1d, 0xb4, 0xfd, 0x05, 0x7b, 0x1a, 0xd0, 0x54, 0x12, 0xf0, 0xcf, 0x6c,
+	0xb8, 0x6a, 0x12, 0x46, 0x17, 0xda, 0x78, 0x23, 0xce, 0xd1, 0x91, 0x8b, 0xae, 0x75, 0x19, 0xd7,
+	0x2d, 0x75, 0x27, 0x81, 0x7e, 0xd5, 0xc0, 0xfc, 0x2b, 0xbb, 0xbb, 0xa6, 0xb6, 0xf0, 0xdd, 0x83,
+	0x23, 0x51, 0x61, 0xee, 0x0d, 0x44, 0xdb, 0x1d, 0xdc, 0xd1, 0x8a, 0x3b, 0x0d, 0xf8, 0x59, 0x4b,
+	0xf3, 0x8f, 0x6c, 0xb0, 0x26, 0x77, 0x34, 0x81, 0x4a, 0x4c, 0xa5, 0x22, 0xb4, 0xd1, 0xf5, 0x0e,
+	0xea, 0xdd, 0x15, 0xf5, 0x69, 0x03, 0xbf, 0x6c, 0xd9, 0xa3, 0x67, 0xbf, 0xfe, 0xec, 0xf5, 0xbe,
+	0x3c, 0xe9, 0x76, 0xa6, 0xe5, 0xb7, 0x6c, 0x76, 0xaa, 0xe3, 0xad, 0xb6, 0xcb, 0xc1, 0xbf, 0x01,
+	0x00, 0x70, 0xc0, 0x86, 0x0d, 0xe1, 0x03, 0x00, 0x00,
+}
+
+func (this *OutlierDetection) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*OutlierDetection)
+	if !ok {
+		that2, ok := that.(OutlierDetection)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Consecutive_5Xx.Equal(that1.Consecutive_5Xx) {
+		return false
+	}
+	if this.Interval != nil && that1.Interval != nil {
+		if *this.Interval != *that1.Interval {
+			return false
+		}
+	} else if this.Interval != nil {
+		return false
+	} else if that1.Interval != nil {
+		return false
+	}
+	if this.BaseEjectionTime != nil && that1.BaseEjectionTime != nil {
+		if *this.BaseEjectionTime != *that1.BaseEjectionTime {
+			return false
+		}
+	} else if this.BaseEjectionTime != nil {
+		return false
+	} else if that1.BaseEjectionTime != nil {
+		return false
+	}
+	if !this.MaxEjectionPercent.Equal(that1.MaxEjectionPercent) {
+		return false
+	}
+	if !this.EnforcingConsecutive_5Xx.Equal(that1.EnforcingConsecutive_5Xx) {
+		return false
+	}
+	if !this.EnforcingSuccessRate.Equal(that1.EnforcingSuccessRate) {
+		return false
+	}
+	if !this.SuccessRateMinimumHosts.Equal(that1.SuccessRateMinimumHosts) {
+		return false
+	}
+	if !this.SuccessRateRequestVolume.Equal(that1.SuccessRateRequestVolume) {
+		return false
+	}
+	if !this.SuccessRateStdevFactor.Equal(that1.SuccessRateStdevFactor) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
@@ -12,18 +12,37 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	_ "github.com/gogo/protobuf/types"
+	adaptiveconcurrency "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/adaptiveconcurrency"
+	alibaba "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/alibaba"
 	aws "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/aws"
 	azure "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/azure"
+	cloudmap "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/cloudmap"
 	consul "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/consul"
+	deadline "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/deadline"
+	ec2 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/ec2"
+	external "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/external"
+	failover "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/failover"
 	faultinjection "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/faultinjection"
 	grpc "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/grpc"
 	grpc_web "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/grpc_web"
+	gzip "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/gzip"
 	hcm "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/hcm"
 	kubernetes "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/kubernetes"
+	lbhash "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/lbhash"
+	lua "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/lua"
+	nomad "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/nomad"
+	openfaas "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/openfaas"
+	openwhisk "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/openwhisk"
+	originaldst "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/originaldst"
 	rest "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/rest"
 	retries "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/retries"
 	static "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/static"
+	thrift "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/thrift"
+	trailers "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/trailers"
 	transformation "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/transformation"
+	tuning "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/tuning"
+	wasm "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/wasm"
+	websocket "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/websocket"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -43,11 +62,17 @@ const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 // Note to developers: new Listener Plugins must be added to this struct
 // to be usable by Gloo.
 type ListenerPlugins struct {
-	GrpcWeb                       *grpc_web.GrpcWeb                  `protobuf:"bytes,1,opt,name=grpc_web,json=grpcWeb,proto3" json:"grpc_web,omitempty"`
-	HttpConnectionManagerSettings *hcm.HttpConnectionManagerSettings `protobuf:"bytes,2,opt,name=http_connection_manager_settings,json=httpConnectionManagerSettings,proto3" json:"http_connection_manager_settings,omitempty"`
-	XXX_NoUnkeyedLiteral          struct{}                           `json:"-"`
-	XXX_unrecognized              []byte                             `json:"-"`
-	XXX_sizecache                 int32                              `json:"-"`
+	GrpcWeb                       *grpc_web.GrpcWeb                        `protobuf:"bytes,1,opt,name=grpc_web,json=grpcWeb,proto3" json:"grpc_web,omitempty"`
+	HttpConnectionManagerSettings *hcm.HttpConnectionManagerSettings       `protobuf:"bytes,2,opt,name=http_connection_manager_settings,json=httpConnectionManagerSettings,proto3" json:"http_connection_manager_settings,omitempty"`
+	ListenerTuning                *tuning.ListenerTuning                   `protobuf:"bytes,3,opt,name=listener_tuning,json=listenerTuning,proto3" json:"listener_tuning,omitempty"`
+	Wasm                          *wasm.PluginSource                       `protobuf:"bytes,4,opt,name=wasm,proto3" json:"wasm,omitempty"`
+	Lua                           *lua.LuaFilter                           `protobuf:"bytes,5,opt,name=lua,proto3" json:"lua,omitempty"`
+	Gzip                          *gzip.Gzip                               `protobuf:"bytes,6,opt,name=gzip,proto3" json:"gzip,omitempty"`
+	AdaptiveConcurrency           *adaptiveconcurrency.AdaptiveConcurrency `protobuf:"bytes,7,opt,name=adaptive_concurrency,json=adaptiveConcurrency,proto3" json:"adaptive_concurrency,omitempty"`
+	OriginalDst                   *originaldst.ListenerOriginalDst         `protobuf:"bytes,8,opt,name=original_dst,json=originalDst,proto3" json:"original_dst,omitempty"`
+	XXX_NoUnkeyedLiteral          struct{}                                 `json:"-"`
+	XXX_unrecognized              []byte                                   `json:"-"`
+	XXX_sizecache                 int32                                    `json:"-"`
 }
 
 func (m *ListenerPlugins) Reset()         { *m = ListenerPlugins{} }
@@ -88,6 +113,48 @@ func (m *ListenerPlugins) GetHttpConnectionManagerSettings() *hcm.HttpConnection
 	return nil
 }
 
+func (m *ListenerPlugins) GetListenerTuning() *tuning.ListenerTuning {
+	if m != nil {
+		return m.ListenerTuning
+	}
+	return nil
+}
+
+func (m *ListenerPlugins) GetWasm() *wasm.PluginSource {
+	if m != nil {
+		return m.Wasm
+	}
+	return nil
+}
+
+func (m *ListenerPlugins) GetLua() *lua.LuaFilter {
+	if m != nil {
+		return m.Lua
+	}
+	return nil
+}
+
+func (m *ListenerPlugins) GetGzip() *gzip.Gzip {
+	if m != nil {
+		return m.Gzip
+	}
+	return nil
+}
+
+func (m *ListenerPlugins) GetAdaptiveConcurrency() *adaptiveconcurrency.AdaptiveConcurrency {
+	if m != nil {
+		return m.AdaptiveConcurrency
+	}
+	return nil
+}
+
+func (m *ListenerPlugins) GetOriginalDst() *originaldst.ListenerOriginalDst {
+	if m != nil {
+		return m.OriginalDst
+	}
+	return nil
+}
+
 // Plugin-specific configuration that lives on virtual hosts
 // Each VirtualHostPlugin object contains configuration for a specific plugin
 // Note to developers: new Virtual Host Plugins must be added to this struct
@@ -149,6 +216,13 @@ type RoutePlugins struct {
 	Timeout              *time.Duration                       `protobuf:"bytes,4,opt,name=timeout,proto3,stdduration" json:"timeout,omitempty"`
 	Retries              *retries.RetryPolicy                 `protobuf:"bytes,5,opt,name=retries,proto3" json:"retries,omitempty"`
 	Extensions           *Extensions                          `protobuf:"bytes,6,opt,name=extensions,proto3" json:"extensions,omitempty"`
+	ConditionalHeaders   *transformation.ConditionalHeaders   `protobuf:"bytes,7,opt,name=conditional_headers,json=conditionalHeaders,proto3" json:"conditional_headers,omitempty"`
+	Websocket            *websocket.RouteWebSocket            `protobuf:"bytes,8,opt,name=websocket,proto3" json:"websocket,omitempty"`
+	DeadlinePropagation  *deadline.DeadlinePropagation        `protobuf:"bytes,9,opt,name=deadline_propagation,json=deadlinePropagation,proto3" json:"deadline_propagation,omitempty"`
+	LbHash               *lbhash.RouteActionHashConfig        `protobuf:"bytes,11,opt,name=lb_hash,json=lbHash,proto3" json:"lb_hash,omitempty"`
+	Lua                  *lua.RouteLua                        `protobuf:"bytes,12,opt,name=lua,proto3" json:"lua,omitempty"`
+	Gzip                 *gzip.RouteGzip                      `protobuf:"bytes,13,opt,name=gzip,proto3" json:"gzip,omitempty"`
+	ResponseTrailers     *trailers.ResponseTrailers           `protobuf:"bytes,14,opt,name=response_trailers,json=responseTrailers,proto3" json:"response_trailers,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                             `json:"-"`
 	XXX_unrecognized     []byte                               `json:"-"`
 	XXX_sizecache        int32                                `json:"-"`
@@ -220,6 +294,55 @@ func (m *RoutePlugins) GetExtensions() *Extensions {
 	return nil
 }
 
+func (m *RoutePlugins) GetConditionalHeaders() *transformation.ConditionalHeaders {
+	if m != nil {
+		return m.ConditionalHeaders
+	}
+	return nil
+}
+
+func (m *RoutePlugins) GetWebsocket() *websocket.RouteWebSocket {
+	if m != nil {
+		return m.Websocket
+	}
+	return nil
+}
+
+func (m *RoutePlugins) GetDeadlinePropagation() *deadline.DeadlinePropagation {
+	if m != nil {
+		return m.DeadlinePropagation
+	}
+	return nil
+}
+
+func (m *RoutePlugins) GetLbHash() *lbhash.RouteActionHashConfig {
+	if m != nil {
+		return m.LbHash
+	}
+	return nil
+}
+
+func (m *RoutePlugins) GetLua() *lua.RouteLua {
+	if m != nil {
+		return m.Lua
+	}
+	return nil
+}
+
+func (m *RoutePlugins) GetGzip() *gzip.RouteGzip {
+	if m != nil {
+		return m.Gzip
+	}
+	return nil
+}
+
+func (m *RoutePlugins) GetResponseTrailers() *trailers.ResponseTrailers {
+	if m != nil {
+		return m.ResponseTrailers
+	}
+	return nil
+}
+
 // Configuration for Destinations that are tied to the UpstreamSpec or ServiceSpec on that destination
 type DestinationSpec struct {
 	// Note to developers: new DestinationSpecs must be added to this oneof field
@@ -230,6 +353,11 @@ type DestinationSpec struct {
 	//	*DestinationSpec_Azure
 	//	*DestinationSpec_Rest
 	//	*DestinationSpec_Grpc
+	//	*DestinationSpec_Openfaas
+	//	*DestinationSpec_Alibaba
+	//	*DestinationSpec_Openwhisk
+	//	*DestinationSpec_External
+	//	*DestinationSpec_Thrift
 	DestinationType      isDestinationSpec_DestinationType `protobuf_oneof:"destination_type"`
 	XXX_NoUnkeyedLiteral struct{}                          `json:"-"`
 	XXX_unrecognized     []byte                            `json:"-"`
@@ -277,11 +405,31 @@ type DestinationSpec_Rest struct {
 type DestinationSpec_Grpc struct {
 	Grpc *grpc.DestinationSpec `protobuf:"bytes,4,opt,name=grpc,proto3,oneof"`
 }
+type DestinationSpec_Openfaas struct {
+	Openfaas *openfaas.DestinationSpec `protobuf:"bytes,5,opt,name=openfaas,proto3,oneof"`
+}
+type DestinationSpec_Alibaba struct {
+	Alibaba *alibaba.DestinationSpec `protobuf:"bytes,6,opt,name=alibaba,proto3,oneof"`
+}
+type DestinationSpec_Openwhisk struct {
+	Openwhisk *openwhisk.DestinationSpec `protobuf:"bytes,7,opt,name=openwhisk,proto3,oneof"`
+}
+type DestinationSpec_External struct {
+	External *external.DestinationSpec `protobuf:"bytes,8,opt,name=external,proto3,oneof"`
+}
+type DestinationSpec_Thrift struct {
+	Thrift *thrift.DestinationSpec `protobuf:"bytes,9,opt,name=thrift,proto3,oneof"`
+}
 
-func (*DestinationSpec_Aws) isDestinationSpec_DestinationType()   {}
-func (*DestinationSpec_Azure) isDestinationSpec_DestinationType() {}
-func (*DestinationSpec_Rest) isDestinationSpec_DestinationType()  {}
-func (*DestinationSpec_Grpc) isDestinationSpec_DestinationType()  {}
+func (*DestinationSpec_Aws) isDestinationSpec_DestinationType()       {}
+func (*DestinationSpec_Azure) isDestinationSpec_DestinationType()     {}
+func (*DestinationSpec_Rest) isDestinationSpec_DestinationType()      {}
+func (*DestinationSpec_Grpc) isDestinationSpec_DestinationType()      {}
+func (*DestinationSpec_Openfaas) isDestinationSpec_DestinationType()  {}
+func (*DestinationSpec_Alibaba) isDestinationSpec_DestinationType()   {}
+func (*DestinationSpec_Openwhisk) isDestinationSpec_DestinationType() {}
+func (*DestinationSpec_External) isDestinationSpec_DestinationType()  {}
+func (*DestinationSpec_Thrift) isDestinationSpec_DestinationType()    {}
 
 func (m *DestinationSpec) GetDestinationType() isDestinationSpec_DestinationType {
 	if m != nil {
@@ -318,6 +466,41 @@ func (m *DestinationSpec) GetGrpc() *grpc.DestinationSpec {
 	return nil
 }
 
+func (m *DestinationSpec) GetOpenfaas() *openfaas.DestinationSpec {
+	if x, ok := m.GetDestinationType().(*DestinationSpec_Openfaas); ok {
+		return x.Openfaas
+	}
+	return nil
+}
+
+func (m *DestinationSpec) GetAlibaba() *alibaba.DestinationSpec {
+	if x, ok := m.GetDestinationType().(*DestinationSpec_Alibaba); ok {
+		return x.Alibaba
+	}
+	return nil
+}
+
+func (m *DestinationSpec) GetOpenwhisk() *openwhisk.DestinationSpec {
+	if x, ok := m.GetDestinationType().(*DestinationSpec_Openwhisk); ok {
+		return x.Openwhisk
+	}
+	return nil
+}
+
+func (m *DestinationSpec) GetExternal() *external.DestinationSpec {
+	if x, ok := m.GetDestinationType().(*DestinationSpec_External); ok {
+		return x.External
+	}
+	return nil
+}
+
+func (m *DestinationSpec) GetThrift() *thrift.DestinationSpec {
+	if x, ok := m.GetDestinationType().(*DestinationSpec_Thrift); ok {
+		return x.Thrift
+	}
+	return nil
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*DestinationSpec) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _DestinationSpec_OneofMarshaler, _DestinationSpec_OneofUnmarshaler, _DestinationSpec_OneofSizer, []interface{}{
@@ -325,6 +508,11 @@ func (*DestinationSpec) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffe
 		(*DestinationSpec_Azure)(nil),
 		(*DestinationSpec_Rest)(nil),
 		(*DestinationSpec_Grpc)(nil),
+		(*DestinationSpec_Openfaas)(nil),
+		(*DestinationSpec_Alibaba)(nil),
+		(*DestinationSpec_Openwhisk)(nil),
+		(*DestinationSpec_External)(nil),
+		(*DestinationSpec_Thrift)(nil),
 	}
 }
 
@@ -352,6 +540,31 @@ func _DestinationSpec_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
 		if err := b.EncodeMessage(x.Grpc); err != nil {
 			return err
 		}
+	case *DestinationSpec_Openfaas:
+		_ = b.EncodeVarint(5<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Openfaas); err != nil {
+			return err
+		}
+	case *DestinationSpec_Alibaba:
+		_ = b.EncodeVarint(6<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Alibaba); err != nil {
+			return err
+		}
+	case *DestinationSpec_Openwhisk:
+		_ = b.EncodeVarint(7<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Openwhisk); err != nil {
+			return err
+		}
+	case *DestinationSpec_External:
+		_ = b.EncodeVarint(8<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.External); err != nil {
+			return err
+		}
+	case *DestinationSpec_Thrift:
+		_ = b.EncodeVarint(9<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Thrift); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("DestinationSpec.DestinationType has unexpected type %T", x)
@@ -394,6 +607,46 @@ func _DestinationSpec_OneofUnmarshaler(msg proto.Message, tag, wire int, b *prot
 		err := b.DecodeMessage(msg)
 		m.DestinationType = &DestinationSpec_Grpc{msg}
 		return true, err
+	case 5: // destination_type.openfaas
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(openfaas.DestinationSpec)
+		err := b.DecodeMessage(msg)
+		m.DestinationType = &DestinationSpec_Openfaas{msg}
+		return true, err
+	case 6: // destination_type.alibaba
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(alibaba.DestinationSpec)
+		err := b.DecodeMessage(msg)
+		m.DestinationType = &DestinationSpec_Alibaba{msg}
+		return true, err
+	case 7: // destination_type.openwhisk
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(openwhisk.DestinationSpec)
+		err := b.DecodeMessage(msg)
+		m.DestinationType = &DestinationSpec_Openwhisk{msg}
+		return true, err
+	case 8: // destination_type.external
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(external.DestinationSpec)
+		err := b.DecodeMessage(msg)
+		m.DestinationType = &DestinationSpec_External{msg}
+		return true, err
+	case 9: // destination_type.thrift
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(thrift.DestinationSpec)
+		err := b.DecodeMessage(msg)
+		m.DestinationType = &DestinationSpec_Thrift{msg}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -423,6 +676,31 @@ func _DestinationSpec_OneofSizer(msg proto.Message) (n int) {
 		n += 1 // tag and wire
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *DestinationSpec_Openfaas:
+		s := proto.Size(x.Openfaas)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *DestinationSpec_Alibaba:
+		s := proto.Size(x.Alibaba)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *DestinationSpec_Openwhisk:
+		s := proto.Size(x.Openwhisk)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *DestinationSpec_External:
+		s := proto.Size(x.External)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *DestinationSpec_Thrift:
+		s := proto.Size(x.Thrift)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -434,12 +712,16 @@ func _DestinationSpec_OneofSizer(msg proto.Message) (n int) {
 // Each upstream type is handled by a corresponding Gloo plugin.
 type UpstreamSpec struct {
 	SslConfig *UpstreamSslConfig `protobuf:"bytes,6,opt,name=ssl_config,json=sslConfig,proto3" json:"ssl_config,omitempty"`
-	// Circuite breakers for this upstream. if not set, the defaults ones from the Gloo settings will be used.
+	// Circuite breakers for this upstream. the thresholds not set here are taken from the defaults of the Gloo settings.
 	// if those are not set,  [envoy's defaults](https://www.envoyproxy.io/docs/envoy/latest/api-v2/api/v2/cluster/circuit_breaker.proto#envoy-api-msg-cluster-circuitbreakers)
 	// will be used.
 	CircuitBreakers    *CircuitBreakerConfig `protobuf:"bytes,7,opt,name=circuit_breakers,json=circuitBreakers,proto3" json:"circuit_breakers,omitempty"`
 	LoadBalancerConfig *LoadBalancerConfig   `protobuf:"bytes,8,opt,name=load_balancer_config,json=loadBalancerConfig,proto3" json:"load_balancer_config,omitempty"`
 	ConnectionConfig   *ConnectionConfig     `protobuf:"bytes,9,opt,name=connection_config,json=connectionConfig,proto3" json:"connection_config,omitempty"`
+	// Active health checks of the endpoints of this upstream.
+	HealthChecks []*HealthCheck `protobuf:"bytes,17,rep,name=health_checks,json=healthChecks,proto3" json:"health_checks,omitempty"`
+	// Ejects the endpoints of this upstream that fail the requests sent to them.
+	OutlierDetection *OutlierDetection `protobuf:"bytes,18,opt,name=outlier_detection,json=outlierDetection,proto3" json:"outlier_detection,omitempty"`
 	// Note to developers: new Upstream Plugins must be added to this oneof field
 	// to be usable by Gloo.
 	//
@@ -449,6 +731,14 @@ type UpstreamSpec struct {
 	//	*UpstreamSpec_Aws
 	//	*UpstreamSpec_Azure
 	//	*UpstreamSpec_Consul
+	//	*UpstreamSpec_Alibaba
+	//	*UpstreamSpec_Ec2
+	//	*UpstreamSpec_Cloudmap
+	//	*UpstreamSpec_Openwhisk
+	//	*UpstreamSpec_External
+	//	*UpstreamSpec_Nomad
+	//	*UpstreamSpec_Failover
+	//	*UpstreamSpec_OriginalDst
 	UpstreamType         isUpstreamSpec_UpstreamType `protobuf_oneof:"upstream_type"`
 	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
 	XXX_unrecognized     []byte                      `json:"-"`
@@ -499,12 +789,44 @@ type UpstreamSpec_Azure struct {
 type UpstreamSpec_Consul struct {
 	Consul *consul.UpstreamSpec `protobuf:"bytes,5,opt,name=consul,proto3,oneof"`
 }
+type UpstreamSpec_Alibaba struct {
+	Alibaba *alibaba.UpstreamSpec `protobuf:"bytes,10,opt,name=alibaba,proto3,oneof"`
+}
+type UpstreamSpec_Ec2 struct {
+	Ec2 *ec2.UpstreamSpec `protobuf:"bytes,11,opt,name=ec2,proto3,oneof"`
+}
+type UpstreamSpec_Cloudmap struct {
+	Cloudmap *cloudmap.UpstreamSpec `protobuf:"bytes,12,opt,name=cloudmap,proto3,oneof"`
+}
+type UpstreamSpec_Openwhisk struct {
+	Openwhisk *openwhisk.UpstreamSpec `protobuf:"bytes,13,opt,name=openwhisk,proto3,oneof"`
+}
+type UpstreamSpec_External struct {
+	External *external.UpstreamSpec `protobuf:"bytes,14,opt,name=external,proto3,oneof"`
+}
+type UpstreamSpec_Nomad struct {
+	Nomad *nomad.UpstreamSpec `protobuf:"bytes,16,opt,name=nomad,proto3,oneof"`
+}
+type UpstreamSpec_Failover struct {
+	Failover *failover.UpstreamSpec `protobuf:"bytes,19,opt,name=failover,proto3,oneof"`
+}
+type UpstreamSpec_OriginalDst struct {
+	OriginalDst *originaldst.UpstreamSpec `protobuf:"bytes,20,opt,name=original_dst,json=originalDst,proto3,oneof"`
+}
 
-func (*UpstreamSpec_Kube) isUpstreamSpec_UpstreamType()   {}
-func (*UpstreamSpec_Static) isUpstreamSpec_UpstreamType() {}
-func (*UpstreamSpec_Aws) isUpstreamSpec_UpstreamType()    {}
-func (*UpstreamSpec_Azure) isUpstreamSpec_UpstreamType()  {}
-func (*UpstreamSpec_Consul) isUpstreamSpec_UpstreamType() {}
+func (*UpstreamSpec_Kube) isUpstreamSpec_UpstreamType()        {}
+func (*UpstreamSpec_Static) isUpstreamSpec_UpstreamType()      {}
+func (*UpstreamSpec_Aws) isUpstreamSpec_UpstreamType()         {}
+func (*UpstreamSpec_Azure) isUpstreamSpec_UpstreamType()       {}
+func (*UpstreamSpec_Consul) isUpstreamSpec_UpstreamType()      {}
+func (*UpstreamSpec_Alibaba) isUpstreamSpec_UpstreamType()     {}
+func (*UpstreamSpec_Ec2) isUpstreamSpec_UpstreamType()         {}
+func (*UpstreamSpec_Cloudmap) isUpstreamSpec_UpstreamType()    {}
+func (*UpstreamSpec_Openwhisk) isUpstreamSpec_UpstreamType()   {}
+func (*UpstreamSpec_External) isUpstreamSpec_UpstreamType()    {}
+func (*UpstreamSpec_Nomad) isUpstreamSpec_UpstreamType()       {}
+func (*UpstreamSpec_Failover) isUpstreamSpec_UpstreamType()    {}
+func (*UpstreamSpec_OriginalDst) isUpstreamSpec_UpstreamType() {}
 
 func (m *UpstreamSpec) GetUpstreamType() isUpstreamSpec_UpstreamType {
 	if m != nil {
@@ -541,6 +863,20 @@ func (m *UpstreamSpec) GetConnectionConfig() *ConnectionConfig {
 	return nil
 }
 
+func (m *UpstreamSpec) GetHealthChecks() []*HealthCheck {
+	if m != nil {
+		return m.HealthChecks
+	}
+	return nil
+}
+
+func (m *UpstreamSpec) GetOutlierDetection() *OutlierDetection {
+	if m != nil {
+		return m.OutlierDetection
+	}
+	return nil
+}
+
 func (m *UpstreamSpec) GetKube() *kubernetes.UpstreamSpec {
 	if x, ok := m.GetUpstreamType().(*UpstreamSpec_Kube); ok {
 		return x.Kube
@@ -576,6 +912,62 @@ func (m *UpstreamSpec) GetConsul() *consul.UpstreamSpec {
 	return nil
 }
 
+func (m *UpstreamSpec) GetAlibaba() *alibaba.UpstreamSpec {
+	if x, ok := m.GetUpstreamType().(*UpstreamSpec_Alibaba); ok {
+		return x.Alibaba
+	}
+	return nil
+}
+
+func (m *UpstreamSpec) GetEc2() *ec2.UpstreamSpec {
+	if x, ok := m.GetUpstreamType().(*UpstreamSpec_Ec2); ok {
+		return x.Ec2
+	}
+	return nil
+}
+
+func (m *UpstreamSpec) GetCloudmap() *cloudmap.UpstreamSpec {
+	if x, ok := m.GetUpstreamType().(*UpstreamSpec_Cloudmap); ok {
+		return x.Cloudmap
+	}
+	return nil
+}
+
+func (m *UpstreamSpec) GetOpenwhisk() *openwhisk.UpstreamSpec {
+	if x, ok := m.GetUpstreamType().(*UpstreamSpec_Openwhisk); ok {
+		return x.Openwhisk
+	}
+	return nil
+}
+
+func (m *UpstreamSpec) GetExternal() *external.UpstreamSpec {
+	if x, ok := m.GetUpstreamType().(*UpstreamSpec_External); ok {
+		return x.External
+	}
+	return nil
+}
+
+func (m *UpstreamSpec) GetNomad() *nomad.UpstreamSpec {
+	if x, ok := m.GetUpstreamType().(*UpstreamSpec_Nomad); ok {
+		return x.Nomad
+	}
+	return nil
+}
+
+func (m *UpstreamSpec) GetFailover() *failover.UpstreamSpec {
+	if x, ok := m.GetUpstreamType().(*UpstreamSpec_Failover); ok {
+		return x.Failover
+	}
+	return nil
+}
+
+func (m *UpstreamSpec) GetOriginalDst() *originaldst.UpstreamSpec {
+	if x, ok := m.GetUpstreamType().(*UpstreamSpec_OriginalDst); ok {
+		return x.OriginalDst
+	}
+	return nil
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*UpstreamSpec) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _UpstreamSpec_OneofMarshaler, _UpstreamSpec_OneofUnmarshaler, _UpstreamSpec_OneofSizer, []interface{}{
@@ -584,6 +976,14 @@ func (*UpstreamSpec) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer)
 		(*UpstreamSpec_Aws)(nil),
 		(*UpstreamSpec_Azure)(nil),
 		(*UpstreamSpec_Consul)(nil),
+		(*UpstreamSpec_Alibaba)(nil),
+		(*UpstreamSpec_Ec2)(nil),
+		(*UpstreamSpec_Cloudmap)(nil),
+		(*UpstreamSpec_Openwhisk)(nil),
+		(*UpstreamSpec_External)(nil),
+		(*UpstreamSpec_Nomad)(nil),
+		(*UpstreamSpec_Failover)(nil),
+		(*UpstreamSpec_OriginalDst)(nil),
 	}
 }
 
@@ -616,6 +1016,46 @@ func _UpstreamSpec_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
 		if err := b.EncodeMessage(x.Consul); err != nil {
 			return err
 		}
+	case *UpstreamSpec_Alibaba:
+		_ = b.EncodeVarint(10<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Alibaba); err != nil {
+			return err
+		}
+	case *UpstreamSpec_Ec2:
+		_ = b.EncodeVarint(11<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Ec2); err != nil {
+			return err
+		}
+	case *UpstreamSpec_Cloudmap:
+		_ = b.EncodeVarint(12<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Cloudmap); err != nil {
+			return err
+		}
+	case *UpstreamSpec_Openwhisk:
+		_ = b.EncodeVarint(13<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Openwhisk); err != nil {
+			return err
+		}
+	case *UpstreamSpec_External:
+		_ = b.EncodeVarint(14<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.External); err != nil {
+			return err
+		}
+	case *UpstreamSpec_Nomad:
+		_ = b.EncodeVarint(16<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Nomad); err != nil {
+			return err
+		}
+	case *UpstreamSpec_Failover:
+		_ = b.EncodeVarint(19<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Failover); err != nil {
+			return err
+		}
+	case *UpstreamSpec_OriginalDst:
+		_ = b.EncodeVarint(20<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.OriginalDst); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("UpstreamSpec.UpstreamType has unexpected type %T", x)
@@ -666,6 +1106,70 @@ func _UpstreamSpec_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.B
 		err := b.DecodeMessage(msg)
 		m.UpstreamType = &UpstreamSpec_Consul{msg}
 		return true, err
+	case 10: // upstream_type.alibaba
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(alibaba.UpstreamSpec)
+		err := b.DecodeMessage(msg)
+		m.UpstreamType = &UpstreamSpec_Alibaba{msg}
+		return true, err
+	case 11: // upstream_type.ec2
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ec2.UpstreamSpec)
+		err := b.DecodeMessage(msg)
+		m.UpstreamType = &UpstreamSpec_Ec2{msg}
+		return true, err
+	case 12: // upstream_type.cloudmap
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(cloudmap.UpstreamSpec)
+		err := b.DecodeMessage(msg)
+		m.UpstreamType = &UpstreamSpec_Cloudmap{msg}
+		return true, err
+	case 13: // upstream_type.openwhisk
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(openwhisk.UpstreamSpec)
+		err := b.DecodeMessage(msg)
+		m.UpstreamType = &UpstreamSpec_Openwhisk{msg}
+		return true, err
+	case 14: // upstream_type.external
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(external.UpstreamSpec)
+		err := b.DecodeMessage(msg)
+		m.UpstreamType = &UpstreamSpec_External{msg}
+		return true, err
+	case 16: // upstream_type.nomad
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(nomad.UpstreamSpec)
+		err := b.DecodeMessage(msg)
+		m.UpstreamType = &UpstreamSpec_Nomad{msg}
+		return true, err
+	case 19: // upstream_type.failover
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(failover.UpstreamSpec)
+		err := b.DecodeMessage(msg)
+		m.UpstreamType = &UpstreamSpec_Failover{msg}
+		return true, err
+	case 20: // upstream_type.original_dst
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(originaldst.UpstreamSpec)
+		err := b.DecodeMessage(msg)
+		m.UpstreamType = &UpstreamSpec_OriginalDst{msg}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -700,15 +1204,55 @@ func _UpstreamSpec_OneofSizer(msg proto.Message) (n int) {
 		n += 1 // tag and wire
 		n += proto.SizeVarint(uint64(s))
 		n += s
-	case nil:
-	default:
-		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
-	}
-	return n
-}
-
-func init() {
-	proto.RegisterType((*ListenerPlugins)(nil), "gloo.solo.io.ListenerPlugins")
+	case *UpstreamSpec_Alibaba:
+		s := proto.Size(x.Alibaba)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *UpstreamSpec_Ec2:
+		s := proto.Size(x.Ec2)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *UpstreamSpec_Cloudmap:
+		s := proto.Size(x.Cloudmap)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *UpstreamSpec_Openwhisk:
+		s := proto.Size(x.Openwhisk)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *UpstreamSpec_External:
+		s := proto.Size(x.External)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *UpstreamSpec_Nomad:
+		s := proto.Size(x.Nomad)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *UpstreamSpec_Failover:
+		s := proto.Size(x.Failover)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *UpstreamSpec_OriginalDst:
+		s := proto.Size(x.OriginalDst)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+func init() {
+	proto.RegisterType((*ListenerPlugins)(nil), "gloo.solo.io.ListenerPlugins")
 	proto.RegisterType((*VirtualHostPlugins)(nil), "gloo.solo.io.VirtualHostPlugins")
 	proto.RegisterType((*RoutePlugins)(nil), "gloo.solo.io.RoutePlugins")
 	proto.RegisterType((*DestinationSpec)(nil), "gloo.solo.io.DestinationSpec")
@@ -720,67 +1264,117 @@ func init() {
 }
 
 var fileDescriptor_ae47d2df5fad2a45 = []byte{
-	// 959 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0xdd, 0x72, 0xdb, 0x44,
-	0x14, 0xc7, 0x71, 0x3e, 0x9c, 0x76, 0x49, 0x71, 0xd8, 0xe9, 0x85, 0xc9, 0x40, 0x9a, 0xf1, 0x05,
-	0x34, 0x65, 0xba, 0x82, 0x30, 0x53, 0xa0, 0x33, 0x85, 0x60, 0x87, 0x92, 0x81, 0x74, 0xc8, 0xa8,
-	0x7c, 0x14, 0x6e, 0x3c, 0xeb, 0xcd, 0x5a, 0xd9, 0x46, 0xd6, 0x6a, 0x76, 0x8f, 0x92, 0x86, 0x2b,
-	0xde, 0x81, 0x1b, 0x2e, 0x78, 0x00, 0x6e, 0x78, 0x05, 0x5e, 0x83, 0x4b, 0x66, 0x78, 0x12, 0x46,
-	0xbb, 0x47, 0x8a, 0x25, 0x4c, 0xc7, 0x56, 0x72, 0x61, 0x6b, 0xa5, 0x3d, 0xff, 0x9f, 0xf6, 0xe3,
-	0xbf, 0xe7, 0x88, 0x3c, 0x8c, 0x14, 0x9c, 0x64, 0x23, 0x26, 0xf4, 0x24, 0xb0, 0x3a, 0xd6, 0xf7,
-	0x95, 0x0e, 0xa2, 0x58, 0xeb, 0x20, 0x35, 0xfa, 0xb9, 0x14, 0x60, 0xfd, 0x1d, 0x4f, 0x55, 0x70,
-	0xf6, 0x7e, 0x90, 0xc6, 0x59, 0xa4, 0x12, 0xcb, 0x52, 0xa3, 0x41, 0xd3, 0xf5, 0xbc, 0x8b, 0xe5,
-	0x2a, 0xa6, 0xf4, 0xe6, 0x9b, 0x91, 0xd6, 0x51, 0x2c, 0x03, 0xd7, 0x37, 0xca, 0xc6, 0x81, 0x05,
-	0x93, 0x09, 0xf0, 0xb1, 0x9b, 0xb7, 0x23, 0x1d, 0x69, 0xd7, 0x0c, 0xf2, 0x16, 0x3e, 0x7d, 0xb0,
-	0xd0, 0xdb, 0xad, 0x8d, 0x51, 0xf7, 0x68, 0x21, 0x9d, 0x7c, 0x01, 0x32, 0xb1, 0x4a, 0x17, 0x03,
-	0xdf, 0xec, 0x2f, 0x24, 0x17, 0xca, 0x88, 0x4c, 0xc1, 0x70, 0x64, 0x24, 0x3f, 0x95, 0x06, 0x19,
-	0x7b, 0x0b, 0x31, 0x62, 0xcd, 0x8f, 0x87, 0x23, 0x1e, 0xf3, 0x44, 0x94, 0x84, 0xc5, 0x26, 0x21,
-	0x74, 0x92, 0x48, 0x01, 0x4a, 0x27, 0x8d, 0x26, 0x81, 0x3b, 0x17, 0xf0, 0x73, 0xf7, 0x43, 0xc6,
-	0x7e, 0x23, 0x86, 0x91, 0x16, 0xdc, 0xdf, 0x95, 0x28, 0x91, 0x49, 0x85, 0xfb, 0x43, 0xca, 0x61,
-	0x63, 0xca, 0xf0, 0x5c, 0x8e, 0xca, 0xc6, 0x95, 0x56, 0xe7, 0x44, 0x4c, 0xf2, 0x1f, 0x32, 0x1e,
-	0x37, 0x5b, 0xe1, 0x9f, 0x32, 0x23, 0xfd, 0x3f, 0x72, 0x0e, 0x1a, 0x71, 0x84, 0x4e, 0x6c, 0x16,
-	0xe3, 0x05, 0x49, 0x47, 0x8d, 0x48, 0xa7, 0xd9, 0x48, 0x9a, 0x44, 0x82, 0x9c, 0x6e, 0x22, 0xf1,
-	0xcb, 0x86, 0x0e, 0x00, 0xa3, 0x64, 0x79, 0xbd, 0xd2, 0x3c, 0x2d, 0x70, 0x50, 0x02, 0x2f, 0x48,
-	0x7a, 0xd6, 0x88, 0x04, 0x86, 0x27, 0x76, 0xac, 0xcd, 0x84, 0xe7, 0xc7, 0x24, 0x48, 0x8d, 0x1c,
-	0xab, 0x17, 0x43, 0x23, 0xcf, 0x8d, 0x02, 0x79, 0x9d, 0xe4, 0xea, 0x2d, 0x92, 0xbf, 0x6e, 0x44,
-	0x1e, 0xf3, 0x2c, 0x06, 0x95, 0x3c, 0xf7, 0x47, 0xdb, 0xdf, 0x22, 0x70, 0xab, 0x9e, 0x50, 0x8f,
-	0x33, 0x33, 0xf5, 0xc2, 0xde, 0x5f, 0x2d, 0xd2, 0x39, 0x54, 0x16, 0x64, 0x22, 0xcd, 0x91, 0xc7,
-	0xd1, 0xcf, 0xc8, 0x8d, 0xe2, 0x20, 0x74, 0x5b, 0xdb, 0xad, 0xbb, 0xaf, 0xee, 0xbe, 0xcd, 0x2e,
-	0x4f, 0x06, 0x66, 0xef, 0xe9, 0xb4, 0xcd, 0xbe, 0x30, 0xa9, 0xf8, 0x5e, 0x8e, 0xc2, 0xb5, 0xc8,
-	0x37, 0xe8, 0xcf, 0x2d, 0xb2, 0x7d, 0x02, 0x90, 0x0e, 0x2f, 0x33, 0xce, 0x70, 0xc2, 0x13, 0x1e,
-	0x49, 0x33, 0xb4, 0x12, 0x40, 0x25, 0x91, 0xed, 0x2e, 0x39, 0xf6, 0x87, 0xcc, 0x1d, 0x96, 0x59,
-	0xd8, 0x03, 0x80, 0x74, 0x50, 0x02, 0x9e, 0x78, 0xfd, 0x53, 0x94, 0x87, 0x6f, 0x9d, 0xbc, 0xac,
-	0xbb, 0xf7, 0x4b, 0x8b, 0xd0, 0xef, 0x94, 0x81, 0x8c, 0xc7, 0x07, 0xda, 0x42, 0x31, 0xb9, 0x8f,
-	0x08, 0xb9, 0x4c, 0xe5, 0x38, 0xbd, 0x6e, 0xf5, 0xb5, 0x9f, 0x97, 0xfd, 0xe1, 0x54, 0x2c, 0x1d,
-	0x90, 0x35, 0xb4, 0x6a, 0x77, 0xd5, 0xc9, 0x76, 0x58, 0x69, 0xdd, 0x59, 0xa3, 0x0f, 0x25, 0x98,
-	0x8b, 0x23, 0x1d, 0x2b, 0x71, 0x11, 0x16, 0xca, 0xde, 0x9f, 0xcb, 0x64, 0x3d, 0xd4, 0x19, 0xc8,
-	0x62, 0x3c, 0xcf, 0x48, 0xa7, 0xea, 0x84, 0x62, 0x50, 0x8c, 0xc9, 0xe4, 0x4c, 0x5f, 0x30, 0x9e,
-	0x2a, 0x76, 0xb6, 0xcb, 0xc6, 0x2a, 0x06, 0x69, 0x58, 0x3e, 0x67, 0xe6, 0x00, 0xdf, 0x54, 0x55,
-	0x61, 0x1d, 0x43, 0x3f, 0x25, 0x6d, 0xe7, 0x84, 0x62, 0xa1, 0xdf, 0x61, 0x68, 0x8c, 0x99, 0x83,
-	0xcd, 0x91, 0x8f, 0x5d, 0x78, 0x88, 0x32, 0xfa, 0x03, 0x79, 0xad, 0x6a, 0xff, 0xee, 0xb2, 0x03,
-	0xed, 0xb2, 0xba, 0x77, 0x67, 0x11, 0x8f, 0x9c, 0x34, 0xf4, 0xca, 0xf0, 0x56, 0x3a, 0x7d, 0x4b,
-	0x3f, 0x26, 0x6b, 0xa0, 0x26, 0x52, 0x67, 0xd0, 0x5d, 0x71, 0xcc, 0x37, 0x98, 0x37, 0x2a, 0x2b,
-	0x8c, 0xca, 0xf6, 0xd1, 0xa8, 0xfd, 0x95, 0x5f, 0xff, 0xbe, 0xd3, 0x0a, 0x8b, 0xf8, 0x6b, 0xd9,
-	0x86, 0x9a, 0x0b, 0xda, 0xf3, 0xbb, 0xa0, 0xf7, 0xc7, 0x12, 0xe9, 0xec, 0x4b, 0x0b, 0x2a, 0x71,
-	0xa3, 0x7b, 0x9a, 0x4a, 0x41, 0x1f, 0x91, 0x65, 0x7e, 0x5e, 0xec, 0xdb, 0x0e, 0x73, 0xa5, 0x71,
-	0xd6, 0x50, 0x6a, 0xba, 0x83, 0x57, 0xc2, 0x5c, 0x47, 0x07, 0x64, 0xd5, 0x65, 0x7a, 0xdc, 0xa7,
-	0x77, 0x19, 0xe6, 0xfd, 0xf9, 0x10, 0x5e, 0x4b, 0xf7, 0xc8, 0x4a, 0x5e, 0x4d, 0x71, 0x8b, 0xee,
-	0x31, 0x5f, 0x5a, 0xe7, 0x43, 0x38, 0x65, 0x4e, 0xc8, 0x8f, 0x2f, 0x6e, 0xc8, 0x3d, 0xe6, 0xcb,
-	0xea, 0x9c, 0x84, 0x3c, 0xb8, 0x4f, 0xc9, 0xc6, 0xf1, 0x65, 0xd7, 0x10, 0x2e, 0x52, 0xd9, 0xfb,
-	0x6d, 0x95, 0xac, 0x7f, 0x9b, 0x5a, 0x30, 0x92, 0x4f, 0xdc, 0x62, 0x7d, 0x42, 0x88, 0xb5, 0x71,
-	0x9e, 0x18, 0xc6, 0x2a, 0xc2, 0xa5, 0xbf, 0x53, 0xe5, 0x97, 0xf1, 0x36, 0x1e, 0xb8, 0xb0, 0xf0,
-	0xa6, 0x2d, 0x9a, 0xf4, 0x09, 0xd9, 0xa8, 0x7d, 0x4c, 0xd9, 0xee, 0x9a, 0xa3, 0xf4, 0xaa, 0x94,
-	0x81, 0x8f, 0xea, 0xfb, 0x20, 0x04, 0x75, 0x44, 0xe5, 0xa9, 0xa5, 0x21, 0xb9, 0x5d, 0xf9, 0xae,
-	0x2a, 0x06, 0x76, 0xc3, 0x21, 0xb7, 0xab, 0xc8, 0x43, 0xcd, 0x8f, 0xfb, 0x18, 0x88, 0x40, 0x1a,
-	0xff, 0xe7, 0x19, 0xfd, 0x8a, 0xbc, 0x3e, 0x95, 0xf7, 0x10, 0x78, 0xd3, 0x01, 0xb7, 0x6a, 0x63,
-	0x2c, 0xc3, 0x10, 0xb7, 0x21, 0x6a, 0x4f, 0xe8, 0x80, 0xac, 0xe4, 0x05, 0x17, 0xdd, 0x75, 0x9f,
-	0x4d, 0x57, 0xdf, 0x59, 0x9b, 0x33, 0xbd, 0xd8, 0xf9, 0xce, 0xe4, 0xf1, 0x74, 0x40, 0xda, 0xbe,
-	0x36, 0xe2, 0xee, 0xee, 0xb0, 0xa2, 0x54, 0xce, 0x81, 0x40, 0x29, 0x7d, 0xe8, 0x6d, 0xbe, 0x84,
-	0x25, 0xe1, 0x7f, 0x6d, 0x5e, 0x93, 0x3b, 0x8f, 0xef, 0x15, 0x1e, 0xf7, 0xfe, 0xbc, 0xfb, 0x32,
-	0x8f, 0xd7, 0xf4, 0x68, 0xf0, 0x01, 0x69, 0xfb, 0xcf, 0x98, 0xf2, 0xd8, 0x17, 0x5f, 0x35, 0xf3,
-	0x4c, 0xc1, 0xc7, 0xf6, 0x3b, 0xe4, 0x56, 0x86, 0x3d, 0xce, 0x9e, 0xfd, 0x07, 0xbf, 0xff, 0xb3,
-	0xd5, 0xfa, 0xf1, 0xbd, 0xf9, 0xca, 0x6e, 0x7a, 0x1a, 0x61, 0xe9, 0x1d, 0xb5, 0x5d, 0x9e, 0xfa,
-	0xe0, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x4a, 0x8d, 0x8f, 0x4e, 0xfb, 0x0c, 0x00, 0x00,
+	// 1757 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x4f, 0x73, 0xdc, 0xb6,
+	0x15, 0xaf, 0xa2, 0x7f, 0x36, 0x2c, 0x59, 0x32, 0xac, 0xc3, 0xd6, 0xd3, 0x3a, 0x1e, 0xb5, 0x93,
+	0xc4, 0x71, 0x8d, 0x6d, 0x95, 0xe6, 0x4f, 0xdd, 0x71, 0x62, 0x6b, 0x55, 0x55, 0x6e, 0x94, 0xda,
+	0x43, 0xa9, 0x8d, 0xdb, 0x69, 0x87, 0x83, 0xe5, 0x62, 0xb9, 0x88, 0xb0, 0x04, 0x07, 0x00, 0xa5,
+	0xc8, 0xa7, 0x7e, 0x87, 0x5e, 0x7a, 0xee, 0xa9, 0x97, 0x7e, 0xa6, 0xce, 0xf4, 0x2b, 0xf4, 0xd2,
+	0x63, 0x86, 0xc0, 0x03, 0xc9, 0xa5, 0x28, 0x85, 0x5a, 0xfa, 0x40, 0x12, 0x00, 0xdf, 0xfb, 0xf1,
+	0x11, 0xc0, 0xfb, 0xe1, 0xbd, 0x87, 0x9e, 0xc4, 0xdc, 0x4c, 0xb2, 0x21, 0x89, 0xe4, 0xb4, 0xaf,
+	0xa5, 0x90, 0x8f, 0xb9, 0xec, 0xc7, 0x42, 0xca, 0x7e, 0xaa, 0xe4, 0x37, 0x2c, 0x32, 0xda, 0xf5,
+	0x68, 0xca, 0xfb, 0xa7, 0xbf, 0xe8, 0xa7, 0x22, 0x8b, 0x79, 0xa2, 0x49, 0xaa, 0xa4, 0x91, 0x78,
+	0x2d, 0x7f, 0x45, 0x72, 0x2d, 0xc2, 0xe5, 0xbd, 0x1f, 0xc5, 0x52, 0xc6, 0x82, 0xf5, 0xed, 0xbb,
+	0x61, 0x36, 0xee, 0x6b, 0xa3, 0xb2, 0xc8, 0x38, 0xd9, 0x7b, 0x5b, 0xb1, 0x8c, 0xa5, 0x6d, 0xf6,
+	0xf3, 0x16, 0x8c, 0x7e, 0x72, 0xad, 0xaf, 0x6b, 0x2d, 0x40, 0xef, 0xe9, 0xb5, 0xf4, 0xd8, 0xb7,
+	0x86, 0x25, 0x9a, 0x4b, 0x6f, 0xf8, 0xbd, 0xdd, 0x6b, 0xa9, 0x47, 0x5c, 0x45, 0x19, 0x37, 0xe1,
+	0x50, 0x31, 0x7a, 0xc2, 0x14, 0x60, 0x3c, 0xbb, 0x16, 0x86, 0x90, 0x74, 0x14, 0x0e, 0xa9, 0xa0,
+	0x49, 0xc4, 0xd4, 0x5c, 0x3f, 0x11, 0xc9, 0x24, 0x61, 0x91, 0xe1, 0x32, 0x01, 0xf5, 0x2f, 0xae,
+	0xa5, 0x3e, 0x61, 0x54, 0x98, 0x49, 0x18, 0x4d, 0x58, 0x74, 0x02, 0x00, 0x7b, 0xd7, 0x02, 0x90,
+	0x99, 0x11, 0x9c, 0xa9, 0x70, 0xc4, 0xcc, 0x8c, 0x19, 0xbb, 0xf3, 0x6c, 0xa0, 0x3e, 0x3d, 0xb3,
+	0x17, 0x60, 0xfc, 0x6e, 0x3e, 0x0c, 0xc1, 0x87, 0x74, 0x48, 0xfd, 0x13, 0xb0, 0x7e, 0x3f, 0x17,
+	0x96, 0x4c, 0x59, 0x72, 0x36, 0xe1, 0xfa, 0xa4, 0x6c, 0x01, 0xde, 0xe1, 0x5c, 0x78, 0xf9, 0x96,
+	0x53, 0x09, 0x15, 0x45, 0xa3, 0xd3, 0x6c, 0xb1, 0x68, 0x27, 0xbf, 0x3a, 0x59, 0x14, 0x09, 0x99,
+	0x8d, 0xa6, 0x34, 0x2d, 0x1a, 0x73, 0xed, 0x02, 0x8f, 0xa6, 0x98, 0x36, 0xf6, 0xd6, 0x09, 0x25,
+	0x56, 0x69, 0x64, 0x6f, 0x9d, 0xfe, 0x2c, 0x5f, 0xb1, 0x31, 0xa5, 0x65, 0x03, 0xd0, 0x0e, 0xe6,
+	0x42, 0x33, 0x13, 0xc5, 0xc7, 0x06, 0x1e, 0x9d, 0xec, 0xca, 0x7f, 0x2c, 0x3c, 0x63, 0xc3, 0xa2,
+	0xd1, 0x69, 0x0f, 0x4c, 0xa2, 0x69, 0x7e, 0x75, 0xfb, 0xb7, 0x2c, 0xe1, 0x49, 0x0c, 0x8f, 0x4e,
+	0x2b, 0x77, 0x46, 0xf5, 0xd4, 0xde, 0x3a, 0x79, 0xdd, 0x19, 0x1b, 0x6a, 0x19, 0x9d, 0x30, 0x53,
+	0xb6, 0x3a, 0xcd, 0xf8, 0x88, 0xd1, 0x91, 0xe0, 0x09, 0x2b, 0x1a, 0x9d, 0x66, 0x4b, 0x0c, 0x27,
+	0x54, 0x4f, 0xe0, 0xd1, 0x69, 0xed, 0x44, 0x46, 0xf3, 0xab, 0x9b, 0xaf, 0xbc, 0xe1, 0xa9, 0xbd,
+	0x75, 0x9a, 0x21, 0xa3, 0x28, 0x17, 0x4c, 0x95, 0x0d, 0x40, 0x8b, 0xe6, 0x63, 0xe0, 0x11, 0x4d,
+	0x0d, 0x3f, 0x65, 0x91, 0x4c, 0xa2, 0x4c, 0x29, 0x96, 0x44, 0xe7, 0xc5, 0x58, 0x58, 0x19, 0x84,
+	0x8f, 0xec, 0xcf, 0xf7, 0x91, 0x37, 0x99, 0x62, 0xee, 0xde, 0x69, 0x39, 0x23, 0x99, 0xe8, 0x4c,
+	0xc0, 0xa3, 0x93, 0x45, 0x89, 0x9c, 0xd2, 0x91, 0xbb, 0x77, 0x5a, 0x8c, 0x31, 0xe5, 0x42, 0x9e,
+	0x32, 0x55, 0x34, 0x00, 0x2d, 0x98, 0x0b, 0x4d, 0x2a, 0x1e, 0xf3, 0x84, 0x8a, 0x91, 0x36, 0xd5,
+	0x36, 0x60, 0xbe, 0x9a, 0x0b, 0xf3, 0x24, 0x1b, 0x32, 0x95, 0x30, 0xc3, 0xaa, 0xcd, 0x4e, 0x87,
+	0xb6, 0x62, 0x46, 0x71, 0x56, 0x3c, 0x3b, 0xad, 0xa8, 0x36, 0xd4, 0xf0, 0x08, 0x1e, 0x80, 0xf4,
+	0x7a, 0x5e, 0xb7, 0x48, 0xf4, 0x58, 0xaa, 0x29, 0x35, 0x5c, 0x26, 0xfd, 0x54, 0xb1, 0x31, 0xff,
+	0x36, 0x54, 0xec, 0x4c, 0x71, 0xe3, 0x77, 0xdd, 0x5f, 0xdf, 0x06, 0x72, 0x24, 0x93, 0x11, 0xcf,
+	0x5b, 0x54, 0x84, 0x13, 0x46, 0x47, 0xa5, 0x07, 0xbe, 0x15, 0xc3, 0x67, 0xbb, 0x80, 0xfc, 0x72,
+	0xce, 0xcd, 0x99, 0x09, 0xc3, 0x93, 0x6f, 0x5c, 0xb0, 0xe7, 0xba, 0x00, 0x78, 0xbf, 0x1e, 0xe9,
+	0x8f, 0x32, 0x55, 0xf9, 0xe0, 0xf6, 0x3f, 0x97, 0xd1, 0xc6, 0x21, 0xd7, 0x86, 0x25, 0x4c, 0xbd,
+	0x72, 0x70, 0xf8, 0x39, 0xba, 0xe1, 0x8f, 0xc1, 0xde, 0xc2, 0x83, 0x85, 0x0f, 0x6e, 0xed, 0xbc,
+	0x47, 0xca, 0x73, 0xd1, 0x09, 0x91, 0x6a, 0x3e, 0x41, 0x7e, 0xab, 0xd2, 0xe8, 0x6b, 0x36, 0x0c,
+	0x56, 0x63, 0xd7, 0xc0, 0x7f, 0x5b, 0x40, 0x0f, 0x26, 0xc6, 0xa4, 0x61, 0x19, 0x0a, 0x87, 0x53,
+	0x9a, 0xd0, 0x98, 0xa9, 0x50, 0x33, 0x63, 0x78, 0x12, 0xeb, 0xde, 0x3b, 0x16, 0xfb, 0x53, 0x62,
+	0x8f, 0xca, 0x26, 0xd8, 0x03, 0x63, 0xd2, 0x41, 0x01, 0xf0, 0x95, 0xd3, 0x3f, 0x02, 0xf5, 0xe0,
+	0xc7, 0x93, 0xab, 0x5e, 0xe3, 0x63, 0xb4, 0x21, 0xe0, 0xc7, 0x42, 0x77, 0x8a, 0xf6, 0x16, 0xed,
+	0x07, 0x1f, 0x11, 0x7f, 0xa8, 0x36, 0x7d, 0xd3, 0x4f, 0xc6, 0xb1, 0x95, 0x09, 0x6e, 0x8b, 0x99,
+	0x3e, 0xfe, 0x35, 0x5a, 0xca, 0x8f, 0xd2, 0xde, 0x92, 0x85, 0x7a, 0x9f, 0xb8, 0x73, 0xb5, 0x09,
+	0xc8, 0xcd, 0xe6, 0x91, 0xcc, 0x54, 0xc4, 0x02, 0xab, 0x84, 0x3f, 0x46, 0x8b, 0x22, 0xa3, 0xbd,
+	0x65, 0xab, 0xfb, 0x13, 0x62, 0x8f, 0x99, 0x46, 0x1b, 0x32, 0xba, 0xcf, 0x85, 0x61, 0x2a, 0xc8,
+	0xe5, 0xf1, 0x47, 0x68, 0x29, 0x3f, 0x4c, 0x7a, 0x2b, 0x56, 0xef, 0x5d, 0xe2, 0x4e, 0x96, 0xc6,
+	0x75, 0x78, 0xc3, 0xd3, 0xc0, 0x0a, 0xe3, 0x14, 0x6d, 0x35, 0xd1, 0x7b, 0x6f, 0xd5, 0x82, 0x3c,
+	0x25, 0x0d, 0xe7, 0x41, 0x33, 0xe6, 0x73, 0x10, 0x1c, 0x94, 0x82, 0xc1, 0x5d, 0x7a, 0x71, 0x10,
+	0xbf, 0x46, 0x6b, 0x9e, 0xcb, 0xc2, 0x91, 0x36, 0xbd, 0x1b, 0xf6, 0x4b, 0x1f, 0x93, 0x19, 0x82,
+	0xbb, 0x6a, 0xca, 0x5f, 0x82, 0xe0, 0x9e, 0x36, 0xc1, 0x2d, 0x59, 0x76, 0xb6, 0xff, 0xbe, 0x80,
+	0xf0, 0x1f, 0xb9, 0x32, 0x19, 0x15, 0x07, 0x52, 0x1b, 0xbf, 0x4f, 0x3f, 0x43, 0xa8, 0x4c, 0x17,
+	0x61, 0xa7, 0xf6, 0x66, 0xa1, 0x7f, 0x53, 0xbc, 0x0f, 0x2a, 0xb2, 0x78, 0x80, 0x56, 0x81, 0xd4,
+	0x60, 0x31, 0x1e, 0x92, 0x82, 0xe4, 0x9a, 0x2c, 0x0c, 0x98, 0x51, 0xe7, 0xaf, 0xa4, 0xe0, 0xd1,
+	0x79, 0xe0, 0x35, 0xb7, 0xff, 0xbf, 0x8a, 0xd6, 0x02, 0x99, 0x19, 0xe6, 0xed, 0x79, 0x8d, 0x36,
+	0x66, 0x9d, 0xda, 0x1b, 0x45, 0x08, 0x4b, 0x4e, 0xe5, 0x39, 0xa1, 0x29, 0x27, 0xa7, 0x3b, 0x64,
+	0x6c, 0xd7, 0x96, 0xe4, 0xdb, 0x97, 0x58, 0x80, 0xe3, 0x59, 0xad, 0xa0, 0x0e, 0x83, 0xbf, 0x40,
+	0x2b, 0xd6, 0xa9, 0xbd, 0xcf, 0xbc, 0x4f, 0xc0, 0xc7, 0x1b, 0x8d, 0xcd, 0x21, 0xf7, 0xad, 0x78,
+	0x00, 0x6a, 0xf8, 0x4f, 0xe8, 0xf6, 0x2c, 0x51, 0x82, 0x2f, 0xec, 0x90, 0x3a, 0x0d, 0x35, 0x6e,
+	0x65, 0xab, 0x1a, 0x38, 0xcd, 0x60, 0x3d, 0xad, 0x76, 0xf1, 0xaf, 0xd0, 0xaa, 0xe1, 0x53, 0x26,
+	0x33, 0x03, 0x4e, 0xf1, 0x43, 0xe2, 0x38, 0x87, 0x78, 0xce, 0x21, 0x7b, 0xc0, 0x39, 0xbb, 0x4b,
+	0xff, 0xf8, 0xcf, 0xbb, 0x0b, 0x81, 0x97, 0x7f, 0x2b, 0xcb, 0x50, 0xdb, 0x05, 0x2b, 0xd7, 0xd8,
+	0x05, 0x13, 0x74, 0xb7, 0x81, 0xe3, 0xc1, 0x43, 0x3e, 0x6d, 0x35, 0x33, 0x83, 0x52, 0xff, 0xc0,
+	0xa9, 0x07, 0x38, 0xba, 0x30, 0x86, 0x0f, 0xd1, 0xcd, 0x22, 0x6a, 0x06, 0xbf, 0x20, 0xa4, 0x12,
+	0x47, 0x5f, 0xba, 0x8c, 0x5f, 0xb3, 0xe1, 0x91, 0x95, 0x09, 0x4a, 0x00, 0xcc, 0xd0, 0x96, 0x0f,
+	0x9a, 0xc3, 0x54, 0xc9, 0x94, 0xc6, 0xd6, 0xc2, 0xde, 0x4d, 0x58, 0xd2, 0x32, 0xa2, 0x6e, 0xc2,
+	0xdd, 0x83, 0xb7, 0xaf, 0x4a, 0xcd, 0xe0, 0xee, 0xe8, 0xe2, 0x20, 0xfe, 0x12, 0xad, 0x8a, 0x61,
+	0x98, 0x07, 0xd4, 0xbd, 0x5b, 0x80, 0xec, 0xe3, 0xeb, 0x4b, 0xed, 0x7d, 0x6e, 0xa9, 0xf8, 0x80,
+	0xea, 0xc9, 0x40, 0x26, 0x63, 0x1e, 0x07, 0x2b, 0x62, 0x98, 0xf7, 0xf0, 0x2f, 0x1d, 0xf5, 0xad,
+	0x59, 0xa0, 0xed, 0xcb, 0xa9, 0xcf, 0xa2, 0x1c, 0x66, 0xd4, 0x31, 0xdf, 0x67, 0xc0, 0x7c, 0xeb,
+	0x56, 0xed, 0xa7, 0x57, 0x30, 0x9f, 0xd5, 0xab, 0xd0, 0xdf, 0x5f, 0xd0, 0x1d, 0xc5, 0x74, 0x2a,
+	0x13, 0xcd, 0x42, 0x1f, 0x3f, 0xf7, 0x6e, 0x5b, 0x98, 0x3e, 0x29, 0x03, 0xea, 0xe6, 0x5d, 0xe6,
+	0xf4, 0x8e, 0x41, 0x2a, 0xd8, 0x54, 0xb5, 0x91, 0xed, 0x7f, 0x2f, 0xa3, 0x8d, 0x3d, 0xa6, 0x0d,
+	0x4f, 0xec, 0x54, 0x1d, 0xa5, 0x2c, 0xc2, 0x4f, 0xd1, 0x22, 0x3d, 0xf3, 0x1e, 0xff, 0x90, 0xd0,
+	0xb3, 0x4b, 0xe0, 0x6b, 0x7a, 0x07, 0x3f, 0x08, 0x72, 0x3d, 0x3c, 0x40, 0xcb, 0x36, 0x6e, 0x06,
+	0x0f, 0x7f, 0x44, 0x20, 0x8a, 0x6e, 0x07, 0xe1, 0x74, 0xf1, 0x33, 0xb4, 0x94, 0x27, 0xfa, 0xe0,
+	0xdc, 0x1f, 0x12, 0x97, 0xf5, 0xb7, 0x83, 0xb0, 0x9a, 0x39, 0x42, 0x7e, 0x86, 0x83, 0x2b, 0x7f,
+	0x48, 0x5c, 0xc6, 0xdf, 0x12, 0x21, 0x17, 0xc6, 0x87, 0xe8, 0x86, 0x4f, 0xee, 0xc1, 0xab, 0x09,
+	0x29, 0xb3, 0xfd, 0x76, 0x48, 0x05, 0x02, 0x7e, 0x81, 0x56, 0xa1, 0x66, 0x04, 0xae, 0xfd, 0x98,
+	0x14, 0x35, 0xa4, 0x76, 0x58, 0x5e, 0x1f, 0xbf, 0x44, 0x37, 0x8b, 0x82, 0x11, 0x38, 0x79, 0x9f,
+	0xc8, 0xb2, 0x84, 0xd4, 0x0e, 0xae, 0xc4, 0xc8, 0xff, 0xd4, 0x97, 0x8c, 0x0a, 0xa7, 0x2e, 0x6b,
+	0x48, 0x2d, 0xff, 0xd4, 0x2b, 0xe0, 0x7d, 0xb4, 0xe2, 0x0a, 0x19, 0xe0, 0xc7, 0x3f, 0x23, 0xae,
+	0xdb, 0x16, 0x09, 0xb4, 0x77, 0x31, 0xda, 0x1c, 0x95, 0x2f, 0x43, 0x73, 0x9e, 0xb2, 0xed, 0xff,
+	0x21, 0xb4, 0xf6, 0x87, 0x54, 0x1b, 0xc5, 0xe8, 0xd4, 0x6e, 0xd6, 0xcf, 0x11, 0xd2, 0x5a, 0xe4,
+	0x81, 0xc1, 0x98, 0xc7, 0x65, 0x60, 0x51, 0xfd, 0x42, 0x21, 0xaf, 0x05, 0xf8, 0xf2, 0x4d, 0xed,
+	0x9b, 0xf8, 0x2b, 0xb4, 0x59, 0x2b, 0xb5, 0x7a, 0xde, 0xdc, 0xae, 0x11, 0xa4, 0x93, 0xda, 0x75,
+	0x42, 0x00, 0xb4, 0x11, 0xcd, 0x8c, 0x6a, 0x1c, 0xa0, 0xad, 0x99, 0xaa, 0xab, 0x37, 0xcc, 0xcd,
+	0xea, 0x83, 0x5a, 0xb8, 0x20, 0xe9, 0x68, 0x17, 0x04, 0x01, 0x10, 0x8b, 0x0b, 0x63, 0xf8, 0x4b,
+	0x74, 0xa7, 0x12, 0x7c, 0x02, 0xa0, 0x9b, 0xda, 0xfb, 0x17, 0x48, 0x1c, 0xc4, 0x00, 0x6e, 0x33,
+	0xaa, 0x8d, 0xe0, 0xcf, 0xd1, 0x7a, 0xb5, 0x2a, 0xab, 0x7b, 0x77, 0x1e, 0x2c, 0xba, 0xa3, 0x6e,
+	0x26, 0x5e, 0xb5, 0x22, 0x83, 0x5c, 0x22, 0x58, 0x9b, 0x94, 0x1d, 0x9d, 0x1b, 0x73, 0xa1, 0x28,
+	0xdb, 0xc3, 0x4d, 0xc6, 0xbc, 0x74, 0x62, 0x7b, 0x5e, 0x2a, 0xd8, 0x94, 0xb5, 0x11, 0x3c, 0x40,
+	0x4b, 0x79, 0x86, 0x07, 0x54, 0xf3, 0x98, 0x54, 0xd3, 0xbd, 0xa6, 0xbd, 0x52, 0x5d, 0xf9, 0xdc,
+	0x4d, 0x73, 0x79, 0x3c, 0x40, 0x2b, 0x2e, 0x19, 0x03, 0x57, 0x7f, 0x48, 0x7c, 0x6e, 0xd6, 0x02,
+	0x02, 0x54, 0xf1, 0x13, 0xc7, 0x79, 0xef, 0x40, 0x92, 0x70, 0x29, 0xe7, 0xd5, 0xd4, 0x2d, 0xe1,
+	0x3d, 0xf3, 0x84, 0xe7, 0xc8, 0xea, 0x83, 0xab, 0x08, 0xaf, 0xa6, 0x0f, 0x6c, 0x37, 0x40, 0x2b,
+	0xae, 0x42, 0x50, 0x44, 0x0f, 0xae, 0xdb, 0xee, 0x17, 0x9c, 0x2c, 0xde, 0x2f, 0x09, 0x06, 0x01,
+	0xe7, 0x5d, 0x49, 0x30, 0x35, 0x98, 0x82, 0x5d, 0x9e, 0xa0, 0x45, 0x16, 0xed, 0xc0, 0x49, 0xf9,
+	0x1e, 0xb1, 0x25, 0xe0, 0x36, 0x53, 0xc1, 0xa2, 0x1d, 0xfc, 0x02, 0xdd, 0xf0, 0x95, 0x5e, 0x38,
+	0x21, 0x1f, 0x91, 0xb2, 0xf4, 0xdb, 0x02, 0xa5, 0x50, 0xcf, 0x23, 0x8d, 0x92, 0xe4, 0xd6, 0x81,
+	0x48, 0xbe, 0x87, 0xe4, 0x6a, 0x60, 0x15, 0x86, 0x7b, 0x51, 0x61, 0xb8, 0xdb, 0x60, 0xd8, 0xd5,
+	0x0c, 0x57, 0x37, 0xac, 0xa0, 0xb7, 0x67, 0x68, 0xd9, 0x56, 0x61, 0x7a, 0x9b, 0xb0, 0xdc, 0xb6,
+	0xd7, 0x6e, 0xb9, 0xad, 0x68, 0x6e, 0x8c, 0x2f, 0xbe, 0xf4, 0xee, 0x82, 0x31, 0x65, 0x35, 0xa6,
+	0x8d, 0x31, 0x5e, 0x1a, 0x1f, 0xd5, 0x52, 0x95, 0x2d, 0x7f, 0x4e, 0x7d, 0x5f, 0xaa, 0x52, 0x43,
+	0xac, 0x66, 0x29, 0xbb, 0x1b, 0x68, 0x3d, 0x83, 0xd7, 0x96, 0x75, 0x77, 0x3f, 0xf9, 0xd7, 0x7f,
+	0xef, 0x2f, 0xfc, 0xf9, 0xe7, 0xed, 0x52, 0xfa, 0xf4, 0x24, 0x86, 0xb4, 0x7e, 0xb8, 0x62, 0x03,
+	0xe7, 0x8f, 0xbe, 0x1b, 0x00, 0x53, 0x24, 0xe8, 0x2a, 0xf0, 0x1b, 0x00, 0x00,
 }
 
 func (this *ListenerPlugins) Equal(that interface{}) bool {
@@ -808,6 +1402,24 @@ func (this *ListenerPlugins) Equal(that interface{}) bool {
 	if !this.HttpConnectionManagerSettings.Equal(that1.HttpConnectionManagerSettings) {
 		return false
 	}
+	if !this.ListenerTuning.Equal(that1.ListenerTuning) {
+		return false
+	}
+	if !this.Wasm.Equal(that1.Wasm) {
+		return false
+	}
+	if !this.Lua.Equal(that1.Lua) {
+		return false
+	}
+	if !this.Gzip.Equal(that1.Gzip) {
+		return false
+	}
+	if !this.AdaptiveConcurrency.Equal(that1.AdaptiveConcurrency) {
+		return false
+	}
+	if !this.OriginalDst.Equal(that1.OriginalDst) {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -886,6 +1498,27 @@ func (this *RoutePlugins) Equal(that interface{}) bool {
 	if !this.Extensions.Equal(that1.Extensions) {
 		return false
 	}
+	if !this.ConditionalHeaders.Equal(that1.ConditionalHeaders) {
+		return false
+	}
+	if !this.Websocket.Equal(that1.Websocket) {
+		return false
+	}
+	if !this.DeadlinePropagation.Equal(that1.DeadlinePropagation) {
+		return false
+	}
+	if !this.LbHash.Equal(that1.LbHash) {
+		return false
+	}
+	if !this.Lua.Equal(that1.Lua) {
+		return false
+	}
+	if !this.Gzip.Equal(that1.Gzip) {
+		return false
+	}
+	if !this.ResponseTrailers.Equal(that1.ResponseTrailers) {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -1020,6 +1653,126 @@ func (this *DestinationSpec_Grpc) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *DestinationSpec_Openfaas) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DestinationSpec_Openfaas)
+	if !ok {
+		that2, ok := that.(DestinationSpec_Openfaas)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Openfaas.Equal(that1.Openfaas) {
+		return false
+	}
+	return true
+}
+func (this *DestinationSpec_Alibaba) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DestinationSpec_Alibaba)
+	if !ok {
+		that2, ok := that.(DestinationSpec_Alibaba)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Alibaba.Equal(that1.Alibaba) {
+		return false
+	}
+	return true
+}
+func (this *DestinationSpec_Openwhisk) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DestinationSpec_Openwhisk)
+	if !ok {
+		that2, ok := that.(DestinationSpec_Openwhisk)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Openwhisk.Equal(that1.Openwhisk) {
+		return false
+	}
+	return true
+}
+func (this *DestinationSpec_External) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DestinationSpec_External)
+	if !ok {
+		that2, ok := that.(DestinationSpec_External)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.External.Equal(that1.External) {
+		return false
+	}
+	return true
+}
+func (this *DestinationSpec_Thrift) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DestinationSpec_Thrift)
+	if !ok {
+		that2, ok := that.(DestinationSpec_Thrift)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Thrift.Equal(that1.Thrift) {
+		return false
+	}
+	return true
+}
 func (this *UpstreamSpec) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -1051,6 +1804,17 @@ func (this *UpstreamSpec) Equal(that interface{}) bool {
 	if !this.ConnectionConfig.Equal(that1.ConnectionConfig) {
 		return false
 	}
+	if len(this.HealthChecks) != len(that1.HealthChecks) {
+		return false
+	}
+	for i := range this.HealthChecks {
+		if !this.HealthChecks[i].Equal(that1.HealthChecks[i]) {
+			return false
+		}
+	}
+	if !this.OutlierDetection.Equal(that1.OutlierDetection) {
+		return false
+	}
 	if that1.UpstreamType == nil {
 		if this.UpstreamType != nil {
 			return false
@@ -1185,3 +1949,195 @@ func (this *UpstreamSpec_Consul) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *UpstreamSpec_Alibaba) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UpstreamSpec_Alibaba)
+	if !ok {
+		that2, ok := that.(UpstreamSpec_Alibaba)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Alibaba.Equal(that1.Alibaba) {
+		return false
+	}
+	return true
+}
+func (this *UpstreamSpec_Ec2) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UpstreamSpec_Ec2)
+	if !ok {
+		that2, ok := that.(UpstreamSpec_Ec2)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Ec2.Equal(that1.Ec2) {
+		return false
+	}
+	return true
+}
+func (this *UpstreamSpec_Cloudmap) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UpstreamSpec_Cloudmap)
+	if !ok {
+		that2, ok := that.(UpstreamSpec_Cloudmap)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Cloudmap.Equal(that1.Cloudmap) {
+		return false
+	}
+	return true
+}
+func (this *UpstreamSpec_Openwhisk) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UpstreamSpec_Openwhisk)
+	if !ok {
+		that2, ok := that.(UpstreamSpec_Openwhisk)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Openwhisk.Equal(that1.Openwhisk) {
+		return false
+	}
+	return true
+}
+func (this *UpstreamSpec_External) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UpstreamSpec_External)
+	if !ok {
+		that2, ok := that.(UpstreamSpec_External)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.External.Equal(that1.External) {
+		return false
+	}
+	return true
+}
+func (this *UpstreamSpec_Nomad) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UpstreamSpec_Nomad)
+	if !ok {
+		that2, ok := that.(UpstreamSpec_Nomad)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Nomad.Equal(that1.Nomad) {
+		return false
+	}
+	return true
+}
+func (this *UpstreamSpec_Failover) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UpstreamSpec_Failover)
+	if !ok {
+		that2, ok := that.(UpstreamSpec_Failover)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Failover.Equal(that1.Failover) {
+		return false
+	}
+	return true
+}
+func (this *UpstreamSpec_OriginalDst) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UpstreamSpec_OriginalDst)
+	if !ok {
+		that2, ok := that.(UpstreamSpec_OriginalDst)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.OriginalDst.Equal(that1.OriginalDst) {
+		return false
+	}
+	return true
+}
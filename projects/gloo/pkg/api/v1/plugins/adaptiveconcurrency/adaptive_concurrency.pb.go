@@ -0,0 +1,228 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: github.com/solo-io/gloo/projects/gloo/api/v1/plugins/adaptiveconcurrency/adaptive_concurrency.proto
+
+package adaptiveconcurrency
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	math "math"
+	time "time"
+
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	types "github.com/gogo/protobuf/types"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+// Limits the concurrent requests of an http listener to its upstreams, adapting the limit to the latency of the
+// requests, so that the overloaded upstreams are protected without static rate limits. The requests above the limit
+// are rejected with a 503.
+// The limit grows while the latency of the requests stays close to the minimum latency, measured periodically with the
+// limit lowered to the minimum concurrency. See the
+// [envoy adaptive concurrency filter](https://www.envoyproxy.io/docs/envoy/latest/configuration/http/http_filters/adaptive_concurrency_filter)
+// for the gradient of the limit. It requires an envoy build supporting it, which must be listed in the envoy
+// extensions of the settings as `envoy.filters.http.adaptive_concurrency`: the listeners are rejected otherwise.
+type AdaptiveConcurrency struct {
+	// The percentile of the latencies of the sampled requests compared to the minimum latency, from 0 to 100.
+	// Defaults to 50.
+	SampleAggregatePercentile *types.DoubleValue `protobuf:"bytes,1,opt,name=sample_aggregate_percentile,json=sampleAggregatePercentile,proto3" json:"sample_aggregate_percentile,omitempty"`
+	// The maximum of the concurrency limit. Defaults to 1000.
+	MaxConcurrencyLimit *types.UInt32Value `protobuf:"bytes,2,opt,name=max_concurrency_limit,json=maxConcurrencyLimit,proto3" json:"max_concurrency_limit,omitempty"`
+	// The interval the concurrency limit is updated at, from the latencies of the requests sampled during the interval.
+	// Required.
+	ConcurrencyUpdateInterval *time.Duration `protobuf:"bytes,3,opt,name=concurrency_update_interval,json=concurrencyUpdateInterval,proto3,stdduration" json:"concurrency_update_interval,omitempty"`
+	// The interval the minimum latency is measured at. Required.
+	MinRttCalcInterval *time.Duration `protobuf:"bytes,4,opt,name=min_rtt_calc_interval,json=minRttCalcInterval,proto3,stdduration" json:"min_rtt_calc_interval,omitempty"`
+	// The number of requests sampled to measure the minimum latency. Defaults to 50.
+	MinRttCalcRequestCount *types.UInt32Value `protobuf:"bytes,5,opt,name=min_rtt_calc_request_count,json=minRttCalcRequestCount,proto3" json:"min_rtt_calc_request_count,omitempty"`
+	// The percentage of the interval the measurements of the minimum latency are randomly delayed by, from 0 to 100,
+	// so that the instances of envoy do not measure it together. Defaults to 15.
+	MinRttCalcJitter *types.DoubleValue `protobuf:"bytes,6,opt,name=min_rtt_calc_jitter,json=minRttCalcJitter,proto3" json:"min_rtt_calc_jitter,omitempty"`
+	// The concurrency limit while the minimum latency is measured. Defaults to 3.
+	MinConcurrency       *types.UInt32Value `protobuf:"bytes,7,opt,name=min_concurrency,json=minConcurrency,proto3" json:"min_concurrency,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *AdaptiveConcurrency) Reset()         { *m = AdaptiveConcurrency{} }
+func (m *AdaptiveConcurrency) String() string { return proto.CompactTextString(m) }
+func (*AdaptiveConcurrency) ProtoMessage()    {}
+func (*AdaptiveConcurrency) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d5de43a36048f47, []int{0}
+}
+func (m *AdaptiveConcurrency) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AdaptiveConcurrency.Unmarshal(m, b)
+}
+func (m *AdaptiveConcurrency) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AdaptiveConcurrency.Marshal(b, m, deterministic)
+}
+func (m *AdaptiveConcurrency) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdaptiveConcurrency.Merge(m, src)
+}
+func (m *AdaptiveConcurrency) XXX_Size() int {
+	return xxx_messageInfo_AdaptiveConcurrency.Size(m)
+}
+func (m *AdaptiveConcurrency) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdaptiveConcurrency.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdaptiveConcurrency proto.InternalMessageInfo
+
+func (m *AdaptiveConcurrency) GetSampleAggregatePercentile() *types.DoubleValue {
+	if m != nil {
+		return m.SampleAggregatePercentile
+	}
+	return nil
+}
+
+func (m *AdaptiveConcurrency) GetMaxConcurrencyLimit() *types.UInt32Value {
+	if m != nil {
+		return m.MaxConcurrencyLimit
+	}
+	return nil
+}
+
+func (m *AdaptiveConcurrency) GetConcurrencyUpdateInterval() *time.Duration {
+	if m != nil {
+		return m.ConcurrencyUpdateInterval
+	}
+	return nil
+}
+
+func (m *AdaptiveConcurrency) GetMinRttCalcInterval() *time.Duration {
+	if m != nil {
+		return m.MinRttCalcInterval
+	}
+	return nil
+}
+
+func (m *AdaptiveConcurrency) GetMinRttCalcRequestCount() *types.UInt32Value {
+	if m != nil {
+		return m.MinRttCalcRequestCount
+	}
+	return nil
+}
+
+func (m *AdaptiveConcurrency) GetMinRttCalcJitter() *types.DoubleValue {
+	if m != nil {
+		return m.MinRttCalcJitter
+	}
+	return nil
+}
+
+func (m *AdaptiveConcurrency) GetMinConcurrency() *types.UInt32Value {
+	if m != nil {
+		return m.MinConcurrency
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*AdaptiveConcurrency)(nil), "adaptiveconcurrency.plugins.gloo.solo.io.AdaptiveConcurrency")
+}
+
+func init() {
+	proto.RegisterFile("github.com/solo-io/gloo/projects/gloo/api/v1/plugins/adaptiveconcurrency/adaptive_concurrency.proto", fileDescriptor_1d5de43a36048f47)
+}
+
+var fileDescriptor_1d5de43a36048f47 = []byte{
+	// 425 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0xd3, 0xdd, 0x6a, 0x14, 0x31,
+	0x14, 0x07, 0x70, 0x56, 0xd7, 0x0a, 0x11, 0x54, 0x66, 0xad, 0xcc, 0xb6, 0x52, 0xc5, 0xab, 0xde,
+	0x98, 0x60, 0xfb, 0x04, 0xed, 0xea, 0x45, 0xb5, 0x17, 0x65, 0xa0, 0x22, 0x22, 0x0c, 0xd9, 0xec,
+	0x31, 0xa6, 0x26, 0x39, 0x31, 0x73, 0xb2, 0xd6, 0x37, 0xf1, 0x11, 0x7c, 0x2b, 0xc1, 0x2b, 0x1f,
+	0x43, 0xe6, 0xa3, 0x3b, 0x53, 0xaa, 0x30, 0x77, 0x99, 0x64, 0xfe, 0xbf, 0x73, 0x38, 0x21, 0x4c,
+	0x69, 0x43, 0x9f, 0xd3, 0x92, 0x2b, 0x74, 0xa2, 0x42, 0x8b, 0x2f, 0x0c, 0x0a, 0x6d, 0x11, 0x45,
+	0x88, 0x78, 0x01, 0x8a, 0xaa, 0xf6, 0x4b, 0x06, 0x23, 0xd6, 0x2f, 0x45, 0xb0, 0x49, 0x1b, 0x5f,
+	0x09, 0xb9, 0x92, 0x81, 0xcc, 0x1a, 0x14, 0x7a, 0x95, 0x62, 0x04, 0xaf, 0xbe, 0x6f, 0xf6, 0xca,
+	0xc1, 0x26, 0x0f, 0x11, 0x09, 0xb3, 0xfd, 0x7f, 0xfc, 0xcf, 0x3b, 0x8b, 0xd7, 0x3e, 0xaf, 0x4b,
+	0x73, 0x83, 0x3b, 0x7b, 0x1a, 0x51, 0x5b, 0x10, 0x4d, 0x6e, 0x99, 0x3e, 0x89, 0x55, 0x8a, 0x92,
+	0x0c, 0xfa, 0x56, 0xba, 0x79, 0xfe, 0x2d, 0xca, 0x10, 0x20, 0x56, 0xdd, 0xf9, 0x23, 0x8d, 0x1a,
+	0x9b, 0xa5, 0xa8, 0x57, 0xed, 0xee, 0xf3, 0x3f, 0x53, 0x36, 0x3b, 0xea, 0x5a, 0x58, 0xf4, 0x2d,
+	0x64, 0x1f, 0xd9, 0x6e, 0x25, 0x5d, 0xb0, 0x50, 0x4a, 0xad, 0x23, 0x68, 0x49, 0x50, 0x06, 0x88,
+	0x0a, 0x3c, 0x19, 0x0b, 0xf9, 0xe4, 0xd9, 0x64, 0xff, 0xde, 0xc1, 0x13, 0xde, 0xd6, 0xe4, 0x57,
+	0x35, 0xf9, 0x2b, 0x4c, 0x4b, 0x0b, 0xef, 0xa4, 0x4d, 0x50, 0xcc, 0x5b, 0xe0, 0xe8, 0x2a, 0x7f,
+	0xb6, 0x89, 0x67, 0x67, 0x6c, 0xdb, 0xc9, 0xcb, 0xe1, 0x38, 0x4a, 0x6b, 0x9c, 0xa1, 0xfc, 0xd6,
+	0x7f, 0xdc, 0xf3, 0x13, 0x4f, 0x87, 0x07, 0xad, 0x3b, 0x73, 0xf2, 0x72, 0xd0, 0xea, 0x69, 0x1d,
+	0xcc, 0x4a, 0xb6, 0x3b, 0xd4, 0x52, 0x58, 0xd5, 0x1d, 0x1b, 0x4f, 0x10, 0xd7, 0xd2, 0xe6, 0xb7,
+	0x1b, 0x77, 0x7e, 0xb3, 0xdf, 0x6e, 0x86, 0xc7, 0xd3, 0x1f, 0xbf, 0x9e, 0x4e, 0x8a, 0xf9, 0xc0,
+	0x38, 0x6f, 0x88, 0x93, 0x4e, 0xc8, 0x0a, 0xb6, 0xed, 0x8c, 0x2f, 0x23, 0x51, 0xa9, 0xa4, 0x55,
+	0x3d, 0x3d, 0x1d, 0x47, 0x67, 0xce, 0xf8, 0x82, 0x68, 0x21, 0xad, 0xda, 0x98, 0xef, 0xd9, 0xce,
+	0x35, 0x33, 0xc2, 0xd7, 0x04, 0x15, 0x95, 0x0a, 0x93, 0xa7, 0xfc, 0xce, 0x88, 0x59, 0x3c, 0xee,
+	0xd5, 0xa2, 0x0d, 0x2f, 0xea, 0x6c, 0xf6, 0x96, 0xcd, 0xae, 0xc9, 0x17, 0x86, 0x08, 0x62, 0xbe,
+	0x35, 0xe2, 0xda, 0x1e, 0xf6, 0xe4, 0x9b, 0x26, 0x95, 0xbd, 0x66, 0x0f, 0x6a, 0x6c, 0x30, 0x9b,
+	0xfc, 0xee, 0x88, 0xde, 0xee, 0x3b, 0xe3, 0x07, 0xf7, 0x74, 0x5c, 0xfc, 0xfc, 0xbd, 0x37, 0xf9,
+	0x70, 0x3a, 0xee, 0x55, 0x85, 0x2f, 0x7a, 0xc4, 0xcb, 0x5a, 0x6e, 0x35, 0x95, 0x0f, 0xff, 0x0e,
+	0x00, 0x4f, 0xe0, 0x6b, 0x31, 0xac, 0x03, 0x00, 0x00,
+}
+
+func (this *AdaptiveConcurrency) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AdaptiveConcurrency)
+	if !ok {
+		that2, ok := that.(AdaptiveConcurrency)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.SampleAggregatePercentile.Equal(that1.SampleAggregatePercentile) {
+		return false
+	}
+	if !this.MaxConcurrencyLimit.Equal(that1.MaxConcurrencyLimit) {
+		return false
+	}
+	if this.ConcurrencyUpdateInterval != nil && that1.ConcurrencyUpdateInterval != nil {
+		if *this.ConcurrencyUpdateInterval != *that1.ConcurrencyUpdateInterval {
+			return false
+		}
+	} else if this.ConcurrencyUpdateInterval != nil {
+		return false
+	} else if that1.ConcurrencyUpdateInterval != nil {
+		return false
+	}
+	if this.MinRttCalcInterval != nil && that1.MinRttCalcInterval != nil {
+		if *this.MinRttCalcInterval != *that1.MinRttCalcInterval {
+			return false
+		}
+	} else if this.MinRttCalcInterval != nil {
+		return false
+	} else if that1.MinRttCalcInterval != nil {
+		return false
+	}
+	if !this.MinRttCalcRequestCount.Equal(that1.MinRttCalcRequestCount) {
+		return false
+	}
+	if !this.MinRttCalcJitter.Equal(that1.MinRttCalcJitter) {
+		return false
+	}
+	if !this.MinConcurrency.Equal(that1.MinConcurrency) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,369 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: github.com/solo-io/gloo/projects/gloo/api/v1/plugins/alibaba/alibaba.proto
+
+package alibaba
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	math "math"
+
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	core "github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+// Upstream Spec for Alibaba Cloud Function Compute Upstreams
+// Alibaba Upstreams represent a collection of Function Compute functions for a particular Alibaba Cloud account
+// in a particular region
+type UpstreamSpec struct {
+	// The Alibaba Cloud Region where the desired functions exist, e.g. `cn-hangzhou`
+	Region string `protobuf:"bytes,1,opt,name=region,proto3" json:"region,omitempty"`
+	// The ID of the Alibaba Cloud account owning the functions. Function Compute endpoints are
+	// specific to an account: `<account_id>.<region>.fc.aliyuncs.com`
+	AccountId string `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	// A [Gloo Secret Ref](https://gloo.solo.io/introduction/concepts/#Secrets) to an Alibaba Secret
+	// The secret is used by discovery to list the functions, not to invoke them.
+	// If the secret is created manually, it must conform to the following structure:
+	//  ```
+	//  access_key_id: <alibaba access key id>
+	//  access_key_secret: <alibaba access key secret>
+	//  ```
+	SecretRef core.ResourceRef `protobuf:"bytes,3,opt,name=secret_ref,json=secretRef,proto3" json:"secret_ref"`
+	// The list of functions contained within this region.
+	// This list will be automatically populated by Gloo if discovery is enabled for Function Compute
+	Functions            []*FunctionSpec `protobuf:"bytes,4,rep,name=functions,proto3" json:"functions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *UpstreamSpec) Reset()         { *m = UpstreamSpec{} }
+func (m *UpstreamSpec) String() string { return proto.CompactTextString(m) }
+func (*UpstreamSpec) ProtoMessage()    {}
+func (*UpstreamSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48a58e68a05fd7c6, []int{0}
+}
+func (m *UpstreamSpec) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpstreamSpec.Unmarshal(m, b)
+}
+func (m *UpstreamSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpstreamSpec.Marshal(b, m, deterministic)
+}
+func (m *UpstreamSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpstreamSpec.Merge(m, src)
+}
+func (m *UpstreamSpec) XXX_Size() int {
+	return xxx_messageInfo_UpstreamSpec.Size(m)
+}
+func (m *UpstreamSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpstreamSpec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpstreamSpec proto.InternalMessageInfo
+
+func (m *UpstreamSpec) GetRegion() string {
+	if m != nil {
+		return m.Region
+	}
+	return ""
+}
+
+func (m *UpstreamSpec) GetAccountId() string {
+	if m != nil {
+		return m.AccountId
+	}
+	return ""
+}
+
+func (m *UpstreamSpec) GetSecretRef() core.ResourceRef {
+	if m != nil {
+		return m.SecretRef
+	}
+	return core.ResourceRef{}
+}
+
+func (m *UpstreamSpec) GetFunctions() []*FunctionSpec {
+	if m != nil {
+		return m.Functions
+	}
+	return nil
+}
+
+// Each Function Spec contains data necessary for Gloo to invoke Function Compute functions
+// Gloo invokes the functions through their HTTP triggers, which must allow anonymous invocations: the requests are not
+// signed, as the envoy shipped with gloo cannot sign them for Function Compute. Anyone reaching the Function Compute
+// endpoint can then invoke the functions, without going through gloo, so protect them in the functions themselves.
+type FunctionSpec struct {
+	// the logical name gloo should associate with this function.
+	// discovered functions are named `<service_name>-<function_name>`
+	LogicalName string `protobuf:"bytes,1,opt,name=logical_name,json=logicalName,proto3" json:"logical_name,omitempty"`
+	// The name of the Function Compute service the function belongs to
+	ServiceName string `protobuf:"bytes,2,opt,name=service_name,json=serviceName,proto3" json:"service_name,omitempty"`
+	// The name of the function as it appears in the Function Compute console
+	FunctionName string `protobuf:"bytes,3,opt,name=function_name,json=functionName,proto3" json:"function_name,omitempty"`
+	// (Optional) The version or alias of the service to invoke. Defaults to `LATEST`
+	Qualifier string `protobuf:"bytes,4,opt,name=qualifier,proto3" json:"qualifier,omitempty"`
+	// The URL of the HTTP trigger the function is invoked at. Set by discovery for reference, the
+	// routes to the function append the path following the prefix they match to it.
+	InvokeUrl            string   `protobuf:"bytes,5,opt,name=invoke_url,json=invokeUrl,proto3" json:"invoke_url,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FunctionSpec) Reset()         { *m = FunctionSpec{} }
+func (m *FunctionSpec) String() string { return proto.CompactTextString(m) }
+func (*FunctionSpec) ProtoMessage()    {}
+func (*FunctionSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48a58e68a05fd7c6, []int{1}
+}
+func (m *FunctionSpec) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FunctionSpec.Unmarshal(m, b)
+}
+func (m *FunctionSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FunctionSpec.Marshal(b, m, deterministic)
+}
+func (m *FunctionSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FunctionSpec.Merge(m, src)
+}
+func (m *FunctionSpec) XXX_Size() int {
+	return xxx_messageInfo_FunctionSpec.Size(m)
+}
+func (m *FunctionSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_FunctionSpec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FunctionSpec proto.InternalMessageInfo
+
+func (m *FunctionSpec) GetLogicalName() string {
+	if m != nil {
+		return m.LogicalName
+	}
+	return ""
+}
+
+func (m *FunctionSpec) GetServiceName() string {
+	if m != nil {
+		return m.ServiceName
+	}
+	return ""
+}
+
+func (m *FunctionSpec) GetFunctionName() string {
+	if m != nil {
+		return m.FunctionName
+	}
+	return ""
+}
+
+func (m *FunctionSpec) GetQualifier() string {
+	if m != nil {
+		return m.Qualifier
+	}
+	return ""
+}
+
+func (m *FunctionSpec) GetInvokeUrl() string {
+	if m != nil {
+		return m.InvokeUrl
+	}
+	return ""
+}
+
+type DestinationSpec struct {
+	// The Logical Name of the FunctionSpec to be invoked.
+	LogicalName          string   `protobuf:"bytes,1,opt,name=logical_name,json=logicalName,proto3" json:"logical_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DestinationSpec) Reset()         { *m = DestinationSpec{} }
+func (m *DestinationSpec) String() string { return proto.CompactTextString(m) }
+func (*DestinationSpec) ProtoMessage()    {}
+func (*DestinationSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48a58e68a05fd7c6, []int{2}
+}
+func (m *DestinationSpec) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DestinationSpec.Unmarshal(m, b)
+}
+func (m *DestinationSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DestinationSpec.Marshal(b, m, deterministic)
+}
+func (m *DestinationSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DestinationSpec.Merge(m, src)
+}
+func (m *DestinationSpec) XXX_Size() int {
+	return xxx_messageInfo_DestinationSpec.Size(m)
+}
+func (m *DestinationSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_DestinationSpec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DestinationSpec proto.InternalMessageInfo
+
+func (m *DestinationSpec) GetLogicalName() string {
+	if m != nil {
+		return m.LogicalName
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*UpstreamSpec)(nil), "alibaba.plugins.gloo.solo.io.UpstreamSpec")
+	proto.RegisterType((*FunctionSpec)(nil), "alibaba.plugins.gloo.solo.io.FunctionSpec")
+	proto.RegisterType((*DestinationSpec)(nil), "alibaba.plugins.gloo.solo.io.DestinationSpec")
+}
+
+func init() {
+	proto.RegisterFile("github.com/solo-io/gloo/projects/gloo/api/v1/plugins/alibaba/alibaba.proto", fileDescriptor_48a58e68a05fd7c6)
+}
+
+var fileDescriptor_48a58e68a05fd7c6 = []byte{
+	// 392 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xcf, 0x8a, 0xd4, 0x40,
+	0x10, 0xc6, 0x8d, 0x33, 0x2e, 0xa4, 0x13, 0x11, 0x82, 0x48, 0x5c, 0x56, 0x1d, 0xc7, 0xcb, 0x20,
+	0xda, 0xc1, 0xd5, 0xb3, 0xc8, 0x22, 0x8b, 0x7a, 0xf0, 0x10, 0xd9, 0x8b, 0x97, 0xd0, 0xe9, 0xa9,
+	0xb4, 0xe5, 0x74, 0xba, 0x62, 0x77, 0x67, 0x9e, 0xc9, 0x27, 0xf0, 0x19, 0xbc, 0xf9, 0x06, 0x1e,
+	0x7c, 0x12, 0x49, 0x3a, 0x19, 0x3d, 0xa8, 0xb8, 0xa7, 0x4e, 0x7d, 0xf5, 0xfb, 0x52, 0x7f, 0x28,
+	0xf6, 0x46, 0xa1, 0xff, 0xd0, 0xd7, 0x5c, 0x52, 0x5b, 0x38, 0xd2, 0xf4, 0x18, 0xa9, 0x50, 0x9a,
+	0xa8, 0xe8, 0x2c, 0x7d, 0x04, 0xe9, 0x5d, 0x88, 0x44, 0x87, 0xc5, 0xfe, 0x49, 0xd1, 0xe9, 0x5e,
+	0xa1, 0x71, 0x85, 0xd0, 0x58, 0x8b, 0x5a, 0xcc, 0x2f, 0xef, 0x2c, 0x79, 0xca, 0x4e, 0x0e, 0x61,
+	0xc0, 0xf8, 0x60, 0xe5, 0xc3, 0x5f, 0x39, 0xd2, 0xf1, 0x4d, 0x45, 0x8a, 0x46, 0xb0, 0x18, 0xbe,
+	0x82, 0xe7, 0xf8, 0xd1, 0x1f, 0xea, 0x8f, 0xef, 0x0e, 0xfd, 0x5c, 0xd5, 0x42, 0x13, 0xe8, 0xf5,
+	0xb7, 0x88, 0xa5, 0x17, 0x9d, 0xf3, 0x16, 0x44, 0xfb, 0xae, 0x03, 0x99, 0xdd, 0x62, 0x47, 0x16,
+	0x14, 0x92, 0xc9, 0xa3, 0x55, 0xb4, 0x89, 0xcb, 0x29, 0xca, 0xee, 0x30, 0x26, 0xa4, 0xa4, 0xde,
+	0xf8, 0x0a, 0xb7, 0xf9, 0xd5, 0x31, 0x17, 0x4f, 0xca, 0xeb, 0x6d, 0xf6, 0x9c, 0x31, 0x07, 0xd2,
+	0x82, 0xaf, 0x2c, 0x34, 0xf9, 0x62, 0x15, 0x6d, 0x92, 0xd3, 0xdb, 0x5c, 0x92, 0x85, 0xb9, 0x5d,
+	0x5e, 0x82, 0xa3, 0xde, 0x4a, 0x28, 0xa1, 0x39, 0x5b, 0x7e, 0xfd, 0x7e, 0xef, 0x4a, 0x19, 0x07,
+	0x4b, 0x09, 0x4d, 0xf6, 0x8a, 0xc5, 0x4d, 0x6f, 0xa4, 0x47, 0x32, 0x2e, 0x5f, 0xae, 0x16, 0x9b,
+	0xe4, 0xf4, 0x21, 0xff, 0xd7, 0xf4, 0xfc, 0x7c, 0xc2, 0x87, 0xae, 0xcb, 0x5f, 0xe6, 0xf5, 0x97,
+	0x88, 0xa5, 0xbf, 0xe7, 0xb2, 0xfb, 0x2c, 0xd5, 0xa4, 0x50, 0x0a, 0x5d, 0x19, 0xd1, 0xc2, 0x34,
+	0x57, 0x32, 0x69, 0x6f, 0x45, 0x0b, 0x03, 0xe2, 0xc0, 0xee, 0x51, 0x42, 0x40, 0xc2, 0x78, 0xc9,
+	0xa4, 0x8d, 0xc8, 0x03, 0x76, 0x7d, 0xae, 0x11, 0x98, 0xc5, 0xc8, 0xa4, 0xb3, 0x38, 0x42, 0x27,
+	0x2c, 0xfe, 0xd4, 0x0b, 0x8d, 0x0d, 0x82, 0xcd, 0x97, 0x61, 0x47, 0x07, 0x61, 0x58, 0x21, 0x9a,
+	0x3d, 0xed, 0xa0, 0xea, 0xad, 0xce, 0xaf, 0x85, 0x74, 0x50, 0x2e, 0xac, 0x5e, 0x3f, 0x63, 0x37,
+	0x5e, 0x82, 0xf3, 0x68, 0xc4, 0x25, 0x5a, 0x3f, 0x3b, 0xff, 0xfc, 0xe3, 0x6e, 0xf4, 0xfe, 0xc5,
+	0xff, 0x1d, 0x5d, 0xb7, 0x53, 0x7f, 0x39, 0xbc, 0xfa, 0x68, 0xbc, 0x87, 0xa7, 0x3f, 0x07, 0x00,
+	0x62, 0x52, 0xc7, 0x5c, 0xbf, 0x02, 0x00, 0x00,
+}
+
+func (this *UpstreamSpec) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UpstreamSpec)
+	if !ok {
+		that2, ok := that.(UpstreamSpec)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Region != that1.Region {
+		return false
+	}
+	if this.AccountId != that1.AccountId {
+		return false
+	}
+	if !this.SecretRef.Equal(&that1.SecretRef) {
+		return false
+	}
+	if len(this.Functions) != len(that1.Functions) {
+		return false
+	}
+	for i := range this.Functions {
+		if !this.Functions[i].Equal(that1.Functions[i]) {
+			return false
+		}
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *FunctionSpec) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FunctionSpec)
+	if !ok {
+		that2, ok := that.(FunctionSpec)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.LogicalName != that1.LogicalName {
+		return false
+	}
+	if this.ServiceName != that1.ServiceName {
+		return false
+	}
+	if this.FunctionName != that1.FunctionName {
+		return false
+	}
+	if this.Qualifier != that1.Qualifier {
+		return false
+	}
+	if this.InvokeUrl != that1.InvokeUrl {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *DestinationSpec) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DestinationSpec)
+	if !ok {
+		that2, ok := that.(DestinationSpec)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.LogicalName != that1.LogicalName {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
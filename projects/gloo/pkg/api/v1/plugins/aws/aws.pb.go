@@ -27,7 +27,10 @@ const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 type DestinationSpec_InvocationStyle int32
 
 const (
-	DestinationSpec_SYNC  DestinationSpec_InvocationStyle = 0
+	// Invoke the function with the `RequestResponse` invocation type, and respond with its result.
+	DestinationSpec_SYNC DestinationSpec_InvocationStyle = 0
+	// Invoke the function with the `Event` invocation type: Lambda queues the event and Gloo responds
+	// immediately with `202 Accepted` and an empty body. Useful for fire-and-forget webhooks.
 	DestinationSpec_ASYNC DestinationSpec_InvocationStyle = 1
 )
 
@@ -62,13 +65,33 @@ type UpstreamSpec struct {
 	//  access_key: <aws access key>
 	//  secret_key: <aws secret key>
 	//  ```
+	// If no secret is referenced, Gloo uses the credentials of the environment it is running in:
+	// the projected service account token for [IAM Roles for Service Accounts](https://docs.aws.amazon.com/eks/latest/userguide/iam-roles-for-service-accounts.html)
+	// if present, otherwise the AWS default credential chain.
 	SecretRef core.ResourceRef `protobuf:"bytes,2,opt,name=secret_ref,json=secretRef,proto3" json:"secret_ref"`
 	// The list of Lambda Functions contained within this region.
 	// This list will be automatically populated by Gloo if discovery is enabled for AWS Lambda Functions
-	LambdaFunctions      []*LambdaFunctionSpec `protobuf:"bytes,3,rep,name=lambda_functions,json=lambdaFunctions,proto3" json:"lambda_functions,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+	LambdaFunctions []*LambdaFunctionSpec `protobuf:"bytes,3,rep,name=lambda_functions,json=lambdaFunctions,proto3" json:"lambda_functions,omitempty"`
+	// (Optional) The ARN of an IAM Role to assume via STS when invoking and discovering Lambda Functions.
+	// If set, the credentials in the secret referenced by `secret_ref` are only used to call `sts:AssumeRole`.
+	// The temporary credentials returned by STS are refreshed automatically before they expire.
+	// Envoy signs the invocations with a session token along with the temporary credentials: the upstream is rejected
+	// unless the `envoy_extensions` of the settings list `io.solo.aws_lambda.session_token`, as the lambda filter of the
+	// envoy image shipped with gloo does not support session tokens.
+	// This allows a single set of credentials to be used to access Lambda Functions in multiple accounts.
+	RoleArn string `protobuf:"bytes,4,opt,name=role_arn,json=roleArn,proto3" json:"role_arn,omitempty"`
+	// (Optional) Restricts the Lambda Functions imported by discovery to the given qualifiers.
+	// A qualifier can be a version (e.g. `1`), an alias (e.g. `live`) or `$LATEST`.
+	// If empty, all versions and aliases of every function are imported.
+	Qualifiers []string `protobuf:"bytes,5,rep,name=qualifiers,proto3" json:"qualifiers,omitempty"`
+	// (Optional) If set, function discovery checks that the credentials of this upstream have the permissions
+	// it requires (`lambda:ListFunctions` and `lambda:ListAliases`) before polling for functions.
+	// Missing permissions are reported as the last error of the function discovery status in the discovery metadata
+	// of the upstream, and functions are not polled for until they are granted.
+	CheckDiscoveryPermissions bool     `protobuf:"varint,6,opt,name=check_discovery_permissions,json=checkDiscoveryPermissions,proto3" json:"check_discovery_permissions,omitempty"`
+	XXX_NoUnkeyedLiteral      struct{} `json:"-"`
+	XXX_unrecognized          []byte   `json:"-"`
+	XXX_sizecache             int32    `json:"-"`
 }
 
 func (m *UpstreamSpec) Reset()         { *m = UpstreamSpec{} }
@@ -116,12 +139,35 @@ func (m *UpstreamSpec) GetLambdaFunctions() []*LambdaFunctionSpec {
 	return nil
 }
 
+func (m *UpstreamSpec) GetRoleArn() string {
+	if m != nil {
+		return m.RoleArn
+	}
+	return ""
+}
+
+func (m *UpstreamSpec) GetQualifiers() []string {
+	if m != nil {
+		return m.Qualifiers
+	}
+	return nil
+}
+
+func (m *UpstreamSpec) GetCheckDiscoveryPermissions() bool {
+	if m != nil {
+		return m.CheckDiscoveryPermissions
+	}
+	return false
+}
+
 // Each Lambda Function Spec contains data necessary for Gloo to invoke Lambda functions:
 // - name of the function
 // - qualifier for the function
 type LambdaFunctionSpec struct {
 	// the logical name gloo should associate with this function. if left empty, it will default to
-	// lambda_function_name+qualifier
+	// lambda_function_name+qualifier.
+	// discovered functions are named `<lambda_function_name>:<qualifier>`, or `<lambda_function_name>`
+	// for the `$LATEST` qualifier
 	LogicalName string `protobuf:"bytes,1,opt,name=logical_name,json=logicalName,proto3" json:"logical_name,omitempty"`
 	// The Name of the Lambda Function as it appears in the AWS Lambda Portal
 	LambdaFunctionName string `protobuf:"bytes,2,opt,name=lambda_function_name,json=lambdaFunctionName,proto3" json:"lambda_function_name,omitempty"`
@@ -184,7 +230,8 @@ type DestinationSpec struct {
 	LogicalName string `protobuf:"bytes,1,opt,name=logical_name,json=logicalName,proto3" json:"logical_name,omitempty"`
 	// Can be either Sync or Async.
 	InvocationStyle DestinationSpec_InvocationStyle `protobuf:"varint,2,opt,name=invocation_style,json=invocationStyle,proto3,enum=aws.plugins.gloo.solo.io.DestinationSpec_InvocationStyle" json:"invocation_style,omitempty"`
-	// de-jsonify response bodies returned from aws lambda
+	// de-jsonify response bodies returned from aws lambda.
+	// Cannot be used with the `ASYNC` invocation style, whose responses have no body.
 	ResponseTransformation bool     `protobuf:"varint,5,opt,name=response_transformation,json=responseTransformation,proto3" json:"response_transformation,omitempty"`
 	XXX_NoUnkeyedLiteral   struct{} `json:"-"`
 	XXX_unrecognized       []byte   `json:"-"`
@@ -248,35 +295,39 @@ func init() {
 }
 
 var fileDescriptor_b7b3b1f86348dc9d = []byte{
-	// 446 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xc1, 0x6e, 0x13, 0x31,
-	0x10, 0x86, 0xeb, 0xa6, 0xad, 0x1a, 0xa7, 0x22, 0x91, 0x55, 0x95, 0xa5, 0x42, 0x10, 0x72, 0x40,
-	0x39, 0x14, 0x2f, 0x84, 0x03, 0x42, 0x42, 0x48, 0x04, 0x84, 0x84, 0x84, 0x7a, 0xd8, 0x80, 0x10,
-	0x5c, 0x56, 0x8e, 0x3b, 0xbb, 0x98, 0x7a, 0x3d, 0xc6, 0xf6, 0x16, 0xf1, 0x04, 0xbc, 0x0a, 0x6f,
-	0xc1, 0x95, 0xa7, 0xe0, 0xc0, 0x6b, 0x70, 0x41, 0xeb, 0x4d, 0x40, 0x09, 0xad, 0x44, 0x0f, 0xab,
-	0x1d, 0xcf, 0x7c, 0xff, 0xcc, 0x6f, 0x6b, 0xe8, 0xb4, 0x54, 0xe1, 0x7d, 0x3d, 0xe7, 0x12, 0xab,
-	0xd4, 0xa3, 0xc6, 0x3b, 0x0a, 0xd3, 0x52, 0x23, 0xa6, 0xd6, 0xe1, 0x07, 0x90, 0xc1, 0xb7, 0x27,
-	0x61, 0x55, 0x7a, 0x76, 0x2f, 0xb5, 0xba, 0x2e, 0x95, 0xf1, 0xa9, 0xf8, 0x14, 0x3f, 0x6e, 0x1d,
-	0x06, 0x64, 0x49, 0x0c, 0xdb, 0x12, 0x6f, 0x70, 0xde, 0x74, 0xe2, 0x0a, 0x0f, 0xf7, 0x4b, 0x2c,
-	0x31, 0x42, 0x69, 0x13, 0xb5, 0xfc, 0xe1, 0xd1, 0x39, 0x33, 0xe3, 0xff, 0x54, 0x85, 0xe5, 0x24,
-	0x07, 0x45, 0x4b, 0x8f, 0xbe, 0x11, 0xba, 0xf7, 0xda, 0xfa, 0xe0, 0x40, 0x54, 0x33, 0x0b, 0x92,
-	0x1d, 0xd0, 0x1d, 0x07, 0xa5, 0x42, 0x93, 0x90, 0x21, 0x19, 0x77, 0xb3, 0xc5, 0x89, 0x3d, 0xa6,
-	0xd4, 0x83, 0x74, 0x10, 0x72, 0x07, 0x45, 0xb2, 0x39, 0x24, 0xe3, 0xde, 0xe4, 0x1a, 0x97, 0xe8,
-	0x60, 0xe9, 0x87, 0x67, 0xe0, 0xb1, 0x76, 0x12, 0x32, 0x28, 0xa6, 0x5b, 0xdf, 0x7f, 0xdc, 0xdc,
-	0xc8, 0xba, 0xad, 0x24, 0x83, 0x82, 0xbd, 0xa1, 0x03, 0x2d, 0xaa, 0xf9, 0x89, 0xc8, 0x8b, 0xda,
-	0xc8, 0xa0, 0xd0, 0xf8, 0xa4, 0x33, 0xec, 0x8c, 0x7b, 0x93, 0x23, 0x7e, 0xd1, 0x0d, 0xf9, 0xcb,
-	0xa8, 0x78, 0xbe, 0x10, 0x34, 0xfe, 0xb2, 0xbe, 0x5e, 0xc9, 0xf9, 0xd1, 0x17, 0x42, 0xd9, 0xbf,
-	0x1c, 0xbb, 0x45, 0xf7, 0x34, 0x96, 0x4a, 0x0a, 0x9d, 0x1b, 0x51, 0xc1, 0xe2, 0x36, 0xbd, 0x45,
-	0xee, 0x58, 0x54, 0xc0, 0xee, 0xd2, 0xfd, 0x35, 0x4b, 0x2d, 0xba, 0x19, 0x51, 0xb6, 0x3a, 0x28,
-	0x2a, 0xae, 0xd3, 0xee, 0xc7, 0x5a, 0x68, 0x55, 0x28, 0x70, 0x49, 0x27, 0x62, 0x7f, 0x13, 0xa3,
-	0x5f, 0x84, 0xf6, 0x9f, 0x81, 0x0f, 0xca, 0x88, 0xcb, 0xd8, 0x38, 0xa1, 0x03, 0x65, 0xce, 0x50,
-	0x46, 0x51, 0xee, 0xc3, 0x67, 0xdd, 0x5a, 0xb8, 0x32, 0x79, 0x78, 0xf1, 0xcb, 0xac, 0xcd, 0xe1,
-	0x2f, 0xfe, 0x74, 0x98, 0x35, 0x0d, 0xb2, 0xbe, 0x5a, 0x4d, 0xb0, 0x07, 0xf4, 0xaa, 0x03, 0x6f,
-	0xd1, 0x78, 0xc8, 0x83, 0x13, 0xc6, 0x17, 0xe8, 0xaa, 0x58, 0x4f, 0xb6, 0x87, 0x64, 0xbc, 0x9b,
-	0x1d, 0x2c, 0xcb, 0xaf, 0x56, 0xaa, 0xa3, 0xdb, 0xb4, 0xbf, 0xd6, 0x9c, 0xed, 0xd2, 0xad, 0xd9,
-	0xdb, 0xe3, 0xa7, 0x83, 0x0d, 0xd6, 0xa5, 0xdb, 0x4f, 0x62, 0x48, 0xa6, 0xd3, 0xaf, 0x3f, 0x6f,
-	0x90, 0x77, 0x8f, 0xfe, 0x6f, 0xe3, 0xed, 0x69, 0x79, 0xce, 0xd6, 0xcf, 0x77, 0xe2, 0x52, 0xde,
-	0xff, 0x1d, 0x00, 0x00, 0xff, 0xff, 0x8e, 0x58, 0x30, 0x08, 0x38, 0x03, 0x00, 0x00,
+	// 509 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0xc7, 0xeb, 0xe6, 0x83, 0x64, 0x52, 0x91, 0x68, 0x55, 0x15, 0xa7, 0xa0, 0x12, 0x72, 0x40,
+	0x39, 0x14, 0x1b, 0xc2, 0x01, 0x21, 0xa1, 0x4a, 0x0d, 0x15, 0x12, 0x12, 0xaa, 0x90, 0x03, 0x42,
+	0x70, 0xb1, 0x36, 0x9b, 0xb1, 0xbb, 0xc4, 0xde, 0x31, 0xbb, 0x4e, 0x50, 0x9f, 0x80, 0x57, 0xe1,
+	0x19, 0x78, 0x02, 0x9e, 0x82, 0x03, 0xaf, 0xc1, 0x05, 0x79, 0x9d, 0xb4, 0x24, 0xb4, 0x12, 0x1c,
+	0xa2, 0xcc, 0xc7, 0x6f, 0x66, 0xfe, 0x3b, 0xd6, 0xc0, 0x28, 0x96, 0xf9, 0xd9, 0x7c, 0xe2, 0x09,
+	0x4a, 0x7d, 0x43, 0x09, 0x3d, 0x90, 0xe4, 0xc7, 0x09, 0x91, 0x9f, 0x69, 0xfa, 0x88, 0x22, 0x37,
+	0xa5, 0xc7, 0x33, 0xe9, 0x2f, 0x1e, 0xf9, 0x59, 0x32, 0x8f, 0xa5, 0x32, 0x3e, 0xff, 0x6c, 0x7f,
+	0x5e, 0xa6, 0x29, 0x27, 0xe6, 0x5a, 0xb3, 0x4c, 0x79, 0x05, 0xee, 0x15, 0x9d, 0x3c, 0x49, 0xfb,
+	0xbb, 0x31, 0xc5, 0x64, 0x21, 0xbf, 0xb0, 0x4a, 0x7e, 0xff, 0xf0, 0x8a, 0x99, 0xf6, 0x7f, 0x26,
+	0xf3, 0xd5, 0x24, 0x8d, 0x51, 0x49, 0xf7, 0xbf, 0x6d, 0xc3, 0xce, 0xdb, 0xcc, 0xe4, 0x1a, 0x79,
+	0x3a, 0xce, 0x50, 0xb0, 0x3d, 0xa8, 0x6b, 0x8c, 0x25, 0x29, 0xd7, 0xe9, 0x39, 0x83, 0x66, 0xb0,
+	0xf4, 0xd8, 0x11, 0x80, 0x41, 0xa1, 0x31, 0x0f, 0x35, 0x46, 0xee, 0x76, 0xcf, 0x19, 0xb4, 0x86,
+	0x5d, 0x4f, 0x90, 0xc6, 0x95, 0x1e, 0x2f, 0x40, 0x43, 0x73, 0x2d, 0x30, 0xc0, 0x68, 0x54, 0xfd,
+	0xfe, 0xe3, 0xee, 0x56, 0xd0, 0x2c, 0x4b, 0x02, 0x8c, 0xd8, 0x3b, 0xe8, 0x24, 0x3c, 0x9d, 0x4c,
+	0x79, 0x18, 0xcd, 0x95, 0xc8, 0x25, 0x29, 0xe3, 0x56, 0x7a, 0x95, 0x41, 0x6b, 0x78, 0xe8, 0x5d,
+	0xf7, 0x42, 0xef, 0x95, 0xad, 0x78, 0xb1, 0x2c, 0x28, 0xf4, 0x05, 0xed, 0x64, 0x2d, 0x66, 0x58,
+	0x17, 0x1a, 0x9a, 0x12, 0x0c, 0xb9, 0x56, 0x6e, 0xd5, 0x4a, 0xbe, 0x51, 0xf8, 0xc7, 0x5a, 0xb1,
+	0x03, 0x80, 0x4f, 0x73, 0x9e, 0xc8, 0x48, 0xa2, 0x36, 0x6e, 0xad, 0x57, 0x19, 0x34, 0x83, 0x3f,
+	0x22, 0xec, 0x08, 0x6e, 0x8b, 0x33, 0x14, 0xb3, 0x70, 0x2a, 0x8d, 0xa0, 0x05, 0xea, 0xf3, 0x30,
+	0x43, 0x9d, 0x4a, 0x63, 0xac, 0xbc, 0x7a, 0xcf, 0x19, 0x34, 0x82, 0xae, 0x45, 0x4e, 0x56, 0xc4,
+	0xeb, 0x4b, 0xa0, 0xff, 0xc5, 0x01, 0xf6, 0xb7, 0x44, 0x76, 0x0f, 0x76, 0x12, 0x8a, 0xa5, 0xe0,
+	0x49, 0xa8, 0x78, 0x8a, 0xcb, 0x45, 0xb6, 0x96, 0xb1, 0x53, 0x9e, 0x22, 0x7b, 0x08, 0xbb, 0x1b,
+	0xdb, 0x28, 0xd1, 0x6d, 0x8b, 0xb2, 0xf5, 0x37, 0xda, 0x8a, 0x3b, 0xd0, 0xbc, 0x50, 0xee, 0x56,
+	0x2c, 0x76, 0x19, 0xe8, 0xff, 0x72, 0xa0, 0x7d, 0x82, 0x26, 0x97, 0x8a, 0xff, 0x8f, 0x8c, 0x29,
+	0x74, 0xa4, 0x5a, 0x90, 0xb0, 0x45, 0xa1, 0xc9, 0xcf, 0x93, 0x52, 0xc2, 0xcd, 0xe1, 0xd3, 0xeb,
+	0x3f, 0xca, 0xc6, 0x1c, 0xef, 0xe5, 0x45, 0x87, 0x71, 0xd1, 0x20, 0x68, 0xcb, 0xf5, 0x00, 0x7b,
+	0x02, 0xb7, 0x34, 0x9a, 0x8c, 0x94, 0xc1, 0x30, 0xd7, 0x5c, 0x99, 0x88, 0x74, 0x6a, 0xf3, 0x6e,
+	0xcd, 0xae, 0x78, 0x6f, 0x95, 0x7e, 0xb3, 0x96, 0xed, 0xdf, 0x87, 0xf6, 0x46, 0x73, 0xd6, 0x80,
+	0xea, 0xf8, 0xfd, 0xe9, 0xf3, 0xce, 0x16, 0x6b, 0x42, 0xed, 0xd8, 0x9a, 0xce, 0x68, 0xf4, 0xf5,
+	0xe7, 0x81, 0xf3, 0xe1, 0xd9, 0xbf, 0x1d, 0x5b, 0x36, 0x8b, 0xaf, 0x38, 0xb8, 0x49, 0xdd, 0xde,
+	0xc3, 0xe3, 0xdf, 0x03, 0x00, 0xd4, 0xdb, 0x28, 0xe8, 0xb3, 0x03, 0x00, 0x00,
 }
 
 func (this *UpstreamSpec) Equal(that interface{}) bool {
@@ -312,6 +363,20 @@ func (this *UpstreamSpec) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.RoleArn != that1.RoleArn {
+		return false
+	}
+	if len(this.Qualifiers) != len(that1.Qualifiers) {
+		return false
+	}
+	for i := range this.Qualifiers {
+		if this.Qualifiers[i] != that1.Qualifiers[i] {
+			return false
+		}
+	}
+	if this.CheckDiscoveryPermissions != that1.CheckDiscoveryPermissions {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -61,11 +61,20 @@ type UpstreamSpec struct {
 	// A [Gloo Secret Ref](https://gloo.solo.io/introduction/concepts/#Secrets) to an [Azure Publish Profile JSON file](https://azure.microsoft.com/en-us/downloads/publishing-profile-overview/).
 	// {{ hide_not_implemented "Azure Secrets can be created with `glooctl secret create azure ...`" }}
 	// Note that this secret is not required unless Function Discovery is enabled
-	SecretRef            core.ResourceRef             `protobuf:"bytes,2,opt,name=secret_ref,json=secretRef,proto3" json:"secret_ref"`
-	Functions            []*UpstreamSpec_FunctionSpec `protobuf:"bytes,3,rep,name=functions,proto3" json:"functions,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
-	XXX_unrecognized     []byte                       `json:"-"`
-	XXX_sizecache        int32                        `json:"-"`
+	SecretRef core.ResourceRef `protobuf:"bytes,2,opt,name=secret_ref,json=secretRef,proto3" json:"secret_ref"`
+	// The list of functions contained within this Function App.
+	// This list will be automatically populated by Gloo if discovery is enabled for Azure Functions
+	Functions []*UpstreamSpec_FunctionSpec `protobuf:"bytes,3,rep,name=functions,proto3" json:"functions,omitempty"`
+	// The ID of the Azure Subscription of the Function App.
+	// Required for Function Discovery, which lists the functions of the Function App through the Azure Resource Manager API
+	// with the service principal credentials of the secret referenced by `secret_ref`
+	SubscriptionId string `protobuf:"bytes,4,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	// (Optional) The Resource Group of the Function App.
+	// If empty, Function Discovery looks up the Function App in all the Resource Groups of the subscription
+	ResourceGroup        string   `protobuf:"bytes,5,opt,name=resource_group,json=resourceGroup,proto3" json:"resource_group,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *UpstreamSpec) Reset()         { *m = UpstreamSpec{} }
@@ -113,6 +122,20 @@ func (m *UpstreamSpec) GetFunctions() []*UpstreamSpec_FunctionSpec {
 	return nil
 }
 
+func (m *UpstreamSpec) GetSubscriptionId() string {
+	if m != nil {
+		return m.SubscriptionId
+	}
+	return ""
+}
+
+func (m *UpstreamSpec) GetResourceGroup() string {
+	if m != nil {
+		return m.ResourceGroup
+	}
+	return ""
+}
+
 // Function Spec for Functions on Azure Functions Upstreams
 // The Function Spec contains data necessary for Gloo to invoke Azure functions
 type UpstreamSpec_FunctionSpec struct {
@@ -215,32 +238,34 @@ func init() {
 }
 
 var fileDescriptor_e7497f9bd29a35ca = []byte{
-	// 386 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x52, 0xbb, 0x8e, 0xd3, 0x40,
-	0x14, 0x5d, 0x6f, 0x00, 0xe1, 0x59, 0x2f, 0x1b, 0x46, 0x14, 0xc1, 0x05, 0x44, 0xa1, 0x89, 0x10,
-	0x8c, 0x45, 0x22, 0x28, 0x83, 0x1c, 0x45, 0xa9, 0x10, 0x85, 0x23, 0x1a, 0x0a, 0xac, 0x89, 0xb9,
-	0x76, 0x86, 0xd8, 0x73, 0x47, 0xf3, 0x88, 0x04, 0x9f, 0xc2, 0x17, 0xf0, 0x29, 0xf9, 0x0a, 0x0a,
-	0xbe, 0x04, 0xd9, 0x8e, 0x43, 0x8a, 0x20, 0xc1, 0x36, 0x33, 0xf7, 0x75, 0xce, 0xbd, 0x47, 0x3a,
-	0x64, 0x59, 0x08, 0xbb, 0x71, 0x6b, 0x96, 0x61, 0x15, 0x19, 0x2c, 0xf1, 0xa5, 0xc0, 0xa8, 0x28,
-	0x11, 0x23, 0xa5, 0xf1, 0x0b, 0x64, 0xd6, 0xb4, 0x19, 0x57, 0x22, 0xda, 0xbd, 0x8a, 0x54, 0xe9,
-	0x0a, 0x21, 0x4d, 0xc4, 0xbf, 0x39, 0x0d, 0xed, 0xcb, 0x94, 0x46, 0x8b, 0x34, 0x3c, 0x24, 0xed,
-	0x00, 0xab, 0x41, 0xac, 0xe6, 0x63, 0x02, 0xc3, 0x47, 0x05, 0x16, 0xd8, 0x8c, 0x45, 0x75, 0xd4,
-	0x22, 0xc2, 0x17, 0x67, 0x36, 0x37, 0xff, 0x56, 0xd8, 0x6e, 0x9f, 0x86, 0xbc, 0x9d, 0x1e, 0x7d,
-	0xef, 0x91, 0xe0, 0x83, 0x32, 0x56, 0x03, 0xaf, 0x56, 0x0a, 0x32, 0xfa, 0x9c, 0x3c, 0xcc, 0x9d,
-	0xcc, 0xac, 0x40, 0x99, 0x72, 0xa5, 0x52, 0xc9, 0x2b, 0x18, 0x78, 0x43, 0x6f, 0xec, 0x27, 0x37,
-	0x5d, 0x23, 0x56, 0xea, 0x3d, 0xaf, 0x80, 0xce, 0x08, 0x31, 0x90, 0x69, 0xb0, 0xa9, 0x86, 0x7c,
-	0x70, 0x39, 0xf4, 0xc6, 0x57, 0x93, 0xc7, 0x2c, 0x43, 0x0d, 0xdd, 0x8d, 0x2c, 0x01, 0x83, 0x4e,
-	0x67, 0x90, 0x40, 0x3e, 0xbf, 0xb3, 0xff, 0xf9, 0xf4, 0x22, 0xf1, 0x5b, 0x48, 0x02, 0x39, 0x5d,
-	0x11, 0xbf, 0xa3, 0x34, 0x83, 0xde, 0xb0, 0x37, 0xbe, 0x9a, 0xbc, 0x66, 0x7f, 0x17, 0xcc, 0x4e,
-	0x0f, 0x65, 0xcb, 0x03, 0xb2, 0x4e, 0x92, 0x3f, 0x3c, 0xe1, 0xde, 0x23, 0xc1, 0x69, 0x8f, 0x3e,
-	0x23, 0xd7, 0x47, 0x45, 0x27, 0x6a, 0x82, 0xae, 0xd8, 0x48, 0xf9, 0x44, 0x08, 0x77, 0x76, 0x93,
-	0x96, 0xb0, 0x83, 0xb2, 0x91, 0xf2, 0x60, 0xf2, 0xf6, 0x56, 0xb7, 0xb0, 0xd8, 0xd9, 0xcd, 0xbb,
-	0x9a, 0x26, 0xf1, 0x79, 0x17, 0x8e, 0xa6, 0xc4, 0x3f, 0xd6, 0xe9, 0x35, 0xf1, 0x63, 0x89, 0xf2,
-	0x6b, 0x85, 0xce, 0xf4, 0x2f, 0x68, 0x40, 0xee, 0x77, 0x04, 0x7d, 0x8f, 0xfa, 0xe4, 0x6e, 0xfc,
-	0xb9, 0x12, 0xb2, 0x7f, 0x39, 0x7a, 0x43, 0x6e, 0x16, 0x60, 0xac, 0x90, 0xfc, 0xbf, 0xc4, 0xcc,
-	0x17, 0x3f, 0x7e, 0x3d, 0xf1, 0x3e, 0xce, 0xfe, 0xcd, 0x82, 0x6a, 0x5b, 0x9c, 0xb5, 0xe1, 0xfa,
-	0x5e, 0xe3, 0x90, 0xe9, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0xb0, 0x13, 0xa5, 0x5e, 0xcb, 0x02,
-	0x00, 0x00,
+	// 426 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x52, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0xae, 0x9b, 0x16, 0xe1, 0x69, 0xfe, 0x58, 0x71, 0x30, 0x39, 0x40, 0x14, 0x84, 0x88, 0x10,
+	0xac, 0x45, 0x2a, 0x38, 0x16, 0xa5, 0xaa, 0x8a, 0x90, 0x10, 0x07, 0x57, 0x5c, 0x38, 0x60, 0x6d,
+	0x9c, 0xb1, 0xb3, 0xd4, 0xf6, 0xac, 0xf6, 0xa7, 0x12, 0x3c, 0x11, 0x8f, 0xd2, 0xa7, 0xe0, 0xc0,
+	0x91, 0xa7, 0x40, 0xb6, 0xe3, 0xe2, 0x43, 0x90, 0xa0, 0x17, 0x7b, 0xe6, 0xdb, 0x99, 0x6f, 0xe6,
+	0xfb, 0x34, 0x70, 0x9e, 0x49, 0xbb, 0x71, 0x2b, 0x9e, 0x50, 0x11, 0x1a, 0xca, 0xe9, 0x85, 0xa4,
+	0x30, 0xcb, 0x89, 0x42, 0xa5, 0xe9, 0x0b, 0x26, 0xd6, 0x34, 0x99, 0x50, 0x32, 0xbc, 0x7a, 0x19,
+	0xaa, 0xdc, 0x65, 0xb2, 0x34, 0xa1, 0xf8, 0xe6, 0x34, 0x36, 0x5f, 0xae, 0x34, 0x59, 0x62, 0x93,
+	0x6d, 0xd2, 0x14, 0xf0, 0xaa, 0x89, 0x57, 0x7c, 0x5c, 0xd2, 0xe4, 0x7e, 0x46, 0x19, 0xd5, 0x65,
+	0x61, 0x15, 0x35, 0x1d, 0x93, 0xe7, 0x3b, 0x26, 0xd7, 0xff, 0x4b, 0x69, 0xdb, 0x79, 0x1a, 0xd3,
+	0xa6, 0x7a, 0xf6, 0xab, 0x07, 0xfd, 0x8f, 0xca, 0x58, 0x8d, 0xa2, 0xb8, 0x50, 0x98, 0xb0, 0x67,
+	0x70, 0x2f, 0x75, 0x65, 0x62, 0x25, 0x95, 0xb1, 0x50, 0x2a, 0x2e, 0x45, 0x81, 0x81, 0x37, 0xf5,
+	0xe6, 0x7e, 0x34, 0x6a, 0x1f, 0x96, 0x4a, 0x7d, 0x10, 0x05, 0xb2, 0x13, 0x00, 0x83, 0x89, 0x46,
+	0x1b, 0x6b, 0x4c, 0x83, 0xfd, 0xa9, 0x37, 0x3f, 0x5a, 0x3c, 0xe0, 0x09, 0x69, 0x6c, 0x77, 0xe4,
+	0x11, 0x1a, 0x72, 0x3a, 0xc1, 0x08, 0xd3, 0xd3, 0x83, 0xeb, 0x1f, 0x8f, 0xf6, 0x22, 0xbf, 0x69,
+	0x89, 0x30, 0x65, 0x17, 0xe0, 0xb7, 0x94, 0x26, 0xe8, 0x4d, 0x7b, 0xf3, 0xa3, 0xc5, 0x2b, 0xfe,
+	0x77, 0xc1, 0xbc, 0xbb, 0x28, 0x3f, 0xdf, 0x76, 0x56, 0x49, 0xf4, 0x87, 0x87, 0x3d, 0x85, 0x91,
+	0x71, 0x2b, 0x93, 0x68, 0xa9, 0x6a, 0x11, 0x72, 0x1d, 0x1c, 0xd4, 0xeb, 0x0f, 0xbb, 0xf0, 0xbb,
+	0x35, 0x7b, 0x02, 0x43, 0xbd, 0xdd, 0x2e, 0xce, 0x34, 0x39, 0x15, 0x1c, 0xd6, 0x75, 0x83, 0x16,
+	0x7d, 0x5b, 0x81, 0x93, 0x6b, 0x0f, 0xfa, 0xdd, 0x59, 0xec, 0x31, 0x0c, 0x6e, 0x1c, 0xea, 0xb8,
+	0xd3, 0x6f, 0xc1, 0xda, 0x9a, 0xcf, 0x00, 0xc2, 0xd9, 0x4d, 0x9c, 0xe3, 0x15, 0xe6, 0xb5, 0x35,
+	0xc3, 0xc5, 0x9b, 0x5b, 0x69, 0xe3, 0x4b, 0x67, 0x37, 0xef, 0x2b, 0x9a, 0xc8, 0x17, 0x6d, 0x38,
+	0x3b, 0x06, 0xff, 0x06, 0x67, 0x03, 0xf0, 0x97, 0x25, 0x95, 0x5f, 0x0b, 0x72, 0x66, 0xbc, 0xc7,
+	0xfa, 0x70, 0xb7, 0x25, 0x18, 0x7b, 0xcc, 0x87, 0xc3, 0xe5, 0xba, 0x90, 0xe5, 0x78, 0x7f, 0xf6,
+	0x1a, 0x46, 0x67, 0x68, 0xac, 0x2c, 0xc5, 0x7f, 0x89, 0x39, 0x3d, 0xfb, 0xfe, 0xf3, 0xa1, 0xf7,
+	0xe9, 0xe4, 0xdf, 0x4e, 0x5a, 0x5d, 0x66, 0x3b, 0xcf, 0x7a, 0x75, 0xa7, 0xbe, 0xb8, 0xe3, 0xdf,
+	0x03, 0x00, 0x0a, 0xe4, 0x85, 0xc6, 0x1b, 0x03, 0x00, 0x00,
 }
 
 func (this *UpstreamSpec) Equal(that interface{}) bool {
@@ -276,6 +301,12 @@ func (this *UpstreamSpec) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.SubscriptionId != that1.SubscriptionId {
+		return false
+	}
+	if this.ResourceGroup != that1.ResourceGroup {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
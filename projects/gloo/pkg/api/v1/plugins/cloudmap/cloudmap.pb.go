@@ -0,0 +1,191 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: github.com/solo-io/gloo/projects/gloo/api/v1/plugins/cloudmap/cloudmap.proto
+
+package cloudmap
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	math "math"
+
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	core "github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+// Upstream Spec for AWS Cloud Map Upstreams
+// Cloud Map Upstreams represent the instances registered to a service of an AWS Cloud Map namespace,
+// such as the tasks of an ECS or Fargate service using service discovery.
+// The endpoints of the upstream are resolved through the Cloud Map API, and are refreshed periodically.
+type UpstreamSpec struct {
+	// The AWS Region of the namespace
+	Region string `protobuf:"bytes,1,opt,name=region,proto3" json:"region,omitempty"`
+	// A [Gloo Secret Ref](https://gloo.solo.io/introduction/concepts/#Secrets) to an AWS Secret
+	// used to list the instances.
+	// If no secret is referenced, Gloo uses the credentials of the environment it is running in.
+	SecretRef core.ResourceRef `protobuf:"bytes,2,opt,name=secret_ref,json=secretRef,proto3" json:"secret_ref"`
+	// (Optional) The ARN of a role to assume with the credentials before listing the instances
+	RoleArn string `protobuf:"bytes,3,opt,name=role_arn,json=roleArn,proto3" json:"role_arn,omitempty"`
+	// The name of the Cloud Map namespace, e.g. `example.local`
+	NamespaceName string `protobuf:"bytes,4,opt,name=namespace_name,json=namespaceName,proto3" json:"namespace_name,omitempty"`
+	// The name of the service in the namespace
+	ServiceName string `protobuf:"bytes,5,opt,name=service_name,json=serviceName,proto3" json:"service_name,omitempty"`
+	// (Optional) The port the instances are listening on.
+	// Defaults to the `AWS_INSTANCE_PORT` attribute of each instance.
+	Port                 uint32   `protobuf:"varint,6,opt,name=port,proto3" json:"port,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpstreamSpec) Reset()         { *m = UpstreamSpec{} }
+func (m *UpstreamSpec) String() string { return proto.CompactTextString(m) }
+func (*UpstreamSpec) ProtoMessage()    {}
+func (*UpstreamSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3f7698a775c87e89, []int{0}
+}
+func (m *UpstreamSpec) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpstreamSpec.Unmarshal(m, b)
+}
+func (m *UpstreamSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpstreamSpec.Marshal(b, m, deterministic)
+}
+func (m *UpstreamSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpstreamSpec.Merge(m, src)
+}
+func (m *UpstreamSpec) XXX_Size() int {
+	return xxx_messageInfo_UpstreamSpec.Size(m)
+}
+func (m *UpstreamSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpstreamSpec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpstreamSpec proto.InternalMessageInfo
+
+func (m *UpstreamSpec) GetRegion() string {
+	if m != nil {
+		return m.Region
+	}
+	return ""
+}
+
+func (m *UpstreamSpec) GetSecretRef() core.ResourceRef {
+	if m != nil {
+		return m.SecretRef
+	}
+	return core.ResourceRef{}
+}
+
+func (m *UpstreamSpec) GetRoleArn() string {
+	if m != nil {
+		return m.RoleArn
+	}
+	return ""
+}
+
+func (m *UpstreamSpec) GetNamespaceName() string {
+	if m != nil {
+		return m.NamespaceName
+	}
+	return ""
+}
+
+func (m *UpstreamSpec) GetServiceName() string {
+	if m != nil {
+		return m.ServiceName
+	}
+	return ""
+}
+
+func (m *UpstreamSpec) GetPort() uint32 {
+	if m != nil {
+		return m.Port
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*UpstreamSpec)(nil), "cloudmap.plugins.gloo.solo.io.UpstreamSpec")
+}
+
+func init() {
+	proto.RegisterFile("github.com/solo-io/gloo/projects/gloo/api/v1/plugins/cloudmap/cloudmap.proto", fileDescriptor_3f7698a775c87e89)
+}
+
+var fileDescriptor_3f7698a775c87e89 = []byte{
+	// 312 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0xc1, 0x4a, 0x33, 0x31,
+	0x10, 0xc7, 0xbf, 0xfd, 0xbe, 0x7e, 0xd5, 0xa6, 0xad, 0x87, 0x45, 0x64, 0x5b, 0x50, 0xab, 0x20,
+	0xf4, 0xa0, 0x09, 0xea, 0x5d, 0x68, 0x2f, 0x5e, 0xc4, 0xc3, 0x8a, 0x17, 0x2f, 0x25, 0x8d, 0xb3,
+	0x31, 0x76, 0x77, 0x27, 0x4c, 0xb2, 0x7d, 0x26, 0x1f, 0xc5, 0xa7, 0x50, 0xf0, 0x49, 0x64, 0xb3,
+	0x6d, 0xbd, 0x28, 0x78, 0xca, 0xff, 0x3f, 0xfc, 0xfe, 0x93, 0x61, 0x86, 0xdd, 0x68, 0xe3, 0x9f,
+	0xaa, 0x39, 0x57, 0x58, 0x08, 0x87, 0x39, 0x9e, 0x19, 0x14, 0x3a, 0x47, 0x14, 0x96, 0xf0, 0x19,
+	0x94, 0x77, 0x8d, 0x93, 0xd6, 0x88, 0xe5, 0xb9, 0xb0, 0x79, 0xa5, 0x4d, 0xe9, 0x84, 0xca, 0xb1,
+	0x7a, 0x2c, 0xa4, 0xdd, 0x08, 0x6e, 0x09, 0x3d, 0xc6, 0xfb, 0x5f, 0xbe, 0x21, 0x79, 0x9d, 0xe6,
+	0x75, 0x63, 0x6e, 0x70, 0xb8, 0xab, 0x51, 0x63, 0x20, 0x45, 0xad, 0x9a, 0xd0, 0xf0, 0xf4, 0x9b,
+	0x11, 0xc2, 0xbb, 0x30, 0x7e, 0xfd, 0x31, 0x41, 0xd6, 0xd0, 0xc7, 0xef, 0x11, 0xeb, 0xdd, 0x5b,
+	0xe7, 0x09, 0x64, 0x71, 0x67, 0x41, 0xc5, 0x7b, 0xac, 0x4d, 0xa0, 0x0d, 0x96, 0x49, 0x34, 0x8a,
+	0xc6, 0x9d, 0x74, 0xe5, 0xe2, 0x2b, 0xc6, 0x1c, 0x28, 0x02, 0x3f, 0x23, 0xc8, 0x92, 0xbf, 0xa3,
+	0x68, 0xdc, 0xbd, 0x18, 0x70, 0x85, 0x04, 0xeb, 0x79, 0x78, 0x0a, 0x0e, 0x2b, 0x52, 0x90, 0x42,
+	0x36, 0x6d, 0xbd, 0xbe, 0x1d, 0xfe, 0x49, 0x3b, 0x4d, 0x24, 0x85, 0x2c, 0x1e, 0xb0, 0x6d, 0xc2,
+	0x1c, 0x66, 0x92, 0xca, 0xe4, 0x5f, 0xe8, 0xbc, 0x55, 0xfb, 0x09, 0x95, 0xf1, 0x09, 0xdb, 0x29,
+	0x65, 0x01, 0xce, 0x4a, 0x05, 0xb3, 0x5a, 0x25, 0xad, 0x00, 0xf4, 0x37, 0xd5, 0x5b, 0x59, 0x40,
+	0x7c, 0xc4, 0x7a, 0x0e, 0x68, 0x69, 0xd6, 0xd0, 0xff, 0x00, 0x75, 0x57, 0xb5, 0x80, 0xc4, 0xac,
+	0x65, 0x91, 0x7c, 0xd2, 0x1e, 0x45, 0xe3, 0x7e, 0x1a, 0xf4, 0xf4, 0xfa, 0xe5, 0xe3, 0x20, 0x7a,
+	0x98, 0xfc, 0xee, 0x30, 0x76, 0xa1, 0x7f, 0x3a, 0xce, 0xbc, 0x1d, 0x36, 0x76, 0xf9, 0x39, 0x00,
+	0x30, 0xe7, 0x45, 0x8b, 0xe4, 0x01, 0x00, 0x00,
+}
+
+func (this *UpstreamSpec) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UpstreamSpec)
+	if !ok {
+		that2, ok := that.(UpstreamSpec)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Region != that1.Region {
+		return false
+	}
+	if !this.SecretRef.Equal(&that1.SecretRef) {
+		return false
+	}
+	if this.RoleArn != that1.RoleArn {
+		return false
+	}
+	if this.NamespaceName != that1.NamespaceName {
+		return false
+	}
+	if this.ServiceName != that1.ServiceName {
+		return false
+	}
+	if this.Port != that1.Port {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
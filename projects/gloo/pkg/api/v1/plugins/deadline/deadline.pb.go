@@ -0,0 +1,153 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: github.com/solo-io/gloo/projects/gloo/api/v1/plugins/deadline/deadline.proto
+
+package deadline
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	math "math"
+	time "time"
+
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+// Propagates the deadline of the gRPC requests of a route to the upstreams, so that the gRPC services can stop working
+// on requests the caller no longer waits for. Envoy uses the `grpc-timeout` header sent by the caller as the timeout of
+// the request instead of the timeout of the route, capped by the max gRPC timeout and lowered by the offset, and sends
+// that timeout to the upstream in the `grpc-timeout` header.
+// Envoy sends the timeout of the other requests to the upstreams in the `x-envoy-expected-rq-timeout-ms` header.
+type DeadlinePropagation struct {
+	// The maximum timeout of the gRPC requests of the route. Unlimited if not set: the gRPC requests without
+	// `grpc-timeout` header then have no timeout.
+	MaxGrpcTimeout *time.Duration `protobuf:"bytes,1,opt,name=max_grpc_timeout,json=maxGrpcTimeout,proto3,stdduration" json:"max_grpc_timeout,omitempty"`
+	// Subtracted from the `grpc-timeout` sent by the caller, leaving time for the response to reach the caller.
+	GrpcTimeoutOffset    *time.Duration `protobuf:"bytes,2,opt,name=grpc_timeout_offset,json=grpcTimeoutOffset,proto3,stdduration" json:"grpc_timeout_offset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *DeadlinePropagation) Reset()         { *m = DeadlinePropagation{} }
+func (m *DeadlinePropagation) String() string { return proto.CompactTextString(m) }
+func (*DeadlinePropagation) ProtoMessage()    {}
+func (*DeadlinePropagation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ac05af4e5131b279, []int{0}
+}
+func (m *DeadlinePropagation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeadlinePropagation.Unmarshal(m, b)
+}
+func (m *DeadlinePropagation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeadlinePropagation.Marshal(b, m, deterministic)
+}
+func (m *DeadlinePropagation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeadlinePropagation.Merge(m, src)
+}
+func (m *DeadlinePropagation) XXX_Size() int {
+	return xxx_messageInfo_DeadlinePropagation.Size(m)
+}
+func (m *DeadlinePropagation) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeadlinePropagation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeadlinePropagation proto.InternalMessageInfo
+
+func (m *DeadlinePropagation) GetMaxGrpcTimeout() *time.Duration {
+	if m != nil {
+		return m.MaxGrpcTimeout
+	}
+	return nil
+}
+
+func (m *DeadlinePropagation) GetGrpcTimeoutOffset() *time.Duration {
+	if m != nil {
+		return m.GrpcTimeoutOffset
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*DeadlinePropagation)(nil), "deadline.plugins.gloo.solo.io.DeadlinePropagation")
+}
+
+func init() {
+	proto.RegisterFile("github.com/solo-io/gloo/projects/gloo/api/v1/plugins/deadline/deadline.proto", fileDescriptor_ac05af4e5131b279)
+}
+
+var fileDescriptor_ac05af4e5131b279 = []byte{
+	// 257 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x90, 0x41, 0x4a, 0xc3, 0x40,
+	0x14, 0x86, 0x89, 0x88, 0x8b, 0x08, 0xa2, 0xa9, 0x8b, 0x5a, 0xb0, 0x8a, 0x2b, 0x37, 0xce, 0xa0,
+	0x9e, 0xc0, 0x52, 0x28, 0x82, 0x50, 0x11, 0x57, 0x6e, 0xc2, 0x24, 0x99, 0x3c, 0x47, 0x27, 0xf9,
+	0x87, 0xc9, 0x8c, 0xf4, 0x28, 0x1e, 0xc1, 0xad, 0x27, 0x12, 0x3c, 0x89, 0x64, 0xa6, 0x5a, 0x37,
+	0x85, 0xee, 0xde, 0xcf, 0x7b, 0xdf, 0xf7, 0xe0, 0x4f, 0xef, 0x48, 0xb9, 0x67, 0x5f, 0xb0, 0x12,
+	0x0d, 0xef, 0xa0, 0x71, 0xa1, 0xc0, 0x49, 0x03, 0xdc, 0x58, 0xbc, 0xc8, 0xd2, 0x75, 0x31, 0x09,
+	0xa3, 0xf8, 0xdb, 0x25, 0x37, 0xda, 0x93, 0x6a, 0x3b, 0x5e, 0x49, 0x51, 0x69, 0xd5, 0xca, 0xbf,
+	0x81, 0x19, 0x0b, 0x87, 0xec, 0x78, 0x95, 0xe3, 0x25, 0xeb, 0x69, 0xd6, 0x8b, 0x99, 0xc2, 0xe8,
+	0x90, 0x40, 0x08, 0x97, 0xbc, 0x9f, 0x22, 0x34, 0x1a, 0x13, 0x40, 0x5a, 0xf2, 0x90, 0x0a, 0x5f,
+	0xf3, 0xca, 0x5b, 0xe1, 0x14, 0xda, 0xb8, 0x3f, 0xfb, 0x4c, 0xd2, 0xc1, 0x74, 0xe9, 0xbd, 0xb7,
+	0x30, 0x82, 0xc2, 0x36, 0xbb, 0x4d, 0xf7, 0x1b, 0xb1, 0xc8, 0xc9, 0x9a, 0x32, 0x77, 0xaa, 0x91,
+	0xf0, 0x6e, 0x98, 0x9c, 0x26, 0xe7, 0xbb, 0x57, 0x47, 0x2c, 0x2a, 0xd9, 0xaf, 0x92, 0x4d, 0x97,
+	0xca, 0xc9, 0xf6, 0xfb, 0xd7, 0x49, 0xf2, 0xb0, 0xd7, 0x88, 0xc5, 0xcc, 0x9a, 0xf2, 0x31, 0x62,
+	0xd9, 0x3c, 0x1d, 0xfc, 0xd7, 0xe4, 0xa8, 0xeb, 0x4e, 0xba, 0xe1, 0xd6, 0x66, 0xb6, 0x03, 0x5a,
+	0xa9, 0xe6, 0x81, 0x9c, 0xcc, 0x3e, 0xbe, 0xc7, 0xc9, 0xd3, 0xcd, 0x66, 0xe5, 0x9a, 0x57, 0x5a,
+	0x57, 0x70, 0xb1, 0x13, 0x9e, 0x5e, 0xff, 0x0c, 0x00, 0xf7, 0xa4, 0x07, 0xc7, 0xa8, 0x01, 0x00,
+	0x00,
+}
+
+func (this *DeadlinePropagation) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DeadlinePropagation)
+	if !ok {
+		that2, ok := that.(DeadlinePropagation)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MaxGrpcTimeout != nil && that1.MaxGrpcTimeout != nil {
+		if *this.MaxGrpcTimeout != *that1.MaxGrpcTimeout {
+			return false
+		}
+	} else if this.MaxGrpcTimeout != nil {
+		return false
+	} else if that1.MaxGrpcTimeout != nil {
+		return false
+	}
+	if this.GrpcTimeoutOffset != nil && that1.GrpcTimeoutOffset != nil {
+		if *this.GrpcTimeoutOffset != *that1.GrpcTimeoutOffset {
+			return false
+		}
+	} else if this.GrpcTimeoutOffset != nil {
+		return false
+	} else if that1.GrpcTimeoutOffset != nil {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,309 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: github.com/solo-io/gloo/projects/gloo/api/v1/plugins/ec2/ec2.proto
+
+package ec2
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	math "math"
+
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	core "github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+// Upstream Spec for AWS EC2 Upstreams
+// EC2 Upstreams represent the set of running EC2 instances matching the filters of the upstream.
+// The endpoints of the upstream are discovered by describing the instances of the region, and are
+// refreshed periodically.
+type UpstreamSpec struct {
+	// The AWS Region where the instances are running
+	Region string `protobuf:"bytes,1,opt,name=region,proto3" json:"region,omitempty"`
+	// A [Gloo Secret Ref](https://gloo.solo.io/introduction/concepts/#Secrets) to an AWS Secret
+	// used to describe the instances.
+	// If no secret is referenced, Gloo uses the credentials of the environment it is running in.
+	SecretRef core.ResourceRef `protobuf:"bytes,2,opt,name=secret_ref,json=secretRef,proto3" json:"secret_ref"`
+	// (Optional) The ARN of a role to assume with the credentials before describing the instances
+	RoleArn string `protobuf:"bytes,3,opt,name=role_arn,json=roleArn,proto3" json:"role_arn,omitempty"`
+	// Only instances matching all of the tag filters are included in the upstream
+	Filters []*TagFilter `protobuf:"bytes,4,rep,name=filters,proto3" json:"filters,omitempty"`
+	// (Optional) Only include instances running in this VPC
+	VpcId string `protobuf:"bytes,5,opt,name=vpc_id,json=vpcId,proto3" json:"vpc_id,omitempty"`
+	// (Optional) Only include instances running in one of these subnets
+	SubnetIds []string `protobuf:"bytes,6,rep,name=subnet_ids,json=subnetIds,proto3" json:"subnet_ids,omitempty"`
+	// Use the public IP of the instances rather than their private IP.
+	// Instances without a public IP are skipped.
+	PublicIp bool `protobuf:"varint,7,opt,name=public_ip,json=publicIp,proto3" json:"public_ip,omitempty"`
+	// The port the instances are listening on
+	Port                 uint32   `protobuf:"varint,8,opt,name=port,proto3" json:"port,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpstreamSpec) Reset()         { *m = UpstreamSpec{} }
+func (m *UpstreamSpec) String() string { return proto.CompactTextString(m) }
+func (*UpstreamSpec) ProtoMessage()    {}
+func (*UpstreamSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21ace2bbe2236ee0, []int{0}
+}
+func (m *UpstreamSpec) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpstreamSpec.Unmarshal(m, b)
+}
+func (m *UpstreamSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpstreamSpec.Marshal(b, m, deterministic)
+}
+func (m *UpstreamSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpstreamSpec.Merge(m, src)
+}
+func (m *UpstreamSpec) XXX_Size() int {
+	return xxx_messageInfo_UpstreamSpec.Size(m)
+}
+func (m *UpstreamSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpstreamSpec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpstreamSpec proto.InternalMessageInfo
+
+func (m *UpstreamSpec) GetRegion() string {
+	if m != nil {
+		return m.Region
+	}
+	return ""
+}
+
+func (m *UpstreamSpec) GetSecretRef() core.ResourceRef {
+	if m != nil {
+		return m.SecretRef
+	}
+	return core.ResourceRef{}
+}
+
+func (m *UpstreamSpec) GetRoleArn() string {
+	if m != nil {
+		return m.RoleArn
+	}
+	return ""
+}
+
+func (m *UpstreamSpec) GetFilters() []*TagFilter {
+	if m != nil {
+		return m.Filters
+	}
+	return nil
+}
+
+func (m *UpstreamSpec) GetVpcId() string {
+	if m != nil {
+		return m.VpcId
+	}
+	return ""
+}
+
+func (m *UpstreamSpec) GetSubnetIds() []string {
+	if m != nil {
+		return m.SubnetIds
+	}
+	return nil
+}
+
+func (m *UpstreamSpec) GetPublicIp() bool {
+	if m != nil {
+		return m.PublicIp
+	}
+	return false
+}
+
+func (m *UpstreamSpec) GetPort() uint32 {
+	if m != nil {
+		return m.Port
+	}
+	return 0
+}
+
+// Matches the tags of an EC2 instance
+type TagFilter struct {
+	// The key of the tag
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// The value of the tag. If empty, any instance with the tag matches
+	Value                string   `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TagFilter) Reset()         { *m = TagFilter{} }
+func (m *TagFilter) String() string { return proto.CompactTextString(m) }
+func (*TagFilter) ProtoMessage()    {}
+func (*TagFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21ace2bbe2236ee0, []int{1}
+}
+func (m *TagFilter) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TagFilter.Unmarshal(m, b)
+}
+func (m *TagFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TagFilter.Marshal(b, m, deterministic)
+}
+func (m *TagFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TagFilter.Merge(m, src)
+}
+func (m *TagFilter) XXX_Size() int {
+	return xxx_messageInfo_TagFilter.Size(m)
+}
+func (m *TagFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_TagFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TagFilter proto.InternalMessageInfo
+
+func (m *TagFilter) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *TagFilter) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*UpstreamSpec)(nil), "ec2.plugins.gloo.solo.io.UpstreamSpec")
+	proto.RegisterType((*TagFilter)(nil), "ec2.plugins.gloo.solo.io.TagFilter")
+}
+
+func init() {
+	proto.RegisterFile("github.com/solo-io/gloo/projects/gloo/api/v1/plugins/ec2/ec2.proto", fileDescriptor_21ace2bbe2236ee0)
+}
+
+var fileDescriptor_21ace2bbe2236ee0 = []byte{
+	// 383 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0xbd, 0x8e, 0xd4, 0x30,
+	0x10, 0x26, 0xfb, 0x9b, 0xf8, 0x40, 0x42, 0xd6, 0x81, 0x7c, 0x87, 0x80, 0xe8, 0x68, 0x52, 0x80,
+	0x23, 0xf6, 0x5a, 0x40, 0x22, 0x05, 0xd2, 0xb6, 0x06, 0x1a, 0x9a, 0x28, 0x71, 0x26, 0xc6, 0x6c,
+	0x2e, 0x63, 0xd9, 0x4e, 0x24, 0x9e, 0x08, 0x1e, 0x85, 0xa7, 0xa0, 0xe0, 0x49, 0x50, 0x9c, 0x5d,
+	0xaa, 0x43, 0xba, 0xc2, 0xf2, 0xf7, 0x8d, 0xbf, 0x99, 0x6f, 0x6c, 0x0f, 0x29, 0x94, 0xf6, 0x5f,
+	0x87, 0x9a, 0x4b, 0xbc, 0xc9, 0x1d, 0x76, 0xf8, 0x4a, 0x63, 0xae, 0x3a, 0xc4, 0xdc, 0x58, 0xfc,
+	0x06, 0xd2, 0xbb, 0x99, 0x55, 0x46, 0xe7, 0xe3, 0xeb, 0xdc, 0x74, 0x83, 0xd2, 0xbd, 0xcb, 0x41,
+	0xee, 0xa6, 0xc5, 0x8d, 0x45, 0x8f, 0x94, 0x05, 0x38, 0x1f, 0xf1, 0x49, 0xce, 0xa7, 0x4a, 0x5c,
+	0xe3, 0xe5, 0xb9, 0x42, 0x85, 0x41, 0x94, 0x4f, 0x68, 0xd6, 0x5f, 0xbe, 0xbc, 0xc5, 0x33, 0xec,
+	0x07, 0xed, 0x4f, 0x4e, 0x16, 0xda, 0x59, 0x7d, 0xf5, 0x63, 0x41, 0xee, 0x7f, 0x36, 0xce, 0x5b,
+	0xa8, 0x6e, 0x3e, 0x1a, 0x90, 0xf4, 0x31, 0xd9, 0x58, 0x50, 0x1a, 0x7b, 0x16, 0xa5, 0x51, 0x96,
+	0x88, 0x23, 0xa3, 0xef, 0x08, 0x71, 0x20, 0x2d, 0xf8, 0xd2, 0x42, 0xcb, 0x16, 0x69, 0x94, 0x9d,
+	0xed, 0x2e, 0xb8, 0x44, 0x0b, 0xa7, 0x7e, 0xb8, 0x00, 0x87, 0x83, 0x95, 0x20, 0xa0, 0x2d, 0x56,
+	0xbf, 0x7e, 0x3f, 0xbf, 0x27, 0x92, 0x39, 0x45, 0x40, 0x4b, 0x2f, 0x48, 0x6c, 0xb1, 0x83, 0xb2,
+	0xb2, 0x3d, 0x5b, 0x86, 0xca, 0xdb, 0x89, 0xbf, 0xb7, 0x3d, 0x7d, 0x4b, 0xb6, 0xad, 0xee, 0x3c,
+	0x58, 0xc7, 0x56, 0xe9, 0x32, 0x3b, 0xdb, 0xbd, 0xe0, 0xff, 0xbb, 0x33, 0xff, 0x54, 0xa9, 0x0f,
+	0x41, 0x2b, 0x4e, 0x39, 0xf4, 0x11, 0xd9, 0x8c, 0x46, 0x96, 0xba, 0x61, 0xeb, 0x50, 0x77, 0x3d,
+	0x1a, 0xb9, 0x6f, 0xe8, 0x53, 0x42, 0xdc, 0x50, 0xf7, 0xe0, 0x4b, 0xdd, 0x38, 0xb6, 0x49, 0x97,
+	0x59, 0x22, 0x92, 0x39, 0xb2, 0x6f, 0x1c, 0x7d, 0x42, 0x12, 0x33, 0xd4, 0x9d, 0x96, 0xa5, 0x36,
+	0x6c, 0x9b, 0x46, 0x59, 0x2c, 0xe2, 0x39, 0xb0, 0x37, 0x94, 0x92, 0x95, 0x41, 0xeb, 0x59, 0x9c,
+	0x46, 0xd9, 0x03, 0x11, 0xf0, 0xd5, 0x35, 0x49, 0xfe, 0x99, 0xd3, 0x87, 0x64, 0x79, 0x80, 0xef,
+	0xc7, 0x27, 0x9a, 0x20, 0x3d, 0x27, 0xeb, 0xb1, 0xea, 0x06, 0x60, 0x8b, 0x63, 0x13, 0x13, 0x29,
+	0x8a, 0x9f, 0x7f, 0x9e, 0x45, 0x5f, 0xde, 0xdc, 0x6d, 0x0c, 0xcc, 0x41, 0xdd, 0x32, 0x0a, 0xf5,
+	0x26, 0xfc, 0xd4, 0xf5, 0xdf, 0x01, 0x00, 0xfd, 0x22, 0xb2, 0x12, 0x4d, 0x02, 0x00, 0x00,
+}
+
+func (this *UpstreamSpec) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UpstreamSpec)
+	if !ok {
+		that2, ok := that.(UpstreamSpec)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Region != that1.Region {
+		return false
+	}
+	if !this.SecretRef.Equal(&that1.SecretRef) {
+		return false
+	}
+	if this.RoleArn != that1.RoleArn {
+		return false
+	}
+	if len(this.Filters) != len(that1.Filters) {
+		return false
+	}
+	for i := range this.Filters {
+		if !this.Filters[i].Equal(that1.Filters[i]) {
+			return false
+		}
+	}
+	if this.VpcId != that1.VpcId {
+		return false
+	}
+	if len(this.SubnetIds) != len(that1.SubnetIds) {
+		return false
+	}
+	for i := range this.SubnetIds {
+		if this.SubnetIds[i] != that1.SubnetIds[i] {
+			return false
+		}
+	}
+	if this.PublicIp != that1.PublicIp {
+		return false
+	}
+	if this.Port != that1.Port {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *TagFilter) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TagFilter)
+	if !ok {
+		that2, ok := that.(TagFilter)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Key != that1.Key {
+		return false
+	}
+	if this.Value != that1.Value {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
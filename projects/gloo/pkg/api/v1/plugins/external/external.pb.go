@@ -0,0 +1,277 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: github.com/solo-io/gloo/projects/gloo/api/v1/plugins/external/external.proto
+
+package external
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	math "math"
+
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+// Upstream Spec for External Function Upstreams
+// External Function Upstreams expose HTTPS endpoints, such as Cloudflare Workers routes or other edge functions,
+// as functions that routes can select by name. Gloo does not call any provider API: the functions are not discovered,
+// and are invoked with the request as is.
+type UpstreamSpec struct {
+	// The functions of this upstream.
+	// All the functions must be served by the same host, create an upstream per host otherwise.
+	Functions            []*FunctionSpec `protobuf:"bytes,1,rep,name=functions,proto3" json:"functions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *UpstreamSpec) Reset()         { *m = UpstreamSpec{} }
+func (m *UpstreamSpec) String() string { return proto.CompactTextString(m) }
+func (*UpstreamSpec) ProtoMessage()    {}
+func (*UpstreamSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8bf6e9f1865ffcc, []int{0}
+}
+func (m *UpstreamSpec) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpstreamSpec.Unmarshal(m, b)
+}
+func (m *UpstreamSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpstreamSpec.Marshal(b, m, deterministic)
+}
+func (m *UpstreamSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpstreamSpec.Merge(m, src)
+}
+func (m *UpstreamSpec) XXX_Size() int {
+	return xxx_messageInfo_UpstreamSpec.Size(m)
+}
+func (m *UpstreamSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpstreamSpec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpstreamSpec proto.InternalMessageInfo
+
+func (m *UpstreamSpec) GetFunctions() []*FunctionSpec {
+	if m != nil {
+		return m.Functions
+	}
+	return nil
+}
+
+// Each Function Spec contains the endpoint Gloo routes to when the function is selected
+type FunctionSpec struct {
+	// the logical name gloo should associate with this function
+	LogicalName string `protobuf:"bytes,1,opt,name=logical_name,json=logicalName,proto3" json:"logical_name,omitempty"`
+	// The HTTPS URL of the function, with an optional port (defaults to 443) and query,
+	// e.g. `https://hello.example.workers.dev/api`
+	Url                  string   `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FunctionSpec) Reset()         { *m = FunctionSpec{} }
+func (m *FunctionSpec) String() string { return proto.CompactTextString(m) }
+func (*FunctionSpec) ProtoMessage()    {}
+func (*FunctionSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8bf6e9f1865ffcc, []int{1}
+}
+func (m *FunctionSpec) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FunctionSpec.Unmarshal(m, b)
+}
+func (m *FunctionSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FunctionSpec.Marshal(b, m, deterministic)
+}
+func (m *FunctionSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FunctionSpec.Merge(m, src)
+}
+func (m *FunctionSpec) XXX_Size() int {
+	return xxx_messageInfo_FunctionSpec.Size(m)
+}
+func (m *FunctionSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_FunctionSpec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FunctionSpec proto.InternalMessageInfo
+
+func (m *FunctionSpec) GetLogicalName() string {
+	if m != nil {
+		return m.LogicalName
+	}
+	return ""
+}
+
+func (m *FunctionSpec) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+type DestinationSpec struct {
+	// The Logical Name of the FunctionSpec to be invoked.
+	LogicalName          string   `protobuf:"bytes,1,opt,name=logical_name,json=logicalName,proto3" json:"logical_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DestinationSpec) Reset()         { *m = DestinationSpec{} }
+func (m *DestinationSpec) String() string { return proto.CompactTextString(m) }
+func (*DestinationSpec) ProtoMessage()    {}
+func (*DestinationSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8bf6e9f1865ffcc, []int{2}
+}
+func (m *DestinationSpec) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DestinationSpec.Unmarshal(m, b)
+}
+func (m *DestinationSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DestinationSpec.Marshal(b, m, deterministic)
+}
+func (m *DestinationSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DestinationSpec.Merge(m, src)
+}
+func (m *DestinationSpec) XXX_Size() int {
+	return xxx_messageInfo_DestinationSpec.Size(m)
+}
+func (m *DestinationSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_DestinationSpec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DestinationSpec proto.InternalMessageInfo
+
+func (m *DestinationSpec) GetLogicalName() string {
+	if m != nil {
+		return m.LogicalName
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*UpstreamSpec)(nil), "external.plugins.gloo.solo.io.UpstreamSpec")
+	proto.RegisterType((*FunctionSpec)(nil), "external.plugins.gloo.solo.io.FunctionSpec")
+	proto.RegisterType((*DestinationSpec)(nil), "external.plugins.gloo.solo.io.DestinationSpec")
+}
+
+func init() {
+	proto.RegisterFile("github.com/solo-io/gloo/projects/gloo/api/v1/plugins/external/external.proto", fileDescriptor_d8bf6e9f1865ffcc)
+}
+
+var fileDescriptor_d8bf6e9f1865ffcc = []byte{
+	// 248 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x90, 0xcd, 0x4a, 0x04, 0x31,
+	0x10, 0x84, 0x19, 0x17, 0x84, 0xcd, 0x0e, 0x28, 0x83, 0x87, 0x41, 0x50, 0xd6, 0x39, 0x2d, 0x88,
+	0x09, 0xfe, 0xbc, 0x80, 0x3f, 0x28, 0x82, 0x78, 0x58, 0xf1, 0xa0, 0x17, 0xc9, 0x86, 0x36, 0xb6,
+	0x66, 0xd2, 0x21, 0x3f, 0xe2, 0x23, 0xf9, 0x5c, 0x3e, 0x89, 0x64, 0x66, 0x71, 0xbc, 0x28, 0x7a,
+	0xab, 0xee, 0xaa, 0xaf, 0x0e, 0xc5, 0xae, 0x34, 0xc6, 0xa7, 0xb4, 0xe0, 0x8a, 0x5a, 0x11, 0xc8,
+	0xd0, 0x1e, 0x92, 0xd0, 0x86, 0x48, 0x38, 0x4f, 0xcf, 0xa0, 0x62, 0xe8, 0x2f, 0xe9, 0x50, 0xbc,
+	0xee, 0x0b, 0x67, 0x92, 0x46, 0x1b, 0x04, 0xbc, 0x45, 0xf0, 0x56, 0x9a, 0x2f, 0xc1, 0x9d, 0xa7,
+	0x48, 0xd5, 0xd6, 0x70, 0xf7, 0x49, 0x9e, 0x69, 0x9e, 0x8b, 0x39, 0xd2, 0xe6, 0x86, 0x26, 0x4d,
+	0x5d, 0x52, 0x64, 0xd5, 0x43, 0xcd, 0x1d, 0x2b, 0x6f, 0x5d, 0x88, 0x1e, 0x64, 0x7b, 0xe3, 0x40,
+	0x55, 0x97, 0x6c, 0xfc, 0x98, 0xac, 0x8a, 0x48, 0x36, 0xd4, 0xc5, 0x74, 0x34, 0x9b, 0x1c, 0xec,
+	0xf2, 0x5f, 0x8b, 0xf9, 0xf9, 0x32, 0x9f, 0xf9, 0xf9, 0x40, 0x37, 0xa7, 0xac, 0xfc, 0x6e, 0x55,
+	0x3b, 0xac, 0x34, 0xa4, 0x51, 0x49, 0xf3, 0x60, 0x65, 0x0b, 0x75, 0x31, 0x2d, 0x66, 0xe3, 0xf9,
+	0x64, 0xf9, 0xbb, 0x96, 0x2d, 0x54, 0xeb, 0x6c, 0x94, 0xbc, 0xa9, 0x57, 0x3a, 0x27, 0xcb, 0xe6,
+	0x88, 0xad, 0x9d, 0x41, 0x88, 0x68, 0xe5, 0x3f, 0x7a, 0x4e, 0x2e, 0xde, 0x3f, 0xb6, 0x8b, 0xfb,
+	0xe3, 0xbf, 0xcd, 0xeb, 0x5e, 0xf4, 0x4f, 0x13, 0x2f, 0x56, 0xbb, 0x95, 0x0e, 0x3f, 0x07, 0x00,
+	0x7d, 0x3e, 0xe3, 0x80, 0xaa, 0x01, 0x00, 0x00,
+}
+
+func (this *UpstreamSpec) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UpstreamSpec)
+	if !ok {
+		that2, ok := that.(UpstreamSpec)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Functions) != len(that1.Functions) {
+		return false
+	}
+	for i := range this.Functions {
+		if !this.Functions[i].Equal(that1.Functions[i]) {
+			return false
+		}
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *FunctionSpec) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FunctionSpec)
+	if !ok {
+		that2, ok := that.(FunctionSpec)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.LogicalName != that1.LogicalName {
+		return false
+	}
+	if this.Url != that1.Url {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *DestinationSpec) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DestinationSpec)
+	if !ok {
+		that2, ok := that.(DestinationSpec)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.LogicalName != that1.LogicalName {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
@@ -11,6 +11,7 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	transformation "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/transformation"
+	core "github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -35,10 +36,11 @@ type ServiceSpec struct {
 	// List of services used by this upstream. For a grpc upstream where you don't
 	// need to use Gloo's function routing, this can be an empty list. These
 	// services must be present in the descriptors.
-	GrpcServices         []*ServiceSpec_GrpcService `protobuf:"bytes,2,rep,name=grpc_services,json=grpcServices,proto3" json:"grpc_services,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
-	XXX_unrecognized     []byte                     `json:"-"`
-	XXX_sizecache        int32                      `json:"-"`
+	GrpcServices          []*ServiceSpec_GrpcService         `protobuf:"bytes,2,rep,name=grpc_services,json=grpcServices,proto3" json:"grpc_services,omitempty"`
+	ReflectionCredentials *ServiceSpec_ReflectionCredentials `protobuf:"bytes,3,opt,name=reflection_credentials,json=reflectionCredentials,proto3" json:"reflection_credentials,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{}                           `json:"-"`
+	XXX_unrecognized      []byte                             `json:"-"`
+	XXX_sizecache         int32                              `json:"-"`
 }
 
 func (m *ServiceSpec) Reset()         { *m = ServiceSpec{} }
@@ -79,6 +81,13 @@ func (m *ServiceSpec) GetGrpcServices() []*ServiceSpec_GrpcService {
 	return nil
 }
 
+func (m *ServiceSpec) GetReflectionCredentials() *ServiceSpec_ReflectionCredentials {
+	if m != nil {
+		return m.ReflectionCredentials
+	}
+	return nil
+}
+
 // Describes a grpc service
 type ServiceSpec_GrpcService struct {
 	// The package of this service.
@@ -137,6 +146,66 @@ func (m *ServiceSpec_GrpcService) GetFunctionNames() []string {
 	return nil
 }
 
+// Credentials sent as metadata with each call made by function discovery, for services that
+// authenticate the server reflection. As the token is only sent over TLS, the upstream must
+// have an ssl config, which is also used by the reflection client.
+type ServiceSpec_ReflectionCredentials struct {
+	// The secret holding the token. It must be an extension secret with the token in its `token` field.
+	SecretRef *core.ResourceRef `protobuf:"bytes,1,opt,name=secret_ref,json=secretRef,proto3" json:"secret_ref,omitempty"`
+	// The metadata key carrying the token. Defaults to `authorization`.
+	Header string `protobuf:"bytes,2,opt,name=header,proto3" json:"header,omitempty"`
+	// Prepended to the token, e.g. `Bearer `.
+	Prefix               string   `protobuf:"bytes,3,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ServiceSpec_ReflectionCredentials) Reset()         { *m = ServiceSpec_ReflectionCredentials{} }
+func (m *ServiceSpec_ReflectionCredentials) String() string { return proto.CompactTextString(m) }
+func (*ServiceSpec_ReflectionCredentials) ProtoMessage()    {}
+func (*ServiceSpec_ReflectionCredentials) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93666c393f0bbf49, []int{0, 1}
+}
+func (m *ServiceSpec_ReflectionCredentials) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ServiceSpec_ReflectionCredentials.Unmarshal(m, b)
+}
+func (m *ServiceSpec_ReflectionCredentials) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ServiceSpec_ReflectionCredentials.Marshal(b, m, deterministic)
+}
+func (m *ServiceSpec_ReflectionCredentials) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ServiceSpec_ReflectionCredentials.Merge(m, src)
+}
+func (m *ServiceSpec_ReflectionCredentials) XXX_Size() int {
+	return xxx_messageInfo_ServiceSpec_ReflectionCredentials.Size(m)
+}
+func (m *ServiceSpec_ReflectionCredentials) XXX_DiscardUnknown() {
+	xxx_messageInfo_ServiceSpec_ReflectionCredentials.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ServiceSpec_ReflectionCredentials proto.InternalMessageInfo
+
+func (m *ServiceSpec_ReflectionCredentials) GetSecretRef() *core.ResourceRef {
+	if m != nil {
+		return m.SecretRef
+	}
+	return nil
+}
+
+func (m *ServiceSpec_ReflectionCredentials) GetHeader() string {
+	if m != nil {
+		return m.Header
+	}
+	return ""
+}
+
+func (m *ServiceSpec_ReflectionCredentials) GetPrefix() string {
+	if m != nil {
+		return m.Prefix
+	}
+	return ""
+}
+
 // This is only for upstream with Grpc service spec.
 type DestinationSpec struct {
 	// The proto package of the function.
@@ -208,6 +277,7 @@ func (m *DestinationSpec) GetParameters() *transformation.Parameters {
 func init() {
 	proto.RegisterType((*ServiceSpec)(nil), "grpc.plugins.gloo.solo.io.ServiceSpec")
 	proto.RegisterType((*ServiceSpec_GrpcService)(nil), "grpc.plugins.gloo.solo.io.ServiceSpec.GrpcService")
+	proto.RegisterType((*ServiceSpec_ReflectionCredentials)(nil), "grpc.plugins.gloo.solo.io.ServiceSpec.ReflectionCredentials")
 	proto.RegisterType((*DestinationSpec)(nil), "grpc.plugins.gloo.solo.io.DestinationSpec")
 }
 
@@ -216,31 +286,37 @@ func init() {
 }
 
 var fileDescriptor_93666c393f0bbf49 = []byte{
-	// 369 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x52, 0xc1, 0x4b, 0xfb, 0x30,
-	0x14, 0xa6, 0xeb, 0x8f, 0x9f, 0x2e, 0xdd, 0x14, 0x8a, 0x87, 0xda, 0x83, 0xd4, 0x81, 0xd0, 0x8b,
-	0x09, 0xce, 0xb3, 0x17, 0x1d, 0x78, 0x53, 0xe9, 0x04, 0xc1, 0xcb, 0xc8, 0x62, 0x16, 0xe3, 0xd6,
-	0x26, 0x24, 0xd9, 0x0e, 0x9e, 0xfd, 0x63, 0x3c, 0xfb, 0x27, 0xf9, 0x97, 0x48, 0x93, 0x74, 0xab,
-	0x30, 0x41, 0xbc, 0x94, 0x7c, 0xef, 0x7d, 0xef, 0x7d, 0xef, 0x7d, 0x7d, 0x60, 0xc4, 0xb8, 0x79,
-	0x5e, 0x4e, 0x21, 0x11, 0x25, 0xd2, 0x62, 0x21, 0x4e, 0xb9, 0x40, 0x6c, 0x21, 0x04, 0x92, 0x4a,
-	0xbc, 0x50, 0x62, 0xb4, 0x43, 0x58, 0x72, 0xb4, 0x3a, 0x43, 0x72, 0xb1, 0x64, 0xbc, 0xd2, 0x88,
-	0x29, 0x49, 0xec, 0x07, 0x4a, 0x25, 0x8c, 0x88, 0x0f, 0xdd, 0xdb, 0x65, 0x61, 0x5d, 0x01, 0xeb,
-	0x66, 0x90, 0x8b, 0xf4, 0x80, 0x09, 0x26, 0x2c, 0x0b, 0xd5, 0x2f, 0x57, 0x90, 0xde, 0xff, 0x49,
-	0xd6, 0x28, 0x5c, 0xe9, 0x99, 0x50, 0x25, 0x36, 0x5c, 0x54, 0x48, 0x62, 0x85, 0x4b, 0x6a, 0xa8,
-	0xd2, 0xae, 0xeb, 0xe0, 0xad, 0x03, 0xa2, 0x31, 0x55, 0x2b, 0x4e, 0xe8, 0x58, 0x52, 0x12, 0x67,
-	0x20, 0x7a, 0xa2, 0x9a, 0x28, 0x2e, 0x8d, 0x50, 0x3a, 0x09, 0xb2, 0x20, 0xef, 0x15, 0xed, 0x50,
-	0xfc, 0x00, 0xfa, 0xf5, 0xe8, 0x13, 0xed, 0xaa, 0x74, 0xd2, 0xc9, 0xc2, 0x3c, 0x1a, 0x0e, 0xe1,
-	0x8f, 0x0b, 0xc1, 0x96, 0x00, 0xbc, 0x56, 0x92, 0x78, 0x5c, 0xf4, 0xd8, 0x06, 0xe8, 0xf4, 0x15,
-	0x44, 0xad, 0x64, 0x7c, 0x0c, 0x7a, 0x12, 0x93, 0x39, 0x66, 0x74, 0x52, 0xe1, 0x92, 0xda, 0x51,
-	0xba, 0x45, 0xe4, 0x63, 0x37, 0xb8, 0xb4, 0x14, 0x3f, 0x85, 0xa3, 0x74, 0x1c, 0xc5, 0xc7, 0x2c,
-	0xe5, 0x04, 0xec, 0xcd, 0x96, 0x15, 0xa9, 0x97, 0xb7, 0x1c, 0x9d, 0x84, 0x59, 0x98, 0x77, 0x8b,
-	0x7e, 0x13, 0xad, 0x59, 0x7a, 0xf0, 0x11, 0x80, 0xfd, 0x11, 0xd5, 0x86, 0x57, 0xd6, 0x27, 0x6b,
-	0x45, 0x02, 0x76, 0xbc, 0x98, 0xd7, 0x6e, 0x60, 0x9d, 0xf1, 0x1a, 0x5e, 0xb2, 0x81, 0x71, 0x0a,
-	0x76, 0x9b, 0xc6, 0x49, 0x68, 0x53, 0x6b, 0x1c, 0xdf, 0x02, 0xb0, 0xb1, 0x3f, 0xf9, 0x97, 0x05,
-	0x79, 0x34, 0x44, 0xf0, 0xfb, 0x0f, 0xda, 0xee, 0xdf, 0xdd, 0xba, 0xac, 0x68, 0xb5, 0xb8, 0xbc,
-	0x7a, 0xff, 0x3c, 0x0a, 0x1e, 0x2f, 0x7e, 0x77, 0x17, 0x72, 0xce, 0xb6, 0x9d, 0xe4, 0xf4, 0xbf,
-	0xbd, 0x83, 0xf3, 0xaf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x48, 0xdd, 0x66, 0x31, 0xd6, 0x02, 0x00,
-	0x00,
+	// 476 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x53, 0x4d, 0x8b, 0xd4, 0x40,
+	0x10, 0x25, 0x3b, 0xb2, 0x3a, 0x9d, 0x59, 0x85, 0xe0, 0x2e, 0xb3, 0x39, 0xc8, 0xb8, 0x20, 0xcc,
+	0x41, 0x3b, 0x38, 0x5e, 0x3c, 0xe8, 0xc5, 0x5d, 0xf0, 0xa6, 0xd2, 0x2b, 0x08, 0x5e, 0x86, 0xde,
+	0x9e, 0x4a, 0x6f, 0x3b, 0x49, 0xba, 0xa9, 0xee, 0x59, 0xc4, 0x9b, 0xff, 0xc6, 0xb3, 0x3f, 0xc9,
+	0xff, 0x21, 0x48, 0x7f, 0x64, 0x66, 0x84, 0x08, 0x8b, 0x97, 0xa4, 0x5f, 0xf5, 0xab, 0x57, 0xf5,
+	0x92, 0x2a, 0x72, 0x21, 0x95, 0xbb, 0xde, 0x5c, 0x51, 0xa1, 0xdb, 0xca, 0xea, 0x46, 0x3f, 0x53,
+	0xba, 0x92, 0x8d, 0xd6, 0x95, 0x41, 0xfd, 0x05, 0x84, 0xb3, 0x11, 0x71, 0xa3, 0xaa, 0x9b, 0xe7,
+	0x95, 0x69, 0x36, 0x52, 0x75, 0xb6, 0x92, 0x68, 0x44, 0x78, 0x50, 0x83, 0xda, 0xe9, 0xe2, 0x34,
+	0x9e, 0xe3, 0x2d, 0xf5, 0x19, 0xd4, 0x8b, 0x51, 0xa5, 0xcb, 0x87, 0x52, 0x4b, 0x1d, 0x58, 0x95,
+	0x3f, 0xc5, 0x84, 0xf2, 0xe3, 0x7f, 0x95, 0x75, 0xc8, 0x3b, 0x5b, 0x6b, 0x6c, 0xb9, 0x53, 0xba,
+	0xab, 0x0c, 0x47, 0xde, 0x82, 0x03, 0xb4, 0x49, 0xf5, 0xe9, 0x80, 0x6a, 0x78, 0xaf, 0x95, 0xeb,
+	0xb5, 0x10, 0xea, 0xc8, 0x3e, 0xfb, 0x3d, 0x22, 0xf9, 0x25, 0xe0, 0x8d, 0x12, 0x70, 0x69, 0x40,
+	0x14, 0x33, 0x92, 0xaf, 0xc0, 0x0a, 0x54, 0xc6, 0x69, 0xb4, 0xd3, 0x6c, 0x96, 0xcd, 0x27, 0x6c,
+	0x3f, 0x54, 0x7c, 0x22, 0x47, 0xde, 0xe8, 0xd2, 0xc6, 0x2c, 0x3b, 0x3d, 0x98, 0x8d, 0xe6, 0xf9,
+	0x62, 0x41, 0xff, 0x69, 0x9f, 0xee, 0x15, 0xa0, 0x6f, 0xd1, 0x88, 0x84, 0xd9, 0x44, 0xee, 0x80,
+	0x2d, 0x2c, 0x39, 0x41, 0xa8, 0x1b, 0x10, 0xde, 0xd7, 0x52, 0x20, 0xac, 0xa0, 0x73, 0x8a, 0x37,
+	0x76, 0x3a, 0x9a, 0x65, 0xf3, 0x7c, 0xf1, 0xea, 0x96, 0x15, 0xd8, 0x56, 0xe4, 0x7c, 0xa7, 0xc1,
+	0x8e, 0x71, 0x28, 0x5c, 0x7e, 0x23, 0xf9, 0x5e, 0x47, 0xc5, 0x63, 0x32, 0x31, 0x5c, 0xac, 0xb9,
+	0x84, 0x65, 0xc7, 0x5b, 0x08, 0xfe, 0xc7, 0x2c, 0x4f, 0xb1, 0x77, 0xbc, 0x0d, 0x94, 0x64, 0x3d,
+	0x52, 0x0e, 0x22, 0x25, 0xc5, 0x02, 0xe5, 0x09, 0xb9, 0x5f, 0x6f, 0xba, 0xe8, 0xc3, 0x73, 0xbc,
+	0x83, 0xd1, 0x7c, 0xcc, 0x8e, 0xfa, 0xa8, 0x67, 0xd9, 0xf2, 0x7b, 0x46, 0x8e, 0x07, 0x9b, 0x2d,
+	0x5e, 0x12, 0x62, 0x41, 0x20, 0xb8, 0x25, 0x42, 0x1d, 0x9a, 0xc8, 0x17, 0xa7, 0x54, 0x68, 0x84,
+	0xad, 0x63, 0x06, 0x56, 0x6f, 0x50, 0x00, 0x83, 0x9a, 0x8d, 0x23, 0x99, 0x41, 0x5d, 0x9c, 0x90,
+	0xc3, 0x6b, 0xe0, 0x2b, 0xc0, 0xd4, 0x57, 0x42, 0x3e, 0x6e, 0x10, 0x6a, 0xf5, 0x35, 0x7c, 0xcc,
+	0x31, 0x4b, 0xe8, 0xec, 0x67, 0x46, 0x1e, 0x5c, 0x80, 0x75, 0xaa, 0x0b, 0xe3, 0x14, 0x66, 0x60,
+	0x4a, 0xee, 0x26, 0xc3, 0xc9, 0x7f, 0x0f, 0xfd, 0x4d, 0xf2, 0x99, 0xe4, 0x7b, 0x58, 0x94, 0xe4,
+	0x5e, 0x6f, 0x2e, 0x55, 0xd8, 0xe2, 0xe2, 0x3d, 0x21, 0xbb, 0x29, 0x9d, 0xde, 0x09, 0x6e, 0x2a,
+	0xfa, 0xf7, 0x1c, 0x0f, 0xff, 0xd6, 0x0f, 0xdb, 0x34, 0xb6, 0x27, 0xf1, 0xe6, 0xfc, 0xc7, 0xaf,
+	0x47, 0xd9, 0xe7, 0xd7, 0xb7, 0x5b, 0x1f, 0xb3, 0x96, 0x43, 0x9b, 0x7b, 0x75, 0x18, 0x16, 0xe0,
+	0xc5, 0x9f, 0x01, 0x00, 0xe5, 0x82, 0xd0, 0x4f, 0xfd, 0x03, 0x00, 0x00,
 }
 
 func (this *ServiceSpec) Equal(that interface{}) bool {
@@ -273,6 +349,9 @@ func (this *ServiceSpec) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if !this.ReflectionCredentials.Equal(that1.ReflectionCredentials) {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -316,6 +395,39 @@ func (this *ServiceSpec_GrpcService) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *ServiceSpec_ReflectionCredentials) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ServiceSpec_ReflectionCredentials)
+	if !ok {
+		that2, ok := that.(ServiceSpec_ReflectionCredentials)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.SecretRef.Equal(that1.SecretRef) {
+		return false
+	}
+	if this.Header != that1.Header {
+		return false
+	}
+	if this.Prefix != that1.Prefix {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
 func (this *DestinationSpec) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
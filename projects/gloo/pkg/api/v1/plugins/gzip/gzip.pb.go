@@ -0,0 +1,290 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: github.com/solo-io/gloo/projects/gloo/api/v1/plugins/gzip/gzip.proto
+
+package gzip
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	math "math"
+
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	types "github.com/gogo/protobuf/types"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+type Gzip_CompressionLevel int32
+
+const (
+	Gzip_DEFAULT Gzip_CompressionLevel = 0
+	Gzip_BEST    Gzip_CompressionLevel = 1
+	Gzip_SPEED   Gzip_CompressionLevel = 2
+)
+
+var Gzip_CompressionLevel_name = map[int32]string{
+	0: "DEFAULT",
+	1: "BEST",
+	2: "SPEED",
+}
+
+var Gzip_CompressionLevel_value = map[string]int32{
+	"DEFAULT": 0,
+	"BEST":    1,
+	"SPEED":   2,
+}
+
+func (x Gzip_CompressionLevel) String() string {
+	return proto.EnumName(Gzip_CompressionLevel_name, int32(x))
+}
+
+func (Gzip_CompressionLevel) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_58523c6bf98e244f, []int{0, 0}
+}
+
+// Compresses the responses of an http listener with gzip, for the clients accepting it.
+// See the [envoy gzip filter](https://www.envoyproxy.io/docs/envoy/latest/configuration/http_filters/gzip_filter)
+// for the responses it skips. Brotli compression is not supported by the envoy gloo runs.
+type Gzip struct {
+	// The minimum length in bytes of the responses compressed, 30 by default.
+	MinContentLength *types.UInt32Value `protobuf:"bytes,1,opt,name=min_content_length,json=minContentLength,proto3" json:"min_content_length,omitempty"`
+	// The content types of the responses compressed, e.g. `application/json`. By default `application/javascript`,
+	// `application/json`, `application/xhtml+xml`, `image/svg+xml`, `text/css`, `text/html`, `text/plain` and
+	// `text/xml`.
+	ContentTypes []string `protobuf:"bytes,2,rep,name=content_types,json=contentTypes,proto3" json:"content_types,omitempty"`
+	// Whether the responses with an etag header are not compressed.
+	DisableOnEtagHeader bool `protobuf:"varint,3,opt,name=disable_on_etag_header,json=disableOnEtagHeader,proto3" json:"disable_on_etag_header,omitempty"`
+	// Whether the accept-encoding header is removed from the requests, so that the upstreams do not compress the
+	// responses themselves.
+	RemoveAcceptEncodingHeader bool `protobuf:"varint,4,opt,name=remove_accept_encoding_header,json=removeAcceptEncodingHeader,proto3" json:"remove_accept_encoding_header,omitempty"`
+	// Whether the responses are compressed for size or for speed.
+	CompressionLevel     Gzip_CompressionLevel `protobuf:"varint,5,opt,name=compression_level,json=compressionLevel,proto3,enum=gzip.plugins.gloo.solo.io.Gzip_CompressionLevel" json:"compression_level,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *Gzip) Reset()         { *m = Gzip{} }
+func (m *Gzip) String() string { return proto.CompactTextString(m) }
+func (*Gzip) ProtoMessage()    {}
+func (*Gzip) Descriptor() ([]byte, []int) {
+	return fileDescriptor_58523c6bf98e244f, []int{0}
+}
+func (m *Gzip) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Gzip.Unmarshal(m, b)
+}
+func (m *Gzip) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Gzip.Marshal(b, m, deterministic)
+}
+func (m *Gzip) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Gzip.Merge(m, src)
+}
+func (m *Gzip) XXX_Size() int {
+	return xxx_messageInfo_Gzip.Size(m)
+}
+func (m *Gzip) XXX_DiscardUnknown() {
+	xxx_messageInfo_Gzip.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Gzip proto.InternalMessageInfo
+
+func (m *Gzip) GetMinContentLength() *types.UInt32Value {
+	if m != nil {
+		return m.MinContentLength
+	}
+	return nil
+}
+
+func (m *Gzip) GetContentTypes() []string {
+	if m != nil {
+		return m.ContentTypes
+	}
+	return nil
+}
+
+func (m *Gzip) GetDisableOnEtagHeader() bool {
+	if m != nil {
+		return m.DisableOnEtagHeader
+	}
+	return false
+}
+
+func (m *Gzip) GetRemoveAcceptEncodingHeader() bool {
+	if m != nil {
+		return m.RemoveAcceptEncodingHeader
+	}
+	return false
+}
+
+func (m *Gzip) GetCompressionLevel() Gzip_CompressionLevel {
+	if m != nil {
+		return m.CompressionLevel
+	}
+	return Gzip_DEFAULT
+}
+
+// Configures the compression of the responses of a route.
+type RouteGzip struct {
+	// Disables the compression of the responses of the route. The route adds the `no-transform` directive to the
+	// `cache-control` header of its responses, which the gzip filter does not compress. The directive is not removed
+	// once the filter is passed: the clients, and the caches and CDNs between gloo and the clients, receive it too, so
+	// that they no longer transform the responses either, e.g. by compressing them or converting their images.
+	Disabled             bool     `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RouteGzip) Reset()         { *m = RouteGzip{} }
+func (m *RouteGzip) String() string { return proto.CompactTextString(m) }
+func (*RouteGzip) ProtoMessage()    {}
+func (*RouteGzip) Descriptor() ([]byte, []int) {
+	return fileDescriptor_58523c6bf98e244f, []int{1}
+}
+func (m *RouteGzip) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RouteGzip.Unmarshal(m, b)
+}
+func (m *RouteGzip) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RouteGzip.Marshal(b, m, deterministic)
+}
+func (m *RouteGzip) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RouteGzip.Merge(m, src)
+}
+func (m *RouteGzip) XXX_Size() int {
+	return xxx_messageInfo_RouteGzip.Size(m)
+}
+func (m *RouteGzip) XXX_DiscardUnknown() {
+	xxx_messageInfo_RouteGzip.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RouteGzip proto.InternalMessageInfo
+
+func (m *RouteGzip) GetDisabled() bool {
+	if m != nil {
+		return m.Disabled
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterEnum("gzip.plugins.gloo.solo.io.Gzip_CompressionLevel", Gzip_CompressionLevel_name, Gzip_CompressionLevel_value)
+	proto.RegisterType((*Gzip)(nil), "gzip.plugins.gloo.solo.io.Gzip")
+	proto.RegisterType((*RouteGzip)(nil), "gzip.plugins.gloo.solo.io.RouteGzip")
+}
+
+func init() {
+	proto.RegisterFile("github.com/solo-io/gloo/projects/gloo/api/v1/plugins/gzip/gzip.proto", fileDescriptor_58523c6bf98e244f)
+}
+
+var fileDescriptor_58523c6bf98e244f = []byte{
+	// 419 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xcf, 0x8e, 0xd3, 0x30,
+	0x10, 0xc6, 0xc9, 0xb6, 0x0b, 0xad, 0x17, 0x50, 0x30, 0x08, 0x95, 0x0a, 0x56, 0x55, 0x39, 0xd0,
+	0x0b, 0x36, 0xb4, 0x5c, 0x39, 0x74, 0xdb, 0xf0, 0x4f, 0x95, 0x40, 0xd9, 0x2e, 0x07, 0x24, 0x14,
+	0xa5, 0xc9, 0xe0, 0x1a, 0x1c, 0x8f, 0x95, 0x38, 0x45, 0xec, 0x99, 0x87, 0xe1, 0xb9, 0x78, 0x12,
+	0x14, 0x3b, 0xbb, 0x48, 0x15, 0x48, 0x7b, 0x89, 0xc6, 0xdf, 0x7c, 0xbf, 0x4f, 0xca, 0xcc, 0x90,
+	0xa5, 0x90, 0x76, 0x5b, 0x6f, 0x58, 0x86, 0x05, 0xaf, 0x50, 0xe1, 0x53, 0x89, 0x5c, 0x28, 0x44,
+	0x6e, 0x4a, 0xfc, 0x0a, 0x99, 0xad, 0xfc, 0x2b, 0x35, 0x92, 0xef, 0x9e, 0x73, 0xa3, 0x6a, 0x21,
+	0x75, 0xc5, 0xc5, 0xb9, 0x34, 0xee, 0xc3, 0x4c, 0x89, 0x16, 0xe9, 0x03, 0x5f, 0xfb, 0x2e, 0x6b,
+	0x08, 0xd6, 0x84, 0x31, 0x89, 0xc3, 0x63, 0x81, 0x28, 0x14, 0x70, 0x67, 0xdc, 0xd4, 0x5f, 0xf8,
+	0xf7, 0x32, 0x35, 0x06, 0xca, 0xca, 0xa3, 0xc3, 0x7b, 0x02, 0x05, 0xba, 0x92, 0x37, 0x95, 0x57,
+	0xc7, 0x3f, 0x3b, 0xa4, 0xfb, 0xfa, 0x5c, 0x1a, 0xfa, 0x8e, 0xd0, 0x42, 0xea, 0x24, 0x43, 0x6d,
+	0x41, 0xdb, 0x44, 0x81, 0x16, 0x76, 0x3b, 0x08, 0x46, 0xc1, 0xe4, 0x68, 0xfa, 0x90, 0xf9, 0x6c,
+	0x76, 0x91, 0xcd, 0xce, 0xde, 0x6a, 0x3b, 0x9b, 0x7e, 0x4c, 0x55, 0x0d, 0x71, 0x58, 0x48, 0xbd,
+	0xf0, 0xd8, 0xca, 0x51, 0xf4, 0x31, 0xb9, 0x75, 0x91, 0x63, 0x7f, 0x18, 0xa8, 0x06, 0x07, 0xa3,
+	0xce, 0xa4, 0x1f, 0xdf, 0x6c, 0xc5, 0x75, 0xa3, 0xd1, 0x19, 0xb9, 0x9f, 0xcb, 0x2a, 0xdd, 0x28,
+	0x48, 0x50, 0x27, 0x60, 0x53, 0x91, 0x6c, 0x21, 0xcd, 0xa1, 0x1c, 0x74, 0x46, 0xc1, 0xa4, 0x17,
+	0xdf, 0x6d, 0xbb, 0xef, 0x75, 0x64, 0x53, 0xf1, 0xc6, 0xb5, 0xe8, 0x9c, 0x3c, 0x2a, 0xa1, 0xc0,
+	0x1d, 0x24, 0x69, 0x96, 0x81, 0xb1, 0x09, 0xe8, 0x0c, 0x73, 0xa9, 0x2f, 0xd9, 0xae, 0x63, 0x87,
+	0xde, 0x34, 0x77, 0x9e, 0xa8, 0xb5, 0xb4, 0x11, 0x9f, 0xc9, 0x9d, 0x0c, 0x0b, 0x53, 0x42, 0x55,
+	0x49, 0xd4, 0x89, 0x82, 0x1d, 0xa8, 0xc1, 0xe1, 0x28, 0x98, 0xdc, 0x9e, 0x3e, 0x63, 0xff, 0x1d,
+	0x2f, 0x6b, 0x86, 0xc4, 0x16, 0x7f, 0xc1, 0x55, 0xc3, 0xc5, 0x61, 0xb6, 0xa7, 0x8c, 0x5f, 0x90,
+	0x70, 0xdf, 0x45, 0x8f, 0xc8, 0x8d, 0x65, 0xf4, 0x6a, 0x7e, 0xb6, 0x5a, 0x87, 0xd7, 0x68, 0x8f,
+	0x74, 0x4f, 0xa2, 0xd3, 0x75, 0x18, 0xd0, 0x3e, 0x39, 0x3c, 0xfd, 0x10, 0x45, 0xcb, 0xf0, 0x60,
+	0xfc, 0x84, 0xf4, 0x63, 0xac, 0x2d, 0xb8, 0x55, 0x0c, 0x49, 0xaf, 0xfd, 0xf7, 0xdc, 0x2d, 0xa0,
+	0x17, 0x5f, 0xbe, 0x4f, 0x16, 0xbf, 0x7e, 0x1f, 0x07, 0x9f, 0x5e, 0x5e, 0xed, 0x98, 0xcc, 0x37,
+	0xf1, 0xaf, 0x83, 0xda, 0x5c, 0x77, 0x7b, 0x9c, 0xfd, 0x19, 0x00, 0xd4, 0xbb, 0x0b, 0x69, 0x94,
+	0x02, 0x00, 0x00,
+}
+
+func (this *Gzip) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Gzip)
+	if !ok {
+		that2, ok := that.(Gzip)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.MinContentLength.Equal(that1.MinContentLength) {
+		return false
+	}
+	if len(this.ContentTypes) != len(that1.ContentTypes) {
+		return false
+	}
+	for i := range this.ContentTypes {
+		if this.ContentTypes[i] != that1.ContentTypes[i] {
+			return false
+		}
+	}
+	if this.DisableOnEtagHeader != that1.DisableOnEtagHeader {
+		return false
+	}
+	if this.RemoveAcceptEncodingHeader != that1.RemoveAcceptEncodingHeader {
+		return false
+	}
+	if this.CompressionLevel != that1.CompressionLevel {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *RouteGzip) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RouteGzip)
+	if !ok {
+		that2, ok := that.(RouteGzip)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Disabled != that1.Disabled {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
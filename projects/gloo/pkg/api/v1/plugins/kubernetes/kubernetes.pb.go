@@ -46,10 +46,19 @@ type UpstreamSpec struct {
 	// Subset configuration. For discovery sources that has labels (like kubernetes). this
 	// configuration allows you to partition the upstream to a set of subsets.
 	// for each unique set of keys and values, a subset will be created.
-	SubsetSpec           *plugins.SubsetSpec `protobuf:"bytes,6,opt,name=subset_spec,json=subsetSpec,proto3" json:"subset_spec,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
-	XXX_unrecognized     []byte              `json:"-"`
-	XXX_sizecache        int32               `json:"-"`
+	SubsetSpec *plugins.SubsetSpec `protobuf:"bytes,6,opt,name=subset_spec,json=subsetSpec,proto3" json:"subset_spec,omitempty"`
+	// Restricts the Upstream to a single pod of the service, e.g. a member of a StatefulSet behind a headless service.
+	// Gloo generates these Upstreams for the headless services annotated with `gloo.solo.io/per_pod_upstreams: "true"`
+	PodName string `protobuf:"bytes,7,opt,name=pod_name,json=podName,proto3" json:"pod_name,omitempty"`
+	// Routes to these named ports of the service instead of the service port only, which must be the first of them.
+	// Destinations select a port with a subset whose `gloo.solo.io/port` value is the name of the port, the subset
+	// spec must have a selector with this key. Destinations that do not select a port are routed to the first port.
+	// Gloo generates these Upstreams for the services annotated with `gloo.solo.io/merge_ports: "true"`, as long as
+	// their ports speak the same protocol: the ports share the service spec and the ssl config of the Upstream.
+	ServicePortNames     []string `protobuf:"bytes,8,rep,name=service_port_names,json=servicePortNames,proto3" json:"service_port_names,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *UpstreamSpec) Reset()         { *m = UpstreamSpec{} }
@@ -118,6 +127,20 @@ func (m *UpstreamSpec) GetSubsetSpec() *plugins.SubsetSpec {
 	return nil
 }
 
+func (m *UpstreamSpec) GetPodName() string {
+	if m != nil {
+		return m.PodName
+	}
+	return ""
+}
+
+func (m *UpstreamSpec) GetServicePortNames() []string {
+	if m != nil {
+		return m.ServicePortNames
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*UpstreamSpec)(nil), "kubernetes.plugins.gloo.solo.io.UpstreamSpec")
 	proto.RegisterMapType((map[string]string)(nil), "kubernetes.plugins.gloo.solo.io.UpstreamSpec.SelectorEntry")
@@ -128,30 +151,32 @@ func init() {
 }
 
 var fileDescriptor_419a7b10c074c4e5 = []byte{
-	// 356 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0xcf, 0x4a, 0xf3, 0x40,
-	0x14, 0xc5, 0x49, 0xf3, 0xb5, 0x7c, 0x4e, 0x5a, 0xa8, 0xa1, 0x8b, 0xd0, 0x85, 0xa6, 0xae, 0x02,
-	0xe2, 0x04, 0xeb, 0x46, 0xec, 0xca, 0xff, 0xb8, 0x91, 0xd2, 0x22, 0x82, 0x1b, 0x49, 0xc2, 0x25,
-	0xc6, 0x26, 0xb9, 0xc3, 0xcc, 0xa4, 0xd0, 0xb7, 0x71, 0xe9, 0x73, 0xf9, 0x24, 0x92, 0x49, 0x1a,
-	0x47, 0xb0, 0x28, 0xdd, 0x9d, 0xb9, 0x9c, 0xf9, 0xcd, 0xc9, 0xb9, 0x21, 0xd3, 0x38, 0x91, 0x2f,
-	0x45, 0x48, 0x23, 0xcc, 0x7c, 0x81, 0x29, 0x1e, 0x25, 0xe8, 0xc7, 0x29, 0xa2, 0xcf, 0x38, 0xbe,
-	0x42, 0x24, 0x45, 0x75, 0x0a, 0x58, 0xe2, 0x2f, 0x8f, 0x7d, 0x96, 0x16, 0x71, 0x92, 0x0b, 0x7f,
-	0x51, 0x84, 0xc0, 0x73, 0x90, 0xa0, 0x4b, 0xca, 0x38, 0x4a, 0xb4, 0xf7, 0xf5, 0x49, 0xe5, 0xa7,
-	0x25, 0x83, 0x96, 0x78, 0x9a, 0xe0, 0x70, 0x10, 0x63, 0x8c, 0xca, 0xeb, 0x97, 0xaa, 0xba, 0x36,
-	0xbc, 0xdd, 0x2a, 0x88, 0x00, 0xbe, 0x4c, 0x22, 0x78, 0x16, 0x0c, 0xa2, 0x1a, 0x74, 0xb3, 0x1d,
-	0xa8, 0x08, 0x05, 0x48, 0x8d, 0x73, 0xf0, 0x66, 0x92, 0xee, 0x03, 0x13, 0x92, 0x43, 0x90, 0xcd,
-	0x19, 0x44, 0xf6, 0x88, 0x74, 0xd7, 0xcf, 0xe5, 0x41, 0x06, 0x8e, 0xe1, 0x1a, 0xde, 0xce, 0xcc,
-	0xaa, 0x67, 0xf7, 0x41, 0x06, 0xf6, 0x21, 0xd9, 0xd5, 0x2d, 0x82, 0x05, 0x11, 0x38, 0x2d, 0xe5,
-	0xeb, 0x6b, 0x3e, 0x35, 0xd7, 0x79, 0x0c, 0xb9, 0x74, 0x4c, 0xd7, 0xf0, 0x7a, 0x0d, 0x6f, 0x8a,
-	0x5c, 0xda, 0x8f, 0xe4, 0xbf, 0x80, 0x14, 0x22, 0x89, 0xdc, 0xf9, 0xe7, 0x9a, 0x9e, 0x35, 0x9e,
-	0xd0, 0x5f, 0xea, 0xa5, 0x7a, 0x66, 0x3a, 0xaf, 0x6f, 0x5f, 0xe7, 0x92, 0xaf, 0x66, 0x0d, 0xcc,
-	0xbe, 0xfa, 0x7a, 0xbb, 0xfc, 0x64, 0xa7, 0xed, 0x1a, 0x9e, 0x35, 0x1e, 0xfd, 0x4c, 0x9c, 0x57,
-	0xce, 0x12, 0xd8, 0xc4, 0x53, 0x8d, 0x9c, 0x13, 0x4b, 0xeb, 0xcd, 0xe9, 0x28, 0x88, 0xbb, 0x01,
-	0xa2, 0x8c, 0x8a, 0x41, 0x44, 0xa3, 0x87, 0x13, 0xd2, 0xfb, 0x96, 0xd1, 0xee, 0x13, 0x73, 0x01,
-	0xab, 0xba, 0xdc, 0x52, 0xda, 0x03, 0xd2, 0x5e, 0x06, 0x69, 0xb1, 0x2e, 0xb2, 0x3a, 0x9c, 0xb5,
-	0x4e, 0x8d, 0x8b, 0xbb, 0xf7, 0x8f, 0x3d, 0xe3, 0xe9, 0xf2, 0x6f, 0x0b, 0x67, 0x8b, 0x78, 0xf3,
-	0x6f, 0x1c, 0x76, 0xd4, 0xd2, 0x4f, 0x3e, 0x03, 0x00, 0x00, 0xff, 0xff, 0x6e, 0x2f, 0x9f, 0xb4,
-	0x10, 0x03, 0x00, 0x00,
+	// 389 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0xcf, 0x6b, 0xdb, 0x30,
+	0x14, 0xc7, 0x71, 0xbc, 0xfc, 0x98, 0x9c, 0x40, 0x26, 0x72, 0xf0, 0x72, 0xd8, 0x9c, 0x9d, 0x0c,
+	0xdb, 0x64, 0x96, 0x5d, 0xc6, 0x72, 0xda, 0x6f, 0x76, 0x19, 0x21, 0x61, 0x14, 0x7a, 0x29, 0xb6,
+	0xf3, 0x70, 0xdd, 0xd8, 0x7e, 0x42, 0x92, 0x03, 0xf9, 0x8f, 0xfa, 0x77, 0xf5, 0xd6, 0xff, 0xa2,
+	0x58, 0x76, 0x5c, 0x15, 0x1a, 0x5a, 0x72, 0x7b, 0x7e, 0x7c, 0xf5, 0xd1, 0xd3, 0x47, 0x32, 0x59,
+	0x26, 0xa9, 0xba, 0x2c, 0x23, 0x16, 0x63, 0x1e, 0x48, 0xcc, 0xf0, 0x63, 0x8a, 0x41, 0x92, 0x21,
+	0x06, 0x5c, 0xe0, 0x15, 0xc4, 0x4a, 0xd6, 0x5f, 0x21, 0x4f, 0x83, 0xdd, 0xa7, 0x80, 0x67, 0x65,
+	0x92, 0x16, 0x32, 0xd8, 0x96, 0x11, 0x88, 0x02, 0x14, 0x98, 0x25, 0xe3, 0x02, 0x15, 0xd2, 0xb7,
+	0x66, 0xa7, 0xce, 0xb3, 0x8a, 0xc1, 0x2a, 0x3c, 0x4b, 0x71, 0x3a, 0x49, 0x30, 0x41, 0x9d, 0x0d,
+	0xaa, 0xaa, 0x5e, 0x36, 0xfd, 0x73, 0xd2, 0x20, 0x12, 0xc4, 0x2e, 0x8d, 0xe1, 0x42, 0x72, 0x88,
+	0x1b, 0xd0, 0xef, 0xd3, 0x40, 0x65, 0x24, 0x41, 0x19, 0x9c, 0x77, 0xb7, 0x36, 0x19, 0xfe, 0xe7,
+	0x52, 0x09, 0x08, 0xf3, 0x35, 0x87, 0x98, 0xce, 0xc8, 0xf0, 0xb0, 0x5d, 0x11, 0xe6, 0xe0, 0x5a,
+	0x9e, 0xe5, 0xbf, 0x5c, 0x39, 0x4d, 0xef, 0x5f, 0x98, 0x03, 0x7d, 0x4f, 0x5e, 0x99, 0x11, 0xc9,
+	0xc3, 0x18, 0xdc, 0x8e, 0xce, 0x8d, 0x8d, 0x9c, 0xee, 0x9b, 0x3c, 0x8e, 0x42, 0xb9, 0xb6, 0x67,
+	0xf9, 0xa3, 0x96, 0xb7, 0x44, 0xa1, 0xe8, 0x19, 0x19, 0x48, 0xc8, 0x20, 0x56, 0x28, 0xdc, 0x17,
+	0x9e, 0xed, 0x3b, 0xf3, 0x05, 0x7b, 0x42, 0x2f, 0x33, 0x67, 0x66, 0xeb, 0x66, 0xf5, 0xaf, 0x42,
+	0x89, 0xfd, 0xaa, 0x85, 0xd1, 0x9f, 0xf7, 0x7b, 0x57, 0x47, 0x76, 0xbb, 0x9e, 0xe5, 0x3b, 0xf3,
+	0xd9, 0xe3, 0xc4, 0x75, 0x9d, 0xac, 0x80, 0xed, 0x78, 0xda, 0xc8, 0x37, 0xe2, 0x18, 0xde, 0xdc,
+	0x9e, 0x86, 0x78, 0x47, 0x20, 0x3a, 0xa8, 0x19, 0x44, 0xb6, 0x35, 0x7d, 0x4d, 0x06, 0x1c, 0x37,
+	0xb5, 0xd0, 0xbe, 0x16, 0xd5, 0xe7, 0xb8, 0xd1, 0x32, 0x3f, 0x10, 0x6a, 0xfa, 0xa9, 0x8d, 0xba,
+	0x03, 0xcf, 0x36, 0x6c, 0x56, 0x96, 0xb4, 0xd1, 0xe9, 0x82, 0x8c, 0x1e, 0x1c, 0x96, 0x8e, 0x89,
+	0xbd, 0x85, 0x7d, 0x73, 0x4b, 0x55, 0x49, 0x27, 0xa4, 0xbb, 0x0b, 0xb3, 0xf2, 0x70, 0x23, 0xf5,
+	0xc7, 0xd7, 0xce, 0x17, 0xeb, 0xfb, 0xdf, 0xeb, 0x9b, 0x37, 0xd6, 0xf9, 0x8f, 0xe7, 0xbd, 0x1c,
+	0xbe, 0x4d, 0x8e, 0xff, 0x0f, 0x51, 0x4f, 0xbf, 0x9e, 0xcf, 0x77, 0x03, 0x00, 0xcf, 0xa0, 0xeb,
+	0x41, 0x59, 0x03, 0x00, 0x00,
 }
 
 func (this *UpstreamSpec) Equal(that interface{}) bool {
@@ -196,6 +221,17 @@ func (this *UpstreamSpec) Equal(that interface{}) bool {
 	if !this.SubsetSpec.Equal(that1.SubsetSpec) {
 		return false
 	}
+	if this.PodName != that1.PodName {
+		return false
+	}
+	if len(this.ServicePortNames) != len(that1.ServicePortNames) {
+		return false
+	}
+	for i := range this.ServicePortNames {
+		if this.ServicePortNames[i] != that1.ServicePortNames[i] {
+			return false
+		}
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -0,0 +1,524 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: github.com/solo-io/gloo/projects/gloo/api/v1/plugins/lbhash/lbhash.proto
+
+package lbhash
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	math "math"
+	time "time"
+
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+// Specifies how the requests of a route are hashed, so that the upstreams with a `ringHash` or `maglev` load balancer
+// send the requests with the same hash to the same endpoint. The upstreams with other load balancers ignore it.
+type RouteActionHashConfig struct {
+	// The policies are evaluated in order and their hashes combined. A request that none of the policies hashes is
+	// sent to a random endpoint.
+	HashPolicies         []*HashPolicy `protobuf:"bytes,1,rep,name=hash_policies,json=hashPolicies,proto3" json:"hash_policies,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *RouteActionHashConfig) Reset()         { *m = RouteActionHashConfig{} }
+func (m *RouteActionHashConfig) String() string { return proto.CompactTextString(m) }
+func (*RouteActionHashConfig) ProtoMessage()    {}
+func (*RouteActionHashConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_abebf15c10732758, []int{0}
+}
+func (m *RouteActionHashConfig) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RouteActionHashConfig.Unmarshal(m, b)
+}
+func (m *RouteActionHashConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RouteActionHashConfig.Marshal(b, m, deterministic)
+}
+func (m *RouteActionHashConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RouteActionHashConfig.Merge(m, src)
+}
+func (m *RouteActionHashConfig) XXX_Size() int {
+	return xxx_messageInfo_RouteActionHashConfig.Size(m)
+}
+func (m *RouteActionHashConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_RouteActionHashConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RouteActionHashConfig proto.InternalMessageInfo
+
+func (m *RouteActionHashConfig) GetHashPolicies() []*HashPolicy {
+	if m != nil {
+		return m.HashPolicies
+	}
+	return nil
+}
+
+type HashPolicy struct {
+	// Types that are valid to be assigned to KeyType:
+	//	*HashPolicy_Header
+	//	*HashPolicy_Cookie
+	//	*HashPolicy_SourceIp
+	KeyType isHashPolicy_KeyType `protobuf_oneof:"key_type"`
+	// Stop evaluating the following policies when this policy hashes the request.
+	Terminal             bool     `protobuf:"varint,4,opt,name=terminal,proto3" json:"terminal,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HashPolicy) Reset()         { *m = HashPolicy{} }
+func (m *HashPolicy) String() string { return proto.CompactTextString(m) }
+func (*HashPolicy) ProtoMessage()    {}
+func (*HashPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_abebf15c10732758, []int{1}
+}
+func (m *HashPolicy) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HashPolicy.Unmarshal(m, b)
+}
+func (m *HashPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HashPolicy.Marshal(b, m, deterministic)
+}
+func (m *HashPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HashPolicy.Merge(m, src)
+}
+func (m *HashPolicy) XXX_Size() int {
+	return xxx_messageInfo_HashPolicy.Size(m)
+}
+func (m *HashPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_HashPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HashPolicy proto.InternalMessageInfo
+
+type isHashPolicy_KeyType interface {
+	isHashPolicy_KeyType()
+	Equal(interface{}) bool
+}
+
+type HashPolicy_Header struct {
+	Header string `protobuf:"bytes,1,opt,name=header,proto3,oneof"`
+}
+type HashPolicy_Cookie struct {
+	Cookie *Cookie `protobuf:"bytes,2,opt,name=cookie,proto3,oneof"`
+}
+type HashPolicy_SourceIp struct {
+	SourceIp bool `protobuf:"varint,3,opt,name=source_ip,json=sourceIp,proto3,oneof"`
+}
+
+func (*HashPolicy_Header) isHashPolicy_KeyType()   {}
+func (*HashPolicy_Cookie) isHashPolicy_KeyType()   {}
+func (*HashPolicy_SourceIp) isHashPolicy_KeyType() {}
+
+func (m *HashPolicy) GetKeyType() isHashPolicy_KeyType {
+	if m != nil {
+		return m.KeyType
+	}
+	return nil
+}
+
+func (m *HashPolicy) GetHeader() string {
+	if x, ok := m.GetKeyType().(*HashPolicy_Header); ok {
+		return x.Header
+	}
+	return ""
+}
+
+func (m *HashPolicy) GetCookie() *Cookie {
+	if x, ok := m.GetKeyType().(*HashPolicy_Cookie); ok {
+		return x.Cookie
+	}
+	return nil
+}
+
+func (m *HashPolicy) GetSourceIp() bool {
+	if x, ok := m.GetKeyType().(*HashPolicy_SourceIp); ok {
+		return x.SourceIp
+	}
+	return false
+}
+
+func (m *HashPolicy) GetTerminal() bool {
+	if m != nil {
+		return m.Terminal
+	}
+	return false
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*HashPolicy) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _HashPolicy_OneofMarshaler, _HashPolicy_OneofUnmarshaler, _HashPolicy_OneofSizer, []interface{}{
+		(*HashPolicy_Header)(nil),
+		(*HashPolicy_Cookie)(nil),
+		(*HashPolicy_SourceIp)(nil),
+	}
+}
+
+func _HashPolicy_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*HashPolicy)
+	// key_type
+	switch x := m.KeyType.(type) {
+	case *HashPolicy_Header:
+		_ = b.EncodeVarint(1<<3 | proto.WireBytes)
+		_ = b.EncodeStringBytes(x.Header)
+	case *HashPolicy_Cookie:
+		_ = b.EncodeVarint(2<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Cookie); err != nil {
+			return err
+		}
+	case *HashPolicy_SourceIp:
+		t := uint64(0)
+		if x.SourceIp {
+			t = 1
+		}
+		_ = b.EncodeVarint(3<<3 | proto.WireVarint)
+		_ = b.EncodeVarint(t)
+	case nil:
+	default:
+		return fmt.Errorf("HashPolicy.KeyType has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _HashPolicy_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*HashPolicy)
+	switch tag {
+	case 1: // key_type.header
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeStringBytes()
+		m.KeyType = &HashPolicy_Header{x}
+		return true, err
+	case 2: // key_type.cookie
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Cookie)
+		err := b.DecodeMessage(msg)
+		m.KeyType = &HashPolicy_Cookie{msg}
+		return true, err
+	case 3: // key_type.source_ip
+		if wire != proto.WireVarint {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeVarint()
+		m.KeyType = &HashPolicy_SourceIp{x != 0}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _HashPolicy_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*HashPolicy)
+	// key_type
+	switch x := m.KeyType.(type) {
+	case *HashPolicy_Header:
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(len(x.Header)))
+		n += len(x.Header)
+	case *HashPolicy_Cookie:
+		s := proto.Size(x.Cookie)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *HashPolicy_SourceIp:
+		n += 1 // tag and wire
+		n += 1
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+type Cookie struct {
+	// The name of the cookie.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Envoy generates the cookie with this time to live when the request does not have it. A zero ttl generates a
+	// session cookie. No cookie is generated when not set.
+	Ttl *time.Duration `protobuf:"bytes,2,opt,name=ttl,proto3,stdduration" json:"ttl,omitempty"`
+	// The path of the generated cookie.
+	Path                 string   `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Cookie) Reset()         { *m = Cookie{} }
+func (m *Cookie) String() string { return proto.CompactTextString(m) }
+func (*Cookie) ProtoMessage()    {}
+func (*Cookie) Descriptor() ([]byte, []int) {
+	return fileDescriptor_abebf15c10732758, []int{2}
+}
+func (m *Cookie) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Cookie.Unmarshal(m, b)
+}
+func (m *Cookie) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Cookie.Marshal(b, m, deterministic)
+}
+func (m *Cookie) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Cookie.Merge(m, src)
+}
+func (m *Cookie) XXX_Size() int {
+	return xxx_messageInfo_Cookie.Size(m)
+}
+func (m *Cookie) XXX_DiscardUnknown() {
+	xxx_messageInfo_Cookie.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Cookie proto.InternalMessageInfo
+
+func (m *Cookie) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Cookie) GetTtl() *time.Duration {
+	if m != nil {
+		return m.Ttl
+	}
+	return nil
+}
+
+func (m *Cookie) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*RouteActionHashConfig)(nil), "lbhash.plugins.gloo.solo.io.RouteActionHashConfig")
+	proto.RegisterType((*HashPolicy)(nil), "lbhash.plugins.gloo.solo.io.HashPolicy")
+	proto.RegisterType((*Cookie)(nil), "lbhash.plugins.gloo.solo.io.Cookie")
+}
+
+func init() {
+	proto.RegisterFile("github.com/solo-io/gloo/projects/gloo/api/v1/plugins/lbhash/lbhash.proto", fileDescriptor_abebf15c10732758)
+}
+
+var fileDescriptor_abebf15c10732758 = []byte{
+	// 371 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0x41, 0xcb, 0x13, 0x31,
+	0x10, 0xfd, 0x62, 0xcb, 0xb2, 0x4d, 0xf5, 0x12, 0x14, 0xd6, 0x8a, 0x75, 0xa9, 0x07, 0xf7, 0x62,
+	0x42, 0xeb, 0x59, 0xc4, 0x56, 0x61, 0x05, 0x0f, 0x92, 0xa3, 0x97, 0x92, 0xdd, 0xa6, 0xd9, 0xd8,
+	0x74, 0x27, 0x6c, 0xb2, 0x42, 0xff, 0x89, 0x3f, 0xc1, 0x83, 0xff, 0x49, 0xf0, 0x97, 0x48, 0x36,
+	0x5b, 0xbd, 0x48, 0xf1, 0x94, 0xf7, 0x32, 0xef, 0xcd, 0x3c, 0x86, 0xc1, 0xa5, 0xd2, 0xbe, 0xe9,
+	0x2b, 0x5a, 0xc3, 0x99, 0x39, 0x30, 0xf0, 0x52, 0x03, 0x53, 0x06, 0x80, 0xd9, 0x0e, 0xbe, 0xc8,
+	0xda, 0xbb, 0xc8, 0x84, 0xd5, 0xec, 0xeb, 0x9a, 0x59, 0xd3, 0x2b, 0xdd, 0x3a, 0x66, 0xaa, 0x46,
+	0xb8, 0x66, 0x7c, 0xa8, 0xed, 0xc0, 0x03, 0x79, 0x72, 0x65, 0x51, 0x43, 0x83, 0x8f, 0x86, 0x96,
+	0x54, 0xc3, 0xe2, 0xa1, 0x02, 0x05, 0x83, 0x8e, 0x05, 0x14, 0x2d, 0x8b, 0xa5, 0x02, 0x50, 0x46,
+	0xb2, 0x81, 0x55, 0xfd, 0x91, 0x1d, 0xfa, 0x4e, 0x78, 0x0d, 0x6d, 0xac, 0xaf, 0x24, 0x7e, 0xc4,
+	0xa1, 0xf7, 0xf2, 0x6d, 0x1d, 0x3e, 0x4b, 0xe1, 0x9a, 0x1d, 0xb4, 0x47, 0xad, 0xc8, 0x47, 0xfc,
+	0x20, 0xcc, 0xda, 0x5b, 0x30, 0xba, 0xd6, 0xd2, 0x65, 0x28, 0x9f, 0x14, 0xf3, 0xcd, 0x0b, 0x7a,
+	0x23, 0x03, 0x0d, 0xfe, 0x4f, 0xc1, 0x70, 0xe1, 0xf7, 0x9b, 0x2b, 0xd6, 0xd2, 0xad, 0x7e, 0x20,
+	0x8c, 0xff, 0x16, 0x49, 0x86, 0x93, 0x46, 0x8a, 0x83, 0xec, 0x32, 0x94, 0xa3, 0x62, 0x56, 0xde,
+	0xf1, 0x91, 0x93, 0xd7, 0x38, 0xa9, 0x01, 0x4e, 0x5a, 0x66, 0xf7, 0x72, 0x54, 0xcc, 0x37, 0xcf,
+	0x6f, 0xce, 0xdb, 0x0d, 0xd2, 0x60, 0x8f, 0x26, 0xf2, 0x14, 0xcf, 0x1c, 0xf4, 0x5d, 0x2d, 0xf7,
+	0xda, 0x66, 0x93, 0x1c, 0x15, 0x69, 0x79, 0xc7, 0xd3, 0xf8, 0xf5, 0xc1, 0x92, 0x05, 0x4e, 0xbd,
+	0xec, 0xce, 0xba, 0x15, 0x26, 0x9b, 0x86, 0x2a, 0xff, 0xc3, 0xb7, 0x18, 0xa7, 0x27, 0x79, 0xd9,
+	0xfb, 0x8b, 0x95, 0xab, 0x1a, 0x27, 0xb1, 0x35, 0x21, 0x78, 0xda, 0x8a, 0xb3, 0x8c, 0x39, 0xf9,
+	0x80, 0xc9, 0x1a, 0x4f, 0xbc, 0x37, 0x63, 0xc0, 0xc7, 0x34, 0x6e, 0x98, 0x5e, 0x37, 0x4c, 0xdf,
+	0x8d, 0x1b, 0xde, 0x4e, 0xbf, 0xfd, 0x7c, 0x86, 0x78, 0xd0, 0x86, 0x36, 0x56, 0xf8, 0x66, 0x88,
+	0x34, 0xe3, 0x03, 0xde, 0xbe, 0xff, 0xfe, 0x6b, 0x89, 0x3e, 0xbf, 0xf9, 0xbf, 0xeb, 0xb0, 0x27,
+	0xf5, 0xef, 0x0b, 0xa9, 0x92, 0x61, 0xf0, 0xab, 0xdf, 0x03, 0x00, 0x74, 0x43, 0x03, 0x79, 0x67,
+	0x02, 0x00, 0x00,
+}
+
+func (this *RouteActionHashConfig) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RouteActionHashConfig)
+	if !ok {
+		that2, ok := that.(RouteActionHashConfig)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.HashPolicies) != len(that1.HashPolicies) {
+		return false
+	}
+	for i := range this.HashPolicies {
+		if !this.HashPolicies[i].Equal(that1.HashPolicies[i]) {
+			return false
+		}
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *HashPolicy) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*HashPolicy)
+	if !ok {
+		that2, ok := that.(HashPolicy)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if that1.KeyType == nil {
+		if this.KeyType != nil {
+			return false
+		}
+	} else if this.KeyType == nil {
+		return false
+	} else if !this.KeyType.Equal(that1.KeyType) {
+		return false
+	}
+	if this.Terminal != that1.Terminal {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *HashPolicy_Header) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*HashPolicy_Header)
+	if !ok {
+		that2, ok := that.(HashPolicy_Header)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Header != that1.Header {
+		return false
+	}
+	return true
+}
+func (this *HashPolicy_Cookie) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*HashPolicy_Cookie)
+	if !ok {
+		that2, ok := that.(HashPolicy_Cookie)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Cookie.Equal(that1.Cookie) {
+		return false
+	}
+	return true
+}
+func (this *HashPolicy_SourceIp) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*HashPolicy_SourceIp)
+	if !ok {
+		that2, ok := that.(HashPolicy_SourceIp)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.SourceIp != that1.SourceIp {
+		return false
+	}
+	return true
+}
+func (this *Cookie) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Cookie)
+	if !ok {
+		that2, ok := that.(Cookie)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.Ttl != nil && that1.Ttl != nil {
+		if *this.Ttl != *that1.Ttl {
+			return false
+		}
+	} else if this.Ttl != nil {
+		return false
+	} else if that1.Ttl != nil {
+		return false
+	}
+	if this.Path != that1.Path {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,421 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: github.com/solo-io/gloo/projects/gloo/api/v1/plugins/lua/lua.proto
+
+package lua
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	math "math"
+
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+// Runs lua code for the requests of an http listener, e.g. to change their headers without an external service.
+// See the [envoy lua filter](https://www.envoyproxy.io/docs/envoy/latest/configuration/http_filters/lua_filter) for
+// the functions the code can define, `envoy_on_request` and `envoy_on_response`, and the api they can call.
+type LuaFilter struct {
+	// The lua code run for the requests of the listener, unless their route overrides it.
+	InlineCode string `protobuf:"bytes,1,opt,name=inline_code,json=inlineCode,proto3" json:"inline_code,omitempty"`
+	// Named lua code the routes can run instead of the inline code, by name.
+	// Requires an envoy build supporting them, with `envoy.lua.per_route` in the envoy extensions of the settings:
+	// the listeners setting it are rejected otherwise.
+	SourceCodes          map[string]string `protobuf:"bytes,2,rep,name=source_codes,json=sourceCodes,proto3" json:"source_codes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *LuaFilter) Reset()         { *m = LuaFilter{} }
+func (m *LuaFilter) String() string { return proto.CompactTextString(m) }
+func (*LuaFilter) ProtoMessage()    {}
+func (*LuaFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b34e32db1b7b4141, []int{0}
+}
+func (m *LuaFilter) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LuaFilter.Unmarshal(m, b)
+}
+func (m *LuaFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LuaFilter.Marshal(b, m, deterministic)
+}
+func (m *LuaFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LuaFilter.Merge(m, src)
+}
+func (m *LuaFilter) XXX_Size() int {
+	return xxx_messageInfo_LuaFilter.Size(m)
+}
+func (m *LuaFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_LuaFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LuaFilter proto.InternalMessageInfo
+
+func (m *LuaFilter) GetInlineCode() string {
+	if m != nil {
+		return m.InlineCode
+	}
+	return ""
+}
+
+func (m *LuaFilter) GetSourceCodes() map[string]string {
+	if m != nil {
+		return m.SourceCodes
+	}
+	return nil
+}
+
+// Overrides the lua code run for the requests of a route.
+// Requires an envoy build supporting it, with `envoy.lua.per_route` in the envoy extensions of the settings, as the
+// envoy shipped with gloo would ignore it: the routes setting it are rejected otherwise.
+type RouteLua struct {
+	// Types that are valid to be assigned to Override:
+	//	*RouteLua_Disabled
+	//	*RouteLua_Name
+	//	*RouteLua_SourceCode
+	Override             isRouteLua_Override `protobuf_oneof:"override"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *RouteLua) Reset()         { *m = RouteLua{} }
+func (m *RouteLua) String() string { return proto.CompactTextString(m) }
+func (*RouteLua) ProtoMessage()    {}
+func (*RouteLua) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b34e32db1b7b4141, []int{1}
+}
+func (m *RouteLua) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RouteLua.Unmarshal(m, b)
+}
+func (m *RouteLua) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RouteLua.Marshal(b, m, deterministic)
+}
+func (m *RouteLua) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RouteLua.Merge(m, src)
+}
+func (m *RouteLua) XXX_Size() int {
+	return xxx_messageInfo_RouteLua.Size(m)
+}
+func (m *RouteLua) XXX_DiscardUnknown() {
+	xxx_messageInfo_RouteLua.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RouteLua proto.InternalMessageInfo
+
+type isRouteLua_Override interface {
+	isRouteLua_Override()
+	Equal(interface{}) bool
+}
+
+type RouteLua_Disabled struct {
+	Disabled bool `protobuf:"varint,1,opt,name=disabled,proto3,oneof"`
+}
+type RouteLua_Name struct {
+	Name string `protobuf:"bytes,2,opt,name=name,proto3,oneof"`
+}
+type RouteLua_SourceCode struct {
+	SourceCode string `protobuf:"bytes,3,opt,name=source_code,json=sourceCode,proto3,oneof"`
+}
+
+func (*RouteLua_Disabled) isRouteLua_Override()   {}
+func (*RouteLua_Name) isRouteLua_Override()       {}
+func (*RouteLua_SourceCode) isRouteLua_Override() {}
+
+func (m *RouteLua) GetOverride() isRouteLua_Override {
+	if m != nil {
+		return m.Override
+	}
+	return nil
+}
+
+func (m *RouteLua) GetDisabled() bool {
+	if x, ok := m.GetOverride().(*RouteLua_Disabled); ok {
+		return x.Disabled
+	}
+	return false
+}
+
+func (m *RouteLua) GetName() string {
+	if x, ok := m.GetOverride().(*RouteLua_Name); ok {
+		return x.Name
+	}
+	return ""
+}
+
+func (m *RouteLua) GetSourceCode() string {
+	if x, ok := m.GetOverride().(*RouteLua_SourceCode); ok {
+		return x.SourceCode
+	}
+	return ""
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*RouteLua) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _RouteLua_OneofMarshaler, _RouteLua_OneofUnmarshaler, _RouteLua_OneofSizer, []interface{}{
+		(*RouteLua_Disabled)(nil),
+		(*RouteLua_Name)(nil),
+		(*RouteLua_SourceCode)(nil),
+	}
+}
+
+func _RouteLua_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*RouteLua)
+	// override
+	switch x := m.Override.(type) {
+	case *RouteLua_Disabled:
+		t := uint64(0)
+		if x.Disabled {
+			t = 1
+		}
+		_ = b.EncodeVarint(1<<3 | proto.WireVarint)
+		_ = b.EncodeVarint(t)
+	case *RouteLua_Name:
+		_ = b.EncodeVarint(2<<3 | proto.WireBytes)
+		_ = b.EncodeStringBytes(x.Name)
+	case *RouteLua_SourceCode:
+		_ = b.EncodeVarint(3<<3 | proto.WireBytes)
+		_ = b.EncodeStringBytes(x.SourceCode)
+	case nil:
+	default:
+		return fmt.Errorf("RouteLua.Override has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _RouteLua_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*RouteLua)
+	switch tag {
+	case 1: // override.disabled
+		if wire != proto.WireVarint {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeVarint()
+		m.Override = &RouteLua_Disabled{x != 0}
+		return true, err
+	case 2: // override.name
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeStringBytes()
+		m.Override = &RouteLua_Name{x}
+		return true, err
+	case 3: // override.source_code
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeStringBytes()
+		m.Override = &RouteLua_SourceCode{x}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _RouteLua_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*RouteLua)
+	// override
+	switch x := m.Override.(type) {
+	case *RouteLua_Disabled:
+		n += 1 // tag and wire
+		n += 1
+	case *RouteLua_Name:
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(len(x.Name)))
+		n += len(x.Name)
+	case *RouteLua_SourceCode:
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(len(x.SourceCode)))
+		n += len(x.SourceCode)
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+func init() {
+	proto.RegisterType((*LuaFilter)(nil), "lua.plugins.gloo.solo.io.LuaFilter")
+	proto.RegisterMapType((map[string]string)(nil), "lua.plugins.gloo.solo.io.LuaFilter.SourceCodesEntry")
+	proto.RegisterType((*RouteLua)(nil), "lua.plugins.gloo.solo.io.RouteLua")
+}
+
+func init() {
+	proto.RegisterFile("github.com/solo-io/gloo/projects/gloo/api/v1/plugins/lua/lua.proto", fileDescriptor_b34e32db1b7b4141)
+}
+
+var fileDescriptor_b34e32db1b7b4141 = []byte{
+	// 315 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x51, 0xcd, 0x4a, 0xf3, 0x40,
+	0x14, 0x6d, 0xda, 0xef, 0x93, 0xf4, 0xc6, 0x45, 0x19, 0xba, 0x08, 0x45, 0xb4, 0x76, 0xd5, 0x8d,
+	0x33, 0xf8, 0xb3, 0x10, 0x11, 0x17, 0x11, 0xa5, 0x8b, 0xae, 0xe2, 0x42, 0x70, 0x23, 0xd3, 0x64,
+	0x88, 0x63, 0xa7, 0xb9, 0x61, 0x26, 0x53, 0xe8, 0x1b, 0xf9, 0x22, 0xbe, 0x88, 0x4f, 0x22, 0x99,
+	0x09, 0xad, 0x88, 0x82, 0x8b, 0xc0, 0x39, 0xe7, 0x9e, 0x7b, 0x72, 0x87, 0x03, 0x49, 0x21, 0xeb,
+	0x17, 0xbb, 0xa0, 0x19, 0xae, 0x98, 0x41, 0x85, 0x27, 0x12, 0x59, 0xa1, 0x10, 0x59, 0xa5, 0xf1,
+	0x55, 0x64, 0xb5, 0xf1, 0x8c, 0x57, 0x92, 0xad, 0x4f, 0x59, 0xa5, 0x6c, 0x21, 0x4b, 0xc3, 0x94,
+	0xe5, 0xcd, 0x47, 0x2b, 0x8d, 0x35, 0x92, 0xd8, 0x41, 0x3f, 0xa2, 0x8d, 0x9d, 0x36, 0x49, 0x54,
+	0xe2, 0x68, 0x58, 0x60, 0x81, 0xce, 0xc4, 0x1a, 0xe4, 0xfd, 0x93, 0xf7, 0x00, 0xfa, 0x73, 0xcb,
+	0xef, 0xa5, 0xaa, 0x85, 0x26, 0x47, 0x10, 0xc9, 0x52, 0xc9, 0x52, 0x3c, 0x67, 0x98, 0x8b, 0x38,
+	0x18, 0x07, 0xd3, 0x7e, 0x0a, 0x5e, 0xba, 0xc5, 0x5c, 0x90, 0x47, 0xd8, 0x37, 0x68, 0x75, 0xe6,
+	0x0d, 0x26, 0xee, 0x8e, 0x7b, 0xd3, 0xe8, 0xec, 0x82, 0xfe, 0xf6, 0x57, 0xba, 0xcd, 0xa6, 0x0f,
+	0x6e, 0xaf, 0x49, 0x31, 0x77, 0x65, 0xad, 0x37, 0x69, 0x64, 0x76, 0xca, 0xe8, 0x06, 0x06, 0xdf,
+	0x0d, 0x64, 0x00, 0xbd, 0xa5, 0xd8, 0xb4, 0x57, 0x34, 0x90, 0x0c, 0xe1, 0xff, 0x9a, 0x2b, 0x2b,
+	0xe2, 0xae, 0xd3, 0x3c, 0xb9, 0xea, 0x5e, 0x06, 0x93, 0x15, 0x84, 0x29, 0xda, 0x5a, 0xcc, 0x2d,
+	0x27, 0x07, 0x10, 0xe6, 0xd2, 0xf0, 0x85, 0x12, 0xb9, 0x5b, 0x0e, 0x67, 0x9d, 0x74, 0xab, 0x90,
+	0x21, 0xfc, 0x2b, 0xf9, 0xaa, 0x8d, 0x98, 0x75, 0x52, 0xc7, 0xc8, 0x31, 0x44, 0x5f, 0x1e, 0x16,
+	0xf7, 0xda, 0x21, 0xec, 0x6e, 0x4c, 0x00, 0x42, 0x5c, 0x0b, 0xad, 0x65, 0x2e, 0x92, 0xe4, 0xed,
+	0xe3, 0x30, 0x78, 0xba, 0xfe, 0x5b, 0x61, 0xd5, 0xb2, 0xf8, 0xa1, 0xb4, 0xc5, 0x9e, 0x6b, 0xe0,
+	0xfc, 0x73, 0x00, 0xeb, 0x2a, 0xa6, 0x3f, 0xf7, 0x01, 0x00, 0x00,
+}
+
+func (this *LuaFilter) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*LuaFilter)
+	if !ok {
+		that2, ok := that.(LuaFilter)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.InlineCode != that1.InlineCode {
+		return false
+	}
+	if len(this.SourceCodes) != len(that1.SourceCodes) {
+		return false
+	}
+	for i := range this.SourceCodes {
+		if this.SourceCodes[i] != that1.SourceCodes[i] {
+			return false
+		}
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *RouteLua) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RouteLua)
+	if !ok {
+		that2, ok := that.(RouteLua)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if that1.Override == nil {
+		if this.Override != nil {
+			return false
+		}
+	} else if this.Override == nil {
+		return false
+	} else if !this.Override.Equal(that1.Override) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *RouteLua_Disabled) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RouteLua_Disabled)
+	if !ok {
+		that2, ok := that.(RouteLua_Disabled)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Disabled != that1.Disabled {
+		return false
+	}
+	return true
+}
+func (this *RouteLua_Name) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RouteLua_Name)
+	if !ok {
+		that2, ok := that.(RouteLua_Name)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	return true
+}
+func (this *RouteLua_SourceCode) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RouteLua_SourceCode)
+	if !ok {
+		that2, ok := that.(RouteLua_SourceCode)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.SourceCode != that1.SourceCode {
+		return false
+	}
+	return true
+}
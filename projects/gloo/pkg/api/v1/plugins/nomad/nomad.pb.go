@@ -0,0 +1,165 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: github.com/solo-io/gloo/projects/gloo/api/v1/plugins/nomad/nomad.proto
+
+package nomad
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	math "math"
+
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	plugins "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+// Upstream Spec for Nomad Upstreams
+// Nomad Upstreams represent the allocations registering a service in the native service registry of Nomad.
+// The endpoints of the upstream are the addresses the allocations register the service with.
+// Nomad Upstreams are typically generated automatically by Gloo from the Nomad API
+type UpstreamSpec struct {
+	// The name of the Nomad service
+	ServiceName string `protobuf:"bytes,1,opt,name=service_name,json=serviceName,proto3" json:"service_name,omitempty"`
+	// The Nomad namespace of the service, `default` if empty
+	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// (Optional) The tags the service registrations must all have to be endpoints of the upstream
+	ServiceTags []string `protobuf:"bytes,3,rep,name=service_tags,json=serviceTags,proto3" json:"service_tags,omitempty"`
+	// An optional Service Spec describing the service listening at this address
+	ServiceSpec          *plugins.ServiceSpec `protobuf:"bytes,4,opt,name=service_spec,json=serviceSpec,proto3" json:"service_spec,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *UpstreamSpec) Reset()         { *m = UpstreamSpec{} }
+func (m *UpstreamSpec) String() string { return proto.CompactTextString(m) }
+func (*UpstreamSpec) ProtoMessage()    {}
+func (*UpstreamSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fda78c3e8ea59b24, []int{0}
+}
+func (m *UpstreamSpec) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpstreamSpec.Unmarshal(m, b)
+}
+func (m *UpstreamSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpstreamSpec.Marshal(b, m, deterministic)
+}
+func (m *UpstreamSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpstreamSpec.Merge(m, src)
+}
+func (m *UpstreamSpec) XXX_Size() int {
+	return xxx_messageInfo_UpstreamSpec.Size(m)
+}
+func (m *UpstreamSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpstreamSpec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpstreamSpec proto.InternalMessageInfo
+
+func (m *UpstreamSpec) GetServiceName() string {
+	if m != nil {
+		return m.ServiceName
+	}
+	return ""
+}
+
+func (m *UpstreamSpec) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *UpstreamSpec) GetServiceTags() []string {
+	if m != nil {
+		return m.ServiceTags
+	}
+	return nil
+}
+
+func (m *UpstreamSpec) GetServiceSpec() *plugins.ServiceSpec {
+	if m != nil {
+		return m.ServiceSpec
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*UpstreamSpec)(nil), "nomad.plugins.gloo.solo.io.UpstreamSpec")
+}
+
+func init() {
+	proto.RegisterFile("github.com/solo-io/gloo/projects/gloo/api/v1/plugins/nomad/nomad.proto", fileDescriptor_fda78c3e8ea59b24)
+}
+
+var fileDescriptor_fda78c3e8ea59b24 = []byte{
+	// 254 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x91, 0xb1, 0x4e, 0xc3, 0x30,
+	0x10, 0x86, 0x65, 0x8a, 0x90, 0xea, 0x76, 0x8a, 0x18, 0xa2, 0x08, 0xa1, 0x94, 0xa9, 0x0b, 0xb6,
+	0x80, 0x9d, 0x01, 0x55, 0xb0, 0x31, 0x50, 0x58, 0x58, 0x90, 0x6b, 0x4e, 0xc6, 0x10, 0xe7, 0x4e,
+	0x39, 0xb7, 0xcf, 0xc4, 0x23, 0xf0, 0x3c, 0x3c, 0x09, 0x72, 0x5c, 0x20, 0x43, 0x07, 0xc4, 0x62,
+	0xf9, 0xbf, 0xfb, 0xef, 0xf3, 0xe9, 0xb7, 0xbc, 0x76, 0x3e, 0xbe, 0xac, 0x57, 0xca, 0x62, 0xd0,
+	0x8c, 0x0d, 0x9e, 0x7a, 0xd4, 0xae, 0x41, 0xd4, 0xd4, 0xe1, 0x2b, 0xd8, 0xc8, 0x59, 0x19, 0xf2,
+	0x7a, 0x73, 0xa6, 0xa9, 0x59, 0x3b, 0xdf, 0xb2, 0x6e, 0x31, 0x98, 0xe7, 0x7c, 0x2a, 0xea, 0x30,
+	0x62, 0x51, 0x6d, 0x45, 0x36, 0xa8, 0x34, 0xa4, 0x12, 0x4f, 0x79, 0xac, 0x0e, 0x1d, 0x3a, 0xec,
+	0x6d, 0x3a, 0xdd, 0xf2, 0x44, 0x75, 0xf3, 0xaf, 0x97, 0x19, 0xba, 0x8d, 0xb7, 0xf0, 0xc4, 0x04,
+	0x36, 0x83, 0x4e, 0x3e, 0x84, 0x9c, 0x3e, 0x10, 0xc7, 0x0e, 0x4c, 0x58, 0x12, 0xd8, 0x62, 0x26,
+	0xa7, 0xdf, 0xb6, 0xd6, 0x04, 0x28, 0x45, 0x2d, 0xe6, 0xe3, 0xbb, 0xc9, 0xb6, 0x76, 0x6b, 0x02,
+	0x14, 0x47, 0x72, 0x9c, 0x5a, 0x4c, 0xc6, 0x42, 0xb9, 0xd7, 0xf7, 0x7f, 0x0b, 0x43, 0x40, 0x34,
+	0x8e, 0xcb, 0x51, 0x3d, 0x1a, 0x00, 0xee, 0x8d, 0xe3, 0x62, 0x21, 0xa7, 0xc3, 0x55, 0xca, 0xfd,
+	0x5a, 0xcc, 0x27, 0xe7, 0xb3, 0x9d, 0x01, 0xa8, 0x65, 0x76, 0xa6, 0xe5, 0x7e, 0x28, 0x49, 0x5c,
+	0x2d, 0xde, 0x3f, 0x8f, 0xc5, 0xe3, 0xe5, 0xdf, 0x92, 0xa0, 0x37, 0xb7, 0xf3, 0x1f, 0x56, 0x07,
+	0x7d, 0x0e, 0x17, 0x5f, 0x03, 0x00, 0xb9, 0x6a, 0xb4, 0x20, 0xcc, 0x01, 0x00, 0x00,
+}
+
+func (this *UpstreamSpec) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UpstreamSpec)
+	if !ok {
+		that2, ok := that.(UpstreamSpec)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ServiceName != that1.ServiceName {
+		return false
+	}
+	if this.Namespace != that1.Namespace {
+		return false
+	}
+	if len(this.ServiceTags) != len(that1.ServiceTags) {
+		return false
+	}
+	for i := range this.ServiceTags {
+		if this.ServiceTags[i] != that1.ServiceTags[i] {
+			return false
+		}
+	}
+	if !this.ServiceSpec.Equal(that1.ServiceSpec) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
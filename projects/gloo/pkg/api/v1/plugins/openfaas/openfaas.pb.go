@@ -0,0 +1,264 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: github.com/solo-io/gloo/projects/gloo/api/v1/plugins/openfaas/openfaas.proto
+
+package openfaas
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	math "math"
+
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+// Service spec describing an OpenFaaS gateway. This will usually be filled
+// automatically via function discovery, which lists the functions deployed
+// behind the gateway at /system/functions.
+type ServiceSpec struct {
+	// List of functions available on the gateway.
+	Functions            []*ServiceSpec_FunctionSpec `protobuf:"bytes,1,rep,name=functions,proto3" json:"functions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
+}
+
+func (m *ServiceSpec) Reset()         { *m = ServiceSpec{} }
+func (m *ServiceSpec) String() string { return proto.CompactTextString(m) }
+func (*ServiceSpec) ProtoMessage()    {}
+func (*ServiceSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1e2498c55fb752e, []int{0}
+}
+func (m *ServiceSpec) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ServiceSpec.Unmarshal(m, b)
+}
+func (m *ServiceSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ServiceSpec.Marshal(b, m, deterministic)
+}
+func (m *ServiceSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ServiceSpec.Merge(m, src)
+}
+func (m *ServiceSpec) XXX_Size() int {
+	return xxx_messageInfo_ServiceSpec.Size(m)
+}
+func (m *ServiceSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_ServiceSpec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ServiceSpec proto.InternalMessageInfo
+
+func (m *ServiceSpec) GetFunctions() []*ServiceSpec_FunctionSpec {
+	if m != nil {
+		return m.Functions
+	}
+	return nil
+}
+
+// Describes an OpenFaaS function
+type ServiceSpec_FunctionSpec struct {
+	// The name of the function, as deployed on the OpenFaaS gateway.
+	FunctionName         string   `protobuf:"bytes,1,opt,name=function_name,json=functionName,proto3" json:"function_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ServiceSpec_FunctionSpec) Reset()         { *m = ServiceSpec_FunctionSpec{} }
+func (m *ServiceSpec_FunctionSpec) String() string { return proto.CompactTextString(m) }
+func (*ServiceSpec_FunctionSpec) ProtoMessage()    {}
+func (*ServiceSpec_FunctionSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1e2498c55fb752e, []int{0, 0}
+}
+func (m *ServiceSpec_FunctionSpec) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ServiceSpec_FunctionSpec.Unmarshal(m, b)
+}
+func (m *ServiceSpec_FunctionSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ServiceSpec_FunctionSpec.Marshal(b, m, deterministic)
+}
+func (m *ServiceSpec_FunctionSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ServiceSpec_FunctionSpec.Merge(m, src)
+}
+func (m *ServiceSpec_FunctionSpec) XXX_Size() int {
+	return xxx_messageInfo_ServiceSpec_FunctionSpec.Size(m)
+}
+func (m *ServiceSpec_FunctionSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_ServiceSpec_FunctionSpec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ServiceSpec_FunctionSpec proto.InternalMessageInfo
+
+func (m *ServiceSpec_FunctionSpec) GetFunctionName() string {
+	if m != nil {
+		return m.FunctionName
+	}
+	return ""
+}
+
+// This is only for upstream with OpenFaaS service spec.
+// Requests are sent to /function/<function_name>/ on the gateway, followed by the path of the request after the prefix
+// the route matches and by the query string.
+type DestinationSpec struct {
+	// The name of the function.
+	FunctionName         string   `protobuf:"bytes,1,opt,name=function_name,json=functionName,proto3" json:"function_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DestinationSpec) Reset()         { *m = DestinationSpec{} }
+func (m *DestinationSpec) String() string { return proto.CompactTextString(m) }
+func (*DestinationSpec) ProtoMessage()    {}
+func (*DestinationSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1e2498c55fb752e, []int{1}
+}
+func (m *DestinationSpec) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DestinationSpec.Unmarshal(m, b)
+}
+func (m *DestinationSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DestinationSpec.Marshal(b, m, deterministic)
+}
+func (m *DestinationSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DestinationSpec.Merge(m, src)
+}
+func (m *DestinationSpec) XXX_Size() int {
+	return xxx_messageInfo_DestinationSpec.Size(m)
+}
+func (m *DestinationSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_DestinationSpec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DestinationSpec proto.InternalMessageInfo
+
+func (m *DestinationSpec) GetFunctionName() string {
+	if m != nil {
+		return m.FunctionName
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*ServiceSpec)(nil), "openfaas.plugins.gloo.solo.io.ServiceSpec")
+	proto.RegisterType((*ServiceSpec_FunctionSpec)(nil), "openfaas.plugins.gloo.solo.io.ServiceSpec.FunctionSpec")
+	proto.RegisterType((*DestinationSpec)(nil), "openfaas.plugins.gloo.solo.io.DestinationSpec")
+}
+
+func init() {
+	proto.RegisterFile("github.com/solo-io/gloo/projects/gloo/api/v1/plugins/openfaas/openfaas.proto", fileDescriptor_c1e2498c55fb752e)
+}
+
+var fileDescriptor_c1e2498c55fb752e = []byte{
+	// 233 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xf2, 0x49, 0xcf, 0x2c, 0xc9,
+	0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x2f, 0xce, 0xcf, 0xc9, 0xd7, 0xcd, 0xcc, 0xd7, 0x4f,
+	0xcf, 0xc9, 0xcf, 0xd7, 0x2f, 0x28, 0xca, 0xcf, 0x4a, 0x4d, 0x2e, 0x29, 0x86, 0xf0, 0x12, 0x0b,
+	0x32, 0xf5, 0xcb, 0x0c, 0xf5, 0x0b, 0x72, 0x4a, 0xd3, 0x33, 0xf3, 0x8a, 0xf5, 0xf3, 0x0b, 0x52,
+	0xf3, 0xd2, 0x12, 0x13, 0x11, 0x0c, 0xbd, 0x82, 0xa2, 0xfc, 0x92, 0x7c, 0x21, 0x59, 0x04, 0x1f,
+	0xa2, 0x52, 0x0f, 0xa4, 0x5b, 0x0f, 0x64, 0xb0, 0x5e, 0x66, 0xbe, 0x94, 0x48, 0x7a, 0x7e, 0x7a,
+	0x3e, 0x58, 0xa5, 0x3e, 0x88, 0x05, 0xd1, 0xa4, 0x34, 0x93, 0x91, 0x8b, 0x3b, 0x38, 0xb5, 0xa8,
+	0x2c, 0x33, 0x39, 0x35, 0xb8, 0x20, 0x35, 0x59, 0x28, 0x94, 0x8b, 0x33, 0xad, 0x34, 0x2f, 0xb9,
+	0x24, 0x33, 0x3f, 0xaf, 0x58, 0x82, 0x51, 0x81, 0x59, 0x83, 0xdb, 0xc8, 0x5c, 0x0f, 0xaf, 0xc1,
+	0x7a, 0x48, 0xda, 0xf5, 0xdc, 0xa0, 0x7a, 0x41, 0x9c, 0x20, 0x84, 0x49, 0x52, 0xc6, 0x5c, 0x3c,
+	0xc8, 0x52, 0x42, 0xca, 0x5c, 0xbc, 0x30, 0xc9, 0xf8, 0xbc, 0xc4, 0xdc, 0x54, 0x09, 0x46, 0x05,
+	0x46, 0x0d, 0xce, 0x20, 0x1e, 0x98, 0xa0, 0x5f, 0x62, 0x6e, 0xaa, 0x92, 0x19, 0x17, 0xbf, 0x4b,
+	0x6a, 0x71, 0x49, 0x66, 0x5e, 0x22, 0x49, 0xfa, 0x9c, 0xdc, 0x57, 0x3c, 0x92, 0x63, 0x8c, 0x72,
+	0x24, 0x2e, 0x70, 0x0b, 0xb2, 0xd3, 0x71, 0x05, 0x70, 0x12, 0x1b, 0x38, 0x8c, 0x8c, 0x01, 0x03,
+	0x00, 0x23, 0xa6, 0x24, 0x37, 0xa8, 0x01, 0x00, 0x00,
+}
+
+func (this *ServiceSpec) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ServiceSpec)
+	if !ok {
+		that2, ok := that.(ServiceSpec)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Functions) != len(that1.Functions) {
+		return false
+	}
+	for i := range this.Functions {
+		if !this.Functions[i].Equal(that1.Functions[i]) {
+			return false
+		}
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *ServiceSpec_FunctionSpec) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ServiceSpec_FunctionSpec)
+	if !ok {
+		that2, ok := that.(ServiceSpec_FunctionSpec)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.FunctionName != that1.FunctionName {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *DestinationSpec) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DestinationSpec)
+	if !ok {
+		that2, ok := that.(DestinationSpec)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.FunctionName != that1.FunctionName {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,326 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: github.com/solo-io/gloo/projects/gloo/api/v1/plugins/openwhisk/openwhisk.proto
+
+package openwhisk
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	math "math"
+
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	core "github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+// Upstream Spec for OpenWhisk Upstreams
+// OpenWhisk Upstreams represent the actions of a namespace of an OpenWhisk-compatible provider,
+// such as IBM Cloud Functions or Apache OpenWhisk
+type UpstreamSpec struct {
+	// The host of the OpenWhisk API, with an optional port (defaults to 443), e.g. `us-south.functions.cloud.ibm.com`
+	ApiHost string `protobuf:"bytes,1,opt,name=api_host,json=apiHost,proto3" json:"api_host,omitempty"`
+	// The namespace of the actions. Defaults to `_`, the default namespace of the API key
+	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// A [Gloo Secret Ref](https://gloo.solo.io/introduction/concepts/#Secrets) to an OpenWhisk Secret
+	// If the secret is created manually, it must conform to the following structure:
+	//  ```
+	//  api_key: <uuid>:<key>
+	//  ```
+	// Only the cluster of the upstream carries the API key: the routes to the actions reference it from the cluster,
+	// so that stripping the secrets from the configuration of a proxy removes the upstream rather than its listeners.
+	SecretRef core.ResourceRef `protobuf:"bytes,3,opt,name=secret_ref,json=secretRef,proto3" json:"secret_ref"`
+	// The list of actions contained within this namespace.
+	// This list will be automatically populated by Gloo if discovery is enabled for OpenWhisk
+	Actions              []*ActionSpec `protobuf:"bytes,4,rep,name=actions,proto3" json:"actions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *UpstreamSpec) Reset()         { *m = UpstreamSpec{} }
+func (m *UpstreamSpec) String() string { return proto.CompactTextString(m) }
+func (*UpstreamSpec) ProtoMessage()    {}
+func (*UpstreamSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d593df8c06ea7a8, []int{0}
+}
+func (m *UpstreamSpec) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpstreamSpec.Unmarshal(m, b)
+}
+func (m *UpstreamSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpstreamSpec.Marshal(b, m, deterministic)
+}
+func (m *UpstreamSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpstreamSpec.Merge(m, src)
+}
+func (m *UpstreamSpec) XXX_Size() int {
+	return xxx_messageInfo_UpstreamSpec.Size(m)
+}
+func (m *UpstreamSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpstreamSpec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpstreamSpec proto.InternalMessageInfo
+
+func (m *UpstreamSpec) GetApiHost() string {
+	if m != nil {
+		return m.ApiHost
+	}
+	return ""
+}
+
+func (m *UpstreamSpec) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *UpstreamSpec) GetSecretRef() core.ResourceRef {
+	if m != nil {
+		return m.SecretRef
+	}
+	return core.ResourceRef{}
+}
+
+func (m *UpstreamSpec) GetActions() []*ActionSpec {
+	if m != nil {
+		return m.Actions
+	}
+	return nil
+}
+
+// Each Action Spec contains data necessary for Gloo to invoke OpenWhisk actions
+type ActionSpec struct {
+	// the logical name gloo should associate with this action.
+	// discovered actions are named `<package_name>-<action_name>`, or `<action_name>` for actions
+	// outside of a package
+	LogicalName string `protobuf:"bytes,1,opt,name=logical_name,json=logicalName,proto3" json:"logical_name,omitempty"`
+	// The name of the action, prefixed with the name of its package if any: `<package_name>/<action_name>`
+	ActionName           string   `protobuf:"bytes,2,opt,name=action_name,json=actionName,proto3" json:"action_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ActionSpec) Reset()         { *m = ActionSpec{} }
+func (m *ActionSpec) String() string { return proto.CompactTextString(m) }
+func (*ActionSpec) ProtoMessage()    {}
+func (*ActionSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d593df8c06ea7a8, []int{1}
+}
+func (m *ActionSpec) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ActionSpec.Unmarshal(m, b)
+}
+func (m *ActionSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ActionSpec.Marshal(b, m, deterministic)
+}
+func (m *ActionSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActionSpec.Merge(m, src)
+}
+func (m *ActionSpec) XXX_Size() int {
+	return xxx_messageInfo_ActionSpec.Size(m)
+}
+func (m *ActionSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActionSpec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActionSpec proto.InternalMessageInfo
+
+func (m *ActionSpec) GetLogicalName() string {
+	if m != nil {
+		return m.LogicalName
+	}
+	return ""
+}
+
+func (m *ActionSpec) GetActionName() string {
+	if m != nil {
+		return m.ActionName
+	}
+	return ""
+}
+
+type DestinationSpec struct {
+	// The Logical Name of the ActionSpec to be invoked.
+	LogicalName          string   `protobuf:"bytes,1,opt,name=logical_name,json=logicalName,proto3" json:"logical_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DestinationSpec) Reset()         { *m = DestinationSpec{} }
+func (m *DestinationSpec) String() string { return proto.CompactTextString(m) }
+func (*DestinationSpec) ProtoMessage()    {}
+func (*DestinationSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d593df8c06ea7a8, []int{2}
+}
+func (m *DestinationSpec) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DestinationSpec.Unmarshal(m, b)
+}
+func (m *DestinationSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DestinationSpec.Marshal(b, m, deterministic)
+}
+func (m *DestinationSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DestinationSpec.Merge(m, src)
+}
+func (m *DestinationSpec) XXX_Size() int {
+	return xxx_messageInfo_DestinationSpec.Size(m)
+}
+func (m *DestinationSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_DestinationSpec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DestinationSpec proto.InternalMessageInfo
+
+func (m *DestinationSpec) GetLogicalName() string {
+	if m != nil {
+		return m.LogicalName
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*UpstreamSpec)(nil), "openwhisk.plugins.gloo.solo.io.UpstreamSpec")
+	proto.RegisterType((*ActionSpec)(nil), "openwhisk.plugins.gloo.solo.io.ActionSpec")
+	proto.RegisterType((*DestinationSpec)(nil), "openwhisk.plugins.gloo.solo.io.DestinationSpec")
+}
+
+func init() {
+	proto.RegisterFile("github.com/solo-io/gloo/projects/gloo/api/v1/plugins/openwhisk/openwhisk.proto", fileDescriptor_7d593df8c06ea7a8)
+}
+
+var fileDescriptor_7d593df8c06ea7a8 = []byte{
+	// 343 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xc1, 0x4e, 0x3a, 0x31,
+	0x10, 0xc6, 0xff, 0xfb, 0x87, 0x88, 0x74, 0x49, 0x4c, 0x36, 0x1e, 0x16, 0x62, 0x00, 0x39, 0x11,
+	0xa3, 0x6d, 0x44, 0xcf, 0x26, 0x12, 0x0e, 0x9c, 0x88, 0x59, 0xe3, 0xc5, 0x0b, 0x29, 0xcd, 0x6c,
+	0xa9, 0xec, 0xee, 0x34, 0x6d, 0xd1, 0x57, 0xf2, 0x51, 0xbc, 0xf8, 0x0a, 0x1e, 0x7c, 0x12, 0xb3,
+	0x5b, 0x08, 0x1e, 0xd4, 0xe8, 0x69, 0x67, 0xbf, 0xfe, 0xbe, 0xe9, 0x37, 0xe9, 0x90, 0x99, 0x54,
+	0x6e, 0xb9, 0x5e, 0x50, 0x81, 0x39, 0xb3, 0x98, 0xe1, 0x99, 0x42, 0x26, 0x33, 0x44, 0xa6, 0x0d,
+	0x3e, 0x80, 0x70, 0xd6, 0xff, 0x71, 0xad, 0xd8, 0xe3, 0x39, 0xd3, 0xd9, 0x5a, 0xaa, 0xc2, 0x32,
+	0xd4, 0x50, 0x3c, 0x2d, 0x95, 0x5d, 0xed, 0x2a, 0xaa, 0x0d, 0x3a, 0x8c, 0xba, 0x9f, 0x04, 0x0f,
+	0xd3, 0xb2, 0x01, 0x2d, 0x7b, 0x53, 0x85, 0x9d, 0x43, 0x89, 0x12, 0x2b, 0x94, 0x95, 0x95, 0x77,
+	0x75, 0x4e, 0xbf, 0x48, 0x51, 0x7d, 0x57, 0xca, 0x6d, 0xef, 0x36, 0x90, 0x7a, 0x7a, 0xf0, 0x1a,
+	0x90, 0xd6, 0x9d, 0xb6, 0xce, 0x00, 0xcf, 0x6f, 0x35, 0x88, 0xa8, 0x4d, 0xf6, 0xb9, 0x56, 0xf3,
+	0x25, 0x5a, 0x17, 0x07, 0xfd, 0x60, 0xd8, 0x4c, 0x1a, 0x5c, 0xab, 0x29, 0x5a, 0x17, 0x1d, 0x91,
+	0x66, 0xc1, 0x73, 0xb0, 0x9a, 0x0b, 0x88, 0xff, 0x57, 0x67, 0x3b, 0x21, 0xba, 0x22, 0xc4, 0x82,
+	0x30, 0xe0, 0xe6, 0x06, 0xd2, 0xb8, 0xd6, 0x0f, 0x86, 0xe1, 0xa8, 0x4d, 0x05, 0x1a, 0xd8, 0x06,
+	0xa6, 0x09, 0x58, 0x5c, 0x1b, 0x01, 0x09, 0xa4, 0xe3, 0xfa, 0xcb, 0x5b, 0xef, 0x5f, 0xd2, 0xf4,
+	0x96, 0x04, 0xd2, 0x68, 0x42, 0x1a, 0x5c, 0x38, 0x85, 0x85, 0x8d, 0xeb, 0xfd, 0xda, 0x30, 0x1c,
+	0x9d, 0xd0, 0x9f, 0xe7, 0xa7, 0xd7, 0x15, 0x5e, 0xa6, 0x4e, 0xb6, 0xd6, 0xc1, 0x0d, 0x21, 0x3b,
+	0x39, 0x3a, 0x26, 0xad, 0x0c, 0xa5, 0x12, 0x3c, 0x9b, 0x97, 0x41, 0x37, 0x03, 0x85, 0x1b, 0x6d,
+	0xc6, 0x73, 0x88, 0x7a, 0x24, 0xf4, 0x5e, 0x4f, 0xf8, 0xb1, 0x88, 0x97, 0x4a, 0x60, 0x70, 0x49,
+	0x0e, 0x26, 0x60, 0x9d, 0x2a, 0xf8, 0x1f, 0xda, 0x8e, 0xa7, 0xcf, 0xef, 0xdd, 0xe0, 0x7e, 0xfc,
+	0xbb, 0x8d, 0xd0, 0x2b, 0xf9, 0xed, 0x56, 0x2c, 0xf6, 0xaa, 0x87, 0xba, 0xf8, 0x18, 0x00, 0x45,
+	0x2b, 0xdb, 0x39, 0x5e, 0x02, 0x00, 0x00,
+}
+
+func (this *UpstreamSpec) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UpstreamSpec)
+	if !ok {
+		that2, ok := that.(UpstreamSpec)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ApiHost != that1.ApiHost {
+		return false
+	}
+	if this.Namespace != that1.Namespace {
+		return false
+	}
+	if !this.SecretRef.Equal(&that1.SecretRef) {
+		return false
+	}
+	if len(this.Actions) != len(that1.Actions) {
+		return false
+	}
+	for i := range this.Actions {
+		if !this.Actions[i].Equal(that1.Actions[i]) {
+			return false
+		}
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *ActionSpec) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ActionSpec)
+	if !ok {
+		that2, ok := that.(ActionSpec)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.LogicalName != that1.LogicalName {
+		return false
+	}
+	if this.ActionName != that1.ActionName {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *DestinationSpec) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DestinationSpec)
+	if !ok {
+		that2, ok := that.(DestinationSpec)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.LogicalName != that1.LogicalName {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
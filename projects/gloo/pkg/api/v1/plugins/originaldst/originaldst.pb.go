@@ -0,0 +1,255 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: github.com/solo-io/gloo/projects/gloo/api/v1/plugins/originaldst/originaldst.proto
+
+package originaldst
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	math "math"
+	time "time"
+
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+// Upstream Spec for Original Destination Upstreams
+// Original Destination Upstreams forward the requests to the original destination of their connection, e.g. when gloo
+// runs as a transparent proxy the connections are redirected to by an iptables REDIRECT rule. The listeners of the
+// redirected connections must restore their original destination, see `plugins.originalDst`.
+// The upstreams are translated into envoy ORIGINAL_DST clusters, whose load balancer cannot be configured.
+type UpstreamSpec struct {
+	// Forwards the requests to the host of their `x-envoy-original-dst-host` header instead, e.g. `10.0.0.1:8080`.
+	// Warning: the clients choose the host, so that any client able to route to the upstream can send gloo to any
+	// address reachable from its network, as with an open proxy. The upstream can therefore only be routed to from
+	// the listeners trusting the header, see `ListenerOriginalDst.trust_http_header`.
+	UseHttpHeader bool `protobuf:"varint,1,opt,name=use_http_header,json=useHttpHeader,proto3" json:"use_http_header,omitempty"`
+	// The interval the unused hosts of the upstream are removed at. Defaults to 5 seconds.
+	CleanupInterval      *time.Duration `protobuf:"bytes,2,opt,name=cleanup_interval,json=cleanupInterval,proto3,stdduration" json:"cleanup_interval,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *UpstreamSpec) Reset()         { *m = UpstreamSpec{} }
+func (m *UpstreamSpec) String() string { return proto.CompactTextString(m) }
+func (*UpstreamSpec) ProtoMessage()    {}
+func (*UpstreamSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_50eedf9d2d0231c2, []int{0}
+}
+func (m *UpstreamSpec) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpstreamSpec.Unmarshal(m, b)
+}
+func (m *UpstreamSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpstreamSpec.Marshal(b, m, deterministic)
+}
+func (m *UpstreamSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpstreamSpec.Merge(m, src)
+}
+func (m *UpstreamSpec) XXX_Size() int {
+	return xxx_messageInfo_UpstreamSpec.Size(m)
+}
+func (m *UpstreamSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpstreamSpec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpstreamSpec proto.InternalMessageInfo
+
+func (m *UpstreamSpec) GetUseHttpHeader() bool {
+	if m != nil {
+		return m.UseHttpHeader
+	}
+	return false
+}
+
+func (m *UpstreamSpec) GetCleanupInterval() *time.Duration {
+	if m != nil {
+		return m.CleanupInterval
+	}
+	return nil
+}
+
+// Restores the original destination of the connections redirected to an http listener, e.g. by an iptables REDIRECT
+// rule, for the original destination upstreams to forward the requests to, and sets which clients of the listener
+// are trusted with the original destination upstreams.
+type ListenerOriginalDst struct {
+	// Enables the restoration of the original destination of the connections of the listener.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// Lets the routes of the listener forward to the original destination upstreams with `use_http_header`, which
+	// send the requests to the host chosen by the client. Only set it on listeners that untrusted clients cannot
+	// connect to, or along with `source_cidrs`.
+	TrustHttpHeader bool `protobuf:"varint,2,opt,name=trust_http_header,json=trustHttpHeader,proto3" json:"trust_http_header,omitempty"`
+	// Only accepts the connections of the clients within these CIDRs, e.g. `10.0.0.0/8`. The connections of the other
+	// clients are closed. All the clients are accepted if empty.
+	SourceCidrs          []string `protobuf:"bytes,3,rep,name=source_cidrs,json=sourceCidrs,proto3" json:"source_cidrs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListenerOriginalDst) Reset()         { *m = ListenerOriginalDst{} }
+func (m *ListenerOriginalDst) String() string { return proto.CompactTextString(m) }
+func (*ListenerOriginalDst) ProtoMessage()    {}
+func (*ListenerOriginalDst) Descriptor() ([]byte, []int) {
+	return fileDescriptor_50eedf9d2d0231c2, []int{1}
+}
+func (m *ListenerOriginalDst) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListenerOriginalDst.Unmarshal(m, b)
+}
+func (m *ListenerOriginalDst) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListenerOriginalDst.Marshal(b, m, deterministic)
+}
+func (m *ListenerOriginalDst) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListenerOriginalDst.Merge(m, src)
+}
+func (m *ListenerOriginalDst) XXX_Size() int {
+	return xxx_messageInfo_ListenerOriginalDst.Size(m)
+}
+func (m *ListenerOriginalDst) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListenerOriginalDst.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListenerOriginalDst proto.InternalMessageInfo
+
+func (m *ListenerOriginalDst) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *ListenerOriginalDst) GetTrustHttpHeader() bool {
+	if m != nil {
+		return m.TrustHttpHeader
+	}
+	return false
+}
+
+func (m *ListenerOriginalDst) GetSourceCidrs() []string {
+	if m != nil {
+		return m.SourceCidrs
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*UpstreamSpec)(nil), "originaldst.plugins.gloo.solo.io.UpstreamSpec")
+	proto.RegisterType((*ListenerOriginalDst)(nil), "originaldst.plugins.gloo.solo.io.ListenerOriginalDst")
+}
+
+func init() {
+	proto.RegisterFile("github.com/solo-io/gloo/projects/gloo/api/v1/plugins/originaldst/originaldst.proto", fileDescriptor_50eedf9d2d0231c2)
+}
+
+var fileDescriptor_50eedf9d2d0231c2 = []byte{
+	// 335 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0xcd, 0x4e, 0xeb, 0x30,
+	0x10, 0x85, 0x95, 0xf6, 0xea, 0xfe, 0xb8, 0xbd, 0xea, 0xbd, 0x81, 0x45, 0xe8, 0xa2, 0x84, 0x2e,
+	0x50, 0x85, 0x84, 0x2d, 0xe0, 0x0d, 0x4a, 0x17, 0xa5, 0x42, 0x42, 0x0a, 0x62, 0xc3, 0x26, 0x72,
+	0x12, 0xe3, 0x1a, 0xdc, 0x8c, 0x65, 0x8f, 0xbb, 0x64, 0xc1, 0x53, 0xf0, 0x08, 0xbc, 0x15, 0x12,
+	0x4f, 0x82, 0xf2, 0x53, 0xa9, 0xdd, 0x20, 0x76, 0x67, 0x8e, 0xe7, 0x8c, 0xbf, 0xd1, 0x90, 0x44,
+	0x2a, 0x5c, 0xfa, 0x8c, 0xe6, 0xb0, 0x62, 0x0e, 0x34, 0x9c, 0x2a, 0x60, 0x52, 0x03, 0x30, 0x63,
+	0xe1, 0x51, 0xe4, 0xe8, 0x9a, 0x8a, 0x1b, 0xc5, 0xd6, 0x67, 0xcc, 0x68, 0x2f, 0x55, 0xe9, 0x18,
+	0x58, 0x25, 0x55, 0xc9, 0x75, 0xe1, 0x70, 0x5b, 0x53, 0x63, 0x01, 0x21, 0x8c, 0x77, 0xac, 0x26,
+	0x42, 0xab, 0x31, 0xb4, 0xfa, 0x81, 0x2a, 0x18, 0x8e, 0x24, 0x80, 0xd4, 0x82, 0xd5, 0xfd, 0x99,
+	0x7f, 0x60, 0x85, 0xb7, 0x1c, 0x15, 0x94, 0xcd, 0x84, 0xe1, 0xbe, 0x04, 0x09, 0xb5, 0x64, 0x95,
+	0x6a, 0xdc, 0xf1, 0x4b, 0x40, 0xfa, 0x77, 0xc6, 0xa1, 0x15, 0x7c, 0x75, 0x6b, 0x44, 0x1e, 0x1e,
+	0x93, 0x81, 0x77, 0x22, 0x5d, 0x22, 0x9a, 0x74, 0x29, 0x78, 0x21, 0x6c, 0x14, 0xc4, 0xc1, 0xe4,
+	0x77, 0xf2, 0xd7, 0x3b, 0x31, 0x47, 0x34, 0xf3, 0xda, 0x0c, 0x17, 0xe4, 0x5f, 0xae, 0x05, 0x2f,
+	0xbd, 0x49, 0x55, 0x89, 0xc2, 0xae, 0xb9, 0x8e, 0x3a, 0x71, 0x30, 0xe9, 0x9d, 0x1f, 0xd0, 0x86,
+	0x84, 0x6e, 0x48, 0xe8, 0xac, 0x25, 0x99, 0xfe, 0x78, 0x7d, 0x3f, 0x0c, 0x92, 0x41, 0x1b, 0xbc,
+	0x6a, 0x73, 0xe3, 0x67, 0xb2, 0x77, 0xad, 0x1c, 0x8a, 0x52, 0xd8, 0x9b, 0x76, 0xcd, 0x99, 0xc3,
+	0x30, 0x22, 0xbf, 0x44, 0xc9, 0x33, 0x2d, 0x8a, 0x16, 0x61, 0x53, 0x86, 0x27, 0xe4, 0x3f, 0x5a,
+	0xef, 0x70, 0x07, 0xb3, 0x53, 0xf7, 0x0c, 0xea, 0x87, 0x2d, 0xd0, 0x23, 0xd2, 0x77, 0xe0, 0x6d,
+	0x2e, 0xd2, 0x5c, 0x15, 0xd6, 0x45, 0xdd, 0xb8, 0x3b, 0xf9, 0x93, 0xf4, 0x1a, 0xef, 0xb2, 0xb2,
+	0xa6, 0x8b, 0xb7, 0x8f, 0x51, 0x70, 0x3f, 0xfb, 0xde, 0xd9, 0xcc, 0x93, 0xfc, 0xe2, 0x74, 0xd9,
+	0xcf, 0x7a, 0xeb, 0x8b, 0xcf, 0x01, 0x00, 0x31, 0x71, 0xcb, 0xd2, 0x05, 0x02, 0x00, 0x00,
+}
+
+func (this *UpstreamSpec) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UpstreamSpec)
+	if !ok {
+		that2, ok := that.(UpstreamSpec)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.UseHttpHeader != that1.UseHttpHeader {
+		return false
+	}
+	if this.CleanupInterval != nil && that1.CleanupInterval != nil {
+		if *this.CleanupInterval != *that1.CleanupInterval {
+			return false
+		}
+	} else if this.CleanupInterval != nil {
+		return false
+	} else if that1.CleanupInterval != nil {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *ListenerOriginalDst) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ListenerOriginalDst)
+	if !ok {
+		that2, ok := that.(ListenerOriginalDst)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Enabled != that1.Enabled {
+		return false
+	}
+	if this.TrustHttpHeader != that1.TrustHttpHeader {
+		return false
+	}
+	if len(this.SourceCidrs) != len(that1.SourceCidrs) {
+		return false
+	}
+	for i := range this.SourceCidrs {
+		if this.SourceCidrs[i] != that1.SourceCidrs[i] {
+			return false
+		}
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
@@ -27,6 +27,10 @@ const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 type ServiceSpec struct {
 	Transformations      map[string]*transformation.TransformationTemplate `protobuf:"bytes,1,rep,name=transformations,proto3" json:"transformations,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	SwaggerInfo          *ServiceSpec_SwaggerInfo                          `protobuf:"bytes,2,opt,name=swagger_info,json=swaggerInfo,proto3" json:"swagger_info,omitempty"`
+	GraphqlInfo          *ServiceSpec_GraphQLInfo                          `protobuf:"bytes,3,opt,name=graphql_info,json=graphqlInfo,proto3" json:"graphql_info,omitempty"`
+	SoapInfo             *ServiceSpec_SoapInfo                             `protobuf:"bytes,4,opt,name=soap_info,json=soapInfo,proto3" json:"soap_info,omitempty"`
+	WebhookInfo          *ServiceSpec_WebhookInfo                          `protobuf:"bytes,5,opt,name=webhook_info,json=webhookInfo,proto3" json:"webhook_info,omitempty"`
+	AsyncApiInfo         *ServiceSpec_AsyncApiInfo                         `protobuf:"bytes,6,opt,name=async_api_info,json=asyncApiInfo,proto3" json:"async_api_info,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                                          `json:"-"`
 	XXX_unrecognized     []byte                                            `json:"-"`
 	XXX_sizecache        int32                                             `json:"-"`
@@ -70,6 +74,34 @@ func (m *ServiceSpec) GetSwaggerInfo() *ServiceSpec_SwaggerInfo {
 	return nil
 }
 
+func (m *ServiceSpec) GetGraphqlInfo() *ServiceSpec_GraphQLInfo {
+	if m != nil {
+		return m.GraphqlInfo
+	}
+	return nil
+}
+
+func (m *ServiceSpec) GetSoapInfo() *ServiceSpec_SoapInfo {
+	if m != nil {
+		return m.SoapInfo
+	}
+	return nil
+}
+
+func (m *ServiceSpec) GetWebhookInfo() *ServiceSpec_WebhookInfo {
+	if m != nil {
+		return m.WebhookInfo
+	}
+	return nil
+}
+
+func (m *ServiceSpec) GetAsyncApiInfo() *ServiceSpec_AsyncApiInfo {
+	if m != nil {
+		return m.AsyncApiInfo
+	}
+	return nil
+}
+
 type ServiceSpec_SwaggerInfo struct {
 	// Types that are valid to be assigned to SwaggerSpec:
 	//	*ServiceSpec_SwaggerInfo_Url
@@ -206,6 +238,250 @@ func _ServiceSpec_SwaggerInfo_OneofSizer(msg proto.Message) (n int) {
 	return n
 }
 
+// Describes a GraphQL endpoint. Its queries and mutations are discovered as the transformations
+// of the service, which turn a REST call into a GraphQL operation.
+type ServiceSpec_GraphQLInfo struct {
+	// The path of the endpoint, e.g. `/graphql`
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// How deep the selection set of the generated operations goes into the object types
+	// of the result. Defaults to 2.
+	MaxSelectionDepth    uint32   `protobuf:"varint,2,opt,name=max_selection_depth,json=maxSelectionDepth,proto3" json:"max_selection_depth,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ServiceSpec_GraphQLInfo) Reset()         { *m = ServiceSpec_GraphQLInfo{} }
+func (m *ServiceSpec_GraphQLInfo) String() string { return proto.CompactTextString(m) }
+func (*ServiceSpec_GraphQLInfo) ProtoMessage()    {}
+func (*ServiceSpec_GraphQLInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10f084fc89ebe515, []int{0, 2}
+}
+func (m *ServiceSpec_GraphQLInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ServiceSpec_GraphQLInfo.Unmarshal(m, b)
+}
+func (m *ServiceSpec_GraphQLInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ServiceSpec_GraphQLInfo.Marshal(b, m, deterministic)
+}
+func (m *ServiceSpec_GraphQLInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ServiceSpec_GraphQLInfo.Merge(m, src)
+}
+func (m *ServiceSpec_GraphQLInfo) XXX_Size() int {
+	return xxx_messageInfo_ServiceSpec_GraphQLInfo.Size(m)
+}
+func (m *ServiceSpec_GraphQLInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ServiceSpec_GraphQLInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ServiceSpec_GraphQLInfo proto.InternalMessageInfo
+
+func (m *ServiceSpec_GraphQLInfo) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *ServiceSpec_GraphQLInfo) GetMaxSelectionDepth() uint32 {
+	if m != nil {
+		return m.MaxSelectionDepth
+	}
+	return 0
+}
+
+// Describes a SOAP service by its WSDL. Its operations are discovered as the transformations of the service,
+// which wrap the parameters of a REST call in a SOAP envelope. The parameters are inserted in the envelope as is,
+// without escaping XML markup.
+type ServiceSpec_SoapInfo struct {
+	// The url of the WSDL, e.g. `/service?wsdl`. Relative urls are resolved against the address of the upstream.
+	WsdlUrl              string   `protobuf:"bytes,1,opt,name=wsdl_url,json=wsdlUrl,proto3" json:"wsdl_url,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ServiceSpec_SoapInfo) Reset()         { *m = ServiceSpec_SoapInfo{} }
+func (m *ServiceSpec_SoapInfo) String() string { return proto.CompactTextString(m) }
+func (*ServiceSpec_SoapInfo) ProtoMessage()    {}
+func (*ServiceSpec_SoapInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10f084fc89ebe515, []int{0, 3}
+}
+func (m *ServiceSpec_SoapInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ServiceSpec_SoapInfo.Unmarshal(m, b)
+}
+func (m *ServiceSpec_SoapInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ServiceSpec_SoapInfo.Marshal(b, m, deterministic)
+}
+func (m *ServiceSpec_SoapInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ServiceSpec_SoapInfo.Merge(m, src)
+}
+func (m *ServiceSpec_SoapInfo) XXX_Size() int {
+	return xxx_messageInfo_ServiceSpec_SoapInfo.Size(m)
+}
+func (m *ServiceSpec_SoapInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ServiceSpec_SoapInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ServiceSpec_SoapInfo proto.InternalMessageInfo
+
+func (m *ServiceSpec_SoapInfo) GetWsdlUrl() string {
+	if m != nil {
+		return m.WsdlUrl
+	}
+	return ""
+}
+
+// The transformations of the service are discovered by a function discovery webhook of the settings.
+type ServiceSpec_WebhookInfo struct {
+	// The url of the webhook.
+	Url                  string   `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ServiceSpec_WebhookInfo) Reset()         { *m = ServiceSpec_WebhookInfo{} }
+func (m *ServiceSpec_WebhookInfo) String() string { return proto.CompactTextString(m) }
+func (*ServiceSpec_WebhookInfo) ProtoMessage()    {}
+func (*ServiceSpec_WebhookInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10f084fc89ebe515, []int{0, 4}
+}
+func (m *ServiceSpec_WebhookInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ServiceSpec_WebhookInfo.Unmarshal(m, b)
+}
+func (m *ServiceSpec_WebhookInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ServiceSpec_WebhookInfo.Marshal(b, m, deterministic)
+}
+func (m *ServiceSpec_WebhookInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ServiceSpec_WebhookInfo.Merge(m, src)
+}
+func (m *ServiceSpec_WebhookInfo) XXX_Size() int {
+	return xxx_messageInfo_ServiceSpec_WebhookInfo.Size(m)
+}
+func (m *ServiceSpec_WebhookInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ServiceSpec_WebhookInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ServiceSpec_WebhookInfo proto.InternalMessageInfo
+
+func (m *ServiceSpec_WebhookInfo) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+// Describes a message-driven service by its AsyncAPI document. The publish operations of its channels are discovered
+// as the transformations of the service, which post the body of the request, the message, to the HTTP bridge publishing
+// on the channel.
+type ServiceSpec_AsyncApiInfo struct {
+	// The url of the AsyncAPI document, e.g. `/asyncapi.json`. Relative urls are resolved against the address of the upstream.
+	Url                  string                               `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Bridge               *ServiceSpec_AsyncApiInfo_HttpBridge `protobuf:"bytes,2,opt,name=bridge,proto3" json:"bridge,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                             `json:"-"`
+	XXX_unrecognized     []byte                               `json:"-"`
+	XXX_sizecache        int32                                `json:"-"`
+}
+
+func (m *ServiceSpec_AsyncApiInfo) Reset()         { *m = ServiceSpec_AsyncApiInfo{} }
+func (m *ServiceSpec_AsyncApiInfo) String() string { return proto.CompactTextString(m) }
+func (*ServiceSpec_AsyncApiInfo) ProtoMessage()    {}
+func (*ServiceSpec_AsyncApiInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10f084fc89ebe515, []int{0, 5}
+}
+func (m *ServiceSpec_AsyncApiInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ServiceSpec_AsyncApiInfo.Unmarshal(m, b)
+}
+func (m *ServiceSpec_AsyncApiInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ServiceSpec_AsyncApiInfo.Marshal(b, m, deterministic)
+}
+func (m *ServiceSpec_AsyncApiInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ServiceSpec_AsyncApiInfo.Merge(m, src)
+}
+func (m *ServiceSpec_AsyncApiInfo) XXX_Size() int {
+	return xxx_messageInfo_ServiceSpec_AsyncApiInfo.Size(m)
+}
+func (m *ServiceSpec_AsyncApiInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ServiceSpec_AsyncApiInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ServiceSpec_AsyncApiInfo proto.InternalMessageInfo
+
+func (m *ServiceSpec_AsyncApiInfo) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *ServiceSpec_AsyncApiInfo) GetBridge() *ServiceSpec_AsyncApiInfo_HttpBridge {
+	if m != nil {
+		return m.Bridge
+	}
+	return nil
+}
+
+// The HTTP endpoint of the upstream publishing the messages posted to it on a channel, e.g. the REST proxy of a broker.
+type ServiceSpec_AsyncApiInfo_HttpBridge struct {
+	// The path of the messages of a channel, in which `{channel}` is replaced with the name of the channel.
+	// The parameters of the channel, e.g. `{userId}` in `user/{userId}/signedup`, are read from the extractors of the route.
+	// Defaults to `/{channel}`.
+	PathTemplate string `protobuf:"bytes,1,opt,name=path_template,json=pathTemplate,proto3" json:"path_template,omitempty"`
+	// The method of the requests. Defaults to `POST`.
+	Method string `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+	// Headers added to the requests, e.g. the content type expected by the bridge. Defaults to the content type of
+	// the messages of the channel.
+	Headers              map[string]string `protobuf:"bytes,3,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ServiceSpec_AsyncApiInfo_HttpBridge) Reset()         { *m = ServiceSpec_AsyncApiInfo_HttpBridge{} }
+func (m *ServiceSpec_AsyncApiInfo_HttpBridge) String() string { return proto.CompactTextString(m) }
+func (*ServiceSpec_AsyncApiInfo_HttpBridge) ProtoMessage()    {}
+func (*ServiceSpec_AsyncApiInfo_HttpBridge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10f084fc89ebe515, []int{0, 5, 0}
+}
+func (m *ServiceSpec_AsyncApiInfo_HttpBridge) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ServiceSpec_AsyncApiInfo_HttpBridge.Unmarshal(m, b)
+}
+func (m *ServiceSpec_AsyncApiInfo_HttpBridge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ServiceSpec_AsyncApiInfo_HttpBridge.Marshal(b, m, deterministic)
+}
+func (m *ServiceSpec_AsyncApiInfo_HttpBridge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ServiceSpec_AsyncApiInfo_HttpBridge.Merge(m, src)
+}
+func (m *ServiceSpec_AsyncApiInfo_HttpBridge) XXX_Size() int {
+	return xxx_messageInfo_ServiceSpec_AsyncApiInfo_HttpBridge.Size(m)
+}
+func (m *ServiceSpec_AsyncApiInfo_HttpBridge) XXX_DiscardUnknown() {
+	xxx_messageInfo_ServiceSpec_AsyncApiInfo_HttpBridge.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ServiceSpec_AsyncApiInfo_HttpBridge proto.InternalMessageInfo
+
+func (m *ServiceSpec_AsyncApiInfo_HttpBridge) GetPathTemplate() string {
+	if m != nil {
+		return m.PathTemplate
+	}
+	return ""
+}
+
+func (m *ServiceSpec_AsyncApiInfo_HttpBridge) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
+}
+
+func (m *ServiceSpec_AsyncApiInfo_HttpBridge) GetHeaders() map[string]string {
+	if m != nil {
+		return m.Headers
+	}
+	return nil
+}
+
 // This is only for upstream with REST service spec
 type DestinationSpec struct {
 	FunctionName           string                                 `protobuf:"bytes,1,opt,name=function_name,json=functionName,proto3" json:"function_name,omitempty"`
@@ -265,6 +541,12 @@ func init() {
 	proto.RegisterType((*ServiceSpec)(nil), "rest.plugins.gloo.solo.io.ServiceSpec")
 	proto.RegisterMapType((map[string]*transformation.TransformationTemplate)(nil), "rest.plugins.gloo.solo.io.ServiceSpec.TransformationsEntry")
 	proto.RegisterType((*ServiceSpec_SwaggerInfo)(nil), "rest.plugins.gloo.solo.io.ServiceSpec.SwaggerInfo")
+	proto.RegisterType((*ServiceSpec_GraphQLInfo)(nil), "rest.plugins.gloo.solo.io.ServiceSpec.GraphQLInfo")
+	proto.RegisterType((*ServiceSpec_SoapInfo)(nil), "rest.plugins.gloo.solo.io.ServiceSpec.SoapInfo")
+	proto.RegisterType((*ServiceSpec_WebhookInfo)(nil), "rest.plugins.gloo.solo.io.ServiceSpec.WebhookInfo")
+	proto.RegisterType((*ServiceSpec_AsyncApiInfo)(nil), "rest.plugins.gloo.solo.io.ServiceSpec.AsyncApiInfo")
+	proto.RegisterType((*ServiceSpec_AsyncApiInfo_HttpBridge)(nil), "rest.plugins.gloo.solo.io.ServiceSpec.AsyncApiInfo.HttpBridge")
+	proto.RegisterMapType((map[string]string)(nil), "rest.plugins.gloo.solo.io.ServiceSpec.AsyncApiInfo.HttpBridge.HeadersEntry")
 	proto.RegisterType((*DestinationSpec)(nil), "rest.plugins.gloo.solo.io.DestinationSpec")
 }
 
@@ -273,35 +555,52 @@ func init() {
 }
 
 var fileDescriptor_10f084fc89ebe515 = []byte{
-	// 440 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0xd1, 0x8a, 0xd4, 0x30,
-	0x14, 0x86, 0xed, 0x16, 0x17, 0x4c, 0x57, 0x57, 0xc2, 0xa2, 0x75, 0x2e, 0x64, 0x58, 0x6f, 0xe6,
-	0xc6, 0x54, 0xc7, 0x1b, 0x51, 0xbc, 0x59, 0x57, 0x51, 0x04, 0x95, 0xce, 0x08, 0xe2, 0xcd, 0x90,
-	0x2d, 0xa7, 0xd9, 0xb8, 0x69, 0x4e, 0x48, 0xd2, 0xca, 0xbc, 0x84, 0xcf, 0xe1, 0x73, 0xf9, 0x08,
-	0x3e, 0x81, 0xb4, 0xe9, 0x3a, 0x33, 0x65, 0x84, 0x65, 0xd8, 0x9b, 0x92, 0x3f, 0xe9, 0xff, 0xfd,
-	0x3d, 0x27, 0xa7, 0xe4, 0x54, 0x48, 0x7f, 0x5e, 0x9f, 0xb1, 0x02, 0xab, 0xcc, 0xa1, 0xc2, 0xc7,
-	0x12, 0x33, 0xa1, 0x10, 0x33, 0x63, 0xf1, 0x3b, 0x14, 0xde, 0x05, 0xc5, 0x8d, 0xcc, 0x9a, 0xa7,
-	0x99, 0x51, 0xb5, 0x90, 0xda, 0x65, 0x16, 0x9c, 0xef, 0x1e, 0xcc, 0x58, 0xf4, 0x48, 0x1f, 0x84,
-	0x75, 0x38, 0x65, 0xad, 0x83, 0xb5, 0x30, 0x26, 0x71, 0x74, 0x24, 0x50, 0x60, 0xf7, 0x56, 0xd6,
-	0xae, 0x82, 0x61, 0xf4, 0x75, 0xa7, 0x58, 0x6f, 0xb9, 0x76, 0x25, 0xda, 0x8a, 0x7b, 0x89, 0x7a,
-	0x20, 0x7b, 0xf2, 0xfc, 0x3a, 0xc8, 0x86, 0x5b, 0x5e, 0x81, 0x07, 0xeb, 0x02, 0xf5, 0xf8, 0x67,
-	0x4c, 0x92, 0x19, 0xd8, 0x46, 0x16, 0x30, 0x33, 0x50, 0x50, 0x20, 0x87, 0x9b, 0x16, 0x97, 0x46,
-	0xe3, 0x78, 0x92, 0x4c, 0x5f, 0xb2, 0xff, 0xb6, 0x82, 0xad, 0x01, 0xd8, 0x7c, 0xd3, 0xfd, 0x46,
-	0x7b, 0xbb, 0xcc, 0x87, 0x4c, 0xfa, 0x85, 0x1c, 0xb8, 0x1f, 0x5c, 0x08, 0xb0, 0x0b, 0xa9, 0x4b,
-	0x4c, 0xf7, 0xc6, 0xd1, 0x24, 0x99, 0x4e, 0xaf, 0x98, 0x31, 0x0b, 0xd6, 0xf7, 0xba, 0xc4, 0x3c,
-	0x71, 0x2b, 0x31, 0xf2, 0xe4, 0x68, 0x5b, 0x3e, 0xbd, 0x4b, 0xe2, 0x0b, 0x58, 0xa6, 0xd1, 0x38,
-	0x9a, 0xdc, 0xca, 0xdb, 0x25, 0x7d, 0x4b, 0x6e, 0x36, 0x5c, 0xd5, 0xd0, 0x27, 0x3f, 0x61, 0xa0,
-	0x1b, 0x5c, 0x32, 0x6e, 0x24, 0x6b, 0xa6, 0xac, 0x94, 0xca, 0x83, 0x65, 0xe7, 0xde, 0x9b, 0x41,
-	0x41, 0x73, 0xa8, 0x8c, 0xe2, 0x1e, 0xf2, 0x60, 0x7f, 0xb1, 0xf7, 0x3c, 0x1a, 0x7d, 0x20, 0xc9,
-	0xda, 0x17, 0x51, 0x4a, 0xe2, 0xda, 0xaa, 0x10, 0xf6, 0xee, 0x46, 0xde, 0x0a, 0x9a, 0x92, 0x7d,
-	0xa9, 0x95, 0xd4, 0x21, 0xaf, 0xdd, 0xee, 0xf5, 0xc9, 0x9d, 0x55, 0x27, 0x9c, 0x81, 0xe2, 0xf8,
-	0x4f, 0x44, 0x0e, 0x4f, 0xc1, 0x79, 0xa9, 0xbb, 0xbc, 0xee, 0x52, 0x1e, 0x91, 0xdb, 0x65, 0xad,
-	0x8b, 0x56, 0x2f, 0x34, 0xaf, 0xa0, 0x2f, 0xe4, 0xe0, 0x72, 0xf3, 0x23, 0xaf, 0x80, 0x7e, 0x22,
-	0x64, 0x75, 0xbb, 0x7d, 0x59, 0x19, 0x1b, 0x8e, 0xd2, 0xb6, 0xd6, 0x7e, 0xfe, 0x67, 0xcb, 0xd7,
-	0x10, 0x54, 0x92, 0xfb, 0x16, 0x9c, 0x41, 0xed, 0x60, 0xb1, 0x89, 0x49, 0xe3, 0x1d, 0x9b, 0x76,
-	0xef, 0x12, 0xb8, 0x79, 0x7e, 0xf2, 0xfa, 0xd7, 0xef, 0x87, 0xd1, 0xb7, 0x57, 0x57, 0x9b, 0x70,
-	0x73, 0x21, 0xb6, 0xfd, 0xb6, 0x67, 0xfb, 0xdd, 0x44, 0x3f, 0xfb, 0x1b, 0x00, 0x00, 0xff, 0xff,
-	0xab, 0x66, 0xe3, 0x5e, 0xfa, 0x03, 0x00, 0x00,
+	// 712 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0xcf, 0x6f, 0x13, 0x3b,
+	0x10, 0xc7, 0xdf, 0x36, 0x6d, 0xda, 0x4c, 0xd2, 0xf6, 0x3d, 0xbf, 0xaa, 0x2f, 0xdd, 0xc3, 0xa3,
+	0x6a, 0x85, 0xd4, 0x0b, 0x5e, 0x48, 0x2f, 0xa8, 0x08, 0xa4, 0x96, 0x02, 0x45, 0xad, 0x80, 0x6e,
+	0x5a, 0x7e, 0x5d, 0x22, 0x27, 0x71, 0x76, 0x4d, 0x77, 0xd7, 0xc6, 0x76, 0x92, 0xe6, 0x3f, 0xe2,
+	0xef, 0xe2, 0x82, 0x84, 0x38, 0xf1, 0x17, 0x20, 0xaf, 0x37, 0xcd, 0x26, 0x04, 0x29, 0x2d, 0x5c,
+	0xa2, 0x19, 0xdb, 0xf3, 0xf1, 0xec, 0x7c, 0x27, 0x63, 0x38, 0x0c, 0x98, 0x0e, 0xbb, 0x4d, 0xdc,
+	0xe2, 0xb1, 0xa7, 0x78, 0xc4, 0xef, 0x30, 0xee, 0x05, 0x11, 0xe7, 0x9e, 0x90, 0xfc, 0x03, 0x6d,
+	0x69, 0x65, 0x3d, 0x22, 0x98, 0xd7, 0xbb, 0xe7, 0x89, 0xa8, 0x1b, 0xb0, 0x44, 0x79, 0x92, 0x2a,
+	0x9d, 0xfe, 0x60, 0x21, 0xb9, 0xe6, 0x68, 0xc3, 0xda, 0x76, 0x17, 0x9b, 0x08, 0x6c, 0x60, 0x98,
+	0x71, 0x77, 0x2d, 0xe0, 0x01, 0x4f, 0x4f, 0x79, 0xc6, 0xb2, 0x01, 0xee, 0xdb, 0x1b, 0x5d, 0xab,
+	0x25, 0x49, 0x54, 0x87, 0xcb, 0x98, 0x68, 0xc6, 0x93, 0x09, 0x37, 0x23, 0x9f, 0xfd, 0x09, 0xb2,
+	0x20, 0x92, 0xc4, 0x54, 0x53, 0xa9, 0x2c, 0x75, 0xeb, 0x6b, 0x09, 0xca, 0x75, 0x2a, 0x7b, 0xac,
+	0x45, 0xeb, 0x82, 0xb6, 0x10, 0x85, 0xd5, 0xf1, 0x10, 0x55, 0x75, 0x36, 0x0b, 0x3b, 0xe5, 0xda,
+	0x03, 0xfc, 0xcb, 0x52, 0xe0, 0x1c, 0x00, 0x9f, 0x8d, 0x47, 0x3f, 0x49, 0xb4, 0x1c, 0xf8, 0x93,
+	0x4c, 0x74, 0x0e, 0x15, 0xd5, 0x27, 0x41, 0x40, 0x65, 0x83, 0x25, 0x1d, 0x5e, 0x9d, 0xdb, 0x74,
+	0x76, 0xca, 0xb5, 0xda, 0x8c, 0x77, 0xd4, 0x6d, 0xe8, 0xf3, 0xa4, 0xc3, 0xfd, 0xb2, 0x1a, 0x39,
+	0x06, 0x1b, 0x48, 0x22, 0xc2, 0x8f, 0x91, 0xc5, 0x16, 0xae, 0x85, 0x7d, 0x66, 0x42, 0x4f, 0x4f,
+	0x2c, 0x36, 0xe3, 0xa4, 0xd8, 0x13, 0x28, 0x29, 0x4e, 0x84, 0x65, 0xce, 0xa7, 0x4c, 0x6f, 0xd6,
+	0x54, 0x39, 0x11, 0x29, 0x70, 0x49, 0x65, 0x96, 0x49, 0xb2, 0x4f, 0x9b, 0x21, 0xe7, 0x17, 0x16,
+	0xb8, 0x70, 0xad, 0x24, 0xdf, 0xd8, 0x50, 0x9b, 0x64, 0x7f, 0xe4, 0xa0, 0x77, 0xb0, 0x42, 0xd4,
+	0x20, 0x69, 0x35, 0x88, 0x60, 0x16, 0x5c, 0x4c, 0xc1, 0xbb, 0x33, 0x82, 0xf7, 0x4d, 0xf0, 0xbe,
+	0x60, 0x29, 0xb9, 0x42, 0x72, 0x9e, 0xab, 0x61, 0x6d, 0x9a, 0xac, 0xe8, 0x6f, 0x28, 0x5c, 0xd0,
+	0x41, 0xd5, 0xd9, 0x74, 0x76, 0x4a, 0xbe, 0x31, 0xd1, 0x53, 0x58, 0xe8, 0x91, 0xa8, 0x4b, 0x33,
+	0x41, 0xef, 0x62, 0x9a, 0xf4, 0xf8, 0x00, 0x13, 0xc1, 0x70, 0xaf, 0x86, 0x3b, 0x2c, 0xd2, 0x54,
+	0xe2, 0x50, 0x6b, 0x31, 0xd1, 0x27, 0x67, 0x34, 0x16, 0x11, 0xd1, 0xd4, 0xb7, 0xe1, 0x7b, 0x73,
+	0xf7, 0x1d, 0xf7, 0x18, 0xca, 0x39, 0xa1, 0x11, 0x82, 0x42, 0x57, 0x46, 0xf6, 0xb2, 0xa3, 0xbf,
+	0x7c, 0xe3, 0xa0, 0x2a, 0x14, 0x59, 0x12, 0xb1, 0xc4, 0xde, 0x67, 0x96, 0x33, 0xff, 0x60, 0x65,
+	0xd4, 0x60, 0x4a, 0xd0, 0x96, 0x7b, 0x0a, 0xe5, 0x9c, 0xbc, 0x08, 0xc1, 0xbc, 0x20, 0x3a, 0xcc,
+	0x52, 0x4f, 0x6d, 0x84, 0xe1, 0xdf, 0x98, 0x5c, 0x36, 0x14, 0x8d, 0x68, 0xcb, 0xe4, 0xd4, 0x68,
+	0x53, 0xa1, 0xc3, 0x94, 0xbc, 0xec, 0xff, 0x13, 0x93, 0xcb, 0xfa, 0x70, 0xe7, 0xd0, 0x6c, 0xb8,
+	0xb7, 0x61, 0x69, 0xa8, 0x2e, 0xda, 0x80, 0xa5, 0xbe, 0x6a, 0x47, 0x8d, 0xab, 0x0c, 0xfd, 0x45,
+	0xe3, 0x9f, 0xcb, 0xc8, 0xbd, 0x05, 0xe5, 0x9c, 0x66, 0xa6, 0x66, 0xa3, 0x43, 0xc6, 0x74, 0xbf,
+	0xcc, 0x41, 0x25, 0x5f, 0xfc, 0x9f, 0x8f, 0xa0, 0xd7, 0x50, 0x6c, 0x4a, 0xd6, 0x0e, 0x86, 0x75,
+	0x7d, 0x74, 0x03, 0x4d, 0xf1, 0x91, 0xd6, 0xe2, 0x20, 0xa5, 0xf8, 0x19, 0xcd, 0xfd, 0xe6, 0x00,
+	0x8c, 0x96, 0xd1, 0x36, 0x2c, 0x9b, 0x4a, 0x34, 0x74, 0xa6, 0x46, 0x96, 0x42, 0xc5, 0x2c, 0x0e,
+	0x15, 0x42, 0xeb, 0x50, 0x8c, 0xa9, 0x0e, 0x79, 0xdb, 0xd6, 0xdc, 0xcf, 0x3c, 0x44, 0x61, 0x31,
+	0xa4, 0xa4, 0x4d, 0xa5, 0xaa, 0x16, 0xd2, 0x89, 0x71, 0xfc, 0x7b, 0x49, 0xe2, 0x23, 0x4b, 0xb3,
+	0x13, 0x64, 0xc8, 0x76, 0xf7, 0xa0, 0x92, 0xdf, 0x98, 0xd2, 0x83, 0x6b, 0xf9, 0x1e, 0x2c, 0xe5,
+	0x3a, 0x6a, 0xeb, 0xbb, 0x03, 0xab, 0x87, 0x54, 0x69, 0x96, 0xa4, 0x4d, 0x97, 0x0e, 0xbc, 0x6d,
+	0x58, 0xee, 0x74, 0x13, 0x2b, 0x78, 0x42, 0xe2, 0xab, 0x6f, 0x1e, 0x2e, 0xbe, 0x20, 0x31, 0x45,
+	0x2f, 0x01, 0x46, 0x93, 0x33, 0xd3, 0xc0, 0xc3, 0x93, 0x63, 0x7a, 0xda, 0x87, 0xbe, 0xba, 0x0a,
+	0xf3, 0x73, 0x08, 0xc4, 0xe0, 0x3f, 0x49, 0x95, 0xe0, 0x89, 0xa2, 0x8d, 0x71, 0x4c, 0xb5, 0x70,
+	0xc3, 0x7f, 0xce, 0xfa, 0x10, 0x38, 0xbe, 0x7f, 0xf0, 0xf8, 0xd3, 0xe7, 0xff, 0x9d, 0xf7, 0x0f,
+	0x67, 0x7b, 0x3d, 0xc4, 0x45, 0x30, 0xed, 0x49, 0x6c, 0x16, 0xd3, 0xd7, 0x62, 0xf7, 0xc7, 0x00,
+	0x29, 0x54, 0x15, 0x40, 0x56, 0x07, 0x00, 0x00,
 }
 
 func (this *ServiceSpec) Equal(that interface{}) bool {
@@ -334,6 +633,18 @@ func (this *ServiceSpec) Equal(that interface{}) bool {
 	if !this.SwaggerInfo.Equal(that1.SwaggerInfo) {
 		return false
 	}
+	if !this.GraphqlInfo.Equal(that1.GraphqlInfo) {
+		return false
+	}
+	if !this.SoapInfo.Equal(that1.SoapInfo) {
+		return false
+	}
+	if !this.WebhookInfo.Equal(that1.WebhookInfo) {
+		return false
+	}
+	if !this.AsyncApiInfo.Equal(that1.AsyncApiInfo) {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -420,6 +731,158 @@ func (this *ServiceSpec_SwaggerInfo_Inline) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *ServiceSpec_GraphQLInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ServiceSpec_GraphQLInfo)
+	if !ok {
+		that2, ok := that.(ServiceSpec_GraphQLInfo)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Path != that1.Path {
+		return false
+	}
+	if this.MaxSelectionDepth != that1.MaxSelectionDepth {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *ServiceSpec_SoapInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ServiceSpec_SoapInfo)
+	if !ok {
+		that2, ok := that.(ServiceSpec_SoapInfo)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.WsdlUrl != that1.WsdlUrl {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *ServiceSpec_WebhookInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ServiceSpec_WebhookInfo)
+	if !ok {
+		that2, ok := that.(ServiceSpec_WebhookInfo)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Url != that1.Url {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *ServiceSpec_AsyncApiInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ServiceSpec_AsyncApiInfo)
+	if !ok {
+		that2, ok := that.(ServiceSpec_AsyncApiInfo)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Url != that1.Url {
+		return false
+	}
+	if !this.Bridge.Equal(that1.Bridge) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *ServiceSpec_AsyncApiInfo_HttpBridge) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ServiceSpec_AsyncApiInfo_HttpBridge)
+	if !ok {
+		that2, ok := that.(ServiceSpec_AsyncApiInfo_HttpBridge)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.PathTemplate != that1.PathTemplate {
+		return false
+	}
+	if this.Method != that1.Method {
+		return false
+	}
+	if len(this.Headers) != len(that1.Headers) {
+		return false
+	}
+	for i := range this.Headers {
+		if this.Headers[i] != that1.Headers[i] {
+			return false
+		}
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
 func (this *DestinationSpec) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
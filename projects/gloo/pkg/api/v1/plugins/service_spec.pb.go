@@ -11,7 +11,9 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	grpc "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/grpc"
+	openfaas "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/openfaas"
 	rest "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/rest"
+	thrift "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/thrift"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -36,6 +38,8 @@ type ServiceSpec struct {
 	// Types that are valid to be assigned to PluginType:
 	//	*ServiceSpec_Rest
 	//	*ServiceSpec_Grpc
+	//	*ServiceSpec_Openfaas
+	//	*ServiceSpec_Thrift
 	PluginType           isServiceSpec_PluginType `protobuf_oneof:"plugin_type"`
 	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
 	XXX_unrecognized     []byte                   `json:"-"`
@@ -77,9 +81,17 @@ type ServiceSpec_Rest struct {
 type ServiceSpec_Grpc struct {
 	Grpc *grpc.ServiceSpec `protobuf:"bytes,2,opt,name=grpc,proto3,oneof"`
 }
+type ServiceSpec_Openfaas struct {
+	Openfaas *openfaas.ServiceSpec `protobuf:"bytes,3,opt,name=openfaas,proto3,oneof"`
+}
+type ServiceSpec_Thrift struct {
+	Thrift *thrift.ServiceSpec `protobuf:"bytes,4,opt,name=thrift,proto3,oneof"`
+}
 
-func (*ServiceSpec_Rest) isServiceSpec_PluginType() {}
-func (*ServiceSpec_Grpc) isServiceSpec_PluginType() {}
+func (*ServiceSpec_Rest) isServiceSpec_PluginType()     {}
+func (*ServiceSpec_Grpc) isServiceSpec_PluginType()     {}
+func (*ServiceSpec_Openfaas) isServiceSpec_PluginType() {}
+func (*ServiceSpec_Thrift) isServiceSpec_PluginType()   {}
 
 func (m *ServiceSpec) GetPluginType() isServiceSpec_PluginType {
 	if m != nil {
@@ -102,11 +114,27 @@ func (m *ServiceSpec) GetGrpc() *grpc.ServiceSpec {
 	return nil
 }
 
+func (m *ServiceSpec) GetOpenfaas() *openfaas.ServiceSpec {
+	if x, ok := m.GetPluginType().(*ServiceSpec_Openfaas); ok {
+		return x.Openfaas
+	}
+	return nil
+}
+
+func (m *ServiceSpec) GetThrift() *thrift.ServiceSpec {
+	if x, ok := m.GetPluginType().(*ServiceSpec_Thrift); ok {
+		return x.Thrift
+	}
+	return nil
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*ServiceSpec) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _ServiceSpec_OneofMarshaler, _ServiceSpec_OneofUnmarshaler, _ServiceSpec_OneofSizer, []interface{}{
 		(*ServiceSpec_Rest)(nil),
 		(*ServiceSpec_Grpc)(nil),
+		(*ServiceSpec_Openfaas)(nil),
+		(*ServiceSpec_Thrift)(nil),
 	}
 }
 
@@ -124,6 +152,16 @@ func _ServiceSpec_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
 		if err := b.EncodeMessage(x.Grpc); err != nil {
 			return err
 		}
+	case *ServiceSpec_Openfaas:
+		_ = b.EncodeVarint(3<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Openfaas); err != nil {
+			return err
+		}
+	case *ServiceSpec_Thrift:
+		_ = b.EncodeVarint(4<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Thrift); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("ServiceSpec.PluginType has unexpected type %T", x)
@@ -150,6 +188,22 @@ func _ServiceSpec_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Bu
 		err := b.DecodeMessage(msg)
 		m.PluginType = &ServiceSpec_Grpc{msg}
 		return true, err
+	case 3: // plugin_type.openfaas
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(openfaas.ServiceSpec)
+		err := b.DecodeMessage(msg)
+		m.PluginType = &ServiceSpec_Openfaas{msg}
+		return true, err
+	case 4: // plugin_type.thrift
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(thrift.ServiceSpec)
+		err := b.DecodeMessage(msg)
+		m.PluginType = &ServiceSpec_Thrift{msg}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -169,6 +223,16 @@ func _ServiceSpec_OneofSizer(msg proto.Message) (n int) {
 		n += 1 // tag and wire
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *ServiceSpec_Openfaas:
+		s := proto.Size(x.Openfaas)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ServiceSpec_Thrift:
+		s := proto.Size(x.Thrift)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -185,22 +249,25 @@ func init() {
 }
 
 var fileDescriptor_354c0c4fb380b5cd = []byte{
-	// 226 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x72, 0x4f, 0xcf, 0x2c, 0xc9,
-	0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x2f, 0xce, 0xcf, 0xc9, 0xd7, 0xcd, 0xcc, 0xd7, 0x4f,
-	0xcf, 0xc9, 0xcf, 0xd7, 0x2f, 0x28, 0xca, 0xcf, 0x4a, 0x4d, 0x2e, 0x29, 0x86, 0xf0, 0x12, 0x0b,
-	0x32, 0xf5, 0xcb, 0x0c, 0xf5, 0x0b, 0x72, 0x4a, 0xd3, 0x33, 0xf3, 0x8a, 0xf5, 0x8b, 0x53, 0x8b,
-	0xca, 0x32, 0x93, 0x53, 0xe3, 0x8b, 0x0b, 0x52, 0x93, 0xf5, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85,
-	0x44, 0xa0, 0x72, 0x7a, 0x20, 0xf5, 0x7a, 0x20, 0xa3, 0xf4, 0x32, 0xf3, 0xa5, 0x44, 0xd2, 0xf3,
-	0xd3, 0xf3, 0xc1, 0x0a, 0xf4, 0x41, 0x2c, 0x88, 0x5a, 0x29, 0x17, 0xb2, 0x2c, 0x2d, 0x4a, 0x2d,
-	0x2e, 0x01, 0x13, 0x14, 0x99, 0x92, 0x5e, 0x54, 0x90, 0x0c, 0x26, 0x20, 0xa6, 0x28, 0xcd, 0x60,
-	0xe4, 0xe2, 0x0e, 0x86, 0x78, 0x27, 0xb8, 0x20, 0x35, 0x59, 0xc8, 0x86, 0x8b, 0x05, 0x64, 0x87,
-	0x04, 0xa3, 0x02, 0xa3, 0x06, 0xb7, 0x91, 0x9a, 0x1e, 0xc4, 0x42, 0x2c, 0x7e, 0xd3, 0x43, 0xd2,
-	0xe5, 0xc1, 0x10, 0x04, 0xd6, 0x05, 0xd2, 0x0d, 0x32, 0x5b, 0x82, 0x09, 0xaa, 0x1b, 0x62, 0x11,
-	0x11, 0xba, 0x41, 0x0a, 0x9d, 0x78, 0xb9, 0xb8, 0x21, 0x6a, 0xe3, 0x4b, 0x2a, 0x0b, 0x52, 0x9d,
-	0xec, 0x56, 0x3c, 0x92, 0x63, 0x8c, 0xb2, 0x20, 0xce, 0x9b, 0x05, 0xd9, 0xe9, 0x68, 0x5e, 0x4d,
-	0x62, 0x03, 0xfb, 0xd0, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0xe0, 0xa0, 0xbd, 0x4a, 0xe4, 0x01,
-	0x00, 0x00,
+	// 287 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x90, 0x4f, 0x4b, 0x03, 0x31,
+	0x10, 0xc5, 0x6d, 0x2d, 0x45, 0xb2, 0x78, 0x59, 0x7a, 0x58, 0x7a, 0x10, 0xf1, 0x20, 0x45, 0x70,
+	0x82, 0x7a, 0xf1, 0x20, 0x1e, 0x16, 0xc1, 0x3d, 0x78, 0xb2, 0x37, 0x2f, 0x65, 0x1b, 0xd2, 0x34,
+	0xba, 0x76, 0x86, 0x24, 0x2d, 0xf8, 0x85, 0xc4, 0xcf, 0xe5, 0x27, 0x91, 0xfc, 0xa9, 0x15, 0x11,
+	0x5a, 0xd6, 0xcb, 0x64, 0x42, 0xde, 0xfb, 0x85, 0xf7, 0xd8, 0xbd, 0xd2, 0x6e, 0xbe, 0x9c, 0x82,
+	0xc0, 0x57, 0x6e, 0xb1, 0xc1, 0x73, 0x8d, 0x5c, 0x35, 0x88, 0x9c, 0x0c, 0x3e, 0x4b, 0xe1, 0x6c,
+	0xbc, 0xd5, 0xa4, 0xf9, 0xea, 0x82, 0x53, 0xb3, 0x54, 0x7a, 0x61, 0xb9, 0x95, 0x66, 0xa5, 0x85,
+	0x9c, 0x58, 0x92, 0x02, 0xc8, 0xa0, 0xc3, 0x7c, 0x90, 0xde, 0xc0, 0xeb, 0xc1, 0xa3, 0x40, 0xe3,
+	0x70, 0xa0, 0x50, 0x61, 0x10, 0x70, 0xbf, 0x45, 0xed, 0xf0, 0xae, 0xd5, 0xa7, 0x46, 0x5a, 0x17,
+	0xc6, 0xbf, 0x28, 0xca, 0x90, 0x08, 0x23, 0x51, 0x1e, 0x5a, 0x51, 0x90, 0xe4, 0x62, 0x56, 0xd7,
+	0x9b, 0x25, 0xd1, 0xaa, 0x56, 0x34, 0x37, 0x37, 0x7a, 0xe6, 0xd2, 0x11, 0x49, 0x27, 0xef, 0x5d,
+	0x96, 0x8d, 0x63, 0xcd, 0x63, 0x92, 0x22, 0xbf, 0x61, 0x3d, 0x9f, 0xbd, 0xe8, 0x1c, 0x77, 0x46,
+	0xd9, 0xe5, 0x29, 0xc4, 0x22, 0xfe, 0xe8, 0x1c, 0x7e, 0xb8, 0xaa, 0xbd, 0xc7, 0xe0, 0xf2, 0x6e,
+	0x9f, 0xb9, 0xe8, 0x26, 0x77, 0x2c, 0x60, 0x07, 0xb7, 0x17, 0xe6, 0x15, 0x3b, 0x58, 0xe7, 0x2c,
+	0xf6, 0x03, 0xe1, 0x0c, 0x36, 0xc1, 0xb7, 0x53, 0xbe, 0xdd, 0x79, 0xc9, 0xfa, 0x31, 0x65, 0xd1,
+	0x0b, 0x9c, 0x11, 0xac, 0x43, 0x6f, 0xa7, 0x24, 0x67, 0x79, 0xc8, 0xb2, 0xa8, 0x9e, 0xb8, 0x37,
+	0x92, 0xe5, 0xed, 0xc7, 0xe7, 0x51, 0xe7, 0xe9, 0x7a, 0xb7, 0xe2, 0xe9, 0x45, 0xfd, 0x2a, 0x7f,
+	0xda, 0x0f, 0x7d, 0x5f, 0x7d, 0x0d, 0x00, 0x0d, 0x3b, 0xba, 0x8f, 0x0a, 0x03, 0x00, 0x00,
 }
 
 func (this *ServiceSpec) Equal(that interface{}) bool {
@@ -284,3 +351,51 @@ func (this *ServiceSpec_Grpc) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *ServiceSpec_Openfaas) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ServiceSpec_Openfaas)
+	if !ok {
+		that2, ok := that.(ServiceSpec_Openfaas)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Openfaas.Equal(that1.Openfaas) {
+		return false
+	}
+	return true
+}
+func (this *ServiceSpec_Thrift) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ServiceSpec_Thrift)
+	if !ok {
+		that2, ok := that.(ServiceSpec_Thrift)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Thrift.Equal(that1.Thrift) {
+		return false
+	}
+	return true
+}
@@ -7,9 +7,11 @@ import (
 	bytes "bytes"
 	fmt "fmt"
 	math "math"
+	time "time"
 
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
 	plugins "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins"
 )
 
@@ -17,6 +19,7 @@ import (
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -38,10 +41,13 @@ type UpstreamSpec struct {
 	// An optional Service Spec describing the service listening at this address
 	ServiceSpec *plugins.ServiceSpec `protobuf:"bytes,5,opt,name=service_spec,json=serviceSpec,proto3" json:"service_spec,omitempty"`
 	// Use http2 when communicating with this upstream
-	UseHttp2             bool     `protobuf:"varint,6,opt,name=use_http2,json=useHttp2,proto3" json:"use_http2,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	UseHttp2 bool `protobuf:"varint,6,opt,name=use_http2,json=useHttp2,proto3" json:"use_http2,omitempty"`
+	// Resolves the hosts of the upstream from DNS SRV records instead of the list of hosts, so that Gloo follows
+	// backends that are only exposed through DNS. The hosts are published as endpoints, and updated as the records change.
+	SrvDiscovery         *SrvDiscovery `protobuf:"bytes,7,opt,name=srv_discovery,json=srvDiscovery,proto3" json:"srv_discovery,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
 func (m *UpstreamSpec) Reset()         { *m = UpstreamSpec{} }
@@ -96,6 +102,63 @@ func (m *UpstreamSpec) GetUseHttp2() bool {
 	return false
 }
 
+func (m *UpstreamSpec) GetSrvDiscovery() *SrvDiscovery {
+	if m != nil {
+		return m.SrvDiscovery
+	}
+	return nil
+}
+
+// Resolves the hosts of a static upstream from DNS SRV records. Each target of the records is resolved to its IPv4
+// addresses, and published as endpoints with the port of the record.
+type SrvDiscovery struct {
+	// The name of the SRV records, e.g. `_http._tcp.example.com`
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// How often the records are resolved again. Defaults to 30 seconds.
+	RefreshInterval      *time.Duration `protobuf:"bytes,2,opt,name=refresh_interval,json=refreshInterval,proto3,stdduration" json:"refresh_interval,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *SrvDiscovery) Reset()         { *m = SrvDiscovery{} }
+func (m *SrvDiscovery) String() string { return proto.CompactTextString(m) }
+func (*SrvDiscovery) ProtoMessage()    {}
+func (*SrvDiscovery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ffe915d162b6f8af, []int{1}
+}
+func (m *SrvDiscovery) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SrvDiscovery.Unmarshal(m, b)
+}
+func (m *SrvDiscovery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SrvDiscovery.Marshal(b, m, deterministic)
+}
+func (m *SrvDiscovery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SrvDiscovery.Merge(m, src)
+}
+func (m *SrvDiscovery) XXX_Size() int {
+	return xxx_messageInfo_SrvDiscovery.Size(m)
+}
+func (m *SrvDiscovery) XXX_DiscardUnknown() {
+	xxx_messageInfo_SrvDiscovery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SrvDiscovery proto.InternalMessageInfo
+
+func (m *SrvDiscovery) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *SrvDiscovery) GetRefreshInterval() *time.Duration {
+	if m != nil {
+		return m.RefreshInterval
+	}
+	return nil
+}
+
 // Represents a single instance of an upstream
 type Host struct {
 	// Address (hostname or IP)
@@ -111,7 +174,7 @@ func (m *Host) Reset()         { *m = Host{} }
 func (m *Host) String() string { return proto.CompactTextString(m) }
 func (*Host) ProtoMessage()    {}
 func (*Host) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ffe915d162b6f8af, []int{1}
+	return fileDescriptor_ffe915d162b6f8af, []int{2}
 }
 func (m *Host) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Host.Unmarshal(m, b)
@@ -147,6 +210,7 @@ func (m *Host) GetPort() uint32 {
 
 func init() {
 	proto.RegisterType((*UpstreamSpec)(nil), "static.plugins.gloo.solo.io.UpstreamSpec")
+	proto.RegisterType((*SrvDiscovery)(nil), "static.plugins.gloo.solo.io.SrvDiscovery")
 	proto.RegisterType((*Host)(nil), "static.plugins.gloo.solo.io.Host")
 }
 
@@ -155,26 +219,33 @@ func init() {
 }
 
 var fileDescriptor_ffe915d162b6f8af = []byte{
-	// 296 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x51, 0x4d, 0x4b, 0x03, 0x31,
-	0x10, 0x25, 0xf6, 0xc3, 0x36, 0xad, 0x97, 0x20, 0xb8, 0xb4, 0x20, 0x6b, 0x4f, 0x7b, 0x31, 0xc1,
-	0x7a, 0xf0, 0x28, 0x88, 0x62, 0xcf, 0x5b, 0xbd, 0x78, 0x29, 0xdb, 0x6d, 0x48, 0xa3, 0x5b, 0x27,
-	0x64, 0x66, 0xfb, 0x9b, 0xfc, 0x1f, 0xfe, 0x13, 0x7f, 0x89, 0x24, 0x5b, 0xd1, 0x43, 0x11, 0xf1,
-	0x94, 0xf7, 0xc2, 0x9b, 0xf7, 0x1e, 0x33, 0x7c, 0x66, 0x2c, 0xad, 0xeb, 0xa5, 0x2c, 0x61, 0xa3,
-	0x10, 0x2a, 0x38, 0xb7, 0xa0, 0x4c, 0x05, 0xa0, 0x9c, 0x87, 0x67, 0x5d, 0x12, 0x36, 0xac, 0x70,
-	0x56, 0x6d, 0x2f, 0x94, 0xab, 0x6a, 0x63, 0x5f, 0x51, 0x21, 0x15, 0x64, 0xcb, 0xdd, 0x23, 0x9d,
-	0x07, 0x02, 0x31, 0xfe, 0x62, 0x8d, 0x46, 0x86, 0x39, 0x19, 0x2c, 0xa5, 0x85, 0xd1, 0xb1, 0x01,
-	0x03, 0x51, 0xa7, 0x02, 0x6a, 0x46, 0x46, 0xf7, 0xff, 0x0b, 0xd7, 0x7e, 0x6b, 0x4b, 0xbd, 0x40,
-	0xa7, 0x77, 0xd9, 0x93, 0x77, 0xc6, 0x87, 0x8f, 0x0e, 0xc9, 0xeb, 0x62, 0x33, 0x77, 0xba, 0x14,
-	0x57, 0xbc, 0xb3, 0x06, 0x24, 0x4c, 0x58, 0xda, 0xca, 0x06, 0xd3, 0x33, 0xf9, 0x4b, 0x39, 0x39,
-	0x03, 0xa4, 0xbc, 0xd1, 0x8b, 0x13, 0x7e, 0x58, 0xa3, 0x5e, 0x50, 0x85, 0x49, 0x2b, 0x65, 0x59,
-	0x2f, 0xef, 0xd6, 0xa8, 0x1f, 0x2a, 0x14, 0xb7, 0x7c, 0xf8, 0x33, 0x38, 0xe9, 0xa4, 0x2c, 0x1a,
-	0xef, 0x75, 0x9c, 0x37, 0xca, 0x50, 0x25, 0x1f, 0xe0, 0x37, 0x11, 0x63, 0xde, 0x0f, 0xf6, 0x6b,
-	0x22, 0x37, 0x4d, 0xba, 0x31, 0xa0, 0x57, 0xa3, 0x9e, 0x05, 0x3e, 0x91, 0xbc, 0x1d, 0xaa, 0x08,
-	0xc1, 0xdb, 0xc5, 0x6a, 0xe5, 0x13, 0x96, 0xb2, 0xac, 0x9f, 0x47, 0x1c, 0xfe, 0x1c, 0x78, 0x4a,
-	0x0e, 0x52, 0x96, 0x1d, 0xe5, 0x11, 0xdf, 0xdc, 0xbd, 0x7d, 0x9c, 0xb2, 0xa7, 0xeb, 0xbf, 0x2d,
-	0xd1, 0xbd, 0x98, 0xfd, 0x57, 0x5c, 0x76, 0xe3, 0x0e, 0x2f, 0x3f, 0x03, 0x00, 0x00, 0xff, 0xff,
-	0x62, 0xf1, 0xb2, 0xf6, 0x0b, 0x02, 0x00, 0x00,
+	// 402 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x52, 0x4f, 0x8b, 0xd3, 0x40,
+	0x14, 0x27, 0xbb, 0xdd, 0xee, 0xee, 0x6c, 0x16, 0x65, 0x10, 0x8c, 0x5d, 0xa8, 0xb1, 0xa7, 0x7a,
+	0x70, 0x06, 0xeb, 0xc1, 0xa3, 0x50, 0x2a, 0x56, 0x0f, 0x1e, 0x52, 0xbd, 0x78, 0x09, 0x69, 0x32,
+	0x9d, 0x8c, 0xa6, 0x79, 0xc3, 0xbc, 0x49, 0xc0, 0xcf, 0xe1, 0xc5, 0x8f, 0xe0, 0xb7, 0x12, 0xfc,
+	0x24, 0x32, 0x93, 0x04, 0x7b, 0x28, 0x45, 0xf6, 0x94, 0xf7, 0xcb, 0xbc, 0xdf, 0x1f, 0x7e, 0x3c,
+	0xb2, 0x96, 0xca, 0x96, 0xcd, 0x96, 0xe5, 0xb0, 0xe7, 0x08, 0x15, 0xbc, 0x50, 0xc0, 0x65, 0x05,
+	0xc0, 0xb5, 0x81, 0xaf, 0x22, 0xb7, 0xd8, 0xa1, 0x4c, 0x2b, 0xde, 0xbe, 0xe4, 0xba, 0x6a, 0xa4,
+	0xaa, 0x91, 0xa3, 0xcd, 0xac, 0xca, 0xfb, 0x0f, 0xd3, 0x06, 0x2c, 0xd0, 0xbb, 0x01, 0x75, 0x3b,
+	0xcc, 0xf1, 0x98, 0x93, 0x64, 0x0a, 0x26, 0x8f, 0x24, 0x48, 0xf0, 0x7b, 0xdc, 0x4d, 0x1d, 0x65,
+	0xf2, 0xee, 0x7e, 0xe6, 0xc2, 0xb4, 0x2a, 0x17, 0x29, 0x6a, 0xd1, 0x7b, 0x4f, 0xa6, 0x12, 0x40,
+	0x56, 0x82, 0x7b, 0xb4, 0x6d, 0x76, 0xbc, 0x68, 0x4c, 0x66, 0x15, 0xd4, 0xdd, 0xfb, 0xec, 0xc7,
+	0x19, 0x09, 0x3f, 0x6b, 0xb4, 0x46, 0x64, 0xfb, 0x8d, 0x16, 0x39, 0x7d, 0x4d, 0x2e, 0x4a, 0x40,
+	0x8b, 0x51, 0x10, 0x9f, 0xcf, 0x6f, 0x16, 0xcf, 0xd8, 0x89, 0xf0, 0x6c, 0x0d, 0x68, 0x93, 0x6e,
+	0x9f, 0x3e, 0x26, 0x97, 0x0d, 0x8a, 0xd4, 0x56, 0x18, 0x9d, 0xc7, 0xc1, 0xfc, 0x2a, 0x19, 0x37,
+	0x28, 0x3e, 0x55, 0x48, 0x57, 0x24, 0x3c, 0x0c, 0x16, 0x5d, 0xc4, 0x81, 0x17, 0x3e, 0xaa, 0xb8,
+	0xe9, 0x36, 0x5d, 0x94, 0xe4, 0x06, 0xff, 0x01, 0x7a, 0x47, 0xae, 0x9d, 0x7c, 0x69, 0xad, 0x5e,
+	0x44, 0x63, 0x6f, 0x70, 0xd5, 0xa0, 0x58, 0x3b, 0x4c, 0x3f, 0x92, 0x5b, 0x34, 0x6d, 0x5a, 0x28,
+	0xcc, 0xa1, 0x15, 0xe6, 0x7b, 0x74, 0xe9, 0x3d, 0x9e, 0x9f, 0x0c, 0xbf, 0x31, 0xed, 0x6a, 0x20,
+	0x24, 0x21, 0x1e, 0xa0, 0x59, 0x4d, 0xc2, 0xc3, 0x57, 0x4a, 0xc9, 0xa8, 0xce, 0xf6, 0x22, 0x0a,
+	0xe2, 0x60, 0x7e, 0x9d, 0xf8, 0x99, 0x7e, 0x20, 0x0f, 0x8d, 0xd8, 0x19, 0x81, 0x65, 0xaa, 0x6a,
+	0x2b, 0x4c, 0x9b, 0x55, 0xd1, 0x99, 0xb7, 0x7d, 0xc2, 0xba, 0xd2, 0xd9, 0x50, 0x3a, 0x5b, 0xf5,
+	0xa5, 0x2f, 0x47, 0x3f, 0x7f, 0x3f, 0x0d, 0x92, 0x07, 0x3d, 0xf1, 0x7d, 0xcf, 0x9b, 0x31, 0x32,
+	0x72, 0x55, 0x3a, 0x9f, 0xac, 0x28, 0xcc, 0xe0, 0xe3, 0x66, 0xf7, 0x4f, 0x83, 0xb1, 0x5e, 0xfb,
+	0x36, 0xf1, 0xf3, 0xf2, 0xed, 0xaf, 0x3f, 0xd3, 0xe0, 0xcb, 0x9b, 0xff, 0x3b, 0x12, 0xfd, 0x4d,
+	0x1e, 0xbf, 0xd2, 0xed, 0xd8, 0x07, 0x7c, 0xf5, 0x77, 0x00, 0x3e, 0xa4, 0x4f, 0x24, 0xeb, 0x02,
+	0x00, 0x00,
 }
 
 func (this *UpstreamSpec) Equal(that interface{}) bool {
@@ -213,6 +284,45 @@ func (this *UpstreamSpec) Equal(that interface{}) bool {
 	if this.UseHttp2 != that1.UseHttp2 {
 		return false
 	}
+	if !this.SrvDiscovery.Equal(that1.SrvDiscovery) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *SrvDiscovery) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SrvDiscovery)
+	if !ok {
+		that2, ok := that.(SrvDiscovery)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.RefreshInterval != nil && that1.RefreshInterval != nil {
+		if *this.RefreshInterval != *that1.RefreshInterval {
+			return false
+		}
+	} else if this.RefreshInterval != nil {
+		return false
+	} else if that1.RefreshInterval != nil {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
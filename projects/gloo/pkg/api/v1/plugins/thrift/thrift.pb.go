@@ -0,0 +1,693 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: github.com/solo-io/gloo/projects/gloo/api/v1/plugins/thrift/thrift.proto
+
+package thrift
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	math "math"
+
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	core "github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+// Service spec describing Thrift services served over HTTP with the JSON protocol (TJSONProtocol).
+// Function discovery detects the endpoint of the upstreams that opted in to function discovery (with the
+// `discovery.solo.io/function-discovery: enabled` annotation or the discovery metadata), and fills the services from
+// the IDL of the upstream, read from an artifact or a url. Each method of the services is a function of the upstream.
+type ServiceSpec struct {
+	// The IDL (the `.thrift` file) describing the services. Function discovery keeps the services up to date with it.
+	//
+	// Types that are valid to be assigned to IdlSource:
+	//	*ServiceSpec_IdlArtifact
+	//	*ServiceSpec_IdlUrl
+	IdlSource isServiceSpec_IdlSource `protobuf_oneof:"idl_source"`
+	// The path the calls are posted to. Defaults to `/`.
+	Path string `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	// The server multiplexes its services (TMultiplexedProcessor): the method names are prefixed with `<service>:`.
+	Multiplexed bool `protobuf:"varint,4,opt,name=multiplexed,proto3" json:"multiplexed,omitempty"`
+	// The services of the IDL.
+	Services             []*ServiceSpec_ThriftService `protobuf:"bytes,5,rep,name=services,proto3" json:"services,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_unrecognized     []byte                       `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
+}
+
+func (m *ServiceSpec) Reset()         { *m = ServiceSpec{} }
+func (m *ServiceSpec) String() string { return proto.CompactTextString(m) }
+func (*ServiceSpec) ProtoMessage()    {}
+func (*ServiceSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c56ecdaad5c158d0, []int{0}
+}
+func (m *ServiceSpec) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ServiceSpec.Unmarshal(m, b)
+}
+func (m *ServiceSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ServiceSpec.Marshal(b, m, deterministic)
+}
+func (m *ServiceSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ServiceSpec.Merge(m, src)
+}
+func (m *ServiceSpec) XXX_Size() int {
+	return xxx_messageInfo_ServiceSpec.Size(m)
+}
+func (m *ServiceSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_ServiceSpec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ServiceSpec proto.InternalMessageInfo
+
+type isServiceSpec_IdlSource interface {
+	isServiceSpec_IdlSource()
+	Equal(interface{}) bool
+}
+
+type ServiceSpec_IdlArtifact struct {
+	IdlArtifact *core.ResourceRef `protobuf:"bytes,1,opt,name=idl_artifact,json=idlArtifact,proto3,oneof"`
+}
+type ServiceSpec_IdlUrl struct {
+	IdlUrl string `protobuf:"bytes,2,opt,name=idl_url,json=idlUrl,proto3,oneof"`
+}
+
+func (*ServiceSpec_IdlArtifact) isServiceSpec_IdlSource() {}
+func (*ServiceSpec_IdlUrl) isServiceSpec_IdlSource()      {}
+
+func (m *ServiceSpec) GetIdlSource() isServiceSpec_IdlSource {
+	if m != nil {
+		return m.IdlSource
+	}
+	return nil
+}
+
+func (m *ServiceSpec) GetIdlArtifact() *core.ResourceRef {
+	if x, ok := m.GetIdlSource().(*ServiceSpec_IdlArtifact); ok {
+		return x.IdlArtifact
+	}
+	return nil
+}
+
+func (m *ServiceSpec) GetIdlUrl() string {
+	if x, ok := m.GetIdlSource().(*ServiceSpec_IdlUrl); ok {
+		return x.IdlUrl
+	}
+	return ""
+}
+
+func (m *ServiceSpec) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *ServiceSpec) GetMultiplexed() bool {
+	if m != nil {
+		return m.Multiplexed
+	}
+	return false
+}
+
+func (m *ServiceSpec) GetServices() []*ServiceSpec_ThriftService {
+	if m != nil {
+		return m.Services
+	}
+	return nil
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*ServiceSpec) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _ServiceSpec_OneofMarshaler, _ServiceSpec_OneofUnmarshaler, _ServiceSpec_OneofSizer, []interface{}{
+		(*ServiceSpec_IdlArtifact)(nil),
+		(*ServiceSpec_IdlUrl)(nil),
+	}
+}
+
+func _ServiceSpec_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*ServiceSpec)
+	// idl_source
+	switch x := m.IdlSource.(type) {
+	case *ServiceSpec_IdlArtifact:
+		_ = b.EncodeVarint(1<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.IdlArtifact); err != nil {
+			return err
+		}
+	case *ServiceSpec_IdlUrl:
+		_ = b.EncodeVarint(2<<3 | proto.WireBytes)
+		_ = b.EncodeStringBytes(x.IdlUrl)
+	case nil:
+	default:
+		return fmt.Errorf("ServiceSpec.IdlSource has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _ServiceSpec_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*ServiceSpec)
+	switch tag {
+	case 1: // idl_source.idl_artifact
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(core.ResourceRef)
+		err := b.DecodeMessage(msg)
+		m.IdlSource = &ServiceSpec_IdlArtifact{msg}
+		return true, err
+	case 2: // idl_source.idl_url
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeStringBytes()
+		m.IdlSource = &ServiceSpec_IdlUrl{x}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _ServiceSpec_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*ServiceSpec)
+	// idl_source
+	switch x := m.IdlSource.(type) {
+	case *ServiceSpec_IdlArtifact:
+		s := proto.Size(x.IdlArtifact)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ServiceSpec_IdlUrl:
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(len(x.IdlUrl)))
+		n += len(x.IdlUrl)
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+// A field of a struct, here an argument of a method
+type ServiceSpec_Field struct {
+	// The field id.
+	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The name of the field.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// The type of the field: `bool`, `byte`, `i16`, `i32`, `i64`, `double`, `string` or `binary`, enums being `i32`
+	// and typedefs resolved. Structs and containers are `struct`, `list`, `set` and `map`.
+	Type                 string   `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ServiceSpec_Field) Reset()         { *m = ServiceSpec_Field{} }
+func (m *ServiceSpec_Field) String() string { return proto.CompactTextString(m) }
+func (*ServiceSpec_Field) ProtoMessage()    {}
+func (*ServiceSpec_Field) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c56ecdaad5c158d0, []int{0, 0}
+}
+func (m *ServiceSpec_Field) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ServiceSpec_Field.Unmarshal(m, b)
+}
+func (m *ServiceSpec_Field) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ServiceSpec_Field.Marshal(b, m, deterministic)
+}
+func (m *ServiceSpec_Field) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ServiceSpec_Field.Merge(m, src)
+}
+func (m *ServiceSpec_Field) XXX_Size() int {
+	return xxx_messageInfo_ServiceSpec_Field.Size(m)
+}
+func (m *ServiceSpec_Field) XXX_DiscardUnknown() {
+	xxx_messageInfo_ServiceSpec_Field.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ServiceSpec_Field proto.InternalMessageInfo
+
+func (m *ServiceSpec_Field) GetId() int32 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *ServiceSpec_Field) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ServiceSpec_Field) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+// Describes a method of a service
+type ServiceSpec_Method struct {
+	// The name of the method.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The arguments of the method.
+	Arguments []*ServiceSpec_Field `protobuf:"bytes,2,rep,name=arguments,proto3" json:"arguments,omitempty"`
+	// The method is oneway: the server does not reply.
+	Oneway               bool     `protobuf:"varint,3,opt,name=oneway,proto3" json:"oneway,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ServiceSpec_Method) Reset()         { *m = ServiceSpec_Method{} }
+func (m *ServiceSpec_Method) String() string { return proto.CompactTextString(m) }
+func (*ServiceSpec_Method) ProtoMessage()    {}
+func (*ServiceSpec_Method) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c56ecdaad5c158d0, []int{0, 1}
+}
+func (m *ServiceSpec_Method) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ServiceSpec_Method.Unmarshal(m, b)
+}
+func (m *ServiceSpec_Method) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ServiceSpec_Method.Marshal(b, m, deterministic)
+}
+func (m *ServiceSpec_Method) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ServiceSpec_Method.Merge(m, src)
+}
+func (m *ServiceSpec_Method) XXX_Size() int {
+	return xxx_messageInfo_ServiceSpec_Method.Size(m)
+}
+func (m *ServiceSpec_Method) XXX_DiscardUnknown() {
+	xxx_messageInfo_ServiceSpec_Method.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ServiceSpec_Method proto.InternalMessageInfo
+
+func (m *ServiceSpec_Method) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ServiceSpec_Method) GetArguments() []*ServiceSpec_Field {
+	if m != nil {
+		return m.Arguments
+	}
+	return nil
+}
+
+func (m *ServiceSpec_Method) GetOneway() bool {
+	if m != nil {
+		return m.Oneway
+	}
+	return false
+}
+
+// Describes a Thrift service
+type ServiceSpec_ThriftService struct {
+	// The name of the service.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The methods of the service, including those of the services it extends.
+	Methods              []*ServiceSpec_Method `protobuf:"bytes,2,rep,name=methods,proto3" json:"methods,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *ServiceSpec_ThriftService) Reset()         { *m = ServiceSpec_ThriftService{} }
+func (m *ServiceSpec_ThriftService) String() string { return proto.CompactTextString(m) }
+func (*ServiceSpec_ThriftService) ProtoMessage()    {}
+func (*ServiceSpec_ThriftService) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c56ecdaad5c158d0, []int{0, 2}
+}
+func (m *ServiceSpec_ThriftService) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ServiceSpec_ThriftService.Unmarshal(m, b)
+}
+func (m *ServiceSpec_ThriftService) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ServiceSpec_ThriftService.Marshal(b, m, deterministic)
+}
+func (m *ServiceSpec_ThriftService) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ServiceSpec_ThriftService.Merge(m, src)
+}
+func (m *ServiceSpec_ThriftService) XXX_Size() int {
+	return xxx_messageInfo_ServiceSpec_ThriftService.Size(m)
+}
+func (m *ServiceSpec_ThriftService) XXX_DiscardUnknown() {
+	xxx_messageInfo_ServiceSpec_ThriftService.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ServiceSpec_ThriftService proto.InternalMessageInfo
+
+func (m *ServiceSpec_ThriftService) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ServiceSpec_ThriftService) GetMethods() []*ServiceSpec_Method {
+	if m != nil {
+		return m.Methods
+	}
+	return nil
+}
+
+// This is only for upstream with Thrift service spec.
+// The arguments of the method are read from the fields of the same name of the JSON body of the request; only
+// the boolean and numeric arguments are filled, the others are left unset: the strings of the request can neither be
+// JSON-escaped nor base64-encoded by the transformation filter. The reply of the server is returned as is.
+type DestinationSpec struct {
+	// The name of the service.
+	Service string `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
+	// The name of the method.
+	Method               string   `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DestinationSpec) Reset()         { *m = DestinationSpec{} }
+func (m *DestinationSpec) String() string { return proto.CompactTextString(m) }
+func (*DestinationSpec) ProtoMessage()    {}
+func (*DestinationSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c56ecdaad5c158d0, []int{1}
+}
+func (m *DestinationSpec) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DestinationSpec.Unmarshal(m, b)
+}
+func (m *DestinationSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DestinationSpec.Marshal(b, m, deterministic)
+}
+func (m *DestinationSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DestinationSpec.Merge(m, src)
+}
+func (m *DestinationSpec) XXX_Size() int {
+	return xxx_messageInfo_DestinationSpec.Size(m)
+}
+func (m *DestinationSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_DestinationSpec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DestinationSpec proto.InternalMessageInfo
+
+func (m *DestinationSpec) GetService() string {
+	if m != nil {
+		return m.Service
+	}
+	return ""
+}
+
+func (m *DestinationSpec) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*ServiceSpec)(nil), "thrift.plugins.gloo.solo.io.ServiceSpec")
+	proto.RegisterType((*ServiceSpec_Field)(nil), "thrift.plugins.gloo.solo.io.ServiceSpec.Field")
+	proto.RegisterType((*ServiceSpec_Method)(nil), "thrift.plugins.gloo.solo.io.ServiceSpec.Method")
+	proto.RegisterType((*ServiceSpec_ThriftService)(nil), "thrift.plugins.gloo.solo.io.ServiceSpec.ThriftService")
+	proto.RegisterType((*DestinationSpec)(nil), "thrift.plugins.gloo.solo.io.DestinationSpec")
+}
+
+func init() {
+	proto.RegisterFile("github.com/solo-io/gloo/projects/gloo/api/v1/plugins/thrift/thrift.proto", fileDescriptor_c56ecdaad5c158d0)
+}
+
+var fileDescriptor_c56ecdaad5c158d0 = []byte{
+	// 451 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xcd, 0x6e, 0xd4, 0x30,
+	0x10, 0xc7, 0x9b, 0xed, 0x7e, 0x75, 0x52, 0x40, 0x8a, 0x10, 0x4a, 0x83, 0x84, 0xa2, 0x9e, 0xf6,
+	0x00, 0x8e, 0x28, 0x12, 0x47, 0x2a, 0xca, 0x87, 0x16, 0x09, 0x2e, 0x2e, 0x5c, 0xb8, 0xa0, 0x34,
+	0x99, 0xcd, 0x0e, 0x75, 0x62, 0xcb, 0x71, 0x0a, 0xbd, 0xf2, 0x34, 0x3c, 0x01, 0x0f, 0xc4, 0x93,
+	0x20, 0xdb, 0x09, 0xbb, 0x48, 0x2b, 0xb4, 0x27, 0xcf, 0x8c, 0xe6, 0x3f, 0xf3, 0x9b, 0xb1, 0x0d,
+	0xcb, 0x8a, 0xcc, 0xba, 0xbb, 0x62, 0x85, 0xac, 0xb3, 0x56, 0x0a, 0xf9, 0x84, 0x64, 0x56, 0x09,
+	0x29, 0x33, 0xa5, 0xe5, 0x57, 0x2c, 0x4c, 0xeb, 0xbd, 0x5c, 0x51, 0x76, 0xf3, 0x34, 0x53, 0xa2,
+	0xab, 0xa8, 0x69, 0x33, 0xb3, 0xd6, 0xb4, 0x32, 0xfd, 0xc1, 0x94, 0x96, 0x46, 0x46, 0x0f, 0x07,
+	0xcf, 0xe7, 0x30, 0xab, 0x63, 0xb6, 0x24, 0x23, 0x99, 0xdc, 0xaf, 0x64, 0x25, 0x5d, 0x5e, 0x66,
+	0x2d, 0x2f, 0x49, 0x1e, 0xef, 0x68, 0xee, 0xce, 0x6b, 0x32, 0x43, 0x4b, 0x8d, 0x2b, 0x9f, 0x7d,
+	0xfa, 0x6b, 0x0c, 0xe1, 0x25, 0xea, 0x1b, 0x2a, 0xf0, 0x52, 0x61, 0x11, 0xbd, 0x80, 0x63, 0x2a,
+	0xc5, 0x97, 0x5c, 0x1b, 0x5a, 0xe5, 0x85, 0x89, 0x83, 0x34, 0x58, 0x84, 0x67, 0x27, 0xac, 0x90,
+	0x1a, 0x87, 0xc6, 0x8c, 0x63, 0x2b, 0x3b, 0x5d, 0x20, 0xc7, 0xd5, 0xf2, 0x80, 0x87, 0x54, 0x8a,
+	0x97, 0x7d, 0x7e, 0x74, 0x02, 0x33, 0xab, 0xef, 0xb4, 0x88, 0x47, 0x69, 0xb0, 0x38, 0x5a, 0x1e,
+	0xf0, 0x29, 0x95, 0xe2, 0x93, 0x16, 0x51, 0x04, 0x63, 0x95, 0x9b, 0x75, 0x7c, 0x68, 0xe3, 0xdc,
+	0xd9, 0x51, 0x0a, 0x61, 0xdd, 0x09, 0x43, 0x4a, 0xe0, 0x77, 0x2c, 0xe3, 0x71, 0x1a, 0x2c, 0xe6,
+	0x7c, 0x3b, 0x14, 0x71, 0x98, 0xb7, 0x9e, 0xaf, 0x8d, 0x27, 0xe9, 0xe1, 0x22, 0x3c, 0x7b, 0xce,
+	0xfe, 0xb3, 0x14, 0xb6, 0x35, 0x0c, 0xfb, 0xe8, 0xf2, 0xfa, 0x08, 0xff, 0x5b, 0x27, 0x39, 0x87,
+	0xc9, 0x5b, 0x42, 0x51, 0x46, 0x77, 0x61, 0x44, 0xa5, 0x9b, 0x71, 0xc2, 0x47, 0x54, 0x5a, 0xc4,
+	0x26, 0xaf, 0xd1, 0xa3, 0x73, 0x67, 0xdb, 0x98, 0xb9, 0x55, 0x38, 0x60, 0x5b, 0x3b, 0xf9, 0x11,
+	0xc0, 0xf4, 0x03, 0x9a, 0xb5, 0xdc, 0x48, 0x82, 0x2d, 0xc9, 0x7b, 0x38, 0xca, 0x75, 0xd5, 0xd5,
+	0xd8, 0x98, 0x36, 0x1e, 0x39, 0x68, 0xb6, 0x37, 0xb4, 0x23, 0xe3, 0x9b, 0x02, 0xd1, 0x03, 0x98,
+	0xca, 0x06, 0xbf, 0xe5, 0xb7, 0x0e, 0x61, 0xce, 0x7b, 0x2f, 0x69, 0xe0, 0xce, 0x3f, 0x03, 0xee,
+	0x44, 0x79, 0x07, 0xb3, 0xda, 0x81, 0x0e, 0x20, 0xd9, 0xde, 0x20, 0x7e, 0x40, 0x3e, 0xe8, 0x2f,
+	0x8e, 0x01, 0xec, 0xd5, 0xfa, 0xab, 0x3f, 0x7d, 0x05, 0xf7, 0x5e, 0x63, 0x6b, 0xa8, 0xc9, 0x0d,
+	0xc9, 0xc6, 0xbd, 0x9d, 0x18, 0x66, 0xfd, 0x8a, 0x7b, 0x84, 0xc1, 0xb5, 0x23, 0xf8, 0x2a, 0xfd,
+	0x66, 0x7b, 0xef, 0xe2, 0xcd, 0xcf, 0xdf, 0x8f, 0x82, 0xcf, 0xe7, 0xfb, 0x7d, 0x17, 0x75, 0x5d,
+	0xed, 0xfe, 0x32, 0x57, 0x53, 0xf7, 0x96, 0x9f, 0xfd, 0x19, 0x00, 0x69, 0x0d, 0xfd, 0x8f, 0x78,
+	0x03, 0x00, 0x00,
+}
+
+func (this *ServiceSpec) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ServiceSpec)
+	if !ok {
+		that2, ok := that.(ServiceSpec)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if that1.IdlSource == nil {
+		if this.IdlSource != nil {
+			return false
+		}
+	} else if this.IdlSource == nil {
+		return false
+	} else if !this.IdlSource.Equal(that1.IdlSource) {
+		return false
+	}
+	if this.Path != that1.Path {
+		return false
+	}
+	if this.Multiplexed != that1.Multiplexed {
+		return false
+	}
+	if len(this.Services) != len(that1.Services) {
+		return false
+	}
+	for i := range this.Services {
+		if !this.Services[i].Equal(that1.Services[i]) {
+			return false
+		}
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *ServiceSpec_IdlArtifact) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ServiceSpec_IdlArtifact)
+	if !ok {
+		that2, ok := that.(ServiceSpec_IdlArtifact)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.IdlArtifact.Equal(that1.IdlArtifact) {
+		return false
+	}
+	return true
+}
+func (this *ServiceSpec_IdlUrl) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ServiceSpec_IdlUrl)
+	if !ok {
+		that2, ok := that.(ServiceSpec_IdlUrl)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.IdlUrl != that1.IdlUrl {
+		return false
+	}
+	return true
+}
+func (this *ServiceSpec_Field) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ServiceSpec_Field)
+	if !ok {
+		that2, ok := that.(ServiceSpec_Field)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.Type != that1.Type {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *ServiceSpec_Method) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ServiceSpec_Method)
+	if !ok {
+		that2, ok := that.(ServiceSpec_Method)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if len(this.Arguments) != len(that1.Arguments) {
+		return false
+	}
+	for i := range this.Arguments {
+		if !this.Arguments[i].Equal(that1.Arguments[i]) {
+			return false
+		}
+	}
+	if this.Oneway != that1.Oneway {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *ServiceSpec_ThriftService) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ServiceSpec_ThriftService)
+	if !ok {
+		that2, ok := that.(ServiceSpec_ThriftService)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if len(this.Methods) != len(that1.Methods) {
+		return false
+	}
+	for i := range this.Methods {
+		if !this.Methods[i].Equal(that1.Methods[i]) {
+			return false
+		}
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *DestinationSpec) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DestinationSpec)
+	if !ok {
+		that2, ok := that.(DestinationSpec)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Service != that1.Service {
+		return false
+	}
+	if this.Method != that1.Method {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
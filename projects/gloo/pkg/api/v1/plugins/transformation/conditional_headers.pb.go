@@ -0,0 +1,152 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: github.com/solo-io/gloo/projects/gloo/api/v1/plugins/transformation/conditional_headers.proto
+
+package transformation
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	math "math"
+
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+// ConditionalHeaders controls how the validators used for conditional requests
+// (`ETag`, `Last-Modified` and the `If-*` request headers) are handled by the transformations
+// applied to a route, so that conditional GETs reach the upstream and `304 Not Modified`
+// responses can be served back to the client (or to a cache in front of the gateway).
+type ConditionalHeaders struct {
+	// Pass `If-Match`, `If-None-Match`, `If-Modified-Since` and `If-Unmodified-Since` request headers
+	// to the upstream unmodified, even if a request transformation on the route would overwrite them.
+	ForwardRequestHeaders bool `protobuf:"varint,1,opt,name=forward_request_headers,json=forwardRequestHeaders,proto3" json:"forward_request_headers,omitempty"`
+	// Pass `ETag` and `Last-Modified` response headers to the client unmodified, even if a response
+	// transformation on the route would overwrite them.
+	ForwardResponseHeaders bool `protobuf:"varint,2,opt,name=forward_response_headers,json=forwardResponseHeaders,proto3" json:"forward_response_headers,omitempty"`
+	// Strip the weak validator prefix (`W/`) from every entity tag of the `ETag` response header and the `If-None-Match`
+	// request header, so that validators can be compared by backends and caches that only support strong ETags.
+	// The headers are left as they are when absent or when they are not entity tags, e.g. `If-None-Match: *`, and the
+	// entity tags after the eighth entry of a list keep their prefix.
+	NormalizeEtags       bool     `protobuf:"varint,3,opt,name=normalize_etags,json=normalizeEtags,proto3" json:"normalize_etags,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ConditionalHeaders) Reset()         { *m = ConditionalHeaders{} }
+func (m *ConditionalHeaders) String() string { return proto.CompactTextString(m) }
+func (*ConditionalHeaders) ProtoMessage()    {}
+func (*ConditionalHeaders) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8864b65a4646f47a, []int{0}
+}
+func (m *ConditionalHeaders) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConditionalHeaders.Unmarshal(m, b)
+}
+func (m *ConditionalHeaders) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConditionalHeaders.Marshal(b, m, deterministic)
+}
+func (m *ConditionalHeaders) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConditionalHeaders.Merge(m, src)
+}
+func (m *ConditionalHeaders) XXX_Size() int {
+	return xxx_messageInfo_ConditionalHeaders.Size(m)
+}
+func (m *ConditionalHeaders) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConditionalHeaders.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConditionalHeaders proto.InternalMessageInfo
+
+func (m *ConditionalHeaders) GetForwardRequestHeaders() bool {
+	if m != nil {
+		return m.ForwardRequestHeaders
+	}
+	return false
+}
+
+func (m *ConditionalHeaders) GetForwardResponseHeaders() bool {
+	if m != nil {
+		return m.ForwardResponseHeaders
+	}
+	return false
+}
+
+func (m *ConditionalHeaders) GetNormalizeEtags() bool {
+	if m != nil {
+		return m.NormalizeEtags
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*ConditionalHeaders)(nil), "transformation.plugins.gloo.solo.io.ConditionalHeaders")
+}
+
+func init() {
+	proto.RegisterFile("github.com/solo-io/gloo/projects/gloo/api/v1/plugins/transformation/conditional_headers.proto", fileDescriptor_8864b65a4646f47a)
+}
+
+var fileDescriptor_8864b65a4646f47a = []byte{
+	// 255 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x90, 0xbf, 0x4a, 0x34, 0x31,
+	0x14, 0xc5, 0x99, 0xef, 0x03, 0x91, 0x14, 0x0a, 0x83, 0x7f, 0x06, 0x0b, 0x11, 0x2d, 0xb4, 0x31,
+	0x41, 0x04, 0xb1, 0x56, 0x44, 0x1b, 0x9b, 0x2d, 0x05, 0x19, 0xb2, 0x33, 0xd9, 0x6c, 0x34, 0x9b,
+	0x13, 0x73, 0x33, 0x0a, 0x3e, 0x91, 0x9d, 0xef, 0xe4, 0x93, 0x48, 0x26, 0x3b, 0xb3, 0xd8, 0x88,
+	0xdd, 0xcd, 0x3d, 0xf7, 0x77, 0x0e, 0x39, 0xec, 0x51, 0x9b, 0x38, 0xef, 0xa6, 0xbc, 0xc1, 0x42,
+	0x10, 0x2c, 0x4e, 0x0d, 0x84, 0xb6, 0x80, 0xf0, 0x01, 0x4f, 0xaa, 0x89, 0x94, 0x5f, 0xd2, 0x1b,
+	0xf1, 0x7a, 0x26, 0xbc, 0xed, 0xb4, 0x71, 0x24, 0x62, 0x90, 0x8e, 0x66, 0x08, 0x0b, 0x19, 0x0d,
+	0x9c, 0x68, 0xe0, 0x5a, 0x93, 0x26, 0x69, 0xeb, 0xb9, 0x92, 0xad, 0x0a, 0xc4, 0x7d, 0x40, 0x44,
+	0x79, 0xf4, 0xf3, 0x92, 0x2f, 0x0d, 0x78, 0x32, 0xe5, 0x29, 0x8f, 0x1b, 0xec, 0x6d, 0x69, 0x68,
+	0xf4, 0xf7, 0x22, 0x4d, 0x19, 0x3d, 0xfc, 0x2c, 0x58, 0x79, 0xbd, 0x32, 0xbe, 0xcb, 0xbe, 0xe5,
+	0x05, 0xdb, 0x9d, 0x21, 0xbc, 0xc9, 0xd0, 0xd6, 0x41, 0xbd, 0x74, 0x8a, 0xe2, 0x10, 0x59, 0x15,
+	0x07, 0xc5, 0xc9, 0xfa, 0x64, 0x7b, 0x29, 0x4f, 0xb2, 0x3a, 0x70, 0x97, 0xac, 0x5a, 0x71, 0xe4,
+	0xe1, 0x48, 0x8d, 0xe0, 0xbf, 0x1e, 0xdc, 0x19, 0xc1, 0x2c, 0x0f, 0xe4, 0x31, 0xdb, 0x74, 0xe9,
+	0x03, 0xd6, 0xbc, 0xab, 0x5a, 0x45, 0xa9, 0xa9, 0xfa, 0xdf, 0x03, 0x1b, 0xe3, 0xfa, 0x26, 0x6d,
+	0xaf, 0xee, 0x3f, 0xbe, 0xf6, 0x8b, 0x87, 0xdb, 0xbf, 0x35, 0xea, 0x9f, 0xf5, 0xef, 0xad, 0x4e,
+	0xd7, 0xfa, 0x1e, 0xce, 0xbf, 0x07, 0x00, 0x00, 0xd7, 0x1d, 0xcf, 0xa3, 0x01, 0x00, 0x00,
+}
+
+func (this *ConditionalHeaders) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ConditionalHeaders)
+	if !ok {
+		that2, ok := that.(ConditionalHeaders)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ForwardRequestHeaders != that1.ForwardRequestHeaders {
+		return false
+	}
+	if this.ForwardResponseHeaders != that1.ForwardResponseHeaders {
+		return false
+	}
+	if this.NormalizeEtags != that1.NormalizeEtags {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
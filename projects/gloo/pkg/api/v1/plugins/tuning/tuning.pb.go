@@ -0,0 +1,465 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: github.com/solo-io/gloo/projects/gloo/api/v1/plugins/tuning/tuning.proto
+
+package tuning
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	math "math"
+
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	types "github.com/gogo/protobuf/types"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+type SocketOption_SocketState int32
+
+const (
+	// Applied after the socket is created, before it is bound to a port.
+	SocketOption_PREBIND SocketOption_SocketState = 0
+	// Applied after the socket is bound to a port, before listening.
+	SocketOption_BOUND SocketOption_SocketState = 1
+	// Applied after listening.
+	SocketOption_LISTENING SocketOption_SocketState = 2
+)
+
+var SocketOption_SocketState_name = map[int32]string{
+	0: "PREBIND",
+	1: "BOUND",
+	2: "LISTENING",
+}
+
+var SocketOption_SocketState_value = map[string]int32{
+	"PREBIND":   0,
+	"BOUND":     1,
+	"LISTENING": 2,
+}
+
+func (x SocketOption_SocketState) String() string {
+	return proto.EnumName(SocketOption_SocketState_name, int32(x))
+}
+
+func (SocketOption_SocketState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_0a531b237df1eb66, []int{1, 0}
+}
+
+// Tunes the resources used by the connections of a listener, for high-throughput gateways.
+// See here for more information: https://www.envoyproxy.io/docs/envoy/v1.9.0/api-v2/api/v2/lds.proto
+// The envoy api gloo builds against has no limit of the downstream connections of a listener: the connections to the
+// upstreams are limited by their circuit breakers.
+type ListenerTuning struct {
+	// Soft limit on the size of the read and write buffers of the connections of the listener. Defaults to 1MiB.
+	PerConnectionBufferLimitBytes *types.UInt32Value `protobuf:"bytes,1,opt,name=per_connection_buffer_limit_bytes,json=perConnectionBufferLimitBytes,proto3" json:"per_connection_buffer_limit_bytes,omitempty"`
+	// Enables TCP Fast Open with the given queue length of pending connections. Set to 0 to disable it.
+	TcpFastOpenQueueLength *types.UInt32Value `protobuf:"bytes,2,opt,name=tcp_fast_open_queue_length,json=tcpFastOpenQueueLength,proto3" json:"tcp_fast_open_queue_length,omitempty"`
+	// Additional socket options set on the socket of the listener.
+	SocketOptions        []*SocketOption `protobuf:"bytes,3,rep,name=socket_options,json=socketOptions,proto3" json:"socket_options,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ListenerTuning) Reset()         { *m = ListenerTuning{} }
+func (m *ListenerTuning) String() string { return proto.CompactTextString(m) }
+func (*ListenerTuning) ProtoMessage()    {}
+func (*ListenerTuning) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0a531b237df1eb66, []int{0}
+}
+func (m *ListenerTuning) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListenerTuning.Unmarshal(m, b)
+}
+func (m *ListenerTuning) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListenerTuning.Marshal(b, m, deterministic)
+}
+func (m *ListenerTuning) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListenerTuning.Merge(m, src)
+}
+func (m *ListenerTuning) XXX_Size() int {
+	return xxx_messageInfo_ListenerTuning.Size(m)
+}
+func (m *ListenerTuning) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListenerTuning.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListenerTuning proto.InternalMessageInfo
+
+func (m *ListenerTuning) GetPerConnectionBufferLimitBytes() *types.UInt32Value {
+	if m != nil {
+		return m.PerConnectionBufferLimitBytes
+	}
+	return nil
+}
+
+func (m *ListenerTuning) GetTcpFastOpenQueueLength() *types.UInt32Value {
+	if m != nil {
+		return m.TcpFastOpenQueueLength
+	}
+	return nil
+}
+
+func (m *ListenerTuning) GetSocketOptions() []*SocketOption {
+	if m != nil {
+		return m.SocketOptions
+	}
+	return nil
+}
+
+// A socket option, as passed to setsockopt.
+type SocketOption struct {
+	// An optional description of the option, for debugging.
+	Description string `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
+	// The level of the option, such as `IPPROTO_TCP`.
+	Level int64 `protobuf:"varint,2,opt,name=level,proto3" json:"level,omitempty"`
+	// The numeric name of the option.
+	Name int64 `protobuf:"varint,3,opt,name=name,proto3" json:"name,omitempty"`
+	// Types that are valid to be assigned to Value:
+	//	*SocketOption_IntValue
+	//	*SocketOption_BufValue
+	Value isSocketOption_Value `protobuf_oneof:"value"`
+	// When the option is applied.
+	State                SocketOption_SocketState `protobuf:"varint,6,opt,name=state,proto3,enum=tuning.plugins.gloo.solo.io.SocketOption_SocketState" json:"state,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *SocketOption) Reset()         { *m = SocketOption{} }
+func (m *SocketOption) String() string { return proto.CompactTextString(m) }
+func (*SocketOption) ProtoMessage()    {}
+func (*SocketOption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0a531b237df1eb66, []int{1}
+}
+func (m *SocketOption) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SocketOption.Unmarshal(m, b)
+}
+func (m *SocketOption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SocketOption.Marshal(b, m, deterministic)
+}
+func (m *SocketOption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SocketOption.Merge(m, src)
+}
+func (m *SocketOption) XXX_Size() int {
+	return xxx_messageInfo_SocketOption.Size(m)
+}
+func (m *SocketOption) XXX_DiscardUnknown() {
+	xxx_messageInfo_SocketOption.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SocketOption proto.InternalMessageInfo
+
+type isSocketOption_Value interface {
+	isSocketOption_Value()
+	Equal(interface{}) bool
+}
+
+type SocketOption_IntValue struct {
+	IntValue int64 `protobuf:"varint,4,opt,name=int_value,json=intValue,proto3,oneof"`
+}
+type SocketOption_BufValue struct {
+	BufValue []byte `protobuf:"bytes,5,opt,name=buf_value,json=bufValue,proto3,oneof"`
+}
+
+func (*SocketOption_IntValue) isSocketOption_Value() {}
+func (*SocketOption_BufValue) isSocketOption_Value() {}
+
+func (m *SocketOption) GetValue() isSocketOption_Value {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *SocketOption) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *SocketOption) GetLevel() int64 {
+	if m != nil {
+		return m.Level
+	}
+	return 0
+}
+
+func (m *SocketOption) GetName() int64 {
+	if m != nil {
+		return m.Name
+	}
+	return 0
+}
+
+func (m *SocketOption) GetIntValue() int64 {
+	if x, ok := m.GetValue().(*SocketOption_IntValue); ok {
+		return x.IntValue
+	}
+	return 0
+}
+
+func (m *SocketOption) GetBufValue() []byte {
+	if x, ok := m.GetValue().(*SocketOption_BufValue); ok {
+		return x.BufValue
+	}
+	return nil
+}
+
+func (m *SocketOption) GetState() SocketOption_SocketState {
+	if m != nil {
+		return m.State
+	}
+	return SocketOption_PREBIND
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*SocketOption) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _SocketOption_OneofMarshaler, _SocketOption_OneofUnmarshaler, _SocketOption_OneofSizer, []interface{}{
+		(*SocketOption_IntValue)(nil),
+		(*SocketOption_BufValue)(nil),
+	}
+}
+
+func _SocketOption_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*SocketOption)
+	// value
+	switch x := m.Value.(type) {
+	case *SocketOption_IntValue:
+		_ = b.EncodeVarint(4<<3 | proto.WireVarint)
+		_ = b.EncodeVarint(uint64(x.IntValue))
+	case *SocketOption_BufValue:
+		_ = b.EncodeVarint(5<<3 | proto.WireBytes)
+		_ = b.EncodeRawBytes(x.BufValue)
+	case nil:
+	default:
+		return fmt.Errorf("SocketOption.Value has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _SocketOption_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*SocketOption)
+	switch tag {
+	case 4: // value.int_value
+		if wire != proto.WireVarint {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeVarint()
+		m.Value = &SocketOption_IntValue{int64(x)}
+		return true, err
+	case 5: // value.buf_value
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeRawBytes(true)
+		m.Value = &SocketOption_BufValue{x}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _SocketOption_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*SocketOption)
+	// value
+	switch x := m.Value.(type) {
+	case *SocketOption_IntValue:
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(x.IntValue))
+	case *SocketOption_BufValue:
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(len(x.BufValue)))
+		n += len(x.BufValue)
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+func init() {
+	proto.RegisterEnum("tuning.plugins.gloo.solo.io.SocketOption_SocketState", SocketOption_SocketState_name, SocketOption_SocketState_value)
+	proto.RegisterType((*ListenerTuning)(nil), "tuning.plugins.gloo.solo.io.ListenerTuning")
+	proto.RegisterType((*SocketOption)(nil), "tuning.plugins.gloo.solo.io.SocketOption")
+}
+
+func init() {
+	proto.RegisterFile("github.com/solo-io/gloo/projects/gloo/api/v1/plugins/tuning/tuning.proto", fileDescriptor_0a531b237df1eb66)
+}
+
+var fileDescriptor_0a531b237df1eb66 = []byte{
+	// 477 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x41, 0x6f, 0xd3, 0x3e,
+	0x18, 0xc6, 0x97, 0x76, 0xd9, 0xfe, 0x75, 0xb6, 0xaa, 0xb2, 0xa6, 0xbf, 0xa2, 0xc2, 0xa6, 0xb0,
+	0x53, 0x39, 0xe0, 0x88, 0x0e, 0xce, 0x48, 0x61, 0x85, 0x55, 0x54, 0xed, 0x48, 0x37, 0x84, 0xb8,
+	0x44, 0x49, 0x78, 0xe3, 0x99, 0xa5, 0xb6, 0x89, 0xed, 0x22, 0xbe, 0x0f, 0x07, 0x3e, 0x17, 0x9f,
+	0x04, 0xd9, 0xe9, 0x50, 0x0f, 0x08, 0xf5, 0x14, 0x3f, 0xef, 0xe3, 0xe7, 0xe7, 0xbc, 0xaf, 0x8d,
+	0xae, 0x28, 0xd3, 0x77, 0xa6, 0x20, 0xa5, 0x58, 0xc5, 0x4a, 0xd4, 0xe2, 0x19, 0x13, 0x31, 0xad,
+	0x85, 0x88, 0x65, 0x23, 0xbe, 0x40, 0xa9, 0x55, 0xab, 0x72, 0xc9, 0xe2, 0xf5, 0xf3, 0x58, 0xd6,
+	0x86, 0x32, 0xae, 0x62, 0x6d, 0x38, 0xe3, 0x74, 0xf3, 0x21, 0xb2, 0x11, 0x5a, 0xe0, 0x47, 0x0f,
+	0xaa, 0xdd, 0x43, 0x6c, 0x8e, 0x58, 0x24, 0x61, 0x62, 0x78, 0x42, 0x05, 0x15, 0x6e, 0x5f, 0x6c,
+	0x57, 0x6d, 0x64, 0x78, 0x46, 0x85, 0xa0, 0x35, 0xc4, 0x4e, 0x15, 0xa6, 0x8a, 0xbf, 0x35, 0xb9,
+	0x94, 0xd0, 0xa8, 0xd6, 0x3f, 0xff, 0xd1, 0x41, 0xfd, 0x19, 0x53, 0x1a, 0x38, 0x34, 0x37, 0x8e,
+	0x8e, 0x2b, 0xf4, 0x44, 0x42, 0x93, 0x95, 0x82, 0x73, 0x28, 0x35, 0x13, 0x3c, 0x2b, 0x4c, 0x55,
+	0x41, 0x93, 0xd5, 0x6c, 0xc5, 0x74, 0x56, 0x7c, 0xd7, 0xa0, 0x42, 0x2f, 0xf2, 0x46, 0xc1, 0xf8,
+	0x31, 0x69, 0xf1, 0xe4, 0x01, 0x4f, 0x6e, 0xa7, 0x5c, 0x5f, 0x8c, 0x3f, 0xe4, 0xb5, 0x81, 0xf4,
+	0x54, 0x42, 0xf3, 0xfa, 0x0f, 0x25, 0x71, 0x90, 0x99, 0x65, 0x24, 0x16, 0x81, 0x3f, 0xa2, 0xa1,
+	0x2e, 0x65, 0x56, 0xe5, 0x4a, 0x67, 0x42, 0x02, 0xcf, 0xbe, 0x1a, 0x30, 0x90, 0xd5, 0xc0, 0xa9,
+	0xbe, 0x0b, 0x3b, 0x3b, 0x1c, 0xf0, 0xbf, 0x2e, 0xe5, 0x9b, 0x5c, 0xe9, 0x85, 0x04, 0xfe, 0xde,
+	0x86, 0x67, 0x2e, 0x8b, 0xaf, 0x51, 0x5f, 0x89, 0xf2, 0x1e, 0x2c, 0xd7, 0x1e, 0xad, 0xc2, 0x6e,
+	0xd4, 0x1d, 0x05, 0xe3, 0xa7, 0xe4, 0x1f, 0x03, 0x24, 0x4b, 0x17, 0x59, 0xb8, 0x44, 0x7a, 0xac,
+	0xb6, 0x94, 0xb2, 0x63, 0x3a, 0xda, 0xf6, 0x71, 0x84, 0x82, 0xcf, 0xa0, 0xca, 0x86, 0x39, 0xe9,
+	0xc6, 0xd1, 0x4b, 0xb7, 0x4b, 0xf8, 0x04, 0xf9, 0x35, 0xac, 0xa1, 0x76, 0x9d, 0x74, 0xd3, 0x56,
+	0x60, 0x8c, 0xf6, 0x79, 0xbe, 0x82, 0xb0, 0xeb, 0x8a, 0x6e, 0x8d, 0x4f, 0x51, 0x8f, 0x71, 0x9d,
+	0xad, 0x6d, 0x4f, 0xe1, 0xbe, 0x35, 0xae, 0xf6, 0xd2, 0xff, 0x18, 0xd7, 0xae, 0x4b, 0x6b, 0x17,
+	0xa6, 0xda, 0xd8, 0x7e, 0xe4, 0x8d, 0x8e, 0xac, 0x5d, 0x98, 0xaa, 0xb5, 0xdf, 0x21, 0x5f, 0xe9,
+	0x5c, 0x43, 0x78, 0x10, 0x79, 0xa3, 0xfe, 0xf8, 0xe5, 0xce, 0x3d, 0x6e, 0xc4, 0xd2, 0x86, 0xd3,
+	0x96, 0x71, 0xfe, 0x02, 0x05, 0x5b, 0x55, 0x1c, 0xa0, 0xc3, 0xeb, 0x74, 0x92, 0x4c, 0xe7, 0x97,
+	0x83, 0x3d, 0xdc, 0x43, 0x7e, 0xb2, 0xb8, 0x9d, 0x5f, 0x0e, 0x3c, 0x7c, 0x8c, 0x7a, 0xb3, 0xe9,
+	0xf2, 0x66, 0x32, 0x9f, 0xce, 0xdf, 0x0e, 0x3a, 0xc9, 0x21, 0xf2, 0xdd, 0xdf, 0x25, 0x93, 0x9f,
+	0xbf, 0xce, 0xbc, 0x4f, 0xaf, 0x76, 0x7b, 0xf0, 0xf2, 0x9e, 0xfe, 0xfd, 0xd1, 0x17, 0x07, 0xee,
+	0xb6, 0x2f, 0x7e, 0x0f, 0x00, 0xd3, 0x33, 0xa7, 0x1f, 0x3a, 0x03, 0x00, 0x00,
+}
+
+func (this *ListenerTuning) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ListenerTuning)
+	if !ok {
+		that2, ok := that.(ListenerTuning)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.PerConnectionBufferLimitBytes.Equal(that1.PerConnectionBufferLimitBytes) {
+		return false
+	}
+	if !this.TcpFastOpenQueueLength.Equal(that1.TcpFastOpenQueueLength) {
+		return false
+	}
+	if len(this.SocketOptions) != len(that1.SocketOptions) {
+		return false
+	}
+	for i := range this.SocketOptions {
+		if !this.SocketOptions[i].Equal(that1.SocketOptions[i]) {
+			return false
+		}
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *SocketOption) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SocketOption)
+	if !ok {
+		that2, ok := that.(SocketOption)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if this.Level != that1.Level {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if that1.Value == nil {
+		if this.Value != nil {
+			return false
+		}
+	} else if this.Value == nil {
+		return false
+	} else if !this.Value.Equal(that1.Value) {
+		return false
+	}
+	if this.State != that1.State {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *SocketOption_IntValue) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SocketOption_IntValue)
+	if !ok {
+		that2, ok := that.(SocketOption_IntValue)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.IntValue != that1.IntValue {
+		return false
+	}
+	return true
+}
+func (this *SocketOption_BufValue) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SocketOption_BufValue)
+	if !ok {
+		that2, ok := that.(SocketOption_BufValue)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.BufValue, that1.BufValue) {
+		return false
+	}
+	return true
+}
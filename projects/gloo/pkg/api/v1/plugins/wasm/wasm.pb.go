@@ -0,0 +1,424 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: github.com/solo-io/gloo/projects/gloo/api/v1/plugins/wasm/wasm.proto
+
+package wasm
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	math "math"
+
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+type WasmFilter_VmType int32
+
+const (
+	WasmFilter_V8   WasmFilter_VmType = 0
+	WasmFilter_WAVM WasmFilter_VmType = 1
+)
+
+var WasmFilter_VmType_name = map[int32]string{
+	0: "V8",
+	1: "WAVM",
+}
+
+var WasmFilter_VmType_value = map[string]int32{
+	"V8":   0,
+	"WAVM": 1,
+}
+
+func (x WasmFilter_VmType) String() string {
+	return proto.EnumName(WasmFilter_VmType_name, int32(x))
+}
+
+func (WasmFilter_VmType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f2ade8e6172386ea, []int{1, 0}
+}
+
+// The wasm filters of an http listener, run in order after the authentication filters.
+// The wasm filters require an envoy build with the wasm extension, `envoy.filters.http.wasm`, which must be listed in the
+// envoy extensions of the settings: the listeners with wasm filters are rejected otherwise.
+type PluginSource struct {
+	Filters              []*WasmFilter `protobuf:"bytes,1,rep,name=filters,proto3" json:"filters,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *PluginSource) Reset()         { *m = PluginSource{} }
+func (m *PluginSource) String() string { return proto.CompactTextString(m) }
+func (*PluginSource) ProtoMessage()    {}
+func (*PluginSource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f2ade8e6172386ea, []int{0}
+}
+func (m *PluginSource) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PluginSource.Unmarshal(m, b)
+}
+func (m *PluginSource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PluginSource.Marshal(b, m, deterministic)
+}
+func (m *PluginSource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PluginSource.Merge(m, src)
+}
+func (m *PluginSource) XXX_Size() int {
+	return xxx_messageInfo_PluginSource.Size(m)
+}
+func (m *PluginSource) XXX_DiscardUnknown() {
+	xxx_messageInfo_PluginSource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PluginSource proto.InternalMessageInfo
+
+func (m *PluginSource) GetFilters() []*WasmFilter {
+	if m != nil {
+		return m.Filters
+	}
+	return nil
+}
+
+// A wasm filter, i.e. a module compiled to WebAssembly run by envoy for the requests of the listener
+type WasmFilter struct {
+	// Types that are valid to be assigned to Source:
+	//	*WasmFilter_Image
+	//	*WasmFilter_FilePath
+	Source isWasmFilter_Source `protobuf_oneof:"source"`
+	// The configuration passed to the filter.
+	Config string `protobuf:"bytes,3,opt,name=config,proto3" json:"config,omitempty"`
+	// The name of the filter, which also identifies its virtual machine. Defaults to the image or file path.
+	Name string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	// The root id of the filter, selecting the filter when the module contains several filters.
+	RootId string `protobuf:"bytes,5,opt,name=root_id,json=rootId,proto3" json:"root_id,omitempty"`
+	// The runtime of the virtual machine of the filter. Defaults to V8.
+	VmType               WasmFilter_VmType `protobuf:"varint,6,opt,name=vm_type,json=vmType,proto3,enum=wasm.plugins.gloo.solo.io.WasmFilter_VmType" json:"vm_type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *WasmFilter) Reset()         { *m = WasmFilter{} }
+func (m *WasmFilter) String() string { return proto.CompactTextString(m) }
+func (*WasmFilter) ProtoMessage()    {}
+func (*WasmFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f2ade8e6172386ea, []int{1}
+}
+func (m *WasmFilter) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WasmFilter.Unmarshal(m, b)
+}
+func (m *WasmFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WasmFilter.Marshal(b, m, deterministic)
+}
+func (m *WasmFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WasmFilter.Merge(m, src)
+}
+func (m *WasmFilter) XXX_Size() int {
+	return xxx_messageInfo_WasmFilter.Size(m)
+}
+func (m *WasmFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_WasmFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WasmFilter proto.InternalMessageInfo
+
+type isWasmFilter_Source interface {
+	isWasmFilter_Source()
+	Equal(interface{}) bool
+}
+
+type WasmFilter_Image struct {
+	Image string `protobuf:"bytes,1,opt,name=image,proto3,oneof"`
+}
+type WasmFilter_FilePath struct {
+	FilePath string `protobuf:"bytes,2,opt,name=file_path,json=filePath,proto3,oneof"`
+}
+
+func (*WasmFilter_Image) isWasmFilter_Source()    {}
+func (*WasmFilter_FilePath) isWasmFilter_Source() {}
+
+func (m *WasmFilter) GetSource() isWasmFilter_Source {
+	if m != nil {
+		return m.Source
+	}
+	return nil
+}
+
+func (m *WasmFilter) GetImage() string {
+	if x, ok := m.GetSource().(*WasmFilter_Image); ok {
+		return x.Image
+	}
+	return ""
+}
+
+func (m *WasmFilter) GetFilePath() string {
+	if x, ok := m.GetSource().(*WasmFilter_FilePath); ok {
+		return x.FilePath
+	}
+	return ""
+}
+
+func (m *WasmFilter) GetConfig() string {
+	if m != nil {
+		return m.Config
+	}
+	return ""
+}
+
+func (m *WasmFilter) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *WasmFilter) GetRootId() string {
+	if m != nil {
+		return m.RootId
+	}
+	return ""
+}
+
+func (m *WasmFilter) GetVmType() WasmFilter_VmType {
+	if m != nil {
+		return m.VmType
+	}
+	return WasmFilter_V8
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*WasmFilter) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _WasmFilter_OneofMarshaler, _WasmFilter_OneofUnmarshaler, _WasmFilter_OneofSizer, []interface{}{
+		(*WasmFilter_Image)(nil),
+		(*WasmFilter_FilePath)(nil),
+	}
+}
+
+func _WasmFilter_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*WasmFilter)
+	// source
+	switch x := m.Source.(type) {
+	case *WasmFilter_Image:
+		_ = b.EncodeVarint(1<<3 | proto.WireBytes)
+		_ = b.EncodeStringBytes(x.Image)
+	case *WasmFilter_FilePath:
+		_ = b.EncodeVarint(2<<3 | proto.WireBytes)
+		_ = b.EncodeStringBytes(x.FilePath)
+	case nil:
+	default:
+		return fmt.Errorf("WasmFilter.Source has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _WasmFilter_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*WasmFilter)
+	switch tag {
+	case 1: // source.image
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeStringBytes()
+		m.Source = &WasmFilter_Image{x}
+		return true, err
+	case 2: // source.file_path
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeStringBytes()
+		m.Source = &WasmFilter_FilePath{x}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _WasmFilter_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*WasmFilter)
+	// source
+	switch x := m.Source.(type) {
+	case *WasmFilter_Image:
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(len(x.Image)))
+		n += len(x.Image)
+	case *WasmFilter_FilePath:
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(len(x.FilePath)))
+		n += len(x.FilePath)
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+func init() {
+	proto.RegisterEnum("wasm.plugins.gloo.solo.io.WasmFilter_VmType", WasmFilter_VmType_name, WasmFilter_VmType_value)
+	proto.RegisterType((*PluginSource)(nil), "wasm.plugins.gloo.solo.io.PluginSource")
+	proto.RegisterType((*WasmFilter)(nil), "wasm.plugins.gloo.solo.io.WasmFilter")
+}
+
+func init() {
+	proto.RegisterFile("github.com/solo-io/gloo/projects/gloo/api/v1/plugins/wasm/wasm.proto", fileDescriptor_f2ade8e6172386ea)
+}
+
+var fileDescriptor_f2ade8e6172386ea = []byte{
+	// 333 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x51, 0xcd, 0x4a, 0x2b, 0x31,
+	0x14, 0xee, 0xf4, 0x67, 0xda, 0x9e, 0x7b, 0xb9, 0x94, 0x70, 0xe9, 0x9d, 0x5b, 0x50, 0x4a, 0x41,
+	0xe8, 0x42, 0x33, 0x58, 0x37, 0x6e, 0x44, 0xac, 0x3f, 0xe8, 0x42, 0x2c, 0xa3, 0xb4, 0xe0, 0xa6,
+	0x4c, 0xa7, 0x69, 0x1a, 0x9d, 0xf4, 0x84, 0x49, 0x5a, 0xe9, 0x1b, 0xf9, 0x5c, 0xbe, 0x83, 0x7b,
+	0x99, 0xa4, 0xe2, 0x46, 0xa1, 0x9b, 0xe4, 0x7c, 0x7f, 0x07, 0x92, 0x0f, 0x2e, 0xb8, 0x30, 0xf3,
+	0xe5, 0x84, 0x26, 0x28, 0x43, 0x8d, 0x29, 0x1e, 0x08, 0x0c, 0x79, 0x8a, 0x18, 0xaa, 0x0c, 0x9f,
+	0x58, 0x62, 0xb4, 0x43, 0xb1, 0x12, 0xe1, 0xea, 0x30, 0x54, 0xe9, 0x92, 0x8b, 0x85, 0x0e, 0x5f,
+	0x62, 0x2d, 0xed, 0x41, 0x55, 0x86, 0x06, 0xc9, 0x7f, 0x37, 0x3b, 0x95, 0xe6, 0x09, 0x9a, 0x2f,
+	0xa3, 0x02, 0x5b, 0x7f, 0x39, 0x72, 0xb4, 0xae, 0x30, 0x9f, 0x5c, 0xa0, 0x73, 0x07, 0xbf, 0x07,
+	0xd6, 0x7d, 0x8f, 0xcb, 0x2c, 0x61, 0xe4, 0x14, 0xaa, 0x33, 0x91, 0x1a, 0x96, 0xe9, 0xc0, 0x6b,
+	0x97, 0xba, 0xbf, 0x7a, 0x7b, 0xf4, 0xc7, 0x95, 0x74, 0x14, 0x6b, 0x79, 0x65, 0xdd, 0xd1, 0x67,
+	0xaa, 0xf3, 0xee, 0x01, 0x7c, 0xf1, 0xa4, 0x09, 0x15, 0x21, 0x63, 0xce, 0x02, 0xaf, 0xed, 0x75,
+	0xeb, 0xd7, 0x85, 0xc8, 0x41, 0xb2, 0x03, 0xf5, 0x99, 0x48, 0xd9, 0x58, 0xc5, 0x66, 0x1e, 0x14,
+	0x37, 0x5a, 0x2d, 0xa7, 0x06, 0xb1, 0x99, 0x93, 0x26, 0xf8, 0x09, 0x2e, 0x66, 0x82, 0x07, 0xa5,
+	0x5c, 0x8b, 0x36, 0x88, 0x10, 0x28, 0x2f, 0x62, 0xc9, 0x82, 0xb2, 0x65, 0xed, 0x4c, 0xfe, 0x41,
+	0x35, 0x43, 0x34, 0x63, 0x31, 0x0d, 0x2a, 0xce, 0x9c, 0xc3, 0x9b, 0x29, 0xb9, 0x84, 0xea, 0x4a,
+	0x8e, 0xcd, 0x5a, 0xb1, 0xc0, 0x6f, 0x7b, 0xdd, 0x3f, 0xbd, 0xfd, 0xad, 0xde, 0x42, 0x87, 0xf2,
+	0x61, 0xad, 0x58, 0xe4, 0xaf, 0xec, 0xdd, 0x69, 0x81, 0xef, 0x18, 0xe2, 0x43, 0x71, 0x78, 0xdc,
+	0x28, 0x90, 0x1a, 0x94, 0x47, 0x67, 0xc3, 0xdb, 0x86, 0xd7, 0xaf, 0x81, 0xaf, 0xed, 0xc7, 0xf5,
+	0xcf, 0x5f, 0xdf, 0x76, 0xbd, 0xc7, 0x93, 0xed, 0x5a, 0x54, 0xcf, 0xfc, 0xbb, 0x26, 0x27, 0xbe,
+	0x2d, 0xe5, 0xe8, 0x63, 0x00, 0x53, 0x9b, 0x6d, 0x9e, 0x0d, 0x02, 0x00, 0x00,
+}
+
+func (this *PluginSource) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PluginSource)
+	if !ok {
+		that2, ok := that.(PluginSource)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Filters) != len(that1.Filters) {
+		return false
+	}
+	for i := range this.Filters {
+		if !this.Filters[i].Equal(that1.Filters[i]) {
+			return false
+		}
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *WasmFilter) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*WasmFilter)
+	if !ok {
+		that2, ok := that.(WasmFilter)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if that1.Source == nil {
+		if this.Source != nil {
+			return false
+		}
+	} else if this.Source == nil {
+		return false
+	} else if !this.Source.Equal(that1.Source) {
+		return false
+	}
+	if this.Config != that1.Config {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.RootId != that1.RootId {
+		return false
+	}
+	if this.VmType != that1.VmType {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *WasmFilter_Image) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*WasmFilter_Image)
+	if !ok {
+		that2, ok := that.(WasmFilter_Image)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Image != that1.Image {
+		return false
+	}
+	return true
+}
+func (this *WasmFilter_FilePath) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*WasmFilter_FilePath)
+	if !ok {
+		that2, ok := that.(WasmFilter_FilePath)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.FilePath != that1.FilePath {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,143 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: github.com/solo-io/gloo/projects/gloo/api/v1/plugins/websocket/websocket.proto
+
+package websocket
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	math "math"
+	time "time"
+
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+// Configures the WebSockets upgraded on a route. WebSocket upgrades are enabled on all routes by default.
+// Envoy has no limit of the size of the messages and frames of the WebSockets: limit them on the upstreams.
+type RouteWebSocket struct {
+	// Disables the WebSocket upgrades of the route.
+	Disabled bool `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	// Closes the socket when no data was sent in either direction for the duration. Ping and pong frames count as data.
+	// Sets the idle timeout of the route, which also applies to its requests not upgraded.
+	IdleTimeout          *time.Duration `protobuf:"bytes,2,opt,name=idle_timeout,json=idleTimeout,proto3,stdduration" json:"idle_timeout,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *RouteWebSocket) Reset()         { *m = RouteWebSocket{} }
+func (m *RouteWebSocket) String() string { return proto.CompactTextString(m) }
+func (*RouteWebSocket) ProtoMessage()    {}
+func (*RouteWebSocket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c0d6b350ef080be6, []int{0}
+}
+func (m *RouteWebSocket) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RouteWebSocket.Unmarshal(m, b)
+}
+func (m *RouteWebSocket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RouteWebSocket.Marshal(b, m, deterministic)
+}
+func (m *RouteWebSocket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RouteWebSocket.Merge(m, src)
+}
+func (m *RouteWebSocket) XXX_Size() int {
+	return xxx_messageInfo_RouteWebSocket.Size(m)
+}
+func (m *RouteWebSocket) XXX_DiscardUnknown() {
+	xxx_messageInfo_RouteWebSocket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RouteWebSocket proto.InternalMessageInfo
+
+func (m *RouteWebSocket) GetDisabled() bool {
+	if m != nil {
+		return m.Disabled
+	}
+	return false
+}
+
+func (m *RouteWebSocket) GetIdleTimeout() *time.Duration {
+	if m != nil {
+		return m.IdleTimeout
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*RouteWebSocket)(nil), "websocket.plugins.gloo.solo.io.RouteWebSocket")
+}
+
+func init() {
+	proto.RegisterFile("github.com/solo-io/gloo/projects/gloo/api/v1/plugins/websocket/websocket.proto", fileDescriptor_c0d6b350ef080be6)
+}
+
+var fileDescriptor_c0d6b350ef080be6 = []byte{
+	// 243 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x90, 0xcf, 0x4a, 0xc4, 0x30,
+	0x18, 0xc4, 0x89, 0x88, 0x2c, 0x5d, 0xf1, 0x50, 0x3c, 0xac, 0x3d, 0xd4, 0xc5, 0xd3, 0x5e, 0x4c,
+	0x50, 0xdf, 0xa0, 0x78, 0xf0, 0xe4, 0xa1, 0x0a, 0x82, 0x17, 0x69, 0xda, 0xcf, 0xf8, 0xb9, 0xd9,
+	0x4e, 0x68, 0x12, 0x7d, 0x15, 0x1f, 0xc1, 0xb7, 0x12, 0x7c, 0x12, 0x69, 0xe3, 0xbf, 0x8b, 0xe0,
+	0xed, 0x9b, 0x64, 0x66, 0x7e, 0x30, 0xd9, 0xa5, 0xe1, 0xf0, 0x10, 0xb5, 0x6c, 0xb1, 0x51, 0x1e,
+	0x16, 0xc7, 0x0c, 0x65, 0x2c, 0xa0, 0xdc, 0x80, 0x47, 0x6a, 0x83, 0x4f, 0xaa, 0x71, 0xac, 0x9e,
+	0x4e, 0x94, 0xb3, 0xd1, 0x70, 0xef, 0xd5, 0x33, 0x69, 0x8f, 0x76, 0x4d, 0xe1, 0xe7, 0x92, 0x6e,
+	0x40, 0x40, 0x5e, 0xfe, 0x7a, 0x48, 0x66, 0x39, 0x16, 0xc8, 0xb1, 0x5b, 0x32, 0x8a, 0x7d, 0x03,
+	0x83, 0xc9, 0xaa, 0xc6, 0x2b, 0xa5, 0x8a, 0xd2, 0x00, 0xc6, 0x92, 0x9a, 0x94, 0x8e, 0xf7, 0xaa,
+	0x8b, 0x43, 0x13, 0x18, 0x7d, 0xfa, 0x3f, 0x72, 0xd9, 0x5e, 0x8d, 0x18, 0xe8, 0x86, 0xf4, 0xd5,
+	0x54, 0x9e, 0x17, 0xd9, 0xac, 0x63, 0xdf, 0x68, 0x4b, 0xdd, 0x42, 0x2c, 0xc5, 0x6a, 0x56, 0x7f,
+	0xeb, 0xbc, 0xca, 0x76, 0xb9, 0xb3, 0x74, 0x17, 0x78, 0x43, 0x88, 0x61, 0xb1, 0xb5, 0x14, 0xab,
+	0xf9, 0xe9, 0x81, 0x4c, 0x10, 0xf9, 0x05, 0x91, 0xe7, 0x9f, 0x90, 0x6a, 0xfb, 0xe5, 0xed, 0x50,
+	0xd4, 0xf3, 0x31, 0x74, 0x9d, 0x32, 0xd5, 0xc5, 0xeb, 0x7b, 0x29, 0x6e, 0xab, 0xff, 0xad, 0xe3,
+	0xd6, 0xe6, 0xcf, 0x85, 0xf4, 0xce, 0xc4, 0x3b, 0xfb, 0x18, 0x00, 0x73, 0x41, 0x0d, 0x46, 0x6a,
+	0x01, 0x00, 0x00,
+}
+
+func (this *RouteWebSocket) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RouteWebSocket)
+	if !ok {
+		that2, ok := that.(RouteWebSocket)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Disabled != that1.Disabled {
+		return false
+	}
+	if this.IdleTimeout != nil && that1.IdleTimeout != nil {
+		if *this.IdleTimeout != *that1.IdleTimeout {
+			return false
+		}
+	} else if this.IdleTimeout != nil {
+		return false
+	} else if that1.IdleTimeout != nil {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
@@ -480,10 +480,14 @@ type Route struct {
 	// Plugins should be specified here in the form of
 	//   `"plugin_name": {..//plugin_config...}`
 	// to allow specifying multiple plugins.
-	RoutePlugins         *RoutePlugins `protobuf:"bytes,5,opt,name=route_plugins,json=routePlugins,proto3" json:"route_plugins,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+	RoutePlugins *RoutePlugins `protobuf:"bytes,5,opt,name=route_plugins,json=routePlugins,proto3" json:"route_plugins,omitempty"`
+	// Annotations of the route. The gateway adds the annotations of the virtual service the route belongs to,
+	// without overriding the annotations set on the route.
+	// The annotations selected in the settings are copied into the metadata of the Envoy route.
+	Annotations          map[string]string `protobuf:"bytes,6,rep,name=annotations,proto3" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *Route) Reset()         { *m = Route{} }
@@ -571,6 +575,13 @@ func (m *Route) GetRoutePlugins() *RoutePlugins {
 	return nil
 }
 
+func (m *Route) GetAnnotations() map[string]string {
+	if m != nil {
+		return m.Annotations
+	}
+	return nil
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*Route) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _Route_OneofMarshaler, _Route_OneofUnmarshaler, _Route_OneofSizer, []interface{}{
@@ -1878,6 +1889,7 @@ func init() {
 	proto.RegisterType((*HttpListener)(nil), "gloo.solo.io.HttpListener")
 	proto.RegisterType((*VirtualHost)(nil), "gloo.solo.io.VirtualHost")
 	proto.RegisterType((*Route)(nil), "gloo.solo.io.Route")
+	proto.RegisterMapType((map[string]string)(nil), "gloo.solo.io.Route.AnnotationsEntry")
 	proto.RegisterType((*Matcher)(nil), "gloo.solo.io.Matcher")
 	proto.RegisterType((*HeaderMatcher)(nil), "gloo.solo.io.HeaderMatcher")
 	proto.RegisterType((*QueryParameterMatcher)(nil), "gloo.solo.io.QueryParameterMatcher")
@@ -1897,105 +1909,107 @@ func init() {
 }
 
 var fileDescriptor_c6a47f72e9923590 = []byte{
-	// 1561 bytes of a gzipped FileDescriptorProto
+	// 1600 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0x4f, 0x73, 0x23, 0x47,
-	0x15, 0xb7, 0x24, 0x4b, 0x96, 0x9e, 0x34, 0xb2, 0xb6, 0xa3, 0x75, 0x66, 0x37, 0xb0, 0xeb, 0xcc,
-	0xd6, 0x16, 0xae, 0xca, 0x22, 0xb1, 0x0e, 0x2c, 0x49, 0xa0, 0x42, 0x59, 0xb6, 0x58, 0x51, 0x15,
-	0xaf, 0x45, 0xdb, 0x71, 0x6a, 0xc3, 0x61, 0x6a, 0x3c, 0xd3, 0x1a, 0x4d, 0x32, 0x52, 0x4f, 0xba,
+	0x15, 0xf7, 0x58, 0x96, 0x2c, 0x3f, 0x49, 0xb6, 0xb6, 0xa3, 0x75, 0x66, 0x1d, 0xd8, 0x75, 0x26,
+	0xa4, 0x70, 0x55, 0x16, 0x89, 0x75, 0x60, 0xd9, 0x2c, 0xd4, 0x52, 0x96, 0xad, 0xac, 0xa8, 0x8a,
+	0xd7, 0xa2, 0xed, 0x38, 0xb5, 0xe1, 0x30, 0x35, 0x9e, 0x69, 0x8d, 0x26, 0x3b, 0x52, 0x4f, 0xba,
 	0x7b, 0xfc, 0xe7, 0x0b, 0x70, 0xe6, 0xc0, 0x81, 0x8f, 0xc0, 0x47, 0xa0, 0x8a, 0x0b, 0x47, 0x2e,
-	0x7c, 0x00, 0x2e, 0x39, 0x70, 0xe2, 0xcc, 0x85, 0x2b, 0xd5, 0x7f, 0x66, 0x34, 0xe3, 0x28, 0xec,
-	0x6e, 0xc1, 0x21, 0x27, 0xcd, 0x7b, 0xef, 0xf7, 0x5e, 0xbf, 0x7e, 0x7f, 0x5b, 0xf0, 0x41, 0x18,
-	0x89, 0x79, 0x7a, 0x31, 0xf0, 0xe9, 0x62, 0xc8, 0x69, 0x4c, 0x7f, 0x18, 0xd1, 0x61, 0x18, 0x53,
-	0x3a, 0x4c, 0x18, 0xfd, 0x82, 0xf8, 0x82, 0x6b, 0xca, 0x4b, 0xa2, 0xe1, 0xe5, 0x53, 0xc9, 0xbc,
-	0xbe, 0x19, 0x24, 0x8c, 0x0a, 0x8a, 0x3a, 0x52, 0x30, 0x90, 0x3a, 0x83, 0x88, 0xde, 0x7f, 0x10,
-	0x52, 0x1a, 0xc6, 0x64, 0xa8, 0x64, 0x17, 0xe9, 0x6c, 0x78, 0xc5, 0xbc, 0x24, 0x21, 0x8c, 0x6b,
-	0xf4, 0xfd, 0x7e, 0x48, 0x43, 0xaa, 0x3e, 0x87, 0xf2, 0xcb, 0x70, 0x9f, 0xae, 0x39, 0x5d, 0xfd,
-	0x7e, 0x19, 0x89, 0xec, 0xcc, 0x05, 0x11, 0x5e, 0xe0, 0x09, 0xcf, 0xa8, 0x0c, 0x5f, 0x43, 0x85,
-	0x0b, 0x4f, 0xa4, 0xd9, 0xc9, 0x4f, 0x5e, 0x43, 0x81, 0x91, 0x99, 0x41, 0x3f, 0x7b, 0xa3, 0x78,
-	0x70, 0x1e, 0x1b, 0xbd, 0x0f, 0xdf, 0x4c, 0x2f, 0xbd, 0xe0, 0x44, 0x18, 0xd5, 0x8f, 0xde, 0x2c,
-	0x05, 0x71, 0x1a, 0x46, 0x4b, 0x73, 0x39, 0xe7, 0x2f, 0x15, 0xa8, 0x4f, 0x65, 0x52, 0xd0, 0x8f,
-	0xa1, 0x15, 0x47, 0x5c, 0x90, 0x25, 0x61, 0xdc, 0xae, 0xee, 0xd6, 0xf6, 0xda, 0xfb, 0x3b, 0x83,
-	0x62, 0x8a, 0x06, 0x9f, 0x18, 0x31, 0x5e, 0x01, 0xd1, 0x73, 0x68, 0xe8, 0x60, 0xd9, 0x8d, 0xdd,
-	0xca, 0x5e, 0x7b, 0xbf, 0x3f, 0xf0, 0x29, 0x23, 0xb9, 0xca, 0xa9, 0x92, 0x8d, 0xee, 0xfd, 0xf5,
-	0xeb, 0x87, 0x1b, 0xff, 0xfa, 0xfa, 0xe1, 0x1d, 0x41, 0xb8, 0x08, 0xa2, 0xd9, 0xec, 0x23, 0x27,
-	0x0a, 0x97, 0x94, 0x11, 0x07, 0x1b, 0x75, 0xf4, 0x01, 0x34, 0xb3, 0x44, 0xd9, 0x5b, 0xca, 0xd4,
-	0x4e, 0xd9, 0xd4, 0xb1, 0x91, 0x8e, 0x36, 0xa5, 0x31, 0x9c, 0xa3, 0x9d, 0x3f, 0x57, 0xa1, 0x99,
-	0xb9, 0x86, 0x10, 0x6c, 0x2e, 0xbd, 0x05, 0xb1, 0x2b, 0xbb, 0x95, 0xbd, 0x16, 0x56, 0xdf, 0xe8,
-	0x5d, 0xe8, 0x5c, 0x44, 0xcb, 0xc0, 0xf5, 0x82, 0x80, 0x11, 0x2e, 0x2f, 0x27, 0x65, 0x6d, 0xc9,
-	0x3b, 0xd0, 0x2c, 0xf4, 0x0e, 0xb4, 0x14, 0x24, 0xa1, 0x4c, 0xd8, 0xb5, 0xdd, 0xca, 0x9e, 0x85,
-	0x9b, 0x92, 0x31, 0xa5, 0x4c, 0xa0, 0x03, 0xb0, 0xe6, 0x42, 0x24, 0x6e, 0x76, 0x6b, 0x7b, 0x53,
-	0xf9, 0x77, 0xbf, 0x1c, 0x9d, 0x89, 0x10, 0x49, 0xe6, 0xc6, 0x64, 0x03, 0x77, 0xe6, 0x05, 0x1a,
-	0x1d, 0xc1, 0x1d, 0xce, 0x63, 0xd7, 0xa7, 0xcb, 0x59, 0x14, 0xa6, 0x9e, 0x88, 0xe8, 0x92, 0xdb,
-	0x75, 0x15, 0xe4, 0xb7, 0xcb, 0x66, 0x4e, 0x79, 0x7c, 0xa8, 0x50, 0xb8, 0xc7, 0xb3, 0x4f, 0xa3,
-	0x80, 0x46, 0xb0, 0x9d, 0x72, 0xe2, 0xaa, 0x26, 0x72, 0x55, 0xfe, 0x4c, 0xd4, 0xef, 0x0f, 0x74,
-	0xf7, 0x0c, 0xb2, 0xee, 0x19, 0x8c, 0x28, 0x8d, 0xcf, 0xbd, 0x38, 0x25, 0xd8, 0x4a, 0x39, 0x51,
-	0x19, 0x9e, 0x4a, 0xd9, 0xa8, 0x0b, 0x9d, 0xcc, 0xab, 0xb3, 0x9b, 0x84, 0x38, 0x7f, 0xa8, 0x40,
-	0xa7, 0xe8, 0x3a, 0xfa, 0x18, 0xac, 0xcb, 0x88, 0x89, 0xd4, 0x8b, 0xdd, 0x39, 0xe5, 0x82, 0xdb,
-	0x15, 0xe5, 0xe6, 0xbd, 0xb2, 0x9b, 0xe7, 0x1a, 0x32, 0xa1, 0x5c, 0xe0, 0xce, 0xe5, 0x8a, 0xe0,
-	0x68, 0x02, 0xbd, 0x2c, 0x50, 0xae, 0xa9, 0x35, 0x15, 0xf1, 0xf6, 0xfe, 0xf7, 0xd7, 0x97, 0xd3,
-	0x54, 0x83, 0xf0, 0x76, 0x5c, 0x66, 0x38, 0xff, 0xae, 0x40, 0xbb, 0x70, 0xce, 0xda, 0xdc, 0xda,
-	0xb0, 0x15, 0xd0, 0x85, 0xa7, 0x0f, 0xa9, 0xed, 0xb5, 0x70, 0x46, 0xa2, 0xf7, 0xa0, 0xc1, 0x68,
-	0x2a, 0x08, 0xb7, 0x6b, 0xea, 0x02, 0x6f, 0x95, 0x4f, 0xc7, 0x52, 0x86, 0x0d, 0x04, 0x61, 0xe8,
-	0x17, 0x2f, 0x9d, 0x3b, 0xae, 0x33, 0xbd, 0xfb, 0xad, 0x77, 0xcf, 0x7c, 0x47, 0x97, 0xdf, 0xe0,
-	0xa1, 0x0f, 0xa1, 0xed, 0x53, 0xc6, 0xdd, 0x84, 0xc6, 0x91, 0x7f, 0x63, 0xd7, 0x95, 0x29, 0xbb,
-	0x6c, 0xea, 0x90, 0x32, 0x3e, 0x55, 0x72, 0x0c, 0x7e, 0xfe, 0xed, 0xfc, 0xb3, 0x0a, 0x75, 0xe5,
-	0x20, 0x1a, 0xc2, 0xd6, 0xc2, 0x13, 0xfe, 0x9c, 0x30, 0x75, 0xed, 0xf6, 0xfe, 0xdd, 0xb2, 0x81,
-	0x63, 0x2d, 0xc4, 0x19, 0x0a, 0x7d, 0x0c, 0x1d, 0x75, 0x27, 0xd7, 0xf3, 0x65, 0xd1, 0x98, 0xd0,
-	0xdf, 0x5b, 0x73, 0xf9, 0x03, 0x05, 0x98, 0x6c, 0xe0, 0x36, 0x5b, 0x91, 0xe8, 0x39, 0x6c, 0x33,
-	0x12, 0x44, 0x8c, 0xf8, 0x22, 0x33, 0x51, 0x53, 0x26, 0xbe, 0x77, 0xcb, 0x84, 0x01, 0xe5, 0x56,
-	0xba, 0xac, 0xc4, 0x41, 0x9f, 0xc3, 0x8e, 0x31, 0xc3, 0x08, 0x4f, 0xe8, 0x92, 0xe7, 0x2e, 0xe9,
-	0xa0, 0x3a, 0x65, 0x7b, 0x47, 0x0a, 0x8b, 0x0d, 0x34, 0xb7, 0xda, 0x0f, 0xd6, 0xf0, 0xd1, 0x2f,
-	0xc0, 0xd2, 0x97, 0xcc, 0xf2, 0x54, 0x5f, 0xd7, 0x91, 0xea, 0x96, 0x59, 0x86, 0x74, 0x54, 0x0c,
-	0x35, 0x6a, 0x42, 0x43, 0x3b, 0xe3, 0xfc, 0xb6, 0x0a, 0x5b, 0x26, 0x88, 0xc8, 0x86, 0x46, 0xc2,
-	0xc8, 0x2c, 0xba, 0xd6, 0x25, 0x36, 0xd9, 0xc0, 0x86, 0x46, 0x3b, 0x50, 0x27, 0xd7, 0x9e, 0x2f,
-	0xf4, 0xec, 0x98, 0x6c, 0x60, 0x4d, 0x4a, 0x3e, 0x23, 0x21, 0xb9, 0x56, 0x31, 0x52, 0x7c, 0x45,
-	0xa2, 0x9f, 0xc0, 0xd6, 0x9c, 0x78, 0x81, 0x1c, 0xa5, 0x0d, 0x55, 0x7d, 0xef, 0xdc, 0x1a, 0x16,
-	0x4a, 0x98, 0x27, 0xcf, 0x60, 0xd1, 0x0b, 0xe8, 0x7d, 0x95, 0x12, 0x76, 0xe3, 0x26, 0x1e, 0xf3,
-	0x16, 0x44, 0x48, 0xfd, 0x2d, 0xa5, 0xff, 0xa8, 0xac, 0xff, 0x6b, 0x89, 0x9a, 0x66, 0xa0, 0xcc,
-	0xce, 0xf6, 0x57, 0x25, 0x36, 0x97, 0xdd, 0xb1, 0x20, 0x62, 0x4e, 0x03, 0x6e, 0x37, 0x75, 0x77,
-	0x18, 0x72, 0xd4, 0x83, 0x6e, 0xe2, 0x89, 0xb9, 0xcb, 0x13, 0xe2, 0x47, 0xb3, 0x88, 0x30, 0xe7,
-	0x04, 0xac, 0x92, 0x57, 0x6b, 0xdb, 0xad, 0x0f, 0xf5, 0x4b, 0x39, 0x55, 0xcc, 0x0c, 0xd5, 0x84,
-	0xe4, 0xae, 0xa2, 0xd0, 0x34, 0x31, 0x70, 0x3e, 0x83, 0xbb, 0x6b, 0xdd, 0xfc, 0x9f, 0x0d, 0xff,
-	0xad, 0x02, 0xed, 0x42, 0x05, 0xa3, 0xf7, 0xa1, 0xc1, 0xa3, 0x65, 0x18, 0x13, 0xd3, 0x22, 0xb7,
-	0x8a, 0xfd, 0x88, 0x70, 0x11, 0x2d, 0x3d, 0x53, 0x50, 0x06, 0x8a, 0x9e, 0x41, 0x7d, 0x91, 0xc6,
-	0x22, 0x32, 0x0d, 0xf2, 0xe0, 0x56, 0x5b, 0x49, 0x51, 0x59, 0x51, 0xc3, 0xd1, 0x08, 0xba, 0x69,
-	0xc2, 0x05, 0x23, 0xde, 0xc2, 0x0d, 0x19, 0x4d, 0x13, 0xd3, 0x1e, 0xf7, 0xca, 0xdb, 0x0a, 0x13,
-	0x4e, 0x53, 0xe6, 0x13, 0x4c, 0x66, 0x93, 0x0d, 0x6c, 0x65, 0x2a, 0xcf, 0xa5, 0xc6, 0xc8, 0x82,
-	0x76, 0xb0, 0xb2, 0xed, 0xfc, 0xae, 0x0a, 0xed, 0xc2, 0x59, 0xe8, 0xa7, 0xd0, 0xcc, 0xf0, 0x36,
-	0xbc, 0xda, 0x78, 0x0e, 0x46, 0x3f, 0x87, 0x2d, 0x4e, 0xd8, 0x65, 0xe4, 0x13, 0xbb, 0xbd, 0x6e,
-	0x70, 0x9d, 0x6a, 0x61, 0xf9, 0x5e, 0x99, 0x8a, 0x1c, 0xdc, 0x05, 0xaf, 0x54, 0x65, 0xac, 0x1f,
-	0xdc, 0x05, 0xfd, 0xd3, 0x84, 0xf8, 0x78, 0x3b, 0x28, 0x33, 0xd0, 0x13, 0x68, 0xe8, 0x07, 0x8a,
-	0x89, 0x4d, 0xff, 0x96, 0x1b, 0x4a, 0x86, 0x0d, 0x66, 0x84, 0xca, 0xe7, 0x0a, 0xb9, 0x95, 0x7e,
-	0x03, 0xe8, 0x9b, 0xce, 0xa2, 0xa7, 0x50, 0x63, 0x64, 0x96, 0x67, 0xf9, 0xdb, 0x62, 0x62, 0x5e,
-	0x08, 0x12, 0x2b, 0x6b, 0x4d, 0xed, 0xf4, 0xaa, 0xda, 0xe9, 0xea, 0xdb, 0xf9, 0x7b, 0x05, 0xac,
-	0x4f, 0x8b, 0x09, 0x41, 0x63, 0xe8, 0x14, 0x5c, 0xc8, 0x56, 0xde, 0xbb, 0x65, 0xb7, 0x3f, 0x23,
-	0x51, 0x38, 0x17, 0x24, 0x28, 0x78, 0x84, 0x4b, 0x6a, 0xdf, 0x85, 0xc7, 0xd0, 0x4b, 0xe8, 0xdd,
-	0xae, 0xdd, 0xff, 0xd3, 0xed, 0x9c, 0x2f, 0xe0, 0xad, 0x35, 0x20, 0xf4, 0xb3, 0x52, 0x31, 0xbf,
-	0xb2, 0x05, 0x71, 0x11, 0x8d, 0x76, 0xa0, 0x71, 0xa5, 0x6c, 0x9a, 0x04, 0x19, 0xca, 0xf9, 0x53,
-	0x0d, 0xba, 0xe5, 0x0d, 0x83, 0x1e, 0x81, 0xa5, 0x56, 0x73, 0xb6, 0x66, 0xcc, 0xf8, 0xe8, 0x48,
-	0x66, 0x06, 0x45, 0x8f, 0xc1, 0x52, 0x63, 0x2d, 0x07, 0x65, 0xf3, 0xba, 0x23, 0xd9, 0x39, 0xec,
-	0x07, 0xd0, 0xd5, 0x83, 0xdd, 0x65, 0xe4, 0x8a, 0x45, 0x82, 0xa8, 0x05, 0x22, 0x71, 0x96, 0xe6,
-	0x63, 0xcd, 0x46, 0xe7, 0x60, 0xe5, 0xdb, 0xcb, 0xa7, 0x01, 0x51, 0x05, 0xdd, 0xdd, 0x7f, 0xfa,
-	0xdf, 0x76, 0x61, 0x4e, 0x66, 0x4b, 0xeb, 0x90, 0x06, 0x04, 0x77, 0x58, 0x81, 0x42, 0x8f, 0xa1,
-	0x2b, 0xdf, 0x87, 0x7c, 0xe5, 0xe8, 0xa6, 0x9a, 0x70, 0xea, 0xa1, 0xc9, 0x73, 0x3f, 0x1f, 0x42,
-	0x9b, 0x0b, 0x16, 0x25, 0xae, 0x1a, 0xec, 0xaa, 0xaa, 0x9a, 0x18, 0x14, 0x4b, 0x8d, 0x56, 0xe7,
-	0x0a, 0xfa, 0xeb, 0x4e, 0x43, 0x77, 0xe1, 0xce, 0xf1, 0xc9, 0xf9, 0xf8, 0xc8, 0x9d, 0x8e, 0xf1,
-	0xf1, 0xc1, 0x8b, 0xf1, 0x8b, 0xb3, 0x4f, 0x5e, 0xf6, 0x36, 0x50, 0x0b, 0xea, 0xbf, 0x3c, 0xf9,
-	0xf4, 0xc5, 0x51, 0xaf, 0x82, 0x2c, 0x68, 0x9d, 0x8e, 0xc7, 0xee, 0xc9, 0xd9, 0x64, 0x8c, 0x7b,
-	0x55, 0xb4, 0x03, 0xe8, 0x6c, 0x7c, 0x3c, 0x3d, 0xc1, 0x07, 0xf8, 0xa5, 0x8b, 0xc7, 0x47, 0xbf,
-	0xc2, 0xe3, 0xc3, 0xb3, 0x5e, 0x4d, 0xf2, 0x73, 0x13, 0x2b, 0xfe, 0xe6, 0xc8, 0x86, 0x1d, 0x13,
-	0x68, 0x15, 0xa8, 0xc2, 0x1e, 0x19, 0x41, 0x7f, 0xdd, 0x2e, 0x97, 0xa9, 0x36, 0xcd, 0x51, 0xd1,
-	0xa9, 0x36, 0xb5, 0x8e, 0x60, 0xf3, 0x82, 0x06, 0x37, 0x66, 0xf0, 0xab, 0x6f, 0xe7, 0xf7, 0x55,
-	0x80, 0xd5, 0xd3, 0x48, 0x3e, 0xe0, 0xbd, 0x38, 0xa6, 0x57, 0x2e, 0x65, 0x51, 0x18, 0x2d, 0x55,
-	0x01, 0xb7, 0x70, 0x5b, 0xf1, 0x4e, 0x14, 0x0b, 0x3d, 0x01, 0x54, 0x84, 0xb8, 0x7a, 0x6d, 0xe8,
-	0x27, 0x61, 0xaf, 0x00, 0xc4, 0x6a, 0x3d, 0x3f, 0x02, 0x4b, 0xa3, 0xb3, 0xed, 0x58, 0x53, 0x40,
-	0x7d, 0xca, 0xb1, 0xe6, 0xad, 0x40, 0xd9, 0x26, 0xdf, 0x2c, 0x80, 0x26, 0x66, 0x63, 0x3f, 0x86,
-	0x2e, 0xb9, 0x4e, 0x28, 0x27, 0x39, 0xaa, 0xae, 0x50, 0x96, 0xe6, 0x66, 0xb0, 0xb7, 0xe5, 0x33,
-	0xee, 0xda, 0xf5, 0x42, 0xa2, 0x92, 0xd8, 0xc2, 0x8d, 0x85, 0x77, 0x7d, 0x10, 0x12, 0xf4, 0x1e,
-	0xdc, 0xd1, 0x87, 0xf8, 0x8c, 0x04, 0x64, 0x29, 0x22, 0x2f, 0xe6, 0xaa, 0xe5, 0x9b, 0xc6, 0xed,
-	0xc3, 0x15, 0x7f, 0xf4, 0xec, 0x8f, 0xff, 0x78, 0x50, 0xf9, 0xfc, 0x47, 0xaf, 0xf7, 0x77, 0x2f,
-	0xf9, 0x32, 0x34, 0x7f, 0xf9, 0x2e, 0x1a, 0xea, 0x6f, 0xc1, 0xfb, 0xff, 0x09, 0x00, 0x00, 0xff,
-	0xff, 0x01, 0x95, 0xd7, 0xba, 0xac, 0x0f, 0x00, 0x00,
+	0x7c, 0x00, 0x2e, 0x39, 0xf0, 0x09, 0x28, 0x2e, 0x5c, 0xa9, 0xfe, 0x33, 0xa3, 0x19, 0x67, 0x60,
+	0x77, 0x0b, 0x0e, 0x9c, 0x34, 0xef, 0xbd, 0xdf, 0x7b, 0xfd, 0xfe, 0xf6, 0x6b, 0xc1, 0x93, 0x30,
+	0x12, 0xb3, 0xf4, 0xa2, 0xef, 0xd3, 0xf9, 0x80, 0xd3, 0x98, 0xfe, 0x20, 0xa2, 0x83, 0x30, 0xa6,
+	0x74, 0x90, 0x30, 0xfa, 0x15, 0xf1, 0x05, 0xd7, 0x94, 0x97, 0x44, 0x83, 0xcb, 0x47, 0x92, 0x79,
+	0x7d, 0xd3, 0x4f, 0x18, 0x15, 0x14, 0xb5, 0xa5, 0xa0, 0x2f, 0x75, 0xfa, 0x11, 0xdd, 0xb9, 0x1f,
+	0x52, 0x1a, 0xc6, 0x64, 0xa0, 0x64, 0x17, 0xe9, 0x74, 0x70, 0xc5, 0xbc, 0x24, 0x21, 0x8c, 0x6b,
+	0xf4, 0x4e, 0x2f, 0xa4, 0x21, 0x55, 0x9f, 0x03, 0xf9, 0x65, 0xb8, 0x8f, 0x2a, 0x4e, 0x57, 0xbf,
+	0xaf, 0x22, 0x91, 0x9d, 0x39, 0x27, 0xc2, 0x0b, 0x3c, 0xe1, 0x19, 0x95, 0xc1, 0x1b, 0xa8, 0x70,
+	0xe1, 0x89, 0x34, 0x3b, 0xf9, 0xe1, 0x1b, 0x28, 0x30, 0x32, 0x35, 0xe8, 0xc7, 0x6f, 0x95, 0x0f,
+	0xce, 0x63, 0xa3, 0xf7, 0xc9, 0xdb, 0xe9, 0xa5, 0x17, 0x9c, 0x08, 0xa3, 0xfa, 0xf4, 0xed, 0x4a,
+	0x10, 0xa7, 0x61, 0xb4, 0x30, 0xc1, 0x39, 0x7f, 0xb2, 0xa0, 0x3e, 0x91, 0x45, 0x41, 0x3f, 0x82,
+	0x8d, 0x38, 0xe2, 0x82, 0x2c, 0x08, 0xe3, 0xf6, 0xea, 0x6e, 0x6d, 0xaf, 0xb5, 0xbf, 0xdd, 0x2f,
+	0x96, 0xa8, 0xff, 0x99, 0x11, 0xe3, 0x25, 0x10, 0x3d, 0x87, 0x86, 0x4e, 0x96, 0xdd, 0xd8, 0xb5,
+	0xf6, 0x5a, 0xfb, 0xbd, 0xbe, 0x4f, 0x19, 0xc9, 0x55, 0x4e, 0x95, 0x6c, 0x78, 0xef, 0xcf, 0xdf,
+	0x3c, 0x58, 0xf9, 0xc7, 0x37, 0x0f, 0xee, 0x08, 0xc2, 0x45, 0x10, 0x4d, 0xa7, 0x4f, 0x9d, 0x28,
+	0x5c, 0x50, 0x46, 0x1c, 0x6c, 0xd4, 0xd1, 0x13, 0x68, 0x66, 0x85, 0xb2, 0xd7, 0x95, 0xa9, 0xed,
+	0xb2, 0xa9, 0x63, 0x23, 0x1d, 0xae, 0x49, 0x63, 0x38, 0x47, 0x3b, 0x7f, 0x5c, 0x85, 0x66, 0xe6,
+	0x1a, 0x42, 0xb0, 0xb6, 0xf0, 0xe6, 0xc4, 0xb6, 0x76, 0xad, 0xbd, 0x0d, 0xac, 0xbe, 0xd1, 0xfb,
+	0xd0, 0xbe, 0x88, 0x16, 0x81, 0xeb, 0x05, 0x01, 0x23, 0x5c, 0x06, 0x27, 0x65, 0x2d, 0xc9, 0x3b,
+	0xd0, 0x2c, 0xf4, 0x1e, 0x6c, 0x28, 0x48, 0x42, 0x99, 0xb0, 0x6b, 0xbb, 0xd6, 0x5e, 0x07, 0x37,
+	0x25, 0x63, 0x42, 0x99, 0x40, 0x07, 0xd0, 0x99, 0x09, 0x91, 0xb8, 0x59, 0xd4, 0xf6, 0x9a, 0xf2,
+	0x6f, 0xa7, 0x9c, 0x9d, 0xb1, 0x10, 0x49, 0xe6, 0xc6, 0x78, 0x05, 0xb7, 0x67, 0x05, 0x1a, 0x1d,
+	0xc1, 0x1d, 0xce, 0x63, 0xd7, 0xa7, 0x8b, 0x69, 0x14, 0xa6, 0x9e, 0x88, 0xe8, 0x82, 0xdb, 0x75,
+	0x95, 0xe4, 0x77, 0xcb, 0x66, 0x4e, 0x79, 0x7c, 0xa8, 0x50, 0xb8, 0xcb, 0xb3, 0x4f, 0xa3, 0x80,
+	0x86, 0xb0, 0x95, 0x72, 0xe2, 0xaa, 0x21, 0x72, 0x55, 0xfd, 0x4c, 0xd6, 0x77, 0xfa, 0x7a, 0x7a,
+	0xfa, 0xd9, 0xf4, 0xf4, 0x87, 0x94, 0xc6, 0xe7, 0x5e, 0x9c, 0x12, 0xdc, 0x49, 0x39, 0x51, 0x15,
+	0x9e, 0x48, 0xd9, 0x70, 0x13, 0xda, 0x99, 0x57, 0x67, 0x37, 0x09, 0x71, 0x7e, 0x67, 0x41, 0xbb,
+	0xe8, 0x3a, 0x7a, 0x06, 0x9d, 0xcb, 0x88, 0x89, 0xd4, 0x8b, 0xdd, 0x19, 0xe5, 0x82, 0xdb, 0x96,
+	0x72, 0xf3, 0x5e, 0xd9, 0xcd, 0x73, 0x0d, 0x19, 0x53, 0x2e, 0x70, 0xfb, 0x72, 0x49, 0x70, 0x34,
+	0x86, 0x6e, 0x96, 0x28, 0xd7, 0xf4, 0x9a, 0xca, 0x78, 0x6b, 0xff, 0xbb, 0xd5, 0xed, 0x34, 0xd1,
+	0x20, 0xbc, 0x15, 0x97, 0x19, 0xce, 0x3f, 0x2d, 0x68, 0x15, 0xce, 0xa9, 0xac, 0xad, 0x0d, 0xeb,
+	0x01, 0x9d, 0x7b, 0xfa, 0x90, 0xda, 0xde, 0x06, 0xce, 0x48, 0xf4, 0x11, 0x34, 0x18, 0x4d, 0x05,
+	0xe1, 0x76, 0x4d, 0x05, 0xf0, 0x4e, 0xf9, 0x74, 0x2c, 0x65, 0xd8, 0x40, 0x10, 0x86, 0x5e, 0x31,
+	0xe8, 0xdc, 0x71, 0x5d, 0xe9, 0xdd, 0x7f, 0x1b, 0x7b, 0xe6, 0x3b, 0xba, 0xfc, 0x16, 0x0f, 0x7d,
+	0x02, 0x2d, 0x9f, 0x32, 0xee, 0x26, 0x34, 0x8e, 0xfc, 0x1b, 0xbb, 0xae, 0x4c, 0xd9, 0x65, 0x53,
+	0x87, 0x94, 0xf1, 0x89, 0x92, 0x63, 0xf0, 0xf3, 0x6f, 0xe7, 0xef, 0x35, 0xa8, 0x2b, 0x07, 0xd1,
+	0x00, 0xd6, 0xe7, 0x9e, 0xf0, 0x67, 0x84, 0xa9, 0xb0, 0x5b, 0xfb, 0x77, 0xcb, 0x06, 0x8e, 0xb5,
+	0x10, 0x67, 0x28, 0xf4, 0x0c, 0xda, 0x2a, 0x26, 0xd7, 0xf3, 0x65, 0xd3, 0x98, 0xd4, 0xdf, 0xab,
+	0x08, 0xfe, 0x40, 0x01, 0xc6, 0x2b, 0xb8, 0xc5, 0x96, 0x24, 0x7a, 0x0e, 0x5b, 0x8c, 0x04, 0x11,
+	0x23, 0xbe, 0xc8, 0x4c, 0xd4, 0x94, 0x89, 0xef, 0xdc, 0x32, 0x61, 0x40, 0xb9, 0x95, 0x4d, 0x56,
+	0xe2, 0xa0, 0x2f, 0x61, 0xdb, 0x98, 0x61, 0x84, 0x27, 0x74, 0xc1, 0x73, 0x97, 0x74, 0x52, 0x9d,
+	0xb2, 0xbd, 0x23, 0x85, 0xc5, 0x06, 0x9a, 0x5b, 0xed, 0x05, 0x15, 0x7c, 0xf4, 0x73, 0xe8, 0xe8,
+	0x20, 0xb3, 0x3a, 0xd5, 0xab, 0x26, 0x52, 0x45, 0x99, 0x55, 0xa8, 0xcd, 0x0a, 0x14, 0xfa, 0x14,
+	0x5a, 0xde, 0x62, 0x41, 0x85, 0x99, 0xc4, 0x86, 0xea, 0x90, 0xef, 0x55, 0xa8, 0xf7, 0x0f, 0x96,
+	0xb0, 0xd1, 0x42, 0xb0, 0x1b, 0x5c, 0x54, 0xdc, 0x79, 0x06, 0xdd, 0xdb, 0x00, 0xd4, 0x85, 0xda,
+	0x2b, 0x72, 0x63, 0xba, 0x54, 0x7e, 0xa2, 0x1e, 0xd4, 0x2f, 0xe5, 0x2c, 0x9a, 0x9b, 0x47, 0x13,
+	0x4f, 0x57, 0x9f, 0x58, 0xc3, 0x26, 0x34, 0x74, 0x52, 0x9c, 0x5f, 0xaf, 0xc2, 0xba, 0x29, 0x26,
+	0xb2, 0xa1, 0x91, 0x30, 0x32, 0x8d, 0xae, 0xb5, 0x91, 0xf1, 0x0a, 0x36, 0x34, 0xda, 0x86, 0x3a,
+	0xb9, 0xf6, 0x7c, 0xa1, 0x2d, 0x8d, 0x57, 0xb0, 0x26, 0x25, 0x9f, 0x91, 0x90, 0x5c, 0xdb, 0xb5,
+	0x8c, 0xaf, 0x48, 0xf4, 0x63, 0x58, 0x9f, 0x11, 0x2f, 0x20, 0x2c, 0x8b, 0xf1, 0xbd, 0x5b, 0x97,
+	0x96, 0x12, 0xe6, 0x4d, 0x64, 0xb0, 0xe8, 0x05, 0x74, 0xbf, 0x4e, 0x09, 0xbb, 0x71, 0x13, 0x8f,
+	0x79, 0x73, 0x22, 0xa4, 0xfe, 0xba, 0xd2, 0xff, 0xa0, 0xac, 0xff, 0x4b, 0x89, 0x9a, 0x64, 0xa0,
+	0xcc, 0xce, 0xd6, 0xd7, 0x25, 0x36, 0x97, 0x53, 0x3a, 0x27, 0x62, 0x46, 0x03, 0x6e, 0x37, 0xf5,
+	0x94, 0x1a, 0x72, 0xd8, 0x85, 0xcd, 0xc4, 0x13, 0x33, 0x97, 0x27, 0xc4, 0x8f, 0xa6, 0x11, 0x61,
+	0xce, 0x09, 0x74, 0x4a, 0x5e, 0x55, 0x8e, 0x7d, 0x65, 0x46, 0x25, 0x77, 0x99, 0x85, 0xa6, 0xc9,
+	0x81, 0xf3, 0x05, 0xdc, 0xad, 0x74, 0xf3, 0xbf, 0x36, 0xfc, 0x17, 0x0b, 0x5a, 0x85, 0x49, 0x42,
+	0x1f, 0x43, 0x83, 0x47, 0x8b, 0x30, 0x26, 0xb6, 0x55, 0x35, 0x74, 0x47, 0x84, 0x8b, 0x68, 0xe1,
+	0x99, 0xc6, 0x36, 0x50, 0xf4, 0x18, 0xea, 0xf3, 0x34, 0x16, 0x91, 0x19, 0xd4, 0xfb, 0xb7, 0xc6,
+	0x5b, 0x8a, 0xca, 0x8a, 0x1a, 0x8e, 0x86, 0xb0, 0x99, 0x26, 0x5c, 0x30, 0xe2, 0xcd, 0xdd, 0x90,
+	0xd1, 0x34, 0x31, 0x63, 0x7a, 0xaf, 0xbc, 0x35, 0x31, 0xe1, 0x34, 0x65, 0x3e, 0xc1, 0x64, 0x3a,
+	0x5e, 0xc1, 0x9d, 0x4c, 0xe5, 0xb9, 0xd4, 0x18, 0x76, 0xa0, 0x15, 0x2c, 0x6d, 0x3b, 0xbf, 0x59,
+	0x85, 0x56, 0xe1, 0x2c, 0xf4, 0x13, 0x68, 0x66, 0x78, 0x1b, 0x5e, 0x6f, 0x3c, 0x07, 0xa3, 0x9f,
+	0xc1, 0x3a, 0x27, 0xec, 0x32, 0xf2, 0x89, 0xdd, 0xaa, 0xba, 0x40, 0x4f, 0xb5, 0xb0, 0x1c, 0x57,
+	0xa6, 0x22, 0x17, 0x48, 0xc1, 0x2b, 0xd5, 0x19, 0xd5, 0x0b, 0xa4, 0xa0, 0x7f, 0x9a, 0x10, 0x1f,
+	0x6f, 0x05, 0x65, 0x06, 0x7a, 0x08, 0x0d, 0xfd, 0x50, 0x32, 0xb9, 0xe9, 0xdd, 0x72, 0x43, 0xc9,
+	0xb0, 0xc1, 0x0c, 0x51, 0xf9, 0x5c, 0x21, 0xb7, 0xe3, 0xaf, 0x00, 0x7d, 0xdb, 0x59, 0xf4, 0x08,
+	0x6a, 0x8c, 0x4c, 0x6d, 0xeb, 0x35, 0x39, 0x31, 0x2f, 0x15, 0x89, 0x95, 0xbd, 0xa6, 0xde, 0x16,
+	0xab, 0xea, 0x6d, 0xa1, 0xbe, 0x9d, 0xbf, 0x5a, 0xd0, 0xf9, 0xbc, 0x58, 0x10, 0x34, 0x82, 0x76,
+	0xc1, 0x85, 0x6c, 0xf5, 0xbe, 0x5f, 0x76, 0xfb, 0x0b, 0x12, 0x85, 0x33, 0x41, 0x82, 0x82, 0x47,
+	0xb8, 0xa4, 0xf6, 0xff, 0xf0, 0x28, 0x7b, 0x09, 0xdd, 0xdb, 0xbd, 0xfb, 0x3f, 0x8a, 0xce, 0xf9,
+	0x0a, 0xde, 0xa9, 0x00, 0xa1, 0x9f, 0x96, 0x9a, 0xf9, 0xb5, 0x23, 0x88, 0x8b, 0x68, 0xb4, 0x0d,
+	0x8d, 0x2b, 0x65, 0xd3, 0x14, 0xc8, 0x50, 0xce, 0x1f, 0x6a, 0xb0, 0x59, 0xde, 0x74, 0xe8, 0x03,
+	0xe8, 0xa8, 0x27, 0x42, 0xb6, 0xee, 0xcc, 0xf5, 0xd1, 0x96, 0xcc, 0x0c, 0x8a, 0x3e, 0x84, 0x8e,
+	0xba, 0xd6, 0x72, 0x50, 0x76, 0x5f, 0xb7, 0x25, 0x3b, 0x87, 0x7d, 0x1f, 0x36, 0xf5, 0xc5, 0xee,
+	0x32, 0x72, 0xc5, 0x22, 0x41, 0xec, 0xba, 0xc1, 0x75, 0x34, 0x1f, 0x6b, 0x36, 0x3a, 0x87, 0x4e,
+	0xbe, 0x45, 0x7d, 0x1a, 0x10, 0xd5, 0xd0, 0x9b, 0xfb, 0x8f, 0xfe, 0xd3, 0x4e, 0xce, 0xc9, 0x6c,
+	0x79, 0x1e, 0xd2, 0x80, 0xe0, 0x36, 0x2b, 0x50, 0xe8, 0x43, 0xd8, 0x94, 0xef, 0x54, 0xbe, 0x74,
+	0x74, 0x4d, 0xdd, 0x70, 0xea, 0xc1, 0xcb, 0x73, 0x3f, 0x1f, 0x40, 0x8b, 0x0b, 0x16, 0x25, 0xae,
+	0xba, 0xd8, 0x55, 0x57, 0x35, 0x31, 0x28, 0x96, 0xba, 0x5a, 0x9d, 0x2b, 0xe8, 0x55, 0x9d, 0x86,
+	0xee, 0xc2, 0x9d, 0xe3, 0x93, 0xf3, 0xd1, 0x91, 0x3b, 0x19, 0xe1, 0xe3, 0x83, 0x17, 0xa3, 0x17,
+	0x67, 0x9f, 0xbd, 0xec, 0xae, 0xa0, 0x0d, 0xa8, 0x7f, 0x7a, 0xf2, 0xf9, 0x8b, 0xa3, 0xae, 0x85,
+	0x3a, 0xb0, 0x71, 0x3a, 0x1a, 0xb9, 0x27, 0x67, 0xe3, 0x11, 0xee, 0xae, 0xa2, 0x6d, 0x40, 0x67,
+	0xa3, 0xe3, 0xc9, 0x09, 0x3e, 0xc0, 0x2f, 0x5d, 0x3c, 0x3a, 0xfa, 0x05, 0x1e, 0x1d, 0x9e, 0x75,
+	0x6b, 0x92, 0x9f, 0x9b, 0x58, 0xf2, 0xd7, 0x86, 0x36, 0x6c, 0x9b, 0x44, 0xab, 0x44, 0x15, 0xf6,
+	0xc8, 0x10, 0x7a, 0x55, 0x6f, 0x0a, 0x59, 0x6a, 0x33, 0x1c, 0x96, 0x2e, 0xb5, 0xa6, 0xe4, 0x84,
+	0x5e, 0xd0, 0xe0, 0xc6, 0x5c, 0xfc, 0xea, 0xdb, 0xf9, 0xed, 0x2a, 0xc0, 0xf2, 0x89, 0x26, 0xff,
+	0x48, 0x78, 0x71, 0x4c, 0xaf, 0x5c, 0xca, 0xa2, 0x30, 0x5a, 0xa8, 0x06, 0xde, 0xc0, 0x2d, 0xc5,
+	0x3b, 0x51, 0x2c, 0xf4, 0x10, 0x50, 0x11, 0xe2, 0xea, 0xb5, 0xa1, 0x9f, 0xa6, 0xdd, 0x02, 0x10,
+	0x4b, 0xbe, 0xec, 0x25, 0x8d, 0xce, 0xb6, 0x63, 0x4d, 0x01, 0xf5, 0x29, 0xc7, 0x9a, 0xb7, 0x04,
+	0x65, 0x9b, 0x7c, 0xad, 0x00, 0xd2, 0xab, 0x92, 0xcb, 0x42, 0x92, 0xeb, 0x84, 0x72, 0x92, 0xa3,
+	0xea, 0x0a, 0xd5, 0xd1, 0xdc, 0x0c, 0xf6, 0xae, 0x7c, 0x4e, 0x5e, 0xbb, 0x5e, 0x48, 0x54, 0x11,
+	0x37, 0x70, 0x63, 0xee, 0x5d, 0x1f, 0x84, 0x04, 0x7d, 0x04, 0x77, 0xf4, 0x21, 0x3e, 0x23, 0x01,
+	0x59, 0x88, 0xc8, 0x8b, 0xb9, 0x1a, 0xf9, 0xa6, 0x71, 0xfb, 0x70, 0xc9, 0x1f, 0x3e, 0xfe, 0xfd,
+	0xdf, 0xee, 0x5b, 0x5f, 0xfe, 0xf0, 0xcd, 0xfe, 0x76, 0x26, 0xaf, 0x42, 0xf3, 0xd7, 0xf3, 0xa2,
+	0xa1, 0xfe, 0x9e, 0x7c, 0xfc, 0xaf, 0x01, 0x00, 0x5e, 0x7b, 0x80, 0x5e, 0x34, 0x10, 0x00, 0x00,
 }
 
 func (this *Proxy) Equal(that interface{}) bool {
@@ -2231,6 +2245,14 @@ func (this *Route) Equal(that interface{}) bool {
 	if !this.RoutePlugins.Equal(that1.RoutePlugins) {
 		return false
 	}
+	if len(this.Annotations) != len(that1.Annotations) {
+		return false
+	}
+	for i := range this.Annotations {
+		if this.Annotations[i] != that1.Annotations[i] {
+			return false
+		}
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -44,6 +44,8 @@ type Secret struct {
 	//	*Secret_Azure
 	//	*Secret_Tls
 	//	*Secret_Extension
+	//	*Secret_Alibaba
+	//	*Secret_Openwhisk
 	Kind isSecret_Kind `protobuf_oneof:"kind"`
 	// Metadata contains the object metadata for this resource
 	Metadata             core.Metadata `protobuf:"bytes,7,opt,name=metadata,proto3" json:"metadata"`
@@ -93,11 +95,19 @@ type Secret_Tls struct {
 type Secret_Extension struct {
 	Extension *Extension `protobuf:"bytes,4,opt,name=extension,proto3,oneof"`
 }
+type Secret_Alibaba struct {
+	Alibaba *AlibabaSecret `protobuf:"bytes,5,opt,name=alibaba,proto3,oneof"`
+}
+type Secret_Openwhisk struct {
+	Openwhisk *OpenWhiskSecret `protobuf:"bytes,6,opt,name=openwhisk,proto3,oneof"`
+}
 
 func (*Secret_Aws) isSecret_Kind()       {}
 func (*Secret_Azure) isSecret_Kind()     {}
 func (*Secret_Tls) isSecret_Kind()       {}
 func (*Secret_Extension) isSecret_Kind() {}
+func (*Secret_Alibaba) isSecret_Kind()   {}
+func (*Secret_Openwhisk) isSecret_Kind() {}
 
 func (m *Secret) GetKind() isSecret_Kind {
 	if m != nil {
@@ -134,6 +144,20 @@ func (m *Secret) GetExtension() *Extension {
 	return nil
 }
 
+func (m *Secret) GetAlibaba() *AlibabaSecret {
+	if x, ok := m.GetKind().(*Secret_Alibaba); ok {
+		return x.Alibaba
+	}
+	return nil
+}
+
+func (m *Secret) GetOpenwhisk() *OpenWhiskSecret {
+	if x, ok := m.GetKind().(*Secret_Openwhisk); ok {
+		return x.Openwhisk
+	}
+	return nil
+}
+
 func (m *Secret) GetMetadata() core.Metadata {
 	if m != nil {
 		return m.Metadata
@@ -148,6 +172,8 @@ func (*Secret) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error,
 		(*Secret_Azure)(nil),
 		(*Secret_Tls)(nil),
 		(*Secret_Extension)(nil),
+		(*Secret_Alibaba)(nil),
+		(*Secret_Openwhisk)(nil),
 	}
 }
 
@@ -175,6 +201,16 @@ func _Secret_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
 		if err := b.EncodeMessage(x.Extension); err != nil {
 			return err
 		}
+	case *Secret_Alibaba:
+		_ = b.EncodeVarint(5<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Alibaba); err != nil {
+			return err
+		}
+	case *Secret_Openwhisk:
+		_ = b.EncodeVarint(6<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Openwhisk); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("Secret.Kind has unexpected type %T", x)
@@ -217,6 +253,22 @@ func _Secret_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer)
 		err := b.DecodeMessage(msg)
 		m.Kind = &Secret_Extension{msg}
 		return true, err
+	case 5: // kind.alibaba
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(AlibabaSecret)
+		err := b.DecodeMessage(msg)
+		m.Kind = &Secret_Alibaba{msg}
+		return true, err
+	case 6: // kind.openwhisk
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(OpenWhiskSecret)
+		err := b.DecodeMessage(msg)
+		m.Kind = &Secret_Openwhisk{msg}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -246,6 +298,16 @@ func _Secret_OneofSizer(msg proto.Message) (n int) {
 		n += 1 // tag and wire
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *Secret_Alibaba:
+		s := proto.Size(x.Alibaba)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Secret_Openwhisk:
+		s := proto.Size(x.Openwhisk)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -300,10 +362,15 @@ func (m *AwsSecret) GetSecretKey() string {
 }
 
 type AzureSecret struct {
-	ApiKeys              map[string]string `protobuf:"bytes,1,rep,name=api_keys,json=apiKeys,proto3" json:"api_keys,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	ApiKeys map[string]string `protobuf:"bytes,1,rep,name=api_keys,json=apiKeys,proto3" json:"api_keys,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The credentials of an Azure AD service principal, used by function discovery
+	// to list functions through the Azure Resource Manager API
+	TenantId             string   `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	ClientId             string   `protobuf:"bytes,3,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	ClientSecret         string   `protobuf:"bytes,4,opt,name=client_secret,json=clientSecret,proto3" json:"client_secret,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *AzureSecret) Reset()         { *m = AzureSecret{} }
@@ -337,6 +404,113 @@ func (m *AzureSecret) GetApiKeys() map[string]string {
 	return nil
 }
 
+func (m *AzureSecret) GetTenantId() string {
+	if m != nil {
+		return m.TenantId
+	}
+	return ""
+}
+
+func (m *AzureSecret) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *AzureSecret) GetClientSecret() string {
+	if m != nil {
+		return m.ClientSecret
+	}
+	return ""
+}
+
+type AlibabaSecret struct {
+	// The AccessKey of a RAM user, used to sign Function Compute requests
+	AccessKeyId          string   `protobuf:"bytes,1,opt,name=access_key_id,json=accessKeyId,proto3" json:"access_key_id,omitempty"`
+	AccessKeySecret      string   `protobuf:"bytes,2,opt,name=access_key_secret,json=accessKeySecret,proto3" json:"access_key_secret,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AlibabaSecret) Reset()         { *m = AlibabaSecret{} }
+func (m *AlibabaSecret) String() string { return proto.CompactTextString(m) }
+func (*AlibabaSecret) ProtoMessage()    {}
+func (*AlibabaSecret) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2f79c35f1213791, []int{3}
+}
+func (m *AlibabaSecret) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AlibabaSecret.Unmarshal(m, b)
+}
+func (m *AlibabaSecret) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AlibabaSecret.Marshal(b, m, deterministic)
+}
+func (m *AlibabaSecret) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlibabaSecret.Merge(m, src)
+}
+func (m *AlibabaSecret) XXX_Size() int {
+	return xxx_messageInfo_AlibabaSecret.Size(m)
+}
+func (m *AlibabaSecret) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlibabaSecret.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AlibabaSecret proto.InternalMessageInfo
+
+func (m *AlibabaSecret) GetAccessKeyId() string {
+	if m != nil {
+		return m.AccessKeyId
+	}
+	return ""
+}
+
+func (m *AlibabaSecret) GetAccessKeySecret() string {
+	if m != nil {
+		return m.AccessKeySecret
+	}
+	return ""
+}
+
+type OpenWhiskSecret struct {
+	// The API key of the namespace, in the `<uuid>:<key>` format, used as basic auth credentials
+	ApiKey               string   `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *OpenWhiskSecret) Reset()         { *m = OpenWhiskSecret{} }
+func (m *OpenWhiskSecret) String() string { return proto.CompactTextString(m) }
+func (*OpenWhiskSecret) ProtoMessage()    {}
+func (*OpenWhiskSecret) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2f79c35f1213791, []int{4}
+}
+func (m *OpenWhiskSecret) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OpenWhiskSecret.Unmarshal(m, b)
+}
+func (m *OpenWhiskSecret) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OpenWhiskSecret.Marshal(b, m, deterministic)
+}
+func (m *OpenWhiskSecret) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OpenWhiskSecret.Merge(m, src)
+}
+func (m *OpenWhiskSecret) XXX_Size() int {
+	return xxx_messageInfo_OpenWhiskSecret.Size(m)
+}
+func (m *OpenWhiskSecret) XXX_DiscardUnknown() {
+	xxx_messageInfo_OpenWhiskSecret.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OpenWhiskSecret proto.InternalMessageInfo
+
+func (m *OpenWhiskSecret) GetApiKey() string {
+	if m != nil {
+		return m.ApiKey
+	}
+	return ""
+}
+
 type TlsSecret struct {
 	CertChain            string   `protobuf:"bytes,1,opt,name=cert_chain,json=certChain,proto3" json:"cert_chain,omitempty"`
 	PrivateKey           string   `protobuf:"bytes,2,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`
@@ -350,7 +524,7 @@ func (m *TlsSecret) Reset()         { *m = TlsSecret{} }
 func (m *TlsSecret) String() string { return proto.CompactTextString(m) }
 func (*TlsSecret) ProtoMessage()    {}
 func (*TlsSecret) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c2f79c35f1213791, []int{3}
+	return fileDescriptor_c2f79c35f1213791, []int{5}
 }
 func (m *TlsSecret) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TlsSecret.Unmarshal(m, b)
@@ -396,6 +570,8 @@ func init() {
 	proto.RegisterType((*AwsSecret)(nil), "gloo.solo.io.AwsSecret")
 	proto.RegisterType((*AzureSecret)(nil), "gloo.solo.io.AzureSecret")
 	proto.RegisterMapType((map[string]string)(nil), "gloo.solo.io.AzureSecret.ApiKeysEntry")
+	proto.RegisterType((*AlibabaSecret)(nil), "gloo.solo.io.AlibabaSecret")
+	proto.RegisterType((*OpenWhiskSecret)(nil), "gloo.solo.io.OpenWhiskSecret")
 	proto.RegisterType((*TlsSecret)(nil), "gloo.solo.io.TlsSecret")
 }
 
@@ -404,36 +580,44 @@ func init() {
 }
 
 var fileDescriptor_c2f79c35f1213791 = []byte{
-	// 454 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0x8d, 0x93, 0x34, 0xa9, 0x27, 0x3d, 0xa0, 0x55, 0x45, 0x4d, 0x24, 0x28, 0xca, 0x01, 0x55,
-	0x42, 0xac, 0x49, 0x91, 0xa0, 0x54, 0xe2, 0x90, 0x54, 0x95, 0x8a, 0x22, 0x2e, 0x86, 0x13, 0x97,
-	0x68, 0xbb, 0x59, 0xb9, 0x4b, 0x5c, 0x8f, 0xb5, 0xbb, 0x49, 0x31, 0xdf, 0xc0, 0x81, 0xcf, 0xe0,
-	0x53, 0xf8, 0x0a, 0x0e, 0x7c, 0x09, 0xda, 0x5d, 0xc7, 0xb1, 0x50, 0x90, 0xe0, 0x64, 0xcf, 0xbc,
-	0xf7, 0x46, 0xf3, 0xde, 0x0e, 0xbc, 0x4e, 0xa5, 0xb9, 0x59, 0x5d, 0x53, 0x8e, 0xb7, 0xb1, 0xc6,
-	0x0c, 0x9f, 0x49, 0x8c, 0xd3, 0x0c, 0x31, 0x2e, 0x14, 0x7e, 0x12, 0xdc, 0x68, 0x5f, 0xb1, 0x42,
-	0xc6, 0xeb, 0x71, 0xac, 0x05, 0x57, 0xc2, 0xd0, 0x42, 0xa1, 0x41, 0x72, 0x60, 0x11, 0x6a, 0x45,
-	0x54, 0xe2, 0xf0, 0x30, 0xc5, 0x14, 0x1d, 0x10, 0xdb, 0x3f, 0xcf, 0x19, 0xbe, 0xf9, 0xaf, 0xf1,
-	0xe2, 0xb3, 0x11, 0xb9, 0x96, 0x98, 0xeb, 0x4a, 0x3e, 0xde, 0x21, 0x77, 0xdf, 0xa5, 0x34, 0x1b,
-	0xd1, 0xad, 0x30, 0x6c, 0xc1, 0x0c, 0xf3, 0x92, 0xd1, 0xb7, 0x36, 0xf4, 0xde, 0xbb, 0x35, 0xc9,
-	0x53, 0xe8, 0xb0, 0x3b, 0x1d, 0x05, 0x8f, 0x83, 0x93, 0xc1, 0xe9, 0x11, 0x6d, 0xae, 0x4b, 0x27,
-	0x77, 0xda, 0xb3, 0xae, 0x5a, 0x89, 0x65, 0x91, 0x31, 0xec, 0xb1, 0x2f, 0x2b, 0x25, 0xa2, 0xb6,
-	0xa3, 0x3f, 0xf8, 0x83, 0x6e, 0xa1, 0x5a, 0xe0, 0x99, 0x76, 0xbe, 0xc9, 0x74, 0xd4, 0xd9, 0x35,
-	0xff, 0x43, 0xd6, 0x98, 0x6f, 0x32, 0x4d, 0x5e, 0x41, 0x58, 0xdb, 0x8b, 0xba, 0xbb, 0x24, 0x97,
-	0x1b, 0xf8, 0xaa, 0x95, 0x6c, 0xb9, 0xe4, 0x0c, 0xf6, 0x37, 0x16, 0xa3, 0xbe, 0xd3, 0xdd, 0xa7,
-	0x1c, 0x95, 0xa8, 0x75, 0xef, 0x2a, 0x74, 0xda, 0xfd, 0xf1, 0xf3, 0xb8, 0x95, 0xd4, 0xec, 0x69,
-	0x0f, 0xba, 0x4b, 0x99, 0x2f, 0x46, 0x6f, 0x21, 0xac, 0xed, 0x92, 0x87, 0x00, 0x8c, 0x73, 0xa1,
-	0xf5, 0x7c, 0x29, 0x4a, 0x97, 0x4d, 0x98, 0x84, 0xbe, 0x33, 0x13, 0xa5, 0x85, 0xfd, 0x23, 0x3b,
-	0xb8, 0xed, 0x61, 0xdf, 0x99, 0x89, 0x72, 0xf4, 0x35, 0x80, 0x41, 0x23, 0x0b, 0x32, 0x81, 0x7d,
-	0x56, 0x48, 0xcb, 0xb5, 0x39, 0x77, 0x4e, 0x06, 0xa7, 0x4f, 0xfe, 0x1a, 0x1c, 0x9d, 0x14, 0x72,
-	0x26, 0x4a, 0x7d, 0x99, 0x1b, 0x55, 0x26, 0x7d, 0xe6, 0xab, 0xe1, 0x39, 0x1c, 0x34, 0x01, 0x72,
-	0x0f, 0x3a, 0xdb, 0xcd, 0xec, 0x2f, 0x39, 0x84, 0xbd, 0x35, 0xcb, 0x56, 0xa2, 0x5a, 0xc7, 0x17,
-	0xe7, 0xed, 0xb3, 0x60, 0xb4, 0x80, 0xb0, 0x0e, 0xda, 0xae, 0xce, 0x85, 0x32, 0x73, 0x7e, 0xc3,
-	0x64, 0xbe, 0x71, 0x66, 0x3b, 0x17, 0xb6, 0x41, 0x8e, 0x61, 0x50, 0x28, 0xb9, 0x66, 0x46, 0x34,
-	0xac, 0x41, 0xd5, 0xb2, 0xd6, 0x8f, 0xa0, 0xaf, 0x10, 0xcd, 0x9c, 0x33, 0xf7, 0xa4, 0x61, 0xd2,
-	0xb3, 0xe5, 0x05, 0x9b, 0xbe, 0xfc, 0xfe, 0xeb, 0x51, 0xf0, 0xf1, 0xf9, 0xbf, 0x9d, 0x72, 0xb1,
-	0x4c, 0xab, 0xcb, 0xbc, 0xee, 0xb9, 0x8b, 0x7c, 0xf1, 0x3b, 0x00, 0x00, 0xff, 0xff, 0xfd, 0x1c,
-	0x02, 0x9e, 0x64, 0x03, 0x00, 0x00,
+	// 589 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x6f, 0x6b, 0xd3, 0x5c,
+	0x14, 0x5f, 0x97, 0x2d, 0x5d, 0x4e, 0x5b, 0xf6, 0x3c, 0x97, 0x61, 0x63, 0xc7, 0x9c, 0x44, 0x90,
+	0x31, 0x31, 0xb1, 0x13, 0xdc, 0x1c, 0xec, 0x45, 0x3b, 0x06, 0x2b, 0x45, 0x84, 0x28, 0x08, 0xbe,
+	0x29, 0xb7, 0xc9, 0xa5, 0xbd, 0x36, 0xcb, 0x0d, 0xb9, 0xb7, 0xad, 0xf5, 0x13, 0xf8, 0x51, 0xfc,
+	0x28, 0x7e, 0x0a, 0x5f, 0xf8, 0xd6, 0x2f, 0x21, 0x37, 0x27, 0x4d, 0xb3, 0x52, 0x41, 0x5f, 0x35,
+	0xf7, 0xfc, 0xfe, 0x9c, 0x93, 0xf3, 0xcb, 0x2d, 0xbc, 0x1e, 0x71, 0x35, 0x9e, 0x0e, 0xdd, 0x40,
+	0xdc, 0x79, 0x52, 0x44, 0xe2, 0x39, 0x17, 0xde, 0x28, 0x12, 0xc2, 0x4b, 0x52, 0xf1, 0x89, 0x05,
+	0x4a, 0xe2, 0x89, 0x26, 0xdc, 0x9b, 0xb5, 0x3d, 0xc9, 0x82, 0x94, 0x29, 0x37, 0x49, 0x85, 0x12,
+	0xa4, 0xae, 0x11, 0x57, 0x8b, 0x5c, 0x2e, 0x5a, 0x07, 0x23, 0x31, 0x12, 0x19, 0xe0, 0xe9, 0x27,
+	0xe4, 0xb4, 0xae, 0xfe, 0xc9, 0x9e, 0x7d, 0x56, 0x2c, 0x96, 0x5c, 0xc4, 0x32, 0x97, 0xb7, 0x37,
+	0xc8, 0xb3, 0xdf, 0x09, 0x57, 0x4b, 0xd1, 0x1d, 0x53, 0x34, 0xa4, 0x8a, 0xa2, 0xc4, 0xf9, 0x6a,
+	0x80, 0xf9, 0x2e, 0x1b, 0x93, 0x3c, 0x03, 0x83, 0xce, 0xa5, 0x5d, 0x79, 0x5c, 0x39, 0xa9, 0x9d,
+	0x35, 0xdd, 0xf2, 0xb8, 0x6e, 0x67, 0x2e, 0x91, 0x75, 0xbb, 0xe5, 0x6b, 0x16, 0x69, 0xc3, 0x2e,
+	0xfd, 0x32, 0x4d, 0x99, 0xbd, 0x9d, 0xd1, 0x1f, 0xae, 0xd1, 0x35, 0x54, 0x08, 0x90, 0xa9, 0xfd,
+	0x55, 0x24, 0x6d, 0x63, 0x93, 0xff, 0xfb, 0xa8, 0xe4, 0xaf, 0x22, 0x49, 0xce, 0xc1, 0x2a, 0x5e,
+	0xcf, 0xde, 0xd9, 0x24, 0xb9, 0x59, 0xc2, 0xb7, 0x5b, 0xfe, 0x8a, 0x4b, 0xce, 0xa1, 0x4a, 0x23,
+	0x3e, 0xa4, 0x43, 0x6a, 0xef, 0x66, 0xb2, 0xc3, 0xb5, 0xd1, 0x10, 0x2c, 0xba, 0x2d, 0xd9, 0xe4,
+	0x0a, 0x2c, 0x91, 0xb0, 0x78, 0x3e, 0xe6, 0x72, 0x62, 0x9b, 0x99, 0xf4, 0xe8, 0xbe, 0xf4, 0x6d,
+	0xc2, 0xe2, 0x0f, 0x1a, 0x2e, 0xc4, 0x2b, 0x05, 0xb9, 0x80, 0xbd, 0xe5, 0x6a, 0xed, 0x6a, 0xa6,
+	0x7e, 0xe0, 0x06, 0x22, 0x65, 0x85, 0xfa, 0x4d, 0x8e, 0x76, 0x77, 0xbe, 0xff, 0x38, 0xde, 0xf2,
+	0x0b, 0x76, 0xd7, 0x84, 0x9d, 0x09, 0x8f, 0x43, 0xa7, 0x07, 0x56, 0xb1, 0x66, 0x72, 0x04, 0x40,
+	0x83, 0x80, 0x49, 0x39, 0x98, 0xb0, 0x45, 0x96, 0x89, 0xe5, 0x5b, 0x58, 0xe9, 0xb3, 0x85, 0x86,
+	0xf1, 0xe3, 0xca, 0xe0, 0x6d, 0x84, 0xb1, 0xd2, 0x67, 0x0b, 0xe7, 0x57, 0x05, 0x6a, 0xa5, 0x0c,
+	0x48, 0x07, 0xf6, 0x68, 0xc2, 0x35, 0x57, 0xe7, 0x6b, 0x9c, 0xd4, 0xce, 0x9e, 0xfe, 0x31, 0x30,
+	0xb7, 0x93, 0xf0, 0x3e, 0x5b, 0xc8, 0x9b, 0x58, 0xa5, 0x0b, 0xbf, 0x4a, 0xf1, 0x44, 0x0e, 0xc1,
+	0x52, 0x2c, 0xa6, 0xb1, 0x1a, 0xf0, 0x30, 0x6f, 0xb8, 0x87, 0x85, 0x5e, 0xa8, 0xc1, 0x20, 0xe2,
+	0x0c, 0x41, 0x03, 0x41, 0x2c, 0xf4, 0x42, 0xf2, 0x04, 0x1a, 0x39, 0x88, 0x03, 0x66, 0x71, 0x5a,
+	0x7e, 0x1d, 0x8b, 0xd8, 0xb4, 0x75, 0x09, 0xf5, 0x72, 0x5f, 0xf2, 0x1f, 0x18, 0xab, 0x17, 0xd7,
+	0x8f, 0xe4, 0x00, 0x76, 0x67, 0x34, 0x9a, 0xb2, 0xbc, 0x39, 0x1e, 0x2e, 0xb7, 0x2f, 0x2a, 0xce,
+	0x00, 0x1a, 0xf7, 0x52, 0x25, 0x0e, 0x34, 0x56, 0xcb, 0xd3, 0x23, 0xa1, 0x4d, 0xad, 0xd8, 0x5f,
+	0x2f, 0x24, 0xa7, 0xf0, 0x7f, 0x89, 0x93, 0x4f, 0x86, 0xd6, 0xfb, 0x05, 0x0f, 0xfd, 0x9c, 0x53,
+	0xd8, 0x5f, 0xcb, 0x9e, 0x34, 0xa1, 0x9a, 0x6f, 0x34, 0x37, 0x37, 0x71, 0x51, 0x4e, 0x08, 0x56,
+	0xf1, 0x31, 0xeb, 0x98, 0x02, 0x96, 0xaa, 0x41, 0x30, 0xa6, 0x3c, 0x5e, 0xa6, 0xa8, 0x2b, 0xd7,
+	0xba, 0x40, 0x8e, 0xa1, 0x96, 0xa4, 0x7c, 0x46, 0x15, 0x2b, 0xc5, 0x08, 0x79, 0x49, 0xc7, 0xdc,
+	0x84, 0x6a, 0x2a, 0x84, 0x1a, 0x04, 0x34, 0xdf, 0xaa, 0xa9, 0x8f, 0xd7, 0xb4, 0xfb, 0xea, 0xdb,
+	0xcf, 0x47, 0x95, 0x8f, 0x2f, 0xfe, 0xee, 0xef, 0x22, 0x99, 0x8c, 0xf2, 0xdb, 0x3f, 0x34, 0xb3,
+	0x5b, 0xff, 0xf2, 0xf7, 0x00, 0x41, 0x88, 0x04, 0x44, 0xc8, 0x04, 0x00, 0x00,
 }
 
 func (this *Secret) Equal(that interface{}) bool {
@@ -568,6 +752,54 @@ func (this *Secret_Extension) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *Secret_Alibaba) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Secret_Alibaba)
+	if !ok {
+		that2, ok := that.(Secret_Alibaba)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Alibaba.Equal(that1.Alibaba) {
+		return false
+	}
+	return true
+}
+func (this *Secret_Openwhisk) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Secret_Openwhisk)
+	if !ok {
+		that2, ok := that.(Secret_Openwhisk)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Openwhisk.Equal(that1.Openwhisk) {
+		return false
+	}
+	return true
+}
 func (this *AwsSecret) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -625,6 +857,72 @@ func (this *AzureSecret) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.TenantId != that1.TenantId {
+		return false
+	}
+	if this.ClientId != that1.ClientId {
+		return false
+	}
+	if this.ClientSecret != that1.ClientSecret {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *AlibabaSecret) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AlibabaSecret)
+	if !ok {
+		that2, ok := that.(AlibabaSecret)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.AccessKeyId != that1.AccessKeyId {
+		return false
+	}
+	if this.AccessKeySecret != that1.AccessKeySecret {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *OpenWhiskSecret) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*OpenWhiskSecret)
+	if !ok {
+		that2, ok := that.(OpenWhiskSecret)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ApiKey != that1.ApiKey {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
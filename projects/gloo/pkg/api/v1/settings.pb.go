@@ -7,6 +7,7 @@ import (
 	bytes "bytes"
 	fmt "fmt"
 	math "math"
+	time "time"
 
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
@@ -18,6 +19,7 @@ import (
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -25,6 +27,65 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 
+type DiscoveryOptions_Mode int32
+
+const (
+	// The discovery runs.
+	DiscoveryOptions_ENABLED DiscoveryOptions_Mode = 0
+	// The discovery stops, and leaves the upstreams as they are until it is resumed.
+	DiscoveryOptions_PAUSED DiscoveryOptions_Mode = 1
+)
+
+var DiscoveryOptions_Mode_name = map[int32]string{
+	0: "ENABLED",
+	1: "PAUSED",
+}
+
+var DiscoveryOptions_Mode_value = map[string]int32{
+	"ENABLED": 0,
+	"PAUSED":  1,
+}
+
+func (x DiscoveryOptions_Mode) String() string {
+	return proto.EnumName(DiscoveryOptions_Mode_name, int32(x))
+}
+
+func (DiscoveryOptions_Mode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_bd7533c2495e1752, []int{5, 0}
+}
+
+type DiscoveryOptions_StaleUpstreamPolicy_Action int32
+
+const (
+	// The upstreams are deleted with their service.
+	DiscoveryOptions_StaleUpstreamPolicy_DELETE DiscoveryOptions_StaleUpstreamPolicy_Action = 0
+	// The upstreams are deleted once their service has been gone for the grace period. They are annotated
+	// with `discovery.solo.io/stale-since` until then, and kept if their service comes back.
+	DiscoveryOptions_StaleUpstreamPolicy_DELETE_AFTER_GRACE_PERIOD DiscoveryOptions_StaleUpstreamPolicy_Action = 1
+	// The upstreams are kept, with a warning logged. They have to be deleted by hand.
+	DiscoveryOptions_StaleUpstreamPolicy_ORPHAN DiscoveryOptions_StaleUpstreamPolicy_Action = 2
+)
+
+var DiscoveryOptions_StaleUpstreamPolicy_Action_name = map[int32]string{
+	0: "DELETE",
+	1: "DELETE_AFTER_GRACE_PERIOD",
+	2: "ORPHAN",
+}
+
+var DiscoveryOptions_StaleUpstreamPolicy_Action_value = map[string]int32{
+	"DELETE":                    0,
+	"DELETE_AFTER_GRACE_PERIOD": 1,
+	"ORPHAN":                    2,
+}
+
+func (x DiscoveryOptions_StaleUpstreamPolicy_Action) String() string {
+	return proto.EnumName(DiscoveryOptions_StaleUpstreamPolicy_Action_name, int32(x))
+}
+
+func (DiscoveryOptions_StaleUpstreamPolicy_Action) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_bd7533c2495e1752, []int{5, 0, 0}
+}
+
 //
 //@solo-kit:resource.short_name=st
 //@solo-kit:resource.plural_name=settings
@@ -47,6 +108,7 @@ type Settings struct {
 	//	*Settings_KubernetesSecretSource
 	//	*Settings_VaultSecretSource
 	//	*Settings_DirectorySecretSource
+	//	*Settings_AzureKeyVaultSecretSource
 	SecretSource isSettings_SecretSource `protobuf_oneof:"secret_source"`
 	// where to read artifacts from (configmap, file)
 	//
@@ -62,7 +124,88 @@ type Settings struct {
 	DevMode bool `protobuf:"varint,13,opt,name=dev_mode,json=devMode,proto3" json:"dev_mode,omitempty"`
 	// enable automatic linkerd upstream header addition for easier routing to linkerd services
 	Linkerd bool `protobuf:"varint,17,opt,name=linkerd,proto3" json:"linkerd,omitempty"`
-	// Default circuit breakers when not set in a specific upstream.
+	// annotations of upstreams and routes to copy into the metadata of the Envoy clusters and routes, so that custom
+	// filters (Wasm, Lua, ext_authz...) can read them. An entry ending with `/` selects all the annotations with that prefix,
+	// e.g. `example.com/`, other entries select the annotation with that exact key. No annotation is copied if empty.
+	// The annotations are set as string fields of the `io.solo.gloo.annotations` filter metadata.
+	MetadataAnnotations []string `protobuf:"bytes,18,rep,name=metadata_annotations,json=metadataAnnotations,proto3" json:"metadata_annotations,omitempty"`
+	// Publish the domains of the virtual services to a DNS provider, bound to the address of the gateway proxy service.
+	// Not published if not set.
+	DnsPublishing *DnsPublishing `protobuf:"bytes,19,opt,name=dns_publishing,json=dnsPublishing,proto3" json:"dns_publishing,omitempty"`
+	// Serves an HTTP endpoint on the discovery service, so that external systems can trigger the discovery of the
+	// functions of an upstream without waiting for its next poll. Not served if not set.
+	DiscoveryTriggers *DiscoveryTriggers `protobuf:"bytes,21,opt,name=discovery_triggers,json=discoveryTriggers,proto3" json:"discovery_triggers,omitempty"`
+	// Limits the writes of function discovery to the upstreams, so that discovering many upstreams at once does not
+	// flood the API server. Defaults apply if not set.
+	DiscoveryWrites *DiscoveryWrites `protobuf:"bytes,22,opt,name=discovery_writes,json=discoveryWrites,proto3" json:"discovery_writes,omitempty"`
+	// Runs upstream and function discovery in observe-only mode: the upstreams they would create, update or delete
+	// are logged instead of written. Discovery writes the upstreams if not set.
+	DiscoveryDryRun *DiscoveryDryRun `protobuf:"bytes,23,opt,name=discovery_dry_run,json=discoveryDryRun,proto3" json:"discovery_dry_run,omitempty"`
+	// Webhooks that discover the functions of the upstreams, in addition to the built-in function discoveries.
+	FunctionDiscoveryWebhooks []*FunctionDiscoveryWebhook `protobuf:"bytes,24,rep,name=function_discovery_webhooks,json=functionDiscoveryWebhooks,proto3" json:"function_discovery_webhooks,omitempty"`
+	// Bounds the probes of function discovery, so that discovering many upstreams at once does not flood them.
+	// The probes are not limited if not set.
+	DiscoveryProbes *DiscoveryProbes `protobuf:"bytes,25,opt,name=discovery_probes,json=discoveryProbes,proto3" json:"discovery_probes,omitempty"`
+	// Sanitizes the xDS snapshots of the proxies before they are served. Each entry whose roles match the role of a
+	// proxy (its `namespace~name`) applies to it, in order. Errored upstreams are always removed from the snapshots.
+	XdsSanitization []*XdsSanitization `protobuf:"bytes,26,rep,name=xds_sanitization,json=xdsSanitization,proto3" json:"xds_sanitization,omitempty"`
+	// When an upstream or a virtual host fails translation, keep serving its last accepted configuration to the proxies
+	// instead of removing the cluster of the upstream or rejecting the whole snapshot of the proxy. The errors are still
+	// reported in the status of the upstream or proxy, and the stale resources are counted in the
+	// `api.gloo.solo.io/translator/stale_resources` metric.
+	KeepLastKnownGood bool `protobuf:"varint,27,opt,name=keep_last_known_good,json=keepLastKnownGood,proto3" json:"keep_last_known_good,omitempty"`
+	// Holds back a new route until the clusters of its destinations have healthy endpoints, so that creating a route
+	// to an upstream that is still starting doesn't send it requests meanwhile. A held back route answers with a 503
+	// rather than letting its requests fall through to the next routes. Once served, a route stays served even if its
+	// clusters lose their endpoints, including after the settings change. The held back routes are counted in the
+	// `api.gloo.solo.io/translator/held_back_routes` metric.
+	WaitForUpstreamEndpoints bool `protobuf:"varint,28,opt,name=wait_for_upstream_endpoints,json=waitForUpstreamEndpoints,proto3" json:"wait_for_upstream_endpoints,omitempty"`
+	// Emits latency histograms and response code counters per function for the routes to functions (e.g. AWS Lambda,
+	// Azure, REST or gRPC functions), tagged by function name, rather than per upstream only. The stats of a function
+	// are named `vhost.<virtual host>.vcluster.<upstream>_<function>.*`.
+	// Only the method and path of the routes tell the functions apart: the functions of routes matching on headers or
+	// query parameters only are counted in the stats of the first function route with the same method and path.
+	FunctionStats bool `protobuf:"varint,29,opt,name=function_stats,json=functionStats,proto3" json:"function_stats,omitempty"`
+	// Pauses and resumes discovery at runtime. Discovery watches the settings and stops or restarts right away, so that
+	// its churn can be paused during an incident without redeploying or scaling down discovery.
+	Discovery *DiscoveryOptions `protobuf:"bytes,30,opt,name=discovery,proto3" json:"discovery,omitempty"`
+	// Discovers the upstreams of the services of the native service registry of a Nomad cluster, and their endpoints.
+	// Nomad services are not discovered if not set.
+	Nomad *NomadConfiguration `protobuf:"bytes,31,opt,name=nomad,proto3" json:"nomad,omitempty"`
+	// Discovers the upstreams of the Docker containers, or of the Swarm services, labelled
+	// `gloo.solo.io/discover: "true"`. Docker is not discovered if not set.
+	Docker *DockerConfiguration `protobuf:"bytes,32,opt,name=docker,proto3" json:"docker,omitempty"`
+	// Reads static upstreams from the YAML or JSON files of a directory, e.g. for bare-metal deployments, in addition to
+	// the upstreams of the config source. A file holds one or more upstreams separated by `---`; those without a namespace
+	// are in the discovery namespace. The upstreams are named `file:<name>` so they can't collide with the upstreams of
+	// the config source, and are read only. Changes to the files are applied without restarting Gloo.
+	UpstreamDirectory *Settings_Directory `protobuf:"bytes,33,opt,name=upstream_directory,json=upstreamDirectory,proto3" json:"upstream_directory,omitempty"`
+	// Synthesizes a read only upstream named `consul-svc:<service>` in the discovery namespace for each service of the
+	// Consul catalog, so routes can reference the Consul services without discovering their upstreams. The Consul agent
+	// is configured by the `CONSUL_HTTP_ADDR` and `CONSUL_HTTP_TOKEN` environment variables.
+	ConsulServiceUpstreams bool `protobuf:"varint,34,opt,name=consul_service_upstreams,json=consulServiceUpstreams,proto3" json:"consul_service_upstreams,omitempty"`
+	// The number of proxies translated concurrently, so that the translation of a large proxy does not delay the updates
+	// of the others. Each worker translates with its own instances of the plugins. Defaults to the number of CPUs.
+	// The proxies are translated one at a time when gloo is extended with plugin instances rather than a factory.
+	ProxyTranslationWorkers uint32 `protobuf:"varint,35,opt,name=proxy_translation_workers,json=proxyTranslationWorkers,proto3" json:"proxy_translation_workers,omitempty"`
+	// Serves the certificates of the TLS secrets referenced by the ssl configs of the listeners and upstreams to envoy
+	// with the Secret Discovery Service over ADS, instead of inlining them into the listeners and clusters. Envoy then
+	// rotates the certificates without draining the listeners, and the private keys are kept out of the config dumps of
+	// the listeners and clusters.
+	SdsSecrets bool `protobuf:"varint,36,opt,name=sds_secrets,json=sdsSecrets,proto3" json:"sds_secrets,omitempty"`
+	// Pulls the modules of the wasm filters of the http listeners from image registries and serves them to envoy. The
+	// wasm filters can only reference images when set.
+	Wasm *WasmOptions `protobuf:"bytes,37,opt,name=wasm,proto3" json:"wasm,omitempty"`
+	// The envoy extensions the proxies support beyond those of the envoy image shipped with gloo, e.g.
+	// `envoy.clusters.aggregate`. Envoy rejects the whole configuration of a proxy that uses an extension it lacks, so
+	// the upstreams and listeners requiring an extension that is not listed are rejected at translation instead.
+	EnvoyExtensions []string `protobuf:"bytes,38,rep,name=envoy_extensions,json=envoyExtensions,proto3" json:"envoy_extensions,omitempty"`
+	// Synthesizes a read only upstream named `svc:<service>-<port>` for each port of the Kubernetes services of the
+	// selected namespaces, so routes can reference the services without discovering their upstreams. Requires Gloo to
+	// run in Kubernetes.
+	KubeServiceUpstreams *KubeServiceUpstreams `protobuf:"bytes,39,opt,name=kube_service_upstreams,json=kubeServiceUpstreams,proto3" json:"kube_service_upstreams,omitempty"`
+	// Default circuit breakers of all the clusters, including the clusters of the cluster generator plugins. An
+	// upstream overrides the thresholds it sets in its own circuit breakers.
 	CircuitBreakers *CircuitBreakerConfig `protobuf:"bytes,3,opt,name=circuit_breakers,json=circuitBreakers,proto3" json:"circuit_breakers,omitempty"`
 	// Settings for extensions
 	Extensions *Extensions `protobuf:"bytes,16,opt,name=extensions,proto3" json:"extensions,omitempty"`
@@ -128,6 +271,9 @@ type Settings_VaultSecretSource struct {
 type Settings_DirectorySecretSource struct {
 	DirectorySecretSource *Settings_Directory `protobuf:"bytes,8,opt,name=directory_secret_source,json=directorySecretSource,proto3,oneof"`
 }
+type Settings_AzureKeyVaultSecretSource struct {
+	AzureKeyVaultSecretSource *Settings_AzureKeyVaultSecrets `protobuf:"bytes,20,opt,name=azure_key_vault_secret_source,json=azureKeyVaultSecretSource,proto3,oneof"`
+}
 type Settings_KubernetesArtifactSource struct {
 	KubernetesArtifactSource *Settings_KubernetesConfigmaps `protobuf:"bytes,9,opt,name=kubernetes_artifact_source,json=kubernetesArtifactSource,proto3,oneof"`
 }
@@ -140,6 +286,7 @@ func (*Settings_DirectoryConfigSource) isSettings_ConfigSource()      {}
 func (*Settings_KubernetesSecretSource) isSettings_SecretSource()     {}
 func (*Settings_VaultSecretSource) isSettings_SecretSource()          {}
 func (*Settings_DirectorySecretSource) isSettings_SecretSource()      {}
+func (*Settings_AzureKeyVaultSecretSource) isSettings_SecretSource()  {}
 func (*Settings_KubernetesArtifactSource) isSettings_ArtifactSource() {}
 func (*Settings_DirectoryArtifactSource) isSettings_ArtifactSource()  {}
 
@@ -211,6 +358,13 @@ func (m *Settings) GetDirectorySecretSource() *Settings_Directory {
 	return nil
 }
 
+func (m *Settings) GetAzureKeyVaultSecretSource() *Settings_AzureKeyVaultSecrets {
+	if x, ok := m.GetSecretSource().(*Settings_AzureKeyVaultSecretSource); ok {
+		return x.AzureKeyVaultSecretSource
+	}
+	return nil
+}
+
 func (m *Settings) GetKubernetesArtifactSource() *Settings_KubernetesConfigmaps {
 	if x, ok := m.GetArtifactSource().(*Settings_KubernetesArtifactSource); ok {
 		return x.KubernetesArtifactSource
@@ -253,6 +407,153 @@ func (m *Settings) GetLinkerd() bool {
 	return false
 }
 
+func (m *Settings) GetMetadataAnnotations() []string {
+	if m != nil {
+		return m.MetadataAnnotations
+	}
+	return nil
+}
+
+func (m *Settings) GetDnsPublishing() *DnsPublishing {
+	if m != nil {
+		return m.DnsPublishing
+	}
+	return nil
+}
+
+func (m *Settings) GetDiscoveryTriggers() *DiscoveryTriggers {
+	if m != nil {
+		return m.DiscoveryTriggers
+	}
+	return nil
+}
+
+func (m *Settings) GetDiscoveryWrites() *DiscoveryWrites {
+	if m != nil {
+		return m.DiscoveryWrites
+	}
+	return nil
+}
+
+func (m *Settings) GetDiscoveryDryRun() *DiscoveryDryRun {
+	if m != nil {
+		return m.DiscoveryDryRun
+	}
+	return nil
+}
+
+func (m *Settings) GetFunctionDiscoveryWebhooks() []*FunctionDiscoveryWebhook {
+	if m != nil {
+		return m.FunctionDiscoveryWebhooks
+	}
+	return nil
+}
+
+func (m *Settings) GetDiscoveryProbes() *DiscoveryProbes {
+	if m != nil {
+		return m.DiscoveryProbes
+	}
+	return nil
+}
+
+func (m *Settings) GetXdsSanitization() []*XdsSanitization {
+	if m != nil {
+		return m.XdsSanitization
+	}
+	return nil
+}
+
+func (m *Settings) GetKeepLastKnownGood() bool {
+	if m != nil {
+		return m.KeepLastKnownGood
+	}
+	return false
+}
+
+func (m *Settings) GetWaitForUpstreamEndpoints() bool {
+	if m != nil {
+		return m.WaitForUpstreamEndpoints
+	}
+	return false
+}
+
+func (m *Settings) GetFunctionStats() bool {
+	if m != nil {
+		return m.FunctionStats
+	}
+	return false
+}
+
+func (m *Settings) GetDiscovery() *DiscoveryOptions {
+	if m != nil {
+		return m.Discovery
+	}
+	return nil
+}
+
+func (m *Settings) GetNomad() *NomadConfiguration {
+	if m != nil {
+		return m.Nomad
+	}
+	return nil
+}
+
+func (m *Settings) GetDocker() *DockerConfiguration {
+	if m != nil {
+		return m.Docker
+	}
+	return nil
+}
+
+func (m *Settings) GetUpstreamDirectory() *Settings_Directory {
+	if m != nil {
+		return m.UpstreamDirectory
+	}
+	return nil
+}
+
+func (m *Settings) GetConsulServiceUpstreams() bool {
+	if m != nil {
+		return m.ConsulServiceUpstreams
+	}
+	return false
+}
+
+func (m *Settings) GetProxyTranslationWorkers() uint32 {
+	if m != nil {
+		return m.ProxyTranslationWorkers
+	}
+	return 0
+}
+
+func (m *Settings) GetSdsSecrets() bool {
+	if m != nil {
+		return m.SdsSecrets
+	}
+	return false
+}
+
+func (m *Settings) GetWasm() *WasmOptions {
+	if m != nil {
+		return m.Wasm
+	}
+	return nil
+}
+
+func (m *Settings) GetEnvoyExtensions() []string {
+	if m != nil {
+		return m.EnvoyExtensions
+	}
+	return nil
+}
+
+func (m *Settings) GetKubeServiceUpstreams() *KubeServiceUpstreams {
+	if m != nil {
+		return m.KubeServiceUpstreams
+	}
+	return nil
+}
+
 func (m *Settings) GetCircuitBreakers() *CircuitBreakerConfig {
 	if m != nil {
 		return m.CircuitBreakers
@@ -289,6 +590,7 @@ func (*Settings) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) erro
 		(*Settings_KubernetesSecretSource)(nil),
 		(*Settings_VaultSecretSource)(nil),
 		(*Settings_DirectorySecretSource)(nil),
+		(*Settings_AzureKeyVaultSecretSource)(nil),
 		(*Settings_KubernetesArtifactSource)(nil),
 		(*Settings_DirectoryArtifactSource)(nil),
 	}
@@ -329,6 +631,11 @@ func _Settings_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
 		if err := b.EncodeMessage(x.DirectorySecretSource); err != nil {
 			return err
 		}
+	case *Settings_AzureKeyVaultSecretSource:
+		_ = b.EncodeVarint(20<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.AzureKeyVaultSecretSource); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("Settings.SecretSource has unexpected type %T", x)
@@ -395,6 +702,14 @@ func _Settings_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffe
 		err := b.DecodeMessage(msg)
 		m.SecretSource = &Settings_DirectorySecretSource{msg}
 		return true, err
+	case 20: // secret_source.azure_key_vault_secret_source
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Settings_AzureKeyVaultSecrets)
+		err := b.DecodeMessage(msg)
+		m.SecretSource = &Settings_AzureKeyVaultSecretSource{msg}
+		return true, err
 	case 9: // artifact_source.kubernetes_artifact_source
 		if wire != proto.WireBytes {
 			return true, proto.ErrInternalBadWireType
@@ -451,6 +766,11 @@ func _Settings_OneofSizer(msg proto.Message) (n int) {
 		n += 1 // tag and wire
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *Settings_AzureKeyVaultSecretSource:
+		s := proto.Size(x.AzureKeyVaultSecretSource)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -633,148 +953,1860 @@ func (m *Settings_Directory) GetDirectory() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*Settings)(nil), "gloo.solo.io.Settings")
-	proto.RegisterType((*Settings_KubernetesCrds)(nil), "gloo.solo.io.Settings.KubernetesCrds")
-	proto.RegisterType((*Settings_KubernetesSecrets)(nil), "gloo.solo.io.Settings.KubernetesSecrets")
-	proto.RegisterType((*Settings_VaultSecrets)(nil), "gloo.solo.io.Settings.VaultSecrets")
-	proto.RegisterType((*Settings_KubernetesConfigmaps)(nil), "gloo.solo.io.Settings.KubernetesConfigmaps")
-	proto.RegisterType((*Settings_Directory)(nil), "gloo.solo.io.Settings.Directory")
+// Reads the secrets from an Azure Key Vault. Only the secrets tagged with `gloo-namespace` are read, each as the Gloo
+// secret of that namespace named after the Key Vault secret. The value of a Key Vault secret is the Gloo secret in YAML
+// or JSON, e.g. `{"aws": {"accessKey": "...", "secretKey": "..."}}`.
+// The secrets are read only, and their values are cached until they are updated in the vault.
+type Settings_AzureKeyVaultSecrets struct {
+	// The name of the vault, e.g. `my-vault` for `https://my-vault.vault.azure.net`.
+	VaultName string `protobuf:"bytes,1,opt,name=vault_name,json=vaultName,proto3" json:"vault_name,omitempty"`
+	// The tenant of the service principal used to read the secrets. If not set, Gloo uses the managed identity (MSI)
+	// of the node or pod it is running on.
+	TenantId string `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	// The client id of the service principal, whose secret is read from the `AZURE_CLIENT_SECRET` environment variable.
+	// Without a tenant id, the client id of the user assigned managed identity to use instead of the system assigned one.
+	ClientId             string   `protobuf:"bytes,3,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func init() {
-	proto.RegisterFile("github.com/solo-io/gloo/projects/gloo/api/v1/settings.proto", fileDescriptor_bd7533c2495e1752)
+func (m *Settings_AzureKeyVaultSecrets) Reset()         { *m = Settings_AzureKeyVaultSecrets{} }
+func (m *Settings_AzureKeyVaultSecrets) String() string { return proto.CompactTextString(m) }
+func (*Settings_AzureKeyVaultSecrets) ProtoMessage()    {}
+func (*Settings_AzureKeyVaultSecrets) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd7533c2495e1752, []int{0, 5}
 }
-
-var fileDescriptor_bd7533c2495e1752 = []byte{
-	// 730 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xdd, 0x4e, 0x13, 0x41,
-	0x14, 0xa6, 0x80, 0xd0, 0x0e, 0x85, 0xb6, 0x0b, 0xe2, 0x76, 0x35, 0xd0, 0xd4, 0x98, 0x94, 0x18,
-	0x77, 0x45, 0x13, 0x43, 0xfc, 0xb9, 0xa0, 0x60, 0x6c, 0x62, 0xf0, 0x62, 0x1b, 0xbd, 0xe0, 0xc2,
-	0x66, 0xba, 0x73, 0xba, 0x8c, 0x6d, 0x77, 0x9a, 0x99, 0xd9, 0x2a, 0x6f, 0xe4, 0xa3, 0xf8, 0x04,
-	0x5e, 0x72, 0xe1, 0x23, 0xf8, 0x04, 0xa6, 0xb3, 0xb3, 0x7f, 0x15, 0x42, 0xb9, 0x6a, 0xe7, 0x9c,
-	0xf3, 0x7d, 0xdf, 0xcc, 0xb7, 0xe7, 0x1c, 0xf4, 0xc6, 0xa7, 0xf2, 0x22, 0xec, 0xdb, 0x1e, 0x1b,
-	0x3b, 0x82, 0x8d, 0xd8, 0x33, 0xca, 0x1c, 0x7f, 0xc4, 0x98, 0x33, 0xe1, 0xec, 0x1b, 0x78, 0x52,
-	0x44, 0x27, 0x3c, 0xa1, 0xce, 0xf4, 0xd0, 0x11, 0x20, 0x25, 0x0d, 0x7c, 0x61, 0x4f, 0x38, 0x93,
-	0xcc, 0x28, 0xcf, 0x72, 0xf6, 0x0c, 0x66, 0x53, 0x66, 0xed, 0xf8, 0xcc, 0x67, 0x2a, 0xe1, 0xcc,
-	0xfe, 0x45, 0x35, 0xd6, 0xe1, 0x35, 0x02, 0xea, 0x77, 0x48, 0x65, 0x4c, 0x3b, 0x06, 0x89, 0x09,
-	0x96, 0x58, 0x43, 0x9c, 0x05, 0x20, 0x42, 0x62, 0x19, 0xea, 0x7b, 0x58, 0xef, 0xee, 0xf4, 0x08,
-	0xf8, 0x21, 0x21, 0x10, 0x94, 0x05, 0x31, 0xbc, 0x7d, 0x27, 0xb8, 0x47, 0xb9, 0x17, 0x52, 0xd9,
-	0xeb, 0x73, 0xc0, 0x43, 0xe0, 0x9a, 0x63, 0xcf, 0x67, 0xcc, 0x1f, 0x81, 0xa3, 0x4e, 0xfd, 0x70,
-	0xe0, 0x90, 0x90, 0x63, 0x49, 0x59, 0x10, 0xe5, 0x9b, 0xbf, 0x11, 0x2a, 0x76, 0xb5, 0x7b, 0x86,
-	0x83, 0xb6, 0x09, 0x15, 0x1e, 0x9b, 0x02, 0xbf, 0xec, 0x05, 0x78, 0x0c, 0x62, 0x82, 0x3d, 0x30,
-	0x0b, 0x8d, 0x42, 0xab, 0xe4, 0x1a, 0x49, 0xea, 0x53, 0x9c, 0x31, 0x0e, 0x50, 0xf5, 0x3b, 0x96,
-	0xde, 0x45, 0x5a, 0x2c, 0xcc, 0xe5, 0xc6, 0x4a, 0xab, 0xe4, 0x56, 0x54, 0x3c, 0xa9, 0x14, 0x06,
-	0x46, 0xe6, 0x30, 0xec, 0x03, 0x0f, 0x40, 0x82, 0xe8, 0x79, 0x2c, 0x18, 0x50, 0xbf, 0x27, 0x58,
-	0xc8, 0x3d, 0x30, 0x57, 0x1b, 0x85, 0xd6, 0xc6, 0x8b, 0x27, 0x76, 0xf6, 0xb3, 0xd9, 0xf1, 0xad,
-	0xec, 0x8f, 0x09, 0xec, 0x84, 0x13, 0xd1, 0x59, 0x72, 0x77, 0x53, 0xa2, 0x13, 0xc5, 0xd3, 0x55,
-	0x34, 0xc6, 0x39, 0x7a, 0x40, 0x28, 0x07, 0x4f, 0x32, 0x7e, 0x39, 0xa7, 0x70, 0x4f, 0x29, 0x34,
-	0x6e, 0x50, 0x38, 0x8d, 0x51, 0x9d, 0x25, 0xf7, 0x7e, 0x42, 0x91, 0xe3, 0x26, 0xb9, 0xeb, 0x0b,
-	0xf0, 0x38, 0xc8, 0x98, 0x7c, 0x4d, 0x91, 0xb7, 0x6e, 0xbd, 0x7e, 0x57, 0xa1, 0x44, 0xa7, 0x90,
-	0x7d, 0x41, 0x14, 0xd4, 0x2a, 0x9f, 0xd1, 0xf6, 0x14, 0x87, 0x23, 0x39, 0x27, 0xb0, 0xae, 0x04,
-	0x1e, 0xdf, 0x20, 0xf0, 0x65, 0x86, 0x48, 0xb9, 0x6b, 0xd3, 0xf4, 0x7c, 0x9d, 0x31, 0x79, 0xea,
-	0xe2, 0x82, 0xc6, 0x14, 0x32, 0xc6, 0xe4, 0xb8, 0x87, 0xc8, 0xca, 0x18, 0x83, 0xb9, 0xa4, 0x03,
-	0xec, 0x25, 0xf4, 0x25, 0x45, 0xff, 0xf4, 0xf6, 0x2f, 0xab, 0xbc, 0x1e, 0xe3, 0x89, 0xe8, 0x2c,
-	0xbb, 0x19, 0xa7, 0x8f, 0x35, 0x9f, 0x16, 0xfb, 0x8a, 0xea, 0xe9, 0x43, 0xe6, 0xb5, 0xd0, 0x82,
-	0x4f, 0x59, 0x76, 0x53, 0x37, 0xe6, 0xf8, 0x1f, 0xa2, 0x52, 0x9f, 0x06, 0xa4, 0x87, 0x09, 0xe1,
-	0xe6, 0x86, 0x6a, 0xfb, 0xe2, 0x2c, 0x70, 0x4c, 0x08, 0x37, 0xde, 0xa2, 0x32, 0x87, 0x01, 0x07,
-	0x71, 0xd1, 0xe3, 0x58, 0x82, 0x59, 0x56, 0x7a, 0x75, 0x3b, 0x9a, 0x30, 0x3b, 0x9e, 0x30, 0xfb,
-	0x54, 0x4f, 0x98, 0xbb, 0xa1, 0xcb, 0x5d, 0x2c, 0xc1, 0xa8, 0xa3, 0x22, 0x81, 0x69, 0x6f, 0xcc,
-	0x08, 0x98, 0x9b, 0x8d, 0x42, 0xab, 0xe8, 0xae, 0x13, 0x98, 0x9e, 0x31, 0x02, 0x86, 0x89, 0xd6,
-	0x47, 0x34, 0x18, 0x02, 0x27, 0x66, 0x2d, 0xca, 0xe8, 0xa3, 0x71, 0x86, 0xaa, 0x73, 0x63, 0x2d,
-	0xcc, 0x15, 0x25, 0xdb, 0xcc, 0x3f, 0xf3, 0x24, 0xaa, 0x6a, 0x47, 0x45, 0x91, 0x9b, 0x6e, 0xc5,
-	0xcb, 0x45, 0x85, 0x71, 0x84, 0x50, 0xba, 0x64, 0xcc, 0xaa, 0x22, 0x32, 0xf3, 0x44, 0xef, 0x93,
-	0xbc, 0x9b, 0xa9, 0x35, 0x8e, 0x50, 0x31, 0x5e, 0x86, 0xe6, 0x96, 0xc2, 0xed, 0xda, 0x1e, 0xe3,
-	0x90, 0xe0, 0xce, 0x74, 0xb6, 0xbd, 0xfa, 0xeb, 0x6a, 0x7f, 0xc9, 0x4d, 0xaa, 0x8d, 0x0f, 0x68,
-	0x2d, 0xda, 0x89, 0x66, 0x45, 0xe1, 0x76, 0xf2, 0xb8, 0xae, 0xca, 0xb5, 0xeb, 0x33, 0xd4, 0xdf,
-	0xab, 0xfd, 0x9a, 0x04, 0x21, 0x09, 0x1d, 0x0c, 0x5e, 0x37, 0xa9, 0x1f, 0x30, 0x0e, 0x4d, 0x57,
-	0xc3, 0xad, 0x2a, 0xda, 0xca, 0x6f, 0x02, 0x6b, 0x1b, 0xd5, 0xfe, 0x1b, 0x2e, 0x6b, 0x0b, 0x95,
-	0xb3, 0x03, 0x61, 0xed, 0xa2, 0x9d, 0xeb, 0xda, 0xcc, 0x3a, 0x40, 0xa5, 0xa4, 0x25, 0x8c, 0x47,
-	0xa8, 0x94, 0xb4, 0x84, 0x5e, 0x77, 0x69, 0xa0, 0x5d, 0x41, 0x9b, 0xb9, 0x6d, 0x32, 0x0b, 0xe4,
-	0xa6, 0xa8, 0x5d, 0x43, 0x95, 0xb9, 0x6e, 0x6c, 0xbf, 0xfa, 0xf9, 0x67, 0xaf, 0x70, 0xfe, 0x7c,
-	0xb1, 0x15, 0x3e, 0x19, 0xfa, 0x7a, 0x8d, 0xf7, 0xd7, 0x54, 0x1f, 0xbd, 0xfc, 0x17, 0x00, 0x00,
-	0xff, 0xff, 0x11, 0x20, 0xf6, 0xfb, 0x01, 0x07, 0x00, 0x00,
+func (m *Settings_AzureKeyVaultSecrets) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Settings_AzureKeyVaultSecrets.Unmarshal(m, b)
+}
+func (m *Settings_AzureKeyVaultSecrets) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Settings_AzureKeyVaultSecrets.Marshal(b, m, deterministic)
+}
+func (m *Settings_AzureKeyVaultSecrets) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Settings_AzureKeyVaultSecrets.Merge(m, src)
+}
+func (m *Settings_AzureKeyVaultSecrets) XXX_Size() int {
+	return xxx_messageInfo_Settings_AzureKeyVaultSecrets.Size(m)
+}
+func (m *Settings_AzureKeyVaultSecrets) XXX_DiscardUnknown() {
+	xxx_messageInfo_Settings_AzureKeyVaultSecrets.DiscardUnknown(m)
 }
 
-func (this *Settings) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+var xxx_messageInfo_Settings_AzureKeyVaultSecrets proto.InternalMessageInfo
+
+func (m *Settings_AzureKeyVaultSecrets) GetVaultName() string {
+	if m != nil {
+		return m.VaultName
 	}
+	return ""
+}
 
-	that1, ok := that.(*Settings)
-	if !ok {
-		that2, ok := that.(Settings)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *Settings_AzureKeyVaultSecrets) GetTenantId() string {
+	if m != nil {
+		return m.TenantId
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return ""
+}
+
+func (m *Settings_AzureKeyVaultSecrets) GetClientId() string {
+	if m != nil {
+		return m.ClientId
 	}
-	if this.DiscoveryNamespace != that1.DiscoveryNamespace {
-		return false
+	return ""
+}
+
+// Triggers the function discovery of an upstream with a `POST /upstreams/<namespace>/<name>/discover` request,
+// e.g. from a CI pipeline after a deployment, or from a cloud event feed (GCP Audit Logs, AWS EventBridge) when a
+// function changes. The request is answered with `202 Accepted` once the discovery of the upstream is restarted,
+// or `404 Not Found` if the upstream is not discovered.
+type DiscoveryTriggers struct {
+	// The address the endpoint binds to. Defaults to `:9979`.
+	BindAddr string `protobuf:"bytes,1,opt,name=bind_addr,json=bindAddr,proto3" json:"bind_addr,omitempty"`
+	// Required. The requests must carry the token as a bearer token in their `Authorization` header. The secret must be an
+	// extension secret with the token in its `token` field, in a namespace watched by discovery.
+	TokenSecretRef       *core.ResourceRef `protobuf:"bytes,2,opt,name=token_secret_ref,json=tokenSecretRef,proto3" json:"token_secret_ref,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *DiscoveryTriggers) Reset()         { *m = DiscoveryTriggers{} }
+func (m *DiscoveryTriggers) String() string { return proto.CompactTextString(m) }
+func (*DiscoveryTriggers) ProtoMessage()    {}
+func (*DiscoveryTriggers) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd7533c2495e1752, []int{1}
+}
+func (m *DiscoveryTriggers) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DiscoveryTriggers.Unmarshal(m, b)
+}
+func (m *DiscoveryTriggers) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DiscoveryTriggers.Marshal(b, m, deterministic)
+}
+func (m *DiscoveryTriggers) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DiscoveryTriggers.Merge(m, src)
+}
+func (m *DiscoveryTriggers) XXX_Size() int {
+	return xxx_messageInfo_DiscoveryTriggers.Size(m)
+}
+func (m *DiscoveryTriggers) XXX_DiscardUnknown() {
+	xxx_messageInfo_DiscoveryTriggers.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DiscoveryTriggers proto.InternalMessageInfo
+
+func (m *DiscoveryTriggers) GetBindAddr() string {
+	if m != nil {
+		return m.BindAddr
 	}
-	if len(this.WatchNamespaces) != len(that1.WatchNamespaces) {
-		return false
+	return ""
+}
+
+func (m *DiscoveryTriggers) GetTokenSecretRef() *core.ResourceRef {
+	if m != nil {
+		return m.TokenSecretRef
 	}
-	for i := range this.WatchNamespaces {
-		if this.WatchNamespaces[i] != that1.WatchNamespaces[i] {
-			return false
-		}
+	return nil
+}
+
+// Function discovery coalesces the writes to the same upstream within a window into a single write,
+// applying the changes of every discovery to the latest version of the upstream, and limits the rate of its requests
+// to the upstreams.
+type DiscoveryWrites struct {
+	// The window the writes are batched over. Defaults to 1 second.
+	BatchWindow *types.Duration `protobuf:"bytes,1,opt,name=batch_window,json=batchWindow,proto3" json:"batch_window,omitempty"`
+	// The maximum number of upstream reads and writes per second. Defaults to 10.
+	Qps float32 `protobuf:"fixed32,2,opt,name=qps,proto3" json:"qps,omitempty"`
+	// The number of requests allowed at once above the rate. Defaults to the qps.
+	Burst                uint32   `protobuf:"varint,3,opt,name=burst,proto3" json:"burst,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DiscoveryWrites) Reset()         { *m = DiscoveryWrites{} }
+func (m *DiscoveryWrites) String() string { return proto.CompactTextString(m) }
+func (*DiscoveryWrites) ProtoMessage()    {}
+func (*DiscoveryWrites) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd7533c2495e1752, []int{2}
+}
+func (m *DiscoveryWrites) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DiscoveryWrites.Unmarshal(m, b)
+}
+func (m *DiscoveryWrites) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DiscoveryWrites.Marshal(b, m, deterministic)
+}
+func (m *DiscoveryWrites) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DiscoveryWrites.Merge(m, src)
+}
+func (m *DiscoveryWrites) XXX_Size() int {
+	return xxx_messageInfo_DiscoveryWrites.Size(m)
+}
+func (m *DiscoveryWrites) XXX_DiscardUnknown() {
+	xxx_messageInfo_DiscoveryWrites.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DiscoveryWrites proto.InternalMessageInfo
+
+func (m *DiscoveryWrites) GetBatchWindow() *types.Duration {
+	if m != nil {
+		return m.BatchWindow
 	}
-	if that1.ConfigSource == nil {
-		if this.ConfigSource != nil {
-			return false
-		}
-	} else if this.ConfigSource == nil {
-		return false
-	} else if !this.ConfigSource.Equal(that1.ConfigSource) {
-		return false
+	return nil
+}
+
+func (m *DiscoveryWrites) GetQps() float32 {
+	if m != nil {
+		return m.Qps
 	}
-	if that1.SecretSource == nil {
-		if this.SecretSource != nil {
-			return false
-		}
-	} else if this.SecretSource == nil {
-		return false
-	} else if !this.SecretSource.Equal(that1.SecretSource) {
-		return false
+	return 0
+}
+
+func (m *DiscoveryWrites) GetBurst() uint32 {
+	if m != nil {
+		return m.Burst
 	}
-	if that1.ArtifactSource == nil {
-		if this.ArtifactSource != nil {
-			return false
-		}
-	} else if this.ArtifactSource == nil {
-		return false
-	} else if !this.ArtifactSource.Equal(that1.ArtifactSource) {
+	return 0
+}
+
+// Observe-only mode of discovery, to evaluate it on a cluster with existing upstreams. Function discovery only discovers
+// the functions of the upstreams that exist, not of those upstream discovery would create.
+type DiscoveryDryRun struct {
+	// Serves the changes discovery would make as JSON on this address, e.g. `:9980`. Upstream discovery serves the
+	// changes of both discoveries. Not served if empty.
+	BindAddr             string   `protobuf:"bytes,1,opt,name=bind_addr,json=bindAddr,proto3" json:"bind_addr,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DiscoveryDryRun) Reset()         { *m = DiscoveryDryRun{} }
+func (m *DiscoveryDryRun) String() string { return proto.CompactTextString(m) }
+func (*DiscoveryDryRun) ProtoMessage()    {}
+func (*DiscoveryDryRun) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd7533c2495e1752, []int{3}
+}
+func (m *DiscoveryDryRun) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DiscoveryDryRun.Unmarshal(m, b)
+}
+func (m *DiscoveryDryRun) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DiscoveryDryRun.Marshal(b, m, deterministic)
+}
+func (m *DiscoveryDryRun) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DiscoveryDryRun.Merge(m, src)
+}
+func (m *DiscoveryDryRun) XXX_Size() int {
+	return xxx_messageInfo_DiscoveryDryRun.Size(m)
+}
+func (m *DiscoveryDryRun) XXX_DiscardUnknown() {
+	xxx_messageInfo_DiscoveryDryRun.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DiscoveryDryRun proto.InternalMessageInfo
+
+func (m *DiscoveryDryRun) GetBindAddr() string {
+	if m != nil {
+		return m.BindAddr
+	}
+	return ""
+}
+
+// A webhook that discovers the functions of upstreams, to plug a function catalog into function discovery without writing
+// a Go plugin. Function discovery POSTs `{"upstream": <upstream>}` to the webhook, and expects the REST functions of the
+// upstream in response, e.g. `{"functions": [{"name": "getUser", "method": "GET", "path": "/users/{{ id }}"}]}`.
+// Each function may also have `headers` and a `body`, and its path, headers and body are templates of the parameters of
+// the function. The webhook responds with no functions, or with a 404, for the upstreams it doesn't know.
+type FunctionDiscoveryWebhook struct {
+	// Required. The url of the webhook.
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// The timeout of the requests to the webhook. Defaults to 10 seconds.
+	Timeout              *types.Duration `protobuf:"bytes,2,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *FunctionDiscoveryWebhook) Reset()         { *m = FunctionDiscoveryWebhook{} }
+func (m *FunctionDiscoveryWebhook) String() string { return proto.CompactTextString(m) }
+func (*FunctionDiscoveryWebhook) ProtoMessage()    {}
+func (*FunctionDiscoveryWebhook) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd7533c2495e1752, []int{4}
+}
+func (m *FunctionDiscoveryWebhook) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FunctionDiscoveryWebhook.Unmarshal(m, b)
+}
+func (m *FunctionDiscoveryWebhook) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FunctionDiscoveryWebhook.Marshal(b, m, deterministic)
+}
+func (m *FunctionDiscoveryWebhook) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FunctionDiscoveryWebhook.Merge(m, src)
+}
+func (m *FunctionDiscoveryWebhook) XXX_Size() int {
+	return xxx_messageInfo_FunctionDiscoveryWebhook.Size(m)
+}
+func (m *FunctionDiscoveryWebhook) XXX_DiscardUnknown() {
+	xxx_messageInfo_FunctionDiscoveryWebhook.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FunctionDiscoveryWebhook proto.InternalMessageInfo
+
+func (m *FunctionDiscoveryWebhook) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *FunctionDiscoveryWebhook) GetTimeout() *types.Duration {
+	if m != nil {
+		return m.Timeout
+	}
+	return nil
+}
+
+type DiscoveryOptions struct {
+	// Pauses and resumes function discovery. While paused, the upstreams are no longer detected nor their functions
+	// polled, and they keep the functions discovered so far. Once resumed, the upstreams are detected again.
+	FdsMode DiscoveryOptions_Mode `protobuf:"varint,1,opt,name=fds_mode,json=fdsMode,proto3,enum=gloo.solo.io.DiscoveryOptions_Mode" json:"fds_mode,omitempty"`
+	// Pauses and resumes upstream discovery. While paused, the upstreams of new services are not created, nor those of
+	// removed services deleted.
+	UdsMode DiscoveryOptions_Mode `protobuf:"varint,2,opt,name=uds_mode,json=udsMode,proto3,enum=gloo.solo.io.DiscoveryOptions_Mode" json:"uds_mode,omitempty"`
+	// Names the upstreams discovered for the kubernetes services, `namespace-service-port` if empty. A Go template
+	// whose variables are the `.Namespace`, `.Service` and `.Labels` of the service, its `.Port` and `.PortName`, the
+	// `.Subset` of the pods of the upstream (the values of their extra labels joined by dashes, empty for all the pods)
+	// and the `.Pod` of the upstreams of a single pod, e.g. `{{ .Labels.team }}-{{ .Service }}-{{ .PortName }}`.
+	// The names are lowercased and their invalid characters replaced with dashes. The upstreams given the same name are
+	// told apart by a suffix.
+	UpstreamNameTemplate string `protobuf:"bytes,3,opt,name=upstream_name_template,json=upstreamNameTemplate,proto3" json:"upstream_name_template,omitempty"`
+	// Deletes the upstreams of the services that disappeared if not set.
+	StaleUpstreamPolicy  *DiscoveryOptions_StaleUpstreamPolicy `protobuf:"bytes,4,opt,name=stale_upstream_policy,json=staleUpstreamPolicy,proto3" json:"stale_upstream_policy,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                              `json:"-"`
+	XXX_unrecognized     []byte                                `json:"-"`
+	XXX_sizecache        int32                                 `json:"-"`
+}
+
+func (m *DiscoveryOptions) Reset()         { *m = DiscoveryOptions{} }
+func (m *DiscoveryOptions) String() string { return proto.CompactTextString(m) }
+func (*DiscoveryOptions) ProtoMessage()    {}
+func (*DiscoveryOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd7533c2495e1752, []int{5}
+}
+func (m *DiscoveryOptions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DiscoveryOptions.Unmarshal(m, b)
+}
+func (m *DiscoveryOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DiscoveryOptions.Marshal(b, m, deterministic)
+}
+func (m *DiscoveryOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DiscoveryOptions.Merge(m, src)
+}
+func (m *DiscoveryOptions) XXX_Size() int {
+	return xxx_messageInfo_DiscoveryOptions.Size(m)
+}
+func (m *DiscoveryOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_DiscoveryOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DiscoveryOptions proto.InternalMessageInfo
+
+func (m *DiscoveryOptions) GetFdsMode() DiscoveryOptions_Mode {
+	if m != nil {
+		return m.FdsMode
+	}
+	return DiscoveryOptions_ENABLED
+}
+
+func (m *DiscoveryOptions) GetUdsMode() DiscoveryOptions_Mode {
+	if m != nil {
+		return m.UdsMode
+	}
+	return DiscoveryOptions_ENABLED
+}
+
+func (m *DiscoveryOptions) GetUpstreamNameTemplate() string {
+	if m != nil {
+		return m.UpstreamNameTemplate
+	}
+	return ""
+}
+
+func (m *DiscoveryOptions) GetStaleUpstreamPolicy() *DiscoveryOptions_StaleUpstreamPolicy {
+	if m != nil {
+		return m.StaleUpstreamPolicy
+	}
+	return nil
+}
+
+// What upstream discovery does with the upstreams it discovered for services that disappeared
+type DiscoveryOptions_StaleUpstreamPolicy struct {
+	Action DiscoveryOptions_StaleUpstreamPolicy_Action `protobuf:"varint,1,opt,name=action,proto3,enum=gloo.solo.io.DiscoveryOptions_StaleUpstreamPolicy_Action" json:"action,omitempty"`
+	// The grace period of `DELETE_AFTER_GRACE_PERIOD`, 5 minutes if not set.
+	GracePeriod *time.Duration `protobuf:"bytes,2,opt,name=grace_period,json=gracePeriod,proto3,stdduration" json:"grace_period,omitempty"`
+	// Keeps the upstreams still routed to by a proxy or an upstream group, with a warning logged, until they are no
+	// longer referenced, whatever the action.
+	ProtectReferenced    bool     `protobuf:"varint,3,opt,name=protect_referenced,json=protectReferenced,proto3" json:"protect_referenced,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DiscoveryOptions_StaleUpstreamPolicy) Reset()         { *m = DiscoveryOptions_StaleUpstreamPolicy{} }
+func (m *DiscoveryOptions_StaleUpstreamPolicy) String() string { return proto.CompactTextString(m) }
+func (*DiscoveryOptions_StaleUpstreamPolicy) ProtoMessage()    {}
+func (*DiscoveryOptions_StaleUpstreamPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd7533c2495e1752, []int{5, 0}
+}
+func (m *DiscoveryOptions_StaleUpstreamPolicy) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DiscoveryOptions_StaleUpstreamPolicy.Unmarshal(m, b)
+}
+func (m *DiscoveryOptions_StaleUpstreamPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DiscoveryOptions_StaleUpstreamPolicy.Marshal(b, m, deterministic)
+}
+func (m *DiscoveryOptions_StaleUpstreamPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DiscoveryOptions_StaleUpstreamPolicy.Merge(m, src)
+}
+func (m *DiscoveryOptions_StaleUpstreamPolicy) XXX_Size() int {
+	return xxx_messageInfo_DiscoveryOptions_StaleUpstreamPolicy.Size(m)
+}
+func (m *DiscoveryOptions_StaleUpstreamPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_DiscoveryOptions_StaleUpstreamPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DiscoveryOptions_StaleUpstreamPolicy proto.InternalMessageInfo
+
+func (m *DiscoveryOptions_StaleUpstreamPolicy) GetAction() DiscoveryOptions_StaleUpstreamPolicy_Action {
+	if m != nil {
+		return m.Action
+	}
+	return DiscoveryOptions_StaleUpstreamPolicy_DELETE
+}
+
+func (m *DiscoveryOptions_StaleUpstreamPolicy) GetGracePeriod() *time.Duration {
+	if m != nil {
+		return m.GracePeriod
+	}
+	return nil
+}
+
+func (m *DiscoveryOptions_StaleUpstreamPolicy) GetProtectReferenced() bool {
+	if m != nil {
+		return m.ProtectReferenced
+	}
+	return false
+}
+
+type DiscoveryProbes struct {
+	// The maximum number of upstreams whose type or functions are discovered at once. The other upstreams wait for
+	// their turn: new upstreams go first, then the upstreams whose last attempt failed, then the polls of the upstreams
+	// already discovered. Not limited if 0.
+	MaxConcurrentUpstreams uint32 `protobuf:"varint,1,opt,name=max_concurrent_upstreams,json=maxConcurrentUpstreams,proto3" json:"max_concurrent_upstreams,omitempty"`
+	// The maximum number of requests per second function discovery sends to the upstreams and webhooks, to detect
+	// their type and poll their functions. Not limited if 0.
+	Qps float32 `protobuf:"fixed32,2,opt,name=qps,proto3" json:"qps,omitempty"`
+	// The number of requests allowed at once above the rate. Defaults to the qps.
+	Burst                uint32   `protobuf:"varint,3,opt,name=burst,proto3" json:"burst,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DiscoveryProbes) Reset()         { *m = DiscoveryProbes{} }
+func (m *DiscoveryProbes) String() string { return proto.CompactTextString(m) }
+func (*DiscoveryProbes) ProtoMessage()    {}
+func (*DiscoveryProbes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd7533c2495e1752, []int{6}
+}
+func (m *DiscoveryProbes) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DiscoveryProbes.Unmarshal(m, b)
+}
+func (m *DiscoveryProbes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DiscoveryProbes.Marshal(b, m, deterministic)
+}
+func (m *DiscoveryProbes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DiscoveryProbes.Merge(m, src)
+}
+func (m *DiscoveryProbes) XXX_Size() int {
+	return xxx_messageInfo_DiscoveryProbes.Size(m)
+}
+func (m *DiscoveryProbes) XXX_DiscardUnknown() {
+	xxx_messageInfo_DiscoveryProbes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DiscoveryProbes proto.InternalMessageInfo
+
+func (m *DiscoveryProbes) GetMaxConcurrentUpstreams() uint32 {
+	if m != nil {
+		return m.MaxConcurrentUpstreams
+	}
+	return 0
+}
+
+func (m *DiscoveryProbes) GetQps() float32 {
+	if m != nil {
+		return m.Qps
+	}
+	return 0
+}
+
+func (m *DiscoveryProbes) GetBurst() uint32 {
+	if m != nil {
+		return m.Burst
+	}
+	return 0
+}
+
+type XdsSanitization struct {
+	// The roles of the proxies this entry applies to, as `namespace~name`. Applies to all proxies if empty.
+	ProxyRoles []string `protobuf:"bytes,1,rep,name=proxy_roles,json=proxyRoles,proto3" json:"proxy_roles,omitempty"`
+	// Removes the resources carrying the value of a secret (TLS private keys, cloud credentials, etc.) from the
+	// snapshots, for proxies that are not trusted with secrets. The listeners and clusters depending on them are removed too.
+	StripSecrets bool `protobuf:"varint,2,opt,name=strip_secrets,json=stripSecrets,proto3" json:"strip_secrets,omitempty"`
+	// Rejects the snapshots with more resources than these caps. The proxy keeps its last accepted snapshot.
+	ResourceCaps         *XdsSanitization_ResourceCaps `protobuf:"bytes,3,opt,name=resource_caps,json=resourceCaps,proto3" json:"resource_caps,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
+	XXX_unrecognized     []byte                        `json:"-"`
+	XXX_sizecache        int32                         `json:"-"`
+}
+
+func (m *XdsSanitization) Reset()         { *m = XdsSanitization{} }
+func (m *XdsSanitization) String() string { return proto.CompactTextString(m) }
+func (*XdsSanitization) ProtoMessage()    {}
+func (*XdsSanitization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd7533c2495e1752, []int{7}
+}
+func (m *XdsSanitization) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_XdsSanitization.Unmarshal(m, b)
+}
+func (m *XdsSanitization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_XdsSanitization.Marshal(b, m, deterministic)
+}
+func (m *XdsSanitization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_XdsSanitization.Merge(m, src)
+}
+func (m *XdsSanitization) XXX_Size() int {
+	return xxx_messageInfo_XdsSanitization.Size(m)
+}
+func (m *XdsSanitization) XXX_DiscardUnknown() {
+	xxx_messageInfo_XdsSanitization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_XdsSanitization proto.InternalMessageInfo
+
+func (m *XdsSanitization) GetProxyRoles() []string {
+	if m != nil {
+		return m.ProxyRoles
+	}
+	return nil
+}
+
+func (m *XdsSanitization) GetStripSecrets() bool {
+	if m != nil {
+		return m.StripSecrets
+	}
+	return false
+}
+
+func (m *XdsSanitization) GetResourceCaps() *XdsSanitization_ResourceCaps {
+	if m != nil {
+		return m.ResourceCaps
+	}
+	return nil
+}
+
+type XdsSanitization_ResourceCaps struct {
+	// The maximum number of clusters. Not capped if 0.
+	MaxClusters uint32 `protobuf:"varint,1,opt,name=max_clusters,json=maxClusters,proto3" json:"max_clusters,omitempty"`
+	// The maximum number of cluster load assignments. Not capped if 0.
+	MaxEndpoints uint32 `protobuf:"varint,2,opt,name=max_endpoints,json=maxEndpoints,proto3" json:"max_endpoints,omitempty"`
+	// The maximum number of listeners. Not capped if 0.
+	MaxListeners uint32 `protobuf:"varint,3,opt,name=max_listeners,json=maxListeners,proto3" json:"max_listeners,omitempty"`
+	// The maximum number of route configurations. Not capped if 0.
+	MaxRoutes            uint32   `protobuf:"varint,4,opt,name=max_routes,json=maxRoutes,proto3" json:"max_routes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *XdsSanitization_ResourceCaps) Reset()         { *m = XdsSanitization_ResourceCaps{} }
+func (m *XdsSanitization_ResourceCaps) String() string { return proto.CompactTextString(m) }
+func (*XdsSanitization_ResourceCaps) ProtoMessage()    {}
+func (*XdsSanitization_ResourceCaps) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd7533c2495e1752, []int{7, 0}
+}
+func (m *XdsSanitization_ResourceCaps) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_XdsSanitization_ResourceCaps.Unmarshal(m, b)
+}
+func (m *XdsSanitization_ResourceCaps) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_XdsSanitization_ResourceCaps.Marshal(b, m, deterministic)
+}
+func (m *XdsSanitization_ResourceCaps) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_XdsSanitization_ResourceCaps.Merge(m, src)
+}
+func (m *XdsSanitization_ResourceCaps) XXX_Size() int {
+	return xxx_messageInfo_XdsSanitization_ResourceCaps.Size(m)
+}
+func (m *XdsSanitization_ResourceCaps) XXX_DiscardUnknown() {
+	xxx_messageInfo_XdsSanitization_ResourceCaps.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_XdsSanitization_ResourceCaps proto.InternalMessageInfo
+
+func (m *XdsSanitization_ResourceCaps) GetMaxClusters() uint32 {
+	if m != nil {
+		return m.MaxClusters
+	}
+	return 0
+}
+
+func (m *XdsSanitization_ResourceCaps) GetMaxEndpoints() uint32 {
+	if m != nil {
+		return m.MaxEndpoints
+	}
+	return 0
+}
+
+func (m *XdsSanitization_ResourceCaps) GetMaxListeners() uint32 {
+	if m != nil {
+		return m.MaxListeners
+	}
+	return 0
+}
+
+func (m *XdsSanitization_ResourceCaps) GetMaxRoutes() uint32 {
+	if m != nil {
+		return m.MaxRoutes
+	}
+	return 0
+}
+
+// Publishes the domains of the virtual services bound to the gateways of this installation to a DNS provider,
+// with records pointing at the address of the gateway proxy service: `A` records for an IP address, `CNAME` records
+// for a hostname. Each published record is claimed with a `TXT` record named `_gloo-owner.<domain>` (`_gloo-owner-wildcard.<domain>`
+// for a wildcard domain), and only records claimed with the same owner id are updated, or deleted once their domain is
+// no longer used by a virtual service. Records created by other tools or installations are left untouched.
+type DnsPublishing struct {
+	// The id written in the ownership records, must be unique among the installations publishing to the same zone.
+	OwnerId string `protobuf:"bytes,1,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	// The name of the gateway proxy service in the write namespace. Defaults to `gateway-proxy`.
+	GatewayService string `protobuf:"bytes,2,opt,name=gateway_service,json=gatewayService,proto3" json:"gateway_service,omitempty"`
+	// The TTL of the published records, in seconds. Defaults to 300.
+	Ttl uint32 `protobuf:"varint,3,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	// How often the records are reconciled with the provider. Defaults to 1 minute.
+	Interval *types.Duration `protobuf:"bytes,4,opt,name=interval,proto3" json:"interval,omitempty"`
+	// Types that are valid to be assigned to Provider:
+	//	*DnsPublishing_Route53_
+	//	*DnsPublishing_CloudDns_
+	Provider             isDnsPublishing_Provider `protobuf_oneof:"provider"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *DnsPublishing) Reset()         { *m = DnsPublishing{} }
+func (m *DnsPublishing) String() string { return proto.CompactTextString(m) }
+func (*DnsPublishing) ProtoMessage()    {}
+func (*DnsPublishing) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd7533c2495e1752, []int{8}
+}
+func (m *DnsPublishing) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DnsPublishing.Unmarshal(m, b)
+}
+func (m *DnsPublishing) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DnsPublishing.Marshal(b, m, deterministic)
+}
+func (m *DnsPublishing) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DnsPublishing.Merge(m, src)
+}
+func (m *DnsPublishing) XXX_Size() int {
+	return xxx_messageInfo_DnsPublishing.Size(m)
+}
+func (m *DnsPublishing) XXX_DiscardUnknown() {
+	xxx_messageInfo_DnsPublishing.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DnsPublishing proto.InternalMessageInfo
+
+type isDnsPublishing_Provider interface {
+	isDnsPublishing_Provider()
+	Equal(interface{}) bool
+}
+
+type DnsPublishing_Route53_ struct {
+	Route53 *DnsPublishing_Route53 `protobuf:"bytes,5,opt,name=route53,proto3,oneof"`
+}
+type DnsPublishing_CloudDns_ struct {
+	CloudDns *DnsPublishing_CloudDns `protobuf:"bytes,6,opt,name=cloud_dns,json=cloudDns,proto3,oneof"`
+}
+
+func (*DnsPublishing_Route53_) isDnsPublishing_Provider()  {}
+func (*DnsPublishing_CloudDns_) isDnsPublishing_Provider() {}
+
+func (m *DnsPublishing) GetProvider() isDnsPublishing_Provider {
+	if m != nil {
+		return m.Provider
+	}
+	return nil
+}
+
+func (m *DnsPublishing) GetOwnerId() string {
+	if m != nil {
+		return m.OwnerId
+	}
+	return ""
+}
+
+func (m *DnsPublishing) GetGatewayService() string {
+	if m != nil {
+		return m.GatewayService
+	}
+	return ""
+}
+
+func (m *DnsPublishing) GetTtl() uint32 {
+	if m != nil {
+		return m.Ttl
+	}
+	return 0
+}
+
+func (m *DnsPublishing) GetInterval() *types.Duration {
+	if m != nil {
+		return m.Interval
+	}
+	return nil
+}
+
+func (m *DnsPublishing) GetRoute53() *DnsPublishing_Route53 {
+	if x, ok := m.GetProvider().(*DnsPublishing_Route53_); ok {
+		return x.Route53
+	}
+	return nil
+}
+
+func (m *DnsPublishing) GetCloudDns() *DnsPublishing_CloudDns {
+	if x, ok := m.GetProvider().(*DnsPublishing_CloudDns_); ok {
+		return x.CloudDns
+	}
+	return nil
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*DnsPublishing) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _DnsPublishing_OneofMarshaler, _DnsPublishing_OneofUnmarshaler, _DnsPublishing_OneofSizer, []interface{}{
+		(*DnsPublishing_Route53_)(nil),
+		(*DnsPublishing_CloudDns_)(nil),
+	}
+}
+
+func _DnsPublishing_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*DnsPublishing)
+	// provider
+	switch x := m.Provider.(type) {
+	case *DnsPublishing_Route53_:
+		_ = b.EncodeVarint(5<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Route53); err != nil {
+			return err
+		}
+	case *DnsPublishing_CloudDns_:
+		_ = b.EncodeVarint(6<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CloudDns); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("DnsPublishing.Provider has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _DnsPublishing_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*DnsPublishing)
+	switch tag {
+	case 5: // provider.route53
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(DnsPublishing_Route53)
+		err := b.DecodeMessage(msg)
+		m.Provider = &DnsPublishing_Route53_{msg}
+		return true, err
+	case 6: // provider.cloud_dns
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(DnsPublishing_CloudDns)
+		err := b.DecodeMessage(msg)
+		m.Provider = &DnsPublishing_CloudDns_{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _DnsPublishing_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*DnsPublishing)
+	// provider
+	switch x := m.Provider.(type) {
+	case *DnsPublishing_Route53_:
+		s := proto.Size(x.Route53)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *DnsPublishing_CloudDns_:
+		s := proto.Size(x.CloudDns)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+// Publishes the records in an AWS Route53 hosted zone, with the credentials of the environment Gloo is running in
+// (IAM Roles for Service Accounts or the AWS default credential chain).
+type DnsPublishing_Route53 struct {
+	// The id of the hosted zone, e.g. `Z1D633PJN98FT9`.
+	HostedZoneId string `protobuf:"bytes,1,opt,name=hosted_zone_id,json=hostedZoneId,proto3" json:"hosted_zone_id,omitempty"`
+	// (Optional) The ARN of an IAM Role to assume via STS to manage the records.
+	RoleArn              string   `protobuf:"bytes,2,opt,name=role_arn,json=roleArn,proto3" json:"role_arn,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DnsPublishing_Route53) Reset()         { *m = DnsPublishing_Route53{} }
+func (m *DnsPublishing_Route53) String() string { return proto.CompactTextString(m) }
+func (*DnsPublishing_Route53) ProtoMessage()    {}
+func (*DnsPublishing_Route53) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd7533c2495e1752, []int{8, 0}
+}
+func (m *DnsPublishing_Route53) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DnsPublishing_Route53.Unmarshal(m, b)
+}
+func (m *DnsPublishing_Route53) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DnsPublishing_Route53.Marshal(b, m, deterministic)
+}
+func (m *DnsPublishing_Route53) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DnsPublishing_Route53.Merge(m, src)
+}
+func (m *DnsPublishing_Route53) XXX_Size() int {
+	return xxx_messageInfo_DnsPublishing_Route53.Size(m)
+}
+func (m *DnsPublishing_Route53) XXX_DiscardUnknown() {
+	xxx_messageInfo_DnsPublishing_Route53.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DnsPublishing_Route53 proto.InternalMessageInfo
+
+func (m *DnsPublishing_Route53) GetHostedZoneId() string {
+	if m != nil {
+		return m.HostedZoneId
+	}
+	return ""
+}
+
+func (m *DnsPublishing_Route53) GetRoleArn() string {
+	if m != nil {
+		return m.RoleArn
+	}
+	return ""
+}
+
+// Publishes the records in a Google Cloud DNS managed zone, with the application default credentials.
+type DnsPublishing_CloudDns struct {
+	// The id of the GCP project of the zone.
+	Project string `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	// The name of the managed zone.
+	ManagedZone          string   `protobuf:"bytes,2,opt,name=managed_zone,json=managedZone,proto3" json:"managed_zone,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DnsPublishing_CloudDns) Reset()         { *m = DnsPublishing_CloudDns{} }
+func (m *DnsPublishing_CloudDns) String() string { return proto.CompactTextString(m) }
+func (*DnsPublishing_CloudDns) ProtoMessage()    {}
+func (*DnsPublishing_CloudDns) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd7533c2495e1752, []int{8, 1}
+}
+func (m *DnsPublishing_CloudDns) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DnsPublishing_CloudDns.Unmarshal(m, b)
+}
+func (m *DnsPublishing_CloudDns) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DnsPublishing_CloudDns.Marshal(b, m, deterministic)
+}
+func (m *DnsPublishing_CloudDns) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DnsPublishing_CloudDns.Merge(m, src)
+}
+func (m *DnsPublishing_CloudDns) XXX_Size() int {
+	return xxx_messageInfo_DnsPublishing_CloudDns.Size(m)
+}
+func (m *DnsPublishing_CloudDns) XXX_DiscardUnknown() {
+	xxx_messageInfo_DnsPublishing_CloudDns.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DnsPublishing_CloudDns proto.InternalMessageInfo
+
+func (m *DnsPublishing_CloudDns) GetProject() string {
+	if m != nil {
+		return m.Project
+	}
+	return ""
+}
+
+func (m *DnsPublishing_CloudDns) GetManagedZone() string {
+	if m != nil {
+		return m.ManagedZone
+	}
+	return ""
+}
+
+// The Nomad cluster whose services are discovered. The ACL token of the requests to the Nomad API is read from the
+// `NOMAD_TOKEN` environment variable, if set.
+type NomadConfiguration struct {
+	// The address of the Nomad HTTP API, e.g. `http://nomad.service:4646`. Defaults to the `NOMAD_ADDR` environment
+	// variable, or `http://127.0.0.1:4646`.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// The Nomad namespaces whose services are discovered. Defaults to all the namespaces.
+	Namespaces           []string `protobuf:"bytes,2,rep,name=namespaces,proto3" json:"namespaces,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NomadConfiguration) Reset()         { *m = NomadConfiguration{} }
+func (m *NomadConfiguration) String() string { return proto.CompactTextString(m) }
+func (*NomadConfiguration) ProtoMessage()    {}
+func (*NomadConfiguration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd7533c2495e1752, []int{9}
+}
+func (m *NomadConfiguration) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NomadConfiguration.Unmarshal(m, b)
+}
+func (m *NomadConfiguration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NomadConfiguration.Marshal(b, m, deterministic)
+}
+func (m *NomadConfiguration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NomadConfiguration.Merge(m, src)
+}
+func (m *NomadConfiguration) XXX_Size() int {
+	return xxx_messageInfo_NomadConfiguration.Size(m)
+}
+func (m *NomadConfiguration) XXX_DiscardUnknown() {
+	xxx_messageInfo_NomadConfiguration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NomadConfiguration proto.InternalMessageInfo
+
+func (m *NomadConfiguration) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *NomadConfiguration) GetNamespaces() []string {
+	if m != nil {
+		return m.Namespaces
+	}
+	return nil
+}
+
+// The upstreams are static upstreams whose hosts are the containers, or the Swarm service, they are discovered for.
+// Their labels configure them:
+// - `gloo.solo.io/port`: the port of the containers, required if the containers expose several ports or none
+// - `gloo.solo.io/upstream`: the name of the upstream, shared by the containers serving it. Defaults to the Compose
+// project and service of the container, or to the name of the container or Swarm service
+// - `gloo.solo.io/network`: the network of the containers whose address is routed to. Defaults to their first network
+// - `gloo.solo.io/h2_service`: `"true"` if the containers serve HTTP/2
+type DockerConfiguration struct {
+	// The address of the Docker API, e.g. `unix:///var/run/docker.sock` or `tcp://docker:2375`. Defaults to the
+	// `DOCKER_HOST` environment variable, or `unix:///var/run/docker.sock`.
+	Host string `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	// Discovers the services of the Swarm the Docker engine is a manager of, instead of its containers. The hosts of
+	// their upstreams are the names of the services, resolved by the Docker DNS on the networks Gloo shares with them.
+	Swarm                bool     `protobuf:"varint,2,opt,name=swarm,proto3" json:"swarm,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DockerConfiguration) Reset()         { *m = DockerConfiguration{} }
+func (m *DockerConfiguration) String() string { return proto.CompactTextString(m) }
+func (*DockerConfiguration) ProtoMessage()    {}
+func (*DockerConfiguration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd7533c2495e1752, []int{10}
+}
+func (m *DockerConfiguration) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DockerConfiguration.Unmarshal(m, b)
+}
+func (m *DockerConfiguration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DockerConfiguration.Marshal(b, m, deterministic)
+}
+func (m *DockerConfiguration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DockerConfiguration.Merge(m, src)
+}
+func (m *DockerConfiguration) XXX_Size() int {
+	return xxx_messageInfo_DockerConfiguration.Size(m)
+}
+func (m *DockerConfiguration) XXX_DiscardUnknown() {
+	xxx_messageInfo_DockerConfiguration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DockerConfiguration proto.InternalMessageInfo
+
+func (m *DockerConfiguration) GetHost() string {
+	if m != nil {
+		return m.Host
+	}
+	return ""
+}
+
+func (m *DockerConfiguration) GetSwarm() bool {
+	if m != nil {
+		return m.Swarm
+	}
+	return false
+}
+
+// Selects the namespaces whose Kubernetes services are synthesized as upstreams.
+type KubeServiceUpstreams struct {
+	// The namespaces whose services are synthesized as upstreams. Defaults to all the namespaces.
+	AllowedNamespaces []string `protobuf:"bytes,1,rep,name=allowed_namespaces,json=allowedNamespaces,proto3" json:"allowed_namespaces,omitempty"`
+	// The namespaces whose services are never synthesized as upstreams, e.g. `kube-system`.
+	DeniedNamespaces     []string `protobuf:"bytes,2,rep,name=denied_namespaces,json=deniedNamespaces,proto3" json:"denied_namespaces,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *KubeServiceUpstreams) Reset()         { *m = KubeServiceUpstreams{} }
+func (m *KubeServiceUpstreams) String() string { return proto.CompactTextString(m) }
+func (*KubeServiceUpstreams) ProtoMessage()    {}
+func (*KubeServiceUpstreams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd7533c2495e1752, []int{11}
+}
+func (m *KubeServiceUpstreams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_KubeServiceUpstreams.Unmarshal(m, b)
+}
+func (m *KubeServiceUpstreams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_KubeServiceUpstreams.Marshal(b, m, deterministic)
+}
+func (m *KubeServiceUpstreams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KubeServiceUpstreams.Merge(m, src)
+}
+func (m *KubeServiceUpstreams) XXX_Size() int {
+	return xxx_messageInfo_KubeServiceUpstreams.Size(m)
+}
+func (m *KubeServiceUpstreams) XXX_DiscardUnknown() {
+	xxx_messageInfo_KubeServiceUpstreams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KubeServiceUpstreams proto.InternalMessageInfo
+
+func (m *KubeServiceUpstreams) GetAllowedNamespaces() []string {
+	if m != nil {
+		return m.AllowedNamespaces
+	}
+	return nil
+}
+
+func (m *KubeServiceUpstreams) GetDeniedNamespaces() []string {
+	if m != nil {
+		return m.DeniedNamespaces
+	}
+	return nil
+}
+
+// Options of the server of the wasm filter modules pulled from image registries.
+// The images are pulled anonymously over https, once per image reference: reference the images by digest, or by a new
+// tag, to update a filter. The images are pulled in the background: the proxies referencing an image are rejected until
+// it is pulled, and the failed pulls are retried with an exponential backoff. The registries on the loopback interface
+// of gloo are refused.
+type WasmOptions struct {
+	// The address gloo serves the modules on. Defaults to `:9979`.
+	ImageCacheBindAddr string `protobuf:"bytes,1,opt,name=image_cache_bind_addr,json=imageCacheBindAddr,proto3" json:"image_cache_bind_addr,omitempty"`
+	// The address envoy fetches the modules from, which must reach the server of the modules. Defaults to
+	// `gloo.<namespace of gloo>.svc.cluster.local` with the port of the bind address.
+	ImageCacheAddress    string   `protobuf:"bytes,2,opt,name=image_cache_address,json=imageCacheAddress,proto3" json:"image_cache_address,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WasmOptions) Reset()         { *m = WasmOptions{} }
+func (m *WasmOptions) String() string { return proto.CompactTextString(m) }
+func (*WasmOptions) ProtoMessage()    {}
+func (*WasmOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd7533c2495e1752, []int{12}
+}
+func (m *WasmOptions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WasmOptions.Unmarshal(m, b)
+}
+func (m *WasmOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WasmOptions.Marshal(b, m, deterministic)
+}
+func (m *WasmOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WasmOptions.Merge(m, src)
+}
+func (m *WasmOptions) XXX_Size() int {
+	return xxx_messageInfo_WasmOptions.Size(m)
+}
+func (m *WasmOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_WasmOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WasmOptions proto.InternalMessageInfo
+
+func (m *WasmOptions) GetImageCacheBindAddr() string {
+	if m != nil {
+		return m.ImageCacheBindAddr
+	}
+	return ""
+}
+
+func (m *WasmOptions) GetImageCacheAddress() string {
+	if m != nil {
+		return m.ImageCacheAddress
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("gloo.solo.io.DiscoveryOptions_Mode", DiscoveryOptions_Mode_name, DiscoveryOptions_Mode_value)
+	proto.RegisterEnum("gloo.solo.io.DiscoveryOptions_StaleUpstreamPolicy_Action", DiscoveryOptions_StaleUpstreamPolicy_Action_name, DiscoveryOptions_StaleUpstreamPolicy_Action_value)
+	proto.RegisterType((*Settings)(nil), "gloo.solo.io.Settings")
+	proto.RegisterType((*Settings_KubernetesCrds)(nil), "gloo.solo.io.Settings.KubernetesCrds")
+	proto.RegisterType((*Settings_KubernetesSecrets)(nil), "gloo.solo.io.Settings.KubernetesSecrets")
+	proto.RegisterType((*Settings_VaultSecrets)(nil), "gloo.solo.io.Settings.VaultSecrets")
+	proto.RegisterType((*Settings_KubernetesConfigmaps)(nil), "gloo.solo.io.Settings.KubernetesConfigmaps")
+	proto.RegisterType((*Settings_Directory)(nil), "gloo.solo.io.Settings.Directory")
+	proto.RegisterType((*Settings_AzureKeyVaultSecrets)(nil), "gloo.solo.io.Settings.AzureKeyVaultSecrets")
+	proto.RegisterType((*DiscoveryTriggers)(nil), "gloo.solo.io.DiscoveryTriggers")
+	proto.RegisterType((*DiscoveryWrites)(nil), "gloo.solo.io.DiscoveryWrites")
+	proto.RegisterType((*DiscoveryDryRun)(nil), "gloo.solo.io.DiscoveryDryRun")
+	proto.RegisterType((*FunctionDiscoveryWebhook)(nil), "gloo.solo.io.FunctionDiscoveryWebhook")
+	proto.RegisterType((*DiscoveryOptions)(nil), "gloo.solo.io.DiscoveryOptions")
+	proto.RegisterType((*DiscoveryOptions_StaleUpstreamPolicy)(nil), "gloo.solo.io.DiscoveryOptions.StaleUpstreamPolicy")
+	proto.RegisterType((*DiscoveryProbes)(nil), "gloo.solo.io.DiscoveryProbes")
+	proto.RegisterType((*XdsSanitization)(nil), "gloo.solo.io.XdsSanitization")
+	proto.RegisterType((*XdsSanitization_ResourceCaps)(nil), "gloo.solo.io.XdsSanitization.ResourceCaps")
+	proto.RegisterType((*DnsPublishing)(nil), "gloo.solo.io.DnsPublishing")
+	proto.RegisterType((*DnsPublishing_Route53)(nil), "gloo.solo.io.DnsPublishing.Route53")
+	proto.RegisterType((*DnsPublishing_CloudDns)(nil), "gloo.solo.io.DnsPublishing.CloudDns")
+	proto.RegisterType((*NomadConfiguration)(nil), "gloo.solo.io.NomadConfiguration")
+	proto.RegisterType((*DockerConfiguration)(nil), "gloo.solo.io.DockerConfiguration")
+	proto.RegisterType((*KubeServiceUpstreams)(nil), "gloo.solo.io.KubeServiceUpstreams")
+	proto.RegisterType((*WasmOptions)(nil), "gloo.solo.io.WasmOptions")
+}
+
+func init() {
+	proto.RegisterFile("github.com/solo-io/gloo/projects/gloo/api/v1/settings.proto", fileDescriptor_bd7533c2495e1752)
+}
+
+var fileDescriptor_bd7533c2495e1752 = []byte{
+	// 2191 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x58, 0xeb, 0x52, 0x1b, 0xc9,
+	0x15, 0x46, 0x02, 0x83, 0x38, 0xdc, 0xa4, 0x06, 0xe3, 0x41, 0x5e, 0x1b, 0x2c, 0xdb, 0xbb, 0x6c,
+	0x36, 0x16, 0xb1, 0x9d, 0xdd, 0xf2, 0x6e, 0x76, 0xe3, 0x92, 0x00, 0x1b, 0x62, 0x1b, 0x93, 0xc6,
+	0x8e, 0xb7, 0xfc, 0x23, 0x53, 0xcd, 0x74, 0x4b, 0x4c, 0x24, 0x4d, 0x4f, 0xba, 0x7b, 0x10, 0xf8,
+	0x49, 0x52, 0xa9, 0x3c, 0x40, 0xde, 0x23, 0x95, 0xaa, 0x3c, 0xc5, 0xa6, 0x2a, 0x4f, 0x90, 0x4a,
+	0xe5, 0x01, 0xb6, 0xfa, 0x32, 0x9a, 0x91, 0xb8, 0x18, 0xff, 0xd2, 0xf4, 0x39, 0xdf, 0x77, 0x4e,
+	0xf7, 0xe9, 0xe9, 0x3e, 0x9f, 0x06, 0x7e, 0xd3, 0x0e, 0xd5, 0x51, 0x72, 0x58, 0x0f, 0x78, 0x6f,
+	0x43, 0xf2, 0x2e, 0x7f, 0x10, 0xf2, 0x8d, 0x76, 0x97, 0xf3, 0x8d, 0x58, 0xf0, 0x3f, 0xb1, 0x40,
+	0x49, 0x3b, 0x22, 0x71, 0xb8, 0x71, 0xfc, 0x70, 0x43, 0x32, 0xa5, 0xc2, 0xa8, 0x2d, 0xeb, 0xb1,
+	0xe0, 0x8a, 0xa3, 0x59, 0xed, 0xab, 0x6b, 0x5a, 0x3d, 0xe4, 0xd5, 0xa5, 0x36, 0x6f, 0x73, 0xe3,
+	0xd8, 0xd0, 0x4f, 0x16, 0x53, 0x7d, 0x78, 0x4e, 0x02, 0xf3, 0xdb, 0x09, 0x55, 0x1a, 0xb6, 0xc7,
+	0x14, 0xa1, 0x44, 0x11, 0x47, 0xd9, 0xb8, 0x02, 0x45, 0x2a, 0xa2, 0x12, 0x37, 0x8f, 0xea, 0x2f,
+	0xaf, 0x40, 0x10, 0xac, 0xe5, 0xd0, 0x3f, 0x7c, 0xd2, 0x92, 0xd9, 0x89, 0x62, 0x91, 0x0c, 0x79,
+	0x94, 0x26, 0x6b, 0x7e, 0x12, 0x3d, 0x08, 0x45, 0x90, 0x84, 0xca, 0x3f, 0x14, 0x8c, 0x74, 0x98,
+	0x70, 0x31, 0x6e, 0xb7, 0x39, 0x6f, 0x77, 0xd9, 0x86, 0x19, 0x1d, 0x26, 0xad, 0x0d, 0x9a, 0x08,
+	0xa2, 0x42, 0x1e, 0x59, 0x7f, 0xed, 0xff, 0xcb, 0x50, 0x3a, 0x70, 0xb5, 0x46, 0x1b, 0xb0, 0x48,
+	0x43, 0x19, 0xf0, 0x63, 0x26, 0x4e, 0xfd, 0x88, 0xf4, 0x98, 0x8c, 0x49, 0xc0, 0xbc, 0xc2, 0x5a,
+	0x61, 0x7d, 0x1a, 0xa3, 0x81, 0x6b, 0x2f, 0xf5, 0xa0, 0x2f, 0xa1, 0xdc, 0x27, 0x2a, 0x38, 0xca,
+	0xc0, 0xd2, 0x2b, 0xae, 0x8d, 0xaf, 0x4f, 0xe3, 0x05, 0x63, 0x1f, 0x20, 0x25, 0x22, 0xe0, 0x75,
+	0x92, 0x43, 0x26, 0x22, 0xa6, 0x98, 0xf4, 0x03, 0x1e, 0xb5, 0xc2, 0xb6, 0x2f, 0x79, 0x22, 0x02,
+	0xe6, 0x4d, 0xac, 0x15, 0xd6, 0x67, 0x1e, 0xdd, 0xaf, 0xe7, 0x37, 0xb9, 0x9e, 0xce, 0xaa, 0xfe,
+	0x62, 0x40, 0xdb, 0x14, 0x54, 0xee, 0x8c, 0xe1, 0xe5, 0x2c, 0xd0, 0xa6, 0x89, 0x73, 0x60, 0xc2,
+	0xa0, 0xf7, 0x70, 0x83, 0x86, 0x82, 0x05, 0x8a, 0x8b, 0xd3, 0x91, 0x0c, 0xd7, 0x4c, 0x86, 0xb5,
+	0x0b, 0x32, 0x6c, 0xa5, 0xac, 0x9d, 0x31, 0x7c, 0x7d, 0x10, 0x62, 0x28, 0x36, 0x1d, 0x9a, 0xbe,
+	0x64, 0x81, 0x60, 0x2a, 0x0d, 0x3e, 0x69, 0x82, 0xaf, 0x7f, 0x74, 0xfa, 0x07, 0x86, 0x25, 0x77,
+	0x0a, 0xf9, 0x15, 0x58, 0xa3, 0xcb, 0xf2, 0x16, 0x16, 0x8f, 0x49, 0xd2, 0x55, 0x23, 0x09, 0xa6,
+	0x4c, 0x82, 0xbb, 0x17, 0x24, 0xf8, 0x83, 0x66, 0x64, 0xb1, 0x2b, 0xc7, 0xd9, 0xf8, 0xbc, 0xc2,
+	0x0c, 0x87, 0x2e, 0x5d, 0xb1, 0x30, 0x85, 0x5c, 0x61, 0x86, 0x62, 0x73, 0xb8, 0x45, 0x3e, 0x24,
+	0x82, 0xf9, 0x1d, 0x76, 0xea, 0x9f, 0x37, 0xf9, 0x25, 0x93, 0xe1, 0xab, 0x0b, 0x32, 0x34, 0x34,
+	0xf7, 0x05, 0x3b, 0x1d, 0x59, 0xc4, 0x0a, 0x39, 0x6b, 0x77, 0x09, 0x3b, 0x50, 0xcd, 0xed, 0x04,
+	0x11, 0x2a, 0x6c, 0x91, 0x60, 0x90, 0x6d, 0xfa, 0xd2, 0x6c, 0x2f, 0x46, 0x5e, 0x9c, 0x1e, 0x89,
+	0xe5, 0x4e, 0x11, 0xe7, 0xb6, 0xb6, 0xe1, 0xe2, 0xb9, 0x64, 0x7f, 0x84, 0x95, 0xac, 0x72, 0xa3,
+	0xb9, 0xe0, 0x8a, 0xb5, 0x2b, 0xe2, 0xac, 0xfc, 0x23, 0xf1, 0x6f, 0xc2, 0xf4, 0x61, 0x18, 0x51,
+	0x9f, 0x50, 0x2a, 0xbc, 0x19, 0x73, 0xce, 0x4a, 0xda, 0xd0, 0xa0, 0x54, 0xa0, 0xef, 0x61, 0x56,
+	0xb0, 0x96, 0x60, 0xf2, 0xc8, 0x17, 0x44, 0x31, 0x6f, 0xd6, 0xe4, 0x5b, 0xa9, 0xdb, 0x23, 0x5d,
+	0x4f, 0x8f, 0x74, 0x7d, 0xcb, 0x1d, 0x69, 0x3c, 0xe3, 0xe0, 0x98, 0x28, 0x86, 0x56, 0xa0, 0x44,
+	0xd9, 0xb1, 0xdf, 0xe3, 0x94, 0x79, 0x73, 0x6b, 0x85, 0xf5, 0x12, 0x9e, 0xa2, 0xec, 0xf8, 0x15,
+	0xa7, 0x0c, 0x79, 0x30, 0xd5, 0x0d, 0xa3, 0x0e, 0x13, 0xd4, 0xab, 0x58, 0x8f, 0x1b, 0xa2, 0x87,
+	0xb0, 0x94, 0x5e, 0x91, 0x3e, 0x89, 0x22, 0xae, 0x4c, 0x60, 0xe9, 0x21, 0x73, 0xa8, 0x17, 0x53,
+	0x5f, 0x23, 0x73, 0xa1, 0x26, 0xcc, 0xd3, 0x48, 0xfa, 0x71, 0x72, 0xd8, 0x0d, 0xe5, 0x51, 0x18,
+	0xb5, 0xbd, 0x45, 0x33, 0xcf, 0x9b, 0xc3, 0x75, 0xd9, 0x8a, 0xe4, 0xfe, 0x00, 0x82, 0xe7, 0x68,
+	0x7e, 0x88, 0xf6, 0x20, 0xbb, 0x5d, 0x7c, 0x25, 0xc2, 0x76, 0x9b, 0x09, 0xe9, 0x5d, 0x37, 0x71,
+	0x56, 0x47, 0xe2, 0xa4, 0xb8, 0x37, 0x0e, 0x86, 0x2b, 0x74, 0xd4, 0x84, 0x76, 0xa0, 0x9c, 0xc5,
+	0xeb, 0x8b, 0x50, 0x31, 0xe9, 0x2d, 0x9b, 0x68, 0xb7, 0x2e, 0x88, 0xf6, 0xce, 0x80, 0xf0, 0x02,
+	0x1d, 0x36, 0xa0, 0x5d, 0xc8, 0xc2, 0xfb, 0x54, 0x9c, 0xfa, 0x22, 0x89, 0xbc, 0x1b, 0x97, 0x86,
+	0xda, 0x12, 0xa7, 0x38, 0x89, 0x72, 0xa1, 0xac, 0x01, 0xb5, 0xe0, 0x66, 0x2b, 0x89, 0x02, 0x5d,
+	0x35, 0x3f, 0x37, 0x3b, 0x76, 0x78, 0xc4, 0x79, 0x47, 0x7a, 0xde, 0xda, 0xf8, 0xfa, 0xcc, 0xa3,
+	0xcf, 0x87, 0x83, 0x3e, 0x73, 0x84, 0x6c, 0x9e, 0x16, 0x8e, 0x57, 0x5a, 0x17, 0x78, 0x46, 0x16,
+	0x1f, 0x0b, 0x7e, 0xc8, 0xa4, 0xb7, 0x72, 0xe9, 0x8c, 0xf7, 0x0d, 0x28, 0x37, 0x63, 0x6b, 0xd0,
+	0x91, 0x4e, 0xa8, 0xf4, 0x25, 0x89, 0x42, 0x15, 0x7e, 0x30, 0xfb, 0xed, 0x55, 0xd7, 0xc6, 0xcf,
+	0x46, 0xfa, 0x91, 0xca, 0x83, 0x1c, 0x08, 0x2f, 0x9c, 0x0c, 0x1b, 0xd0, 0x06, 0x2c, 0x75, 0x18,
+	0x8b, 0xfd, 0x2e, 0x91, 0xca, 0xef, 0x44, 0xbc, 0x1f, 0xf9, 0x6d, 0xce, 0xa9, 0x77, 0xd3, 0xbc,
+	0x7e, 0x15, 0xed, 0x7b, 0x49, 0xa4, 0x7a, 0xa1, 0x3d, 0xcf, 0x39, 0xa7, 0xe8, 0x07, 0xb8, 0xd9,
+	0x27, 0xa1, 0xf2, 0x5b, 0x5c, 0xf8, 0x49, 0x2c, 0x95, 0x60, 0xa4, 0xe7, 0xb3, 0x88, 0xc6, 0x3c,
+	0x8c, 0x94, 0xf4, 0x3e, 0x33, 0x3c, 0x4f, 0x43, 0x9e, 0x71, 0xf1, 0xd6, 0x01, 0xb6, 0x53, 0x3f,
+	0xba, 0x0f, 0xf3, 0x83, 0x5a, 0xeb, 0x06, 0x2e, 0xbd, 0x5b, 0x86, 0x31, 0x97, 0x5a, 0x0f, 0xb4,
+	0x11, 0x7d, 0x0f, 0xd3, 0x83, 0x35, 0x7b, 0xb7, 0x4d, 0x8d, 0x6e, 0x5f, 0x50, 0xa3, 0xd7, 0xb1,
+	0xa6, 0x49, 0x9c, 0x11, 0xd0, 0x37, 0x70, 0x2d, 0xe2, 0x3d, 0x42, 0xbd, 0xd5, 0xf3, 0x2e, 0x82,
+	0x3d, 0xed, 0xb2, 0xd7, 0x4c, 0x7a, 0x3e, 0x2d, 0x1c, 0x7d, 0x0b, 0x93, 0x94, 0x07, 0x1d, 0x26,
+	0xbc, 0x35, 0x43, 0xbc, 0x33, 0x92, 0xd2, 0xf8, 0x86, 0x99, 0x8e, 0x80, 0x5e, 0x03, 0x1a, 0x54,
+	0x63, 0x70, 0xa7, 0x78, 0x77, 0xae, 0x76, 0x11, 0xe1, 0x4a, 0xca, 0x1d, 0x98, 0xd0, 0x13, 0xf0,
+	0x02, 0x1e, 0xc9, 0xa4, 0xeb, 0x4b, 0x26, 0x8e, 0xc3, 0x80, 0x0d, 0xaa, 0x2d, 0xbd, 0x9a, 0x29,
+	0xd9, 0xb2, 0xf5, 0x1f, 0x58, 0x77, 0x5a, 0x6a, 0x89, 0xbe, 0x83, 0x95, 0x58, 0xf0, 0x13, 0x7d,
+	0x5e, 0x49, 0x24, 0xbb, 0x66, 0x9e, 0x7e, 0x9f, 0x8b, 0x8e, 0x3e, 0xba, 0x77, 0xd7, 0x0a, 0xeb,
+	0x73, 0xf8, 0x86, 0x01, 0xbc, 0xc9, 0xfc, 0xef, 0xac, 0x1b, 0xad, 0xc2, 0x8c, 0xa4, 0x69, 0x1b,
+	0x95, 0xde, 0x3d, 0x93, 0x08, 0x24, 0x4d, 0x5b, 0x24, 0x7a, 0x00, 0x13, 0x7d, 0x22, 0x7b, 0xde,
+	0xfd, 0xf4, 0xca, 0xcb, 0xaf, 0xec, 0x1d, 0x91, 0xbd, 0x74, 0x3b, 0x0c, 0x4c, 0xeb, 0x10, 0x16,
+	0x1d, 0xf3, 0x53, 0x3f, 0xd3, 0x50, 0xde, 0xe7, 0x56, 0x87, 0x18, 0xfb, 0xf6, 0xc0, 0x8c, 0x7e,
+	0x04, 0xd3, 0x7c, 0xcf, 0x59, 0xee, 0x17, 0x26, 0x57, 0x6d, 0x38, 0x97, 0xee, 0x18, 0xa3, 0x4b,
+	0xc7, 0x4b, 0x9d, 0x73, 0xac, 0xe8, 0x15, 0x94, 0x47, 0x34, 0x98, 0xf4, 0xc6, 0xcf, 0x8b, 0xb9,
+	0x69, 0x51, 0x4d, 0x0b, 0xb2, 0x1b, 0x8d, 0x17, 0x82, 0x21, 0xab, 0x44, 0x4f, 0x00, 0x72, 0xab,
+	0x29, 0x9b, 0x40, 0xde, 0x70, 0xa0, 0x6c, 0x59, 0x38, 0x87, 0x45, 0x4f, 0xa0, 0x94, 0x5e, 0xd4,
+	0xde, 0xbc, 0xe1, 0x2d, 0xd7, 0x03, 0x2e, 0xd8, 0x80, 0xf7, 0xca, 0x79, 0x9b, 0x13, 0xff, 0xfa,
+	0x69, 0x75, 0x0c, 0x0f, 0xd0, 0xe8, 0x39, 0x4c, 0x5a, 0xb9, 0xeb, 0x2d, 0x18, 0xde, 0xd2, 0x30,
+	0xef, 0xc0, 0xf8, 0x9a, 0x2b, 0x9a, 0xf5, 0xbf, 0x9f, 0x56, 0x2b, 0x8a, 0x49, 0x45, 0xc3, 0x56,
+	0xeb, 0xbb, 0x5a, 0xd8, 0x8e, 0xb8, 0x60, 0x35, 0xec, 0xe8, 0xd5, 0x32, 0xcc, 0x0f, 0xcb, 0xb6,
+	0xea, 0x22, 0x54, 0xce, 0x28, 0xa1, 0xea, 0x3c, 0xcc, 0xe6, 0x1b, 0x7f, 0x75, 0x19, 0x96, 0xce,
+	0x6b, 0xd1, 0xd5, 0x2f, 0x61, 0x3a, 0x7b, 0x65, 0x3f, 0xd3, 0x87, 0xd6, 0x0d, 0x9c, 0x36, 0xcd,
+	0x0c, 0x55, 0x0e, 0x4b, 0xe7, 0x69, 0x0a, 0x74, 0x0b, 0xc0, 0xaa, 0x13, 0x2d, 0x55, 0x53, 0x9a,
+	0xb1, 0x68, 0x91, 0xaa, 0x1b, 0xb1, 0x62, 0x11, 0x89, 0x94, 0x1f, 0x52, 0xaf, 0x68, 0x1b, 0xb1,
+	0x35, 0xec, 0x52, 0xed, 0x0c, 0xba, 0x21, 0xb3, 0xce, 0x71, 0xeb, 0xb4, 0x86, 0x5d, 0xda, 0x5c,
+	0x80, 0xb9, 0x21, 0xad, 0xa9, 0x0d, 0x43, 0x0a, 0xa8, 0x59, 0x81, 0x85, 0x11, 0xe9, 0x50, 0x4b,
+	0xa0, 0x72, 0xa6, 0x91, 0x0d, 0x8b, 0x81, 0xc2, 0x88, 0x18, 0xd8, 0x84, 0xb2, 0xe2, 0x1d, 0x16,
+	0xa5, 0xea, 0x4a, 0xb0, 0x96, 0x57, 0x74, 0xa7, 0x63, 0x68, 0x93, 0x30, 0xb3, 0x39, 0x30, 0x6b,
+	0xe1, 0x79, 0x43, 0xb1, 0x25, 0xc0, 0xac, 0x55, 0xeb, 0xc3, 0xc2, 0x48, 0xc7, 0xd3, 0x22, 0xe3,
+	0xd0, 0x48, 0xf8, 0x7e, 0x18, 0x51, 0xde, 0xf7, 0x0a, 0x2e, 0xe6, 0xc5, 0x22, 0xc3, 0xc0, 0xdf,
+	0x19, 0x34, 0x2a, 0xc3, 0xf8, 0x9f, 0x63, 0x69, 0x26, 0x52, 0xc4, 0xfa, 0x11, 0x2d, 0xc1, 0xb5,
+	0xc3, 0x44, 0x48, 0x65, 0xea, 0x34, 0x87, 0xed, 0xa0, 0x56, 0xcf, 0x25, 0x76, 0xed, 0xf0, 0xb2,
+	0xd5, 0xd6, 0x08, 0x78, 0x17, 0xb5, 0x3e, 0x9d, 0x33, 0x11, 0x5d, 0x47, 0xd1, 0x8f, 0xe8, 0x31,
+	0x4c, 0xa9, 0xb0, 0xc7, 0x78, 0xa2, 0xbc, 0xe2, 0xc7, 0xa6, 0x9f, 0x22, 0x6b, 0xff, 0x9d, 0x80,
+	0xf2, 0xe8, 0xed, 0x8e, 0x7e, 0x0b, 0xa5, 0x16, 0x95, 0x56, 0x34, 0xe9, 0x04, 0xf3, 0xa3, 0xaa,
+	0x7b, 0x94, 0x51, 0xd7, 0x82, 0x0a, 0x4f, 0xb5, 0xa8, 0xd4, 0x0f, 0x9a, 0x9f, 0xa4, 0xfc, 0xe2,
+	0x27, 0xf0, 0x13, 0xc7, 0xff, 0x35, 0x2c, 0x0f, 0xee, 0x77, 0xfd, 0xa2, 0xfa, 0x8a, 0xf5, 0xe2,
+	0xae, 0x16, 0x7f, 0xf6, 0xb5, 0x5b, 0x4a, 0xbd, 0xfa, 0xa5, 0x7d, 0xe3, 0x7c, 0xa8, 0x05, 0xd7,
+	0xa5, 0x22, 0xdd, 0xec, 0x32, 0xf3, 0x63, 0xde, 0x0d, 0x83, 0x53, 0xf7, 0xc7, 0xea, 0xd1, 0x47,
+	0xa6, 0x70, 0xa0, 0xb9, 0xe9, 0x3d, 0xb6, 0x6f, 0x98, 0x78, 0x51, 0x9e, 0x35, 0x56, 0xff, 0x56,
+	0x84, 0xc5, 0x73, 0xc0, 0xe8, 0xf7, 0x30, 0x49, 0xcc, 0x5e, 0xb9, 0x9a, 0x7d, 0xfb, 0xe9, 0x09,
+	0xeb, 0x8d, 0xc0, 0x36, 0x3a, 0x1b, 0x08, 0x35, 0x61, 0xb6, 0x2d, 0x48, 0xc0, 0xfc, 0x98, 0x89,
+	0x90, 0xd3, 0x8f, 0xee, 0x6b, 0x73, 0xe2, 0x2f, 0xff, 0x5e, 0x2d, 0xe0, 0x19, 0x43, 0xda, 0x37,
+	0x1c, 0xf4, 0x00, 0x90, 0xc6, 0xb1, 0xc0, 0x9c, 0x16, 0x26, 0x58, 0x14, 0x30, 0x7b, 0x7e, 0x4b,
+	0xb8, 0xe2, 0x3c, 0x78, 0xe0, 0xa8, 0x3d, 0x85, 0x49, 0x3b, 0x09, 0x04, 0x30, 0xb9, 0xb5, 0xfd,
+	0x72, 0xfb, 0xcd, 0x76, 0x79, 0x0c, 0xdd, 0x82, 0x15, 0xfb, 0xec, 0x37, 0x9e, 0xbd, 0xd9, 0xc6,
+	0xfe, 0x73, 0xdc, 0xd8, 0xdc, 0xf6, 0xf7, 0xb7, 0xf1, 0xee, 0xeb, 0xad, 0x72, 0x41, 0x43, 0x5f,
+	0xe3, 0xfd, 0x9d, 0xc6, 0x5e, 0xb9, 0x58, 0x5b, 0x85, 0x09, 0xb3, 0x89, 0x33, 0x30, 0xb5, 0xbd,
+	0xd7, 0x68, 0xbe, 0xdc, 0xde, 0x2a, 0x8f, 0x69, 0xc0, 0x7e, 0xe3, 0xed, 0xc1, 0xf6, 0x56, 0xb9,
+	0x50, 0x93, 0xb9, 0x53, 0xe0, 0x24, 0xd6, 0x13, 0xf0, 0x7a, 0xe4, 0x44, 0xff, 0x5b, 0x0d, 0x12,
+	0x21, 0xf4, 0x15, 0x93, 0x35, 0xa4, 0x82, 0x39, 0x41, 0xcb, 0x3d, 0x72, 0xb2, 0x39, 0x70, 0x67,
+	0xed, 0xe6, 0xaa, 0x47, 0xef, 0x9f, 0x45, 0x58, 0x18, 0xd1, 0x67, 0xba, 0xff, 0xda, 0xde, 0x2d,
+	0x78, 0x97, 0xe9, 0x44, 0xba, 0x55, 0x82, 0x31, 0x61, 0x6d, 0x41, 0x77, 0x61, 0x4e, 0x2a, 0x11,
+	0xc6, 0x83, 0x16, 0x5d, 0x34, 0x55, 0x9b, 0x35, 0xc6, 0xf4, 0x4a, 0x7d, 0x0d, 0x73, 0xc2, 0x5d,
+	0x36, 0x7e, 0x40, 0xe2, 0xb4, 0xdb, 0xfd, 0xe2, 0x52, 0x6d, 0x38, 0xb8, 0x9f, 0x36, 0x49, 0x2c,
+	0xf1, 0xac, 0xc8, 0x8d, 0xaa, 0x7f, 0x2d, 0xc0, 0x6c, 0xde, 0x8d, 0xee, 0xc0, 0xac, 0xa9, 0x4e,
+	0x37, 0x91, 0x8a, 0x89, 0xb4, 0x22, 0x33, 0xba, 0x22, 0xce, 0xa4, 0x67, 0xaa, 0x21, 0x99, 0x34,
+	0x2c, 0x1a, 0x8c, 0xe6, 0x65, 0x72, 0xd0, 0x81, 0xba, 0xa1, 0x54, 0x2c, 0x4a, 0xfb, 0xb2, 0x05,
+	0xbd, 0x4c, 0x6d, 0xba, 0x43, 0x68, 0x90, 0xe0, 0x89, 0xfe, 0xbb, 0x30, 0x61, 0x10, 0xd3, 0x3d,
+	0x72, 0x82, 0x8d, 0xa1, 0xf6, 0x8f, 0x71, 0x98, 0x1b, 0xfa, 0x13, 0xa3, 0xff, 0x61, 0xf1, 0x7e,
+	0xc4, 0x84, 0xee, 0x0a, 0xf6, 0x36, 0x9a, 0x32, 0xe3, 0x5d, 0x8a, 0xbe, 0x80, 0x85, 0x36, 0x51,
+	0xac, 0x4f, 0x4e, 0x53, 0xa1, 0xe1, 0x9a, 0xca, 0xbc, 0x33, 0x3b, 0xf5, 0xa0, 0x77, 0x51, 0xa9,
+	0xae, 0x9b, 0x8f, 0x7e, 0x44, 0x5f, 0x43, 0x29, 0x8c, 0x14, 0x13, 0xc7, 0xa4, 0xeb, 0x4d, 0x7c,
+	0xe4, 0xad, 0xc7, 0x03, 0x28, 0x7a, 0x0a, 0x53, 0x66, 0xe6, 0x5f, 0x3f, 0x76, 0x1f, 0x3b, 0xee,
+	0x5e, 0xf2, 0xff, 0xab, 0x8e, 0x2d, 0x74, 0x67, 0x0c, 0xa7, 0x2c, 0xb4, 0xa9, 0x9b, 0x1c, 0x4f,
+	0xa8, 0x4f, 0x23, 0xe9, 0x3e, 0x69, 0xdc, 0xbb, 0x2c, 0xc4, 0xa6, 0x06, 0x6f, 0x45, 0xfa, 0x83,
+	0x4c, 0x29, 0x70, 0xcf, 0xd5, 0xdf, 0xc1, 0x94, 0x0b, 0x8d, 0xee, 0xc1, 0xfc, 0x11, 0x97, 0x8a,
+	0x51, 0xff, 0x03, 0x8f, 0x58, 0x56, 0xa3, 0x59, 0x6b, 0x7d, 0xcf, 0x23, 0xb6, 0x4b, 0x75, 0x0d,
+	0xf5, 0x3b, 0xe8, 0x13, 0x11, 0xb9, 0x0a, 0x4d, 0xe9, 0x71, 0x43, 0x44, 0xd5, 0xe7, 0x50, 0x4a,
+	0x73, 0xe8, 0x7f, 0xac, 0xee, 0xa3, 0x57, 0x5a, 0x69, 0x37, 0xb4, 0xaf, 0x48, 0x44, 0xda, 0x2e,
+	0x8f, 0x0b, 0x32, 0xe3, 0x6c, 0x3a, 0x4b, 0x13, 0xa0, 0x14, 0x0b, 0x7e, 0x1c, 0x52, 0x26, 0x6a,
+	0x7b, 0x80, 0xce, 0x0a, 0x73, 0x1d, 0x5e, 0xb7, 0x21, 0x26, 0x65, 0x1a, 0xde, 0x0d, 0xd1, 0x6d,
+	0x80, 0x33, 0xdf, 0xb6, 0x72, 0x96, 0xda, 0x53, 0x58, 0x3c, 0x47, 0xaf, 0x23, 0x04, 0x13, 0x7a,
+	0x99, 0x2e, 0x9a, 0x79, 0xd6, 0xc7, 0x53, 0xf6, 0x89, 0xe8, 0xb9, 0xb3, 0x64, 0x07, 0x35, 0x61,
+	0x25, 0xcf, 0x19, 0x35, 0xf9, 0x00, 0x10, 0xe9, 0x76, 0x79, 0x9f, 0xd1, 0xfc, 0xc7, 0x35, 0x7b,
+	0x52, 0x2b, 0xce, 0x93, 0xfb, 0xbc, 0xf6, 0x15, 0x54, 0x28, 0x8b, 0xc2, 0x61, 0xb4, 0x9d, 0x6e,
+	0xd9, 0x3a, 0x32, 0x70, 0x2d, 0x86, 0x99, 0x9c, 0x86, 0x46, 0x0f, 0xe1, 0x7a, 0xd8, 0x23, 0x6d,
+	0x7d, 0x88, 0x83, 0x23, 0xe6, 0x8f, 0x76, 0x65, 0x64, 0x9c, 0x9b, 0xda, 0xd7, 0x4c, 0xd5, 0x48,
+	0x1d, 0x16, 0xf3, 0x94, 0xb4, 0x78, 0xb6, 0xf8, 0x95, 0x8c, 0xd0, 0xb0, 0x8e, 0xe6, 0x37, 0x7f,
+	0xff, 0xcf, 0xed, 0xc2, 0xfb, 0x5f, 0x5d, 0xed, 0x83, 0x66, 0xdc, 0x69, 0xbb, 0x8f, 0x9a, 0x87,
+	0x93, 0xe6, 0x95, 0x7f, 0xfc, 0xf3, 0x00, 0x49, 0x21, 0x00, 0x2b, 0x3d, 0x16, 0x00, 0x00,
+}
+
+func (this *Settings) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Settings)
+	if !ok {
+		that2, ok := that.(Settings)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.DiscoveryNamespace != that1.DiscoveryNamespace {
+		return false
+	}
+	if len(this.WatchNamespaces) != len(that1.WatchNamespaces) {
+		return false
+	}
+	for i := range this.WatchNamespaces {
+		if this.WatchNamespaces[i] != that1.WatchNamespaces[i] {
+			return false
+		}
+	}
+	if that1.ConfigSource == nil {
+		if this.ConfigSource != nil {
+			return false
+		}
+	} else if this.ConfigSource == nil {
+		return false
+	} else if !this.ConfigSource.Equal(that1.ConfigSource) {
+		return false
+	}
+	if that1.SecretSource == nil {
+		if this.SecretSource != nil {
+			return false
+		}
+	} else if this.SecretSource == nil {
+		return false
+	} else if !this.SecretSource.Equal(that1.SecretSource) {
+		return false
+	}
+	if that1.ArtifactSource == nil {
+		if this.ArtifactSource != nil {
+			return false
+		}
+	} else if this.ArtifactSource == nil {
+		return false
+	} else if !this.ArtifactSource.Equal(that1.ArtifactSource) {
+		return false
+	}
+	if this.BindAddr != that1.BindAddr {
+		return false
+	}
+	if !this.RefreshRate.Equal(that1.RefreshRate) {
+		return false
+	}
+	if this.DevMode != that1.DevMode {
+		return false
+	}
+	if this.Linkerd != that1.Linkerd {
+		return false
+	}
+	if len(this.MetadataAnnotations) != len(that1.MetadataAnnotations) {
+		return false
+	}
+	for i := range this.MetadataAnnotations {
+		if this.MetadataAnnotations[i] != that1.MetadataAnnotations[i] {
+			return false
+		}
+	}
+	if !this.DnsPublishing.Equal(that1.DnsPublishing) {
+		return false
+	}
+	if !this.DiscoveryTriggers.Equal(that1.DiscoveryTriggers) {
+		return false
+	}
+	if !this.DiscoveryWrites.Equal(that1.DiscoveryWrites) {
+		return false
+	}
+	if !this.DiscoveryDryRun.Equal(that1.DiscoveryDryRun) {
+		return false
+	}
+	if len(this.FunctionDiscoveryWebhooks) != len(that1.FunctionDiscoveryWebhooks) {
+		return false
+	}
+	for i := range this.FunctionDiscoveryWebhooks {
+		if !this.FunctionDiscoveryWebhooks[i].Equal(that1.FunctionDiscoveryWebhooks[i]) {
+			return false
+		}
+	}
+	if !this.DiscoveryProbes.Equal(that1.DiscoveryProbes) {
+		return false
+	}
+	if len(this.XdsSanitization) != len(that1.XdsSanitization) {
+		return false
+	}
+	for i := range this.XdsSanitization {
+		if !this.XdsSanitization[i].Equal(that1.XdsSanitization[i]) {
+			return false
+		}
+	}
+	if this.KeepLastKnownGood != that1.KeepLastKnownGood {
+		return false
+	}
+	if this.WaitForUpstreamEndpoints != that1.WaitForUpstreamEndpoints {
+		return false
+	}
+	if this.FunctionStats != that1.FunctionStats {
+		return false
+	}
+	if !this.Discovery.Equal(that1.Discovery) {
+		return false
+	}
+	if !this.Nomad.Equal(that1.Nomad) {
+		return false
+	}
+	if !this.Docker.Equal(that1.Docker) {
+		return false
+	}
+	if !this.UpstreamDirectory.Equal(that1.UpstreamDirectory) {
+		return false
+	}
+	if this.ConsulServiceUpstreams != that1.ConsulServiceUpstreams {
+		return false
+	}
+	if this.ProxyTranslationWorkers != that1.ProxyTranslationWorkers {
+		return false
+	}
+	if this.SdsSecrets != that1.SdsSecrets {
+		return false
+	}
+	if !this.Wasm.Equal(that1.Wasm) {
+		return false
+	}
+	if len(this.EnvoyExtensions) != len(that1.EnvoyExtensions) {
+		return false
+	}
+	for i := range this.EnvoyExtensions {
+		if this.EnvoyExtensions[i] != that1.EnvoyExtensions[i] {
+			return false
+		}
+	}
+	if !this.KubeServiceUpstreams.Equal(that1.KubeServiceUpstreams) {
+		return false
+	}
+	if !this.CircuitBreakers.Equal(that1.CircuitBreakers) {
+		return false
+	}
+	if !this.Extensions.Equal(that1.Extensions) {
+		return false
+	}
+	if !this.Metadata.Equal(&that1.Metadata) {
+		return false
+	}
+	if !this.Status.Equal(&that1.Status) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *Settings_KubernetesConfigSource) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Settings_KubernetesConfigSource)
+	if !ok {
+		that2, ok := that.(Settings_KubernetesConfigSource)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.KubernetesConfigSource.Equal(that1.KubernetesConfigSource) {
+		return false
+	}
+	return true
+}
+func (this *Settings_DirectoryConfigSource) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Settings_DirectoryConfigSource)
+	if !ok {
+		that2, ok := that.(Settings_DirectoryConfigSource)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.DirectoryConfigSource.Equal(that1.DirectoryConfigSource) {
+		return false
+	}
+	return true
+}
+func (this *Settings_KubernetesSecretSource) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Settings_KubernetesSecretSource)
+	if !ok {
+		that2, ok := that.(Settings_KubernetesSecretSource)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.KubernetesSecretSource.Equal(that1.KubernetesSecretSource) {
+		return false
+	}
+	return true
+}
+func (this *Settings_VaultSecretSource) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Settings_VaultSecretSource)
+	if !ok {
+		that2, ok := that.(Settings_VaultSecretSource)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.VaultSecretSource.Equal(that1.VaultSecretSource) {
+		return false
+	}
+	return true
+}
+func (this *Settings_DirectorySecretSource) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Settings_DirectorySecretSource)
+	if !ok {
+		that2, ok := that.(Settings_DirectorySecretSource)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.DirectorySecretSource.Equal(that1.DirectorySecretSource) {
+		return false
+	}
+	return true
+}
+func (this *Settings_AzureKeyVaultSecretSource) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Settings_AzureKeyVaultSecretSource)
+	if !ok {
+		that2, ok := that.(Settings_AzureKeyVaultSecretSource)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.AzureKeyVaultSecretSource.Equal(that1.AzureKeyVaultSecretSource) {
+		return false
+	}
+	return true
+}
+func (this *Settings_KubernetesArtifactSource) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Settings_KubernetesArtifactSource)
+	if !ok {
+		that2, ok := that.(Settings_KubernetesArtifactSource)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.KubernetesArtifactSource.Equal(that1.KubernetesArtifactSource) {
+		return false
+	}
+	return true
+}
+func (this *Settings_DirectoryArtifactSource) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Settings_DirectoryArtifactSource)
+	if !ok {
+		that2, ok := that.(Settings_DirectoryArtifactSource)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.DirectoryArtifactSource.Equal(that1.DirectoryArtifactSource) {
+		return false
+	}
+	return true
+}
+func (this *Settings_KubernetesCrds) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Settings_KubernetesCrds)
+	if !ok {
+		that2, ok := that.(Settings_KubernetesCrds)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *Settings_KubernetesSecrets) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Settings_KubernetesSecrets)
+	if !ok {
+		that2, ok := that.(Settings_KubernetesSecrets)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *Settings_VaultSecrets) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Settings_VaultSecrets)
+	if !ok {
+		that2, ok := that.(Settings_VaultSecrets)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *Settings_KubernetesConfigmaps) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Settings_KubernetesConfigmaps)
+	if !ok {
+		that2, ok := that.(Settings_KubernetesConfigmaps)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *Settings_Directory) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Settings_Directory)
+	if !ok {
+		that2, ok := that.(Settings_Directory)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Directory != that1.Directory {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *Settings_AzureKeyVaultSecrets) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Settings_AzureKeyVaultSecrets)
+	if !ok {
+		that2, ok := that.(Settings_AzureKeyVaultSecrets)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.VaultName != that1.VaultName {
+		return false
+	}
+	if this.TenantId != that1.TenantId {
+		return false
+	}
+	if this.ClientId != that1.ClientId {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *DiscoveryTriggers) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DiscoveryTriggers)
+	if !ok {
+		that2, ok := that.(DiscoveryTriggers)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
 		return false
 	}
 	if this.BindAddr != that1.BindAddr {
 		return false
 	}
-	if !this.RefreshRate.Equal(that1.RefreshRate) {
+	if !this.TokenSecretRef.Equal(that1.TokenSecretRef) {
 		return false
 	}
-	if this.DevMode != that1.DevMode {
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
-	if this.Linkerd != that1.Linkerd {
+	return true
+}
+func (this *DiscoveryWrites) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DiscoveryWrites)
+	if !ok {
+		that2, ok := that.(DiscoveryWrites)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
 		return false
 	}
-	if !this.CircuitBreakers.Equal(that1.CircuitBreakers) {
+	if !this.BatchWindow.Equal(that1.BatchWindow) {
 		return false
 	}
-	if !this.Extensions.Equal(that1.Extensions) {
+	if this.Qps != that1.Qps {
 		return false
 	}
-	if !this.Metadata.Equal(&that1.Metadata) {
+	if this.Burst != that1.Burst {
 		return false
 	}
-	if !this.Status.Equal(&that1.Status) {
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *DiscoveryDryRun) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DiscoveryDryRun)
+	if !ok {
+		that2, ok := that.(DiscoveryDryRun)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.BindAddr != that1.BindAddr {
 		return false
 	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
@@ -782,14 +2814,14 @@ func (this *Settings) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *Settings_KubernetesConfigSource) Equal(that interface{}) bool {
+func (this *FunctionDiscoveryWebhook) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*Settings_KubernetesConfigSource)
+	that1, ok := that.(*FunctionDiscoveryWebhook)
 	if !ok {
-		that2, ok := that.(Settings_KubernetesConfigSource)
+		that2, ok := that.(FunctionDiscoveryWebhook)
 		if ok {
 			that1 = &that2
 		} else {
@@ -801,19 +2833,25 @@ func (this *Settings_KubernetesConfigSource) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.KubernetesConfigSource.Equal(that1.KubernetesConfigSource) {
+	if this.Url != that1.Url {
+		return false
+	}
+	if !this.Timeout.Equal(that1.Timeout) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
 	return true
 }
-func (this *Settings_DirectoryConfigSource) Equal(that interface{}) bool {
+func (this *DiscoveryOptions) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*Settings_DirectoryConfigSource)
+	that1, ok := that.(*DiscoveryOptions)
 	if !ok {
-		that2, ok := that.(Settings_DirectoryConfigSource)
+		that2, ok := that.(DiscoveryOptions)
 		if ok {
 			that1 = &that2
 		} else {
@@ -825,19 +2863,31 @@ func (this *Settings_DirectoryConfigSource) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.DirectoryConfigSource.Equal(that1.DirectoryConfigSource) {
+	if this.FdsMode != that1.FdsMode {
+		return false
+	}
+	if this.UdsMode != that1.UdsMode {
+		return false
+	}
+	if this.UpstreamNameTemplate != that1.UpstreamNameTemplate {
+		return false
+	}
+	if !this.StaleUpstreamPolicy.Equal(that1.StaleUpstreamPolicy) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
 	return true
 }
-func (this *Settings_KubernetesSecretSource) Equal(that interface{}) bool {
+func (this *DiscoveryOptions_StaleUpstreamPolicy) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*Settings_KubernetesSecretSource)
+	that1, ok := that.(*DiscoveryOptions_StaleUpstreamPolicy)
 	if !ok {
-		that2, ok := that.(Settings_KubernetesSecretSource)
+		that2, ok := that.(DiscoveryOptions_StaleUpstreamPolicy)
 		if ok {
 			that1 = &that2
 		} else {
@@ -849,19 +2899,34 @@ func (this *Settings_KubernetesSecretSource) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.KubernetesSecretSource.Equal(that1.KubernetesSecretSource) {
+	if this.Action != that1.Action {
+		return false
+	}
+	if this.GracePeriod != nil && that1.GracePeriod != nil {
+		if *this.GracePeriod != *that1.GracePeriod {
+			return false
+		}
+	} else if this.GracePeriod != nil {
+		return false
+	} else if that1.GracePeriod != nil {
+		return false
+	}
+	if this.ProtectReferenced != that1.ProtectReferenced {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
 	return true
 }
-func (this *Settings_VaultSecretSource) Equal(that interface{}) bool {
+func (this *DiscoveryProbes) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*Settings_VaultSecretSource)
+	that1, ok := that.(*DiscoveryProbes)
 	if !ok {
-		that2, ok := that.(Settings_VaultSecretSource)
+		that2, ok := that.(DiscoveryProbes)
 		if ok {
 			that1 = &that2
 		} else {
@@ -873,19 +2938,28 @@ func (this *Settings_VaultSecretSource) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.VaultSecretSource.Equal(that1.VaultSecretSource) {
+	if this.MaxConcurrentUpstreams != that1.MaxConcurrentUpstreams {
+		return false
+	}
+	if this.Qps != that1.Qps {
+		return false
+	}
+	if this.Burst != that1.Burst {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
 	return true
 }
-func (this *Settings_DirectorySecretSource) Equal(that interface{}) bool {
+func (this *XdsSanitization) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*Settings_DirectorySecretSource)
+	that1, ok := that.(*XdsSanitization)
 	if !ok {
-		that2, ok := that.(Settings_DirectorySecretSource)
+		that2, ok := that.(XdsSanitization)
 		if ok {
 			that1 = &that2
 		} else {
@@ -897,19 +2971,33 @@ func (this *Settings_DirectorySecretSource) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.DirectorySecretSource.Equal(that1.DirectorySecretSource) {
+	if len(this.ProxyRoles) != len(that1.ProxyRoles) {
+		return false
+	}
+	for i := range this.ProxyRoles {
+		if this.ProxyRoles[i] != that1.ProxyRoles[i] {
+			return false
+		}
+	}
+	if this.StripSecrets != that1.StripSecrets {
+		return false
+	}
+	if !this.ResourceCaps.Equal(that1.ResourceCaps) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
 	return true
 }
-func (this *Settings_KubernetesArtifactSource) Equal(that interface{}) bool {
+func (this *XdsSanitization_ResourceCaps) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*Settings_KubernetesArtifactSource)
+	that1, ok := that.(*XdsSanitization_ResourceCaps)
 	if !ok {
-		that2, ok := that.(Settings_KubernetesArtifactSource)
+		that2, ok := that.(XdsSanitization_ResourceCaps)
 		if ok {
 			that1 = &that2
 		} else {
@@ -921,19 +3009,31 @@ func (this *Settings_KubernetesArtifactSource) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.KubernetesArtifactSource.Equal(that1.KubernetesArtifactSource) {
+	if this.MaxClusters != that1.MaxClusters {
+		return false
+	}
+	if this.MaxEndpoints != that1.MaxEndpoints {
+		return false
+	}
+	if this.MaxListeners != that1.MaxListeners {
+		return false
+	}
+	if this.MaxRoutes != that1.MaxRoutes {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
 	return true
 }
-func (this *Settings_DirectoryArtifactSource) Equal(that interface{}) bool {
+func (this *DnsPublishing) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*Settings_DirectoryArtifactSource)
+	that1, ok := that.(*DnsPublishing)
 	if !ok {
-		that2, ok := that.(Settings_DirectoryArtifactSource)
+		that2, ok := that.(DnsPublishing)
 		if ok {
 			that1 = &that2
 		} else {
@@ -945,19 +3045,118 @@ func (this *Settings_DirectoryArtifactSource) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.DirectoryArtifactSource.Equal(that1.DirectoryArtifactSource) {
+	if this.OwnerId != that1.OwnerId {
+		return false
+	}
+	if this.GatewayService != that1.GatewayService {
+		return false
+	}
+	if this.Ttl != that1.Ttl {
+		return false
+	}
+	if !this.Interval.Equal(that1.Interval) {
+		return false
+	}
+	if that1.Provider == nil {
+		if this.Provider != nil {
+			return false
+		}
+	} else if this.Provider == nil {
+		return false
+	} else if !this.Provider.Equal(that1.Provider) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
 	return true
 }
-func (this *Settings_KubernetesCrds) Equal(that interface{}) bool {
+func (this *DnsPublishing_Route53_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*Settings_KubernetesCrds)
+	that1, ok := that.(*DnsPublishing_Route53_)
 	if !ok {
-		that2, ok := that.(Settings_KubernetesCrds)
+		that2, ok := that.(DnsPublishing_Route53_)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Route53.Equal(that1.Route53) {
+		return false
+	}
+	return true
+}
+func (this *DnsPublishing_CloudDns_) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DnsPublishing_CloudDns_)
+	if !ok {
+		that2, ok := that.(DnsPublishing_CloudDns_)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.CloudDns.Equal(that1.CloudDns) {
+		return false
+	}
+	return true
+}
+func (this *DnsPublishing_Route53) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DnsPublishing_Route53)
+	if !ok {
+		that2, ok := that.(DnsPublishing_Route53)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.HostedZoneId != that1.HostedZoneId {
+		return false
+	}
+	if this.RoleArn != that1.RoleArn {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *DnsPublishing_CloudDns) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DnsPublishing_CloudDns)
+	if !ok {
+		that2, ok := that.(DnsPublishing_CloudDns)
 		if ok {
 			that1 = &that2
 		} else {
@@ -969,19 +3168,25 @@ func (this *Settings_KubernetesCrds) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
+	if this.Project != that1.Project {
+		return false
+	}
+	if this.ManagedZone != that1.ManagedZone {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
 	return true
 }
-func (this *Settings_KubernetesSecrets) Equal(that interface{}) bool {
+func (this *NomadConfiguration) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*Settings_KubernetesSecrets)
+	that1, ok := that.(*NomadConfiguration)
 	if !ok {
-		that2, ok := that.(Settings_KubernetesSecrets)
+		that2, ok := that.(NomadConfiguration)
 		if ok {
 			that1 = &that2
 		} else {
@@ -993,19 +3198,30 @@ func (this *Settings_KubernetesSecrets) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if len(this.Namespaces) != len(that1.Namespaces) {
+		return false
+	}
+	for i := range this.Namespaces {
+		if this.Namespaces[i] != that1.Namespaces[i] {
+			return false
+		}
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
 	return true
 }
-func (this *Settings_VaultSecrets) Equal(that interface{}) bool {
+func (this *DockerConfiguration) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*Settings_VaultSecrets)
+	that1, ok := that.(*DockerConfiguration)
 	if !ok {
-		that2, ok := that.(Settings_VaultSecrets)
+		that2, ok := that.(DockerConfiguration)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1017,19 +3233,25 @@ func (this *Settings_VaultSecrets) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
+	if this.Host != that1.Host {
+		return false
+	}
+	if this.Swarm != that1.Swarm {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
 	return true
 }
-func (this *Settings_KubernetesConfigmaps) Equal(that interface{}) bool {
+func (this *KubeServiceUpstreams) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*Settings_KubernetesConfigmaps)
+	that1, ok := that.(*KubeServiceUpstreams)
 	if !ok {
-		that2, ok := that.(Settings_KubernetesConfigmaps)
+		that2, ok := that.(KubeServiceUpstreams)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1041,19 +3263,35 @@ func (this *Settings_KubernetesConfigmaps) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
+	if len(this.AllowedNamespaces) != len(that1.AllowedNamespaces) {
+		return false
+	}
+	for i := range this.AllowedNamespaces {
+		if this.AllowedNamespaces[i] != that1.AllowedNamespaces[i] {
+			return false
+		}
+	}
+	if len(this.DeniedNamespaces) != len(that1.DeniedNamespaces) {
+		return false
+	}
+	for i := range this.DeniedNamespaces {
+		if this.DeniedNamespaces[i] != that1.DeniedNamespaces[i] {
+			return false
+		}
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
 	return true
 }
-func (this *Settings_Directory) Equal(that interface{}) bool {
+func (this *WasmOptions) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*Settings_Directory)
+	that1, ok := that.(*WasmOptions)
 	if !ok {
-		that2, ok := that.(Settings_Directory)
+		that2, ok := that.(WasmOptions)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1065,7 +3303,10 @@ func (this *Settings_Directory) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Directory != that1.Directory {
+	if this.ImageCacheBindAddr != that1.ImageCacheBindAddr {
+		return false
+	}
+	if this.ImageCacheAddress != that1.ImageCacheAddress {
 		return false
 	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
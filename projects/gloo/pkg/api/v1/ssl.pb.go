@@ -335,9 +335,12 @@ type UpstreamSslConfig struct {
 	// note that a root_ca must be provided if this option is used.
 	VerifySubjectAltName []string       `protobuf:"bytes,5,rep,name=verify_subject_alt_name,json=verifySubjectAltName,proto3" json:"verify_subject_alt_name,omitempty"`
 	Parameters           *SslParameters `protobuf:"bytes,7,opt,name=parameters,proto3" json:"parameters,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+	// The ALPN protocols offered to the upstream in the TLS handshake, e.g. h2 for gRPC backends.
+	// Defaults to h2 when HTTP/2 is enabled to the upstream, and to none otherwise.
+	AlpnProtocols        []string `protobuf:"bytes,8,rep,name=alpn_protocols,json=alpnProtocols,proto3" json:"alpn_protocols,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *UpstreamSslConfig) Reset()         { *m = UpstreamSslConfig{} }
@@ -432,6 +435,13 @@ func (m *UpstreamSslConfig) GetParameters() *SslParameters {
 	return nil
 }
 
+func (m *UpstreamSslConfig) GetAlpnProtocols() []string {
+	if m != nil {
+		return m.AlpnProtocols
+	}
+	return nil
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*UpstreamSslConfig) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _UpstreamSslConfig_OneofMarshaler, _UpstreamSslConfig_OneofUnmarshaler, _UpstreamSslConfig_OneofSizer, []interface{}{
@@ -758,55 +768,56 @@ func init() {
 }
 
 var fileDescriptor_c4a65e8067d81add = []byte{
-	// 757 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x55, 0x4f, 0x6f, 0x23, 0x35,
-	0x14, 0x6f, 0x92, 0xd2, 0x76, 0x5e, 0x5b, 0x12, 0xac, 0x90, 0xcc, 0x16, 0x2d, 0xac, 0x82, 0x84,
-	0x56, 0x5a, 0x98, 0x6c, 0xb3, 0xda, 0x0a, 0xc1, 0x69, 0x37, 0x2b, 0x14, 0x89, 0x0a, 0x56, 0x33,
-	0xed, 0x22, 0x71, 0xb1, 0x5c, 0xe7, 0x4d, 0x62, 0xe2, 0x19, 0x47, 0xb6, 0x13, 0x6d, 0xbf, 0x11,
-	0x1f, 0x85, 0x1b, 0x07, 0xbe, 0x01, 0x07, 0x3e, 0x03, 0x47, 0x64, 0x7b, 0xb2, 0x49, 0xa3, 0xa8,
-	0x42, 0xc0, 0x85, 0xd3, 0xf8, 0xfd, 0xde, 0xfb, 0xbd, 0xff, 0x63, 0xc3, 0xc5, 0x44, 0xd8, 0xe9,
-	0xe2, 0x26, 0xe1, 0xaa, 0xe8, 0x1b, 0x25, 0xd5, 0x17, 0x42, 0xf5, 0x27, 0x52, 0xa9, 0xfe, 0x5c,
-	0xab, 0x9f, 0x90, 0x5b, 0x13, 0x24, 0x36, 0x17, 0xfd, 0xe5, 0x79, 0xdf, 0x18, 0x99, 0xcc, 0xb5,
-	0xb2, 0x8a, 0x9c, 0x38, 0x38, 0x71, 0x8c, 0x44, 0xa8, 0xb3, 0xf6, 0x44, 0x4d, 0x94, 0x57, 0xf4,
-	0xdd, 0x29, 0xd8, 0x9c, 0x7d, 0xbe, 0xc3, 0xb7, 0xff, 0xce, 0x84, 0x5d, 0x79, 0xd4, 0x98, 0x07,
-	0xeb, 0xde, 0xaf, 0x75, 0x88, 0x32, 0x23, 0x87, 0xaa, 0xcc, 0xc5, 0x84, 0x7c, 0x05, 0x60, 0x90,
-	0x6b, 0xb4, 0x54, 0x63, 0x1e, 0xd7, 0x1e, 0xd5, 0x1e, 0x1f, 0x0f, 0x1e, 0x24, 0x5c, 0x69, 0x5c,
-	0x05, 0x4d, 0x52, 0x34, 0x6a, 0xa1, 0x39, 0xa6, 0x98, 0x8f, 0xf6, 0xd2, 0x28, 0x98, 0xa7, 0x98,
-	0x93, 0xe7, 0x10, 0x19, 0x23, 0x69, 0x2e, 0x24, 0x9a, 0xb8, 0xee, 0xa9, 0x9d, 0x64, 0x33, 0xdf,
-	0x24, 0xcb, 0x2e, 0xbf, 0x71, 0xda, 0xd1, 0x5e, 0x7a, 0x64, 0x8c, 0xf4, 0x67, 0xf2, 0x04, 0x1a,
-	0x66, 0x6c, 0xe2, 0x7d, 0x4f, 0xe8, 0x6e, 0x11, 0x5e, 0x65, 0x21, 0xb1, 0xd1, 0x5e, 0xea, 0xac,
-	0xc8, 0x27, 0x70, 0x6c, 0x4a, 0x41, 0xc7, 0xaa, 0x60, 0xa2, 0x34, 0x71, 0xe3, 0x51, 0xe3, 0x71,
-	0x94, 0x82, 0x29, 0xc5, 0xab, 0x80, 0x90, 0xe7, 0xd0, 0x5d, 0xa2, 0x16, 0xf9, 0x2d, 0x35, 0x8b,
-	0x1b, 0xd7, 0x49, 0xca, 0xa4, 0xa5, 0x25, 0x2b, 0x30, 0x7e, 0xcf, 0x1b, 0xb7, 0x83, 0x3a, 0x0b,
-	0xda, 0x17, 0xd2, 0x7e, 0xc7, 0x0a, 0x24, 0x5f, 0x03, 0xcc, 0x99, 0x66, 0x05, 0x5a, 0xd4, 0x26,
-	0x3e, 0xf0, 0xb9, 0x7c, 0xb4, 0x95, 0x8b, 0x91, 0xaf, 0xdf, 0x99, 0xa4, 0x1b, 0xe6, 0x2f, 0x4f,
-	0xe1, 0xd8, 0x15, 0x1e, 0x3a, 0x61, 0x7a, 0x3f, 0xc0, 0xd1, 0xaa, 0x50, 0xf2, 0x00, 0x8e, 0xac,
-	0x34, 0x94, 0xa3, 0xb6, 0xbe, 0x9b, 0x51, 0x7a, 0x68, 0xa5, 0x19, 0xa2, 0xb6, 0xa4, 0x0b, 0xee,
-	0x48, 0x67, 0x78, 0xeb, 0x9b, 0x15, 0xa5, 0x07, 0x56, 0x9a, 0x6f, 0xf1, 0xd6, 0x29, 0xb4, 0x52,
-	0x96, 0x72, 0x16, 0x37, 0x82, 0xc2, 0x89, 0x43, 0xd6, 0xfb, 0xa5, 0x0e, 0x1f, 0x5c, 0xcf, 0x8d,
-	0xd5, 0xc8, 0x8a, 0xff, 0xcf, 0xc8, 0x5a, 0xd0, 0x30, 0xa5, 0xa8, 0x4a, 0x71, 0xc7, 0xff, 0x66,
-	0x46, 0x87, 0xff, 0x6a, 0x46, 0x7f, 0xd4, 0x20, 0x7a, 0x97, 0x29, 0x79, 0x08, 0x60, 0x99, 0x9e,
-	0xa0, 0xa5, 0x0b, 0x2d, 0xaa, 0x39, 0x45, 0x01, 0xb9, 0xd6, 0x82, 0x8c, 0xa0, 0xc5, 0x99, 0x94,
-	0x94, 0x6b, 0x1c, 0x63, 0x69, 0x05, 0x93, 0xab, 0x66, 0x3d, 0xbc, 0x1b, 0x7e, 0xc8, 0xa4, 0x1c,
-	0xae, 0x8d, 0xd2, 0x26, 0xbf, 0x0b, 0x90, 0x2f, 0x21, 0x76, 0xab, 0x20, 0x72, 0xc1, 0x99, 0x45,
-	0x53, 0xa5, 0x13, 0x4a, 0x0f, 0x0d, 0xea, 0x6c, 0xea, 0x33, 0xaf, 0xf6, 0xc5, 0x5f, 0x40, 0x77,
-	0xc9, 0xa4, 0x18, 0x33, 0x2b, 0x54, 0x49, 0xb9, 0x2a, 0x2d, 0xbe, 0xad, 0x88, 0xfb, 0x9e, 0xf8,
-	0xe1, 0x5a, 0x3d, 0x0c, 0x5a, 0xc7, 0xeb, 0xfd, 0x56, 0x83, 0xe6, 0x56, 0x5a, 0x64, 0x0a, 0x1d,
-	0x37, 0xf1, 0x8d, 0x7a, 0x68, 0xd8, 0x8f, 0x6a, 0x7b, 0x06, 0xf7, 0x56, 0x95, 0xb8, 0x1d, 0x58,
-	0xcb, 0x59, 0xd8, 0xac, 0x76, 0xbe, 0x03, 0x3d, 0x7b, 0x03, 0xed, 0x5d, 0xd6, 0xe4, 0x33, 0x68,
-	0x5a, 0x35, 0xc3, 0xd2, 0x6f, 0x5e, 0xa8, 0x22, 0x74, 0xfd, 0xd4, 0xc3, 0x8e, 0xe3, 0xab, 0xee,
-	0xc0, 0xc1, 0x14, 0xd9, 0x18, 0xf5, 0xea, 0x17, 0x09, 0x52, 0xef, 0xcf, 0x3a, 0x9c, 0xde, 0x99,
-	0x35, 0x41, 0x88, 0x0b, 0x51, 0x8a, 0x62, 0x51, 0x50, 0x7f, 0xaf, 0x71, 0x25, 0xe9, 0x12, 0xb5,
-	0x11, 0xaa, 0xf4, 0xae, 0xdf, 0x1f, 0x3c, 0xb9, 0x67, 0x55, 0x92, 0xd7, 0x15, 0xe7, 0x4d, 0xa0,
-	0xa4, 0x9d, 0xca, 0xd9, 0x16, 0xee, 0xc3, 0xb0, 0xb7, 0xbb, 0xc3, 0xd4, 0xff, 0x49, 0x98, 0xe0,
-	0x6c, 0x3b, 0xcc, 0xa7, 0x70, 0xca, 0xc5, 0x7c, 0x8a, 0x9a, 0x9a, 0x85, 0xb0, 0xb8, 0xba, 0xe8,
-	0x4e, 0x02, 0x98, 0x79, 0xcc, 0xdd, 0x85, 0xc8, 0xc7, 0x53, 0xca, 0x17, 0x7a, 0x89, 0xee, 0x6f,
-	0xf4, 0x77, 0xa1, 0x83, 0x86, 0x1e, 0xe9, 0x65, 0xd0, 0xdc, 0x76, 0x7c, 0x02, 0x47, 0x57, 0x97,
-	0x19, 0x7d, 0x71, 0x7d, 0xf5, 0x7d, 0x6b, 0x8f, 0x1c, 0xc3, 0xe1, 0xd5, 0x65, 0xb6, 0x3c, 0xa7,
-	0x4f, 0x5b, 0xb5, 0xb5, 0x70, 0xde, 0xaa, 0xaf, 0x85, 0x41, 0xab, 0xb1, 0x16, 0x9e, 0xb5, 0xf6,
-	0x5f, 0x5e, 0xfc, 0xfc, 0xfb, 0xc7, 0xb5, 0x1f, 0x9f, 0xfe, 0xbd, 0xf7, 0x6b, 0x3e, 0x9b, 0x54,
-	0x2f, 0xce, 0xcd, 0x81, 0xef, 0xd7, 0xb3, 0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0xed, 0x10, 0x97,
-	0xa4, 0xfa, 0x06, 0x00, 0x00,
+	// 773 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x55, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0x8f, 0xed, 0x90, 0x78, 0x5f, 0xe2, 0xc6, 0x8c, 0x82, 0xb3, 0x0d, 0x2a, 0x54, 0x46, 0xa0,
+	0x4a, 0x85, 0x75, 0x93, 0xaa, 0x11, 0x82, 0x53, 0xeb, 0x0a, 0x45, 0x22, 0x82, 0x6a, 0x37, 0x29,
+	0x12, 0x97, 0xd1, 0x64, 0xfc, 0xd6, 0x1e, 0x3c, 0xbb, 0xb3, 0x9a, 0x19, 0x5b, 0xcd, 0x37, 0xe2,
+	0xdb, 0x70, 0xe0, 0x1b, 0x70, 0xe0, 0xca, 0x95, 0x23, 0x9a, 0x19, 0x6f, 0xec, 0x58, 0x56, 0x04,
+	0x88, 0x0b, 0xa7, 0x9d, 0xf7, 0x7b, 0xff, 0x7f, 0xf3, 0xdb, 0x5d, 0x38, 0x1b, 0x0b, 0x3b, 0x99,
+	0x5d, 0x27, 0x5c, 0x15, 0x03, 0xa3, 0xa4, 0xfa, 0x42, 0xa8, 0xc1, 0x58, 0x2a, 0x35, 0xa8, 0xb4,
+	0xfa, 0x09, 0xb9, 0x35, 0xc1, 0x62, 0x95, 0x18, 0xcc, 0x4f, 0x06, 0xc6, 0xc8, 0xa4, 0xd2, 0xca,
+	0x2a, 0xb2, 0xef, 0xe0, 0xc4, 0x65, 0x24, 0x42, 0x1d, 0x1f, 0x8e, 0xd5, 0x58, 0x79, 0xc7, 0xc0,
+	0x9d, 0x42, 0xcc, 0xf1, 0xe7, 0x1b, 0x6a, 0xfb, 0xe7, 0x54, 0xd8, 0xba, 0xa2, 0xc6, 0x3c, 0x44,
+	0xf7, 0x7f, 0x69, 0x42, 0x94, 0x19, 0x39, 0x54, 0x65, 0x2e, 0xc6, 0xe4, 0x2b, 0x00, 0x83, 0x5c,
+	0xa3, 0xa5, 0x1a, 0xf3, 0xb8, 0xf1, 0xb8, 0xf1, 0x64, 0xef, 0xf4, 0x61, 0xc2, 0x95, 0xc6, 0xba,
+	0x69, 0x92, 0xa2, 0x51, 0x33, 0xcd, 0x31, 0xc5, 0xfc, 0x7c, 0x2b, 0x8d, 0x42, 0x78, 0x8a, 0x39,
+	0x79, 0x01, 0x91, 0x31, 0x92, 0xe6, 0x42, 0xa2, 0x89, 0x9b, 0x3e, 0xb5, 0x97, 0xac, 0xce, 0x9b,
+	0x64, 0xd9, 0xc5, 0x37, 0xce, 0x7b, 0xbe, 0x95, 0xb6, 0x8d, 0x91, 0xfe, 0x4c, 0x9e, 0x42, 0xcb,
+	0x8c, 0x4c, 0xbc, 0xed, 0x13, 0x8e, 0xd6, 0x12, 0x5e, 0x67, 0x61, 0xb0, 0xf3, 0xad, 0xd4, 0x45,
+	0x91, 0x8f, 0x61, 0xcf, 0x94, 0x82, 0x8e, 0x54, 0xc1, 0x44, 0x69, 0xe2, 0xd6, 0xe3, 0xd6, 0x93,
+	0x28, 0x05, 0x53, 0x8a, 0xd7, 0x01, 0x21, 0x2f, 0xe0, 0x68, 0x8e, 0x5a, 0xe4, 0x37, 0xd4, 0xcc,
+	0xae, 0x1d, 0x93, 0x94, 0x49, 0x4b, 0x4b, 0x56, 0x60, 0xfc, 0x9e, 0x0f, 0x3e, 0x0c, 0xee, 0x2c,
+	0x78, 0x5f, 0x4a, 0xfb, 0x1d, 0x2b, 0x90, 0x7c, 0x0d, 0x50, 0x31, 0xcd, 0x0a, 0xb4, 0xa8, 0x4d,
+	0xbc, 0xe3, 0x67, 0xf9, 0x70, 0x6d, 0x16, 0x23, 0xdf, 0xdc, 0x86, 0xa4, 0x2b, 0xe1, 0xaf, 0x3a,
+	0xb0, 0xe7, 0x16, 0x0f, 0x4c, 0x98, 0xfe, 0x0f, 0xd0, 0xae, 0x17, 0x25, 0x0f, 0xa1, 0x6d, 0xa5,
+	0xa1, 0x1c, 0xb5, 0xf5, 0x6c, 0x46, 0xe9, 0xae, 0x95, 0x66, 0x88, 0xda, 0x92, 0x23, 0x70, 0x47,
+	0x3a, 0xc5, 0x1b, 0x4f, 0x56, 0x94, 0xee, 0x58, 0x69, 0xbe, 0xc5, 0x1b, 0xe7, 0xd0, 0x4a, 0x59,
+	0xca, 0x59, 0xdc, 0x0a, 0x0e, 0x67, 0x0e, 0x59, 0xff, 0x8f, 0x26, 0xbc, 0x7f, 0x55, 0x19, 0xab,
+	0x91, 0x15, 0xff, 0x9f, 0x2b, 0xeb, 0x42, 0xcb, 0x94, 0x62, 0xb1, 0x8a, 0x3b, 0xfe, 0x37, 0x77,
+	0xb4, 0xfb, 0x8f, 0xee, 0x88, 0x7c, 0x0a, 0x0f, 0x98, 0xac, 0x4a, 0xea, 0x45, 0xcf, 0x95, 0x34,
+	0x71, 0xdb, 0xb7, 0xea, 0x38, 0xf4, 0x4d, 0x0d, 0xae, 0x5f, 0xe5, 0xef, 0x0d, 0x88, 0x6e, 0x17,
+	0x22, 0x8f, 0x00, 0x2c, 0xd3, 0x63, 0xb4, 0x74, 0xa6, 0xc5, 0xe2, 0x3a, 0xa3, 0x80, 0x5c, 0x69,
+	0x41, 0xce, 0xa1, 0xcb, 0x99, 0x94, 0x94, 0x6b, 0x1c, 0x61, 0x69, 0x05, 0x93, 0x35, 0xa7, 0x8f,
+	0xee, 0x4e, 0x39, 0x64, 0x52, 0x0e, 0x97, 0x41, 0xe9, 0x01, 0xbf, 0x0b, 0x90, 0x2f, 0x21, 0x76,
+	0x8a, 0x11, 0xb9, 0xe0, 0xcc, 0xa2, 0x59, 0x8c, 0x13, 0x18, 0x0a, 0x3c, 0xf6, 0x56, 0xfd, 0x99,
+	0x77, 0x7b, 0x8e, 0xce, 0xe0, 0x68, 0xce, 0xa4, 0x18, 0x31, 0x2b, 0x54, 0x49, 0xb9, 0x2a, 0x2d,
+	0xbe, 0x5b, 0x24, 0x6e, 0xfb, 0xc4, 0x0f, 0x96, 0xee, 0x61, 0xf0, 0xba, 0xbc, 0xfe, 0xaf, 0x0d,
+	0x38, 0x58, 0x1b, 0x8b, 0x4c, 0xa0, 0xe7, 0x84, 0xb1, 0xb2, 0x0f, 0x0d, 0x32, 0x5a, 0x88, 0xec,
+	0xf4, 0xde, 0xad, 0x12, 0x27, 0x95, 0xa5, 0x9d, 0x05, 0x01, 0x1e, 0xe6, 0x1b, 0xd0, 0xe3, 0xb7,
+	0x70, 0xb8, 0x29, 0x9a, 0x7c, 0x06, 0x07, 0x56, 0x4d, 0xb1, 0xf4, 0x02, 0x0d, 0x5b, 0x04, 0xd6,
+	0x3b, 0x1e, 0x76, 0x39, 0x7e, 0xeb, 0x1e, 0xec, 0x4c, 0x90, 0x8d, 0x50, 0xd7, 0x6f, 0x52, 0xb0,
+	0xfa, 0x7f, 0x36, 0xa1, 0x73, 0x47, 0x12, 0x04, 0x21, 0x2e, 0x44, 0x29, 0x8a, 0x59, 0x71, 0xab,
+	0x04, 0x3a, 0x47, 0x6d, 0x84, 0x2a, 0x7d, 0xe9, 0x07, 0xa7, 0x4f, 0xef, 0x51, 0x54, 0x52, 0x0b,
+	0xe5, 0x6d, 0x48, 0x49, 0x7b, 0x8b, 0x62, 0x6b, 0xb8, 0x6f, 0xc3, 0xde, 0x6d, 0x6e, 0xd3, 0xfc,
+	0x37, 0x6d, 0x42, 0xb1, 0xf5, 0x36, 0x9f, 0x40, 0x87, 0x8b, 0x6a, 0x82, 0x9a, 0x9a, 0x99, 0xb0,
+	0x58, 0x7f, 0x0f, 0xf7, 0x03, 0x98, 0x79, 0xcc, 0x7d, 0x32, 0x91, 0x8f, 0x26, 0x94, 0xcf, 0xf4,
+	0x1c, 0xdd, 0x4b, 0xeb, 0x42, 0xc0, 0x41, 0x43, 0x8f, 0xf4, 0x33, 0x38, 0x58, 0x2f, 0xbc, 0x0f,
+	0xed, 0xcb, 0x8b, 0x8c, 0xbe, 0xbc, 0xba, 0xfc, 0xbe, 0xbb, 0x45, 0xf6, 0x60, 0xf7, 0xf2, 0x22,
+	0x9b, 0x9f, 0xd0, 0x67, 0xdd, 0xc6, 0xd2, 0x38, 0xe9, 0x36, 0x97, 0xc6, 0x69, 0xb7, 0xb5, 0x34,
+	0x9e, 0x77, 0xb7, 0x5f, 0x9d, 0xfd, 0xfc, 0xdb, 0x47, 0x8d, 0x1f, 0x9f, 0xfd, 0xbd, 0xdf, 0x5c,
+	0x35, 0x1d, 0x2f, 0x7e, 0x4c, 0xd7, 0x3b, 0x9e, 0xaf, 0xe7, 0x7f, 0x0d, 0x00, 0x64, 0x2c, 0xb8,
+	0x98, 0x21, 0x07, 0x00, 0x00,
 }
 
 func (this *SslConfig) Equal(that interface{}) bool {
@@ -1008,6 +1019,14 @@ func (this *UpstreamSslConfig) Equal(that interface{}) bool {
 	if !this.Parameters.Equal(that1.Parameters) {
 		return false
 	}
+	if len(this.AlpnProtocols) != len(that1.AlpnProtocols) {
+		return false
+	}
+	for i := range this.AlpnProtocols {
+		if this.AlpnProtocols[i] != that1.AlpnProtocols[i] {
+			return false
+		}
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -7,9 +7,11 @@ import (
 	bytes "bytes"
 	fmt "fmt"
 	math "math"
+	time "time"
 
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	types "github.com/gogo/protobuf/types"
 	core "github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 )
 
@@ -17,6 +19,7 @@ import (
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -102,9 +105,28 @@ func (m *Upstream) GetDiscoveryMetadata() *DiscoveryMetadata {
 
 // created by discovery services
 type DiscoveryMetadata struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	// Set to false to opt the upstream out of function discovery, or to true to opt it back in.
+	// If unset, the `discovery.solo.io/function-discovery` annotation of the upstream is used
+	// (`enabled` or `disabled`), and upstreams are discovered by default.
+	FunctionDiscovery *types.BoolValue `protobuf:"bytes,1,opt,name=function_discovery,json=functionDiscovery,proto3" json:"function_discovery,omitempty"`
+	// How often the functions of the upstream are polled, overriding the interval of the
+	// discovery handling it. If unset, the `discovery.solo.io/function-poll-interval` annotation
+	// of the upstream is used, e.g. `30s`.
+	FunctionPollInterval *time.Duration `protobuf:"bytes,2,opt,name=function_poll_interval,json=functionPollInterval,proto3,stdduration" json:"function_poll_interval,omitempty"`
+	// Reported by function discovery, to show why the functions of the upstream are not discovered. Read-only.
+	FunctionDiscoveryStatus *FunctionDiscoveryStatus `protobuf:"bytes,3,opt,name=function_discovery_status,json=functionDiscoveryStatus,proto3" json:"function_discovery_status,omitempty"`
+	// How function discovery probes the upstream for a swagger or OpenAPI document
+	SwaggerDiscovery *SwaggerDiscovery `protobuf:"bytes,4,opt,name=swagger_discovery,json=swaggerDiscovery,proto3" json:"swagger_discovery,omitempty"`
+	// Forces the type of service function discovery detects the upstream as, e.g. `grpc` or `swagger`, when more than
+	// one discovery could detect it. The detections of the other types are ignored. If unset, the
+	// `discovery.solo.io/service-type` annotation of the upstream is used, and the detection with the highest score wins.
+	ServiceType string `protobuf:"bytes,5,opt,name=service_type,json=serviceType,proto3" json:"service_type,omitempty"`
+	// Set by function discovery when the upstream is served by short-lived or scale-to-zero workloads, which may have
+	// to start before answering a request. Read-only.
+	ColdStartHints       *ColdStartHints `protobuf:"bytes,6,opt,name=cold_start_hints,json=coldStartHints,proto3" json:"cold_start_hints,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
 func (m *DiscoveryMetadata) Reset()         { *m = DiscoveryMetadata{} }
@@ -131,9 +153,318 @@ func (m *DiscoveryMetadata) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DiscoveryMetadata proto.InternalMessageInfo
 
+func (m *DiscoveryMetadata) GetFunctionDiscovery() *types.BoolValue {
+	if m != nil {
+		return m.FunctionDiscovery
+	}
+	return nil
+}
+
+func (m *DiscoveryMetadata) GetFunctionPollInterval() *time.Duration {
+	if m != nil {
+		return m.FunctionPollInterval
+	}
+	return nil
+}
+
+func (m *DiscoveryMetadata) GetFunctionDiscoveryStatus() *FunctionDiscoveryStatus {
+	if m != nil {
+		return m.FunctionDiscoveryStatus
+	}
+	return nil
+}
+
+func (m *DiscoveryMetadata) GetSwaggerDiscovery() *SwaggerDiscovery {
+	if m != nil {
+		return m.SwaggerDiscovery
+	}
+	return nil
+}
+
+func (m *DiscoveryMetadata) GetServiceType() string {
+	if m != nil {
+		return m.ServiceType
+	}
+	return ""
+}
+
+func (m *DiscoveryMetadata) GetColdStartHints() *ColdStartHints {
+	if m != nil {
+		return m.ColdStartHints
+	}
+	return nil
+}
+
+// Hints for the routes to the functions of an upstream whose workloads may have to start before answering, e.g. the
+// pods of Kubernetes Jobs and CronJobs, or deployments scaled to zero by KEDA. The routes that don't set their own
+// timeout or retries use the ones of the hints.
+type ColdStartHints struct {
+	// The kind of workload serving the upstream: `Job`, `CronJob` or `ScaledToZero`
+	WorkloadKind string `protobuf:"bytes,1,opt,name=workload_kind,json=workloadKind,proto3" json:"workload_kind,omitempty"`
+	// The timeout of the routes to the upstream
+	Timeout *time.Duration `protobuf:"bytes,2,opt,name=timeout,proto3,stdduration" json:"timeout,omitempty"`
+	// The number of retries of the routes to the upstream, when the workloads can't be connected to yet
+	NumRetries           uint32   `protobuf:"varint,3,opt,name=num_retries,json=numRetries,proto3" json:"num_retries,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ColdStartHints) Reset()         { *m = ColdStartHints{} }
+func (m *ColdStartHints) String() string { return proto.CompactTextString(m) }
+func (*ColdStartHints) ProtoMessage()    {}
+func (*ColdStartHints) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b74df493149f644d, []int{2}
+}
+func (m *ColdStartHints) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ColdStartHints.Unmarshal(m, b)
+}
+func (m *ColdStartHints) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ColdStartHints.Marshal(b, m, deterministic)
+}
+func (m *ColdStartHints) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ColdStartHints.Merge(m, src)
+}
+func (m *ColdStartHints) XXX_Size() int {
+	return xxx_messageInfo_ColdStartHints.Size(m)
+}
+func (m *ColdStartHints) XXX_DiscardUnknown() {
+	xxx_messageInfo_ColdStartHints.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ColdStartHints proto.InternalMessageInfo
+
+func (m *ColdStartHints) GetWorkloadKind() string {
+	if m != nil {
+		return m.WorkloadKind
+	}
+	return ""
+}
+
+func (m *ColdStartHints) GetTimeout() *time.Duration {
+	if m != nil {
+		return m.Timeout
+	}
+	return nil
+}
+
+func (m *ColdStartHints) GetNumRetries() uint32 {
+	if m != nil {
+		return m.NumRetries
+	}
+	return 0
+}
+
+// Options for services that serve their swagger or OpenAPI document at a non-standard path,
+// or only to authenticated clients. The headers and credentials are sent with the probes,
+// and with the requests polling the document once it is found.
+type SwaggerDiscovery struct {
+	// Paths tried before the common ones, e.g. `/api/openapi.yaml`. They are resolved against
+	// the address of the upstream, and may include a query.
+	Paths []string `protobuf:"bytes,1,rep,name=paths,proto3" json:"paths,omitempty"`
+	// Headers added to the requests, e.g. `x-api-key`. Like the credentials, they are only sent to the host of
+	// the upstream, not to the documents of other hosts or the redirects to them.
+	Headers              map[string]string             `protobuf:"bytes,2,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Credentials          *SwaggerDiscovery_Credentials `protobuf:"bytes,3,opt,name=credentials,proto3" json:"credentials,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
+	XXX_unrecognized     []byte                        `json:"-"`
+	XXX_sizecache        int32                         `json:"-"`
+}
+
+func (m *SwaggerDiscovery) Reset()         { *m = SwaggerDiscovery{} }
+func (m *SwaggerDiscovery) String() string { return proto.CompactTextString(m) }
+func (*SwaggerDiscovery) ProtoMessage()    {}
+func (*SwaggerDiscovery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b74df493149f644d, []int{3}
+}
+func (m *SwaggerDiscovery) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SwaggerDiscovery.Unmarshal(m, b)
+}
+func (m *SwaggerDiscovery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SwaggerDiscovery.Marshal(b, m, deterministic)
+}
+func (m *SwaggerDiscovery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SwaggerDiscovery.Merge(m, src)
+}
+func (m *SwaggerDiscovery) XXX_Size() int {
+	return xxx_messageInfo_SwaggerDiscovery.Size(m)
+}
+func (m *SwaggerDiscovery) XXX_DiscardUnknown() {
+	xxx_messageInfo_SwaggerDiscovery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SwaggerDiscovery proto.InternalMessageInfo
+
+func (m *SwaggerDiscovery) GetPaths() []string {
+	if m != nil {
+		return m.Paths
+	}
+	return nil
+}
+
+func (m *SwaggerDiscovery) GetHeaders() map[string]string {
+	if m != nil {
+		return m.Headers
+	}
+	return nil
+}
+
+func (m *SwaggerDiscovery) GetCredentials() *SwaggerDiscovery_Credentials {
+	if m != nil {
+		return m.Credentials
+	}
+	return nil
+}
+
+// Credentials sent in the `authorization` header of the requests.
+type SwaggerDiscovery_Credentials struct {
+	// The secret holding the credentials. It must be an extension secret in the namespace of the upstream,
+	// with a `token` field for bearer credentials, or `username` and `password` fields for basic credentials.
+	SecretRef            *core.ResourceRef `protobuf:"bytes,1,opt,name=secret_ref,json=secretRef,proto3" json:"secret_ref,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *SwaggerDiscovery_Credentials) Reset()         { *m = SwaggerDiscovery_Credentials{} }
+func (m *SwaggerDiscovery_Credentials) String() string { return proto.CompactTextString(m) }
+func (*SwaggerDiscovery_Credentials) ProtoMessage()    {}
+func (*SwaggerDiscovery_Credentials) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b74df493149f644d, []int{3, 1}
+}
+func (m *SwaggerDiscovery_Credentials) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SwaggerDiscovery_Credentials.Unmarshal(m, b)
+}
+func (m *SwaggerDiscovery_Credentials) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SwaggerDiscovery_Credentials.Marshal(b, m, deterministic)
+}
+func (m *SwaggerDiscovery_Credentials) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SwaggerDiscovery_Credentials.Merge(m, src)
+}
+func (m *SwaggerDiscovery_Credentials) XXX_Size() int {
+	return xxx_messageInfo_SwaggerDiscovery_Credentials.Size(m)
+}
+func (m *SwaggerDiscovery_Credentials) XXX_DiscardUnknown() {
+	xxx_messageInfo_SwaggerDiscovery_Credentials.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SwaggerDiscovery_Credentials proto.InternalMessageInfo
+
+func (m *SwaggerDiscovery_Credentials) GetSecretRef() *core.ResourceRef {
+	if m != nil {
+		return m.SecretRef
+	}
+	return nil
+}
+
+// The outcome of the attempts to discover the functions of an upstream.
+// As every write of the upstream restarts its discovery, the status is only updated when the outcome
+// of an attempt differs from the last one recorded: the attempt times are those of that attempt.
+// The errors are compared without the details changing on every attempt, such as the request ids of the aws errors.
+type FunctionDiscoveryStatus struct {
+	// The discovery handling the upstream, e.g. `swagger` or `grpc`
+	DiscoveryType string `protobuf:"bytes,1,opt,name=discovery_type,json=discoveryType,proto3" json:"discovery_type,omitempty"`
+	// The time of the last attempt whose outcome changed, i.e. since when the attempts have had the recorded outcome,
+	// not the time of the latest attempt
+	LastAttempt *types.Timestamp `protobuf:"bytes,2,opt,name=last_attempt,json=lastAttempt,proto3" json:"last_attempt,omitempty"`
+	// The time of the first attempt of the last run of successful attempts
+	LastSuccess *types.Timestamp `protobuf:"bytes,3,opt,name=last_success,json=lastSuccess,proto3" json:"last_success,omitempty"`
+	// The error of the attempts since the last attempt time, empty if they succeeded
+	LastError string `protobuf:"bytes,4,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	// Set when the upstream would exceed the maximum size of a resource with all the discovered functions,
+	// and some of them were left out. The functions named in the `discovery.solo.io/function-priority` annotation
+	// of the upstream, separated by commas, are kept first.
+	Warning string `protobuf:"bytes,5,opt,name=warning,proto3" json:"warning,omitempty"`
+	// The type of service the upstream was detected as, e.g. `grpc` or `swagger`
+	DetectedServiceType string `protobuf:"bytes,6,opt,name=detected_service_type,json=detectedServiceType,proto3" json:"detected_service_type,omitempty"`
+	// Why the detected type won over the other detections of the upstream, if any
+	DetectionReason      string   `protobuf:"bytes,7,opt,name=detection_reason,json=detectionReason,proto3" json:"detection_reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FunctionDiscoveryStatus) Reset()         { *m = FunctionDiscoveryStatus{} }
+func (m *FunctionDiscoveryStatus) String() string { return proto.CompactTextString(m) }
+func (*FunctionDiscoveryStatus) ProtoMessage()    {}
+func (*FunctionDiscoveryStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b74df493149f644d, []int{4}
+}
+func (m *FunctionDiscoveryStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FunctionDiscoveryStatus.Unmarshal(m, b)
+}
+func (m *FunctionDiscoveryStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FunctionDiscoveryStatus.Marshal(b, m, deterministic)
+}
+func (m *FunctionDiscoveryStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FunctionDiscoveryStatus.Merge(m, src)
+}
+func (m *FunctionDiscoveryStatus) XXX_Size() int {
+	return xxx_messageInfo_FunctionDiscoveryStatus.Size(m)
+}
+func (m *FunctionDiscoveryStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_FunctionDiscoveryStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FunctionDiscoveryStatus proto.InternalMessageInfo
+
+func (m *FunctionDiscoveryStatus) GetDiscoveryType() string {
+	if m != nil {
+		return m.DiscoveryType
+	}
+	return ""
+}
+
+func (m *FunctionDiscoveryStatus) GetLastAttempt() *types.Timestamp {
+	if m != nil {
+		return m.LastAttempt
+	}
+	return nil
+}
+
+func (m *FunctionDiscoveryStatus) GetLastSuccess() *types.Timestamp {
+	if m != nil {
+		return m.LastSuccess
+	}
+	return nil
+}
+
+func (m *FunctionDiscoveryStatus) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
+}
+
+func (m *FunctionDiscoveryStatus) GetWarning() string {
+	if m != nil {
+		return m.Warning
+	}
+	return ""
+}
+
+func (m *FunctionDiscoveryStatus) GetDetectedServiceType() string {
+	if m != nil {
+		return m.DetectedServiceType
+	}
+	return ""
+}
+
+func (m *FunctionDiscoveryStatus) GetDetectionReason() string {
+	if m != nil {
+		return m.DetectionReason
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Upstream)(nil), "gloo.solo.io.Upstream")
 	proto.RegisterType((*DiscoveryMetadata)(nil), "gloo.solo.io.DiscoveryMetadata")
+	proto.RegisterType((*ColdStartHints)(nil), "gloo.solo.io.ColdStartHints")
+	proto.RegisterType((*SwaggerDiscovery)(nil), "gloo.solo.io.SwaggerDiscovery")
+	proto.RegisterMapType((map[string]string)(nil), "gloo.solo.io.SwaggerDiscovery.HeadersEntry")
+	proto.RegisterType((*SwaggerDiscovery_Credentials)(nil), "gloo.solo.io.SwaggerDiscovery.Credentials")
+	proto.RegisterType((*FunctionDiscoveryStatus)(nil), "gloo.solo.io.FunctionDiscoveryStatus")
 }
 
 func init() {
@@ -141,28 +472,63 @@ func init() {
 }
 
 var fileDescriptor_b74df493149f644d = []byte{
-	// 325 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x90, 0x4d, 0x4e, 0x02, 0x31,
-	0x1c, 0xc5, 0x85, 0x18, 0x24, 0x15, 0x17, 0x8c, 0xc4, 0x20, 0x0b, 0x31, 0xb3, 0x72, 0x63, 0x2b,
-	0x9a, 0x18, 0x83, 0x0b, 0x13, 0x62, 0xe2, 0x4a, 0x17, 0x43, 0xdc, 0xb8, 0x21, 0xa5, 0x53, 0x6a,
-	0xe5, 0xe3, 0xdf, 0xb4, 0x1d, 0x12, 0x2f, 0xe3, 0xda, 0xa3, 0x78, 0x0a, 0x16, 0x1e, 0xc1, 0x13,
-	0x98, 0x29, 0xed, 0x04, 0xd4, 0x05, 0xae, 0x66, 0x3a, 0xef, 0xfd, 0xde, 0xf4, 0x3d, 0x74, 0x2d,
-	0xa4, 0x7d, 0xce, 0x86, 0x98, 0xc1, 0x94, 0x18, 0x98, 0xc0, 0xa9, 0x04, 0x22, 0x26, 0x00, 0x44,
-	0x69, 0x78, 0xe1, 0xcc, 0x9a, 0xe5, 0x89, 0x2a, 0x49, 0xe6, 0x1d, 0x92, 0x29, 0x63, 0x35, 0xa7,
-	0x53, 0xac, 0x34, 0x58, 0x88, 0x6a, 0xb9, 0x86, 0x73, 0x0c, 0x4b, 0x68, 0x35, 0x04, 0x08, 0x70,
-	0x02, 0xc9, 0xdf, 0x96, 0x9e, 0x56, 0xe7, 0x8f, 0x1f, 0xb8, 0xe7, 0x58, 0xda, 0x10, 0x3b, 0xe5,
-	0x96, 0xa6, 0xd4, 0x52, 0x8f, 0x90, 0x0d, 0x10, 0x63, 0xa9, 0xcd, 0x8c, 0x07, 0xba, 0xff, 0x2a,
-	0xa1, 0x26, 0x99, 0x90, 0x33, 0xcf, 0xc6, 0x6f, 0x65, 0x54, 0x7d, 0xf4, 0xb5, 0xa2, 0x1b, 0xb4,
-	0x17, 0x2a, 0x0e, 0x8c, 0xe2, 0xac, 0x59, 0x3e, 0x2e, 0x9d, 0xec, 0x9e, 0xb7, 0xf0, 0x6a, 0x51,
-	0x1c, 0xec, 0x7d, 0xc5, 0x59, 0x52, 0xcb, 0x56, 0x4e, 0xd1, 0x1d, 0xaa, 0x2c, 0x6f, 0xd6, 0xac,
-	0x38, 0xb2, 0x81, 0x19, 0x68, 0x5e, 0x90, 0x7d, 0xa7, 0xf5, 0x0e, 0x3f, 0x16, 0xed, 0xad, 0xaf,
-	0x45, 0xbb, 0x6e, 0xb9, 0xb1, 0xa9, 0x1c, 0x8d, 0xba, 0xb1, 0x14, 0x33, 0xd0, 0x3c, 0x4e, 0x3c,
-	0x1e, 0x5d, 0xa1, 0x6a, 0x58, 0xa5, 0xb9, 0xe3, 0xa2, 0x0e, 0xd6, 0xa3, 0xee, 0xbd, 0xda, 0xdb,
-	0xce, 0xc3, 0x92, 0xc2, 0x1d, 0x3d, 0xa0, 0x28, 0x95, 0x86, 0xc1, 0x9c, 0xeb, 0xd7, 0x41, 0x91,
-	0x51, 0x75, 0x19, 0xed, 0xf5, 0x22, 0xb7, 0xc1, 0x17, 0xc2, 0x92, 0x7a, 0xfa, 0xf3, 0x53, 0xbc,
-	0x8f, 0xea, 0xbf, 0x7c, 0xbd, 0xcb, 0xf7, 0xcf, 0xa3, 0xd2, 0xd3, 0xd9, 0x66, 0xbb, 0xab, 0xb1,
-	0xf0, 0xdb, 0x0f, 0x2b, 0x6e, 0xf4, 0x8b, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0xa0, 0x26, 0x75,
-	0x6c, 0x77, 0x02, 0x00, 0x00,
+	// 886 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0x4f, 0x6f, 0xe3, 0x44,
+	0x14, 0x27, 0xc9, 0x6e, 0xdb, 0xbc, 0xa4, 0x25, 0x19, 0xca, 0x6e, 0x1a, 0x41, 0x53, 0x82, 0x56,
+	0x2a, 0xff, 0x6c, 0xb6, 0x48, 0xa8, 0x14, 0x21, 0x44, 0x76, 0xbb, 0x7f, 0xb4, 0x80, 0xd0, 0x64,
+	0x97, 0x03, 0x17, 0x6b, 0x6a, 0x3f, 0xbb, 0x43, 0x1c, 0x8f, 0x35, 0x33, 0x4e, 0x95, 0x8f, 0xc1,
+	0x8d, 0x13, 0x67, 0x3e, 0x07, 0x27, 0x8e, 0x9c, 0x38, 0x2e, 0x12, 0x1f, 0x81, 0x4f, 0x80, 0x3c,
+	0xf6, 0xb8, 0x71, 0xba, 0xcb, 0x96, 0x53, 0xf2, 0xde, 0xfb, 0xfd, 0x7e, 0x33, 0xf3, 0x7b, 0xf3,
+	0xc6, 0xf0, 0x79, 0xc4, 0xf5, 0x79, 0x76, 0xe6, 0xf8, 0x62, 0xee, 0x2a, 0x11, 0x8b, 0x8f, 0xb8,
+	0x70, 0xa3, 0x58, 0x08, 0x37, 0x95, 0xe2, 0x47, 0xf4, 0xb5, 0x2a, 0x22, 0x96, 0x72, 0x77, 0x71,
+	0xd7, 0xcd, 0x52, 0xa5, 0x25, 0xb2, 0xb9, 0x93, 0x4a, 0xa1, 0x05, 0xe9, 0xe6, 0x35, 0x27, 0xa7,
+	0x39, 0x5c, 0x0c, 0x77, 0x23, 0x11, 0x09, 0x53, 0x70, 0xf3, 0x7f, 0x05, 0x66, 0x78, 0xf7, 0x05,
+	0x0b, 0x98, 0xdf, 0x19, 0xd7, 0x56, 0x76, 0x8e, 0x9a, 0x05, 0x4c, 0xb3, 0x92, 0xe2, 0x5e, 0x83,
+	0xa2, 0x34, 0xd3, 0x99, 0x2a, 0x09, 0x1f, 0x5e, 0x83, 0x20, 0x31, 0x2c, 0xd1, 0x27, 0xff, 0xeb,
+	0xc8, 0x69, 0x9c, 0x45, 0x3c, 0xb1, 0x2b, 0xed, 0x47, 0x42, 0x44, 0x31, 0xba, 0x26, 0x3a, 0xcb,
+	0x42, 0x37, 0xc8, 0x24, 0xd3, 0x5c, 0x24, 0x65, 0x7d, 0xb4, 0x5e, 0xd7, 0x7c, 0x8e, 0x4a, 0xb3,
+	0x79, 0xfa, 0x32, 0x81, 0x0b, 0xc9, 0xd2, 0x14, 0x65, 0xb9, 0xc0, 0xf8, 0x97, 0x26, 0x6c, 0x3d,
+	0x2b, 0x5d, 0x26, 0x5f, 0xc2, 0xb6, 0x75, 0xdc, 0x53, 0x29, 0xfa, 0x83, 0xe6, 0x41, 0xe3, 0xb0,
+	0x73, 0x34, 0x74, 0x56, 0x7d, 0x77, 0x2c, 0x7c, 0x9a, 0xa2, 0x4f, 0xbb, 0xd9, 0x4a, 0x44, 0x1e,
+	0xc2, 0x46, 0x61, 0xd4, 0x60, 0xc3, 0x30, 0x77, 0x1d, 0x5f, 0x48, 0xac, 0x98, 0x53, 0x53, 0x9b,
+	0xec, 0xfd, 0xfe, 0x7c, 0xf4, 0xda, 0x3f, 0xcf, 0x47, 0x7d, 0x8d, 0x4a, 0x07, 0x3c, 0x0c, 0x4f,
+	0xc6, 0x3c, 0x4a, 0x84, 0xc4, 0x31, 0x2d, 0xe9, 0xe4, 0x18, 0xb6, 0x6c, 0x93, 0x06, 0x9b, 0x46,
+	0xea, 0x56, 0x5d, 0xea, 0x9b, 0xb2, 0x3a, 0xb9, 0x91, 0x8b, 0xd1, 0x0a, 0x4d, 0xbe, 0x05, 0x12,
+	0x70, 0xe5, 0x8b, 0x05, 0xca, 0xa5, 0x57, 0x69, 0x6c, 0x19, 0x8d, 0x51, 0xfd, 0x20, 0xf7, 0x2d,
+	0xce, 0x8a, 0xd1, 0x7e, 0xb0, 0x9e, 0x1a, 0xff, 0xd1, 0x82, 0xfe, 0x15, 0x20, 0x79, 0x0c, 0x24,
+	0xcc, 0x12, 0x3f, 0xef, 0x84, 0x57, 0x71, 0x06, 0x0d, 0x6b, 0x97, 0xf1, 0xdc, 0xb1, 0x9e, 0x3b,
+	0x13, 0x21, 0xe2, 0xef, 0x59, 0x9c, 0x21, 0xed, 0x5b, 0x56, 0x25, 0x49, 0x9e, 0xc1, 0xad, 0x4a,
+	0x2a, 0x15, 0x71, 0xec, 0xf1, 0x44, 0xa3, 0x5c, 0xb0, 0xb8, 0x74, 0x7f, 0xef, 0x8a, 0xdc, 0xfd,
+	0xf2, 0x0e, 0x4c, 0x6e, 0xfc, 0xfc, 0xd7, 0xa8, 0x41, 0x77, 0x2d, 0xfd, 0x3b, 0x11, 0xc7, 0x8f,
+	0x4b, 0x32, 0x61, 0xb0, 0x77, 0x75, 0x87, 0x5e, 0xd9, 0x9d, 0x96, 0x51, 0xbe, 0x53, 0xb7, 0xe3,
+	0xc1, 0xfa, 0xd6, 0x8a, 0x76, 0xd1, 0xdb, 0xe1, 0x8b, 0x0b, 0xe4, 0x09, 0xf4, 0xd5, 0x05, 0x8b,
+	0x22, 0x94, 0x2b, 0x1e, 0xdc, 0x30, 0xd2, 0xfb, 0x75, 0xe9, 0x69, 0x01, 0xab, 0x04, 0x68, 0x4f,
+	0xad, 0x65, 0xc8, 0x3b, 0xd0, 0x55, 0x28, 0x17, 0xdc, 0x47, 0x4f, 0x2f, 0x53, 0x1c, 0xdc, 0x3c,
+	0x68, 0x1c, 0xb6, 0x69, 0xa7, 0xcc, 0x3d, 0x5d, 0xa6, 0x48, 0x1e, 0x40, 0xcf, 0x17, 0x71, 0x90,
+	0x1f, 0x42, 0x6a, 0xef, 0x9c, 0x27, 0xda, 0xde, 0xb3, 0xb7, 0xea, 0xcb, 0xdd, 0x13, 0x71, 0x30,
+	0xcd, 0x41, 0x8f, 0x72, 0x0c, 0xdd, 0xf1, 0x6b, 0xf1, 0xf8, 0xa7, 0x06, 0xec, 0xd4, 0x21, 0xe4,
+	0x5d, 0xd8, 0xbe, 0x10, 0x72, 0x16, 0x0b, 0x16, 0x78, 0x33, 0x9e, 0x04, 0xa6, 0x95, 0x6d, 0xda,
+	0xb5, 0xc9, 0x27, 0x3c, 0x09, 0xc8, 0x67, 0xb0, 0x99, 0x8f, 0x97, 0xc8, 0xf4, 0x75, 0x5b, 0x63,
+	0xf1, 0x64, 0x04, 0x9d, 0x24, 0x9b, 0x7b, 0x12, 0xb5, 0xe4, 0x58, 0xf8, 0xbf, 0x4d, 0x21, 0xc9,
+	0xe6, 0xb4, 0xc8, 0x8c, 0x7f, 0x6b, 0x42, 0x6f, 0xdd, 0x25, 0xb2, 0x0b, 0x37, 0x53, 0xa6, 0xcf,
+	0xd5, 0xa0, 0x71, 0xd0, 0x3a, 0x6c, 0xd3, 0x22, 0x20, 0xa7, 0xb0, 0x79, 0x8e, 0x2c, 0x40, 0xa9,
+	0x06, 0xcd, 0x83, 0xd6, 0x61, 0xe7, 0xe8, 0x83, 0xff, 0x36, 0xdb, 0x79, 0x54, 0xa0, 0x4f, 0x13,
+	0x2d, 0x97, 0xd4, 0x72, 0xc9, 0xd7, 0xd0, 0xf1, 0x25, 0x06, 0x98, 0x68, 0xce, 0x62, 0x7b, 0x25,
+	0xde, 0x7f, 0x85, 0xd4, 0xbd, 0x4b, 0x06, 0x5d, 0xa5, 0x0f, 0x4f, 0xa0, 0xbb, 0xba, 0x0c, 0xe9,
+	0x41, 0x6b, 0x86, 0xcb, 0xd2, 0xc6, 0xfc, 0x6f, 0x7e, 0x98, 0x45, 0x3e, 0x03, 0xc6, 0xbb, 0x36,
+	0x2d, 0x82, 0x93, 0xe6, 0x71, 0x63, 0xf8, 0x10, 0x3a, 0x2b, 0xba, 0xe4, 0x18, 0x40, 0xa1, 0x2f,
+	0x51, 0x7b, 0x12, 0xc3, 0x72, 0xa6, 0xf6, 0xea, 0xd3, 0x4f, 0x51, 0x89, 0x4c, 0xfa, 0x48, 0x31,
+	0xa4, 0xed, 0x02, 0x4c, 0x31, 0x1c, 0xff, 0xd9, 0x84, 0xdb, 0x2f, 0xb9, 0xc5, 0xe4, 0x0e, 0xec,
+	0x5c, 0x8e, 0x81, 0xb9, 0x61, 0xc5, 0xde, 0xb6, 0xab, 0xac, 0xb9, 0x63, 0x5f, 0x40, 0x37, 0x66,
+	0x4a, 0x7b, 0x4c, 0x6b, 0x9c, 0xa7, 0xfa, 0xf2, 0x05, 0x5c, 0x6b, 0xf4, 0x53, 0xfb, 0xce, 0xd2,
+	0x4e, 0x8e, 0xff, 0xaa, 0x80, 0x57, 0x74, 0x95, 0xf9, 0x3e, 0x2a, 0xeb, 0xea, 0x2b, 0xe9, 0xd3,
+	0x02, 0x4e, 0xde, 0x06, 0x30, 0x74, 0x94, 0x52, 0x48, 0x33, 0x4a, 0x6d, 0xda, 0xce, 0x33, 0xa7,
+	0x79, 0x82, 0x0c, 0x60, 0xf3, 0x82, 0xc9, 0x84, 0x27, 0x51, 0x39, 0x1e, 0x36, 0x24, 0x47, 0xf0,
+	0x66, 0x80, 0x1a, 0x7d, 0x8d, 0x81, 0x57, 0x1b, 0xa3, 0x0d, 0x83, 0x7b, 0xc3, 0x16, 0xa7, 0x2b,
+	0xe3, 0xf4, 0x1e, 0xf4, 0x8a, 0x74, 0xfe, 0x44, 0x48, 0x64, 0x4a, 0x24, 0xe6, 0xad, 0x6d, 0xd3,
+	0xd7, 0xab, 0x3c, 0x35, 0xe9, 0xc9, 0xa7, 0xbf, 0xfe, 0xbd, 0xdf, 0xf8, 0xe1, 0xe3, 0xeb, 0x7d,
+	0xc8, 0xd2, 0x59, 0x54, 0x7e, 0xcc, 0xce, 0x36, 0xcc, 0x81, 0x3f, 0xf9, 0x77, 0x00, 0x9f, 0x9f,
+	0x34, 0x37, 0xf6, 0x07, 0x00, 0x00,
 }
 
 func (this *Upstream) Equal(that interface{}) bool {
@@ -220,6 +586,184 @@ func (this *DiscoveryMetadata) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
+	if !this.FunctionDiscovery.Equal(that1.FunctionDiscovery) {
+		return false
+	}
+	if this.FunctionPollInterval != nil && that1.FunctionPollInterval != nil {
+		if *this.FunctionPollInterval != *that1.FunctionPollInterval {
+			return false
+		}
+	} else if this.FunctionPollInterval != nil {
+		return false
+	} else if that1.FunctionPollInterval != nil {
+		return false
+	}
+	if !this.FunctionDiscoveryStatus.Equal(that1.FunctionDiscoveryStatus) {
+		return false
+	}
+	if !this.SwaggerDiscovery.Equal(that1.SwaggerDiscovery) {
+		return false
+	}
+	if this.ServiceType != that1.ServiceType {
+		return false
+	}
+	if !this.ColdStartHints.Equal(that1.ColdStartHints) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *ColdStartHints) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ColdStartHints)
+	if !ok {
+		that2, ok := that.(ColdStartHints)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.WorkloadKind != that1.WorkloadKind {
+		return false
+	}
+	if this.Timeout != nil && that1.Timeout != nil {
+		if *this.Timeout != *that1.Timeout {
+			return false
+		}
+	} else if this.Timeout != nil {
+		return false
+	} else if that1.Timeout != nil {
+		return false
+	}
+	if this.NumRetries != that1.NumRetries {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *SwaggerDiscovery) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SwaggerDiscovery)
+	if !ok {
+		that2, ok := that.(SwaggerDiscovery)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Paths) != len(that1.Paths) {
+		return false
+	}
+	for i := range this.Paths {
+		if this.Paths[i] != that1.Paths[i] {
+			return false
+		}
+	}
+	if len(this.Headers) != len(that1.Headers) {
+		return false
+	}
+	for i := range this.Headers {
+		if this.Headers[i] != that1.Headers[i] {
+			return false
+		}
+	}
+	if !this.Credentials.Equal(that1.Credentials) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *SwaggerDiscovery_Credentials) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SwaggerDiscovery_Credentials)
+	if !ok {
+		that2, ok := that.(SwaggerDiscovery_Credentials)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.SecretRef.Equal(that1.SecretRef) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *FunctionDiscoveryStatus) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FunctionDiscoveryStatus)
+	if !ok {
+		that2, ok := that.(FunctionDiscoveryStatus)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.DiscoveryType != that1.DiscoveryType {
+		return false
+	}
+	if !this.LastAttempt.Equal(that1.LastAttempt) {
+		return false
+	}
+	if !this.LastSuccess.Equal(that1.LastSuccess) {
+		return false
+	}
+	if this.LastError != that1.LastError {
+		return false
+	}
+	if this.Warning != that1.Warning {
+		return false
+	}
+	if this.DetectedServiceType != that1.DetectedServiceType {
+		return false
+	}
+	if this.DetectionReason != that1.DetectionReason {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
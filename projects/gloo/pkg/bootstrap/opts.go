@@ -4,6 +4,7 @@ import (
 	"net"
 
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/imagecache"
 
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients/factory"
@@ -27,6 +28,8 @@ type Opts struct {
 	DevMode         bool
 	ControlPlane    ControlPlane
 	Settings        *v1.Settings
+	// serves the wasm filter modules pulled from image registries, nil if the wasm options of the settings are not set
+	WasmImageCache *imagecache.Cache
 }
 
 type ControlPlane struct {
@@ -8,6 +8,7 @@ import (
 
 	kubeconverters "github.com/solo-io/gloo/projects/gloo/pkg/api/converters/kube"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/secrets/azurekeyvault"
 	"github.com/solo-io/go-utils/kubeutils"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients/factory"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients/kube"
@@ -86,6 +87,14 @@ func SecretFactoryForSettings(ctx context.Context,
 		}, nil
 	case *v1.Settings_VaultSecretSource:
 		return nil, errors.Errorf("vault configuration not implemented")
+	case *v1.Settings_AzureKeyVaultSecretSource:
+		vault, err := azurekeyvault.NewVault(source.AzureKeyVaultSecretSource)
+		if err != nil {
+			return nil, err
+		}
+		return &azurekeyvault.ResourceClientFactory{
+			Vault: vault,
+		}, nil
 	case *v1.Settings_DirectorySecretSource:
 		return &factory.FileResourceClientFactory{
 			RootDir: filepath.Join(source.DirectorySecretSource.Directory, pluralName),
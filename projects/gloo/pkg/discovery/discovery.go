@@ -6,6 +6,7 @@ import (
 	"sort"
 	"strings"
 	"sync"
+	"time"
 
 	"go.uber.org/zap"
 
@@ -39,11 +40,16 @@ type DiscoveryPlugin interface {
 type UpstreamDiscovery struct {
 	watchNamespaces        []string
 	writeNamespace         string
+	upstreamClient         v1.UpstreamClient
 	upstreamReconciler     v1.UpstreamReconciler
 	discoveryPlugins       []DiscoveryPlugin
 	lock                   sync.Mutex
 	latestDesiredUpstreams map[DiscoveryPlugin]v1.UpstreamList
 	extraSelectorLabels    map[string]string
+
+	stalePolicy         *v1.DiscoveryOptions_StaleUpstreamPolicy
+	referencedUpstreams ReferencedUpstreams
+	staleResync         *time.Timer
 }
 
 type EndpointDiscovery struct {
@@ -70,6 +76,7 @@ func NewUpstreamDiscovery(watchNamespaces []string, writeNamespace string,
 	return &UpstreamDiscovery{
 		watchNamespaces:        watchNamespaces,
 		writeNamespace:         writeNamespace,
+		upstreamClient:         upstreamClient,
 		upstreamReconciler:     v1.NewUpstreamReconciler(upstreamClient),
 		discoveryPlugins:       discoveryPlugins,
 		latestDesiredUpstreams: make(map[DiscoveryPlugin]v1.UpstreamList),
@@ -116,6 +123,7 @@ func (d *UpstreamDiscovery) StartUds(opts clients.WatchOpts, discOpts Opts) (cha
 func (d *UpstreamDiscovery) Resync(ctx context.Context) error {
 	d.lock.Lock()
 	defer d.lock.Unlock()
+	var staleExpiry time.Time
 	for uds, desiredUpstreams := range d.latestDesiredUpstreams {
 		udsName := strings.Replace(reflect.TypeOf(uds).String(), "*", "", -1)
 		udsName = strings.Replace(udsName, ".", "", -1)
@@ -125,16 +133,34 @@ func (d *UpstreamDiscovery) Resync(ctx context.Context) error {
 		for k, v := range d.extraSelectorLabels {
 			selector[k] = v
 		}
-		if err := d.upstreamReconciler.Reconcile(d.writeNamespace, desiredUpstreams, uds.UpdateUpstream, clients.ListOpts{
+		desiredUpstreams, expiry, err := d.keepStaleUpstreams(ctx, desiredUpstreams, selector)
+		if err != nil {
+			return err
+		}
+		if !expiry.IsZero() && (staleExpiry.IsZero() || expiry.Before(staleExpiry)) {
+			staleExpiry = expiry
+		}
+		if err := d.upstreamReconciler.Reconcile(d.writeNamespace, desiredUpstreams, updateStaleSince(preserveDiscoveryMetadata(uds.UpdateUpstream)), clients.ListOpts{
 			Ctx:      ctx,
 			Selector: selector,
 		}); err != nil {
 			return err
 		}
 	}
+	if !staleExpiry.IsZero() {
+		d.scheduleResync(ctx, staleExpiry)
+	}
 	return nil
 }
 
+// the discovery metadata is set by users and function discovery, it is never discovered
+func preserveDiscoveryMetadata(update func(original, desired *v1.Upstream) (bool, error)) func(original, desired *v1.Upstream) (bool, error) {
+	return func(original, desired *v1.Upstream) (bool, error) {
+		desired.DiscoveryMetadata = original.DiscoveryMetadata
+		return update(original, desired)
+	}
+}
+
 func setLabels(udsName string, upstreamList v1.UpstreamList) v1.UpstreamList {
 	clone := upstreamList.Clone()
 	for _, us := range clone {
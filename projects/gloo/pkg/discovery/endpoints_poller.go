@@ -0,0 +1,153 @@
+package discovery
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	multierror "github.com/hashicorp/go-multierror"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/solo-io/solo-kit/pkg/errors"
+)
+
+// EndpointAddress is an address of an upstream, as listed by an EndpointsPoller
+type EndpointAddress struct {
+	// Name of the endpoint; upstreams listing an address with the same name share its endpoint
+	Name    string
+	Address string
+	Port    uint32
+}
+
+// ListUpstreamAddresses returns the addresses of an upstream
+type ListUpstreamAddresses func(ctx context.Context, upstream core.ResourceRef) ([]EndpointAddress, error)
+
+// WaitForChanges blocks until the addresses of the upstreams should be listed again
+type WaitForChanges func(ctx context.Context) error
+
+// PollInterval lists the addresses again after the given interval
+func PollInterval(interval time.Duration) WaitForChanges {
+	return func(ctx context.Context) error {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(interval):
+			return nil
+		}
+	}
+}
+
+// EndpointsPoller builds the endpoints of upstreams whose addresses are listed from an external registry, e.g.
+// a cloud provider API. When the addresses of an upstream cannot be listed, the last ones known are kept, so that
+// an unavailable registry does not remove the endpoints of its upstreams.
+type EndpointsPoller struct {
+	upstreams     []core.ResourceRef
+	listAddresses ListUpstreamAddresses
+	wait          WaitForChanges
+	lastKnown     map[core.ResourceRef][]EndpointAddress
+}
+
+func NewEndpointsPoller(upstreams []core.ResourceRef, listAddresses ListUpstreamAddresses, wait WaitForChanges) *EndpointsPoller {
+	// sort upstreams for idempotency
+	sorted := append([]core.ResourceRef{}, upstreams...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Key() < sorted[j].Key() })
+	return &EndpointsPoller{
+		upstreams:     sorted,
+		listAddresses: listAddresses,
+		wait:          wait,
+		lastKnown:     make(map[core.ResourceRef][]EndpointAddress),
+	}
+}
+
+// Watch lists the endpoints, then lists them again each time the wait returns, until the context is done.
+// The errors of the listings and of the wait are sent on the error channel.
+func (p *EndpointsPoller) Watch(writeNamespace string, opts clients.WatchOpts) (<-chan v1.EndpointList, <-chan error, error) {
+	opts = opts.WithDefaults()
+	endpointsChan := make(chan v1.EndpointList)
+	errs := make(chan error)
+
+	sendErr := func(err error) bool {
+		select {
+		case <-opts.Ctx.Done():
+			return false
+		case errs <- err:
+			return true
+		}
+	}
+
+	go func() {
+		defer close(endpointsChan)
+		defer close(errs)
+
+		for {
+			list, err := p.List(opts.Ctx, writeNamespace)
+			select {
+			case <-opts.Ctx.Done():
+				return
+			case endpointsChan <- list:
+			}
+			if err != nil && !sendErr(err) {
+				return
+			}
+
+			// nothing changes if there is no upstream
+			if len(p.upstreams) == 0 {
+				<-opts.Ctx.Done()
+				return
+			}
+			for {
+				err := p.wait(opts.Ctx)
+				if opts.Ctx.Err() != nil {
+					return
+				}
+				if err == nil {
+					break
+				}
+				if !sendErr(err) {
+					return
+				}
+			}
+		}
+	}()
+	return endpointsChan, errs, nil
+}
+
+// List returns the endpoints of the upstreams. The upstreams whose addresses cannot be listed keep the last ones
+// known, and their errors are returned along with the endpoints.
+func (p *EndpointsPoller) List(ctx context.Context, writeNamespace string) (v1.EndpointList, error) {
+	var errs error
+	endpointsMap := make(map[EndpointAddress][]*core.ResourceRef)
+
+	for _, usRef := range p.upstreams {
+		addresses, err := p.listAddresses(ctx, usRef)
+		if err != nil {
+			errs = multierror.Append(errs, errors.Wrapf(err, "upstream %v, keeping its last known endpoints", usRef.Key()))
+			addresses = p.lastKnown[usRef]
+		} else {
+			p.lastKnown[usRef] = addresses
+		}
+		for _, addr := range addresses {
+			copyRef := usRef
+			endpointsMap[addr] = append(endpointsMap[addr], &copyRef)
+		}
+	}
+
+	var endpoints v1.EndpointList
+	for addr, refs := range endpointsMap {
+		endpoints = append(endpoints, &v1.Endpoint{
+			Metadata: core.Metadata{
+				Namespace: writeNamespace,
+				Name:      addr.Name,
+			},
+			Upstreams: refs,
+			Address:   addr.Address,
+			Port:      addr.Port,
+		})
+	}
+
+	// sort endpoints for idempotency
+	sort.Slice(endpoints, func(i, j int) bool { return endpoints[i].Metadata.Name < endpoints[j].Metadata.Name })
+
+	return endpoints, errs
+}
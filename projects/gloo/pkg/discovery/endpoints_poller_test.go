@@ -0,0 +1,86 @@
+package discovery
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/solo-io/solo-kit/pkg/errors"
+)
+
+var _ = Describe("EndpointsPoller", func() {
+
+	var (
+		ctx       context.Context
+		cancel    context.CancelFunc
+		petstore  = core.ResourceRef{Name: "petstore", Namespace: "gloo-system"}
+		petstore2 = core.ResourceRef{Name: "petstore-2", Namespace: "gloo-system"}
+		addresses map[core.ResourceRef][]EndpointAddress
+		listErr   error
+		poller    *EndpointsPoller
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		shared := EndpointAddress{Name: "shared", Address: "10.0.0.1", Port: 8080}
+		addresses = map[core.ResourceRef][]EndpointAddress{
+			petstore:  {shared},
+			petstore2: {shared, {Name: "other", Address: "10.0.0.2", Port: 8080}},
+		}
+		listErr = nil
+		listAddresses := func(ctx context.Context, usRef core.ResourceRef) ([]EndpointAddress, error) {
+			if listErr != nil && usRef == petstore2 {
+				return nil, listErr
+			}
+			return addresses[usRef], nil
+		}
+		poller = NewEndpointsPoller([]core.ResourceRef{petstore2, petstore}, listAddresses, PollInterval(0))
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	It("merges the addresses shared by upstreams", func() {
+		endpoints, err := poller.List(ctx, "gloo-system")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(endpoints).To(HaveLen(2))
+		Expect(endpoints[0].Metadata.Name).To(Equal("other"))
+		Expect(endpoints[0].Upstreams).To(Equal([]*core.ResourceRef{&petstore2}))
+		Expect(endpoints[1].Metadata.Name).To(Equal("shared"))
+		Expect(endpoints[1].Metadata.Namespace).To(Equal("gloo-system"))
+		Expect(endpoints[1].Upstreams).To(Equal([]*core.ResourceRef{&petstore, &petstore2}))
+	})
+
+	It("keeps the last known addresses of the upstreams that cannot be listed", func() {
+		_, err := poller.List(ctx, "gloo-system")
+		Expect(err).NotTo(HaveOccurred())
+
+		listErr = errors.Errorf("unavailable")
+		delete(addresses, petstore)
+		endpoints, err := poller.List(ctx, "gloo-system")
+		Expect(err).To(MatchError(ContainSubstring("unavailable")))
+		Expect(endpoints).To(HaveLen(2))
+		Expect(endpoints[1].Upstreams).To(Equal([]*core.ResourceRef{&petstore2}))
+	})
+
+	It("lists the addresses again after each wait and reports the errors", func() {
+		calls := 0
+		listAddresses := func(ctx context.Context, usRef core.ResourceRef) ([]EndpointAddress, error) {
+			calls++
+			if calls > 1 {
+				return nil, errors.Errorf("unavailable")
+			}
+			return addresses[usRef], nil
+		}
+		poller = NewEndpointsPoller([]core.ResourceRef{petstore}, listAddresses, PollInterval(0))
+
+		endpoints, errs, err := poller.Watch("gloo-system", clients.WatchOpts{Ctx: ctx})
+		Expect(err).NotTo(HaveOccurred())
+		Eventually(endpoints).Should(Receive(HaveLen(1)))
+		Eventually(endpoints).Should(Receive(HaveLen(1)))
+		Eventually(errs).Should(Receive(MatchError(ContainSubstring("unavailable"))))
+	})
+})
@@ -3,5 +3,7 @@ package discovery
 type Opts struct {
 	KubeOpts struct {
 		IgnoredServices []string
+		// the template naming the upstreams of the services, from the discovery options of the settings
+		UpstreamNameTemplate string
 	}
 }
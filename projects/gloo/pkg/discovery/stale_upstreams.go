@@ -0,0 +1,234 @@
+package discovery
+
+import (
+	"context"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+// StaleSinceAnnotation is set on the discovered upstreams kept for the grace period after their service disappeared
+const StaleSinceAnnotation = "discovery.solo.io/stale-since"
+
+const DefaultStaleUpstreamGracePeriod = 5 * time.Minute
+
+// ReferencedUpstreams lists the upstreams routed to
+type ReferencedUpstreams func(ctx context.Context) (map[core.ResourceRef]bool, error)
+
+// ReferencedByRoutes lists the upstreams routed to by the proxies and the upstream groups of the namespaces
+func ReferencedByRoutes(proxyClient v1.ProxyClient, upstreamGroupClient v1.UpstreamGroupClient, namespaces []string) ReferencedUpstreams {
+	return func(ctx context.Context) (map[core.ResourceRef]bool, error) {
+		referenced := make(map[core.ResourceRef]bool)
+		addDestinations := func(destinations []*v1.WeightedDestination) {
+			for _, dest := range destinations {
+				if upstream := dest.GetDestination().GetUpstream(); upstream != nil {
+					referenced[*upstream] = true
+				}
+			}
+		}
+		for _, ns := range namespaces {
+			proxies, err := proxyClient.List(ns, clients.ListOpts{Ctx: ctx})
+			if err != nil {
+				return nil, err
+			}
+			for _, proxy := range proxies {
+				for _, listener := range proxy.Listeners {
+					for _, vhost := range listener.GetHttpListener().GetVirtualHosts() {
+						for _, route := range vhost.Routes {
+							action := route.GetRouteAction()
+							if upstream := action.GetSingle().GetUpstream(); upstream != nil {
+								referenced[*upstream] = true
+							}
+							addDestinations(action.GetMulti().GetDestinations())
+						}
+					}
+				}
+			}
+			upstreamGroups, err := upstreamGroupClient.List(ns, clients.ListOpts{Ctx: ctx})
+			if err != nil {
+				return nil, err
+			}
+			for _, upstreamGroup := range upstreamGroups {
+				addDestinations(upstreamGroup.Destinations)
+			}
+		}
+		return referenced, nil
+	}
+}
+
+// WatchReferences signals every change of the proxies and the upstream groups of the namespaces, as the upstreams
+// routed to may have changed
+func WatchReferences(opts clients.WatchOpts, proxyClient v1.ProxyClient, upstreamGroupClient v1.UpstreamGroupClient, namespaces []string) (<-chan struct{}, <-chan error, error) {
+	changes := make(chan struct{}, 1)
+	errs := make(chan error)
+	signal := func() {
+		// a pending signal covers this change too
+		select {
+		case changes <- struct{}{}:
+		default:
+		}
+	}
+	forwardErrs := func(src <-chan error) {
+		for {
+			select {
+			case err := <-src:
+				select {
+				case errs <- err:
+				case <-opts.Ctx.Done():
+					return
+				}
+			case <-opts.Ctx.Done():
+				return
+			}
+		}
+	}
+	for _, ns := range namespaces {
+		proxies, proxyErrs, err := proxyClient.Watch(ns, opts)
+		if err != nil {
+			return nil, nil, err
+		}
+		upstreamGroups, upstreamGroupErrs, err := upstreamGroupClient.Watch(ns, opts)
+		if err != nil {
+			return nil, nil, err
+		}
+		go forwardErrs(proxyErrs)
+		go forwardErrs(upstreamGroupErrs)
+		go func() {
+			for {
+				select {
+				case <-proxies:
+					signal()
+				case <-upstreamGroups:
+					signal()
+				case <-opts.Ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+	return changes, errs, nil
+}
+
+// ResyncOn resyncs on every signal until the context is done, so that the stale upstreams are deleted once no route
+// refers to them rather than on the next discovery event
+func (d *UpstreamDiscovery) ResyncOn(ctx context.Context, signals <-chan struct{}) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-signals:
+		}
+		if err := d.Resync(ctx); err != nil {
+			contextutils.LoggerFrom(ctx).Errorw("resyncing the stale upstreams", "error", err)
+		}
+	}
+}
+
+// SetStaleUpstreamPolicy sets what becomes of the discovered upstreams whose service disappeared, they are deleted
+// if the policy is nil. referenced is required if the policy protects the referenced upstreams.
+func (d *UpstreamDiscovery) SetStaleUpstreamPolicy(policy *v1.DiscoveryOptions_StaleUpstreamPolicy, referenced ReferencedUpstreams) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	d.stalePolicy = policy
+	d.referencedUpstreams = referenced
+}
+
+// adds the stale upstreams kept by the policy to the desired upstreams, and returns when the first of them expires
+func (d *UpstreamDiscovery) keepStaleUpstreams(ctx context.Context, desired v1.UpstreamList, selector map[string]string) (v1.UpstreamList, time.Time, error) {
+	var expiry time.Time
+	policy := d.stalePolicy
+	if policy == nil || (policy.Action == v1.DiscoveryOptions_StaleUpstreamPolicy_DELETE && !policy.ProtectReferenced) {
+		return desired, expiry, nil
+	}
+	logger := contextutils.LoggerFrom(ctx)
+
+	existing, err := d.upstreamClient.List(d.writeNamespace, clients.ListOpts{Ctx: ctx, Selector: selector})
+	if err != nil {
+		return nil, expiry, err
+	}
+	desiredRefs := make(map[core.ResourceRef]bool)
+	for _, us := range desired {
+		desiredRefs[us.Metadata.Ref()] = true
+	}
+	var referenced map[core.ResourceRef]bool
+	if policy.ProtectReferenced && d.referencedUpstreams != nil {
+		if referenced, err = d.referencedUpstreams(ctx); err != nil {
+			return nil, expiry, err
+		}
+	}
+
+	gracePeriod := DefaultStaleUpstreamGracePeriod
+	if policy.GracePeriod != nil {
+		gracePeriod = *policy.GracePeriod
+	}
+	now := time.Now()
+	kept := append(v1.UpstreamList{}, desired...)
+	for _, us := range existing {
+		ref := us.Metadata.Ref()
+		if desiredRefs[ref] {
+			continue
+		}
+		switch {
+		case referenced[ref]:
+			logger.Warnf("upstream %v is kept while routes refer to it, its service disappeared", ref.Key())
+		case policy.Action == v1.DiscoveryOptions_StaleUpstreamPolicy_ORPHAN:
+			logger.Warnf("upstream %v is orphaned, its service disappeared", ref.Key())
+		case policy.Action == v1.DiscoveryOptions_StaleUpstreamPolicy_DELETE_AFTER_GRACE_PERIOD:
+			staleSince, err := time.Parse(time.RFC3339, us.Metadata.Annotations[StaleSinceAnnotation])
+			if err != nil {
+				// newly stale, or an unreadable annotation
+				staleSince = now
+			}
+			deleteAt := staleSince.Add(gracePeriod)
+			if !now.Before(deleteAt) {
+				// deleted by the reconciler
+				continue
+			}
+			if expiry.IsZero() || deleteAt.Before(expiry) {
+				expiry = deleteAt
+			}
+			us = proto.Clone(us).(*v1.Upstream)
+			resources.UpdateMetadata(us, func(meta *core.Metadata) {
+				if meta.Annotations == nil {
+					meta.Annotations = make(map[string]string)
+				}
+				meta.Annotations[StaleSinceAnnotation] = staleSince.Format(time.RFC3339)
+			})
+		default:
+			continue
+		}
+		kept = append(kept, us)
+	}
+	return kept, expiry, nil
+}
+
+// resyncs once the first stale upstream expires, to delete it
+func (d *UpstreamDiscovery) scheduleResync(ctx context.Context, at time.Time) {
+	if d.staleResync != nil {
+		d.staleResync.Stop()
+	}
+	d.staleResync = time.AfterFunc(time.Until(at), func() {
+		if ctx.Err() != nil {
+			return
+		}
+		if err := d.Resync(ctx); err != nil {
+			contextutils.LoggerFrom(ctx).Errorw("deleting stale upstreams", "error", err)
+		}
+	})
+}
+
+// the stale since annotation of the kept stale upstreams is written, and removed once their service is back
+func updateStaleSince(update func(original, desired *v1.Upstream) (bool, error)) func(original, desired *v1.Upstream) (bool, error) {
+	return func(original, desired *v1.Upstream) (bool, error) {
+		updated, err := update(original, desired)
+		if err != nil {
+			return false, err
+		}
+		return updated || original.Metadata.Annotations[StaleSinceAnnotation] != desired.Metadata.Annotations[StaleSinceAnnotation], nil
+	}
+}
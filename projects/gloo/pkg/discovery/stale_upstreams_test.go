@@ -0,0 +1,170 @@
+package discovery
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/static"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/factory"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/memory"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+type fakePlugin struct{}
+
+func (p *fakePlugin) Init(params plugins.InitParams) error {
+	return nil
+}
+
+func (p *fakePlugin) DiscoverUpstreams(watchNamespaces []string, writeNamespace string, opts clients.WatchOpts, discOpts Opts) (chan v1.UpstreamList, chan error, error) {
+	return nil, nil, nil
+}
+
+func (p *fakePlugin) UpdateUpstream(original, desired *v1.Upstream) (bool, error) {
+	return !original.UpstreamSpec.Equal(desired.UpstreamSpec), nil
+}
+
+func (p *fakePlugin) WatchEndpoints(writeNamespace string, upstreamsToTrack v1.UpstreamList, opts clients.WatchOpts) (<-chan v1.EndpointList, <-chan error, error) {
+	return nil, nil, nil
+}
+
+var _ = Describe("StaleUpstreams", func() {
+
+	var (
+		ctx            context.Context
+		cancel         context.CancelFunc
+		upstreamClient v1.UpstreamClient
+		uds            *UpstreamDiscovery
+		plugin         = &fakePlugin{}
+	)
+
+	upstream := func(name string) *v1.Upstream {
+		return &v1.Upstream{
+			Metadata: core.Metadata{Namespace: "gloo-system", Name: name},
+			UpstreamSpec: &v1.UpstreamSpec{
+				UpstreamType: &v1.UpstreamSpec_Static{
+					Static: &static.UpstreamSpec{Hosts: []*static.Host{{Addr: name, Port: 80}}},
+				},
+			},
+		}
+	}
+
+	discover := func(upstreams ...*v1.Upstream) {
+		uds.lock.Lock()
+		uds.latestDesiredUpstreams[plugin] = setLabels("discoveryfakePlugin", upstreams)
+		uds.lock.Unlock()
+		Expect(uds.Resync(ctx)).NotTo(HaveOccurred())
+	}
+
+	names := func() []string {
+		upstreams, err := upstreamClient.List("gloo-system", clients.ListOpts{Ctx: ctx})
+		Expect(err).NotTo(HaveOccurred())
+		var names []string
+		for _, us := range upstreams {
+			names = append(names, us.Metadata.Name)
+		}
+		return names
+	}
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		var err error
+		upstreamClient, err = v1.NewUpstreamClient(&factory.MemoryResourceClientFactory{
+			Cache: memory.NewInMemoryResourceCache(),
+		})
+		Expect(err).NotTo(HaveOccurred())
+		uds = NewUpstreamDiscovery(nil, "gloo-system", upstreamClient, []DiscoveryPlugin{plugin})
+		discover(upstream("petstore"), upstream("orders"))
+		Expect(names()).To(ConsistOf("petstore", "orders"))
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	It("deletes the upstreams of the services that disappeared by default", func() {
+		discover(upstream("petstore"))
+		Expect(names()).To(ConsistOf("petstore"))
+	})
+
+	It("orphans the upstreams of the services that disappeared", func() {
+		uds.SetStaleUpstreamPolicy(&v1.DiscoveryOptions_StaleUpstreamPolicy{Action: v1.DiscoveryOptions_StaleUpstreamPolicy_ORPHAN}, nil)
+		discover(upstream("petstore"))
+		Expect(names()).To(ConsistOf("petstore", "orders"))
+	})
+
+	It("deletes the upstreams of the services that disappeared after the grace period", func() {
+		gracePeriod := time.Second
+		uds.SetStaleUpstreamPolicy(&v1.DiscoveryOptions_StaleUpstreamPolicy{
+			Action:      v1.DiscoveryOptions_StaleUpstreamPolicy_DELETE_AFTER_GRACE_PERIOD,
+			GracePeriod: &gracePeriod,
+		}, nil)
+		discover(upstream("petstore"))
+		Expect(names()).To(ConsistOf("petstore", "orders"))
+		orders, err := upstreamClient.Read("gloo-system", "orders", clients.ReadOpts{Ctx: ctx})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(orders.Metadata.Annotations).To(HaveKey(StaleSinceAnnotation))
+
+		Eventually(names, 3*time.Second, 100*time.Millisecond).Should(ConsistOf("petstore"))
+	})
+
+	It("removes the stale since annotation of the upstreams whose service is back", func() {
+		gracePeriod := time.Hour
+		uds.SetStaleUpstreamPolicy(&v1.DiscoveryOptions_StaleUpstreamPolicy{
+			Action:      v1.DiscoveryOptions_StaleUpstreamPolicy_DELETE_AFTER_GRACE_PERIOD,
+			GracePeriod: &gracePeriod,
+		}, nil)
+		discover(upstream("petstore"))
+		discover(upstream("petstore"), upstream("orders"))
+		orders, err := upstreamClient.Read("gloo-system", "orders", clients.ReadOpts{Ctx: ctx})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(orders.Metadata.Annotations).NotTo(HaveKey(StaleSinceAnnotation))
+	})
+
+	It("keeps the upstreams of the services that disappeared while routes refer to them", func() {
+		referenced := map[core.ResourceRef]bool{{Namespace: "gloo-system", Name: "orders"}: true}
+		uds.SetStaleUpstreamPolicy(&v1.DiscoveryOptions_StaleUpstreamPolicy{ProtectReferenced: true}, func(ctx context.Context) (map[core.ResourceRef]bool, error) {
+			return referenced, nil
+		})
+		discover(upstream("petstore"))
+		Expect(names()).To(ConsistOf("petstore", "orders"))
+
+		referenced = nil
+		discover(upstream("petstore"))
+		Expect(names()).To(ConsistOf("petstore"))
+	})
+
+	It("deletes the stale upstreams once routes no longer refer to them", func() {
+		cache := memory.NewInMemoryResourceCache()
+		proxyClient, err := v1.NewProxyClient(&factory.MemoryResourceClientFactory{Cache: cache})
+		Expect(err).NotTo(HaveOccurred())
+		upstreamGroupClient, err := v1.NewUpstreamGroupClient(&factory.MemoryResourceClientFactory{Cache: cache})
+		Expect(err).NotTo(HaveOccurred())
+		_, err = upstreamGroupClient.Write(&v1.UpstreamGroup{
+			Metadata: core.Metadata{Namespace: "gloo-system", Name: "orders"},
+			Destinations: []*v1.WeightedDestination{{
+				Destination: &v1.Destination{
+					DestinationType: &v1.Destination_Upstream{Upstream: &core.ResourceRef{Namespace: "gloo-system", Name: "orders"}},
+				},
+				Weight: 1,
+			}},
+		}, clients.WriteOpts{Ctx: ctx})
+		Expect(err).NotTo(HaveOccurred())
+
+		namespaces := []string{"gloo-system"}
+		uds.SetStaleUpstreamPolicy(&v1.DiscoveryOptions_StaleUpstreamPolicy{ProtectReferenced: true}, ReferencedByRoutes(proxyClient, upstreamGroupClient, namespaces))
+		changes, _, err := WatchReferences(clients.WatchOpts{Ctx: ctx, RefreshRate: 100 * time.Millisecond}, proxyClient, upstreamGroupClient, namespaces)
+		Expect(err).NotTo(HaveOccurred())
+		go uds.ResyncOn(ctx, changes)
+		discover(upstream("petstore"))
+		Expect(names()).To(ConsistOf("petstore", "orders"))
+
+		Expect(upstreamGroupClient.Delete("gloo-system", "orders", clients.DeleteOpts{Ctx: ctx})).NotTo(HaveOccurred())
+		Eventually(names, 3*time.Second, 100*time.Millisecond).Should(ConsistOf("petstore"))
+	})
+})
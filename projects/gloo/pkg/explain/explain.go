@@ -0,0 +1,376 @@
+package explain
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+// Request is the test request to route
+type Request struct {
+	// the host or authority of the request, envoy compares it to the domains including its port
+	Host string
+	// the path and query of the request
+	Path    string
+	Method  string
+	Headers map[string][]string
+}
+
+// Report is the chain of decisions that routes a request on an http listener, in the order the proxy takes them
+type Report struct {
+	Proxy    string `json:"proxy"`
+	Listener string `json:"listener"`
+	BindPort uint32 `json:"bindPort"`
+
+	// the domain of each virtual host that was compared to the host, until one matched
+	Domains     []DomainDecision `json:"domains,omitempty"`
+	VirtualHost string           `json:"virtualHost,omitempty"`
+	// the plugins configured on the selected virtual host
+	VirtualHostPlugins []string `json:"virtualHostPlugins,omitempty"`
+
+	// each route of the virtual host that was evaluated, until one matched
+	Routes []RouteDecision `json:"routes,omitempty"`
+	Route  *MatchedRoute   `json:"matchedRoute,omitempty"`
+
+	// the outcome of the request, e.g. the 404 when nothing matched
+	Result string `json:"result"`
+}
+
+type DomainDecision struct {
+	VirtualHost string `json:"virtualHost"`
+	Domain      string `json:"domain"`
+	Matched     bool   `json:"matched"`
+}
+
+type RouteDecision struct {
+	Index   int      `json:"index"`
+	Matched bool     `json:"matched"`
+	Reasons []string `json:"reasons"`
+}
+
+type MatchedRoute struct {
+	Index int `json:"index"`
+	// route, redirect or direct response
+	Action string `json:"action"`
+	// the destinations of a route action
+	Destinations  []Destination      `json:"destinations,omitempty"`
+	UpstreamGroup string             `json:"upstreamGroup,omitempty"`
+	Redirect      *v1.RedirectAction `json:"redirect,omitempty"`
+	// the status of a direct response
+	Status uint32 `json:"status,omitempty"`
+	// the plugins configured on the route
+	Plugins []string `json:"plugins,omitempty"`
+}
+
+type Destination struct {
+	Upstream string `json:"upstream,omitempty"`
+	Service  string `json:"service,omitempty"`
+	Weight   uint32 `json:"weight,omitempty"`
+	// the function of the upstream, when the destination has a spec
+	DestinationSpec *v1.DestinationSpec `json:"destinationSpec,omitempty"`
+	Subset          *v1.Subset          `json:"subset,omitempty"`
+}
+
+// Explain routes the request on each http listener of the proxies, as envoy would with their translated config
+func Explain(proxies v1.ProxyList, req Request) []Report {
+	var reports []Report
+	for _, proxy := range proxies {
+		for _, listener := range proxy.Listeners {
+			httpListener := listener.GetHttpListener()
+			if httpListener == nil {
+				continue
+			}
+			report := Report{
+				Proxy:    proxy.Metadata.Ref().Key(),
+				Listener: listener.Name,
+				BindPort: listener.BindPort,
+			}
+			explainListener(&report, httpListener, req)
+			reports = append(reports, report)
+		}
+	}
+	return reports
+}
+
+func explainListener(report *Report, listener *v1.HttpListener, req Request) {
+	host := strings.ToLower(req.Host)
+
+	vhost := selectVirtualHost(report, listener.VirtualHosts, host)
+	if vhost == nil {
+		report.Result = fmt.Sprintf("404: no virtual host matches the domain %v", host)
+		return
+	}
+	report.VirtualHost = vhost.Name
+	report.VirtualHostPlugins = pluginNames(vhost.VirtualHostPlugins)
+
+	path, query := req.Path, url.Values{}
+	if i := strings.Index(path, "?"); i >= 0 {
+		query, _ = url.ParseQuery(path[i+1:])
+		path = path[:i]
+	}
+	if path == "" {
+		path = "/"
+	}
+	headers := make(map[string][]string, len(req.Headers)+1)
+	for name, values := range req.Headers {
+		headers[strings.ToLower(name)] = values
+	}
+	method := req.Method
+	if method == "" {
+		method = "GET"
+	}
+	headers[":method"] = []string{method}
+
+	for i, route := range vhost.Routes {
+		reasons, matched := matchRoute(route.Matcher, path, query, headers)
+		report.Routes = append(report.Routes, RouteDecision{Index: i, Matched: matched, Reasons: reasons})
+		if matched {
+			report.Route = matchedRoute(i, route)
+			report.Result = fmt.Sprintf("matched route %v of virtual host %v", i, vhost.Name)
+			return
+		}
+	}
+	report.Result = fmt.Sprintf("404: no route of virtual host %v matches", vhost.Name)
+}
+
+// envoy prefers an exact domain, then the longest wildcard suffix, then the longest wildcard prefix, then "*"
+func selectVirtualHost(report *Report, vhosts []*v1.VirtualHost, host string) *v1.VirtualHost {
+	var (
+		best      *v1.VirtualHost
+		bestRank  int
+		bestLen   int
+		decisions []DomainDecision
+	)
+	for _, vhost := range vhosts {
+		domains := vhost.Domains
+		if len(domains) == 0 || (len(domains) == 1 && domains[0] == "") {
+			// the translator serves the virtual hosts without domains on any domain
+			domains = []string{"*"}
+		}
+		for _, domain := range domains {
+			rank, length := matchDomain(strings.ToLower(domain), host)
+			decisions = append(decisions, DomainDecision{VirtualHost: vhost.Name, Domain: domain, Matched: rank > 0})
+			if rank > bestRank || (rank == bestRank && rank > 0 && length > bestLen) {
+				best, bestRank, bestLen = vhost, rank, length
+			}
+		}
+	}
+	report.Domains = decisions
+	return best
+}
+
+const (
+	noMatch = iota
+	catchAllMatch
+	prefixWildcardMatch
+	suffixWildcardMatch
+	exactMatch
+)
+
+// returns how well the domain matches the host, and the length of the domain to break ties between wildcards
+func matchDomain(domain, host string) (int, int) {
+	switch {
+	case domain == host:
+		return exactMatch, len(domain)
+	case domain == "*":
+		return catchAllMatch, 0
+	case strings.HasPrefix(domain, "*"):
+		// the wildcard does not match the empty string
+		suffix := domain[1:]
+		if len(host) > len(suffix) && strings.HasSuffix(host, suffix) {
+			return suffixWildcardMatch, len(domain)
+		}
+	case strings.HasSuffix(domain, "*"):
+		prefix := domain[:len(domain)-1]
+		if len(host) > len(prefix) && strings.HasPrefix(host, prefix) {
+			return prefixWildcardMatch, len(domain)
+		}
+	}
+	return noMatch, 0
+}
+
+// evaluates every condition of the matcher, so that the report lists all the reasons a route did not match
+func matchRoute(matcher *v1.Matcher, path string, query url.Values, headers map[string][]string) ([]string, bool) {
+	var reasons []string
+	matched := true
+	check := func(ok bool, reason string) {
+		matched = matched && ok
+		reasons = append(reasons, reason)
+	}
+
+	switch spec := matcher.GetPathSpecifier().(type) {
+	case *v1.Matcher_Exact:
+		ok := path == spec.Exact
+		check(ok, fmt.Sprintf("path %v %v %v", path, is(ok, "equals", "does not equal"), spec.Exact))
+	case *v1.Matcher_Regex:
+		ok, err := fullMatch(spec.Regex, path)
+		check(ok, regexReason("path "+path, spec.Regex, ok, err))
+	case *v1.Matcher_Prefix:
+		ok := strings.HasPrefix(path, spec.Prefix)
+		check(ok, fmt.Sprintf("path %v %v prefix %v", path, is(ok, "has", "does not have"), spec.Prefix))
+	default:
+		check(true, "no path matcher, any path matches")
+	}
+
+	if len(matcher.GetMethods()) > 0 {
+		method := headers[":method"][0]
+		ok := false
+		for _, m := range matcher.Methods {
+			ok = ok || m == method
+		}
+		check(ok, fmt.Sprintf("method %v %v %v", method, is(ok, "is one of", "is not one of"), matcher.Methods))
+	}
+
+	for _, header := range matcher.GetHeaders() {
+		name := strings.ToLower(header.Name)
+		values, present := headers[name]
+		switch {
+		case !present:
+			check(false, fmt.Sprintf("header %v is missing", name))
+		case header.Value == "":
+			check(true, fmt.Sprintf("header %v is present", name))
+		case header.Regex:
+			ok, err := fullMatch(header.Value, values[0])
+			check(ok, regexReason(fmt.Sprintf("header %v value %v", name, values[0]), header.Value, ok, err))
+		default:
+			ok := values[0] == header.Value
+			check(ok, fmt.Sprintf("header %v value %v %v %v", name, values[0], is(ok, "equals", "does not equal"), header.Value))
+		}
+	}
+
+	for _, param := range matcher.GetQueryParameters() {
+		values, present := query[param.Name]
+		switch {
+		case !present:
+			check(false, fmt.Sprintf("query parameter %v is missing", param.Name))
+		case param.Value == "":
+			check(true, fmt.Sprintf("query parameter %v is present", param.Name))
+		case param.Regex:
+			ok, err := fullMatch(param.Value, values[0])
+			check(ok, regexReason(fmt.Sprintf("query parameter %v value %v", param.Name, values[0]), param.Value, ok, err))
+		default:
+			ok := values[0] == param.Value
+			check(ok, fmt.Sprintf("query parameter %v value %v %v %v", param.Name, values[0], is(ok, "equals", "does not equal"), param.Value))
+		}
+	}
+	return reasons, matched
+}
+
+// envoy matches the regexes against the whole value. go regexes are close to, but not exactly, the ecmascript ones
+// envoy uses, which the report may disagree with for the less common constructs
+func fullMatch(expr, value string) (bool, error) {
+	re, err := regexp.Compile("^(?:" + expr + ")$")
+	if err != nil {
+		return false, err
+	}
+	return re.MatchString(value), nil
+}
+
+func regexReason(subject, expr string, ok bool, err error) string {
+	if err != nil {
+		return fmt.Sprintf("%v cannot be matched, invalid regex %v: %v", subject, expr, err)
+	}
+	return fmt.Sprintf("%v %v regex %v", subject, is(ok, "matches", "does not match"), expr)
+}
+
+func is(ok bool, yes, no string) string {
+	if ok {
+		return yes
+	}
+	return no
+}
+
+func matchedRoute(index int, route *v1.Route) *MatchedRoute {
+	matched := &MatchedRoute{
+		Index:   index,
+		Plugins: pluginNames(route.RoutePlugins),
+	}
+	switch action := route.Action.(type) {
+	case *v1.Route_RouteAction:
+		matched.Action = "route"
+		switch dest := action.RouteAction.GetDestination().(type) {
+		case *v1.RouteAction_Single:
+			matched.Destinations = []Destination{destination(dest.Single, 0)}
+		case *v1.RouteAction_Multi:
+			for _, weighted := range dest.Multi.GetDestinations() {
+				matched.Destinations = append(matched.Destinations, destination(weighted.Destination, weighted.Weight))
+			}
+		case *v1.RouteAction_UpstreamGroup:
+			matched.UpstreamGroup = refKey(dest.UpstreamGroup)
+		}
+	case *v1.Route_RedirectAction:
+		matched.Action = "redirect"
+		matched.Redirect = action.RedirectAction
+	case *v1.Route_DirectResponseAction:
+		matched.Action = "direct response"
+		matched.Status = action.DirectResponseAction.GetStatus()
+	}
+	return matched
+}
+
+func destination(dest *v1.Destination, weight uint32) Destination {
+	out := Destination{
+		Weight:          weight,
+		DestinationSpec: dest.GetDestinationSpec(),
+		Subset:          dest.GetSubset(),
+	}
+	switch destType := dest.GetDestinationType().(type) {
+	case *v1.Destination_Upstream:
+		out.Upstream = refKey(destType.Upstream)
+	case *v1.Destination_Service:
+		out.Service = fmt.Sprintf("%v:%v", refKey(&destType.Service.Ref), destType.Service.Port)
+	}
+	return out
+}
+
+func refKey(ref *core.ResourceRef) string {
+	if ref == nil {
+		return ""
+	}
+	return ref.Key()
+}
+
+// the names of the plugins that are set, from the json names of the fields of the plugins message
+func pluginNames(pluginsMessage interface{}) []string {
+	value := reflect.ValueOf(pluginsMessage)
+	if value.Kind() != reflect.Ptr || value.IsNil() {
+		return nil
+	}
+	value = value.Elem()
+	var names []string
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Type().Field(i)
+		name := strings.Split(field.Tag.Get("json"), ",")[0]
+		if name == "" || name == "-" || strings.HasPrefix(field.Name, "XXX_") {
+			continue
+		}
+		if isZero(value.Field(i)) {
+			continue
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func isZero(value reflect.Value) bool {
+	switch value.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		return value.IsNil()
+	case reflect.Map, reflect.Slice:
+		return value.Len() == 0
+	}
+	return reflect.DeepEqual(value.Interface(), reflect.Zero(value.Type()).Interface())
+}
+
+// MarshalReports renders the reports as indented json
+func MarshalReports(reports []Report) ([]byte, error) {
+	return json.MarshalIndent(reports, "", "  ")
+}
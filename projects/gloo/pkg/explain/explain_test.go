@@ -0,0 +1,175 @@
+package explain_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/aws"
+	. "github.com/solo-io/gloo/projects/gloo/pkg/explain"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+var _ = Describe("Explain", func() {
+
+	var (
+		proxies v1.ProxyList
+		timeout time.Duration
+	)
+
+	route := func(matcher *v1.Matcher, upstream string) *v1.Route {
+		return &v1.Route{
+			Matcher: matcher,
+			Action: &v1.Route_RouteAction{
+				RouteAction: &v1.RouteAction{
+					Destination: &v1.RouteAction_Single{
+						Single: &v1.Destination{
+							DestinationType: &v1.Destination_Upstream{
+								Upstream: &core.ResourceRef{Name: upstream, Namespace: "gloo-system"},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		timeout = 5 * time.Second
+		functionRoute := route(&v1.Matcher{
+			PathSpecifier: &v1.Matcher_Prefix{Prefix: "/lambda"},
+			Methods:       []string{"POST"},
+		}, "aws")
+		functionRoute.GetRouteAction().GetSingle().DestinationSpec = &v1.DestinationSpec{
+			DestinationType: &v1.DestinationSpec_Aws{Aws: &aws.DestinationSpec{LogicalName: "hello"}},
+		}
+		functionRoute.RoutePlugins = &v1.RoutePlugins{Timeout: &timeout}
+
+		proxies = v1.ProxyList{{
+			Metadata: core.Metadata{Name: "gateway-proxy", Namespace: "gloo-system"},
+			Listeners: []*v1.Listener{{
+				Name:     "http",
+				BindPort: 8080,
+				ListenerType: &v1.Listener_HttpListener{
+					HttpListener: &v1.HttpListener{
+						VirtualHosts: []*v1.VirtualHost{
+							{
+								Name:    "default",
+								Domains: []string{"*"},
+								Routes: []*v1.Route{
+									route(&v1.Matcher{PathSpecifier: &v1.Matcher_Prefix{Prefix: "/"}}, "default"),
+								},
+							},
+							{
+								Name:    "wildcard",
+								Domains: []string{"*.example.com"},
+								Routes: []*v1.Route{
+									route(&v1.Matcher{PathSpecifier: &v1.Matcher_Prefix{Prefix: "/"}}, "wildcard"),
+								},
+							},
+							{
+								Name:    "api",
+								Domains: []string{"api.example.com"},
+								Routes: []*v1.Route{
+									route(&v1.Matcher{
+										PathSpecifier: &v1.Matcher_Exact{Exact: "/pets"},
+										Headers:       []*v1.HeaderMatcher{{Name: "x-version", Value: "v[0-9]+", Regex: true}},
+									}, "pets"),
+									route(&v1.Matcher{
+										PathSpecifier:   &v1.Matcher_Regex{Regex: "/stores/[0-9]+"},
+										QueryParameters: []*v1.QueryParameterMatcher{{Name: "open"}},
+									}, "stores"),
+									functionRoute,
+								},
+							},
+						},
+					},
+				},
+			}},
+		}}
+	})
+
+	explainOne := func(req Request) Report {
+		reports := Explain(proxies, req)
+		Expect(reports).To(HaveLen(1))
+		return reports[0]
+	}
+
+	It("selects the virtual host with the exact domain before the wildcards", func() {
+		report := explainOne(Request{Host: "api.example.com", Path: "/lambda"})
+		Expect(report.Proxy).To(Equal("gloo-system.gateway-proxy"))
+		Expect(report.VirtualHost).To(Equal("api"))
+		Expect(report.Domains).To(ContainElement(DomainDecision{VirtualHost: "wildcard", Domain: "*.example.com", Matched: true}))
+
+		Expect(explainOne(Request{Host: "www.example.com"}).VirtualHost).To(Equal("wildcard"))
+		Expect(explainOne(Request{Host: "example.com"}).VirtualHost).To(Equal("default"))
+	})
+
+	It("compares the host to the domains including its port", func() {
+		// neither the exact domain nor the suffix wildcard matches a host with a port
+		Expect(explainOne(Request{Host: "api.example.com:8080"}).VirtualHost).To(Equal("default"))
+	})
+
+	It("reports a 404 when no virtual host matches", func() {
+		proxies[0].Listeners[0].GetHttpListener().VirtualHosts = proxies[0].Listeners[0].GetHttpListener().VirtualHosts[1:]
+		report := explainOne(Request{Host: "example.com"})
+		Expect(report.VirtualHost).To(BeEmpty())
+		Expect(report.Result).To(Equal("404: no virtual host matches the domain example.com"))
+	})
+
+	It("explains why each route before the matched one did not match", func() {
+		report := explainOne(Request{
+			Host:    "api.example.com",
+			Path:    "/lambda/invoke?debug=true",
+			Method:  "POST",
+			Headers: map[string][]string{"X-Version": {"latest"}},
+		})
+		Expect(report.Routes).To(HaveLen(3))
+		Expect(report.Routes[0]).To(Equal(RouteDecision{Index: 0, Matched: false, Reasons: []string{
+			"path /lambda/invoke does not equal /pets",
+			"header x-version value latest does not match regex v[0-9]+",
+		}}))
+		Expect(report.Routes[1]).To(Equal(RouteDecision{Index: 1, Matched: false, Reasons: []string{
+			"path /lambda/invoke does not match regex /stores/[0-9]+",
+			"query parameter open is missing",
+		}}))
+		Expect(report.Routes[2]).To(Equal(RouteDecision{Index: 2, Matched: true, Reasons: []string{
+			"path /lambda/invoke has prefix /lambda",
+			"method POST is one of [POST]",
+		}}))
+		Expect(report.Result).To(Equal("matched route 2 of virtual host api"))
+	})
+
+	It("reports the destination, function and plugins of the matched route", func() {
+		report := explainOne(Request{Host: "api.example.com", Path: "/lambda", Method: "POST"})
+		Expect(report.Route).NotTo(BeNil())
+		Expect(report.Route.Action).To(Equal("route"))
+		Expect(report.Route.Plugins).To(Equal([]string{"timeout"}))
+		Expect(report.Route.Destinations).To(HaveLen(1))
+		Expect(report.Route.Destinations[0].Upstream).To(Equal("gloo-system.aws"))
+		Expect(report.Route.Destinations[0].DestinationSpec.GetAws().LogicalName).To(Equal("hello"))
+	})
+
+	It("matches the headers and query parameters", func() {
+		report := explainOne(Request{
+			Host:    "api.example.com",
+			Path:    "/pets",
+			Headers: map[string][]string{"X-Version": {"v2"}},
+		})
+		Expect(report.Route.Index).To(Equal(0))
+
+		report = explainOne(Request{Host: "api.example.com", Path: "/stores/12?open"})
+		Expect(report.Route.Index).To(Equal(1))
+		Expect(report.Route.Destinations[0].Upstream).To(Equal("gloo-system.stores"))
+	})
+
+	It("reports a 404 when no route matches", func() {
+		report := explainOne(Request{Host: "api.example.com", Path: "/lambda", Method: "GET"})
+		Expect(report.Route).To(BeNil())
+		Expect(report.Routes).To(HaveLen(3))
+		Expect(report.Routes[2].Reasons).To(ContainElement("method GET is not one of [POST]"))
+		Expect(report.Result).To(Equal("404: no route of virtual host api matches"))
+	})
+})
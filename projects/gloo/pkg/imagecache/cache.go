@@ -0,0 +1,211 @@
+package imagecache
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/solo-kit/pkg/errors"
+)
+
+const (
+	DefaultBindAddr = ":9979"
+	defaultPort     = "9979"
+
+	// the path the modules are served on, followed by their sha256 digest
+	ModulesPath = "/modules/"
+
+	pullTimeout    = time.Minute
+	initialBackoff = 5 * time.Second
+	maxBackoff     = 5 * time.Minute
+)
+
+// Cache pulls the wasm filter modules from image registries, and serves them to envoy over http by their sha256 digest.
+// The images are pulled in the background, so that the translation of the proxies never waits for a registry: the
+// proxies referencing an image are rejected until it is pulled, and translated again when the pull completes. The
+// failed pulls are retried with an exponential backoff.
+// It is shared by the workers translating the proxies concurrently.
+type Cache struct {
+	ctx context.Context
+
+	lock sync.Mutex
+	// the sha256 digests of the modules of the images pulled, by image reference
+	digests map[string]string
+	// the modules, by sha256 digest
+	modules map[string][]byte
+	// the images being pulled or whose last pull failed, by image reference
+	pulls map[string]*pull
+
+	puller *puller
+	pulled chan struct{}
+}
+
+// the state of the pull of an image not pulled yet
+type pull struct {
+	// whether the image is being pulled
+	inProgress bool
+	// the error of the last pull
+	err error
+	// the number of consecutive failed pulls
+	failures int
+	// the image is not pulled again before
+	retryAt time.Time
+}
+
+// New returns a cache pulling the images until the context is done
+func New(ctx context.Context) *Cache {
+	return NewWithClient(ctx, &http.Client{
+		Timeout:   pullTimeout,
+		Transport: &http.Transport{DialContext: (&net.Dialer{Control: refuseLoopback}).DialContext},
+	})
+}
+
+// NewWithClient returns a cache pulling the images with the http client
+func NewWithClient(ctx context.Context, client *http.Client) *Cache {
+	return &Cache{
+		ctx:     ctx,
+		digests: make(map[string]string),
+		modules: make(map[string][]byte),
+		pulls:   make(map[string]*pull),
+		puller:  newPuller(client),
+		pulled:  make(chan struct{}, 1),
+	}
+}
+
+// Pulled is signaled when a pull of an image completes or a failed pull can be retried, for the proxies referencing the
+// image to be translated again
+func (c *Cache) Pulled() <-chan struct{} {
+	return c.pulled
+}
+
+// Fetch returns the sha256 digest of the module of the image when the image was pulled. Otherwise it starts pulling the
+// image in the background, unless it is already being pulled or its last pull failed less than its backoff ago, and
+// returns an error.
+func (c *Cache) Fetch(image string) (string, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if digest, ok := c.digests[image]; ok {
+		return digest, nil
+	}
+	state, ok := c.pulls[image]
+	if !ok {
+		state = &pull{}
+		c.pulls[image] = state
+	}
+	switch {
+	case state.inProgress:
+		return "", errors.Errorf("wasm image %v is being pulled", image)
+	case state.err != nil && time.Now().Before(state.retryAt):
+		return "", errors.Wrapf(state.err, "pulling wasm image %v failed, retrying in %v", image,
+			time.Until(state.retryAt).Round(time.Second))
+	}
+	state.inProgress = true
+	go c.pull(image)
+	return "", errors.Errorf("wasm image %v is being pulled", image)
+}
+
+func (c *Cache) pull(image string) {
+	logger := contextutils.LoggerFrom(c.ctx)
+	logger.Infof("pulling wasm image %v", image)
+	digest, module, err := c.puller.pull(c.ctx, image)
+
+	c.lock.Lock()
+	state := c.pulls[image]
+	state.inProgress = false
+	if err == nil {
+		delete(c.pulls, image)
+		c.digests[image] = digest
+		c.modules[digest] = module
+		c.lock.Unlock()
+		c.notify()
+		return
+	}
+	state.err = err
+	state.failures++
+	backoff := retryBackoff(state.failures)
+	state.retryAt = time.Now().Add(backoff)
+	c.lock.Unlock()
+
+	logger.Warnf("pulling wasm image %v failed, retrying in %v: %v", image, backoff, err)
+	// report the error, and retry once the backoff is over
+	c.notify()
+	time.AfterFunc(backoff, c.notify)
+}
+
+func (c *Cache) notify() {
+	select {
+	case c.pulled <- struct{}{}:
+	default:
+	}
+}
+
+// the backoff after consecutive failed pulls of an image, doubling from the initial backoff up to the max backoff
+func retryBackoff(failures int) time.Duration {
+	backoff := initialBackoff
+	for i := 1; i < failures && backoff < maxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > maxBackoff {
+		return maxBackoff
+	}
+	return backoff
+}
+
+func (c *Cache) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet || !strings.HasPrefix(r.URL.Path, ModulesPath) {
+		http.NotFound(w, r)
+		return
+	}
+	c.lock.Lock()
+	module, ok := c.modules[strings.TrimPrefix(r.URL.Path, ModulesPath)]
+	c.lock.Unlock()
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	w.Header().Set("Content-Type", "application/wasm")
+	http.ServeContent(w, r, "", time.Time{}, bytes.NewReader(module))
+}
+
+// Serve serves the modules on the address until the context is done
+func (c *Cache) Serve(ctx context.Context, addr string) error {
+	server := &http.Server{
+		Addr:    addr,
+		Handler: c,
+	}
+	go func() {
+		<-ctx.Done()
+		server.Close()
+	}()
+	if err := server.ListenAndServe(); err != http.ErrServerClosed {
+		return err
+	}
+	return nil
+}
+
+// BindAddr returns the address the modules are served on
+func BindAddr(opts *v1.WasmOptions) string {
+	if addr := opts.GetImageCacheBindAddr(); addr != "" {
+		return addr
+	}
+	return DefaultBindAddr
+}
+
+// Address returns the address envoy fetches the modules from, the service of gloo in its namespace by default
+func Address(opts *v1.WasmOptions, namespace string) string {
+	if addr := opts.GetImageCacheAddress(); addr != "" {
+		return addr
+	}
+	_, port, err := net.SplitHostPort(BindAddr(opts))
+	if err != nil || port == "" {
+		port = defaultPort
+	}
+	return fmt.Sprintf("gloo.%v.svc.cluster.local:%v", namespace, port)
+}
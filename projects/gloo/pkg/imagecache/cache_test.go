@@ -0,0 +1,161 @@
+package imagecache_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	. "github.com/solo-io/gloo/projects/gloo/pkg/imagecache"
+)
+
+var _ = Describe("Cache", func() {
+
+	var (
+		module   []byte
+		digest   string
+		registry *httptest.Server
+		// the number of manifests served by the registry
+		pulls int
+		// the bearer token the registry requires, none if empty
+		token  string
+		cache  *Cache
+		image  string
+		ctx    context.Context
+		cancel context.CancelFunc
+	)
+
+	// pulls the image, which is pulled in the background
+	fetch := func(image string) (string, error) {
+		_, err := cache.Fetch(image)
+		Expect(err).To(MatchError(ContainSubstring("is being pulled")))
+		Eventually(cache.Pulled()).Should(Receive())
+		return cache.Fetch(image)
+	}
+
+	BeforeEach(func() {
+		module = []byte("\x00asm\x01\x00\x00\x00")
+		sum := sha256.Sum256(module)
+		digest = hex.EncodeToString(sum[:])
+		pulls = 0
+		token = ""
+
+		mux := http.NewServeMux()
+		mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+			Expect(r.URL.Query().Get("scope")).To(Equal("repository:user/filter:pull"))
+			fmt.Fprintf(w, `{"token": %q}`, token)
+		})
+		mux.HandleFunc("/v2/user/filter/", func(w http.ResponseWriter, r *http.Request) {
+			if token != "" && r.Header.Get("Authorization") != "Bearer "+token {
+				w.Header().Set("Www-Authenticate", `Bearer realm="https://example.com/token",service="registry",scope="repository:user/filter:pull"`)
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			switch r.URL.Path {
+			case "/v2/user/filter/manifests/v1":
+				pulls++
+				fmt.Fprintf(w, `{"schemaVersion": 2, "layers": [{"mediaType": "application/vnd.oci.image.config.v1+json", "digest": "sha256:0"}, {"mediaType": %q, "digest": "sha256:%v"}]}`,
+					WasmLayerMediaType, digest)
+			case "/v2/user/filter/blobs/sha256:" + digest:
+				w.Write(module)
+			default:
+				http.NotFound(w, r)
+			}
+		})
+		registry = httptest.NewTLSServer(mux)
+		// the registry is served on the loopback interface, which the cache refuses to pull from, as example.com
+		transport := registry.Client().Transport.(*http.Transport)
+		transport.DialContext = func(ctx context.Context, network, _ string) (net.Conn, error) {
+			return (&net.Dialer{}).DialContext(ctx, network, registry.Listener.Addr().String())
+		}
+		image = "example.com/user/filter:v1"
+		ctx, cancel = context.WithCancel(context.Background())
+		cache = NewWithClient(ctx, &http.Client{Transport: transport})
+	})
+
+	AfterEach(func() {
+		cancel()
+		registry.Close()
+	})
+
+	It("should pull the module of an image once", func() {
+		fetched, err := fetch(image)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(fetched).To(Equal(digest))
+
+		fetched, err = cache.Fetch(image)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(fetched).To(Equal(digest))
+		Expect(pulls).To(Equal(1))
+	})
+
+	It("should authenticate with the token of the registry", func() {
+		token = "anonymous"
+		fetched, err := fetch(image)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(fetched).To(Equal(digest))
+	})
+
+	It("should fail for missing images, and not pull them again before their backoff", func() {
+		missing := strings.TrimSuffix(image, "v1") + "v2"
+		_, err := cache.Fetch(missing)
+		Expect(err).To(HaveOccurred())
+		Eventually(func() error {
+			_, err := cache.Fetch(missing)
+			return err
+		}).Should(MatchError(ContainSubstring("retrying in")))
+
+		_, err = cache.Fetch(missing)
+		Expect(err).To(MatchError(ContainSubstring("retrying in")))
+	})
+
+	It("should refuse the registries on the loopback interface", func() {
+		for _, registry := range []string{"localhost:5000", "127.0.0.1:5000", "[::1]:5000"} {
+			_, err := fetch(registry + "/user/filter:v1")
+			Expect(err).To(MatchError(ContainSubstring("loopback interface")))
+		}
+	})
+
+	It("should refuse the token realms on the loopback interface", func() {
+		registry.Config.Handler.(*http.ServeMux).HandleFunc("/v2/user/loopback/", func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Www-Authenticate", `Bearer realm="https://127.0.0.1/token"`)
+			w.WriteHeader(http.StatusUnauthorized)
+		})
+		_, err := fetch("example.com/user/loopback:v1")
+		Expect(err).To(MatchError(ContainSubstring("loopback interface")))
+	})
+
+	It("should serve the modules pulled by digest", func() {
+		server := httptest.NewServer(cache)
+		defer server.Close()
+
+		resp, err := http.Get(server.URL + ModulesPath + digest)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resp.StatusCode).To(Equal(http.StatusNotFound))
+		resp.Body.Close()
+
+		_, err = fetch(image)
+		Expect(err).NotTo(HaveOccurred())
+		resp, err = http.Get(server.URL + ModulesPath + digest)
+		Expect(err).NotTo(HaveOccurred())
+		defer resp.Body.Close()
+		Expect(resp.StatusCode).To(Equal(http.StatusOK))
+		body, err := ioutil.ReadAll(resp.Body)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(body).To(Equal(module))
+	})
+
+	It("should default the addresses to the service of gloo", func() {
+		Expect(BindAddr(&v1.WasmOptions{})).To(Equal(DefaultBindAddr))
+		Expect(Address(&v1.WasmOptions{ImageCacheBindAddr: "0.0.0.0:8080"}, "gloo-system")).To(Equal("gloo.gloo-system.svc.cluster.local:8080"))
+		Expect(Address(&v1.WasmOptions{ImageCacheAddress: "gloo:9979"}, "gloo-system")).To(Equal("gloo:9979"))
+	})
+})
@@ -0,0 +1,13 @@
+package imagecache_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestImageCache(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "ImageCache Suite")
+}
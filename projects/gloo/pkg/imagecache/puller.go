@@ -0,0 +1,260 @@
+package imagecache
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"syscall"
+
+	"github.com/solo-io/solo-kit/pkg/errors"
+)
+
+const (
+	defaultRegistry = "registry-1.docker.io"
+	defaultTag      = "latest"
+
+	// the media type of the layers holding a wasm module
+	WasmLayerMediaType = "application/vnd.module.wasm.content.layer.v1+wasm"
+
+	ociManifestMediaType    = "application/vnd.oci.image.manifest.v1+json"
+	dockerManifestMediaType = "application/vnd.docker.distribution.manifest.v2+json"
+)
+
+// a reference to an image, e.g. webassemblyhub.io/user/filter:v1 or webassemblyhub.io/user/filter@sha256:<digest>
+type reference struct {
+	registry   string
+	repository string
+	// the tag or digest of the manifest
+	reference string
+}
+
+func parseReference(image string) (reference, error) {
+	ref := reference{registry: defaultRegistry}
+	name := image
+	// the first component is the registry when it has a port, a dot or is localhost
+	if i := strings.Index(name, "/"); i > 0 {
+		first := name[:i]
+		if strings.ContainsAny(first, ".:") || first == "localhost" {
+			ref.registry = first
+			name = name[i+1:]
+		}
+	}
+	switch {
+	case strings.Contains(name, "@"):
+		i := strings.Index(name, "@")
+		ref.repository, ref.reference = name[:i], name[i+1:]
+	case strings.LastIndex(name, ":") > strings.LastIndex(name, "/"):
+		i := strings.LastIndex(name, ":")
+		ref.repository, ref.reference = name[:i], name[i+1:]
+	default:
+		ref.repository, ref.reference = name, defaultTag
+	}
+	if ref.repository == "" || ref.reference == "" {
+		return reference{}, errors.Errorf("invalid image reference %v", image)
+	}
+	if ref.registry == defaultRegistry && !strings.Contains(ref.repository, "/") {
+		ref.repository = "library/" + ref.repository
+	}
+	return ref, nil
+}
+
+type descriptor struct {
+	MediaType string `json:"mediaType"`
+	Digest    string `json:"digest"`
+}
+
+type manifest struct {
+	Layers []descriptor `json:"layers"`
+}
+
+// pulls the modules with the registry http api v2, authenticating anonymously with the bearer tokens of the registries
+// that require them
+type puller struct {
+	client *http.Client
+}
+
+func newPuller(client *http.Client) *puller {
+	return &puller{client: client}
+}
+
+// the sha256 digest of the module of the image, and the module
+func (p *puller) pull(ctx context.Context, image string) (string, []byte, error) {
+	ref, err := parseReference(image)
+	if err != nil {
+		return "", nil, err
+	}
+
+	body, err := p.get(ctx, ref, "manifests/"+ref.reference, ociManifestMediaType+","+dockerManifestMediaType)
+	if err != nil {
+		return "", nil, err
+	}
+	var m manifest
+	if err := json.Unmarshal(body, &m); err != nil {
+		return "", nil, errors.Wrapf(err, "invalid manifest")
+	}
+	layer, err := moduleLayer(m)
+	if err != nil {
+		return "", nil, err
+	}
+
+	module, err := p.get(ctx, ref, "blobs/"+layer.Digest, "")
+	if err != nil {
+		return "", nil, err
+	}
+	sum := sha256.Sum256(module)
+	digest := hex.EncodeToString(sum[:])
+	if layer.Digest != "sha256:"+digest {
+		return "", nil, errors.Errorf("the digest of the module is sha256:%v, expected %v", digest, layer.Digest)
+	}
+	return digest, module, nil
+}
+
+// the layer of wasm media type, or the single layer of the image
+func moduleLayer(m manifest) (descriptor, error) {
+	for _, layer := range m.Layers {
+		if layer.MediaType == WasmLayerMediaType {
+			return layer, nil
+		}
+	}
+	if len(m.Layers) != 1 {
+		return descriptor{}, errors.Errorf("the image must have a single layer or a layer of media type %v, found %d layers",
+			WasmLayerMediaType, len(m.Layers))
+	}
+	if !strings.HasPrefix(m.Layers[0].Digest, "sha256:") {
+		return descriptor{}, errors.Errorf("unsupported digest %v", m.Layers[0].Digest)
+	}
+	return m.Layers[0], nil
+}
+
+func (p *puller) get(ctx context.Context, ref reference, path, accept string) ([]byte, error) {
+	registry, err := registryUrl(ref.registry)
+	if err != nil {
+		return nil, err
+	}
+	u := fmt.Sprintf("%v/v2/%v/%v", registry, ref.repository, path)
+	resp, err := p.do(ctx, u, accept, "")
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusUnauthorized {
+		challenge := resp.Header.Get("Www-Authenticate")
+		resp.Body.Close()
+		token, err := p.token(ctx, challenge)
+		if err != nil {
+			return nil, errors.Wrapf(err, "authenticating to registry %v", ref.registry)
+		}
+		resp, err = p.do(ctx, u, accept, token)
+		if err != nil {
+			return nil, err
+		}
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("GET %v: unexpected status %v", u, resp.Status)
+	}
+	return ioutil.ReadAll(resp.Body)
+}
+
+func (p *puller) do(ctx context.Context, u, accept, token string) (*http.Response, error) {
+	req, err := http.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	if accept != "" {
+		req.Header.Set("Accept", accept)
+	}
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	return p.client.Do(req.WithContext(ctx))
+}
+
+// the anonymous token of the bearer challenge of the registry, e.g.
+// Bearer realm="https://auth.docker.io/token",service="registry.docker.io",scope="repository:library/filter:pull"
+func (p *puller) token(ctx context.Context, challenge string) (string, error) {
+	if !strings.HasPrefix(challenge, "Bearer ") {
+		return "", errors.Errorf("unsupported authentication challenge %q", challenge)
+	}
+	params := make(map[string]string)
+	for _, param := range strings.Split(strings.TrimPrefix(challenge, "Bearer "), ",") {
+		kv := strings.SplitN(strings.TrimSpace(param), "=", 2)
+		if len(kv) == 2 {
+			params[kv[0]] = strings.Trim(kv[1], `"`)
+		}
+	}
+	realm, err := url.Parse(params["realm"])
+	if err != nil || params["realm"] == "" {
+		return "", errors.Errorf("invalid realm in authentication challenge %q", challenge)
+	}
+	if realm.Scheme != "https" || isLoopback(realm.Hostname()) {
+		return "", errors.Errorf("refusing the realm %v of authentication challenge, which must be served over https "+
+			"and not on the loopback interface", realm)
+	}
+	query := realm.Query()
+	for _, key := range []string{"service", "scope"} {
+		if params[key] != "" {
+			query.Set(key, params[key])
+		}
+	}
+	realm.RawQuery = query.Encode()
+
+	resp, err := p.do(ctx, realm.String(), "", "")
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", errors.Errorf("GET %v: unexpected status %v", realm, resp.Status)
+	}
+	var body struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return "", err
+	}
+	if body.Token != "" {
+		return body.Token, nil
+	}
+	return body.AccessToken, nil
+}
+
+// the registries are only pulled from over https, and never on the loopback interface of gloo, which would let the
+// authors of the proxies query the services listening on it
+func registryUrl(registry string) (string, error) {
+	host := registry
+	if h, _, err := net.SplitHostPort(registry); err == nil {
+		host = h
+	}
+	if isLoopback(host) {
+		return "", errors.Errorf("refusing to pull from the registry %v on the loopback interface", registry)
+	}
+	return "https://" + registry, nil
+}
+
+func isLoopback(host string) bool {
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return true
+	}
+	ip := net.ParseIP(strings.Trim(host, "[]"))
+	return ip != nil && (ip.IsLoopback() || ip.IsUnspecified())
+}
+
+// refuses the connections to the loopback interface, for the registries and token realms whose names resolve to it
+func refuseLoopback(network, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	if isLoopback(host) {
+		return errors.Errorf("refusing to connect to %v on the loopback interface", address)
+	}
+	return nil
+}
@@ -0,0 +1,432 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: filter.proto
+
+package adaptiveconcurrency
+
+import (
+	fmt "fmt"
+	math "math"
+
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	proto "github.com/gogo/protobuf/proto"
+	types "github.com/gogo/protobuf/types"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+// Configuration parameters for the gradient controller.
+type GradientControllerConfig struct {
+	// The percentile to use when summarizing aggregated samples. Defaults to p50.
+	SampleAggregatePercentile *Percent                                                    `protobuf:"bytes,1,opt,name=sample_aggregate_percentile,json=sampleAggregatePercentile,proto3" json:"sample_aggregate_percentile,omitempty"`
+	ConcurrencyLimitParams    *GradientControllerConfig_ConcurrencyLimitCalculationParams `protobuf:"bytes,2,opt,name=concurrency_limit_params,json=concurrencyLimitParams,proto3" json:"concurrency_limit_params,omitempty"`
+	MinRttCalcParams          *GradientControllerConfig_MinimumRTTCalculationParams       `protobuf:"bytes,3,opt,name=min_rtt_calc_params,json=minRttCalcParams,proto3" json:"min_rtt_calc_params,omitempty"`
+	XXX_NoUnkeyedLiteral      struct{}                                                    `json:"-"`
+	XXX_unrecognized          []byte                                                      `json:"-"`
+	XXX_sizecache             int32                                                       `json:"-"`
+}
+
+func (m *GradientControllerConfig) Reset()         { *m = GradientControllerConfig{} }
+func (m *GradientControllerConfig) String() string { return proto.CompactTextString(m) }
+func (*GradientControllerConfig) ProtoMessage()    {}
+func (*GradientControllerConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f5303cab7a20d6f, []int{0}
+}
+func (m *GradientControllerConfig) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GradientControllerConfig.Unmarshal(m, b)
+}
+func (m *GradientControllerConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GradientControllerConfig.Marshal(b, m, deterministic)
+}
+func (m *GradientControllerConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GradientControllerConfig.Merge(m, src)
+}
+func (m *GradientControllerConfig) XXX_Size() int {
+	return xxx_messageInfo_GradientControllerConfig.Size(m)
+}
+func (m *GradientControllerConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_GradientControllerConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GradientControllerConfig proto.InternalMessageInfo
+
+func (m *GradientControllerConfig) GetSampleAggregatePercentile() *Percent {
+	if m != nil {
+		return m.SampleAggregatePercentile
+	}
+	return nil
+}
+
+func (m *GradientControllerConfig) GetConcurrencyLimitParams() *GradientControllerConfig_ConcurrencyLimitCalculationParams {
+	if m != nil {
+		return m.ConcurrencyLimitParams
+	}
+	return nil
+}
+
+func (m *GradientControllerConfig) GetMinRttCalcParams() *GradientControllerConfig_MinimumRTTCalculationParams {
+	if m != nil {
+		return m.MinRttCalcParams
+	}
+	return nil
+}
+
+// Parameters controlling the periodic recalculation of the concurrency limit from sampled request
+// latencies.
+type GradientControllerConfig_ConcurrencyLimitCalculationParams struct {
+	// The allowed upper-bound on the calculated concurrency limit. Defaults to 1000.
+	MaxConcurrencyLimit *types.UInt32Value `protobuf:"bytes,2,opt,name=max_concurrency_limit,json=maxConcurrencyLimit,proto3" json:"max_concurrency_limit,omitempty"`
+	// The period of time samples are taken to recalculate the concurrency limit.
+	ConcurrencyUpdateInterval *types.Duration `protobuf:"bytes,3,opt,name=concurrency_update_interval,json=concurrencyUpdateInterval,proto3" json:"concurrency_update_interval,omitempty"`
+	XXX_NoUnkeyedLiteral      struct{}        `json:"-"`
+	XXX_unrecognized          []byte          `json:"-"`
+	XXX_sizecache             int32           `json:"-"`
+}
+
+func (m *GradientControllerConfig_ConcurrencyLimitCalculationParams) Reset() {
+	*m = GradientControllerConfig_ConcurrencyLimitCalculationParams{}
+}
+func (m *GradientControllerConfig_ConcurrencyLimitCalculationParams) String() string {
+	return proto.CompactTextString(m)
+}
+func (*GradientControllerConfig_ConcurrencyLimitCalculationParams) ProtoMessage() {}
+func (*GradientControllerConfig_ConcurrencyLimitCalculationParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f5303cab7a20d6f, []int{0, 0}
+}
+func (m *GradientControllerConfig_ConcurrencyLimitCalculationParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GradientControllerConfig_ConcurrencyLimitCalculationParams.Unmarshal(m, b)
+}
+func (m *GradientControllerConfig_ConcurrencyLimitCalculationParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GradientControllerConfig_ConcurrencyLimitCalculationParams.Marshal(b, m, deterministic)
+}
+func (m *GradientControllerConfig_ConcurrencyLimitCalculationParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GradientControllerConfig_ConcurrencyLimitCalculationParams.Merge(m, src)
+}
+func (m *GradientControllerConfig_ConcurrencyLimitCalculationParams) XXX_Size() int {
+	return xxx_messageInfo_GradientControllerConfig_ConcurrencyLimitCalculationParams.Size(m)
+}
+func (m *GradientControllerConfig_ConcurrencyLimitCalculationParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_GradientControllerConfig_ConcurrencyLimitCalculationParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GradientControllerConfig_ConcurrencyLimitCalculationParams proto.InternalMessageInfo
+
+func (m *GradientControllerConfig_ConcurrencyLimitCalculationParams) GetMaxConcurrencyLimit() *types.UInt32Value {
+	if m != nil {
+		return m.MaxConcurrencyLimit
+	}
+	return nil
+}
+
+func (m *GradientControllerConfig_ConcurrencyLimitCalculationParams) GetConcurrencyUpdateInterval() *types.Duration {
+	if m != nil {
+		return m.ConcurrencyUpdateInterval
+	}
+	return nil
+}
+
+// Parameters controlling the periodic minRTT recalculation.
+// [#next-free-field: 6]
+type GradientControllerConfig_MinimumRTTCalculationParams struct {
+	// The time interval between recalculating the minimum request round-trip time.
+	Interval *types.Duration `protobuf:"bytes,1,opt,name=interval,proto3" json:"interval,omitempty"`
+	// The number of requests to aggregate/sample during the minRTT recalculation window before
+	// updating. Defaults to 50.
+	RequestCount *types.UInt32Value `protobuf:"bytes,2,opt,name=request_count,json=requestCount,proto3" json:"request_count,omitempty"`
+	// Randomized time delta that will be introduced to the start of the minRTT calculation window.
+	// This is represented as a percentage of the interval duration. Defaults to 15%.
+	//
+	// Example: If the interval is 10s and the jitter is 15%, the next window will begin
+	// somewhere in the range (10s - 11.5s).
+	Jitter *Percent `protobuf:"bytes,3,opt,name=jitter,proto3" json:"jitter,omitempty"`
+	// The concurrency limit set while measuring the minRTT. Defaults to 3.
+	MinConcurrency *types.UInt32Value `protobuf:"bytes,4,opt,name=min_concurrency,json=minConcurrency,proto3" json:"min_concurrency,omitempty"`
+	// Amount added to the measured minRTT to add stability to the concurrency limit during natural
+	// variability in latency. This is expressed as a percentage of the measured value and can be
+	// adjusted to allow more or less tolerance to the sampled latency values.
+	//
+	// Defaults to 25%.
+	Buffer               *Percent `protobuf:"bytes,5,opt,name=buffer,proto3" json:"buffer,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GradientControllerConfig_MinimumRTTCalculationParams) Reset() {
+	*m = GradientControllerConfig_MinimumRTTCalculationParams{}
+}
+func (m *GradientControllerConfig_MinimumRTTCalculationParams) String() string {
+	return proto.CompactTextString(m)
+}
+func (*GradientControllerConfig_MinimumRTTCalculationParams) ProtoMessage() {}
+func (*GradientControllerConfig_MinimumRTTCalculationParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f5303cab7a20d6f, []int{0, 1}
+}
+func (m *GradientControllerConfig_MinimumRTTCalculationParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GradientControllerConfig_MinimumRTTCalculationParams.Unmarshal(m, b)
+}
+func (m *GradientControllerConfig_MinimumRTTCalculationParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GradientControllerConfig_MinimumRTTCalculationParams.Marshal(b, m, deterministic)
+}
+func (m *GradientControllerConfig_MinimumRTTCalculationParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GradientControllerConfig_MinimumRTTCalculationParams.Merge(m, src)
+}
+func (m *GradientControllerConfig_MinimumRTTCalculationParams) XXX_Size() int {
+	return xxx_messageInfo_GradientControllerConfig_MinimumRTTCalculationParams.Size(m)
+}
+func (m *GradientControllerConfig_MinimumRTTCalculationParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_GradientControllerConfig_MinimumRTTCalculationParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GradientControllerConfig_MinimumRTTCalculationParams proto.InternalMessageInfo
+
+func (m *GradientControllerConfig_MinimumRTTCalculationParams) GetInterval() *types.Duration {
+	if m != nil {
+		return m.Interval
+	}
+	return nil
+}
+
+func (m *GradientControllerConfig_MinimumRTTCalculationParams) GetRequestCount() *types.UInt32Value {
+	if m != nil {
+		return m.RequestCount
+	}
+	return nil
+}
+
+func (m *GradientControllerConfig_MinimumRTTCalculationParams) GetJitter() *Percent {
+	if m != nil {
+		return m.Jitter
+	}
+	return nil
+}
+
+func (m *GradientControllerConfig_MinimumRTTCalculationParams) GetMinConcurrency() *types.UInt32Value {
+	if m != nil {
+		return m.MinConcurrency
+	}
+	return nil
+}
+
+func (m *GradientControllerConfig_MinimumRTTCalculationParams) GetBuffer() *Percent {
+	if m != nil {
+		return m.Buffer
+	}
+	return nil
+}
+
+type AdaptiveConcurrency struct {
+	// Types that are valid to be assigned to ConcurrencyControllerConfig:
+	//	*AdaptiveConcurrency_GradientControllerConfig
+	ConcurrencyControllerConfig isAdaptiveConcurrency_ConcurrencyControllerConfig `protobuf_oneof:"concurrency_controller_config"`
+	XXX_NoUnkeyedLiteral        struct{}                                          `json:"-"`
+	XXX_unrecognized            []byte                                            `json:"-"`
+	XXX_sizecache               int32                                             `json:"-"`
+}
+
+func (m *AdaptiveConcurrency) Reset()         { *m = AdaptiveConcurrency{} }
+func (m *AdaptiveConcurrency) String() string { return proto.CompactTextString(m) }
+func (*AdaptiveConcurrency) ProtoMessage()    {}
+func (*AdaptiveConcurrency) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f5303cab7a20d6f, []int{1}
+}
+func (m *AdaptiveConcurrency) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AdaptiveConcurrency.Unmarshal(m, b)
+}
+func (m *AdaptiveConcurrency) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AdaptiveConcurrency.Marshal(b, m, deterministic)
+}
+func (m *AdaptiveConcurrency) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdaptiveConcurrency.Merge(m, src)
+}
+func (m *AdaptiveConcurrency) XXX_Size() int {
+	return xxx_messageInfo_AdaptiveConcurrency.Size(m)
+}
+func (m *AdaptiveConcurrency) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdaptiveConcurrency.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdaptiveConcurrency proto.InternalMessageInfo
+
+type isAdaptiveConcurrency_ConcurrencyControllerConfig interface {
+	isAdaptiveConcurrency_ConcurrencyControllerConfig()
+}
+
+type AdaptiveConcurrency_GradientControllerConfig struct {
+	GradientControllerConfig *GradientControllerConfig `protobuf:"bytes,1,opt,name=gradient_controller_config,json=gradientControllerConfig,proto3,oneof"`
+}
+
+func (*AdaptiveConcurrency_GradientControllerConfig) isAdaptiveConcurrency_ConcurrencyControllerConfig() {
+}
+
+func (m *AdaptiveConcurrency) GetConcurrencyControllerConfig() isAdaptiveConcurrency_ConcurrencyControllerConfig {
+	if m != nil {
+		return m.ConcurrencyControllerConfig
+	}
+	return nil
+}
+
+func (m *AdaptiveConcurrency) GetGradientControllerConfig() *GradientControllerConfig {
+	if x, ok := m.GetConcurrencyControllerConfig().(*AdaptiveConcurrency_GradientControllerConfig); ok {
+		return x.GradientControllerConfig
+	}
+	return nil
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*AdaptiveConcurrency) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _AdaptiveConcurrency_OneofMarshaler, _AdaptiveConcurrency_OneofUnmarshaler, _AdaptiveConcurrency_OneofSizer, []interface{}{
+		(*AdaptiveConcurrency_GradientControllerConfig)(nil),
+	}
+}
+
+func _AdaptiveConcurrency_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*AdaptiveConcurrency)
+	// concurrency_controller_config
+	switch x := m.ConcurrencyControllerConfig.(type) {
+	case *AdaptiveConcurrency_GradientControllerConfig:
+		_ = b.EncodeVarint(1<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.GradientControllerConfig); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("AdaptiveConcurrency.ConcurrencyControllerConfig has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _AdaptiveConcurrency_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*AdaptiveConcurrency)
+	switch tag {
+	case 1: // concurrency_controller_config.gradient_controller_config
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(GradientControllerConfig)
+		err := b.DecodeMessage(msg)
+		m.ConcurrencyControllerConfig = &AdaptiveConcurrency_GradientControllerConfig{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _AdaptiveConcurrency_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*AdaptiveConcurrency)
+	// concurrency_controller_config
+	switch x := m.ConcurrencyControllerConfig.(type) {
+	case *AdaptiveConcurrency_GradientControllerConfig:
+		s := proto.Size(x.GradientControllerConfig)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+// Identifies a percentage, in the range [0.0, 100.0].
+type Percent struct {
+	Value                float64  `protobuf:"fixed64,1,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Percent) Reset()         { *m = Percent{} }
+func (m *Percent) String() string { return proto.CompactTextString(m) }
+func (*Percent) ProtoMessage()    {}
+func (*Percent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f5303cab7a20d6f, []int{2}
+}
+func (m *Percent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Percent.Unmarshal(m, b)
+}
+func (m *Percent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Percent.Marshal(b, m, deterministic)
+}
+func (m *Percent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Percent.Merge(m, src)
+}
+func (m *Percent) XXX_Size() int {
+	return xxx_messageInfo_Percent.Size(m)
+}
+func (m *Percent) XXX_DiscardUnknown() {
+	xxx_messageInfo_Percent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Percent proto.InternalMessageInfo
+
+func (m *Percent) GetValue() float64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*GradientControllerConfig)(nil), "envoy.config.filter.http.adaptive_concurrency.v2alpha.GradientControllerConfig")
+	proto.RegisterType((*GradientControllerConfig_ConcurrencyLimitCalculationParams)(nil), "envoy.config.filter.http.adaptive_concurrency.v2alpha.GradientControllerConfig.ConcurrencyLimitCalculationParams")
+	proto.RegisterType((*GradientControllerConfig_MinimumRTTCalculationParams)(nil), "envoy.config.filter.http.adaptive_concurrency.v2alpha.GradientControllerConfig.MinimumRTTCalculationParams")
+	proto.RegisterType((*AdaptiveConcurrency)(nil), "envoy.config.filter.http.adaptive_concurrency.v2alpha.AdaptiveConcurrency")
+	proto.RegisterType((*Percent)(nil), "envoy.config.filter.http.adaptive_concurrency.v2alpha.Percent")
+}
+
+func init() { proto.RegisterFile("filter.proto", fileDescriptor_1f5303cab7a20d6f) }
+
+var fileDescriptor_1f5303cab7a20d6f = []byte{
+	// 662 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xcd, 0x4e, 0x1b, 0x3b,
+	0x14, 0x8e, 0x49, 0xf8, 0xf3, 0xe5, 0xde, 0xcb, 0x35, 0x97, 0x76, 0x12, 0x4a, 0x45, 0x59, 0xb5,
+	0x48, 0x9d, 0x91, 0x40, 0xdd, 0x74, 0x81, 0x4a, 0xd2, 0x8a, 0x22, 0x51, 0x35, 0x1a, 0x01, 0x52,
+	0xdb, 0xc5, 0xc8, 0x71, 0x1c, 0x63, 0xf0, 0xd8, 0x83, 0xc7, 0x4e, 0x61, 0xd3, 0x07, 0xe8, 0x2b,
+	0x54, 0xea, 0xb2, 0x8b, 0x2e, 0xbb, 0xa9, 0xc4, 0xaa, 0xaf, 0xc3, 0x0b, 0x74, 0x5d, 0xcd, 0x8c,
+	0x93, 0x8e, 0x88, 0xa0, 0x02, 0xc5, 0xab, 0x44, 0xe7, 0x9c, 0xef, 0xfb, 0xce, 0x77, 0xce, 0x49,
+	0xe0, 0x5c, 0x8f, 0x0b, 0x43, 0xb5, 0x9f, 0x68, 0x65, 0x14, 0x7a, 0x42, 0x65, 0x5f, 0x9d, 0xf9,
+	0x44, 0xc9, 0x1e, 0x67, 0xbe, 0x0b, 0x1d, 0x1a, 0x93, 0xf8, 0xb8, 0x8b, 0x13, 0xc3, 0xfb, 0x34,
+	0x22, 0x4a, 0x12, 0xab, 0x35, 0x95, 0xe4, 0xcc, 0xef, 0xaf, 0x63, 0x91, 0x1c, 0xe2, 0xc6, 0x7d,
+	0xa6, 0x14, 0x13, 0x34, 0xc8, 0x41, 0x3a, 0xb6, 0x17, 0x74, 0xad, 0xc6, 0x86, 0x2b, 0x59, 0xc0,
+	0x8e, 0xc6, 0xdf, 0x6b, 0x9c, 0x24, 0x54, 0xa7, 0x2e, 0x7e, 0xb7, 0x8f, 0x05, 0xef, 0x62, 0x43,
+	0x83, 0xc1, 0x87, 0x22, 0xb0, 0xfa, 0x79, 0x16, 0x7a, 0xdb, 0x1a, 0x77, 0x39, 0x95, 0xa6, 0xa5,
+	0xa4, 0xd1, 0x4a, 0x08, 0xaa, 0x5b, 0xb9, 0x3c, 0xf4, 0x01, 0x2e, 0xa5, 0x38, 0x4e, 0x04, 0x8d,
+	0x30, 0x63, 0x9a, 0x32, 0x6c, 0x68, 0x94, 0x50, 0x4d, 0xa8, 0x34, 0x5c, 0x50, 0x0f, 0xac, 0x80,
+	0x87, 0x7f, 0xad, 0x6f, 0xfa, 0xb7, 0x6a, 0xc9, 0x6f, 0x17, 0x40, 0x61, 0xbd, 0xa0, 0xd8, 0x1a,
+	0x30, 0xb4, 0x87, 0x04, 0xe8, 0x3b, 0x80, 0x5e, 0xa9, 0x34, 0x12, 0x3c, 0xe6, 0x26, 0x4a, 0xb0,
+	0xc6, 0x71, 0xea, 0x4d, 0xe4, 0xec, 0x27, 0xb7, 0x64, 0xbf, 0xaa, 0x67, 0xbf, 0xf5, 0x3b, 0x79,
+	0x37, 0xa3, 0x6b, 0x61, 0x41, 0xac, 0xc8, 0xbd, 0x6e, 0xe7, 0xc4, 0x4d, 0x78, 0x7e, 0xf1, 0xa3,
+	0x3a, 0xf9, 0x11, 0x4c, 0xcc, 0x83, 0xf0, 0x0e, 0xb9, 0x94, 0x5e, 0xe4, 0xa0, 0x2f, 0x00, 0x2e,
+	0xc4, 0x5c, 0x46, 0xda, 0x98, 0x88, 0x60, 0x41, 0x06, 0xa2, 0xab, 0xb9, 0xe8, 0xe3, 0x71, 0x8b,
+	0x7e, 0xc5, 0x25, 0x8f, 0x6d, 0x1c, 0xee, 0xed, 0x5d, 0x2f, 0x77, 0x3e, 0xe6, 0x32, 0x34, 0x79,
+	0x4f, 0x45, 0xb4, 0x71, 0x01, 0xe0, 0x83, 0x3f, 0xb6, 0x8c, 0xde, 0xc1, 0xc5, 0x18, 0x9f, 0x46,
+	0x23, 0xb3, 0x70, 0x43, 0xb8, 0xe7, 0x17, 0xeb, 0xe7, 0x0f, 0xd6, 0xcf, 0xdf, 0xdf, 0x91, 0x66,
+	0x63, 0xfd, 0x00, 0x0b, 0x4b, 0x9b, 0xb3, 0x99, 0x80, 0xda, 0xda, 0xc4, 0x4a, 0x25, 0x5c, 0x88,
+	0xf1, 0xe9, 0x65, 0x36, 0xc4, 0xe0, 0x52, 0x19, 0xd8, 0x26, 0xd9, 0x7a, 0x46, 0x5c, 0x1a, 0xaa,
+	0xfb, 0x58, 0x38, 0xcb, 0xea, 0x23, 0x14, 0xcf, 0xdd, 0x05, 0x34, 0xe7, 0x32, 0xfc, 0xe9, 0xaf,
+	0xa0, 0x36, 0x03, 0xd6, 0x2a, 0x61, 0xbd, 0x84, 0xb5, 0x9f, 0x43, 0xed, 0x38, 0xa4, 0xc6, 0xb7,
+	0x2a, 0x5c, 0xba, 0xc6, 0x29, 0xd4, 0x82, 0x33, 0x43, 0x56, 0x70, 0x33, 0xd6, 0x61, 0x21, 0xda,
+	0x85, 0x7f, 0x6b, 0x7a, 0x62, 0x69, 0x6a, 0x22, 0xa2, 0xac, 0xbc, 0xb1, 0x45, 0x73, 0xae, 0xba,
+	0x95, 0x15, 0xa3, 0x03, 0x38, 0x75, 0xc4, 0x8d, 0xa1, 0xda, 0xab, 0x8e, 0xe5, 0xd8, 0x1c, 0x1a,
+	0x6a, 0xc3, 0x7f, 0xb3, 0xf5, 0x2c, 0xa5, 0x7a, 0xb5, 0x9b, 0xe9, 0xfc, 0x27, 0xe6, 0xb2, 0x34,
+	0xca, 0x4c, 0x69, 0xc7, 0xf6, 0x7a, 0x54, 0x7b, 0x93, 0xe3, 0x51, 0x5a, 0xa0, 0xad, 0xfe, 0x04,
+	0x70, 0x61, 0xcb, 0x15, 0x94, 0xf9, 0x3e, 0x01, 0xd8, 0x60, 0xee, 0x1e, 0x22, 0x32, 0x3c, 0x88,
+	0xa8, 0xe0, 0x73, 0xf3, 0x7b, 0x3d, 0xe6, 0x43, 0x2b, 0x1f, 0xd3, 0xcb, 0x4a, 0xe8, 0xb1, 0xab,
+	0xf2, 0xb6, 0xe0, 0x72, 0x79, 0xa7, 0x47, 0xf4, 0xa1, 0x95, 0xf3, 0xcd, 0x65, 0xb4, 0x08, 0xff,
+	0x1b, 0xfe, 0x1c, 0x67, 0x63, 0xe7, 0x9a, 0x76, 0xe7, 0x41, 0xa3, 0x66, 0xb4, 0xa5, 0xab, 0x4f,
+	0xe1, 0xb4, 0xf3, 0x02, 0x05, 0x70, 0xb2, 0x9f, 0xf9, 0x9f, 0x77, 0x05, 0x9a, 0xf5, 0x4c, 0xc4,
+	0xff, 0x08, 0xd5, 0x2b, 0xf9, 0x7b, 0xf3, 0xec, 0x51, 0xc5, 0xbd, 0xb0, 0xc8, 0x6b, 0x6e, 0xbf,
+	0x7d, 0xc1, 0xb8, 0x39, 0xb4, 0x1d, 0x9f, 0xa8, 0x38, 0x48, 0x95, 0x50, 0x8f, 0xb9, 0x0a, 0x98,
+	0x50, 0x2a, 0xfb, 0x87, 0x38, 0xa2, 0xc4, 0xa4, 0xee, 0xdb, 0x31, 0x0b, 0x12, 0x61, 0x19, 0x97,
+	0x69, 0x30, 0x70, 0xa4, 0x24, 0xbc, 0x33, 0x95, 0xaf, 0xc1, 0xc6, 0xaf, 0x01, 0x00, 0x0d, 0x54,
+	0x1b, 0x9e, 0xc5, 0x06, 0x00, 0x00,
+}
@@ -0,0 +1,121 @@
+package adaptiveconcurrency
+
+import (
+	"time"
+
+	types "github.com/gogo/protobuf/types"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/adaptiveconcurrency"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/pluginutils"
+	"github.com/solo-io/solo-kit/pkg/errors"
+)
+
+//go:generate protoc -I$GOPATH/src/github.com/envoyproxy/protoc-gen-validate -I. -I$GOPATH/src/github.com/gogo/protobuf/protobuf --gogo_out=${GOPATH}/src/ filter.proto
+
+const (
+	FilterName = "envoy.filters.http.adaptive_concurrency"
+	// the requests rejected by the authentication filters do not count against the concurrency limit
+	pluginStage = plugins.PostInAuth
+)
+
+type Plugin struct {
+	settings *v1.Settings
+}
+
+var _ plugins.HttpFilterPlugin = NewPlugin()
+
+func NewPlugin() *Plugin {
+	return &Plugin{}
+}
+
+func (p *Plugin) Init(params plugins.InitParams) error {
+	p.settings = params.Settings
+	return nil
+}
+
+func (p *Plugin) HttpFilters(params plugins.Params, listener *v1.HttpListener) ([]plugins.StagedHttpFilter, error) {
+	cfg := listener.GetListenerPlugins().GetAdaptiveConcurrency()
+	if cfg == nil {
+		return nil, nil
+	}
+	if err := pluginutils.RequireEnvoyExtension(p.settings, FilterName); err != nil {
+		return nil, errors.Wrapf(err, "invalid adaptive concurrency configuration")
+	}
+
+	config, err := filterConfig(cfg)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid adaptive concurrency configuration")
+	}
+	stagedFilter, err := plugins.NewStagedFilterWithConfig(FilterName, config, pluginStage)
+	if err != nil {
+		return nil, err
+	}
+	return []plugins.StagedHttpFilter{stagedFilter}, nil
+}
+
+func filterConfig(cfg *adaptiveconcurrency.AdaptiveConcurrency) (*AdaptiveConcurrency, error) {
+	updateInterval, err := interval(cfg.ConcurrencyUpdateInterval, "concurrency update interval")
+	if err != nil {
+		return nil, err
+	}
+	minRttInterval, err := interval(cfg.MinRttCalcInterval, "min rtt calc interval")
+	if err != nil {
+		return nil, err
+	}
+	percentile, err := percent(cfg.SampleAggregatePercentile, "sample aggregate percentile")
+	if err != nil {
+		return nil, err
+	}
+	jitter, err := percent(cfg.MinRttCalcJitter, "min rtt calc jitter")
+	if err != nil {
+		return nil, err
+	}
+	for name, value := range map[string]*types.UInt32Value{
+		"max concurrency limit":      cfg.MaxConcurrencyLimit,
+		"min rtt calc request count": cfg.MinRttCalcRequestCount,
+		"min concurrency":            cfg.MinConcurrency,
+	} {
+		if value != nil && value.Value == 0 {
+			return nil, errors.Errorf("the %v must be positive", name)
+		}
+	}
+
+	return &AdaptiveConcurrency{
+		ConcurrencyControllerConfig: &AdaptiveConcurrency_GradientControllerConfig{
+			GradientControllerConfig: &GradientControllerConfig{
+				SampleAggregatePercentile: percentile,
+				ConcurrencyLimitParams: &GradientControllerConfig_ConcurrencyLimitCalculationParams{
+					MaxConcurrencyLimit:       cfg.MaxConcurrencyLimit,
+					ConcurrencyUpdateInterval: updateInterval,
+				},
+				MinRttCalcParams: &GradientControllerConfig_MinimumRTTCalculationParams{
+					Interval:       minRttInterval,
+					RequestCount:   cfg.MinRttCalcRequestCount,
+					Jitter:         jitter,
+					MinConcurrency: cfg.MinConcurrency,
+				},
+			},
+		},
+	}, nil
+}
+
+func interval(d *time.Duration, name string) (*types.Duration, error) {
+	if d == nil {
+		return nil, errors.Errorf("the %v is required", name)
+	}
+	if *d <= 0 {
+		return nil, errors.Errorf("the %v must be positive, found %v", name, *d)
+	}
+	return types.DurationProto(*d), nil
+}
+
+func percent(value *types.DoubleValue, name string) (*Percent, error) {
+	if value == nil {
+		return nil, nil
+	}
+	if value.Value < 0 || value.Value > 100 {
+		return nil, errors.Errorf("the %v must be from 0 to 100, found %v", name, value.Value)
+	}
+	return &Percent{Value: value.Value}, nil
+}
@@ -0,0 +1,106 @@
+package adaptiveconcurrency_test
+
+import (
+	"time"
+
+	"github.com/envoyproxy/go-control-plane/pkg/util"
+	types "github.com/gogo/protobuf/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/adaptiveconcurrency"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	. "github.com/solo-io/gloo/projects/gloo/pkg/plugins/adaptiveconcurrency"
+)
+
+var _ = Describe("Plugin", func() {
+	var (
+		plugin   *Plugin
+		cfg      *adaptiveconcurrency.AdaptiveConcurrency
+		listener *v1.HttpListener
+	)
+
+	BeforeEach(func() {
+		plugin = NewPlugin()
+		Expect(plugin.Init(plugins.InitParams{
+			Settings: &v1.Settings{EnvoyExtensions: []string{FilterName}},
+		})).NotTo(HaveOccurred())
+		updateInterval := 100 * time.Millisecond
+		minRttInterval := time.Minute
+		cfg = &adaptiveconcurrency.AdaptiveConcurrency{
+			ConcurrencyUpdateInterval: &updateInterval,
+			MinRttCalcInterval:        &minRttInterval,
+		}
+		listener = &v1.HttpListener{
+			ListenerPlugins: &v1.ListenerPlugins{
+				AdaptiveConcurrency: cfg,
+			},
+		}
+	})
+
+	filterConfig := func() *AdaptiveConcurrency {
+		filters, err := plugin.HttpFilters(plugins.Params{}, listener)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(filters).To(HaveLen(1))
+		Expect(filters[0].HttpFilter.Name).To(Equal(FilterName))
+		var config AdaptiveConcurrency
+		err = util.StructToMessage(filters[0].HttpFilter.GetConfig(), &config)
+		Expect(err).NotTo(HaveOccurred())
+		return &config
+	}
+
+	It("adds no filter without adaptive concurrency configuration", func() {
+		filters, err := plugin.HttpFilters(plugins.Params{}, &v1.HttpListener{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(filters).To(BeEmpty())
+	})
+
+	It("rejects the configuration unless envoy supports the filter", func() {
+		Expect(plugin.Init(plugins.InitParams{Settings: &v1.Settings{}})).NotTo(HaveOccurred())
+		_, err := plugin.HttpFilters(plugins.Params{}, listener)
+		Expect(err).To(MatchError(ContainSubstring(FilterName)))
+	})
+
+	It("configures the gradient controller", func() {
+		cfg.SampleAggregatePercentile = &types.DoubleValue{Value: 90}
+		cfg.MaxConcurrencyLimit = &types.UInt32Value{Value: 500}
+		cfg.MinRttCalcRequestCount = &types.UInt32Value{Value: 20}
+		cfg.MinRttCalcJitter = &types.DoubleValue{Value: 10}
+		cfg.MinConcurrency = &types.UInt32Value{Value: 5}
+		Expect(filterConfig()).To(Equal(&AdaptiveConcurrency{
+			ConcurrencyControllerConfig: &AdaptiveConcurrency_GradientControllerConfig{
+				GradientControllerConfig: &GradientControllerConfig{
+					SampleAggregatePercentile: &Percent{Value: 90},
+					ConcurrencyLimitParams: &GradientControllerConfig_ConcurrencyLimitCalculationParams{
+						MaxConcurrencyLimit:       &types.UInt32Value{Value: 500},
+						ConcurrencyUpdateInterval: types.DurationProto(100 * time.Millisecond),
+					},
+					MinRttCalcParams: &GradientControllerConfig_MinimumRTTCalculationParams{
+						Interval:       types.DurationProto(time.Minute),
+						RequestCount:   &types.UInt32Value{Value: 20},
+						Jitter:         &Percent{Value: 10},
+						MinConcurrency: &types.UInt32Value{Value: 5},
+					},
+				},
+			},
+		}))
+	})
+
+	It("requires the intervals", func() {
+		cfg.MinRttCalcInterval = nil
+		_, err := plugin.HttpFilters(plugins.Params{}, listener)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("rejects percentiles above 100", func() {
+		cfg.SampleAggregatePercentile = &types.DoubleValue{Value: 101}
+		_, err := plugin.HttpFilters(plugins.Params{}, listener)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("rejects a zero concurrency limit", func() {
+		cfg.MaxConcurrencyLimit = &types.UInt32Value{}
+		_, err := plugin.HttpFilters(plugins.Params{}, listener)
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,13 @@
+package alibaba
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestAlibaba(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Alibaba Suite")
+}
@@ -0,0 +1,147 @@
+package alibaba
+
+import (
+	"context"
+	"fmt"
+
+	envoyapi "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	envoyauth "github.com/envoyproxy/go-control-plane/envoy/api/v2/auth"
+	envoyroute "github.com/envoyproxy/go-control-plane/envoy/api/v2/route"
+	"github.com/gogo/protobuf/proto"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/alibaba"
+	transformationapi "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/transformation"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/pluginutils"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/transformation"
+	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/solo-io/solo-kit/pkg/errors"
+)
+
+const (
+	apiVersion       = "2016-08-15"
+	defaultQualifier = "LATEST"
+	subPathExtractor = "sub_path"
+)
+
+// GetFunctionComputeHostname returns the Function Compute endpoint of the account and region of the upstream
+func GetFunctionComputeHostname(s *alibaba.UpstreamSpec) string {
+	return fmt.Sprintf("%s.%s.fc.aliyuncs.com", s.AccountId, s.Region)
+}
+
+// HttpTriggerPath returns the path of the HTTP trigger of a function on the Function Compute endpoint
+func HttpTriggerPath(fn *alibaba.FunctionSpec) string {
+	qualifier := fn.Qualifier
+	if qualifier == "" {
+		qualifier = defaultQualifier
+	}
+	return fmt.Sprintf("/%s/proxy/%s.%s/%s/", apiVersion, fn.ServiceName, qualifier, fn.FunctionName)
+}
+
+func NewPlugin(transformsAdded *bool) plugins.Plugin {
+	return &plugin{transformsAdded: transformsAdded}
+}
+
+type plugin struct {
+	recordedUpstreams map[core.ResourceRef]*alibaba.UpstreamSpec
+	ctx               context.Context
+	transformsAdded   *bool
+}
+
+func (p *plugin) Init(params plugins.InitParams) error {
+	p.ctx = params.Ctx
+	p.recordedUpstreams = make(map[core.ResourceRef]*alibaba.UpstreamSpec)
+	return nil
+}
+
+func (p *plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *envoyapi.Cluster) error {
+	upstreamSpec, ok := in.UpstreamSpec.UpstreamType.(*v1.UpstreamSpec_Alibaba)
+	if !ok {
+		// not ours
+		return nil
+	}
+	// even if it failed, route should still be valid
+	p.recordedUpstreams[in.Metadata.Ref()] = upstreamSpec.Alibaba
+
+	if upstreamSpec.Alibaba.AccountId == "" || upstreamSpec.Alibaba.Region == "" {
+		return errors.Errorf("alibaba upstream %v must specify an account id and a region", in.Metadata.Ref())
+	}
+	hostname := GetFunctionComputeHostname(upstreamSpec.Alibaba)
+
+	// configure Envoy cluster routing info
+	out.ClusterDiscoveryType = &envoyapi.Cluster_Type{
+		Type: envoyapi.Cluster_LOGICAL_DNS,
+	}
+	out.DnsLookupFamily = envoyapi.Cluster_V4_ONLY
+	pluginutils.EnvoySingleEndpointLoadAssignment(out, hostname, 443)
+
+	out.TlsContext = &envoyauth.UpstreamTlsContext{
+		// TODO: Add verification context
+		Sni: hostname,
+	}
+	return nil
+}
+
+// the alibaba upstreams are recorded to translate the routes to them
+func (p *plugin) CacheableUpstream(in *v1.Upstream) bool {
+	_, ours := in.UpstreamSpec.UpstreamType.(*v1.UpstreamSpec_Alibaba)
+	return !ours
+}
+
+func (p *plugin) ProcessRoute(params plugins.Params, in *v1.Route, out *envoyroute.Route) error {
+	return pluginutils.MarkPerFilterConfig(p.ctx, params.Snapshot, in, out, transformation.FilterName, func(spec *v1.Destination) (proto.Message, error) {
+		// check if it's alibaba destination
+		if spec.DestinationSpec == nil || spec.GetUpstream() == nil {
+			return nil, nil
+		}
+		alibabaDestinationSpec, ok := spec.DestinationSpec.DestinationType.(*v1.DestinationSpec_Alibaba)
+		if !ok {
+			return nil, nil
+		}
+		// get upstream
+		upstreamSpec, ok := p.recordedUpstreams[*spec.GetUpstream()]
+		if !ok {
+			err := errors.Errorf("%v is not an Alibaba upstream", *spec.GetUpstream())
+			contextutils.LoggerFrom(p.ctx).Error(err)
+			return nil, err
+		}
+
+		// get function
+		logicalName := alibabaDestinationSpec.Alibaba.LogicalName
+		for _, function := range upstreamSpec.Functions {
+			if function.LogicalName != logicalName {
+				continue
+			}
+
+			*p.transformsAdded = true
+
+			// the function is invoked through its http trigger, with the path of the request following the route.
+			// the request is not signed, envoy cannot sign it for function compute: the trigger must allow anonymous
+			// invocations.
+			return &transformationapi.RouteTransformations{
+				RequestTransformation: &transformationapi.Transformation{
+					TransformationType: &transformationapi.Transformation_TransformationTemplate{
+						TransformationTemplate: &transformationapi.TransformationTemplate{
+							Extractors: map[string]*transformationapi.Extraction{
+								subPathExtractor: pluginutils.SubPathExtraction(in),
+							},
+							Headers: map[string]*transformationapi.InjaTemplate{
+								":path": {
+									Text: HttpTriggerPath(function) + "{{ " + subPathExtractor + " }}",
+								},
+								":authority": {
+									Text: GetFunctionComputeHostname(upstreamSpec),
+								},
+							},
+							BodyTransformation: &transformationapi.TransformationTemplate_Passthrough{
+								Passthrough: &transformationapi.Passthrough{},
+							},
+						},
+					},
+				},
+			}, nil
+		}
+		return nil, errors.Errorf("unknown function %v", logicalName)
+	})
+}
@@ -0,0 +1,154 @@
+package alibaba
+
+import (
+	envoyapi "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	envoyroute "github.com/envoyproxy/go-control-plane/envoy/api/v2/route"
+	"github.com/envoyproxy/go-control-plane/pkg/util"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/alibaba"
+	transformationapi "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/transformation"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/transformation"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+var _ = Describe("Plugin", func() {
+	var (
+		transformsAdded bool
+		params          plugins.Params
+		plugin          plugins.Plugin
+		upstream        *v1.Upstream
+		route           *v1.Route
+		out             *envoyapi.Cluster
+		outroute        *envoyroute.Route
+	)
+	BeforeEach(func() {
+		transformsAdded = false
+		plugin = NewPlugin(&transformsAdded)
+		plugin.Init(plugins.InitParams{})
+		upstreamName := "up"
+		upstream = &v1.Upstream{
+			Metadata: core.Metadata{
+				Name: upstreamName,
+			},
+			UpstreamSpec: &v1.UpstreamSpec{
+				UpstreamType: &v1.UpstreamSpec_Alibaba{
+					Alibaba: &alibaba.UpstreamSpec{
+						Region:    "cn-hangzhou",
+						AccountId: "1234",
+						SecretRef: core.ResourceRef{
+							Name: "secretref",
+						},
+						Functions: []*alibaba.FunctionSpec{{
+							LogicalName:  "demo-hello",
+							ServiceName:  "demo",
+							FunctionName: "hello",
+						}},
+					},
+				},
+			},
+		}
+		route = &v1.Route{
+			Matcher: &v1.Matcher{
+				PathSpecifier: &v1.Matcher_Prefix{Prefix: "/hello"},
+			},
+			Action: &v1.Route_RouteAction{
+				RouteAction: &v1.RouteAction{
+					Destination: &v1.RouteAction_Single{
+						Single: &v1.Destination{
+							DestinationType: &v1.Destination_Upstream{
+								Upstream: &core.ResourceRef{
+									Name: upstreamName,
+								},
+							},
+							DestinationSpec: &v1.DestinationSpec{
+								DestinationType: &v1.DestinationSpec_Alibaba{
+									Alibaba: &alibaba.DestinationSpec{
+										LogicalName: "demo-hello",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+
+		out = &envoyapi.Cluster{}
+		outroute = &envoyroute.Route{
+			Action: &envoyroute.Route_Route{
+				Route: &envoyroute.RouteAction{
+					ClusterSpecifier: &envoyroute.RouteAction_Cluster{
+						Cluster: upstreamName,
+					},
+				},
+			},
+		}
+
+		params.Snapshot = &v1.ApiSnapshot{}
+	})
+
+	Context("upstreams", func() {
+		It("should point the cluster at the function compute endpoint of the account", func() {
+			err := plugin.(plugins.UpstreamPlugin).ProcessUpstream(params, upstream, out)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.TlsContext.Sni).To(Equal("1234.cn-hangzhou.fc.aliyuncs.com"))
+			Expect(out.GetType()).To(Equal(envoyapi.Cluster_LOGICAL_DNS))
+		})
+
+		It("should not require the secret of the upstream", func() {
+			err := plugin.(plugins.UpstreamPlugin).ProcessUpstream(params, upstream, out)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.ExtensionProtocolOptions).To(BeEmpty())
+		})
+	})
+
+	Context("routes", func() {
+		BeforeEach(func() {
+			err := plugin.(plugins.UpstreamPlugin).ProcessUpstream(params, upstream, out)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		routeTransformation := func() *transformationapi.TransformationTemplate {
+			var transformations transformationapi.RouteTransformations
+			err := util.StructToMessage(outroute.PerFilterConfig[transformation.FilterName], &transformations)
+			Expect(err).NotTo(HaveOccurred())
+			return transformations.RequestTransformation.GetTransformationTemplate()
+		}
+
+		It("should rewrite the path to the http trigger of the function", func() {
+			err := plugin.(plugins.RoutePlugin).ProcessRoute(params, route, outroute)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(transformsAdded).To(BeTrue())
+
+			template := routeTransformation()
+			Expect(template.Headers[":path"].Text).To(Equal("/2016-08-15/proxy/demo.LATEST/hello/{{ sub_path }}"))
+			Expect(template.Headers[":authority"].Text).To(Equal("1234.cn-hangzhou.fc.aliyuncs.com"))
+			Expect(template.Extractors).To(HaveKey("sub_path"))
+			Expect(template.Extractors["sub_path"].Regex).To(Equal("^/hello/?(.*)$"))
+		})
+
+		It("should invoke the qualifier of the function", func() {
+			upstream.UpstreamSpec.GetAlibaba().Functions[0].Qualifier = "prod"
+			err := plugin.(plugins.UpstreamPlugin).ProcessUpstream(params, upstream, out)
+			Expect(err).NotTo(HaveOccurred())
+
+			err = plugin.(plugins.RoutePlugin).ProcessRoute(params, route, outroute)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(routeTransformation().Headers[":path"].Text).To(Equal("/2016-08-15/proxy/demo.prod/hello/{{ sub_path }}"))
+		})
+
+		It("should not process with a function mismatch", func() {
+			destination := route.Action.(*v1.Route_RouteAction).RouteAction.Destination.(*v1.RouteAction_Single).Single
+			destination.DestinationSpec.DestinationType.(*v1.DestinationSpec_Alibaba).Alibaba.LogicalName = "somethingelse"
+
+			err := plugin.(plugins.RoutePlugin).ProcessRoute(params, route, outroute)
+			Expect(err).To(HaveOccurred())
+			Expect(outroute.PerFilterConfig).NotTo(HaveKey(transformation.FilterName))
+			Expect(transformsAdded).To(BeFalse())
+		})
+	})
+})
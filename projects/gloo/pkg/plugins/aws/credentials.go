@@ -0,0 +1,312 @@
+package aws
+
+import (
+	"io/ioutil"
+	"os"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/defaults"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sts"
+	multierror "github.com/hashicorp/go-multierror"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	awsapi "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/aws"
+	"github.com/solo-io/solo-kit/pkg/errors"
+)
+
+const (
+	// temporary credentials are refreshed this long before they expire
+	roleCredentialsExpiryWindow = 5 * time.Minute
+
+	// the cached role credentials are dropped once they have not been used for this long
+	roleCredentialsIdleTimeout = time.Hour
+
+	roleSessionName = "gloo"
+
+	// environment variables set by the EKS pod identity webhook for IAM Roles for Service Accounts
+	webIdentityRoleArnEnv   = "AWS_ROLE_ARN"
+	webIdentityTokenFileEnv = "AWS_WEB_IDENTITY_TOKEN_FILE"
+	webIdentitySessionEnv   = "AWS_ROLE_SESSION_NAME"
+
+	defaultWebIdentitySession = "gloo"
+
+	// the instance metadata serves the rotated instance role credentials at least 5 minutes before the previous ones
+	// expire, so temporary credentials of the default credential chain are retrieved again more often than that
+	defaultChainRefreshInterval = 4 * time.Minute
+
+	// STS is a global service, the region is only used to resolve its endpoint
+	stsRegion = "us-east-1"
+)
+
+type roleCredentialsKey struct {
+	accessKey string
+	secretKey string
+	roleArn   string
+}
+
+type cachedRoleCredentials struct {
+	creds    *credentials.Credentials
+	lastUsed time.Time
+}
+
+var (
+	roleCredentialsLock  sync.Mutex
+	roleCredentialsCache = make(map[roleCredentialsKey]*cachedRoleCredentials)
+
+	defaultCredentialsLock sync.Mutex
+	defaultCredentials     *credentials.Credentials
+
+	// overridden in tests
+	newRoleCredentials = func(accessKey, secretKey, roleArn string) (*credentials.Credentials, error) {
+		creds := DefaultCredentials()
+		if accessKey != "" {
+			creds = credentials.NewStaticCredentials(accessKey, secretKey, "")
+		}
+		sess, err := newStsSession(creds)
+		if err != nil {
+			return nil, err
+		}
+		return credentials.NewCredentials(&assumeRoleProvider{
+			roleArn:    roleArn,
+			assumeRole: sts.New(sess).AssumeRole,
+		}), nil
+	}
+)
+
+func newStsSession(creds *credentials.Credentials) (*session.Session, error) {
+	sess, err := session.NewSession(aws.NewConfig().
+		WithCredentials(creds).
+		WithRegion(stsRegion))
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to create AWS session")
+	}
+	return sess, nil
+}
+
+// UpstreamCredentials returns the credentials to use for an AWS upstream.
+// These are the keys stored in the secret referenced by the upstream, or the DefaultCredentials
+// of the environment if the upstream does not reference a secret.
+// If the upstream specifies a role, the credentials are used to assume it.
+func UpstreamCredentials(secrets v1.SecretList, upstreamSpec *awsapi.UpstreamSpec) (*credentials.Credentials, error) {
+	if upstreamSpec.SecretRef.Name == "" {
+		if upstreamSpec.RoleArn != "" {
+			return RoleCredentials("", "", upstreamSpec.RoleArn)
+		}
+		return DefaultCredentials(), nil
+	}
+
+	// TODO(ilacakrms): consider if secretRef should be namespace+name
+	secret, err := secrets.Find(upstreamSpec.SecretRef.Strings())
+	if err != nil {
+		return nil, errors.Wrapf(err, "retrieving aws secret")
+	}
+
+	awsSecret, ok := secret.Kind.(*v1.Secret_Aws)
+	if !ok {
+		return nil, errors.Errorf("secret %v is not an AWS secret", secret.GetMetadata().Ref())
+	}
+
+	var secretErrs error
+
+	accessKey := awsSecret.Aws.AccessKey
+	secretKey := awsSecret.Aws.SecretKey
+	if accessKey == "" || !utf8.Valid([]byte(accessKey)) {
+		secretErrs = multierror.Append(secretErrs, errors.Errorf("access_key is not a valid string"))
+	}
+	if secretKey == "" || !utf8.Valid([]byte(secretKey)) {
+		secretErrs = multierror.Append(secretErrs, errors.Errorf("secret_key is not a valid string"))
+	}
+
+	if secretErrs != nil {
+		return nil, secretErrs
+	}
+
+	if upstreamSpec.RoleArn != "" {
+		return RoleCredentials(accessKey, secretKey, upstreamSpec.RoleArn)
+	}
+	return credentials.NewStaticCredentials(accessKey, secretKey, ""), nil
+}
+
+// RoleCredentials returns credentials for the role with the given ARN, assumed via STS
+// using the provided access and secret keys. If no access key is provided, the role is
+// assumed using the DefaultCredentials of the environment.
+// The credentials are cached per role and key pair, and are refreshed from STS when
+// retrieved shortly before they expire; CredentialsRefreshes signals when they need to be retrieved again.
+// The credentials not used for an hour are dropped from the cache.
+func RoleCredentials(accessKey, secretKey, roleArn string) (*credentials.Credentials, error) {
+	key := roleCredentialsKey{
+		accessKey: accessKey,
+		secretKey: secretKey,
+		roleArn:   roleArn,
+	}
+
+	roleCredentialsLock.Lock()
+	defer roleCredentialsLock.Unlock()
+
+	now := time.Now()
+	evictIdleRoleCredentials(now)
+	if cached, ok := roleCredentialsCache[key]; ok {
+		cached.lastUsed = now
+		return cached.creds, nil
+	}
+	creds, err := newRoleCredentials(accessKey, secretKey, roleArn)
+	if err != nil {
+		return nil, err
+	}
+	roleCredentialsCache[key] = &cachedRoleCredentials{creds: creds, lastUsed: now}
+	return creds, nil
+}
+
+// drops the role credentials unused since the idle timeout, e.g. those of deleted upstreams or rotated secrets.
+// must be called with the lock held.
+func evictIdleRoleCredentials(now time.Time) {
+	for key, cached := range roleCredentialsCache {
+		if now.Sub(cached.lastUsed) > roleCredentialsIdleTimeout {
+			delete(roleCredentialsCache, key)
+		}
+	}
+}
+
+// DefaultCredentials returns the credentials of the environment Gloo is running in, used for
+// AWS upstreams that do not reference a secret.
+// If the pod has a projected service account token for IAM Roles for Service Accounts
+// (AWS_ROLE_ARN and AWS_WEB_IDENTITY_TOKEN_FILE are set), the token is exchanged for temporary
+// credentials via STS. Otherwise the AWS default credential chain is used
+// (environment, shared credentials file, EC2 / ECS instance role).
+// Temporary credentials are retrieved again before they expire, and CredentialsRefreshes signals when.
+func DefaultCredentials() *credentials.Credentials {
+	defaultCredentialsLock.Lock()
+	defer defaultCredentialsLock.Unlock()
+	if defaultCredentials == nil {
+		defaultCredentials = credentials.NewCredentials(newDefaultCredentialsProvider(os.Getenv))
+	}
+	return defaultCredentials
+}
+
+func newDefaultCredentialsProvider(getenv func(string) string) credentials.Provider {
+	roleArn := getenv(webIdentityRoleArnEnv)
+	tokenFile := getenv(webIdentityTokenFileEnv)
+	if roleArn != "" && tokenFile != "" {
+		sessionName := getenv(webIdentitySessionEnv)
+		if sessionName == "" {
+			sessionName = defaultWebIdentitySession
+		}
+		return &webIdentityProvider{
+			roleArn:     roleArn,
+			tokenFile:   tokenFile,
+			sessionName: sessionName,
+		}
+	}
+	cfg := defaults.Config()
+	return &defaultChainProvider{
+		chain: &credentials.ChainProvider{
+			VerboseErrors: aws.BoolValue(cfg.CredentialsChainVerboseErrors),
+			Providers:     defaults.CredProviders(cfg, defaults.Handlers()),
+		},
+	}
+}
+
+// defaultChainProvider retrieves the credentials of the AWS default credential chain. The chain hides the expiry of
+// the instance and container role credentials it retrieves, so temporary credentials are retrieved again periodically.
+type defaultChainProvider struct {
+	chain *credentials.ChainProvider
+	// zero for long term credentials
+	refreshAt time.Time
+}
+
+func (p *defaultChainProvider) Retrieve() (credentials.Value, error) {
+	value, err := p.chain.Retrieve()
+	if err != nil {
+		return value, err
+	}
+	p.refreshAt = time.Time{}
+	if value.SessionToken != "" {
+		p.refreshAt = time.Now().Add(defaultChainRefreshInterval)
+		credentialsRefreshes.schedule(p.refreshAt)
+	}
+	return value, nil
+}
+
+func (p *defaultChainProvider) IsExpired() bool {
+	if !p.refreshAt.IsZero() && !time.Now().Before(p.refreshAt) {
+		return true
+	}
+	return p.chain.IsExpired()
+}
+
+// stsExpiry is the expiry of temporary credentials retrieved from STS. The credentials are retrieved again shortly
+// before they expire, and their refresh is scheduled so that the upstreams using them are translated again in time.
+type stsExpiry struct {
+	credentials.Expiry
+}
+
+func (e *stsExpiry) setExpiration(expiration time.Time) {
+	e.SetExpiration(expiration, roleCredentialsExpiryWindow)
+	credentialsRefreshes.schedule(expiration.Add(-roleCredentialsExpiryWindow))
+}
+
+// assumeRoleProvider retrieves temporary credentials of the given role
+type assumeRoleProvider struct {
+	stsExpiry
+
+	roleArn    string
+	assumeRole func(*sts.AssumeRoleInput) (*sts.AssumeRoleOutput, error)
+}
+
+func (p *assumeRoleProvider) Retrieve() (credentials.Value, error) {
+	out, err := p.assumeRole(&sts.AssumeRoleInput{
+		RoleArn:         aws.String(p.roleArn),
+		RoleSessionName: aws.String(roleSessionName),
+	})
+	if err != nil {
+		return credentials.Value{}, errors.Wrapf(err, "assuming role %v", p.roleArn)
+	}
+	p.setExpiration(aws.TimeValue(out.Credentials.Expiration))
+	return credentials.Value{
+		AccessKeyID:     aws.StringValue(out.Credentials.AccessKeyId),
+		SecretAccessKey: aws.StringValue(out.Credentials.SecretAccessKey),
+		SessionToken:    aws.StringValue(out.Credentials.SessionToken),
+		ProviderName:    "AssumeRoleProvider",
+	}, nil
+}
+
+// webIdentityProvider exchanges a web identity token read from a file for temporary
+// credentials of the given role
+type webIdentityProvider struct {
+	stsExpiry
+
+	roleArn     string
+	tokenFile   string
+	sessionName string
+}
+
+func (p *webIdentityProvider) Retrieve() (credentials.Value, error) {
+	// the token is rotated by kubernetes, so it needs to be read every time
+	token, err := ioutil.ReadFile(p.tokenFile)
+	if err != nil {
+		return credentials.Value{}, errors.Wrapf(err, "reading web identity token file %v", p.tokenFile)
+	}
+	sess, err := newStsSession(credentials.AnonymousCredentials)
+	if err != nil {
+		return credentials.Value{}, err
+	}
+	out, err := sts.New(sess).AssumeRoleWithWebIdentity(&sts.AssumeRoleWithWebIdentityInput{
+		RoleArn:          aws.String(p.roleArn),
+		RoleSessionName:  aws.String(p.sessionName),
+		WebIdentityToken: aws.String(string(token)),
+	})
+	if err != nil {
+		return credentials.Value{}, errors.Wrapf(err, "assuming role %v with web identity", p.roleArn)
+	}
+	p.setExpiration(aws.TimeValue(out.Credentials.Expiration))
+	return credentials.Value{
+		AccessKeyID:     aws.StringValue(out.Credentials.AccessKeyId),
+		SecretAccessKey: aws.StringValue(out.Credentials.SecretAccessKey),
+		SessionToken:    aws.StringValue(out.Credentials.SessionToken),
+		ProviderName:    "WebIdentityProvider",
+	}, nil
+}
@@ -0,0 +1,142 @@
+package aws
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/service/sts"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Credentials", func() {
+
+	Context("role credentials", func() {
+
+		var origNewRoleCredentials func(accessKey, secretKey, roleArn string) (*credentials.Credentials, error)
+
+		BeforeEach(func() {
+			origNewRoleCredentials = newRoleCredentials
+			newRoleCredentials = func(accessKey, secretKey, roleArn string) (*credentials.Credentials, error) {
+				return credentials.NewStaticCredentials("temp access", "temp secret", "token"), nil
+			}
+		})
+
+		AfterEach(func() {
+			newRoleCredentials = origNewRoleCredentials
+		})
+
+		It("retrieves the credentials again and signals a refresh before they expire", func() {
+			calls := 0
+			provider := &assumeRoleProvider{
+				roleArn: "arn:aws:iam::123456789012:role/gloo",
+				assumeRole: func(input *sts.AssumeRoleInput) (*sts.AssumeRoleOutput, error) {
+					calls++
+					Expect(aws.StringValue(input.RoleArn)).To(Equal("arn:aws:iam::123456789012:role/gloo"))
+					return &sts.AssumeRoleOutput{Credentials: &sts.Credentials{
+						AccessKeyId:     aws.String("temp access"),
+						SecretAccessKey: aws.String("temp secret"),
+						SessionToken:    aws.String("token"),
+						Expiration:      aws.Time(time.Now().Add(roleCredentialsExpiryWindow + 100*time.Millisecond)),
+					}}, nil
+				},
+			}
+			creds := credentials.NewCredentials(provider)
+
+			value, err := creds.Get()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(value.SessionToken).To(Equal("token"))
+			Expect(calls).To(Equal(1))
+
+			Eventually(CredentialsRefreshes()).Should(Receive())
+			_, err = creds.Get()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(calls).To(Equal(2))
+		})
+
+		It("drops the credentials unused for longer than the idle timeout", func() {
+			creds, err := RoleCredentials("access", "secret", "arn:aws:iam::123456789012:role/idle")
+			Expect(err).NotTo(HaveOccurred())
+			cached, err := RoleCredentials("access", "secret", "arn:aws:iam::123456789012:role/idle")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cached).To(BeIdenticalTo(creds))
+
+			key := roleCredentialsKey{accessKey: "access", secretKey: "secret", roleArn: "arn:aws:iam::123456789012:role/idle"}
+			roleCredentialsLock.Lock()
+			evictIdleRoleCredentials(time.Now().Add(roleCredentialsIdleTimeout / 2))
+			Expect(roleCredentialsCache).To(HaveKey(key))
+			evictIdleRoleCredentials(time.Now().Add(2 * roleCredentialsIdleTimeout))
+			Expect(roleCredentialsCache).NotTo(HaveKey(key))
+			roleCredentialsLock.Unlock()
+		})
+	})
+
+	Context("default credentials", func() {
+
+		getenv := func(env map[string]string) func(string) string {
+			return func(key string) string { return env[key] }
+		}
+
+		It("exchanges the web identity token of the service account when set", func() {
+			provider := newDefaultCredentialsProvider(getenv(map[string]string{
+				webIdentityRoleArnEnv:   "arn:aws:iam::123456789012:role/gloo",
+				webIdentityTokenFileEnv: "/var/run/secrets/eks.amazonaws.com/serviceaccount/token",
+			}))
+			Expect(provider).To(Equal(&webIdentityProvider{
+				roleArn:     "arn:aws:iam::123456789012:role/gloo",
+				tokenFile:   "/var/run/secrets/eks.amazonaws.com/serviceaccount/token",
+				sessionName: defaultWebIdentitySession,
+			}))
+		})
+
+		It("uses the default credential chain otherwise", func() {
+			provider := newDefaultCredentialsProvider(getenv(map[string]string{
+				webIdentityRoleArnEnv: "arn:aws:iam::123456789012:role/gloo",
+			}))
+			Expect(provider).To(BeAssignableToTypeOf(&defaultChainProvider{}))
+		})
+
+		It("retrieves the temporary credentials of the chain again periodically", func() {
+			value := credentials.Value{AccessKeyID: "access", SecretAccessKey: "secret", SessionToken: "token"}
+			provider := &defaultChainProvider{chain: &credentials.ChainProvider{
+				Providers: []credentials.Provider{&credentials.StaticProvider{Value: value}},
+			}}
+
+			_, err := provider.Retrieve()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(provider.IsExpired()).To(BeFalse())
+			Expect(provider.refreshAt).To(BeTemporally("~", time.Now().Add(defaultChainRefreshInterval), time.Second))
+
+			provider.refreshAt = time.Now().Add(-time.Second)
+			Expect(provider.IsExpired()).To(BeTrue())
+		})
+
+		It("keeps the long term credentials of the chain", func() {
+			value := credentials.Value{AccessKeyID: "access", SecretAccessKey: "secret"}
+			provider := &defaultChainProvider{chain: &credentials.ChainProvider{
+				Providers: []credentials.Provider{&credentials.StaticProvider{Value: value}},
+			}}
+
+			_, err := provider.Retrieve()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(provider.refreshAt).To(BeZero())
+			Expect(provider.IsExpired()).To(BeFalse())
+		})
+	})
+
+	Context("refresh scheduler", func() {
+
+		It("signals each scheduled refresh once it is due", func() {
+			scheduler := newRefreshScheduler()
+			now := time.Now()
+			scheduler.schedule(now.Add(200 * time.Millisecond))
+			scheduler.schedule(now.Add(50 * time.Millisecond))
+
+			Consistently(scheduler.signals, 25*time.Millisecond).ShouldNot(Receive())
+			Eventually(scheduler.signals).Should(Receive())
+			Eventually(scheduler.signals).Should(Receive())
+			Consistently(scheduler.signals, 250*time.Millisecond).ShouldNot(Receive())
+		})
+	})
+})
@@ -89,7 +89,9 @@ type LambdaProtocolExtension struct {
 	// The access_key for AWS this cluster
 	AccessKey string `protobuf:"bytes,3,opt,name=access_key,json=accessKey,proto3" json:"access_key,omitempty"`
 	// The secret_key for AWS this cluster
-	SecretKey            string   `protobuf:"bytes,4,opt,name=secret_key,json=secretKey,proto3" json:"secret_key,omitempty"`
+	SecretKey string `protobuf:"bytes,4,opt,name=secret_key,json=secretKey,proto3" json:"secret_key,omitempty"`
+	// The session_token for AWS this cluster; only set when using temporary credentials
+	SessionToken         string   `protobuf:"bytes,5,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -147,6 +149,13 @@ func (m *LambdaProtocolExtension) GetSecretKey() string {
 	return ""
 }
 
+func (m *LambdaProtocolExtension) GetSessionToken() string {
+	if m != nil {
+		return m.SessionToken
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*LambdaPerRoute)(nil), "envoy.config.filter.http.aws.v2.LambdaPerRoute")
 	proto.RegisterType((*LambdaProtocolExtension)(nil), "envoy.config.filter.http.aws.v2.LambdaProtocolExtension")
@@ -155,24 +164,25 @@ func init() {
 func init() { proto.RegisterFile("filter.proto", fileDescriptor_1f5303cab7a20d6f) }
 
 var fileDescriptor_1f5303cab7a20d6f = []byte{
-	// 292 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0xcd, 0x4a, 0x03, 0x31,
-	0x14, 0x85, 0x99, 0xb6, 0x16, 0x27, 0x88, 0x8b, 0x20, 0xb4, 0x88, 0x62, 0xed, 0xaa, 0x1b, 0x13,
-	0x50, 0xc4, 0x7d, 0xc1, 0x55, 0x5d, 0xc8, 0x2c, 0xdd, 0x48, 0x9a, 0xde, 0xc9, 0xc4, 0x66, 0x72,
-	0xc7, 0x24, 0x33, 0x75, 0x9e, 0xca, 0xbd, 0x2b, 0x5f, 0xc7, 0xb7, 0x90, 0xf9, 0x11, 0x15, 0xdd,
-	0xe5, 0x9c, 0xf3, 0x85, 0x0f, 0x2e, 0x39, 0x48, 0xb5, 0x09, 0xe0, 0x58, 0xe1, 0x30, 0x20, 0x3d,
-	0x03, 0x5b, 0x61, 0xcd, 0x24, 0xda, 0x54, 0x2b, 0xd6, 0x4f, 0x59, 0x08, 0x05, 0x13, 0x3b, 0xcf,
-	0xaa, 0xcb, 0xe3, 0x49, 0x25, 0x8c, 0xde, 0x88, 0x00, 0xfc, 0xeb, 0xd1, 0xfd, 0x9c, 0x4b, 0x72,
-	0x78, 0x27, 0xf2, 0xf5, 0x46, 0xdc, 0x83, 0x4b, 0xb0, 0x0c, 0x40, 0x4f, 0xc9, 0xc8, 0x8a, 0x1c,
-	0xa6, 0xd1, 0x2c, 0x5a, 0xc4, 0xcb, 0xf8, 0xed, 0xe3, 0x7d, 0x38, 0x72, 0x83, 0x59, 0x94, 0xb4,
-	0x35, 0x3d, 0x21, 0xf1, 0x73, 0x29, 0x8c, 0x4e, 0x35, 0xb8, 0xe9, 0xa0, 0x61, 0x92, 0xef, 0x82,
-	0x1e, 0x91, 0x3d, 0xe1, 0x6b, 0x2b, 0xa7, 0xc3, 0x59, 0xb4, 0xd8, 0x4f, 0xba, 0x30, 0x7f, 0x8d,
-	0xc8, 0xa4, 0xb7, 0x34, 0x52, 0x89, 0xe6, 0xf6, 0x25, 0x80, 0xf5, 0x1a, 0x6d, 0xa3, 0xcb, 0xd0,
-	0x87, 0x7f, 0x74, 0x4d, 0x4d, 0xcf, 0xc9, 0xd8, 0x81, 0xd2, 0x68, 0x3b, 0xd7, 0x4f, 0xa0, 0x1f,
-	0xe8, 0x82, 0x10, 0x21, 0x25, 0x78, 0xff, 0xb8, 0x85, 0xba, 0x15, 0xff, 0xc2, 0xe2, 0x6e, 0x5c,
-	0x41, 0xdd, 0x90, 0x1e, 0xa4, 0x83, 0xd0, 0x92, 0xa3, 0x3f, 0x64, 0x37, 0xae, 0xa0, 0x5e, 0xde,
-	0x3c, 0x5c, 0x2b, 0x1d, 0xb2, 0x72, 0xcd, 0x24, 0xe6, 0xdc, 0xa3, 0xc1, 0x0b, 0x8d, 0x5c, 0x19,
-	0x44, 0x5e, 0x38, 0x7c, 0x02, 0x19, 0x7c, 0x9f, 0xb6, 0x8a, 0x17, 0xa6, 0x54, 0xda, 0x7a, 0x2e,
-	0x76, 0x7e, 0x3d, 0x6e, 0xcf, 0x7a, 0xf5, 0x19, 0x00, 0x00, 0xff, 0xff, 0x41, 0x6f, 0xc7, 0x8b,
-	0xa0, 0x01, 0x00, 0x00,
+	// 311 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0xbd, 0x4e, 0xf3, 0x30,
+	0x14, 0x86, 0x95, 0xfe, 0xe9, 0x8b, 0xd5, 0x8f, 0x21, 0x42, 0x6a, 0x84, 0x40, 0x94, 0xb2, 0x74,
+	0x21, 0x91, 0x40, 0x88, 0xbd, 0x12, 0x13, 0x0c, 0x28, 0x62, 0x62, 0xa9, 0x5c, 0xf7, 0xd4, 0x35,
+	0x75, 0x7d, 0x82, 0x8f, 0x93, 0x92, 0x5b, 0x63, 0xe2, 0x22, 0xb8, 0x09, 0xee, 0x02, 0x39, 0x09,
+	0x42, 0x2a, 0x6c, 0x3e, 0xcf, 0xfb, 0x58, 0xaf, 0x7d, 0xd8, 0x70, 0xa5, 0xb4, 0x03, 0x9b, 0xe4,
+	0x16, 0x1d, 0x46, 0xa7, 0x60, 0x4a, 0xac, 0x12, 0x81, 0x66, 0xa5, 0x64, 0xd2, 0x46, 0x6b, 0xe7,
+	0xf2, 0x84, 0xef, 0x28, 0x29, 0x2f, 0x8f, 0x46, 0x25, 0xd7, 0x6a, 0xc9, 0x1d, 0xa4, 0xdf, 0x87,
+	0xe6, 0xe6, 0x44, 0xb0, 0x83, 0x7b, 0xbe, 0x5d, 0x2c, 0xf9, 0x03, 0xd8, 0x0c, 0x0b, 0x07, 0xd1,
+	0x09, 0xeb, 0x19, 0xbe, 0x85, 0x38, 0x18, 0x07, 0xd3, 0x70, 0x16, 0xbe, 0x7d, 0xbe, 0x77, 0x7b,
+	0xb6, 0x33, 0x0e, 0xb2, 0x1a, 0x47, 0xc7, 0x2c, 0x7c, 0x29, 0xb8, 0x56, 0x2b, 0x05, 0x36, 0xee,
+	0x78, 0x27, 0xfb, 0x01, 0xd1, 0x21, 0xeb, 0x73, 0xaa, 0x8c, 0x88, 0xbb, 0xe3, 0x60, 0xfa, 0x2f,
+	0x6b, 0x86, 0xc9, 0x47, 0xc0, 0x46, 0x6d, 0x8b, 0x2f, 0x15, 0xa8, 0x6f, 0x5f, 0x1d, 0x18, 0x52,
+	0x68, 0x7c, 0xdd, 0x1a, 0xc9, 0xfd, 0x51, 0xe7, 0x71, 0x74, 0xc6, 0x06, 0x16, 0xa4, 0x42, 0x13,
+	0x77, 0xf6, 0x85, 0x36, 0x88, 0xa6, 0x8c, 0x71, 0x21, 0x80, 0x68, 0xbe, 0x81, 0x2a, 0xee, 0xee,
+	0x6b, 0x61, 0x13, 0xde, 0x41, 0xe5, 0x4d, 0x02, 0x61, 0xc1, 0xd5, 0x66, 0xef, 0x97, 0xd9, 0x84,
+	0xde, 0x3c, 0x67, 0xff, 0x09, 0xc8, 0x3f, 0x70, 0xee, 0x70, 0x03, 0x26, 0xee, 0xd7, 0x3f, 0x1d,
+	0xb6, 0xf0, 0xd1, 0xb3, 0xd9, 0xcd, 0xd3, 0xb5, 0x54, 0x6e, 0x5d, 0x2c, 0x12, 0x81, 0xdb, 0x94,
+	0x50, 0xe3, 0x85, 0xc2, 0x54, 0x6a, 0xc4, 0x34, 0xb7, 0xf8, 0x0c, 0xc2, 0x51, 0x3b, 0x6d, 0x64,
+	0x9a, 0xeb, 0x42, 0x2a, 0x43, 0x29, 0xdf, 0xd1, 0x62, 0x50, 0xef, 0xfe, 0xea, 0x6b, 0x00, 0x83,
+	0xc3, 0x4a, 0x6d, 0xc5, 0x01, 0x00, 0x00,
 }
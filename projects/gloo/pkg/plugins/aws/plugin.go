@@ -4,12 +4,10 @@ import (
 	"context"
 	"fmt"
 	"net/url"
-	"unicode/utf8"
 
 	envoyapi "github.com/envoyproxy/go-control-plane/envoy/api/v2"
 	envoyauth "github.com/envoyproxy/go-control-plane/envoy/api/v2/auth"
 	envoyroute "github.com/envoyproxy/go-control-plane/envoy/api/v2/route"
-	multierror "github.com/hashicorp/go-multierror"
 	envoy_transform "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/transformation"
 
 	"github.com/gogo/protobuf/proto"
@@ -30,9 +28,9 @@ const (
 	filterName  = "io.solo.aws_lambda"
 	pluginStage = plugins.OutAuth
 
-	// cluster info
-	accessKey = "access_key"
-	secretKey = "secret_key"
+	// the lambda filter of the envoy image shipped with gloo signs the requests without a session token, which AWS
+	// requires along with temporary credentials
+	SessionTokenExtension = filterName + ".session_token"
 )
 
 func getLambdaHostname(s *aws.UpstreamSpec) string {
@@ -47,11 +45,13 @@ func NewPlugin(transformsAdded *bool) plugins.Plugin {
 type plugin struct {
 	recordedUpstreams map[core.ResourceRef]*aws.UpstreamSpec
 	ctx               context.Context
+	settings          *v1.Settings
 	transformsAdded   *bool
 }
 
 func (p *plugin) Init(params plugins.InitParams) error {
 	p.ctx = params.Ctx
+	p.settings = params.Settings
 	p.recordedUpstreams = make(map[core.ResourceRef]*aws.UpstreamSpec)
 	return nil
 }
@@ -80,37 +80,27 @@ func (p *plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *en
 		Sni: lambdaHostname,
 	}
 
-	// TODO(ilacakrms): consider if secretRef should be namespace+name
-	secrets, err := params.Snapshot.Secrets.Find(upstreamSpec.Aws.SecretRef.Strings())
+	creds, err := UpstreamCredentials(params.Snapshot.Secrets, upstreamSpec.Aws)
 	if err != nil {
-		return errors.Wrapf(err, "retrieving aws secret")
-	}
-
-	awsSecrets, ok := secrets.Kind.(*v1.Secret_Aws)
-	if !ok {
-		return errors.Errorf("secret %v is not an AWS secret", secrets.GetMetadata().Ref())
-	}
-
-	var secretErrs error
-
-	accessKey := awsSecrets.Aws.AccessKey
-	secretKey := awsSecrets.Aws.SecretKey
-	if accessKey == "" || !utf8.Valid([]byte(accessKey)) {
-		secretErrs = multierror.Append(secretErrs, errors.Errorf("access_key is not a valid string"))
+		return err
 	}
-	if secretKey == "" || !utf8.Valid([]byte(secretKey)) {
-		secretErrs = multierror.Append(secretErrs, errors.Errorf("secret_key is not a valid string"))
+	// refreshes the credentials if they are temporary and about to expire
+	value, err := creds.Get()
+	if err != nil {
+		return errors.Wrapf(err, "retrieving aws credentials")
 	}
-
-	if secretErrs != nil {
-		return secretErrs
+	if value.SessionToken != "" {
+		if err := pluginutils.RequireEnvoyExtension(p.settings, SessionTokenExtension); err != nil {
+			return errors.Wrapf(err, "temporary aws credentials need a session token")
+		}
 	}
 
 	lpe := &LambdaProtocolExtension{
-		Host:      lambdaHostname,
-		Region:    upstreamSpec.Aws.Region,
-		AccessKey: accessKey,
-		SecretKey: secretKey,
+		Host:         lambdaHostname,
+		Region:       upstreamSpec.Aws.Region,
+		AccessKey:    value.AccessKeyID,
+		SecretKey:    value.SecretAccessKey,
+		SessionToken: value.SessionToken,
 	}
 
 	err = pluginutils.SetExtenstionProtocolOptions(out, filterName, lpe)
@@ -121,6 +111,12 @@ func (p *plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *en
 	return nil
 }
 
+// the lambda upstreams are recorded to translate the routes to them, and their temporary credentials are refreshed
+func (p *plugin) CacheableUpstream(in *v1.Upstream) bool {
+	_, ours := in.UpstreamSpec.UpstreamType.(*v1.UpstreamSpec_Aws)
+	return !ours
+}
+
 func (p *plugin) ProcessRoute(params plugins.Params, in *v1.Route, out *envoyroute.Route) error {
 	err := pluginutils.MarkPerFilterConfig(p.ctx, params.Snapshot, in, out, filterName, func(spec *v1.Destination) (proto.Message, error) {
 		// check if it's aws destination
@@ -140,13 +136,18 @@ func (p *plugin) ProcessRoute(params plugins.Params, in *v1.Route, out *envoyrou
 		}
 		// should be aws upstream
 
+		async := awsDestinationSpec.Aws.InvocationStyle == aws.DestinationSpec_ASYNC
+		if async && awsDestinationSpec.Aws.ResponseTransformation {
+			return nil, errors.Errorf("response transformation cannot be used with asynchronous invocations, which respond with no body")
+		}
+
 		// get function
 		logicalName := awsDestinationSpec.Aws.LogicalName
 		for _, lambdaFunc := range lambdaSpec.LambdaFunctions {
 			if lambdaFunc.LogicalName == logicalName {
 
 				lambdaRouteFunc := &LambdaPerRoute{
-					Async: awsDestinationSpec.Aws.InvocationStyle == aws.DestinationSpec_ASYNC,
+					Async: async,
 					// we need to query escape per AWS spec:
 					// see the CanonicalQueryString section in here: https://docs.aws.amazon.com/general/latest/gr/sigv4-create-canonical-request.html
 					Qualifier: url.QueryEscape(lambdaFunc.Qualifier),
@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"github.com/aws/aws-sdk-go/aws/credentials"
 	envoyapi "github.com/envoyproxy/go-control-plane/envoy/api/v2"
 	envoyroute "github.com/envoyproxy/go-control-plane/envoy/api/v2/route"
 	"github.com/envoyproxy/go-control-plane/pkg/util"
@@ -127,6 +128,49 @@ var _ = Describe("Plugin", func() {
 			Expect(lpe.Host).To(Equal("lambda.us-east1.amazonaws.com"))
 		})
 
+		It("should process upstream with a role to assume", func() {
+			origNewRoleCredentials := newRoleCredentials
+			defer func() { newRoleCredentials = origNewRoleCredentials }()
+			newRoleCredentials = func(accessKey, secretKey, roleArn string) (*credentials.Credentials, error) {
+				Expect(accessKey).To(Equal(accessKeyValue))
+				Expect(secretKey).To(Equal(secretKeyValue))
+				return credentials.NewStaticCredentials("temp access", "temp secret", roleArn+" token"), nil
+			}
+			upstream.UpstreamSpec.UpstreamType.(*v1.UpstreamSpec_Aws).Aws.RoleArn = "arn:aws:iam::123456789012:role/gloo"
+
+			err := plugin.(plugins.UpstreamPlugin).ProcessUpstream(params, upstream, out)
+			Expect(err).To(MatchError(ContainSubstring(SessionTokenExtension)))
+
+			plugin.Init(plugins.InitParams{Settings: &v1.Settings{EnvoyExtensions: []string{SessionTokenExtension}}})
+			err = plugin.(plugins.UpstreamPlugin).ProcessUpstream(params, upstream, out)
+			Expect(err).NotTo(HaveOccurred())
+
+			lpe := &LambdaProtocolExtension{}
+			err = util.StructToMessage(out.ExtensionProtocolOptions[filterName], lpe)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(lpe.AccessKey).To(Equal("temp access"))
+			Expect(lpe.SecretKey).To(Equal("temp secret"))
+			Expect(lpe.SessionToken).To(Equal("arn:aws:iam::123456789012:role/gloo token"))
+		})
+
+		It("should process upstream without a secret using the default credentials", func() {
+			origDefaultCredentials := defaultCredentials
+			defer func() { defaultCredentials = origDefaultCredentials }()
+			defaultCredentials = credentials.NewStaticCredentials("env access", "env secret", "")
+			upstream.UpstreamSpec.UpstreamType.(*v1.UpstreamSpec_Aws).Aws.SecretRef = core.ResourceRef{}
+
+			err := plugin.(plugins.UpstreamPlugin).ProcessUpstream(params, upstream, out)
+			Expect(err).NotTo(HaveOccurred())
+
+			lpe := &LambdaProtocolExtension{}
+			err = util.StructToMessage(out.ExtensionProtocolOptions[filterName], lpe)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(lpe.AccessKey).To(Equal("env access"))
+			Expect(lpe.SecretKey).To(Equal("env secret"))
+		})
+
 		It("should error upstream with no secrets", func() {
 			params.Snapshot.Secrets = nil
 			err := plugin.(plugins.UpstreamPlugin).ProcessUpstream(params, upstream, out)
@@ -180,6 +224,37 @@ var _ = Describe("Plugin", func() {
 			Expect(outroute.PerFilterConfig).To(HaveKey(filterName))
 		})
 
+		It("should invoke the function synchronously by default", func() {
+			err := plugin.(plugins.RoutePlugin).ProcessRoute(params, route, outroute)
+			Expect(err).NotTo(HaveOccurred())
+
+			var perRoute LambdaPerRoute
+			err = util.StructToMessage(outroute.PerFilterConfig[filterName], &perRoute)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(perRoute.Async).To(BeFalse())
+		})
+
+		It("should invoke the function asynchronously with the async invocation style", func() {
+			destination.DestinationSpec.DestinationType.(*v1.DestinationSpec_Aws).Aws.InvocationStyle = awsapi.DestinationSpec_ASYNC
+
+			err := plugin.(plugins.RoutePlugin).ProcessRoute(params, route, outroute)
+			Expect(err).NotTo(HaveOccurred())
+
+			var perRoute LambdaPerRoute
+			err = util.StructToMessage(outroute.PerFilterConfig[filterName], &perRoute)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(perRoute.Async).To(BeTrue())
+		})
+
+		It("should error with a response transformation on asynchronous invocations", func() {
+			awsDestination := destination.DestinationSpec.DestinationType.(*v1.DestinationSpec_Aws).Aws
+			awsDestination.InvocationStyle = awsapi.DestinationSpec_ASYNC
+			awsDestination.ResponseTransformation = true
+
+			err := plugin.(plugins.RoutePlugin).ProcessRoute(params, route, outroute)
+			Expect(err).To(HaveOccurred())
+		})
+
 		It("should not process with no spec", func() {
 			destination.DestinationSpec = nil
 
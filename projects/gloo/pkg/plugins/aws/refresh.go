@@ -0,0 +1,65 @@
+package aws
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+var credentialsRefreshes = newRefreshScheduler()
+
+// CredentialsRefreshes signals when temporary credentials used by aws upstreams are about to expire. Envoy only gets
+// the refreshed credentials once the upstreams are translated again, so the last snapshot should be synced again on
+// every signal.
+func CredentialsRefreshes() <-chan struct{} {
+	return credentialsRefreshes.signals
+}
+
+// refreshScheduler signals each time one of the scheduled refreshes is due. The signals of refreshes due together are
+// coalesced.
+type refreshScheduler struct {
+	lock    sync.Mutex
+	due     []time.Time
+	timer   *time.Timer
+	signals chan struct{}
+}
+
+func newRefreshScheduler() *refreshScheduler {
+	return &refreshScheduler{signals: make(chan struct{}, 1)}
+}
+
+func (s *refreshScheduler) schedule(at time.Time) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.due = append(s.due, at)
+	sort.Slice(s.due, func(i, j int) bool { return s.due[i].Before(s.due[j]) })
+	s.arm()
+}
+
+// must be called with the lock held
+func (s *refreshScheduler) arm() {
+	if s.timer != nil {
+		s.timer.Stop()
+		s.timer = nil
+	}
+	if len(s.due) == 0 {
+		return
+	}
+	s.timer = time.AfterFunc(time.Until(s.due[0]), s.fire)
+}
+
+func (s *refreshScheduler) fire() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	now := time.Now()
+	for len(s.due) > 0 && !s.due[0].After(now) {
+		s.due = s.due[1:]
+	}
+	s.arm()
+
+	select {
+	case s.signals <- struct{}{}:
+	default:
+		// a signal is already pending
+	}
+}
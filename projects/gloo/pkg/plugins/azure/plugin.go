@@ -78,6 +78,12 @@ func (p *plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *en
 	return nil
 }
 
+// the azure upstreams and their api keys are recorded to translate the routes to them
+func (p *plugin) CacheableUpstream(in *v1.Upstream) bool {
+	_, ours := in.UpstreamSpec.UpstreamType.(*v1.UpstreamSpec_Azure)
+	return !ours
+}
+
 func (p *plugin) ProcessRoute(params plugins.Params, in *v1.Route, out *envoyroute.Route) error {
 	return pluginutils.MarkPerFilterConfig(p.ctx, params.Snapshot, in, out, transformation.FilterName, func(spec *v1.Destination) (proto.Message, error) {
 		// check if it's aws upstream destination
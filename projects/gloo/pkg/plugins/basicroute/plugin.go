@@ -1,15 +1,21 @@
 package basicroute
 
 import (
+	"time"
+
 	envoyroute "github.com/envoyproxy/go-control-plane/envoy/api/v2/route"
 	"github.com/gogo/protobuf/types"
 
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	retries "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/retries"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/pluginutils"
 	"github.com/solo-io/solo-kit/pkg/errors"
 )
 
+// the failures of the requests sent while the workloads of an upstream start
+const coldStartRetryOn = "connect-failure,refused-stream,gateway-error"
+
 type Plugin struct{}
 
 var _ plugins.RoutePlugin = NewPlugin()
@@ -32,6 +38,9 @@ func (p *Plugin) ProcessVirtualHost(params plugins.Params, in *v1.VirtualHost, o
 }
 
 func (p *Plugin) ProcessRoute(params plugins.Params, in *v1.Route, out *envoyroute.Route) error {
+	if err := applyColdStartHints(params, in, out); err != nil {
+		return err
+	}
 	if in.RoutePlugins == nil {
 		return nil
 	}
@@ -99,6 +108,54 @@ func applyRetries(in *v1.Route, out *envoyroute.Route) error {
 	return nil
 }
 
+// the routes to upstreams with cold starts wait for their workloads to start, unless the routes set their own timeout
+// and retries. The hints of the slowest upstream win for the routes to several upstreams.
+func applyColdStartHints(params plugins.Params, in *v1.Route, out *envoyroute.Route) error {
+	action := in.GetRouteAction()
+	if action == nil || action.Destination == nil || params.Snapshot == nil {
+		return nil
+	}
+	routeAction, ok := out.Action.(*envoyroute.Route_Route)
+	if !ok || routeAction.Route == nil {
+		return nil
+	}
+	refs, err := pluginutils.DestinationUpstreams(params.Snapshot, action)
+	if err != nil {
+		// reported by the translation of the route
+		return nil
+	}
+
+	var timeout *time.Duration
+	var numRetries uint32
+	for _, ref := range refs {
+		upstream, err := params.Snapshot.Upstreams.Find(ref.Strings())
+		if err != nil {
+			continue
+		}
+		hints := upstream.GetDiscoveryMetadata().GetColdStartHints()
+		if hints == nil {
+			continue
+		}
+		if hints.Timeout != nil && (timeout == nil || *hints.Timeout > *timeout) {
+			timeout = hints.Timeout
+		}
+		if hints.NumRetries > numRetries {
+			numRetries = hints.NumRetries
+		}
+	}
+
+	if timeout != nil && in.GetRoutePlugins().GetTimeout() == nil {
+		routeAction.Route.Timeout = timeout
+	}
+	if numRetries > 0 && in.GetRoutePlugins().GetRetries() == nil {
+		routeAction.Route.RetryPolicy = &envoyroute.RetryPolicy{
+			RetryOn:    coldStartRetryOn,
+			NumRetries: &types.UInt32Value{Value: numRetries},
+		}
+	}
+	return nil
+}
+
 func applyRetriesVhost(in *v1.VirtualHost, out *envoyroute.VirtualHost) error {
 	out.RetryPolicy = convertPolicy(in.VirtualHostPlugins.Retries)
 	return nil
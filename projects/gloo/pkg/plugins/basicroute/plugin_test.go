@@ -12,6 +12,7 @@ import (
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/transformation"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
 	. "github.com/solo-io/gloo/projects/gloo/pkg/plugins/basicroute"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 )
 
 var _ = Describe("prefix rewrite", func() {
@@ -110,3 +111,62 @@ var _ = Describe("retries", func() {
 		Expect(out.RetryPolicy).To(Equal(expectedRetryPolicy))
 	})
 })
+
+var _ = Describe("cold start hints", func() {
+
+	var (
+		params      plugins.Params
+		routeAction *envoyroute.RouteAction
+		out         *envoyroute.Route
+		route       *v1.Route
+	)
+
+	BeforeEach(func() {
+		timeout := 2 * time.Minute
+		params = plugins.Params{
+			Snapshot: &v1.ApiSnapshot{
+				Upstreams: v1.UpstreamList{{
+					Metadata: core.Metadata{Namespace: "gloo-system", Name: "default-report-8080"},
+					DiscoveryMetadata: &v1.DiscoveryMetadata{
+						ColdStartHints: &v1.ColdStartHints{WorkloadKind: "CronJob", Timeout: &timeout, NumRetries: 3},
+					},
+				}},
+			},
+		}
+		routeAction = &envoyroute.RouteAction{}
+		out = &envoyroute.Route{
+			Action: &envoyroute.Route_Route{
+				Route: routeAction,
+			},
+		}
+		route = &v1.Route{
+			Action: &v1.Route_RouteAction{
+				RouteAction: &v1.RouteAction{
+					Destination: &v1.RouteAction_Single{
+						Single: &v1.Destination{
+							DestinationType: &v1.Destination_Upstream{
+								Upstream: &core.ResourceRef{Namespace: "gloo-system", Name: "default-report-8080"},
+							},
+						},
+					},
+				},
+			},
+		}
+	})
+
+	It("sets the timeout and retries of the routes to upstreams with cold starts", func() {
+		err := NewPlugin().ProcessRoute(params, route, out)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(*routeAction.Timeout).To(Equal(2 * time.Minute))
+		Expect(routeAction.RetryPolicy.NumRetries).To(Equal(&types.UInt32Value{Value: 3}))
+	})
+
+	It("keeps the timeout of the route", func() {
+		t := time.Second
+		route.RoutePlugins = &v1.RoutePlugins{Timeout: &t}
+		err := NewPlugin().ProcessRoute(params, route, out)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(*routeAction.Timeout).To(Equal(time.Second))
+		Expect(routeAction.RetryPolicy).NotTo(BeNil())
+	})
+})
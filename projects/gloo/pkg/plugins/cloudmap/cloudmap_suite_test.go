@@ -0,0 +1,13 @@
+package cloudmap
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestCloudmap(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Cloudmap Suite")
+}
@@ -0,0 +1,236 @@
+package cloudmap
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/servicediscovery"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	awsapi "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/aws"
+	cloudmapapi "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/cloudmap"
+	"github.com/solo-io/gloo/projects/gloo/pkg/discovery"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/aws"
+	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/solo-io/solo-kit/pkg/errors"
+)
+
+const (
+	// the instances of the upstreams are listed again after this interval
+	pollInterval = 30 * time.Second
+
+	// attributes set by cloud map when registering instances, e.g. for ecs tasks
+	instanceIpv4Attribute = "AWS_INSTANCE_IPV4"
+	instancePortAttribute = "AWS_INSTANCE_PORT"
+)
+
+// returns the instances registered to the service of an upstream
+type instanceLister func(ctx context.Context, spec *cloudmapapi.UpstreamSpec, secrets v1.SecretList) ([]*servicediscovery.InstanceSummary, error)
+
+func (p *plugin) WatchEndpoints(writeNamespace string, upstreamsToTrack v1.UpstreamList, opts clients.WatchOpts) (<-chan v1.EndpointList, <-chan error, error) {
+	var secretClient v1.SecretClient
+	if p.secretFactory != nil {
+		var err error
+		secretClient, err = v1.NewSecretClient(p.secretFactory)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return newEndpointsWatcher(secretClient, upstreamsToTrack, discovery.PollInterval(pollInterval)).Watch(writeNamespace, opts)
+}
+
+type edsWatcher struct {
+	*discovery.EndpointsPoller
+	secrets       v1.SecretClient
+	upstreams     map[core.ResourceRef]*cloudmapapi.UpstreamSpec
+	listInstances instanceLister
+}
+
+func newEndpointsWatcher(secrets v1.SecretClient, upstreams v1.UpstreamList, wait discovery.WaitForChanges) *edsWatcher {
+	upstreamSpecs := make(map[core.ResourceRef]*cloudmapapi.UpstreamSpec)
+	var upstreamRefs []core.ResourceRef
+	for _, us := range upstreams {
+		cloudmapUpstream, ok := us.UpstreamSpec.UpstreamType.(*v1.UpstreamSpec_Cloudmap)
+		// only care about cloud map upstreams
+		if !ok {
+			continue
+		}
+		upstreamSpecs[us.Metadata.Ref()] = cloudmapUpstream.Cloudmap
+		upstreamRefs = append(upstreamRefs, us.Metadata.Ref())
+	}
+	c := &edsWatcher{
+		secrets:       secrets,
+		upstreams:     upstreamSpecs,
+		listInstances: listInstances,
+	}
+	c.EndpointsPoller = discovery.NewEndpointsPoller(upstreamRefs, c.listAddresses, wait)
+	return c
+}
+
+// listAddresses returns the addresses of the instances of an upstream. Instances without a usable address are
+// skipped, so that they do not prevent the others from being routed to.
+func (c *edsWatcher) listAddresses(ctx context.Context, usRef core.ResourceRef) ([]discovery.EndpointAddress, error) {
+	logger := contextutils.LoggerFrom(contextutils.WithLogger(ctx, "cloudmap_eds"))
+
+	spec := c.upstreams[usRef]
+	secrets, err := c.upstreamSecrets(ctx, spec)
+	if err != nil {
+		return nil, err
+	}
+	instances, err := c.listInstances(ctx, spec, secrets)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to list instances")
+	}
+	var addresses []discovery.EndpointAddress
+	for _, instance := range instances {
+		address, port, err := instanceAddress(instance, spec.Port)
+		if err != nil {
+			logger.Warnf("upstream %v: %v", usRef.Key(), err)
+			continue
+		}
+		addresses = append(addresses, discovery.EndpointAddress{
+			Name:    EndpointName(awssdk.StringValue(instance.Id), address, port),
+			Address: address,
+			Port:    port,
+		})
+	}
+	return addresses, nil
+}
+
+func (c *edsWatcher) upstreamSecrets(ctx context.Context, spec *cloudmapapi.UpstreamSpec) (v1.SecretList, error) {
+	if spec.SecretRef.Name == "" {
+		// the credentials of the environment are used
+		return nil, nil
+	}
+	if c.secrets == nil {
+		return nil, errors.Errorf("secret %v cannot be read, no secret client configured", spec.SecretRef)
+	}
+	secret, err := c.secrets.Read(spec.SecretRef.Namespace, spec.SecretRef.Name, clients.ReadOpts{Ctx: ctx})
+	if err != nil {
+		return nil, errors.Wrapf(err, "reading secret %v", spec.SecretRef)
+	}
+	return v1.SecretList{secret}, nil
+}
+
+// EndpointName returns the name of the endpoint for the given address of an instance
+func EndpointName(instanceId, address string, port uint32) string {
+	dnsname := func(s string) string {
+		return strings.Map(func(r rune) rune {
+			if '0' <= r && r <= '9' {
+				return r
+			}
+			if 'a' <= r && r <= 'z' {
+				return r
+			}
+			return '-'
+		}, strings.ToLower(s))
+	}
+	return fmt.Sprintf("cloudmap-%v-%v-%v", dnsname(instanceId), dnsname(address), port)
+}
+
+// returns the address of an instance, registered as attributes of the instance.
+// instances registered with a CNAME or alias rather than an ip cannot be routed to.
+func instanceAddress(instance *servicediscovery.InstanceSummary, port uint32) (string, uint32, error) {
+	instanceId := awssdk.StringValue(instance.Id)
+	address := awssdk.StringValue(instance.Attributes[instanceIpv4Attribute])
+	if address == "" {
+		return "", 0, errors.Errorf("instance %v has no %v attribute", instanceId, instanceIpv4Attribute)
+	}
+	if port != 0 {
+		return address, port, nil
+	}
+	instancePort, err := strconv.ParseUint(awssdk.StringValue(instance.Attributes[instancePortAttribute]), 10, 16)
+	if err != nil {
+		return "", 0, errors.Errorf("instance %v has no valid %v attribute and the upstream does not set a port", instanceId, instancePortAttribute)
+	}
+	return address, uint32(instancePort), nil
+}
+
+func listInstances(ctx context.Context, spec *cloudmapapi.UpstreamSpec, secrets v1.SecretList) ([]*servicediscovery.InstanceSummary, error) {
+	// cloud map upstreams use the same credentials as lambda upstreams
+	creds, err := aws.UpstreamCredentials(secrets, &awsapi.UpstreamSpec{
+		Region:    spec.Region,
+		SecretRef: spec.SecretRef,
+		RoleArn:   spec.RoleArn,
+	})
+	if err != nil {
+		return nil, err
+	}
+	sess, err := session.NewSession(awssdk.NewConfig().
+		WithCredentials(creds).
+		WithRegion(spec.Region))
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to create AWS session")
+	}
+	svc := servicediscovery.New(sess)
+
+	serviceId, err := findService(ctx, svc, spec.NamespaceName, spec.ServiceName)
+	if err != nil {
+		return nil, err
+	}
+
+	var instances []*servicediscovery.InstanceSummary
+	input := &servicediscovery.ListInstancesInput{
+		ServiceId: awssdk.String(serviceId),
+	}
+	err = svc.ListInstancesPagesWithContext(ctx, input, func(page *servicediscovery.ListInstancesOutput, lastPage bool) bool {
+		instances = append(instances, page.Instances...)
+		return true
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to list instances of service %v", spec.ServiceName)
+	}
+	return instances, nil
+}
+
+// findService returns the id of the service with the given name in the namespace with the given name
+func findService(ctx context.Context, svc *servicediscovery.ServiceDiscovery, namespaceName, serviceName string) (string, error) {
+	var namespaceId string
+	err := svc.ListNamespacesPagesWithContext(ctx, &servicediscovery.ListNamespacesInput{}, func(page *servicediscovery.ListNamespacesOutput, lastPage bool) bool {
+		for _, namespace := range page.Namespaces {
+			if awssdk.StringValue(namespace.Name) == namespaceName {
+				namespaceId = awssdk.StringValue(namespace.Id)
+				return false
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return "", errors.Wrapf(err, "unable to list namespaces")
+	}
+	if namespaceId == "" {
+		return "", errors.Errorf("namespace %v not found", namespaceName)
+	}
+
+	var serviceId string
+	input := &servicediscovery.ListServicesInput{
+		Filters: []*servicediscovery.ServiceFilter{{
+			Name:      awssdk.String(servicediscovery.ServiceFilterNameNamespaceId),
+			Condition: awssdk.String(servicediscovery.FilterConditionEq),
+			Values:    awssdk.StringSlice([]string{namespaceId}),
+		}},
+	}
+	err = svc.ListServicesPagesWithContext(ctx, input, func(page *servicediscovery.ListServicesOutput, lastPage bool) bool {
+		for _, service := range page.Services {
+			if awssdk.StringValue(service.Name) == serviceName {
+				serviceId = awssdk.StringValue(service.Id)
+				return false
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return "", errors.Wrapf(err, "unable to list services of namespace %v", namespaceName)
+	}
+	if serviceId == "" {
+		return "", errors.Errorf("service %v not found in namespace %v", serviceName, namespaceName)
+	}
+	return serviceId, nil
+}
@@ -0,0 +1,119 @@
+package cloudmap
+
+import (
+	"context"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicediscovery"
+	envoyapi "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	cloudmapapi "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/cloudmap"
+	"github.com/solo-io/gloo/projects/gloo/pkg/discovery"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/solo-io/solo-kit/pkg/errors"
+)
+
+var _ = Describe("Cloud Map Upstreams", func() {
+	var (
+		spec     *cloudmapapi.UpstreamSpec
+		upstream *v1.Upstream
+	)
+
+	BeforeEach(func() {
+		spec = &cloudmapapi.UpstreamSpec{
+			Region:        "us-east-1",
+			NamespaceName: "example.local",
+			ServiceName:   "petstore",
+		}
+		upstream = &v1.Upstream{
+			Metadata: core.Metadata{Name: "petstore", Namespace: "gloo-system"},
+			UpstreamSpec: &v1.UpstreamSpec{
+				UpstreamType: &v1.UpstreamSpec_Cloudmap{Cloudmap: spec},
+			},
+		}
+	})
+
+	Context("plugin", func() {
+		It("should use eds for cloud map upstreams", func() {
+			out := &envoyapi.Cluster{}
+			err := NewPlugin(nil).(plugins.UpstreamPlugin).ProcessUpstream(plugins.Params{}, upstream, out)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.GetType()).To(Equal(envoyapi.Cluster_EDS))
+		})
+
+		It("should require a service", func() {
+			spec.ServiceName = ""
+			err := NewPlugin(nil).(plugins.UpstreamPlugin).ProcessUpstream(plugins.Params{}, upstream, &envoyapi.Cluster{})
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("endpoints", func() {
+		var (
+			watcher   *edsWatcher
+			instances []*servicediscovery.InstanceSummary
+		)
+
+		BeforeEach(func() {
+			instances = []*servicediscovery.InstanceSummary{
+				{
+					Id: awssdk.String("task-1"),
+					Attributes: awssdk.StringMap(map[string]string{
+						instanceIpv4Attribute: "10.0.0.1",
+						instancePortAttribute: "8080",
+					}),
+				},
+				{
+					Id: awssdk.String("task-2"),
+					Attributes: awssdk.StringMap(map[string]string{
+						instanceIpv4Attribute: "10.0.0.2",
+					}),
+				},
+				{
+					Id: awssdk.String("cname"),
+					Attributes: awssdk.StringMap(map[string]string{
+						"AWS_INSTANCE_CNAME": "petstore.example.com",
+					}),
+				},
+			}
+			watcher = newEndpointsWatcher(nil, v1.UpstreamList{upstream}, discovery.PollInterval(0))
+			watcher.listInstances = func(ctx context.Context, spec *cloudmapapi.UpstreamSpec, secrets v1.SecretList) ([]*servicediscovery.InstanceSummary, error) {
+				return instances, nil
+			}
+		})
+
+		It("should use the port attribute of the instances", func() {
+			endpoints, err := watcher.List(context.TODO(), "gloo-system")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(endpoints).To(HaveLen(1))
+			Expect(endpoints[0].Metadata.Name).To(Equal("cloudmap-task-1-10-0-0-1-8080"))
+			Expect(endpoints[0].Address).To(Equal("10.0.0.1"))
+			Expect(endpoints[0].Port).To(BeEquivalentTo(8080))
+			Expect(endpoints[0].Upstreams).To(ConsistOf(&core.ResourceRef{Name: "petstore", Namespace: "gloo-system"}))
+		})
+
+		It("should prefer the port of the upstream", func() {
+			spec.Port = 9090
+			endpoints, err := watcher.List(context.TODO(), "gloo-system")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(endpoints).To(HaveLen(2))
+			Expect(endpoints[0].Port).To(BeEquivalentTo(9090))
+			Expect(endpoints[1].Address).To(Equal("10.0.0.2"))
+		})
+
+		It("should keep the last known endpoints of upstreams whose instances cannot be listed", func() {
+			_, err := watcher.List(context.TODO(), "gloo-system")
+			Expect(err).NotTo(HaveOccurred())
+
+			watcher.listInstances = func(ctx context.Context, spec *cloudmapapi.UpstreamSpec, secrets v1.SecretList) ([]*servicediscovery.InstanceSummary, error) {
+				return nil, errors.Errorf("service not found")
+			}
+			endpoints, err := watcher.List(context.TODO(), "gloo-system")
+			Expect(err).To(MatchError(ContainSubstring("service not found")))
+			Expect(endpoints).To(HaveLen(1))
+		})
+	})
+})
@@ -0,0 +1,60 @@
+package cloudmap
+
+import (
+	envoyapi "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/discovery"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/factory"
+	"github.com/solo-io/solo-kit/pkg/errors"
+)
+
+var _ discovery.DiscoveryPlugin = new(plugin)
+
+type plugin struct {
+	secretFactory factory.ResourceClientFactory
+}
+
+// NewPlugin returns the plugin for Cloud Map upstreams. The secrets referenced by the upstreams
+// are read with a client created from the given factory when resolving their instances.
+func NewPlugin(secretFactory factory.ResourceClientFactory) plugins.Plugin {
+	return &plugin{secretFactory: secretFactory}
+}
+
+func (p *plugin) Init(params plugins.InitParams) error {
+	return nil
+}
+
+func (p *plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *envoyapi.Cluster) error {
+	cloudmapSpec, ok := in.UpstreamSpec.UpstreamType.(*v1.UpstreamSpec_Cloudmap)
+	if !ok {
+		return nil
+	}
+	if cloudmapSpec.Cloudmap.Region == "" {
+		return errors.Errorf("region of cloud map upstream %v must be set", in.Metadata.Ref())
+	}
+	if cloudmapSpec.Cloudmap.NamespaceName == "" || cloudmapSpec.Cloudmap.ServiceName == "" {
+		return errors.Errorf("namespace and service of cloud map upstream %v must be set", in.Metadata.Ref())
+	}
+
+	// the registered instances are published as endpoints
+	xds.SetEdsOnCluster(out)
+
+	return nil
+}
+
+// the cluster only depends on the upstream
+func (p *plugin) CacheableUpstream(in *v1.Upstream) bool {
+	return true
+}
+
+// cloud map upstreams are created by users, only their endpoints are discovered
+func (p *plugin) DiscoverUpstreams(watchNamespaces []string, writeNamespace string, opts clients.WatchOpts, discOpts discovery.Opts) (chan v1.UpstreamList, chan error, error) {
+	return nil, nil, nil
+}
+
+func (p *plugin) UpdateUpstream(original, desired *v1.Upstream) (bool, error) {
+	return false, nil
+}
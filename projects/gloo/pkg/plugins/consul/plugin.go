@@ -55,3 +55,8 @@ func (p *plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *en
 
 	return nil
 }
+
+// the cluster only depends on the upstream
+func (p *plugin) CacheableUpstream(in *v1.Upstream) bool {
+	return true
+}
@@ -0,0 +1,58 @@
+package deadline
+
+import (
+	"time"
+
+	envoyroute "github.com/envoyproxy/go-control-plane/envoy/api/v2/route"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/solo-kit/pkg/errors"
+)
+
+type Plugin struct{}
+
+var _ plugins.RoutePlugin = NewPlugin()
+
+func NewPlugin() *Plugin {
+	return &Plugin{}
+}
+
+func (p *Plugin) Init(params plugins.InitParams) error {
+	return nil
+}
+
+func (p *Plugin) ProcessRoute(params plugins.Params, in *v1.Route, out *envoyroute.Route) error {
+	cfg := in.GetRoutePlugins().GetDeadlinePropagation()
+	if cfg == nil {
+		return nil
+	}
+	routeAction, ok := out.Action.(*envoyroute.Route_Route)
+	if !ok {
+		return errors.Errorf("deadline propagation is only available for Route Actions")
+	}
+	if routeAction.Route == nil {
+		return errors.Errorf("internal error: route %v specified deadline propagation, but output Envoy object "+
+			"had nil route", in.Action)
+	}
+
+	var maxGrpcTimeout time.Duration
+	if cfg.MaxGrpcTimeout != nil {
+		if *cfg.MaxGrpcTimeout <= 0 {
+			return errors.Errorf("the max grpc timeout must be positive, found %v", *cfg.MaxGrpcTimeout)
+		}
+		maxGrpcTimeout = *cfg.MaxGrpcTimeout
+	}
+	if cfg.GrpcTimeoutOffset != nil {
+		if *cfg.GrpcTimeoutOffset < 0 {
+			return errors.Errorf("the grpc timeout offset cannot be negative, found %v", *cfg.GrpcTimeoutOffset)
+		}
+		if maxGrpcTimeout > 0 && *cfg.GrpcTimeoutOffset >= maxGrpcTimeout {
+			return errors.Errorf("the grpc timeout offset %v must be shorter than the max grpc timeout %v",
+				*cfg.GrpcTimeoutOffset, maxGrpcTimeout)
+		}
+		routeAction.Route.GrpcTimeoutOffset = cfg.GrpcTimeoutOffset
+	}
+	// envoy only honors the grpc-timeout header with a max grpc timeout, 0 for unlimited
+	routeAction.Route.MaxGrpcTimeout = &maxGrpcTimeout
+	return nil
+}
@@ -0,0 +1,81 @@
+package deadline_test
+
+import (
+	"time"
+
+	envoyroute "github.com/envoyproxy/go-control-plane/envoy/api/v2/route"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/deadline"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	. "github.com/solo-io/gloo/projects/gloo/pkg/plugins/deadline"
+)
+
+var _ = Describe("Plugin", func() {
+	var (
+		plugin *Plugin
+		cfg    *deadline.DeadlinePropagation
+		in     *v1.Route
+		out    *envoyroute.Route
+	)
+
+	BeforeEach(func() {
+		plugin = NewPlugin()
+		Expect(plugin.Init(plugins.InitParams{})).NotTo(HaveOccurred())
+		cfg = &deadline.DeadlinePropagation{}
+		in = &v1.Route{
+			RoutePlugins: &v1.RoutePlugins{
+				DeadlinePropagation: cfg,
+			},
+		}
+		out = &envoyroute.Route{
+			Action: &envoyroute.Route_Route{
+				Route: &envoyroute.RouteAction{},
+			},
+		}
+	})
+
+	duration := func(d time.Duration) *time.Duration {
+		return &d
+	}
+
+	routeAction := func() *envoyroute.RouteAction {
+		return out.Action.(*envoyroute.Route_Route).Route
+	}
+
+	It("does not change the routes without deadline propagation", func() {
+		Expect(plugin.ProcessRoute(plugins.Params{}, &v1.Route{}, out)).NotTo(HaveOccurred())
+		Expect(routeAction().MaxGrpcTimeout).To(BeNil())
+	})
+
+	It("honors the grpc-timeout of the caller without limit", func() {
+		Expect(plugin.ProcessRoute(plugins.Params{}, in, out)).NotTo(HaveOccurred())
+		Expect(routeAction().MaxGrpcTimeout).To(Equal(duration(0)))
+		Expect(routeAction().GrpcTimeoutOffset).To(BeNil())
+	})
+
+	It("caps the grpc-timeout of the caller and subtracts the offset", func() {
+		cfg.MaxGrpcTimeout = duration(30 * time.Second)
+		cfg.GrpcTimeoutOffset = duration(50 * time.Millisecond)
+		Expect(plugin.ProcessRoute(plugins.Params{}, in, out)).NotTo(HaveOccurred())
+		Expect(routeAction().MaxGrpcTimeout).To(Equal(duration(30 * time.Second)))
+		Expect(routeAction().GrpcTimeoutOffset).To(Equal(duration(50 * time.Millisecond)))
+	})
+
+	It("rejects offsets longer than the max grpc timeout", func() {
+		cfg.MaxGrpcTimeout = duration(time.Second)
+		cfg.GrpcTimeoutOffset = duration(time.Second)
+		Expect(plugin.ProcessRoute(plugins.Params{}, in, out)).To(HaveOccurred())
+	})
+
+	It("rejects non-positive max grpc timeouts", func() {
+		cfg.MaxGrpcTimeout = duration(0)
+		Expect(plugin.ProcessRoute(plugins.Params{}, in, out)).To(HaveOccurred())
+	})
+
+	It("rejects routes without route action", func() {
+		out.Action = &envoyroute.Route_Redirect{}
+		Expect(plugin.ProcessRoute(plugins.Params{}, in, out)).To(HaveOccurred())
+	})
+})
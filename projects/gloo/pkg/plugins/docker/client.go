@@ -0,0 +1,176 @@
+package docker
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/solo-io/solo-kit/pkg/errors"
+)
+
+const (
+	// the environment variable of the Docker CLI
+	hostEnv = "DOCKER_HOST"
+
+	defaultHost = "unix:///var/run/docker.sock"
+
+	requestTimeout = 30 * time.Second
+)
+
+// the types of the objects of the Docker events
+const (
+	eventTypeContainer = "container"
+	eventTypeService   = "service"
+)
+
+// a running container, as listed by the Docker API
+type container struct {
+	Id              string
+	Names           []string
+	Labels          map[string]string
+	Ports           []containerPort
+	NetworkSettings struct {
+		Networks map[string]containerNetwork
+	}
+}
+
+type containerPort struct {
+	PrivatePort uint32
+	Type        string
+}
+
+type containerNetwork struct {
+	IPAddress string
+}
+
+// a service of the Swarm, as listed by the Docker API
+type swarmService struct {
+	ID   string
+	Spec struct {
+		Name   string
+		Labels map[string]string
+	}
+}
+
+// client of the Docker API
+type dockerClient interface {
+	// Containers lists the running containers
+	Containers(ctx context.Context) ([]container, error)
+	// Services lists the services of the Swarm
+	Services(ctx context.Context) ([]swarmService, error)
+	// WaitForEvent blocks until an object of the type changed since the time
+	WaitForEvent(ctx context.Context, eventType string, since time.Time) error
+}
+
+type httpClient struct {
+	// the base url of the API, the host is ignored when talking over a unix socket
+	address string
+	client  *http.Client
+	// waits for the events, without a timeout
+	eventsClient *http.Client
+}
+
+func newHttpClient(host string) (dockerClient, error) {
+	if host == "" {
+		host = os.Getenv(hostEnv)
+	}
+	if host == "" {
+		host = defaultHost
+	}
+	u, err := url.Parse(host)
+	if err != nil {
+		return nil, errors.Wrapf(err, "parsing docker host %v", host)
+	}
+	transport := &http.Transport{}
+	address := "http://" + u.Host
+	switch u.Scheme {
+	case "unix":
+		socket := u.Path
+		transport.DialContext = func(ctx context.Context, _, _ string) (net.Conn, error) {
+			var dialer net.Dialer
+			return dialer.DialContext(ctx, "unix", socket)
+		}
+		address = "http://docker"
+	case "tcp", "http":
+	case "https":
+		address = "https://" + u.Host
+	default:
+		return nil, errors.Errorf("unsupported docker host %v", host)
+	}
+	return &httpClient{
+		address:      address,
+		client:       &http.Client{Transport: transport, Timeout: requestTimeout},
+		eventsClient: &http.Client{Transport: transport},
+	}, nil
+}
+
+func (c *httpClient) Containers(ctx context.Context) ([]container, error) {
+	var containers []container
+	if err := c.get(ctx, c.client, "/containers/json", nil, &containers); err != nil {
+		return nil, errors.Wrapf(err, "listing the docker containers")
+	}
+	return containers, nil
+}
+
+func (c *httpClient) Services(ctx context.Context) ([]swarmService, error) {
+	var services []swarmService
+	if err := c.get(ctx, c.client, "/services", nil, &services); err != nil {
+		return nil, errors.Wrapf(err, "listing the swarm services")
+	}
+	return services, nil
+}
+
+func (c *httpClient) WaitForEvent(ctx context.Context, eventType string, since time.Time) error {
+	filters, err := json.Marshal(map[string][]string{"type": {eventType}})
+	if err != nil {
+		return err
+	}
+	query := url.Values{
+		"since":   {strconv.FormatInt(since.Unix(), 10)},
+		"filters": {string(filters)},
+	}
+	// the events are streamed, the first one is enough
+	var event struct{}
+	if err := c.get(ctx, c.eventsClient, "/events", query, &event); err != nil {
+		return errors.Wrapf(err, "waiting for docker %v events", eventType)
+	}
+	return nil
+}
+
+// sends a GET request to the Docker API, decodes the first JSON value of the response
+func (c *httpClient) get(ctx context.Context, client *http.Client, path string, query url.Values, out interface{}) error {
+	u := c.address + path
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+	req, err := http.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := client.Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return errors.Errorf("unexpected status %v", resp.Status)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return errors.Wrapf(err, "decoding the response")
+	}
+	return nil
+}
+
+// the name of the container, without the leading slash of the Docker API
+func containerName(c container) string {
+	if len(c.Names) == 0 {
+		return c.Id
+	}
+	return strings.TrimPrefix(c.Names[0], "/")
+}
@@ -0,0 +1,41 @@
+package docker
+
+import (
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+)
+
+// the docker plugin discovers upstreams for the labelled Docker containers or Swarm services, so Gloo can serve as
+// the edge proxy of a single Docker host or of a Swarm.
+// the discovered upstreams are static upstreams, translated by the static plugin.
+type plugin struct {
+	config *v1.DockerConfiguration
+	client dockerClient
+}
+
+// NewPlugin returns the plugin discovering the upstreams of Docker if the config is not nil
+func NewPlugin(config *v1.DockerConfiguration) plugins.Plugin {
+	return &plugin{config: config}
+}
+
+func (p *plugin) Init(params plugins.InitParams) error {
+	return nil
+}
+
+func (p *plugin) tryGetClient() error {
+	if p.client != nil {
+		return nil
+	}
+	client, err := newHttpClient(p.config.GetHost())
+	if err != nil {
+		return err
+	}
+	p.client = client
+	return nil
+}
+
+func (p *plugin) WatchEndpoints(writeNamespace string, upstreamsToTrack v1.UpstreamList, opts clients.WatchOpts) (<-chan v1.EndpointList, <-chan error, error) {
+	// static upstreams resolve their hosts with dns, there are no endpoints to discover
+	return nil, nil, nil
+}
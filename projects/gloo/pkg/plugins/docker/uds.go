@@ -0,0 +1,320 @@
+package docker
+
+import (
+	"context"
+	"crypto/md5"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/static"
+	"github.com/solo-io/gloo/projects/gloo/pkg/discovery"
+	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/solo-io/solo-kit/pkg/errors"
+)
+
+// the labels of the containers and Swarm services
+const (
+	DiscoverLabel  = "gloo.solo.io/discover"
+	PortLabel      = "gloo.solo.io/port"
+	UpstreamLabel  = "gloo.solo.io/upstream"
+	NetworkLabel   = "gloo.solo.io/network"
+	H2ServiceLabel = "gloo.solo.io/h2_service"
+
+	// set by Compose on the containers of its services
+	composeProjectLabel = "com.docker.compose.project"
+	composeServiceLabel = "com.docker.compose.service"
+)
+
+// how long to wait before querying the Docker API again after an error
+const retryInterval = 10 * time.Second
+
+var _ discovery.DiscoveryPlugin = new(plugin)
+
+func (p *plugin) DiscoverUpstreams(watchNamespaces []string, writeNamespace string, opts clients.WatchOpts, discOpts discovery.Opts) (chan v1.UpstreamList, chan error, error) {
+	if p.config == nil {
+		// docker is not discovered
+		return nil, nil, nil
+	}
+	if err := p.tryGetClient(); err != nil {
+		return nil, nil, err
+	}
+	ctx := contextutils.WithLogger(opts.Ctx, "docker-uds")
+	logger := contextutils.LoggerFrom(ctx)
+
+	eventType := eventTypeContainer
+	if p.config.Swarm {
+		eventType = eventTypeService
+	}
+
+	logger.Infow("started", "swarm", p.config.Swarm, "writens", writeNamespace)
+
+	upstreamsChan := make(chan v1.UpstreamList)
+	errs := make(chan error)
+
+	go func() {
+		defer logger.Info("ended")
+		defer close(upstreamsChan)
+		defer close(errs)
+
+		for {
+			// the changes made while listing are caught by the events since the list started
+			since := time.Now()
+			upstreams, err := p.listUpstreams(ctx, writeNamespace)
+			if ctx.Err() != nil {
+				return
+			}
+			if err != nil {
+				if !sendErrAndWait(ctx, errs, err) {
+					return
+				}
+				continue
+			}
+			logger.Debugw("discovered docker upstreams", "num", len(upstreams))
+			select {
+			case <-ctx.Done():
+				return
+			case upstreamsChan <- upstreams:
+			}
+
+			err = p.client.WaitForEvent(ctx, eventType, since)
+			if ctx.Err() != nil {
+				return
+			}
+			if err != nil && !sendErrAndWait(ctx, errs, err) {
+				return
+			}
+		}
+	}()
+
+	return upstreamsChan, errs, nil
+}
+
+// reports the error, then waits before the next query. Returns false if the context is done.
+func sendErrAndWait(ctx context.Context, errs chan error, err error) bool {
+	select {
+	case <-ctx.Done():
+		return false
+	case errs <- err:
+	}
+	select {
+	case <-ctx.Done():
+		return false
+	case <-time.After(retryInterval):
+		return true
+	}
+}
+
+func (p *plugin) listUpstreams(ctx context.Context, writeNamespace string) (v1.UpstreamList, error) {
+	if p.config.Swarm {
+		services, err := p.client.Services(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return convertServices(services, writeNamespace), nil
+	}
+	containers, err := p.client.Containers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return convertContainers(containers, writeNamespace), nil
+}
+
+// convertContainers creates an upstream for the discovered containers sharing an upstream label, or a Compose
+// service. The containers without a single port to route to are skipped.
+func convertContainers(containers []container, writeNamespace string) v1.UpstreamList {
+	byName := make(map[string]*v1.Upstream)
+	for _, c := range containers {
+		if c.Labels[DiscoverLabel] != "true" {
+			continue
+		}
+		port, ok := portOf(c)
+		if !ok {
+			continue
+		}
+		addr := addressOf(c)
+		if addr == "" {
+			continue
+		}
+		name := UpstreamName(upstreamLabelOf(c))
+		us, ok := byName[name]
+		if !ok {
+			us = newUpstream(name, writeNamespace, c.Labels)
+			byName[name] = us
+		}
+		staticSpec := us.UpstreamSpec.UpstreamType.(*v1.UpstreamSpec_Static).Static
+		staticSpec.Hosts = append(staticSpec.Hosts, &static.Host{Addr: addr, Port: port})
+	}
+
+	var upstreams v1.UpstreamList
+	for _, us := range byName {
+		hosts := us.UpstreamSpec.UpstreamType.(*v1.UpstreamSpec_Static).Static.Hosts
+		// sort for idempotency
+		sort.SliceStable(hosts, func(i, j int) bool { return hosts[i].Addr < hosts[j].Addr })
+		upstreams = append(upstreams, us)
+	}
+	// sort for idempotency
+	sort.SliceStable(upstreams, func(i, j int) bool { return upstreams[i].Metadata.Name < upstreams[j].Metadata.Name })
+	return upstreams
+}
+
+// convertServices creates an upstream for the discovered Swarm services with a port label. Their host is the name
+// of the service, resolved to its virtual IP by the Docker DNS.
+func convertServices(services []swarmService, writeNamespace string) v1.UpstreamList {
+	var upstreams v1.UpstreamList
+	for _, svc := range services {
+		labels := svc.Spec.Labels
+		if labels[DiscoverLabel] != "true" {
+			continue
+		}
+		port, err := strconv.ParseUint(labels[PortLabel], 10, 32)
+		if err != nil || port == 0 {
+			continue
+		}
+		name := labels[UpstreamLabel]
+		if name == "" {
+			name = svc.Spec.Name
+		}
+		us := newUpstream(UpstreamName(name), writeNamespace, labels)
+		staticSpec := us.UpstreamSpec.UpstreamType.(*v1.UpstreamSpec_Static).Static
+		staticSpec.Hosts = []*static.Host{{Addr: svc.Spec.Name, Port: uint32(port)}}
+		upstreams = append(upstreams, us)
+	}
+	// sort for idempotency
+	sort.SliceStable(upstreams, func(i, j int) bool { return upstreams[i].Metadata.Name < upstreams[j].Metadata.Name })
+	return upstreams
+}
+
+func newUpstream(name, writeNamespace string, labels map[string]string) *v1.Upstream {
+	return &v1.Upstream{
+		Metadata: core.Metadata{
+			Name:      name,
+			Namespace: writeNamespace,
+		},
+		UpstreamSpec: &v1.UpstreamSpec{
+			UpstreamType: &v1.UpstreamSpec_Static{
+				Static: &static.UpstreamSpec{
+					UseHttp2: labels[H2ServiceLabel] == "true",
+				},
+			},
+		},
+		DiscoveryMetadata: &v1.DiscoveryMetadata{},
+	}
+}
+
+// the port of the label, or the single tcp port of the container
+func portOf(c container) (uint32, bool) {
+	if label, ok := c.Labels[PortLabel]; ok {
+		port, err := strconv.ParseUint(label, 10, 32)
+		return uint32(port), err == nil && port != 0
+	}
+	var tcpPorts []uint32
+	for _, port := range c.Ports {
+		if port.Type == "tcp" && !containsPort(port.PrivatePort, tcpPorts) {
+			tcpPorts = append(tcpPorts, port.PrivatePort)
+		}
+	}
+	if len(tcpPorts) != 1 {
+		return 0, false
+	}
+	return tcpPorts[0], true
+}
+
+// the address of the container on the network of the label, or on its first network
+func addressOf(c container) string {
+	networks := c.NetworkSettings.Networks
+	if network, ok := c.Labels[NetworkLabel]; ok {
+		return networks[network].IPAddress
+	}
+	var names []string
+	for name := range networks {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if addr := networks[name].IPAddress; addr != "" {
+			return addr
+		}
+	}
+	return ""
+}
+
+// the name of the upstream of the container before sanitizing
+func upstreamLabelOf(c container) string {
+	if name := c.Labels[UpstreamLabel]; name != "" {
+		return name
+	}
+	project, service := c.Labels[composeProjectLabel], c.Labels[composeServiceLabel]
+	if project != "" && service != "" {
+		return project + "-" + service
+	}
+	return containerName(c)
+}
+
+// UpstreamName returns the name of the upstream of a container or Swarm service
+func UpstreamName(name string) string {
+	const maxLen = 63
+
+	name = "docker-" + strings.Map(func(r rune) rune {
+		if ('0' <= r && r <= '9') || ('a' <= r && r <= 'z') {
+			return r
+		}
+		return '-'
+	}, strings.ToLower(name))
+	if len(name) > maxLen {
+		hash := md5.Sum([]byte(name))
+		hexhash := fmt.Sprintf("%x", hash)
+		name = name[:maxLen-len(hexhash)] + hexhash
+	}
+	return name
+}
+
+func (p *plugin) UpdateUpstream(original, desired *v1.Upstream) (bool, error) {
+	originalSpec, ok := original.UpstreamSpec.UpstreamType.(*v1.UpstreamSpec_Static)
+	if !ok {
+		return false, errors.Errorf("internal error: expected *v1.UpstreamSpec_Static, got %v", reflect.TypeOf(original.UpstreamSpec.UpstreamType).Name())
+	}
+	desiredSpec, ok := desired.UpstreamSpec.UpstreamType.(*v1.UpstreamSpec_Static)
+	if !ok {
+		return false, errors.Errorf("internal error: expected *v1.UpstreamSpec_Static, got %v", reflect.TypeOf(original.UpstreamSpec.UpstreamType).Name())
+	}
+	// copy service spec, we don't want to overwrite that
+	desiredSpec.Static.ServiceSpec = originalSpec.Static.ServiceSpec
+	// tls is not discovered, the user may have enabled it
+	desiredSpec.Static.UseTls = originalSpec.Static.UseTls
+
+	// do not override ssl and connection config if none specified by discovery
+	if desired.UpstreamSpec.SslConfig == nil {
+		desired.UpstreamSpec.SslConfig = original.UpstreamSpec.SslConfig
+	}
+	if desired.UpstreamSpec.CircuitBreakers == nil {
+		desired.UpstreamSpec.CircuitBreakers = original.UpstreamSpec.CircuitBreakers
+	}
+	if desired.UpstreamSpec.LoadBalancerConfig == nil {
+		desired.UpstreamSpec.LoadBalancerConfig = original.UpstreamSpec.LoadBalancerConfig
+	}
+	if desired.UpstreamSpec.ConnectionConfig == nil {
+		desired.UpstreamSpec.ConnectionConfig = original.UpstreamSpec.ConnectionConfig
+	}
+
+	if originalSpec.Equal(desiredSpec) {
+		return false, nil
+	}
+
+	return true, nil
+}
+
+func containsPort(port uint32, ports []uint32) bool {
+	for _, p := range ports {
+		if p == port {
+			return true
+		}
+	}
+	return false
+}
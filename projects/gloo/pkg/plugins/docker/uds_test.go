@@ -0,0 +1,138 @@
+package docker
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/static"
+	"github.com/solo-io/gloo/projects/gloo/pkg/discovery"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+)
+
+type fakeClient struct {
+	containers []container
+	services   []swarmService
+}
+
+func (c *fakeClient) Containers(ctx context.Context) ([]container, error) {
+	return c.containers, nil
+}
+
+func (c *fakeClient) Services(ctx context.Context) ([]swarmService, error) {
+	return c.services, nil
+}
+
+func (c *fakeClient) WaitForEvent(ctx context.Context, eventType string, since time.Time) error {
+	// nothing changes
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+func newContainer(name, addr string, labels map[string]string, ports ...uint32) container {
+	c := container{Id: name, Names: []string{"/" + name}, Labels: labels}
+	for _, port := range ports {
+		c.Ports = append(c.Ports, containerPort{PrivatePort: port, Type: "tcp"})
+	}
+	c.NetworkSettings.Networks = map[string]containerNetwork{"bridge": {IPAddress: addr}}
+	return c
+}
+
+func newService(name string, labels map[string]string) swarmService {
+	svc := swarmService{ID: name}
+	svc.Spec.Name = name
+	svc.Spec.Labels = labels
+	return svc
+}
+
+var _ = Describe("Docker Upstreams", func() {
+
+	names := func(upstreams v1.UpstreamList) []string {
+		var names []string
+		for _, us := range upstreams {
+			names = append(names, us.Metadata.Name)
+		}
+		return names
+	}
+
+	Context("containers", func() {
+		It("should only discover the labelled containers", func() {
+			upstreams := convertContainers([]container{
+				newContainer("redis", "172.17.0.2", map[string]string{DiscoverLabel: "true"}, 6379),
+				newContainer("db", "172.17.0.3", nil, 5432),
+			}, "gloo-system")
+			Expect(names(upstreams)).To(Equal([]string{"docker-redis"}))
+			Expect(upstreams[0].UpstreamSpec.GetStatic()).To(Equal(&static.UpstreamSpec{
+				Hosts: []*static.Host{{Addr: "172.17.0.2", Port: 6379}},
+			}))
+		})
+
+		It("should group the containers of a compose service", func() {
+			labels := map[string]string{DiscoverLabel: "true", composeProjectLabel: "shop", composeServiceLabel: "api", H2ServiceLabel: "true"}
+			upstreams := convertContainers([]container{
+				newContainer("shop_api_2", "172.17.0.3", labels, 8080),
+				newContainer("shop_api_1", "172.17.0.2", labels, 8080),
+			}, "gloo-system")
+			Expect(names(upstreams)).To(Equal([]string{"docker-shop-api"}))
+			Expect(upstreams[0].UpstreamSpec.GetStatic()).To(Equal(&static.UpstreamSpec{
+				Hosts:    []*static.Host{{Addr: "172.17.0.2", Port: 8080}, {Addr: "172.17.0.3", Port: 8080}},
+				UseHttp2: true,
+			}))
+		})
+
+		It("should route to the port and network of the labels", func() {
+			c := newContainer("api", "172.17.0.2", map[string]string{DiscoverLabel: "true", PortLabel: "9090", NetworkLabel: "backend"}, 8080, 9090)
+			c.NetworkSettings.Networks["backend"] = containerNetwork{IPAddress: "10.0.1.5"}
+			upstreams := convertContainers([]container{c}, "gloo-system")
+			Expect(upstreams).To(HaveLen(1))
+			Expect(upstreams[0].UpstreamSpec.GetStatic().Hosts).To(Equal([]*static.Host{{Addr: "10.0.1.5", Port: 9090}}))
+		})
+
+		It("should skip the containers without a single port", func() {
+			upstreams := convertContainers([]container{
+				newContainer("api", "172.17.0.2", map[string]string{DiscoverLabel: "true"}, 8080, 9090),
+			}, "gloo-system")
+			Expect(upstreams).To(BeEmpty())
+		})
+	})
+
+	Context("swarm services", func() {
+		It("should resolve the name of the labelled services", func() {
+			upstreams := convertServices([]swarmService{
+				newService("shop_api", map[string]string{DiscoverLabel: "true", PortLabel: "8080", UpstreamLabel: "api"}),
+				newService("shop_db", map[string]string{PortLabel: "5432"}),
+				newService("shop_web", map[string]string{DiscoverLabel: "true"}),
+			}, "gloo-system")
+			Expect(names(upstreams)).To(Equal([]string{"docker-api"}))
+			Expect(upstreams[0].UpstreamSpec.GetStatic().Hosts).To(Equal([]*static.Host{{Addr: "shop_api", Port: 8080}}))
+		})
+	})
+
+	Context("discovery", func() {
+		It("should not discover the upstreams without config", func() {
+			p := NewPlugin(nil).(*plugin)
+			upstreams, errs, err := p.DiscoverUpstreams(nil, "gloo-system", clients.WatchOpts{Ctx: context.TODO()}, discovery.Opts{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(upstreams).To(BeNil())
+			Expect(errs).To(BeNil())
+		})
+
+		It("should discover the upstreams of the swarm", func() {
+			ctx, cancel := context.WithCancel(context.TODO())
+			defer cancel()
+			p := &plugin{
+				config: &v1.DockerConfiguration{Swarm: true},
+				client: &fakeClient{services: []swarmService{newService("api", map[string]string{DiscoverLabel: "true", PortLabel: "80"})}},
+			}
+			upstreams, _, err := p.DiscoverUpstreams(nil, "gloo-system", clients.WatchOpts{Ctx: ctx}, discovery.Opts{})
+			Expect(err).NotTo(HaveOccurred())
+			Eventually(upstreams).Should(Receive(HaveLen(1)))
+		})
+	})
+
+	It("should name the upstreams after valid kube names", func() {
+		Expect(UpstreamName("Shop_API.v2")).To(Equal("docker-shop-api-v2"))
+	})
+})
@@ -0,0 +1,196 @@
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	awsapi "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/aws"
+	ec2api "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/ec2"
+	"github.com/solo-io/gloo/projects/gloo/pkg/discovery"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/aws"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/solo-io/solo-kit/pkg/errors"
+)
+
+// the instances of the upstreams are described again after this interval
+const pollInterval = 30 * time.Second
+
+// returns the instances matching the filters of an upstream
+type instanceDescriber func(ctx context.Context, spec *ec2api.UpstreamSpec, secrets v1.SecretList) ([]*ec2.Instance, error)
+
+func (p *plugin) WatchEndpoints(writeNamespace string, upstreamsToTrack v1.UpstreamList, opts clients.WatchOpts) (<-chan v1.EndpointList, <-chan error, error) {
+	var secretClient v1.SecretClient
+	if p.secretFactory != nil {
+		var err error
+		secretClient, err = v1.NewSecretClient(p.secretFactory)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return newEndpointsWatcher(secretClient, upstreamsToTrack, discovery.PollInterval(pollInterval)).Watch(writeNamespace, opts)
+}
+
+type edsWatcher struct {
+	*discovery.EndpointsPoller
+	secrets           v1.SecretClient
+	upstreams         map[core.ResourceRef]*ec2api.UpstreamSpec
+	describeInstances instanceDescriber
+}
+
+func newEndpointsWatcher(secrets v1.SecretClient, upstreams v1.UpstreamList, wait discovery.WaitForChanges) *edsWatcher {
+	upstreamSpecs := make(map[core.ResourceRef]*ec2api.UpstreamSpec)
+	var upstreamRefs []core.ResourceRef
+	for _, us := range upstreams {
+		ec2Upstream, ok := us.UpstreamSpec.UpstreamType.(*v1.UpstreamSpec_Ec2)
+		// only care about ec2 upstreams
+		if !ok {
+			continue
+		}
+		upstreamSpecs[us.Metadata.Ref()] = ec2Upstream.Ec2
+		upstreamRefs = append(upstreamRefs, us.Metadata.Ref())
+	}
+	c := &edsWatcher{
+		secrets:           secrets,
+		upstreams:         upstreamSpecs,
+		describeInstances: describeInstances,
+	}
+	c.EndpointsPoller = discovery.NewEndpointsPoller(upstreamRefs, c.listAddresses, wait)
+	return c
+}
+
+// listAddresses returns the addresses of the instances of an upstream
+func (c *edsWatcher) listAddresses(ctx context.Context, usRef core.ResourceRef) ([]discovery.EndpointAddress, error) {
+	spec := c.upstreams[usRef]
+	secrets, err := c.upstreamSecrets(ctx, spec)
+	if err != nil {
+		return nil, err
+	}
+	instances, err := c.describeInstances(ctx, spec, secrets)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to describe instances")
+	}
+	var addresses []discovery.EndpointAddress
+	for _, instance := range instances {
+		address := instanceAddress(instance, spec.PublicIp)
+		if address == "" {
+			continue
+		}
+		addresses = append(addresses, discovery.EndpointAddress{
+			Name:    EndpointName(awssdk.StringValue(instance.InstanceId), address, spec.Port),
+			Address: address,
+			Port:    spec.Port,
+		})
+	}
+	return addresses, nil
+}
+
+func (c *edsWatcher) upstreamSecrets(ctx context.Context, spec *ec2api.UpstreamSpec) (v1.SecretList, error) {
+	if spec.SecretRef.Name == "" {
+		// the credentials of the environment are used
+		return nil, nil
+	}
+	if c.secrets == nil {
+		return nil, errors.Errorf("secret %v cannot be read, no secret client configured", spec.SecretRef)
+	}
+	secret, err := c.secrets.Read(spec.SecretRef.Namespace, spec.SecretRef.Name, clients.ReadOpts{Ctx: ctx})
+	if err != nil {
+		return nil, errors.Wrapf(err, "reading secret %v", spec.SecretRef)
+	}
+	return v1.SecretList{secret}, nil
+}
+
+// EndpointName returns the name of the endpoint for the given address of an instance
+func EndpointName(instanceId, address string, port uint32) string {
+	dnsname := strings.Map(func(r rune) rune {
+		if '0' <= r && r <= '9' {
+			return r
+		}
+		if 'a' <= r && r <= 'z' {
+			return r
+		}
+		return '-'
+	}, strings.ToLower(address))
+	return fmt.Sprintf("ec2-%v-%v-%v", strings.ToLower(instanceId), dnsname, port)
+}
+
+func instanceAddress(instance *ec2.Instance, publicIp bool) string {
+	if publicIp {
+		return awssdk.StringValue(instance.PublicIpAddress)
+	}
+	return awssdk.StringValue(instance.PrivateIpAddress)
+}
+
+// Filters returns the filters selecting the running instances of an upstream
+func Filters(spec *ec2api.UpstreamSpec) []*ec2.Filter {
+	filters := []*ec2.Filter{{
+		Name:   awssdk.String("instance-state-name"),
+		Values: awssdk.StringSlice([]string{ec2.InstanceStateNameRunning}),
+	}}
+	for _, tag := range spec.Filters {
+		if tag.Value == "" {
+			filters = append(filters, &ec2.Filter{
+				Name:   awssdk.String("tag-key"),
+				Values: awssdk.StringSlice([]string{tag.Key}),
+			})
+			continue
+		}
+		filters = append(filters, &ec2.Filter{
+			Name:   awssdk.String("tag:" + tag.Key),
+			Values: awssdk.StringSlice([]string{tag.Value}),
+		})
+	}
+	if spec.VpcId != "" {
+		filters = append(filters, &ec2.Filter{
+			Name:   awssdk.String("vpc-id"),
+			Values: awssdk.StringSlice([]string{spec.VpcId}),
+		})
+	}
+	if len(spec.SubnetIds) > 0 {
+		filters = append(filters, &ec2.Filter{
+			Name:   awssdk.String("subnet-id"),
+			Values: awssdk.StringSlice(spec.SubnetIds),
+		})
+	}
+	return filters
+}
+
+func describeInstances(ctx context.Context, spec *ec2api.UpstreamSpec, secrets v1.SecretList) ([]*ec2.Instance, error) {
+	// ec2 upstreams use the same credentials as lambda upstreams
+	creds, err := aws.UpstreamCredentials(secrets, &awsapi.UpstreamSpec{
+		Region:    spec.Region,
+		SecretRef: spec.SecretRef,
+		RoleArn:   spec.RoleArn,
+	})
+	if err != nil {
+		return nil, err
+	}
+	sess, err := session.NewSession(awssdk.NewConfig().
+		WithCredentials(creds).
+		WithRegion(spec.Region))
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to create AWS session")
+	}
+
+	var instances []*ec2.Instance
+	input := &ec2.DescribeInstancesInput{
+		Filters: Filters(spec),
+	}
+	err = ec2.New(sess).DescribeInstancesPagesWithContext(ctx, input, func(page *ec2.DescribeInstancesOutput, lastPage bool) bool {
+		for _, reservation := range page.Reservations {
+			instances = append(instances, reservation.Instances...)
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return instances, nil
+}
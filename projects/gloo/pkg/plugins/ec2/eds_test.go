@@ -0,0 +1,137 @@
+package ec2
+
+import (
+	"context"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	envoyapi "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	ec2api "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/ec2"
+	"github.com/solo-io/gloo/projects/gloo/pkg/discovery"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/solo-io/solo-kit/pkg/errors"
+)
+
+var _ = Describe("EC2 Upstreams", func() {
+	var (
+		spec     *ec2api.UpstreamSpec
+		upstream *v1.Upstream
+	)
+
+	BeforeEach(func() {
+		spec = &ec2api.UpstreamSpec{
+			Region: "us-east-1",
+			Filters: []*ec2api.TagFilter{
+				{Key: "app", Value: "petstore"},
+				{Key: "gloo"},
+			},
+			VpcId:     "vpc-1",
+			SubnetIds: []string{"subnet-1", "subnet-2"},
+			Port:      8080,
+		}
+		upstream = &v1.Upstream{
+			Metadata: core.Metadata{Name: "petstore", Namespace: "gloo-system"},
+			UpstreamSpec: &v1.UpstreamSpec{
+				UpstreamType: &v1.UpstreamSpec_Ec2{Ec2: spec},
+			},
+		}
+	})
+
+	Context("plugin", func() {
+		It("should use eds for ec2 upstreams", func() {
+			out := &envoyapi.Cluster{}
+			err := NewPlugin(nil).(plugins.UpstreamPlugin).ProcessUpstream(plugins.Params{}, upstream, out)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.GetType()).To(Equal(envoyapi.Cluster_EDS))
+		})
+
+		It("should require a port", func() {
+			spec.Port = 0
+			err := NewPlugin(nil).(plugins.UpstreamPlugin).ProcessUpstream(plugins.Params{}, upstream, &envoyapi.Cluster{})
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("filters", func() {
+		It("should select the running instances matching the tags, vpc and subnets", func() {
+			Expect(Filters(spec)).To(Equal([]*ec2.Filter{
+				{Name: awssdk.String("instance-state-name"), Values: awssdk.StringSlice([]string{"running"})},
+				{Name: awssdk.String("tag:app"), Values: awssdk.StringSlice([]string{"petstore"})},
+				{Name: awssdk.String("tag-key"), Values: awssdk.StringSlice([]string{"gloo"})},
+				{Name: awssdk.String("vpc-id"), Values: awssdk.StringSlice([]string{"vpc-1"})},
+				{Name: awssdk.String("subnet-id"), Values: awssdk.StringSlice([]string{"subnet-1", "subnet-2"})},
+			}))
+		})
+	})
+
+	Context("endpoints", func() {
+		var (
+			watcher   *edsWatcher
+			instances []*ec2.Instance
+		)
+
+		BeforeEach(func() {
+			instances = []*ec2.Instance{
+				{
+					InstanceId:       awssdk.String("i-1"),
+					PrivateIpAddress: awssdk.String("10.0.0.1"),
+					PublicIpAddress:  awssdk.String("54.0.0.1"),
+				},
+				{
+					InstanceId:       awssdk.String("i-2"),
+					PrivateIpAddress: awssdk.String("10.0.0.2"),
+				},
+			}
+			watcher = newEndpointsWatcher(nil, v1.UpstreamList{upstream}, discovery.PollInterval(0))
+			watcher.describeInstances = func(ctx context.Context, spec *ec2api.UpstreamSpec, secrets v1.SecretList) ([]*ec2.Instance, error) {
+				return instances, nil
+			}
+		})
+
+		It("should publish the private ips of the instances", func() {
+			endpoints, err := watcher.List(context.TODO(), "gloo-system")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(endpoints).To(HaveLen(2))
+			Expect(endpoints[0].Metadata.Name).To(Equal("ec2-i-1-10-0-0-1-8080"))
+			Expect(endpoints[0].Address).To(Equal("10.0.0.1"))
+			Expect(endpoints[0].Port).To(BeEquivalentTo(8080))
+			Expect(endpoints[0].Upstreams).To(ConsistOf(&core.ResourceRef{Name: "petstore", Namespace: "gloo-system"}))
+			Expect(endpoints[1].Address).To(Equal("10.0.0.2"))
+		})
+
+		It("should publish the public ips of the instances and skip instances without one", func() {
+			spec.PublicIp = true
+			endpoints, err := watcher.List(context.TODO(), "gloo-system")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(endpoints).To(HaveLen(1))
+			Expect(endpoints[0].Address).To(Equal("54.0.0.1"))
+		})
+
+		It("should keep the last known endpoints of upstreams whose instances cannot be described", func() {
+			_, err := watcher.List(context.TODO(), "gloo-system")
+			Expect(err).NotTo(HaveOccurred())
+
+			watcher.describeInstances = func(ctx context.Context, spec *ec2api.UpstreamSpec, secrets v1.SecretList) ([]*ec2.Instance, error) {
+				return nil, errors.Errorf("access denied")
+			}
+			endpoints, err := watcher.List(context.TODO(), "gloo-system")
+			Expect(err).To(MatchError(ContainSubstring("access denied")))
+			Expect(endpoints).To(HaveLen(2))
+		})
+
+		It("should refresh the endpoints periodically", func() {
+			ctx, cancel := context.WithCancel(context.TODO())
+			defer cancel()
+			endpoints, _, err := watcher.Watch("gloo-system", clients.WatchOpts{Ctx: ctx})
+			Expect(err).NotTo(HaveOccurred())
+			// the instances are described again after each poll interval
+			Eventually(endpoints).Should(Receive(HaveLen(2)))
+			Eventually(endpoints).Should(Receive(HaveLen(2)))
+		})
+	})
+})
@@ -0,0 +1,60 @@
+package ec2
+
+import (
+	envoyapi "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/discovery"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/factory"
+	"github.com/solo-io/solo-kit/pkg/errors"
+)
+
+var _ discovery.DiscoveryPlugin = new(plugin)
+
+type plugin struct {
+	secretFactory factory.ResourceClientFactory
+}
+
+// NewPlugin returns the plugin for EC2 upstreams. The secrets referenced by the upstreams
+// are read with a client created from the given factory when discovering their endpoints.
+func NewPlugin(secretFactory factory.ResourceClientFactory) plugins.Plugin {
+	return &plugin{secretFactory: secretFactory}
+}
+
+func (p *plugin) Init(params plugins.InitParams) error {
+	return nil
+}
+
+func (p *plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *envoyapi.Cluster) error {
+	ec2Spec, ok := in.UpstreamSpec.UpstreamType.(*v1.UpstreamSpec_Ec2)
+	if !ok {
+		return nil
+	}
+	if ec2Spec.Ec2.Region == "" {
+		return errors.Errorf("region of ec2 upstream %v must be set", in.Metadata.Ref())
+	}
+	if ec2Spec.Ec2.Port == 0 {
+		return errors.Errorf("port of ec2 upstream %v must be set", in.Metadata.Ref())
+	}
+
+	// the instances are published as endpoints
+	xds.SetEdsOnCluster(out)
+
+	return nil
+}
+
+// the cluster only depends on the upstream
+func (p *plugin) CacheableUpstream(in *v1.Upstream) bool {
+	return true
+}
+
+// ec2 upstreams are created by users, only their endpoints are discovered
+func (p *plugin) DiscoverUpstreams(watchNamespaces []string, writeNamespace string, opts clients.WatchOpts, discOpts discovery.Opts) (chan v1.UpstreamList, chan error, error) {
+	return nil, nil, nil
+}
+
+func (p *plugin) UpdateUpstream(original, desired *v1.Upstream) (bool, error) {
+	return false, nil
+}
@@ -0,0 +1,184 @@
+package external
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+
+	envoyapi "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	envoyauth "github.com/envoyproxy/go-control-plane/envoy/api/v2/auth"
+	envoyroute "github.com/envoyproxy/go-control-plane/envoy/api/v2/route"
+	"github.com/gogo/protobuf/proto"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/external"
+	transformationapi "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/transformation"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/pluginutils"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/transformation"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/solo-io/solo-kit/pkg/errors"
+)
+
+const (
+	defaultPort = 443
+)
+
+// the endpoint shared by the functions of an upstream
+type endpoint struct {
+	// the host of the urls, including the port if any
+	authority string
+	hostname  string
+	port      uint32
+}
+
+type recordedUpstream struct {
+	// the path of each function, by logical name
+	paths map[string]string
+	endpoint
+}
+
+type plugin struct {
+	recordedUpstreams map[core.ResourceRef]*recordedUpstream
+	ctx               context.Context
+	transformsAdded   *bool
+}
+
+func NewPlugin(transformsAdded *bool) plugins.Plugin {
+	return &plugin{transformsAdded: transformsAdded}
+}
+
+func (p *plugin) Init(params plugins.InitParams) error {
+	p.ctx = params.Ctx
+	p.recordedUpstreams = make(map[core.ResourceRef]*recordedUpstream)
+	return nil
+}
+
+func (p *plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *envoyapi.Cluster) error {
+	upstreamSpec, ok := in.UpstreamSpec.UpstreamType.(*v1.UpstreamSpec_External)
+	if !ok {
+		// not ours
+		return nil
+	}
+
+	recorded, err := recordUpstream(upstreamSpec.External)
+	if err != nil {
+		return errors.Wrapf(err, "invalid external function upstream %v", in.Metadata.Ref())
+	}
+	p.recordedUpstreams[in.Metadata.Ref()] = recorded
+
+	// configure Envoy cluster routing info
+	out.ClusterDiscoveryType = &envoyapi.Cluster_Type{
+		Type: envoyapi.Cluster_LOGICAL_DNS,
+	}
+	out.DnsLookupFamily = envoyapi.Cluster_V4_ONLY
+	pluginutils.EnvoySingleEndpointLoadAssignment(out, recorded.hostname, recorded.port)
+
+	out.TlsContext = &envoyauth.UpstreamTlsContext{
+		Sni: recorded.hostname,
+	}
+	return nil
+}
+
+// the external function upstreams are recorded to translate the routes to them
+func (p *plugin) CacheableUpstream(in *v1.Upstream) bool {
+	_, ours := in.UpstreamSpec.UpstreamType.(*v1.UpstreamSpec_External)
+	return !ours
+}
+
+func recordUpstream(spec *external.UpstreamSpec) (*recordedUpstream, error) {
+	if len(spec.Functions) == 0 {
+		return nil, errors.Errorf("at least one function is required")
+	}
+	recorded := &recordedUpstream{
+		paths: make(map[string]string),
+	}
+	for _, fn := range spec.Functions {
+		if fn.LogicalName == "" {
+			return nil, errors.Errorf("the function with url %v has no logical name", fn.Url)
+		}
+		if _, ok := recorded.paths[fn.LogicalName]; ok {
+			return nil, errors.Errorf("duplicate function %v", fn.LogicalName)
+		}
+		u, err := url.Parse(fn.Url)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid url for function %v", fn.LogicalName)
+		}
+		if u.Scheme != "https" || u.Host == "" {
+			return nil, errors.Errorf("the url of function %v must be an https url, got %v", fn.LogicalName, fn.Url)
+		}
+		fnEndpoint, err := getEndpoint(u)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid url for function %v", fn.LogicalName)
+		}
+		if recorded.authority == "" {
+			recorded.endpoint = fnEndpoint
+		} else if fnEndpoint != recorded.endpoint {
+			return nil, errors.Errorf("all the functions must be served by the same host: function %v is served by %v, not %v",
+				fn.LogicalName, fnEndpoint.authority, recorded.authority)
+		}
+		recorded.paths[fn.LogicalName] = u.RequestURI()
+	}
+	return recorded, nil
+}
+
+func getEndpoint(u *url.URL) (endpoint, error) {
+	port := uint32(defaultPort)
+	if u.Port() != "" {
+		p, err := strconv.ParseUint(u.Port(), 10, 16)
+		if err != nil {
+			return endpoint{}, errors.Wrapf(err, "invalid port %v", u.Port())
+		}
+		port = uint32(p)
+	}
+	return endpoint{
+		authority: u.Host,
+		hostname:  u.Hostname(),
+		port:      port,
+	}, nil
+}
+
+func (p *plugin) ProcessRoute(params plugins.Params, in *v1.Route, out *envoyroute.Route) error {
+	return pluginutils.MarkPerFilterConfig(p.ctx, params.Snapshot, in, out, transformation.FilterName, func(spec *v1.Destination) (proto.Message, error) {
+		// check if it's external function upstream destination
+		if spec.DestinationSpec == nil || spec.GetUpstream() == nil {
+			return nil, nil
+		}
+		externalDestinationSpec, ok := spec.DestinationSpec.DestinationType.(*v1.DestinationSpec_External)
+		if !ok {
+			return nil, nil
+		}
+
+		upstream, ok := p.recordedUpstreams[*spec.GetUpstream()]
+		if !ok {
+			return nil, errors.Errorf("%v is not a valid external function upstream", *spec.GetUpstream())
+		}
+
+		logicalName := externalDestinationSpec.External.LogicalName
+		path, ok := upstream.paths[logicalName]
+		if !ok {
+			return nil, errors.Errorf("unknown function %v", logicalName)
+		}
+
+		*p.transformsAdded = true
+
+		return &transformationapi.RouteTransformations{
+			RequestTransformation: &transformationapi.Transformation{
+				TransformationType: &transformationapi.Transformation_TransformationTemplate{
+					TransformationTemplate: &transformationapi.TransformationTemplate{
+						Headers: map[string]*transformationapi.InjaTemplate{
+							":path": {
+								Text: path,
+							},
+							":authority": {
+								Text: upstream.authority,
+							},
+						},
+						BodyTransformation: &transformationapi.TransformationTemplate_Passthrough{
+							Passthrough: &transformationapi.Passthrough{},
+						},
+					},
+				},
+			},
+		}, nil
+	})
+}
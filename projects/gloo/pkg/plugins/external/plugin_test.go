@@ -0,0 +1,166 @@
+package external
+
+import (
+	envoyapi "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	envoyroute "github.com/envoyproxy/go-control-plane/envoy/api/v2/route"
+	"github.com/envoyproxy/go-control-plane/pkg/util"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/external"
+	transformationapi "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/transformation"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/transformation"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+var _ = Describe("Plugin", func() {
+	var (
+		params          plugins.Params
+		plugin          plugins.Plugin
+		transformsAdded bool
+		upstream        *v1.Upstream
+		upstreamSpec    *external.UpstreamSpec
+		route           *v1.Route
+		out             *envoyapi.Cluster
+		outroute        *envoyroute.Route
+	)
+	BeforeEach(func() {
+		transformsAdded = false
+		plugin = NewPlugin(&transformsAdded)
+		plugin.Init(plugins.InitParams{})
+		upstreamName := "up"
+		upstreamSpec = &external.UpstreamSpec{
+			Functions: []*external.FunctionSpec{
+				{
+					LogicalName: "hello",
+					Url:         "https://example.workers.dev/hello",
+				},
+				{
+					LogicalName: "search",
+					Url:         "https://example.workers.dev/api/search?source=gloo",
+				},
+			},
+		}
+		upstream = &v1.Upstream{
+			Metadata: core.Metadata{
+				Name: upstreamName,
+			},
+			UpstreamSpec: &v1.UpstreamSpec{
+				UpstreamType: &v1.UpstreamSpec_External{
+					External: upstreamSpec,
+				},
+			},
+		}
+		route = &v1.Route{
+			Action: &v1.Route_RouteAction{
+				RouteAction: &v1.RouteAction{
+					Destination: &v1.RouteAction_Single{
+						Single: &v1.Destination{
+							DestinationType: &v1.Destination_Upstream{
+								Upstream: &core.ResourceRef{
+									Name: upstreamName,
+								},
+							},
+							DestinationSpec: &v1.DestinationSpec{
+								DestinationType: &v1.DestinationSpec_External{
+									External: &external.DestinationSpec{
+										LogicalName: "search",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+
+		out = &envoyapi.Cluster{}
+		outroute = &envoyroute.Route{
+			Action: &envoyroute.Route_Route{
+				Route: &envoyroute.RouteAction{
+					ClusterSpecifier: &envoyroute.RouteAction_Cluster{
+						Cluster: upstreamName,
+					},
+				},
+			},
+		}
+		params.Snapshot = &v1.ApiSnapshot{}
+	})
+
+	Context("upstreams", func() {
+		socketAddress := func() (string, uint32) {
+			address := out.LoadAssignment.Endpoints[0].LbEndpoints[0].GetEndpoint().Address.GetSocketAddress()
+			return address.Address, address.GetPortValue()
+		}
+
+		It("should point the cluster at the host of the functions", func() {
+			err := plugin.(plugins.UpstreamPlugin).ProcessUpstream(params, upstream, out)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.TlsContext.Sni).To(Equal("example.workers.dev"))
+			Expect(out.GetType()).To(Equal(envoyapi.Cluster_LOGICAL_DNS))
+			address, port := socketAddress()
+			Expect(address).To(Equal("example.workers.dev"))
+			Expect(port).To(BeEquivalentTo(443))
+		})
+
+		It("should use the port of the urls", func() {
+			for _, fn := range upstreamSpec.Functions {
+				fn.Url = "https://edge.example.com:8443/" + fn.LogicalName
+			}
+			err := plugin.(plugins.UpstreamPlugin).ProcessUpstream(params, upstream, out)
+			Expect(err).NotTo(HaveOccurred())
+			address, port := socketAddress()
+			Expect(address).To(Equal("edge.example.com"))
+			Expect(port).To(BeEquivalentTo(8443))
+		})
+
+		It("should error with functions served by different hosts", func() {
+			upstreamSpec.Functions[1].Url = "https://other.workers.dev/api/search"
+			err := plugin.(plugins.UpstreamPlugin).ProcessUpstream(params, upstream, out)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should error with a url that is not https", func() {
+			upstreamSpec.Functions[0].Url = "http://example.workers.dev/hello"
+			err := plugin.(plugins.UpstreamPlugin).ProcessUpstream(params, upstream, out)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should error with duplicate functions", func() {
+			upstreamSpec.Functions[1].LogicalName = "hello"
+			err := plugin.(plugins.UpstreamPlugin).ProcessUpstream(params, upstream, out)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("routes", func() {
+		BeforeEach(func() {
+			err := plugin.(plugins.UpstreamPlugin).ProcessUpstream(params, upstream, out)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should route to the url of the function", func() {
+			err := plugin.(plugins.RoutePlugin).ProcessRoute(params, route, outroute)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(transformsAdded).To(BeTrue())
+
+			var transformations transformationapi.RouteTransformations
+			err = util.StructToMessage(outroute.PerFilterConfig[transformation.FilterName], &transformations)
+			Expect(err).NotTo(HaveOccurred())
+			headers := transformations.RequestTransformation.GetTransformationTemplate().Headers
+			Expect(headers[":path"].Text).To(Equal("/api/search?source=gloo"))
+			Expect(headers[":authority"].Text).To(Equal("example.workers.dev"))
+		})
+
+		It("should not process with a function mismatch", func() {
+			destination := route.Action.(*v1.Route_RouteAction).RouteAction.Destination.(*v1.RouteAction_Single).Single
+			destination.DestinationSpec.DestinationType.(*v1.DestinationSpec_External).External.LogicalName = "somethingelse"
+
+			err := plugin.(plugins.RoutePlugin).ProcessRoute(params, route, outroute)
+			Expect(err).To(HaveOccurred())
+			Expect(outroute.PerFilterConfig).NotTo(HaveKey(transformation.FilterName))
+		})
+	})
+})
@@ -0,0 +1,87 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: aggregate.proto
+
+package failover
+
+import (
+	fmt "fmt"
+	math "math"
+
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	proto "github.com/gogo/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+// Configuration for the aggregate cluster. See the :ref:`architecture overview
+// <arch_overview_aggregate_cluster>` for more information.
+type ClusterConfig struct {
+	// Load balancing clusters in aggregate cluster. Clusters are prioritized based on the order they
+	// appear in this list.
+	Clusters             []string `protobuf:"bytes,1,rep,name=clusters,proto3" json:"clusters,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ClusterConfig) Reset()         { *m = ClusterConfig{} }
+func (m *ClusterConfig) String() string { return proto.CompactTextString(m) }
+func (*ClusterConfig) ProtoMessage()    {}
+func (*ClusterConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2274752f0f9a48c5, []int{0}
+}
+func (m *ClusterConfig) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ClusterConfig.Unmarshal(m, b)
+}
+func (m *ClusterConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ClusterConfig.Marshal(b, m, deterministic)
+}
+func (m *ClusterConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterConfig.Merge(m, src)
+}
+func (m *ClusterConfig) XXX_Size() int {
+	return xxx_messageInfo_ClusterConfig.Size(m)
+}
+func (m *ClusterConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClusterConfig proto.InternalMessageInfo
+
+func (m *ClusterConfig) GetClusters() []string {
+	if m != nil {
+		return m.Clusters
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*ClusterConfig)(nil), "envoy.config.cluster.aggregate.v2alpha.ClusterConfig")
+}
+
+func init() { proto.RegisterFile("aggregate.proto", fileDescriptor_2274752f0f9a48c5) }
+
+var fileDescriptor_2274752f0f9a48c5 = []byte{
+	// 185 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0x4f, 0x4c, 0x4f, 0x2f,
+	0x4a, 0x4d, 0x4f, 0x2c, 0x49, 0xd5, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x52, 0x4b, 0xcd, 0x2b,
+	0xcb, 0xaf, 0xd4, 0x4b, 0xce, 0xcf, 0x4b, 0xcb, 0x4c, 0xd7, 0x4b, 0xce, 0x29, 0x2d, 0x2e, 0x49,
+	0x2d, 0xd2, 0x43, 0xa8, 0x2a, 0x33, 0x4a, 0xcc, 0x29, 0xc8, 0x48, 0x94, 0x12, 0x2f, 0x4b, 0xcc,
+	0xc9, 0x4c, 0x49, 0x2c, 0x49, 0xd5, 0x87, 0x31, 0x20, 0x06, 0x28, 0x99, 0x73, 0xf1, 0x3a, 0x43,
+	0x74, 0x39, 0x83, 0xcd, 0x10, 0x52, 0xe3, 0xe2, 0x80, 0x1a, 0x53, 0x2c, 0xc1, 0xa8, 0xc0, 0xac,
+	0xc1, 0xe9, 0xc4, 0xb5, 0xeb, 0xe5, 0x01, 0x66, 0xd6, 0x49, 0x8c, 0x4c, 0x1c, 0x8c, 0x41, 0x70,
+	0x39, 0x27, 0x9b, 0x28, 0xab, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0xfd,
+	0xe2, 0xfc, 0x9c, 0x7c, 0xdd, 0xcc, 0x7c, 0xfd, 0xf4, 0x9c, 0xfc, 0x7c, 0xfd, 0x82, 0xa2, 0xfc,
+	0xac, 0xd4, 0xe4, 0x92, 0x62, 0x28, 0x2f, 0x3b, 0x5d, 0xbf, 0x20, 0xa7, 0x34, 0x3d, 0x33, 0xaf,
+	0x58, 0x3f, 0x2d, 0x31, 0x33, 0x27, 0xbf, 0x2c, 0xb5, 0x28, 0x89, 0x0d, 0x6c, 0xbb, 0x31, 0x60,
+	0x00, 0x66, 0xf7, 0x5e, 0xdc, 0xd1, 0x00, 0x00, 0x00,
+}
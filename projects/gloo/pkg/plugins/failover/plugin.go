@@ -0,0 +1,101 @@
+package failover
+
+import (
+	envoyapi "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	types "github.com/gogo/protobuf/types"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/failover"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/pluginutils"
+	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/solo-io/solo-kit/pkg/errors"
+)
+
+const (
+	AggregateClusterName = "envoy.clusters.aggregate"
+
+	// the CLUSTER_PROVIDED lb policy of envoy, which the aggregate clusters require, missing from the envoy api we
+	// build against
+	clusterProvidedLbPolicy envoyapi.Cluster_LbPolicy = 6
+)
+
+type Plugin struct {
+	settings *v1.Settings
+}
+
+func NewPlugin() *Plugin {
+	return &Plugin{}
+}
+
+func (p *Plugin) Init(params plugins.InitParams) error {
+	p.settings = params.Settings
+	return nil
+}
+
+func (p *Plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *envoyapi.Cluster) error {
+	upstreamSpec, ok := in.UpstreamSpec.UpstreamType.(*v1.UpstreamSpec_Failover)
+	if !ok {
+		// not ours
+		return nil
+	}
+
+	// envoy rejects all the clusters of the proxy if it lacks the aggregate cluster
+	if err := pluginutils.RequireEnvoyExtension(p.settings, AggregateClusterName); err != nil {
+		return errors.Wrapf(err, "invalid failover upstream %v", in.Metadata.Ref())
+	}
+
+	clusters, err := aggregatedClusters(params.Snapshot, in, upstreamSpec.Failover)
+	if err != nil {
+		return errors.Wrapf(err, "invalid failover upstream %v", in.Metadata.Ref())
+	}
+	config, err := types.MarshalAny(&ClusterConfig{
+		Clusters: clusters,
+	})
+	if err != nil {
+		return err
+	}
+
+	out.ClusterDiscoveryType = &envoyapi.Cluster_ClusterType{
+		ClusterType: &envoyapi.Cluster_CustomClusterType{
+			Name:        AggregateClusterName,
+			TypedConfig: config,
+		},
+	}
+	out.LbPolicy = clusterProvidedLbPolicy
+	return nil
+}
+
+// the failover upstreams depend on the other upstreams of the snapshot
+func (p *Plugin) CacheableUpstream(in *v1.Upstream) bool {
+	_, ours := in.UpstreamSpec.UpstreamType.(*v1.UpstreamSpec_Failover)
+	return !ours
+}
+
+// the names of the clusters of the upstreams, in order of priority
+func aggregatedClusters(snapshot *v1.ApiSnapshot, in *v1.Upstream, spec *failover.UpstreamSpec) ([]string, error) {
+	if len(spec.Upstreams) == 0 {
+		return nil, errors.Errorf("at least one upstream is required")
+	}
+	var clusters []string
+	seen := make(map[core.ResourceRef]bool)
+	for _, ref := range spec.Upstreams {
+		if ref == in.Metadata.Ref() {
+			return nil, errors.Errorf("the upstream cannot fail over to itself")
+		}
+		if seen[ref] {
+			return nil, errors.Errorf("duplicate upstream %v", ref)
+		}
+		seen[ref] = true
+		upstream, err := snapshot.Upstreams.Find(ref.Strings())
+		if err != nil {
+			return nil, errors.Wrapf(err, "upstream %v not found", ref)
+		}
+		// envoy does not support aggregate clusters of aggregate clusters
+		if _, nested := upstream.UpstreamSpec.UpstreamType.(*v1.UpstreamSpec_Failover); nested {
+			return nil, errors.Errorf("upstream %v is a failover upstream", ref)
+		}
+		clusters = append(clusters, translator.UpstreamToClusterName(ref))
+	}
+	return clusters, nil
+}
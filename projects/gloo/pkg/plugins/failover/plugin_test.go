@@ -0,0 +1,142 @@
+package failover_test
+
+import (
+	envoyapi "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	types "github.com/gogo/protobuf/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/failover"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/kubernetes"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/static"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	. "github.com/solo-io/gloo/projects/gloo/pkg/plugins/failover"
+	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+var _ = Describe("Plugin", func() {
+
+	var (
+		params   plugins.Params
+		plugin   *Plugin
+		upstream *v1.Upstream
+		spec     *failover.UpstreamSpec
+		out      *envoyapi.Cluster
+	)
+
+	BeforeEach(func() {
+		primary := &v1.Upstream{
+			Metadata: core.Metadata{Name: "primary", Namespace: "gloo-system"},
+			UpstreamSpec: &v1.UpstreamSpec{
+				UpstreamType: &v1.UpstreamSpec_Kube{
+					Kube: &kubernetes.UpstreamSpec{ServiceName: "primary", ServiceNamespace: "default", ServicePort: 80},
+				},
+			},
+		}
+		secondary := &v1.Upstream{
+			Metadata: core.Metadata{Name: "secondary", Namespace: "gloo-system"},
+			UpstreamSpec: &v1.UpstreamSpec{
+				UpstreamType: &v1.UpstreamSpec_Static{
+					Static: &static.UpstreamSpec{Hosts: []*static.Host{{Addr: "function.example.com", Port: 443}}},
+				},
+			},
+		}
+		spec = &failover.UpstreamSpec{
+			Upstreams: []core.ResourceRef{primary.Metadata.Ref(), secondary.Metadata.Ref()},
+		}
+		upstream = &v1.Upstream{
+			Metadata: core.Metadata{Name: "failover", Namespace: "gloo-system"},
+			UpstreamSpec: &v1.UpstreamSpec{
+				UpstreamType: &v1.UpstreamSpec_Failover{
+					Failover: spec,
+				},
+			},
+		}
+		params = plugins.Params{
+			Snapshot: &v1.ApiSnapshot{
+				Upstreams: v1.UpstreamList{primary, secondary, upstream},
+			},
+		}
+		out = new(envoyapi.Cluster)
+		plugin = NewPlugin()
+		err := plugin.Init(plugins.InitParams{
+			Settings: &v1.Settings{EnvoyExtensions: []string{AggregateClusterName}},
+		})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("should translate the upstreams into an aggregate cluster", func() {
+		err := plugin.ProcessUpstream(params, upstream, out)
+		Expect(err).NotTo(HaveOccurred())
+
+		clusterType := out.GetClusterType()
+		Expect(clusterType).NotTo(BeNil())
+		Expect(clusterType.Name).To(Equal(AggregateClusterName))
+		var config ClusterConfig
+		err = types.UnmarshalAny(clusterType.TypedConfig, &config)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(config.Clusters).To(Equal([]string{
+			translator.UpstreamToClusterName(spec.Upstreams[0]),
+			translator.UpstreamToClusterName(spec.Upstreams[1]),
+		}))
+		// CLUSTER_PROVIDED
+		Expect(out.LbPolicy).To(Equal(envoyapi.Cluster_LbPolicy(6)))
+	})
+
+	It("should reject failover upstreams unless envoy supports aggregate clusters", func() {
+		err := plugin.Init(plugins.InitParams{Settings: &v1.Settings{}})
+		Expect(err).NotTo(HaveOccurred())
+		err = plugin.ProcessUpstream(params, upstream, out)
+		Expect(err).To(HaveOccurred())
+		Expect(out.ClusterDiscoveryType).To(BeNil())
+	})
+
+	It("should not process other upstreams", func() {
+		err := plugin.ProcessUpstream(params, params.Snapshot.Upstreams[0], out)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out.ClusterDiscoveryType).To(BeNil())
+	})
+
+	It("should not cache the failover upstreams", func() {
+		Expect(plugin.CacheableUpstream(upstream)).To(BeFalse())
+		Expect(plugin.CacheableUpstream(params.Snapshot.Upstreams[0])).To(BeTrue())
+	})
+
+	It("should reject a failover upstream without upstreams", func() {
+		spec.Upstreams = nil
+		err := plugin.ProcessUpstream(params, upstream, out)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should reject missing upstreams", func() {
+		spec.Upstreams = append(spec.Upstreams, core.ResourceRef{Name: "missing", Namespace: "gloo-system"})
+		err := plugin.ProcessUpstream(params, upstream, out)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should reject duplicate upstreams", func() {
+		spec.Upstreams = append(spec.Upstreams, spec.Upstreams[0])
+		err := plugin.ProcessUpstream(params, upstream, out)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should reject failing over to itself or to another failover upstream", func() {
+		spec.Upstreams = append(spec.Upstreams, upstream.Metadata.Ref())
+		err := plugin.ProcessUpstream(params, upstream, out)
+		Expect(err).To(HaveOccurred())
+
+		other := &v1.Upstream{
+			Metadata: core.Metadata{Name: "other", Namespace: "gloo-system"},
+			UpstreamSpec: &v1.UpstreamSpec{
+				UpstreamType: &v1.UpstreamSpec_Failover{
+					Failover: &failover.UpstreamSpec{Upstreams: []core.ResourceRef{upstream.Metadata.Ref()}},
+				},
+			},
+		}
+		params.Snapshot.Upstreams = append(params.Snapshot.Upstreams, other)
+		spec.Upstreams = []core.ResourceRef{other.Metadata.Ref()}
+		err = plugin.ProcessUpstream(params, upstream, out)
+		Expect(err).To(HaveOccurred())
+	})
+})
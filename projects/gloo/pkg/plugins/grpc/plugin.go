@@ -78,7 +78,9 @@ func (p *plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *en
 		return nil
 	}
 	grpcSpec := grpcWrapper.Grpc
-	out.Http2ProtocolOptions = &envoycore.Http2ProtocolOptions{}
+	if out.Http2ProtocolOptions == nil {
+		out.Http2ProtocolOptions = &envoycore.Http2ProtocolOptions{}
+	}
 
 	if grpcSpec == nil || len(grpcSpec.GrpcServices) == 0 {
 		// no services, this just marks the upstream as a grpc one.
@@ -110,6 +112,16 @@ func (p *plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *en
 	return nil
 }
 
+// the grpc services of the upstreams are recorded to translate the routes to them
+func (p *plugin) CacheableUpstream(in *v1.Upstream) bool {
+	withServiceSpec, ok := in.UpstreamSpec.UpstreamType.(v1.ServiceSpecGetter)
+	if !ok || withServiceSpec.GetServiceSpec() == nil {
+		return true
+	}
+	_, ours := withServiceSpec.GetServiceSpec().PluginType.(*glooplugins.ServiceSpec_Grpc)
+	return !ours
+}
+
 func genFullServiceName(packageName, serviceName string) string {
 	return packageName + "." + serviceName
 }
@@ -0,0 +1,281 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: filter.proto
+
+package gzip
+
+import (
+	fmt "fmt"
+	math "math"
+
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	proto "github.com/gogo/protobuf/proto"
+	types "github.com/gogo/protobuf/types"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+type Gzip_CompressionStrategy int32
+
+const (
+	Gzip_DEFAULT  Gzip_CompressionStrategy = 0
+	Gzip_FILTERED Gzip_CompressionStrategy = 1
+	Gzip_HUFFMAN  Gzip_CompressionStrategy = 2
+	Gzip_RLE      Gzip_CompressionStrategy = 3
+)
+
+var Gzip_CompressionStrategy_name = map[int32]string{
+	0: "DEFAULT",
+	1: "FILTERED",
+	2: "HUFFMAN",
+	3: "RLE",
+}
+
+var Gzip_CompressionStrategy_value = map[string]int32{
+	"DEFAULT":  0,
+	"FILTERED": 1,
+	"HUFFMAN":  2,
+	"RLE":      3,
+}
+
+func (x Gzip_CompressionStrategy) String() string {
+	return proto.EnumName(Gzip_CompressionStrategy_name, int32(x))
+}
+
+func (Gzip_CompressionStrategy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_1f5303cab7a20d6f, []int{0, 0}
+}
+
+type Gzip_CompressionLevel_Enum int32
+
+const (
+	Gzip_CompressionLevel_DEFAULT Gzip_CompressionLevel_Enum = 0
+	Gzip_CompressionLevel_BEST    Gzip_CompressionLevel_Enum = 1
+	Gzip_CompressionLevel_SPEED   Gzip_CompressionLevel_Enum = 2
+)
+
+var Gzip_CompressionLevel_Enum_name = map[int32]string{
+	0: "DEFAULT",
+	1: "BEST",
+	2: "SPEED",
+}
+
+var Gzip_CompressionLevel_Enum_value = map[string]int32{
+	"DEFAULT": 0,
+	"BEST":    1,
+	"SPEED":   2,
+}
+
+func (x Gzip_CompressionLevel_Enum) String() string {
+	return proto.EnumName(Gzip_CompressionLevel_Enum_name, int32(x))
+}
+
+func (Gzip_CompressionLevel_Enum) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_1f5303cab7a20d6f, []int{0, 0, 0}
+}
+
+type Gzip struct {
+	// Value from 1 to 9 that controls the amount of internal memory used by zlib. Higher values
+	// use more memory, but are faster and produce better compression results. The default value is 5.
+	MemoryLevel *types.UInt32Value `protobuf:"bytes,1,opt,name=memory_level,json=memoryLevel,proto3" json:"memory_level,omitempty"`
+	// Minimum response length, in bytes, which will trigger compression. The default value is 30.
+	ContentLength *types.UInt32Value `protobuf:"bytes,2,opt,name=content_length,json=contentLength,proto3" json:"content_length,omitempty"`
+	// A value used for selecting the zlib compression level. This setting will affect speed and
+	// amount of compression applied to the content. "BEST" provides higher compression at the cost of
+	// higher latency, "SPEED" provides lower compression with minimum impact on response time.
+	// "DEFAULT" provides an optimal result between speed and compression. This field will be set to
+	// "DEFAULT" if not specified.
+	CompressionLevel Gzip_CompressionLevel_Enum `protobuf:"varint,3,opt,name=compression_level,json=compressionLevel,proto3,enum=envoy.config.filter.http.gzip.v2.Gzip_CompressionLevel_Enum" json:"compression_level,omitempty"`
+	// A value used for selecting the zlib compression strategy which is directly related to the
+	// characteristics of the content. Most of the time "DEFAULT" will be the best choice, though
+	// there are situations which changing this parameter might produce better results. For example,
+	// run-length encoding (RLE) is typically used when the content is known for having sequences
+	// which same data occurs many consecutive times. For more information about each strategy, please
+	// refer to zlib manual.
+	CompressionStrategy Gzip_CompressionStrategy `protobuf:"varint,4,opt,name=compression_strategy,json=compressionStrategy,proto3,enum=envoy.config.filter.http.gzip.v2.Gzip_CompressionStrategy" json:"compression_strategy,omitempty"`
+	// Set of strings that allows specifying which mime-types yield compression; e.g.,
+	// application/json, text/html, etc. When this field is not defined, compression will be applied
+	// to the following mime-types: "application/javascript", "application/json",
+	// "application/xhtml+xml", "image/svg+xml", "text/css", "text/html", "text/plain", "text/xml".
+	ContentType []string `protobuf:"bytes,6,rep,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	// If true, disables compression when the response contains an etag header. When it is false, the
+	// filter will preserve weak etags and remove the ones that require strong validation.
+	DisableOnEtagHeader bool `protobuf:"varint,7,opt,name=disable_on_etag_header,json=disableOnEtagHeader,proto3" json:"disable_on_etag_header,omitempty"`
+	// If true, removes accept-encoding from the request headers before dispatching it to the upstream
+	// so that responses do not get compressed before reaching the filter.
+	RemoveAcceptEncodingHeader bool `protobuf:"varint,8,opt,name=remove_accept_encoding_header,json=removeAcceptEncodingHeader,proto3" json:"remove_accept_encoding_header,omitempty"`
+	// Value from 9 to 15 that represents the base two logarithmic of the compressor's window size.
+	// Larger window results in better compression at the expense of memory usage. The default is 12
+	// which will produce a 4096 bytes window. For more details about this parameter, please refer to
+	// zlib manual > deflateInit2.
+	WindowBits           *types.UInt32Value `protobuf:"bytes,9,opt,name=window_bits,json=windowBits,proto3" json:"window_bits,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *Gzip) Reset()         { *m = Gzip{} }
+func (m *Gzip) String() string { return proto.CompactTextString(m) }
+func (*Gzip) ProtoMessage()    {}
+func (*Gzip) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f5303cab7a20d6f, []int{0}
+}
+func (m *Gzip) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Gzip.Unmarshal(m, b)
+}
+func (m *Gzip) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Gzip.Marshal(b, m, deterministic)
+}
+func (m *Gzip) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Gzip.Merge(m, src)
+}
+func (m *Gzip) XXX_Size() int {
+	return xxx_messageInfo_Gzip.Size(m)
+}
+func (m *Gzip) XXX_DiscardUnknown() {
+	xxx_messageInfo_Gzip.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Gzip proto.InternalMessageInfo
+
+func (m *Gzip) GetMemoryLevel() *types.UInt32Value {
+	if m != nil {
+		return m.MemoryLevel
+	}
+	return nil
+}
+
+func (m *Gzip) GetContentLength() *types.UInt32Value {
+	if m != nil {
+		return m.ContentLength
+	}
+	return nil
+}
+
+func (m *Gzip) GetCompressionLevel() Gzip_CompressionLevel_Enum {
+	if m != nil {
+		return m.CompressionLevel
+	}
+	return Gzip_CompressionLevel_DEFAULT
+}
+
+func (m *Gzip) GetCompressionStrategy() Gzip_CompressionStrategy {
+	if m != nil {
+		return m.CompressionStrategy
+	}
+	return Gzip_DEFAULT
+}
+
+func (m *Gzip) GetContentType() []string {
+	if m != nil {
+		return m.ContentType
+	}
+	return nil
+}
+
+func (m *Gzip) GetDisableOnEtagHeader() bool {
+	if m != nil {
+		return m.DisableOnEtagHeader
+	}
+	return false
+}
+
+func (m *Gzip) GetRemoveAcceptEncodingHeader() bool {
+	if m != nil {
+		return m.RemoveAcceptEncodingHeader
+	}
+	return false
+}
+
+func (m *Gzip) GetWindowBits() *types.UInt32Value {
+	if m != nil {
+		return m.WindowBits
+	}
+	return nil
+}
+
+type Gzip_CompressionLevel struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Gzip_CompressionLevel) Reset()         { *m = Gzip_CompressionLevel{} }
+func (m *Gzip_CompressionLevel) String() string { return proto.CompactTextString(m) }
+func (*Gzip_CompressionLevel) ProtoMessage()    {}
+func (*Gzip_CompressionLevel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f5303cab7a20d6f, []int{0, 0}
+}
+func (m *Gzip_CompressionLevel) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Gzip_CompressionLevel.Unmarshal(m, b)
+}
+func (m *Gzip_CompressionLevel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Gzip_CompressionLevel.Marshal(b, m, deterministic)
+}
+func (m *Gzip_CompressionLevel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Gzip_CompressionLevel.Merge(m, src)
+}
+func (m *Gzip_CompressionLevel) XXX_Size() int {
+	return xxx_messageInfo_Gzip_CompressionLevel.Size(m)
+}
+func (m *Gzip_CompressionLevel) XXX_DiscardUnknown() {
+	xxx_messageInfo_Gzip_CompressionLevel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Gzip_CompressionLevel proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterEnum("envoy.config.filter.http.gzip.v2.Gzip_CompressionStrategy", Gzip_CompressionStrategy_name, Gzip_CompressionStrategy_value)
+	proto.RegisterEnum("envoy.config.filter.http.gzip.v2.Gzip_CompressionLevel_Enum", Gzip_CompressionLevel_Enum_name, Gzip_CompressionLevel_Enum_value)
+	proto.RegisterType((*Gzip)(nil), "envoy.config.filter.http.gzip.v2.Gzip")
+	proto.RegisterType((*Gzip_CompressionLevel)(nil), "envoy.config.filter.http.gzip.v2.Gzip.CompressionLevel")
+}
+
+func init() { proto.RegisterFile("filter.proto", fileDescriptor_1f5303cab7a20d6f) }
+
+var fileDescriptor_1f5303cab7a20d6f = []byte{
+	// 547 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0xcd, 0x6e, 0xd3, 0x40,
+	0x14, 0x85, 0xb1, 0xe3, 0xa6, 0xf1, 0x38, 0x14, 0x33, 0x45, 0x60, 0x45, 0x50, 0x45, 0x5d, 0x59,
+	0x95, 0x3a, 0x96, 0x52, 0x09, 0x21, 0xd4, 0x4d, 0x42, 0x1d, 0x5a, 0x64, 0x0a, 0x72, 0x53, 0x16,
+	0x6c, 0x2c, 0xc7, 0xb9, 0x9d, 0x0c, 0xd8, 0x33, 0x96, 0x3d, 0x4e, 0xe5, 0x2e, 0x79, 0x01, 0x24,
+	0x1e, 0x87, 0x15, 0xaf, 0xc3, 0x5b, 0x20, 0xff, 0x04, 0x68, 0x41, 0x82, 0xee, 0x6c, 0x9d, 0xf3,
+	0x9d, 0x33, 0x73, 0xaf, 0x8d, 0xfa, 0x17, 0x2c, 0x96, 0x90, 0x91, 0x34, 0x13, 0x52, 0xe0, 0x21,
+	0xf0, 0x95, 0x28, 0x49, 0x24, 0xf8, 0x05, 0xa3, 0xa4, 0x95, 0x96, 0x52, 0xa6, 0x84, 0x5e, 0xb1,
+	0x94, 0xac, 0x46, 0x83, 0x1d, 0x2a, 0x04, 0x8d, 0xc1, 0xa9, 0xfd, 0xf3, 0xe2, 0xc2, 0xb9, 0xcc,
+	0xc2, 0x34, 0x85, 0x2c, 0x6f, 0x12, 0x06, 0x8f, 0x56, 0x61, 0xcc, 0x16, 0xa1, 0x04, 0x67, 0xfd,
+	0xd0, 0x08, 0xbb, 0x9f, 0xbb, 0x48, 0x7b, 0x79, 0xc5, 0x52, 0xec, 0xa1, 0x7e, 0x02, 0x89, 0xc8,
+	0xca, 0x20, 0x86, 0x15, 0xc4, 0x96, 0x32, 0x54, 0x6c, 0x63, 0xf4, 0x98, 0x34, 0xc1, 0x64, 0x1d,
+	0x4c, 0xce, 0x4f, 0xb8, 0x3c, 0x18, 0xbd, 0x0b, 0xe3, 0x02, 0x26, 0xc6, 0xd7, 0xef, 0xdf, 0x3a,
+	0xdd, 0x3d, 0xcd, 0xd2, 0x6d, 0xc5, 0x37, 0x1a, 0xdc, 0xab, 0x68, 0x7c, 0x8a, 0xb6, 0x22, 0xc1,
+	0x25, 0x70, 0x19, 0xc4, 0xc0, 0xa9, 0x5c, 0x5a, 0xea, 0x7f, 0xe4, 0xe9, 0x55, 0x9e, 0xb6, 0xa7,
+	0xda, 0x3b, 0xfe, 0xdd, 0x16, 0xf7, 0x6a, 0x1a, 0x17, 0xe8, 0x7e, 0x24, 0x92, 0x34, 0x83, 0x3c,
+	0x67, 0x82, 0xb7, 0x47, 0xec, 0x0c, 0x15, 0x7b, 0x6b, 0x74, 0x48, 0xfe, 0x35, 0x1d, 0x52, 0x5d,
+	0x90, 0xbc, 0xf8, 0xc5, 0xd7, 0x67, 0x24, 0x2e, 0x2f, 0x92, 0x09, 0xaa, 0x2a, 0x37, 0x3e, 0x29,
+	0xaa, 0xa9, 0xf8, 0x66, 0x74, 0xc3, 0x82, 0x4b, 0xf4, 0xe0, 0xf7, 0xda, 0x5c, 0x66, 0xa1, 0x04,
+	0x5a, 0x5a, 0x5a, 0xdd, 0xfc, 0xfc, 0xf6, 0xcd, 0x67, 0x6d, 0xc2, 0xb5, 0xde, 0xed, 0xe8, 0x4f,
+	0x03, 0xde, 0x47, 0xfd, 0xf5, 0x04, 0x65, 0x99, 0x82, 0xd5, 0x1d, 0x76, 0x6c, 0xbd, 0xc5, 0xbe,
+	0x28, 0xaa, 0x39, 0xf2, 0x8d, 0x56, 0x9f, 0x95, 0x29, 0xe0, 0x03, 0xf4, 0x70, 0xc1, 0xf2, 0x70,
+	0x1e, 0x43, 0x20, 0x78, 0x00, 0x32, 0xa4, 0xc1, 0x12, 0xc2, 0x05, 0x64, 0xd6, 0xe6, 0x50, 0xb1,
+	0x7b, 0xfe, 0x76, 0xab, 0xbe, 0xe1, 0xae, 0x0c, 0xe9, 0x71, 0x2d, 0xe1, 0x31, 0x7a, 0x92, 0x41,
+	0x22, 0x56, 0x10, 0x84, 0x51, 0x04, 0xa9, 0x0c, 0x80, 0x47, 0x62, 0xc1, 0xf8, 0x4f, 0xb6, 0x57,
+	0xb3, 0x83, 0xc6, 0x34, 0xae, 0x3d, 0x6e, 0x6b, 0x69, 0x23, 0x5e, 0x21, 0xe3, 0x92, 0xf1, 0x85,
+	0xb8, 0x0c, 0xe6, 0x4c, 0xe6, 0x96, 0x7e, 0x9b, 0xaf, 0xe6, 0x9e, 0xad, 0xfb, 0xa8, 0xa1, 0x27,
+	0x4c, 0xe6, 0x83, 0x43, 0x64, 0xde, 0x5c, 0xd2, 0xae, 0x8d, 0xb4, 0x6a, 0x4f, 0xd8, 0x40, 0x9b,
+	0x47, 0xee, 0x74, 0x7c, 0xee, 0xcd, 0xcc, 0x3b, 0xb8, 0x87, 0xb4, 0x89, 0x7b, 0x36, 0x33, 0x15,
+	0xac, 0xa3, 0x8d, 0xb3, 0xb7, 0xae, 0x7b, 0x64, 0xaa, 0xbb, 0x53, 0xb4, 0xfd, 0x97, 0x41, 0x5f,
+	0x07, 0xfb, 0xa8, 0x37, 0x3d, 0xf1, 0x66, 0xae, 0xef, 0x1e, 0x99, 0x4a, 0x25, 0x1d, 0x9f, 0x4f,
+	0xa7, 0xaf, 0xc7, 0xa7, 0xa6, 0x8a, 0x37, 0x51, 0xc7, 0xf7, 0x5c, 0xb3, 0x33, 0x79, 0xf6, 0xfe,
+	0x29, 0x65, 0x72, 0x59, 0xcc, 0x49, 0x24, 0x12, 0x27, 0x17, 0xb1, 0xd8, 0x67, 0xc2, 0xa1, 0xb1,
+	0x10, 0xd5, 0xdf, 0xf5, 0x01, 0x22, 0x99, 0xb7, 0x6f, 0x1f, 0xa9, 0x93, 0xc6, 0x05, 0x65, 0x3c,
+	0x77, 0xaa, 0x7d, 0xcf, 0xbb, 0xf5, 0x75, 0x0f, 0x7e, 0x0c, 0x00, 0x45, 0x4d, 0x28, 0x4d, 0xbd,
+	0x03, 0x00, 0x00,
+}
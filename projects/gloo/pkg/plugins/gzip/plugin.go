@@ -0,0 +1,110 @@
+package gzip
+
+import (
+	envoycore "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
+	envoyroute "github.com/envoyproxy/go-control-plane/envoy/api/v2/route"
+	envoyutil "github.com/envoyproxy/go-control-plane/pkg/util"
+	types "github.com/gogo/protobuf/types"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/gzip"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/solo-kit/pkg/errors"
+)
+
+//go:generate protoc -I$GOPATH/src/github.com/envoyproxy/protoc-gen-validate -I. -I$GOPATH/src/github.com/gogo/protobuf/protobuf --gogo_out=${GOPATH}/src/ filter.proto
+
+const (
+	FilterName = envoyutil.Gzip
+	// the filters of the first stage encode the responses last, compressing the bodies the other filters produce
+	pluginStage = plugins.FaultFilter
+
+	// the limits of the gzip filter of envoy
+	minContentLength = 30
+	maxContentTypes  = 50
+
+	// the gzip filter does not compress the responses whose cache-control header has the no-transform directive.
+	// there is no per route config of the filter, and the directive reaches the clients, which then do not transform
+	// the responses either.
+	cacheControlHeader = "cache-control"
+	noTransform        = "no-transform"
+)
+
+var compressionLevels = map[gzip.Gzip_CompressionLevel]Gzip_CompressionLevel_Enum{
+	gzip.Gzip_DEFAULT: Gzip_CompressionLevel_DEFAULT,
+	gzip.Gzip_BEST:    Gzip_CompressionLevel_BEST,
+	gzip.Gzip_SPEED:   Gzip_CompressionLevel_SPEED,
+}
+
+type Plugin struct{}
+
+var _ plugins.RoutePlugin = NewPlugin()
+var _ plugins.HttpFilterPlugin = NewPlugin()
+
+func NewPlugin() *Plugin {
+	return &Plugin{}
+}
+
+func (p *Plugin) Init(params plugins.InitParams) error {
+	return nil
+}
+
+func (p *Plugin) ProcessRoute(params plugins.Params, in *v1.Route, out *envoyroute.Route) error {
+	if !in.GetRoutePlugins().GetGzip().GetDisabled() {
+		return nil
+	}
+	out.ResponseHeadersToAdd = append(out.ResponseHeadersToAdd, &envoycore.HeaderValueOption{
+		Header: &envoycore.HeaderValue{
+			Key:   cacheControlHeader,
+			Value: noTransform,
+		},
+		// keep the directives of the upstream
+		Append: &types.BoolValue{Value: true},
+	})
+	return nil
+}
+
+func (p *Plugin) HttpFilters(params plugins.Params, listener *v1.HttpListener) ([]plugins.StagedHttpFilter, error) {
+	cfg := listener.GetListenerPlugins().GetGzip()
+	if cfg == nil {
+		// no compression no filter
+		return nil, nil
+	}
+
+	config, err := filterConfig(cfg)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid gzip configuration")
+	}
+	stagedFilter, err := plugins.NewStagedFilterWithConfig(FilterName, config, pluginStage)
+	if err != nil {
+		return nil, err
+	}
+	return []plugins.StagedHttpFilter{stagedFilter}, nil
+}
+
+func filterConfig(cfg *gzip.Gzip) (*Gzip, error) {
+	if length := cfg.MinContentLength; length != nil && length.Value < minContentLength {
+		return nil, errors.Errorf("the minimum content length must be at least %d bytes, found %d",
+			minContentLength, length.Value)
+	}
+	if len(cfg.ContentTypes) > maxContentTypes {
+		return nil, errors.Errorf("at most %d content types can be compressed, found %d",
+			maxContentTypes, len(cfg.ContentTypes))
+	}
+	for _, contentType := range cfg.ContentTypes {
+		if contentType == "" {
+			return nil, errors.Errorf("the content types cannot be empty")
+		}
+	}
+	level, ok := compressionLevels[cfg.CompressionLevel]
+	if !ok {
+		return nil, errors.Errorf("unknown compression level %v", cfg.CompressionLevel)
+	}
+
+	return &Gzip{
+		ContentLength:              cfg.MinContentLength,
+		ContentType:                cfg.ContentTypes,
+		DisableOnEtagHeader:        cfg.DisableOnEtagHeader,
+		RemoveAcceptEncodingHeader: cfg.RemoveAcceptEncodingHeader,
+		CompressionLevel:           level,
+	}, nil
+}
@@ -0,0 +1,116 @@
+package gzip_test
+
+import (
+	envoycore "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
+	envoyroute "github.com/envoyproxy/go-control-plane/envoy/api/v2/route"
+	"github.com/envoyproxy/go-control-plane/pkg/util"
+	envoyutil "github.com/envoyproxy/go-control-plane/pkg/util"
+	types "github.com/gogo/protobuf/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/gzip"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	. "github.com/solo-io/gloo/projects/gloo/pkg/plugins/gzip"
+)
+
+var _ = Describe("Plugin", func() {
+	var (
+		plugin   *Plugin
+		listener *v1.HttpListener
+	)
+
+	BeforeEach(func() {
+		plugin = NewPlugin()
+		Expect(plugin.Init(plugins.InitParams{})).NotTo(HaveOccurred())
+		listener = &v1.HttpListener{
+			ListenerPlugins: &v1.ListenerPlugins{
+				Gzip: &gzip.Gzip{},
+			},
+		}
+	})
+
+	filterConfig := func() *Gzip {
+		filters, err := plugin.HttpFilters(plugins.Params{}, listener)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(filters).To(HaveLen(1))
+		Expect(filters[0].HttpFilter.Name).To(Equal(envoyutil.Gzip))
+		Expect(filters[0].Stage).To(Equal(plugins.FaultFilter))
+		var config Gzip
+		err = util.StructToMessage(filters[0].HttpFilter.GetConfig(), &config)
+		Expect(err).NotTo(HaveOccurred())
+		return &config
+	}
+
+	It("adds no filter without gzip configuration", func() {
+		filters, err := plugin.HttpFilters(plugins.Params{}, &v1.HttpListener{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(filters).To(BeEmpty())
+	})
+
+	It("compresses with the defaults of envoy", func() {
+		Expect(filterConfig()).To(Equal(&Gzip{}))
+	})
+
+	It("copies the configuration of the listener", func() {
+		listener.ListenerPlugins.Gzip = &gzip.Gzip{
+			MinContentLength:           &types.UInt32Value{Value: 1024},
+			ContentTypes:               []string{"application/json", "text/html"},
+			DisableOnEtagHeader:        true,
+			RemoveAcceptEncodingHeader: true,
+			CompressionLevel:           gzip.Gzip_SPEED,
+		}
+		Expect(filterConfig()).To(Equal(&Gzip{
+			ContentLength:              &types.UInt32Value{Value: 1024},
+			ContentType:                []string{"application/json", "text/html"},
+			DisableOnEtagHeader:        true,
+			RemoveAcceptEncodingHeader: true,
+			CompressionLevel:           Gzip_CompressionLevel_SPEED,
+		}))
+	})
+
+	It("rejects minimum content lengths below 30 bytes", func() {
+		listener.ListenerPlugins.Gzip.MinContentLength = &types.UInt32Value{Value: 29}
+		_, err := plugin.HttpFilters(plugins.Params{}, listener)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("rejects empty content types", func() {
+		listener.ListenerPlugins.Gzip.ContentTypes = []string{""}
+		_, err := plugin.HttpFilters(plugins.Params{}, listener)
+		Expect(err).To(HaveOccurred())
+	})
+
+	Context("routes", func() {
+		var (
+			in  *v1.Route
+			out *envoyroute.Route
+		)
+
+		BeforeEach(func() {
+			in = &v1.Route{
+				RoutePlugins: &v1.RoutePlugins{
+					Gzip: &gzip.RouteGzip{},
+				},
+			}
+			out = &envoyroute.Route{}
+		})
+
+		It("does not change the routes compressing their responses", func() {
+			Expect(plugin.ProcessRoute(plugins.Params{}, in, out)).NotTo(HaveOccurred())
+			Expect(out.ResponseHeadersToAdd).To(BeEmpty())
+		})
+
+		It("adds the no-transform directive to the responses of the routes disabling compression", func() {
+			in.RoutePlugins.Gzip.Disabled = true
+			Expect(plugin.ProcessRoute(plugins.Params{}, in, out)).NotTo(HaveOccurred())
+			Expect(out.ResponseHeadersToAdd).To(Equal([]*envoycore.HeaderValueOption{{
+				Header: &envoycore.HeaderValue{
+					Key:   "cache-control",
+					Value: "no-transform",
+				},
+				Append: &types.BoolValue{Value: true},
+			}}))
+		})
+	})
+})
@@ -0,0 +1,195 @@
+package healthcheck
+
+import (
+	"encoding/hex"
+	"net"
+
+	envoyapi "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	envoycluster "github.com/envoyproxy/go-control-plane/envoy/api/v2/cluster"
+	envoycore "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
+	types "github.com/gogo/protobuf/types"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/solo-kit/pkg/errors"
+)
+
+const (
+	// the default of gloo for the thresholds, which envoy requires
+	defaultThreshold = 1
+	maxPercent       = 100
+)
+
+type Plugin struct{}
+
+func NewPlugin() *Plugin {
+	return &Plugin{}
+}
+
+func (p *Plugin) Init(params plugins.InitParams) error {
+	return nil
+}
+
+// must run after the plugins that set the endpoints of the cluster, as the host of the http health checks defaults
+// to the hostname of the single endpoint of the cluster
+func (p *Plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *envoyapi.Cluster) error {
+	healthChecks := in.GetUpstreamSpec().GetHealthChecks()
+	if len(healthChecks) > 0 {
+		out.HealthChecks = nil
+		for i, hc := range healthChecks {
+			envoyHealthCheck, err := convertHealthCheck(hc, singleHostname(out))
+			if err != nil {
+				return errors.Wrapf(err, "invalid health check %d of upstream %v", i, in.Metadata.Ref())
+			}
+			out.HealthChecks = append(out.HealthChecks, envoyHealthCheck)
+		}
+	}
+
+	if outlierDetection := in.GetUpstreamSpec().GetOutlierDetection(); outlierDetection != nil {
+		if err := validateOutlierDetection(outlierDetection); err != nil {
+			return errors.Wrapf(err, "invalid outlier detection of upstream %v", in.Metadata.Ref())
+		}
+		out.OutlierDetection = convertOutlierDetection(outlierDetection)
+	}
+	return nil
+}
+
+// the cluster only depends on the health checks and outlier detection of the upstream, and on the endpoints set by
+// the other plugins
+func (p *Plugin) CacheableUpstream(in *v1.Upstream) bool {
+	return true
+}
+
+func convertHealthCheck(hc *v1.HealthCheck, hostname string) (*envoycore.HealthCheck, error) {
+	if hc.Timeout == nil || hc.Interval == nil {
+		return nil, errors.Errorf("timeout and interval must be set")
+	}
+	out := &envoycore.HealthCheck{
+		Timeout:            hc.Timeout,
+		Interval:           hc.Interval,
+		UnhealthyThreshold: threshold(hc.UnhealthyThreshold),
+		HealthyThreshold:   threshold(hc.HealthyThreshold),
+	}
+	if hc.IntervalJitter != nil {
+		out.IntervalJitter = types.DurationProto(*hc.IntervalJitter)
+	}
+
+	switch checker := hc.HealthChecker.(type) {
+	case *v1.HealthCheck_HttpHealthCheck_:
+		if checker.HttpHealthCheck.Path == "" {
+			return nil, errors.Errorf("the path of an http health check must be set")
+		}
+		host := checker.HttpHealthCheck.Host
+		if host == "" {
+			host = hostname
+		}
+		out.HealthChecker = &envoycore.HealthCheck_HttpHealthCheck_{
+			HttpHealthCheck: &envoycore.HealthCheck_HttpHealthCheck{
+				Host:     host,
+				Path:     checker.HttpHealthCheck.Path,
+				UseHttp2: checker.HttpHealthCheck.UseHttp2,
+			},
+		}
+	case *v1.HealthCheck_TcpHealthCheck_:
+		tcp := &envoycore.HealthCheck_TcpHealthCheck{}
+		if checker.TcpHealthCheck.Send != "" {
+			send, err := hexPayload(checker.TcpHealthCheck.Send)
+			if err != nil {
+				return nil, err
+			}
+			tcp.Send = send
+		}
+		for _, receive := range checker.TcpHealthCheck.Receive {
+			payload, err := hexPayload(receive)
+			if err != nil {
+				return nil, err
+			}
+			tcp.Receive = append(tcp.Receive, payload)
+		}
+		out.HealthChecker = &envoycore.HealthCheck_TcpHealthCheck_{
+			TcpHealthCheck: tcp,
+		}
+	case *v1.HealthCheck_GrpcHealthCheck_:
+		out.HealthChecker = &envoycore.HealthCheck_GrpcHealthCheck_{
+			GrpcHealthCheck: &envoycore.HealthCheck_GrpcHealthCheck{
+				ServiceName: checker.GrpcHealthCheck.ServiceName,
+				Authority:   checker.GrpcHealthCheck.Authority,
+			},
+		}
+	default:
+		return nil, errors.Errorf("the kind of health check must be set")
+	}
+	return out, nil
+}
+
+func threshold(in *types.UInt32Value) *types.UInt32Value {
+	if in != nil {
+		return in
+	}
+	return &types.UInt32Value{Value: defaultThreshold}
+}
+
+// envoy rejects the whole cluster when a payload is not hex encoded
+func hexPayload(payload string) (*envoycore.HealthCheck_Payload, error) {
+	if _, err := hex.DecodeString(payload); err != nil {
+		return nil, errors.Wrapf(err, "the tcp health check payload %v is not hex encoded", payload)
+	}
+	return &envoycore.HealthCheck_Payload{
+		Payload: &envoycore.HealthCheck_Payload_Text{
+			Text: payload,
+		},
+	}, nil
+}
+
+// the ranges allowed by envoy, which rejects the whole cluster otherwise
+func validateOutlierDetection(od *v1.OutlierDetection) error {
+	if percent := od.MaxEjectionPercent; percent != nil && percent.Value > maxPercent {
+		return errors.Errorf("max ejection percent must be between 0 and %d, got %d", maxPercent, percent.Value)
+	}
+	if percent := od.EnforcingConsecutive_5Xx; percent != nil && percent.Value > maxPercent {
+		return errors.Errorf("enforcing consecutive 5xx must be between 0 and %d, got %d", maxPercent, percent.Value)
+	}
+	if percent := od.EnforcingSuccessRate; percent != nil && percent.Value > maxPercent {
+		return errors.Errorf("enforcing success rate must be between 0 and %d, got %d", maxPercent, percent.Value)
+	}
+	if od.Interval != nil && *od.Interval <= 0 {
+		return errors.Errorf("interval must be positive, got %v", *od.Interval)
+	}
+	if od.BaseEjectionTime != nil && *od.BaseEjectionTime <= 0 {
+		return errors.Errorf("base ejection time must be positive, got %v", *od.BaseEjectionTime)
+	}
+	return nil
+}
+
+func convertOutlierDetection(od *v1.OutlierDetection) *envoycluster.OutlierDetection {
+	out := &envoycluster.OutlierDetection{
+		Consecutive_5Xx:          od.Consecutive_5Xx,
+		MaxEjectionPercent:       od.MaxEjectionPercent,
+		EnforcingConsecutive_5Xx: od.EnforcingConsecutive_5Xx,
+		EnforcingSuccessRate:     od.EnforcingSuccessRate,
+		SuccessRateMinimumHosts:  od.SuccessRateMinimumHosts,
+		SuccessRateRequestVolume: od.SuccessRateRequestVolume,
+		SuccessRateStdevFactor:   od.SuccessRateStdevFactor,
+	}
+	if od.Interval != nil {
+		out.Interval = types.DurationProto(*od.Interval)
+	}
+	if od.BaseEjectionTime != nil {
+		out.BaseEjectionTime = types.DurationProto(*od.BaseEjectionTime)
+	}
+	return out
+}
+
+// the hostname of the cluster when it has a single endpoint that is not an ip, e.g. the region of a function
+// provider, so that the health check requests reach the same virtual host as the requests. Empty otherwise, in which
+// case envoy uses the name of the cluster.
+func singleHostname(out *envoyapi.Cluster) string {
+	endpoints := out.GetLoadAssignment().GetEndpoints()
+	if len(endpoints) != 1 || len(endpoints[0].LbEndpoints) != 1 {
+		return ""
+	}
+	address := endpoints[0].LbEndpoints[0].GetEndpoint().GetAddress().GetSocketAddress().GetAddress()
+	if net.ParseIP(address) != nil {
+		return ""
+	}
+	return address
+}
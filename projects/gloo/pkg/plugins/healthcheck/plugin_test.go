@@ -0,0 +1,180 @@
+package healthcheck_test
+
+import (
+	"time"
+
+	envoyapi "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	envoycluster "github.com/envoyproxy/go-control-plane/envoy/api/v2/cluster"
+	envoycore "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
+	types "github.com/gogo/protobuf/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	. "github.com/solo-io/gloo/projects/gloo/pkg/plugins/healthcheck"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/pluginutils"
+)
+
+var _ = Describe("Plugin", func() {
+
+	var (
+		params       plugins.Params
+		plugin       *Plugin
+		upstream     *v1.Upstream
+		upstreamSpec *v1.UpstreamSpec
+		healthCheck  *v1.HealthCheck
+		out          *envoyapi.Cluster
+	)
+	BeforeEach(func() {
+		out = &envoyapi.Cluster{Name: "cluster"}
+
+		params = plugins.Params{}
+		timeout := time.Second
+		interval := 10 * time.Second
+		healthCheck = &v1.HealthCheck{
+			Timeout:  &timeout,
+			Interval: &interval,
+			HealthChecker: &v1.HealthCheck_HttpHealthCheck_{
+				HttpHealthCheck: &v1.HealthCheck_HttpHealthCheck{
+					Path: "/health",
+				},
+			},
+		}
+		upstreamSpec = &v1.UpstreamSpec{
+			HealthChecks: []*v1.HealthCheck{healthCheck},
+		}
+		upstream = &v1.Upstream{
+			UpstreamSpec: upstreamSpec,
+		}
+		plugin = NewPlugin()
+	})
+
+	It("should not set health checks when none are provided", func() {
+		upstreamSpec.HealthChecks = nil
+		err := plugin.ProcessUpstream(params, upstream, out)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out.HealthChecks).To(BeNil())
+	})
+
+	It("should set http health checks", func() {
+		jitter := time.Second
+		healthCheck.IntervalJitter = &jitter
+		healthCheck.UnhealthyThreshold = &types.UInt32Value{Value: 3}
+
+		err := plugin.ProcessUpstream(params, upstream, out)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out.HealthChecks).To(HaveLen(1))
+		hc := out.HealthChecks[0]
+		Expect(*hc.Timeout).To(Equal(time.Second))
+		Expect(*hc.Interval).To(Equal(10 * time.Second))
+		Expect(hc.IntervalJitter).To(Equal(types.DurationProto(time.Second)))
+		Expect(hc.UnhealthyThreshold.Value).To(BeEquivalentTo(3))
+		Expect(hc.HealthyThreshold.Value).To(BeEquivalentTo(1))
+		Expect(hc.GetHttpHealthCheck().Path).To(Equal("/health"))
+		Expect(hc.GetHttpHealthCheck().Host).To(BeEmpty())
+	})
+
+	It("should default the host of http health checks to the hostname of a single endpoint", func() {
+		pluginutils.EnvoySingleEndpointLoadAssignment(out, "lambda.us-east-1.amazonaws.com", 443)
+		err := plugin.ProcessUpstream(params, upstream, out)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out.HealthChecks[0].GetHttpHealthCheck().Host).To(Equal("lambda.us-east-1.amazonaws.com"))
+	})
+
+	It("should not default the host of http health checks to an ip", func() {
+		pluginutils.EnvoySingleEndpointLoadAssignment(out, "1.2.3.4", 80)
+		err := plugin.ProcessUpstream(params, upstream, out)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out.HealthChecks[0].GetHttpHealthCheck().Host).To(BeEmpty())
+	})
+
+	It("should set tcp health checks", func() {
+		healthCheck.HealthChecker = &v1.HealthCheck_TcpHealthCheck_{
+			TcpHealthCheck: &v1.HealthCheck_TcpHealthCheck{
+				Send:    "50494e47",
+				Receive: []string{"504f4e47"},
+			},
+		}
+		err := plugin.ProcessUpstream(params, upstream, out)
+		Expect(err).NotTo(HaveOccurred())
+		tcp := out.HealthChecks[0].GetTcpHealthCheck()
+		Expect(tcp.Send.GetText()).To(Equal("50494e47"))
+		Expect(tcp.Receive).To(Equal([]*envoycore.HealthCheck_Payload{{
+			Payload: &envoycore.HealthCheck_Payload_Text{Text: "504f4e47"},
+		}}))
+	})
+
+	It("should set grpc health checks", func() {
+		healthCheck.HealthChecker = &v1.HealthCheck_GrpcHealthCheck_{
+			GrpcHealthCheck: &v1.HealthCheck_GrpcHealthCheck{
+				ServiceName: "svc",
+			},
+		}
+		err := plugin.ProcessUpstream(params, upstream, out)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out.HealthChecks[0].GetGrpcHealthCheck().ServiceName).To(Equal("svc"))
+	})
+
+	It("should error on a tcp payload that is not hex encoded", func() {
+		healthCheck.HealthChecker = &v1.HealthCheck_TcpHealthCheck_{
+			TcpHealthCheck: &v1.HealthCheck_TcpHealthCheck{
+				Send: "PING",
+			},
+		}
+		err := plugin.ProcessUpstream(params, upstream, out)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should error without a timeout", func() {
+		healthCheck.Timeout = nil
+		err := plugin.ProcessUpstream(params, upstream, out)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should error without a kind of health check", func() {
+		healthCheck.HealthChecker = nil
+		err := plugin.ProcessUpstream(params, upstream, out)
+		Expect(err).To(HaveOccurred())
+	})
+
+	Context("outlier detection", func() {
+		BeforeEach(func() {
+			upstreamSpec.HealthChecks = nil
+		})
+
+		It("should set outlier detection", func() {
+			interval := 5 * time.Second
+			upstreamSpec.OutlierDetection = &v1.OutlierDetection{
+				Consecutive_5Xx:    &types.UInt32Value{Value: 3},
+				Interval:           &interval,
+				MaxEjectionPercent: &types.UInt32Value{Value: 50},
+			}
+			err := plugin.ProcessUpstream(params, upstream, out)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.HealthChecks).To(BeNil())
+			Expect(out.OutlierDetection).To(Equal(&envoycluster.OutlierDetection{
+				Consecutive_5Xx:    &types.UInt32Value{Value: 3},
+				Interval:           types.DurationProto(interval),
+				MaxEjectionPercent: &types.UInt32Value{Value: 50},
+			}))
+		})
+
+		It("should error on a percent above 100", func() {
+			upstreamSpec.OutlierDetection = &v1.OutlierDetection{
+				EnforcingSuccessRate: &types.UInt32Value{Value: 101},
+			}
+			err := plugin.ProcessUpstream(params, upstream, out)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should error on a zero base ejection time", func() {
+			var zero time.Duration
+			upstreamSpec.OutlierDetection = &v1.OutlierDetection{
+				BaseEjectionTime: &zero,
+			}
+			err := plugin.ProcessUpstream(params, upstream, out)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
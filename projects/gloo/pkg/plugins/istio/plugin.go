@@ -0,0 +1,42 @@
+package istio
+
+import (
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/go-utils/kubeutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"k8s.io/client-go/dynamic"
+)
+
+// the istio plugin discovers upstreams for the istio service entries, so the egress services defined for istio
+// can be routed to without defining them again.
+// the discovered upstreams are static upstreams, translated by the static plugin.
+type plugin struct {
+	// the clients vendored here predate istio, the service entries are read as unstructured objects
+	dynamic dynamic.Interface
+}
+
+func NewPlugin() plugins.Plugin {
+	return &plugin{}
+}
+
+func (p *plugin) Init(params plugins.InitParams) error {
+	return nil
+}
+
+func (p *plugin) tryGetClient() error {
+	if p.dynamic != nil {
+		return nil
+	}
+	cfg, err := kubeutils.GetConfig("", "")
+	if err != nil {
+		return err
+	}
+	p.dynamic, err = dynamic.NewForConfig(cfg)
+	return err
+}
+
+func (p *plugin) WatchEndpoints(writeNamespace string, upstreamsToTrack v1.UpstreamList, opts clients.WatchOpts) (<-chan v1.EndpointList, <-chan error, error) {
+	// static upstreams resolve their hosts with dns, there are no endpoints to discover
+	return nil, nil, nil
+}
@@ -0,0 +1,60 @@
+package istio
+
+import (
+	"github.com/solo-io/solo-kit/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var serviceEntriesResource = schema.GroupVersionResource{Group: "networking.istio.io", Version: "v1alpha3", Resource: "serviceentries"}
+
+// the resolutions of the service entries
+const (
+	ResolutionNone   = "NONE"
+	ResolutionStatic = "STATIC"
+	ResolutionDNS    = "DNS"
+)
+
+// ServiceEntry holds the fields of the istio service entries read by the discovery
+type ServiceEntry struct {
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ServiceEntrySpec `json:"spec"`
+}
+
+type ServiceEntrySpec struct {
+	// the hosts of the service, may be wildcards
+	Hosts []string `json:"hosts"`
+	Ports []Port   `json:"ports"`
+	// how the addresses of the service are found, NONE if empty
+	Resolution string `json:"resolution,omitempty"`
+	// the addresses of the service, its hosts are resolved if none are given with the DNS resolution
+	Endpoints []WorkloadEntry `json:"endpoints,omitempty"`
+}
+
+type Port struct {
+	Number     uint32 `json:"number"`
+	Protocol   string `json:"protocol,omitempty"`
+	Name       string `json:"name,omitempty"`
+	TargetPort uint32 `json:"targetPort,omitempty"`
+}
+
+type WorkloadEntry struct {
+	Address string `json:"address"`
+	// the ports of the endpoint by port name, the ports of the service entry are used if missing
+	Ports map[string]uint32 `json:"ports,omitempty"`
+}
+
+func serviceEntryFromUnstructured(obj interface{}) (*ServiceEntry, error) {
+	u, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return nil, errors.Errorf("internal error: expected *unstructured.Unstructured, got %T", obj)
+	}
+	var serviceEntry ServiceEntry
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &serviceEntry); err != nil {
+		return nil, errors.Wrapf(err, "converting service entry %v.%v", u.GetNamespace(), u.GetName())
+	}
+	return &serviceEntry, nil
+}
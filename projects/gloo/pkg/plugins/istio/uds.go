@@ -0,0 +1,247 @@
+package istio
+
+import (
+	"crypto/md5"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/solo-io/gloo/pkg/utils"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/static"
+	"github.com/solo-io/gloo/projects/gloo/pkg/discovery"
+	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/kube/controller"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/solo-io/solo-kit/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/tools/cache"
+)
+
+var _ discovery.DiscoveryPlugin = new(plugin)
+
+func (p *plugin) DiscoverUpstreams(watchNamespaces []string, writeNamespace string, opts clients.WatchOpts, discOpts discovery.Opts) (chan v1.UpstreamList, chan error, error) {
+	if err := p.tryGetClient(); err != nil {
+		return nil, nil, err
+	}
+	ctx := contextutils.WithLogger(opts.Ctx, "istio-uds")
+	logger := contextutils.LoggerFrom(ctx)
+
+	serviceEntries := p.dynamic.Resource(serviceEntriesResource).Namespace(metav1.NamespaceAll)
+	if _, err := serviceEntries.List(metav1.ListOptions{Limit: 1}); err != nil {
+		return nil, nil, errors.Wrapf(err, "listing istio service entries, is istio installed?")
+	}
+
+	informer := cache.NewSharedIndexInformer(
+		&cache.ListWatch{
+			ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+				return serviceEntries.List(options)
+			},
+			WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+				return serviceEntries.Watch(options)
+			},
+		},
+		&unstructured.Unstructured{},
+		12*time.Hour,
+		cache.Indexers{},
+	)
+
+	updated := make(chan struct{}, 1)
+	istioController := controller.NewController("istio-uds-controller",
+		controller.NewLockingSyncHandler(func() {
+			select {
+			case updated <- struct{}{}:
+			default:
+			}
+		}),
+		informer)
+	if err := istioController.Run(2, ctx.Done()); err != nil {
+		return nil, nil, errors.Wrapf(err, "could not start istio service entry informer")
+	}
+	if ok := cache.WaitForCacheSync(ctx.Done(), informer.HasSynced); !ok {
+		return nil, nil, errors.Errorf("waiting for istio service entries cache sync failed")
+	}
+
+	logger.Infow("started", "watchns", watchNamespaces, "writens", writeNamespace)
+
+	upstreamsChan := make(chan v1.UpstreamList)
+	errs := make(chan error)
+	discoverUpstreams := func() {
+		var entries []*ServiceEntry
+		for _, obj := range informer.GetStore().List() {
+			serviceEntry, err := serviceEntryFromUnstructured(obj)
+			if err != nil {
+				errs <- err
+				return
+			}
+			entries = append(entries, serviceEntry)
+		}
+		upstreams := ConvertServiceEntries(watchNamespaces, entries, writeNamespace)
+		logger.Debugw("discovered istio service entries", "num", len(upstreams))
+		upstreamsChan <- upstreams
+	}
+
+	go func() {
+		defer logger.Info("ended")
+		defer close(upstreamsChan)
+		defer close(errs)
+		// watch should open up with an initial read
+		discoverUpstreams()
+		for {
+			select {
+			case <-updated:
+				discoverUpstreams()
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return upstreamsChan, errs, nil
+}
+
+// ConvertServiceEntries creates an upstream for every port of the service entries in the watched namespaces.
+// The hosts of the upstream are the endpoints of the service entry, or its hosts when it resolves them with DNS.
+// The service entries with the NONE resolution are skipped: istio forwards their requests to the address the
+// client connected to, which Gloo has no equivalent of.
+func ConvertServiceEntries(watchNamespaces []string, serviceEntries []*ServiceEntry, writeNamespace string) v1.UpstreamList {
+	var upstreams v1.UpstreamList
+	for _, serviceEntry := range serviceEntries {
+		if !utils.AllNamespaces(watchNamespaces) && !containsString(serviceEntry.Namespace, watchNamespaces) {
+			continue
+		}
+		for _, port := range serviceEntry.Spec.Ports {
+			hosts := hostsForPort(serviceEntry.Spec, port)
+			if len(hosts) == 0 {
+				continue
+			}
+			upstreams = append(upstreams, &v1.Upstream{
+				Metadata: core.Metadata{
+					Name:      UpstreamName(serviceEntry.Namespace, serviceEntry.Name, port.Number),
+					Namespace: writeNamespace,
+				},
+				UpstreamSpec: &v1.UpstreamSpec{
+					UpstreamType: &v1.UpstreamSpec_Static{
+						Static: &static.UpstreamSpec{
+							Hosts:    hosts,
+							UseTls:   isProtocol(port, "HTTPS", "TLS"),
+							UseHttp2: isProtocol(port, "GRPC", "GRPC-WEB", "HTTP2"),
+						},
+					},
+				},
+				DiscoveryMetadata: &v1.DiscoveryMetadata{},
+			})
+		}
+	}
+	// sort for idempotency
+	sort.SliceStable(upstreams, func(i, j int) bool {
+		return upstreams[i].Metadata.Name < upstreams[j].Metadata.Name
+	})
+	return upstreams
+}
+
+func hostsForPort(spec ServiceEntrySpec, port Port) []*static.Host {
+	var hosts []*static.Host
+	switch strings.ToUpper(spec.Resolution) {
+	case ResolutionStatic, ResolutionDNS, "DNS_ROUND_ROBIN":
+	default:
+		return nil
+	}
+	for _, endpoint := range spec.Endpoints {
+		// unix domain sockets can't be reached from gloo
+		if endpoint.Address == "" || strings.HasPrefix(endpoint.Address, "unix://") {
+			continue
+		}
+		hostPort := endpoint.Ports[port.Name]
+		if hostPort == 0 {
+			hostPort = targetPort(port)
+		}
+		hosts = append(hosts, &static.Host{Addr: endpoint.Address, Port: hostPort})
+	}
+	if len(spec.Endpoints) > 0 || strings.ToUpper(spec.Resolution) == ResolutionStatic {
+		return hosts
+	}
+	for _, host := range spec.Hosts {
+		// wildcard hosts can't be resolved
+		if strings.HasPrefix(host, "*") {
+			continue
+		}
+		hosts = append(hosts, &static.Host{Addr: host, Port: targetPort(port)})
+	}
+	return hosts
+}
+
+func targetPort(port Port) uint32 {
+	if port.TargetPort != 0 {
+		return port.TargetPort
+	}
+	return port.Number
+}
+
+func isProtocol(port Port, protocols ...string) bool {
+	for _, protocol := range protocols {
+		if strings.EqualFold(port.Protocol, protocol) {
+			return true
+		}
+	}
+	return false
+}
+
+func UpstreamName(serviceEntryNamespace, serviceEntryName string, port uint32) string {
+	const maxLen = 63
+
+	name := strings.ToLower(fmt.Sprintf("istio-%s-%s-%v", serviceEntryNamespace, serviceEntryName, port))
+	if len(name) > maxLen {
+		hash := md5.Sum([]byte(name))
+		hexhash := fmt.Sprintf("%x", hash)
+		name = name[:maxLen-len(hexhash)] + hexhash
+	}
+	return strings.Replace(name, ".", "-", -1)
+}
+
+func (p *plugin) UpdateUpstream(original, desired *v1.Upstream) (bool, error) {
+	originalSpec, ok := original.UpstreamSpec.UpstreamType.(*v1.UpstreamSpec_Static)
+	if !ok {
+		return false, errors.Errorf("internal error: expected *v1.UpstreamSpec_Static, got %v", reflect.TypeOf(original.UpstreamSpec.UpstreamType).Name())
+	}
+	desiredSpec, ok := desired.UpstreamSpec.UpstreamType.(*v1.UpstreamSpec_Static)
+	if !ok {
+		return false, errors.Errorf("internal error: expected *v1.UpstreamSpec_Static, got %v", reflect.TypeOf(original.UpstreamSpec.UpstreamType).Name())
+	}
+	// copy service spec, we don't want to overwrite that
+	desiredSpec.Static.ServiceSpec = originalSpec.Static.ServiceSpec
+
+	// do not override ssl and connection config if none specified by discovery
+	if desired.UpstreamSpec.SslConfig == nil {
+		desired.UpstreamSpec.SslConfig = original.UpstreamSpec.SslConfig
+	}
+	if desired.UpstreamSpec.CircuitBreakers == nil {
+		desired.UpstreamSpec.CircuitBreakers = original.UpstreamSpec.CircuitBreakers
+	}
+	if desired.UpstreamSpec.LoadBalancerConfig == nil {
+		desired.UpstreamSpec.LoadBalancerConfig = original.UpstreamSpec.LoadBalancerConfig
+	}
+	if desired.UpstreamSpec.ConnectionConfig == nil {
+		desired.UpstreamSpec.ConnectionConfig = original.UpstreamSpec.ConnectionConfig
+	}
+
+	if originalSpec.Equal(desiredSpec) {
+		return false, nil
+	}
+
+	return true, nil
+}
+
+func containsString(s string, slice []string) bool {
+	for _, s2 := range slice {
+		if s2 == s {
+			return true
+		}
+	}
+	return false
+}
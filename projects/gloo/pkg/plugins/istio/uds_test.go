@@ -0,0 +1,109 @@
+package istio_test
+
+import (
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/static"
+	"github.com/solo-io/gloo/projects/gloo/pkg/discovery"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/solo-io/gloo/projects/gloo/pkg/plugins/istio"
+)
+
+var _ = Describe("Uds", func() {
+
+	serviceEntry := func(namespace, name string, spec ServiceEntrySpec) *ServiceEntry {
+		return &ServiceEntry{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+			Spec:       spec,
+		}
+	}
+
+	staticSpec := func(us *gloov1.Upstream) *static.UpstreamSpec {
+		return us.UpstreamSpec.UpstreamType.(*gloov1.UpstreamSpec_Static).Static
+	}
+
+	It("should create a static upstream per port of the service entries resolved with dns", func() {
+		entries := []*ServiceEntry{
+			serviceEntry("default", "httpbin", ServiceEntrySpec{
+				Hosts:      []string{"httpbin.org", "*.httpbin.org"},
+				Ports:      []Port{{Number: 80, Protocol: "HTTP", Name: "http"}, {Number: 443, Protocol: "HTTPS", Name: "https"}},
+				Resolution: ResolutionDNS,
+			}),
+			serviceEntry("other", "ignored", ServiceEntrySpec{
+				Hosts:      []string{"ignored.com"},
+				Ports:      []Port{{Number: 80, Protocol: "HTTP", Name: "http"}},
+				Resolution: ResolutionDNS,
+			}),
+		}
+		upstreams := ConvertServiceEntries([]string{"default"}, entries, "gloo-system")
+		Expect(upstreams).To(HaveLen(2))
+		Expect(upstreams[0].Metadata.Name).To(Equal("istio-default-httpbin-443"))
+		Expect(upstreams[0].Metadata.Namespace).To(Equal("gloo-system"))
+		Expect(staticSpec(upstreams[0])).To(Equal(&static.UpstreamSpec{
+			Hosts:  []*static.Host{{Addr: "httpbin.org", Port: 443}},
+			UseTls: true,
+		}))
+		Expect(upstreams[1].Metadata.Name).To(Equal("istio-default-httpbin-80"))
+		Expect(staticSpec(upstreams[1]).Hosts).To(Equal([]*static.Host{{Addr: "httpbin.org", Port: 80}}))
+	})
+
+	It("should use the endpoints of the service entries and their ports", func() {
+		entries := []*ServiceEntry{
+			serviceEntry("default", "grpc-vms", ServiceEntrySpec{
+				Hosts:      []string{"grpc.internal"},
+				Ports:      []Port{{Number: 9000, Protocol: "GRPC", Name: "grpc", TargetPort: 9090}},
+				Resolution: ResolutionStatic,
+				Endpoints: []WorkloadEntry{
+					{Address: "10.0.0.1"},
+					{Address: "10.0.0.2", Ports: map[string]uint32{"grpc": 9443}},
+				},
+			}),
+		}
+		upstreams := ConvertServiceEntries([]string{""}, entries, "gloo-system")
+		Expect(upstreams).To(HaveLen(1))
+		Expect(staticSpec(upstreams[0])).To(Equal(&static.UpstreamSpec{
+			Hosts:    []*static.Host{{Addr: "10.0.0.1", Port: 9090}, {Addr: "10.0.0.2", Port: 9443}},
+			UseHttp2: true,
+		}))
+	})
+
+	It("should skip the service entries without resolution", func() {
+		entries := []*ServiceEntry{
+			serviceEntry("default", "passthrough", ServiceEntrySpec{
+				Hosts: []string{"passthrough.com"},
+				Ports: []Port{{Number: 443, Protocol: "TLS", Name: "tls"}},
+			}),
+		}
+		Expect(ConvertServiceEntries([]string{""}, entries, "gloo-system")).To(BeEmpty())
+	})
+
+	It("should preserve the service spec when updating upstreams", func() {
+		plugin := NewPlugin().(discovery.DiscoveryPlugin)
+		desired := &gloov1.Upstream{
+			UpstreamSpec: &gloov1.UpstreamSpec{
+				UpstreamType: &gloov1.UpstreamSpec_Static{
+					Static: &static.UpstreamSpec{
+						Hosts: []*static.Host{{Addr: "httpbin.org", Port: 80}},
+					},
+				},
+			},
+		}
+		original := &gloov1.Upstream{
+			UpstreamSpec: &gloov1.UpstreamSpec{
+				UpstreamType: &gloov1.UpstreamSpec_Static{
+					Static: &static.UpstreamSpec{
+						Hosts: []*static.Host{{Addr: "httpbin.org", Port: 8080}},
+					},
+				},
+				SslConfig: &gloov1.UpstreamSslConfig{Sni: "testsni"},
+			},
+		}
+		updated, err := plugin.UpdateUpstream(original, desired)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(updated).To(BeTrue())
+		Expect(desired.UpstreamSpec.SslConfig).To(BeIdenticalTo(original.UpstreamSpec.SslConfig))
+	})
+})
@@ -0,0 +1,206 @@
+package knative
+
+import (
+	"crypto/md5"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	knativev1alpha1 "github.com/knative/serving/pkg/apis/serving/v1alpha1"
+	"github.com/solo-io/gloo/pkg/utils"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/static"
+	"github.com/solo-io/gloo/projects/gloo/pkg/discovery"
+	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/kube/controller"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/solo-io/solo-kit/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/tools/cache"
+)
+
+var _ discovery.DiscoveryPlugin = new(plugin)
+
+// knative routes are served by the knative ingress on port 80 of their internal domain
+const routePort = 80
+
+func (p *plugin) DiscoverUpstreams(watchNamespaces []string, writeNamespace string, opts clients.WatchOpts, discOpts discovery.Opts) (chan v1.UpstreamList, chan error, error) {
+	if err := p.tryGetClient(); err != nil {
+		return nil, nil, err
+	}
+	ctx := contextutils.WithLogger(opts.Ctx, "knative-uds")
+	logger := contextutils.LoggerFrom(ctx)
+
+	// every knative service creates a route with the same name, so watching
+	// routes covers both services and routes created directly by the user
+	routes := p.knative.ServingV1alpha1().Routes(metav1.NamespaceAll)
+	if _, err := routes.List(metav1.ListOptions{Limit: 1}); err != nil {
+		return nil, nil, errors.Wrapf(err, "listing knative routes, is knative serving installed?")
+	}
+
+	informer := cache.NewSharedIndexInformer(
+		&cache.ListWatch{
+			ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+				return routes.List(options)
+			},
+			WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+				return routes.Watch(options)
+			},
+		},
+		&knativev1alpha1.Route{},
+		12*time.Hour,
+		cache.Indexers{},
+	)
+
+	updated := make(chan struct{}, 1)
+	knativeController := controller.NewController("knative-uds-controller",
+		controller.NewLockingSyncHandler(func() {
+			select {
+			case updated <- struct{}{}:
+			default:
+			}
+		}),
+		informer)
+	if err := knativeController.Run(2, ctx.Done()); err != nil {
+		return nil, nil, errors.Wrapf(err, "could not start knative route informer")
+	}
+	if ok := cache.WaitForCacheSync(ctx.Done(), informer.HasSynced); !ok {
+		return nil, nil, errors.Errorf("waiting for knative routes cache sync failed")
+	}
+
+	logger.Infow("started", "watchns", watchNamespaces, "writens", writeNamespace)
+
+	upstreamsChan := make(chan v1.UpstreamList)
+	errs := make(chan error)
+	discoverUpstreams := func() {
+		var knativeRoutes []*knativev1alpha1.Route
+		for _, obj := range informer.GetStore().List() {
+			route, ok := obj.(*knativev1alpha1.Route)
+			if !ok {
+				errs <- errors.Errorf("internal error: expected *v1alpha1.Route, got %v", reflect.TypeOf(obj).Name())
+				return
+			}
+			knativeRoutes = append(knativeRoutes, route)
+		}
+		upstreams := ConvertRoutes(watchNamespaces, knativeRoutes, writeNamespace)
+		logger.Debugw("discovered knative routes", "num", len(upstreams))
+		upstreamsChan <- upstreams
+	}
+
+	go func() {
+		defer logger.Info("ended")
+		defer close(upstreamsChan)
+		defer close(errs)
+		// watch should open up with an initial read
+		discoverUpstreams()
+		for {
+			select {
+			case <-updated:
+				discoverUpstreams()
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return upstreamsChan, errs, nil
+}
+
+// ConvertRoutes creates an upstream for every ready knative route in the watched namespaces.
+// The upstream targets the internal domain of the route; the static plugin rewrites the host
+// header to that domain so the knative ingress can route the request to the right revision.
+func ConvertRoutes(watchNamespaces []string, routes []*knativev1alpha1.Route, writeNamespace string) v1.UpstreamList {
+	var upstreams v1.UpstreamList
+	for _, route := range routes {
+		if !utils.AllNamespaces(watchNamespaces) && !containsString(route.Namespace, watchNamespaces) {
+			continue
+		}
+		// routes that are not ready yet do not have a domain to send traffic to
+		if !route.Status.IsReady() || route.Status.DomainInternal == "" {
+			continue
+		}
+		upstreams = append(upstreams, &v1.Upstream{
+			Metadata: core.Metadata{
+				Name:      UpstreamName(route.Namespace, route.Name),
+				Namespace: writeNamespace,
+			},
+			UpstreamSpec: &v1.UpstreamSpec{
+				UpstreamType: &v1.UpstreamSpec_Static{
+					Static: &static.UpstreamSpec{
+						Hosts: []*static.Host{{
+							Addr: route.Status.DomainInternal,
+							Port: routePort,
+						}},
+					},
+				},
+			},
+			DiscoveryMetadata: &v1.DiscoveryMetadata{},
+		})
+	}
+	// sort for idempotency
+	sort.SliceStable(upstreams, func(i, j int) bool {
+		return upstreams[i].Metadata.Name < upstreams[j].Metadata.Name
+	})
+	return upstreams
+}
+
+func UpstreamName(routeNamespace, routeName string) string {
+	const maxLen = 63
+
+	name := strings.ToLower(fmt.Sprintf("knative-%s-%s", routeNamespace, routeName))
+	if len(name) > maxLen {
+		hash := md5.Sum([]byte(name))
+		hexhash := fmt.Sprintf("%x", hash)
+		name = name[:maxLen-len(hexhash)] + hexhash
+	}
+	return name
+}
+
+func (p *plugin) UpdateUpstream(original, desired *v1.Upstream) (bool, error) {
+	originalSpec, ok := original.UpstreamSpec.UpstreamType.(*v1.UpstreamSpec_Static)
+	if !ok {
+		return false, errors.Errorf("internal error: expected *v1.UpstreamSpec_Static, got %v", reflect.TypeOf(original.UpstreamSpec.UpstreamType).Name())
+	}
+	desiredSpec, ok := desired.UpstreamSpec.UpstreamType.(*v1.UpstreamSpec_Static)
+	if !ok {
+		return false, errors.Errorf("internal error: expected *v1.UpstreamSpec_Static, got %v", reflect.TypeOf(original.UpstreamSpec.UpstreamType).Name())
+	}
+	// copy service spec, we don't want to overwrite that
+	desiredSpec.Static.ServiceSpec = originalSpec.Static.ServiceSpec
+	// copy settings the user may have written over, they cannot be auto-discovered
+	desiredSpec.Static.UseTls = originalSpec.Static.UseTls
+	desiredSpec.Static.UseHttp2 = originalSpec.Static.UseHttp2
+
+	// do not override ssl and connection config if none specified by discovery
+	if desired.UpstreamSpec.SslConfig == nil {
+		desired.UpstreamSpec.SslConfig = original.UpstreamSpec.SslConfig
+	}
+	if desired.UpstreamSpec.CircuitBreakers == nil {
+		desired.UpstreamSpec.CircuitBreakers = original.UpstreamSpec.CircuitBreakers
+	}
+	if desired.UpstreamSpec.LoadBalancerConfig == nil {
+		desired.UpstreamSpec.LoadBalancerConfig = original.UpstreamSpec.LoadBalancerConfig
+	}
+	if desired.UpstreamSpec.ConnectionConfig == nil {
+		desired.UpstreamSpec.ConnectionConfig = original.UpstreamSpec.ConnectionConfig
+	}
+
+	if originalSpec.Equal(desiredSpec) {
+		return false, nil
+	}
+
+	return true, nil
+}
+
+func containsString(s string, slice []string) bool {
+	for _, s2 := range slice {
+		if s2 == s {
+			return true
+		}
+	}
+	return false
+}
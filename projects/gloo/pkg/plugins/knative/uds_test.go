@@ -0,0 +1,88 @@
+package knative_test
+
+import (
+	duckv1alpha1 "github.com/knative/pkg/apis/duck/v1alpha1"
+	knativev1alpha1 "github.com/knative/serving/pkg/apis/serving/v1alpha1"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/static"
+	"github.com/solo-io/gloo/projects/gloo/pkg/discovery"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/solo-io/gloo/projects/gloo/pkg/plugins/knative"
+)
+
+var _ = Describe("Uds", func() {
+
+	route := func(namespace, name, domainInternal string, ready bool) *knativev1alpha1.Route {
+		status := corev1.ConditionFalse
+		if ready {
+			status = corev1.ConditionTrue
+		}
+		return &knativev1alpha1.Route{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+			Status: knativev1alpha1.RouteStatus{
+				DomainInternal: domainInternal,
+				Conditions: duckv1alpha1.Conditions{{
+					Type:   duckv1alpha1.ConditionReady,
+					Status: status,
+				}},
+			},
+		}
+	}
+
+	It("should create static upstreams for the internal domain of ready routes", func() {
+		routes := []*knativev1alpha1.Route{
+			route("default", "helloworld", "helloworld.default.svc.cluster.local", true),
+			route("default", "pending", "pending.default.svc.cluster.local", false),
+			route("other", "ignored", "ignored.other.svc.cluster.local", true),
+		}
+		upstreams := ConvertRoutes([]string{"default"}, routes, "gloo-system")
+		Expect(upstreams).To(HaveLen(1))
+		Expect(upstreams[0].Metadata.Name).To(Equal("knative-default-helloworld"))
+		Expect(upstreams[0].Metadata.Namespace).To(Equal("gloo-system"))
+		Expect(upstreams[0].UpstreamSpec.UpstreamType).To(Equal(&gloov1.UpstreamSpec_Static{
+			Static: &static.UpstreamSpec{
+				Hosts: []*static.Host{{Addr: "helloworld.default.svc.cluster.local", Port: 80}},
+			},
+		}))
+	})
+
+	It("should shorten long upstream names", func() {
+		name := UpstreamName("a-very-long-namespace-name", "a-very-long-knative-service-name-too")
+		Expect(len(name)).To(BeNumerically("<=", 63))
+		Expect(name).To(HavePrefix("knative-a-very-long-namespace-"))
+	})
+
+	It("should preserve the service spec when updating upstreams", func() {
+		plugin := NewPlugin().(discovery.DiscoveryPlugin)
+		desired := &gloov1.Upstream{
+			UpstreamSpec: &gloov1.UpstreamSpec{
+				UpstreamType: &gloov1.UpstreamSpec_Static{
+					Static: &static.UpstreamSpec{
+						Hosts: []*static.Host{{Addr: "helloworld.default.svc.cluster.local", Port: 80}},
+					},
+				},
+			},
+		}
+		original := &gloov1.Upstream{
+			UpstreamSpec: &gloov1.UpstreamSpec{
+				UpstreamType: &gloov1.UpstreamSpec_Static{
+					Static: &static.UpstreamSpec{
+						Hosts:    []*static.Host{{Addr: "helloworld.default.svc.cluster.local", Port: 80}},
+						UseHttp2: true,
+					},
+				},
+				SslConfig: &gloov1.UpstreamSslConfig{Sni: "testsni"},
+			},
+		}
+		updated, err := plugin.UpdateUpstream(original, desired)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(updated).To(BeFalse())
+		Expect(desired.UpstreamSpec.UpstreamType.(*gloov1.UpstreamSpec_Static).Static.UseHttp2).To(BeTrue())
+		Expect(desired.UpstreamSpec.SslConfig).To(BeIdenticalTo(original.UpstreamSpec.SslConfig))
+	})
+})
@@ -0,0 +1,132 @@
+package kubernetes
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	kubev1 "k8s.io/api/core/v1"
+)
+
+// GlooAppProtocolsAnnotation sets the application protocol of the ports of a service, like the appProtocol of the
+// ports of newer kube versions: a comma separated list of `<port name or number>=<protocol>`, e.g. `8443=https,api=grpc`
+const GlooAppProtocolsAnnotation = "gloo.solo.io/app_protocols"
+
+// the application protocols of the ports
+const (
+	AppProtocolHttp  = "http"
+	AppProtocolHttp2 = "h2"
+	AppProtocolHttps = "https"
+)
+
+// AppProtocol returns the application protocol of the port from the app protocols annotation of the service, or from
+// the name of the port, e.g. `grpc-api` or `https`. Empty if unknown.
+func AppProtocol(svc *kubev1.Service, port kubev1.ServicePort) string {
+	for _, entry := range appProtocolEntries(svc.Annotations) {
+		if entry.port == port.Name || entry.port == strconv.Itoa(int(port.Port)) {
+			return entry.protocol
+		}
+	}
+	// the protocol prefixes of the port names of istio
+	name := strings.ToLower(port.Name)
+	if strings.HasPrefix(name, "grpc") || strings.HasPrefix(name, "h2") || hasProtocolPrefix(name, "http2") {
+		return AppProtocolHttp2
+	}
+	for _, protocol := range []string{"https", "tls", "wss", "ws", "http"} {
+		if hasProtocolPrefix(name, protocol) {
+			return normalizeAppProtocol(protocol)
+		}
+	}
+	return ""
+}
+
+type appProtocolEntry struct {
+	// the name or number of the port
+	port     string
+	protocol string
+}
+
+// the entries of the app protocols annotation, in order
+func appProtocolEntries(annotations map[string]string) []appProtocolEntry {
+	var entries []appProtocolEntry
+	for _, entry := range strings.Split(annotations[GlooAppProtocolsAnnotation], ",") {
+		parts := strings.SplitN(strings.TrimSpace(entry), "=", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		entries = append(entries, appProtocolEntry{port: parts[0], protocol: normalizeAppProtocol(parts[1])})
+	}
+	return entries
+}
+
+// e.g. `https` or `https-web` for https
+func hasProtocolPrefix(portName, protocol string) bool {
+	return portName == protocol || strings.HasPrefix(portName, protocol+"-")
+}
+
+func normalizeAppProtocol(protocol string) string {
+	switch strings.ToLower(strings.TrimSpace(protocol)) {
+	case "h2", "h2c", "http2", "grpc", "kubernetes.io/h2c":
+		return AppProtocolHttp2
+	case "https", "tls", "wss":
+		return AppProtocolHttps
+	case "http", "http1", "ws", "grpc-web", "kubernetes.io/ws":
+		return AppProtocolHttp
+	}
+	return ""
+}
+
+// GlooSslServiceAnnotation set to `"true"` declares that all the ports of a service serve https
+const GlooSslServiceAnnotation = "gloo.solo.io/ssl_service"
+
+// GlooSslSecretAnnotation references the tls secret used by the upstreams of a service to originate tls to all its
+// ports, as `<name>` in the namespace of the service or `<namespace>/<name>`
+const GlooSslSecretAnnotation = "gloo.solo.io/ssl_secret"
+
+// GetSslConfig returns the ssl config of the upstreams of the ports serving https, which originate tls to the service
+// with the secret of the ssl secret annotation if any, or else without verifying its certificate. Nil for the other
+// ports.
+func GetSslConfig(svc *kubev1.Service, port kubev1.ServicePort) *v1.UpstreamSslConfig {
+	secretRef := sslSecretRef(svc)
+	if secretRef == nil && svc.Annotations[GlooSslServiceAnnotation] != "true" && AppProtocol(svc, port) != AppProtocolHttps {
+		return nil
+	}
+	sslConfig := &v1.UpstreamSslConfig{
+		SslSecrets: &v1.UpstreamSslConfig_SslFiles{SslFiles: &v1.SSLFiles{}},
+		Sni:        fmt.Sprintf("%v.%v.svc.cluster.local", svc.Name, svc.Namespace),
+	}
+	if secretRef != nil {
+		sslConfig.SslSecrets = &v1.UpstreamSslConfig_SecretRef{SecretRef: secretRef}
+	}
+	return sslConfig
+}
+
+// SslAnnotated returns true if the annotations of a service, which its upstreams have too, ask for tls on the port.
+// The ports named in the app protocols annotation are assumed to be the port, as the upstreams don't know the names.
+func SslAnnotated(annotations map[string]string, port uint32) bool {
+	if annotations[GlooSslServiceAnnotation] == "true" || strings.TrimSpace(annotations[GlooSslSecretAnnotation]) != "" {
+		return true
+	}
+	for _, entry := range appProtocolEntries(annotations) {
+		if entry.protocol != AppProtocolHttps {
+			continue
+		}
+		if number, err := strconv.Atoi(entry.port); err != nil || uint32(number) == port {
+			return true
+		}
+	}
+	return false
+}
+
+func sslSecretRef(svc *kubev1.Service) *core.ResourceRef {
+	secret := strings.TrimSpace(svc.Annotations[GlooSslSecretAnnotation])
+	if secret == "" {
+		return nil
+	}
+	if parts := strings.SplitN(secret, "/", 2); len(parts) == 2 {
+		return &core.ResourceRef{Namespace: parts[0], Name: parts[1]}
+	}
+	return &core.ResourceRef{Namespace: svc.Namespace, Name: secret}
+}
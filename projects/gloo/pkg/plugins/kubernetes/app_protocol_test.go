@@ -0,0 +1,74 @@
+package kubernetes
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	kubev1 "k8s.io/api/core/v1"
+)
+
+var _ = Describe("AppProtocol", func() {
+
+	var svc *kubev1.Service
+
+	BeforeEach(func() {
+		svc = &kubev1.Service{}
+		svc.Name = "petstore"
+		svc.Namespace = "default"
+	})
+
+	DescribeTable("should tell the protocol from the name of the port", func(portName, protocol string) {
+		Expect(AppProtocol(svc, kubev1.ServicePort{Name: portName, Port: 8080})).To(Equal(protocol))
+	},
+		Entry("grpc", "grpc-api", AppProtocolHttp2),
+		Entry("h2", "h2", AppProtocolHttp2),
+		Entry("http2", "http2-web", AppProtocolHttp2),
+		Entry("https", "https", AppProtocolHttps),
+		Entry("tls", "tls-db", AppProtocolHttps),
+		Entry("ws", "ws", AppProtocolHttp),
+		Entry("http", "http-web", AppProtocolHttp),
+		Entry("unknown", "web", ""),
+		Entry("no protocol prefix", "httpsomething", ""),
+	)
+
+	It("should prefer the protocols of the annotation", func() {
+		svc.Annotations = map[string]string{GlooAppProtocolsAnnotation: "8443=https, api=grpc"}
+		Expect(AppProtocol(svc, kubev1.ServicePort{Name: "web", Port: 8443})).To(Equal(AppProtocolHttps))
+		Expect(AppProtocol(svc, kubev1.ServicePort{Name: "api", Port: 9090})).To(Equal(AppProtocolHttp2))
+		Expect(AppProtocol(svc, kubev1.ServicePort{Name: "http2", Port: 8080})).To(Equal(AppProtocolHttp2))
+	})
+
+	It("should originate tls to the https ports", func() {
+		sslConfig := GetSslConfig(svc, kubev1.ServicePort{Name: "https", Port: 443})
+		Expect(sslConfig).NotTo(BeNil())
+		Expect(sslConfig.GetSslFiles()).NotTo(BeNil())
+		Expect(sslConfig.Sni).To(Equal("petstore.default.svc.cluster.local"))
+
+		Expect(GetSslConfig(svc, kubev1.ServicePort{Name: "http", Port: 80})).To(BeNil())
+	})
+
+	It("should originate tls to all the ports of ssl services", func() {
+		svc.Annotations = map[string]string{GlooSslServiceAnnotation: "true"}
+		Expect(GetSslConfig(svc, kubev1.ServicePort{Name: "web", Port: 8080}).GetSslFiles()).NotTo(BeNil())
+	})
+
+	It("should originate tls with the secret of the annotation", func() {
+		svc.Annotations = map[string]string{GlooSslSecretAnnotation: "petstore-tls"}
+		sslConfig := GetSslConfig(svc, kubev1.ServicePort{Name: "web", Port: 8080})
+		Expect(sslConfig.GetSecretRef()).To(Equal(&core.ResourceRef{Namespace: "default", Name: "petstore-tls"}))
+
+		svc.Annotations[GlooSslSecretAnnotation] = "gloo-system/petstore-tls"
+		sslConfig = GetSslConfig(svc, kubev1.ServicePort{Name: "web", Port: 8080})
+		Expect(sslConfig.GetSecretRef()).To(Equal(&core.ResourceRef{Namespace: "gloo-system", Name: "petstore-tls"}))
+	})
+	It("should tell the annotations asking for tls", func() {
+		Expect(SslAnnotated(nil, 443)).To(BeFalse())
+		Expect(SslAnnotated(map[string]string{GlooSslServiceAnnotation: "true"}, 8080)).To(BeTrue())
+		Expect(SslAnnotated(map[string]string{GlooSslSecretAnnotation: "petstore-tls"}, 8080)).To(BeTrue())
+
+		annotations := map[string]string{GlooAppProtocolsAnnotation: "8443=https,9090=grpc"}
+		Expect(SslAnnotated(annotations, 8443)).To(BeTrue())
+		Expect(SslAnnotated(annotations, 9090)).To(BeFalse())
+	})
+})
@@ -6,19 +6,34 @@ import (
 	"time"
 
 	"github.com/pkg/errors"
+	kubev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/tools/cache"
 
+	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/go-utils/kubeutils"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients/kube/controller"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/dynamicinformer"
 	kubeinformers "k8s.io/client-go/informers"
 	kubelisters "k8s.io/client-go/listers/core/v1"
 
 	"k8s.io/client-go/kubernetes"
 )
 
+// the EndpointSlices split the endpoints of the large services, that Endpoints cap and duplicate
+var endpointSlicesResource = schema.GroupVersionResource{Group: "discovery.k8s.io", Version: "v1", Resource: "endpointslices"}
+
 type KubePluginSharedFactory interface {
 	EndpointsLister() kubelisters.EndpointsLister
+	// EndpointSlicesLister is nil if the cluster doesn't serve the EndpointSlices, EndpointsLister is nil otherwise
+	EndpointSlicesLister() cache.GenericLister
 	ServicesLister() kubelisters.ServiceLister
 	PodsLister() kubelisters.PodLister
+	// NodesLister lists the nodes, for the localities of the endpoints. The nodes are watched from the first call,
+	// it returns nil if gloo may not list them.
+	NodesLister() kubelisters.NodeLister
 	Subscribe() <-chan struct{}
 	Unsubscribe(<-chan struct{})
 }
@@ -26,9 +41,16 @@ type KubePluginSharedFactory interface {
 type KubePluginListers struct {
 	initError error
 
-	endpointsLister kubelisters.EndpointsLister
-	servicesLister  kubelisters.ServiceLister
-	podsLister      kubelisters.PodLister
+	ctx                 context.Context
+	kubeInformerFactory kubeinformers.SharedInformerFactory
+	client              kubernetes.Interface
+	nodesListerOnce     sync.Once
+
+	endpointsLister      kubelisters.EndpointsLister
+	endpointSlicesLister cache.GenericLister
+	servicesLister       kubelisters.ServiceLister
+	podsLister           kubelisters.PodLister
+	nodesLister          kubelisters.NodeLister
 
 	cacheUpdatedWatchers      []chan struct{}
 	cacheUpdatedWatchersMutex sync.Mutex
@@ -52,19 +74,35 @@ func startInformerFactory(ctx context.Context, client kubernetes.Interface) *Kub
 	resyncDuration := 12 * time.Hour
 	kubeInformerFactory := kubeinformers.NewSharedInformerFactory(client, resyncDuration)
 
-	endpointInformer := kubeInformerFactory.Core().V1().Endpoints()
 	podsInformer := kubeInformerFactory.Core().V1().Pods()
 	servicesInformer := kubeInformerFactory.Core().V1().Services()
 
 	k := &KubePluginListers{
-		endpointsLister: endpointInformer.Lister(),
-		servicesLister:  servicesInformer.Lister(),
-		podsLister:      podsInformer.Lister(),
+		ctx:                 ctx,
+		kubeInformerFactory: kubeInformerFactory,
+		client:              client,
+		servicesLister:      servicesInformer.Lister(),
+		podsLister:          podsInformer.Lister(),
+	}
+	informers := []cache.SharedIndexInformer{podsInformer.Informer(), servicesInformer.Informer()}
+
+	if servesEndpointSlices(client) {
+		endpointSlicesInformer, err := newEndpointSlicesInformer(resyncDuration)
+		if err != nil {
+			k.initError = errors.Wrapf(err, "could not start endpoint slices informer")
+			return k
+		}
+		k.endpointSlicesLister = endpointSlicesInformer.Lister()
+		informers = append(informers, endpointSlicesInformer.Informer())
+	} else {
+		// the clusters older than kube 1.21 only serve the Endpoints
+		endpointInformer := kubeInformerFactory.Core().V1().Endpoints()
+		k.endpointsLister = endpointInformer.Lister()
+		informers = append(informers, endpointInformer.Informer())
 	}
 
 	kubeController := controller.NewController("kube-plugin-controller",
-		controller.NewLockingSyncHandler(k.updatedOccured),
-		endpointInformer.Informer(), podsInformer.Informer(), servicesInformer.Informer())
+		controller.NewLockingSyncHandler(k.updatedOccured), informers...)
 
 	stop := ctx.Done()
 	err := kubeController.Run(2, stop)
@@ -73,10 +111,11 @@ func startInformerFactory(ctx context.Context, client kubernetes.Interface) *Kub
 		return k
 	}
 
-	ok := cache.WaitForCacheSync(stop,
-		endpointInformer.Informer().HasSynced,
-		podsInformer.Informer().HasSynced,
-		servicesInformer.Informer().HasSynced)
+	var synced []cache.InformerSynced
+	for _, informer := range informers {
+		synced = append(synced, informer.HasSynced)
+	}
+	ok := cache.WaitForCacheSync(stop, synced...)
 	if !ok {
 		// if initError is non-nil, the kube resource client will panic
 		k.initError = errors.Errorf("waiting for kube pod, endpoints, services cache sync failed")
@@ -85,10 +124,41 @@ func startInformerFactory(ctx context.Context, client kubernetes.Interface) *Kub
 	return k
 }
 
+// whether the cluster serves the v1 EndpointSlices
+func servesEndpointSlices(client kubernetes.Interface) bool {
+	resources, err := client.Discovery().ServerResourcesForGroupVersion(endpointSlicesResource.GroupVersion().String())
+	if err != nil {
+		return false
+	}
+	for _, resource := range resources.APIResources {
+		if resource.Name == endpointSlicesResource.Resource {
+			return true
+		}
+	}
+	return false
+}
+
+// the typed clients vendored here predate discovery.k8s.io, the EndpointSlices are read as unstructured objects
+func newEndpointSlicesInformer(resyncDuration time.Duration) (kubeinformers.GenericInformer, error) {
+	cfg, err := kubeutils.GetConfig("", "")
+	if err != nil {
+		return nil, err
+	}
+	dynamicClient, err := dynamic.NewForConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return dynamicinformer.NewDynamicSharedInformerFactory(dynamicClient, resyncDuration).ForResource(endpointSlicesResource), nil
+}
+
 func (k *KubePluginListers) EndpointsLister() kubelisters.EndpointsLister {
 	return k.endpointsLister
 }
 
+func (k *KubePluginListers) EndpointSlicesLister() cache.GenericLister {
+	return k.endpointSlicesLister
+}
+
 func (k *KubePluginListers) ServicesLister() kubelisters.ServiceLister {
 	return k.servicesLister
 }
@@ -97,6 +167,49 @@ func (k *KubePluginListers) PodsLister() kubelisters.PodLister {
 	return k.podsLister
 }
 
+func (k *KubePluginListers) NodesLister() kubelisters.NodeLister {
+	k.nodesListerOnce.Do(func() {
+		k.nodesLister = k.startNodesInformer()
+	})
+	return k.nodesLister
+}
+
+// the nodes are only watched by the upstreams with localities, and only their topology labels matter
+func (k *KubePluginListers) startNodesInformer() kubelisters.NodeLister {
+	logger := contextutils.LoggerFrom(k.ctx)
+	// the informer retries forever when gloo may not list the nodes, e.g. with the cluster roles of older releases
+	if _, err := k.client.CoreV1().Nodes().List(metav1.ListOptions{Limit: 1}); err != nil {
+		logger.Warnf("the endpoints have no locality, gloo could not list the nodes: %v", err)
+		return nil
+	}
+
+	nodesInformer := k.kubeInformerFactory.Core().V1().Nodes()
+	informer := nodesInformer.Informer()
+	informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			k.updatedOccured()
+		},
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			oldNode, oldOk := oldObj.(*kubev1.Node)
+			newNode, newOk := newObj.(*kubev1.Node)
+			if oldOk && newOk && nodeLocality(oldNode).Equal(nodeLocality(newNode)) {
+				// the status of the nodes is updated every few seconds
+				return
+			}
+			k.updatedOccured()
+		},
+		DeleteFunc: func(obj interface{}) {
+			k.updatedOccured()
+		},
+	})
+	go informer.Run(k.ctx.Done())
+	if !cache.WaitForCacheSync(k.ctx.Done(), informer.HasSynced) {
+		logger.Warnf("the endpoints have no locality, waiting for kube nodes cache sync failed")
+		return nil
+	}
+	return nodesInformer.Lister()
+}
+
 func (k *KubePluginListers) Subscribe() <-chan struct{} {
 	k.cacheUpdatedWatchersMutex.Lock()
 	defer k.cacheUpdatedWatchersMutex.Unlock()
@@ -35,10 +35,13 @@ type edsWatcher struct {
 	kube             kubernetes.Interface
 	upstreams        map[core.ResourceRef]*kubeplugin.UpstreamSpec
 	kubeShareFactory KubePluginSharedFactory
+	// whether an upstream prioritizes or weights the localities of its endpoints
+	usesLocality bool
 }
 
 func newEndpointsWatcher(kube kubernetes.Interface, kubeShareFactory KubePluginSharedFactory, upstreams v1.UpstreamList) *edsWatcher {
 	upstreamSpecs := make(map[core.ResourceRef]*kubeplugin.UpstreamSpec)
+	var usesLocality bool
 	for _, us := range upstreams {
 		kubeUpstream, ok := us.UpstreamSpec.UpstreamType.(*v1.UpstreamSpec_Kube)
 		// only care about kube upstreams
@@ -46,16 +49,21 @@ func newEndpointsWatcher(kube kubernetes.Interface, kubeShareFactory KubePluginS
 			continue
 		}
 		upstreamSpecs[us.Metadata.Ref()] = kubeUpstream.Kube
+		lbConfig := us.UpstreamSpec.GetLoadBalancerConfig()
+		if len(lbConfig.GetLocalityPriorities()) > 0 || lbConfig.GetLocalityWeighted() {
+			usesLocality = true
+		}
 	}
 	return &edsWatcher{
 		kube:             kube,
 		upstreams:        upstreamSpecs,
 		kubeShareFactory: kubeShareFactory,
+		usesLocality:     usesLocality,
 	}
 }
 
 func (c *edsWatcher) List(writeNamespace string, opts clients.ListOpts) (v1.EndpointList, error) {
-	endpoints, err := c.kubeShareFactory.EndpointsLister().List(labels.SelectorFromSet(opts.Selector))
+	endpoints, err := c.listEndpoints(opts)
 	if err != nil {
 		return nil, err
 	}
@@ -70,7 +78,39 @@ func (c *edsWatcher) List(writeNamespace string, opts clients.ListOpts) (v1.Endp
 		return nil, err
 	}
 
-	return filterEndpoints(opts.Ctx, writeNamespace, endpoints, services, pods, c.upstreams), nil
+	nodes, err := c.listNodes()
+	if err != nil {
+		return nil, err
+	}
+
+	return filterEndpoints(opts.Ctx, writeNamespace, endpoints, services, pods, nodes, c.upstreams), nil
+}
+
+// the endpoints of the services, mirrored from their EndpointSlices when the cluster serves them
+func (c *edsWatcher) listEndpoints(opts clients.ListOpts) ([]*kubev1.Endpoints, error) {
+	selector := labels.SelectorFromSet(opts.Selector)
+	slicesLister := c.kubeShareFactory.EndpointSlicesLister()
+	if slicesLister == nil {
+		return c.kubeShareFactory.EndpointsLister().List(selector)
+	}
+	slices, err := slicesLister.List(selector)
+	if err != nil {
+		return nil, err
+	}
+	return endpointsFromSlices(slices)
+}
+
+// the nodes with the localities of the endpoints, none when no upstream uses them or gloo may not list them
+func (c *edsWatcher) listNodes() ([]*kubev1.Node, error) {
+	if !c.usesLocality {
+		return nil, nil
+	}
+	nodesLister := c.kubeShareFactory.NodesLister()
+	if nodesLister == nil {
+		return nil, nil
+	}
+	// the nodes are not selected by the labels of the services
+	return nodesLister.List(labels.Everything())
 }
 
 func (c *edsWatcher) watch(writeNamespace string, opts clients.WatchOpts) (<-chan v1.EndpointList, <-chan error, error) {
@@ -117,7 +157,7 @@ func (c *edsWatcher) watch(writeNamespace string, opts clients.WatchOpts) (<-cha
 }
 
 func filterEndpoints(ctx context.Context, writeNamespace string, kubeEndpoints []*kubev1.Endpoints,
-	services []*kubev1.Service, pods []*kubev1.Pod, upstreams map[core.ResourceRef]*kubeplugin.UpstreamSpec) v1.EndpointList {
+	services []*kubev1.Service, pods []*kubev1.Pod, nodes []*kubev1.Node, upstreams map[core.ResourceRef]*kubeplugin.UpstreamSpec) v1.EndpointList {
 	var endpoints v1.EndpointList
 
 	logger := contextutils.LoggerFrom(contextutils.WithLogger(ctx, "kubernetes_eds"))
@@ -129,6 +169,10 @@ func filterEndpoints(ctx context.Context, writeNamespace string, kubeEndpoints [
 		PodNamespace string
 	}
 	endpointsMap := make(map[Epkey][]*core.ResourceRef)
+	// the names of the ports of the endpoints of the upstreams routing to several ports
+	portNames := make(map[Epkey]string)
+	// the names of the nodes of the endpoints, when the endpoints know them
+	nodeNames := make(map[Epkey]string)
 
 	// for each upstream
 	for usRef, spec := range upstreams {
@@ -160,19 +204,8 @@ func filterEndpoints(ctx context.Context, writeNamespace string, kubeEndpoints [
 				continue
 			}
 			for _, subset := range eps.Subsets {
-				var port uint32
-				for _, p := range subset.Ports {
-					// if the edpoint port is not named, it implies that
-					// the kube service only has a single unnamed port as well.
-					switch {
-					case singlePortService:
-						port = uint32(p.Port)
-					case p.Name == kubeServicePort.Name:
-						port = uint32(p.Port)
-						break
-					}
-				}
-				if port == 0 {
+				ports := endpointPorts(subset, spec, kubeServicePort, singlePortService)
+				if len(ports) == 0 {
 					logger.Warnf("upstream %v: port %v not found for service %v in endpoint %v", usRef.Key(), spec.ServicePort, spec.ServiceName, subset)
 					continue
 				}
@@ -185,6 +218,9 @@ func filterEndpoints(ctx context.Context, writeNamespace string, kubeEndpoints [
 							podNamespace = targetRef.Namespace
 						}
 					}
+					if spec.PodName != "" && !isPod(spec.PodName, spec.ServiceNamespace, addr.IP, podName, podNamespace, pods) {
+						continue
+					}
 					if len(spec.Selector) != 0 {
 						// determine whether labels for the owner of this ip (pod) matches the spec
 						podLabels, err := getPodLabelsForIp(addr.IP, podName, podNamespace, pods)
@@ -201,9 +237,17 @@ func filterEndpoints(ctx context.Context, writeNamespace string, kubeEndpoints [
 							continue
 						}
 					}
-					key := Epkey{addr.IP, port, podName, podNamespace}
-					copyRef := usRef
-					endpointsMap[key] = append(endpointsMap[key], &copyRef)
+					for _, port := range ports {
+						key := Epkey{addr.IP, uint32(port.Port), podName, podNamespace}
+						copyRef := usRef
+						endpointsMap[key] = append(endpointsMap[key], &copyRef)
+						if len(spec.ServicePortNames) > 0 {
+							portNames[key] = port.Name
+						}
+						if addr.NodeName != nil {
+							nodeNames[key] = *addr.NodeName
+						}
+					}
 				}
 			}
 		}
@@ -226,7 +270,8 @@ func filterEndpoints(ctx context.Context, writeNamespace string, kubeEndpoints [
 		}, addr.Address)
 		endpointName := fmt.Sprintf("ep-%v-%v-%x", dnsname, addr.Port, hash)
 		pod, _ := getPodForIp(addr.Address, addr.PodName, addr.PodNamespace, pods)
-		ep := createEndpoint(writeNamespace, endpointName, refs, addr.Address, addr.Port, pod)
+		ep := createEndpoint(writeNamespace, endpointName, refs, addr.Address, addr.Port, pod, portNames[addr])
+		ep.Locality = endpointLocality(nodeNames[addr], pod, nodes)
 		endpoints = append(endpoints, ep)
 	}
 
@@ -236,7 +281,35 @@ func filterEndpoints(ctx context.Context, writeNamespace string, kubeEndpoints [
 	return endpoints
 }
 
-func createEndpoint(namespace, name string, upstreams []*core.ResourceRef, address string, port uint32, pod *kubev1.Pod) *v1.Endpoint {
+// the ports of the endpoints of the subset routed to by the upstream
+func endpointPorts(subset kubev1.EndpointSubset, spec *kubeplugin.UpstreamSpec, kubeServicePort *kubev1.ServicePort, singlePortService bool) []kubev1.EndpointPort {
+	if len(spec.ServicePortNames) > 0 {
+		var ports []kubev1.EndpointPort
+		for _, p := range subset.Ports {
+			if containsString(p.Name, spec.ServicePortNames) {
+				ports = append(ports, p)
+			}
+		}
+		return ports
+	}
+	var port *kubev1.EndpointPort
+	for i, p := range subset.Ports {
+		// if the edpoint port is not named, it implies that
+		// the kube service only has a single unnamed port as well.
+		switch {
+		case singlePortService:
+			port = &subset.Ports[i]
+		case p.Name == kubeServicePort.Name:
+			port = &subset.Ports[i]
+		}
+	}
+	if port == nil || port.Port == 0 {
+		return nil
+	}
+	return []kubev1.EndpointPort{*port}
+}
+
+func createEndpoint(namespace, name string, upstreams []*core.ResourceRef, address string, port uint32, pod *kubev1.Pod, portName string) *v1.Endpoint {
 	ep := &v1.Endpoint{
 		Metadata: core.Metadata{
 			Namespace: namespace,
@@ -245,15 +318,32 @@ func createEndpoint(namespace, name string, upstreams []*core.ResourceRef, addre
 		Upstreams: upstreams,
 		Address:   address,
 		Port:      port,
-		// TODO: add locality info
 	}
 
 	if pod != nil {
 		ep.Metadata.Labels = pod.Labels
 	}
+	if portName != "" {
+		// selects the port in the subsets of the upstreams
+		labels := make(map[string]string)
+		for k, v := range ep.Metadata.Labels {
+			labels[k] = v
+		}
+		labels[PortSubsetKey] = portName
+		ep.Metadata.Labels = labels
+	}
 	return ep
 }
 
+// whether the address belongs to the pod of the per pod upstream
+func isPod(name, namespace, ip string, podName, podNamespace string, pods []*kubev1.Pod) bool {
+	if podName != "" {
+		return podName == name && podNamespace == namespace
+	}
+	pod, err := getPodForIp(ip, podName, podNamespace, pods)
+	return err == nil && pod.Name == name && pod.Namespace == namespace
+}
+
 func getPodLabelsForIp(ip string, podName, podNamespace string, pods []*kubev1.Pod) (map[string]string, error) {
 	pod, err := getPodForIp(ip, podName, podNamespace, pods)
 	if err != nil {
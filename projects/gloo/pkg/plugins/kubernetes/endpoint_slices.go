@@ -0,0 +1,99 @@
+package kubernetes
+
+import (
+	"github.com/solo-io/solo-kit/pkg/errors"
+	kubev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	// the label of the EndpointSlices naming the service they belong to
+	serviceNameLabel = "kubernetes.io/service-name"
+	// the Endpoints only ever had IPv4 addresses
+	addressTypeIPv4 = "IPv4"
+)
+
+// the fields of the discovery.k8s.io/v1 EndpointSlice read by the EDS
+type endpointSlice struct {
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	AddressType string          `json:"addressType"`
+	Endpoints   []sliceEndpoint `json:"endpoints"`
+	Ports       []slicePort     `json:"ports,omitempty"`
+}
+
+type sliceEndpoint struct {
+	Addresses  []string                `json:"addresses"`
+	Conditions sliceConditions         `json:"conditions,omitempty"`
+	TargetRef  *kubev1.ObjectReference `json:"targetRef,omitempty"`
+	NodeName   *string                 `json:"nodeName,omitempty"`
+}
+
+type sliceConditions struct {
+	Ready *bool `json:"ready,omitempty"`
+}
+
+type slicePort struct {
+	Name     *string          `json:"name,omitempty"`
+	Protocol *kubev1.Protocol `json:"protocol,omitempty"`
+	Port     *int32           `json:"port,omitempty"`
+}
+
+// endpointsFromSlices mirrors the EndpointSlices into the Endpoints of their services, one subset per slice, so the
+// endpoints are filtered the same way whether the cluster serves the EndpointSlices or not
+func endpointsFromSlices(objects []runtime.Object) ([]*kubev1.Endpoints, error) {
+	var endpoints []*kubev1.Endpoints
+	for _, obj := range objects {
+		u, ok := obj.(*unstructured.Unstructured)
+		if !ok {
+			return nil, errors.Errorf("unexpected endpoint slice type %T", obj)
+		}
+		var slice endpointSlice
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &slice); err != nil {
+			return nil, errors.Wrapf(err, "converting endpoint slice %v.%v", u.GetNamespace(), u.GetName())
+		}
+		serviceName := slice.Labels[serviceNameLabel]
+		if serviceName == "" || slice.AddressType != addressTypeIPv4 {
+			continue
+		}
+		endpoints = append(endpoints, &kubev1.Endpoints{
+			ObjectMeta: metav1.ObjectMeta{Namespace: slice.Namespace, Name: serviceName},
+			Subsets:    []kubev1.EndpointSubset{sliceSubset(slice)},
+		})
+	}
+	return endpoints, nil
+}
+
+func sliceSubset(slice endpointSlice) kubev1.EndpointSubset {
+	var subset kubev1.EndpointSubset
+	for _, port := range slice.Ports {
+		if port.Port == nil {
+			// the slice applies to all the ports of the service, which the Endpoints can't express
+			continue
+		}
+		endpointPort := kubev1.EndpointPort{Port: *port.Port}
+		if port.Name != nil {
+			endpointPort.Name = *port.Name
+		}
+		if port.Protocol != nil {
+			endpointPort.Protocol = *port.Protocol
+		}
+		subset.Ports = append(subset.Ports, endpointPort)
+	}
+	for _, ep := range slice.Endpoints {
+		if len(ep.Addresses) == 0 {
+			continue
+		}
+		// the addresses of an endpoint are fungible, consumers use the first one
+		addr := kubev1.EndpointAddress{IP: ep.Addresses[0], TargetRef: ep.TargetRef, NodeName: ep.NodeName}
+		// an unknown readiness is read as ready
+		if ep.Conditions.Ready == nil || *ep.Conditions.Ready {
+			subset.Addresses = append(subset.Addresses, addr)
+		} else {
+			subset.NotReadyAddresses = append(subset.NotReadyAddresses, addr)
+		}
+	}
+	return subset
+}
@@ -0,0 +1,106 @@
+package kubernetes
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	kubeplugin "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/kubernetes"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	kubev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ = Describe("EndpointSlices", func() {
+
+	slice := func(name, addressType string, endpoints ...interface{}) runtime.Object {
+		return &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "discovery.k8s.io/v1",
+			"kind":       "EndpointSlice",
+			"metadata": map[string]interface{}{
+				"namespace": "default",
+				"name":      name,
+				"labels":    map[string]interface{}{serviceNameLabel: "petstore"},
+			},
+			"addressType": addressType,
+			"ports":       []interface{}{map[string]interface{}{"name": "http", "port": int64(8080)}},
+			"endpoints":   endpoints,
+		}}
+	}
+
+	endpoint := func(address string, ready bool) interface{} {
+		return map[string]interface{}{
+			"addresses":  []interface{}{address},
+			"conditions": map[string]interface{}{"ready": ready},
+		}
+	}
+
+	It("mirrors the ready endpoints of all the slices of a service", func() {
+		endpoints, err := endpointsFromSlices([]runtime.Object{
+			slice("petstore-abcde", addressTypeIPv4, endpoint("10.0.0.1", true), endpoint("10.0.0.2", false)),
+			slice("petstore-fghij", addressTypeIPv4, endpoint("10.0.0.3", true)),
+			slice("petstore-klmno", "IPv6", endpoint("fd00::4", true)),
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(endpoints).To(HaveLen(2))
+
+		svc := &kubev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "petstore"},
+			Spec:       kubev1.ServiceSpec{Ports: []kubev1.ServicePort{{Name: "http", Port: 80}}},
+		}
+		upstreams := map[core.ResourceRef]*kubeplugin.UpstreamSpec{
+			{Namespace: "gloo-system", Name: "default-petstore-80"}: {ServiceNamespace: "default", ServiceName: "petstore", ServicePort: 80},
+		}
+		eps := filterEndpoints(context.TODO(), "gloo-system", endpoints, []*kubev1.Service{svc}, nil, nil, upstreams)
+
+		var addresses []string
+		for _, ep := range eps {
+			Expect(ep.Port).To(Equal(uint32(8080)))
+			addresses = append(addresses, ep.Address)
+		}
+		Expect(addresses).To(ConsistOf("10.0.0.1", "10.0.0.3"))
+	})
+
+	It("ignores the slices not owned by a service", func() {
+		orphan := slice("custom", addressTypeIPv4, endpoint("10.0.0.1", true))
+		orphan.(*unstructured.Unstructured).SetLabels(nil)
+		endpoints, err := endpointsFromSlices([]runtime.Object{orphan})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(endpoints).To(BeEmpty())
+	})
+
+	It("locates the endpoints on the zones of their nodes", func() {
+		withNode := endpoint("10.0.0.1", true).(map[string]interface{})
+		withNode["nodeName"] = "node-b"
+		endpoints, err := endpointsFromSlices([]runtime.Object{
+			slice("petstore-abcde", addressTypeIPv4, withNode, endpoint("10.0.0.2", true)),
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		svc := &kubev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "petstore"},
+			Spec:       kubev1.ServiceSpec{Ports: []kubev1.ServicePort{{Name: "http", Port: 80}}},
+		}
+		nodes := []*kubev1.Node{{
+			ObjectMeta: metav1.ObjectMeta{Name: "node-b", Labels: map[string]string{
+				regionLabel:         "us-east1",
+				deprecatedZoneLabel: "us-east1-b",
+			}},
+		}}
+		upstreams := map[core.ResourceRef]*kubeplugin.UpstreamSpec{
+			{Namespace: "gloo-system", Name: "default-petstore-80"}: {ServiceNamespace: "default", ServiceName: "petstore", ServicePort: 80},
+		}
+		eps := filterEndpoints(context.TODO(), "gloo-system", endpoints, []*kubev1.Service{svc}, nil, nodes, upstreams)
+		Expect(eps).To(HaveLen(2))
+
+		localities := make(map[string]*v1.Locality)
+		for _, ep := range eps {
+			localities[ep.Address] = ep.Locality
+		}
+		Expect(localities["10.0.0.1"]).To(Equal(&v1.Locality{Region: "us-east1", Zone: "us-east1-b"}))
+		Expect(localities["10.0.0.2"]).To(BeNil())
+	})
+})
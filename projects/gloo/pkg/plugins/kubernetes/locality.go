@@ -0,0 +1,49 @@
+package kubernetes
+
+import (
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	kubev1 "k8s.io/api/core/v1"
+)
+
+// the labels of the nodes with their topology, the deprecated ones are read on the clusters older than kube 1.17
+const (
+	regionLabel           = "topology.kubernetes.io/region"
+	zoneLabel             = "topology.kubernetes.io/zone"
+	deprecatedRegionLabel = "failure-domain.beta.kubernetes.io/region"
+	deprecatedZoneLabel   = "failure-domain.beta.kubernetes.io/zone"
+)
+
+// the locality of the node of the endpoint, named by the endpoint or by its pod. Nil when the node is unknown or has
+// no topology labels.
+func endpointLocality(nodeName string, pod *kubev1.Pod, nodes []*kubev1.Node) *v1.Locality {
+	if nodeName == "" && pod != nil {
+		nodeName = pod.Spec.NodeName
+	}
+	if nodeName == "" {
+		return nil
+	}
+	for _, node := range nodes {
+		if node.Name == nodeName {
+			return nodeLocality(node)
+		}
+	}
+	return nil
+}
+
+func nodeLocality(node *kubev1.Node) *v1.Locality {
+	locality := &v1.Locality{
+		Region: topologyLabel(node, regionLabel, deprecatedRegionLabel),
+		Zone:   topologyLabel(node, zoneLabel, deprecatedZoneLabel),
+	}
+	if locality.Region == "" && locality.Zone == "" {
+		return nil
+	}
+	return locality
+}
+
+func topologyLabel(node *kubev1.Node, label, deprecatedLabel string) string {
+	if value := node.Labels[label]; value != "" {
+		return value
+	}
+	return node.Labels[deprecatedLabel]
+}
@@ -0,0 +1,120 @@
+package kubernetes
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	kubeplugin "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/kubernetes"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	kubev1 "k8s.io/api/core/v1"
+	kubeerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	kubeinformers "k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes/fake"
+	kubelisters "k8s.io/client-go/listers/core/v1"
+	kubetesting "k8s.io/client-go/testing"
+)
+
+var _ = Describe("Locality", func() {
+
+	var (
+		ctx    context.Context
+		cancel context.CancelFunc
+		client *fake.Clientset
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		client = fake.NewSimpleClientset(&kubev1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   "node-1",
+				Labels: map[string]string{zoneLabel: "us-east1-b"},
+			},
+		})
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	listers := func() *KubePluginListers {
+		return &KubePluginListers{
+			ctx:                 ctx,
+			client:              client,
+			kubeInformerFactory: kubeinformers.NewSharedInformerFactory(client, time.Hour),
+		}
+	}
+
+	It("watches the nodes from the first listing", func() {
+		k := listers()
+		updated := k.Subscribe()
+
+		nodes, err := k.NodesLister().List(labels.Everything())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(nodes).To(HaveLen(1))
+		Eventually(updated).Should(Receive())
+	})
+
+	It("ignores the updates of the nodes that keep their topology", func() {
+		k := listers()
+		updated := k.Subscribe()
+		Expect(k.NodesLister()).NotTo(BeNil())
+		Eventually(updated).Should(Receive())
+
+		node, err := client.CoreV1().Nodes().Get("node-1", metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		node.Status.Phase = kubev1.NodeRunning
+		_, err = client.CoreV1().Nodes().Update(node)
+		Expect(err).NotTo(HaveOccurred())
+		Consistently(updated, 100*time.Millisecond).ShouldNot(Receive())
+
+		node.Labels[zoneLabel] = "us-east1-c"
+		_, err = client.CoreV1().Nodes().Update(node)
+		Expect(err).NotTo(HaveOccurred())
+		Eventually(updated).Should(Receive())
+	})
+
+	It("has no nodes lister when gloo may not list the nodes", func() {
+		client.PrependReactor("list", "nodes", func(action kubetesting.Action) (bool, runtime.Object, error) {
+			return true, nil, kubeerrors.NewForbidden(schema.GroupResource{Resource: "nodes"}, "", nil)
+		})
+		Expect(listers().NodesLister()).To(BeNil())
+	})
+
+	It("only lists the nodes for the upstreams with localities", func() {
+		factory := &nodesListerFactory{}
+		upstream := &v1.Upstream{
+			Metadata: core.Metadata{Name: "petstore", Namespace: "gloo-system"},
+			UpstreamSpec: &v1.UpstreamSpec{
+				UpstreamType: &v1.UpstreamSpec_Kube{Kube: &kubeplugin.UpstreamSpec{ServiceName: "petstore"}},
+			},
+		}
+
+		nodes, err := newEndpointsWatcher(client, factory, v1.UpstreamList{upstream}).listNodes()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(nodes).To(BeEmpty())
+		Expect(factory.listed).To(BeFalse())
+
+		upstream.UpstreamSpec.LoadBalancerConfig = &v1.LoadBalancerConfig{LocalityWeighted: true}
+		_, err = newEndpointsWatcher(client, factory, v1.UpstreamList{upstream}).listNodes()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(factory.listed).To(BeTrue())
+	})
+})
+
+// records whether the nodes were listed
+type nodesListerFactory struct {
+	KubePluginListers
+	listed bool
+}
+
+func (f *nodesListerFactory) NodesLister() kubelisters.NodeLister {
+	f.listed = true
+	return nil
+}
@@ -0,0 +1,97 @@
+package kubernetes
+
+import (
+	"context"
+	"strings"
+
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins"
+	"github.com/solo-io/go-utils/contextutils"
+	kubev1 "k8s.io/api/core/v1"
+)
+
+// GlooMergePortsAnnotation set to `"true"` asks for a single upstream routing to all the ports of a service, instead
+// of an upstream per port
+const GlooMergePortsAnnotation = "gloo.solo.io/merge_ports"
+
+// PortSubsetKey is the key of the subsets selecting a port of the upstreams routing to several ports, whose
+// endpoints are labeled with the name of their port
+const PortSubsetKey = "gloo.solo.io/port"
+
+// only the ports of services with several ports can be merged, kube requires them to be named
+func wantsMergedPorts(ctx context.Context, svc *kubev1.Service) bool {
+	if svc.Annotations[GlooMergePortsAnnotation] != "true" || len(svc.Spec.Ports) < 2 {
+		return false
+	}
+	for _, port := range svc.Spec.Ports {
+		if port.Name == "" {
+			return false
+		}
+	}
+	if !sameProtocols(svc) {
+		contextutils.LoggerFrom(ctx).Warnf("service %v.%v keeps an upstream per port, as the protocols of its ports "+
+			"differ and a merged upstream speaks the protocol of the first port to all of them", svc.Namespace, svc.Name)
+		return false
+	}
+	return true
+}
+
+// the merged upstream has the service spec and the ssl config of the first port, which must suit the other ports
+func sameProtocols(svc *kubev1.Service) bool {
+	first := svc.Spec.Ports[0]
+	serviceSpec, sslConfig := GetServiceSpec(svc, first), GetSslConfig(svc, first)
+	for _, port := range svc.Spec.Ports[1:] {
+		if !serviceSpec.Equal(GetServiceSpec(svc, port)) || !sslConfig.Equal(GetSslConfig(svc, port)) {
+			return false
+		}
+	}
+	return true
+}
+
+// turns the upstream of the first port of the service into the upstream of all its ports
+func mergePorts(svc *kubev1.Service, us *v1.Upstream) {
+	kubeSpec := us.UpstreamSpec.GetKube()
+	kubeSpec.ServicePortNames = nil
+	for _, port := range svc.Spec.Ports {
+		kubeSpec.ServicePortNames = append(kubeSpec.ServicePortNames, port.Name)
+	}
+	kubeSpec.SubsetSpec = withPortSubsets(kubeSpec.SubsetSpec)
+
+	extraLabels := make(map[string]string)
+	for k, v := range kubeSpec.Selector {
+		if _, ok := svc.Spec.Selector[k]; !ok {
+			extraLabels[k] = v
+		}
+	}
+	us.Metadata.Name = strings.ToLower(MergedUpstreamName(svc.Namespace, svc.Name, extraLabels))
+}
+
+// the port key is added to each selector, and a selector of the port alone to select the ports of all the pods
+func withPortSubsets(spec *plugins.SubsetSpec) *plugins.SubsetSpec {
+	merged := &plugins.SubsetSpec{}
+	hasPortSelector := false
+	if spec != nil {
+		for _, selector := range spec.Selectors {
+			keys := selector.Keys
+			if !containsString(PortSubsetKey, keys) {
+				keys = append(append([]string{}, keys...), PortSubsetKey)
+			}
+			hasPortSelector = hasPortSelector || len(keys) == 1
+			merged.Selectors = append(merged.Selectors, &plugins.Selector{Keys: keys})
+		}
+	}
+	if !hasPortSelector {
+		merged.Selectors = append(merged.Selectors, &plugins.Selector{Keys: []string{PortSubsetKey}})
+	}
+	return merged
+}
+
+// whether a selector of the subset spec has the port key
+func hasPortSubsets(spec *plugins.SubsetSpec) bool {
+	for _, selector := range spec.GetSelectors() {
+		if containsString(PortSubsetKey, selector.Keys) {
+			return true
+		}
+	}
+	return false
+}
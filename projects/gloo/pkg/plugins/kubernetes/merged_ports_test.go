@@ -0,0 +1,157 @@
+package kubernetes
+
+import (
+	"context"
+
+	envoyapi "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	v1plugins "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins"
+	kubeplugin "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/kubernetes"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	kubev1 "k8s.io/api/core/v1"
+)
+
+var _ = Describe("MergedPorts", func() {
+
+	var (
+		svc  *kubev1.Service
+		pods []*kubev1.Pod
+	)
+
+	BeforeEach(func() {
+		svc = &kubev1.Service{
+			Spec: kubev1.ServiceSpec{
+				Selector: map[string]string{"app": "api"},
+				Ports: []kubev1.ServicePort{
+					{Name: "http", Port: 80},
+					{Name: "api", Port: 9090},
+					{Name: "admin", Port: 9000},
+				},
+			},
+		}
+		svc.Name = "api"
+		svc.Namespace = "default"
+		svc.Annotations = map[string]string{GlooMergePortsAnnotation: "true"}
+		pod := &kubev1.Pod{}
+		pod.Name = "api-0"
+		pod.Namespace = "default"
+		pod.Labels = map[string]string{"app": "api"}
+		pod.Status.PodIP = "10.0.0.1"
+		pods = []*kubev1.Pod{pod}
+	})
+
+	It("should create a single upstream for the ports of annotated services", func() {
+		upstreams := DefaultUpstreamConverter().UpstreamsForService(context.TODO(), svc, pods)
+		Expect(upstreams).To(HaveLen(1))
+		Expect(upstreams[0].Metadata.Name).To(Equal("default-api"))
+		kubeSpec := upstreams[0].UpstreamSpec.GetKube()
+		Expect(kubeSpec.ServicePort).To(BeEquivalentTo(80))
+		Expect(kubeSpec.ServicePortNames).To(Equal([]string{"http", "api", "admin"}))
+		Expect(kubeSpec.SubsetSpec).To(Equal(&v1plugins.SubsetSpec{
+			Selectors: []*v1plugins.Selector{{Keys: []string{PortSubsetKey}}},
+		}))
+	})
+
+	It("should select the ports in the subsets of the annotation", func() {
+		svc.Annotations[GlooSubsetsAnnotation] = "version"
+		upstreams := DefaultUpstreamConverter().UpstreamsForService(context.TODO(), svc, pods)
+		Expect(upstreams[0].UpstreamSpec.GetKube().SubsetSpec).To(Equal(&v1plugins.SubsetSpec{
+			Selectors: []*v1plugins.Selector{
+				{Keys: []string{"version", PortSubsetKey}},
+				{Keys: []string{PortSubsetKey}},
+			},
+		}))
+	})
+
+	It("should not merge the ports of different protocols", func() {
+		svc.Spec.Ports[1].Name = "grpc-api"
+		upstreams := DefaultUpstreamConverter().UpstreamsForService(context.TODO(), svc, pods)
+		Expect(upstreams).To(HaveLen(3))
+		Expect(upstreams[1].Metadata.Name).To(Equal("default-api-9090"))
+		Expect(upstreams[1].UpstreamSpec.GetKube().ServiceSpec.GetGrpc()).NotTo(BeNil())
+		Expect(upstreams[0].UpstreamSpec.GetKube().ServiceSpec).To(BeNil())
+	})
+
+	It("should not merge a tls port with the others", func() {
+		svc.Annotations[GlooAppProtocolsAnnotation] = "admin=https"
+		upstreams := DefaultUpstreamConverter().UpstreamsForService(context.TODO(), svc, pods)
+		Expect(upstreams).To(HaveLen(3))
+		Expect(upstreams[0].UpstreamSpec.SslConfig).To(BeNil())
+		Expect(upstreams[2].UpstreamSpec.SslConfig).NotTo(BeNil())
+	})
+
+	It("should merge the ports when they all speak tls", func() {
+		svc.Annotations[GlooSslServiceAnnotation] = "true"
+		upstreams := DefaultUpstreamConverter().UpstreamsForService(context.TODO(), svc, pods)
+		Expect(upstreams).To(HaveLen(1))
+		Expect(upstreams[0].UpstreamSpec.SslConfig).NotTo(BeNil())
+	})
+
+	It("should not merge the ports of services with a single port", func() {
+		svc.Spec.Ports = svc.Spec.Ports[:1]
+		upstreams := DefaultUpstreamConverter().UpstreamsForService(context.TODO(), svc, pods)
+		Expect(upstreams).To(HaveLen(1))
+		Expect(upstreams[0].Metadata.Name).To(Equal("default-api-80"))
+		Expect(upstreams[0].UpstreamSpec.GetKube().ServicePortNames).To(BeEmpty())
+	})
+
+	It("should label the endpoints with the names of their ports", func() {
+		eps := &kubev1.Endpoints{
+			Subsets: []kubev1.EndpointSubset{{
+				Ports: []kubev1.EndpointPort{
+					{Name: "http", Port: 8080},
+					{Name: "grpc-api", Port: 9090},
+					{Name: "metrics", Port: 9102},
+				},
+				Addresses: []kubev1.EndpointAddress{
+					{IP: "10.0.0.1", TargetRef: &kubev1.ObjectReference{Kind: "Pod", Name: "api-0", Namespace: "default"}},
+				},
+			}},
+		}
+		eps.Name = "api"
+		eps.Namespace = "default"
+		upstreams := map[core.ResourceRef]*kubeplugin.UpstreamSpec{
+			{Namespace: "gloo-system", Name: "default-api"}: {
+				ServiceNamespace: "default",
+				ServiceName:      "api",
+				ServicePort:      80,
+				ServicePortNames: []string{"http", "grpc-api"},
+			},
+		}
+		endpoints := filterEndpoints(context.TODO(), "gloo-system", []*kubev1.Endpoints{eps}, []*kubev1.Service{svc}, pods, nil, upstreams)
+		Expect(endpoints).To(HaveLen(2))
+		portNames := make(map[uint32]string)
+		for _, ep := range endpoints {
+			portNames[ep.Port] = ep.Metadata.Labels[PortSubsetKey]
+			Expect(ep.Metadata.Labels).To(HaveKeyWithValue("app", "api"))
+		}
+		Expect(portNames).To(Equal(map[uint32]string{8080: "http", 9090: "grpc-api"}))
+		// the labels of the pod are left as is
+		Expect(pods[0].Labels).NotTo(HaveKey(PortSubsetKey))
+	})
+
+	It("should route to the first port by default", func() {
+		us := &v1.Upstream{
+			UpstreamSpec: &v1.UpstreamSpec{
+				UpstreamType: &v1.UpstreamSpec_Kube{
+					Kube: &kubeplugin.UpstreamSpec{
+						ServicePortNames: []string{"http", "grpc-api"},
+						SubsetSpec:       withPortSubsets(nil),
+					},
+				},
+			},
+		}
+		out := &envoyapi.Cluster{LbSubsetConfig: &envoyapi.Cluster_LbSubsetConfig{}}
+		err := NewPlugin(nil).(*plugin).ProcessUpstream(plugins.Params{}, us, out)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out.LbSubsetConfig.FallbackPolicy).To(Equal(envoyapi.Cluster_LbSubsetConfig_DEFAULT_SUBSET))
+		Expect(out.LbSubsetConfig.DefaultSubset.Fields[PortSubsetKey].GetStringValue()).To(Equal("http"))
+
+		us.UpstreamSpec.GetKube().SubsetSpec = nil
+		err = NewPlugin(nil).(*plugin).ProcessUpstream(plugins.Params{}, us, &envoyapi.Cluster{})
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -5,9 +5,12 @@ import (
 	"net/url"
 
 	envoyapi "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	"github.com/gogo/protobuf/types"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	kubeplugin "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/kubernetes"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
 	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
+	"github.com/solo-io/solo-kit/pkg/errors"
 	"k8s.io/client-go/kubernetes"
 )
 
@@ -41,12 +44,36 @@ func (p *plugin) Init(params plugins.InitParams) error {
 
 func (p *plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *envoyapi.Cluster) error {
 	// not ours
-	_, ok := in.UpstreamSpec.UpstreamType.(*v1.UpstreamSpec_Kube)
+	kubeSpec, ok := in.UpstreamSpec.UpstreamType.(*v1.UpstreamSpec_Kube)
 	if !ok {
 		return nil
 	}
 
 	// configure the cluster to use EDS:ADS and call it a day
 	xds.SetEdsOnCluster(out)
+
+	if len(kubeSpec.Kube.ServicePortNames) > 0 {
+		return setDefaultPortSubset(kubeSpec.Kube, out)
+	}
+	return nil
+}
+
+// the cluster only depends on the upstream
+func (p *plugin) CacheableUpstream(in *v1.Upstream) bool {
+	return true
+}
+
+// the destinations that do not select a port of the upstream are routed to its first port
+func setDefaultPortSubset(spec *kubeplugin.UpstreamSpec, out *envoyapi.Cluster) error {
+	if out.LbSubsetConfig == nil || !hasPortSubsets(spec.SubsetSpec) {
+		return errors.Errorf("upstream routing to the ports %v requires a subset selector with the key %v",
+			spec.ServicePortNames, PortSubsetKey)
+	}
+	out.LbSubsetConfig.FallbackPolicy = envoyapi.Cluster_LbSubsetConfig_DEFAULT_SUBSET
+	out.LbSubsetConfig.DefaultSubset = &types.Struct{
+		Fields: map[string]*types.Value{
+			PortSubsetKey: {Kind: &types.Value_StringValue{StringValue: spec.ServicePortNames[0]}},
+		},
+	}
 	return nil
 }
@@ -0,0 +1,35 @@
+package kubernetes
+
+import (
+	"strings"
+
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins"
+	kubev1 "k8s.io/api/core/v1"
+)
+
+// GlooSubsetsAnnotation partitions the upstreams of a service into subsets of its pods by their labels, so that routes
+// can select e.g. a version of the service instead of discovery creating an upstream per set of pod labels: a
+// semicolon separated list of selectors, each a comma separated list of pod label keys, e.g. `version` or
+// `version;version,zone`
+const GlooSubsetsAnnotation = "gloo.solo.io/subsets"
+
+// GetSubsetSpec returns the subsets of the subsets annotation of the service. Nil if the service is not annotated.
+func GetSubsetSpec(svc *kubev1.Service) *plugins.SubsetSpec {
+	var spec *plugins.SubsetSpec
+	for _, selector := range strings.Split(svc.Annotations[GlooSubsetsAnnotation], ";") {
+		var keys []string
+		for _, key := range strings.Split(selector, ",") {
+			if key = strings.TrimSpace(key); key != "" && !containsString(key, keys) {
+				keys = append(keys, key)
+			}
+		}
+		if len(keys) == 0 {
+			continue
+		}
+		if spec == nil {
+			spec = &plugins.SubsetSpec{}
+		}
+		spec.Selectors = append(spec.Selectors, &plugins.Selector{Keys: keys})
+	}
+	return spec
+}
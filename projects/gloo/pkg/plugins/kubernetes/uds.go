@@ -25,6 +25,10 @@ func (p *plugin) DiscoverUpstreams(watchNamespaces []string, writeNamespace stri
 	ctx := contextutils.WithLogger(opts.Ctx, "kube-uds")
 	logger := contextutils.LoggerFrom(ctx)
 
+	if _, err := NewUpstreamNamer(discOpts.KubeOpts.UpstreamNameTemplate); err != nil {
+		return nil, nil, err
+	}
+
 	logger.Infow("started", "watchns", watchNamespaces, "writens", writeNamespace)
 
 	watch := p.kubeShareFactory.Subscribe()
@@ -72,6 +76,9 @@ func (p *plugin) DiscoverUpstreams(watchNamespaces []string, writeNamespace stri
 
 func (p *plugin) ConvertServices(ctx context.Context, watchNamespaces []string, services []*kubev1.Service, pods []*kubev1.Pod, opts discovery.Opts, writeNamespace string) v1.UpstreamList {
 	var upstreams v1.UpstreamList
+	// validated when the discovery started
+	namer, _ := NewUpstreamNamer(opts.KubeOpts.UpstreamNameTemplate)
+	defaultNames := make(map[*v1.Upstream]string)
 	for _, svc := range services {
 		if skip(svc, opts) {
 			continue
@@ -86,9 +93,21 @@ func (p *plugin) ConvertServices(ctx context.Context, watchNamespaces []string,
 		upstreamsToCreate := p.UpstreamConverter.UpstreamsForService(ctx, svc, pods)
 		for _, u := range upstreamsToCreate {
 			u.Metadata.Namespace = writeNamespace
+			defaultNames[u] = u.Metadata.Name
+		}
+		if namer != nil {
+			if err := namer.Rename(svc, upstreamsToCreate); err != nil {
+				contextutils.LoggerFrom(ctx).Errorf("service %v.%v keeps the default upstream names: %v", svc.Namespace, svc.Name, err)
+				for _, u := range upstreamsToCreate {
+					u.Metadata.Name = defaultNames[u]
+				}
+			}
 		}
 
 		upstreams = append(upstreams, upstreamsToCreate...)
 	}
+	if namer != nil {
+		deduplicateUpstreamNames(upstreams, defaultNames)
+	}
 	return upstreams
 }
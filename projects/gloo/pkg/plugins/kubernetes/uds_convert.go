@@ -21,6 +21,13 @@ import (
 
 const GlooH2Annotation = "gloo.solo.io/h2_service"
 
+// GlooPerPodUpstreamsAnnotation asks for an upstream per pod of a headless service, besides the upstreams of the
+// service, so that the members of a StatefulSet can be routed to individually
+const GlooPerPodUpstreamsAnnotation = "gloo.solo.io/per_pod_upstreams"
+
+// set by the StatefulSet controller on each of its pods
+const statefulSetPodNameLabel = "statefulset.kubernetes.io/pod-name"
+
 var ignoredLabels = []string{
 	"pod-template-hash",        // it is common and provides nothing useful for discovery
 	"controller-revision-hash", // set by helm
@@ -45,12 +52,80 @@ type KubeUpstreamConverter struct {
 func (uc *KubeUpstreamConverter) UpstreamsForService(ctx context.Context, svc *kubev1.Service, pods []*kubev1.Pod) v1.UpstreamList {
 
 	uniqueLabelSets := GetUniqueLabelSets(svc, pods)
-	return uc.CreateUpstreamForLabels(ctx, uniqueLabelSets, svc)
+	if GetSubsetSpec(svc) != nil {
+		// the pods are selected by the subsets of the upstreams of the service
+		uniqueLabelSets = []map[string]string{svc.Spec.Selector}
+	}
+	if wantsPerPodUpstreams(svc) {
+		// the per pod upstreams select the pods individually, the upstreams of the labels would be named the same
+		uniqueLabelSets = withoutLabel(uniqueLabelSets, statefulSetPodNameLabel)
+	}
+	upstreams := uc.CreateUpstreamForLabels(ctx, uniqueLabelSets, svc)
+	if wantsPerPodUpstreams(svc) {
+		upstreams = append(upstreams, uc.CreateUpstreamsForPods(ctx, svc, pods)...)
+	}
+	return upstreams
+}
+
+// removes the label from the label sets, dropping the sets left empty or duplicated
+func withoutLabel(labelSets []map[string]string, label string) []map[string]string {
+	var result []map[string]string
+	for i, labelSet := range labelSets {
+		trimmed := make(map[string]string, len(labelSet))
+		for k, v := range labelSet {
+			if k != label {
+				trimmed[k] = v
+			}
+		}
+		// the first set is the selector of the service
+		if i > 0 && (len(trimmed) == 0 || containsMap(result, trimmed)) {
+			continue
+		}
+		result = append(result, trimmed)
+	}
+	return result
+}
+
+// CreateUpstreamsForPods creates an upstream per port of the service for each pod it selects
+func (uc *KubeUpstreamConverter) CreateUpstreamsForPods(ctx context.Context, svc *kubev1.Service, pods []*kubev1.Pod) v1.UpstreamList {
+	var upstreams v1.UpstreamList
+	for _, pod := range pods {
+		if pod.Namespace != svc.Namespace || !labels.AreLabelsInWhiteList(svc.Spec.Selector, pod.Labels) {
+			continue
+		}
+		for _, port := range svc.Spec.Ports {
+			upstream := uc.createUpstream(ctx, svc, port, svc.Spec.Selector)
+			// the pods of a StatefulSet are named by their hostname
+			upstream.Metadata.Name = strings.ToLower(UpstreamName(svc.Namespace, svc.Name, port.Port, map[string]string{"hostname": podHostname(pod)}))
+			upstream.UpstreamSpec.UpstreamType.(*v1.UpstreamSpec_Kube).Kube.PodName = pod.Name
+			upstreams = append(upstreams, upstream)
+		}
+	}
+	return upstreams
+}
+
+// only the pods of headless services are addressed individually, the others are behind a virtual ip
+func wantsPerPodUpstreams(svc *kubev1.Service) bool {
+	return svc.Spec.ClusterIP == kubev1.ClusterIPNone && len(svc.Spec.Selector) > 0 &&
+		svc.Annotations[GlooPerPodUpstreamsAnnotation] == "true"
+}
+
+func podHostname(pod *kubev1.Pod) string {
+	if pod.Spec.Hostname != "" {
+		return pod.Spec.Hostname
+	}
+	return pod.Name
 }
 
 func (uc *KubeUpstreamConverter) CreateUpstreamForLabels(ctx context.Context, uniqueLabelSets []map[string]string, svc *kubev1.Service) v1.UpstreamList {
 	var upstreams v1.UpstreamList
 	for _, extendedLabels := range uniqueLabelSets {
+		if wantsMergedPorts(ctx, svc) {
+			upstream := uc.createUpstream(ctx, svc, svc.Spec.Ports[0], extendedLabels)
+			mergePorts(svc, upstream)
+			upstreams = append(upstreams, upstream)
+			continue
+		}
 		for _, port := range svc.Spec.Ports {
 			upstreams = append(upstreams, uc.createUpstream(ctx, svc, port, extendedLabels))
 		}
@@ -125,8 +200,10 @@ func createUpstream(ctx context.Context, svc *kubev1.Service, port kubev1.Servic
 					ServiceNamespace: meta.Namespace,
 					ServicePort:      uint32(port.Port),
 					Selector:         labels,
+					SubsetSpec:       GetSubsetSpec(svc),
 				},
 			},
+			SslConfig: GetSslConfig(svc, port),
 		},
 		DiscoveryMetadata: &v1.DiscoveryMetadata{},
 	}
@@ -147,7 +224,7 @@ func GetServiceSpec(svc *kubev1.Service, port kubev1.ServicePort) *plugins.Servi
 		}
 	}
 
-	if strings.HasPrefix(port.Name, "grpc") || strings.HasPrefix(port.Name, "h2") {
+	if AppProtocol(svc, port) == AppProtocolHttp2 {
 		return grpcSpec
 	}
 
@@ -155,6 +232,15 @@ func GetServiceSpec(svc *kubev1.Service, port kubev1.ServicePort) *plugins.Servi
 }
 
 func UpstreamName(serviceNamespace, serviceName string, servicePort int32, extraLabels map[string]string) string {
+	return upstreamName(serviceNamespace, serviceName, fmt.Sprintf("-%v", servicePort), extraLabels)
+}
+
+// MergedUpstreamName names the upstreams routing to all the ports of a service
+func MergedUpstreamName(serviceNamespace, serviceName string, extraLabels map[string]string) string {
+	return upstreamName(serviceNamespace, serviceName, "", extraLabels)
+}
+
+func upstreamName(serviceNamespace, serviceName, portTag string, extraLabels map[string]string) string {
 	const maxLen = 63
 
 	var labelsTag string
@@ -162,7 +248,7 @@ func UpstreamName(serviceNamespace, serviceName string, servicePort int32, extra
 		_, values := keysAndValues(extraLabels)
 		labelsTag = fmt.Sprintf("-%v", strings.Join(values, "-"))
 	}
-	name := fmt.Sprintf("%s-%s%s-%v", serviceNamespace, serviceName, labelsTag, servicePort)
+	name := fmt.Sprintf("%s-%s%s%s", serviceNamespace, serviceName, labelsTag, portTag)
 	if len(name) > maxLen {
 		hash := md5.Sum([]byte(name))
 		hexhash := fmt.Sprintf("%x", hash)
@@ -238,8 +324,9 @@ func UpdateUpstream(original, desired *v1.Upstream) (bool, error) {
 	// copy labels; user may have written them over. cannot be auto-discovered
 	desiredSpec.Kube.Selector = originalSpec.Kube.Selector
 
-	// do not override ssl and subset config if none specified by discovery
-	if desired.UpstreamSpec.SslConfig == nil {
+	// do not override ssl and subset config if none specified by discovery. the ssl config belongs to discovery while
+	// the service has ssl annotations, so that it is removed with them.
+	if desired.UpstreamSpec.SslConfig == nil && !SslAnnotated(original.Metadata.Annotations, originalSpec.Kube.ServicePort) {
 		desired.UpstreamSpec.SslConfig = original.UpstreamSpec.SslConfig
 	}
 	if desired.UpstreamSpec.CircuitBreakers == nil {
@@ -258,7 +345,7 @@ func UpdateUpstream(original, desired *v1.Upstream) (bool, error) {
 		}
 	}
 
-	if originalSpec.Equal(desiredSpec) {
+	if original.UpstreamSpec.Equal(desired.UpstreamSpec) {
 		return false, nil
 	}
 
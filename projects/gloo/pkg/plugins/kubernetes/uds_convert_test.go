@@ -4,6 +4,9 @@ import (
 	"context"
 	"strings"
 
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins"
+	kubeplugin "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/kubernetes"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 	kubev1 "k8s.io/api/core/v1"
 
 	. "github.com/onsi/ginkgo"
@@ -111,4 +114,139 @@ var _ = Describe("UdsConvert", func() {
 			Entry("prefix h2", "h2-test"),
 		)
 	})
+
+	Context("per pod upstreams", func() {
+		var (
+			svc  *kubev1.Service
+			pods []*kubev1.Pod
+		)
+
+		pod := func(name, ip string) *kubev1.Pod {
+			p := &kubev1.Pod{}
+			p.Name = name
+			p.Namespace = "default"
+			p.Labels = map[string]string{"app": "db", "statefulset.kubernetes.io/pod-name": name}
+			p.Spec.Hostname = name
+			p.Status.PodIP = ip
+			p.Status.Phase = kubev1.PodRunning
+			return p
+		}
+
+		BeforeEach(func() {
+			svc = &kubev1.Service{
+				Spec: kubev1.ServiceSpec{
+					ClusterIP: kubev1.ClusterIPNone,
+					Selector:  map[string]string{"app": "db"},
+					Ports:     []kubev1.ServicePort{{Name: "sql", Port: 5432}},
+				},
+			}
+			svc.Name = "db"
+			svc.Namespace = "default"
+			svc.Annotations = map[string]string{GlooPerPodUpstreamsAnnotation: "true"}
+			pods = []*kubev1.Pod{pod("db-0", "10.0.0.1"), pod("db-1", "10.0.0.2")}
+		})
+
+		It("should create an upstream per pod of annotated headless services", func() {
+			upstreams := DefaultUpstreamConverter().UpstreamsForService(context.TODO(), svc, pods)
+			var names, podNames []string
+			for _, us := range upstreams {
+				names = append(names, us.Metadata.Name)
+				podNames = append(podNames, us.UpstreamSpec.GetKube().PodName)
+			}
+			Expect(names).To(ConsistOf("default-db-5432", "default-db-db-0-5432", "default-db-db-1-5432"))
+			Expect(podNames).To(ConsistOf("", "db-0", "db-1"))
+		})
+
+		It("should not create upstreams per pod of services with a virtual ip", func() {
+			svc.Spec.ClusterIP = "10.96.0.10"
+			upstreams := DefaultUpstreamConverter().UpstreamsForService(context.TODO(), svc, pods)
+			var podNames []string
+			for _, us := range upstreams {
+				podNames = append(podNames, us.UpstreamSpec.GetKube().PodName)
+			}
+			Expect(podNames).To(ConsistOf("", "", ""))
+		})
+
+		It("should keep the other labels of the pods", func() {
+			pods[1].Labels["version"] = "v2"
+			upstreams := DefaultUpstreamConverter().UpstreamsForService(context.TODO(), svc, pods)
+			var names []string
+			for _, us := range upstreams {
+				names = append(names, us.Metadata.Name)
+			}
+			Expect(names).To(ConsistOf("default-db-5432", "default-db-v2-5432", "default-db-db-0-5432", "default-db-db-1-5432"))
+		})
+
+		It("should only send the endpoint of the pod of the upstream", func() {
+			eps := &kubev1.Endpoints{
+				Subsets: []kubev1.EndpointSubset{{
+					Ports: []kubev1.EndpointPort{{Name: "sql", Port: 5432}},
+					Addresses: []kubev1.EndpointAddress{
+						{IP: "10.0.0.1", TargetRef: &kubev1.ObjectReference{Kind: "Pod", Name: "db-0", Namespace: "default"}},
+						{IP: "10.0.0.2", TargetRef: &kubev1.ObjectReference{Kind: "Pod", Name: "db-1", Namespace: "default"}},
+					},
+				}},
+			}
+			eps.Name = "db"
+			eps.Namespace = "default"
+			upstreams := map[core.ResourceRef]*kubeplugin.UpstreamSpec{
+				{Namespace: "gloo-system", Name: "default-db-db-1-5432"}: {ServiceNamespace: "default", ServiceName: "db", ServicePort: 5432, PodName: "db-1"},
+			}
+			endpoints := filterEndpoints(context.TODO(), "gloo-system", []*kubev1.Endpoints{eps}, []*kubev1.Service{svc}, pods, nil, upstreams)
+			Expect(endpoints).To(HaveLen(1))
+			Expect(endpoints[0].Address).To(Equal("10.0.0.2"))
+		})
+	})
+
+	Context("subsets", func() {
+
+		var (
+			svc  *kubev1.Service
+			pods []*kubev1.Pod
+		)
+
+		pod := func(name, version string) *kubev1.Pod {
+			p := &kubev1.Pod{}
+			p.Name = name
+			p.Namespace = "default"
+			p.Labels = map[string]string{"app": "reviews", "version": version}
+			return p
+		}
+
+		BeforeEach(func() {
+			svc = &kubev1.Service{
+				Spec: kubev1.ServiceSpec{
+					Selector: map[string]string{"app": "reviews"},
+					Ports:    []kubev1.ServicePort{{Name: "http", Port: 9080}},
+				},
+			}
+			svc.Name = "reviews"
+			svc.Namespace = "default"
+			pods = []*kubev1.Pod{pod("reviews-v1", "v1"), pod("reviews-v2", "v2")}
+		})
+
+		It("should create an upstream per set of pod labels of services without subsets", func() {
+			upstreams := DefaultUpstreamConverter().UpstreamsForService(context.TODO(), svc, pods)
+			Expect(upstreams).To(HaveLen(3))
+			Expect(upstreams[0].UpstreamSpec.GetKube().SubsetSpec).To(BeNil())
+		})
+
+		It("should create a single upstream with the subsets of the annotation", func() {
+			svc.Annotations = map[string]string{GlooSubsetsAnnotation: "version; version, zone"}
+			upstreams := DefaultUpstreamConverter().UpstreamsForService(context.TODO(), svc, pods)
+			Expect(upstreams).To(HaveLen(1))
+			Expect(upstreams[0].Metadata.Name).To(Equal("default-reviews-9080"))
+			Expect(upstreams[0].UpstreamSpec.GetKube().SubsetSpec).To(Equal(&plugins.SubsetSpec{
+				Selectors: []*plugins.Selector{
+					{Keys: []string{"version"}},
+					{Keys: []string{"version", "zone"}},
+				},
+			}))
+		})
+
+		It("should ignore empty subsets", func() {
+			svc.Annotations = map[string]string{GlooSubsetsAnnotation: " ; ,"}
+			Expect(GetSubsetSpec(svc)).To(BeNil())
+		})
+	})
 })
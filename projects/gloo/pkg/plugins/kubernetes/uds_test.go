@@ -36,4 +36,48 @@ var _ = Describe("Uds", func() {
 		Expect(desired.UpstreamSpec.SslConfig).To(BeIdenticalTo(original.UpstreamSpec.SslConfig))
 	})
 
+	Context("ssl config", func() {
+		var original, desired *gloov1.Upstream
+
+		BeforeEach(func() {
+			original = &gloov1.Upstream{
+				UpstreamSpec: &gloov1.UpstreamSpec{
+					UpstreamType: &gloov1.UpstreamSpec_Kube{
+						Kube: &gloov1kube.UpstreamSpec{ServiceName: "test", ServicePort: 8443},
+					},
+				},
+			}
+			desired = &gloov1.Upstream{
+				UpstreamSpec: &gloov1.UpstreamSpec{
+					UpstreamType: &gloov1.UpstreamSpec_Kube{
+						Kube: &gloov1kube.UpstreamSpec{ServiceName: "test", ServicePort: 8443},
+					},
+				},
+			}
+		})
+
+		It("should update the upstreams whose ssl config is discovered", func() {
+			desired.UpstreamSpec.SslConfig = &gloov1.UpstreamSslConfig{Sni: "test.default.svc.cluster.local"}
+			updated, err := UpdateUpstream(original, desired)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updated).To(BeTrue())
+		})
+
+		It("should not update the upstreams that did not change", func() {
+			original.UpstreamSpec.SslConfig = &gloov1.UpstreamSslConfig{Sni: "test.default.svc.cluster.local"}
+			desired.UpstreamSpec.SslConfig = &gloov1.UpstreamSslConfig{Sni: "test.default.svc.cluster.local"}
+			updated, err := UpdateUpstream(original, desired)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updated).To(BeFalse())
+		})
+
+		It("should remove the discovered ssl config with the annotation", func() {
+			original.Metadata.Annotations = map[string]string{GlooSslServiceAnnotation: "true"}
+			original.UpstreamSpec.SslConfig = &gloov1.UpstreamSslConfig{Sni: "test.default.svc.cluster.local"}
+			updated, err := UpdateUpstream(original, desired)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updated).To(BeTrue())
+			Expect(desired.UpstreamSpec.SslConfig).To(BeNil())
+		})
+	})
 })
@@ -0,0 +1,129 @@
+package kubernetes
+
+import (
+	"bytes"
+	"crypto/md5"
+	"fmt"
+	"sort"
+	"strings"
+	"text/template"
+
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/solo-kit/pkg/errors"
+	kubev1 "k8s.io/api/core/v1"
+)
+
+const maxUpstreamNameLen = 63
+
+// UpstreamNameVariables are the variables of the upstream name template of the settings
+type UpstreamNameVariables struct {
+	// the namespace, name and labels of the service
+	Namespace string
+	Service   string
+	Labels    map[string]string
+	// the port of the service
+	Port     int32
+	PortName string
+	// the values of the extra labels of the pods of the upstream joined by dashes, empty for all the pods
+	Subset string
+	// the pod of the upstreams of a single pod, empty otherwise
+	Pod string
+}
+
+// UpstreamNamer names the discovered upstreams after the upstream name template of the settings
+type UpstreamNamer struct {
+	template *template.Template
+}
+
+// NewUpstreamNamer returns nil if the template is empty, the upstreams keep their default names
+func NewUpstreamNamer(upstreamNameTemplate string) (*UpstreamNamer, error) {
+	if upstreamNameTemplate == "" {
+		return nil, nil
+	}
+	tmpl, err := template.New("upstream-name").Option("missingkey=zero").Parse(upstreamNameTemplate)
+	if err != nil {
+		return nil, errors.Wrapf(err, "parsing upstream name template")
+	}
+	return &UpstreamNamer{template: tmpl}, nil
+}
+
+// Rename renames the upstreams of the service after the template
+func (n *UpstreamNamer) Rename(svc *kubev1.Service, upstreams v1.UpstreamList) error {
+	for _, us := range upstreams {
+		kubeSpec, ok := us.UpstreamSpec.UpstreamType.(*v1.UpstreamSpec_Kube)
+		if !ok {
+			continue
+		}
+		vars := UpstreamNameVariables{
+			Namespace: svc.Namespace,
+			Service:   svc.Name,
+			Labels:    svc.Labels,
+			Port:      int32(kubeSpec.Kube.ServicePort),
+			Pod:       kubeSpec.Kube.PodName,
+		}
+		for _, port := range svc.Spec.Ports {
+			if uint32(port.Port) == kubeSpec.Kube.ServicePort {
+				vars.PortName = port.Name
+			}
+		}
+		if kubeSpec.Kube.PodName == "" {
+			extraLabels := make(map[string]string)
+			for k, v := range kubeSpec.Kube.Selector {
+				if _, ok := svc.Spec.Selector[k]; !ok {
+					extraLabels[k] = v
+				}
+			}
+			_, values := keysAndValues(extraLabels)
+			vars.Subset = strings.Join(values, "-")
+		}
+
+		var buf bytes.Buffer
+		if err := n.template.Execute(&buf, vars); err != nil {
+			return errors.Wrapf(err, "naming the upstream %v", us.Metadata.Name)
+		}
+		if name := sanitizeUpstreamName(buf.String()); name != "" {
+			us.Metadata.Name = name
+		}
+	}
+	return nil
+}
+
+// the rendered names are valid kube names, shortened with a hash if too long
+func sanitizeUpstreamName(name string) string {
+	name = strings.Map(func(r rune) rune {
+		if ('0' <= r && r <= '9') || ('a' <= r && r <= 'z') || r == '-' {
+			return r
+		}
+		return '-'
+	}, strings.ToLower(name))
+	name = strings.Trim(name, "-")
+	if len(name) > maxUpstreamNameLen {
+		hash := md5.Sum([]byte(name))
+		hexhash := fmt.Sprintf("%x", hash)
+		name = name[:maxUpstreamNameLen-len(hexhash)] + hexhash
+	}
+	return name
+}
+
+// templates may give several upstreams the same name, all but the first of them by default name are suffixed with
+// a hash of their default name
+func deduplicateUpstreamNames(upstreams v1.UpstreamList, defaultNames map[*v1.Upstream]string) {
+	byName := make(map[string][]*v1.Upstream)
+	for _, us := range upstreams {
+		byName[us.Metadata.Name] = append(byName[us.Metadata.Name], us)
+	}
+	for name, colliding := range byName {
+		if len(colliding) < 2 {
+			continue
+		}
+		sort.Slice(colliding, func(i, j int) bool { return defaultNames[colliding[i]] < defaultNames[colliding[j]] })
+		for _, us := range colliding[1:] {
+			hash := fmt.Sprintf("%x", md5.Sum([]byte(defaultNames[us])))[:8]
+			prefix := name
+			if len(prefix) > maxUpstreamNameLen-len(hash)-1 {
+				prefix = prefix[:maxUpstreamNameLen-len(hash)-1]
+			}
+			us.Metadata.Name = prefix + "-" + hash
+		}
+	}
+}
@@ -0,0 +1,59 @@
+package kubernetes
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo/projects/gloo/pkg/discovery"
+	kubev1 "k8s.io/api/core/v1"
+)
+
+var _ = Describe("UpstreamNamer", func() {
+
+	var svc *kubev1.Service
+
+	convert := func(upstreamNameTemplate string) []string {
+		var opts discovery.Opts
+		opts.KubeOpts.UpstreamNameTemplate = upstreamNameTemplate
+		p := &plugin{UpstreamConverter: DefaultUpstreamConverter()}
+		var names []string
+		for _, us := range p.ConvertServices(context.TODO(), []string{""}, []*kubev1.Service{svc}, nil, opts, "gloo-system") {
+			names = append(names, us.Metadata.Name)
+		}
+		return names
+	}
+
+	BeforeEach(func() {
+		svc = &kubev1.Service{
+			Spec: kubev1.ServiceSpec{
+				Selector: map[string]string{"app": "petstore"},
+				Ports:    []kubev1.ServicePort{{Name: "http", Port: 8080}, {Name: "grpc", Port: 9090}},
+			},
+		}
+		svc.Name = "petstore"
+		svc.Namespace = "default"
+		svc.Labels = map[string]string{"team": "Pets_Team"}
+	})
+
+	It("should keep the default names without a template", func() {
+		Expect(convert("")).To(ConsistOf("default-petstore-8080", "default-petstore-9090"))
+	})
+
+	It("should name the upstreams after the template", func() {
+		Expect(convert("{{ .Labels.team }}.{{ .Service }}-{{ .PortName }}")).To(ConsistOf("pets-team-petstore-http", "pets-team-petstore-grpc"))
+	})
+
+	It("should tell apart the upstreams given the same name", func() {
+		names := convert("{{ .Labels.team }}-{{ .Service }}")
+		Expect(names).To(HaveLen(2))
+		Expect(names).To(ContainElement("pets-team-petstore"))
+		Expect(names[0]).NotTo(Equal(names[1]))
+		Expect(convert("{{ .Labels.team }}-{{ .Service }}")).To(Equal(names))
+	})
+
+	It("should reject invalid templates", func() {
+		_, err := NewUpstreamNamer("{{ .Service ")
+		Expect(err).To(HaveOccurred())
+	})
+})
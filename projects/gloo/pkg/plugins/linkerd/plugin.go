@@ -164,3 +164,8 @@ func createHeaderForUpstream(us *kubernetes.UpstreamSpec) *envoycore.HeaderValue
 func (p *Plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *envoyapi.Cluster) error {
 	return nil
 }
+
+// upstreams are not processed
+func (p *Plugin) CacheableUpstream(in *v1.Upstream) bool {
+	return true
+}
@@ -6,7 +6,9 @@ import (
 	envoytype "github.com/envoyproxy/go-control-plane/envoy/type"
 	types "github.com/gogo/protobuf/types"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/lbhash"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/solo-kit/pkg/errors"
 )
 
 type Plugin struct{}
@@ -19,11 +21,65 @@ func (p *Plugin) Init(params plugins.InitParams) error {
 	return nil
 }
 
+// the hash policies of the route are used by the upstreams with a ring hash or maglev load balancer
 func (p *Plugin) ProcessRoute(params plugins.Params, in *v1.Route, out *envoyroute.Route) error {
-	// TODO(yuval-k): add ring hash config
+	hashConfig := in.GetRoutePlugins().GetLbHash()
+	if hashConfig == nil {
+		return nil
+	}
+	routeAction, ok := out.Action.(*envoyroute.Route_Route)
+	if !ok {
+		return errors.Errorf("hash policies are only available for Route Actions")
+	}
+	if routeAction.Route == nil {
+		return errors.Errorf("internal error: route %v specified hash policies, but output Envoy object "+
+			"had nil route", in.Action)
+	}
+	routeAction.Route.HashPolicy = nil
+	for _, policy := range hashConfig.HashPolicies {
+		hashPolicy, err := convertHashPolicy(policy)
+		if err != nil {
+			return err
+		}
+		routeAction.Route.HashPolicy = append(routeAction.Route.HashPolicy, hashPolicy)
+	}
 	return nil
 }
 
+func convertHashPolicy(policy *lbhash.HashPolicy) (*envoyroute.RouteAction_HashPolicy, error) {
+	out := &envoyroute.RouteAction_HashPolicy{
+		Terminal: policy.Terminal,
+	}
+	switch key := policy.KeyType.(type) {
+	case *lbhash.HashPolicy_Header:
+		out.PolicySpecifier = &envoyroute.RouteAction_HashPolicy_Header_{
+			Header: &envoyroute.RouteAction_HashPolicy_Header{
+				HeaderName: key.Header,
+			},
+		}
+	case *lbhash.HashPolicy_Cookie:
+		if key.Cookie.Name == "" {
+			return nil, errors.Errorf("the name of a hash policy cookie must be set")
+		}
+		out.PolicySpecifier = &envoyroute.RouteAction_HashPolicy_Cookie_{
+			Cookie: &envoyroute.RouteAction_HashPolicy_Cookie{
+				Name: key.Cookie.Name,
+				Ttl:  key.Cookie.Ttl,
+				Path: key.Cookie.Path,
+			},
+		}
+	case *lbhash.HashPolicy_SourceIp:
+		out.PolicySpecifier = &envoyroute.RouteAction_HashPolicy_ConnectionProperties_{
+			ConnectionProperties: &envoyroute.RouteAction_HashPolicy_ConnectionProperties{
+				SourceIp: key.SourceIp,
+			},
+		}
+	default:
+		return nil, errors.Errorf("the key of a hash policy must be set")
+	}
+	return out, nil
+}
+
 func (p *Plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *envoyapi.Cluster) error {
 
 	cfg := in.GetUpstreamSpec().GetLoadBalancerConfig()
@@ -31,7 +87,7 @@ func (p *Plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *en
 		return nil
 	}
 
-	if cfg.HealthyPanicThreshold != nil || cfg.UpdateMergeWindow != nil {
+	if cfg.HealthyPanicThreshold != nil || cfg.UpdateMergeWindow != nil || cfg.LocalityWeighted {
 		out.CommonLbConfig = &envoyapi.Cluster_CommonLbConfig{}
 		if cfg.HealthyPanicThreshold != nil {
 			out.CommonLbConfig.HealthyPanicThreshold = &envoytype.Percent{
@@ -41,6 +97,12 @@ func (p *Plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *en
 		if cfg.UpdateMergeWindow != nil {
 			out.CommonLbConfig.UpdateMergeWindow = types.DurationProto(*cfg.UpdateMergeWindow)
 		}
+		if cfg.LocalityWeighted {
+			// the weights of the localities are set by the translator with the endpoints
+			out.CommonLbConfig.LocalityConfigSpecifier = &envoyapi.Cluster_CommonLbConfig_LocalityWeightedLbConfig_{
+				LocalityWeightedLbConfig: &envoyapi.Cluster_CommonLbConfig_LocalityWeightedLbConfig{},
+			}
+		}
 	}
 
 	if cfg.Type != nil {
@@ -60,8 +122,45 @@ func (p *Plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *en
 			}
 		case *v1.LoadBalancerConfig_Random_:
 			out.LbPolicy = envoyapi.Cluster_RANDOM
+		case *v1.LoadBalancerConfig_RingHash_:
+			out.LbPolicy = envoyapi.Cluster_RING_HASH
+			ringHashConfig, err := convertRingHash(lbtype.RingHash)
+			if err != nil {
+				return errors.Wrapf(err, "invalid ring hash config for upstream %v", in.Metadata.Ref())
+			}
+			if ringHashConfig != nil {
+				out.LbConfig = &envoyapi.Cluster_RingHashLbConfig_{
+					RingHashLbConfig: ringHashConfig,
+				}
+			}
+		case *v1.LoadBalancerConfig_Maglev_:
+			out.LbPolicy = envoyapi.Cluster_MAGLEV
 		}
 	}
 
 	return nil
 }
+
+// nil when the ring sizes are not set, to use the defaults of envoy
+func convertRingHash(ringHash *v1.LoadBalancerConfig_RingHash) (*envoyapi.Cluster_RingHashLbConfig, error) {
+	if ringHash.MinimumRingSize == 0 && ringHash.MaximumRingSize == 0 {
+		return nil, nil
+	}
+	if ringHash.MaximumRingSize != 0 && ringHash.MinimumRingSize > ringHash.MaximumRingSize {
+		return nil, errors.Errorf("the minimum ring size %d is larger than the maximum ring size %d",
+			ringHash.MinimumRingSize, ringHash.MaximumRingSize)
+	}
+	out := &envoyapi.Cluster_RingHashLbConfig{}
+	if ringHash.MinimumRingSize != 0 {
+		out.MinimumRingSize = &types.UInt64Value{Value: ringHash.MinimumRingSize}
+	}
+	if ringHash.MaximumRingSize != 0 {
+		out.MaximumRingSize = &types.UInt64Value{Value: ringHash.MaximumRingSize}
+	}
+	return out, nil
+}
+
+// the cluster only depends on the load balancer config of the upstream
+func (p *Plugin) CacheableUpstream(in *v1.Upstream) bool {
+	return true
+}
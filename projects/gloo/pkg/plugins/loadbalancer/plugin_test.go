@@ -4,8 +4,10 @@ import (
 	"time"
 
 	envoyapi "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	envoyroute "github.com/envoyproxy/go-control-plane/envoy/api/v2/route"
 	types "github.com/gogo/protobuf/types"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/lbhash"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
 
 	. "github.com/onsi/ginkgo"
@@ -57,6 +59,15 @@ var _ = Describe("Plugin", func() {
 		Expect(out.CommonLbConfig.UpdateMergeWindow.Nanos).To(BeEquivalentTo(0))
 	})
 
+	It("should set locality weighted lb config", func() {
+		upstreamSpec.LoadBalancerConfig = &v1.LoadBalancerConfig{
+			LocalityWeighted: true,
+		}
+		err := plugin.ProcessUpstream(params, upstream, out)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out.CommonLbConfig.GetLocalityWeightedLbConfig()).NotTo(BeNil())
+	})
+
 	It("should set lb policy random", func() {
 		upstreamSpec.LoadBalancerConfig = &v1.LoadBalancerConfig{
 			Type: &v1.LoadBalancerConfig_Random_{
@@ -107,4 +118,114 @@ var _ = Describe("Plugin", func() {
 		Expect(out.LbPolicy).To(Equal(envoyapi.Cluster_ROUND_ROBIN))
 	})
 
+	Context("ring hash", func() {
+		It("should set lb policy ring hash with default config", func() {
+			upstreamSpec.LoadBalancerConfig = &v1.LoadBalancerConfig{
+				Type: &v1.LoadBalancerConfig_RingHash_{
+					RingHash: &v1.LoadBalancerConfig_RingHash{},
+				},
+			}
+			err := plugin.ProcessUpstream(params, upstream, out)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.LbPolicy).To(Equal(envoyapi.Cluster_RING_HASH))
+			Expect(out.GetRingHashLbConfig()).To(BeNil())
+		})
+
+		It("should set the ring sizes", func() {
+			upstreamSpec.LoadBalancerConfig = &v1.LoadBalancerConfig{
+				Type: &v1.LoadBalancerConfig_RingHash_{
+					RingHash: &v1.LoadBalancerConfig_RingHash{MinimumRingSize: 100, MaximumRingSize: 200},
+				},
+			}
+			err := plugin.ProcessUpstream(params, upstream, out)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.GetRingHashLbConfig().MinimumRingSize.Value).To(BeEquivalentTo(100))
+			Expect(out.GetRingHashLbConfig().MaximumRingSize.Value).To(BeEquivalentTo(200))
+		})
+
+		It("should error when the minimum ring size is larger than the maximum", func() {
+			upstreamSpec.LoadBalancerConfig = &v1.LoadBalancerConfig{
+				Type: &v1.LoadBalancerConfig_RingHash_{
+					RingHash: &v1.LoadBalancerConfig_RingHash{MinimumRingSize: 200, MaximumRingSize: 100},
+				},
+			}
+			err := plugin.ProcessUpstream(params, upstream, out)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	It("should set lb policy maglev", func() {
+		upstreamSpec.LoadBalancerConfig = &v1.LoadBalancerConfig{
+			Type: &v1.LoadBalancerConfig_Maglev_{
+				Maglev: &v1.LoadBalancerConfig_Maglev{},
+			},
+		}
+		err := plugin.ProcessUpstream(params, upstream, out)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out.LbPolicy).To(Equal(envoyapi.Cluster_MAGLEV))
+	})
+
+	Context("hash policies", func() {
+		var (
+			route    *v1.Route
+			outRoute *envoyroute.Route
+		)
+		BeforeEach(func() {
+			ttl := time.Hour
+			route = &v1.Route{
+				RoutePlugins: &v1.RoutePlugins{
+					LbHash: &lbhash.RouteActionHashConfig{
+						HashPolicies: []*lbhash.HashPolicy{
+							{KeyType: &lbhash.HashPolicy_Header{Header: "x-user"}, Terminal: true},
+							{KeyType: &lbhash.HashPolicy_Cookie{Cookie: &lbhash.Cookie{Name: "session", Ttl: &ttl, Path: "/"}}},
+							{KeyType: &lbhash.HashPolicy_SourceIp{SourceIp: true}},
+						},
+					},
+				},
+			}
+			outRoute = &envoyroute.Route{
+				Action: &envoyroute.Route_Route{
+					Route: &envoyroute.RouteAction{},
+				},
+			}
+		})
+
+		It("should set the hash policies of the route", func() {
+			err := plugin.ProcessRoute(params, route, outRoute)
+			Expect(err).NotTo(HaveOccurred())
+			ttl := time.Hour
+			Expect(outRoute.GetRoute().HashPolicy).To(Equal([]*envoyroute.RouteAction_HashPolicy{
+				{
+					PolicySpecifier: &envoyroute.RouteAction_HashPolicy_Header_{
+						Header: &envoyroute.RouteAction_HashPolicy_Header{HeaderName: "x-user"},
+					},
+					Terminal: true,
+				},
+				{
+					PolicySpecifier: &envoyroute.RouteAction_HashPolicy_Cookie_{
+						Cookie: &envoyroute.RouteAction_HashPolicy_Cookie{Name: "session", Ttl: &ttl, Path: "/"},
+					},
+				},
+				{
+					PolicySpecifier: &envoyroute.RouteAction_HashPolicy_ConnectionProperties_{
+						ConnectionProperties: &envoyroute.RouteAction_HashPolicy_ConnectionProperties{SourceIp: true},
+					},
+				},
+			}))
+		})
+
+		It("should error on a cookie without a name", func() {
+			route.RoutePlugins.LbHash.HashPolicies = []*lbhash.HashPolicy{
+				{KeyType: &lbhash.HashPolicy_Cookie{Cookie: &lbhash.Cookie{}}},
+			}
+			err := plugin.ProcessRoute(params, route, outRoute)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should error on a route that is not a route action", func() {
+			outRoute.Action = &envoyroute.Route_DirectResponse{}
+			err := plugin.ProcessRoute(params, route, outRoute)
+			Expect(err).To(HaveOccurred())
+		})
+	})
 })
@@ -0,0 +1,449 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: filter.proto
+
+package lua
+
+import (
+	fmt "fmt"
+	math "math"
+
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	proto "github.com/gogo/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+type Lua struct {
+	// The Lua code that Envoy will execute. This can be a very small script that
+	// further loads code from disk if desired. Note that if JSON configuration is used, the code must
+	// be properly escaped. YAML configuration may be easier to read since YAML supports multi-line
+	// strings so complex scripts can be easily expressed inline in the configuration.
+	InlineCode string `protobuf:"bytes,1,opt,name=inline_code,json=inlineCode,proto3" json:"inline_code,omitempty"`
+	// Map of named Lua source codes that can be referenced in :ref:`LuaPerRoute
+	// <envoy_api_msg_config.filter.http.lua.v2.LuaPerRoute>`. The Lua source codes can be
+	// loaded from inline string or local files.
+	SourceCodes          map[string]*DataSource `protobuf:"bytes,2,rep,name=source_codes,json=sourceCodes,proto3" json:"source_codes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *Lua) Reset()         { *m = Lua{} }
+func (m *Lua) String() string { return proto.CompactTextString(m) }
+func (*Lua) ProtoMessage()    {}
+func (*Lua) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f5303cab7a20d6f, []int{0}
+}
+func (m *Lua) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Lua.Unmarshal(m, b)
+}
+func (m *Lua) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Lua.Marshal(b, m, deterministic)
+}
+func (m *Lua) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Lua.Merge(m, src)
+}
+func (m *Lua) XXX_Size() int {
+	return xxx_messageInfo_Lua.Size(m)
+}
+func (m *Lua) XXX_DiscardUnknown() {
+	xxx_messageInfo_Lua.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Lua proto.InternalMessageInfo
+
+func (m *Lua) GetInlineCode() string {
+	if m != nil {
+		return m.InlineCode
+	}
+	return ""
+}
+
+func (m *Lua) GetSourceCodes() map[string]*DataSource {
+	if m != nil {
+		return m.SourceCodes
+	}
+	return nil
+}
+
+type LuaPerRoute struct {
+	// Types that are valid to be assigned to Override:
+	//	*LuaPerRoute_Disabled
+	//	*LuaPerRoute_Name
+	//	*LuaPerRoute_SourceCode
+	Override             isLuaPerRoute_Override `protobuf_oneof:"override"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *LuaPerRoute) Reset()         { *m = LuaPerRoute{} }
+func (m *LuaPerRoute) String() string { return proto.CompactTextString(m) }
+func (*LuaPerRoute) ProtoMessage()    {}
+func (*LuaPerRoute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f5303cab7a20d6f, []int{1}
+}
+func (m *LuaPerRoute) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LuaPerRoute.Unmarshal(m, b)
+}
+func (m *LuaPerRoute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LuaPerRoute.Marshal(b, m, deterministic)
+}
+func (m *LuaPerRoute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LuaPerRoute.Merge(m, src)
+}
+func (m *LuaPerRoute) XXX_Size() int {
+	return xxx_messageInfo_LuaPerRoute.Size(m)
+}
+func (m *LuaPerRoute) XXX_DiscardUnknown() {
+	xxx_messageInfo_LuaPerRoute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LuaPerRoute proto.InternalMessageInfo
+
+type isLuaPerRoute_Override interface {
+	isLuaPerRoute_Override()
+}
+
+type LuaPerRoute_Disabled struct {
+	Disabled bool `protobuf:"varint,1,opt,name=disabled,proto3,oneof"`
+}
+type LuaPerRoute_Name struct {
+	Name string `protobuf:"bytes,2,opt,name=name,proto3,oneof"`
+}
+type LuaPerRoute_SourceCode struct {
+	SourceCode *DataSource `protobuf:"bytes,3,opt,name=source_code,json=sourceCode,proto3,oneof"`
+}
+
+func (*LuaPerRoute_Disabled) isLuaPerRoute_Override()   {}
+func (*LuaPerRoute_Name) isLuaPerRoute_Override()       {}
+func (*LuaPerRoute_SourceCode) isLuaPerRoute_Override() {}
+
+func (m *LuaPerRoute) GetOverride() isLuaPerRoute_Override {
+	if m != nil {
+		return m.Override
+	}
+	return nil
+}
+
+func (m *LuaPerRoute) GetDisabled() bool {
+	if x, ok := m.GetOverride().(*LuaPerRoute_Disabled); ok {
+		return x.Disabled
+	}
+	return false
+}
+
+func (m *LuaPerRoute) GetName() string {
+	if x, ok := m.GetOverride().(*LuaPerRoute_Name); ok {
+		return x.Name
+	}
+	return ""
+}
+
+func (m *LuaPerRoute) GetSourceCode() *DataSource {
+	if x, ok := m.GetOverride().(*LuaPerRoute_SourceCode); ok {
+		return x.SourceCode
+	}
+	return nil
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*LuaPerRoute) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _LuaPerRoute_OneofMarshaler, _LuaPerRoute_OneofUnmarshaler, _LuaPerRoute_OneofSizer, []interface{}{
+		(*LuaPerRoute_Disabled)(nil),
+		(*LuaPerRoute_Name)(nil),
+		(*LuaPerRoute_SourceCode)(nil),
+	}
+}
+
+func _LuaPerRoute_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*LuaPerRoute)
+	// override
+	switch x := m.Override.(type) {
+	case *LuaPerRoute_Disabled:
+		t := uint64(0)
+		if x.Disabled {
+			t = 1
+		}
+		_ = b.EncodeVarint(1<<3 | proto.WireVarint)
+		_ = b.EncodeVarint(t)
+	case *LuaPerRoute_Name:
+		_ = b.EncodeVarint(2<<3 | proto.WireBytes)
+		_ = b.EncodeStringBytes(x.Name)
+	case *LuaPerRoute_SourceCode:
+		_ = b.EncodeVarint(3<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.SourceCode); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("LuaPerRoute.Override has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _LuaPerRoute_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*LuaPerRoute)
+	switch tag {
+	case 1: // override.disabled
+		if wire != proto.WireVarint {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeVarint()
+		m.Override = &LuaPerRoute_Disabled{x != 0}
+		return true, err
+	case 2: // override.name
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeStringBytes()
+		m.Override = &LuaPerRoute_Name{x}
+		return true, err
+	case 3: // override.source_code
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(DataSource)
+		err := b.DecodeMessage(msg)
+		m.Override = &LuaPerRoute_SourceCode{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _LuaPerRoute_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*LuaPerRoute)
+	// override
+	switch x := m.Override.(type) {
+	case *LuaPerRoute_Disabled:
+		n += 1 // tag and wire
+		n += 1
+	case *LuaPerRoute_Name:
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(len(x.Name)))
+		n += len(x.Name)
+	case *LuaPerRoute_SourceCode:
+		s := proto.Size(x.SourceCode)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+// Data source consisting of either a file or an inline value.
+type DataSource struct {
+	// Types that are valid to be assigned to Specifier:
+	//	*DataSource_Filename
+	//	*DataSource_InlineBytes
+	//	*DataSource_InlineString
+	Specifier            isDataSource_Specifier `protobuf_oneof:"specifier"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *DataSource) Reset()         { *m = DataSource{} }
+func (m *DataSource) String() string { return proto.CompactTextString(m) }
+func (*DataSource) ProtoMessage()    {}
+func (*DataSource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f5303cab7a20d6f, []int{2}
+}
+func (m *DataSource) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DataSource.Unmarshal(m, b)
+}
+func (m *DataSource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DataSource.Marshal(b, m, deterministic)
+}
+func (m *DataSource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DataSource.Merge(m, src)
+}
+func (m *DataSource) XXX_Size() int {
+	return xxx_messageInfo_DataSource.Size(m)
+}
+func (m *DataSource) XXX_DiscardUnknown() {
+	xxx_messageInfo_DataSource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DataSource proto.InternalMessageInfo
+
+type isDataSource_Specifier interface {
+	isDataSource_Specifier()
+}
+
+type DataSource_Filename struct {
+	Filename string `protobuf:"bytes,1,opt,name=filename,proto3,oneof"`
+}
+type DataSource_InlineBytes struct {
+	InlineBytes []byte `protobuf:"bytes,2,opt,name=inline_bytes,json=inlineBytes,proto3,oneof"`
+}
+type DataSource_InlineString struct {
+	InlineString string `protobuf:"bytes,3,opt,name=inline_string,json=inlineString,proto3,oneof"`
+}
+
+func (*DataSource_Filename) isDataSource_Specifier()     {}
+func (*DataSource_InlineBytes) isDataSource_Specifier()  {}
+func (*DataSource_InlineString) isDataSource_Specifier() {}
+
+func (m *DataSource) GetSpecifier() isDataSource_Specifier {
+	if m != nil {
+		return m.Specifier
+	}
+	return nil
+}
+
+func (m *DataSource) GetFilename() string {
+	if x, ok := m.GetSpecifier().(*DataSource_Filename); ok {
+		return x.Filename
+	}
+	return ""
+}
+
+func (m *DataSource) GetInlineBytes() []byte {
+	if x, ok := m.GetSpecifier().(*DataSource_InlineBytes); ok {
+		return x.InlineBytes
+	}
+	return nil
+}
+
+func (m *DataSource) GetInlineString() string {
+	if x, ok := m.GetSpecifier().(*DataSource_InlineString); ok {
+		return x.InlineString
+	}
+	return ""
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*DataSource) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _DataSource_OneofMarshaler, _DataSource_OneofUnmarshaler, _DataSource_OneofSizer, []interface{}{
+		(*DataSource_Filename)(nil),
+		(*DataSource_InlineBytes)(nil),
+		(*DataSource_InlineString)(nil),
+	}
+}
+
+func _DataSource_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*DataSource)
+	// specifier
+	switch x := m.Specifier.(type) {
+	case *DataSource_Filename:
+		_ = b.EncodeVarint(1<<3 | proto.WireBytes)
+		_ = b.EncodeStringBytes(x.Filename)
+	case *DataSource_InlineBytes:
+		_ = b.EncodeVarint(2<<3 | proto.WireBytes)
+		_ = b.EncodeRawBytes(x.InlineBytes)
+	case *DataSource_InlineString:
+		_ = b.EncodeVarint(3<<3 | proto.WireBytes)
+		_ = b.EncodeStringBytes(x.InlineString)
+	case nil:
+	default:
+		return fmt.Errorf("DataSource.Specifier has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _DataSource_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*DataSource)
+	switch tag {
+	case 1: // specifier.filename
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeStringBytes()
+		m.Specifier = &DataSource_Filename{x}
+		return true, err
+	case 2: // specifier.inline_bytes
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeRawBytes(true)
+		m.Specifier = &DataSource_InlineBytes{x}
+		return true, err
+	case 3: // specifier.inline_string
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeStringBytes()
+		m.Specifier = &DataSource_InlineString{x}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _DataSource_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*DataSource)
+	// specifier
+	switch x := m.Specifier.(type) {
+	case *DataSource_Filename:
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(len(x.Filename)))
+		n += len(x.Filename)
+	case *DataSource_InlineBytes:
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(len(x.InlineBytes)))
+		n += len(x.InlineBytes)
+	case *DataSource_InlineString:
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(len(x.InlineString)))
+		n += len(x.InlineString)
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+func init() {
+	proto.RegisterType((*Lua)(nil), "envoy.config.filter.http.lua.v2.Lua")
+	proto.RegisterMapType((map[string]*DataSource)(nil), "envoy.config.filter.http.lua.v2.Lua.SourceCodesEntry")
+	proto.RegisterType((*LuaPerRoute)(nil), "envoy.config.filter.http.lua.v2.LuaPerRoute")
+	proto.RegisterType((*DataSource)(nil), "envoy.config.filter.http.lua.v2.DataSource")
+}
+
+func init() { proto.RegisterFile("filter.proto", fileDescriptor_1f5303cab7a20d6f) }
+
+var fileDescriptor_1f5303cab7a20d6f = []byte{
+	// 448 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0x4d, 0x8b, 0xd3, 0x50,
+	0x14, 0x9d, 0x97, 0x54, 0x69, 0x6f, 0x2a, 0xd4, 0x07, 0x62, 0x28, 0xc8, 0x84, 0x6e, 0x2c, 0xea,
+	0xbc, 0x48, 0x65, 0x50, 0x5c, 0x08, 0x46, 0x85, 0x59, 0x14, 0x91, 0xcc, 0x46, 0xdc, 0xc8, 0x6b,
+	0x72, 0x9b, 0x79, 0xd3, 0x37, 0x79, 0xf1, 0x7d, 0x04, 0xea, 0x4f, 0x9b, 0x95, 0x2b, 0xff, 0x81,
+	0x3f, 0xc2, 0x7f, 0xe0, 0x52, 0xf2, 0x31, 0x53, 0x19, 0x17, 0x65, 0x76, 0x2f, 0x39, 0xe7, 0x9e,
+	0x7b, 0xce, 0xbd, 0x17, 0xc6, 0x6b, 0x21, 0x2d, 0x6a, 0x56, 0x69, 0x65, 0x15, 0x3d, 0xc4, 0xb2,
+	0x56, 0x5b, 0x96, 0xa9, 0x72, 0x2d, 0x0a, 0xd6, 0x43, 0x67, 0xd6, 0x56, 0x4c, 0x3a, 0xce, 0xea,
+	0xc5, 0xf4, 0x61, 0xcd, 0xa5, 0xc8, 0xb9, 0xc5, 0xf8, 0xea, 0xd1, 0x55, 0xce, 0xfe, 0x10, 0xf0,
+	0x97, 0x8e, 0xd3, 0x27, 0x10, 0x88, 0x52, 0x8a, 0x12, 0xbf, 0x66, 0x2a, 0xc7, 0x90, 0x44, 0x64,
+	0x3e, 0x4a, 0x46, 0x97, 0xbf, 0x7f, 0xf8, 0x03, 0xed, 0x45, 0x24, 0x85, 0x0e, 0x7d, 0xa7, 0x72,
+	0xa4, 0x9f, 0x61, 0x6c, 0x94, 0xd3, 0x59, 0xc7, 0x35, 0xa1, 0x17, 0xf9, 0xf3, 0x60, 0x71, 0xcc,
+	0xf6, 0x98, 0x60, 0x4b, 0xc7, 0xd9, 0x69, 0x5b, 0xd8, 0xc8, 0x98, 0x0f, 0xa5, 0xd5, 0xdb, 0x34,
+	0x30, 0xbb, 0x3f, 0xd3, 0x0d, 0x4c, 0x6e, 0x12, 0xe8, 0x04, 0xfc, 0x0d, 0x6e, 0x3b, 0x47, 0x69,
+	0xf3, 0xa4, 0x6f, 0xe1, 0x4e, 0xcd, 0xa5, 0xc3, 0xd0, 0x8b, 0xc8, 0x3c, 0x58, 0x3c, 0xdd, 0xdb,
+	0xf8, 0x3d, 0xb7, 0xbc, 0xd3, 0x4d, 0xbb, 0xca, 0xd7, 0xde, 0x2b, 0x32, 0xfb, 0x45, 0x20, 0x58,
+	0x3a, 0xfe, 0x09, 0x75, 0xaa, 0x9c, 0x45, 0xfa, 0x18, 0x86, 0xb9, 0x30, 0x7c, 0x25, 0x31, 0x6f,
+	0xbb, 0x0d, 0xfb, 0xfc, 0xe7, 0xde, 0x90, 0x9c, 0x1c, 0xa4, 0xd7, 0x20, 0x3d, 0x84, 0x41, 0xc9,
+	0x2f, 0xba, 0xf6, 0xbb, 0x21, 0x4d, 0x1a, 0x52, 0x0b, 0xd0, 0x8f, 0x10, 0xfc, 0x33, 0xa0, 0xd0,
+	0xbf, 0xb5, 0xcd, 0x93, 0x83, 0x14, 0x76, 0x73, 0x49, 0x9e, 0xc1, 0x50, 0xd5, 0xa8, 0xb5, 0xc8,
+	0x91, 0x46, 0x97, 0x6f, 0x1e, 0xd1, 0x07, 0x70, 0xff, 0x7a, 0x8f, 0x1a, 0xbf, 0x39, 0xa1, 0x31,
+	0x9f, 0x90, 0xe9, 0xc0, 0x6a, 0x87, 0xb3, 0x9f, 0x04, 0x60, 0x27, 0xd5, 0xc4, 0x5a, 0x0b, 0x89,
+	0xad, 0xe3, 0x9b, 0x6b, 0x6d, 0x62, 0x5d, 0x81, 0x94, 0xc1, 0xb8, 0x3f, 0x81, 0xd5, 0xd6, 0xb6,
+	0x6b, 0x25, 0xf3, 0x71, 0x4f, 0xfe, 0xde, 0xc5, 0xeb, 0x6f, 0x24, 0x69, 0x70, 0xfa, 0x1c, 0xee,
+	0xf5, 0x7c, 0x63, 0xb5, 0x28, 0x8b, 0xd0, 0xff, 0x5f, 0xbd, 0x57, 0x3c, 0x6d, 0x09, 0xc9, 0x11,
+	0x8c, 0x4c, 0x85, 0x99, 0x58, 0x0b, 0xd4, 0xfb, 0x83, 0x24, 0x2f, 0xbf, 0x1c, 0x17, 0xc2, 0x9e,
+	0xb9, 0x15, 0xcb, 0xd4, 0x45, 0x6c, 0x94, 0x54, 0x47, 0x42, 0xc5, 0x85, 0x54, 0x2a, 0xae, 0xb4,
+	0x3a, 0xc7, 0xcc, 0x9a, 0xfe, 0x6b, 0x53, 0xc4, 0x95, 0x74, 0x85, 0x28, 0x4d, 0x2c, 0x1d, 0x5f,
+	0xdd, 0x6d, 0x6f, 0xfb, 0xc5, 0xdf, 0x01, 0x00, 0x42, 0xef, 0x0e, 0xec, 0x25, 0x03, 0x00, 0x00,
+}
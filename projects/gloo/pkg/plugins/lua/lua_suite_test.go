@@ -0,0 +1,13 @@
+package lua_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestLua(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Lua Suite")
+}
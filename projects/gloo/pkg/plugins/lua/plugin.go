@@ -0,0 +1,142 @@
+package lua
+
+import (
+	envoyroute "github.com/envoyproxy/go-control-plane/envoy/api/v2/route"
+	envoyutil "github.com/envoyproxy/go-control-plane/pkg/util"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/lua"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/pluginutils"
+	"github.com/solo-io/solo-kit/pkg/errors"
+)
+
+//go:generate protoc -I$GOPATH/src/github.com/envoyproxy/protoc-gen-validate -I. -I$GOPATH/src/github.com/gogo/protobuf/protobuf --gogo_out=${GOPATH}/src/ filter.proto
+
+const (
+	// filter info
+	FilterName  = envoyutil.Lua
+	pluginStage = plugins.PostInAuth
+
+	// the lua filter of the envoy image shipped with gloo has neither named source codes nor a per route config, which
+	// it would ignore, running the lua code of the listener on the routes disabling it
+	PerRouteExtension = FilterName + ".per_route"
+
+	// envoy requires inline code, run for the listeners whose lua code is only set on their routes
+	noopInlineCode = "-- the lua code is set on the routes"
+)
+
+type Plugin struct {
+	settings *v1.Settings
+}
+
+var _ plugins.RoutePlugin = NewPlugin()
+var _ plugins.HttpFilterPlugin = NewPlugin()
+
+func NewPlugin() *Plugin {
+	return &Plugin{}
+}
+
+func (p *Plugin) Init(params plugins.InitParams) error {
+	p.settings = params.Settings
+	return nil
+}
+
+func (p *Plugin) ProcessRoute(params plugins.Params, in *v1.Route, out *envoyroute.Route) error {
+	cfg := in.GetRoutePlugins().GetLua()
+	if cfg == nil {
+		return nil
+	}
+	if err := pluginutils.RequireEnvoyExtension(p.settings, PerRouteExtension); err != nil {
+		return errors.Wrapf(err, "invalid lua code of the route")
+	}
+
+	perRoute := &LuaPerRoute{}
+	switch override := cfg.Override.(type) {
+	case *lua.RouteLua_Disabled:
+		if !override.Disabled {
+			return nil
+		}
+		perRoute.Override = &LuaPerRoute_Disabled{
+			Disabled: true,
+		}
+	case *lua.RouteLua_Name:
+		if override.Name == "" {
+			return errors.Errorf("the name of the lua source code of the route cannot be empty")
+		}
+		perRoute.Override = &LuaPerRoute_Name{
+			Name: override.Name,
+		}
+	case *lua.RouteLua_SourceCode:
+		if override.SourceCode == "" {
+			return errors.Errorf("the lua source code of the route cannot be empty")
+		}
+		perRoute.Override = &LuaPerRoute_SourceCode{
+			SourceCode: inlineString(override.SourceCode),
+		}
+	default:
+		return errors.Errorf("the lua override of the route must be set")
+	}
+
+	if err := pluginutils.SetRoutePerFilterConfig(out, FilterName, perRoute); err != nil {
+		return errors.Wrapf(err, "converting lua route configuration to struct")
+	}
+	return nil
+}
+
+func (p *Plugin) HttpFilters(params plugins.Params, listener *v1.HttpListener) ([]plugins.StagedHttpFilter, error) {
+	cfg := listener.GetListenerPlugins().GetLua()
+	if cfg == nil && !routesRunLua(listener) {
+		// no lua code no filter
+		return nil, nil
+	}
+
+	config := &Lua{
+		InlineCode: cfg.GetInlineCode(),
+	}
+	if config.InlineCode == "" {
+		if cfg != nil {
+			return nil, errors.Errorf("the lua inline code of the listener cannot be empty")
+		}
+		config.InlineCode = noopInlineCode
+	}
+	if len(cfg.GetSourceCodes()) > 0 {
+		if err := pluginutils.RequireEnvoyExtension(p.settings, PerRouteExtension); err != nil {
+			return nil, errors.Wrapf(err, "invalid lua source codes")
+		}
+	}
+	for name, code := range cfg.GetSourceCodes() {
+		if code == "" {
+			return nil, errors.Errorf("the lua source code %v cannot be empty", name)
+		}
+		if config.SourceCodes == nil {
+			config.SourceCodes = make(map[string]*DataSource)
+		}
+		config.SourceCodes[name] = inlineString(code)
+	}
+
+	stagedFilter, err := plugins.NewStagedFilterWithConfig(FilterName, config, pluginStage)
+	if err != nil {
+		return nil, err
+	}
+	return []plugins.StagedHttpFilter{stagedFilter}, nil
+}
+
+// whether a route of the listener runs lua code of its own, which requires the filter
+func routesRunLua(listener *v1.HttpListener) bool {
+	for _, vhost := range listener.VirtualHosts {
+		for _, route := range vhost.Routes {
+			if route.GetRoutePlugins().GetLua().GetSourceCode() != "" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func inlineString(code string) *DataSource {
+	return &DataSource{
+		Specifier: &DataSource_InlineString{
+			InlineString: code,
+		},
+	}
+}
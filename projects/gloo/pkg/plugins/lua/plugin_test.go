@@ -0,0 +1,159 @@
+package lua_test
+
+import (
+	envoyroute "github.com/envoyproxy/go-control-plane/envoy/api/v2/route"
+	"github.com/envoyproxy/go-control-plane/pkg/util"
+	envoyutil "github.com/envoyproxy/go-control-plane/pkg/util"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/lua"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	. "github.com/solo-io/gloo/projects/gloo/pkg/plugins/lua"
+)
+
+const code = `function envoy_on_request(request_handle)
+  request_handle:headers():add("x-lua", "true")
+end`
+
+var _ = Describe("Plugin", func() {
+	var (
+		plugin   *Plugin
+		listener *v1.HttpListener
+		in       *v1.Route
+		out      *envoyroute.Route
+	)
+
+	BeforeEach(func() {
+		plugin = NewPlugin()
+		Expect(plugin.Init(plugins.InitParams{})).NotTo(HaveOccurred())
+		listener = &v1.HttpListener{
+			ListenerPlugins: &v1.ListenerPlugins{
+				Lua: &lua.LuaFilter{
+					InlineCode: code,
+				},
+			},
+		}
+		in = &v1.Route{
+			RoutePlugins: &v1.RoutePlugins{
+				Lua: &lua.RouteLua{},
+			},
+		}
+		out = &envoyroute.Route{}
+	})
+
+	filterConfig := func(listener *v1.HttpListener) *Lua {
+		filters, err := plugin.HttpFilters(plugins.Params{}, listener)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(filters).To(HaveLen(1))
+		Expect(filters[0].HttpFilter.Name).To(Equal(envoyutil.Lua))
+		var config Lua
+		err = util.StructToMessage(filters[0].HttpFilter.GetConfig(), &config)
+		Expect(err).NotTo(HaveOccurred())
+		return &config
+	}
+
+	It("adds no filter without lua code", func() {
+		filters, err := plugin.HttpFilters(plugins.Params{}, &v1.HttpListener{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(filters).To(BeEmpty())
+	})
+
+	It("runs the inline code of the listener", func() {
+		Expect(filterConfig(listener)).To(Equal(&Lua{
+			InlineCode: code,
+		}))
+	})
+
+	It("rejects empty inline code", func() {
+		listener.ListenerPlugins.Lua.InlineCode = ""
+		_, err := plugin.HttpFilters(plugins.Params{}, listener)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("rejects source codes unless envoy supports them", func() {
+		listener.ListenerPlugins.Lua.SourceCodes = map[string]string{"other": "-- other"}
+		_, err := plugin.HttpFilters(plugins.Params{}, listener)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("rejects the lua code of the routes unless envoy supports it", func() {
+		for _, routeLua := range []*lua.RouteLua{
+			{Override: &lua.RouteLua_Disabled{Disabled: true}},
+			{Override: &lua.RouteLua_Name{Name: "other"}},
+			{Override: &lua.RouteLua_SourceCode{SourceCode: code}},
+		} {
+			in.RoutePlugins.Lua = routeLua
+			Expect(plugin.ProcessRoute(plugins.Params{}, in, out)).To(HaveOccurred())
+			Expect(out.PerFilterConfig).To(BeEmpty())
+		}
+	})
+
+	It("processes the routes without lua code", func() {
+		Expect(plugin.ProcessRoute(plugins.Params{}, &v1.Route{}, out)).NotTo(HaveOccurred())
+	})
+
+	Context("with an envoy supporting the lua code of the routes", func() {
+		BeforeEach(func() {
+			err := plugin.Init(plugins.InitParams{Settings: &v1.Settings{EnvoyExtensions: []string{PerRouteExtension}}})
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		perRouteConfig := func() *LuaPerRoute {
+			Expect(plugin.ProcessRoute(plugins.Params{}, in, out)).NotTo(HaveOccurred())
+			var config LuaPerRoute
+			err := util.StructToMessage(out.PerFilterConfig[FilterName], &config)
+			Expect(err).NotTo(HaveOccurred())
+			return &config
+		}
+
+		It("passes the source codes of the listener", func() {
+			listener.ListenerPlugins.Lua.SourceCodes = map[string]string{"other": "-- other"}
+			Expect(filterConfig(listener)).To(Equal(&Lua{
+				InlineCode: code,
+				SourceCodes: map[string]*DataSource{
+					"other": {Specifier: &DataSource_InlineString{InlineString: "-- other"}},
+				},
+			}))
+		})
+
+		It("rejects empty source codes", func() {
+			listener.ListenerPlugins.Lua.SourceCodes = map[string]string{"other": ""}
+			_, err := plugin.HttpFilters(plugins.Params{}, listener)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("disables the lua code of the route", func() {
+			in.RoutePlugins.Lua.Override = &lua.RouteLua_Disabled{Disabled: true}
+			Expect(perRouteConfig()).To(Equal(&LuaPerRoute{
+				Override: &LuaPerRoute_Disabled{Disabled: true},
+			}))
+		})
+
+		It("runs a source code of the listener by name", func() {
+			in.RoutePlugins.Lua.Override = &lua.RouteLua_Name{Name: "other"}
+			Expect(perRouteConfig()).To(Equal(&LuaPerRoute{
+				Override: &LuaPerRoute_Name{Name: "other"},
+			}))
+		})
+
+		It("adds the filter for the source code of the routes", func() {
+			in.RoutePlugins.Lua.Override = &lua.RouteLua_SourceCode{SourceCode: code}
+			Expect(perRouteConfig()).To(Equal(&LuaPerRoute{
+				Override: &LuaPerRoute_SourceCode{
+					SourceCode: &DataSource{Specifier: &DataSource_InlineString{InlineString: code}},
+				},
+			}))
+
+			config := filterConfig(&v1.HttpListener{
+				VirtualHosts: []*v1.VirtualHost{{Routes: []*v1.Route{in}}},
+			})
+			Expect(config.InlineCode).NotTo(BeEmpty())
+			Expect(config.SourceCodes).To(BeEmpty())
+		})
+
+		It("rejects routes without override", func() {
+			Expect(plugin.ProcessRoute(plugins.Params{}, in, out)).To(HaveOccurred())
+		})
+	})
+})
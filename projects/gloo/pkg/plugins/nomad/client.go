@@ -0,0 +1,125 @@
+package nomad
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/solo-io/solo-kit/pkg/errors"
+)
+
+const (
+	// the environment variables of the Nomad CLI
+	addressEnv = "NOMAD_ADDR"
+	tokenEnv   = "NOMAD_TOKEN"
+
+	defaultAddress = "http://127.0.0.1:4646"
+
+	// how long the blocking queries wait for a change
+	blockingQueryWait = 5 * time.Minute
+)
+
+// the services of a Nomad namespace, as listed by the Nomad API
+type namespaceServices struct {
+	Namespace string
+	Services  []nomadService
+}
+
+type nomadService struct {
+	ServiceName string
+	Tags        []string
+}
+
+// a service registered by an allocation, as returned by the Nomad API
+type serviceRegistration struct {
+	ID          string
+	ServiceName string
+	Namespace   string
+	JobID       string
+	AllocID     string
+	Tags        []string
+	Address     string
+	Port        int
+}
+
+// client of the native service registry of Nomad
+type nomadClient interface {
+	// Services lists the services of the namespace, `*` for all the namespaces. Blocks until the registry changed
+	// since the index, if not 0. Returns the index of the registry.
+	Services(ctx context.Context, namespace string, index uint64) ([]namespaceServices, uint64, error)
+	// Registrations lists the registrations of the service in the namespace
+	Registrations(ctx context.Context, namespace, service string) ([]serviceRegistration, error)
+}
+
+type httpClient struct {
+	address string
+	token   string
+	client  *http.Client
+}
+
+func newHttpClient(address string) nomadClient {
+	if address == "" {
+		address = os.Getenv(addressEnv)
+	}
+	if address == "" {
+		address = defaultAddress
+	}
+	return &httpClient{
+		address: strings.TrimSuffix(address, "/"),
+		token:   os.Getenv(tokenEnv),
+		client:  &http.Client{Timeout: blockingQueryWait + time.Minute},
+	}
+}
+
+func (c *httpClient) Services(ctx context.Context, namespace string, index uint64) ([]namespaceServices, uint64, error) {
+	query := url.Values{"namespace": {namespace}}
+	if index > 0 {
+		query.Set("index", strconv.FormatUint(index, 10))
+		query.Set("wait", fmt.Sprintf("%ds", int(blockingQueryWait.Seconds())))
+	}
+	var services []namespaceServices
+	index, err := c.get(ctx, "/v1/services", query, &services)
+	if err != nil {
+		return nil, 0, errors.Wrapf(err, "listing the services of nomad namespace %v", namespace)
+	}
+	return services, index, nil
+}
+
+func (c *httpClient) Registrations(ctx context.Context, namespace, service string) ([]serviceRegistration, error) {
+	var registrations []serviceRegistration
+	if _, err := c.get(ctx, "/v1/service/"+url.PathEscape(service), url.Values{"namespace": {namespace}}, &registrations); err != nil {
+		return nil, errors.Wrapf(err, "listing the registrations of nomad service %v.%v", namespace, service)
+	}
+	return registrations, nil
+}
+
+// sends a GET request to the Nomad API, returns the index of the response
+func (c *httpClient) get(ctx context.Context, path string, query url.Values, out interface{}) (uint64, error) {
+	req, err := http.NewRequest(http.MethodGet, c.address+path+"?"+query.Encode(), nil)
+	if err != nil {
+		return 0, err
+	}
+	req = req.WithContext(ctx)
+	if c.token != "" {
+		req.Header.Set("X-Nomad-Token", c.token)
+	}
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return 0, errors.Errorf("unexpected status %v", resp.Status)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return 0, errors.Wrapf(err, "decoding the response")
+	}
+	index, _ := strconv.ParseUint(resp.Header.Get("X-Nomad-Index"), 10, 64)
+	return index, nil
+}
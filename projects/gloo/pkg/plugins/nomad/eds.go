@@ -0,0 +1,94 @@
+package nomad
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	nomadapi "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/nomad"
+	"github.com/solo-io/gloo/projects/gloo/pkg/discovery"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+func (p *plugin) WatchEndpoints(writeNamespace string, upstreamsToTrack v1.UpstreamList, opts clients.WatchOpts) (<-chan v1.EndpointList, <-chan error, error) {
+	upstreams := make(map[core.ResourceRef]*nomadapi.UpstreamSpec)
+	for _, us := range upstreamsToTrack {
+		nomadUpstream, ok := us.UpstreamSpec.UpstreamType.(*v1.UpstreamSpec_Nomad)
+		// only care about nomad upstreams
+		if !ok {
+			continue
+		}
+		upstreams[us.Metadata.Ref()] = nomadUpstream.Nomad
+	}
+
+	servicesIndex := &servicesIndexWatcher{client: p.client}
+	return p.endpointsPoller(upstreams, servicesIndex.wait).Watch(writeNamespace, opts)
+}
+
+// endpointsPoller lists the endpoints of the given upstreams: the addresses their service is registered with by
+// the allocations.
+func (p *plugin) endpointsPoller(upstreams map[core.ResourceRef]*nomadapi.UpstreamSpec, wait discovery.WaitForChanges) *discovery.EndpointsPoller {
+	var upstreamRefs []core.ResourceRef
+	for usRef := range upstreams {
+		upstreamRefs = append(upstreamRefs, usRef)
+	}
+	listAddresses := func(ctx context.Context, usRef core.ResourceRef) ([]discovery.EndpointAddress, error) {
+		spec := upstreams[usRef]
+		registrations, err := p.client.Registrations(ctx, namespaceOf(spec), spec.ServiceName)
+		if err != nil {
+			return nil, err
+		}
+		var addresses []discovery.EndpointAddress
+		for _, registration := range registrations {
+			if !hasTags(registration, spec.ServiceTags) {
+				continue
+			}
+			addresses = append(addresses, discovery.EndpointAddress{
+				Name:    EndpointName(registration.ID, registration.Address, uint32(registration.Port)),
+				Address: registration.Address,
+				Port:    uint32(registration.Port),
+			})
+		}
+		return addresses, nil
+	}
+	return discovery.NewEndpointsPoller(upstreamRefs, listAddresses, wait)
+}
+
+// servicesIndexWatcher waits for the index of the services to change, as the allocations register and deregister
+// their services
+type servicesIndexWatcher struct {
+	client nomadClient
+	index  uint64
+	failed bool
+}
+
+func (w *servicesIndexWatcher) wait(ctx context.Context) error {
+	if w.failed {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(retryInterval):
+		}
+	}
+	for {
+		// the first query returns the current index without blocking, the next ones block until it changes
+		_, nextIndex, err := w.client.Services(ctx, allNamespaces, w.index)
+		w.failed = err != nil
+		if err != nil {
+			return err
+		}
+		if nextIndex == w.index {
+			// the wait of the blocking query expired
+			continue
+		}
+		w.index = nextIndex
+		return nil
+	}
+}
+
+// EndpointName returns the name of the endpoint for the given address of a service registration
+func EndpointName(registrationId, address string, port uint32) string {
+	return fmt.Sprintf("nomad-%v-%v-%v", dnsName(registrationId), dnsName(address), port)
+}
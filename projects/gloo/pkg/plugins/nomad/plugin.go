@@ -0,0 +1,109 @@
+package nomad
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"time"
+
+	envoyapi "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	nomadapi "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/nomad"
+	"github.com/solo-io/gloo/projects/gloo/pkg/discovery"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
+	"github.com/solo-io/solo-kit/pkg/errors"
+)
+
+const (
+	// the namespace of the services with no namespace
+	defaultNamespace = "default"
+
+	resolveTimeout = 10 * time.Second
+)
+
+var _ discovery.DiscoveryPlugin = new(plugin)
+
+type plugin struct {
+	config *v1.NomadConfiguration
+	client nomadClient
+}
+
+// NewPlugin returns the plugin for Nomad upstreams. The upstreams of the services of the Nomad cluster are
+// discovered if the config is not nil. The endpoints of the Nomad upstreams are always discovered.
+func NewPlugin(config *v1.NomadConfiguration) plugins.Plugin {
+	return &plugin{
+		config: config,
+		client: newHttpClient(config.GetAddress()),
+	}
+}
+
+func (p *plugin) Init(params plugins.InitParams) error {
+	return nil
+}
+
+func (p *plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *envoyapi.Cluster) error {
+	nomadSpec, ok := in.UpstreamSpec.UpstreamType.(*v1.UpstreamSpec_Nomad)
+	if !ok {
+		return nil
+	}
+	if nomadSpec.Nomad.ServiceName == "" {
+		return errors.Errorf("service of nomad upstream %v must be set", in.Metadata.Ref())
+	}
+
+	// the addresses of the allocations are published as endpoints
+	xds.SetEdsOnCluster(out)
+
+	return nil
+}
+
+// the cluster only depends on the upstream
+func (p *plugin) CacheableUpstream(in *v1.Upstream) bool {
+	return true
+}
+
+func (p *plugin) Resolve(u *v1.Upstream) (*url.URL, error) {
+	nomadSpec, ok := u.UpstreamSpec.UpstreamType.(*v1.UpstreamSpec_Nomad)
+	if !ok {
+		return nil, nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), resolveTimeout)
+	defer cancel()
+	registrations, err := p.client.Registrations(ctx, namespaceOf(nomadSpec.Nomad), nomadSpec.Nomad.ServiceName)
+	if err != nil {
+		return nil, err
+	}
+	for _, registration := range registrations {
+		if hasTags(registration, nomadSpec.Nomad.ServiceTags) {
+			return url.Parse(fmt.Sprintf("tcp://%s", net.JoinHostPort(registration.Address, strconv.Itoa(registration.Port))))
+		}
+	}
+	return nil, errors.Errorf("no allocation registers nomad service %v.%v", namespaceOf(nomadSpec.Nomad), nomadSpec.Nomad.ServiceName)
+}
+
+func namespaceOf(spec *nomadapi.UpstreamSpec) string {
+	if spec.Namespace == "" {
+		return defaultNamespace
+	}
+	return spec.Namespace
+}
+
+// whether the registration has all the tags
+func hasTags(registration serviceRegistration, tags []string) bool {
+	for _, tag := range tags {
+		var found bool
+		for _, registered := range registration.Tags {
+			if registered == tag {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
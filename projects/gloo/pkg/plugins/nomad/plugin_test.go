@@ -0,0 +1,144 @@
+package nomad
+
+import (
+	"context"
+
+	envoyapi "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	nomadapi "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/nomad"
+	"github.com/solo-io/gloo/projects/gloo/pkg/discovery"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/solo-io/solo-kit/pkg/errors"
+)
+
+type fakeClient struct {
+	services      []namespaceServices
+	registrations map[string][]serviceRegistration
+}
+
+func (c *fakeClient) Services(ctx context.Context, namespace string, index uint64) ([]namespaceServices, uint64, error) {
+	if index > 0 {
+		// nothing changes
+		<-ctx.Done()
+		return nil, 0, ctx.Err()
+	}
+	return c.services, 1, nil
+}
+
+func (c *fakeClient) Registrations(ctx context.Context, namespace, service string) ([]serviceRegistration, error) {
+	registrations, ok := c.registrations[namespace+"."+service]
+	if !ok {
+		return nil, errors.Errorf("service not found")
+	}
+	return registrations, nil
+}
+
+var _ = Describe("Nomad Upstreams", func() {
+	var (
+		client   *fakeClient
+		p        *plugin
+		spec     *nomadapi.UpstreamSpec
+		upstream *v1.Upstream
+	)
+
+	BeforeEach(func() {
+		client = &fakeClient{
+			services: []namespaceServices{
+				{Namespace: "default", Services: []nomadService{{ServiceName: "redis"}, {ServiceName: "api", Tags: []string{"v1", "v2"}}}},
+				{Namespace: "staging", Services: []nomadService{{ServiceName: "api"}}},
+			},
+			registrations: map[string][]serviceRegistration{
+				"default.api": {
+					{ID: "_nomad-task-1", Address: "10.0.0.1", Port: 25000, Tags: []string{"v1"}},
+					{ID: "_nomad-task-2", Address: "10.0.0.2", Port: 25001, Tags: []string{"v2"}},
+				},
+			},
+		}
+		p = &plugin{config: &v1.NomadConfiguration{}, client: client}
+		spec = &nomadapi.UpstreamSpec{ServiceName: "api"}
+		upstream = &v1.Upstream{
+			Metadata: core.Metadata{Name: "nomad-default-api", Namespace: "gloo-system"},
+			UpstreamSpec: &v1.UpstreamSpec{
+				UpstreamType: &v1.UpstreamSpec_Nomad{Nomad: spec},
+			},
+		}
+	})
+
+	Context("plugin", func() {
+		It("should use eds for nomad upstreams", func() {
+			out := &envoyapi.Cluster{}
+			err := NewPlugin(nil).(plugins.UpstreamPlugin).ProcessUpstream(plugins.Params{}, upstream, out)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.GetType()).To(Equal(envoyapi.Cluster_EDS))
+		})
+
+		It("should resolve the address of a registration", func() {
+			u, err := p.Resolve(upstream)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(u.String()).To(Equal("tcp://10.0.0.1:25000"))
+		})
+	})
+
+	Context("upstreams", func() {
+		It("should create an upstream per service", func() {
+			upstreams := p.convertServices(client.services, "gloo-system")
+			var names []string
+			for _, us := range upstreams {
+				names = append(names, us.Metadata.Name)
+			}
+			Expect(names).To(Equal([]string{"nomad-default-api", "nomad-default-redis", "nomad-staging-api"}))
+			Expect(upstreams[2].UpstreamSpec.GetNomad()).To(Equal(&nomadapi.UpstreamSpec{ServiceName: "api", Namespace: "staging"}))
+		})
+
+		It("should only discover the configured namespaces", func() {
+			p.config.Namespaces = []string{"staging"}
+			upstreams := p.convertServices(client.services, "gloo-system")
+			Expect(upstreams).To(HaveLen(1))
+			Expect(upstreams[0].Metadata.Name).To(Equal("nomad-staging-api"))
+		})
+
+		It("should not discover the upstreams without config", func() {
+			p.config = nil
+			upstreams, errs, err := p.DiscoverUpstreams(nil, "gloo-system", clients.WatchOpts{Ctx: context.TODO()}, discovery.Opts{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(upstreams).To(BeNil())
+			Expect(errs).To(BeNil())
+		})
+	})
+
+	Context("endpoints", func() {
+		It("should publish the addresses of the registrations with the tags of the upstream", func() {
+			spec.ServiceTags = []string{"v2"}
+			endpoints, err := p.endpointsPoller(map[core.ResourceRef]*nomadapi.UpstreamSpec{upstream.Metadata.Ref(): spec}, nil).List(context.TODO(), "gloo-system")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(endpoints).To(HaveLen(1))
+			Expect(endpoints[0].Metadata.Name).To(Equal("nomad--nomad-task-2-10-0-0-2-25001"))
+			Expect(endpoints[0].Address).To(Equal("10.0.0.2"))
+			Expect(endpoints[0].Port).To(BeEquivalentTo(25001))
+			Expect(endpoints[0].Upstreams).To(ConsistOf(&core.ResourceRef{Name: "nomad-default-api", Namespace: "gloo-system"}))
+		})
+
+		It("should watch the endpoints of the nomad upstreams", func() {
+			ctx, cancel := context.WithCancel(context.TODO())
+			defer cancel()
+			endpoints, _, err := p.WatchEndpoints("gloo-system", v1.UpstreamList{upstream}, clients.WatchOpts{Ctx: ctx})
+			Expect(err).NotTo(HaveOccurred())
+			Eventually(endpoints).Should(Receive(HaveLen(2)))
+		})
+
+		It("should keep the last known endpoints of upstreams whose registrations cannot be listed", func() {
+			poller := p.endpointsPoller(map[core.ResourceRef]*nomadapi.UpstreamSpec{upstream.Metadata.Ref(): spec}, nil)
+			_, err := poller.List(context.TODO(), "gloo-system")
+			Expect(err).NotTo(HaveOccurred())
+
+			delete(client.registrations, "default.api")
+			endpoints, err := poller.List(context.TODO(), "gloo-system")
+			Expect(err).To(MatchError(ContainSubstring("service not found")))
+			Expect(endpoints).To(HaveLen(2))
+		})
+	})
+})
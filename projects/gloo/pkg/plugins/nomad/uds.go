@@ -0,0 +1,162 @@
+package nomad
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	nomadapi "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/nomad"
+	"github.com/solo-io/gloo/projects/gloo/pkg/discovery"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/solo-io/solo-kit/pkg/errors"
+)
+
+const (
+	// lists the services of all the namespaces
+	allNamespaces = "*"
+
+	// how long to wait before querying the Nomad API again after an error
+	retryInterval = 10 * time.Second
+)
+
+func (p *plugin) DiscoverUpstreams(watchNamespaces []string, writeNamespace string, opts clients.WatchOpts, discOpts discovery.Opts) (chan v1.UpstreamList, chan error, error) {
+	if p.config == nil {
+		// nomad services are not discovered
+		return nil, nil, nil
+	}
+
+	upstreamsChan := make(chan v1.UpstreamList)
+	errs := make(chan error)
+
+	go func() {
+		defer close(upstreamsChan)
+		defer close(errs)
+
+		var index uint64
+		for {
+			services, nextIndex, err := p.client.Services(opts.Ctx, allNamespaces, index)
+			if opts.Ctx.Err() != nil {
+				return
+			}
+			if err != nil {
+				if !sendErrAndWait(opts.Ctx, errs, err) {
+					return
+				}
+				continue
+			}
+			if index != 0 && nextIndex == index {
+				// the wait of the blocking query expired
+				continue
+			}
+			index = nextIndex
+
+			select {
+			case <-opts.Ctx.Done():
+				return
+			case upstreamsChan <- p.convertServices(services, writeNamespace):
+			}
+		}
+	}()
+
+	return upstreamsChan, errs, nil
+}
+
+// reports the error, then waits before the next query. Returns false if the context is done.
+func sendErrAndWait(ctx context.Context, errs chan error, err error) bool {
+	select {
+	case <-ctx.Done():
+		return false
+	case errs <- err:
+	}
+	select {
+	case <-ctx.Done():
+		return false
+	case <-time.After(retryInterval):
+		return true
+	}
+}
+
+func (p *plugin) convertServices(services []namespaceServices, writeNamespace string) v1.UpstreamList {
+	var upstreams v1.UpstreamList
+	for _, namespace := range services {
+		if !p.discovers(namespace.Namespace) {
+			continue
+		}
+		for _, svc := range namespace.Services {
+			upstreams = append(upstreams, &v1.Upstream{
+				Metadata: core.Metadata{
+					Name:      UpstreamName(namespace.Namespace, svc.ServiceName),
+					Namespace: writeNamespace,
+				},
+				UpstreamSpec: &v1.UpstreamSpec{
+					UpstreamType: &v1.UpstreamSpec_Nomad{
+						Nomad: &nomadapi.UpstreamSpec{
+							ServiceName: svc.ServiceName,
+							Namespace:   namespace.Namespace,
+						},
+					},
+				},
+				DiscoveryMetadata: &v1.DiscoveryMetadata{},
+			})
+		}
+	}
+	// sort for idempotency
+	sort.SliceStable(upstreams, func(i, j int) bool { return upstreams[i].Metadata.Name < upstreams[j].Metadata.Name })
+	return upstreams
+}
+
+// whether the services of the nomad namespace are discovered
+func (p *plugin) discovers(namespace string) bool {
+	if len(p.config.GetNamespaces()) == 0 {
+		return true
+	}
+	for _, ns := range p.config.GetNamespaces() {
+		if ns == namespace {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *plugin) UpdateUpstream(original, desired *v1.Upstream) (bool, error) {
+	originalSpec, ok := original.UpstreamSpec.UpstreamType.(*v1.UpstreamSpec_Nomad)
+	if !ok {
+		return false, errors.Errorf("internal error: expected *v1.UpstreamSpec_Nomad, got %v", reflect.TypeOf(original.UpstreamSpec.UpstreamType).Name())
+	}
+	desiredSpec, ok := desired.UpstreamSpec.UpstreamType.(*v1.UpstreamSpec_Nomad)
+	if !ok {
+		return false, errors.Errorf("internal error: expected *v1.UpstreamSpec_Nomad, got %v", reflect.TypeOf(original.UpstreamSpec.UpstreamType).Name())
+	}
+	// copy service spec, we don't want to overwrite that
+	desiredSpec.Nomad.ServiceSpec = originalSpec.Nomad.ServiceSpec
+	// copy tags; user may have written them over. cannot be auto-discovered
+	desiredSpec.Nomad.ServiceTags = originalSpec.Nomad.ServiceTags
+
+	if originalSpec.Equal(desiredSpec) {
+		return false, nil
+	}
+
+	return true, nil
+}
+
+// UpstreamName returns the name of the upstream of a nomad service
+func UpstreamName(namespace, service string) string {
+	return fmt.Sprintf("nomad-%v-%v", dnsName(namespace), dnsName(service))
+}
+
+func dnsName(s string) string {
+	return strings.Map(func(r rune) rune {
+		if '0' <= r && r <= '9' {
+			return r
+		}
+		if 'a' <= r && r <= 'z' {
+			return r
+		}
+		return '-'
+	}, strings.ToLower(s))
+}
@@ -0,0 +1,13 @@
+package openfaas_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestOpenfaas(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "OpenFaaS Suite")
+}
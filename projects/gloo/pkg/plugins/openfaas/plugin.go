@@ -0,0 +1,126 @@
+package openfaas
+
+import (
+	"context"
+	"fmt"
+
+	envoyapi "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	envoyroute "github.com/envoyproxy/go-control-plane/envoy/api/v2/route"
+	"github.com/gogo/protobuf/proto"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	glooplugins "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/openfaas"
+	transformationapi "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/transformation"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/pluginutils"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/transformation"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/solo-io/solo-kit/pkg/errors"
+)
+
+const (
+	subPathExtractor = "sub_path"
+)
+
+type plugin struct {
+	recordedUpstreams map[core.ResourceRef]*openfaas.ServiceSpec
+	ctx               context.Context
+	transformsAdded   *bool
+}
+
+func NewPlugin(transformsAdded *bool) plugins.Plugin {
+	return &plugin{transformsAdded: transformsAdded}
+}
+
+func (p *plugin) Init(params plugins.InitParams) error {
+	p.ctx = params.Ctx
+	p.recordedUpstreams = make(map[core.ResourceRef]*openfaas.ServiceSpec)
+	return nil
+}
+
+func (p *plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, _ *envoyapi.Cluster) error {
+	withServiceSpec, ok := in.UpstreamSpec.UpstreamType.(v1.ServiceSpecGetter)
+	if !ok {
+		return nil
+	}
+	serviceSpec := withServiceSpec.GetServiceSpec()
+	if serviceSpec == nil {
+		return nil
+	}
+	openfaasServiceSpec, ok := serviceSpec.PluginType.(*glooplugins.ServiceSpec_Openfaas)
+	if !ok {
+		// not ours
+		return nil
+	}
+	if openfaasServiceSpec.Openfaas == nil {
+		return errors.Errorf("%v has an empty openfaas service spec", in.Metadata.Ref())
+	}
+	p.recordedUpstreams[in.Metadata.Ref()] = openfaasServiceSpec.Openfaas
+	return nil
+}
+
+// the openfaas service specs of the upstreams are recorded to translate the routes to them
+func (p *plugin) CacheableUpstream(in *v1.Upstream) bool {
+	withServiceSpec, ok := in.UpstreamSpec.UpstreamType.(v1.ServiceSpecGetter)
+	if !ok || withServiceSpec.GetServiceSpec() == nil {
+		return true
+	}
+	_, ours := withServiceSpec.GetServiceSpec().PluginType.(*glooplugins.ServiceSpec_Openfaas)
+	return !ours
+}
+
+func (p *plugin) ProcessRoute(params plugins.Params, in *v1.Route, out *envoyroute.Route) error {
+	return pluginutils.MarkPerFilterConfig(p.ctx, params.Snapshot, in, out, transformation.FilterName, func(spec *v1.Destination) (proto.Message, error) {
+		// check if it's an openfaas destination
+		if spec.DestinationSpec == nil || spec.GetUpstream() == nil {
+			return nil, nil
+		}
+		openfaasDestinationSpec, ok := spec.DestinationSpec.DestinationType.(*v1.DestinationSpec_Openfaas)
+		if !ok {
+			return nil, nil
+		}
+
+		serviceSpec, ok := p.recordedUpstreams[*spec.GetUpstream()]
+		if !ok {
+			return nil, errors.Errorf("%v does not have an openfaas service spec", *spec.GetUpstream())
+		}
+
+		functionName := openfaasDestinationSpec.Openfaas.FunctionName
+		for _, functionSpec := range serviceSpec.Functions {
+			if functionSpec.FunctionName != functionName {
+				continue
+			}
+
+			*p.transformsAdded = true
+
+			// the gateway invokes the function on its /function/<name> endpoint, with the path of the request
+			// following the route and its query string
+			ret := &transformationapi.RouteTransformations{
+				RequestTransformation: &transformationapi.Transformation{
+					TransformationType: &transformationapi.Transformation_TransformationTemplate{
+						TransformationTemplate: &transformationapi.TransformationTemplate{
+							Extractors: map[string]*transformationapi.Extraction{
+								subPathExtractor: pluginutils.SubPathExtraction(in),
+							},
+							Headers: map[string]*transformationapi.InjaTemplate{
+								":path": {
+									Text: FunctionPath(functionName) + "/{{ " + subPathExtractor + " }}",
+								},
+							},
+							BodyTransformation: &transformationapi.TransformationTemplate_Passthrough{
+								Passthrough: &transformationapi.Passthrough{},
+							},
+						},
+					},
+				},
+			}
+			return ret, nil
+		}
+		return nil, errors.Errorf("unknown function %v", functionName)
+	})
+}
+
+// FunctionPath returns the path of a function on the OpenFaaS gateway
+func FunctionPath(functionName string) string {
+	return fmt.Sprintf("/function/%s", functionName)
+}
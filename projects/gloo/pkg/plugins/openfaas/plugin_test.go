@@ -0,0 +1,147 @@
+package openfaas_test
+
+import (
+	"context"
+
+	envoyapi "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	envoyroute "github.com/envoyproxy/go-control-plane/envoy/api/v2/route"
+	"github.com/envoyproxy/go-control-plane/pkg/util"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	glooplugins "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/openfaas"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/static"
+	transformationapi "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/transformation"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	. "github.com/solo-io/gloo/projects/gloo/pkg/plugins/openfaas"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/transformation"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+var _ = Describe("Plugin", func() {
+	var (
+		params          plugins.Params
+		plugin          plugins.Plugin
+		transformsAdded bool
+		upstream        *v1.Upstream
+		route           *v1.Route
+		destination     *v1.Destination
+		outroute        *envoyroute.Route
+	)
+
+	BeforeEach(func() {
+		transformsAdded = false
+		plugin = NewPlugin(&transformsAdded)
+		plugin.Init(plugins.InitParams{Ctx: context.TODO()})
+		params.Snapshot = &v1.ApiSnapshot{}
+
+		upstream = &v1.Upstream{
+			Metadata: core.Metadata{
+				Name:      "gateway",
+				Namespace: "openfaas",
+			},
+			UpstreamSpec: &v1.UpstreamSpec{
+				UpstreamType: &v1.UpstreamSpec_Static{
+					Static: &static.UpstreamSpec{
+						Hosts: []*static.Host{{Addr: "gateway.openfaas", Port: 8080}},
+						ServiceSpec: &glooplugins.ServiceSpec{
+							PluginType: &glooplugins.ServiceSpec_Openfaas{
+								Openfaas: &openfaas.ServiceSpec{
+									Functions: []*openfaas.ServiceSpec_FunctionSpec{
+										{FunctionName: "figlet"},
+										{FunctionName: "nodeinfo"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+		ref := upstream.Metadata.Ref()
+		destination = &v1.Destination{
+			DestinationType: &v1.Destination_Upstream{
+				Upstream: &ref,
+			},
+			DestinationSpec: &v1.DestinationSpec{
+				DestinationType: &v1.DestinationSpec_Openfaas{
+					Openfaas: &openfaas.DestinationSpec{
+						FunctionName: "figlet",
+					},
+				},
+			},
+		}
+		route = &v1.Route{
+			Matcher: &v1.Matcher{
+				PathSpecifier: &v1.Matcher_Prefix{Prefix: "/figlet"},
+			},
+			Action: &v1.Route_RouteAction{
+				RouteAction: &v1.RouteAction{
+					Destination: &v1.RouteAction_Single{
+						Single: destination,
+					},
+				},
+			},
+		}
+		outroute = &envoyroute.Route{
+			Action: &envoyroute.Route_Route{
+				Route: &envoyroute.RouteAction{
+					ClusterSpecifier: &envoyroute.RouteAction_Cluster{
+						Cluster: "gateway",
+					},
+				},
+			},
+		}
+
+		err := plugin.(plugins.UpstreamPlugin).ProcessUpstream(params, upstream, &envoyapi.Cluster{})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("should rewrite the path to the function endpoint of the gateway", func() {
+		err := plugin.(plugins.RoutePlugin).ProcessRoute(params, route, outroute)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(transformsAdded).To(BeTrue())
+		Expect(outroute.PerFilterConfig).To(HaveKey(transformation.FilterName))
+
+		var transformations transformationapi.RouteTransformations
+		err = util.StructToMessage(outroute.PerFilterConfig[transformation.FilterName], &transformations)
+		Expect(err).NotTo(HaveOccurred())
+		template := transformations.RequestTransformation.GetTransformationTemplate()
+		Expect(template.Headers).To(HaveKeyWithValue(":path", &transformationapi.InjaTemplate{Text: "/function/figlet/{{ sub_path }}"}))
+		Expect(template.Extractors).To(HaveKeyWithValue("sub_path", &transformationapi.Extraction{
+			Header:   ":path",
+			Regex:    "^/figlet/?(.*)$",
+			Subgroup: 1,
+		}))
+		Expect(template.GetPassthrough()).NotTo(BeNil())
+	})
+
+	It("should error with an unknown function", func() {
+		destination.DestinationSpec.DestinationType.(*v1.DestinationSpec_Openfaas).Openfaas.FunctionName = "somethingelse"
+
+		err := plugin.(plugins.RoutePlugin).ProcessRoute(params, route, outroute)
+		Expect(err).To(HaveOccurred())
+		Expect(outroute.PerFilterConfig).NotTo(HaveKey(transformation.FilterName))
+	})
+
+	It("should error when the upstream has no openfaas service spec", func() {
+		upstream.UpstreamSpec.UpstreamType.(*v1.UpstreamSpec_Static).Static.ServiceSpec = nil
+		plugin.Init(plugins.InitParams{Ctx: context.TODO()})
+		err := plugin.(plugins.UpstreamPlugin).ProcessUpstream(params, upstream, &envoyapi.Cluster{})
+		Expect(err).NotTo(HaveOccurred())
+
+		err = plugin.(plugins.RoutePlugin).ProcessRoute(params, route, outroute)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should not process other destinations", func() {
+		destination.DestinationSpec = nil
+
+		err := plugin.(plugins.RoutePlugin).ProcessRoute(params, route, outroute)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(transformsAdded).To(BeFalse())
+		Expect(outroute.PerFilterConfig).NotTo(HaveKey(transformation.FilterName))
+	})
+})
@@ -0,0 +1,13 @@
+package openwhisk
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestOpenWhisk(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "OpenWhisk Suite")
+}
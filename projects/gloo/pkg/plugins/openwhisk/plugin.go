@@ -0,0 +1,240 @@
+package openwhisk
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+
+	envoyapi "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	envoyauth "github.com/envoyproxy/go-control-plane/envoy/api/v2/auth"
+	envoycore "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
+	envoyroute "github.com/envoyproxy/go-control-plane/envoy/api/v2/route"
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/types"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/openwhisk"
+	transformationapi "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/transformation"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/pluginutils"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/transformation"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/solo-io/solo-kit/pkg/errors"
+)
+
+const (
+	defaultNamespace = "_"
+	defaultPort      = 443
+
+	// the filter metadata of the endpoint of the cluster holding the authorization header of the upstream
+	metadataNamespace        = "io.solo.openwhisk"
+	authorizationMetadataKey = "authorization"
+)
+
+// the header formatter of envoy reads the authorization from the endpoint the request is sent to, so that the api key
+// is only carried by the cluster of the upstream, not by the route configurations of the listeners
+var authorizationHeader = fmt.Sprintf(`%%UPSTREAM_METADATA(["%s", "%s"])%%`, metadataNamespace, authorizationMetadataKey)
+
+// GetApiKeyBasicAuth returns the value of the authorization header of the requests to the OpenWhisk api
+func GetApiKeyBasicAuth(apiKey string) string {
+	return "Basic " + base64.StdEncoding.EncodeToString([]byte(apiKey))
+}
+
+// GetNamespace returns the namespace of the actions of the upstream
+func GetNamespace(s *openwhisk.UpstreamSpec) string {
+	if s.Namespace == "" {
+		return defaultNamespace
+	}
+	return s.Namespace
+}
+
+// GetHostAndPort splits the api host of the upstream, the port defaults to 443
+func GetHostAndPort(s *openwhisk.UpstreamSpec) (string, uint32, error) {
+	if s.ApiHost == "" {
+		return "", 0, errors.Errorf("api host is required")
+	}
+	host, port, err := net.SplitHostPort(s.ApiHost)
+	if err != nil {
+		// no port
+		return s.ApiHost, defaultPort, nil
+	}
+	p, err := strconv.ParseUint(port, 10, 16)
+	if err != nil {
+		return "", 0, errors.Wrapf(err, "invalid port in api host %v", s.ApiHost)
+	}
+	return host, uint32(p), nil
+}
+
+// GetApiKey returns the api key of the OpenWhisk secret, validating its format
+func GetApiKey(secrets v1.SecretList, ref core.ResourceRef) (string, error) {
+	secret, err := secrets.Find(ref.Strings())
+	if err != nil {
+		return "", errors.Wrapf(err, "openwhisk secrets for ref %v not found", ref)
+	}
+	openwhiskSecret, ok := secret.Kind.(*v1.Secret_Openwhisk)
+	if !ok {
+		return "", errors.Errorf("secret %v is not an OpenWhisk secret", secret.GetMetadata().Ref())
+	}
+	if !strings.Contains(openwhiskSecret.Openwhisk.ApiKey, ":") {
+		return "", errors.Errorf("the api key of secret %v must be in the <uuid>:<key> format", secret.GetMetadata().Ref())
+	}
+	return openwhiskSecret.Openwhisk.ApiKey, nil
+}
+
+type recordedUpstream struct {
+	spec          *openwhisk.UpstreamSpec
+	authorization string
+}
+
+type plugin struct {
+	recordedUpstreams map[core.ResourceRef]*recordedUpstream
+	ctx               context.Context
+	transformsAdded   *bool
+}
+
+func NewPlugin(transformsAdded *bool) plugins.Plugin {
+	return &plugin{transformsAdded: transformsAdded}
+}
+
+func (p *plugin) Init(params plugins.InitParams) error {
+	p.ctx = params.Ctx
+	p.recordedUpstreams = make(map[core.ResourceRef]*recordedUpstream)
+	return nil
+}
+
+func (p *plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *envoyapi.Cluster) error {
+	upstreamSpec, ok := in.UpstreamSpec.UpstreamType.(*v1.UpstreamSpec_Openwhisk)
+	if !ok {
+		// not ours
+		return nil
+	}
+	// even if it failed, route should still be valid
+	recorded := &recordedUpstream{spec: upstreamSpec.Openwhisk}
+	p.recordedUpstreams[in.Metadata.Ref()] = recorded
+
+	host, port, err := GetHostAndPort(upstreamSpec.Openwhisk)
+	if err != nil {
+		return errors.Wrapf(err, "invalid openwhisk upstream %v", in.Metadata.Ref())
+	}
+
+	// configure Envoy cluster routing info
+	out.ClusterDiscoveryType = &envoyapi.Cluster_Type{
+		Type: envoyapi.Cluster_LOGICAL_DNS,
+	}
+	out.DnsLookupFamily = envoyapi.Cluster_V4_ONLY
+	pluginutils.EnvoySingleEndpointLoadAssignment(out, host, port)
+
+	out.TlsContext = &envoyauth.UpstreamTlsContext{
+		Sni: host,
+	}
+
+	apiKey, err := GetApiKey(params.Snapshot.Secrets, upstreamSpec.Openwhisk.SecretRef)
+	if err != nil {
+		return err
+	}
+	recorded.authorization = GetApiKeyBasicAuth(apiKey)
+	out.LoadAssignment.Endpoints[0].LbEndpoints[0].Metadata = &envoycore.Metadata{
+		FilterMetadata: map[string]*types.Struct{
+			metadataNamespace: {
+				Fields: map[string]*types.Value{
+					authorizationMetadataKey: {Kind: &types.Value_StringValue{StringValue: recorded.authorization}},
+				},
+			},
+		},
+	}
+	return nil
+}
+
+// the openwhisk upstreams are recorded to translate the routes to them
+func (p *plugin) CacheableUpstream(in *v1.Upstream) bool {
+	_, ours := in.UpstreamSpec.UpstreamType.(*v1.UpstreamSpec_Openwhisk)
+	return !ours
+}
+
+func (p *plugin) ProcessRoute(params plugins.Params, in *v1.Route, out *envoyroute.Route) error {
+	err := pluginutils.MarkPerFilterConfig(p.ctx, params.Snapshot, in, out, transformation.FilterName, func(spec *v1.Destination) (proto.Message, error) {
+		// check if it's openwhisk upstream destination
+		if spec.DestinationSpec == nil || spec.GetUpstream() == nil {
+			return nil, nil
+		}
+		openwhiskDestinationSpec, ok := spec.DestinationSpec.DestinationType.(*v1.DestinationSpec_Openwhisk)
+		if !ok {
+			return nil, nil
+		}
+
+		upstream, ok := p.recordedUpstreams[*spec.GetUpstream()]
+		if !ok {
+			return nil, errors.Errorf("%v is not an OpenWhisk upstream", *spec.GetUpstream())
+		}
+		if upstream.authorization == "" {
+			return nil, errors.Errorf("no api key for OpenWhisk upstream %v", *spec.GetUpstream())
+		}
+
+		// get action
+		logicalName := openwhiskDestinationSpec.Openwhisk.LogicalName
+		for _, action := range upstream.spec.Actions {
+			if action.LogicalName != logicalName {
+				continue
+			}
+			host, _, err := GetHostAndPort(upstream.spec)
+			if err != nil {
+				return nil, err
+			}
+
+			*p.transformsAdded = true
+
+			return &transformationapi.RouteTransformations{
+				RequestTransformation: &transformationapi.Transformation{
+					TransformationType: &transformationapi.Transformation_TransformationTemplate{
+						TransformationTemplate: &transformationapi.TransformationTemplate{
+							Headers: map[string]*transformationapi.InjaTemplate{
+								// actions are always invoked with a POST request, waiting for their result
+								":method": {
+									Text: "POST",
+								},
+								":path": {
+									Text: getPath(upstream.spec, action),
+								},
+								":authority": {
+									Text: host,
+								},
+							},
+							BodyTransformation: &transformationapi.TransformationTemplate_Passthrough{
+								Passthrough: &transformationapi.Passthrough{},
+							},
+						},
+					},
+				},
+			}, nil
+		}
+		return nil, errors.Errorf("unknown action %v", logicalName)
+	})
+	if err != nil {
+		return err
+	}
+	return pluginutils.MarkHeaders(p.ctx, params.Snapshot, in, out, func(spec *v1.Destination) ([]*envoycore.HeaderValueOption, error) {
+		if _, ok := spec.GetDestinationSpec().GetDestinationType().(*v1.DestinationSpec_Openwhisk); !ok {
+			return nil, nil
+		}
+		return []*envoycore.HeaderValueOption{{
+			Header: &envoycore.HeaderValue{
+				Key:   "authorization",
+				Value: authorizationHeader,
+			},
+			Append: &types.BoolValue{Value: false},
+		}}, nil
+	})
+}
+
+func getPath(spec *openwhisk.UpstreamSpec, action *openwhisk.ActionSpec) string {
+	// the package and the name of the action are separate path segments
+	var segments []string
+	for _, segment := range strings.Split(action.ActionName, "/") {
+		segments = append(segments, url.PathEscape(segment))
+	}
+	return fmt.Sprintf("/api/v1/namespaces/%s/actions/%s?blocking=true&result=true",
+		url.PathEscape(GetNamespace(spec)), strings.Join(segments, "/"))
+}
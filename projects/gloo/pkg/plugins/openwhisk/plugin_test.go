@@ -0,0 +1,219 @@
+package openwhisk
+
+import (
+	"encoding/base64"
+
+	envoyapi "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	envoycore "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
+	envoyroute "github.com/envoyproxy/go-control-plane/envoy/api/v2/route"
+	"github.com/envoyproxy/go-control-plane/pkg/util"
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/openwhisk"
+	transformationapi "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/transformation"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/transformation"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+const apiKey = "23bc46b1-71f6-4ed5-8c54-816aa4f8c502:123zO3xZCLrMN6v2BKK1dXYFpXlPkccOFqm12CdAsMgRU4VrNZ9lyGVCGuMDGIwP"
+
+var _ = Describe("Plugin", func() {
+	var (
+		params          plugins.Params
+		plugin          plugins.Plugin
+		transformsAdded bool
+		upstream        *v1.Upstream
+		upstreamSpec    *openwhisk.UpstreamSpec
+		route           *v1.Route
+		out             *envoyapi.Cluster
+		outroute        *envoyroute.Route
+	)
+	BeforeEach(func() {
+		transformsAdded = false
+		plugin = NewPlugin(&transformsAdded)
+		plugin.Init(plugins.InitParams{})
+		upstreamName := "up"
+		upstreamSpec = &openwhisk.UpstreamSpec{
+			ApiHost: "us-south.functions.cloud.ibm.com",
+			SecretRef: core.ResourceRef{
+				Name: "secretref",
+			},
+			Actions: []*openwhisk.ActionSpec{
+				{
+					LogicalName: "hello",
+					ActionName:  "hello",
+				},
+				{
+					LogicalName: "utils-echo",
+					ActionName:  "utils/echo",
+				},
+			},
+		}
+		upstream = &v1.Upstream{
+			Metadata: core.Metadata{
+				Name: upstreamName,
+			},
+			UpstreamSpec: &v1.UpstreamSpec{
+				UpstreamType: &v1.UpstreamSpec_Openwhisk{
+					Openwhisk: upstreamSpec,
+				},
+			},
+		}
+		route = &v1.Route{
+			Action: &v1.Route_RouteAction{
+				RouteAction: &v1.RouteAction{
+					Destination: &v1.RouteAction_Single{
+						Single: &v1.Destination{
+							DestinationType: &v1.Destination_Upstream{
+								Upstream: &core.ResourceRef{
+									Name: upstreamName,
+								},
+							},
+							DestinationSpec: &v1.DestinationSpec{
+								DestinationType: &v1.DestinationSpec_Openwhisk{
+									Openwhisk: &openwhisk.DestinationSpec{
+										LogicalName: "utils-echo",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+
+		out = &envoyapi.Cluster{}
+		outroute = &envoyroute.Route{
+			Action: &envoyroute.Route_Route{
+				Route: &envoyroute.RouteAction{
+					ClusterSpecifier: &envoyroute.RouteAction_Cluster{
+						Cluster: upstreamName,
+					},
+				},
+			},
+		}
+
+		params.Snapshot = &v1.ApiSnapshot{
+			Secrets: v1.SecretList{{
+				Metadata: core.Metadata{
+					Name: "secretref",
+				},
+				Kind: &v1.Secret_Openwhisk{
+					Openwhisk: &v1.OpenWhiskSecret{
+						ApiKey: apiKey,
+					},
+				},
+			}},
+		}
+	})
+
+	Context("upstreams", func() {
+		It("should point the cluster at the api host", func() {
+			err := plugin.(plugins.UpstreamPlugin).ProcessUpstream(params, upstream, out)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.TlsContext.Sni).To(Equal("us-south.functions.cloud.ibm.com"))
+			Expect(out.GetType()).To(Equal(envoyapi.Cluster_LOGICAL_DNS))
+			socketAddress := out.LoadAssignment.Endpoints[0].LbEndpoints[0].GetEndpoint().Address.GetSocketAddress()
+			Expect(socketAddress.Address).To(Equal("us-south.functions.cloud.ibm.com"))
+			Expect(socketAddress.GetPortValue()).To(BeEquivalentTo(443))
+		})
+
+		It("should carry the api key in the metadata of the endpoint", func() {
+			err := plugin.(plugins.UpstreamPlugin).ProcessUpstream(params, upstream, out)
+			Expect(err).NotTo(HaveOccurred())
+			metadata := out.LoadAssignment.Endpoints[0].LbEndpoints[0].Metadata.FilterMetadata["io.solo.openwhisk"]
+			Expect(metadata.Fields["authorization"].GetStringValue()).To(Equal("Basic " + base64.StdEncoding.EncodeToString([]byte(apiKey))))
+		})
+
+		It("should use the port of the api host", func() {
+			upstreamSpec.ApiHost = "openwhisk.example.com:31001"
+			err := plugin.(plugins.UpstreamPlugin).ProcessUpstream(params, upstream, out)
+			Expect(err).NotTo(HaveOccurred())
+			socketAddress := out.LoadAssignment.Endpoints[0].LbEndpoints[0].GetEndpoint().Address.GetSocketAddress()
+			Expect(socketAddress.Address).To(Equal("openwhisk.example.com"))
+			Expect(socketAddress.GetPortValue()).To(BeEquivalentTo(31001))
+		})
+
+		It("should error without an api host", func() {
+			upstreamSpec.ApiHost = ""
+			err := plugin.(plugins.UpstreamPlugin).ProcessUpstream(params, upstream, out)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should error with a secret of another kind", func() {
+			params.Snapshot.Secrets[0].Kind = &v1.Secret_Aws{Aws: &v1.AwsSecret{}}
+			err := plugin.(plugins.UpstreamPlugin).ProcessUpstream(params, upstream, out)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should error with an api key without a key", func() {
+			params.Snapshot.Secrets[0].Kind.(*v1.Secret_Openwhisk).Openwhisk.ApiKey = "23bc46b1-71f6-4ed5-8c54-816aa4f8c502"
+			err := plugin.(plugins.UpstreamPlugin).ProcessUpstream(params, upstream, out)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("routes", func() {
+		BeforeEach(func() {
+			err := plugin.(plugins.UpstreamPlugin).ProcessUpstream(params, upstream, out)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		requestHeaders := func() map[string]*transformationapi.InjaTemplate {
+			var transformations transformationapi.RouteTransformations
+			err := util.StructToMessage(outroute.PerFilterConfig[transformation.FilterName], &transformations)
+			Expect(err).NotTo(HaveOccurred())
+			return transformations.RequestTransformation.GetTransformationTemplate().Headers
+		}
+
+		It("should invoke the action with the api key", func() {
+			err := plugin.(plugins.RoutePlugin).ProcessRoute(params, route, outroute)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(transformsAdded).To(BeTrue())
+
+			headers := requestHeaders()
+			Expect(headers[":method"].Text).To(Equal("POST"))
+			Expect(headers[":path"].Text).To(Equal("/api/v1/namespaces/_/actions/utils/echo?blocking=true&result=true"))
+			Expect(headers[":authority"].Text).To(Equal("us-south.functions.cloud.ibm.com"))
+			Expect(headers).NotTo(HaveKey("authorization"))
+			Expect(outroute.RequestHeadersToAdd).To(ConsistOf(&envoycore.HeaderValueOption{
+				Header: &envoycore.HeaderValue{
+					Key:   "authorization",
+					Value: `%UPSTREAM_METADATA(["io.solo.openwhisk", "authorization"])%`,
+				},
+				Append: &types.BoolValue{Value: false},
+			}))
+		})
+
+		It("should keep the api key out of the route", func() {
+			err := plugin.(plugins.RoutePlugin).ProcessRoute(params, route, outroute)
+			Expect(err).NotTo(HaveOccurred())
+			serialized, err := proto.Marshal(outroute)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(serialized)).NotTo(ContainSubstring(apiKey))
+			Expect(string(serialized)).NotTo(ContainSubstring(base64.StdEncoding.EncodeToString([]byte(apiKey))))
+		})
+
+		It("should invoke the action in the namespace of the upstream", func() {
+			upstreamSpec.Namespace = "user@example.com_dev"
+			err := plugin.(plugins.RoutePlugin).ProcessRoute(params, route, outroute)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(requestHeaders()[":path"].Text).To(Equal("/api/v1/namespaces/user@example.com_dev/actions/utils/echo?blocking=true&result=true"))
+		})
+
+		It("should not process with an action mismatch", func() {
+			destination := route.Action.(*v1.Route_RouteAction).RouteAction.Destination.(*v1.RouteAction_Single).Single
+			destination.DestinationSpec.DestinationType.(*v1.DestinationSpec_Openwhisk).Openwhisk.LogicalName = "somethingelse"
+
+			err := plugin.(plugins.RoutePlugin).ProcessRoute(params, route, outroute)
+			Expect(err).To(HaveOccurred())
+			Expect(outroute.PerFilterConfig).NotTo(HaveKey(transformation.FilterName))
+			Expect(outroute.RequestHeadersToAdd).To(BeEmpty())
+		})
+	})
+})
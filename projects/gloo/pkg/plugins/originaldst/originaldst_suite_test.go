@@ -0,0 +1,13 @@
+package originaldst_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestOriginalDst(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "OriginalDst Suite")
+}
@@ -0,0 +1,155 @@
+package originaldst
+
+import (
+	"net"
+
+	envoyapi "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	envoycore "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
+	envoylistener "github.com/envoyproxy/go-control-plane/envoy/api/v2/listener"
+	envoyutil "github.com/envoyproxy/go-control-plane/pkg/util"
+	"github.com/gogo/protobuf/types"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/solo-io/solo-kit/pkg/errors"
+)
+
+const ListenerFilterName = envoyutil.OriginalDestination
+
+type Plugin struct{}
+
+var _ plugins.UpstreamPlugin = NewPlugin()
+var _ plugins.ListenerPlugin = NewPlugin()
+
+func NewPlugin() *Plugin {
+	return &Plugin{}
+}
+
+func (p *Plugin) Init(params plugins.InitParams) error {
+	return nil
+}
+
+func (p *Plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *envoyapi.Cluster) error {
+	upstreamSpec, ok := in.UpstreamSpec.UpstreamType.(*v1.UpstreamSpec_OriginalDst)
+	if !ok {
+		// not ours
+		return nil
+	}
+	spec := upstreamSpec.OriginalDst
+
+	// envoy requires the original destination load balancer for the original destination clusters
+	if in.UpstreamSpec.GetLoadBalancerConfig().GetType() != nil {
+		return errors.Errorf("the load balancer of the original destination upstream %v cannot be configured",
+			in.Metadata.Ref())
+	}
+	if spec.CleanupInterval != nil && *spec.CleanupInterval <= 0 {
+		return errors.Errorf("the cleanup interval must be positive, found %v", *spec.CleanupInterval)
+	}
+
+	out.ClusterDiscoveryType = &envoyapi.Cluster_Type{
+		Type: envoyapi.Cluster_ORIGINAL_DST,
+	}
+	out.LbPolicy = envoyapi.Cluster_ORIGINAL_DST_LB
+	out.CleanupInterval = spec.CleanupInterval
+	if spec.UseHttpHeader {
+		out.LbConfig = &envoyapi.Cluster_OriginalDstLbConfig_{
+			OriginalDstLbConfig: &envoyapi.Cluster_OriginalDstLbConfig{
+				UseHttpHeader: true,
+			},
+		}
+	}
+	return nil
+}
+
+// the cluster only depends on the upstream
+func (p *Plugin) CacheableUpstream(in *v1.Upstream) bool {
+	return true
+}
+
+func (p *Plugin) ProcessListener(params plugins.Params, in *v1.Listener, out *envoyapi.Listener) error {
+	cfg := in.GetHttpListener().GetListenerPlugins().GetOriginalDst()
+	if !cfg.GetTrustHttpHeader() {
+		if err := rejectHttpHeaderUpstreams(params.Snapshot, in); err != nil {
+			return err
+		}
+	}
+	if len(cfg.GetSourceCidrs()) > 0 {
+		sourceRanges, err := cidrRanges(cfg.GetSourceCidrs())
+		if err != nil {
+			return err
+		}
+		for i := range out.FilterChains {
+			if out.FilterChains[i].FilterChainMatch == nil {
+				out.FilterChains[i].FilterChainMatch = &envoylistener.FilterChainMatch{}
+			}
+			out.FilterChains[i].FilterChainMatch.SourcePrefixRanges = sourceRanges
+		}
+	}
+	if !cfg.GetEnabled() {
+		return nil
+	}
+	out.ListenerFilters = append(out.ListenerFilters, envoylistener.ListenerFilter{
+		Name: ListenerFilterName,
+	})
+	return nil
+}
+
+// the upstreams forwarding to the host of the header of the requests would let the clients of the listener send gloo
+// anywhere, so they are only routed to from the listeners trusting the header
+func rejectHttpHeaderUpstreams(snap *v1.ApiSnapshot, in *v1.Listener) error {
+	for _, virtualHost := range in.GetHttpListener().GetVirtualHosts() {
+		for _, route := range virtualHost.GetRoutes() {
+			for _, ref := range destinationUpstreams(snap, route.GetRouteAction()) {
+				upstream, err := snap.Upstreams.Find(ref.Strings())
+				if err != nil {
+					// reported by the translation of the route
+					continue
+				}
+				if upstream.GetUpstreamSpec().GetOriginalDst().GetUseHttpHeader() {
+					return errors.Errorf("listener %v routes to the original destination upstream %v, which forwards "+
+						"the requests to the host of their header: the listener must trust the header", in.Name, ref)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func destinationUpstreams(snap *v1.ApiSnapshot, action *v1.RouteAction) []core.ResourceRef {
+	var destinations []*v1.WeightedDestination
+	switch dest := action.GetDestination().(type) {
+	case *v1.RouteAction_Single:
+		destinations = []*v1.WeightedDestination{{Destination: dest.Single}}
+	case *v1.RouteAction_Multi:
+		destinations = dest.Multi.GetDestinations()
+	case *v1.RouteAction_UpstreamGroup:
+		upstreamGroup, err := snap.Upstreamgroups.Find(dest.UpstreamGroup.Namespace, dest.UpstreamGroup.Name)
+		if err != nil {
+			return nil
+		}
+		destinations = upstreamGroup.Destinations
+	}
+	var refs []core.ResourceRef
+	for _, destination := range destinations {
+		if upstream := destination.GetDestination().GetUpstream(); upstream != nil {
+			refs = append(refs, *upstream)
+		}
+	}
+	return refs
+}
+
+func cidrRanges(cidrs []string) ([]*envoycore.CidrRange, error) {
+	var ranges []*envoycore.CidrRange
+	for _, cidr := range cidrs {
+		_, ipNet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid source cidr %v", cidr)
+		}
+		prefixLen, _ := ipNet.Mask.Size()
+		ranges = append(ranges, &envoycore.CidrRange{
+			AddressPrefix: ipNet.IP.String(),
+			PrefixLen:     &types.UInt32Value{Value: uint32(prefixLen)},
+		})
+	}
+	return ranges, nil
+}
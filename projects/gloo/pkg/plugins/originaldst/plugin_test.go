@@ -0,0 +1,190 @@
+package originaldst_test
+
+import (
+	"time"
+
+	envoyapi "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	envoycore "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
+	envoylistener "github.com/envoyproxy/go-control-plane/envoy/api/v2/listener"
+	envoyutil "github.com/envoyproxy/go-control-plane/pkg/util"
+	"github.com/gogo/protobuf/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/originaldst"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/static"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	. "github.com/solo-io/gloo/projects/gloo/pkg/plugins/originaldst"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+var _ = Describe("Plugin", func() {
+	var (
+		plugin *Plugin
+	)
+
+	BeforeEach(func() {
+		plugin = NewPlugin()
+		Expect(plugin.Init(plugins.InitParams{})).NotTo(HaveOccurred())
+	})
+
+	Context("upstreams", func() {
+		var (
+			upstream *v1.Upstream
+			spec     *originaldst.UpstreamSpec
+			out      *envoyapi.Cluster
+		)
+
+		BeforeEach(func() {
+			spec = &originaldst.UpstreamSpec{}
+			upstream = &v1.Upstream{
+				Metadata: core.Metadata{Name: "passthrough", Namespace: "gloo-system"},
+				UpstreamSpec: &v1.UpstreamSpec{
+					UpstreamType: &v1.UpstreamSpec_OriginalDst{
+						OriginalDst: spec,
+					},
+				},
+			}
+			out = &envoyapi.Cluster{}
+		})
+
+		It("translates the upstream into an original destination cluster", func() {
+			Expect(plugin.ProcessUpstream(plugins.Params{}, upstream, out)).NotTo(HaveOccurred())
+			Expect(out.GetType()).To(Equal(envoyapi.Cluster_ORIGINAL_DST))
+			Expect(out.LbPolicy).To(Equal(envoyapi.Cluster_ORIGINAL_DST_LB))
+			Expect(out.CleanupInterval).To(BeNil())
+			Expect(out.LbConfig).To(BeNil())
+		})
+
+		It("forwards the requests to the host of their header", func() {
+			cleanupInterval := time.Minute
+			spec.UseHttpHeader = true
+			spec.CleanupInterval = &cleanupInterval
+			Expect(plugin.ProcessUpstream(plugins.Params{}, upstream, out)).NotTo(HaveOccurred())
+			Expect(*out.CleanupInterval).To(Equal(time.Minute))
+			Expect(out.GetOriginalDstLbConfig()).To(Equal(&envoyapi.Cluster_OriginalDstLbConfig{
+				UseHttpHeader: true,
+			}))
+		})
+
+		It("rejects load balancers", func() {
+			upstream.UpstreamSpec.LoadBalancerConfig = &v1.LoadBalancerConfig{
+				Type: &v1.LoadBalancerConfig_Random_{Random: &v1.LoadBalancerConfig_Random{}},
+			}
+			Expect(plugin.ProcessUpstream(plugins.Params{}, upstream, out)).To(HaveOccurred())
+		})
+
+		It("ignores the other upstreams", func() {
+			upstream.UpstreamSpec.UpstreamType = &v1.UpstreamSpec_Static{
+				Static: &static.UpstreamSpec{Hosts: []*static.Host{{Addr: "example.com", Port: 80}}},
+			}
+			Expect(plugin.ProcessUpstream(plugins.Params{}, upstream, out)).NotTo(HaveOccurred())
+			Expect(out).To(Equal(&envoyapi.Cluster{}))
+		})
+	})
+
+	Context("listeners", func() {
+		listener := func(cfg *originaldst.ListenerOriginalDst) *v1.Listener {
+			return &v1.Listener{
+				ListenerType: &v1.Listener_HttpListener{
+					HttpListener: &v1.HttpListener{
+						ListenerPlugins: &v1.ListenerPlugins{
+							OriginalDst: cfg,
+						},
+					},
+				},
+			}
+		}
+
+		It("restores the original destination of the connections", func() {
+			out := &envoyapi.Listener{}
+			in := listener(&originaldst.ListenerOriginalDst{Enabled: true})
+			Expect(plugin.ProcessListener(plugins.Params{}, in, out)).NotTo(HaveOccurred())
+			Expect(out.ListenerFilters).To(Equal([]envoylistener.ListenerFilter{{
+				Name: envoyutil.OriginalDestination,
+			}}))
+		})
+
+		It("leaves the listener untouched unless enabled", func() {
+			out := &envoyapi.Listener{}
+			Expect(plugin.ProcessListener(plugins.Params{}, listener(&originaldst.ListenerOriginalDst{}), out)).NotTo(HaveOccurred())
+			Expect(out).To(Equal(&envoyapi.Listener{}))
+		})
+
+		It("only accepts the connections of the source cidrs", func() {
+			out := &envoyapi.Listener{FilterChains: []envoylistener.FilterChain{{}, {
+				FilterChainMatch: &envoylistener.FilterChainMatch{ServerNames: []string{"example.com"}},
+			}}}
+			in := listener(&originaldst.ListenerOriginalDst{SourceCidrs: []string{"10.0.0.0/8", "192.168.1.7/32"}})
+			Expect(plugin.ProcessListener(plugins.Params{}, in, out)).NotTo(HaveOccurred())
+			expected := []*envoycore.CidrRange{
+				{AddressPrefix: "10.0.0.0", PrefixLen: &types.UInt32Value{Value: 8}},
+				{AddressPrefix: "192.168.1.7", PrefixLen: &types.UInt32Value{Value: 32}},
+			}
+			Expect(out.FilterChains[0].FilterChainMatch.SourcePrefixRanges).To(Equal(expected))
+			Expect(out.FilterChains[1].FilterChainMatch.SourcePrefixRanges).To(Equal(expected))
+			Expect(out.FilterChains[1].FilterChainMatch.ServerNames).To(Equal([]string{"example.com"}))
+		})
+
+		It("rejects invalid source cidrs", func() {
+			in := listener(&originaldst.ListenerOriginalDst{SourceCidrs: []string{"10.0.0.0"}})
+			Expect(plugin.ProcessListener(plugins.Params{}, in, &envoyapi.Listener{})).To(HaveOccurred())
+		})
+
+		Context("routes to the upstreams using the header", func() {
+			var (
+				params plugins.Params
+				in     *v1.Listener
+			)
+
+			BeforeEach(func() {
+				params.Snapshot = &v1.ApiSnapshot{
+					Upstreams: v1.UpstreamList{{
+						Metadata: core.Metadata{Name: "passthrough", Namespace: "gloo-system"},
+						UpstreamSpec: &v1.UpstreamSpec{
+							UpstreamType: &v1.UpstreamSpec_OriginalDst{
+								OriginalDst: &originaldst.UpstreamSpec{UseHttpHeader: true},
+							},
+						},
+					}},
+				}
+				in = listener(&originaldst.ListenerOriginalDst{})
+				in.GetHttpListener().VirtualHosts = []*v1.VirtualHost{{
+					Name: "vhost",
+					Routes: []*v1.Route{{
+						Action: &v1.Route_RouteAction{
+							RouteAction: &v1.RouteAction{
+								Destination: &v1.RouteAction_Multi{
+									Multi: &v1.MultiDestination{
+										Destinations: []*v1.WeightedDestination{{
+											Destination: &v1.Destination{
+												DestinationType: &v1.Destination_Upstream{
+													Upstream: &core.ResourceRef{Name: "passthrough", Namespace: "gloo-system"},
+												},
+											},
+										}},
+									},
+								},
+							},
+						},
+					}},
+				}}
+			})
+
+			It("rejects them from the listeners not trusting the header", func() {
+				err := plugin.ProcessListener(params, in, &envoyapi.Listener{})
+				Expect(err).To(MatchError(ContainSubstring("the listener must trust the header")))
+			})
+
+			It("accepts them from the listeners trusting the header", func() {
+				in.GetHttpListener().ListenerPlugins.OriginalDst.TrustHttpHeader = true
+				Expect(plugin.ProcessListener(params, in, &envoyapi.Listener{})).NotTo(HaveOccurred())
+			})
+
+			It("accepts the routes to the upstreams not using the header", func() {
+				params.Snapshot.Upstreams[0].UpstreamSpec.GetOriginalDst().UseHttpHeader = false
+				Expect(plugin.ProcessListener(params, in, &envoyapi.Listener{})).NotTo(HaveOccurred())
+			})
+		})
+	})
+})
@@ -35,6 +35,15 @@ type UpstreamPlugin interface {
 	ProcessUpstream(params Params, in *v1.Upstream, out *envoyapi.Cluster) error
 }
 
+// An UpstreamPlugin is a CacheableUpstreamPlugin if the translator may reuse the cluster of an unchanged upstream
+// without the plugin processing it again: its processing of the upstream only depends on the upstream and the secrets,
+// and it keeps no state about the upstream, e.g. to translate the routes to it.
+// The cluster of an upstream is reused only if all the upstream plugins are cacheable for it.
+type CacheableUpstreamPlugin interface {
+	UpstreamPlugin
+	CacheableUpstream(in *v1.Upstream) bool
+}
+
 /*
 	Routing Plugins
 */
@@ -0,0 +1,18 @@
+package pluginutils
+
+import (
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/solo-kit/pkg/errors"
+)
+
+// RequireEnvoyExtension returns an error unless the settings list the envoy extension among the extensions the proxies
+// support, for the features requiring an extension missing from the envoy image shipped with gloo.
+func RequireEnvoyExtension(settings *v1.Settings, name string) error {
+	for _, extension := range settings.GetEnvoyExtensions() {
+		if extension == name {
+			return nil
+		}
+	}
+	return errors.Errorf("the envoy image shipped with gloo lacks the %v extension, add it to the envoy extensions "+
+		"of the settings once the proxies run an envoy build with it", name)
+}
@@ -0,0 +1,23 @@
+package pluginutils_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+
+	. "github.com/solo-io/gloo/projects/gloo/pkg/plugins/pluginutils"
+)
+
+var _ = Describe("RequireEnvoyExtension", func() {
+
+	It("accepts the extensions listed in the settings", func() {
+		settings := &v1.Settings{EnvoyExtensions: []string{"envoy.clusters.aggregate"}}
+		Expect(RequireEnvoyExtension(settings, "envoy.clusters.aggregate")).NotTo(HaveOccurred())
+	})
+
+	It("rejects the extensions missing from the settings", func() {
+		settings := &v1.Settings{EnvoyExtensions: []string{"envoy.clusters.aggregate"}}
+		Expect(RequireEnvoyExtension(settings, "envoy.filters.http.wasm")).To(HaveOccurred())
+		Expect(RequireEnvoyExtension(nil, "envoy.clusters.aggregate")).To(HaveOccurred())
+	})
+})
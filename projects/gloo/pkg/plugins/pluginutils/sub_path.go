@@ -0,0 +1,30 @@
+package pluginutils
+
+import (
+	"regexp"
+	"strings"
+
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	transformationapi "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/transformation"
+)
+
+// SubPathExtraction extracts the path of the requests of the route that follows the prefix the route matches, without
+// its leading slash, and the query string, e.g. `items/1?verbose=true` for the request `/api/items/1?verbose=true` of a
+// route matching the prefix `/api`. Only the query string is extracted for the routes matching exact paths or regexes.
+// The plugins rewriting the path of the requests to the path of a function append it to the path of the function.
+func SubPathExtraction(in *v1.Route) *transformationapi.Extraction {
+	switch in.GetMatcher().GetPathSpecifier().(type) {
+	case *v1.Matcher_Exact, *v1.Matcher_Regex:
+		return &transformationapi.Extraction{
+			Header:   ":path",
+			Regex:    `^[^?]*(\?.*)?$`,
+			Subgroup: 1,
+		}
+	}
+	prefix := strings.TrimSuffix(in.GetMatcher().GetPrefix(), "/")
+	return &transformationapi.Extraction{
+		Header:   ":path",
+		Regex:    "^" + regexp.QuoteMeta(prefix) + "/?(.*)$",
+		Subgroup: 1,
+	}
+}
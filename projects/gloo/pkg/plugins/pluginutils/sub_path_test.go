@@ -0,0 +1,46 @@
+package pluginutils_test
+
+import (
+	"regexp"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+
+	. "github.com/solo-io/gloo/projects/gloo/pkg/plugins/pluginutils"
+)
+
+var _ = Describe("SubPathExtraction", func() {
+
+	extract := func(matcher *v1.Matcher, path string) string {
+		extraction := SubPathExtraction(&v1.Route{Matcher: matcher})
+		Expect(extraction.Header).To(Equal(":path"))
+		match := regexp.MustCompile(extraction.Regex).FindStringSubmatch(path)
+		Expect(match).NotTo(BeNil())
+		return match[extraction.Subgroup]
+	}
+
+	It("extracts the path following the prefix of the route and the query string", func() {
+		matcher := &v1.Matcher{PathSpecifier: &v1.Matcher_Prefix{Prefix: "/api"}}
+		Expect(extract(matcher, "/api/items/1?verbose=true")).To(Equal("items/1?verbose=true"))
+		Expect(extract(matcher, "/api")).To(Equal(""))
+		Expect(extract(matcher, "/api?verbose=true")).To(Equal("?verbose=true"))
+	})
+
+	It("extracts the whole path for the routes without matcher", func() {
+		Expect(extract(nil, "/items/1?verbose=true")).To(Equal("items/1?verbose=true"))
+	})
+
+	It("escapes the prefix of the route", func() {
+		matcher := &v1.Matcher{PathSpecifier: &v1.Matcher_Prefix{Prefix: "/v1.0/"}}
+		Expect(extract(matcher, "/v1.0/items")).To(Equal("items"))
+		extraction := SubPathExtraction(&v1.Route{Matcher: matcher})
+		Expect(regexp.MustCompile(extraction.Regex).MatchString("/v1x0/items")).To(BeFalse())
+	})
+
+	It("extracts only the query string for the routes matching exact paths", func() {
+		matcher := &v1.Matcher{PathSpecifier: &v1.Matcher_Exact{Exact: "/items"}}
+		Expect(extract(matcher, "/items?verbose=true")).To(Equal("?verbose=true"))
+		Expect(extract(matcher, "/items")).To(Equal(""))
+	})
+})
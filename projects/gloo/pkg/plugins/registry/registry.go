@@ -2,23 +2,47 @@ package registry
 
 import (
 	"github.com/solo-io/gloo/projects/gloo/pkg/bootstrap"
+	"github.com/solo-io/gloo/projects/gloo/pkg/imagecache"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/adaptiveconcurrency"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/alibaba"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/aws"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/azure"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/basicroute"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/cloudmap"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/consul"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/cors"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/deadline"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/docker"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/ec2"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/external"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/failover"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/faultinjection"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/grpc"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/gzip"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/hcm"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/healthcheck"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/istio"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/knative"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/kubernetes"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/linkerd"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/loadbalancer"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/lua"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/nomad"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/openfaas"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/openwhisk"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/originaldst"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/rest"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/static"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/stats"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/thrift"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/trailers"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/transformation"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/tuning"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/upstreamconn"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/upstreamssl"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/wasm"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/websocket"
 )
 
 type registry struct {
@@ -35,19 +59,43 @@ var globalRegistry = func(opts bootstrap.Opts, pluginExtensions ...plugins.Plugi
 		upstreamssl.NewPlugin(),
 		azure.NewPlugin(&transformationPlugin.RequireTransformationFilter),
 		aws.NewPlugin(&transformationPlugin.RequireTransformationFilter),
+		alibaba.NewPlugin(&transformationPlugin.RequireTransformationFilter),
 		rest.NewPlugin(&transformationPlugin.RequireTransformationFilter),
+		openfaas.NewPlugin(&transformationPlugin.RequireTransformationFilter),
+		thrift.NewPlugin(&transformationPlugin.RequireTransformationFilter),
+		openwhisk.NewPlugin(&transformationPlugin.RequireTransformationFilter),
+		external.NewPlugin(&transformationPlugin.RequireTransformationFilter),
+		failover.NewPlugin(),
+		originaldst.NewPlugin(),
 		hcm.NewPlugin(),
+		tuning.NewPlugin(),
+		websocket.NewPlugin(),
+		deadline.NewPlugin(),
+		gzip.NewPlugin(),
+		trailers.NewPlugin(),
 		static.NewPlugin(),
 		transformationPlugin,
 		consul.NewPlugin(),
+		nomad.NewPlugin(opts.Settings.GetNomad()),
+		docker.NewPlugin(opts.Settings.GetDocker()),
+		ec2.NewPlugin(opts.Secrets),
+		cloudmap.NewPlugin(opts.Secrets),
 		grpc.NewPlugin(&transformationPlugin.RequireTransformationFilter),
 		faultinjection.NewPlugin(),
 		basicroute.NewPlugin(),
 		cors.NewPlugin(),
 		linkerd.NewPlugin(),
+		adaptiveconcurrency.NewPlugin(),
+		lua.NewPlugin(),
+		wasm.NewPlugin(opts.WasmImageCache, imagecache.Address(opts.Settings.GetWasm(), opts.WriteNamespace)),
+		stats.NewPlugin(),
+		// must run after all plugins that set the endpoints of the clusters
+		healthcheck.NewPlugin(),
+		// must run after all plugins that set transformations
+		transformation.NewConditionalHeadersPlugin(),
 	)
 	if opts.KubeClient != nil {
-		reg.plugins = append(reg.plugins, kubernetes.NewPlugin(opts.KubeClient))
+		reg.plugins = append(reg.plugins, kubernetes.NewPlugin(opts.KubeClient), knative.NewPlugin(), istio.NewPlugin())
 	}
 	for _, pluginExtension := range pluginExtensions {
 		reg.plugins = append(reg.plugins, pluginExtension)
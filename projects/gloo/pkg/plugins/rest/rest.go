@@ -69,6 +69,16 @@ func (p *plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, _ *envo
 	return nil
 }
 
+// the rest service specs of the upstreams are recorded to translate the routes to them
+func (p *plugin) CacheableUpstream(in *v1.Upstream) bool {
+	withServiceSpec, ok := in.UpstreamSpec.UpstreamType.(UpstreamWithServiceSpec)
+	if !ok || withServiceSpec.GetServiceSpec() == nil {
+		return true
+	}
+	_, ours := withServiceSpec.GetServiceSpec().PluginType.(*glooplugins.ServiceSpec_Rest)
+	return !ours
+}
+
 func (p *plugin) ProcessRoute(params plugins.Params, in *v1.Route, out *envoyroute.Route) error {
 	return pluginutils.MarkPerFilterConfig(p.ctx, params.Snapshot, in, out, transformation.FilterName, func(spec *v1.Destination) (proto.Message, error) {
 		// check if it's rest destination
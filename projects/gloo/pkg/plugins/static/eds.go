@@ -0,0 +1,217 @@
+package static
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	v1static "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/static"
+	"github.com/solo-io/gloo/projects/gloo/pkg/discovery"
+	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/solo-io/solo-kit/pkg/errors"
+)
+
+const (
+	// the records of the upstreams are resolved again after this interval, unless they set their own
+	defaultRefreshInterval = 30 * time.Second
+
+	resolveTimeout = 10 * time.Second
+)
+
+var _ discovery.DiscoveryPlugin = new(plugin)
+
+// resolves the srv records and the addresses of their targets, implemented by net.Resolver
+type dnsResolver interface {
+	LookupSRV(ctx context.Context, service, proto, name string) (string, []*net.SRV, error)
+	LookupIPAddr(ctx context.Context, host string) ([]net.IPAddr, error)
+}
+
+func (p *plugin) dnsResolver() dnsResolver {
+	if p.resolver == nil {
+		return net.DefaultResolver
+	}
+	return p.resolver
+}
+
+// static upstreams are created by users, only the endpoints of the ones resolved from srv records are discovered
+func (p *plugin) DiscoverUpstreams(watchNamespaces []string, writeNamespace string, opts clients.WatchOpts, discOpts discovery.Opts) (chan v1.UpstreamList, chan error, error) {
+	return nil, nil, nil
+}
+
+func (p *plugin) UpdateUpstream(original, desired *v1.Upstream) (bool, error) {
+	return false, nil
+}
+
+func (p *plugin) WatchEndpoints(writeNamespace string, upstreamsToTrack v1.UpstreamList, opts clients.WatchOpts) (<-chan v1.EndpointList, <-chan error, error) {
+	upstreams := make(map[core.ResourceRef]*v1static.SrvDiscovery)
+	for _, us := range upstreamsToTrack {
+		staticUpstream, ok := us.UpstreamSpec.UpstreamType.(*v1.UpstreamSpec_Static)
+		// only care about the static upstreams resolved from srv records
+		if !ok || staticUpstream.Static.SrvDiscovery == nil {
+			continue
+		}
+		upstreams[us.Metadata.Ref()] = staticUpstream.Static.SrvDiscovery
+	}
+	opts = opts.WithDefaults()
+
+	endpointsChan := make(chan v1.EndpointList)
+	errs := make(chan error)
+
+	go func() {
+		defer close(endpointsChan)
+		defer close(errs)
+
+		// the endpoints last resolved for each upstream, and when to resolve them again
+		resolved := make(map[core.ResourceRef][]*v1.Endpoint)
+		due := make(map[core.ResourceRef]time.Time)
+		var previous v1.EndpointList
+		var sent bool
+		for {
+			now := time.Now()
+			next := now.Add(defaultRefreshInterval)
+			for ref, srv := range upstreams {
+				if due[ref].After(now) {
+					if due[ref].Before(next) {
+						next = due[ref]
+					}
+					continue
+				}
+				endpoints, err := p.resolveEndpoints(opts.Ctx, writeNamespace, ref, srv)
+				if err != nil {
+					// keep the endpoints resolved last
+					contextutils.LoggerFrom(opts.Ctx).Warnf("upstream %v: %v", ref.Key(), err)
+				} else {
+					resolved[ref] = endpoints
+				}
+				due[ref] = now.Add(refreshInterval(srv))
+				if due[ref].Before(next) {
+					next = due[ref]
+				}
+			}
+
+			list := aggregateEndpoints(resolved)
+			if !sent || !endpointsEqual(list, previous) {
+				select {
+				case <-opts.Ctx.Done():
+					return
+				case endpointsChan <- list:
+				}
+				previous, sent = list, true
+			}
+
+			// nothing changes if there is no upstream resolved from srv records
+			if len(upstreams) == 0 {
+				<-opts.Ctx.Done()
+				return
+			}
+			select {
+			case <-opts.Ctx.Done():
+				return
+			case <-time.After(time.Until(next)):
+			}
+		}
+	}()
+	return endpointsChan, errs, nil
+}
+
+func refreshInterval(srv *v1static.SrvDiscovery) time.Duration {
+	if srv.RefreshInterval == nil || *srv.RefreshInterval <= 0 {
+		return defaultRefreshInterval
+	}
+	return *srv.RefreshInterval
+}
+
+// resolves the records of the upstream, and the addresses of their targets
+func (p *plugin) resolveEndpoints(ctx context.Context, writeNamespace string, ref core.ResourceRef, srv *v1static.SrvDiscovery) ([]*v1.Endpoint, error) {
+	ctx, cancel := context.WithTimeout(ctx, resolveTimeout)
+	defer cancel()
+
+	_, records, err := p.dnsResolver().LookupSRV(ctx, "", "", srv.Name)
+	if err != nil {
+		return nil, errors.Wrapf(err, "resolving srv records %v", srv.Name)
+	}
+	var endpoints []*v1.Endpoint
+	for _, record := range records {
+		addrs, err := p.dnsResolver().LookupIPAddr(ctx, record.Target)
+		if err != nil {
+			return nil, errors.Wrapf(err, "resolving target %v of srv records %v", record.Target, srv.Name)
+		}
+		for _, addr := range addrs {
+			// the same address family as the clusters of the static upstreams with hostnames
+			if addr.IP.To4() == nil {
+				continue
+			}
+			copyRef := ref
+			endpoints = append(endpoints, &v1.Endpoint{
+				Metadata: core.Metadata{
+					Namespace: writeNamespace,
+					Name:      EndpointName(ref.Name, addr.IP.String(), uint32(record.Port)),
+				},
+				Upstreams: []*core.ResourceRef{&copyRef},
+				Address:   addr.IP.String(),
+				Port:      uint32(record.Port),
+			})
+		}
+	}
+	return endpoints, nil
+}
+
+func aggregateEndpoints(resolved map[core.ResourceRef][]*v1.Endpoint) v1.EndpointList {
+	var endpoints v1.EndpointList
+	for _, upstreamEndpoints := range resolved {
+		endpoints = append(endpoints, upstreamEndpoints...)
+	}
+	// sort endpoints for idempotency
+	sort.Slice(endpoints, func(i, j int) bool { return endpoints[i].Metadata.Name < endpoints[j].Metadata.Name })
+	return endpoints
+}
+
+func endpointsEqual(list1, list2 v1.EndpointList) bool {
+	if len(list1) != len(list2) {
+		return false
+	}
+	for i := range list1 {
+		if !list1[i].Equal(list2[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EndpointName returns the name of the endpoint of an upstream for an address resolved from its srv records
+func EndpointName(upstreamName, address string, port uint32) string {
+	dnsname := func(s string) string {
+		return strings.Map(func(r rune) rune {
+			if '0' <= r && r <= '9' {
+				return r
+			}
+			if 'a' <= r && r <= 'z' {
+				return r
+			}
+			return '-'
+		}, strings.ToLower(s))
+	}
+	return fmt.Sprintf("srv-%v-%v-%v", dnsname(upstreamName), dnsname(address), port)
+}
+
+// the address of the first target of the srv records
+func (p *plugin) resolveSrv(srv *v1static.SrvDiscovery) (*url.URL, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), resolveTimeout)
+	defer cancel()
+	_, records, err := p.dnsResolver().LookupSRV(ctx, "", "", srv.Name)
+	if err != nil {
+		return nil, errors.Wrapf(err, "resolving srv records %v", srv.Name)
+	}
+	if len(records) == 0 {
+		return nil, errors.Errorf("no srv record %v", srv.Name)
+	}
+	target := strings.TrimSuffix(records[0].Target, ".")
+	return url.Parse(fmt.Sprintf("tcp://%v:%v", target, records[0].Port))
+}
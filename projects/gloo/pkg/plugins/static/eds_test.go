@@ -0,0 +1,116 @@
+package static
+
+import (
+	"context"
+	"net"
+	"sync"
+	"time"
+
+	envoyapi "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	v1static "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/static"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+type fakeResolver struct {
+	lock    sync.Mutex
+	records map[string][]*net.SRV
+	addrs   map[string][]net.IPAddr
+}
+
+func (r *fakeResolver) setRecords(name string, records []*net.SRV) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.records[name] = records
+}
+
+func (r *fakeResolver) LookupSRV(ctx context.Context, service, proto, name string) (string, []*net.SRV, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	records, ok := r.records[name]
+	if !ok {
+		return "", nil, &net.DNSError{Err: "no such host", Name: name}
+	}
+	return name, records, nil
+}
+
+func (r *fakeResolver) LookupIPAddr(ctx context.Context, host string) ([]net.IPAddr, error) {
+	return r.addrs[host], nil
+}
+
+var _ = Describe("SRV endpoints", func() {
+
+	var (
+		p        *plugin
+		resolver *fakeResolver
+		upstream *v1.Upstream
+	)
+
+	BeforeEach(func() {
+		resolver = &fakeResolver{
+			records: map[string][]*net.SRV{
+				"_http._tcp.example.com": {
+					{Target: "a.example.com.", Port: 8080},
+					{Target: "b.example.com.", Port: 8081},
+				},
+			},
+			addrs: map[string][]net.IPAddr{
+				"a.example.com.": {{IP: net.ParseIP("10.0.0.1")}, {IP: net.ParseIP("fd00::1")}},
+				"b.example.com.": {{IP: net.ParseIP("10.0.0.2")}},
+			},
+		}
+		p = &plugin{resolver: resolver}
+		upstream = &v1.Upstream{
+			Metadata: core.Metadata{Name: "example", Namespace: "gloo-system"},
+			UpstreamSpec: &v1.UpstreamSpec{
+				UpstreamType: &v1.UpstreamSpec_Static{
+					Static: &v1static.UpstreamSpec{
+						SrvDiscovery: &v1static.SrvDiscovery{Name: "_http._tcp.example.com"},
+					},
+				},
+			},
+		}
+	})
+
+	It("should use eds for the upstreams resolved from srv records", func() {
+		Expect(p.Init(plugins.InitParams{})).NotTo(HaveOccurred())
+		out := &envoyapi.Cluster{}
+		Expect(p.ProcessUpstream(plugins.Params{}, upstream, out)).NotTo(HaveOccurred())
+		Expect(out.GetType()).To(Equal(envoyapi.Cluster_EDS))
+		Expect(out.LoadAssignment).To(BeNil())
+	})
+
+	It("should publish the ipv4 addresses of the targets", func() {
+		endpoints, err := p.resolveEndpoints(context.TODO(), "gloo-system", upstream.Metadata.Ref(), upstream.UpstreamSpec.GetStatic().SrvDiscovery)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(endpoints).To(HaveLen(2))
+		Expect(endpoints[0].Metadata.Name).To(Equal("srv-example-10-0-0-1-8080"))
+		Expect(endpoints[0].Address).To(Equal("10.0.0.1"))
+		Expect(endpoints[0].Port).To(BeEquivalentTo(8080))
+		Expect(endpoints[1].Address).To(Equal("10.0.0.2"))
+		Expect(endpoints[1].Port).To(BeEquivalentTo(8081))
+	})
+
+	It("should follow the records", func() {
+		interval := 10 * time.Millisecond
+		upstream.UpstreamSpec.GetStatic().SrvDiscovery.RefreshInterval = &interval
+		ctx, cancel := context.WithCancel(context.TODO())
+		defer cancel()
+		endpoints, _, err := p.WatchEndpoints("gloo-system", v1.UpstreamList{upstream}, clients.WatchOpts{Ctx: ctx})
+		Expect(err).NotTo(HaveOccurred())
+		Eventually(endpoints).Should(Receive(HaveLen(2)))
+
+		resolver.setRecords("_http._tcp.example.com", []*net.SRV{{Target: "a.example.com.", Port: 8080}})
+		Eventually(endpoints).Should(Receive(HaveLen(1)))
+	})
+
+	It("should resolve the first target for function discovery", func() {
+		u, err := p.Resolve(upstream)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(u.String()).To(Equal("tcp://a.example.com:8080"))
+	})
+})
@@ -13,6 +13,7 @@ import (
 	envoyroute "github.com/envoyproxy/go-control-plane/envoy/api/v2/route"
 	"github.com/gogo/protobuf/types"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	v1static "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/static"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/pluginutils"
 	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
@@ -20,7 +21,12 @@ import (
 	"github.com/solo-io/solo-kit/pkg/errors"
 )
 
-type plugin struct{ hostRewriteUpstreams map[core.ResourceRef]bool }
+type plugin struct {
+	hostRewriteUpstreams map[core.ResourceRef]bool
+
+	// resolves the srv records of the upstreams, the default resolver if nil
+	resolver dnsResolver
+}
 
 func NewPlugin() plugins.Plugin {
 	return &plugin{}
@@ -31,6 +37,9 @@ func (p *plugin) Resolve(u *v1.Upstream) (*url.URL, error) {
 	if !ok {
 		return nil, nil
 	}
+	if srv := staticSpec.Static.SrvDiscovery; srv != nil {
+		return p.resolveSrv(srv)
+	}
 	if len(staticSpec.Static.Hosts) == 0 {
 		return nil, errors.Errorf("must provide at least 1 host in static spec")
 	}
@@ -51,6 +60,9 @@ func (p *plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *en
 	}
 
 	spec := staticSpec.Static
+	if spec.SrvDiscovery != nil {
+		return processSrvUpstream(spec, out)
+	}
 	var foundSslPort bool
 	var hostname string
 
@@ -142,6 +154,36 @@ func (p *plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *en
 	return nil
 }
 
+// the upstreams with a hostname are recorded to rewrite the host of the routes to them
+func (p *plugin) CacheableUpstream(in *v1.Upstream) bool {
+	staticSpec, ok := in.UpstreamSpec.UpstreamType.(*v1.UpstreamSpec_Static)
+	if !ok || staticSpec.Static.SrvDiscovery != nil {
+		return true
+	}
+	for _, host := range staticSpec.Static.Hosts {
+		if net.ParseIP(host.Addr) == nil {
+			return false
+		}
+	}
+	return true
+}
+
+// the hosts of the upstreams resolved from srv records are published as endpoints
+func processSrvUpstream(spec *v1static.UpstreamSpec, out *envoyapi.Cluster) error {
+	if spec.SrvDiscovery.Name == "" {
+		return errors.Errorf("the name of the srv records cannot be empty")
+	}
+	xds.SetEdsOnCluster(out)
+
+	if spec.UseTls && out.TlsContext == nil {
+		out.TlsContext = &envoyauth.UpstreamTlsContext{}
+	}
+	if spec.UseHttp2 && out.Http2ProtocolOptions == nil {
+		out.Http2ProtocolOptions = &envoycore.Http2ProtocolOptions{}
+	}
+	return nil
+}
+
 func (p *plugin) ProcessRouteAction(params plugins.Params, in *v1.RouteAction, _ map[string]*plugins.RoutePlugin, out *envoyroute.RouteAction) error {
 	upstreams, err := pluginutils.DestinationUpstreams(params.Snapshot, in)
 	if err != nil {
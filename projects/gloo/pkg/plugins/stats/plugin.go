@@ -0,0 +1,123 @@
+package stats
+
+import (
+	"fmt"
+	"regexp"
+
+	envoycore "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
+	envoyroute "github.com/envoyproxy/go-control-plane/envoy/api/v2/route"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
+)
+
+// the characters that can't be in the name of a stat
+var invalidStatChars = regexp.MustCompile(`[^a-zA-Z0-9_\-]`)
+
+type Plugin struct {
+	enabled bool
+}
+
+var _ plugins.VirtualHostPlugin = NewPlugin()
+
+// Emits the stats of each function with a virtual cluster per route to a function. Envoy records the latency
+// histograms and the response code counters of the requests matching a virtual cluster under its name.
+func NewPlugin() *Plugin {
+	return &Plugin{}
+}
+
+func (p *Plugin) Init(params plugins.InitParams) error {
+	p.enabled = params.Settings.GetFunctionStats()
+	return nil
+}
+
+func (p *Plugin) ProcessVirtualHost(params plugins.Params, in *v1.VirtualHost, out *envoyroute.VirtualHost) error {
+	if !p.enabled {
+		return nil
+	}
+	// envoy counts a request in the first virtual cluster it matches, the virtual clusters follow the order of the routes
+	for _, route := range in.Routes {
+		name, ok := functionName(route)
+		if !ok || route.Matcher == nil {
+			continue
+		}
+		pattern, ok := pathPattern(route.Matcher)
+		if !ok {
+			continue
+		}
+		out.VirtualClusters = append(out.VirtualClusters, &envoyroute.VirtualCluster{
+			Name:    name,
+			Pattern: pattern,
+			Method:  method(route.Matcher),
+		})
+	}
+	return nil
+}
+
+// the name of the function of the route, prefixed by its upstream as the functions of different upstreams may have the
+// same name. not ok if the route has no single function destination.
+func functionName(route *v1.Route) (string, bool) {
+	single := route.GetRouteAction().GetSingle()
+	if single == nil || single.GetUpstream() == nil {
+		return "", false
+	}
+	function := destinationFunction(single.DestinationSpec)
+	if function == "" {
+		return "", false
+	}
+	name := translator.UpstreamToClusterName(*single.GetUpstream()) + "_" + function
+	return invalidStatChars.ReplaceAllString(name, "_"), true
+}
+
+func destinationFunction(spec *v1.DestinationSpec) string {
+	switch dest := spec.GetDestinationType().(type) {
+	case *v1.DestinationSpec_Aws:
+		return dest.Aws.GetLogicalName()
+	case *v1.DestinationSpec_Azure:
+		return dest.Azure.GetFunctionName()
+	case *v1.DestinationSpec_Rest:
+		return dest.Rest.GetFunctionName()
+	case *v1.DestinationSpec_Grpc:
+		if dest.Grpc.GetFunction() == "" {
+			return ""
+		}
+		return fmt.Sprintf("%s_%s_%s", dest.Grpc.GetPackage(), dest.Grpc.GetService(), dest.Grpc.GetFunction())
+	case *v1.DestinationSpec_Openfaas:
+		return dest.Openfaas.GetFunctionName()
+	case *v1.DestinationSpec_Alibaba:
+		return dest.Alibaba.GetLogicalName()
+	case *v1.DestinationSpec_Openwhisk:
+		return dest.Openwhisk.GetLogicalName()
+	case *v1.DestinationSpec_External:
+		return dest.External.GetLogicalName()
+	case *v1.DestinationSpec_Thrift:
+		if dest.Thrift.GetMethod() == "" {
+			return ""
+		}
+		return fmt.Sprintf("%s_%s", dest.Thrift.GetService(), dest.Thrift.GetMethod())
+	}
+	return ""
+}
+
+// the pattern of a virtual cluster matches the whole path of the request, including its query string, while the
+// matchers of the routes ignore the query string
+func pathPattern(matcher *v1.Matcher) (string, bool) {
+	const query = `(\?.*)?`
+	switch path := matcher.PathSpecifier.(type) {
+	case *v1.Matcher_Prefix:
+		return regexp.QuoteMeta(path.Prefix) + ".*", true
+	case *v1.Matcher_Exact:
+		return regexp.QuoteMeta(path.Exact) + query, true
+	case *v1.Matcher_Regex:
+		return "(?:" + path.Regex + ")" + query, true
+	}
+	return "", false
+}
+
+// a virtual cluster matches a single method, or any method
+func method(matcher *v1.Matcher) envoycore.RequestMethod {
+	if len(matcher.Methods) != 1 {
+		return envoycore.METHOD_UNSPECIFIED
+	}
+	return envoycore.RequestMethod(envoycore.RequestMethod_value[matcher.Methods[0]])
+}
@@ -0,0 +1,89 @@
+package stats_test
+
+import (
+	envoycore "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
+	envoyroute "github.com/envoyproxy/go-control-plane/envoy/api/v2/route"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/aws"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/grpc"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	. "github.com/solo-io/gloo/projects/gloo/pkg/plugins/stats"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+func functionRoute(matcher *v1.Matcher, spec *v1.DestinationSpec) *v1.Route {
+	return &v1.Route{
+		Matcher: matcher,
+		Action: &v1.Route_RouteAction{
+			RouteAction: &v1.RouteAction{
+				Destination: &v1.RouteAction_Single{
+					Single: &v1.Destination{
+						DestinationType: &v1.Destination_Upstream{
+							Upstream: &core.ResourceRef{Name: "lambda", Namespace: "gloo-system"},
+						},
+						DestinationSpec: spec,
+					},
+				},
+			},
+		},
+	}
+}
+
+func awsFunction(name string) *v1.DestinationSpec {
+	return &v1.DestinationSpec{DestinationType: &v1.DestinationSpec_Aws{Aws: &aws.DestinationSpec{LogicalName: name}}}
+}
+
+var _ = Describe("Plugin", func() {
+	var (
+		plugin *Plugin
+		out    *envoyroute.VirtualHost
+	)
+
+	BeforeEach(func() {
+		plugin = NewPlugin()
+		err := plugin.Init(plugins.InitParams{Settings: &v1.Settings{FunctionStats: true}})
+		Expect(err).NotTo(HaveOccurred())
+		out = &envoyroute.VirtualHost{}
+	})
+
+	It("adds a virtual cluster per function route", func() {
+		in := &v1.VirtualHost{Routes: []*v1.Route{
+			functionRoute(&v1.Matcher{PathSpecifier: &v1.Matcher_Prefix{Prefix: "/hello.world"}, Methods: []string{"POST"}}, awsFunction("hello:1")),
+			functionRoute(&v1.Matcher{PathSpecifier: &v1.Matcher_Exact{Exact: "/greet"}}, &v1.DestinationSpec{
+				DestinationType: &v1.DestinationSpec_Grpc{Grpc: &grpc.DestinationSpec{Package: "greeter", Service: "Greeter", Function: "SayHello"}},
+			}),
+			functionRoute(&v1.Matcher{PathSpecifier: &v1.Matcher_Regex{Regex: "/users/[0-9]+"}, Methods: []string{"GET", "PUT"}}, awsFunction("users")),
+		}}
+		err := plugin.ProcessVirtualHost(plugins.Params{}, in, out)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out.VirtualClusters).To(Equal([]*envoyroute.VirtualCluster{
+			{Name: "lambda_gloo-system_hello_1", Pattern: `/hello\.world.*`, Method: envoycore.POST},
+			{Name: "lambda_gloo-system_greeter_Greeter_SayHello", Pattern: `/greet(\?.*)?`},
+			{Name: "lambda_gloo-system_users", Pattern: `(?:/users/[0-9]+)(\?.*)?`},
+		}))
+	})
+
+	It("ignores the routes without function", func() {
+		in := &v1.VirtualHost{Routes: []*v1.Route{
+			functionRoute(&v1.Matcher{PathSpecifier: &v1.Matcher_Prefix{Prefix: "/"}}, nil),
+			{Matcher: &v1.Matcher{PathSpecifier: &v1.Matcher_Prefix{Prefix: "/"}}, Action: &v1.Route_DirectResponseAction{}},
+		}}
+		err := plugin.ProcessVirtualHost(plugins.Params{}, in, out)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out.VirtualClusters).To(BeEmpty())
+	})
+
+	It("does nothing when function stats are disabled", func() {
+		plugin = NewPlugin()
+		err := plugin.Init(plugins.InitParams{Settings: &v1.Settings{}})
+		Expect(err).NotTo(HaveOccurred())
+		in := &v1.VirtualHost{Routes: []*v1.Route{
+			functionRoute(&v1.Matcher{PathSpecifier: &v1.Matcher_Prefix{Prefix: "/"}}, awsFunction("hello")),
+		}}
+		err = plugin.ProcessVirtualHost(plugins.Params{}, in, out)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out.VirtualClusters).To(BeEmpty())
+	})
+})
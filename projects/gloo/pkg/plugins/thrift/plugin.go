@@ -0,0 +1,187 @@
+package thrift
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	envoyapi "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	envoyroute "github.com/envoyproxy/go-control-plane/envoy/api/v2/route"
+	"github.com/gogo/protobuf/proto"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	glooplugins "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/thrift"
+	transformationapi "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/transformation"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/pluginutils"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/transformation"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/solo-io/solo-kit/pkg/errors"
+)
+
+const (
+	ContentType = "application/x-thrift"
+
+	// the message types of the thrift protocol
+	messageTypeCall   = 1
+	messageTypeOneway = 4
+)
+
+// the type ids of the JSON protocol, for the base types that are filled from the request. the transformation filter
+// writes the strings of the request as is: it can neither JSON-escape a string nor base64-encode a binary, so that
+// the string and binary arguments are not filled rather than letting the request alter the call.
+var jsonTypeIds = map[string]string{
+	"bool":   "tf",
+	"byte":   "i8",
+	"i8":     "i8",
+	"i16":    "i16",
+	"i32":    "i32",
+	"i64":    "i64",
+	"double": "dbl",
+}
+
+type plugin struct {
+	recordedUpstreams map[core.ResourceRef]*thrift.ServiceSpec
+	ctx               context.Context
+	transformsAdded   *bool
+}
+
+func NewPlugin(transformsAdded *bool) plugins.Plugin {
+	return &plugin{transformsAdded: transformsAdded}
+}
+
+func (p *plugin) Init(params plugins.InitParams) error {
+	p.ctx = params.Ctx
+	p.recordedUpstreams = make(map[core.ResourceRef]*thrift.ServiceSpec)
+	return nil
+}
+
+func (p *plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, _ *envoyapi.Cluster) error {
+	withServiceSpec, ok := in.UpstreamSpec.UpstreamType.(v1.ServiceSpecGetter)
+	if !ok {
+		return nil
+	}
+	serviceSpec := withServiceSpec.GetServiceSpec()
+	if serviceSpec == nil {
+		return nil
+	}
+	thriftServiceSpec, ok := serviceSpec.PluginType.(*glooplugins.ServiceSpec_Thrift)
+	if !ok {
+		// not ours
+		return nil
+	}
+	if thriftServiceSpec.Thrift == nil {
+		return errors.Errorf("%v has an empty thrift service spec", in.Metadata.Ref())
+	}
+	p.recordedUpstreams[in.Metadata.Ref()] = thriftServiceSpec.Thrift
+	return nil
+}
+
+// the thrift service specs of the upstreams are recorded to translate the routes to them
+func (p *plugin) CacheableUpstream(in *v1.Upstream) bool {
+	withServiceSpec, ok := in.UpstreamSpec.UpstreamType.(v1.ServiceSpecGetter)
+	if !ok || withServiceSpec.GetServiceSpec() == nil {
+		return true
+	}
+	_, ours := withServiceSpec.GetServiceSpec().PluginType.(*glooplugins.ServiceSpec_Thrift)
+	return !ours
+}
+
+func (p *plugin) ProcessRoute(params plugins.Params, in *v1.Route, out *envoyroute.Route) error {
+	return pluginutils.MarkPerFilterConfig(p.ctx, params.Snapshot, in, out, transformation.FilterName, func(spec *v1.Destination) (proto.Message, error) {
+		// check if it's a thrift destination
+		if spec.DestinationSpec == nil || spec.GetUpstream() == nil {
+			return nil, nil
+		}
+		thriftDestinationSpec, ok := spec.DestinationSpec.DestinationType.(*v1.DestinationSpec_Thrift)
+		if !ok {
+			return nil, nil
+		}
+
+		serviceSpec, ok := p.recordedUpstreams[*spec.GetUpstream()]
+		if !ok {
+			return nil, errors.Errorf("%v does not have a thrift service spec", *spec.GetUpstream())
+		}
+
+		method := FindMethod(serviceSpec, thriftDestinationSpec.Thrift.Service, thriftDestinationSpec.Thrift.Method)
+		if method == nil {
+			return nil, errors.Errorf("unknown method %v of service %v", thriftDestinationSpec.Thrift.Method, thriftDestinationSpec.Thrift.Service)
+		}
+
+		*p.transformsAdded = true
+
+		path := serviceSpec.Path
+		if path == "" {
+			path = "/"
+		}
+		ret := &transformationapi.RouteTransformations{
+			RequestTransformation: &transformationapi.Transformation{
+				TransformationType: &transformationapi.Transformation_TransformationTemplate{
+					TransformationTemplate: &transformationapi.TransformationTemplate{
+						Headers: map[string]*transformationapi.InjaTemplate{
+							":method":      {Text: "POST"},
+							":path":        {Text: path},
+							"content-type": {Text: ContentType},
+						},
+						BodyTransformation: &transformationapi.TransformationTemplate_Body{
+							Body: &transformationapi.InjaTemplate{
+								Text: CallTemplate(serviceSpec, thriftDestinationSpec.Thrift.Service, method),
+							},
+						},
+					},
+				},
+			},
+		}
+		return ret, nil
+	})
+}
+
+// FindMethod returns the method of a service of the spec, nil if not found
+func FindMethod(spec *thrift.ServiceSpec, service, method string) *thrift.ServiceSpec_Method {
+	for _, thriftService := range spec.Services {
+		if thriftService.Name != service {
+			continue
+		}
+		for _, thriftMethod := range thriftService.Methods {
+			if thriftMethod.Name == method {
+				return thriftMethod
+			}
+		}
+	}
+	return nil
+}
+
+// CallTemplate returns the template of the body of a call in the JSON protocol, e.g. `[1,"add",1,0,{"1":{"i32":...}}]`.
+// the arguments are read from the fields of the same name of the JSON body of the request.
+func CallTemplate(spec *thrift.ServiceSpec, service string, method *thrift.ServiceSpec_Method) string {
+	name := method.Name
+	if spec.Multiplexed {
+		name = service + ":" + name
+	}
+	messageType := messageTypeCall
+	if method.Oneway {
+		messageType = messageTypeOneway
+	}
+
+	var args bytes.Buffer
+	for _, arg := range method.Arguments {
+		typeId, ok := jsonTypeIds[arg.Type]
+		if !ok {
+			// strings, binaries, structs and containers cannot be built from the request, and are left unset
+			continue
+		}
+		if args.Len() > 0 {
+			args.WriteString(",")
+		}
+		fmt.Fprintf(&args, `"%v":{"%v":%v}`, arg.Id, typeId, argumentTemplate(arg))
+	}
+	return fmt.Sprintf(`[1,"%v",%v,0,{%v}]`, name, messageType, args.String())
+}
+
+// booleans are written as 1 or 0, numbers are inlined
+func argumentTemplate(arg *thrift.ServiceSpec_Field) string {
+	if arg.Type == "bool" {
+		return fmt.Sprintf(`{%% if default(%v, false) %%}1{%% else %%}0{%% endif %%}`, arg.Name)
+	}
+	return fmt.Sprintf(`{{ default(%v, 0) }}`, arg.Name)
+}
@@ -0,0 +1,170 @@
+package thrift_test
+
+import (
+	"context"
+
+	envoyapi "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	envoyroute "github.com/envoyproxy/go-control-plane/envoy/api/v2/route"
+	"github.com/envoyproxy/go-control-plane/pkg/util"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	glooplugins "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/static"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/thrift"
+	transformationapi "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/transformation"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	. "github.com/solo-io/gloo/projects/gloo/pkg/plugins/thrift"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/transformation"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+var _ = Describe("Plugin", func() {
+	var (
+		params          plugins.Params
+		plugin          plugins.Plugin
+		transformsAdded bool
+		serviceSpec     *thrift.ServiceSpec
+		upstream        *v1.Upstream
+		route           *v1.Route
+		destination     *v1.Destination
+		outroute        *envoyroute.Route
+	)
+
+	BeforeEach(func() {
+		transformsAdded = false
+		plugin = NewPlugin(&transformsAdded)
+		plugin.Init(plugins.InitParams{Ctx: context.TODO()})
+		params.Snapshot = &v1.ApiSnapshot{}
+
+		serviceSpec = &thrift.ServiceSpec{
+			Path: "/thrift",
+			Services: []*thrift.ServiceSpec_ThriftService{{
+				Name: "Calculator",
+				Methods: []*thrift.ServiceSpec_Method{
+					{
+						Name: "add",
+						Arguments: []*thrift.ServiceSpec_Field{
+							{Id: 1, Name: "num1", Type: "i32"},
+							{Id: 2, Name: "num2", Type: "i32"},
+						},
+					},
+					{
+						Name: "log",
+						Arguments: []*thrift.ServiceSpec_Field{
+							{Id: 1, Name: "message", Type: "string"},
+							{Id: 2, Name: "verbose", Type: "bool"},
+							{Id: 3, Name: "tags", Type: "list"},
+						},
+						Oneway: true,
+					},
+				},
+			}},
+		}
+		upstream = &v1.Upstream{
+			Metadata: core.Metadata{
+				Name:      "calculator",
+				Namespace: "default",
+			},
+			UpstreamSpec: &v1.UpstreamSpec{
+				UpstreamType: &v1.UpstreamSpec_Static{
+					Static: &static.UpstreamSpec{
+						Hosts: []*static.Host{{Addr: "calculator", Port: 9090}},
+						ServiceSpec: &glooplugins.ServiceSpec{
+							PluginType: &glooplugins.ServiceSpec_Thrift{
+								Thrift: serviceSpec,
+							},
+						},
+					},
+				},
+			},
+		}
+		ref := upstream.Metadata.Ref()
+		destination = &v1.Destination{
+			DestinationType: &v1.Destination_Upstream{
+				Upstream: &ref,
+			},
+			DestinationSpec: &v1.DestinationSpec{
+				DestinationType: &v1.DestinationSpec_Thrift{
+					Thrift: &thrift.DestinationSpec{
+						Service: "Calculator",
+						Method:  "add",
+					},
+				},
+			},
+		}
+		route = &v1.Route{
+			Action: &v1.Route_RouteAction{
+				RouteAction: &v1.RouteAction{
+					Destination: &v1.RouteAction_Single{
+						Single: destination,
+					},
+				},
+			},
+		}
+		outroute = &envoyroute.Route{
+			Action: &envoyroute.Route_Route{
+				Route: &envoyroute.RouteAction{
+					ClusterSpecifier: &envoyroute.RouteAction_Cluster{
+						Cluster: "calculator",
+					},
+				},
+			},
+		}
+
+		err := plugin.(plugins.UpstreamPlugin).ProcessUpstream(params, upstream, &envoyapi.Cluster{})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	processTemplate := func() *transformationapi.TransformationTemplate {
+		err := plugin.(plugins.RoutePlugin).ProcessRoute(params, route, outroute)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(transformsAdded).To(BeTrue())
+		Expect(outroute.PerFilterConfig).To(HaveKey(transformation.FilterName))
+
+		var transformations transformationapi.RouteTransformations
+		err = util.StructToMessage(outroute.PerFilterConfig[transformation.FilterName], &transformations)
+		Expect(err).NotTo(HaveOccurred())
+		return transformations.RequestTransformation.GetTransformationTemplate()
+	}
+
+	It("should post a call of the method in the json protocol", func() {
+		template := processTemplate()
+		Expect(template.Headers).To(HaveKeyWithValue(":method", &transformationapi.InjaTemplate{Text: "POST"}))
+		Expect(template.Headers).To(HaveKeyWithValue(":path", &transformationapi.InjaTemplate{Text: "/thrift"}))
+		Expect(template.Headers).To(HaveKeyWithValue("content-type", &transformationapi.InjaTemplate{Text: ContentType}))
+		Expect(template.GetBody().GetText()).To(Equal(`[1,"add",1,0,{"1":{"i32":{{ default(num1, 0) }}},"2":{"i32":{{ default(num2, 0) }}}}]`))
+	})
+
+	It("should fill the booleans and numbers and skip the others", func() {
+		destination.DestinationSpec.DestinationType.(*v1.DestinationSpec_Thrift).Thrift.Method = "log"
+
+		template := processTemplate()
+		Expect(template.GetBody().GetText()).To(Equal(`[1,"log",4,0,{"2":{"tf":{% if default(verbose, false) %}1{% else %}0{% endif %}}}]`))
+	})
+
+	It("should prefix the method of multiplexed services", func() {
+		serviceSpec.Multiplexed = true
+
+		template := processTemplate()
+		Expect(template.GetBody().GetText()).To(HavePrefix(`[1,"Calculator:add",1,0,`))
+	})
+
+	It("should error with an unknown method", func() {
+		destination.DestinationSpec.DestinationType.(*v1.DestinationSpec_Thrift).Thrift.Method = "subtract"
+
+		err := plugin.(plugins.RoutePlugin).ProcessRoute(params, route, outroute)
+		Expect(err).To(HaveOccurred())
+		Expect(outroute.PerFilterConfig).NotTo(HaveKey(transformation.FilterName))
+	})
+
+	It("should not process other destinations", func() {
+		destination.DestinationSpec = nil
+
+		err := plugin.(plugins.RoutePlugin).ProcessRoute(params, route, outroute)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(transformsAdded).To(BeFalse())
+		Expect(outroute.PerFilterConfig).NotTo(HaveKey(transformation.FilterName))
+	})
+})
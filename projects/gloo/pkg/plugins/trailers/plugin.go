@@ -0,0 +1,133 @@
+package trailers
+
+import (
+	"strings"
+
+	envoycore "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
+	envoyroute "github.com/envoyproxy/go-control-plane/envoy/api/v2/route"
+	envoyutil "github.com/envoyproxy/go-control-plane/pkg/util"
+	types "github.com/gogo/protobuf/types"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/lua"
+	"github.com/solo-io/solo-kit/pkg/errors"
+)
+
+const (
+	// the envoy shipped with gloo has no filter changing the trailers, the lua filter can
+	FilterName = envoyutil.Lua
+	// as close as possible to the router, to remove the trailers of the upstreams before the other filters see them
+	pluginStage = plugins.OutAuth
+
+	// the route metadata read by the lua code, which the lua filter can only read from its own namespace
+	MetadataKey = "gloo.response_trailers"
+	stripKey    = "strip"
+	removeKey   = "remove"
+)
+
+// the lua filter has no per route config in the envoy shipped with gloo, the code reads what to remove from the metadata
+// of the route. the body is streamed, not buffered, until the trailers arrive.
+const luaCode = `function envoy_on_response(response_handle)
+  local config = response_handle:metadata():get("` + MetadataKey + `")
+  if config == nil then
+    return
+  end
+  for _ in response_handle:bodyChunks() do
+  end
+  local trailers = response_handle:trailers()
+  if trailers == nil then
+    return
+  end
+  local removed = {}
+  if config["` + stripKey + `"] then
+    for name, _ in pairs(trailers) do
+      table.insert(removed, name)
+    end
+  elseif config["` + removeKey + `"] ~= nil then
+    removed = config["` + removeKey + `"]
+  end
+  for _, name in ipairs(removed) do
+    trailers:remove(name)
+  end
+end
+`
+
+type Plugin struct{}
+
+var _ plugins.RoutePlugin = NewPlugin()
+var _ plugins.HttpFilterPlugin = NewPlugin()
+
+func NewPlugin() *Plugin {
+	return &Plugin{}
+}
+
+func (p *Plugin) Init(params plugins.InitParams) error {
+	return nil
+}
+
+func (p *Plugin) ProcessRoute(params plugins.Params, in *v1.Route, out *envoyroute.Route) error {
+	cfg := in.GetRoutePlugins().GetResponseTrailers()
+	if cfg == nil {
+		return nil
+	}
+	if _, ok := out.Action.(*envoyroute.Route_Route); !ok {
+		return errors.Errorf("response trailers are only available for Route Actions")
+	}
+	if in.GetRoutePlugins().GetLua() != nil {
+		// the lua config of the route would apply to the lua filter removing the trailers too
+		return errors.Errorf("the routes removing response trailers cannot override the lua code of their listener")
+	}
+
+	removed := &types.ListValue{}
+	for _, trailer := range cfg.Remove {
+		if trailer == "" {
+			return errors.Errorf("the name of a removed trailer is required")
+		}
+		removed.Values = append(removed.Values, &types.Value{
+			Kind: &types.Value_StringValue{StringValue: strings.ToLower(trailer)},
+		})
+	}
+	if out.Metadata == nil {
+		out.Metadata = &envoycore.Metadata{}
+	}
+	if out.Metadata.FilterMetadata == nil {
+		out.Metadata.FilterMetadata = make(map[string]*types.Struct)
+	}
+	luaMetadata := out.Metadata.FilterMetadata[FilterName]
+	if luaMetadata == nil {
+		luaMetadata = &types.Struct{Fields: make(map[string]*types.Value)}
+		out.Metadata.FilterMetadata[FilterName] = luaMetadata
+	}
+	luaMetadata.Fields[MetadataKey] = &types.Value{
+		Kind: &types.Value_StructValue{StructValue: &types.Struct{
+			Fields: map[string]*types.Value{
+				stripKey:  {Kind: &types.Value_BoolValue{BoolValue: cfg.Strip}},
+				removeKey: {Kind: &types.Value_ListValue{ListValue: removed}},
+			},
+		}},
+	}
+	return nil
+}
+
+func (p *Plugin) HttpFilters(params plugins.Params, listener *v1.HttpListener) ([]plugins.StagedHttpFilter, error) {
+	if !removesTrailers(listener) {
+		// no trailers removed no filter
+		return nil, nil
+	}
+	stagedFilter, err := plugins.NewStagedFilterWithConfig(FilterName, &lua.Lua{InlineCode: luaCode}, pluginStage)
+	if err != nil {
+		return nil, err
+	}
+	return []plugins.StagedHttpFilter{stagedFilter}, nil
+}
+
+func removesTrailers(listener *v1.HttpListener) bool {
+	for _, vhost := range listener.VirtualHosts {
+		for _, route := range vhost.Routes {
+			if route.GetRoutePlugins().GetResponseTrailers() != nil {
+				return true
+			}
+		}
+	}
+	return false
+}
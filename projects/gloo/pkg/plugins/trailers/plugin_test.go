@@ -0,0 +1,127 @@
+package trailers_test
+
+import (
+	envoycore "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
+	envoyroute "github.com/envoyproxy/go-control-plane/envoy/api/v2/route"
+	envoyutil "github.com/envoyproxy/go-control-plane/pkg/util"
+	types "github.com/gogo/protobuf/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	luaapi "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/lua"
+	trailersapi "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/trailers"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/lua"
+	. "github.com/solo-io/gloo/projects/gloo/pkg/plugins/trailers"
+)
+
+var _ = Describe("Plugin", func() {
+	var (
+		plugin *Plugin
+		in     *v1.Route
+		out    *envoyroute.Route
+	)
+
+	BeforeEach(func() {
+		plugin = NewPlugin()
+		Expect(plugin.Init(plugins.InitParams{})).NotTo(HaveOccurred())
+		in = &v1.Route{
+			RoutePlugins: &v1.RoutePlugins{
+				ResponseTrailers: &trailersapi.ResponseTrailers{},
+			},
+		}
+		out = &envoyroute.Route{
+			Action: &envoyroute.Route_Route{Route: &envoyroute.RouteAction{}},
+		}
+	})
+
+	trailersMetadata := func() *types.Struct {
+		luaMetadata := out.GetMetadata().GetFilterMetadata()[envoyutil.Lua]
+		Expect(luaMetadata).NotTo(BeNil())
+		Expect(luaMetadata.Fields).To(HaveKey(MetadataKey))
+		return luaMetadata.Fields[MetadataKey].GetStructValue()
+	}
+
+	Context("routes", func() {
+		It("leaves the routes without trailer controls unchanged", func() {
+			in.RoutePlugins.ResponseTrailers = nil
+			Expect(plugin.ProcessRoute(plugins.Params{}, in, out)).NotTo(HaveOccurred())
+			Expect(out.Metadata).To(BeNil())
+		})
+
+		It("marks the routes stripping all the trailers", func() {
+			in.RoutePlugins.ResponseTrailers.Strip = true
+			Expect(plugin.ProcessRoute(plugins.Params{}, in, out)).NotTo(HaveOccurred())
+			Expect(trailersMetadata().Fields["strip"].GetBoolValue()).To(BeTrue())
+		})
+
+		It("lists the removed trailers in lower case", func() {
+			in.RoutePlugins.ResponseTrailers.Remove = []string{"Grpc-Status-Details-Bin", "grpc-message"}
+			Expect(plugin.ProcessRoute(plugins.Params{}, in, out)).NotTo(HaveOccurred())
+			cfg := trailersMetadata()
+			Expect(cfg.Fields["strip"].GetBoolValue()).To(BeFalse())
+			var removed []string
+			for _, value := range cfg.Fields["remove"].GetListValue().GetValues() {
+				removed = append(removed, value.GetStringValue())
+			}
+			Expect(removed).To(Equal([]string{"grpc-status-details-bin", "grpc-message"}))
+		})
+
+		It("keeps the other metadata of the lua filter", func() {
+			out.Metadata = &envoycore.Metadata{FilterMetadata: map[string]*types.Struct{
+				envoyutil.Lua: {Fields: map[string]*types.Value{
+					"team": {Kind: &types.Value_StringValue{StringValue: "payments"}},
+				}},
+			}}
+			Expect(plugin.ProcessRoute(plugins.Params{}, in, out)).NotTo(HaveOccurred())
+			Expect(out.Metadata.FilterMetadata[envoyutil.Lua].Fields).To(HaveKey("team"))
+			Expect(out.Metadata.FilterMetadata[envoyutil.Lua].Fields).To(HaveKey(MetadataKey))
+		})
+
+		It("rejects empty trailer names", func() {
+			in.RoutePlugins.ResponseTrailers.Remove = []string{""}
+			Expect(plugin.ProcessRoute(plugins.Params{}, in, out)).To(HaveOccurred())
+		})
+
+		It("rejects the routes that are not route actions", func() {
+			out.Action = &envoyroute.Route_Redirect{Redirect: &envoyroute.RedirectAction{}}
+			Expect(plugin.ProcessRoute(plugins.Params{}, in, out)).To(HaveOccurred())
+		})
+
+		It("rejects the routes overriding the lua code of their listener", func() {
+			in.RoutePlugins.Lua = &luaapi.RouteLua{Override: &luaapi.RouteLua_Disabled{Disabled: true}}
+			Expect(plugin.ProcessRoute(plugins.Params{}, in, out)).To(HaveOccurred())
+		})
+	})
+
+	Context("listeners", func() {
+		var listener *v1.HttpListener
+
+		BeforeEach(func() {
+			listener = &v1.HttpListener{
+				VirtualHosts: []*v1.VirtualHost{{
+					Routes: []*v1.Route{{}, in},
+				}},
+			}
+		})
+
+		It("adds no filter to the listeners without trailer controls", func() {
+			in.RoutePlugins = nil
+			filters, err := plugin.HttpFilters(plugins.Params{}, listener)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filters).To(BeEmpty())
+		})
+
+		It("adds the lua filter removing the trailers next to the router", func() {
+			filters, err := plugin.HttpFilters(plugins.Params{}, listener)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filters).To(HaveLen(1))
+			Expect(filters[0].HttpFilter.Name).To(Equal(envoyutil.Lua))
+			Expect(filters[0].Stage).To(Equal(plugins.OutAuth))
+			var config lua.Lua
+			Expect(envoyutil.StructToMessage(filters[0].HttpFilter.GetConfig(), &config)).NotTo(HaveOccurred())
+			Expect(config.InlineCode).To(ContainSubstring(MetadataKey))
+			Expect(config.InlineCode).To(ContainSubstring("trailers:remove(name)"))
+		})
+	})
+})
@@ -0,0 +1,13 @@
+package trailers_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestTrailers(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Trailers Suite")
+}
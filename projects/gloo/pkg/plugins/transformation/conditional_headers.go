@@ -0,0 +1,222 @@
+package transformation
+
+import (
+	"fmt"
+	"strings"
+
+	envoyroute "github.com/envoyproxy/go-control-plane/envoy/api/v2/route"
+	"github.com/envoyproxy/go-control-plane/pkg/util"
+	"github.com/gogo/protobuf/types"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	envoy_transform "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/transformation"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/solo-kit/pkg/errors"
+)
+
+const (
+	etagHeader        = "etag"
+	ifNoneMatchHeader = "if-none-match"
+
+	// entities tags after this many entries of a list are passed through as they are
+	maxNormalizedEtags = 8
+	// an entity tag of a list, with the weak validator prefix out of the subgroup
+	etagEntryRegex = `(?:W/)?("[^"]*")`
+	// the entries of a list before the one extracted
+	etagPrecedingEntriesRegex = `\s*(?:(?:W/)?"[^"]*"\s*,\s*){%d}`
+)
+
+var (
+	conditionalRequestHeaders = []string{
+		"if-match",
+		ifNoneMatchHeader,
+		"if-modified-since",
+		"if-unmodified-since",
+	}
+	conditionalResponseHeaders = []string{
+		etagHeader,
+		"last-modified",
+	}
+
+	// extractor names are used as template variables, so they can't contain dashes
+	normalizedHeaderExtractors = map[string]string{
+		etagHeader:        "normalized_etag",
+		ifNoneMatchHeader: "normalized_if_none_match",
+	}
+)
+
+type ConditionalHeadersPlugin struct{}
+
+var _ plugins.RoutePlugin = NewConditionalHeadersPlugin()
+
+// Adjusts the transformations set on a route by other plugins according to the ConditionalHeaders
+// route plugin. It needs to be registered after all plugins that set transformations.
+func NewConditionalHeadersPlugin() *ConditionalHeadersPlugin {
+	return &ConditionalHeadersPlugin{}
+}
+
+func (p *ConditionalHeadersPlugin) Init(params plugins.InitParams) error {
+	return nil
+}
+
+func (p *ConditionalHeadersPlugin) ProcessRoute(params plugins.Params, in *v1.Route, out *envoyroute.Route) error {
+	conditionalHeaders := in.GetRoutePlugins().GetConditionalHeaders()
+	if conditionalHeaders == nil {
+		return nil
+	}
+
+	if out.PerFilterConfig == nil {
+		out.PerFilterConfig = make(map[string]*types.Struct)
+	}
+	// normalization needs a transformation on the route, even if no other plugin set one
+	if err := applyConditionalHeaders(conditionalHeaders, out.PerFilterConfig, conditionalHeaders.NormalizeEtags); err != nil {
+		return err
+	}
+
+	// weighted clusters may override the transformation of the route
+	routeAction, ok := out.Action.(*envoyroute.Route_Route)
+	if !ok || routeAction.Route == nil {
+		return nil
+	}
+	weightedClusters, ok := routeAction.Route.ClusterSpecifier.(*envoyroute.RouteAction_WeightedClusters)
+	if !ok || weightedClusters.WeightedClusters == nil {
+		return nil
+	}
+	for _, cluster := range weightedClusters.WeightedClusters.Clusters {
+		if err := applyConditionalHeaders(conditionalHeaders, cluster.PerFilterConfig, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func applyConditionalHeaders(conditionalHeaders *envoy_transform.ConditionalHeaders, perFilterConfig map[string]*types.Struct, create bool) error {
+	transformations := &envoy_transform.RouteTransformations{}
+	configStruct, ok := perFilterConfig[FilterName]
+	if ok {
+		if err := util.StructToMessage(configStruct, transformations); err != nil {
+			return errors.Wrapf(err, "converting transformation config from struct")
+		}
+	} else if !create {
+		return nil
+	}
+
+	if conditionalHeaders.ForwardRequestHeaders {
+		removeTemplateHeaders(transformations.RequestTransformation, conditionalRequestHeaders)
+	}
+	if conditionalHeaders.ForwardResponseHeaders {
+		removeTemplateHeaders(transformations.ResponseTransformation, conditionalResponseHeaders)
+	}
+	if conditionalHeaders.NormalizeEtags {
+		transformations.RequestTransformation = normalizeEtagHeader(transformations.RequestTransformation, ifNoneMatchHeader)
+		transformations.ResponseTransformation = normalizeEtagHeader(transformations.ResponseTransformation, etagHeader)
+	}
+
+	configStruct, err := util.MessageToStruct(transformations)
+	if err != nil {
+		return errors.Wrapf(err, "converting transformation config to struct")
+	}
+	perFilterConfig[FilterName] = configStruct
+	return nil
+}
+
+// headers that are not set by the template are passed through by the transformation filter
+func removeTemplateHeaders(transformation *envoy_transform.Transformation, headers []string) {
+	template := transformation.GetTransformationTemplate()
+	if template == nil {
+		return
+	}
+	for name := range template.Headers {
+		for _, header := range headers {
+			if strings.EqualFold(name, header) {
+				delete(template.Headers, name)
+			}
+		}
+	}
+}
+
+// replaces the header with the list of its entity tags without their weak validator prefixes. The header is passed
+// through as it is when it is not a list of entity tags, e.g. `*`, and absent headers render an empty value, which
+// the transformation filter does not set.
+func normalizeEtagHeader(transformation *envoy_transform.Transformation, header string) *envoy_transform.Transformation {
+	if transformation == nil {
+		transformation = &envoy_transform.Transformation{
+			TransformationType: &envoy_transform.Transformation_TransformationTemplate{
+				TransformationTemplate: &envoy_transform.TransformationTemplate{
+					BodyTransformation: &envoy_transform.TransformationTemplate_Passthrough{
+						Passthrough: &envoy_transform.Passthrough{},
+					},
+				},
+			},
+		}
+	}
+	template := transformation.GetTransformationTemplate()
+	if template == nil {
+		// header body transforms can't set individual headers
+		return transformation
+	}
+
+	if template.Extractors == nil {
+		template.Extractors = make(map[string]*envoy_transform.Extraction)
+	}
+	for name, extraction := range etagExtractors(header) {
+		template.Extractors[name] = extraction
+	}
+	if template.Headers == nil {
+		template.Headers = make(map[string]*envoy_transform.InjaTemplate)
+	}
+	template.Headers[header] = &envoy_transform.InjaTemplate{
+		Text: normalizedEtagTemplate(header),
+	}
+	return transformation
+}
+
+func etagEntryExtractor(header string, index int) string {
+	return fmt.Sprintf("%v_%d", normalizedHeaderExtractors[header], index)
+}
+
+func etagRestExtractor(header string) string {
+	return normalizedHeaderExtractors[header] + "_rest"
+}
+
+func etagOriginalExtractor(header string) string {
+	return normalizedHeaderExtractors[header] + "_original"
+}
+
+// extracts each entry of the list of entity tags of the header, the entries after the last one extracted, and the
+// original value. the extraction of an entry fails, with an empty value, when the list has fewer entries.
+func etagExtractors(header string) map[string]*envoy_transform.Extraction {
+	extractors := map[string]*envoy_transform.Extraction{
+		etagRestExtractor(header): {
+			Header:   header,
+			Regex:    fmt.Sprintf(etagPrecedingEntriesRegex, maxNormalizedEtags) + `(.+)`,
+			Subgroup: 1,
+		},
+		etagOriginalExtractor(header): {
+			Header:   header,
+			Regex:    `(.*)`,
+			Subgroup: 1,
+		},
+	}
+	for i := 0; i < maxNormalizedEtags; i++ {
+		extractors[etagEntryExtractor(header, i)] = &envoy_transform.Extraction{
+			Header:   header,
+			Regex:    fmt.Sprintf(etagPrecedingEntriesRegex, i) + etagEntryRegex + `.*`,
+			Subgroup: 1,
+		}
+	}
+	return extractors
+}
+
+func normalizedEtagTemplate(header string) string {
+	var text strings.Builder
+	first := etagEntryExtractor(header, 0)
+	fmt.Fprintf(&text, `{%% if %v != "" %%}{{ %v }}`, first, first)
+	for i := 1; i < maxNormalizedEtags; i++ {
+		entry := etagEntryExtractor(header, i)
+		fmt.Fprintf(&text, `{%% if %v != "" %%}, {{ %v }}{%% endif %%}`, entry, entry)
+	}
+	rest := etagRestExtractor(header)
+	fmt.Fprintf(&text, `{%% if %v != "" %%}, {{ %v }}{%% endif %%}`, rest, rest)
+	fmt.Fprintf(&text, `{%% else %%}{{ %v }}{%% endif %%}`, etagOriginalExtractor(header))
+	return text.String()
+}
@@ -0,0 +1,193 @@
+package transformation
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	envoyroute "github.com/envoyproxy/go-control-plane/envoy/api/v2/route"
+	"github.com/envoyproxy/go-control-plane/pkg/util"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	envoy_transform "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/transformation"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/pluginutils"
+)
+
+var _ = Describe("ConditionalHeadersPlugin", func() {
+	var (
+		params   plugins.Params
+		plugin   *ConditionalHeadersPlugin
+		route    *v1.Route
+		outroute *envoyroute.Route
+	)
+
+	template := func(headers map[string]string) *envoy_transform.Transformation {
+		templates := make(map[string]*envoy_transform.InjaTemplate)
+		for name, text := range headers {
+			templates[name] = &envoy_transform.InjaTemplate{Text: text}
+		}
+		return &envoy_transform.Transformation{
+			TransformationType: &envoy_transform.Transformation_TransformationTemplate{
+				TransformationTemplate: &envoy_transform.TransformationTemplate{
+					Headers: templates,
+				},
+			},
+		}
+	}
+
+	outTransformations := func() *envoy_transform.RouteTransformations {
+		Expect(outroute.PerFilterConfig).To(HaveKey(FilterName))
+		transformations := &envoy_transform.RouteTransformations{}
+		err := util.StructToMessage(outroute.PerFilterConfig[FilterName], transformations)
+		Expect(err).NotTo(HaveOccurred())
+		return transformations
+	}
+
+	BeforeEach(func() {
+		plugin = NewConditionalHeadersPlugin()
+		plugin.Init(plugins.InitParams{})
+		route = &v1.Route{
+			RoutePlugins: &v1.RoutePlugins{
+				ConditionalHeaders: &envoy_transform.ConditionalHeaders{},
+			},
+		}
+		outroute = &envoyroute.Route{}
+		err := pluginutils.SetRoutePerFilterConfig(outroute, FilterName, &envoy_transform.RouteTransformations{
+			RequestTransformation: template(map[string]string{
+				"If-None-Match": "{{ foo }}",
+				"x-foo":         "foo",
+			}),
+			ResponseTransformation: template(map[string]string{
+				"etag":         "{{ bar }}",
+				"content-type": "text/html",
+			}),
+		})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("should not change the route without conditional headers", func() {
+		route.RoutePlugins.ConditionalHeaders = nil
+		expected := outroute.PerFilterConfig[FilterName]
+		err := plugin.ProcessRoute(params, route, outroute)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(outroute.PerFilterConfig[FilterName]).To(BeIdenticalTo(expected))
+	})
+
+	It("should forward conditional request headers", func() {
+		route.RoutePlugins.ConditionalHeaders.ForwardRequestHeaders = true
+		err := plugin.ProcessRoute(params, route, outroute)
+		Expect(err).NotTo(HaveOccurred())
+
+		transformations := outTransformations()
+		Expect(transformations.RequestTransformation.GetTransformationTemplate().Headers).To(HaveLen(1))
+		Expect(transformations.RequestTransformation.GetTransformationTemplate().Headers).To(HaveKey("x-foo"))
+		Expect(transformations.ResponseTransformation.GetTransformationTemplate().Headers).To(HaveKey("etag"))
+	})
+
+	It("should forward validator response headers", func() {
+		route.RoutePlugins.ConditionalHeaders.ForwardResponseHeaders = true
+		err := plugin.ProcessRoute(params, route, outroute)
+		Expect(err).NotTo(HaveOccurred())
+
+		transformations := outTransformations()
+		Expect(transformations.ResponseTransformation.GetTransformationTemplate().Headers).To(HaveLen(1))
+		Expect(transformations.ResponseTransformation.GetTransformationTemplate().Headers).To(HaveKey("content-type"))
+		Expect(transformations.RequestTransformation.GetTransformationTemplate().Headers).To(HaveKey("If-None-Match"))
+	})
+
+	It("should normalize etags when the route has no transformations", func() {
+		route.RoutePlugins.ConditionalHeaders.NormalizeEtags = true
+		outroute.PerFilterConfig = nil
+		err := plugin.ProcessRoute(params, route, outroute)
+		Expect(err).NotTo(HaveOccurred())
+
+		transformations := outTransformations()
+		requestTemplate := transformations.RequestTransformation.GetTransformationTemplate()
+		Expect(requestTemplate.GetPassthrough()).NotTo(BeNil())
+		Expect(requestTemplate.Extractors).To(Equal(etagExtractors("if-none-match")))
+		Expect(requestTemplate.Headers).To(HaveKeyWithValue("if-none-match", &envoy_transform.InjaTemplate{Text: normalizedEtagTemplate("if-none-match")}))
+
+		responseTemplate := transformations.ResponseTransformation.GetTransformationTemplate()
+		Expect(responseTemplate.GetPassthrough()).NotTo(BeNil())
+		Expect(responseTemplate.Extractors).To(HaveKey("normalized_etag_0"))
+		Expect(responseTemplate.Headers).To(HaveKeyWithValue("etag", &envoy_transform.InjaTemplate{Text: normalizedEtagTemplate("etag")}))
+	})
+
+	Context("etag normalization", func() {
+
+		// the transformation filter matches the whole header, and extracts an empty value when it does not match
+		extract := func(header, value string) map[string]string {
+			extracted := make(map[string]string)
+			for name, extraction := range etagExtractors(header) {
+				Expect(extraction.Header).To(Equal(header))
+				match := regexp.MustCompile(`^(?:` + extraction.Regex + `)$`).FindStringSubmatch(value)
+				if match != nil {
+					extracted[name] = match[extraction.Subgroup]
+				}
+			}
+			return extracted
+		}
+
+		It("extracts every entity tag of a list without its weak validator prefix", func() {
+			extracted := extract("if-none-match", `W/"a", "b",W/"c"`)
+			Expect(extracted).To(HaveKeyWithValue("normalized_if_none_match_0", `"a"`))
+			Expect(extracted).To(HaveKeyWithValue("normalized_if_none_match_1", `"b"`))
+			Expect(extracted).To(HaveKeyWithValue("normalized_if_none_match_2", `"c"`))
+			Expect(extracted).NotTo(HaveKey("normalized_if_none_match_3"))
+			Expect(extracted).NotTo(HaveKey("normalized_if_none_match_rest"))
+		})
+
+		It("passes through the entity tags after the last one extracted", func() {
+			var etags []string
+			for i := 0; i <= maxNormalizedEtags; i++ {
+				etags = append(etags, `W/"tag"`)
+			}
+			extracted := extract("if-none-match", strings.Join(etags, ", "))
+			Expect(extracted).To(HaveKeyWithValue(fmt.Sprintf("normalized_if_none_match_%d", maxNormalizedEtags-1), `"tag"`))
+			Expect(extracted).To(HaveKeyWithValue("normalized_if_none_match_rest", `W/"tag"`))
+		})
+
+		It("only extracts the original value of headers that are not entity tags", func() {
+			extracted := extract("if-none-match", `*`)
+			Expect(extracted).To(Equal(map[string]string{
+				"normalized_if_none_match_original": "*",
+			}))
+		})
+
+		It("renders the original value unless the first entity tag is extracted", func() {
+			Expect(normalizedEtagTemplate("etag")).To(HavePrefix(`{% if normalized_etag_0 != "" %}{{ normalized_etag_0 }}{% if normalized_etag_1 != "" %}, {{ normalized_etag_1 }}{% endif %}`))
+			Expect(normalizedEtagTemplate("etag")).To(HaveSuffix(`{% if normalized_etag_rest != "" %}, {{ normalized_etag_rest }}{% endif %}{% else %}{{ normalized_etag_original }}{% endif %}`))
+		})
+	})
+
+	It("should apply to transformations of weighted clusters", func() {
+		route.RoutePlugins.ConditionalHeaders.ForwardResponseHeaders = true
+		cluster := &envoyroute.WeightedCluster_ClusterWeight{Name: "cluster"}
+		err := pluginutils.SetWeightedClusterPerFilterConfig(cluster, FilterName, &envoy_transform.RouteTransformations{
+			ResponseTransformation: template(map[string]string{
+				"last-modified": "{{ bar }}",
+			}),
+		})
+		Expect(err).NotTo(HaveOccurred())
+		outroute.Action = &envoyroute.Route_Route{
+			Route: &envoyroute.RouteAction{
+				ClusterSpecifier: &envoyroute.RouteAction_WeightedClusters{
+					WeightedClusters: &envoyroute.WeightedCluster{
+						Clusters: []*envoyroute.WeightedCluster_ClusterWeight{cluster},
+					},
+				},
+			},
+		}
+
+		err = plugin.ProcessRoute(params, route, outroute)
+		Expect(err).NotTo(HaveOccurred())
+
+		transformations := &envoy_transform.RouteTransformations{}
+		err = util.StructToMessage(cluster.PerFilterConfig[FilterName], transformations)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(transformations.ResponseTransformation.GetTransformationTemplate().Headers).To(BeEmpty())
+	})
+})
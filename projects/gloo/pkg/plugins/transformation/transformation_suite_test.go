@@ -0,0 +1,13 @@
+package transformation
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestTransformation(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Transformation Suite")
+}
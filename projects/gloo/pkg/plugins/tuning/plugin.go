@@ -0,0 +1,67 @@
+package tuning
+
+import (
+	envoyapi "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	envoycore "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/tuning"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+)
+
+func NewPlugin() *Plugin {
+	return &Plugin{}
+}
+
+var _ plugins.Plugin = new(Plugin)
+var _ plugins.ListenerPlugin = new(Plugin)
+
+type Plugin struct {
+}
+
+func (p *Plugin) Init(params plugins.InitParams) error {
+	return nil
+}
+
+func (p *Plugin) ProcessListener(params plugins.Params, in *v1.Listener, out *envoyapi.Listener) error {
+	cfg := in.GetHttpListener().GetListenerPlugins().GetListenerTuning()
+	if cfg == nil {
+		return nil
+	}
+
+	if cfg.PerConnectionBufferLimitBytes != nil {
+		out.PerConnectionBufferLimitBytes = cfg.PerConnectionBufferLimitBytes
+	}
+	if cfg.TcpFastOpenQueueLength != nil {
+		out.TcpFastOpenQueueLength = cfg.TcpFastOpenQueueLength
+	}
+	for _, opt := range cfg.SocketOptions {
+		out.SocketOptions = append(out.SocketOptions, convertSocketOption(opt))
+	}
+	return nil
+}
+
+func convertSocketOption(opt *tuning.SocketOption) *envoycore.SocketOption {
+	out := &envoycore.SocketOption{
+		Description: opt.Description,
+		Level:       opt.Level,
+		Name:        opt.Name,
+		State:       convertSocketState(opt.State),
+	}
+	switch value := opt.Value.(type) {
+	case *tuning.SocketOption_IntValue:
+		out.Value = &envoycore.SocketOption_IntValue{IntValue: value.IntValue}
+	case *tuning.SocketOption_BufValue:
+		out.Value = &envoycore.SocketOption_BufValue{BufValue: value.BufValue}
+	}
+	return out
+}
+
+func convertSocketState(state tuning.SocketOption_SocketState) envoycore.SocketOption_SocketState {
+	switch state {
+	case tuning.SocketOption_BOUND:
+		return envoycore.STATE_BOUND
+	case tuning.SocketOption_LISTENING:
+		return envoycore.STATE_LISTENING
+	}
+	return envoycore.STATE_PREBIND
+}
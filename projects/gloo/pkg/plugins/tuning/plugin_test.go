@@ -0,0 +1,66 @@
+package tuning_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/solo-io/gloo/projects/gloo/pkg/plugins/tuning"
+
+	envoyapi "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	envoycore "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
+	"github.com/gogo/protobuf/types"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/tuning"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+)
+
+var _ = Describe("Plugin", func() {
+	listener := func(cfg *tuning.ListenerTuning) *v1.Listener {
+		return &v1.Listener{
+			ListenerType: &v1.Listener_HttpListener{
+				HttpListener: &v1.HttpListener{
+					ListenerPlugins: &v1.ListenerPlugins{
+						ListenerTuning: cfg,
+					},
+				},
+			},
+		}
+	}
+
+	It("copies the tuning to the listener", func() {
+		in := listener(&tuning.ListenerTuning{
+			PerConnectionBufferLimitBytes: &types.UInt32Value{Value: 32768},
+			TcpFastOpenQueueLength:        &types.UInt32Value{Value: 100},
+			SocketOptions: []*tuning.SocketOption{
+				{
+					Description: "SO_KEEPALIVE",
+					Level:       1,
+					Name:        9,
+					Value:       &tuning.SocketOption_IntValue{IntValue: 1},
+					State:       tuning.SocketOption_LISTENING,
+				},
+			},
+		})
+		out := &envoyapi.Listener{}
+		err := NewPlugin().ProcessListener(plugins.Params{}, in, out)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out.PerConnectionBufferLimitBytes).To(Equal(&types.UInt32Value{Value: 32768}))
+		Expect(out.TcpFastOpenQueueLength).To(Equal(&types.UInt32Value{Value: 100}))
+		Expect(out.SocketOptions).To(Equal([]*envoycore.SocketOption{
+			{
+				Description: "SO_KEEPALIVE",
+				Level:       1,
+				Name:        9,
+				Value:       &envoycore.SocketOption_IntValue{IntValue: 1},
+				State:       envoycore.STATE_LISTENING,
+			},
+		}))
+	})
+
+	It("leaves the listener untouched without tuning", func() {
+		out := &envoyapi.Listener{}
+		err := NewPlugin().ProcessListener(plugins.Params{}, listener(nil), out)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out).To(Equal(&envoyapi.Listener{}))
+	})
+})
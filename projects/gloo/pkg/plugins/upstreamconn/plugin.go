@@ -10,6 +10,13 @@ import (
 	types "github.com/gogo/protobuf/types"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/solo-kit/pkg/errors"
+)
+
+const (
+	minConcurrentStreams = 1
+	minWindowSize        = 65535
+	maxHttp2Value        = 2147483647
 )
 
 type Plugin struct{}
@@ -45,6 +52,45 @@ func (p *Plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *en
 		}
 	}
 
+	if cfg.IdleTimeout != nil {
+		if *cfg.IdleTimeout <= 0 {
+			return errors.Errorf("invalid idle timeout for upstream %v: must be positive, got %v", in.Metadata.Ref(), *cfg.IdleTimeout)
+		}
+		out.CommonHttpProtocolOptions = &envoycore.HttpProtocolOptions{
+			IdleTimeout: cfg.IdleTimeout,
+		}
+	}
+
+	if cfg.Http2Settings != nil {
+		if err := validateHttp2Settings(cfg.Http2Settings); err != nil {
+			return errors.Wrapf(err, "invalid http2 settings for upstream %v", in.Metadata.Ref())
+		}
+		out.Http2ProtocolOptions = &envoycore.Http2ProtocolOptions{
+			MaxConcurrentStreams:        cfg.Http2Settings.MaxConcurrentStreams,
+			InitialStreamWindowSize:     cfg.Http2Settings.InitialStreamWindowSize,
+			InitialConnectionWindowSize: cfg.Http2Settings.InitialConnectionWindowSize,
+		}
+	}
+
+	return nil
+}
+
+// the cluster only depends on the connection config of the upstream
+func (p *Plugin) CacheableUpstream(in *v1.Upstream) bool {
+	return true
+}
+
+// the ranges allowed by envoy, which rejects the whole cluster otherwise
+func validateHttp2Settings(settings *v1.ConnectionConfig_Http2Settings) error {
+	if streams := settings.MaxConcurrentStreams; streams != nil && (streams.Value < minConcurrentStreams || streams.Value > maxHttp2Value) {
+		return errors.Errorf("max concurrent streams must be between %d and %d, got %d", minConcurrentStreams, maxHttp2Value, streams.Value)
+	}
+	if window := settings.InitialStreamWindowSize; window != nil && (window.Value < minWindowSize || window.Value > maxHttp2Value) {
+		return errors.Errorf("initial stream window size must be between %d and %d, got %d", minWindowSize, maxHttp2Value, window.Value)
+	}
+	if window := settings.InitialConnectionWindowSize; window != nil && (window.Value < minWindowSize || window.Value > maxHttp2Value) {
+		return errors.Errorf("initial connection window size must be between %d and %d, got %d", minWindowSize, maxHttp2Value, window.Value)
+	}
 	return nil
 }
 
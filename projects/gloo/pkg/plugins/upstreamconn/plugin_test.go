@@ -83,4 +83,58 @@ var _ = Describe("Plugin", func() {
 
 		Expect(*outKeepAlive).To(Equal(expectedValue))
 	})
+
+	It("should set idle timeout", func() {
+		idleTimeout := 5 * time.Minute
+		upstreamSpec.ConnectionConfig = &v1.ConnectionConfig{
+			IdleTimeout: &idleTimeout,
+		}
+		err := plugin.ProcessUpstream(params, upstream, out)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out.CommonHttpProtocolOptions.GetIdleTimeout()).To(Equal(&idleTimeout))
+	})
+
+	It("should reject a non positive idle timeout", func() {
+		var idleTimeout time.Duration
+		upstreamSpec.ConnectionConfig = &v1.ConnectionConfig{
+			IdleTimeout: &idleTimeout,
+		}
+		err := plugin.ProcessUpstream(params, upstream, out)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should set http2 settings", func() {
+		upstreamSpec.ConnectionConfig = &v1.ConnectionConfig{
+			Http2Settings: &v1.ConnectionConfig_Http2Settings{
+				MaxConcurrentStreams:        &types.UInt32Value{Value: 100},
+				InitialStreamWindowSize:     &types.UInt32Value{Value: 1048576},
+				InitialConnectionWindowSize: &types.UInt32Value{Value: 4194304},
+			},
+		}
+
+		err := plugin.ProcessUpstream(params, upstream, out)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out.Http2ProtocolOptions).To(Equal(&envoycore.Http2ProtocolOptions{
+			MaxConcurrentStreams:        &types.UInt32Value{Value: 100},
+			InitialStreamWindowSize:     &types.UInt32Value{Value: 1048576},
+			InitialConnectionWindowSize: &types.UInt32Value{Value: 4194304},
+		}))
+	})
+
+	It("should reject http2 settings out of range", func() {
+		upstreamSpec.ConnectionConfig = &v1.ConnectionConfig{
+			Http2Settings: &v1.ConnectionConfig_Http2Settings{
+				InitialStreamWindowSize: &types.UInt32Value{Value: 1024},
+			},
+		}
+
+		err := plugin.ProcessUpstream(params, upstream, out)
+		Expect(err).To(HaveOccurred())
+
+		upstreamSpec.ConnectionConfig.Http2Settings = &v1.ConnectionConfig_Http2Settings{
+			MaxConcurrentStreams: &types.UInt32Value{Value: 0},
+		}
+		err = plugin.ProcessUpstream(params, upstream, out)
+		Expect(err).To(HaveOccurred())
+	})
 })
@@ -7,13 +7,17 @@ import (
 	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
 )
 
-type Plugin struct{}
+type Plugin struct {
+	// reference the certificates of the secrets by sds rather than inlining them
+	sds bool
+}
 
 func NewPlugin() *Plugin {
 	return &Plugin{}
 }
 
 func (p *Plugin) Init(params plugins.InitParams) error {
+	p.sds = params.Settings.GetSdsSecrets()
 	return nil
 }
 
@@ -24,7 +28,11 @@ func (p *Plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *en
 		return nil
 	}
 
-	cfg, err := utils.NewSslConfigTranslator(params.Snapshot.Secrets).ResolveUpstreamSslConfig(sslConfig)
+	sslCfgTranslator := utils.NewSslConfigTranslator(params.Snapshot.Secrets)
+	if p.sds {
+		sslCfgTranslator = utils.NewSdsSslConfigTranslator(params.Snapshot.Secrets)
+	}
+	cfg, err := sslCfgTranslator.ResolveUpstreamSslConfig(sslConfig)
 	if err != nil {
 		return err
 	}
@@ -32,3 +40,8 @@ func (p *Plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *en
 
 	return nil
 }
+
+// the cluster only depends on the ssl config of the upstream and its secrets
+func (p *Plugin) CacheableUpstream(in *v1.Upstream) bool {
+	return true
+}
@@ -0,0 +1,755 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: filter.proto
+
+package wasm
+
+import (
+	fmt "fmt"
+	math "math"
+
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	proto "github.com/gogo/protobuf/proto"
+	types "github.com/gogo/protobuf/types"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+type Wasm struct {
+	// General Plugin configuration.
+	Config               *PluginConfig `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *Wasm) Reset()         { *m = Wasm{} }
+func (m *Wasm) String() string { return proto.CompactTextString(m) }
+func (*Wasm) ProtoMessage()    {}
+func (*Wasm) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f5303cab7a20d6f, []int{0}
+}
+func (m *Wasm) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Wasm.Unmarshal(m, b)
+}
+func (m *Wasm) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Wasm.Marshal(b, m, deterministic)
+}
+func (m *Wasm) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Wasm.Merge(m, src)
+}
+func (m *Wasm) XXX_Size() int {
+	return xxx_messageInfo_Wasm.Size(m)
+}
+func (m *Wasm) XXX_DiscardUnknown() {
+	xxx_messageInfo_Wasm.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Wasm proto.InternalMessageInfo
+
+func (m *Wasm) GetConfig() *PluginConfig {
+	if m != nil {
+		return m.Config
+	}
+	return nil
+}
+
+// Base Configuration for Wasm Plugins e.g. filters and services.
+type PluginConfig struct {
+	// A unique name for a filters/services in a VM for use in identifiying the filter/service if
+	// multiple filters/services are handled by the same vm_id and root_id and for logging/debugging.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// A unique ID for a set of filters/services in a VM which will share a RootContext and Contexts
+	// if applicable (e.g. an Wasm HttpFilter and an Wasm AccessLog). If left blank, all
+	// filters/services with a blank root_id with the same vm_id will share Context(s).
+	RootId string `protobuf:"bytes,2,opt,name=root_id,json=rootId,proto3" json:"root_id,omitempty"`
+	// Configuration for finding or starting VM.
+	VmConfig *VmConfig `protobuf:"bytes,3,opt,name=vm_config,json=vmConfig,proto3" json:"vm_config,omitempty"`
+	// Filter/service configuration string e.g. a serialized protobuf which will be the
+	// argument to the proxy_on_configure() call.
+	Configuration        string   `protobuf:"bytes,4,opt,name=configuration,proto3" json:"configuration,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PluginConfig) Reset()         { *m = PluginConfig{} }
+func (m *PluginConfig) String() string { return proto.CompactTextString(m) }
+func (*PluginConfig) ProtoMessage()    {}
+func (*PluginConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f5303cab7a20d6f, []int{1}
+}
+func (m *PluginConfig) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PluginConfig.Unmarshal(m, b)
+}
+func (m *PluginConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PluginConfig.Marshal(b, m, deterministic)
+}
+func (m *PluginConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PluginConfig.Merge(m, src)
+}
+func (m *PluginConfig) XXX_Size() int {
+	return xxx_messageInfo_PluginConfig.Size(m)
+}
+func (m *PluginConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_PluginConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PluginConfig proto.InternalMessageInfo
+
+func (m *PluginConfig) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *PluginConfig) GetRootId() string {
+	if m != nil {
+		return m.RootId
+	}
+	return ""
+}
+
+func (m *PluginConfig) GetVmConfig() *VmConfig {
+	if m != nil {
+		return m.VmConfig
+	}
+	return nil
+}
+
+func (m *PluginConfig) GetConfiguration() string {
+	if m != nil {
+		return m.Configuration
+	}
+	return ""
+}
+
+// Configuration for a Wasm VM.
+type VmConfig struct {
+	// An ID which will be used along with a hash of the wasm code (or null_vm_id) to determine which
+	// VM will be used for a given plugin. All plugins which use the same vm_id and code will use the
+	// same VM. May be left blank.
+	VmId string `protobuf:"bytes,1,opt,name=vm_id,json=vmId,proto3" json:"vm_id,omitempty"`
+	// The Wasm runtime type (either "v8" or "null" for code compiled into Envoy).
+	Runtime string `protobuf:"bytes,2,opt,name=runtime,proto3" json:"runtime,omitempty"`
+	// The Wasm code that Envoy will execute.
+	Code *AsyncDataSource `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	// The Wasm configuration string used on initialization of a new VM (proxy_onStart).
+	Configuration string `protobuf:"bytes,4,opt,name=configuration,proto3" json:"configuration,omitempty"`
+	// Allow the wasm file to include pre-compiled code on VMs which support it.
+	AllowPrecompiled     bool     `protobuf:"varint,5,opt,name=allow_precompiled,json=allowPrecompiled,proto3" json:"allow_precompiled,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VmConfig) Reset()         { *m = VmConfig{} }
+func (m *VmConfig) String() string { return proto.CompactTextString(m) }
+func (*VmConfig) ProtoMessage()    {}
+func (*VmConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f5303cab7a20d6f, []int{2}
+}
+func (m *VmConfig) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VmConfig.Unmarshal(m, b)
+}
+func (m *VmConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VmConfig.Marshal(b, m, deterministic)
+}
+func (m *VmConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VmConfig.Merge(m, src)
+}
+func (m *VmConfig) XXX_Size() int {
+	return xxx_messageInfo_VmConfig.Size(m)
+}
+func (m *VmConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_VmConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VmConfig proto.InternalMessageInfo
+
+func (m *VmConfig) GetVmId() string {
+	if m != nil {
+		return m.VmId
+	}
+	return ""
+}
+
+func (m *VmConfig) GetRuntime() string {
+	if m != nil {
+		return m.Runtime
+	}
+	return ""
+}
+
+func (m *VmConfig) GetCode() *AsyncDataSource {
+	if m != nil {
+		return m.Code
+	}
+	return nil
+}
+
+func (m *VmConfig) GetConfiguration() string {
+	if m != nil {
+		return m.Configuration
+	}
+	return ""
+}
+
+func (m *VmConfig) GetAllowPrecompiled() bool {
+	if m != nil {
+		return m.AllowPrecompiled
+	}
+	return false
+}
+
+// Async data source which support async data fetch.
+type AsyncDataSource struct {
+	// Types that are valid to be assigned to Specifier:
+	//	*AsyncDataSource_Local
+	//	*AsyncDataSource_Remote
+	Specifier            isAsyncDataSource_Specifier `protobuf_oneof:"specifier"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
+}
+
+func (m *AsyncDataSource) Reset()         { *m = AsyncDataSource{} }
+func (m *AsyncDataSource) String() string { return proto.CompactTextString(m) }
+func (*AsyncDataSource) ProtoMessage()    {}
+func (*AsyncDataSource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f5303cab7a20d6f, []int{3}
+}
+func (m *AsyncDataSource) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AsyncDataSource.Unmarshal(m, b)
+}
+func (m *AsyncDataSource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AsyncDataSource.Marshal(b, m, deterministic)
+}
+func (m *AsyncDataSource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AsyncDataSource.Merge(m, src)
+}
+func (m *AsyncDataSource) XXX_Size() int {
+	return xxx_messageInfo_AsyncDataSource.Size(m)
+}
+func (m *AsyncDataSource) XXX_DiscardUnknown() {
+	xxx_messageInfo_AsyncDataSource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AsyncDataSource proto.InternalMessageInfo
+
+type isAsyncDataSource_Specifier interface {
+	isAsyncDataSource_Specifier()
+}
+
+type AsyncDataSource_Local struct {
+	Local *DataSource `protobuf:"bytes,1,opt,name=local,proto3,oneof"`
+}
+type AsyncDataSource_Remote struct {
+	Remote *RemoteDataSource `protobuf:"bytes,2,opt,name=remote,proto3,oneof"`
+}
+
+func (*AsyncDataSource_Local) isAsyncDataSource_Specifier()  {}
+func (*AsyncDataSource_Remote) isAsyncDataSource_Specifier() {}
+
+func (m *AsyncDataSource) GetSpecifier() isAsyncDataSource_Specifier {
+	if m != nil {
+		return m.Specifier
+	}
+	return nil
+}
+
+func (m *AsyncDataSource) GetLocal() *DataSource {
+	if x, ok := m.GetSpecifier().(*AsyncDataSource_Local); ok {
+		return x.Local
+	}
+	return nil
+}
+
+func (m *AsyncDataSource) GetRemote() *RemoteDataSource {
+	if x, ok := m.GetSpecifier().(*AsyncDataSource_Remote); ok {
+		return x.Remote
+	}
+	return nil
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*AsyncDataSource) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _AsyncDataSource_OneofMarshaler, _AsyncDataSource_OneofUnmarshaler, _AsyncDataSource_OneofSizer, []interface{}{
+		(*AsyncDataSource_Local)(nil),
+		(*AsyncDataSource_Remote)(nil),
+	}
+}
+
+func _AsyncDataSource_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*AsyncDataSource)
+	// specifier
+	switch x := m.Specifier.(type) {
+	case *AsyncDataSource_Local:
+		_ = b.EncodeVarint(1<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Local); err != nil {
+			return err
+		}
+	case *AsyncDataSource_Remote:
+		_ = b.EncodeVarint(2<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Remote); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("AsyncDataSource.Specifier has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _AsyncDataSource_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*AsyncDataSource)
+	switch tag {
+	case 1: // specifier.local
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(DataSource)
+		err := b.DecodeMessage(msg)
+		m.Specifier = &AsyncDataSource_Local{msg}
+		return true, err
+	case 2: // specifier.remote
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(RemoteDataSource)
+		err := b.DecodeMessage(msg)
+		m.Specifier = &AsyncDataSource_Remote{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _AsyncDataSource_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*AsyncDataSource)
+	// specifier
+	switch x := m.Specifier.(type) {
+	case *AsyncDataSource_Local:
+		s := proto.Size(x.Local)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *AsyncDataSource_Remote:
+		s := proto.Size(x.Remote)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+// Data source consisting of either a file or an inline value.
+type DataSource struct {
+	// Types that are valid to be assigned to Specifier:
+	//	*DataSource_Filename
+	//	*DataSource_InlineBytes
+	//	*DataSource_InlineString
+	Specifier            isDataSource_Specifier `protobuf_oneof:"specifier"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *DataSource) Reset()         { *m = DataSource{} }
+func (m *DataSource) String() string { return proto.CompactTextString(m) }
+func (*DataSource) ProtoMessage()    {}
+func (*DataSource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f5303cab7a20d6f, []int{4}
+}
+func (m *DataSource) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DataSource.Unmarshal(m, b)
+}
+func (m *DataSource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DataSource.Marshal(b, m, deterministic)
+}
+func (m *DataSource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DataSource.Merge(m, src)
+}
+func (m *DataSource) XXX_Size() int {
+	return xxx_messageInfo_DataSource.Size(m)
+}
+func (m *DataSource) XXX_DiscardUnknown() {
+	xxx_messageInfo_DataSource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DataSource proto.InternalMessageInfo
+
+type isDataSource_Specifier interface {
+	isDataSource_Specifier()
+}
+
+type DataSource_Filename struct {
+	Filename string `protobuf:"bytes,1,opt,name=filename,proto3,oneof"`
+}
+type DataSource_InlineBytes struct {
+	InlineBytes []byte `protobuf:"bytes,2,opt,name=inline_bytes,json=inlineBytes,proto3,oneof"`
+}
+type DataSource_InlineString struct {
+	InlineString string `protobuf:"bytes,3,opt,name=inline_string,json=inlineString,proto3,oneof"`
+}
+
+func (*DataSource_Filename) isDataSource_Specifier()     {}
+func (*DataSource_InlineBytes) isDataSource_Specifier()  {}
+func (*DataSource_InlineString) isDataSource_Specifier() {}
+
+func (m *DataSource) GetSpecifier() isDataSource_Specifier {
+	if m != nil {
+		return m.Specifier
+	}
+	return nil
+}
+
+func (m *DataSource) GetFilename() string {
+	if x, ok := m.GetSpecifier().(*DataSource_Filename); ok {
+		return x.Filename
+	}
+	return ""
+}
+
+func (m *DataSource) GetInlineBytes() []byte {
+	if x, ok := m.GetSpecifier().(*DataSource_InlineBytes); ok {
+		return x.InlineBytes
+	}
+	return nil
+}
+
+func (m *DataSource) GetInlineString() string {
+	if x, ok := m.GetSpecifier().(*DataSource_InlineString); ok {
+		return x.InlineString
+	}
+	return ""
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*DataSource) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _DataSource_OneofMarshaler, _DataSource_OneofUnmarshaler, _DataSource_OneofSizer, []interface{}{
+		(*DataSource_Filename)(nil),
+		(*DataSource_InlineBytes)(nil),
+		(*DataSource_InlineString)(nil),
+	}
+}
+
+func _DataSource_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*DataSource)
+	// specifier
+	switch x := m.Specifier.(type) {
+	case *DataSource_Filename:
+		_ = b.EncodeVarint(1<<3 | proto.WireBytes)
+		_ = b.EncodeStringBytes(x.Filename)
+	case *DataSource_InlineBytes:
+		_ = b.EncodeVarint(2<<3 | proto.WireBytes)
+		_ = b.EncodeRawBytes(x.InlineBytes)
+	case *DataSource_InlineString:
+		_ = b.EncodeVarint(3<<3 | proto.WireBytes)
+		_ = b.EncodeStringBytes(x.InlineString)
+	case nil:
+	default:
+		return fmt.Errorf("DataSource.Specifier has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _DataSource_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*DataSource)
+	switch tag {
+	case 1: // specifier.filename
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeStringBytes()
+		m.Specifier = &DataSource_Filename{x}
+		return true, err
+	case 2: // specifier.inline_bytes
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeRawBytes(true)
+		m.Specifier = &DataSource_InlineBytes{x}
+		return true, err
+	case 3: // specifier.inline_string
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeStringBytes()
+		m.Specifier = &DataSource_InlineString{x}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _DataSource_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*DataSource)
+	// specifier
+	switch x := m.Specifier.(type) {
+	case *DataSource_Filename:
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(len(x.Filename)))
+		n += len(x.Filename)
+	case *DataSource_InlineBytes:
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(len(x.InlineBytes)))
+		n += len(x.InlineBytes)
+	case *DataSource_InlineString:
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(len(x.InlineString)))
+		n += len(x.InlineString)
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+// The message specifies how to fetch data from remote and how to verify it.
+type RemoteDataSource struct {
+	// The HTTP URI to fetch the remote data.
+	HttpUri *HttpUri `protobuf:"bytes,1,opt,name=http_uri,json=httpUri,proto3" json:"http_uri,omitempty"`
+	// SHA256 string for verifying data.
+	Sha256               string   `protobuf:"bytes,2,opt,name=sha256,proto3" json:"sha256,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RemoteDataSource) Reset()         { *m = RemoteDataSource{} }
+func (m *RemoteDataSource) String() string { return proto.CompactTextString(m) }
+func (*RemoteDataSource) ProtoMessage()    {}
+func (*RemoteDataSource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f5303cab7a20d6f, []int{5}
+}
+func (m *RemoteDataSource) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemoteDataSource.Unmarshal(m, b)
+}
+func (m *RemoteDataSource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemoteDataSource.Marshal(b, m, deterministic)
+}
+func (m *RemoteDataSource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoteDataSource.Merge(m, src)
+}
+func (m *RemoteDataSource) XXX_Size() int {
+	return xxx_messageInfo_RemoteDataSource.Size(m)
+}
+func (m *RemoteDataSource) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoteDataSource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoteDataSource proto.InternalMessageInfo
+
+func (m *RemoteDataSource) GetHttpUri() *HttpUri {
+	if m != nil {
+		return m.HttpUri
+	}
+	return nil
+}
+
+func (m *RemoteDataSource) GetSha256() string {
+	if m != nil {
+		return m.Sha256
+	}
+	return ""
+}
+
+// Envoy external URI descriptor
+type HttpUri struct {
+	// The HTTP server URI.
+	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	// Specify how `uri` is to be fetched.
+	//
+	// Types that are valid to be assigned to HttpUpstreamType:
+	//	*HttpUri_Cluster
+	HttpUpstreamType isHttpUri_HttpUpstreamType `protobuf_oneof:"http_upstream_type"`
+	// Sets the maximum duration in milliseconds that a response can take to arrive upon request.
+	Timeout              *types.Duration `protobuf:"bytes,3,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *HttpUri) Reset()         { *m = HttpUri{} }
+func (m *HttpUri) String() string { return proto.CompactTextString(m) }
+func (*HttpUri) ProtoMessage()    {}
+func (*HttpUri) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f5303cab7a20d6f, []int{6}
+}
+func (m *HttpUri) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HttpUri.Unmarshal(m, b)
+}
+func (m *HttpUri) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HttpUri.Marshal(b, m, deterministic)
+}
+func (m *HttpUri) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HttpUri.Merge(m, src)
+}
+func (m *HttpUri) XXX_Size() int {
+	return xxx_messageInfo_HttpUri.Size(m)
+}
+func (m *HttpUri) XXX_DiscardUnknown() {
+	xxx_messageInfo_HttpUri.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HttpUri proto.InternalMessageInfo
+
+type isHttpUri_HttpUpstreamType interface {
+	isHttpUri_HttpUpstreamType()
+}
+
+type HttpUri_Cluster struct {
+	Cluster string `protobuf:"bytes,2,opt,name=cluster,proto3,oneof"`
+}
+
+func (*HttpUri_Cluster) isHttpUri_HttpUpstreamType() {}
+
+func (m *HttpUri) GetHttpUpstreamType() isHttpUri_HttpUpstreamType {
+	if m != nil {
+		return m.HttpUpstreamType
+	}
+	return nil
+}
+
+func (m *HttpUri) GetUri() string {
+	if m != nil {
+		return m.Uri
+	}
+	return ""
+}
+
+func (m *HttpUri) GetCluster() string {
+	if x, ok := m.GetHttpUpstreamType().(*HttpUri_Cluster); ok {
+		return x.Cluster
+	}
+	return ""
+}
+
+func (m *HttpUri) GetTimeout() *types.Duration {
+	if m != nil {
+		return m.Timeout
+	}
+	return nil
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*HttpUri) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _HttpUri_OneofMarshaler, _HttpUri_OneofUnmarshaler, _HttpUri_OneofSizer, []interface{}{
+		(*HttpUri_Cluster)(nil),
+	}
+}
+
+func _HttpUri_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*HttpUri)
+	// http_upstream_type
+	switch x := m.HttpUpstreamType.(type) {
+	case *HttpUri_Cluster:
+		_ = b.EncodeVarint(2<<3 | proto.WireBytes)
+		_ = b.EncodeStringBytes(x.Cluster)
+	case nil:
+	default:
+		return fmt.Errorf("HttpUri.HttpUpstreamType has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _HttpUri_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*HttpUri)
+	switch tag {
+	case 2: // http_upstream_type.cluster
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeStringBytes()
+		m.HttpUpstreamType = &HttpUri_Cluster{x}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _HttpUri_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*HttpUri)
+	// http_upstream_type
+	switch x := m.HttpUpstreamType.(type) {
+	case *HttpUri_Cluster:
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(len(x.Cluster)))
+		n += len(x.Cluster)
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+func init() {
+	proto.RegisterType((*Wasm)(nil), "envoy.config.filter.http.wasm.v2.Wasm")
+	proto.RegisterType((*PluginConfig)(nil), "envoy.config.filter.http.wasm.v2.PluginConfig")
+	proto.RegisterType((*VmConfig)(nil), "envoy.config.filter.http.wasm.v2.VmConfig")
+	proto.RegisterType((*AsyncDataSource)(nil), "envoy.config.filter.http.wasm.v2.AsyncDataSource")
+	proto.RegisterType((*DataSource)(nil), "envoy.config.filter.http.wasm.v2.DataSource")
+	proto.RegisterType((*RemoteDataSource)(nil), "envoy.config.filter.http.wasm.v2.RemoteDataSource")
+	proto.RegisterType((*HttpUri)(nil), "envoy.config.filter.http.wasm.v2.HttpUri")
+}
+
+func init() { proto.RegisterFile("filter.proto", fileDescriptor_1f5303cab7a20d6f) }
+
+var fileDescriptor_1f5303cab7a20d6f = []byte{
+	// 659 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xcd, 0x6e, 0xd3, 0x4c,
+	0x14, 0xcd, 0xa4, 0x49, 0x9c, 0xdc, 0xa6, 0xfa, 0xd2, 0xf9, 0x84, 0x1a, 0x8a, 0x40, 0x21, 0x80,
+	0x28, 0x3f, 0xb5, 0x21, 0x88, 0x8a, 0x15, 0x52, 0x4d, 0x81, 0x54, 0x42, 0xa8, 0x72, 0x05, 0x48,
+	0x6c, 0x22, 0xc7, 0x9e, 0x38, 0x03, 0x63, 0x8f, 0x19, 0x8f, 0x5d, 0x85, 0x17, 0x40, 0xe2, 0x51,
+	0xfa, 0x08, 0x5d, 0xb1, 0x42, 0x3c, 0x00, 0x6b, 0xf6, 0xbc, 0x05, 0xf2, 0x78, 0xdc, 0xdf, 0x85,
+	0x61, 0x37, 0xbe, 0xe7, 0xdc, 0x33, 0xc7, 0x67, 0xee, 0x0c, 0x74, 0x67, 0x94, 0x49, 0x22, 0xcc,
+	0x58, 0x70, 0xc9, 0xf1, 0x80, 0x44, 0x19, 0x5f, 0x98, 0x1e, 0x8f, 0x66, 0x34, 0x30, 0x35, 0x34,
+	0x97, 0x32, 0x36, 0x0f, 0xdc, 0x24, 0x34, 0xb3, 0xd1, 0xfa, 0xb5, 0x80, 0xf3, 0x80, 0x11, 0x4b,
+	0xf1, 0xa7, 0xe9, 0xcc, 0xf2, 0x53, 0xe1, 0x4a, 0xca, 0xa3, 0x42, 0x61, 0x7d, 0x2d, 0x73, 0x19,
+	0xf5, 0x5d, 0x49, 0xac, 0x72, 0x51, 0x00, 0xc3, 0xd7, 0xd0, 0x78, 0xe7, 0x26, 0x21, 0x7e, 0x01,
+	0xad, 0x42, 0xbe, 0x8f, 0x06, 0x68, 0x63, 0x79, 0x64, 0x9a, 0x55, 0x7b, 0x9a, 0x7b, 0x2c, 0x0d,
+	0x68, 0xf4, 0x4c, 0x11, 0x1c, 0xdd, 0x3d, 0x3c, 0x44, 0xd0, 0x3d, 0x0d, 0x60, 0x0c, 0x8d, 0xc8,
+	0x0d, 0x89, 0x92, 0xed, 0x38, 0x6a, 0x8d, 0xd7, 0xc0, 0x10, 0x9c, 0xcb, 0x09, 0xf5, 0xfb, 0x75,
+	0x55, 0x6e, 0xe5, 0x9f, 0xbb, 0x3e, 0x7e, 0x09, 0x9d, 0x2c, 0x9c, 0x68, 0x23, 0x4b, 0xca, 0xc8,
+	0xdd, 0x6a, 0x23, 0x6f, 0x43, 0x6d, 0xa2, 0x9d, 0xe9, 0x15, 0xbe, 0x09, 0x2b, 0x45, 0x83, 0x8e,
+	0xa1, 0xdf, 0x50, 0xfb, 0x9c, 0x2d, 0x0e, 0x7f, 0x21, 0x68, 0x97, 0xcd, 0xf8, 0x7f, 0x68, 0x66,
+	0x61, 0x6e, 0x49, 0x3b, 0xcd, 0xc2, 0x5d, 0x1f, 0xdf, 0x00, 0x43, 0xa4, 0x91, 0xa4, 0x21, 0x29,
+	0x9c, 0xda, 0x9d, 0xa3, 0xdf, 0xdf, 0x96, 0x1a, 0xa2, 0x3e, 0x40, 0x4e, 0x89, 0xe0, 0xe7, 0xd0,
+	0xf0, 0xb8, 0x4f, 0xb4, 0xe1, 0x87, 0xd5, 0x86, 0xb7, 0x93, 0x45, 0xe4, 0xed, 0xb8, 0xd2, 0xdd,
+	0xe7, 0xa9, 0xf0, 0x88, 0xa3, 0xda, 0xff, 0xce, 0x33, 0xbe, 0x07, 0xab, 0x2e, 0x63, 0xfc, 0x60,
+	0x12, 0x0b, 0xe2, 0xf1, 0x30, 0xa6, 0x8c, 0xf8, 0xfd, 0xe6, 0x00, 0x6d, 0xb4, 0x9d, 0x9e, 0x02,
+	0xf6, 0x4e, 0xea, 0xc3, 0x9f, 0x08, 0xfe, 0x3b, 0xb7, 0x19, 0xde, 0x81, 0x26, 0xe3, 0x9e, 0xcb,
+	0xf4, 0x41, 0xdf, 0xaf, 0xb6, 0x7b, 0xd2, 0x3c, 0xae, 0x39, 0x45, 0x33, 0x7e, 0x05, 0x2d, 0x41,
+	0x42, 0x2e, 0x8b, 0x5c, 0x96, 0x47, 0xa3, 0x6a, 0x19, 0x47, 0xf1, 0xcf, 0x88, 0x69, 0x0d, 0x7b,
+	0x13, 0x3a, 0x49, 0x4c, 0x3c, 0x3a, 0xa3, 0x44, 0xe0, 0xc1, 0xd1, 0xd3, 0xab, 0xf8, 0x12, 0xac,
+	0x1e, 0x4f, 0xaa, 0x20, 0x9f, 0x52, 0x2a, 0x88, 0xdf, 0x43, 0xeb, 0x0d, 0x29, 0x52, 0x32, 0xfc,
+	0x8e, 0x00, 0x4e, 0xfd, 0xd1, 0x6d, 0x68, 0xcf, 0x28, 0x23, 0x27, 0x63, 0x76, 0xea, 0x94, 0xc6,
+	0x35, 0xe7, 0x18, 0xc4, 0x26, 0x74, 0x69, 0xc4, 0x68, 0x44, 0x26, 0xd3, 0x85, 0x24, 0x89, 0xb2,
+	0xde, 0xd5, 0xe4, 0xcf, 0xf5, 0x5e, 0x4e, 0x5e, 0x2e, 0x08, 0x76, 0x8e, 0xe3, 0x07, 0xb0, 0xa2,
+	0xf9, 0x89, 0x14, 0x34, 0x2a, 0x46, 0xf2, 0x9c, 0xba, 0x56, 0xdc, 0x57, 0x84, 0x7f, 0xfd, 0x91,
+	0x2f, 0x08, 0x7a, 0xe7, 0x63, 0xc1, 0x7b, 0xd0, 0xce, 0x73, 0x9b, 0xa4, 0x82, 0xea, 0x33, 0xba,
+	0x53, 0x1d, 0xee, 0x58, 0xca, 0xf8, 0x8d, 0xa0, 0x36, 0xe4, 0xde, 0x9a, 0x5f, 0x51, 0xbd, 0x87,
+	0x1c, 0x63, 0x5e, 0x14, 0xf1, 0x75, 0x68, 0x25, 0x73, 0x77, 0xf4, 0x78, 0xeb, 0xe2, 0x10, 0x6b,
+	0x60, 0xf8, 0x03, 0x81, 0xa1, 0x35, 0xf0, 0x15, 0x58, 0x2a, 0xf7, 0x3e, 0xc3, 0xcd, 0xab, 0xf8,
+	0x16, 0x18, 0x1e, 0x4b, 0x13, 0x49, 0xc4, 0x05, 0xb1, 0x71, 0xcd, 0x29, 0x31, 0xbc, 0x0d, 0x46,
+	0x7e, 0x37, 0x78, 0x2a, 0xf5, 0xb5, 0xb8, 0x6c, 0x16, 0x4f, 0x94, 0x59, 0x3e, 0x51, 0xe6, 0x8e,
+	0x1e, 0x69, 0xbb, 0x9b, 0x2b, 0x18, 0x87, 0xa8, 0xd1, 0x46, 0xa3, 0x9a, 0x53, 0xf6, 0xd9, 0x5b,
+	0x80, 0x8b, 0x1c, 0xe2, 0x44, 0x0a, 0xe2, 0x86, 0x13, 0xb9, 0x88, 0x49, 0x75, 0xa8, 0xf6, 0x93,
+	0xf7, 0x5b, 0x01, 0x95, 0xf3, 0x74, 0x6a, 0x7a, 0x3c, 0xb4, 0x12, 0xce, 0xf8, 0x26, 0xe5, 0x56,
+	0xc0, 0x38, 0xcf, 0x9f, 0xc7, 0x0f, 0xc4, 0x93, 0x89, 0xfe, 0xfa, 0x18, 0x58, 0xb1, 0x7a, 0xab,
+	0x12, 0x2b, 0xcf, 0x71, 0xda, 0x52, 0xde, 0x1e, 0xfd, 0x19, 0x00, 0x05, 0x8a, 0xf2, 0xff, 0x7e,
+	0x05, 0x00, 0x00,
+}
@@ -0,0 +1,185 @@
+package wasm
+
+import (
+	"net"
+	"strconv"
+	"time"
+
+	envoyapi "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	types "github.com/gogo/protobuf/types"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/wasm"
+	"github.com/solo-io/gloo/projects/gloo/pkg/imagecache"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/pluginutils"
+	"github.com/solo-io/solo-kit/pkg/errors"
+)
+
+const (
+	FilterName = "envoy.filters.http.wasm"
+
+	// the cluster envoy fetches the modules of the images from
+	ImageCacheClusterName = "wasm-image-cache"
+
+	fetchTimeout = 5 * time.Second
+)
+
+var (
+	pluginStage = plugins.PostInAuth
+
+	runtimes = map[wasm.WasmFilter_VmType]string{
+		wasm.WasmFilter_V8:   "envoy.wasm.runtime.v8",
+		wasm.WasmFilter_WAVM: "envoy.wasm.runtime.wavm",
+	}
+)
+
+type Plugin struct {
+	// nil when the wasm options of the settings are not set
+	imageCache *imagecache.Cache
+	// the host and port envoy fetches the modules of the images from
+	imageCacheAddress string
+
+	settings *v1.Settings
+	// whether a filter of the proxy fetches its module from the image cache
+	fetchesImages bool
+}
+
+func NewPlugin(imageCache *imagecache.Cache, imageCacheAddress string) *Plugin {
+	return &Plugin{
+		imageCache:        imageCache,
+		imageCacheAddress: imageCacheAddress,
+	}
+}
+
+func (p *Plugin) Init(params plugins.InitParams) error {
+	p.settings = params.Settings
+	p.fetchesImages = false
+	return nil
+}
+
+func (p *Plugin) HttpFilters(params plugins.Params, listener *v1.HttpListener) ([]plugins.StagedHttpFilter, error) {
+	wasmFilters := listener.GetListenerPlugins().GetWasm().GetFilters()
+	if len(wasmFilters) == 0 {
+		return nil, nil
+	}
+	if err := pluginutils.RequireEnvoyExtension(p.settings, FilterName); err != nil {
+		return nil, errors.Wrapf(err, "invalid wasm filters")
+	}
+	var filters []plugins.StagedHttpFilter
+	for i, filter := range wasmFilters {
+		config, err := p.filterConfig(filter)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid wasm filter %d", i)
+		}
+		stagedFilter, err := plugins.NewStagedFilterWithConfig(FilterName, config, pluginStage)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, stagedFilter)
+	}
+	return filters, nil
+}
+
+func (p *Plugin) filterConfig(filter *wasm.WasmFilter) (*Wasm, error) {
+	runtime, ok := runtimes[filter.VmType]
+	if !ok {
+		return nil, errors.Errorf("unknown vm type %v", filter.VmType)
+	}
+
+	var (
+		code *AsyncDataSource
+		name string
+	)
+	switch source := filter.Source.(type) {
+	case *wasm.WasmFilter_Image:
+		remote, err := p.fetchImage(source.Image)
+		if err != nil {
+			return nil, err
+		}
+		code = &AsyncDataSource{
+			Specifier: &AsyncDataSource_Remote{
+				Remote: remote,
+			},
+		}
+		name = source.Image
+	case *wasm.WasmFilter_FilePath:
+		if source.FilePath == "" {
+			return nil, errors.Errorf("the file path cannot be empty")
+		}
+		code = &AsyncDataSource{
+			Specifier: &AsyncDataSource_Local{
+				Local: &DataSource{
+					Specifier: &DataSource_Filename{
+						Filename: source.FilePath,
+					},
+				},
+			},
+		}
+		name = source.FilePath
+	default:
+		return nil, errors.Errorf("the image or file path of the module must be set")
+	}
+	if filter.Name != "" {
+		name = filter.Name
+	}
+
+	return &Wasm{
+		Config: &PluginConfig{
+			Name:   name,
+			RootId: filter.RootId,
+			VmConfig: &VmConfig{
+				VmId:    name,
+				Runtime: runtime,
+				Code:    code,
+			},
+			Configuration: filter.Config,
+		},
+	}, nil
+}
+
+func (p *Plugin) fetchImage(image string) (*RemoteDataSource, error) {
+	if p.imageCache == nil {
+		return nil, errors.Errorf("the wasm options of the settings must be set to pull the image %v", image)
+	}
+	// the proxy is translated again once the image is pulled
+	digest, err := p.imageCache.Fetch(image)
+	if err != nil {
+		return nil, err
+	}
+	p.fetchesImages = true
+	return &RemoteDataSource{
+		HttpUri: &HttpUri{
+			Uri: "http://" + p.imageCacheAddress + imagecache.ModulesPath + digest,
+			HttpUpstreamType: &HttpUri_Cluster{
+				Cluster: ImageCacheClusterName,
+			},
+			Timeout: types.DurationProto(fetchTimeout),
+		},
+		Sha256: digest,
+	}, nil
+}
+
+// the cluster of the image cache, when a filter of the proxy fetches its module from it
+func (p *Plugin) GeneratedClusters(params plugins.Params) ([]*envoyapi.Cluster, error) {
+	if !p.fetchesImages {
+		return nil, nil
+	}
+	host, port, err := net.SplitHostPort(p.imageCacheAddress)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid wasm image cache address %v", p.imageCacheAddress)
+	}
+	portNumber, err := strconv.ParseUint(port, 10, 16)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid wasm image cache address %v", p.imageCacheAddress)
+	}
+	out := &envoyapi.Cluster{
+		Name:           ImageCacheClusterName,
+		ConnectTimeout: fetchTimeout,
+		ClusterDiscoveryType: &envoyapi.Cluster_Type{
+			Type: envoyapi.Cluster_STRICT_DNS,
+		},
+		DnsLookupFamily: envoyapi.Cluster_V4_ONLY,
+	}
+	pluginutils.EnvoySingleEndpointLoadAssignment(out, host, uint32(portNumber))
+	return []*envoyapi.Cluster{out}, nil
+}
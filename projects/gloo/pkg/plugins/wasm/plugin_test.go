@@ -0,0 +1,204 @@
+package wasm_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/envoyproxy/go-control-plane/pkg/util"
+	"github.com/gogo/protobuf/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/wasm"
+	"github.com/solo-io/gloo/projects/gloo/pkg/imagecache"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	. "github.com/solo-io/gloo/projects/gloo/pkg/plugins/wasm"
+)
+
+var _ = Describe("Plugin", func() {
+
+	var (
+		listener *v1.HttpListener
+		filters  *wasm.PluginSource
+	)
+
+	BeforeEach(func() {
+		filters = &wasm.PluginSource{}
+		listener = &v1.HttpListener{
+			ListenerPlugins: &v1.ListenerPlugins{
+				Wasm: filters,
+			},
+		}
+	})
+
+	initPlugin := func(plugin *Plugin) {
+		err := plugin.Init(plugins.InitParams{
+			Ctx:      context.TODO(),
+			Settings: &v1.Settings{EnvoyExtensions: []string{FilterName}},
+		})
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	filterConfig := func(filter plugins.StagedHttpFilter) *Wasm {
+		Expect(filter.HttpFilter.Name).To(Equal(FilterName))
+		var config Wasm
+		err := util.StructToMessage(filter.HttpFilter.GetConfig(), &config)
+		Expect(err).NotTo(HaveOccurred())
+		return &config
+	}
+
+	It("should not add filters to listeners without wasm filters", func() {
+		plugin := NewPlugin(nil, "")
+		initPlugin(plugin)
+		stagedFilters, err := plugin.HttpFilters(plugins.Params{}, &v1.HttpListener{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(stagedFilters).To(BeEmpty())
+	})
+
+	It("should load the modules from the filesystem of envoy", func() {
+		filters.Filters = []*wasm.WasmFilter{{
+			Source: &wasm.WasmFilter_FilePath{FilePath: "/etc/envoy/filter.wasm"},
+			Config: `{"header": "x-filtered"}`,
+			RootId: "add_header",
+			VmType: wasm.WasmFilter_WAVM,
+		}}
+		plugin := NewPlugin(nil, "")
+		initPlugin(plugin)
+		stagedFilters, err := plugin.HttpFilters(plugins.Params{}, listener)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(stagedFilters).To(HaveLen(1))
+		Expect(filterConfig(stagedFilters[0])).To(Equal(&Wasm{
+			Config: &PluginConfig{
+				Name:   "/etc/envoy/filter.wasm",
+				RootId: "add_header",
+				VmConfig: &VmConfig{
+					VmId:    "/etc/envoy/filter.wasm",
+					Runtime: "envoy.wasm.runtime.wavm",
+					Code: &AsyncDataSource{
+						Specifier: &AsyncDataSource_Local{
+							Local: &DataSource{
+								Specifier: &DataSource_Filename{Filename: "/etc/envoy/filter.wasm"},
+							},
+						},
+					},
+				},
+				Configuration: `{"header": "x-filtered"}`,
+			},
+		}))
+
+		clusters, err := plugin.GeneratedClusters(plugins.Params{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(clusters).To(BeEmpty())
+	})
+
+	It("should reject the filters unless envoy supports wasm", func() {
+		filters.Filters = []*wasm.WasmFilter{{
+			Source: &wasm.WasmFilter_FilePath{FilePath: "/etc/envoy/filter.wasm"},
+		}}
+		plugin := NewPlugin(nil, "")
+		err := plugin.Init(plugins.InitParams{Ctx: context.TODO(), Settings: &v1.Settings{}})
+		Expect(err).NotTo(HaveOccurred())
+		_, err = plugin.HttpFilters(plugins.Params{}, listener)
+		Expect(err).To(MatchError(ContainSubstring(FilterName)))
+	})
+
+	It("should reject the images when the image cache is disabled", func() {
+		filters.Filters = []*wasm.WasmFilter{{
+			Source: &wasm.WasmFilter_Image{Image: "webassemblyhub.io/user/filter:v1"},
+		}}
+		plugin := NewPlugin(nil, "")
+		initPlugin(plugin)
+		_, err := plugin.HttpFilters(plugins.Params{}, listener)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should reject filters without module", func() {
+		filters.Filters = []*wasm.WasmFilter{{}}
+		plugin := NewPlugin(nil, "")
+		initPlugin(plugin)
+		_, err := plugin.HttpFilters(plugins.Params{}, listener)
+		Expect(err).To(HaveOccurred())
+	})
+
+	Context("images", func() {
+
+		var (
+			registry   *httptest.Server
+			digest     string
+			ctx        context.Context
+			cancel     context.CancelFunc
+			imageCache *imagecache.Cache
+		)
+
+		BeforeEach(func() {
+			module := []byte("\x00asm\x01\x00\x00\x00")
+			sum := sha256.Sum256(module)
+			digest = hex.EncodeToString(sum[:])
+			registry = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case "/v2/user/filter/manifests/v1":
+					fmt.Fprintf(w, `{"schemaVersion": 2, "layers": [{"mediaType": %q, "digest": "sha256:%v"}]}`,
+						imagecache.WasmLayerMediaType, digest)
+				case "/v2/user/filter/blobs/sha256:" + digest:
+					w.Write(module)
+				default:
+					http.NotFound(w, r)
+				}
+			}))
+			// the registry is served on the loopback interface, which the image cache refuses to pull from, as example.com
+			transport := registry.Client().Transport.(*http.Transport)
+			transport.DialContext = func(ctx context.Context, network, _ string) (net.Conn, error) {
+				return (&net.Dialer{}).DialContext(ctx, network, registry.Listener.Addr().String())
+			}
+			ctx, cancel = context.WithCancel(context.Background())
+			imageCache = imagecache.NewWithClient(ctx, &http.Client{Transport: transport})
+			filters.Filters = []*wasm.WasmFilter{{
+				Source: &wasm.WasmFilter_Image{Image: "example.com/user/filter:v1"},
+				Name:   "filter",
+			}}
+		})
+
+		AfterEach(func() {
+			cancel()
+			registry.Close()
+		})
+
+		It("should fetch the modules of the images from the image cache once pulled", func() {
+			plugin := NewPlugin(imageCache, "gloo.gloo-system.svc.cluster.local:9979")
+			initPlugin(plugin)
+			_, err := plugin.HttpFilters(plugins.Params{}, listener)
+			Expect(err).To(MatchError(ContainSubstring("is being pulled")))
+			Eventually(imageCache.Pulled()).Should(Receive())
+
+			initPlugin(plugin)
+			stagedFilters, err := plugin.HttpFilters(plugins.Params{}, listener)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(stagedFilters).To(HaveLen(1))
+
+			config := filterConfig(stagedFilters[0])
+			Expect(config.Config.Name).To(Equal("filter"))
+			Expect(config.Config.VmConfig.Runtime).To(Equal("envoy.wasm.runtime.v8"))
+			Expect(config.Config.VmConfig.Code.GetRemote()).To(Equal(&RemoteDataSource{
+				HttpUri: &HttpUri{
+					Uri:              "http://gloo.gloo-system.svc.cluster.local:9979/modules/" + digest,
+					HttpUpstreamType: &HttpUri_Cluster{Cluster: ImageCacheClusterName},
+					Timeout:          &types.Duration{Seconds: 5},
+				},
+				Sha256: digest,
+			}))
+
+			clusters, err := plugin.GeneratedClusters(plugins.Params{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(clusters).To(HaveLen(1))
+			Expect(clusters[0].Name).To(Equal(ImageCacheClusterName))
+			endpoint := clusters[0].LoadAssignment.Endpoints[0].LbEndpoints[0].GetEndpoint().GetAddress().GetSocketAddress()
+			Expect(endpoint.GetAddress()).To(Equal("gloo.gloo-system.svc.cluster.local"))
+			Expect(endpoint.GetPortValue()).To(Equal(uint32(9979)))
+		})
+	})
+})
@@ -0,0 +1,51 @@
+package websocket
+
+import (
+	envoyroute "github.com/envoyproxy/go-control-plane/envoy/api/v2/route"
+	"github.com/gogo/protobuf/types"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/solo-kit/pkg/errors"
+)
+
+// the same upgrade type as the http connection manager
+const webSocketUpgradeType = "websocket"
+
+type Plugin struct{}
+
+var _ plugins.RoutePlugin = NewPlugin()
+
+func NewPlugin() *Plugin {
+	return &Plugin{}
+}
+
+func (p *Plugin) Init(params plugins.InitParams) error {
+	return nil
+}
+
+func (p *Plugin) ProcessRoute(params plugins.Params, in *v1.Route, out *envoyroute.Route) error {
+	cfg := in.GetRoutePlugins().GetWebsocket()
+	if cfg == nil {
+		return nil
+	}
+	routeAction, ok := out.Action.(*envoyroute.Route_Route)
+	if !ok {
+		return errors.Errorf("websocket configuration is only available for Route Actions")
+	}
+	if routeAction.Route == nil {
+		return errors.Errorf("internal error: route %v specified websocket configuration, but output Envoy object "+
+			"had nil route", in.Action)
+	}
+
+	if cfg.Disabled {
+		routeAction.Route.UpgradeConfigs = []*envoyroute.RouteAction_UpgradeConfig{{
+			UpgradeType: webSocketUpgradeType,
+			Enabled:     &types.BoolValue{Value: false},
+		}}
+		return nil
+	}
+	if cfg.IdleTimeout != nil {
+		routeAction.Route.IdleTimeout = cfg.IdleTimeout
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package websocket_test
+
+import (
+	"time"
+
+	envoyroute "github.com/envoyproxy/go-control-plane/envoy/api/v2/route"
+	"github.com/gogo/protobuf/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/websocket"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	. "github.com/solo-io/gloo/projects/gloo/pkg/plugins/websocket"
+)
+
+var _ = Describe("Plugin", func() {
+	var (
+		plugin *Plugin
+		in     *v1.Route
+		out    *envoyroute.Route
+	)
+
+	BeforeEach(func() {
+		plugin = NewPlugin()
+		Expect(plugin.Init(plugins.InitParams{})).NotTo(HaveOccurred())
+		in = &v1.Route{
+			RoutePlugins: &v1.RoutePlugins{
+				Websocket: &websocket.RouteWebSocket{},
+			},
+		}
+		out = &envoyroute.Route{
+			Action: &envoyroute.Route_Route{
+				Route: &envoyroute.RouteAction{},
+			},
+		}
+	})
+
+	routeAction := func() *envoyroute.RouteAction {
+		return out.Action.(*envoyroute.Route_Route).Route
+	}
+
+	It("disables the upgrades of the route", func() {
+		in.RoutePlugins.Websocket.Disabled = true
+		Expect(plugin.ProcessRoute(plugins.Params{}, in, out)).NotTo(HaveOccurred())
+		Expect(routeAction().UpgradeConfigs).To(Equal([]*envoyroute.RouteAction_UpgradeConfig{{
+			UpgradeType: "websocket",
+			Enabled:     &types.BoolValue{Value: false},
+		}}))
+	})
+
+	It("sets the idle timeout of the route", func() {
+		timeout := time.Minute
+		in.RoutePlugins.Websocket.IdleTimeout = &timeout
+		Expect(plugin.ProcessRoute(plugins.Params{}, in, out)).NotTo(HaveOccurred())
+		Expect(*routeAction().IdleTimeout).To(Equal(time.Minute))
+		Expect(routeAction().UpgradeConfigs).To(BeEmpty())
+		Expect(out.PerFilterConfig).To(BeEmpty())
+	})
+})
@@ -0,0 +1,13 @@
+package azurekeyvault_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestAzureKeyVault(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "AzureKeyVault Suite")
+}
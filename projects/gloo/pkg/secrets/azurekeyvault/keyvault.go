@@ -0,0 +1,165 @@
+package azurekeyvault
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest/adal"
+	"github.com/Azure/go-autorest/autorest/azure"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/solo-kit/pkg/errors"
+)
+
+const (
+	keyVaultApiVersion = "7.0"
+	clientSecretEnv    = "AZURE_CLIENT_SECRET"
+)
+
+// SecretItem is a secret of the vault, without its value
+type SecretItem struct {
+	Id      string
+	Name    string
+	Updated int64
+	Tags    map[string]string
+}
+
+// Vault reads the secrets of a key vault
+type Vault interface {
+	// List returns the enabled secrets of the vault
+	List(ctx context.Context) ([]SecretItem, error)
+	// Value returns the current value of a secret
+	Value(ctx context.Context, item SecretItem) (string, error)
+}
+
+type secretAttributes struct {
+	Enabled bool  `json:"enabled"`
+	Updated int64 `json:"updated"`
+}
+
+type secretListResult struct {
+	Value []struct {
+		Id         string            `json:"id"`
+		Attributes secretAttributes  `json:"attributes"`
+		Tags       map[string]string `json:"tags"`
+	} `json:"value"`
+	NextLink string `json:"nextLink"`
+}
+
+type secretBundle struct {
+	Value string `json:"value"`
+}
+
+type keyVault struct {
+	token      *adal.ServicePrincipalToken
+	httpClient *http.Client
+	vaultUrl   string
+}
+
+// NewVault returns the key vault of the settings, authenticated with a service principal when a tenant id is set, or
+// with the managed identity of the node or pod otherwise
+func NewVault(config *v1.Settings_AzureKeyVaultSecrets) (Vault, error) {
+	if config.VaultName == "" {
+		return nil, errors.Errorf("the vault name is required to read secrets from azure key vault")
+	}
+	env := azure.PublicCloud
+	token, err := newToken(env, config)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to create azure key vault token")
+	}
+	return &keyVault{
+		token:      token,
+		httpClient: http.DefaultClient,
+		vaultUrl:   fmt.Sprintf("https://%s.%s", config.VaultName, env.KeyVaultDNSSuffix),
+	}, nil
+}
+
+func newToken(env azure.Environment, config *v1.Settings_AzureKeyVaultSecrets) (*adal.ServicePrincipalToken, error) {
+	resource := strings.TrimSuffix(env.KeyVaultEndpoint, "/")
+	if config.TenantId != "" {
+		clientSecret := os.Getenv(clientSecretEnv)
+		if config.ClientId == "" || clientSecret == "" {
+			return nil, errors.Errorf("the client id and the %v environment variable are required to authenticate "+
+				"with a service principal", clientSecretEnv)
+		}
+		oauthConfig, err := adal.NewOAuthConfig(env.ActiveDirectoryEndpoint, config.TenantId)
+		if err != nil {
+			return nil, err
+		}
+		return adal.NewServicePrincipalToken(*oauthConfig, config.ClientId, clientSecret, resource)
+	}
+
+	msiEndpoint, err := adal.GetMSIVMEndpoint()
+	if err != nil {
+		return nil, err
+	}
+	if config.ClientId != "" {
+		return adal.NewServicePrincipalTokenFromMSIWithUserAssignedID(msiEndpoint, resource, config.ClientId)
+	}
+	return adal.NewServicePrincipalTokenFromMSI(msiEndpoint, resource)
+}
+
+func (v *keyVault) List(ctx context.Context) ([]SecretItem, error) {
+	var items []SecretItem
+	nextLink := fmt.Sprintf("%s/secrets?api-version=%s", v.vaultUrl, keyVaultApiVersion)
+	for nextLink != "" {
+		var page secretListResult
+		if err := v.get(ctx, nextLink, &page); err != nil {
+			return nil, errors.Wrapf(err, "unable to list the secrets of key vault %v", v.vaultUrl)
+		}
+		for _, secret := range page.Value {
+			if !secret.Attributes.Enabled {
+				continue
+			}
+			items = append(items, SecretItem{
+				Id:      secret.Id,
+				Name:    secretName(secret.Id),
+				Updated: secret.Attributes.Updated,
+				Tags:    secret.Tags,
+			})
+		}
+		nextLink = page.NextLink
+	}
+	return items, nil
+}
+
+func (v *keyVault) Value(ctx context.Context, item SecretItem) (string, error) {
+	var bundle secretBundle
+	if err := v.get(ctx, fmt.Sprintf("%s?api-version=%s", item.Id, keyVaultApiVersion), &bundle); err != nil {
+		return "", errors.Wrapf(err, "unable to read key vault secret %v", item.Name)
+	}
+	return bundle.Value, nil
+}
+
+// secret ids are https://<vault>.vault.azure.net/secrets/<name>, optionally followed by the version
+func secretName(id string) string {
+	parts := strings.Split(id, "/secrets/")
+	return strings.SplitN(parts[len(parts)-1], "/", 2)[0]
+}
+
+func (v *keyVault) get(ctx context.Context, url string, out interface{}) error {
+	if err := v.token.EnsureFreshWithContext(ctx); err != nil {
+		return errors.Wrapf(err, "unable to get azure access token")
+	}
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+v.token.OAuthToken())
+
+	resp, err := v.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := ioutil.ReadAll(resp.Body)
+		return errors.Errorf("azure key vault returned %v: %s", resp.Status, body)
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
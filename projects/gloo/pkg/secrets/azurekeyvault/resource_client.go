@@ -0,0 +1,212 @@
+package azurekeyvault
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/go-utils/protoutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/factory"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/solo-io/solo-kit/pkg/errors"
+)
+
+// NamespaceTag is the tag of the key vault secrets holding the namespace of their gloo secret.
+// Secrets without it are ignored.
+const NamespaceTag = "gloo-namespace"
+
+// ResourceClientFactory creates read only secret clients backed by an azure key vault
+type ResourceClientFactory struct {
+	Vault Vault
+}
+
+func (f *ResourceClientFactory) NewResourceClient(params factory.NewResourceClientParams) (clients.ResourceClient, error) {
+	if _, ok := params.ResourceType.(*v1.Secret); !ok {
+		return nil, errors.Errorf("azure key vault only stores secrets, not %v", resources.Kind(params.ResourceType))
+	}
+	return NewResourceClient(f.Vault), nil
+}
+
+type cachedSecret struct {
+	updated int64
+	secret  *v1.Secret
+}
+
+type ResourceClient struct {
+	vault Vault
+
+	lock sync.Mutex
+	// key vault secret values by name, read again only when they are updated
+	cache map[string]cachedSecret
+}
+
+func NewResourceClient(vault Vault) *ResourceClient {
+	return &ResourceClient{
+		vault: vault,
+		cache: make(map[string]cachedSecret),
+	}
+}
+
+var _ clients.ResourceClient = &ResourceClient{}
+
+func (rc *ResourceClient) Kind() string {
+	return resources.Kind(&v1.Secret{})
+}
+
+func (rc *ResourceClient) NewResource() resources.Resource {
+	return &v1.Secret{}
+}
+
+func (rc *ResourceClient) Register() error {
+	return nil
+}
+
+func (rc *ResourceClient) Read(namespace, name string, opts clients.ReadOpts) (resources.Resource, error) {
+	if err := resources.ValidateName(name); err != nil {
+		return nil, errors.Wrapf(err, "validation error")
+	}
+	opts = opts.WithDefaults()
+	list, err := rc.list(opts.Ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+	for _, secret := range list {
+		if secret.Metadata.Name == name {
+			return secret, nil
+		}
+	}
+	return nil, errors.NewNotExistErr(namespace, name)
+}
+
+func (rc *ResourceClient) Write(resource resources.Resource, opts clients.WriteOpts) (resources.Resource, error) {
+	return nil, errors.Errorf("azure key vault secrets are read only, %v cannot be written", resource.GetMetadata().Ref())
+}
+
+func (rc *ResourceClient) Delete(namespace, name string, opts clients.DeleteOpts) error {
+	return errors.Errorf("azure key vault secrets are read only, %v.%v cannot be deleted", namespace, name)
+}
+
+func (rc *ResourceClient) List(namespace string, opts clients.ListOpts) (resources.ResourceList, error) {
+	opts = opts.WithDefaults()
+	list, err := rc.list(opts.Ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+	var resourceList resources.ResourceList
+	for _, secret := range list {
+		resourceList = append(resourceList, secret)
+	}
+	return resourceList, nil
+}
+
+func (rc *ResourceClient) Watch(namespace string, opts clients.WatchOpts) (<-chan resources.ResourceList, <-chan error, error) {
+	opts = opts.WithDefaults()
+	resourcesChan := make(chan resources.ResourceList)
+	errs := make(chan error)
+	updateResourceList := func() {
+		list, err := rc.List(namespace, clients.ListOpts{
+			Ctx: opts.Ctx,
+		})
+		if err != nil {
+			select {
+			case errs <- err:
+			case <-opts.Ctx.Done():
+			}
+			return
+		}
+		select {
+		case resourcesChan <- list:
+		case <-opts.Ctx.Done():
+		}
+	}
+
+	go func() {
+		// key vault has no watch api, its secrets are polled
+		updateResourceList()
+		for {
+			select {
+			case <-time.After(opts.RefreshRate):
+				updateResourceList()
+			case <-opts.Ctx.Done():
+				close(resourcesChan)
+				close(errs)
+				return
+			}
+		}
+	}()
+
+	return resourcesChan, errs, nil
+}
+
+// returns the secrets of the namespace, or of all namespaces if empty, sorted by name
+func (rc *ResourceClient) list(ctx context.Context, namespace string) (v1.SecretList, error) {
+	items, err := rc.vault.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rc.lock.Lock()
+	defer rc.lock.Unlock()
+
+	var list v1.SecretList
+	current := make(map[string]bool)
+	for _, item := range items {
+		ns, ok := item.Tags[NamespaceTag]
+		if !ok {
+			continue
+		}
+		current[item.Name] = true
+		if namespace != "" && ns != namespace {
+			continue
+		}
+		secret, err := rc.secret(ctx, item, ns)
+		if err != nil {
+			// a single invalid secret must not hide the others
+			contextutils.LoggerFrom(ctx).Warnw("ignoring invalid azure key vault secret", "secret", item.Name, "error", err)
+			continue
+		}
+		list = append(list, secret)
+	}
+	// forget the values of the deleted secrets
+	for name := range rc.cache {
+		if !current[name] {
+			delete(rc.cache, name)
+		}
+	}
+
+	sort.SliceStable(list, func(i, j int) bool {
+		return list[i].Metadata.Less(list[j].Metadata)
+	})
+	return list, nil
+}
+
+// returns the gloo secret of a key vault secret, reading its value only if it was updated since it was cached
+func (rc *ResourceClient) secret(ctx context.Context, item SecretItem, namespace string) (*v1.Secret, error) {
+	if cached, ok := rc.cache[item.Name]; ok && cached.updated == item.Updated && cached.secret.Metadata.Namespace == namespace {
+		return resources.Clone(cached.secret).(*v1.Secret), nil
+	}
+	value, err := rc.vault.Value(ctx, item)
+	if err != nil {
+		return nil, err
+	}
+	var secret v1.Secret
+	if err := protoutils.UnmarshalYaml([]byte(value), &secret); err != nil {
+		return nil, errors.Wrapf(err, "the value of key vault secret %v is not a gloo secret", item.Name)
+	}
+	if secret.Kind == nil {
+		return nil, errors.Errorf("key vault secret %v has no secret kind", item.Name)
+	}
+	secret.Metadata = core.Metadata{
+		Name:            item.Name,
+		Namespace:       namespace,
+		ResourceVersion: strconv.FormatInt(item.Updated, 10),
+	}
+	rc.cache[item.Name] = cachedSecret{updated: item.Updated, secret: &secret}
+	return resources.Clone(&secret).(*v1.Secret), nil
+}
@@ -0,0 +1,110 @@
+package azurekeyvault_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	. "github.com/solo-io/gloo/projects/gloo/pkg/secrets/azurekeyvault"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/solo-io/solo-kit/pkg/errors"
+)
+
+type fakeVault struct {
+	items  []SecretItem
+	values map[string]string
+	reads  map[string]int
+}
+
+func (v *fakeVault) List(ctx context.Context) ([]SecretItem, error) {
+	return v.items, nil
+}
+
+func (v *fakeVault) Value(ctx context.Context, item SecretItem) (string, error) {
+	v.reads[item.Name]++
+	return v.values[item.Name], nil
+}
+
+var _ = Describe("ResourceClient", func() {
+	var (
+		vault *fakeVault
+		rc    *ResourceClient
+	)
+
+	BeforeEach(func() {
+		vault = &fakeVault{
+			items: []SecretItem{
+				{Name: "aws-creds", Updated: 1, Tags: map[string]string{NamespaceTag: "gloo-system"}},
+				{Name: "azure-creds", Updated: 1, Tags: map[string]string{NamespaceTag: "default"}},
+				{Name: "untagged", Updated: 1},
+			},
+			values: map[string]string{
+				"aws-creds":   "aws:\n  accessKey: access\n  secretKey: secret\n",
+				"azure-creds": `{"azure": {"apiKeys": {"key": "value"}}}`,
+				"untagged":    `{"aws": {"accessKey": "a", "secretKey": "b"}}`,
+			},
+			reads: make(map[string]int),
+		}
+		rc = NewResourceClient(vault)
+	})
+
+	It("lists the tagged secrets of the namespace", func() {
+		list, err := rc.List("gloo-system", clients.ListOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(list).To(HaveLen(1))
+		secret := list[0].(*v1.Secret)
+		Expect(secret.Metadata).To(Equal(core.Metadata{Name: "aws-creds", Namespace: "gloo-system", ResourceVersion: "1"}))
+		Expect(secret.Kind).To(Equal(&v1.Secret_Aws{Aws: &v1.AwsSecret{AccessKey: "access", SecretKey: "secret"}}))
+	})
+
+	It("lists the tagged secrets of all namespaces", func() {
+		list, err := rc.List("", clients.ListOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(list).To(HaveLen(2))
+		Expect(list[0].GetMetadata().Name).To(Equal("azure-creds"))
+		Expect(list[1].GetMetadata().Name).To(Equal("aws-creds"))
+	})
+
+	It("reads a secret", func() {
+		res, err := rc.Read("default", "azure-creds", clients.ReadOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res.(*v1.Secret).Kind).To(Equal(&v1.Secret_Azure{Azure: &v1.AzureSecret{ApiKeys: map[string]string{"key": "value"}}}))
+
+		_, err = rc.Read("gloo-system", "azure-creds", clients.ReadOpts{})
+		Expect(errors.IsNotExist(err)).To(BeTrue())
+		_, err = rc.Read("default", "untagged", clients.ReadOpts{})
+		Expect(errors.IsNotExist(err)).To(BeTrue())
+	})
+
+	It("reads the values again only when they are updated", func() {
+		_, err := rc.List("gloo-system", clients.ListOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		_, err = rc.List("gloo-system", clients.ListOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(vault.reads["aws-creds"]).To(Equal(1))
+
+		vault.items[0].Updated = 2
+		vault.values["aws-creds"] = `{"aws": {"accessKey": "new", "secretKey": "secret"}}`
+		list, err := rc.List("gloo-system", clients.ListOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(vault.reads["aws-creds"]).To(Equal(2))
+		Expect(list[0].(*v1.Secret).Kind.(*v1.Secret_Aws).Aws.AccessKey).To(Equal("new"))
+		Expect(list[0].GetMetadata().ResourceVersion).To(Equal("2"))
+	})
+
+	It("ignores the secrets that are not gloo secrets", func() {
+		vault.values["aws-creds"] = "not a secret"
+		list, err := rc.List("", clients.ListOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(list).To(HaveLen(1))
+		Expect(list[0].GetMetadata().Name).To(Equal("azure-creds"))
+	})
+
+	It("is read only", func() {
+		_, err := rc.Write(&v1.Secret{Metadata: core.Metadata{Name: "foo", Namespace: "default"}}, clients.WriteOpts{})
+		Expect(err).To(HaveOccurred())
+		Expect(rc.Delete("default", "aws-creds", clients.DeleteOpts{})).To(HaveOccurred())
+	})
+})
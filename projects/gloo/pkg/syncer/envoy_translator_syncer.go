@@ -4,15 +4,19 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"runtime"
+	"strings"
+	"sync"
 
 	"github.com/gorilla/mux"
+	"github.com/hashicorp/go-multierror"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/explain"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
-	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
+	"github.com/solo-io/gloo/projects/gloo/pkg/syncer/sanitizer"
 	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
 	"github.com/solo-io/go-utils/contextutils"
 	"github.com/solo-io/go-utils/log"
-	envoycache "github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
 	"github.com/solo-io/solo-kit/pkg/api/v1/reporter"
 	"github.com/solo-io/solo-kit/pkg/errors"
 	"go.opencensus.io/stats"
@@ -33,10 +37,30 @@ var (
 		Aggregation: view.LastValue(),
 		TagKeys:     []tag.Key{proxyNameKey, resourceNameKey},
 	}
+
+	staleResources = stats.Int64("api.gloo.solo.io/translator/stale_resources", "The number of errored resources served with their last accepted configuration", "1")
+
+	staleResourcesView = &view.View{
+		Name:        "api.gloo.solo.io/translator/stale_resources",
+		Measure:     staleResources,
+		Description: "The number of errored resources served with their last accepted configuration",
+		Aggregation: view.LastValue(),
+		TagKeys:     []tag.Key{proxyNameKey, resourceNameKey},
+	}
+
+	heldBackRoutes = stats.Int64("api.gloo.solo.io/translator/held_back_routes", "The number of new routes waiting for the endpoints of their destinations", "1")
+
+	heldBackRoutesView = &view.View{
+		Name:        "api.gloo.solo.io/translator/held_back_routes",
+		Measure:     heldBackRoutes,
+		Description: "The number of new routes waiting for the endpoints of their destinations",
+		Aggregation: view.LastValue(),
+		TagKeys:     []tag.Key{proxyNameKey},
+	}
 )
 
 func init() {
-	view.Register(envoySnapshotOutView)
+	view.Register(envoySnapshotOutView, staleResourcesView, heldBackRoutesView)
 }
 
 func measureResource(ctx context.Context, resource string, len int) {
@@ -45,6 +69,12 @@ func measureResource(ctx context.Context, resource string, len int) {
 	}
 }
 
+func measureStaleResources(ctx context.Context, resource string, len int) {
+	if ctxWithTags, err := tag.New(ctx, tag.Insert(resourceNameKey, resource)); err == nil {
+		stats.Record(ctxWithTags, staleResources.M(int64(len)))
+	}
+}
+
 func (s *translatorSyncer) syncEnvoy(ctx context.Context, snap *v1.ApiSnapshot) error {
 
 	ctx, span := trace.StartSpan(ctx, "gloo.syncer.Sync")
@@ -64,60 +94,129 @@ func (s *translatorSyncer) syncEnvoy(ctx context.Context, snap *v1.ApiSnapshot)
 
 	s.xdsHasher.SetKeysFromProxies(snap.Proxies)
 
+	var (
+		keys = make(map[string]bool)
+		// guards allResourceErrs and errs, which the workers translating the proxies add to
+		lock sync.Mutex
+		errs error
+		wg   sync.WaitGroup
+	)
+	// the proxies are translated concurrently, so that a large proxy does not delay the updates of the others
+	workers := make(chan struct{}, s.workers)
 	for _, proxy := range snap.Proxies {
-		proxyCtx := ctx
-		if ctxWithTags, err := tag.New(proxyCtx, tag.Insert(proxyNameKey, proxy.Metadata.Ref().Key())); err == nil {
-			proxyCtx = ctxWithTags
-		}
-
-		params := plugins.Params{
-			Ctx:      proxyCtx,
-			Snapshot: snap,
-		}
-
-		xdsSnapshot, resourceErrs, err := s.translator.Translate(params, proxy)
-		if err != nil {
-			err := errors.Wrapf(err, "translation loop failed")
-			logger.DPanicw("", zap.Error(err))
-			return err
-		}
-
-		allResourceErrs.Merge(resourceErrs)
-
-		if xdsSnapshot, err = validateSnapshot(snap, xdsSnapshot, resourceErrs, logger); err != nil {
-			logger.Warnf("proxy %v was rejected due to invalid config: %v\nxDS cache will not be updated.", err)
-			continue
-		}
+		proxy := proxy
 		key := xds.SnapshotKey(proxy)
-		if err := s.xdsCache.SetSnapshot(key, xdsSnapshot); err != nil {
-			err := errors.Wrapf(err, "failed while updating xds snapshot cache")
-			logger.DPanicw("", zap.Error(err))
-			return err
-		}
-
-		clustersLen := len(xdsSnapshot.GetResources(xds.ClusterType).Items)
-		listenersLen := len(xdsSnapshot.GetResources(xds.ListenerType).Items)
-		routesLen := len(xdsSnapshot.GetResources(xds.RouteType).Items)
-		endpointsLen := len(xdsSnapshot.GetResources(xds.EndpointType).Items)
-
-		measureResource(proxyCtx, "clusters", clustersLen)
-		measureResource(proxyCtx, "listeners", listenersLen)
-		measureResource(proxyCtx, "routes", routesLen)
-		measureResource(proxyCtx, "endpoints", endpointsLen)
-
-		logger.Infow("Setting xDS Snapshot", "key", key,
-			"clusters", clustersLen,
-			"listeners", listenersLen,
-			"routes", routesLen,
-			"endpoints", endpointsLen)
-
-		logger.Debugf("Full snapshot for proxy %v: %v", proxy.Metadata.Name, xdsSnapshot)
+		keys[key] = true
+
+		workers <- struct{}{}
+		wg.Add(1)
+		go func() {
+			defer func() {
+				<-workers
+				wg.Done()
+			}()
+			resourceErrs, err := s.syncProxy(ctx, snap, proxy, key)
+
+			lock.Lock()
+			defer lock.Unlock()
+			allResourceErrs.Merge(resourceErrs)
+			if err != nil {
+				errs = multierror.Append(errs, err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if s.lastKnownGood != nil {
+		s.lastKnownGood.Retain(keys)
+	}
+	if s.readinessGate != nil {
+		s.readinessGate.Retain(keys)
 	}
 	if err := s.reporter.WriteReports(ctx, allResourceErrs, nil); err != nil {
 		logger.Debugf("Failed writing report for proxies: %v", err)
 		return errors.Wrapf(err, "writing reports")
 	}
-	return nil
+	return errs
+}
+
+// translates the proxy and sets its snapshot in the xds cache. The errors of the resources are returned to be
+// reported, and the error of the proxy only affects the proxy.
+func (s *translatorSyncer) syncProxy(ctx context.Context, snap *v1.ApiSnapshot, proxy *v1.Proxy, key string) (reporter.ResourceErrors, error) {
+	proxyCtx := ctx
+	if ctxWithTags, err := tag.New(proxyCtx, tag.Insert(proxyNameKey, proxy.Metadata.Ref().Key())); err == nil {
+		proxyCtx = ctxWithTags
+	}
+	logger := contextutils.LoggerFrom(proxyCtx)
+
+	params := plugins.Params{
+		Ctx:      proxyCtx,
+		Snapshot: snap,
+	}
+
+	xdsSnapshot, resourceErrs, err := s.translator.Translate(params, proxy)
+	if err != nil {
+		err := errors.Wrapf(err, "translation loop failed for proxy %v", key)
+		logger.Errorw("", zap.Error(err))
+		return reporter.ResourceErrors{proxy: err}, err
+	}
+
+	var stale StaleResources
+	if s.lastKnownGood != nil {
+		xdsSnapshot, stale = s.lastKnownGood.Restore(key, snap, xdsSnapshot, resourceErrs)
+		measureStaleResources(proxyCtx, "upstreams", len(stale.Upstreams))
+		measureStaleResources(proxyCtx, "proxies", len(stale.VirtualHosts))
+	}
+	// the errors of the stale resources are reported, but do not reject the snapshot
+	allResourceErrs := make(reporter.ResourceErrors)
+	allResourceErrs.Merge(resourceErrs)
+	allResourceErrs.Merge(stale.Errors())
+
+	sanitizers := append(sanitizer.XdsSanitizers{sanitizer.NewUpstreamRemovingSanitizer()}, s.sanitizers.ForRole(key)...)
+	if xdsSnapshot, err = sanitizers.SanitizeSnapshot(proxyCtx, snap, xdsSnapshot, resourceErrs); err != nil {
+		logger.Warnf("proxy %v was rejected due to invalid config: %v\nxDS cache will not be updated.", key, err)
+		return allResourceErrs, nil
+	}
+	if s.readinessGate != nil {
+		var heldBack int
+		xdsSnapshot, heldBack = s.readinessGate.Gate(key, xdsSnapshot)
+		stats.Record(proxyCtx, heldBackRoutes.M(int64(heldBack)))
+	}
+	if err := s.xdsCache.SetSnapshot(key, xdsSnapshot); err != nil {
+		err := errors.Wrapf(err, "failed while updating xds snapshot cache")
+		logger.DPanicw("", zap.Error(err))
+		return allResourceErrs, err
+	}
+	if s.lastKnownGood != nil {
+		s.lastKnownGood.Record(key, xdsSnapshot)
+	}
+
+	clustersLen := len(xdsSnapshot.GetResources(xds.ClusterType).Items)
+	listenersLen := len(xdsSnapshot.GetResources(xds.ListenerType).Items)
+	routesLen := len(xdsSnapshot.GetResources(xds.RouteType).Items)
+	endpointsLen := len(xdsSnapshot.GetResources(xds.EndpointType).Items)
+
+	measureResource(proxyCtx, "clusters", clustersLen)
+	measureResource(proxyCtx, "listeners", listenersLen)
+	measureResource(proxyCtx, "routes", routesLen)
+	measureResource(proxyCtx, "endpoints", endpointsLen)
+
+	logger.Infow("Setting xDS Snapshot", "key", key,
+		"clusters", clustersLen,
+		"listeners", listenersLen,
+		"routes", routesLen,
+		"endpoints", endpointsLen)
+
+	logger.Debugf("Full snapshot for proxy %v: %v", proxy.Metadata.Name, xdsSnapshot)
+	return allResourceErrs, nil
+}
+
+// the number of proxies translated concurrently
+func proxyTranslationWorkers(settings *v1.Settings) int {
+	if workers := settings.GetProxyTranslationWorkers(); workers > 0 {
+		return int(workers)
+	}
+	return runtime.NumCPU()
 }
 
 // TODO(ilackarms): move this somewhere else, make it part of dev-mode
@@ -129,49 +228,33 @@ func (s *translatorSyncer) ServeXdsSnapshots() error {
 	r.HandleFunc("/api", func(w http.ResponseWriter, r *http.Request) {
 		fmt.Fprintf(w, log.Sprintf("%v", s.latestSnap))
 	})
+	// routes the request sent to /explain/<path> with the proxies of the latest snapshot, e.g.
+	// curl -H "Host: example.com" localhost:10010/explain/api/pets?id=1
+	r.PathPrefix("/explain/").HandlerFunc(s.explainRoute)
 	return http.ListenAndServe(":10010", r)
 }
 
-func validateSnapshot(snap *v1.ApiSnapshot, snapshot envoycache.Snapshot, errs reporter.ResourceErrors, logger *zap.SugaredLogger) (envoycache.Snapshot, error) {
-
-	// find all the errored upstreams and remove them. if the snapshot is still consistent, we are good to go
-	resourcesErr := errs.Validate()
-	if resourcesErr == nil {
-		return snapshot, nil
+func (s *translatorSyncer) explainRoute(w http.ResponseWriter, r *http.Request) {
+	snap := s.latestSnap
+	if snap == nil {
+		http.Error(w, "no snapshot was translated yet", http.StatusServiceUnavailable)
+		return
 	}
-
-	logger.Debug("removing errored upstream and checking consistency")
-
-	clusters := snapshot.GetResources(xds.ClusterType)
-	endpoints := snapshot.GetResources(xds.EndpointType)
-
-	for _, up := range snap.Upstreams.AsInputResources() {
-		if errs[up] != nil {
-			clusterName := translator.UpstreamToClusterName(up.GetMetadata().Ref())
-			// remove cluster and endpoints
-			delete(clusters.Items, clusterName)
-			delete(endpoints.Items, clusterName)
-		}
+	headers := make(map[string][]string, len(r.Header))
+	for name, values := range r.Header {
+		headers[name] = values
 	}
-
-	snapshot = xds.NewSnapshotFromResources(
-		endpoints,
-		clusters,
-		snapshot.GetResources(xds.RouteType),
-		snapshot.GetResources(xds.ListenerType),
-	)
-
-	if snapshot.Consistent() != nil {
-		return snapshot, resourcesErr
-	}
-
-	// snapshot is consistent, so check if we have errors not related to the upstreams
-	for _, up := range snap.Upstreams.AsInputResources() {
-		if errs[up] != nil {
-			delete(errs, up)
-		}
+	reports := explain.Explain(snap.Proxies, explain.Request{
+		Host:    r.Host,
+		Path:    strings.TrimPrefix(r.URL.RequestURI(), "/explain"),
+		Method:  r.Method,
+		Headers: headers,
+	})
+	out, err := explain.MarshalReports(reports)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
 	}
-
-	resourcesErr = errs.Validate()
-	return snapshot, resourcesErr
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(out)
 }
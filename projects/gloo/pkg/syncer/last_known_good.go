@@ -0,0 +1,229 @@
+package syncer
+
+import (
+	"fmt"
+	"sync"
+
+	envoyapi "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	envoyroute "github.com/envoyproxy/go-control-plane/envoy/api/v2/route"
+	"github.com/gogo/protobuf/proto"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/mitchellh/hashstructure"
+	"github.com/pkg/errors"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
+	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
+	envoycache "github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
+	"github.com/solo-io/solo-kit/pkg/api/v1/reporter"
+)
+
+// LastKnownGood keeps the last accepted snapshot of each proxy, to serve the last accepted configuration of the
+// upstreams and virtual hosts that fail translation, rather than removing them or rejecting the whole snapshot.
+type LastKnownGood struct {
+	lock      sync.Mutex
+	snapshots map[string]envoycache.Snapshot
+}
+
+func NewLastKnownGood() *LastKnownGood {
+	return &LastKnownGood{
+		snapshots: make(map[string]envoycache.Snapshot),
+	}
+}
+
+// StaleResources are the errors of the resources whose last accepted configuration is served
+type StaleResources struct {
+	Upstreams    reporter.ResourceErrors
+	VirtualHosts reporter.ResourceErrors
+}
+
+// Restore puts the last accepted clusters of the errored upstreams, and the last accepted virtual hosts of the errored
+// virtual hosts, in the snapshot of the proxy. The errors of the restored resources are removed from the reports and
+// returned. A resource is restored only if it was accepted before and all of its errors can be restored.
+func (l *LastKnownGood) Restore(key string, glooSnapshot *v1.ApiSnapshot, xdsSnapshot envoycache.Snapshot, reports reporter.ResourceErrors) (envoycache.Snapshot, StaleResources) {
+	stale := StaleResources{
+		Upstreams:    make(reporter.ResourceErrors),
+		VirtualHosts: make(reporter.ResourceErrors),
+	}
+	l.lock.Lock()
+	lastSnapshot, ok := l.snapshots[key]
+	l.lock.Unlock()
+	if !ok || reports.Validate() == nil {
+		return xdsSnapshot, stale
+	}
+
+	clusters := copyResources(xdsSnapshot.GetResources(xds.ClusterType))
+	endpoints := copyResources(xdsSnapshot.GetResources(xds.EndpointType))
+	routes := copyResources(xdsSnapshot.GetResources(xds.RouteType))
+
+	lastClusters := lastSnapshot.GetResources(xds.ClusterType).Items
+	lastEndpoints := lastSnapshot.GetResources(xds.EndpointType).Items
+	collisions := translator.ClusterNameCollisions(glooSnapshot.Upstreams)
+	for _, up := range glooSnapshot.Upstreams {
+		err := reports[up]
+		if err == nil {
+			continue
+		}
+		if _, collides := collisions[up.Metadata.Ref()]; collides {
+			// the cluster is the cluster of another upstream
+			continue
+		}
+		clusterName := translator.UpstreamToClusterName(up.Metadata.Ref())
+		lastCluster, ok := lastClusters[clusterName]
+		if !ok {
+			continue
+		}
+		clusters.Items[clusterName] = lastCluster
+		if _, ok := endpoints.Items[clusterName]; !ok {
+			if lastEndpoint, ok := lastEndpoints[clusterName]; ok {
+				endpoints.Items[clusterName] = lastEndpoint
+			}
+		}
+		stale.Upstreams[up] = err
+		delete(reports, up)
+	}
+
+	lastRoutes := lastSnapshot.GetResources(xds.RouteType).Items
+	for _, proxy := range glooSnapshot.Proxies {
+		err := reports[proxy]
+		if err == nil {
+			continue
+		}
+		restored, ok := restoreVirtualHosts(routes, lastRoutes, err)
+		if !ok {
+			continue
+		}
+		routes = restored
+		stale.VirtualHosts[proxy] = err
+		delete(reports, proxy)
+	}
+
+	if len(stale.Upstreams) == 0 && len(stale.VirtualHosts) == 0 {
+		return xdsSnapshot, stale
+	}
+	return xds.NewSnapshotWithSecrets(
+		rehash(endpoints),
+		rehash(clusters),
+		rehash(routes),
+		xdsSnapshot.GetResources(xds.ListenerType),
+		restoreSecrets(xdsSnapshot, lastSnapshot, clusters),
+	), stale
+}
+
+// replaces the errored virtual hosts with their last accepted configuration. not ok if an error is not the error of a
+// virtual host, or if a virtual host has no last accepted configuration.
+func restoreVirtualHosts(routes envoycache.Resources, lastRoutes map[string]envoycache.Resource, err error) (envoycache.Resources, bool) {
+	errs := []error{err}
+	if merr, ok := err.(*multierror.Error); ok {
+		errs = merr.Errors
+	}
+
+	restored := copyResources(routes)
+	for _, err := range errs {
+		vhErr, ok := errors.Cause(err).(*translator.VirtualHostError)
+		if !ok {
+			return routes, false
+		}
+		routeConfig, ok := restored.Items[vhErr.RouteConfig]
+		if !ok {
+			return routes, false
+		}
+		lastRouteConfig, ok := lastRoutes[vhErr.RouteConfig]
+		if !ok {
+			return routes, false
+		}
+		lastVirtualHost := findVirtualHost(lastRouteConfig.ResourceProto().(*envoyapi.RouteConfiguration), vhErr.VirtualHost)
+		if lastVirtualHost == nil {
+			return routes, false
+		}
+		// the route configurations of the snapshot are not modified, they may be served already
+		cfg := proto.Clone(routeConfig.ResourceProto().(*envoyapi.RouteConfiguration)).(*envoyapi.RouteConfiguration)
+		for i := range cfg.VirtualHosts {
+			if cfg.VirtualHosts[i].Name == vhErr.VirtualHost {
+				cfg.VirtualHosts[i] = *lastVirtualHost
+			}
+		}
+		restored.Items[vhErr.RouteConfig] = xds.NewEnvoyResource(cfg)
+	}
+	return restored, true
+}
+
+func findVirtualHost(routeConfig *envoyapi.RouteConfiguration, name string) *envoyroute.VirtualHost {
+	for i := range routeConfig.VirtualHosts {
+		if routeConfig.VirtualHosts[i].Name == name {
+			return &routeConfig.VirtualHosts[i]
+		}
+	}
+	return nil
+}
+
+// Record keeps the snapshot accepted for the proxy
+func (l *LastKnownGood) Record(key string, xdsSnapshot envoycache.Snapshot) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	l.snapshots[key] = xdsSnapshot
+}
+
+// Retain forgets the snapshots of the proxies that are gone
+func (l *LastKnownGood) Retain(keys map[string]bool) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	for key := range l.snapshots {
+		if !keys[key] {
+			delete(l.snapshots, key)
+		}
+	}
+}
+
+// Errors returns the errors of the stale resources, to report along with the other errors
+func (s StaleResources) Errors() reporter.ResourceErrors {
+	errs := make(reporter.ResourceErrors)
+	for up, err := range s.Upstreams {
+		errs.AddError(up, errors.Wrapf(err, "serving the last accepted configuration of the upstream"))
+	}
+	for proxy, err := range s.VirtualHosts {
+		errs.AddError(proxy, errors.Wrapf(err, "serving the last accepted configuration of the errored virtual hosts"))
+	}
+	return errs
+}
+
+// the versions of the translated resources do not match the restored ones, version them by their content
+// the restored clusters keep the last accepted secrets they fetch by sds, if the secrets are no longer served
+func restoreSecrets(xdsSnapshot, lastSnapshot envoycache.Snapshot, clusters envoycache.Resources) envoycache.Resources {
+	secrets := copyResources(xdsSnapshot.GetResources(xds.SecretType))
+	lastSecrets := lastSnapshot.GetResources(xds.SecretType).Items
+	restored := false
+	for name := range xds.GetSdsSecretReferences(clusters.Items) {
+		if _, ok := secrets.Items[name]; ok {
+			continue
+		}
+		if lastSecret, ok := lastSecrets[name]; ok {
+			secrets.Items[name] = lastSecret
+			restored = true
+		}
+	}
+	if !restored {
+		return xdsSnapshot.GetResources(xds.SecretType)
+	}
+	return rehash(secrets)
+}
+
+func rehash(resources envoycache.Resources) envoycache.Resources {
+	version, err := hashstructure.Hash(resources.Items, nil)
+	if err != nil {
+		// keep the translated version
+		return resources
+	}
+	resources.Version = fmt.Sprintf("%v", version)
+	return resources
+}
+
+func copyResources(resources envoycache.Resources) envoycache.Resources {
+	items := make(map[string]envoycache.Resource, len(resources.Items))
+	for name, resource := range resources.Items {
+		items[name] = resource
+	}
+	return envoycache.Resources{
+		Version: resources.Version,
+		Items:   items,
+	}
+}
@@ -0,0 +1,134 @@
+package syncer_test
+
+import (
+	envoyapi "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	envoyroute "github.com/envoyproxy/go-control-plane/envoy/api/v2/route"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	. "github.com/solo-io/gloo/projects/gloo/pkg/syncer"
+	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
+	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
+	envoycache "github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
+	"github.com/solo-io/solo-kit/pkg/api/v1/reporter"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+func snapshotOf(clusters []*envoyapi.Cluster, routeConfigs ...*envoyapi.RouteConfiguration) envoycache.Snapshot {
+	var clusterItems, routeItems []envoycache.Resource
+	for _, cluster := range clusters {
+		clusterItems = append(clusterItems, xds.NewEnvoyResource(cluster))
+	}
+	for _, routeConfig := range routeConfigs {
+		routeItems = append(routeItems, xds.NewEnvoyResource(routeConfig))
+	}
+	return xds.NewSnapshotFromResources(
+		envoycache.NewResources("1", nil),
+		envoycache.NewResources("1", clusterItems),
+		envoycache.NewResources("1", routeItems),
+		envoycache.NewResources("1", nil),
+	)
+}
+
+func routeConfigWith(prefixes ...string) *envoyapi.RouteConfiguration {
+	routeConfig := &envoyapi.RouteConfiguration{Name: "listener-::-8080-routes"}
+	for _, prefix := range prefixes {
+		routeConfig.VirtualHosts = append(routeConfig.VirtualHosts, envoyroute.VirtualHost{
+			Name:    "vh" + prefix,
+			Domains: []string{"*"},
+			Routes: []envoyroute.Route{{
+				Match: envoyroute.RouteMatch{PathSpecifier: &envoyroute.RouteMatch_Prefix{Prefix: prefix}},
+			}},
+		})
+	}
+	return routeConfig
+}
+
+var _ = Describe("LastKnownGood", func() {
+	var (
+		lastKnownGood *LastKnownGood
+		upstream      *v1.Upstream
+		proxy         *v1.Proxy
+		glooSnapshot  *v1.ApiSnapshot
+		key           string
+	)
+
+	BeforeEach(func() {
+		lastKnownGood = NewLastKnownGood()
+		upstream = &v1.Upstream{Metadata: core.Metadata{Name: "up", Namespace: "gloo-system"}}
+		proxy = &v1.Proxy{Metadata: core.Metadata{Name: "proxy", Namespace: "gloo-system"}}
+		glooSnapshot = &v1.ApiSnapshot{
+			Upstreams: v1.UpstreamList{upstream},
+			Proxies:   v1.ProxyList{proxy},
+		}
+		key = xds.SnapshotKey(proxy)
+	})
+
+	It("serves the last accepted cluster of an errored upstream", func() {
+		clusterName := translator.UpstreamToClusterName(upstream.Metadata.Ref())
+		good := &envoyapi.Cluster{Name: clusterName, ConnectTimeout: translator.ClusterConnectionTimeout}
+		lastKnownGood.Record(key, snapshotOf([]*envoyapi.Cluster{good}))
+
+		reports := reporter.ResourceErrors{upstream: errors.Errorf("secret not found")}
+		snap, stale := lastKnownGood.Restore(key, glooSnapshot, snapshotOf([]*envoyapi.Cluster{{Name: clusterName}}), reports)
+
+		Expect(reports).NotTo(HaveKey(upstream))
+		Expect(stale.Upstreams).To(HaveKey(upstream))
+		Expect(snap.GetResources(xds.ClusterType).Items[clusterName].ResourceProto()).To(Equal(good))
+		Expect(stale.Errors()[upstream].Error()).To(ContainSubstring("serving the last accepted configuration of the upstream"))
+	})
+
+	It("does not restore upstreams that were never accepted", func() {
+		reports := reporter.ResourceErrors{upstream: errors.Errorf("secret not found")}
+		_, stale := lastKnownGood.Restore(key, glooSnapshot, snapshotOf(nil), reports)
+		Expect(reports).To(HaveKey(upstream))
+		Expect(stale.Upstreams).To(BeEmpty())
+	})
+
+	It("serves the last accepted configuration of an errored virtual host only", func() {
+		lastKnownGood.Record(key, snapshotOf(nil, routeConfigWith("/a", "/b")))
+
+		translated := routeConfigWith("/a", "/b")
+		translated.VirtualHosts[0].Routes[0].Match.PathSpecifier = &envoyroute.RouteMatch_Prefix{Prefix: "/broken"}
+		translated.VirtualHosts[1].Domains = []string{"b.com"}
+		reports := reporter.ResourceErrors{}
+		reports.AddError(proxy, errors.Wrapf(&translator.VirtualHostError{
+			RouteConfig: translated.Name,
+			VirtualHost: "vh/a",
+			Err:         errors.Errorf("upstream not found"),
+		}, "route_config.invalid route"))
+
+		snap, stale := lastKnownGood.Restore(key, glooSnapshot, snapshotOf(nil, translated), reports)
+		Expect(reports).NotTo(HaveKey(proxy))
+		Expect(stale.VirtualHosts).To(HaveKey(proxy))
+
+		restored := snap.GetResources(xds.RouteType).Items[translated.Name].ResourceProto().(*envoyapi.RouteConfiguration)
+		Expect(restored.VirtualHosts[0]).To(Equal(routeConfigWith("/a").VirtualHosts[0]))
+		Expect(restored.VirtualHosts[1].Domains).To(Equal([]string{"b.com"}))
+		// the translated configuration is left as is
+		Expect(translated.VirtualHosts[0].Routes[0].Match.GetPrefix()).To(Equal("/broken"))
+	})
+
+	It("does not restore the proxy when it has errors other than virtual host errors", func() {
+		lastKnownGood.Record(key, snapshotOf(nil, routeConfigWith("/a")))
+
+		reports := reporter.ResourceErrors{}
+		reports.AddError(proxy, &translator.VirtualHostError{RouteConfig: "listener-::-8080-routes", VirtualHost: "vh/a", Err: errors.Errorf("upstream not found")})
+		reports.AddError(proxy, errors.Errorf("invalid listener"))
+
+		_, stale := lastKnownGood.Restore(key, glooSnapshot, snapshotOf(nil, routeConfigWith("/a")), reports)
+		Expect(reports).To(HaveKey(proxy))
+		Expect(stale.VirtualHosts).To(BeEmpty())
+	})
+
+	It("forgets the proxies that are gone", func() {
+		clusterName := translator.UpstreamToClusterName(upstream.Metadata.Ref())
+		lastKnownGood.Record(key, snapshotOf([]*envoyapi.Cluster{{Name: clusterName}}))
+		lastKnownGood.Retain(map[string]bool{})
+
+		reports := reporter.ResourceErrors{upstream: errors.Errorf("secret not found")}
+		_, stale := lastKnownGood.Restore(key, glooSnapshot, snapshotOf(nil), reports)
+		Expect(stale.Upstreams).To(BeEmpty())
+	})
+})
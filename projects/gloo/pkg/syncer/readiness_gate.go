@@ -0,0 +1,189 @@
+package syncer
+
+import (
+	"net/http"
+	"sync"
+
+	envoyapi "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	envoycore "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
+	envoyroute "github.com/envoyproxy/go-control-plane/envoy/api/v2/route"
+	"github.com/gogo/protobuf/proto"
+	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
+	envoycache "github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
+)
+
+// ReadinessGate holds back the new routes of the proxies until the clusters of their destinations have endpoints:
+// a held back route answers with a 503 rather than being removed, so that its requests don't fall through to the
+// routes after it. Once served, a route is not held back anymore, even if its clusters lose their endpoints.
+type ReadinessGate struct {
+	lock sync.Mutex
+	// the routes served to each proxy, by route configuration and virtual host
+	live map[string]map[string]bool
+	// the snapshots served before the gate was created, nil if unknown
+	served servedSnapshots
+}
+
+// implemented by the snapshot caches that keep the snapshots they serve, such as xds.ServedSnapshotCache
+type servedSnapshots interface {
+	GetSnapshot(node string) (envoycache.Snapshot, error)
+}
+
+// the routes of a proxy that the gate doesn't know yet are seeded from the snapshot the xds cache serves, so that
+// the routes already served before a restart of the syncer are not held back
+func NewReadinessGate(xdsCache envoycache.SnapshotCache) *ReadinessGate {
+	served, _ := xdsCache.(servedSnapshots)
+	return &ReadinessGate{
+		live:   make(map[string]map[string]bool),
+		served: served,
+	}
+}
+
+// Gate replaces the new routes whose clusters are not ready with a 503 in the snapshot of the proxy. The routes it
+// doesn't hold back are recorded as served: Gate must be called with the snapshots that are served.
+func (g *ReadinessGate) Gate(key string, xdsSnapshot envoycache.Snapshot) (envoycache.Snapshot, int) {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+
+	ready := readyClusters(xdsSnapshot)
+	live, known := g.live[key]
+	if !known {
+		live = g.servedRoutes(key)
+	}
+	nowLive := make(map[string]bool)
+	heldBack := 0
+
+	routes := copyResources(xdsSnapshot.GetResources(xds.RouteType))
+	for name, resource := range routes.Items {
+		routeConfig := resource.ResourceProto().(*envoyapi.RouteConfiguration)
+		var gated *envoyapi.RouteConfiguration
+		for i, virtualHost := range routeConfig.VirtualHosts {
+			for j, route := range virtualHost.Routes {
+				id := routeId(name, virtualHost.Name, route)
+				if live[id] || routeReady(route, ready) {
+					nowLive[id] = true
+					continue
+				}
+				heldBack++
+				if gated == nil {
+					// the route configurations of the snapshot are not modified
+					gated = proto.Clone(routeConfig).(*envoyapi.RouteConfiguration)
+				}
+				gated.VirtualHosts[i].Routes[j].Action = heldBackAction()
+			}
+		}
+		if gated != nil {
+			routes.Items[name] = xds.NewEnvoyResource(gated)
+		}
+	}
+	g.live[key] = nowLive
+
+	if heldBack == 0 {
+		return xdsSnapshot, 0
+	}
+	return xds.NewSnapshotWithSecrets(
+		xdsSnapshot.GetResources(xds.EndpointType),
+		xdsSnapshot.GetResources(xds.ClusterType),
+		rehash(routes),
+		xdsSnapshot.GetResources(xds.ListenerType),
+		xdsSnapshot.GetResources(xds.SecretType),
+	), heldBack
+}
+
+// a held back route answers like a route whose clusters have no endpoints, rather than falling through
+func heldBackAction() *envoyroute.Route_DirectResponse {
+	return &envoyroute.Route_DirectResponse{DirectResponse: &envoyroute.DirectResponseAction{
+		Status: http.StatusServiceUnavailable,
+	}}
+}
+
+// the routes to clusters of the snapshot served to the proxy. The held back routes are direct responses and are not
+// seeded: they are gated again.
+func (g *ReadinessGate) servedRoutes(key string) map[string]bool {
+	if g.served == nil {
+		return nil
+	}
+	served, err := g.served.GetSnapshot(key)
+	if err != nil || served == nil {
+		return nil
+	}
+	live := make(map[string]bool)
+	for name, resource := range served.GetResources(xds.RouteType).Items {
+		routeConfig := resource.ResourceProto().(*envoyapi.RouteConfiguration)
+		for _, virtualHost := range routeConfig.VirtualHosts {
+			for _, route := range virtualHost.Routes {
+				if route.GetRoute() != nil {
+					live[routeId(name, virtualHost.Name, route)] = true
+				}
+			}
+		}
+	}
+	return live
+}
+
+// Retain forgets the routes of the proxies that are gone
+func (g *ReadinessGate) Retain(keys map[string]bool) {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+	for key := range g.live {
+		if !keys[key] {
+			delete(g.live, key)
+		}
+	}
+}
+
+// a route is identified by its match: a route whose action changed is still the same route
+func routeId(routeConfig, virtualHost string, route envoyroute.Route) string {
+	match, err := proto.Marshal(&route.Match)
+	if err != nil {
+		match = []byte(route.Match.String())
+	}
+	return routeConfig + "/" + virtualHost + "/" + string(match)
+}
+
+func routeReady(route envoyroute.Route, ready map[string]bool) bool {
+	action := route.GetRoute()
+	if action == nil {
+		// redirects and direct responses have no destination
+		return true
+	}
+	if cluster := action.GetCluster(); cluster != "" {
+		return ready[cluster]
+	}
+	for _, weighted := range action.GetWeightedClusters().GetClusters() {
+		if !ready[weighted.Name] {
+			return false
+		}
+	}
+	// the clusters of the cluster header are only known at request time
+	return true
+}
+
+// the clusters are ready if they have healthy endpoints, or if envoy resolves their hosts itself
+func readyClusters(xdsSnapshot envoycache.Snapshot) map[string]bool {
+	endpoints := xdsSnapshot.GetResources(xds.EndpointType).Items
+	ready := make(map[string]bool)
+	for name, resource := range xdsSnapshot.GetResources(xds.ClusterType).Items {
+		cluster := resource.ResourceProto().(*envoyapi.Cluster)
+		if cluster.GetClusterType() != nil || cluster.GetType() != envoyapi.Cluster_EDS {
+			ready[name] = true
+			continue
+		}
+		if loadAssignment, ok := endpoints[cluster.Name]; ok {
+			ready[name] = hasHealthyEndpoints(loadAssignment.ResourceProto().(*envoyapi.ClusterLoadAssignment))
+		}
+	}
+	return ready
+}
+
+func hasHealthyEndpoints(loadAssignment *envoyapi.ClusterLoadAssignment) bool {
+	for _, locality := range loadAssignment.Endpoints {
+		for _, endpoint := range locality.LbEndpoints {
+			switch endpoint.HealthStatus {
+			case envoycore.HealthStatus_UNHEALTHY, envoycore.HealthStatus_DRAINING:
+				continue
+			}
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,140 @@
+package syncer_test
+
+import (
+	envoyapi "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	envoycore "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
+	envoyendpoint "github.com/envoyproxy/go-control-plane/envoy/api/v2/endpoint"
+	envoyroute "github.com/envoyproxy/go-control-plane/envoy/api/v2/route"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/solo-io/gloo/projects/gloo/pkg/syncer"
+	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
+	envoycache "github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
+)
+
+func routeTo(prefix, cluster string) envoyroute.Route {
+	return envoyroute.Route{
+		Match: envoyroute.RouteMatch{PathSpecifier: &envoyroute.RouteMatch_Prefix{Prefix: prefix}},
+		Action: &envoyroute.Route_Route{Route: &envoyroute.RouteAction{
+			ClusterSpecifier: &envoyroute.RouteAction_Cluster{Cluster: cluster},
+		}},
+	}
+}
+
+func gatedSnapshot(healthy map[string]bool, routes ...envoyroute.Route) envoycache.Snapshot {
+	var clusters, endpoints []envoycache.Resource
+	for name, isHealthy := range healthy {
+		clusters = append(clusters, xds.NewEnvoyResource(&envoyapi.Cluster{
+			Name:                 name,
+			ClusterDiscoveryType: &envoyapi.Cluster_Type{Type: envoyapi.Cluster_EDS},
+		}))
+		loadAssignment := &envoyapi.ClusterLoadAssignment{ClusterName: name}
+		if isHealthy {
+			loadAssignment.Endpoints = []envoyendpoint.LocalityLbEndpoints{{
+				LbEndpoints: []envoyendpoint.LbEndpoint{{HealthStatus: envoycore.HealthStatus_HEALTHY}},
+			}}
+		}
+		endpoints = append(endpoints, xds.NewEnvoyResource(loadAssignment))
+	}
+	routeConfig := &envoyapi.RouteConfiguration{
+		Name: "listener-::-8080-routes",
+		VirtualHosts: []envoyroute.VirtualHost{{
+			Name:    "vh",
+			Domains: []string{"*"},
+			Routes:  routes,
+		}},
+	}
+	return xds.NewSnapshotFromResources(
+		envoycache.NewResources("1", endpoints),
+		envoycache.NewResources("1", clusters),
+		envoycache.NewResources("1", []envoycache.Resource{xds.NewEnvoyResource(routeConfig)}),
+		envoycache.NewResources("1", nil),
+	)
+}
+
+// the prefixes of the routes to clusters
+func servedPrefixes(snap envoycache.Snapshot) []string {
+	var prefixes []string
+	for _, route := range gatedRoutes(snap) {
+		if route.GetRoute() != nil {
+			prefixes = append(prefixes, route.Match.GetPrefix())
+		}
+	}
+	return prefixes
+}
+
+// the prefixes of the routes answering with a 503
+func heldBackPrefixes(snap envoycache.Snapshot) []string {
+	var prefixes []string
+	for _, route := range gatedRoutes(snap) {
+		if route.GetDirectResponse().GetStatus() == 503 {
+			prefixes = append(prefixes, route.Match.GetPrefix())
+		}
+	}
+	return prefixes
+}
+
+func gatedRoutes(snap envoycache.Snapshot) []envoyroute.Route {
+	routeConfig := snap.GetResources(xds.RouteType).Items["listener-::-8080-routes"].ResourceProto().(*envoyapi.RouteConfiguration)
+	return routeConfig.VirtualHosts[0].Routes
+}
+
+var _ = Describe("ReadinessGate", func() {
+	var gate *ReadinessGate
+
+	BeforeEach(func() {
+		gate = NewReadinessGate(&mockXdsCache{})
+	})
+
+	It("holds back the new routes until their clusters have healthy endpoints", func() {
+		snap, heldBack := gate.Gate("proxy", gatedSnapshot(map[string]bool{"ready": true, "starting": false},
+			routeTo("/a", "ready"), routeTo("/b", "starting")))
+		Expect(heldBack).To(Equal(1))
+		Expect(servedPrefixes(snap)).To(Equal([]string{"/a"}))
+		Expect(heldBackPrefixes(snap)).To(Equal([]string{"/b"}))
+
+		snap, heldBack = gate.Gate("proxy", gatedSnapshot(map[string]bool{"ready": true, "starting": true},
+			routeTo("/a", "ready"), routeTo("/b", "starting")))
+		Expect(heldBack).To(Equal(0))
+		Expect(servedPrefixes(snap)).To(Equal([]string{"/a", "/b"}))
+		Expect(heldBackPrefixes(snap)).To(BeEmpty())
+	})
+
+	It("answers with a 503 rather than falling through to the next routes", func() {
+		snap, _ := gate.Gate("proxy", gatedSnapshot(map[string]bool{"ready": true, "starting": false},
+			routeTo("/a/b", "starting"), routeTo("/a", "ready")))
+		routes := gatedRoutes(snap)
+		Expect(routes).To(HaveLen(2))
+		Expect(routes[0].Match.GetPrefix()).To(Equal("/a/b"))
+		Expect(routes[0].GetDirectResponse().GetStatus()).To(Equal(uint32(503)))
+	})
+
+	It("does not hold back the routes served before a restart", func() {
+		served := xds.NewServedSnapshotCache(&mockXdsCache{})
+		snap, _ := gate.Gate("proxy", gatedSnapshot(map[string]bool{"ready": true, "starting": false},
+			routeTo("/a", "ready"), routeTo("/b", "starting")))
+		Expect(served.SetSnapshot("proxy", snap)).NotTo(HaveOccurred())
+
+		snap, heldBack := NewReadinessGate(served).Gate("proxy", gatedSnapshot(map[string]bool{"ready": false, "starting": false},
+			routeTo("/a", "ready"), routeTo("/b", "starting")))
+		Expect(heldBack).To(Equal(1))
+		Expect(servedPrefixes(snap)).To(Equal([]string{"/a"}))
+		Expect(heldBackPrefixes(snap)).To(Equal([]string{"/b"}))
+	})
+
+	It("does not hold back the routes without destination", func() {
+		redirect := envoyroute.Route{
+			Match:  envoyroute.RouteMatch{PathSpecifier: &envoyroute.RouteMatch_Prefix{Prefix: "/redirect"}},
+			Action: &envoyroute.Route_Redirect{Redirect: &envoyroute.RedirectAction{HostRedirect: "solo.io"}},
+		}
+		_, heldBack := gate.Gate("proxy", gatedSnapshot(nil, redirect))
+		Expect(heldBack).To(Equal(0))
+	})
+
+	It("gates the routes of each proxy separately", func() {
+		gate.Gate("proxy", gatedSnapshot(map[string]bool{"ready": true}, routeTo("/a", "ready")))
+
+		_, heldBack := gate.Gate("other-proxy", gatedSnapshot(map[string]bool{"ready": false}, routeTo("/a", "ready")))
+		Expect(heldBack).To(Equal(1))
+	})
+})
@@ -0,0 +1,54 @@
+package syncer
+
+import (
+	"context"
+	"sync"
+
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/go-utils/contextutils"
+)
+
+// ResyncingSyncer syncs the last snapshot again when signaled, for the translations depending on state outside of the
+// snapshots, e.g. the wasm images pulled in the background.
+type ResyncingSyncer struct {
+	syncer v1.ApiSyncer
+
+	// serializes the syncs of the event loop and the resyncs
+	lock     sync.Mutex
+	lastSnap *v1.ApiSnapshot
+}
+
+func NewResyncingSyncer(syncer v1.ApiSyncer) *ResyncingSyncer {
+	return &ResyncingSyncer{syncer: syncer}
+}
+
+func (s *ResyncingSyncer) Sync(ctx context.Context, snap *v1.ApiSnapshot) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.lastSnap = snap
+	return s.syncer.Sync(ctx, snap)
+}
+
+// ResyncOn syncs the last snapshot again on every signal until the context is done
+func (s *ResyncingSyncer) ResyncOn(ctx context.Context, signals <-chan struct{}) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-signals:
+		}
+		if err := s.resync(ctx); err != nil {
+			contextutils.LoggerFrom(ctx).Errorf("resyncing the last snapshot failed: %v", err)
+		}
+	}
+}
+
+func (s *ResyncingSyncer) resync(ctx context.Context) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.lastSnap == nil {
+		// the first sync has not happened yet
+		return nil
+	}
+	return s.syncer.Sync(ctx, s.lastSnap)
+}
@@ -0,0 +1,58 @@
+package syncer_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+
+	. "github.com/solo-io/gloo/projects/gloo/pkg/syncer"
+)
+
+type recordingSyncer struct {
+	synced chan *v1.ApiSnapshot
+}
+
+func (s *recordingSyncer) Sync(_ context.Context, snap *v1.ApiSnapshot) error {
+	s.synced <- snap
+	return nil
+}
+
+var _ = Describe("ResyncingSyncer", func() {
+
+	var (
+		ctx     context.Context
+		cancel  context.CancelFunc
+		inner   *recordingSyncer
+		signals chan struct{}
+		syncer  *ResyncingSyncer
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		inner = &recordingSyncer{synced: make(chan *v1.ApiSnapshot, 10)}
+		signals = make(chan struct{})
+		syncer = NewResyncingSyncer(inner)
+		go syncer.ResyncOn(ctx, signals)
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	It("should sync the last snapshot again when signaled", func() {
+		snap := &v1.ApiSnapshot{}
+		err := syncer.Sync(ctx, snap)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(inner.synced).To(Receive(BeIdenticalTo(snap)))
+
+		signals <- struct{}{}
+		Eventually(inner.synced).Should(Receive(BeIdenticalTo(snap)))
+	})
+
+	It("should not resync before the first sync", func() {
+		signals <- struct{}{}
+		Consistently(inner.synced).ShouldNot(Receive())
+	})
+})
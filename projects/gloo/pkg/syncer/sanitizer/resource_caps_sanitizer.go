@@ -0,0 +1,41 @@
+package sanitizer
+
+import (
+	"context"
+
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
+	envoycache "github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
+	"github.com/solo-io/solo-kit/pkg/api/v1/reporter"
+	"github.com/solo-io/solo-kit/pkg/errors"
+)
+
+// ResourceCapsSanitizer rejects the snapshots with more resources of a type than its cap
+type ResourceCapsSanitizer struct {
+	caps v1.XdsSanitization_ResourceCaps
+}
+
+func NewResourceCapsSanitizer(caps v1.XdsSanitization_ResourceCaps) *ResourceCapsSanitizer {
+	return &ResourceCapsSanitizer{caps: caps}
+}
+
+func (s *ResourceCapsSanitizer) SanitizeSnapshot(ctx context.Context, glooSnapshot *v1.ApiSnapshot, xdsSnapshot envoycache.Snapshot, reports reporter.ResourceErrors) (envoycache.Snapshot, error) {
+	for _, resourceCap := range []struct {
+		name string
+		typ  string
+		max  uint32
+	}{
+		{"clusters", xds.ClusterType, s.caps.MaxClusters},
+		{"endpoints", xds.EndpointType, s.caps.MaxEndpoints},
+		{"listeners", xds.ListenerType, s.caps.MaxListeners},
+		{"routes", xds.RouteType, s.caps.MaxRoutes},
+	} {
+		if resourceCap.max == 0 {
+			continue
+		}
+		if count := len(xdsSnapshot.GetResources(resourceCap.typ).Items); count > int(resourceCap.max) {
+			return nil, errors.Errorf("the snapshot has %v %v, more than the cap of %v", count, resourceCap.name, resourceCap.max)
+		}
+	}
+	return xdsSnapshot, nil
+}
@@ -0,0 +1,81 @@
+package sanitizer
+
+import (
+	"context"
+
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	envoycache "github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
+	"github.com/solo-io/solo-kit/pkg/api/v1/reporter"
+)
+
+// an XdsSanitizer modifies or rejects the xDS snapshot of a proxy before it is served to envoy.
+// a sanitizer returns an error to reject the snapshot, in which case the proxy keeps its last accepted snapshot.
+type XdsSanitizer interface {
+	SanitizeSnapshot(ctx context.Context, glooSnapshot *v1.ApiSnapshot, xdsSnapshot envoycache.Snapshot, reports reporter.ResourceErrors) (envoycache.Snapshot, error)
+}
+
+// XdsSanitizers is a chain of sanitizers, applied in order
+type XdsSanitizers []XdsSanitizer
+
+func (s XdsSanitizers) SanitizeSnapshot(ctx context.Context, glooSnapshot *v1.ApiSnapshot, xdsSnapshot envoycache.Snapshot, reports reporter.ResourceErrors) (envoycache.Snapshot, error) {
+	for _, sanitizer := range s {
+		var err error
+		xdsSnapshot, err = sanitizer.SanitizeSnapshot(ctx, glooSnapshot, xdsSnapshot, reports)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return xdsSnapshot, nil
+}
+
+// RoleSanitizers are the sanitizers of the proxies with the given roles
+type RoleSanitizers struct {
+	// the roles of the proxies, as namespace~name. the sanitizers apply to all proxies if empty.
+	ProxyRoles []string
+	Sanitizers XdsSanitizers
+}
+
+func (r RoleSanitizers) matches(role string) bool {
+	if len(r.ProxyRoles) == 0 {
+		return true
+	}
+	for _, proxyRole := range r.ProxyRoles {
+		if proxyRole == role {
+			return true
+		}
+	}
+	return false
+}
+
+// XdsSanitizersByRole selects the sanitizers of each proxy by its role
+type XdsSanitizersByRole []RoleSanitizers
+
+// ForRole chains the sanitizers of every entry matching the role, in order
+func (s XdsSanitizersByRole) ForRole(role string) XdsSanitizers {
+	var sanitizers XdsSanitizers
+	for _, entry := range s {
+		if entry.matches(role) {
+			sanitizers = append(sanitizers, entry.Sanitizers...)
+		}
+	}
+	return sanitizers
+}
+
+// FromSettings builds the sanitizers configured in the settings
+func FromSettings(settings *v1.Settings) XdsSanitizersByRole {
+	var byRole XdsSanitizersByRole
+	for _, sanitization := range settings.GetXdsSanitization() {
+		var sanitizers XdsSanitizers
+		if sanitization.StripSecrets {
+			sanitizers = append(sanitizers, NewSecretStrippingSanitizer())
+		}
+		if caps := sanitization.ResourceCaps; caps != nil {
+			sanitizers = append(sanitizers, NewResourceCapsSanitizer(*caps))
+		}
+		byRole = append(byRole, RoleSanitizers{
+			ProxyRoles: sanitization.ProxyRoles,
+			Sanitizers: sanitizers,
+		})
+	}
+	return byRole
+}
@@ -0,0 +1,161 @@
+package sanitizer
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/types"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/openwhisk"
+	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
+	"github.com/solo-io/go-utils/contextutils"
+	envoycache "github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
+	"github.com/solo-io/solo-kit/pkg/api/v1/reporter"
+)
+
+// values shorter than this are not looked for, as they would match unrelated resources
+const minSecretValueLength = 8
+
+// SecretStrippingSanitizer removes the resources carrying the value of a secret, for proxies that are not trusted with secrets.
+// the listeners whose route configurations were removed, and the endpoints of the removed clusters, are removed too.
+// the secrets served by sds are not kept, so the clusters and listeners fetching them are removed too.
+type SecretStrippingSanitizer struct{}
+
+func NewSecretStrippingSanitizer() *SecretStrippingSanitizer {
+	return &SecretStrippingSanitizer{}
+}
+
+func (s *SecretStrippingSanitizer) SanitizeSnapshot(ctx context.Context, glooSnapshot *v1.ApiSnapshot, xdsSnapshot envoycache.Snapshot, reports reporter.ResourceErrors) (envoycache.Snapshot, error) {
+	values := secretValues(glooSnapshot.Secrets)
+	if len(values) == 0 {
+		return xdsSnapshot, nil
+	}
+	logger := contextutils.LoggerFrom(ctx)
+
+	strip := func(typ string) (envoycache.Resources, map[string]bool) {
+		resources := copyResources(xdsSnapshot.GetResources(typ))
+		stripped := make(map[string]bool)
+		for name, resource := range resources.Items {
+			carries, err := carriesSecret(resource, values)
+			if err != nil || carries {
+				logger.Debugf("stripping %v %v from the snapshot", typ, name)
+				stripped[name] = true
+				delete(resources.Items, name)
+			}
+		}
+		return resources, stripped
+	}
+
+	clusters, _ := strip(xds.ClusterType)
+	endpoints, _ := strip(xds.EndpointType)
+	routes, strippedRoutes := strip(xds.RouteType)
+	listeners, _ := strip(xds.ListenerType)
+
+	// the secrets served by sds are stripped, so the clusters and listeners cannot fetch them
+	for _, resources := range []envoycache.Resources{clusters, listeners} {
+		for name, resource := range resources.Items {
+			if len(xds.GetSdsSecretReferences(map[string]envoycache.Resource{name: resource})) > 0 {
+				logger.Debugf("stripping %v from the snapshot, as it fetches secrets by sds", name)
+				delete(resources.Items, name)
+			}
+		}
+	}
+
+	// the listeners cannot serve without their route configurations
+	for name, listener := range listeners.Items {
+		for route := range xds.GetResourceReferences(map[string]envoycache.Resource{name: listener}) {
+			if strippedRoutes[route] {
+				delete(listeners.Items, name)
+				break
+			}
+		}
+	}
+
+	// remove what is no longer referenced, to keep the snapshot consistent
+	referencedEndpoints := xds.GetResourceReferences(clusters.Items)
+	for name := range endpoints.Items {
+		if !referencedEndpoints[name] {
+			delete(endpoints.Items, name)
+		}
+	}
+	referencedRoutes := xds.GetResourceReferences(listeners.Items)
+	for name := range routes.Items {
+		if !referencedRoutes[name] {
+			delete(routes.Items, name)
+		}
+	}
+
+	return xds.NewSnapshotFromResources(endpoints, clusters, routes, listeners), nil
+}
+
+func carriesSecret(resource envoycache.Resource, values [][]byte) (bool, error) {
+	// the strings of a proto, including those in any and struct fields, are serialized as is
+	serialized, err := proto.Marshal(resource.ResourceProto())
+	if err != nil {
+		return false, err
+	}
+	for _, value := range values {
+		if bytes.Contains(serialized, value) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func secretValues(secrets v1.SecretList) [][]byte {
+	var values [][]byte
+	add := func(strs ...string) {
+		for _, str := range strs {
+			if len(str) >= minSecretValueLength {
+				values = append(values, []byte(str))
+			}
+		}
+	}
+	for _, secret := range secrets {
+		switch kind := secret.Kind.(type) {
+		case *v1.Secret_Aws:
+			add(kind.Aws.AccessKey, kind.Aws.SecretKey)
+		case *v1.Secret_Azure:
+			for _, key := range kind.Azure.ApiKeys {
+				add(key)
+			}
+			add(kind.Azure.ClientSecret)
+		case *v1.Secret_Tls:
+			// the certificates are public
+			add(kind.Tls.PrivateKey)
+		case *v1.Secret_Extension:
+			add(structStrings(kind.Extension.GetConfig())...)
+		case *v1.Secret_Alibaba:
+			add(kind.Alibaba.AccessKeySecret)
+		case *v1.Secret_Openwhisk:
+			// the clusters carry the api key in the authorization header of the requests
+			add(kind.Openwhisk.ApiKey, openwhisk.GetApiKeyBasicAuth(kind.Openwhisk.ApiKey))
+		}
+	}
+	return values
+}
+
+func structStrings(s *types.Struct) []string {
+	var strs []string
+	for _, value := range s.GetFields() {
+		strs = append(strs, valueStrings(value)...)
+	}
+	return strs
+}
+
+func valueStrings(value *types.Value) []string {
+	switch kind := value.GetKind().(type) {
+	case *types.Value_StringValue:
+		return []string{kind.StringValue}
+	case *types.Value_StructValue:
+		return structStrings(kind.StructValue)
+	case *types.Value_ListValue:
+		var strs []string
+		for _, v := range kind.ListValue.GetValues() {
+			strs = append(strs, valueStrings(v)...)
+		}
+		return strs
+	}
+	return nil
+}
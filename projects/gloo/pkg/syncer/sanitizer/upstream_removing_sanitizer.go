@@ -0,0 +1,84 @@
+package sanitizer
+
+import (
+	"context"
+
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
+	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
+	"github.com/solo-io/go-utils/contextutils"
+	envoycache "github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
+	"github.com/solo-io/solo-kit/pkg/api/v1/reporter"
+)
+
+// UpstreamRemovingSanitizer drops the clusters of the errored upstreams, and keeps the listeners and routes.
+// the snapshot is rejected if it is not consistent without them, or if resources other than upstreams errored.
+type UpstreamRemovingSanitizer struct{}
+
+func NewUpstreamRemovingSanitizer() *UpstreamRemovingSanitizer {
+	return &UpstreamRemovingSanitizer{}
+}
+
+func (s *UpstreamRemovingSanitizer) SanitizeSnapshot(ctx context.Context, glooSnapshot *v1.ApiSnapshot, xdsSnapshot envoycache.Snapshot, reports reporter.ResourceErrors) (envoycache.Snapshot, error) {
+
+	// find all the errored upstreams and remove them. if the snapshot is still consistent, we are good to go
+	resourcesErr := reports.Validate()
+	if resourcesErr == nil {
+		return xdsSnapshot, nil
+	}
+
+	contextutils.LoggerFrom(ctx).Debug("removing errored upstream and checking consistency")
+
+	clusters := copyResources(xdsSnapshot.GetResources(xds.ClusterType))
+	endpoints := copyResources(xdsSnapshot.GetResources(xds.EndpointType))
+
+	collisions := translator.ClusterNameCollisions(glooSnapshot.Upstreams)
+	for _, up := range glooSnapshot.Upstreams.AsInputResources() {
+		if _, collides := collisions[up.GetMetadata().Ref()]; collides {
+			// the cluster is the cluster of another upstream
+			continue
+		}
+		if reports[up] != nil {
+			clusterName := translator.UpstreamToClusterName(up.GetMetadata().Ref())
+			// remove cluster and endpoints
+			delete(clusters.Items, clusterName)
+			delete(endpoints.Items, clusterName)
+		}
+	}
+
+	xdsSnapshot = xds.NewSnapshotWithSecrets(
+		endpoints,
+		clusters,
+		xdsSnapshot.GetResources(xds.RouteType),
+		xdsSnapshot.GetResources(xds.ListenerType),
+		xdsSnapshot.GetResources(xds.SecretType),
+	)
+
+	if xdsSnapshot.Consistent() != nil {
+		return nil, resourcesErr
+	}
+
+	// snapshot is consistent, so check if we have errors not related to the upstreams
+	remaining := make(reporter.ResourceErrors)
+	remaining.Merge(reports)
+	for _, up := range glooSnapshot.Upstreams.AsInputResources() {
+		delete(remaining, up)
+	}
+
+	if err := remaining.Validate(); err != nil {
+		return nil, err
+	}
+	return xdsSnapshot, nil
+}
+
+// copyResources copies the items of the resources, so that removing some does not modify the original snapshot
+func copyResources(resources envoycache.Resources) envoycache.Resources {
+	items := make(map[string]envoycache.Resource, len(resources.Items))
+	for name, resource := range resources.Items {
+		items[name] = resource
+	}
+	return envoycache.Resources{
+		Version: resources.Version,
+		Items:   items,
+	}
+}
@@ -6,20 +6,28 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/solo-io/go-utils/kubeutils"
+	"github.com/solo-io/solo-kit/pkg/api/external/kubernetes/service"
 	corecache "github.com/solo-io/solo-kit/pkg/api/v1/clients/kube/cache"
+	skkube "github.com/solo-io/solo-kit/pkg/api/v1/resources/common/kubernetes"
 
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/aws"
 
 	"github.com/gogo/protobuf/types"
 	grpc_zap "github.com/grpc-ecosystem/go-grpc-middleware/logging/zap"
+	consulapi "github.com/hashicorp/consul/api"
 	"github.com/solo-io/gloo/pkg/utils"
 	"github.com/solo-io/gloo/pkg/utils/setuputils"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/gloo/pkg/bootstrap"
 	"github.com/solo-io/gloo/projects/gloo/pkg/defaults"
 	"github.com/solo-io/gloo/projects/gloo/pkg/discovery"
+	"github.com/solo-io/gloo/projects/gloo/pkg/imagecache"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/registry"
+	"github.com/solo-io/gloo/projects/gloo/pkg/syncer/sanitizer"
 	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
+	"github.com/solo-io/gloo/projects/gloo/pkg/upstreams"
 	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
 	"github.com/solo-io/go-utils/contextutils"
 	"github.com/solo-io/go-utils/errutils"
@@ -96,7 +104,7 @@ func NewControlPlane(ctx context.Context, grpcServer *grpc.Server, callbacks xds
 	var c bootstrap.ControlPlane
 	c.GrpcServer = grpcServer
 	hasher := &xds.ProxyKeyHasher{}
-	snapshotCache := cache.NewSnapshotCache(true, hasher, contextutils.LoggerFrom(ctx))
+	snapshotCache := xds.NewServedSnapshotCache(cache.NewSnapshotCache(true, hasher, contextutils.LoggerFrom(ctx)))
 	xdsServer := server.NewServer(snapshotCache, callbacks)
 	envoyv2.RegisterAggregatedDiscoveryServiceServer(c.GrpcServer, xdsServer)
 	c.SnapshotCache = snapshotCache
@@ -173,9 +181,15 @@ func (s *setupSyncer) Setup(ctx context.Context, kubeCache kube.SharedCache, mem
 }
 
 type Extensions struct {
+	// shared by all the translations: the proxies are translated one at a time when they are set, as the plugins keep
+	// state while translating a proxy
 	PluginExtensions []plugins.Plugin
-	SyncerExtensions []TranslatorSyncerExtensionFactory
-	XdsCallbacks     xdsserver.Callbacks
+	// returns new instances of the extension plugins, for each of the workers translating the proxies concurrently
+	PluginExtensionsFactory func() []plugins.Plugin
+	SyncerExtensions        []TranslatorSyncerExtensionFactory
+	XdsCallbacks            xdsserver.Callbacks
+	// sanitize the xDS snapshots after the sanitizers configured in the settings
+	XdsSanitizers sanitizer.XdsSanitizersByRole
 }
 
 func RunGloo(opts bootstrap.Opts) error {
@@ -198,6 +212,37 @@ func RunGlooWithExtensions(opts bootstrap.Opts, extensions Extensions) error {
 	if err := upstreamClient.Register(); err != nil {
 		return err
 	}
+	// the upstreams of the files and of the consul services are merged with those of the config source, and are read only
+	var fileSource *upstreams.FileUpstreamSource
+	if dir := opts.Settings.GetUpstreamDirectory().GetDirectory(); dir != "" {
+		fileSource = upstreams.NewFileUpstreamSource(dir, opts.WriteNamespace)
+	}
+	var consulSource *upstreams.ConsulUpstreamSource
+	if opts.Settings.GetConsulServiceUpstreams() {
+		consulClient, err := consulapi.NewClient(consulapi.DefaultConfig())
+		if err != nil {
+			return errors.Wrapf(err, "creating consul client")
+		}
+		consulSource = upstreams.NewConsulUpstreamSource(upstreams.NewConsulServiceClient(consulClient), opts.WriteNamespace)
+	}
+	var serviceClient skkube.ServiceClient
+	if opts.Settings.GetKubeServiceUpstreams() != nil {
+		serviceClient, err = kubeServiceClient(watchOpts.Ctx, opts.KubeClient)
+		if err != nil {
+			return errors.Wrapf(err, "creating kube service client")
+		}
+	}
+	if serviceClient != nil || fileSource != nil || consulSource != nil {
+		upstreamClient, err = upstreams.NewHybridUpstreamClient(upstreamClient, upstreams.HybridUpstreamOptions{
+			ServiceClient:     serviceClient,
+			ServiceNamespaces: upstreams.NewNamespaceFilter(opts.Settings.GetKubeServiceUpstreams()),
+			FileSource:        fileSource,
+			ConsulSource:      consulSource,
+		})
+		if err != nil {
+			return err
+		}
+	}
 
 	proxyClient, err := v1.NewProxyClient(opts.Proxies)
 	if err != nil {
@@ -238,7 +283,23 @@ func RunGlooWithExtensions(opts bootstrap.Opts, extensions Extensions) error {
 
 	rpt := reporter.NewReporter("gloo", upstreamClient.BaseClient(), proxyClient.BaseClient())
 
-	plugins := registry.Plugins(opts, extensions.PluginExtensions...)
+	if wasmOpts := opts.Settings.GetWasm(); wasmOpts != nil && opts.WasmImageCache == nil {
+		opts.WasmImageCache = imagecache.New(watchOpts.Ctx)
+		go func() {
+			if err := opts.WasmImageCache.Serve(watchOpts.Ctx, imagecache.BindAddr(wasmOpts)); err != nil {
+				contextutils.LoggerFrom(watchOpts.Ctx).Errorf("wasm image cache failed: %v", err)
+			}
+		}()
+	}
+
+	newPlugins := func() []plugins.Plugin {
+		pluginExtensions := extensions.PluginExtensions
+		if extensions.PluginExtensionsFactory != nil {
+			pluginExtensions = append(extensions.PluginExtensionsFactory(), pluginExtensions...)
+		}
+		return registry.Plugins(opts, pluginExtensions...)
+	}
+	plugins := newPlugins()
 
 	var discoveryPlugins []discovery.DiscoveryPlugin
 	for _, plug := range plugins {
@@ -262,7 +323,22 @@ func RunGlooWithExtensions(opts bootstrap.Opts, extensions Extensions) error {
 		syncerExtensions = append(syncerExtensions, syncerExtension)
 	}
 
-	apiSync := NewTranslatorSyncer(translator.NewTranslator(plugins, opts.Settings), opts.ControlPlane.SnapshotCache, xdsHasher, rpt, opts.DevMode, syncerExtensions)
+	sanitizers := append(sanitizer.FromSettings(opts.Settings), extensions.XdsSanitizers...)
+	workers := proxyTranslationWorkers(opts.Settings)
+	if len(extensions.PluginExtensions) > 0 {
+		// the workers would share the instances of the extension plugins
+		workers = 1
+	}
+	translatorPool := translator.NewTranslatorPool(newPlugins, opts.Settings, workers)
+	var apiSync v1.ApiSyncer = NewTranslatorSyncer(translatorPool, opts.ControlPlane.SnapshotCache, xdsHasher, rpt, opts.DevMode, syncerExtensions, sanitizers, opts.Settings)
+	resyncingSync := NewResyncingSyncer(apiSync)
+	// the aws upstreams are translated again before their temporary credentials expire
+	go resyncingSync.ResyncOn(watchOpts.Ctx, aws.CredentialsRefreshes())
+	if opts.WasmImageCache != nil {
+		// the proxies referencing wasm images are translated again once the images are pulled
+		go resyncingSync.ResyncOn(watchOpts.Ctx, opts.WasmImageCache.Pulled())
+	}
+	apiSync = resyncingSync
 	apiEventLoop := v1.NewApiEventLoop(apiCache, apiSync)
 
 	errs := make(chan error)
@@ -314,6 +390,25 @@ func RunGlooWithExtensions(opts bootstrap.Opts, extensions Extensions) error {
 	return nil
 }
 
+// reads the services of the kube service upstreams from a cache of the kube core resources
+func kubeServiceClient(ctx context.Context, kubeClient kubernetes.Interface) (skkube.ServiceClient, error) {
+	if kubeClient == nil {
+		cfg, err := kubeutils.GetConfig("", "")
+		if err != nil {
+			return nil, err
+		}
+		kubeClient, err = kubernetes.NewForConfig(cfg)
+		if err != nil {
+			return nil, err
+		}
+	}
+	coreCache, err := corecache.NewKubeCoreCache(ctx, kubeClient)
+	if err != nil {
+		return nil, err
+	}
+	return service.NewServiceClient(kubeClient, coreCache), nil
+}
+
 func BootstrapFactories(ctx context.Context, clientset *kubernetes.Interface, kubeCache kube.SharedCache, memCache memory.InMemoryResourceCache, settings *v1.Settings) (bootstrap.Opts, error) {
 
 	var (
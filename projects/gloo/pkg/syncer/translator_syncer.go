@@ -6,6 +6,7 @@ import (
 	"go.opencensus.io/tag"
 
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/syncer/sanitizer"
 	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
 	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
 	envoycache "github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
@@ -18,12 +19,19 @@ var (
 
 type translatorSyncer struct {
 	translator translator.Translator
-	xdsCache   envoycache.SnapshotCache
-	xdsHasher  *xds.ProxyKeyHasher
-	reporter   reporter.Reporter
+	// the number of proxies translated concurrently
+	workers   int
+	xdsCache  envoycache.SnapshotCache
+	xdsHasher *xds.ProxyKeyHasher
+	reporter  reporter.Reporter
 	// used for debugging purposes only
 	latestSnap *v1.ApiSnapshot
 	extensions []TranslatorSyncerExtension
+	sanitizers sanitizer.XdsSanitizersByRole
+	// nil unless the last accepted configuration of the errored resources is kept
+	lastKnownGood *LastKnownGood
+	// nil unless the new routes wait for the endpoints of their destinations
+	readinessGate *ReadinessGate
 }
 
 type TranslatorSyncerExtensionParams struct {
@@ -36,13 +44,21 @@ type TranslatorSyncerExtension interface {
 	Sync(ctx context.Context, snap *v1.ApiSnapshot, xdsCache envoycache.SnapshotCache) error
 }
 
-func NewTranslatorSyncer(translator translator.Translator, xdsCache envoycache.SnapshotCache, xdsHasher *xds.ProxyKeyHasher, reporter reporter.Reporter, devMode bool, extensions []TranslatorSyncerExtension) v1.ApiSyncer {
+func NewTranslatorSyncer(translator translator.Translator, xdsCache envoycache.SnapshotCache, xdsHasher *xds.ProxyKeyHasher, reporter reporter.Reporter, devMode bool, extensions []TranslatorSyncerExtension, sanitizers sanitizer.XdsSanitizersByRole, settings *v1.Settings) v1.ApiSyncer {
 	s := &translatorSyncer{
 		translator: translator,
+		workers:    proxyTranslationWorkers(settings),
 		xdsCache:   xdsCache,
 		xdsHasher:  xdsHasher,
 		reporter:   reporter,
 		extensions: extensions,
+		sanitizers: sanitizers,
+	}
+	if settings.GetKeepLastKnownGood() {
+		s.lastKnownGood = NewLastKnownGood()
+	}
+	if settings.GetWaitForUpstreamEndpoints() {
+		s.readinessGate = NewReadinessGate(xdsCache)
 	}
 	if devMode {
 		// TODO(ilackarms): move this somewhere else?
@@ -5,6 +5,7 @@ import (
 	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
 
 	"context"
+	"sync"
 
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	. "github.com/solo-io/gloo/projects/gloo/pkg/syncer"
@@ -41,7 +42,7 @@ var _ = Describe("GraphQLSyncer", func() {
 		rep := reporter.NewReporter(ref, proxyClient, upstreamClient)
 
 		xdsHasher := &xds.ProxyKeyHasher{}
-		s := NewTranslatorSyncer(&mockTranslator{true}, c, xdsHasher, rep, false, nil)
+		s := NewTranslatorSyncer(&mockTranslator{true}, c, xdsHasher, rep, false, nil, nil, nil)
 		snap := &v1.ApiSnapshot{
 			Proxies: v1.ProxyList{
 				proxy,
@@ -67,7 +68,7 @@ var _ = Describe("GraphQLSyncer", func() {
 		Expect(err).NotTo(HaveOccurred())
 		snap.Proxies[0] = p1.(*v1.Proxy)
 
-		s = NewTranslatorSyncer(&mockTranslator{false}, c, xdsHasher, rep, false, nil)
+		s = NewTranslatorSyncer(&mockTranslator{false}, c, xdsHasher, rep, false, nil, nil, nil)
 		err = s.Sync(context.Background(), snap)
 		Expect(err).NotTo(HaveOccurred())
 
@@ -82,8 +83,76 @@ var _ = Describe("GraphQLSyncer", func() {
 
 		Expect(c.called).To(BeTrue())
 	})
+
+	It("updates the other proxies when the translation of a proxy fails", func() {
+		ref := "syncer-test"
+		resourceClientFactory := &factory.MemoryResourceClientFactory{
+			Cache: memory.NewInMemoryResourceCache(),
+		}
+		proxyClient, err := resourceClientFactory.NewResourceClient(factory.NewResourceClientParams{ResourceType: &v1.Proxy{}})
+		Expect(err).NotTo(HaveOccurred())
+
+		upstreamClient, err := resourceClientFactory.NewResourceClient(factory.NewResourceClientParams{ResourceType: &v1.Upstream{}})
+		Expect(err).NotTo(HaveOccurred())
+
+		failing := &v1.Proxy{
+			Metadata: helpers.NewRandomMetadata(),
+		}
+		var proxies v1.ProxyList
+		for i := 0; i < 5; i++ {
+			proxies = append(proxies, &v1.Proxy{
+				Metadata: helpers.NewRandomMetadata(),
+			})
+		}
+		proxies = append(proxies, failing)
+
+		c := &recordingXdsCache{snapshots: make(map[string]envoycache.Snapshot)}
+		rep := reporter.NewReporter(ref, proxyClient, upstreamClient)
+
+		s := NewTranslatorSyncer(&failingTranslator{failing: failing}, c, &xds.ProxyKeyHasher{}, rep, false, nil, nil, &v1.Settings{
+			ProxyTranslationWorkers: 2,
+		})
+		err = s.Sync(context.Background(), &v1.ApiSnapshot{Proxies: proxies})
+		Expect(err).To(HaveOccurred())
+
+		for _, proxy := range proxies {
+			if proxy == failing {
+				Expect(c.snapshots).NotTo(HaveKey(xds.SnapshotKey(proxy)))
+				continue
+			}
+			Expect(c.snapshots).To(HaveKey(xds.SnapshotKey(proxy)))
+		}
+
+		written, err := proxyClient.Read(failing.Metadata.Namespace, failing.Metadata.Name, clients.ReadOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(written.(*v1.Proxy).Status.State).To(Equal(core.Status_Rejected))
+	})
 })
 
+type failingTranslator struct {
+	failing *v1.Proxy
+}
+
+func (t *failingTranslator) Translate(params plugins.Params, proxy *v1.Proxy) (envoycache.Snapshot, reporter.ResourceErrors, error) {
+	if proxy == t.failing {
+		return nil, nil, errors.Errorf("translation failed")
+	}
+	return envoycache.NilSnapshot{}, nil, nil
+}
+
+type recordingXdsCache struct {
+	mockXdsCache
+	lock      sync.Mutex
+	snapshots map[string]envoycache.Snapshot
+}
+
+func (c *recordingXdsCache) SetSnapshot(node string, snapshot envoycache.Snapshot) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.snapshots[node] = snapshot
+	return nil
+}
+
 type mockTranslator struct {
 	reportErrs bool
 }
@@ -0,0 +1,47 @@
+package translator
+
+import (
+	"strings"
+
+	envoycore "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
+	"github.com/gogo/protobuf/types"
+)
+
+// the filter metadata holding the annotations of the upstreams and routes selected in the settings
+const AnnotationsMetadataKey = "io.solo.gloo.annotations"
+
+// adds the selected annotations to the metadata, does nothing if none is selected
+func setAnnotationsMetadata(meta *envoycore.Metadata, selectors []string, annotations map[string]string) {
+	annotationsStruct := &types.Struct{
+		Fields: map[string]*types.Value{},
+	}
+	for k, v := range annotations {
+		if !annotationSelected(selectors, k) {
+			continue
+		}
+		annotationsStruct.Fields[k] = &types.Value{
+			Kind: &types.Value_StringValue{
+				StringValue: v,
+			},
+		}
+	}
+	if len(annotationsStruct.Fields) == 0 {
+		return
+	}
+	if meta.FilterMetadata == nil {
+		meta.FilterMetadata = map[string]*types.Struct{}
+	}
+	meta.FilterMetadata[AnnotationsMetadataKey] = annotationsStruct
+}
+
+func annotationSelected(selectors []string, key string) bool {
+	for _, selector := range selectors {
+		if strings.HasSuffix(selector, "/") && strings.HasPrefix(key, selector) {
+			return true
+		}
+		if selector == key {
+			return true
+		}
+	}
+	return false
+}
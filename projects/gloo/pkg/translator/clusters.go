@@ -2,6 +2,7 @@ package translator
 
 import (
 	envoyapi "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	envoyauth "github.com/envoyproxy/go-control-plane/envoy/api/v2/auth"
 	envoycluster "github.com/envoyproxy/go-control-plane/envoy/api/v2/cluster"
 	envoycore "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
 
@@ -10,6 +11,7 @@ import (
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
 	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
 	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/go-utils/hashutils"
 	"github.com/solo-io/solo-kit/pkg/api/v1/reporter"
 	"go.opencensus.io/trace"
 )
@@ -24,13 +26,63 @@ func (t *translator) computeClusters(params plugins.Params, resourceErrs reporte
 	var (
 		clusters []*envoyapi.Cluster
 	)
+	t.cache.retainClusters(params.Snapshot.Upstreams)
+	secretsHash := hashutils.HashAll(params.Snapshot.Secrets.AsInterfaces()...)
+	collisions := ClusterNameCollisions(params.Snapshot.Upstreams)
 	for _, upstream := range params.Snapshot.Upstreams {
-		cluster := t.computeCluster(params, upstream, resourceErrs)
+		if owner, collides := collisions[upstream.Metadata.Ref()]; collides {
+			resourceErrs.AddError(upstream, errors.Errorf("the cluster name %v of the upstream is the cluster name of "+
+				"upstream %v, rename one of them", UpstreamToClusterName(upstream.Metadata.Ref()), owner.Key()))
+			continue
+		}
+		cluster := t.computeCachedCluster(params, upstream, secretsHash, resourceErrs)
 		clusters = append(clusters, cluster)
 	}
 	return clusters
 }
 
+// the cluster of the upstream is reused while the upstream, the secrets and whether the upstream has endpoints do not
+// change, unless a plugin keeps state about the upstream
+func (t *translator) computeCachedCluster(params plugins.Params, upstream *v1.Upstream, secretsHash uint64, resourceErrs reporter.ResourceErrors) *envoyapi.Cluster {
+	if !t.cacheableUpstream(upstream) {
+		return t.computeCluster(params, upstream, resourceErrs)
+	}
+	hasEndpoints := len(endpointsForUpstream(upstream, params.Snapshot.Endpoints)) > 0
+	inputsHash := hashutils.HashAll(upstream, secretsHash, hasEndpoints)
+	if cached := t.cache.getCluster(upstream, inputsHash); cached != nil {
+		if cached.err != nil {
+			resourceErrs.AddError(upstream, cached.err)
+		}
+		return cached.cluster
+	}
+
+	upstreamErrs := make(reporter.ResourceErrors)
+	cluster := t.computeCluster(params, upstream, upstreamErrs)
+	err := upstreamErrs[upstream]
+	if err != nil {
+		resourceErrs.AddError(upstream, err)
+	}
+	t.cache.setCluster(upstream, &cachedCluster{
+		inputsHash: inputsHash,
+		cluster:    cluster,
+		err:        err,
+	})
+	return cluster
+}
+
+func (t *translator) cacheableUpstream(upstream *v1.Upstream) bool {
+	for _, plug := range t.plugins {
+		if _, ok := plug.(plugins.UpstreamPlugin); !ok {
+			continue
+		}
+		cacheablePlugin, ok := plug.(plugins.CacheableUpstreamPlugin)
+		if !ok || !cacheablePlugin.CacheableUpstream(upstream) {
+			return false
+		}
+	}
+	return true
+}
+
 func (t *translator) computeCluster(params plugins.Params, upstream *v1.Upstream, resourceErrs reporter.ResourceErrors) *envoyapi.Cluster {
 	params.Ctx = contextutils.WithLogger(params.Ctx, upstream.Metadata.Name)
 	out := t.initializeCluster(upstream, params.Snapshot.Endpoints)
@@ -45,6 +97,7 @@ func (t *translator) computeCluster(params plugins.Params, upstream *v1.Upstream
 			resourceErrs.AddError(upstream, err)
 		}
 	}
+	setDefaultAlpn(out)
 	if err := validateCluster(out); err != nil {
 		resourceErrs.AddError(upstream, errors.Wrapf(err, "cluster was configured improperly "+
 			"by one or more plugins: %v", out))
@@ -61,6 +114,7 @@ func (t *translator) initializeCluster(upstream *v1.Upstream, endpoints []*v1.En
 		// this field can be overridden by plugins
 		ConnectTimeout: ClusterConnectionTimeout,
 	}
+	setAnnotationsMetadata(out.Metadata, t.settings.GetMetadataAnnotations(), upstream.Metadata.Annotations)
 	// set Type = EDS if we have endpoints for the upstream
 	if len(endpointsForUpstream(upstream, endpoints)) > 0 {
 		xds.SetEdsOnCluster(out)
@@ -68,6 +122,20 @@ func (t *translator) initializeCluster(upstream *v1.Upstream, endpoints []*v1.En
 	return out
 }
 
+// the plugins enable tls and http2 on the cluster independently of each other. Offer h2 in the tls handshake of the
+// http2 clusters that do not set their alpn protocols, as backends such as gRPC servers refuse the connections without.
+func setDefaultAlpn(out *envoyapi.Cluster) {
+	if out.TlsContext == nil || out.Http2ProtocolOptions == nil {
+		return
+	}
+	if out.TlsContext.CommonTlsContext == nil {
+		out.TlsContext.CommonTlsContext = &envoyauth.CommonTlsContext{}
+	}
+	if len(out.TlsContext.CommonTlsContext.AlpnProtocols) == 0 {
+		out.TlsContext.CommonTlsContext.AlpnProtocols = []string{http2Alpn}
+	}
+}
+
 func createLbConfig(upstream *v1.Upstream) *envoyapi.Cluster_LbSubsetConfig {
 	specGetter, ok := upstream.UpstreamSpec.UpstreamType.(v1.SubsetSpecGetter)
 	if !ok {
@@ -105,19 +173,35 @@ func validateCluster(c *envoyapi.Cluster) error {
 	return nil
 }
 
-// Convert the first non nil circuit breaker.
+// Convert the circuit breakers, each threshold taken from the first config setting it, so that an upstream only
+// overrides the default thresholds it sets.
 func getCircuitBreakers(cfgs ...*v1.CircuitBreakerConfig) *envoycluster.CircuitBreakers {
-	for _, cfg := range cfgs {
-		if cfg != nil {
-			envoyCfg := &envoycluster.CircuitBreakers{}
-			envoyCfg.Thresholds = []*envoycluster.CircuitBreakers_Thresholds{{
-				MaxConnections:     cfg.MaxConnections,
-				MaxPendingRequests: cfg.MaxPendingRequests,
-				MaxRequests:        cfg.MaxRequests,
-				MaxRetries:         cfg.MaxRetries,
-			}}
-			return envoyCfg
+	var thresholds *envoycluster.CircuitBreakers_Thresholds
+	for i := len(cfgs) - 1; i >= 0; i-- {
+		cfg := cfgs[i]
+		if cfg == nil {
+			continue
+		}
+		if thresholds == nil {
+			thresholds = &envoycluster.CircuitBreakers_Thresholds{}
+		}
+		if cfg.MaxConnections != nil {
+			thresholds.MaxConnections = cfg.MaxConnections
+		}
+		if cfg.MaxPendingRequests != nil {
+			thresholds.MaxPendingRequests = cfg.MaxPendingRequests
+		}
+		if cfg.MaxRequests != nil {
+			thresholds.MaxRequests = cfg.MaxRequests
+		}
+		if cfg.MaxRetries != nil {
+			thresholds.MaxRetries = cfg.MaxRetries
 		}
 	}
-	return nil
+	if thresholds == nil {
+		return nil
+	}
+	return &envoycluster.CircuitBreakers{
+		Thresholds: []*envoycluster.CircuitBreakers_Thresholds{thresholds},
+	}
 }
@@ -8,4 +8,7 @@ const (
 	SslCertificateChainKey = "tls.crt"
 	SslPrivateKeyKey       = "tls.key"
 	SslRootCaKey           = "tls.root"
+
+	// the alpn protocol of http2 over tls
+	http2Alpn = "h2"
 )
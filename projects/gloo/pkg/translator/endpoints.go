@@ -2,6 +2,7 @@ package translator
 
 import (
 	"context"
+	"sort"
 
 	"go.opencensus.io/trace"
 
@@ -35,7 +36,7 @@ func computeClusterEndpoints(ctx context.Context, upstreams []*v1.Upstream, endp
 
 func loadAssignmentForUpstream(upstream *v1.Upstream, clusterEndpoints []*v1.Endpoint) *envoyapi.ClusterLoadAssignment {
 	clusterName := UpstreamToClusterName(upstream.Metadata.Ref())
-	var endpoints []envoyendpoints.LbEndpoint
+	localities := make(map[locality][]envoyendpoints.LbEndpoint)
 	for _, addr := range clusterEndpoints {
 		lbEndpoint := envoyendpoints.LbEndpoint{
 			Metadata: getLbMetadata(upstream, addr.Metadata.Labels),
@@ -55,15 +56,104 @@ func loadAssignmentForUpstream(upstream *v1.Upstream, clusterEndpoints []*v1.End
 				},
 			},
 		}
-		endpoints = append(endpoints, lbEndpoint)
+		key := locality{
+			region:  addr.GetLocality().GetRegion(),
+			zone:    addr.GetLocality().GetZone(),
+			subZone: addr.GetLocality().GetSubZone(),
+		}
+		localities[key] = append(localities[key], lbEndpoint)
 	}
 
 	return &envoyapi.ClusterLoadAssignment{
 		ClusterName: clusterName,
-		Endpoints: []envoyendpoints.LocalityLbEndpoints{{
-			LbEndpoints: endpoints,
-		}},
+		Endpoints:   localityLbEndpoints(upstream.UpstreamSpec.GetLoadBalancerConfig(), localities),
+	}
+}
+
+// the locality of endpoints, empty when unknown
+type locality struct {
+	region, zone, subZone string
+}
+
+// the endpoints grouped by locality, with the priorities and weights of the load balancer config of the upstream
+func localityLbEndpoints(cfg *v1.LoadBalancerConfig, localities map[locality][]envoyendpoints.LbEndpoint) []envoyendpoints.LocalityLbEndpoints {
+	var sorted []locality
+	for l := range localities {
+		sorted = append(sorted, l)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].key() < sorted[j].key()
+	})
+
+	// the endpoints matching no locality priority fail over last
+	var lowestPriority uint32
+	for _, localityPriority := range cfg.GetLocalityPriorities() {
+		if localityPriority.Priority >= lowestPriority {
+			lowestPriority = localityPriority.Priority + 1
+		}
+	}
+
+	var out []envoyendpoints.LocalityLbEndpoints
+	priorities := make(map[uint32]bool)
+	for _, l := range sorted {
+		localityEndpoints := envoyendpoints.LocalityLbEndpoints{
+			LbEndpoints: localities[l],
+			Priority:    lowestPriority,
+		}
+		if l != (locality{}) {
+			localityEndpoints.Locality = &envoycore.Locality{
+				Region:  l.region,
+				Zone:    l.zone,
+				SubZone: l.subZone,
+			}
+		}
+		var weight uint32
+		if localityPriority := l.match(cfg.GetLocalityPriorities()); localityPriority != nil {
+			localityEndpoints.Priority = localityPriority.Priority
+			weight = localityPriority.Weight
+		}
+		if cfg.GetLocalityWeighted() {
+			// envoy sends no requests to the localities without weight
+			if weight == 0 {
+				weight = 1
+			}
+			localityEndpoints.LoadBalancingWeight = &types.UInt32Value{Value: weight}
+		}
+		priorities[localityEndpoints.Priority] = true
+		out = append(out, localityEndpoints)
+	}
+
+	// envoy requires the priorities to be contiguous from 0
+	var distinct []uint32
+	for priority := range priorities {
+		distinct = append(distinct, priority)
+	}
+	sort.Slice(distinct, func(i, j int) bool { return distinct[i] < distinct[j] })
+	contiguous := make(map[uint32]uint32)
+	for i, priority := range distinct {
+		contiguous[priority] = uint32(i)
+	}
+	for i := range out {
+		out[i].Priority = contiguous[out[i].Priority]
 	}
+	return out
+}
+
+// the first locality priority whose non empty fields equal the fields of the locality
+func (l locality) match(localityPriorities []*v1.LoadBalancerConfig_LocalityPriority) *v1.LoadBalancerConfig_LocalityPriority {
+	for _, localityPriority := range localityPriorities {
+		match := localityPriority.GetLocality()
+		if (match.GetRegion() == "" || match.GetRegion() == l.region) &&
+			(match.GetZone() == "" || match.GetZone() == l.zone) &&
+			(match.GetSubZone() == "" || match.GetSubZone() == l.subZone) {
+			return localityPriority
+		}
+	}
+	return nil
+}
+
+func (l locality) key() string {
+	return l.region + "/" + l.zone + "/" + l.subZone
 }
 
 func endpointsForUpstream(upstream *v1.Upstream, endpoints []*v1.Endpoint) []*v1.Endpoint {
@@ -0,0 +1,143 @@
+package translator_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/bootstrap"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/registry"
+	. "github.com/solo-io/gloo/projects/gloo/pkg/translator"
+	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
+	"github.com/solo-io/go-utils/protoutils"
+	envoycache "github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
+)
+
+const (
+	goldenDir  = "testdata/golden"
+	goldenFile = "golden.json"
+
+	// set to regenerate the golden files from the current output of the translator
+	updateGoldenEnv = "UPDATE_GOLDEN"
+)
+
+// the envoy configuration generated for the inputs of a golden test case
+type goldenOutput struct {
+	Clusters  []json.RawMessage `json:"clusters"`
+	Listeners []json.RawMessage `json:"listeners"`
+	Routes    []json.RawMessage `json:"routes"`
+}
+
+// Each directory of testdata/golden is a test case, containing the proxy to translate (proxy.yaml),
+// the upstreams and secrets it references (upstreams.yaml and secrets.yaml, documents separated by ---),
+// and the expected envoy configuration (golden.json).
+// Run `make update-golden` to regenerate the expected configuration after a change to a plugin.
+var _ = Describe("Golden", func() {
+	cases, err := filepath.Glob(filepath.Join(goldenDir, "*"))
+	if err != nil {
+		panic(err)
+	}
+
+	for _, dir := range cases {
+		dir := dir
+		It("should generate the expected envoy configuration for "+filepath.Base(dir), func() {
+			params, proxy := readGoldenInputs(dir)
+
+			settings := &v1.Settings{}
+			translator := NewTranslator(registry.Plugins(bootstrap.Opts{Settings: settings}), settings)
+			snap, errs, err := translator.Translate(params, proxy)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(errs.Validate()).NotTo(HaveOccurred())
+
+			actual := marshalGoldenOutput(snap)
+			golden := filepath.Join(dir, goldenFile)
+			if os.Getenv(updateGoldenEnv) != "" {
+				err := ioutil.WriteFile(golden, actual, 0644)
+				Expect(err).NotTo(HaveOccurred())
+				return
+			}
+			expected, err := ioutil.ReadFile(golden)
+			Expect(err).NotTo(HaveOccurred(), "run `make update-golden` to create %v", golden)
+			Expect(string(actual)).To(Equal(string(expected)), "run `make update-golden` if the change to the envoy configuration is expected")
+		})
+	}
+})
+
+func readGoldenInputs(dir string) (plugins.Params, *v1.Proxy) {
+	proxies := readGoldenResources(filepath.Join(dir, "proxy.yaml"), func() proto.Message { return &v1.Proxy{} })
+	Expect(proxies).To(HaveLen(1))
+
+	snapshot := &v1.ApiSnapshot{}
+	for _, msg := range readGoldenResources(filepath.Join(dir, "upstreams.yaml"), func() proto.Message { return &v1.Upstream{} }) {
+		snapshot.Upstreams = append(snapshot.Upstreams, msg.(*v1.Upstream))
+	}
+	for _, msg := range readGoldenResources(filepath.Join(dir, "secrets.yaml"), func() proto.Message { return &v1.Secret{} }) {
+		snapshot.Secrets = append(snapshot.Secrets, msg.(*v1.Secret))
+	}
+	return plugins.Params{
+		Ctx:      context.Background(),
+		Snapshot: snapshot,
+	}, proxies[0].(*v1.Proxy)
+}
+
+// reads the yaml documents of a file, a missing file contains no document
+func readGoldenResources(filename string, newResource func() proto.Message) []proto.Message {
+	data, err := ioutil.ReadFile(filename)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	Expect(err).NotTo(HaveOccurred())
+
+	var resources []proto.Message
+	for _, doc := range strings.Split(string(data), "\n---\n") {
+		if strings.TrimSpace(doc) == "" {
+			continue
+		}
+		resource := newResource()
+		err := protoutils.UnmarshalYaml([]byte(doc), resource)
+		Expect(err).NotTo(HaveOccurred(), "parsing %v", filename)
+		resources = append(resources, resource)
+	}
+	return resources
+}
+
+func marshalGoldenOutput(snap envoycache.Snapshot) []byte {
+	output := goldenOutput{
+		Clusters:  marshalGoldenResources(snap.GetResources(xds.ClusterType)),
+		Listeners: marshalGoldenResources(snap.GetResources(xds.ListenerType)),
+		Routes:    marshalGoldenResources(snap.GetResources(xds.RouteType)),
+	}
+	data, err := json.MarshalIndent(output, "", "  ")
+	Expect(err).NotTo(HaveOccurred())
+	return append(data, '\n')
+}
+
+// resources are sorted by name for idempotency
+func marshalGoldenResources(resources envoycache.Resources) []json.RawMessage {
+	var names []string
+	for name := range resources.Items {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	marshaler := jsonpb.Marshaler{}
+	var out []json.RawMessage
+	for _, name := range names {
+		var buf bytes.Buffer
+		err := marshaler.Marshal(&buf, resources.Items[name].ResourceProto())
+		Expect(err).NotTo(HaveOccurred())
+		out = append(out, buf.Bytes())
+	}
+	return out
+}
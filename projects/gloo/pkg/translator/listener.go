@@ -29,7 +29,7 @@ func (t *translator) computeListener(params plugins.Params, proxy *v1.Proxy, lis
 		return nil
 	}
 
-	filterChains := computeFilterChainsFromSslConfig(params.Snapshot, listener, listenerFilters, report)
+	filterChains := computeFilterChainsFromSslConfig(params.Snapshot, listener, listenerFilters, t.settings.GetSdsSecrets(), report)
 
 	out := &envoyapi.Listener{
 		Name: listener.Name,
@@ -99,7 +99,7 @@ func (t *translator) computeListenerFilters(params plugins.Params, listener *v1.
 
 // create a duplicate of the listener filter chain for each ssl cert we want to serve
 // if there is no SSL config on the listener, the envoy listener will have one insecure filter chain
-func computeFilterChainsFromSslConfig(snap *v1.ApiSnapshot, listener *v1.Listener, listenerFilters []envoylistener.Filter, report reportFunc) []envoylistener.FilterChain {
+func computeFilterChainsFromSslConfig(snap *v1.ApiSnapshot, listener *v1.Listener, listenerFilters []envoylistener.Filter, sds bool, report reportFunc) []envoylistener.FilterChain {
 
 	// if no ssl config is provided, return a single insecure filter chain
 	if len(listener.SslConfiguations) == 0 {
@@ -112,6 +112,9 @@ func computeFilterChainsFromSslConfig(snap *v1.ApiSnapshot, listener *v1.Listene
 	var secureFilterChains []envoylistener.FilterChain
 
 	sslCfgTranslator := utils.NewSslConfigTranslator(snap.Secrets)
+	if sds {
+		sslCfgTranslator = utils.NewSdsSslConfigTranslator(snap.Secrets)
+	}
 	for _, sslConfig := range listener.SslConfiguations {
 		// get secrets
 		downstreamConfig, err := sslCfgTranslator.ResolveDownstreamSslConfig(sslConfig)
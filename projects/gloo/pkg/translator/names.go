@@ -1,7 +1,83 @@
 package translator
 
-import v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+// the name and namespace of the upstream are separated by an underscore in its cluster name
+const clusterNameSeparator = "_"
 
 func routeConfigName(listener *v1.Listener) string {
 	return listener.Name + "-routes"
 }
+
+// UpstreamToClusterName returns the name of the envoy cluster of the upstream, which only depends on its ref so that
+// the stats of the cluster keep their name across restarts and updates
+func UpstreamToClusterName(upstream core.ResourceRef) string {
+	// Don't use dots in the name as it messes up prometheus stats
+	return fmt.Sprintf("%s%s%s", upstream.Name, clusterNameSeparator, upstream.Namespace)
+}
+
+// ClusterNameToUpstreamRef returns the ref of the upstream of a cluster name. The namespace is the part after the last
+// underscore, which is exact for the upstreams in kubernetes namespaces, as they can't contain underscores.
+// Returns false if the name is not the name of an upstream cluster.
+func ClusterNameToUpstreamRef(clusterName string) (core.ResourceRef, bool) {
+	i := strings.LastIndex(clusterName, clusterNameSeparator)
+	if i <= 0 || i == len(clusterName)-1 {
+		return core.ResourceRef{}, false
+	}
+	return core.ResourceRef{Name: clusterName[:i], Namespace: clusterName[i+1:]}, true
+}
+
+// ClusterNameCollisions returns the upstreams whose cluster name is already the name of the cluster of another upstream,
+// e.g. `a_b` in namespace `c` and `a` in namespace `b_c`, with the upstream that keeps the cluster. The upstream sorted
+// first by namespace and name keeps the cluster, so that the collisions are resolved the same way on every translation.
+func ClusterNameCollisions(upstreams v1.UpstreamList) map[core.ResourceRef]core.ResourceRef {
+	refs := make([]core.ResourceRef, 0, len(upstreams))
+	for _, us := range upstreams {
+		refs = append(refs, us.Metadata.Ref())
+	}
+	sort.SliceStable(refs, func(i, j int) bool {
+		if refs[i].Namespace != refs[j].Namespace {
+			return refs[i].Namespace < refs[j].Namespace
+		}
+		return refs[i].Name < refs[j].Name
+	})
+	owners := make(map[string]core.ResourceRef, len(refs))
+	var collisions map[core.ResourceRef]core.ResourceRef
+	for _, ref := range refs {
+		name := UpstreamToClusterName(ref)
+		owner, taken := owners[name]
+		if !taken {
+			owners[name] = ref
+			continue
+		}
+		if collisions == nil {
+			collisions = make(map[core.ResourceRef]core.ResourceRef)
+		}
+		collisions[ref] = owner
+	}
+	return collisions
+}
+
+// clusterNameOwner returns the upstream keeping the cluster name of the upstream among the upstreams, as resolved by
+// ClusterNameCollisions, and whether it is another upstream.
+func clusterNameOwner(upstreams v1.UpstreamList, ref core.ResourceRef) (core.ResourceRef, bool) {
+	name := UpstreamToClusterName(ref)
+	owner := ref
+	for _, us := range upstreams {
+		other := us.Metadata.Ref()
+		if UpstreamToClusterName(other) != name {
+			continue
+		}
+		if other.Namespace < owner.Namespace || (other.Namespace == owner.Namespace && other.Name < owner.Name) {
+			owner = other
+		}
+	}
+	return owner, owner != ref
+}
@@ -0,0 +1,57 @@
+package translator_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	. "github.com/solo-io/gloo/projects/gloo/pkg/translator"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+var _ = Describe("Names", func() {
+
+	It("names the clusters after the name and namespace of the upstreams", func() {
+		ref := core.ResourceRef{Name: "default-petstore-8080", Namespace: "gloo-system"}
+		Expect(UpstreamToClusterName(ref)).To(Equal("default-petstore-8080_gloo-system"))
+
+		parsed, ok := ClusterNameToUpstreamRef(UpstreamToClusterName(ref))
+		Expect(ok).To(BeTrue())
+		Expect(parsed).To(Equal(ref))
+	})
+
+	It("keeps the underscores of the upstream names", func() {
+		parsed, ok := ClusterNameToUpstreamRef("my_upstream_default")
+		Expect(ok).To(BeTrue())
+		Expect(parsed).To(Equal(core.ResourceRef{Name: "my_upstream", Namespace: "default"}))
+	})
+
+	It("does not parse the names without a name and a namespace", func() {
+		for _, name := range []string{"local", "_default", "upstream_"} {
+			_, ok := ClusterNameToUpstreamRef(name)
+			Expect(ok).To(BeFalse())
+		}
+	})
+
+	It("finds the upstreams whose cluster name collides, in a stable order", func() {
+		upstream := func(namespace, name string) *v1.Upstream {
+			return &v1.Upstream{Metadata: core.Metadata{Namespace: namespace, Name: name}}
+		}
+		upstreams := v1.UpstreamList{
+			upstream("c", "a_b"),
+			upstream("b_c", "a"),
+			upstream("default", "petstore"),
+			upstream("a", "b_c"),
+		}
+		collisions := ClusterNameCollisions(upstreams)
+		Expect(collisions).To(Equal(map[core.ResourceRef]core.ResourceRef{
+			{Namespace: "c", Name: "a_b"}: {Namespace: "b_c", Name: "a"},
+		}))
+
+		// the same collisions whatever the order of the upstreams
+		reversed := v1.UpstreamList{upstreams[3], upstreams[2], upstreams[1], upstreams[0]}
+		Expect(ClusterNameCollisions(reversed)).To(Equal(collisions))
+
+		Expect(ClusterNameCollisions(upstreams[2:])).To(BeEmpty())
+	})
+})
@@ -25,7 +25,7 @@ func (t *translator) computeRouteConfig(params plugins.Params, proxy *v1.Proxy,
 	}
 	params.Ctx = contextutils.WithLogger(params.Ctx, "compute_route_config."+routeCfgName)
 
-	virtualHosts := t.computeVirtualHosts(params, listener, report)
+	virtualHosts := t.computeVirtualHosts(params, listener, routeCfgName, report)
 
 	// validate ssl config if the listener specifies any
 	if err := validateListenerSslConfig(listener, params.Snapshot.Secrets); err != nil {
@@ -38,7 +38,7 @@ func (t *translator) computeRouteConfig(params plugins.Params, proxy *v1.Proxy,
 	}
 }
 
-func (t *translator) computeVirtualHosts(params plugins.Params, listener *v1.Listener, report reportFunc) []envoyroute.VirtualHost {
+func (t *translator) computeVirtualHosts(params plugins.Params, listener *v1.Listener, routeCfgName string, report reportFunc) []envoyroute.VirtualHost {
 	httpListener, ok := listener.ListenerType.(*v1.Listener_HttpListener)
 	if !ok {
 		panic("non-HTTP listeners are not currently supported in Gloo")
@@ -50,12 +50,27 @@ func (t *translator) computeVirtualHosts(params plugins.Params, listener *v1.Lis
 	requireTls := len(listener.SslConfiguations) > 0
 	var envoyVirtualHosts []envoyroute.VirtualHost
 	for _, virtualHost := range virtualHosts {
-		envoyVirtualHosts = append(envoyVirtualHosts, t.computeVirtualHost(params, virtualHost, requireTls, report))
+		envoyVirtualHosts = append(envoyVirtualHosts, t.computeVirtualHost(params, virtualHost, routeCfgName, requireTls, report))
 	}
 	return envoyVirtualHosts
 }
 
-func (t *translator) computeVirtualHost(params plugins.Params, virtualHost *v1.VirtualHost, requireTls bool, report reportFunc) envoyroute.VirtualHost {
+// VirtualHostError is an error of a virtual host, reported on the proxy.
+// it tells which virtual host of the route configuration failed to translate.
+type VirtualHostError struct {
+	RouteConfig string
+	VirtualHost string
+	Err         error
+}
+
+func (e *VirtualHostError) Error() string {
+	return e.Err.Error()
+}
+
+func (t *translator) computeVirtualHost(params plugins.Params, virtualHost *v1.VirtualHost, routeCfgName string, requireTls bool, reportFn reportFunc) envoyroute.VirtualHost {
+	report := func(err error, format string, args ...interface{}) {
+		reportFn(&VirtualHostError{RouteConfig: routeCfgName, VirtualHost: virtualHost.Name, Err: err}, format, args...)
+	}
 	var envoyRoutes []envoyroute.Route
 	for _, route := range virtualHost.Routes {
 		envoyRoute := t.envoyRoute(params, report, route)
@@ -102,6 +117,8 @@ func (t *translator) envoyRoute(params plugins.Params, report reportFunc, in *v1
 
 	setMatch(in, out)
 
+	t.setMetadata(in, out)
+
 	t.setAction(params, report, in, out)
 
 	return *out
@@ -127,6 +144,14 @@ func setMatch(in *v1.Route, out *envoyroute.Route) {
 	out.Match = match
 }
 
+func (t *translator) setMetadata(in *v1.Route, out *envoyroute.Route) {
+	meta := &envoycore.Metadata{}
+	setAnnotationsMetadata(meta, t.settings.GetMetadataAnnotations(), in.Annotations)
+	if len(meta.FilterMetadata) > 0 {
+		out.Metadata = meta
+	}
+}
+
 func (t *translator) setAction(params plugins.Params, report reportFunc, in *v1.Route, out *envoyroute.Route) {
 	switch action := in.Action.(type) {
 	case *v1.Route_RouteAction:
@@ -461,8 +486,15 @@ func validateSingleDestination(upstreams v1.UpstreamList, destination *v1.Destin
 		return errors.Errorf("service destinations are currently not supported")
 	}
 
-	_, err := upstreams.Find(upstreamRef.Strings())
-	return err
+	if _, err := upstreams.Find(upstreamRef.Strings()); err != nil {
+		return err
+	}
+	// the cluster of the upstream would route to the other upstream
+	if owner, collides := clusterNameOwner(upstreams, *upstreamRef); collides {
+		return errors.Errorf("the cluster name %v of upstream %v is the cluster name of upstream %v, rename one of them",
+			UpstreamToClusterName(*upstreamRef), upstreamRef.Key(), owner.Key())
+	}
+	return nil
 }
 
 func validateListenerSslConfig(listener *v1.Listener, secrets []*v1.Secret) error {
@@ -0,0 +1,163 @@
+package translator
+
+import (
+	"sort"
+	"sync"
+
+	envoyapi "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/go-utils/hashutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/reporter"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+// Caches the resources translated from each proxy but its endpoints, so that they are not translated again while only
+// the endpoints of the api snapshot change, e.g. when pods churn. The clusters of the upstreams are cached too, so that
+// an upstream is translated again only when it changes.
+type translationCache struct {
+	lock    sync.Mutex
+	entries map[core.ResourceRef]*cachedTranslation
+	// the clusters of the upstreams, shared by the proxies
+	clusters map[core.ResourceRef]*cachedCluster
+}
+
+type cachedTranslation struct {
+	// the hash of the inputs of the translation besides the endpoints
+	inputsHash uint64
+
+	// the clusters of the upstreams, then the clusters of the cluster generator plugins
+	upstreamClusters  []*envoyapi.Cluster
+	generatedClusters []*envoyapi.Cluster
+	routeConfigs      []*envoyapi.RouteConfiguration
+	listeners         []*envoyapi.Listener
+	resourceErrs      reporter.ResourceErrors
+}
+
+type cachedCluster struct {
+	// the hash of the upstream, the secrets and whether the upstream has endpoints
+	inputsHash uint64
+	cluster    *envoyapi.Cluster
+	// the error reported on the upstream, nil if none
+	err error
+}
+
+func newTranslationCache() *translationCache {
+	return &translationCache{
+		entries:  make(map[core.ResourceRef]*cachedTranslation),
+		clusters: make(map[core.ResourceRef]*cachedCluster),
+	}
+}
+
+// the translation of the proxy if the inputs did not change, nil otherwise. The entries of the proxies missing from
+// the snapshot are evicted.
+func (c *translationCache) get(snapshot *v1.ApiSnapshot, proxy *v1.Proxy, inputsHash uint64) *cachedTranslation {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	for ref := range c.entries {
+		if _, err := snapshot.Proxies.Find(ref.Strings()); err != nil {
+			delete(c.entries, ref)
+		}
+	}
+	entry := c.entries[proxy.Metadata.Ref()]
+	if entry == nil || entry.inputsHash != inputsHash {
+		return nil
+	}
+	return entry
+}
+
+// the errors of the entry are copied, as the caller adds the errors found after translation to them
+func (c *translationCache) set(proxy *v1.Proxy, entry *cachedTranslation) {
+	resourceErrs := make(reporter.ResourceErrors, len(entry.resourceErrs))
+	for resource, err := range entry.resourceErrs {
+		resourceErrs[resource] = err
+	}
+	entry.resourceErrs = resourceErrs
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.entries[proxy.Metadata.Ref()] = entry
+}
+
+// the hash of the snapshot with the proxy as its only proxy and without its endpoints. Which upstreams have endpoints
+// is hashed too, as the clusters of these upstreams use EDS.
+func translationInputsHash(snapshot *v1.ApiSnapshot, proxy *v1.Proxy) uint64 {
+	inputs := *snapshot
+	inputs.Proxies = v1.ProxyList{proxy}
+	inputs.Endpoints = nil
+
+	upstreamsWithEndpoints := make(map[string]bool)
+	for _, ep := range snapshot.Endpoints {
+		for _, ref := range ep.Upstreams {
+			upstreamsWithEndpoints[ref.Key()] = true
+		}
+	}
+	var keys []string
+	for key := range upstreamsWithEndpoints {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return hashutils.HashAll(inputs.Hash(), keys)
+}
+
+// the errors of the translation are reported on the resources of the snapshot, not on the resources of the snapshot
+// the proxy was translated from
+func (t *cachedTranslation) errors(snapshot *v1.ApiSnapshot, proxy *v1.Proxy) reporter.ResourceErrors {
+	resourceErrs := make(reporter.ResourceErrors, len(t.resourceErrs))
+	for resource, err := range t.resourceErrs {
+		resourceErrs[currentResource(snapshot, proxy, resource)] = err
+	}
+	return resourceErrs
+}
+
+func currentResource(snapshot *v1.ApiSnapshot, proxy *v1.Proxy, resource resources.InputResource) resources.InputResource {
+	namespace, name := resource.GetMetadata().Ref().Strings()
+	switch resource.(type) {
+	case *v1.Proxy:
+		return proxy
+	case *v1.Upstream:
+		if us, err := snapshot.Upstreams.Find(namespace, name); err == nil {
+			return us
+		}
+	case *v1.UpstreamGroup:
+		if ug, err := snapshot.Upstreamgroups.Find(namespace, name); err == nil {
+			return ug
+		}
+	}
+	return resource
+}
+
+func (t *cachedTranslation) clusters() []*envoyapi.Cluster {
+	clusters := make([]*envoyapi.Cluster, 0, len(t.upstreamClusters)+len(t.generatedClusters))
+	clusters = append(clusters, t.upstreamClusters...)
+	return append(clusters, t.generatedClusters...)
+}
+
+// the cluster of the upstream if its inputs did not change, nil otherwise
+func (c *translationCache) getCluster(upstream *v1.Upstream, inputsHash uint64) *cachedCluster {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	entry := c.clusters[upstream.Metadata.Ref()]
+	if entry == nil || entry.inputsHash != inputsHash {
+		return nil
+	}
+	return entry
+}
+
+func (c *translationCache) setCluster(upstream *v1.Upstream, entry *cachedCluster) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.clusters[upstream.Metadata.Ref()] = entry
+}
+
+// evicts the clusters of the upstreams missing from the snapshot
+func (c *translationCache) retainClusters(upstreams v1.UpstreamList) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	for ref := range c.clusters {
+		if _, err := upstreams.Find(ref.Strings()); err != nil {
+			delete(c.clusters, ref)
+		}
+	}
+}
@@ -2,12 +2,14 @@ package translator
 
 import (
 	"fmt"
+	"sort"
 
 	envoyapi "github.com/envoyproxy/go-control-plane/envoy/api/v2"
 	"github.com/mitchellh/hashstructure"
 	"github.com/pkg/errors"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
 	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
 	"github.com/solo-io/go-utils/contextutils"
 	envoycache "github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
@@ -23,6 +25,7 @@ type translator struct {
 	plugins            []plugins.Plugin
 	extensionsSettings *v1.Extensions
 	settings           *v1.Settings
+	cache              *translationCache
 }
 
 func NewTranslator(plugins []plugins.Plugin, settings *v1.Settings) Translator {
@@ -30,6 +33,7 @@ func NewTranslator(plugins []plugins.Plugin, settings *v1.Settings) Translator {
 		plugins:            plugins,
 		extensionsSettings: settings.Extensions,
 		settings:           settings,
+		cache:              newTranslationCache(),
 	}
 }
 
@@ -40,6 +44,16 @@ func (t *translator) Translate(params plugins.Params, proxy *v1.Proxy) (envoycac
 	defer span.End()
 
 	params.Ctx = contextutils.WithLogger(params.Ctx, "translator")
+
+	inputsHash := translationInputsHash(params.Snapshot, proxy)
+	if cached := t.cache.get(params.Snapshot, proxy, inputsHash); cached != nil {
+		// only the endpoints changed since the last translation of the proxy
+		contextutils.LoggerFrom(params.Ctx).Debugf("computing envoy endpoints only for proxy: %v", proxy.Metadata.Name)
+		endpoints := computeClusterEndpoints(params.Ctx, params.Snapshot.Upstreams, params.Snapshot.Endpoints)
+		endpoints = withEmptyLoadAssignments(cached.upstreamClusters, endpoints)
+		return generateXDSSnapshot(params.Snapshot.Secrets, cached.clusters(), endpoints, cached.routeConfigs, cached.listeners), cached.errors(params.Snapshot, proxy), nil
+	}
+
 	for _, p := range t.plugins {
 		if err := p.Init(plugins.InitParams{
 			Ctx:                params.Ctx,
@@ -68,25 +82,7 @@ func (t *translator) Translate(params plugins.Params, proxy *v1.Proxy) (envoycac
 
 	endpoints := computeClusterEndpoints(params.Ctx, params.Snapshot.Upstreams, params.Snapshot.Endpoints)
 
-	// find all the eds clusters without endpoints (can happen with kube service that have no enpoints), and create a zero sized load assignment
-	// this is important as otherwise envoy will wait for them forever wondering their fate and not doing much else.
-
-ClusterLoop:
-	for _, c := range clusters {
-		if c.GetType() != envoyapi.Cluster_EDS {
-			continue
-		}
-		for _, ep := range endpoints {
-			if ep.ClusterName == c.Name {
-				continue ClusterLoop
-			}
-		}
-		emptyendpointlist := &envoyapi.ClusterLoadAssignment{
-			ClusterName: c.Name,
-		}
-
-		endpoints = append(endpoints, emptyendpointlist)
-	}
+	endpoints = withEmptyLoadAssignments(clusters, endpoints)
 
 	var (
 		routeConfigs []*envoyapi.RouteConfiguration
@@ -106,6 +102,7 @@ ClusterLoop:
 	}
 
 	// run Cluster Generator Plugins
+	var generatedClusters []*envoyapi.Cluster
 	for _, plug := range t.plugins {
 		clusterGeneratorPlugin, ok := plug.(plugins.ClusterGeneratorPlugin)
 		if !ok {
@@ -115,14 +112,52 @@ ClusterLoop:
 		if err != nil {
 			resourceErrs.AddError(proxy, err)
 		}
-		clusters = append(clusters, generated...)
+		generatedClusters = append(generatedClusters, generated...)
+	}
+	// the default circuit breakers apply to the generated clusters too
+	for _, cluster := range generatedClusters {
+		if cluster.CircuitBreakers == nil {
+			cluster.CircuitBreakers = getCircuitBreakers(t.settings.GetCircuitBreakers())
+		}
+	}
+
+	translation := &cachedTranslation{
+		inputsHash:        inputsHash,
+		upstreamClusters:  clusters,
+		generatedClusters: generatedClusters,
+		routeConfigs:      routeConfigs,
+		listeners:         listeners,
+		resourceErrs:      resourceErrs,
 	}
+	t.cache.set(proxy, translation)
 
-	xdsSnapshot := generateXDSSnapshot(clusters, endpoints, routeConfigs, listeners)
+	xdsSnapshot := generateXDSSnapshot(params.Snapshot.Secrets, translation.clusters(), endpoints, routeConfigs, listeners)
 
 	return xdsSnapshot, resourceErrs, nil
 }
 
+// find all the eds clusters without endpoints (can happen with kube service that have no enpoints), and create a zero sized load assignment
+// this is important as otherwise envoy will wait for them forever wondering their fate and not doing much else.
+func withEmptyLoadAssignments(clusters []*envoyapi.Cluster, endpoints []*envoyapi.ClusterLoadAssignment) []*envoyapi.ClusterLoadAssignment {
+ClusterLoop:
+	for _, c := range clusters {
+		if c.GetType() != envoyapi.Cluster_EDS {
+			continue
+		}
+		for _, ep := range endpoints {
+			if ep.ClusterName == c.Name {
+				continue ClusterLoop
+			}
+		}
+		emptyendpointlist := &envoyapi.ClusterLoadAssignment{
+			ClusterName: c.Name,
+		}
+
+		endpoints = append(endpoints, emptyendpointlist)
+	}
+	return endpoints
+}
+
 // the set of resources returned by one iteration for a single v1.Listener
 // the top level Translate function should aggregate these into a finished snapshot
 type listenerResources struct {
@@ -151,7 +186,9 @@ func (t *translator) computeListenerResources(params plugins.Params, proxy *v1.P
 	}
 }
 
-func generateXDSSnapshot(clusters []*envoyapi.Cluster,
+// the secrets the clusters and listeners fetch by sds are built from the secrets
+func generateXDSSnapshot(secrets v1.SecretList,
+	clusters []*envoyapi.Cluster,
 	endpoints []*envoyapi.ClusterLoadAssignment,
 	routeConfigs []*envoyapi.RouteConfiguration,
 	listeners []*envoyapi.Listener) envoycache.Snapshot {
@@ -198,10 +235,44 @@ func generateXDSSnapshot(clusters []*envoyapi.Cluster,
 		panic(errors.Wrap(err, "constructing version hash for listeners envoy snapshot components"))
 	}
 
-	return xds.NewSnapshotFromResources(envoycache.NewResources(fmt.Sprintf("%v", endpointsVersion), endpointsProto),
-		envoycache.NewResources(fmt.Sprintf("%v", clustersVersion), clustersProto),
+	clustersResources := envoycache.NewResources(fmt.Sprintf("%v", clustersVersion), clustersProto)
+	listenersResources := envoycache.NewResources(fmt.Sprintf("%v", listenersVersion), listenersProto)
+
+	secretsProto := sdsSecrets(secrets, clustersResources, listenersResources)
+	secretsVersion, err := hashstructure.Hash(secretsProto, nil)
+	if err != nil {
+		panic(errors.Wrap(err, "constructing version hash for secrets envoy snapshot components"))
+	}
+
+	return xds.NewSnapshotWithSecrets(envoycache.NewResources(fmt.Sprintf("%v", endpointsVersion), endpointsProto),
+		clustersResources,
 		envoycache.NewResources(fmt.Sprintf("%v", routesVersion), routesProto),
-		envoycache.NewResources(fmt.Sprintf("%v", listenersVersion), listenersProto))
+		listenersResources,
+		envoycache.NewResources(fmt.Sprintf("%v", secretsVersion), secretsProto))
+}
+
+// the secrets referenced by sds. The secrets that fail to build are left out, as the translation of the resources
+// referencing them already reported it.
+func sdsSecrets(secrets v1.SecretList, clusters, listeners envoycache.Resources) []envoycache.Resource {
+	referenced := xds.GetSdsSecretReferences(clusters.Items)
+	for name := range xds.GetSdsSecretReferences(listeners.Items) {
+		referenced[name] = true
+	}
+	var names []string
+	for name := range referenced {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var secretsProto []envoycache.Resource
+	for _, name := range names {
+		secret, err := utils.SdsSecret(name, secrets)
+		if err != nil {
+			continue
+		}
+		secretsProto = append(secretsProto, xds.NewEnvoyResource(secret))
+	}
+	return secretsProto
 }
 
 func containsServiceDestinations(proxy *v1.Proxy) bool {
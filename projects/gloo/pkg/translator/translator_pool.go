@@ -0,0 +1,36 @@
+package translator
+
+import (
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	envoycache "github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
+	"github.com/solo-io/solo-kit/pkg/api/v1/reporter"
+)
+
+// NewTranslatorPool returns a translator that translates up to workers proxies concurrently. The plugins keep state
+// while translating a proxy, so that each worker translates with its own plugins, returned by newPlugins. The
+// translations are cached by all the workers.
+func NewTranslatorPool(newPlugins func() []plugins.Plugin, settings *v1.Settings, workers int) Translator {
+	if workers < 1 {
+		workers = 1
+	}
+	cache := newTranslationCache()
+	pool := make(chan *translator, workers)
+	for i := 0; i < workers; i++ {
+		t := NewTranslator(newPlugins(), settings).(*translator)
+		t.cache = cache
+		pool <- t
+	}
+	return &translatorPool{translators: pool}
+}
+
+type translatorPool struct {
+	// the translators that are not translating
+	translators chan *translator
+}
+
+func (p *translatorPool) Translate(params plugins.Params, proxy *v1.Proxy) (envoycache.Snapshot, reporter.ResourceErrors, error) {
+	t := <-p.translators
+	defer func() { p.translators <- t }()
+	return t.Translate(params, proxy)
+}
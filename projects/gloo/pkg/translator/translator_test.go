@@ -12,6 +12,7 @@ import (
 	. "github.com/solo-io/gloo/projects/gloo/pkg/translator"
 
 	envoycluster "github.com/envoyproxy/go-control-plane/envoy/api/v2/cluster"
+	envoycore "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
 	envoyhttp "github.com/envoyproxy/go-control-plane/envoy/config/filter/network/http_connection_manager/v2"
 	"github.com/gogo/protobuf/types"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
@@ -173,6 +174,48 @@ var _ = Describe("Translator", func() {
 			Expect(oldVersion).ToNot(Equal(newVersion))
 		})
 	})
+
+	Context("cluster caching", func() {
+		BeforeEach(func() {
+			upstream.UpstreamSpec.UpstreamType.(*v1.UpstreamSpec_Static).Static.Hosts[0].Addr = "1.2.3.4"
+		})
+
+		changeProxy := func() {
+			virtualHost := proxy.Listeners[0].ListenerType.(*v1.Listener_HttpListener).HttpListener.VirtualHosts[0]
+			virtualHost.Domains = []string{"example.com"}
+		}
+
+		It("reuses the cluster of an unchanged upstream when the proxy changes", func() {
+			translate()
+			oldCluster := cluster
+
+			changeProxy()
+			translate()
+			Expect(cluster).To(BeIdenticalTo(oldCluster))
+		})
+
+		It("translates the cluster of a changed upstream again", func() {
+			translate()
+			oldCluster := cluster
+
+			upstream.UpstreamSpec.UpstreamType.(*v1.UpstreamSpec_Static).Static.UseHttp2 = true
+			translate()
+			Expect(cluster).NotTo(BeIdenticalTo(oldCluster))
+			Expect(cluster.Http2ProtocolOptions).NotTo(BeNil())
+		})
+
+		It("translates the cluster again when a plugin keeps state about the upstream", func() {
+			// the static plugin records the upstreams with a hostname to rewrite the host of their routes
+			upstream.UpstreamSpec.UpstreamType.(*v1.UpstreamSpec_Static).Static.Hosts[0].Addr = "example.com"
+			translate()
+			oldCluster := cluster
+
+			changeProxy()
+			translate()
+			Expect(cluster).NotTo(BeIdenticalTo(oldCluster))
+			Expect(route_configuration.VirtualHosts[0].Routes[0].GetRoute().GetAutoHostRewrite().GetValue()).To(BeTrue())
+		})
+	})
 	Context("route header match", func() {
 		It("should translate header matcher with no value to a PresentMatch", func() {
 
@@ -309,6 +352,116 @@ var _ = Describe("Translator", func() {
 			Expect(cluster.CircuitBreakers).To(BeEquivalentTo(expectedCircuitBreakers))
 		})
 
+		It("should only override the thresholds set on upstream", func() {
+
+			settings.CircuitBreakers = &v1.CircuitBreakerConfig{
+				MaxConnections:     &types.UInt32Value{Value: 11},
+				MaxPendingRequests: &types.UInt32Value{Value: 12},
+				MaxRetries:         &types.UInt32Value{Value: 14},
+			}
+
+			upstream.UpstreamSpec.CircuitBreakers = &v1.CircuitBreakerConfig{
+				MaxConnections: &types.UInt32Value{Value: 1},
+				MaxRequests:    &types.UInt32Value{Value: 3},
+			}
+
+			expectedCircuitBreakers := &envoycluster.CircuitBreakers{
+				Thresholds: []*envoycluster.CircuitBreakers_Thresholds{
+					{
+						MaxConnections:     &types.UInt32Value{Value: 1},
+						MaxPendingRequests: &types.UInt32Value{Value: 12},
+						MaxRequests:        &types.UInt32Value{Value: 3},
+						MaxRetries:         &types.UInt32Value{Value: 14},
+					},
+				},
+			}
+			translate()
+
+			Expect(cluster.CircuitBreakers).To(BeEquivalentTo(expectedCircuitBreakers))
+		})
+
+	})
+
+	Context("alpn", func() {
+
+		BeforeEach(func() {
+			upstream.UpstreamSpec.SslConfig = &v1.UpstreamSslConfig{
+				SslSecrets: &v1.UpstreamSslConfig_SslFiles{
+					SslFiles: &v1.SSLFiles{
+						RootCa: "/etc/ssl/ca.crt",
+					},
+				},
+			}
+		})
+
+		It("should not offer alpn protocols to http1 upstreams", func() {
+			translate()
+			Expect(cluster.TlsContext.CommonTlsContext.AlpnProtocols).To(BeEmpty())
+		})
+
+		It("should offer h2 to http2 upstreams", func() {
+			upstream.UpstreamSpec.ConnectionConfig = &v1.ConnectionConfig{
+				Http2Settings: &v1.ConnectionConfig_Http2Settings{},
+			}
+			translate()
+			Expect(cluster.TlsContext.CommonTlsContext.AlpnProtocols).To(Equal([]string{"h2"}))
+		})
+
+		It("should offer the alpn protocols set on the upstream", func() {
+			upstream.UpstreamSpec.ConnectionConfig = &v1.ConnectionConfig{
+				Http2Settings: &v1.ConnectionConfig_Http2Settings{},
+			}
+			upstream.UpstreamSpec.SslConfig.AlpnProtocols = []string{"h2", "http/1.1"}
+			translate()
+			Expect(cluster.TlsContext.CommonTlsContext.AlpnProtocols).To(Equal([]string{"h2", "http/1.1"}))
+		})
+	})
+
+	Context("annotations metadata", func() {
+
+		BeforeEach(func() {
+			upstream.Metadata.Annotations = map[string]string{
+				"example.com/team":  "payments",
+				"example.com/tier":  "gold",
+				"other.com/tier":    "silver",
+				"owner":             "alice",
+				"owner.example.com": "bob",
+			}
+			routes[0].Annotations = map[string]string{
+				"example.com/team": "checkout",
+				"owner":            "carol",
+			}
+		})
+
+		It("should not copy annotations by default", func() {
+			translate()
+			Expect(cluster.Metadata.FilterMetadata).NotTo(HaveKey(AnnotationsMetadataKey))
+			Expect(route_configuration.VirtualHosts[0].Routes[0].Metadata).To(BeNil())
+		})
+
+		It("should copy the selected annotations to the cluster and route metadata", func() {
+			settings.MetadataAnnotations = []string{"example.com/", "owner"}
+			translate()
+
+			stringValue := func(s string) *types.Value {
+				return &types.Value{Kind: &types.Value_StringValue{StringValue: s}}
+			}
+			Expect(cluster.Metadata.FilterMetadata[AnnotationsMetadataKey]).To(Equal(&types.Struct{
+				Fields: map[string]*types.Value{
+					"example.com/team": stringValue("payments"),
+					"example.com/tier": stringValue("gold"),
+					"owner":            stringValue("alice"),
+				},
+			}))
+			routeMetadata := route_configuration.VirtualHosts[0].Routes[0].Metadata
+			Expect(routeMetadata).NotTo(BeNil())
+			Expect(routeMetadata.FilterMetadata[AnnotationsMetadataKey]).To(Equal(&types.Struct{
+				Fields: map[string]*types.Value{
+					"example.com/team": stringValue("checkout"),
+					"owner":            stringValue("carol"),
+				},
+			}))
+		})
 	})
 
 	Context("when handling upstream groups", func() {
@@ -403,6 +556,63 @@ var _ = Describe("Translator", func() {
 
 	})
 
+	Context("cluster names", func() {
+		var (
+			first, second *v1.Upstream
+		)
+		BeforeEach(func() {
+			// test_gloo_system is the cluster name of both upstreams
+			first = &v1.Upstream{
+				Metadata:     core.Metadata{Name: "test", Namespace: "gloo_system"},
+				UpstreamSpec: upstream.UpstreamSpec,
+			}
+			second = &v1.Upstream{
+				Metadata:     core.Metadata{Name: "test_gloo", Namespace: "system"},
+				UpstreamSpec: upstream.UpstreamSpec,
+			}
+			params.Snapshot.Upstreams = append(params.Snapshot.Upstreams, second, first)
+		})
+
+		It("should report the upstreams whose cluster name collides", func() {
+			snap, errs, err := translator.Translate(params, proxy)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(errs[first]).To(BeNil())
+			Expect(errs[second]).To(HaveOccurred())
+			Expect(errs[second].Error()).To(ContainSubstring("the cluster name test_gloo_system of the upstream is the cluster name of upstream gloo_system.test"))
+
+			clusters := snap.GetResources(xds.ClusterType)
+			Expect(clusters.Items).To(HaveLen(2))
+			Expect(clusters.Items).To(HaveKey("test_gloo_system"))
+		})
+
+		It("should error the routes to the upstreams whose cluster name collides", func() {
+			routes[0].Action.(*v1.Route_RouteAction).RouteAction.Destination = &v1.RouteAction_Single{
+				Single: &v1.Destination{
+					DestinationType: &v1.Destination_Upstream{
+						Upstream: utils.ResourceRefPtr(second.Metadata.Ref()),
+					},
+				},
+			}
+			_, errs, err := translator.Translate(params, proxy)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(errs[proxy]).To(HaveOccurred())
+			Expect(errs[proxy].Error()).To(ContainSubstring("the cluster name test_gloo_system of upstream system.test_gloo is the cluster name of upstream gloo_system.test"))
+		})
+
+		It("should route to the upstream keeping the cluster name", func() {
+			routes[0].Action.(*v1.Route_RouteAction).RouteAction.Destination = &v1.RouteAction_Single{
+				Single: &v1.Destination{
+					DestinationType: &v1.Destination_Upstream{
+						Upstream: utils.ResourceRefPtr(first.Metadata.Ref()),
+					},
+				},
+			}
+			_, errs, err := translator.Translate(params, proxy)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(errs[proxy]).NotTo(HaveOccurred())
+		})
+	})
+
 	Context("when handling subsets", func() {
 		var (
 			cla_configuration *envoyapi.ClusterLoadAssignment
@@ -512,6 +722,92 @@ var _ = Describe("Translator", func() {
 			Expect(fields).To(HaveKeyWithValue("testkey", sv("")))
 		})
 
+		Context("when only the endpoints change", func() {
+			endpointAddress := func() string {
+				return cla_configuration.Endpoints[0].LbEndpoints[0].GetEndpoint().GetAddress().GetSocketAddress().GetAddress()
+			}
+
+			It("should update the endpoints and reuse the other resources", func() {
+				translateWithEndpoints()
+				oldCluster := cluster
+				oldClustersVersion := snapshot.GetResources(xds.ClusterType).Version
+				oldRoutesVersion := snapshot.GetResources(xds.RouteType).Version
+				Expect(endpointAddress()).To(Equal("1.2.3.4"))
+
+				params.Snapshot.Endpoints[0].Address = "5.6.7.8"
+				translateWithEndpoints()
+				Expect(endpointAddress()).To(Equal("5.6.7.8"))
+				Expect(cluster).To(BeIdenticalTo(oldCluster))
+				Expect(snapshot.GetResources(xds.ClusterType).Version).To(Equal(oldClustersVersion))
+				Expect(snapshot.GetResources(xds.RouteType).Version).To(Equal(oldRoutesVersion))
+			})
+
+			It("should translate the proxy again when an upstream changes", func() {
+				translateWithEndpoints()
+				oldCluster := cluster
+
+				subsetSpec := upstream.UpstreamSpec.GetKube().SubsetSpec
+				subsetSpec.Selectors = append(subsetSpec.Selectors, &v1plugins.Selector{Keys: []string{"otherkey"}})
+				translateWithEndpoints()
+				Expect(cluster).NotTo(BeIdenticalTo(oldCluster))
+				Expect(cluster.LbSubsetConfig.SubsetSelectors).To(HaveLen(2))
+				Expect(cluster.LbSubsetConfig.SubsetSelectors[1].Keys).To(Equal([]string{"otherkey"}))
+			})
+		})
+
+		Context("localities", func() {
+			var (
+				ref core.ResourceRef
+			)
+			BeforeEach(func() {
+				ref = upstream.Metadata.Ref()
+				params.Snapshot.Endpoints[0].Locality = &v1.Locality{Region: "us-east1", Zone: "us-east1-b"}
+				params.Snapshot.Endpoints = append(params.Snapshot.Endpoints, &v1.Endpoint{
+					Metadata:  core.Metadata{Name: "test2", Namespace: "gloo-system"},
+					Upstreams: []*core.ResourceRef{&ref},
+					Address:   "5.6.7.8",
+					Port:      1234,
+					Locality:  &v1.Locality{Region: "us-east1", Zone: "us-east1-c"},
+				})
+			})
+
+			loadAssignment := func() *envoyapi.ClusterLoadAssignment {
+				translate()
+				endpoints := snapshot.GetResources(xds.EndpointType)
+				clusterName := UpstreamToClusterName(upstream.Metadata.Ref())
+				Expect(endpoints.Items).To(HaveKey(clusterName))
+				return endpoints.Items[clusterName].ResourceProto().(*envoyapi.ClusterLoadAssignment)
+			}
+
+			It("should group the endpoints by locality", func() {
+				cla := loadAssignment()
+				Expect(cla.Endpoints).To(HaveLen(2))
+				Expect(cla.Endpoints[0].Locality).To(Equal(&envoycore.Locality{Region: "us-east1", Zone: "us-east1-b"}))
+				Expect(cla.Endpoints[1].Locality).To(Equal(&envoycore.Locality{Region: "us-east1", Zone: "us-east1-c"}))
+				Expect(cla.Endpoints[0].Priority).To(BeEquivalentTo(0))
+				Expect(cla.Endpoints[1].Priority).To(BeEquivalentTo(0))
+				Expect(cla.Endpoints[0].LoadBalancingWeight).To(BeNil())
+			})
+
+			It("should prioritize the localities and fail over to the others", func() {
+				upstream.UpstreamSpec.LoadBalancerConfig = &v1.LoadBalancerConfig{
+					LocalityPriorities: []*v1.LoadBalancerConfig_LocalityPriority{{
+						Locality: &v1.Locality{Zone: "us-east1-c"},
+						Priority: 3,
+						Weight:   5,
+					}},
+					LocalityWeighted: true,
+				}
+				cla := loadAssignment()
+				Expect(cla.Endpoints).To(HaveLen(2))
+				// the priorities are made contiguous
+				Expect(cla.Endpoints[1].Priority).To(BeEquivalentTo(0))
+				Expect(cla.Endpoints[0].Priority).To(BeEquivalentTo(1))
+				Expect(cla.Endpoints[1].LoadBalancingWeight.Value).To(BeEquivalentTo(5))
+				Expect(cla.Endpoints[0].LoadBalancingWeight.Value).To(BeEquivalentTo(1))
+			})
+		})
+
 		Context("bad route", func() {
 			BeforeEach(func() {
 				routes = []*v1.Route{{
@@ -1,20 +1,12 @@
 package translator
 
 import (
-	fmt "fmt"
-
 	envoylistener "github.com/envoyproxy/go-control-plane/envoy/api/v2/listener"
 	envoyutil "github.com/envoyproxy/go-control-plane/pkg/util"
 	"github.com/gogo/protobuf/proto"
 	"github.com/gogo/protobuf/types"
-	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 )
 
-func UpstreamToClusterName(upstream core.ResourceRef) string {
-	// Don't use dots in the name as it messes up prometheus stats
-	return fmt.Sprintf("%s_%s", upstream.Name, upstream.Namespace)
-}
-
 func NewFilterWithConfig(name string, config proto.Message) (envoylistener.Filter, error) {
 
 	s := envoylistener.Filter{
@@ -7,7 +7,7 @@ import (
 )
 
 // Delegates all the function calls to the underlying client in case of real upstreams and does nothing in case of
-// service-derived upstreams.
+// service-derived, file and consul upstreams.
 //
 // NOTE: This is only to be used in reporters, which only call the Write function
 type readOnlyUpstreamBaseClient struct {
@@ -0,0 +1,165 @@
+package upstreams
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/consul/api"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	consulplugin "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/consul"
+	"github.com/solo-io/go-utils/errors"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+// Contains invalid character so any accidental attempt to write to storage fails
+const ConsulUpstreamNamePrefix = "consul-svc:"
+
+func isConsulUpstream(upstreamName string) bool {
+	return strings.HasPrefix(upstreamName, ConsulUpstreamNamePrefix)
+}
+
+// Lists the services of the Consul catalog
+type ConsulServiceClient interface {
+	// Returns the names of the services once the catalog changed since the wait index, and the index of the
+	// returned services. Returns right away if the wait index is 0.
+	Services(ctx context.Context, waitIndex uint64) ([]string, uint64, error)
+}
+
+func NewConsulServiceClient(client *api.Client) ConsulServiceClient {
+	return &consulServiceClient{client: client}
+}
+
+type consulServiceClient struct {
+	client *api.Client
+}
+
+func (c *consulServiceClient) Services(ctx context.Context, waitIndex uint64) ([]string, uint64, error) {
+	opts := (&api.QueryOptions{RequireConsistent: true, WaitIndex: waitIndex}).WithContext(ctx)
+	services, meta, err := c.client.Catalog().Services(opts)
+	if err != nil {
+		return nil, waitIndex, errors.Wrapf(err, "listing consul services")
+	}
+	var names []string
+	for name := range services {
+		names = append(names, name)
+	}
+	return names, meta.LastIndex, nil
+}
+
+// Synthesizes an upstream named `consul-svc:<service>` for each service of the Consul catalog, routing to all its
+// instances. Consul has no namespaces, the upstreams are all in the given namespace.
+type ConsulUpstreamSource struct {
+	client    ConsulServiceClient
+	namespace string
+}
+
+func NewConsulUpstreamSource(client ConsulServiceClient, namespace string) *ConsulUpstreamSource {
+	return &ConsulUpstreamSource{
+		client:    client,
+		namespace: namespace,
+	}
+}
+
+func (s *ConsulUpstreamSource) Read(namespace, name string, opts clients.ReadOpts) (*v1.Upstream, error) {
+	list, err := s.List(namespace, clients.ListOpts{Ctx: opts.Ctx})
+	if err != nil {
+		return nil, err
+	}
+	return list.Find(namespace, name)
+}
+
+// Lists the upstreams of the services if the namespace is empty or the namespace of the source
+func (s *ConsulUpstreamSource) List(namespace string, opts clients.ListOpts) (v1.UpstreamList, error) {
+	opts = opts.WithDefaults()
+	if namespace != "" && namespace != s.namespace {
+		return nil, nil
+	}
+	services, _, err := s.client.Services(opts.Ctx, 0)
+	if err != nil {
+		return nil, err
+	}
+	return s.consulServicesToUpstreams(services), nil
+}
+
+// Sends the upstreams of the services once, then whenever the catalog changes. The catalog is listed again after the
+// refresh rate of the options if it can't be.
+func (s *ConsulUpstreamSource) Watch(namespace string, opts clients.WatchOpts) (<-chan v1.UpstreamList, <-chan error, error) {
+	opts = opts.WithDefaults()
+	ctx := opts.Ctx
+
+	upstreamsChan := make(chan v1.UpstreamList)
+	errs := make(chan error)
+	if namespace != "" && namespace != s.namespace {
+		// no upstream in the namespace
+		go func() {
+			<-ctx.Done()
+			close(upstreamsChan)
+			close(errs)
+		}()
+		return upstreamsChan, errs, nil
+	}
+
+	go func() {
+		defer close(upstreamsChan)
+		defer close(errs)
+
+		var index uint64
+		for {
+			services, nextIndex, err := s.client.Services(ctx, index)
+			if ctx.Err() != nil {
+				return
+			}
+			if err != nil {
+				select {
+				case <-ctx.Done():
+					return
+				case errs <- err:
+				}
+				select {
+				case <-ctx.Done():
+					return
+				case <-time.After(opts.RefreshRate):
+				}
+				continue
+			}
+			if index != 0 && nextIndex == index {
+				// the wait of the blocking query expired
+				continue
+			}
+			index = nextIndex
+
+			select {
+			case <-ctx.Done():
+				return
+			case upstreamsChan <- s.consulServicesToUpstreams(services):
+			}
+		}
+	}()
+
+	return upstreamsChan, errs, nil
+}
+
+func (s *ConsulUpstreamSource) consulServicesToUpstreams(services []string) v1.UpstreamList {
+	sort.Strings(services)
+	var result v1.UpstreamList
+	for _, service := range services {
+		result = append(result, &v1.Upstream{
+			Metadata: core.Metadata{
+				Name:      ConsulUpstreamNamePrefix + service,
+				Namespace: s.namespace,
+			},
+			UpstreamSpec: &v1.UpstreamSpec{
+				UpstreamType: &v1.UpstreamSpec_Consul{
+					Consul: &consulplugin.UpstreamSpec{
+						ServiceName: service,
+					},
+				},
+			},
+			DiscoveryMetadata: &v1.DiscoveryMetadata{},
+		})
+	}
+	return result
+}
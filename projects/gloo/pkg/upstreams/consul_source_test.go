@@ -0,0 +1,123 @@
+package upstreams_test
+
+import (
+	"context"
+	"sync"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/upstreams"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/factory"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/memory"
+)
+
+// the catalog changes when services are set
+type fakeConsulClient struct {
+	lock     sync.Mutex
+	services []string
+	index    uint64
+	changed  chan struct{}
+}
+
+func newFakeConsulClient(services ...string) *fakeConsulClient {
+	return &fakeConsulClient{services: services, index: 1, changed: make(chan struct{})}
+}
+
+func (c *fakeConsulClient) setServices(services ...string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.services = services
+	c.index++
+	close(c.changed)
+	c.changed = make(chan struct{})
+}
+
+func (c *fakeConsulClient) Services(ctx context.Context, waitIndex uint64) ([]string, uint64, error) {
+	c.lock.Lock()
+	if waitIndex == c.index {
+		changed := c.changed
+		c.lock.Unlock()
+		select {
+		case <-ctx.Done():
+			return nil, waitIndex, ctx.Err()
+		case <-changed:
+		}
+		c.lock.Lock()
+	}
+	defer c.lock.Unlock()
+	return append([]string{}, c.services...), c.index, nil
+}
+
+var _ = Describe("ConsulUpstreamSource", func() {
+
+	var (
+		ctx          context.Context
+		cancel       context.CancelFunc
+		consulClient *fakeConsulClient
+		hybridClient v1.UpstreamClient
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		consulClient = newFakeConsulClient("redis", "api")
+
+		inMemoryFactory := &factory.MemoryResourceClientFactory{
+			Cache: memory.NewInMemoryResourceCache(),
+		}
+		baseUsClient, err := v1.NewUpstreamClient(inMemoryFactory)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = baseUsClient.Write(getUpstream("us-1", "gloo-system", "svc-1", "gloo-system", 1234), clients.WriteOpts{Ctx: ctx})
+		Expect(err).NotTo(HaveOccurred())
+
+		consulSource := upstreams.NewConsulUpstreamSource(consulClient, "gloo-system")
+		hybridClient, err = upstreams.NewHybridUpstreamClient(baseUsClient, upstreams.HybridUpstreamOptions{ConsulSource: consulSource})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	It("lists an upstream per consul service", func() {
+		list, err := hybridClient.List("gloo-system", clients.ListOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(list.Names()).To(ConsistOf("us-1", "consul-svc:api", "consul-svc:redis"))
+
+		list, err = hybridClient.List("other-namespace", clients.ListOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(list).To(BeEmpty())
+	})
+
+	It("reads the upstream of a consul service", func() {
+		us, err := hybridClient.Read("gloo-system", "consul-svc:redis", clients.ReadOpts{Ctx: ctx})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(us.UpstreamSpec.GetConsul().ServiceName).To(Equal("redis"))
+
+		_, err = hybridClient.Read("gloo-system", "consul-svc:unknown", clients.ReadOpts{Ctx: ctx})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("does not write the upstreams of consul services", func() {
+		us, err := hybridClient.Read("gloo-system", "consul-svc:redis", clients.ReadOpts{Ctx: ctx})
+		Expect(err).NotTo(HaveOccurred())
+		_, err = hybridClient.Write(us, clients.WriteOpts{Ctx: ctx})
+		Expect(err).NotTo(HaveOccurred())
+		err = hybridClient.Delete("gloo-system", "consul-svc:redis", clients.DeleteOpts{Ctx: ctx})
+		Expect(err).NotTo(HaveOccurred())
+
+		list, err := hybridClient.List("gloo-system", clients.ListOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(list).To(HaveLen(3))
+	})
+
+	It("watches the consul services", func() {
+		usChan, _, err := hybridClient.Watch("gloo-system", clients.WatchOpts{Ctx: ctx})
+		Expect(err).NotTo(HaveOccurred())
+		Eventually(usChan).Should(Receive(HaveLen(3)))
+
+		consulClient.setServices("redis", "api", "billing")
+		Eventually(usChan).Should(Receive(HaveLen(4)))
+	})
+})
@@ -20,7 +20,7 @@ import (
 const ServiceUpstreamNamePrefix = "svc:"
 
 func isRealUpstream(upstreamName string) bool {
-	return !strings.HasPrefix(upstreamName, ServiceUpstreamNamePrefix)
+	return !strings.HasPrefix(upstreamName, ServiceUpstreamNamePrefix) && !isFileUpstream(upstreamName) && !isConsulUpstream(upstreamName)
 }
 
 func buildFakeUpstreamName(serviceName string, port int32) string {
@@ -66,8 +66,10 @@ func serviceToUpstream(svc *kubev1.Service, port kubev1.ServicePort) *gloov1.Ups
 					ServiceName:      svc.Name,
 					ServiceNamespace: svc.Namespace,
 					ServicePort:      uint32(port.Port),
+					SubsetSpec:       kubeplugin.GetSubsetSpec(svc),
 				},
 			},
+			SslConfig: kubeplugin.GetSslConfig(svc, port),
 		},
 		DiscoveryMetadata: &v1.DiscoveryMetadata{},
 	}
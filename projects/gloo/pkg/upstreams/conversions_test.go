@@ -3,6 +3,7 @@ package upstreams
 import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	kubeplugin "github.com/solo-io/gloo/projects/gloo/pkg/plugins/kubernetes"
 	skkube "github.com/solo-io/solo-kit/pkg/api/v1/resources/common/kubernetes"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
@@ -66,4 +67,25 @@ var _ = Describe("Conversions", func() {
 		Expect(usList[1].UpstreamSpec.GetKube().ServiceNamespace).To(Equal("ns-1"))
 		Expect(usList[1].UpstreamSpec.GetKube().ServicePort).To(BeEquivalentTo(8081))
 	})
+
+	It("honors the protocols of the ports of the services", func() {
+		svc := skkube.NewService("ns-1", "svc-1")
+		svc.Annotations = map[string]string{kubeplugin.GlooAppProtocolsAnnotation: "8443=https"}
+		svc.Spec = corev1.ServiceSpec{
+			Ports: []corev1.ServicePort{
+				{Name: "grpc-api", Port: 9090},
+				{Name: "web", Port: 8443},
+				{Name: "http", Port: 8080},
+			},
+		}
+		usList := servicesToUpstreams(skkube.ServiceList{svc}).Sort()
+		Expect(usList).To(HaveLen(3))
+		Expect(usList[0].Metadata.Name).To(Equal("svc:svc-1-8080"))
+		Expect(usList[0].UpstreamSpec.GetKube().ServiceSpec).To(BeNil())
+		Expect(usList[0].UpstreamSpec.SslConfig).To(BeNil())
+		Expect(usList[1].Metadata.Name).To(Equal("svc:svc-1-8443"))
+		Expect(usList[1].UpstreamSpec.SslConfig.GetSslFiles()).NotTo(BeNil())
+		Expect(usList[2].Metadata.Name).To(Equal("svc:svc-1-9090"))
+		Expect(usList[2].UpstreamSpec.GetKube().ServiceSpec.GetGrpc()).NotTo(BeNil())
+	})
 })
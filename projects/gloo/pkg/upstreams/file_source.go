@@ -0,0 +1,209 @@
+package upstreams
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/go-utils/errors"
+	"github.com/solo-io/go-utils/protoutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"gopkg.in/fsnotify/fsnotify.v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// Contains invalid character so any accidental attempt to write to storage fails
+const FileUpstreamNamePrefix = "file:"
+
+// separates the upstreams of a file
+var documentSeparator = regexp.MustCompile(`(?m)^---\s*$`)
+
+func isFileUpstream(upstreamName string) bool {
+	return strings.HasPrefix(upstreamName, FileUpstreamNamePrefix)
+}
+
+// Reads static upstreams from the YAML or JSON files of a directory. The upstreams of a file are named after the name
+// in the file, prefixed with FileUpstreamNamePrefix. Those without a namespace are in the default namespace.
+type FileUpstreamSource struct {
+	directory        string
+	defaultNamespace string
+}
+
+func NewFileUpstreamSource(directory, defaultNamespace string) *FileUpstreamSource {
+	return &FileUpstreamSource{
+		directory:        directory,
+		defaultNamespace: defaultNamespace,
+	}
+}
+
+func (s *FileUpstreamSource) Read(namespace, name string, opts clients.ReadOpts) (*v1.Upstream, error) {
+	list, err := s.List(namespace, clients.ListOpts{})
+	if err != nil {
+		return nil, err
+	}
+	return list.Find(namespace, name)
+}
+
+// Lists the upstreams of the namespace, or of all the namespaces if empty, with the labels of the selector
+func (s *FileUpstreamSource) List(namespace string, opts clients.ListOpts) (v1.UpstreamList, error) {
+	all, err := s.readAll()
+	if err != nil {
+		return nil, err
+	}
+	selector := labels.SelectorFromSet(opts.Selector)
+	var result v1.UpstreamList
+	for _, us := range all {
+		if namespace != "" && us.Metadata.Namespace != namespace {
+			continue
+		}
+		if !selector.Matches(labels.Set(us.Metadata.Labels)) {
+			continue
+		}
+		result = append(result, us)
+	}
+	return result, nil
+}
+
+// Sends the upstreams of the namespace once, then whenever the files of the directory change. The upstreams are not
+// sent again while a file can't be read, the error is sent instead.
+func (s *FileUpstreamSource) Watch(namespace string, opts clients.WatchOpts) (<-chan v1.UpstreamList, <-chan error, error) {
+	opts = opts.WithDefaults()
+	ctx := contextutils.WithLogger(opts.Ctx, "upstream-files")
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "creating watcher for upstream directory %v", s.directory)
+	}
+	if err := watcher.Add(s.directory); err != nil {
+		watcher.Close()
+		return nil, nil, errors.Wrapf(err, "watching upstream directory %v", s.directory)
+	}
+
+	upstreamsChan := make(chan v1.UpstreamList)
+	errs := make(chan error)
+	send := func() {
+		list, err := s.List(namespace, clients.ListOpts{Ctx: ctx, Selector: opts.Selector})
+		if err != nil {
+			select {
+			case <-ctx.Done():
+			case errs <- err:
+			}
+			return
+		}
+		select {
+		case <-ctx.Done():
+		case upstreamsChan <- list:
+		}
+	}
+
+	go func() {
+		defer watcher.Close()
+		defer close(upstreamsChan)
+		defer close(errs)
+		// watch should open up with an initial read
+		send()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event, ok := <-watcher.Events:
+				if !ok {
+					return
+				}
+				contextutils.LoggerFrom(ctx).Debugw("upstream file changed", "file", event.Name, "op", event.Op.String())
+				send()
+			case err, ok := <-watcher.Errors:
+				if !ok {
+					return
+				}
+				select {
+				case <-ctx.Done():
+					return
+				case errs <- errors.Wrapf(err, "watching upstream directory %v", s.directory):
+				}
+			}
+		}
+	}()
+
+	return upstreamsChan, errs, nil
+}
+
+// reads the upstreams of all the files of the directory, sorted by namespace and name
+func (s *FileUpstreamSource) readAll() (v1.UpstreamList, error) {
+	files, err := ioutil.ReadDir(s.directory)
+	if err != nil {
+		return nil, errors.Wrapf(err, "reading upstream directory %v", s.directory)
+	}
+	var result v1.UpstreamList
+	for _, file := range files {
+		if file.IsDir() {
+			continue
+		}
+		switch filepath.Ext(file.Name()) {
+		case ".yaml", ".yml", ".json":
+		default:
+			continue
+		}
+		path := filepath.Join(s.directory, file.Name())
+		upstreams, err := s.readFile(path)
+		if err != nil {
+			return nil, errors.Wrapf(err, "reading upstream file %v", path)
+		}
+		result = append(result, upstreams...)
+	}
+	if dups := duplicateUpstreams(result); len(dups) > 0 {
+		return nil, errors.Errorf("upstreams %v are defined more than once in upstream directory %v", dups, s.directory)
+	}
+	return result.Sort(), nil
+}
+
+func (s *FileUpstreamSource) readFile(path string) (v1.UpstreamList, error) {
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var result v1.UpstreamList
+	for _, doc := range documentSeparator.Split(string(contents), -1) {
+		if strings.TrimSpace(doc) == "" {
+			continue
+		}
+		var us v1.Upstream
+		if err := protoutils.UnmarshalYaml([]byte(doc), &us); err != nil {
+			return nil, err
+		}
+		if us.Metadata.Name == "" {
+			return nil, errors.Errorf("upstream without a name")
+		}
+		if us.UpstreamSpec == nil {
+			return nil, errors.Errorf("upstream %v without an upstream spec", us.Metadata.Name)
+		}
+		us.Metadata.Name = FileUpstreamNamePrefix + us.Metadata.Name
+		if us.Metadata.Namespace == "" {
+			us.Metadata.Namespace = s.defaultNamespace
+		}
+		// the files are the source of truth, there is no version to compare writes against
+		us.Metadata.ResourceVersion = ""
+		result = append(result, &us)
+	}
+	return result, nil
+}
+
+// the keys of the upstreams defined more than once, sorted
+func duplicateUpstreams(upstreams v1.UpstreamList) []string {
+	counts := make(map[string]int)
+	for _, us := range upstreams {
+		counts[us.Metadata.Ref().Key()]++
+	}
+	var dups []string
+	for key, count := range counts {
+		if count > 1 {
+			dups = append(dups, key)
+		}
+	}
+	sort.Strings(dups)
+	return dups
+}
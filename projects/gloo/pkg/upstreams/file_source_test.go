@@ -0,0 +1,136 @@
+package upstreams_test
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/upstreams"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/factory"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/memory"
+)
+
+const petstoreFile = `
+metadata:
+  name: petstore
+upstreamSpec:
+  static:
+    hosts:
+    - addr: 10.0.0.1
+      port: 8080
+---
+metadata:
+  name: inventory
+  namespace: other-namespace
+upstreamSpec:
+  static:
+    hosts:
+    - addr: 10.0.0.2
+      port: 9090
+`
+
+const billingFile = `
+metadata:
+  name: billing
+upstreamSpec:
+  static:
+    hosts:
+    - addr: billing.internal
+      port: 443
+    useTls: true
+`
+
+var _ = Describe("FileUpstreamSource", func() {
+
+	var (
+		ctx        context.Context
+		cancel     context.CancelFunc
+		dir        string
+		fileSource *upstreams.FileUpstreamSource
+	)
+
+	writeFile := func(name, contents string) {
+		err := ioutil.WriteFile(filepath.Join(dir, name), []byte(contents), 0644)
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		var err error
+		dir, err = ioutil.TempDir("", "upstream-files")
+		Expect(err).NotTo(HaveOccurred())
+		fileSource = upstreams.NewFileUpstreamSource(dir, "gloo-system")
+		writeFile("petstore.yaml", petstoreFile)
+		writeFile("README.md", "not an upstream")
+	})
+
+	AfterEach(func() {
+		cancel()
+		os.RemoveAll(dir)
+	})
+
+	It("reads the upstreams of the files", func() {
+		list, err := fileSource.List("", clients.ListOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(list.NamespacesDotNames()).To(Equal([]string{"gloo-system.file:petstore", "other-namespace.file:inventory"}))
+		Expect(list[0].UpstreamSpec.GetStatic().Hosts[0].Addr).To(Equal("10.0.0.1"))
+
+		list, err = fileSource.List("gloo-system", clients.ListOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(list).To(HaveLen(1))
+	})
+
+	It("fails on upstreams defined more than once", func() {
+		writeFile("copy.yaml", petstoreFile)
+		_, err := fileSource.List("", clients.ListOpts{})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("sends the upstreams again when the files change", func() {
+		usChan, _, err := fileSource.Watch("gloo-system", clients.WatchOpts{Ctx: ctx})
+		Expect(err).NotTo(HaveOccurred())
+		Eventually(usChan).Should(Receive(HaveLen(1)))
+
+		writeFile("billing.yml", billingFile)
+		Eventually(usChan).Should(Receive(HaveLen(2)))
+	})
+
+	It("merges the upstreams of the files in the hybrid client", func() {
+		inMemoryFactory := &factory.MemoryResourceClientFactory{
+			Cache: memory.NewInMemoryResourceCache(),
+		}
+		baseUsClient, err := v1.NewUpstreamClient(inMemoryFactory)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = baseUsClient.Write(getUpstream("us-1", "gloo-system", "svc-1", "gloo-system", 1234), clients.WriteOpts{Ctx: ctx})
+		Expect(err).NotTo(HaveOccurred())
+
+		hybridClient, err := upstreams.NewHybridUpstreamClient(baseUsClient, upstreams.HybridUpstreamOptions{FileSource: fileSource})
+		Expect(err).NotTo(HaveOccurred())
+
+		list, err := hybridClient.List("gloo-system", clients.ListOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(list.Names()).To(ConsistOf("us-1", "file:petstore"))
+
+		us, err := hybridClient.Read("gloo-system", "file:petstore", clients.ReadOpts{Ctx: ctx})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(us.UpstreamSpec.GetStatic()).NotTo(BeNil())
+
+		// file upstreams are read only
+		err = hybridClient.Delete("gloo-system", "file:petstore", clients.DeleteOpts{Ctx: ctx})
+		Expect(err).NotTo(HaveOccurred())
+		list, err = hybridClient.List("gloo-system", clients.ListOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(list).To(HaveLen(2))
+
+		usChan, _, err := hybridClient.Watch("gloo-system", clients.WatchOpts{Ctx: ctx})
+		Expect(err).NotTo(HaveOccurred())
+		Eventually(usChan).Should(Receive(HaveLen(2)))
+		writeFile("billing.yml", billingFile)
+		Eventually(usChan).Should(Receive(HaveLen(3)))
+	})
+})
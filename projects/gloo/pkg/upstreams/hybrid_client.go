@@ -15,19 +15,35 @@ var UnableToRetrieveErr = func(err error, namespace, name string) error {
 	return errors.Wrapf(err, "unable to retrieve service-derived upstream %s.%s", namespace, name)
 }
 
-func NewHybridUpstreamClient(
-	upstreamClient v1.UpstreamClient,
-	serviceClient skkube.ServiceClient) (v1.UpstreamClient, error) {
+// The sources of the upstreams merged with those of the upstream client, all optional
+type HybridUpstreamOptions struct {
+	// Converts the services to upstreams
+	ServiceClient skkube.ServiceClient
+	// Selects the namespaces of the services converted to upstreams, all the namespaces if nil
+	ServiceNamespaces *NamespaceFilter
+	// Reads the upstreams of the files of a directory
+	FileSource *FileUpstreamSource
+	// Converts the services of the consul catalog to upstreams
+	ConsulSource *ConsulUpstreamSource
+}
 
+// Merges the upstreams of the client with the read only upstreams of the sources of the options
+func NewHybridUpstreamClient(upstreamClient v1.UpstreamClient, opts HybridUpstreamOptions) (v1.UpstreamClient, error) {
 	return &hybridUpstreamClient{
-		upstreamClient: upstreamClient,
-		serviceClient:  serviceClient,
+		upstreamClient:    upstreamClient,
+		serviceClient:     opts.ServiceClient,
+		serviceNamespaces: opts.ServiceNamespaces,
+		fileSource:        opts.FileSource,
+		consulSource:      opts.ConsulSource,
 	}, nil
 }
 
 type hybridUpstreamClient struct {
-	upstreamClient v1.UpstreamClient
-	serviceClient  skkube.ServiceClient
+	upstreamClient    v1.UpstreamClient
+	serviceClient     skkube.ServiceClient
+	serviceNamespaces *NamespaceFilter
+	fileSource        *FileUpstreamSource
+	consulSource      *ConsulUpstreamSource
 }
 
 func (c *hybridUpstreamClient) BaseClient() clients.ResourceClient {
@@ -43,6 +59,21 @@ func (c *hybridUpstreamClient) Read(namespace, name string, opts clients.ReadOpt
 	if isRealUpstream(name) {
 		return c.upstreamClient.Read(namespace, name, opts)
 	}
+	if isFileUpstream(name) {
+		if c.fileSource == nil {
+			return nil, errors.Errorf("unable to retrieve file upstream %s.%s, no upstream directory is set", namespace, name)
+		}
+		return c.fileSource.Read(namespace, name, opts)
+	}
+	if isConsulUpstream(name) {
+		if c.consulSource == nil {
+			return nil, errors.Errorf("unable to retrieve consul upstream %s.%s, consul services are not watched", namespace, name)
+		}
+		return c.consulSource.Read(namespace, name, opts)
+	}
+	if c.serviceClient == nil || !c.serviceNamespaces.Selects(namespace) {
+		return nil, errors.Errorf("unable to retrieve service-derived upstream %s.%s, services are not watched", namespace, name)
+	}
 
 	serviceName, _, err := reconstructServiceName(name)
 	if err != nil {
@@ -79,11 +110,28 @@ func (c *hybridUpstreamClient) List(namespace string, opts clients.ListOpts) (v1
 	if err != nil {
 		return nil, err
 	}
-	services, err := c.serviceClient.List(namespace, opts)
-	if err != nil {
-		return nil, err
+	if c.serviceClient != nil && (namespace == "" || c.serviceNamespaces.Selects(namespace)) {
+		services, err := c.serviceClient.List(namespace, opts)
+		if err != nil {
+			return nil, err
+		}
+		realUpstreams = append(realUpstreams, servicesToUpstreams(selectServices(services, c.serviceNamespaces))...)
 	}
-	return append(realUpstreams, servicesToUpstreams(services)...), nil
+	if c.fileSource != nil {
+		fileUpstreams, err := c.fileSource.List(namespace, opts)
+		if err != nil {
+			return nil, err
+		}
+		realUpstreams = append(realUpstreams, fileUpstreams...)
+	}
+	if c.consulSource != nil {
+		consulUpstreams, err := c.consulSource.List(namespace, opts)
+		if err != nil {
+			return nil, err
+		}
+		realUpstreams = append(realUpstreams, consulUpstreams...)
+	}
+	return realUpstreams, nil
 }
 
 func (c *hybridUpstreamClient) Watch(namespace string, opts clients.WatchOpts) (<-chan v1.UpstreamList, <-chan error, error) {
@@ -97,9 +145,33 @@ func (c *hybridUpstreamClient) Watch(namespace string, opts clients.WatchOpts) (
 	}
 
 	// Start watching services
-	svcChan, svcErrChan, initErr := c.serviceClient.Watch(namespace, opts)
-	if initErr != nil {
-		return nil, nil, initErr
+	var svcChan <-chan skkube.ServiceList
+	var svcErrChan <-chan error
+	if c.serviceClient != nil && (namespace == "" || c.serviceNamespaces.Selects(namespace)) {
+		svcChan, svcErrChan, initErr = c.serviceClient.Watch(namespace, opts)
+		if initErr != nil {
+			return nil, nil, initErr
+		}
+	}
+
+	// Start watching upstream files
+	var fileChan <-chan v1.UpstreamList
+	var fileErrChan <-chan error
+	if c.fileSource != nil {
+		fileChan, fileErrChan, initErr = c.fileSource.Watch(namespace, opts)
+		if initErr != nil {
+			return nil, nil, initErr
+		}
+	}
+
+	// Start watching consul services
+	var consulChan <-chan v1.UpstreamList
+	var consulErrChan <-chan error
+	if c.consulSource != nil {
+		consulChan, consulErrChan, initErr = c.consulSource.Watch(namespace, opts)
+		if initErr != nil {
+			return nil, nil, initErr
+		}
 	}
 
 	// Aggregate errors
@@ -112,15 +184,34 @@ func (c *hybridUpstreamClient) Watch(namespace string, opts clients.WatchOpts) (
 		errutils.AggregateErrs(ctx, errs, usErrChan, "upstreams")
 	}()
 
-	done.Add(1)
-	go func() {
-		defer done.Done()
-		errutils.AggregateErrs(ctx, errs, svcErrChan, "services")
-	}()
+	if svcErrChan != nil {
+		done.Add(1)
+		go func() {
+			defer done.Done()
+			errutils.AggregateErrs(ctx, errs, svcErrChan, "services")
+		}()
+	}
+
+	if fileErrChan != nil {
+		done.Add(1)
+		go func() {
+			defer done.Done()
+			errutils.AggregateErrs(ctx, errs, fileErrChan, "upstream files")
+		}()
+	}
+
+	if consulErrChan != nil {
+		done.Add(1)
+		go func() {
+			defer done.Done()
+			errutils.AggregateErrs(ctx, errs, consulErrChan, "consul services")
+		}()
+	}
 
 	// Aggregate watches
 	upstreamsOut := make(chan v1.UpstreamList)
 	go func() {
+		serviceCache := newServiceUpstreamCache()
 		previous := hybridUpstreamSnapshot{}
 		current := previous.Clone()
 		syncFunc := func() {
@@ -146,10 +237,20 @@ func (c *hybridUpstreamClient) Watch(namespace string, opts clients.WatchOpts) (
 				}
 			case serviceList, ok := <-svcChan:
 				if ok {
-					convertedUpstreams := servicesToUpstreams(serviceList)
+					convertedUpstreams := serviceCache.servicesToUpstreams(serviceList, c.serviceNamespaces)
 					current.SetServiceUpstreams(convertedUpstreams)
 					syncFunc()
 				}
+			case fileUpstreams, ok := <-fileChan:
+				if ok {
+					current.SetFileUpstreams(fileUpstreams)
+					syncFunc()
+				}
+			case consulUpstreams, ok := <-consulChan:
+				if ok {
+					current.SetConsulUpstreams(consulUpstreams)
+					syncFunc()
+				}
 			}
 		}
 	}()
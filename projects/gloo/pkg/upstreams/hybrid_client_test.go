@@ -62,7 +62,7 @@ var _ = Describe("HybridUpstreams", func() {
 		baseUsClient, err = v1.NewUpstreamClient(inMemoryFactory)
 		Expect(err).NotTo(HaveOccurred())
 
-		hybridClient, err = upstreams.NewHybridUpstreamClient(baseUsClient, svcClient)
+		hybridClient, err = upstreams.NewHybridUpstreamClient(baseUsClient, upstreams.HybridUpstreamOptions{ServiceClient: svcClient})
 		Expect(err).NotTo(HaveOccurred())
 	})
 
@@ -96,6 +96,20 @@ var _ = Describe("HybridUpstreams", func() {
 			Expect(list).To(HaveLen(4))
 		})
 
+		It("only lists the service-derived upstreams of the namespaces selected by the settings", func() {
+			hybridClient, err = upstreams.NewHybridUpstreamClient(baseUsClient, upstreams.HybridUpstreamOptions{
+				ServiceClient:     svcClient,
+				ServiceNamespaces: upstreams.NewNamespaceFilter(&v1.KubeServiceUpstreams{DeniedNamespaces: []string{watchNamespace}}),
+			})
+			Expect(err).NotTo(HaveOccurred())
+			writeResources()
+
+			list, err := hybridClient.List(watchNamespace, clients.ListOpts{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(list).To(HaveLen(1))
+			Expect(list[0].Metadata.Name).To(Equal("us-1"))
+		})
+
 		It("correctly aggregates watches on real and service-derived upstreams", func() {
 			usChan, errChan, initErr := hybridClient.Watch(watchNamespace, clients.WatchOpts{Ctx: ctx})
 			Expect(initErr).NotTo(HaveOccurred())
@@ -0,0 +1,95 @@
+package upstreams
+
+import (
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	skkube "github.com/solo-io/solo-kit/pkg/api/v1/resources/common/kubernetes"
+)
+
+// Selects the namespaces whose services are converted to upstreams. A namespace is selected if the allow list is empty
+// or contains it, and the deny list does not contain it.
+type NamespaceFilter struct {
+	Allow []string
+	Deny  []string
+}
+
+// NewNamespaceFilter selects the namespaces of the kube service upstreams of the settings
+func NewNamespaceFilter(config *v1.KubeServiceUpstreams) *NamespaceFilter {
+	return &NamespaceFilter{
+		Allow: config.GetAllowedNamespaces(),
+		Deny:  config.GetDeniedNamespaces(),
+	}
+}
+
+func (f *NamespaceFilter) Selects(namespace string) bool {
+	if f == nil {
+		return true
+	}
+	for _, ns := range f.Deny {
+		if ns == namespace {
+			return false
+		}
+	}
+	if len(f.Allow) == 0 {
+		return true
+	}
+	for _, ns := range f.Allow {
+		if ns == namespace {
+			return true
+		}
+	}
+	return false
+}
+
+// the services in the namespaces selected by the filter
+func selectServices(services skkube.ServiceList, filter *NamespaceFilter) skkube.ServiceList {
+	var selected skkube.ServiceList
+	for _, svc := range services {
+		if filter.Selects(svc.Namespace) {
+			selected = append(selected, svc)
+		}
+	}
+	return selected
+}
+
+// Caches the upstreams of the services of a watch by resource version, so that only the services that changed since
+// the last conversion are converted again
+type serviceUpstreamCache struct {
+	entries map[serviceKey]serviceUpstreams
+}
+
+type serviceKey struct {
+	namespace, name string
+}
+
+type serviceUpstreams struct {
+	resourceVersion string
+	upstreams       v1.UpstreamList
+}
+
+func newServiceUpstreamCache() *serviceUpstreamCache {
+	return &serviceUpstreamCache{entries: make(map[serviceKey]serviceUpstreams)}
+}
+
+// converts the services selected by the filter to upstreams. The upstreams are shared with the later conversions,
+// they must be cloned before being modified. The services missing from the list are evicted.
+func (c *serviceUpstreamCache) servicesToUpstreams(services skkube.ServiceList, filter *NamespaceFilter) v1.UpstreamList {
+	entries := make(map[serviceKey]serviceUpstreams, len(c.entries))
+	var result v1.UpstreamList
+	for _, svc := range services {
+		if !filter.Selects(svc.Namespace) {
+			continue
+		}
+		key := serviceKey{namespace: svc.Namespace, name: svc.Name}
+		entry, ok := c.entries[key]
+		if !ok || entry.resourceVersion == "" || entry.resourceVersion != svc.ResourceVersion {
+			entry = serviceUpstreams{
+				resourceVersion: svc.ResourceVersion,
+				upstreams:       servicesToUpstreams(skkube.ServiceList{svc}),
+			}
+		}
+		entries[key] = entry
+		result = append(result, entry.upstreams...)
+	}
+	c.entries = entries
+	return result
+}
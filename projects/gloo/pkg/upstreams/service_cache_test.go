@@ -0,0 +1,58 @@
+package upstreams
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	skkube "github.com/solo-io/solo-kit/pkg/api/v1/resources/common/kubernetes"
+	corev1 "k8s.io/api/core/v1"
+)
+
+var _ = Describe("ServiceUpstreamCache", func() {
+
+	newService := func(namespace, name, resourceVersion string) *skkube.Service {
+		svc := skkube.NewService(namespace, name)
+		svc.ResourceVersion = resourceVersion
+		svc.Spec = corev1.ServiceSpec{Ports: []corev1.ServicePort{{Name: "http", Port: 8080}}}
+		return svc
+	}
+
+	It("selects the namespaces of the filter", func() {
+		var filter *NamespaceFilter
+		Expect(filter.Selects("default")).To(BeTrue())
+
+		filter = &NamespaceFilter{Deny: []string{"kube-system"}}
+		Expect(filter.Selects("default")).To(BeTrue())
+		Expect(filter.Selects("kube-system")).To(BeFalse())
+
+		filter = &NamespaceFilter{Allow: []string{"default", "kube-system"}, Deny: []string{"kube-system"}}
+		Expect(filter.Selects("default")).To(BeTrue())
+		Expect(filter.Selects("kube-system")).To(BeFalse())
+		Expect(filter.Selects("other")).To(BeFalse())
+	})
+
+	It("converts the services that changed only", func() {
+		cache := newServiceUpstreamCache()
+		first := cache.servicesToUpstreams(skkube.ServiceList{newService("default", "svc-1", "1"), newService("default", "svc-2", "1")}, nil)
+		Expect(first).To(HaveLen(2))
+
+		second := cache.servicesToUpstreams(skkube.ServiceList{newService("default", "svc-1", "1"), newService("default", "svc-2", "2")}, nil)
+		Expect(second).To(HaveLen(2))
+		Expect(second[0]).To(BeIdenticalTo(first[0]))
+		Expect(second[1]).NotTo(BeIdenticalTo(first[1]))
+
+		// services without a resource version are always converted
+		third := cache.servicesToUpstreams(skkube.ServiceList{newService("default", "svc-3", ""), newService("default", "svc-1", "1")}, nil)
+		fourth := cache.servicesToUpstreams(skkube.ServiceList{newService("default", "svc-3", ""), newService("default", "svc-1", "1")}, nil)
+		Expect(fourth[0]).NotTo(BeIdenticalTo(third[0]))
+		Expect(fourth[1]).To(BeIdenticalTo(first[0]))
+		Expect(cache.entries).To(HaveLen(2))
+	})
+
+	It("skips the services of the namespaces not selected", func() {
+		cache := newServiceUpstreamCache()
+		usList := cache.servicesToUpstreams(skkube.ServiceList{newService("default", "svc-1", "1"), newService("kube-system", "svc-2", "1")},
+			&NamespaceFilter{Deny: []string{"kube-system"}})
+		Expect(usList).To(HaveLen(1))
+		Expect(usList[0].Metadata.Namespace).To(Equal("default"))
+	})
+})
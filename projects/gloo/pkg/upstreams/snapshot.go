@@ -5,9 +5,9 @@ import (
 	"github.com/solo-io/go-utils/hashutils"
 )
 
-// Groups real and service-derived upstreams
+// Groups real, service-derived, file and consul upstreams
 type hybridUpstreamSnapshot struct {
-	realUpstreams, serviceUpstreams v1.UpstreamList
+	realUpstreams, serviceUpstreams, fileUpstreams, consulUpstreams v1.UpstreamList
 }
 
 func (s *hybridUpstreamSnapshot) SetRealUpstreams(upstreams v1.UpstreamList) {
@@ -18,18 +18,32 @@ func (s *hybridUpstreamSnapshot) SetServiceUpstreams(upstreams v1.UpstreamList)
 	s.serviceUpstreams = upstreams
 }
 
+func (s *hybridUpstreamSnapshot) SetFileUpstreams(upstreams v1.UpstreamList) {
+	s.fileUpstreams = upstreams
+}
+
+func (s *hybridUpstreamSnapshot) SetConsulUpstreams(upstreams v1.UpstreamList) {
+	s.consulUpstreams = upstreams
+}
+
 func (s *hybridUpstreamSnapshot) ToList() v1.UpstreamList {
-	return append(s.realUpstreams, s.serviceUpstreams...)
+	var list v1.UpstreamList
+	list = append(list, s.realUpstreams...)
+	list = append(list, s.serviceUpstreams...)
+	list = append(list, s.fileUpstreams...)
+	return append(list, s.consulUpstreams...)
 }
 
 func (s *hybridUpstreamSnapshot) Clone() hybridUpstreamSnapshot {
 	return hybridUpstreamSnapshot{
 		realUpstreams:    s.realUpstreams.Clone(),
-		serviceUpstreams: s.serviceUpstreams.Clone()}
+		serviceUpstreams: s.serviceUpstreams.Clone(),
+		fileUpstreams:    s.fileUpstreams.Clone(),
+		consulUpstreams:  s.consulUpstreams.Clone()}
 }
 
 func (s *hybridUpstreamSnapshot) Hash() uint64 {
 	// Sort merged slice for consistent hashing
-	usList := append(s.realUpstreams, s.serviceUpstreams...).Sort()
+	usList := s.ToList().Sort()
 	return hashutils.HashAll(usList.AsInterfaces()...)
 }
@@ -1,6 +1,8 @@
 package utils
 
 import (
+	"strings"
+
 	envoyauth "github.com/envoyproxy/go-control-plane/envoy/api/v2/auth"
 	envoycore "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
 	"github.com/envoyproxy/go-control-plane/envoy/config/grpc_credential/v2alpha"
@@ -17,6 +19,8 @@ const (
 
 type SslConfigTranslator struct {
 	secrets v1.SecretList
+	// reference the certificates of the secrets by sds rather than inlining them
+	sds bool
 }
 
 func NewSslConfigTranslator(secrets v1.SecretList) *SslConfigTranslator {
@@ -25,11 +29,21 @@ func NewSslConfigTranslator(secrets v1.SecretList) *SslConfigTranslator {
 	}
 }
 
+// NewSdsSslConfigTranslator references the certificates of the secrets by sds over ADS rather than inlining them, so
+// that they are served to envoy by the control plane with SdsSecret
+func NewSdsSslConfigTranslator(secrets v1.SecretList) *SslConfigTranslator {
+	return &SslConfigTranslator{
+		secrets: secrets,
+		sds:     true,
+	}
+}
+
 func (s *SslConfigTranslator) ResolveUpstreamSslConfig(uc *v1.UpstreamSslConfig) (*envoyauth.UpstreamTlsContext, error) {
 	common, err := s.ResolveCommonSslConfig(uc)
 	if err != nil {
 		return nil, err
 	}
+	common.AlpnProtocols = uc.AlpnProtocols
 	return &envoyauth.UpstreamTlsContext{
 		CommonTlsContext: common,
 		Sni:              uc.Sni,
@@ -179,6 +193,9 @@ func (s *SslConfigTranslator) ResolveCommonSslConfig(cs CertSource) (*envoyauth.
 		if err != nil {
 			return nil, err
 		}
+		if s.sds {
+			return referenceSdsSecrets(*ref, cs, certChain, privateKey, rootCa)
+		}
 	} else if sslSecrets := cs.GetSslFiles(); sslSecrets != nil {
 		certChain, privateKey, rootCa = sslSecrets.TlsCert, sslSecrets.TlsKey, sslSecrets.RootCa
 	} else if sslSecrets := cs.GetSds(); sslSecrets != nil {
@@ -241,6 +258,103 @@ func (s *SslConfigTranslator) ResolveCommonSslConfig(cs CertSource) (*envoyauth.
 	return tlsContext, err
 }
 
+// references the certificates of the tls secret by sds rather than inlining them
+func referenceSdsSecrets(ref core.ResourceRef, cs CertSource, certChain, privateKey, rootCa string) (*envoyauth.CommonTlsContext, error) {
+	tlsContext := &envoyauth.CommonTlsContext{}
+
+	if certChain != "" && privateKey != "" {
+		tlsContext.TlsCertificateSdsSecretConfigs = []*envoyauth.SdsSecretConfig{adsSdsSecretConfig(SdsCertificateName(ref))}
+	} else if certChain != "" || privateKey != "" {
+		return nil, errors.Errorf("both or none of cert chain and private key must be provided")
+	}
+
+	sanList := cs.GetVerifySubjectAltName()
+
+	if rootCa != "" {
+		validationContext := adsSdsSecretConfig(SdsValidationContextName(ref))
+		if len(sanList) == 0 {
+			tlsContext.ValidationContextType = &envoyauth.CommonTlsContext_ValidationContextSdsSecretConfig{
+				ValidationContextSdsSecretConfig: validationContext,
+			}
+		} else {
+			// the subject alt names are not part of the secret, which can be shared
+			tlsContext.ValidationContextType = &envoyauth.CommonTlsContext_CombinedValidationContext{
+				CombinedValidationContext: &envoyauth.CommonTlsContext_CombinedCertificateValidationContext{
+					DefaultValidationContext:         &envoyauth.CertificateValidationContext{VerifySubjectAltName: sanList},
+					ValidationContextSdsSecretConfig: validationContext,
+				},
+			}
+		}
+	} else if len(sanList) != 0 {
+		return nil, errors.Errorf("a root_ca must be provided if verify_subject_alt_name is not empty")
+	}
+
+	var err error
+	tlsContext.TlsParams, err = convertTlsParams(cs)
+
+	return tlsContext, err
+}
+
+func adsSdsSecretConfig(name string) *envoyauth.SdsSecretConfig {
+	return &envoyauth.SdsSecretConfig{
+		Name: name,
+		SdsConfig: &envoycore.ConfigSource{
+			ConfigSourceSpecifier: &envoycore.ConfigSource_Ads{
+				Ads: &envoycore.AggregatedConfigSource{},
+			},
+		},
+	}
+}
+
+// SdsCertificateName is the name of the sds secret of the certificate chain and private key of a tls secret
+func SdsCertificateName(ref core.ResourceRef) string {
+	return ref.Namespace + sdsNameSeparator + ref.Name
+}
+
+// SdsValidationContextName is the name of the sds secret of the root ca of a tls secret
+func SdsValidationContextName(ref core.ResourceRef) string {
+	return SdsCertificateName(ref) + sdsNameSeparator + sdsValidationContextSuffix
+}
+
+// the names of the resources cannot contain it
+const (
+	sdsNameSeparator           = "~"
+	sdsValidationContextSuffix = "ca"
+)
+
+// SdsSecret is the sds secret with the name, built from the tls secret it was named after
+func SdsSecret(name string, secrets v1.SecretList) (*envoyauth.Secret, error) {
+	parts := strings.Split(name, sdsNameSeparator)
+	validationContext := len(parts) == 3 && parts[2] == sdsValidationContextSuffix
+	if len(parts) != 2 && !validationContext {
+		return nil, errors.Errorf("%v is not the name of an sds secret of gloo", name)
+	}
+	certChain, privateKey, rootCa, err := getSslSecrets(core.ResourceRef{Namespace: parts[0], Name: parts[1]}, secrets)
+	if err != nil {
+		return nil, err
+	}
+
+	if validationContext {
+		return &envoyauth.Secret{
+			Name: name,
+			Type: &envoyauth.Secret_ValidationContext{
+				ValidationContext: &envoyauth.CertificateValidationContext{
+					TrustedCa: dataSourceGenerator(true)(rootCa),
+				},
+			},
+		}, nil
+	}
+	return &envoyauth.Secret{
+		Name: name,
+		Type: &envoyauth.Secret_TlsCertificate{
+			TlsCertificate: &envoyauth.TlsCertificate{
+				CertificateChain: dataSourceGenerator(true)(certChain),
+				PrivateKey:       dataSourceGenerator(true)(privateKey),
+			},
+		},
+	}, nil
+}
+
 func getSslSecrets(ref core.ResourceRef, secrets v1.SecretList) (string, string, string, error) {
 	secret, err := secrets.Find(ref.Strings())
 	if err != nil {
@@ -178,6 +178,13 @@ var _ = Describe("Ssl", func() {
 			Expect(cfg.CommonTlsContext.AlpnProtocols).To(BeEmpty())
 		})
 
+		It("should set the alpn protocols of the upstream config", func() {
+			upstreamCfg.AlpnProtocols = []string{"h2"}
+			cfg, err := configTranslator.ResolveUpstreamSslConfig(upstreamCfg)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cfg.CommonTlsContext.AlpnProtocols).To(Equal([]string{"h2"}))
+		})
+
 		It("should not set require client cert for downstream config with no rootca", func() {
 			tlsSecret.RootCa = ""
 			cfg, err := configTranslator.ResolveDownstreamSslConfig(downstreamCfg)
@@ -230,6 +237,57 @@ var _ = Describe("Ssl", func() {
 			})
 		})
 
+		Context("sds secrets", func() {
+			var (
+				ref core.ResourceRef
+			)
+			BeforeEach(func() {
+				ref = secret.Metadata.Ref()
+				configTranslator = NewSdsSslConfigTranslator(secrets)
+			})
+
+			It("should reference the certificates by sds", func() {
+				c, err := configTranslator.ResolveCommonSslConfig(upstreamCfg)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(c.TlsCertificates).To(BeEmpty())
+				Expect(c.TlsCertificateSdsSecretConfigs).To(HaveLen(1))
+				Expect(c.TlsCertificateSdsSecretConfigs[0].Name).To(Equal(SdsCertificateName(ref)))
+				Expect(c.TlsCertificateSdsSecretConfigs[0].SdsConfig.GetAds()).NotTo(BeNil())
+				vctx := c.ValidationContextType.(*envoyauth.CommonTlsContext_ValidationContextSdsSecretConfig).ValidationContextSdsSecretConfig
+				Expect(vctx.Name).To(Equal(SdsValidationContextName(ref)))
+				Expect(vctx.SdsConfig.GetAds()).NotTo(BeNil())
+			})
+
+			It("should combine the validation context with the SAN", func() {
+				upstreamCfg.VerifySubjectAltName = []string{"test"}
+				c, err := configTranslator.ResolveCommonSslConfig(upstreamCfg)
+				Expect(err).NotTo(HaveOccurred())
+				vctx := c.ValidationContextType.(*envoyauth.CommonTlsContext_CombinedValidationContext).CombinedValidationContext
+				Expect(vctx.DefaultValidationContext.VerifySubjectAltName).To(Equal(upstreamCfg.VerifySubjectAltName))
+				Expect(vctx.ValidationContextSdsSecretConfig.Name).To(Equal(SdsValidationContextName(ref)))
+			})
+
+			It("should build the sds secrets", func() {
+				cert, err := SdsSecret(SdsCertificateName(ref), secrets)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(cert.Name).To(Equal(SdsCertificateName(ref)))
+				tlsCert := cert.GetTlsCertificate()
+				Expect(tlsCert.CertificateChain.GetInlineString()).To(Equal("tlscert"))
+				Expect(tlsCert.PrivateKey.GetInlineString()).To(Equal("tlskey"))
+
+				ca, err := SdsSecret(SdsValidationContextName(ref), secrets)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(ca.GetValidationContext().TrustedCa.GetInlineString()).To(Equal("rootca"))
+			})
+
+			It("should error when building an unknown sds secret", func() {
+				_, err := SdsSecret("name", secrets)
+				Expect(err).To(HaveOccurred())
+				_, err = SdsSecret(SdsCertificateName(ref), nil)
+				Expect(err).To(HaveOccurred())
+			})
+		})
+
 	})
 
 	Context("sds", func() {
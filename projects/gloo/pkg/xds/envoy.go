@@ -6,6 +6,7 @@ import (
 
 	v2 "github.com/envoyproxy/go-control-plane/envoy/api/v2"
 	"github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
+	discovery "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v2"
 
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/gloo/pkg/defaults"
@@ -82,6 +83,7 @@ func SetupEnvoyXds(grpcServer *grpc.Server, xdsServer envoyserver.Server, envoyC
 	v2.RegisterClusterDiscoveryServiceServer(grpcServer, envoyServer)
 	v2.RegisterRouteDiscoveryServiceServer(grpcServer, envoyServer)
 	v2.RegisterListenerDiscoveryServiceServer(grpcServer, envoyServer)
+	discovery.RegisterSecretDiscoveryServiceServer(grpcServer, envoyServer)
 	envoyCache.SetSnapshot(fallbackNodeKey, fallbackSnapshot(fallbackBindAddr, fallbackBindPort, fallbackStatusCode))
 
 	return hasher
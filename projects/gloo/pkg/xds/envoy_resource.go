@@ -16,6 +16,7 @@ package xds
 
 import (
 	v2 "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	"github.com/envoyproxy/go-control-plane/envoy/api/v2/auth"
 	"github.com/envoyproxy/go-control-plane/envoy/api/v2/listener"
 	hcm "github.com/envoyproxy/go-control-plane/envoy/config/filter/network/http_connection_manager/v2"
 	"github.com/gogo/protobuf/types"
@@ -40,6 +41,7 @@ const (
 	ClusterType  = typePrefix + "Cluster"
 	RouteType    = typePrefix + "RouteConfiguration"
 	ListenerType = typePrefix + "Listener"
+	SecretType   = typePrefix + "auth.Secret"
 )
 
 var (
@@ -49,6 +51,7 @@ var (
 		ClusterType,
 		RouteType,
 		ListenerType,
+		SecretType,
 	}
 )
 
@@ -70,6 +73,8 @@ func (e *EnvoyResource) Name() string {
 		return v.GetName()
 	case *v2.Listener:
 		return v.GetName()
+	case *auth.Secret:
+		return v.GetName()
 	default:
 		return ""
 	}
@@ -89,6 +94,8 @@ func (e *EnvoyResource) Type() string {
 		return RouteType
 	case *v2.Listener:
 		return ListenerType
+	case *auth.Secret:
+		return SecretType
 	default:
 		return ""
 	}
@@ -228,6 +235,8 @@ func GetResourceName(res cache.ResourceProto) string {
 		return v.GetName()
 	case *v2.Listener:
 		return v.GetName()
+	case *auth.Secret:
+		return v.GetName()
 	default:
 		return ""
 	}
@@ -23,6 +23,7 @@ import (
 	"google.golang.org/grpc/status"
 
 	v2 "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	discovery "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v2"
 	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/server"
 )
 
@@ -32,6 +33,7 @@ type EnvoyServer interface {
 	v2.ClusterDiscoveryServiceServer
 	v2.RouteDiscoveryServiceServer
 	v2.ListenerDiscoveryServiceServer
+	discovery.SecretDiscoveryServiceServer
 }
 
 type envoyServer struct {
@@ -59,6 +61,10 @@ func (s *envoyServer) StreamListeners(stream v2.ListenerDiscoveryService_StreamL
 	return s.Server.Stream(stream, ListenerType)
 }
 
+func (s *envoyServer) StreamSecrets(stream discovery.SecretDiscoveryService_StreamSecretsServer) error {
+	return s.Server.Stream(stream, SecretType)
+}
+
 func (s *envoyServer) FetchEndpoints(ctx context.Context, req *v2.DiscoveryRequest) (*v2.DiscoveryResponse, error) {
 	if req == nil {
 		return nil, status.Errorf(codes.Unavailable, "empty request")
@@ -91,6 +97,16 @@ func (s *envoyServer) FetchListeners(ctx context.Context, req *v2.DiscoveryReque
 	return s.Server.Fetch(ctx, req)
 }
 
+func (s *envoyServer) FetchSecrets(ctx context.Context, req *v2.DiscoveryRequest) (*v2.DiscoveryResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.Unavailable, "empty request")
+	}
+	req.TypeUrl = SecretType
+	return s.Server.Fetch(ctx, req)
+}
+
+// incremental xds is not served: the envoy shipped with gloo gets its config over state-of-the-world ADS, which keeps
+// the clusters, endpoints, listeners and routes in order, and go-control-plane has no delta ADS server to move it to
 func (s *envoyServer) DeltaClusters(_ v2.ClusterDiscoveryService_DeltaClustersServer) error {
 	return errors.New("not implemented")
 }
@@ -36,6 +36,9 @@ type EnvoySnapshot struct {
 
 	// Listeners are items in the LDS response payload.
 	Listeners cache.Resources
+
+	// Secrets are items in the SDS response payload.
+	Secrets cache.Resources
 }
 
 var _ cache.Snapshot = &EnvoySnapshot{}
@@ -65,6 +68,17 @@ func NewSnapshotFromResources(endpoints cache.Resources,
 	}
 }
 
+// NewSnapshotWithSecrets creates a snapshot that also serves the secrets the clusters and listeners fetch by SDS.
+func NewSnapshotWithSecrets(endpoints cache.Resources,
+	clusters cache.Resources,
+	routes cache.Resources,
+	listeners cache.Resources,
+	secrets cache.Resources) *EnvoySnapshot {
+	snapshot := NewSnapshotFromResources(endpoints, clusters, routes, listeners)
+	snapshot.Secrets = secrets
+	return snapshot
+}
+
 // Consistent check verifies that the dependent resources are exactly listed in the
 // snapshot:
 // - all EDS resources are listed by name in CDS resources
@@ -106,6 +120,8 @@ func (s *EnvoySnapshot) GetResources(typ string) cache.Resources {
 		return s.Routes
 	case ListenerType:
 		return s.Listeners
+	case SecretType:
+		return s.Secrets
 	}
 	return cache.Resources{}
 }
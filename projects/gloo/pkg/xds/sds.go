@@ -0,0 +1,42 @@
+package xds
+
+import (
+	v2 "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	"github.com/envoyproxy/go-control-plane/envoy/api/v2/auth"
+	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
+)
+
+// GetSdsSecretReferences returns the names of the secrets the tls contexts of the clusters and listeners fetch from
+// the control plane by SDS over ADS. The secrets fetched from other SDS servers are not included.
+func GetSdsSecretReferences(resources map[string]cache.Resource) map[string]bool {
+	out := make(map[string]bool)
+	for _, res := range resources {
+		if res == nil {
+			continue
+		}
+		switch v := res.ResourceProto().(type) {
+		case *v2.Cluster:
+			addSdsSecretReferences(v.GetTlsContext().GetCommonTlsContext(), out)
+		case *v2.Listener:
+			for _, chain := range v.FilterChains {
+				addSdsSecretReferences(chain.GetTlsContext().GetCommonTlsContext(), out)
+			}
+		}
+	}
+	return out
+}
+
+func addSdsSecretReferences(tlsContext *auth.CommonTlsContext, out map[string]bool) {
+	if tlsContext == nil {
+		return
+	}
+	configs := append([]*auth.SdsSecretConfig{
+		tlsContext.GetValidationContextSdsSecretConfig(),
+		tlsContext.GetCombinedValidationContext().GetValidationContextSdsSecretConfig(),
+	}, tlsContext.TlsCertificateSdsSecretConfigs...)
+	for _, config := range configs {
+		if config.GetSdsConfig().GetAds() != nil {
+			out[config.Name] = true
+		}
+	}
+}
@@ -0,0 +1,51 @@
+package xds
+
+import (
+	"sync"
+
+	envoycache "github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
+	"github.com/solo-io/solo-kit/pkg/errors"
+)
+
+// ServedSnapshotCache keeps the latest snapshot of each node besides setting it in the wrapped cache, so that the
+// translator syncers created when the settings change know what the proxies are served
+type ServedSnapshotCache struct {
+	envoycache.SnapshotCache
+
+	lock      sync.RWMutex
+	snapshots map[string]envoycache.Snapshot
+}
+
+func NewServedSnapshotCache(cache envoycache.SnapshotCache) *ServedSnapshotCache {
+	return &ServedSnapshotCache{
+		SnapshotCache: cache,
+		snapshots:     make(map[string]envoycache.Snapshot),
+	}
+}
+
+func (c *ServedSnapshotCache) SetSnapshot(node string, snapshot envoycache.Snapshot) error {
+	if err := c.SnapshotCache.SetSnapshot(node, snapshot); err != nil {
+		return err
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.snapshots[node] = snapshot
+	return nil
+}
+
+func (c *ServedSnapshotCache) GetSnapshot(node string) (envoycache.Snapshot, error) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	snapshot, ok := c.snapshots[node]
+	if !ok {
+		return nil, errors.Errorf("no snapshot found for node %v", node)
+	}
+	return snapshot, nil
+}
+
+func (c *ServedSnapshotCache) ClearSnapshot(node string) {
+	c.SnapshotCache.ClearSnapshot(node)
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	delete(c.snapshots, node)
+}
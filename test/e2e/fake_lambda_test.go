@@ -0,0 +1,83 @@
+package e2e_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/solo-io/gloo/projects/discovery/pkg/fds/discoveries/aws"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	aws_plugin "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/aws"
+	"github.com/solo-io/gloo/test/v1helpers"
+)
+
+var _ = Describe("Fake AWS Lambda", func() {
+	const region = "us-east-1"
+
+	var (
+		ctx        context.Context
+		cancel     context.CancelFunc
+		fakeLambda *v1helpers.FakeLambda
+		secret     *gloov1.Secret
+		upstream   *gloov1.Upstream
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		fakeLambda = v1helpers.NewFakeLambda(ctx,
+			&v1helpers.FakeLambdaFunction{
+				Name:     "uppercase",
+				Versions: []string{"1", "2"},
+				Aliases:  []string{"prod"},
+			},
+			&v1helpers.FakeLambdaFunction{
+				Name: "contact-form",
+			},
+		)
+		secret = fakeLambda.Secret("default", region)
+		upstream = fakeLambda.Upstream(secret, region)
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	detectFunctions := func(secrets gloov1.SecretList) ([]*aws_plugin.LambdaFunctionSpec, error) {
+		factory := &aws.AWSLambdaFunctionDiscoveryFactory{
+			PollingTime: time.Second,
+			Endpoint:    fakeLambda.Endpoint(),
+			HTTPClient:  fakeLambda.Client(),
+		}
+		discovery := factory.NewFunctionDiscovery(upstream).(*aws.AWSLambdaFunctionDiscovery)
+		return discovery.DetectFunctionsOnce(ctx, secrets)
+	}
+
+	It("should discover the versions and aliases of the functions", func() {
+		functions, err := detectFunctions(gloov1.SecretList{secret})
+		Expect(err).NotTo(HaveOccurred())
+
+		var logicalNames []string
+		for _, fn := range functions {
+			logicalNames = append(logicalNames, fn.LogicalName)
+		}
+		Expect(logicalNames).To(ConsistOf("uppercase", "uppercase:1", "uppercase:2", "uppercase:prod", "contact-form"))
+	})
+
+	It("should only import the qualifiers of the upstream", func() {
+		upstream.UpstreamSpec.UpstreamType.(*gloov1.UpstreamSpec_Aws).Aws.Qualifiers = []string{"prod"}
+
+		functions, err := detectFunctions(gloov1.SecretList{secret})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(functions).To(HaveLen(1))
+		Expect(functions[0].LogicalName).To(Equal("uppercase:prod"))
+	})
+
+	It("should reject unknown credentials", func() {
+		secret.Kind.(*gloov1.Secret_Aws).Aws.AccessKey = "someone-else"
+
+		_, err := detectFunctions(gloov1.SecretList{secret})
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,240 @@
+package v1helpers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	aws_plugin "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/plugins/aws"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+const (
+	fakeLambdaAccessKey = "fake-access-key"
+	fakeLambdaSecretKey = "fake-secret-key"
+
+	lambdaApiPrefix = "/2015-03-31/functions/"
+)
+
+// FakeLambdaFunction is a function deployed to a FakeLambda.
+// Handler is called with the payload of each invocation and returns the response payload.
+type FakeLambdaFunction struct {
+	Name     string
+	Versions []string
+	Aliases  []string
+	Handler  func(payload []byte) []byte
+}
+
+// FakeLambda serves the parts of the AWS Lambda API used by Gloo over HTTPS, so that function
+// discovery and invocations can be tested without an AWS account.
+// Requests must be signed with the credentials of the Secret of the fake.
+type FakeLambda struct {
+	server *httptest.Server
+
+	lock      sync.Mutex
+	functions map[string]*FakeLambdaFunction
+	denied    map[string]bool
+	calls     map[string]int
+}
+
+func NewFakeLambda(ctx context.Context, functions ...*FakeLambdaFunction) *FakeLambda {
+	f := &FakeLambda{
+		functions: make(map[string]*FakeLambdaFunction),
+		denied:    make(map[string]bool),
+		calls:     make(map[string]int),
+	}
+	for _, fn := range functions {
+		f.AddFunction(fn)
+	}
+	f.server = httptest.NewTLSServer(http.HandlerFunc(f.serveHTTP))
+	go func() {
+		<-ctx.Done()
+		f.server.Close()
+	}()
+	return f
+}
+
+// Endpoint is the url of the fake API
+func (f *FakeLambda) Endpoint() string {
+	return f.server.URL
+}
+
+// Client is an http client trusting the certificate of the fake API
+func (f *FakeLambda) Client() *http.Client {
+	return f.server.Client()
+}
+
+// AddFunction deploys a function to the fake, replacing any function with the same name
+func (f *FakeLambda) AddFunction(fn *FakeLambdaFunction) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.functions[fn.Name] = fn
+}
+
+// DenyPermissions denies the actions of the permissions to the credentials of the fake, e.g. `lambda:ListAliases`
+func (f *FakeLambda) DenyPermissions(permissions ...string) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	for _, permission := range permissions {
+		f.denied[permission] = true
+	}
+}
+
+// Calls returns the number of calls to the fake requiring the permission, e.g. `lambda:ListAliases`
+func (f *FakeLambda) Calls(permission string) int {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	return f.calls[permission]
+}
+
+// Secret returns the AWS secret with the credentials accepted by the fake
+func (f *FakeLambda) Secret(namespace, name string) *gloov1.Secret {
+	return &gloov1.Secret{
+		Metadata: core.Metadata{
+			Namespace: namespace,
+			Name:      name,
+		},
+		Kind: &gloov1.Secret_Aws{
+			Aws: &gloov1.AwsSecret{
+				AccessKey: fakeLambdaAccessKey,
+				SecretKey: fakeLambdaSecretKey,
+			},
+		},
+	}
+}
+
+// Upstream returns an AWS upstream referencing the secret, without any function
+func (f *FakeLambda) Upstream(secret *gloov1.Secret, region string) *gloov1.Upstream {
+	return &gloov1.Upstream{
+		Metadata: core.Metadata{
+			Namespace: secret.Metadata.Namespace,
+			Name:      "fake-lambda-" + region,
+		},
+		UpstreamSpec: &gloov1.UpstreamSpec{
+			UpstreamType: &gloov1.UpstreamSpec_Aws{
+				Aws: &aws_plugin.UpstreamSpec{
+					Region:    region,
+					SecretRef: secret.Metadata.Ref(),
+				},
+			},
+		},
+	}
+}
+
+type fakeLambdaFunctionConfiguration struct {
+	FunctionName string
+	Version      string
+}
+
+type fakeLambdaAlias struct {
+	Name string
+}
+
+func (f *FakeLambda) serveHTTP(rw http.ResponseWriter, r *http.Request) {
+	// only the access key is checked, the fake does not verify signatures
+	if !strings.Contains(r.Header.Get("Authorization"), "Credential="+fakeLambdaAccessKey+"/") {
+		writeLambdaError(rw, http.StatusForbidden, "AccessDeniedException", "unknown credentials")
+		return
+	}
+	if !strings.HasPrefix(r.URL.Path, lambdaApiPrefix) {
+		writeLambdaError(rw, http.StatusNotFound, "ResourceNotFoundException", r.URL.Path)
+		return
+	}
+
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	parts := strings.Split(strings.TrimPrefix(r.URL.Path, lambdaApiPrefix), "/")
+	switch {
+	case len(parts) == 1 && parts[0] == "" && r.Method == http.MethodGet:
+		if f.allowed(rw, "lambda:ListFunctions") {
+			f.listFunctions(rw, r)
+		}
+		return
+	case len(parts) == 2 && parts[1] == "aliases" && r.Method == http.MethodGet:
+		if f.allowed(rw, "lambda:ListAliases") {
+			f.listAliases(rw, parts[0])
+		}
+		return
+	case len(parts) == 2 && parts[1] == "invocations" && r.Method == http.MethodPost:
+		if f.allowed(rw, "lambda:InvokeFunction") {
+			f.invoke(rw, r, parts[0])
+		}
+		return
+	}
+	writeLambdaError(rw, http.StatusNotFound, "ResourceNotFoundException", r.URL.Path)
+}
+
+func (f *FakeLambda) allowed(rw http.ResponseWriter, permission string) bool {
+	f.calls[permission]++
+	if f.denied[permission] {
+		writeLambdaError(rw, http.StatusForbidden, "AccessDeniedException", fmt.Sprintf("not authorized to perform: %v", permission))
+		return false
+	}
+	return true
+}
+
+func (f *FakeLambda) listFunctions(rw http.ResponseWriter, r *http.Request) {
+	allVersions := r.URL.Query().Get("FunctionVersion") == "ALL"
+	var configurations []fakeLambdaFunctionConfiguration
+	for _, fn := range f.functions {
+		configurations = append(configurations, fakeLambdaFunctionConfiguration{FunctionName: fn.Name, Version: "$LATEST"})
+		if !allVersions {
+			continue
+		}
+		for _, version := range fn.Versions {
+			configurations = append(configurations, fakeLambdaFunctionConfiguration{FunctionName: fn.Name, Version: version})
+		}
+	}
+	writeLambdaResponse(rw, map[string]interface{}{"Functions": configurations})
+}
+
+func (f *FakeLambda) listAliases(rw http.ResponseWriter, name string) {
+	fn, ok := f.functions[name]
+	if !ok {
+		writeLambdaError(rw, http.StatusNotFound, "ResourceNotFoundException", fmt.Sprintf("function not found: %v", name))
+		return
+	}
+	var aliases []fakeLambdaAlias
+	for _, alias := range fn.Aliases {
+		aliases = append(aliases, fakeLambdaAlias{Name: alias})
+	}
+	writeLambdaResponse(rw, map[string]interface{}{"Aliases": aliases})
+}
+
+func (f *FakeLambda) invoke(rw http.ResponseWriter, r *http.Request, name string) {
+	fn, ok := f.functions[name]
+	if !ok {
+		writeLambdaError(rw, http.StatusNotFound, "ResourceNotFoundException", fmt.Sprintf("function not found: %v", name))
+		return
+	}
+	payload, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		writeLambdaError(rw, http.StatusBadRequest, "InvalidRequestContentException", err.Error())
+		return
+	}
+	var response []byte
+	if fn.Handler != nil {
+		response = fn.Handler(payload)
+	}
+	rw.Header().Set("Content-Type", "application/json")
+	rw.Write(response)
+}
+
+func writeLambdaResponse(rw http.ResponseWriter, out interface{}) {
+	rw.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(rw).Encode(out)
+}
+
+func writeLambdaError(rw http.ResponseWriter, status int, errorType, message string) {
+	rw.Header().Set("Content-Type", "application/json")
+	rw.Header().Set("X-Amzn-Errortype", errorType)
+	rw.WriteHeader(status)
+	json.NewEncoder(rw).Encode(map[string]string{"message": message})
+}